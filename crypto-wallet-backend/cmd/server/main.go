@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net"
 	"os"
 	"os/signal"
 	"strconv"
@@ -15,24 +16,67 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	fiberRecover "github.com/gofiber/fiber/v2/middleware/recover"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
-
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc"
+
+	accountusecase "github.com/crypto-wallet/backend/internal/application/usecases/account"
+	addressbookusecase "github.com/crypto-wallet/backend/internal/application/usecases/addressbook"
+	adminusecase "github.com/crypto-wallet/backend/internal/application/usecases/admin"
+	appsusecase "github.com/crypto-wallet/backend/internal/application/usecases/apps"
+	activityusecase "github.com/crypto-wallet/backend/internal/application/usecases/activity"
+	internaleventsusecase "github.com/crypto-wallet/backend/internal/application/usecases/internalevents"
 	analyticsusecase "github.com/crypto-wallet/backend/internal/application/usecases/analytics"
+	exchangeusecase "github.com/crypto-wallet/backend/internal/application/usecases/exchange"
+	auditusecase "github.com/crypto-wallet/backend/internal/application/usecases/audit"
 	authusecase "github.com/crypto-wallet/backend/internal/application/usecases/auth"
+	broadcastusecase "github.com/crypto-wallet/backend/internal/application/usecases/broadcast"
+	eventexportusecase "github.com/crypto-wallet/backend/internal/application/usecases/eventexport"
+	exportusecase "github.com/crypto-wallet/backend/internal/application/usecases/export"
+	gasusecase "github.com/crypto-wallet/backend/internal/application/usecases/gas"
 	kycusecase "github.com/crypto-wallet/backend/internal/application/usecases/kyc"
+	notificationusecase "github.com/crypto-wallet/backend/internal/application/usecases/notification"
+	p2pusecase "github.com/crypto-wallet/backend/internal/application/usecases/p2p"
+	preferencesusecase "github.com/crypto-wallet/backend/internal/application/usecases/preferences"
+	profileusecase "github.com/crypto-wallet/backend/internal/application/usecases/profile"
+	ratesusecase "github.com/crypto-wallet/backend/internal/application/usecases/rates"
+	reconciliationusecase "github.com/crypto-wallet/backend/internal/application/usecases/reconciliation"
 	transactionusecase "github.com/crypto-wallet/backend/internal/application/usecases/transaction"
 	"github.com/crypto-wallet/backend/internal/application/usecases/wallet"
+	webhookusecase "github.com/crypto-wallet/backend/internal/application/usecases/webhook"
 	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/events"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
 	"github.com/crypto-wallet/backend/internal/domain/services"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
 	"github.com/crypto-wallet/backend/internal/infrastructure/blockchain"
+	"github.com/crypto-wallet/backend/internal/infrastructure/budget"
+	"github.com/crypto-wallet/backend/internal/infrastructure/cache"
 	"github.com/crypto-wallet/backend/internal/infrastructure/database"
+	"github.com/crypto-wallet/backend/internal/infrastructure/eventexport"
 	"github.com/crypto-wallet/backend/internal/infrastructure/external"
+	"github.com/crypto-wallet/backend/internal/infrastructure/hdwallet"
+	"github.com/crypto-wallet/backend/internal/infrastructure/jobqueue"
 	"github.com/crypto-wallet/backend/internal/infrastructure/logging"
+	"github.com/crypto-wallet/backend/internal/infrastructure/messaging"
+	"github.com/crypto-wallet/backend/internal/infrastructure/monitoring"
+	"github.com/crypto-wallet/backend/internal/infrastructure/objectstore"
+	"github.com/crypto-wallet/backend/internal/infrastructure/ratelimit"
 	"github.com/crypto-wallet/backend/internal/infrastructure/repository/postgres"
+	"github.com/crypto-wallet/backend/internal/infrastructure/scheduler"
 	"github.com/crypto-wallet/backend/internal/infrastructure/security"
+	"github.com/crypto-wallet/backend/internal/infrastructure/stepup"
+	"github.com/crypto-wallet/backend/internal/infrastructure/tracing"
+	"github.com/crypto-wallet/backend/internal/infrastructure/webhook"
+	"github.com/crypto-wallet/backend/internal/infrastructure/workers"
+	"github.com/crypto-wallet/backend/internal/interfaces/graphqlapi"
+	"github.com/crypto-wallet/backend/internal/interfaces/grpcapi"
 	httproutes "github.com/crypto-wallet/backend/internal/interfaces/http"
 	"github.com/crypto-wallet/backend/internal/interfaces/http/handlers"
 	httpmiddleware "github.com/crypto-wallet/backend/internal/interfaces/http/middleware"
+	"github.com/crypto-wallet/backend/internal/interfaces/http/openapi"
 	"github.com/crypto-wallet/backend/pkg/utils"
 )
 
@@ -42,6 +86,7 @@ type appConfig struct {
 	Environment         string
 	LogLevel            string
 	LogFormat           string
+	LogRedaction        string
 	JWTSecret           string
 	JWTIssuer           string
 	JWTAudience         []string
@@ -52,24 +97,259 @@ type appConfig struct {
 	RateLimitEnabled    bool
 	RateLimitRequests   int
 	RateLimitWindow     time.Duration
+	// RateLimitStore is "redis" or "memory"; it defaults to redis when REDIS_ADDR is set.
+	RateLimitStore      string
+	// RateLimitUserRequests limits each signed-in user per RateLimitWindow; 0 disables it.
+	RateLimitUserRequests int
+	// RateLimitRoutes maps path prefixes to stricter "requests/window" limits.
+	RateLimitRoutes     map[string]string
+	// RequestTimeout bounds each HTTP request's context; 0 disables it. It stays below the
+	// server's write timeout so slow requests are answered rather than dropped.
+	RequestTimeout       time.Duration
+	// RequestTimeoutRoutes maps path prefixes to their own timeouts, e.g. /api/v1/support/admin=2m.
+	RequestTimeoutRoutes map[string]string
 	DatabaseDSNs        map[string]string
+	// AutoMigrate applies pending migrations to every configured database before the server starts.
+	AutoMigrate         bool
 	WalletEncryptionKey string
+	// WalletPreviousKey is the wallet master key being rotated away from; it only unwraps.
+	WalletPreviousKey   string
 	KYCEncryptionKey    string
+	// MasterKeys selects where the wallet and KYC master keys live; WalletKeyID and KYCKeyID name
+	// the remote keys. With a remote provider the env keys above only unwrap existing data.
+	MasterKeys          security.KeyProviderConfig
+	WalletKeyID         string
+	KYCKeyID            string
+	// MasterKeyFallback uses the env key when the remote provider fails its startup check.
+	MasterKeyFallback   bool
+	MasterKeyHealthInterval time.Duration
+	WebhookSecretKey    string
+	// AdminConfirmSecret signs the confirmation tokens of destructive admin operations.
+	AdminConfirmSecret  string
+	AdminConfirmTTL     time.Duration
+	AppTokenTTL         time.Duration
+	AccessTokenTTL      time.Duration
+	RefreshTokenTTL     time.Duration
 	TwoFactorIssuer     string
-	Blockchain          struct {
-		Bitcoin  blockchain.BitcoinConfig
-		Ethereum blockchain.EthereumConfig
-		Solana   blockchain.SolanaConfig
-		Stellar  blockchain.StellarConfig
-	}
+	// StepUpElevationTTL is how long a step-up code exempts its session from further codes.
+	StepUpElevationTTL  time.Duration
+	RedisAddr           string
+	// CacheEnabled serves exchange rates and portfolio balances from Redis when REDIS_ADDR is set.
+	CacheEnabled        bool
+	CacheRateTTL        time.Duration
+	CacheBalanceTTL     time.Duration
+	// Tracing exports OpenTelemetry spans over OTLP/HTTP when enabled.
+	Tracing             tracing.Config
+	P2PClaimWindow      time.Duration
+	P2PDisputeWindow    time.Duration
+	// AddressBookCooldown delays new saved addresses and switching whitelist-only sends off.
+	AddressBookCooldown time.Duration
+	// AddressBookSecret signs address book exports; export and import are off without it.
+	AddressBookSecret   string
+	SupportStaffIDs     []uuid.UUID
+	HandleLookupLimit   int
+	HandleLookupWindow  time.Duration
+	ExportRetention     time.Duration
+	ExportPollInterval  time.Duration
+	// ExportHistoryRetention is how long finished export jobs are kept before the scheduler deletes them.
+	ExportHistoryRetention time.Duration
+	// SchedulerEnabled runs the maintenance jobs; one instance at a time runs them.
+	SchedulerEnabled bool
+	// PriceHistoryRetention is how long candles of each interval are kept before the scheduler rolls
+	// them up into daily or weekly candles and deletes them; intervals without an entry are kept.
+	PriceHistoryRetention map[entities.IntervalType]time.Duration
+	// AccountDeletionRetention is how long deleted accounts are kept before they are purged.
+	AccountDeletionRetention time.Duration
+	AccountPurgeInterval     time.Duration
+	// ReportSyncTimeout bounds inline custom report runs before they move to the export worker.
+	ReportSyncTimeout   time.Duration
+	// ExchangeJobAttempts and ExchangeJobRetryDelay govern queued exchange executions, which need Redis.
+	ExchangeJobAttempts   int
+	ExchangeJobRetryDelay time.Duration
+	// ExchangeQuoteSecret signs exchange quote tokens; without it quotes carry no token.
+	ExchangeQuoteSecret string
+	// PortfolioShareURL is the frontend page that opens portfolio share links.
+	PortfolioShareURL   string
+	// ExecutionBudgets overrides use case time budgets by name, e.g. export.transactions=20m.
+	ExecutionBudgets    map[string]string
+	BroadcastInterval   time.Duration
+	BroadcastThrottle   int
+	RateSyncEnabled     bool
+	RateSyncInterval    time.Duration
+	CoinGeckoAPIKey     string
+	FiatRateSyncEnabled bool
+	FiatRateInterval    time.Duration
+	FXProviderURL       string
+	GasOracleInterval   time.Duration
+	GasHistoryRetention time.Duration
+	PublicAPIEnabled    bool
+	PublicAPIRateLimit  int
+	PublicAPIRateWindow time.Duration
+	PublicAPICacheTTL   time.Duration
+	AuditRetention      time.Duration
+	AuditArchiveDir     string
+	AuditArchivePeriod  time.Duration
+	AlertSlackWebhook   string
+	AlertPagerDutyKey   string
+	AlertInterval       time.Duration
+	AlertRepeat         time.Duration
+	AlertErrorRatePct   int
+	AlertMinRequests    int
+	AlertPoolUsagePct   int
+	AlertQueueBacklog   int
+	KYCExpiryInterval   time.Duration
+	KYCUpgradeInterval  time.Duration
+	// KYCLimitMode is enforce, flag or off for the daily and monthly KYC limits.
+	KYCLimitMode        string
+	AMLRescreenInterval time.Duration
+	TxMonitorInterval   time.Duration
+	TxMonitorBatchSize  int
+	// DepositScan* drive the worker that credits inbound transfers to platform wallets.
+	DepositScanEnabled  bool
+	DepositScanInterval time.Duration
+	DepositScanBlocks   int
+	// Dormancy* set when inactive wallets turn dormant and when owners are reminded beforehand.
+	DormancyEnabled     bool
+	DormancyAfter       time.Duration
+	DormancyReminders   []time.Duration
+	DormancyInterval    time.Duration
+	// Blockchain holds the adapter settings of chains the chain registry does not manage.
+	Blockchain          blockchain.AdapterConfigs
+	ChainRegistryReload time.Duration
+	// RPC* control failover between each chain's primary and fallback node endpoints.
+	RPCFailureThreshold int
+	RPCCircuitCooldown  time.Duration
+	RPCHealthInterval   time.Duration
 	KYCProvider struct {
 		BaseURL   string
 		APIKey    string
 		APISecret string
 	}
+	AMLProvider struct {
+		BaseURL string
+		APIKey  string
+		// FreezeAtLevel freezes a user's wallets when re-screening raises them to this risk level
+		// or above; empty disables it. FreezeOnSanctions freezes them on any new sanctions hit.
+		FreezeAtLevel     string
+		FreezeOnSanctions bool
+	}
+	// EventExport configures the warehouse export of domain events; Sink is s3, kafka, file or empty to disable.
+	EventExport struct {
+		Sink             string
+		Dir              string
+		Prefix           string
+		Interval         time.Duration
+		BatchSize        int
+		S3Bucket         string
+		S3Region         string
+		S3Endpoint       string
+		S3AccessKeyID    string
+		S3SecretKey      string
+		S3SessionToken   string
+		KafkaTopicPrefix string
+	}
+	// ExportStorage keeps generated exports in object storage rather than the database when
+	// Backend is "s3"; downloads are then served through presigned links.
+	ExportStorage struct {
+		Backend        string
+		Prefix         string
+		DownloadURLTTL time.Duration
+		S3Bucket       string
+		S3Region       string
+		S3Endpoint     string
+		S3AccessKeyID  string
+		S3SecretKey    string
+		S3SessionToken string
+	}
+	// KYCDocuments keeps uploaded KYC documents, encrypted, in object storage when Backend is s3,
+	// gcs or local; reviewers then download them through signed links.
+	KYCDocuments struct {
+		Backend        string
+		Dir            string
+		S3Bucket       string
+		S3Region       string
+		S3Endpoint     string
+		S3AccessKeyID  string
+		S3SecretKey    string
+		S3SessionToken string
+		GCSBucket      string
+		GCSAccessToken string
+		GCSEndpoint    string
+		LinkSecret     string
+		LinkTTL        time.Duration
+		DownloadURL    string
+	}
+	// KYCScanner malware-scans uploaded KYC documents when Backend is clamav or http.
+	KYCScanner struct {
+		Backend       string
+		ClamAVAddress string
+		URL           string
+		APIKey        string
+		Timeout       time.Duration
+	}
+	// AuthCookies enables cookie-auth mode: tokens are set as httpOnly cookies and state-changing
+	// requests must carry a double-submit CSRF token.
+	AuthCookies httpmiddleware.CookieAuthConfig
+	// MessageBroker selects where channel messages are published: redis (default) or kafka.
+	MessageBroker string
+	// Kafka.Transport is native (brokers, default) or rest (REST proxy at RESTURL).
+	Kafka struct {
+		Transport        string
+		Brokers          []string
+		ClientID         string
+		SASLMechanism    string
+		TLS              bool
+		RESTURL          string
+		Username         string
+		Password         string
+		TopicPrefix      string
+		Topics           map[string]string
+		FallbackCooldown time.Duration
+	}
+	// InternalAPI serves the server-to-server API over mutual TLS on its own listener; it is
+	// disabled while Addr is empty. ClientCAFile holds the CA that signs internal consumers' certificates.
+	// GRPCAddr serves the internal gRPC API with the same certificates; it is disabled while empty.
+	InternalAPI struct {
+		Addr         string
+		GRPCAddr     string
+		CertFile     string
+		KeyFile      string
+		ClientCAFile string
+	}
+	// BalanceReconciliation configures the nightly comparison of stored and on-chain balances.
+	// Thresholds maps a chain to the drift, in its native unit, that is corrected without review.
+	BalanceReconciliation struct {
+		Enabled    bool
+		HourUTC    int
+		Mode       string
+		SampleSize int
+		Thresholds map[string]string
+	}
+	// Email configures transactional email; Driver is smtp, ses, sendgrid, log or empty to disable.
+	// VerificationURL is the frontend page that redeems email verification tokens. ReportInterval is
+	// how often due scheduled portfolio reports are sent.
+	Email struct {
+		Driver          string
+		FromAddress     string
+		FromName        string
+		VerificationURL string
+		ReportInterval  time.Duration
+		SMTP            external.SMTPConfig
+		SES             external.SESConfig
+		SendGridAPIKey  string
+	}
+}
+
+// backgroundWorker is a periodic job started alongside the HTTP server.
+type backgroundWorker interface {
+	Start(ctx context.Context)
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(runMigrate(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	cfg, err := loadConfig()
 	if err != nil {
 		slog.Error("failed to load configuration", slog.String("error", err.Error()))
@@ -80,6 +360,8 @@ func main() {
 		Level:     cfg.LogLevel,
 		Format:    cfg.LogFormat,
 		AddSource: cfg.Environment != "production",
+		Environment: cfg.Environment,
+		Redaction: cfg.LogRedaction,
 	})
 	if err != nil {
 		slog.Error("failed to initialise logger", slog.String("error", err.Error()))
@@ -88,6 +370,13 @@ func main() {
 
 	appLogger := logging.WithComponent(logger, "http")
 
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing, logging.WithComponent(logger, "tracing"))
+	if err != nil {
+		logger.Warn("tracing disabled", slog.String("error", err.Error()))
+		cfg.Tracing.Enabled = false
+		shutdownTracing = func(context.Context) error { return nil }
+	}
+
 	jwtService, err := security.NewJWTService(security.JWTConfig{
 		Secret:   cfg.JWTSecret,
 		Issuer:   cfg.JWTIssuer,
@@ -99,6 +388,13 @@ func main() {
 		os.Exit(1)
 	}
 
+	if cfg.AutoMigrate {
+		if err := migrateDatabases(context.Background(), cfg.DatabaseDSNs, logging.WithComponent(logger, "migrations")); err != nil {
+			logger.Error("failed to apply database migrations", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+	}
+
 	poolManager := database.NewPoolManager(logging.WithComponent(logger, "database"))
 	registerDatabasePools(poolManager, cfg)
 
@@ -106,11 +402,68 @@ func main() {
 		corePool        *pgxpool.Pool
 		kycPool         *pgxpool.Pool
 		ratesPool       *pgxpool.Pool
+		auditPool       *pgxpool.Pool
 		walletHandler   *handlers.WalletHandler
+		walletService   *services.WalletService
 		authHandler     *handlers.AuthHandler
 		analyticsHandler *handlers.AnalyticsHandler
+		rateHandler     *handlers.RateHandler
+		publicMarketHandler *handlers.PublicMarketHandler
+		sharedPortfolioHandler *handlers.SharedPortfolioHandler
+		p2pHandler      *handlers.P2PHandler
+		disputeHandler  *handlers.P2PDisputeSupportHandler
+		p2pWorker       *workers.P2PClaimExpirationWorker
+		profileHandler  *handlers.ProfileHandler
+		preferencesHandler *handlers.PreferencesHandler
+		accountHandler     *handlers.AccountHandler
+		accountPurgeWorker *workers.AccountPurgeWorker
+		exportHandler   *handlers.ExportHandler
+		webhookHandler  *handlers.WebhookHandler
+		addressBook     *handlers.AddressBookHandler
+		eventExport     *handlers.EventExportHandler
+		adminOps        *handlers.AdminOperationsHandler
+		chainRollouts   *handlers.ChainRolloutHandler
+		chainConfigs    *handlers.ChainConfigHandler
+		chainWorker     *workers.ChainRegistryWorker
+		broadcastAdmin  *handlers.BroadcastAdminHandler
+		broadcastHandler *handlers.BroadcastHandler
+		broadcastWorker *workers.BroadcastDispatcherWorker
+		reconciliation  *handlers.BalanceReconciliationHandler
+		reconcileWorker *workers.BalanceReconciliationWorker
+		connectedApps   *handlers.ConnectedAppsHandler
+		scopeEnforcer   *httpmiddleware.ScopeEnforcer
+		partnerKYC      *handlers.PartnerKYCHandler
+		partnerAuth     fiber.Handler
+		eventWorker     *workers.EventExportWorker
+		exportWorker    *workers.ExportProcessorWorker
+		auditHandler    *handlers.AuditHandler
+		auditWorker     *workers.AuditRetentionWorker
+		alertWorker     *workers.AnomalyMonitorWorker
+		txMonitor       *workers.TransactionMonitor
+		depositMonitor  *workers.DepositMonitor
+		dormancyWorker  *workers.WalletDormancyWorker
+		reportWorker    *workers.ScheduledReportWorker
+		rateSyncWorker  *workers.RateSyncWorker
+		fiatRateWorker  *workers.FiatRateSyncWorker
+		gasHandler      *handlers.GasHandler
+		gasWorker       *workers.GasOracleWorker
+		txNoteHandler   *handlers.TransactionNoteHandler
+		txHandler       *handlers.TransactionHandler
+		activityHandler *handlers.ActivityHandler
+		graphqlHandler  *handlers.GraphQLHandler
+		exchangeHandler *handlers.ExchangeExecutionHandler
+		exchangeWorker  *workers.ExchangeExecutionWorker
+		limitService    *services.LimitEnforcementService
+		notifications   *handlers.NotificationHandler
+		metrics         *monitoring.Metrics
 		kycHandler      *handlers.KYCHandler
+		kycCompliance   *handlers.KYCComplianceHandler
+		kycReview       *handlers.KYCReviewHandler
+		amlReview       *handlers.AMLReviewHandler
+		kycDocuments    *handlers.KYCDocumentDownloadHandler
 		kycEnforcer     *httpmiddleware.KYCEnforcer
+		kycWorkers      []backgroundWorker
+		jobScheduler    *scheduler.Scheduler
 	)
 
 	if pool, err := poolManager.Get("core"); err != nil {
@@ -131,16 +484,96 @@ func main() {
 		ratesPool = pool
 	}
 
+	if pool, err := poolManager.Get("audit"); err != nil {
+		logger.Warn("audit database pool unavailable", slog.String("error", err.Error()))
+	} else {
+		auditPool = pool
+	}
+
+	auditLogger := buildAuditLogger(auditPool, logger)
+	listRegistryAssets(corePool, logger)
+
+	// Operational metrics are only collected when an alert channel is configured.
+	if cfg.AlertSlackWebhook != "" || cfg.AlertPagerDutyKey != "" {
+		metrics = monitoring.NewMetrics()
+	}
+
+	chains := buildChainRegistry(cfg, metrics, logger)
+	rpcHealthWorker := workers.NewRPCHealthWorker(chains, logging.WithComponent(logger, "rpc-health-worker"), cfg.RPCHealthInterval)
+
+	budgetMetrics := monitoring.NewBudgetMetrics()
+	budgets := buildExecutionBudgets(cfg, budgetMetrics, logger)
+
+	publisher := buildPublisher(cfg, logger)
+	pubsubNotifier := buildNotifier(publisher, logger)
+	var webhookEncryptor *security.AESGCMEncryptor
+	if corePool != nil {
+		webhookEncryptor = buildWebhookEncryptor(cfg, logger)
+	}
+	dispatcher := buildEventDispatcher(corePool, pubsubNotifier, webhookEncryptor, auditLogger, logger)
+
+	var walletKey, kycKey *masterKey
 	if corePool != nil {
-		walletHandler = buildWalletHandler(cfg, corePool, logger)
-		authHandler = buildAuthHandler(cfg, corePool, jwtService, logger)
+		walletKey = buildWalletMasterKey(cfg, logger)
+	}
+	if kycPool != nil {
+		kycKey = buildKYCMasterKey(cfg, logger)
+	}
+	keyHealthWorker := buildKeyProviderHealthWorker(cfg, logger, walletKey, kycKey)
+
+	if corePool != nil {
+		chainConfigs, chainWorker = buildChainConfigComponents(cfg, corePool, chains, auditLogger, logger)
+		walletHandler, chainRollouts, walletService = buildWalletHandler(cfg, corePool, chains, walletKey, dispatcher, auditLogger, logger)
+		authHandler = buildAuthHandler(cfg, corePool, jwtService, auditLogger, logger)
+		p2pHandler, disputeHandler, p2pWorker = buildP2PComponents(cfg, corePool, dispatcher, auditLogger, logger)
+		profileHandler = buildProfileHandler(cfg, corePool, logger)
+		preferencesHandler = buildPreferencesHandler(corePool, logger)
+		exportHandler, exportWorker = buildExportComponents(cfg, corePool, kycPool, budgets, logger)
+		accountHandler, accountPurgeWorker = buildAccountComponents(cfg, corePool, kycPool, logger)
+		webhookHandler = buildWebhookHandler(corePool, webhookEncryptor, logger)
+		addressBook = buildAddressBookHandler(cfg, corePool, chains, auditLogger, logger)
+		eventExport, eventWorker = buildEventExportComponents(cfg, corePool, logger)
+		adminOps = buildAdminOperationsHandler(cfg, corePool, budgets, budgetMetrics, auditLogger, logger)
+		broadcastAdmin, broadcastHandler, broadcastWorker = buildBroadcastComponents(cfg, corePool, kycPool, pubsubNotifier, auditLogger, logger)
+		connectedApps, scopeEnforcer = buildConnectedAppsComponents(cfg, corePool, jwtService, auditLogger, logger)
+		txMonitor = buildTransactionMonitor(cfg, corePool, publisher, dispatcher, chains, logger)
+		depositMonitor = buildDepositMonitor(cfg, corePool, publisher, dispatcher, chains, logger)
+		dormancyWorker = buildWalletDormancyWorker(cfg, corePool, dispatcher, auditLogger, logger)
+		reconciliation, reconcileWorker = buildBalanceReconciliationComponents(cfg, corePool, chains, auditLogger, logger)
 	}
 
 	if kycPool != nil {
-		kycHandler, kycEnforcer = buildKYCComponents(cfg, kycPool, logger)
+		limitService = buildLimitEnforcementService(cfg, corePool, kycPool, ratesPool, logger)
+		kycHandler, kycCompliance, kycReview, amlReview, kycDocuments, kycEnforcer, kycWorkers = buildKYCComponents(cfg, kycPool, corePool, kycKey, limitService, dispatcher, auditLogger, logger)
+	}
+
+	if auditPool != nil {
+		auditHandler, auditWorker = buildAuditComponents(cfg, auditPool, logger)
+	}
+
+	if corePool != nil && kycPool != nil {
+		partnerKYC, partnerAuth = buildPartnerKYCComponents(corePool, kycPool, logger)
+	}
+
+	var currencyConverter *services.CurrencyConverter
+	if ratesPool != nil {
+		currencyConverter = services.NewCurrencyConverter(postgres.NewFiatRateRepository(ratesPool, logging.WithComponent(logger, "fiat-rate-repository")), services.DefaultFiatRateCacheTTL)
 	}
 
-	analyticsHandler = buildAnalyticsHandler(cfg, corePool, ratesPool, logger)
+	analyticsHandler, sharedPortfolioHandler, reportWorker = buildAnalyticsHandler(cfg, corePool, ratesPool, currencyConverter, budgets, logger)
+	rateHandler = buildRateHandler(cfg, ratesPool, logger)
+	rateSyncWorker = buildRateSyncWorker(cfg, ratesPool, logger)
+	fiatRateWorker = buildFiatRateSyncWorker(cfg, ratesPool, currencyConverter, logger)
+	gasHandler, gasWorker = buildGasOracleComponents(cfg, corePool, chains, logger)
+	txNoteHandler = buildTransactionNoteHandler(corePool, logger)
+	txHandler = buildTransactionSearchHandler(corePool, logger)
+	activityHandler = buildActivityHandler(corePool, logger)
+	graphqlHandler = buildGraphQLHandler(cfg, corePool, ratesPool, currencyConverter, logger)
+	jobScheduler = buildScheduler(cfg, corePool, ratesPool, logger)
+	exchangeHandler, exchangeWorker = buildExchangeComponents(cfg, corePool, walletService, dispatcher, auditLogger, limitService, logger)
+	notifications = buildNotificationHandler(corePool, logger)
+	publicMarketHandler = buildPublicMarketHandler(cfg, corePool, ratesPool, logger)
+	alertWorker = buildAnomalyMonitor(cfg, metrics, poolManager, corePool, logger)
 
 	app := fiber.New(fiber.Config{
 		AppName:      "crypto-wallet-backend",
@@ -152,7 +585,19 @@ func main() {
 		},
 	})
 
+	if cfg.Tracing.Enabled {
+		app.Use(httpmiddleware.NewTracingMiddleware())
+	}
 	app.Use(httpmiddleware.NewRequestContextMiddleware(logging.WithComponent(logger, "request")))
+	app.Use(httpmiddleware.NewRequestTimeoutMiddleware(httpmiddleware.RequestTimeoutConfig{
+		Timeout: cfg.RequestTimeout,
+		// KYC documents are streamed from storage after the handler returns.
+		ExcludePaths: []string{httproutes.DefaultAPIPrefix + "/kyc-documents"},
+		Routes:       parseRouteTimeouts(cfg.RequestTimeoutRoutes, logger),
+	}))
+	if metrics != nil {
+		app.Use(httpmiddleware.NewMetricsMiddleware(metrics))
+	}
 	app.Use(httpmiddleware.NewRequestValidationMiddleware(httpmiddleware.RequestValidationConfig{
 		MaxBodyBytes: 1 << 20,
 		EnforceJSON:  true,
@@ -166,32 +611,205 @@ func main() {
 		AllowCredentials: true,
 	}))
 
+	rateLimitStore := buildRateLimitStore(cfg, logger)
+	routeRateLimits := parseRouteRateLimits(cfg.RateLimitRoutes, logger)
 	app.Use(httpmiddleware.NewRateLimitMiddleware(httpmiddleware.RateLimitConfig{
 		Enabled:     cfg.RateLimitEnabled,
 		MaxRequests: cfg.RateLimitRequests,
 		Burst:       int(float64(cfg.RateLimitRequests) * 1.5),
 		Window:      cfg.RateLimitWindow,
-		ExcludePaths: []string{"/api/v1/health", "/"},
+		// Public market data has its own per-IP bucket.
+		ExcludePaths: []string{"/api/v1/health", "/api/v1/public", "/"},
+		Store:        rateLimitStore,
+		Scope:        "ip",
+		Routes:       routeRateLimits,
+		Logger:       logging.WithComponent(logger, "rate-limit"),
 	}))
+	userRateLimit := httpmiddleware.NewRateLimitMiddleware(httpmiddleware.RateLimitConfig{
+		Enabled:      cfg.RateLimitEnabled && cfg.RateLimitUserRequests > 0,
+		MaxRequests:  cfg.RateLimitUserRequests,
+		Window:       cfg.RateLimitWindow,
+		KeyGenerator: httpmiddleware.RateLimitKeyByUser,
+		Store:        rateLimitStore,
+		Scope:        "user",
+		Routes:       routeRateLimits,
+		Logger:       logging.WithComponent(logger, "rate-limit"),
+	})
 
-	authMiddleware := httpmiddleware.NewAuthMiddleware(httpmiddleware.AuthConfig{
+	authConfig := httpmiddleware.AuthConfig{
 		JWTService: jwtService,
 		Logger:     logging.WithComponent(logger, "auth"),
+	}
+	var csrfMiddleware fiber.Handler
+	if cfg.AuthCookies.Enabled {
+		authConfig.CookieName = cfg.AuthCookies.AccessCookieName
+		csrfMiddleware = httpmiddleware.NewCSRFMiddleware(httpmiddleware.CSRFConfig{
+			Cookies: cfg.AuthCookies,
+			Logger:  logging.WithComponent(logger, "csrf"),
+		})
+	}
+	authMiddleware := httpmiddleware.NewAuthMiddleware(authConfig)
+	stepUp := buildStepUpAuth(cfg, corePool, auditLogger, logger)
+
+	supportAccess := httpmiddleware.NewSupportAccessMiddleware(httpmiddleware.SupportAccessConfig{
+		StaffUserIDs: cfg.SupportStaffIDs,
+		Logger:       logging.WithComponent(logger, "support-access"),
+	})
+
+	accessControl := httpmiddleware.NewAccessControl(httpmiddleware.AccessControlConfig{
+		Logger: logging.WithComponent(logger, "access-control"),
+	})
+
+	// Swagger UI loads its assets from a CDN and is only served outside production.
+	openAPIHandler := handlers.NewOpenAPIHandler(handlers.OpenAPIHandlerConfig{
+		Document:  openapi.NewDocument(httproutes.DefaultAPIPrefix),
+		SwaggerUI: cfg.Environment != "production",
+		Logger:    logging.WithComponent(logger, "openapi"),
 	})
 
 	httproutes.RegisterRoutes(app, httproutes.RouteOptions{
 		Logger:           logging.WithComponent(logger, "routes"),
 		AuthMiddleware:   authMiddleware,
+		UserRateLimit:    userRateLimit,
+		StepUp:           stepUp,
 		AuthHandler:      authHandler,
 		WalletHandler:    walletHandler,
+		P2PHandler:       p2pHandler,
+		ProfileHandler:   profileHandler,
+		PreferencesHandler: preferencesHandler,
+		AccountHandler:   accountHandler,
+		ExportHandler:    exportHandler,
+		WebhookHandler:   webhookHandler,
+		AddressBookHandler: addressBook,
+		BroadcastHandler: broadcastHandler,
 		AnalyticsHandler: analyticsHandler,
+		RateHandler:      rateHandler,
 		KYCHandler:       kycHandler,
 		KYCEnforcer:      kycEnforcer,
+
+		ScopeEnforcer:        scopeEnforcer,
+		ConnectedAppsHandler: connectedApps,
+
+		PartnerAuth:       partnerAuth,
+		PartnerKYCHandler: partnerKYC,
+
+		SupportAccess:         supportAccess,
+		DisputeSupportHandler: disputeHandler,
+		AuditHandler:          auditHandler,
+		KYCComplianceHandler:  kycCompliance,
+		EventExportHandler:    eventExport,
+		AdminOpsHandler:       adminOps,
+		ChainRolloutHandler:   chainRollouts,
+		ChainConfigHandler:    chainConfigs,
+		BroadcastAdminHandler: broadcastAdmin,
+		ReconciliationHandler: reconciliation,
+		PublicMarketHandler:   publicMarketHandler,
+		SharedPortfolioHandler: sharedPortfolioHandler,
+		KYCDocumentDownloadHandler: kycDocuments,
+		CSRFMiddleware:        csrfMiddleware,
+		AccessControl:         accessControl,
+		KYCReviewHandler:      kycReview,
+		AMLReviewHandler:      amlReview,
+		GasHandler:            gasHandler,
+
+		TransactionNoteHandler: txNoteHandler,
+		TransactionHandler:     txHandler,
+		ActivityHandler:        activityHandler,
+		GraphQLHandler:         graphqlHandler,
+		NotificationHandler:    notifications,
+		OpenAPIHandler:         openAPIHandler,
+
+		ExchangeExecutionHandler: exchangeHandler,
 	})
 
+	internalApp := buildInternalApp(cfg, corePool, logger)
+	internalGRPC := buildInternalGRPCServer(cfg, corePool, ratesPool, walletService, logger)
+
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
+	if p2pWorker != nil {
+		go p2pWorker.Start(ctx)
+	}
+
+	if exportWorker != nil {
+		go exportWorker.Start(ctx)
+	}
+
+	if broadcastWorker != nil {
+		go broadcastWorker.Start(ctx)
+	}
+
+	if auditWorker != nil {
+		go auditWorker.Start(ctx)
+	}
+
+	if eventWorker != nil {
+		go eventWorker.Start(ctx)
+	}
+
+	for _, worker := range kycWorkers {
+		go worker.Start(ctx)
+	}
+
+	if exchangeWorker != nil {
+		go exchangeWorker.Start(ctx)
+	}
+
+	if jobScheduler != nil {
+		go jobScheduler.Start(ctx)
+	}
+
+	if alertWorker != nil {
+		go alertWorker.Start(ctx)
+	}
+
+	if txMonitor != nil {
+		go txMonitor.Start(ctx)
+	}
+
+	if depositMonitor != nil {
+		go depositMonitor.Start(ctx)
+	}
+
+	if dormancyWorker != nil {
+		go dormancyWorker.Start(ctx)
+	}
+
+	if reportWorker != nil {
+		go reportWorker.Start(ctx)
+	}
+
+	if accountPurgeWorker != nil {
+		go accountPurgeWorker.Start(ctx)
+	}
+
+	if reconcileWorker != nil {
+		go reconcileWorker.Start(ctx)
+	}
+
+	if rateSyncWorker != nil {
+		go rateSyncWorker.Start(ctx)
+	}
+
+	if fiatRateWorker != nil {
+		go fiatRateWorker.Start(ctx)
+	}
+
+	if gasWorker != nil {
+		go gasWorker.Start(ctx)
+	}
+
+	if chainWorker != nil {
+		go chainWorker.Start(ctx)
+	}
+
+	go rpcHealthWorker.Start(ctx)
+
+	if keyHealthWorker != nil {
+		go keyHealthWorker.Start(ctx)
+	}
+
 	go func() {
 		<-ctx.Done()
 		logger.Info("shutdown signal received, stopping server")
@@ -201,12 +819,46 @@ func main() {
 		if err := app.ShutdownWithContext(shutdownCtx); err != nil {
 			logger.Error("error during server shutdown", slog.String("error", err.Error()))
 		}
+		if internalApp != nil {
+			if err := internalApp.ShutdownWithContext(shutdownCtx); err != nil {
+				logger.Error("error during internal server shutdown", slog.String("error", err.Error()))
+			}
+		}
+		if internalGRPC != nil {
+			internalGRPC.GracefulStop()
+		}
 		poolManager.CloseAll()
+		if err := shutdownTracing(shutdownCtx); err != nil {
+			logger.Warn("failed to flush traces", slog.String("error", err.Error()))
+		}
 	}()
 
+	if internalApp != nil {
+		go func() {
+			logger.Info("starting internal server", slog.String("address", cfg.InternalAPI.Addr))
+			if err := internalApp.ListenMutualTLS(cfg.InternalAPI.Addr, cfg.InternalAPI.CertFile, cfg.InternalAPI.KeyFile, cfg.InternalAPI.ClientCAFile); err != nil {
+				logger.Error("internal server error", slog.String("error", err.Error()))
+			}
+		}()
+	}
+
+	if internalGRPC != nil {
+		go func() {
+			logger.Info("starting internal gRPC server", slog.String("address", cfg.InternalAPI.GRPCAddr))
+			listener, err := net.Listen("tcp", cfg.InternalAPI.GRPCAddr)
+			if err != nil {
+				logger.Error("internal gRPC listener error", slog.String("error", err.Error()))
+				return
+			}
+			if err := internalGRPC.Serve(listener); err != nil {
+				logger.Error("internal gRPC server error", slog.String("error", err.Error()))
+			}
+		}()
+	}
+
 	address := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
 	logger.Info("starting server", slog.String("address", address), slog.String("environment", cfg.Environment))
-	if err := app.Listen(address); err != nil && !errors.Is(err, fiber.ErrServerClosed) {
+	if err := app.Listen(address); err != nil {
 		logger.Error("server error", slog.String("error", err.Error()))
 		os.Exit(1)
 	}
@@ -220,32 +872,229 @@ func loadConfig() (appConfig, error) {
 		Environment:       strings.ToLower(getEnv("ENVIRONMENT", "development")),
 		LogLevel:          getEnv("LOG_LEVEL", "info"),
 		LogFormat:         getEnv("LOG_FORMAT", "json"),
+		LogRedaction:      getEnv("LOG_REDACTION", ""),
 		JWTSecret:         getEnv("JWT_SECRET", ""),
 		JWTIssuer:         getEnv("JWT_ISSUER", "crypto-wallet"),
 		CORSAllowOrigins:  getEnv("CORS_ALLOW_ORIGINS", "*"),
-		CORSAllowHeaders:  getEnv("CORS_ALLOW_HEADERS", "Authorization,Content-Type,Accept,X-Request-ID"),
+		CORSAllowHeaders:  getEnv("CORS_ALLOW_HEADERS", "Authorization,Content-Type,Accept,X-Request-ID,X-Share-Token"),
 		CORSAllowMethods:  getEnv("CORS_ALLOW_METHODS", "GET,POST,PUT,PATCH,DELETE,OPTIONS"),
 		RateLimitEnabled:  getEnvAsBool("RATE_LIMIT_ENABLED", true),
 		RateLimitRequests: getEnvAsInt("RATE_LIMIT_REQUESTS", 100),
 		RateLimitWindow:   getEnvAsDuration("RATE_LIMIT_WINDOW", time.Minute),
 		JWTLeeway:         getEnvAsDuration("JWT_LEEWAY", 30*time.Second),
-		DatabaseDSNs: map[string]string{
-			"core":  getEnv("CORE_DB_DSN", ""),
-			"kyc":   getEnv("KYC_DB_DSN", ""),
-			"rates": getEnv("RATES_DB_DSN", ""),
-			"audit": getEnv("AUDIT_DB_DSN", ""),
-		},
+		DatabaseDSNs:      loadDatabaseDSNs(),
 	}
 
+	cfg.AutoMigrate = getEnvAsBool("AUTO_MIGRATE", false)
+	cfg.RateLimitStore = strings.ToLower(getEnv("RATE_LIMIT_STORE", ""))
+	cfg.RateLimitUserRequests = getEnvAsInt("RATE_LIMIT_USER_REQUESTS", 300)
+	cfg.RateLimitRoutes = parseKeyValueList(getEnv("RATE_LIMIT_ROUTES", "/api/v1/auth/login=10/1m,/api/v1/exchange=30/1m"))
+	cfg.RequestTimeout = getEnvAsDuration("REQUEST_TIMEOUT", 25*time.Second)
+	cfg.RequestTimeoutRoutes = parseKeyValueList(getEnv("REQUEST_TIMEOUT_ROUTES", ""))
 	cfg.WalletEncryptionKey = getEnv("WALLET_ENCRYPTION_KEY", "")
+	cfg.WalletPreviousKey = getEnv("WALLET_ENCRYPTION_KEY_PREVIOUS", "")
 	cfg.KYCEncryptionKey = getEnv("KYC_ENCRYPTION_KEY", "")
+	cfg.WalletKeyID = getEnv("WALLET_MASTER_KEY_ID", "")
+	cfg.KYCKeyID = getEnv("KYC_MASTER_KEY_ID", "")
+	cfg.MasterKeyFallback = getEnvAsBool("MASTER_KEY_FALLBACK", false)
+	cfg.MasterKeyHealthInterval = getEnvAsDuration("MASTER_KEY_HEALTH_INTERVAL", time.Minute)
+	cfg.MasterKeys = security.KeyProviderConfig{
+		Backend: strings.ToLower(getEnv("MASTER_KEY_PROVIDER", security.KeyProviderLocal)),
+		Timeout: getEnvAsDuration("MASTER_KEY_TIMEOUT", 5*time.Second),
+		AWS: security.AWSKMSConfig{
+			Region:   getEnv("AWS_KMS_REGION", getEnv("AWS_REGION", "")),
+			Endpoint: getEnv("AWS_KMS_ENDPOINT", ""),
+		},
+		GCP: security.GCPKMSConfig{
+			AccessToken: getEnv("GCP_KMS_ACCESS_TOKEN", ""),
+			Endpoint:    getEnv("GCP_KMS_ENDPOINT", ""),
+		},
+		Vault: security.VaultTransitConfig{
+			Address:   getEnv("VAULT_ADDR", ""),
+			Token:     getEnv("VAULT_TOKEN", ""),
+			Namespace: getEnv("VAULT_NAMESPACE", ""),
+			Mount:     getEnv("VAULT_TRANSIT_MOUNT", ""),
+		},
+	}
+	cfg.WebhookSecretKey = getEnv("WEBHOOK_SECRET_ENCRYPTION_KEY", "")
+	cfg.AdminConfirmSecret = getEnv("ADMIN_CONFIRMATION_SECRET", "")
+	cfg.AdminConfirmTTL = getEnvAsDuration("ADMIN_CONFIRMATION_TTL", adminusecase.DefaultConfirmationTTL)
+	cfg.AppTokenTTL = getEnvAsDuration("APP_ACCESS_TOKEN_TTL", appsusecase.DefaultAccessTokenTTL)
+	cfg.AccessTokenTTL = getEnvAsDuration("ACCESS_TOKEN_TTL", entities.DefaultAccessTokenTTL)
+	cfg.RefreshTokenTTL = getEnvAsDuration("REFRESH_TOKEN_TTL", entities.DefaultRefreshTokenTTL)
 	cfg.TwoFactorIssuer = getEnv("TWO_FACTOR_ISSUER", "Atlas Wallet")
+	cfg.StepUpElevationTTL = getEnvAsDuration("STEP_UP_ELEVATION_TTL", httpmiddleware.DefaultStepUpElevationTTL)
+	cfg.RedisAddr = getEnv("REDIS_ADDR", "")
+	cfg.CacheEnabled = getEnvAsBool("CACHE_ENABLED", true)
+	cfg.CacheRateTTL = getEnvAsDuration("CACHE_RATE_TTL", cache.DefaultRateTTL)
+	cfg.CacheBalanceTTL = getEnvAsDuration("CACHE_BALANCE_TTL", cache.DefaultBalanceTTL)
+	cfg.Tracing = tracing.Config{
+		Enabled:     getEnvAsBool("TRACING_ENABLED", false),
+		Endpoint:    getEnv("TRACING_OTLP_ENDPOINT", ""),
+		Insecure:    getEnvAsBool("TRACING_OTLP_INSECURE", false),
+		ServiceName: getEnv("TRACING_SERVICE_NAME", "crypto-wallet-backend"),
+		Environment: cfg.Environment,
+		SampleRatio: getEnvAsFloat("TRACING_SAMPLE_RATIO", 1),
+	}
+	cfg.P2PClaimWindow = getEnvAsDuration("P2P_CLAIM_WINDOW", entities.DefaultP2PClaimWindow)
+	cfg.P2PDisputeWindow = getEnvAsDuration("P2P_DISPUTE_WINDOW", entities.DefaultP2PDisputeWindow)
+	cfg.AddressBookCooldown = getEnvAsDuration("ADDRESS_BOOK_COOLDOWN", entities.DefaultAddressBookCooldown)
+	cfg.AddressBookSecret = getEnv("ADDRESS_BOOK_TRANSFER_SECRET", "")
+	cfg.SupportStaffIDs = parseUUIDList(getEnv("SUPPORT_STAFF_USER_IDS", ""))
+	cfg.HandleLookupLimit = getEnvAsInt("HANDLE_LOOKUP_RATE_LIMIT", 30)
+	cfg.ExchangeJobAttempts = getEnvAsInt("EXCHANGE_JOB_MAX_ATTEMPTS", 5)
+	cfg.ExchangeJobRetryDelay = getEnvAsDuration("EXCHANGE_JOB_RETRY_DELAY", 30*time.Second)
+	cfg.ExchangeQuoteSecret = getEnv("EXCHANGE_QUOTE_SECRET", "")
+	cfg.HandleLookupWindow = getEnvAsDuration("HANDLE_LOOKUP_RATE_WINDOW", time.Minute)
+	cfg.ExportRetention = getEnvAsDuration("EXPORT_RETENTION", entities.DefaultExportRetention)
+	cfg.ExportPollInterval = getEnvAsDuration("EXPORT_POLL_INTERVAL", 10*time.Second)
+	cfg.ExportHistoryRetention = getEnvAsDuration("EXPORT_HISTORY_RETENTION", 90*24*time.Hour)
+	cfg.SchedulerEnabled = getEnvAsBool("SCHEDULER_ENABLED", true)
+	cfg.PriceHistoryRetention = parsePriceHistoryRetention(getEnv("PRICE_HISTORY_RETENTION", ""))
+	cfg.AccountDeletionRetention = getEnvAsDuration("ACCOUNT_DELETION_RETENTION", entities.DefaultAccountDeletionRetention)
+	cfg.AccountPurgeInterval = getEnvAsDuration("ACCOUNT_PURGE_INTERVAL", time.Hour)
+	cfg.ReportSyncTimeout = getEnvAsDuration("REPORT_SYNC_TIMEOUT", analyticsusecase.DefaultReportSyncTimeout)
+	cfg.PortfolioShareURL = getEnv("PORTFOLIO_SHARE_URL", "")
+	cfg.ExecutionBudgets = parseKeyValueList(getEnv("EXECUTION_BUDGETS", ""))
+	cfg.ExportStorage.Backend = strings.ToLower(getEnv("EXPORT_STORAGE", ""))
+	cfg.ExportStorage.Prefix = getEnv("EXPORT_S3_PREFIX", "exports")
+	cfg.ExportStorage.DownloadURLTTL = getEnvAsDuration("EXPORT_DOWNLOAD_URL_TTL", exportusecase.DefaultDownloadURLTTL)
+	cfg.ExportStorage.S3Bucket = getEnv("EXPORT_S3_BUCKET", "")
+	cfg.ExportStorage.S3Region = getEnv("EXPORT_S3_REGION", getEnv("AWS_REGION", ""))
+	cfg.ExportStorage.S3Endpoint = getEnv("EXPORT_S3_ENDPOINT", "")
+	cfg.ExportStorage.S3AccessKeyID = getEnv("AWS_ACCESS_KEY_ID", "")
+	cfg.ExportStorage.S3SecretKey = getEnv("AWS_SECRET_ACCESS_KEY", "")
+	cfg.ExportStorage.S3SessionToken = getEnv("AWS_SESSION_TOKEN", "")
+	cfg.BroadcastInterval = getEnvAsDuration("BROADCAST_DISPATCH_INTERVAL", 10*time.Second)
+	cfg.BroadcastThrottle = getEnvAsInt("BROADCAST_THROTTLE_PER_MINUTE", entities.DefaultBroadcastThrottle)
+	cfg.RateSyncEnabled = getEnvAsBool("RATE_SYNC_ENABLED", true)
+	cfg.RateSyncInterval = getEnvAsDuration("RATE_SYNC_INTERVAL", 30*time.Second)
+	cfg.CoinGeckoAPIKey = getEnv("COINGECKO_API_KEY", "")
+	cfg.FiatRateSyncEnabled = getEnvAsBool("FIAT_RATE_SYNC_ENABLED", true)
+	cfg.FiatRateInterval = getEnvAsDuration("FIAT_RATE_SYNC_INTERVAL", time.Hour)
+	cfg.FXProviderURL = getEnv("FX_PROVIDER_BASE_URL", external.DefaultFXProviderBaseURL)
+	cfg.GasOracleInterval = getEnvAsDuration("GAS_ORACLE_INTERVAL", 15*time.Second)
+	cfg.ChainRegistryReload = getEnvAsDuration("CHAIN_REGISTRY_RELOAD_INTERVAL", 30*time.Second)
+	cfg.RPCFailureThreshold = getEnvAsInt("RPC_FAILURE_THRESHOLD", blockchain.DefaultRPCFailureThreshold)
+	cfg.RPCCircuitCooldown = getEnvAsDuration("RPC_CIRCUIT_COOLDOWN", blockchain.DefaultRPCCooldown)
+	cfg.RPCHealthInterval = getEnvAsDuration("RPC_HEALTH_INTERVAL", 15*time.Second)
+	cfg.GasHistoryRetention = getEnvAsDuration("GAS_HISTORY_RETENTION", 24*time.Hour)
+	cfg.PublicAPIEnabled = getEnvAsBool("PUBLIC_API_ENABLED", true)
+	cfg.PublicAPIRateLimit = getEnvAsInt("PUBLIC_API_RATE_LIMIT", 60)
+	cfg.PublicAPIRateWindow = getEnvAsDuration("PUBLIC_API_RATE_WINDOW", time.Minute)
+	cfg.PublicAPICacheTTL = getEnvAsDuration("PUBLIC_API_CACHE_TTL", 30*time.Second)
+	cfg.AuditRetention = getEnvAsDuration("AUDIT_RETENTION", entities.DefaultAuditRetention)
+	cfg.AuditArchiveDir = getEnv("AUDIT_ARCHIVE_DIR", "")
+	cfg.AuditArchivePeriod = getEnvAsDuration("AUDIT_ARCHIVE_INTERVAL", 6*time.Hour)
+	cfg.AlertSlackWebhook = getEnv("ALERT_SLACK_WEBHOOK_URL", "")
+	cfg.AlertPagerDutyKey = getEnv("ALERT_PAGERDUTY_ROUTING_KEY", "")
+	cfg.AlertInterval = getEnvAsDuration("ALERT_EVALUATION_INTERVAL", time.Minute)
+	cfg.AlertRepeat = getEnvAsDuration("ALERT_REPEAT_INTERVAL", 30*time.Minute)
+	cfg.AlertErrorRatePct = getEnvAsInt("ALERT_ERROR_RATE_PERCENT", 5)
+	cfg.AlertMinRequests = getEnvAsInt("ALERT_MIN_REQUESTS", 20)
+	cfg.AlertPoolUsagePct = getEnvAsInt("ALERT_DB_POOL_USAGE_PERCENT", 90)
+	cfg.AlertQueueBacklog = getEnvAsInt("ALERT_QUEUE_BACKLOG", 100)
+	cfg.KYCExpiryInterval = getEnvAsDuration("KYC_EXPIRY_CHECK_INTERVAL", time.Hour)
+	cfg.KYCUpgradeInterval = getEnvAsDuration("KYC_UPGRADE_POLL_INTERVAL", 15*time.Minute)
+	cfg.KYCLimitMode = strings.ToLower(getEnv("KYC_LIMIT_MODE", string(services.LimitModeEnforce)))
 	cfg.KYCProvider.BaseURL = getEnv("KYC_PROVIDER_BASE_URL", "")
 	cfg.KYCProvider.APIKey = getEnv("KYC_PROVIDER_API_KEY", "")
 	cfg.KYCProvider.APISecret = getEnv("KYC_PROVIDER_API_SECRET", "")
+	cfg.KYCDocuments.Backend = strings.ToLower(getEnv("KYC_DOCUMENT_STORAGE", ""))
+	cfg.KYCDocuments.Dir = getEnv("KYC_DOCUMENT_DIR", "")
+	cfg.KYCDocuments.S3Bucket = getEnv("KYC_S3_BUCKET", "")
+	cfg.KYCDocuments.S3Region = getEnv("KYC_S3_REGION", getEnv("AWS_REGION", ""))
+	cfg.KYCDocuments.S3Endpoint = getEnv("KYC_S3_ENDPOINT", "")
+	cfg.KYCDocuments.S3AccessKeyID = getEnv("AWS_ACCESS_KEY_ID", "")
+	cfg.KYCDocuments.S3SecretKey = getEnv("AWS_SECRET_ACCESS_KEY", "")
+	cfg.KYCDocuments.S3SessionToken = getEnv("AWS_SESSION_TOKEN", "")
+	cfg.KYCDocuments.GCSBucket = getEnv("KYC_GCS_BUCKET", "")
+	cfg.KYCDocuments.GCSAccessToken = getEnv("KYC_GCS_ACCESS_TOKEN", "")
+	cfg.KYCDocuments.GCSEndpoint = getEnv("KYC_GCS_ENDPOINT", "")
+	cfg.KYCDocuments.LinkSecret = getEnv("KYC_DOCUMENT_LINK_SECRET", "")
+	cfg.KYCDocuments.LinkTTL = getEnvAsDuration("KYC_DOCUMENT_LINK_TTL", kycusecase.DefaultDocumentLinkTTL)
+	cfg.KYCDocuments.DownloadURL = getEnv("KYC_DOCUMENT_DOWNLOAD_URL", "")
+	cfg.KYCScanner.Backend = strings.ToLower(getEnv("KYC_DOCUMENT_SCANNER", ""))
+	cfg.KYCScanner.ClamAVAddress = getEnv("CLAMAV_ADDRESS", "localhost:3310")
+	cfg.KYCScanner.URL = getEnv("KYC_SCANNER_URL", "")
+	cfg.KYCScanner.APIKey = getEnv("KYC_SCANNER_API_KEY", "")
+	cfg.KYCScanner.Timeout = getEnvAsDuration("KYC_SCANNER_TIMEOUT", time.Minute)
+	cfg.AMLProvider.BaseURL = getEnv("AML_PROVIDER_BASE_URL", "")
+	cfg.AMLProvider.APIKey = getEnv("AML_PROVIDER_API_KEY", "")
+	cfg.AMLProvider.FreezeAtLevel = strings.ToLower(getEnv("AML_FREEZE_AT_LEVEL", string(entities.RiskLevelCritical)))
+	cfg.AMLProvider.FreezeOnSanctions = getEnvAsBool("AML_FREEZE_ON_SANCTIONS", true)
+	cfg.AMLRescreenInterval = getEnvAsDuration("AML_RESCREEN_INTERVAL", time.Hour)
+	cfg.TxMonitorInterval = getEnvAsDuration("TX_MONITOR_INTERVAL", 15*time.Second)
+	cfg.TxMonitorBatchSize = getEnvAsInt("TX_MONITOR_BATCH_SIZE", 100)
+	cfg.DepositScanEnabled = getEnvAsBool("DEPOSIT_SCAN_ENABLED", true)
+	cfg.DepositScanInterval = getEnvAsDuration("DEPOSIT_SCAN_INTERVAL", 30*time.Second)
+	cfg.DepositScanBlocks = getEnvAsInt("DEPOSIT_SCAN_BLOCKS_PER_TICK", 50)
+	cfg.DormancyEnabled = getEnvAsBool("WALLET_DORMANCY_ENABLED", true)
+	cfg.DormancyAfter = getEnvAsDuration("WALLET_DORMANCY_AFTER", entities.DefaultDormancyInactiveAfter)
+	cfg.DormancyReminders = parseDurationList(getEnv("WALLET_DORMANCY_REMINDERS", ""), entities.DefaultDormancyReminders)
+	cfg.DormancyInterval = getEnvAsDuration("WALLET_DORMANCY_INTERVAL", time.Hour)
+	cfg.BalanceReconciliation.Enabled = getEnvAsBool("BALANCE_RECONCILIATION_ENABLED", true)
+	cfg.BalanceReconciliation.HourUTC = getEnvAsInt("BALANCE_RECONCILIATION_HOUR_UTC", 2)
+	cfg.BalanceReconciliation.Mode = strings.ToLower(getEnv("BALANCE_RECONCILIATION_MODE", string(entities.BalanceReconciliationModeSample)))
+	cfg.BalanceReconciliation.SampleSize = getEnvAsInt("BALANCE_RECONCILIATION_SAMPLE_SIZE", entities.DefaultBalanceReconciliationSampleSize)
+	cfg.BalanceReconciliation.Thresholds = parseKeyValueList(getEnv("BALANCE_RECONCILIATION_THRESHOLDS", ""))
+	cfg.Email.Driver = strings.ToLower(getEnv("EMAIL_DRIVER", ""))
+	cfg.Email.FromAddress = getEnv("EMAIL_FROM_ADDRESS", "")
+	cfg.Email.FromName = getEnv("EMAIL_FROM_NAME", cfg.TwoFactorIssuer)
+	cfg.Email.VerificationURL = getEnv("EMAIL_VERIFICATION_URL", "")
+	cfg.Email.ReportInterval = getEnvAsDuration("SCHEDULED_REPORT_INTERVAL", 5*time.Minute)
+	cfg.Email.SMTP.Host = getEnv("SMTP_HOST", "")
+	cfg.Email.SMTP.Port = getEnvAsInt("SMTP_PORT", 587)
+	cfg.Email.SMTP.Username = getEnv("SMTP_USERNAME", "")
+	cfg.Email.SMTP.Password = getEnv("SMTP_PASSWORD", "")
+	cfg.Email.SES.Region = getEnv("SES_REGION", "")
+	cfg.Email.SES.AccessKeyID = getEnv("SES_ACCESS_KEY_ID", "")
+	cfg.Email.SES.SecretAccessKey = getEnv("SES_SECRET_ACCESS_KEY", "")
+	cfg.Email.SES.SessionToken = getEnv("SES_SESSION_TOKEN", "")
+	cfg.Email.SendGridAPIKey = getEnv("SENDGRID_API_KEY", "")
+	cfg.EventExport.Sink = strings.ToLower(getEnv("EVENT_EXPORT_SINK", ""))
+	cfg.EventExport.Dir = getEnv("EVENT_EXPORT_DIR", "")
+	cfg.EventExport.Prefix = getEnv("EVENT_EXPORT_PREFIX", "events")
+	cfg.EventExport.Interval = getEnvAsDuration("EVENT_EXPORT_INTERVAL", time.Minute)
+	cfg.EventExport.BatchSize = getEnvAsInt("EVENT_EXPORT_BATCH_SIZE", 1000)
+	cfg.EventExport.S3Bucket = getEnv("EVENT_EXPORT_S3_BUCKET", "")
+	cfg.EventExport.S3Region = getEnv("EVENT_EXPORT_S3_REGION", getEnv("AWS_REGION", ""))
+	cfg.EventExport.S3Endpoint = getEnv("EVENT_EXPORT_S3_ENDPOINT", "")
+	cfg.EventExport.S3AccessKeyID = getEnv("AWS_ACCESS_KEY_ID", "")
+	cfg.EventExport.S3SecretKey = getEnv("AWS_SECRET_ACCESS_KEY", "")
+	cfg.EventExport.S3SessionToken = getEnv("AWS_SESSION_TOKEN", "")
+	cfg.EventExport.KafkaTopicPrefix = getEnv("EVENT_EXPORT_KAFKA_TOPIC_PREFIX", "wallet.events")
+	cfg.MessageBroker = strings.ToLower(getEnv("MESSAGE_BROKER", "redis"))
+	cfg.Kafka.Transport = strings.ToLower(getEnv("KAFKA_TRANSPORT", messaging.KafkaTransportNative))
+	cfg.Kafka.Brokers = splitAndTrim(getEnv("KAFKA_BROKERS", ""))
+	cfg.Kafka.ClientID = getEnv("KAFKA_CLIENT_ID", "crypto-wallet-backend")
+	cfg.Kafka.SASLMechanism = strings.ToLower(getEnv("KAFKA_SASL_MECHANISM", ""))
+	cfg.Kafka.TLS = getEnvAsBool("KAFKA_TLS", false)
+	cfg.Kafka.RESTURL = getEnv("KAFKA_REST_URL", "")
+	cfg.Kafka.Username = getEnv("KAFKA_USERNAME", "")
+	cfg.Kafka.Password = getEnv("KAFKA_PASSWORD", "")
+	cfg.Kafka.TopicPrefix = getEnv("KAFKA_TOPIC_PREFIX", "wallet")
+	cfg.Kafka.Topics = parseKeyValueList(getEnv("KAFKA_TOPIC_MAP", ""))
+	cfg.Kafka.FallbackCooldown = getEnvAsDuration("KAFKA_FALLBACK_COOLDOWN", 30*time.Second)
+	cfg.InternalAPI.Addr = getEnv("INTERNAL_API_ADDR", "")
+	cfg.InternalAPI.GRPCAddr = getEnv("INTERNAL_GRPC_ADDR", "")
+	cfg.InternalAPI.CertFile = getEnv("INTERNAL_API_TLS_CERT_FILE", "")
+	cfg.InternalAPI.KeyFile = getEnv("INTERNAL_API_TLS_KEY_FILE", "")
+	cfg.InternalAPI.ClientCAFile = getEnv("INTERNAL_API_CLIENT_CA_FILE", "")
+	cfg.AuthCookies = httpmiddleware.CookieAuthConfig{
+		Enabled:     getEnvAsBool("AUTH_COOKIE_MODE", false),
+		Domain:      getEnv("AUTH_COOKIE_DOMAIN", ""),
+		Secure:      getEnvAsBool("AUTH_COOKIE_SECURE", true),
+		SameSite:    getEnv("AUTH_COOKIE_SAMESITE", "Strict"),
+		RefreshPath: httproutes.DefaultAPIPrefix + "/auth",
+	}.WithDefaults()
+	if cfg.AuthCookies.Enabled && !strings.Contains(strings.ToLower(cfg.CORSAllowHeaders), strings.ToLower(cfg.AuthCookies.CSRFHeaderName)) {
+		cfg.CORSAllowHeaders += "," + cfg.AuthCookies.CSRFHeaderName
+	}
 
 	cfg.Blockchain.Bitcoin = blockchain.BitcoinConfig{
 		RPCURL:                getEnv("BTC_RPC_URL", ""),
+		RPCURLFallback:        getEnv("BTC_RPC_FALLBACK_URL", ""),
 		RPCUser:               getEnv("BTC_RPC_USER", ""),
 		RPCPassword:           getEnv("BTC_RPC_PASSWORD", ""),
 		Network:               getEnv("BTC_NETWORK", "mainnet"),
@@ -254,6 +1103,7 @@ func loadConfig() (appConfig, error) {
 
 	cfg.Blockchain.Ethereum = blockchain.EthereumConfig{
 		RPCURL:                getEnv("ETH_RPC_URL", ""),
+		RPCURLFallback:        getEnv("ETH_RPC_FALLBACK_URL", ""),
 		Network:               getEnv("ETH_NETWORK", "mainnet"),
 		ChainID:               int64(getEnvAsInt("ETH_CHAIN_ID", 1)),
 		ConfirmationThreshold: getEnvAsInt("ETH_CONFIRMATIONS", 12),
@@ -261,6 +1111,7 @@ func loadConfig() (appConfig, error) {
 
 	cfg.Blockchain.Solana = blockchain.SolanaConfig{
 		RPCURL:                getEnv("SOL_RPC_URL", ""),
+		RPCURLFallback:        getEnv("SOL_RPC_FALLBACK_URL", ""),
 		Network:               getEnv("SOL_NETWORK", "mainnet"),
 		ConfirmationThreshold: getEnvAsInt("SOL_CONFIRMATIONS", 32),
 		Commitment:            getEnv("SOL_COMMITMENT", "finalized"),
@@ -268,6 +1119,7 @@ func loadConfig() (appConfig, error) {
 
 	cfg.Blockchain.Stellar = blockchain.StellarConfig{
 		HorizonURL:            getEnv("XLM_HORIZON_URL", ""),
+		HorizonURLFallback:    getEnv("XLM_HORIZON_FALLBACK_URL", ""),
 		Network:               getEnv("XLM_NETWORK", "public"),
 		ConfirmationThreshold: getEnvAsInt("XLM_CONFIRMATIONS", 1),
 	}
@@ -289,6 +1141,16 @@ func loadConfig() (appConfig, error) {
 	return cfg, nil
 }
 
+// loadDatabaseDSNs reads the connection string of each database; unset databases are empty.
+func loadDatabaseDSNs() map[string]string {
+	return map[string]string{
+		"core":  getEnv("CORE_DB_DSN", ""),
+		"kyc":   getEnv("KYC_DB_DSN", ""),
+		"rates": getEnv("RATES_DB_DSN", ""),
+		"audit": getEnv("AUDIT_DB_DSN", ""),
+	}
+}
+
 func registerDatabasePools(manager *database.PoolManager, cfg appConfig) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -297,63 +1159,263 @@ func registerDatabasePools(manager *database.PoolManager, cfg appConfig) {
 		if strings.TrimSpace(dsn) == "" {
 			continue
 		}
-		if err := manager.Register(ctx, name, database.PoolConfig{DSN: dsn}); err != nil {
+		poolConfig := database.PoolConfig{DSN: dsn}
+		if cfg.Tracing.Enabled {
+			poolConfig.QueryTracer = tracing.QueryTracer{Database: name}
+		}
+		if err := manager.Register(ctx, name, poolConfig); err != nil {
 			slog.Warn("failed to register database pool", slog.String("name", name), slog.String("error", err.Error()))
 		}
 	}
 }
 
-func buildWalletHandler(cfg appConfig, pool *pgxpool.Pool, logger *slog.Logger) *handlers.WalletHandler {
+// buildChainRegistry constructs one adapter per supported chain from the environment,
+// instrumented when metrics or tracing are enabled. The chain registry reconfigures them once
+// loaded.
+func buildChainRegistry(cfg appConfig, metrics *monitoring.Metrics, logger *slog.Logger) *blockchain.Registry {
+	policy := blockchain.RPCPolicy{
+		FailureThreshold: cfg.RPCFailureThreshold,
+		Cooldown:         cfg.RPCCircuitCooldown,
+	}
+	if metrics != nil {
+		policy.Observer = metrics
+	}
+	return blockchain.NewRegistry(cfg.Blockchain, func(adapter blockchain.BlockchainAdapter) blockchain.BlockchainAdapter {
+		if metrics != nil {
+			adapter = blockchain.NewObservedAdapter(adapter, metrics)
+		}
+		if cfg.Tracing.Enabled {
+			adapter = blockchain.NewTracedAdapter(adapter)
+		}
+		return adapter
+	}, policy, logging.WithComponent(logger, "blockchain"))
+}
+
+// buildChainConfigComponents loads the chain registry into the adapters and wires the endpoints
+// that manage it, along with the worker that picks up changes made through other instances.
+func buildChainConfigComponents(cfg appConfig, pool *pgxpool.Pool, chains *blockchain.Registry, auditLogger *audit.Logger, logger *slog.Logger) (*handlers.ChainConfigHandler, *workers.ChainRegistryWorker) {
+	if pool == nil || chains == nil {
+		return nil, nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	chainRepo := postgres.NewChainConfigRepository(pool, logging.WithComponent(logger, "chain-config-repository"))
+	reloadUC := wallet.NewReloadChainConfigsUseCase(chainRepo, chains, logging.WithComponent(logger, "chain-config-reload"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := reloadUC.Execute(ctx); err != nil {
+		logger.Warn("failed to load chain registry; using environment adapter settings", slog.String("error", err.Error()))
+	}
+
+	handler := handlers.NewChainConfigHandler(handlers.ChainConfigHandlerConfig{
+		ListUseCase:      wallet.NewListChainConfigsUseCase(chainRepo),
+		UpsertUseCase:    wallet.NewUpsertChainConfigUseCase(chainRepo, reloadUC, auditLogger, logging.WithComponent(logger, "chain-config-upsert")),
+		SetActiveUseCase: wallet.NewSetChainActiveUseCase(chainRepo, reloadUC, auditLogger, logging.WithComponent(logger, "chain-config-active")),
+		Logger:           logging.WithComponent(logger, "chain-config-handler"),
+	})
+
+	return handler, workers.NewChainRegistryWorker(reloadUC, logging.WithComponent(logger, "chain-registry-worker"), cfg.ChainRegistryReload)
+}
+
+// buildBalanceReconciliationComponents wires the discrepancy report endpoints and, unless
+// BALANCE_RECONCILIATION_ENABLED is false, the nightly worker that produces them.
+func buildBalanceReconciliationComponents(cfg appConfig, pool *pgxpool.Pool, chains *blockchain.Registry, auditLogger *audit.Logger, logger *slog.Logger) (*handlers.BalanceReconciliationHandler, *workers.BalanceReconciliationWorker) {
 	if pool == nil {
-		return nil
+		return nil, nil
 	}
 	if logger == nil {
 		logger = slog.Default()
 	}
 
-	componentLogger := logging.WithComponent(logger, "wallet")
-	key, err := resolveEncryptionKey(cfg.WalletEncryptionKey, componentLogger)
-	if err != nil {
-		componentLogger.Error("failed to resolve wallet encryption key", slog.String("error", err.Error()))
+	walletRepo := postgres.NewWalletRepository(pool, logging.WithComponent(logger, "wallet-repository"))
+	runRepo := postgres.NewBalanceReconciliationRepository(pool, logging.WithComponent(logger, "balance-reconciliation-repository"))
+
+	adapters := make(map[entities.Chain]reconciliationusecase.BalanceReader)
+	for chain, adapter := range chains.Adapters() {
+		adapters[chain] = adapter
+	}
+
+	thresholds := make(map[entities.Chain]decimal.Decimal)
+	for key, value := range cfg.BalanceReconciliation.Thresholds {
+		chain := entities.NormalizeChain(key)
+		threshold, err := decimal.NewFromString(value)
+		if chain == "" || err != nil || threshold.IsNegative() {
+			logger.Warn("ignoring invalid balance reconciliation threshold", slog.String("chain", key), slog.String("value", value))
+			continue
+		}
+		thresholds[chain] = threshold
+	}
+
+	handler := handlers.NewBalanceReconciliationHandler(handlers.BalanceReconciliationHandlerConfig{
+		ListRunsUseCase:          reconciliationusecase.NewListRunsUseCase(runRepo, logging.WithComponent(logger, "reconciliation-runs")),
+		ListDiscrepanciesUseCase: reconciliationusecase.NewListDiscrepanciesUseCase(runRepo, logging.WithComponent(logger, "reconciliation-discrepancies")),
+		ResolveUseCase:           reconciliationusecase.NewResolveDiscrepancyUseCase(walletRepo, runRepo, adapters, auditLogger, logging.WithComponent(logger, "reconciliation-resolve")),
+		Logger:                   logging.WithComponent(logger, "reconciliation-handler"),
+	})
+
+	if !cfg.BalanceReconciliation.Enabled {
+		return handler, nil
+	}
+
+	reconcileUC := reconciliationusecase.NewReconcileChainUseCase(walletRepo, runRepo, adapters, reconciliationusecase.Policy{
+		Mode:       entities.BalanceReconciliationMode(cfg.BalanceReconciliation.Mode),
+		SampleSize: cfg.BalanceReconciliation.SampleSize,
+		Thresholds: thresholds,
+	}, auditLogger, logging.WithComponent(logger, "balance-reconciliation"))
+
+	return handler, workers.NewBalanceReconciliationWorker(reconcileUC, logging.WithComponent(logger, "balance-reconciliation-worker"), cfg.BalanceReconciliation.HourUTC)
+}
+
+// buildTransactionMonitor wires the worker that confirms pending transactions, publishes their
+// updates on the transactions channel and raises their events through the dispatcher.
+func buildTransactionMonitor(cfg appConfig, pool *pgxpool.Pool, publisher messaging.MessagePublisher, dispatcher *events.Dispatcher, chains *blockchain.Registry, logger *slog.Logger) *workers.TransactionMonitor {
+	if pool == nil {
 		return nil
 	}
+	if publisher == nil {
+		logger.Warn("no message broker configured; transaction updates will not be published")
+	}
 
-	encryptor, err := security.NewAESGCMEncryptor(security.AESGCMConfig{Key: key})
+	return workers.NewTransactionMonitor(
+		postgres.NewPostgresTransactionRepository(pool),
+		chains.Adapters(),
+		publisher,
+		dispatcher,
+		cfg.TxMonitorInterval,
+		cfg.TxMonitorBatchSize,
+		logging.WithComponent(logger, "transaction-monitor"),
+	)
+}
+
+// buildDepositMonitor wires the deposit scanner for every chain with a node that can list block
+// transfers. Only Ethereum has one today; other chains are skipped until a scanner exists for them.
+// Scanners share the chain's RPC client, so they fail over with its adapter.
+func buildDepositMonitor(cfg appConfig, pool *pgxpool.Pool, publisher messaging.MessagePublisher, dispatcher *events.Dispatcher, chains *blockchain.Registry, logger *slog.Logger) *workers.DepositMonitor {
+	if pool == nil || !cfg.DepositScanEnabled {
+		return nil
+	}
+
+	var scanners []blockchain.DepositScanner
+	ethScanner, err := blockchain.NewEVMDepositScanner(blockchain.EVMDepositScannerConfig{
+		Chain:                 entities.ChainETH,
+		RPCURL:                cfg.Blockchain.Ethereum.RPCURL,
+		ConfirmationThreshold: cfg.Blockchain.Ethereum.ConfirmationThreshold,
+		RPC:                   chains.RPCClient(entities.ChainETH),
+	}, logging.WithComponent(logger, "eth-deposit-scanner"))
 	if err != nil {
-		componentLogger.Error("failed to initialise wallet encryptor", slog.String("error", err.Error()))
+		logger.Warn("ethereum deposit scanning disabled", slog.String("error", err.Error()))
+	} else {
+		scanners = append(scanners, ethScanner)
+	}
+	if len(scanners) == 0 {
 		return nil
 	}
 
-	walletRepo := postgres.NewWalletRepository(pool, logging.WithComponent(logger, "wallet-repository"))
+	return workers.NewDepositMonitor(workers.DepositMonitorConfig{
+		Deposits:  postgres.NewDepositRepository(pool, logging.WithComponent(logger, "deposit-repository")),
+		Wallets:   postgres.NewWalletRepository(pool, logging.WithComponent(logger, "wallet-repository")),
+		Scanners:  scanners,
+		Publisher:     publisher,
+		Notifier:      dispatcher,
+		Interval:      cfg.DepositScanInterval,
+		BlocksPerTick: cfg.DepositScanBlocks,
+		Logger:        logging.WithComponent(logger, "deposit-monitor"),
+	})
+}
 
-	adapters := map[entities.Chain]blockchain.BlockchainAdapter{
-		entities.ChainBTC: blockchain.NewBitcoinAdapter(cfg.Blockchain.Bitcoin, logging.WithComponent(logger, "blockchain-btc")),
-		entities.ChainETH: blockchain.NewEthereumAdapter(cfg.Blockchain.Ethereum, logging.WithComponent(logger, "blockchain-eth")),
-		entities.ChainSOL: blockchain.NewSolanaAdapter(cfg.Blockchain.Solana, logging.WithComponent(logger, "blockchain-sol")),
-		entities.ChainXLM: blockchain.NewStellarAdapter(cfg.Blockchain.Stellar, logging.WithComponent(logger, "blockchain-xlm")),
+// buildWalletHandler wires wallet endpoints and the staff handler for chain rollouts, which share
+// the rollout repository and cohort metrics.
+// buildWalletHandler also returns the wallet service, which the internal gRPC API shares.
+func buildWalletHandler(cfg appConfig, pool *pgxpool.Pool, chains *blockchain.Registry, walletKey *masterKey, dispatcher *events.Dispatcher, auditLogger *audit.Logger, logger *slog.Logger) (*handlers.WalletHandler, *handlers.ChainRolloutHandler, *services.WalletService) {
+	if pool == nil || walletKey == nil {
+		return nil, nil, nil
 	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	componentLogger := logging.WithComponent(logger, "wallet")
+
+	// Keys are sealed under per-secret data keys wrapped by the master key. Legacy master keys
+	// only unwrap, until walletctl wallet rotate-key has re-wrapped everything under the new one.
+	encryptor := security.NewEnvelopeEncryptor(walletKey.provider, walletKey.legacy...)
+
+	walletRepo := postgres.NewWalletRepository(pool, logging.WithComponent(logger, "wallet-repository"))
+
+	adapters := chains.Adapters()
+
+	permissionRepo := postgres.NewWalletPermissionRepository(pool, logging.WithComponent(logger, "wallet-permission-repository"))
 
 	service := services.NewWalletService(services.WalletServiceConfig{
-		Repository: walletRepo,
-		Encryptor:  encryptor,
-		Adapters:   adapters,
-		Logger:     logging.WithComponent(logger, "wallet-service"),
-		Retry:      blockchain.RetryConfig{Attempts: 3, Delay: 350 * time.Millisecond},
+		Repository:  walletRepo,
+		Permissions: permissionRepo,
+		Encryptor:   encryptor,
+		Adapters:    adapters,
+		Logger:      logging.WithComponent(logger, "wallet-service"),
+		Retry:       blockchain.RetryConfig{Attempts: 3, Delay: 350 * time.Millisecond},
+		KeyAudit:    auditLogger,
+		HDSeeds:     postgres.NewHDSeedRepository(pool, logging.WithComponent(logger, "hd-seed-repository")),
+		Keychain:    hdwallet.NewKeychain(hdwallet.Config{BitcoinNetwork: cfg.Blockchain.Bitcoin.Network}),
+		Events:      dispatcher,
 	})
 
-	createUC := wallet.NewCreateWalletUseCase(service, logging.WithComponent(logger, "wallet-usecase-create"))
+	rolloutRepo := postgres.NewChainRolloutRepository(pool, logging.WithComponent(logger, "chain-rollout-repository"))
+	dormancyRepo := postgres.NewWalletDormancyRepository(pool, logging.WithComponent(logger, "wallet-dormancy-repository"))
+	// Without a policy dormant wallets can still be reactivated; activity just omits a dormancy date.
+	var dormancyPolicy entities.DormancyPolicy
+	if cfg.DormancyEnabled {
+		dormancyPolicy = buildDormancyPolicy(cfg, componentLogger)
+	}
+	rolloutMetrics := monitoring.NewRolloutMetrics()
+
+	createUC := wallet.NewCreateWalletUseCase(service, rolloutRepo, rolloutMetrics, logging.WithComponent(logger, "wallet-usecase-create"))
 	listUC := wallet.NewListWalletsUseCase(service, logging.WithComponent(logger, "wallet-usecase-list"))
 	balanceUC := wallet.NewGetWalletBalanceUseCase(service, logging.WithComponent(logger, "wallet-usecase-balance"))
 
-	return handlers.NewWalletHandler(handlers.WalletHandlerConfig{
-		CreateUseCase:  createUC,
-		ListUseCase:    listUC,
-		BalanceUseCase: balanceUC,
-		Logger:         logging.WithComponent(logger, "wallet-handler"),
+	walletHandler := handlers.NewWalletHandler(handlers.WalletHandlerConfig{
+		CreateUseCase:          createUC,
+		ListUseCase:            listUC,
+		BalanceUseCase:         balanceUC,
+		ChainsUseCase:          wallet.NewListChainAvailabilityUseCase(rolloutRepo, logging.WithComponent(logger, "wallet-usecase-chains")),
+		BetaUseCase:            wallet.NewSetBetaOptInUseCase(rolloutRepo, logging.WithComponent(logger, "wallet-usecase-beta")),
+		ListPermissionsUseCase: wallet.NewListWalletPermissionsUseCase(service, permissionRepo, logging.WithComponent(logger, "wallet-usecase-permissions-list")),
+		GrantPermissionUseCase: wallet.NewGrantWalletPermissionUseCase(
+			service,
+			permissionRepo,
+			postgres.NewPostgresUserRepository(pool),
+			auditLogger,
+			logging.WithComponent(logger, "wallet-usecase-permissions-grant"),
+		),
+		RevokePermissionUseCase: wallet.NewRevokeWalletPermissionUseCase(service, permissionRepo, auditLogger, logging.WithComponent(logger, "wallet-usecase-permissions-revoke")),
+		HDStatusUseCase:         wallet.NewGetHDWalletStatusUseCase(service),
+		GenerateMnemonicUseCase: wallet.NewGenerateMnemonicUseCase(service, auditLogger, logging.WithComponent(logger, "wallet-usecase-hd-generate")),
+		ImportMnemonicUseCase:   wallet.NewImportMnemonicUseCase(service, auditLogger, logging.WithComponent(logger, "wallet-usecase-hd-import")),
+		ConfirmBackupUseCase:    wallet.NewConfirmMnemonicBackupUseCase(service, auditLogger, logging.WithComponent(logger, "wallet-usecase-hd-backup")),
+		ActivityUseCase:         wallet.NewGetWalletActivityUseCase(service, dormancyRepo, dormancyPolicy, logging.WithComponent(logger, "wallet-usecase-activity")),
+		ReactivateUseCase: wallet.NewReactivateWalletUseCase(
+			service,
+			dormancyRepo,
+			postgres.NewPostgresUserRepository(pool),
+			dormancyPolicy,
+			auditLogger,
+			logging.WithComponent(logger, "wallet-usecase-reactivate"),
+		),
+		Logger: logging.WithComponent(logger, "wallet-handler"),
 	})
+	rolloutHandler := handlers.NewChainRolloutHandler(handlers.ChainRolloutHandlerConfig{
+		ListUseCase:   wallet.NewListChainRolloutsUseCase(rolloutRepo, rolloutMetrics, logging.WithComponent(logger, "chain-rollout-list")),
+		UpsertUseCase: wallet.NewUpsertChainRolloutUseCase(rolloutRepo, auditLogger, logging.WithComponent(logger, "chain-rollout-upsert")),
+		DeleteUseCase: wallet.NewDeleteChainRolloutUseCase(rolloutRepo, auditLogger, logging.WithComponent(logger, "chain-rollout-delete")),
+		Logger:        logging.WithComponent(logger, "chain-rollout-handler"),
+	})
+
+	return walletHandler, rolloutHandler, service
 }
 
-func buildAuthHandler(cfg appConfig, pool *pgxpool.Pool, jwtService *security.JWTService, logger *slog.Logger) *handlers.AuthHandler {
+func buildAuthHandler(cfg appConfig, pool *pgxpool.Pool, jwtService *security.JWTService, auditLogger *audit.Logger, logger *slog.Logger) *handlers.AuthHandler {
     if pool == nil {
         return nil
     }
@@ -369,81 +1431,1280 @@ func buildAuthHandler(cfg appConfig, pool *pgxpool.Pool, jwtService *security.JW
         return nil
     }
 
-    userRepo := postgres.NewUserRepository(pool, logging.WithComponent(logger, "user-repository"))
+    userRepo := postgres.NewPostgresUserRepository(pool)
+
+    refreshRepo := postgres.NewRefreshTokenRepository(pool, logging.WithComponent(logger, "refresh-token-repository"))
+    roleRepo := postgres.NewUserRoleRepository(pool, logging.WithComponent(logger, "user-role-repository"))
+    deviceRepo := postgres.NewUserDeviceRepository(pool, logging.WithComponent(logger, "user-device-repository"))
+
+    // Security alerts and verification links need an email driver; without one, devices are still
+    // tracked but nothing is sent and the verification endpoints answer 501.
+    var securityEmails *authusecase.SecurityEmails
+    var securityNotifier authusecase.SecurityNotifier
+    var sendVerifyUC *authusecase.SendEmailVerificationUseCase
+    var verifyEmailUC *authusecase.VerifyEmailUseCase
+    if cfg.Email.Driver != "" {
+        sender, err := buildEmailSender(cfg, logger)
+        if err != nil {
+            componentLogger.Warn("failed to initialise email sender; security emails are disabled", slog.String("error", err.Error()))
+        } else {
+            securityEmails = authusecase.NewSecurityEmails(sender, cfg.TwoFactorIssuer, logging.WithComponent(logger, "security-emails"))
+            securityNotifier = securityEmails
+        }
+    }
+    if securityEmails != nil {
+        verificationRepo := postgres.NewEmailVerificationRepository(pool, logging.WithComponent(logger, "email-verification-repository"))
+        verifyEmailUC = authusecase.NewVerifyEmailUseCase(userRepo, verificationRepo, auditLogger, logging.WithComponent(logger, "auth-verify-email"))
+        if strings.TrimSpace(cfg.Email.VerificationURL) != "" {
+            sendVerifyUC = authusecase.NewSendEmailVerificationUseCase(userRepo, verificationRepo, securityEmails, cfg.Email.VerificationURL, logging.WithComponent(logger, "auth-send-verification"))
+        } else {
+            componentLogger.Warn("EMAIL_VERIFICATION_URL not set; verification emails are disabled")
+        }
+    }
 
-    registerUC := authusecase.NewRegisterUseCase(userRepo, hasher, jwtService, 0, 0)
-    loginUC := authusecase.NewLoginUseCase(userRepo, hasher, jwtService, 0, 0)
+    registerUC := authusecase.NewRegisterUseCase(userRepo, hasher, jwtService, refreshRepo, cfg.AccessTokenTTL, cfg.RefreshTokenTTL)
+    loginUC := authusecase.NewLoginUseCase(userRepo, hasher, jwtService, refreshRepo, roleRepo, cfg.AccessTokenTTL, cfg.RefreshTokenTTL, deviceRepo, securityNotifier, auditLogger, logging.WithComponent(logger, "auth-login"))
     logoutUC := authusecase.NewLogoutUseCase(userRepo)
+    refreshUC := authusecase.NewRefreshTokenUseCase(userRepo, jwtService, refreshRepo, roleRepo, cfg.AccessTokenTTL, cfg.RefreshTokenTTL, logging.WithComponent(logger, "auth-refresh"))
+    revokeUC := authusecase.NewRevokeTokenUseCase(jwtService, refreshRepo, logging.WithComponent(logger, "auth-revoke"))
     setup2FAUC := authusecase.NewGenerateTwoFactorSetupUseCase(userRepo, logging.WithComponent(logger, "auth-2fa-setup"))
-    enable2FAUC := authusecase.NewEnableTwoFactorUseCase(userRepo, logging.WithComponent(logger, "auth-2fa-enable"))
-    disable2FAUC := authusecase.NewDisableTwoFactorUseCase(userRepo, logging.WithComponent(logger, "auth-2fa-disable"))
+    enable2FAUC := authusecase.NewEnableTwoFactorUseCase(userRepo, securityNotifier, auditLogger, logging.WithComponent(logger, "auth-2fa-enable"))
+    disable2FAUC := authusecase.NewDisableTwoFactorUseCase(userRepo, securityNotifier, auditLogger, logging.WithComponent(logger, "auth-2fa-disable"))
 
-    return handlers.NewAuthHandler(registerUC, loginUC, logoutUC, setup2FAUC, enable2FAUC, disable2FAUC, cfg.TwoFactorIssuer)
+    return handlers.NewAuthHandler(registerUC, loginUC, logoutUC, refreshUC, revokeUC, setup2FAUC, enable2FAUC, disable2FAUC, sendVerifyUC, verifyEmailUC, cfg.TwoFactorIssuer, cfg.AuthCookies)
 }
 
-func buildAnalyticsHandler(cfg appConfig, corePool, ratesPool *pgxpool.Pool, logger *slog.Logger) *handlers.AnalyticsHandler {
-	if logger == nil {
-		logger = slog.Default()
+func buildNotifier(publisher messaging.MessagePublisher, logger *slog.Logger) *messaging.PubSubNotifier {
+	if publisher == nil {
+		logger.Warn("no message broker configured; user notifications are disabled")
+		return nil
 	}
 
-	var transactionHistoryUC *transactionusecase.GetTransactionHistoryUseCase
-	var exportTransactionsUC *transactionusecase.ExportTransactionsUseCase
-	var summaryUC *analyticsusecase.PortfolioSummaryUseCase
-	var performanceUC *analyticsusecase.PortfolioPerformanceUseCase
+	return messaging.NewPubSubNotifier(publisher)
+}
 
-	if corePool != nil {
-		txRepo := postgres.NewPostgresTransactionRepository(corePool)
-		transactionHistoryUC = transactionusecase.NewGetTransactionHistoryUseCase(txRepo, logging.WithComponent(logger, "analytics-transaction-history"))
-		exportTransactionsUC = transactionusecase.NewExportTransactionsUseCase(txRepo, logging.WithComponent(logger, "analytics-transaction-export"))
+// buildRateLimitStore shares rate limit buckets through Redis when it is configured, so limits hold
+// across instances, and otherwise keeps them in process.
+func buildRateLimitStore(cfg appConfig, logger *slog.Logger) httpmiddleware.RateLimiterStore {
+	backend := cfg.RateLimitStore
+	if backend == "" && strings.TrimSpace(cfg.RedisAddr) != "" {
+		backend = "redis"
+	}
+	switch backend {
+	case "", "memory":
+		return ratelimit.NewMemoryStore()
+	case "redis":
+		if strings.TrimSpace(cfg.RedisAddr) == "" {
+			logger.Warn("RATE_LIMIT_STORE=redis requires REDIS_ADDR; rate limits apply per instance")
+			return ratelimit.NewMemoryStore()
+		}
+		return ratelimit.NewRedisStore(newRedisClient(cfg), ratelimit.DefaultRedisPrefix)
+	default:
+		logger.Warn("unsupported RATE_LIMIT_STORE; rate limits apply per instance", slog.String("store", backend))
+		return ratelimit.NewMemoryStore()
 	}
+}
 
-	if corePool != nil && ratesPool != nil {
-		walletRepo := postgres.NewWalletRepository(corePool, logging.WithComponent(logger, "analytics-wallet-repository"))
-		rateRepo := postgres.NewRateRepository(ratesPool, logging.WithComponent(logger, "analytics-rate-repository"))
-		summaryUC = analyticsusecase.NewPortfolioSummaryUseCase(walletRepo, rateRepo, logging.WithComponent(logger, "analytics-portfolio-summary"))
-		performanceUC = analyticsusecase.NewPortfolioPerformanceUseCase(walletRepo, rateRepo, logging.WithComponent(logger, "analytics-portfolio-performance"))
-	} else if ratesPool == nil {
-		logger.Warn("rates database unavailable for analytics handler")
+// cachedRateRepository serves current exchange rates from Redis when caching is configured.
+func cachedRateRepository(cfg appConfig, rates repositories.RateRepository, logger *slog.Logger) repositories.RateRepository {
+	if !cfg.CacheEnabled || strings.TrimSpace(cfg.RedisAddr) == "" {
+		return rates
 	}
+	return cache.NewRateRepository(rates, cache.Config{
+		Client: newRedisClient(cfg),
+		TTL:    cfg.CacheRateTTL,
+		Logger: logging.WithComponent(logger, "rate-cache"),
+	})
+}
 
-	if transactionHistoryUC == nil && exportTransactionsUC == nil && summaryUC == nil && performanceUC == nil {
-		return nil
+// cachedWalletRepository serves wallet listings from Redis when caching is configured. Cached
+// wallets carry no encrypted private key, so use it only for display reads and for writers whose
+// updates should invalidate them.
+func cachedWalletRepository(cfg appConfig, wallets repositories.WalletRepository, logger *slog.Logger) repositories.WalletRepository {
+	if !cfg.CacheEnabled || strings.TrimSpace(cfg.RedisAddr) == "" {
+		return wallets
 	}
+	return cache.NewWalletRepository(wallets, cache.Config{
+		Client: newRedisClient(cfg),
+		TTL:    cfg.CacheBalanceTTL,
+		Logger: logging.WithComponent(logger, "wallet-cache"),
+	})
+}
 
-	return handlers.NewAnalyticsHandler(handlers.AnalyticsHandlerConfig{
-		TransactionHistoryUseCase:   transactionHistoryUC,
-		ExportTransactionsUseCase:   exportTransactionsUC,
-		PortfolioSummaryUseCase:     summaryUC,
-		PortfolioPerformanceUseCase: performanceUC,
+// parseRouteRateLimits parses prefix=requests/window entries such as /api/v1/auth/login=10/1m,
+// skipping malformed ones.
+func parseRouteRateLimits(routes map[string]string, logger *slog.Logger) []httpmiddleware.RouteRateLimit {
+	limits := make([]httpmiddleware.RouteRateLimit, 0, len(routes))
+	for prefix, value := range routes {
+		rawRequests, rawWindow, _ := strings.Cut(value, "/")
+		requests, err := strconv.Atoi(strings.TrimSpace(rawRequests))
+		window, windowErr := time.ParseDuration(strings.TrimSpace(rawWindow))
+		if err != nil || windowErr != nil || requests <= 0 || window <= 0 {
+			logger.Warn("ignoring invalid route rate limit", slog.String("prefix", prefix), slog.String("value", value))
+			continue
+		}
+		limits = append(limits, httpmiddleware.RouteRateLimit{Prefix: prefix, MaxRequests: requests, Window: window})
+	}
+	return limits
+}
+
+// parseRouteTimeouts parses prefix=duration entries such as /api/v1/support/admin=2m, skipping
+// malformed ones.
+func parseRouteTimeouts(routes map[string]string, logger *slog.Logger) []httpmiddleware.RouteTimeout {
+	timeouts := make([]httpmiddleware.RouteTimeout, 0, len(routes))
+	for prefix, value := range routes {
+		timeout, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil || timeout <= 0 {
+			logger.Warn("ignoring invalid route timeout", slog.String("prefix", prefix), slog.String("value", value))
+			continue
+		}
+		timeouts = append(timeouts, httpmiddleware.RouteTimeout{Prefix: prefix, Timeout: timeout})
+	}
+	return timeouts
+}
+
+// buildStepUpAuth keeps spent codes and elevated sessions in Redis when it is configured, so a code
+// cannot be replayed against another instance.
+func buildStepUpAuth(cfg appConfig, pool *pgxpool.Pool, auditLogger *audit.Logger, logger *slog.Logger) *httpmiddleware.StepUpAuth {
+	if pool == nil {
+		return nil
+	}
+	var store httpmiddleware.StepUpStore = stepup.NewMemoryStore()
+	if strings.TrimSpace(cfg.RedisAddr) != "" {
+		store = stepup.NewRedisStore(newRedisClient(cfg), stepup.DefaultRedisPrefix)
+	}
+	return httpmiddleware.NewStepUpAuth(httpmiddleware.StepUpAuthConfig{
+		Users:        postgres.NewPostgresUserRepository(pool),
+		Store:        store,
+		ElevationTTL: cfg.StepUpElevationTTL,
+		Audit:        auditLogger,
+		Logger:       logging.WithComponent(logger, "step-up-auth"),
 	})
 }
 
-func buildKYCComponents(cfg appConfig, pool *pgxpool.Pool, logger *slog.Logger) (*handlers.KYCHandler, *httpmiddleware.KYCEnforcer) {
-    if pool == nil {
-        return nil, nil
-    }
-    if logger == nil {
-        logger = slog.Default()
-    }
+// newRedisClient connects to REDIS_ADDR, tracing commands when tracing is enabled.
+func newRedisClient(cfg appConfig) *redis.Client {
+	client := redis.NewClient(&redis.Options{Addr: cfg.RedisAddr})
+	if cfg.Tracing.Enabled {
+		client.AddHook(tracing.RedisHook{})
+	}
+	return client
+}
 
-    componentLogger := logging.WithComponent(logger, "kyc")
+// buildPublisher selects the message broker. With MESSAGE_BROKER=kafka, Redis (when configured)
+// takes over while Kafka is unreachable.
+func buildPublisher(cfg appConfig, logger *slog.Logger) messaging.MessagePublisher {
+	var redisPublisher messaging.MessagePublisher
+	if strings.TrimSpace(cfg.RedisAddr) != "" {
+		manager, err := messaging.NewRedisPubSubManager(messaging.RedisPubSubConfig{
+			RedisClient: newRedisClient(cfg),
+			Logger:      logging.WithComponent(logger, "pubsub"),
+		})
+		if err != nil {
+			logger.Warn("failed to initialise pubsub manager", slog.String("error", err.Error()))
+		} else {
+			redisPublisher = manager
+		}
+	}
 
-    key, err := resolveStrictEncryptionKey(cfg.KYCEncryptionKey, componentLogger)
-    if err != nil {
-        componentLogger.Error("failed to resolve KYC encryption key", slog.String("error", err.Error()))
-        return nil, nil
-    }
+	if cfg.MessageBroker != "kafka" {
+		if cfg.MessageBroker != "" && cfg.MessageBroker != "redis" {
+			logger.Warn("unsupported MESSAGE_BROKER; using redis", slog.String("broker", cfg.MessageBroker))
+		}
+		return redisPublisher
+	}
 
-    encryptor, err := security.NewAESGCMEncryptor(security.AESGCMConfig{Key: key})
-    if err != nil {
-        componentLogger.Error("failed to initialise KYC encryptor", slog.String("error", err.Error()))
-        return nil, nil
-    }
+	componentLogger := logging.WithComponent(logger, "kafka")
+	producer, err := messaging.NewKafkaProducer(kafkaConfig(cfg))
+	if err != nil {
+		componentLogger.Warn("kafka publisher disabled; falling back to redis", slog.String("error", err.Error()))
+		return redisPublisher
+	}
+	kafkaPublisher, err := messaging.NewKafkaPublisher(messaging.KafkaPublisherConfig{
+		Producer:    producer,
+		TopicPrefix: cfg.Kafka.TopicPrefix,
+		Topics:      cfg.Kafka.Topics,
+		Logger:      componentLogger,
+	})
+	if err != nil {
+		_ = producer.Close()
+		componentLogger.Warn("kafka publisher disabled; falling back to redis", slog.String("error", err.Error()))
+		return redisPublisher
+	}
 
-    repo := postgres.NewKYCRepository(pool, logging.WithComponent(logger, "kyc-repository"))
+	publisher := messaging.NewFallbackPublisher(kafkaPublisher, redisPublisher, cfg.Kafka.FallbackCooldown, componentLogger)
 
-    var provider external.KYCProviderClient
-    if strings.TrimSpace(cfg.KYCProvider.BaseURL) != "" && strings.TrimSpace(cfg.KYCProvider.APIKey) != "" {
+	pingCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := producer.Ping(pingCtx); err != nil {
+		componentLogger.Warn("kafka unreachable at startup; publishing to fallback until it recovers", slog.String("error", err.Error()))
+		publisher.MarkPrimaryDown()
+	}
+
+	return publisher
+}
+
+func kafkaConfig(cfg appConfig) messaging.KafkaConfig {
+	return messaging.KafkaConfig{
+		Transport:     cfg.Kafka.Transport,
+		Brokers:       cfg.Kafka.Brokers,
+		ClientID:      cfg.Kafka.ClientID,
+		SASLMechanism: cfg.Kafka.SASLMechanism,
+		Username:      cfg.Kafka.Username,
+		Password:      cfg.Kafka.Password,
+		TLS:           cfg.Kafka.TLS,
+		REST:          messaging.KafkaRESTConfig{BaseURL: cfg.Kafka.RESTURL},
+	}
+}
+
+// buildEventDispatcher wires the subscribers of domain events: the core outbox for warehouse
+// export, the in-app notification inbox, which forwards to pub/sub when it is available, the audit
+// trail for new wallets and user webhooks for wallet lifecycle events. Without the core database
+// events only reach pub/sub.
+func buildEventDispatcher(corePool *pgxpool.Pool, pubsub *messaging.PubSubNotifier, webhookEncryptor *security.AESGCMEncryptor, auditLogger *audit.Logger, logger *slog.Logger) *events.Dispatcher {
+	dispatcher := events.NewDispatcher(logging.WithComponent(logger, "event-dispatcher"))
+
+	var next messaging.EventNotifier
+	if pubsub != nil {
+		next = pubsub
+	}
+	if corePool == nil {
+		if next != nil {
+			dispatcher.Subscribe("pubsub", "*", events.Forward(next))
+		}
+		return dispatcher
+	}
+
+	outbox := postgres.NewEventOutboxRepository(corePool, logging.WithComponent(logger, "event-outbox-repository"))
+	dispatcher.Subscribe("outbox", "*", messaging.OutboxSubscriber(outbox))
+	dispatcher.Subscribe("notifications", "*", events.Forward(buildNotificationService(corePool, next, logger)))
+	dispatcher.Subscribe("audit", events.WalletCreatedEvent, wallet.AuditWalletCreated(auditLogger))
+
+	if webhookEncryptor != nil {
+		deliver := webhookusecase.NewDeliverEventsUseCase(
+			postgres.NewWebhookEndpointRepository(corePool, logging.WithComponent(logger, "webhook-repository")),
+			webhookEncryptor,
+			webhook.NewDeliverer(nil),
+			postgres.NewWalletRepository(corePool, logging.WithComponent(logger, "wallet-repository")),
+			logging.WithComponent(logger, "webhook-events"),
+		)
+		for _, name := range []string{events.WalletCreatedEvent, events.BalanceUpdatedEvent, events.TransactionConfirmedEvent, events.ExchangeCompletedEvent} {
+			dispatcher.Subscribe("webhooks", name, deliver.Handle)
+		}
+	}
+	return dispatcher
+}
+
+// buildNotificationService stores in-app notifications for user-facing events before passing every
+// event on to next, which may be nil.
+func buildNotificationService(corePool *pgxpool.Pool, next messaging.EventNotifier, logger *slog.Logger) *notificationusecase.NotificationService {
+	return notificationusecase.NewNotificationService(
+		postgres.NewNotificationRepository(corePool, logging.WithComponent(logger, "notification-repository")),
+		postgres.NewWalletRepository(corePool, logging.WithComponent(logger, "wallet-repository")),
+		postgres.NewUserPreferencesRepository(corePool, logging.WithComponent(logger, "preferences-repository")),
+		next,
+		logging.WithComponent(logger, "notification-service"),
+	)
+}
+
+// buildNotificationHandler wires the in-app notification inbox.
+func buildNotificationHandler(pool *pgxpool.Pool, logger *slog.Logger) *handlers.NotificationHandler {
+	if pool == nil {
+		return nil
+	}
+
+	notificationRepo := postgres.NewNotificationRepository(pool, logging.WithComponent(logger, "notification-repository"))
+	return handlers.NewNotificationHandler(handlers.NotificationHandlerConfig{
+		ListUseCase:     notificationusecase.NewListNotificationsUseCase(notificationRepo, logging.WithComponent(logger, "notification-list")),
+		MarkReadUseCase: notificationusecase.NewMarkReadUseCase(notificationRepo, logging.WithComponent(logger, "notification-read")),
+		Logger:          logging.WithComponent(logger, "notification-handler"),
+	})
+}
+
+func buildP2PComponents(cfg appConfig, pool *pgxpool.Pool, notifier messaging.EventNotifier, auditLogger *audit.Logger, logger *slog.Logger) (*handlers.P2PHandler, *handlers.P2PDisputeSupportHandler, *workers.P2PClaimExpirationWorker) {
+	if pool == nil {
+		return nil, nil, nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	// Keep the interface nil when notifications are disabled so use cases skip publishing.
+	var p2pNotifier p2pusecase.Notifier
+	if notifier != nil {
+		p2pNotifier = notifier
+	}
+
+	transferRepo := postgres.NewP2PTransferRepository(pool, logging.WithComponent(logger, "p2p-repository"))
+	userRepo := postgres.NewPostgresUserRepository(pool)
+	walletRepo := postgres.NewWalletRepository(pool, logging.WithComponent(logger, "p2p-wallet-repository"))
+	profileRepo := postgres.NewPaymentProfileRepository(pool, logging.WithComponent(logger, "p2p-profile-repository"))
+
+	sendUC := p2pusecase.NewSendTransferUseCase(transferRepo, userRepo, profileRepo, walletRepo, p2pNotifier, cfg.P2PClaimWindow, logging.WithComponent(logger, "p2p-send"))
+	claimUC := p2pusecase.NewClaimTransferUseCase(transferRepo, userRepo, walletRepo, p2pNotifier, logging.WithComponent(logger, "p2p-claim"))
+	listUC := p2pusecase.NewListTransfersUseCase(transferRepo, logging.WithComponent(logger, "p2p-list"))
+	returnUC := p2pusecase.NewReturnExpiredTransfersUseCase(transferRepo, p2pNotifier, logging.WithComponent(logger, "p2p-return"))
+
+	disputeRepo := postgres.NewP2PDisputeRepository(pool, logging.WithComponent(logger, "p2p-dispute-repository"))
+	openDisputeUC := p2pusecase.NewOpenDisputeUseCase(transferRepo, disputeRepo, p2pNotifier, auditLogger, cfg.P2PDisputeWindow, logging.WithComponent(logger, "p2p-dispute-open"))
+	getDisputeUC := p2pusecase.NewGetDisputeUseCase(disputeRepo, logging.WithComponent(logger, "p2p-dispute-get"))
+	listDisputesUC := p2pusecase.NewListDisputesUseCase(disputeRepo, logging.WithComponent(logger, "p2p-dispute-list"))
+	holdDisputeUC := p2pusecase.NewHoldDisputeUseCase(transferRepo, disputeRepo, walletRepo, p2pNotifier, auditLogger, logging.WithComponent(logger, "p2p-dispute-hold"))
+	resolveDisputeUC := p2pusecase.NewResolveDisputeUseCase(transferRepo, disputeRepo, walletRepo, p2pNotifier, auditLogger, logging.WithComponent(logger, "p2p-dispute-resolve"))
+
+	handler := handlers.NewP2PHandler(handlers.P2PHandlerConfig{
+		SendUseCase:         sendUC,
+		ClaimUseCase:        claimUC,
+		ListUseCase:         listUC,
+		OpenDisputeUseCase:  openDisputeUC,
+		GetDisputeUseCase:   getDisputeUC,
+		ListDisputesUseCase: listDisputesUC,
+		Logger:              logging.WithComponent(logger, "p2p-handler"),
+	})
+
+	supportHandler := handlers.NewP2PDisputeSupportHandler(handlers.P2PDisputeSupportHandlerConfig{
+		ListUseCase:    listDisputesUC,
+		GetUseCase:     getDisputeUC,
+		HoldUseCase:    holdDisputeUC,
+		ResolveUseCase: resolveDisputeUC,
+		Logger:         logging.WithComponent(logger, "p2p-dispute-support-handler"),
+	})
+
+	worker := workers.NewP2PClaimExpirationWorker(returnUC, logging.WithComponent(logger, "p2p-claim-expiration"), time.Minute)
+
+	return handler, supportHandler, worker
+}
+
+func buildProfileHandler(cfg appConfig, pool *pgxpool.Pool, logger *slog.Logger) *handlers.ProfileHandler {
+	if pool == nil {
+		return nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	profileRepo := postgres.NewPaymentProfileRepository(pool, logging.WithComponent(logger, "profile-repository"))
+
+	return handlers.NewProfileHandler(handlers.ProfileHandlerConfig{
+		GetUseCase:          profileusecase.NewGetProfileUseCase(profileRepo, logging.WithComponent(logger, "profile-get")),
+		ClaimHandleUseCase:  profileusecase.NewClaimHandleUseCase(profileRepo, logging.WithComponent(logger, "profile-claim-handle")),
+		UpdateUseCase:       profileusecase.NewUpdateProfileUseCase(profileRepo, logging.WithComponent(logger, "profile-update")),
+		LookupUseCase:       profileusecase.NewLookupHandleUseCase(profileRepo, logging.WithComponent(logger, "profile-lookup")),
+		AvailabilityUseCase: profileusecase.NewCheckAvailabilityUseCase(profileRepo, logging.WithComponent(logger, "profile-availability")),
+		LookupMaxRequests:   cfg.HandleLookupLimit,
+		LookupWindow:        cfg.HandleLookupWindow,
+		Logger:              logging.WithComponent(logger, "profile-handler"),
+	})
+}
+
+// buildPreferencesHandler wires the user preferences endpoints.
+func buildPreferencesHandler(pool *pgxpool.Pool, logger *slog.Logger) *handlers.PreferencesHandler {
+	if pool == nil {
+		return nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	preferencesRepo := postgres.NewUserPreferencesRepository(pool, logging.WithComponent(logger, "preferences-repository"))
+
+	return handlers.NewPreferencesHandler(handlers.PreferencesHandlerConfig{
+		GetUseCase:    preferencesusecase.NewGetPreferencesUseCase(preferencesRepo),
+		UpdateUseCase: preferencesusecase.NewUpdatePreferencesUseCase(preferencesRepo, logging.WithComponent(logger, "preferences-update")),
+		Logger:        logging.WithComponent(logger, "preferences-handler"),
+	})
+}
+
+// buildWebhookEncryptor builds the encryptor for webhook signing secrets, or returns nil, disabling
+// webhooks, when no usable key is configured.
+func buildWebhookEncryptor(cfg appConfig, logger *slog.Logger) *security.AESGCMEncryptor {
+	componentLogger := logging.WithComponent(logger, "webhook")
+
+	// Signing secrets must survive restarts, so an ephemeral key is not acceptable here.
+	key, err := resolveStrictEncryptionKey("WEBHOOK_SECRET_ENCRYPTION_KEY", cfg.WebhookSecretKey, componentLogger)
+	if err != nil {
+		componentLogger.Warn("webhooks disabled", slog.String("error", err.Error()))
+		return nil
+	}
+
+	encryptor, err := security.NewAESGCMEncryptor(security.AESGCMConfig{Key: key})
+	if err != nil {
+		componentLogger.Error("failed to initialise webhook encryptor", slog.String("error", err.Error()))
+		return nil
+	}
+	return encryptor
+}
+
+func buildWebhookHandler(pool *pgxpool.Pool, encryptor *security.AESGCMEncryptor, logger *slog.Logger) *handlers.WebhookHandler {
+	if pool == nil || encryptor == nil {
+		return nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	endpointRepo := postgres.NewWebhookEndpointRepository(pool, logging.WithComponent(logger, "webhook-repository"))
+	deliverer := webhook.NewDeliverer(nil)
+
+	return handlers.NewWebhookHandler(handlers.WebhookHandlerConfig{
+		RegisterUseCase:     webhookusecase.NewRegisterEndpointUseCase(endpointRepo, encryptor, logging.WithComponent(logger, "webhook-register")),
+		ListUseCase:         webhookusecase.NewListEndpointsUseCase(endpointRepo, logging.WithComponent(logger, "webhook-list")),
+		DeleteUseCase:       webhookusecase.NewDeleteEndpointUseCase(endpointRepo, logging.WithComponent(logger, "webhook-delete")),
+		TestDeliveryUseCase: webhookusecase.NewSendTestDeliveryUseCase(endpointRepo, encryptor, deliverer, logging.WithComponent(logger, "webhook-test-delivery")),
+		VerifyUseCase:       webhookusecase.NewVerifySignatureUseCase(endpointRepo, encryptor, logging.WithComponent(logger, "webhook-verify")),
+		Logger:              logging.WithComponent(logger, "webhook-handler"),
+	})
+}
+
+func buildAddressBookHandler(cfg appConfig, pool *pgxpool.Pool, chains *blockchain.Registry, auditLogger *audit.Logger, logger *slog.Logger) *handlers.AddressBookHandler {
+	if pool == nil {
+		return nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	entryRepo := postgres.NewAddressBookRepository(pool, logging.WithComponent(logger, "address-book-repository"))
+
+	var (
+		exportUC *addressbookusecase.ExportEntriesUseCase
+		importUC *addressbookusecase.ImportEntriesUseCase
+	)
+	if signer, err := addressbookusecase.NewTransferSigner(cfg.AddressBookSecret); err != nil {
+		logger.Warn("ADDRESS_BOOK_TRANSFER_SECRET not configured; address book export and import disabled")
+	} else {
+		validators := make(map[entities.Chain]addressbookusecase.AddressValidator)
+		for chain, adapter := range chains.Adapters() {
+			validators[chain] = adapter
+		}
+		exportUC = addressbookusecase.NewExportEntriesUseCase(entryRepo, signer, auditLogger, logging.WithComponent(logger, "address-book-export"))
+		importUC = addressbookusecase.NewImportEntriesUseCase(entryRepo, signer, validators, cfg.AddressBookCooldown, auditLogger, logging.WithComponent(logger, "address-book-import"))
+	}
+
+	return handlers.NewAddressBookHandler(handlers.AddressBookHandlerConfig{
+		ListUseCase:           addressbookusecase.NewListEntriesUseCase(entryRepo, logging.WithComponent(logger, "address-book-list")),
+		AddUseCase:            addressbookusecase.NewAddEntryUseCase(entryRepo, cfg.AddressBookCooldown, auditLogger, logging.WithComponent(logger, "address-book-add")),
+		UpdateUseCase:         addressbookusecase.NewUpdateEntryUseCase(entryRepo, auditLogger, logging.WithComponent(logger, "address-book-update")),
+		DeleteUseCase:         addressbookusecase.NewDeleteEntryUseCase(entryRepo, auditLogger, logging.WithComponent(logger, "address-book-delete")),
+		GetSettingsUseCase:    addressbookusecase.NewGetSettingsUseCase(entryRepo, cfg.AddressBookCooldown, logging.WithComponent(logger, "address-book-settings")),
+		UpdateSettingsUseCase: addressbookusecase.NewUpdateSettingsUseCase(entryRepo, cfg.AddressBookCooldown, auditLogger, logging.WithComponent(logger, "address-book-settings")),
+		ExportUseCase:         exportUC,
+		ImportUseCase:         importUC,
+		Logger:                logging.WithComponent(logger, "address-book-handler"),
+	})
+}
+
+func buildConnectedAppsComponents(cfg appConfig, pool *pgxpool.Pool, jwtService *security.JWTService, auditLogger *audit.Logger, logger *slog.Logger) (*handlers.ConnectedAppsHandler, *httpmiddleware.ScopeEnforcer) {
+	if pool == nil {
+		return nil, nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	consentRepo := postgres.NewAppConsentRepository(pool, logging.WithComponent(logger, "app-consent-repository"))
+
+	handler := handlers.NewConnectedAppsHandler(handlers.ConnectedAppsHandlerConfig{
+		AuthorizeUseCase: appsusecase.NewAuthorizeAppUseCase(consentRepo, jwtService, auditLogger, cfg.AppTokenTTL, logging.WithComponent(logger, "apps-authorize")),
+		ListUseCase:      appsusecase.NewListConnectedAppsUseCase(consentRepo),
+		RevokeUseCase:    appsusecase.NewRevokeConnectedAppUseCase(consentRepo, auditLogger, logging.WithComponent(logger, "apps-revoke")),
+		Logger:           logging.WithComponent(logger, "apps-handler"),
+	})
+	enforcer := httpmiddleware.NewScopeEnforcer(httpmiddleware.ScopeEnforcerConfig{
+		Consents: consentRepo,
+		Logger:   logging.WithComponent(logger, "app-scopes"),
+	})
+	return handler, enforcer
+}
+
+// buildPartnerKYCComponents needs both pools: partner links live in core, profiles in kyc.
+func buildPartnerKYCComponents(corePool, kycPool *pgxpool.Pool, logger *slog.Logger) (*handlers.PartnerKYCHandler, fiber.Handler) {
+	if corePool == nil || kycPool == nil {
+		return nil, nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	partnerRepo := postgres.NewPartnerRepository(corePool, logging.WithComponent(logger, "partner-repository"))
+	kycRepo := postgres.NewKYCRepository(kycPool, logging.WithComponent(logger, "kyc-repository"))
+
+	handler := handlers.NewPartnerKYCHandler(handlers.PartnerKYCHandlerConfig{
+		BatchStatusUseCase: kycusecase.NewGetPartnerKYCStatusesUseCase(kycRepo, partnerRepo, logging.WithComponent(logger, "partner-kyc-status")),
+		Logger:             logging.WithComponent(logger, "partner-kyc-handler"),
+	})
+	auth := httpmiddleware.NewPartnerAuthMiddleware(httpmiddleware.PartnerAuthConfig{
+		Partners: partnerRepo,
+		Logger:   logging.WithComponent(logger, "partner-auth"),
+	})
+	return handler, auth
+}
+
+func buildExportComponents(cfg appConfig, pool, kycPool *pgxpool.Pool, budgets *budget.Budgets, logger *slog.Logger) (*handlers.ExportHandler, *workers.ExportProcessorWorker) {
+	if pool == nil {
+		return nil, nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	jobRepo := postgres.NewExportJobRepository(pool, logging.WithComponent(logger, "export-repository"))
+	mappingRepo := postgres.NewAccountMappingRepository(pool, logging.WithComponent(logger, "account-mapping-repository"))
+	walletRepo := postgres.NewWalletRepository(pool, logging.WithComponent(logger, "export-wallet-repository"))
+	txRepo := postgres.NewPostgresTransactionRepository(pool)
+
+	var store exportusecase.FileStore
+	if fileStore, err := buildExportFileStore(cfg); err != nil {
+		logger.Warn("export object storage disabled; exports are kept in the database", slog.String("error", err.Error()))
+	} else if fileStore != nil {
+		store = fileStore
+	}
+
+	generators := map[entities.ExportJobKind]exportusecase.Generator{
+		entities.ExportJobKindAccounting:   exportusecase.NewAccountingGenerator(walletRepo, txRepo, mappingRepo, logging.WithComponent(logger, "export-accounting")),
+		entities.ExportJobKindTransactions: exportusecase.NewTransactionsGenerator(walletRepo, txRepo, logging.WithComponent(logger, "export-transactions")),
+		entities.ExportJobKindReport:       exportusecase.NewReportGenerator(postgres.NewReportQueryRepository(pool, logging.WithComponent(logger, "export-report-query-repository")), logging.WithComponent(logger, "export-report")),
+	}
+	var kycProfiles exportusecase.KYCProfileLookup
+	if kycPool != nil {
+		kycProfiles = postgres.NewKYCRepository(kycPool, logging.WithComponent(logger, "export-kyc-repository"))
+	}
+	generators[entities.ExportJobKindPersonalData] = exportusecase.NewPersonalDataGenerator(postgres.NewPostgresUserRepository(pool), walletRepo, txRepo, kycProfiles, logging.WithComponent(logger, "export-personal-data"))
+	processUC := exportusecase.NewProcessExportsUseCase(jobRepo, generators, store, cfg.ExportStorage.Prefix, cfg.ExportRetention, budgets, logging.WithComponent(logger, "export-process"))
+
+	handler := handlers.NewExportHandler(handlers.ExportHandlerConfig{
+		RequestAccountingUseCase:   exportusecase.NewRequestAccountingExportUseCase(jobRepo, walletRepo, logging.WithComponent(logger, "export-request-accounting")),
+		RequestTransactionsUseCase: exportusecase.NewRequestTransactionExportUseCase(jobRepo, walletRepo, logging.WithComponent(logger, "export-request-transactions")),
+		GetUseCase:                 exportusecase.NewGetExportUseCase(jobRepo, store, cfg.ExportStorage.DownloadURLTTL, logging.WithComponent(logger, "export-get")),
+		ListUseCase:                exportusecase.NewListExportsUseCase(jobRepo, logging.WithComponent(logger, "export-list")),
+		DownloadUseCase:            exportusecase.NewDownloadExportUseCase(jobRepo, store, cfg.ExportStorage.DownloadURLTTL, logging.WithComponent(logger, "export-download")),
+		GetMappingsUseCase:         exportusecase.NewGetAccountMappingsUseCase(mappingRepo, logging.WithComponent(logger, "export-mappings-get")),
+		UpdateMappingsUseCase:      exportusecase.NewUpdateAccountMappingsUseCase(mappingRepo, logging.WithComponent(logger, "export-mappings-update")),
+		Logger:                     logging.WithComponent(logger, "export-handler"),
+	})
+
+	worker := workers.NewExportProcessorWorker(processUC, logging.WithComponent(logger, "export-processor"), cfg.ExportPollInterval)
+
+	return handler, worker
+}
+
+// buildAccountComponents wires personal data export requests, account deletion and the worker that
+// purges deleted accounts once ACCOUNT_DELETION_RETENTION has passed. Without the KYC database,
+// purges remove core data only.
+func buildAccountComponents(cfg appConfig, corePool, kycPool *pgxpool.Pool, logger *slog.Logger) (*handlers.AccountHandler, *workers.AccountPurgeWorker) {
+	if corePool == nil {
+		return nil, nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	userRepo := postgres.NewPostgresUserRepository(corePool)
+	deletionRepo := postgres.NewAccountDeletionRepository(corePool, logging.WithComponent(logger, "account-deletion-repository"))
+
+	var (
+		keys accountusecase.KeyShredder
+		kyc  accountusecase.KYCDataPurger
+	)
+	if kycPool != nil {
+		// Shredding only deletes wrapped keys, so it does not need the KYC master key.
+		keys = security.NewUserKeyCipher(nil, postgres.NewUserDataKeyRepository(kycPool, logging.WithComponent(logger, "account-data-key-repository")))
+		kyc = postgres.NewKYCRepository(kycPool, logging.WithComponent(logger, "account-kyc-repository"))
+	}
+
+	handler := handlers.NewAccountHandler(handlers.AccountHandlerConfig{
+		ExportUseCase: exportusecase.NewRequestPersonalDataExportUseCase(postgres.NewExportJobRepository(corePool, logging.WithComponent(logger, "account-export-repository")), logging.WithComponent(logger, "account-data-export")),
+		DeleteUseCase: accountusecase.NewDeleteAccountUseCase(userRepo, postgres.NewWalletRepository(corePool, logging.WithComponent(logger, "account-wallet-repository")), deletionRepo, cfg.AccountDeletionRetention, logging.WithComponent(logger, "account-delete")),
+		Logger:        logging.WithComponent(logger, "account-handler"),
+	})
+
+	purgeUC := accountusecase.NewPurgeDeletedAccountsUseCase(userRepo, deletionRepo, keys, kyc, logging.WithComponent(logger, "account-purge"))
+	worker := workers.NewAccountPurgeWorker(purgeUC, logging.WithComponent(logger, "account-purge-worker"), cfg.AccountPurgeInterval)
+
+	return handler, worker
+}
+
+// buildExportFileStore returns the object store for generated exports, or nil when EXPORT_STORAGE
+// is not set and files stay in the database.
+func buildExportFileStore(cfg appConfig) (*objectstore.S3Store, error) {
+	switch cfg.ExportStorage.Backend {
+	case "":
+		return nil, nil
+	case "s3":
+		return objectstore.NewS3Store(objectstore.S3Config{
+			Bucket:          cfg.ExportStorage.S3Bucket,
+			Region:          cfg.ExportStorage.S3Region,
+			Endpoint:        cfg.ExportStorage.S3Endpoint,
+			AccessKeyID:     cfg.ExportStorage.S3AccessKeyID,
+			SecretAccessKey: cfg.ExportStorage.S3SecretKey,
+			SessionToken:    cfg.ExportStorage.S3SessionToken,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported EXPORT_STORAGE %q", cfg.ExportStorage.Backend)
+	}
+}
+
+// buildBroadcastComponents wires staff broadcast campaigns. Messages go straight to pub/sub rather
+// than through the event outbox, so campaigns are never sent when no broker is configured.
+func buildBroadcastComponents(cfg appConfig, corePool, kycPool *pgxpool.Pool, pubsub *messaging.PubSubNotifier, auditLogger *audit.Logger, logger *slog.Logger) (*handlers.BroadcastAdminHandler, *handlers.BroadcastHandler, *workers.BroadcastDispatcherWorker) {
+	if corePool == nil {
+		return nil, nil, nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	campaignRepo := postgres.NewBroadcastRepository(corePool, logging.WithComponent(logger, "broadcast-repository"))
+
+	// KYC statuses live in the KYC database; segments cannot filter on them without it.
+	var kycUsers broadcastusecase.KYCUserLister
+	if kycPool != nil {
+		kycUsers = postgres.NewKYCRepository(kycPool, logging.WithComponent(logger, "broadcast-kyc-repository"))
+	}
+
+	adminHandler := handlers.NewBroadcastAdminHandler(handlers.BroadcastAdminHandlerConfig{
+		CreateUseCase:  broadcastusecase.NewCreateCampaignUseCase(campaignRepo, kycUsers, auditLogger, cfg.BroadcastThrottle, logging.WithComponent(logger, "broadcast-create")),
+		PreviewUseCase: broadcastusecase.NewPreviewSegmentUseCase(campaignRepo, kycUsers, logging.WithComponent(logger, "broadcast-preview")),
+		ListUseCase:    broadcastusecase.NewListCampaignsUseCase(campaignRepo, logging.WithComponent(logger, "broadcast-list")),
+		GetUseCase:     broadcastusecase.NewGetCampaignUseCase(campaignRepo, logging.WithComponent(logger, "broadcast-get")),
+		CancelUseCase:  broadcastusecase.NewCancelCampaignUseCase(campaignRepo, auditLogger, logging.WithComponent(logger, "broadcast-cancel")),
+		Logger:         logging.WithComponent(logger, "broadcast-admin-handler"),
+	})
+	userHandler := handlers.NewBroadcastHandler(handlers.BroadcastHandlerConfig{
+		RecordOpenUseCase: broadcastusecase.NewRecordOpenUseCase(campaignRepo, logging.WithComponent(logger, "broadcast-open")),
+		Logger:            logging.WithComponent(logger, "broadcast-handler"),
+	})
+
+	if pubsub == nil {
+		logger.Warn("no message broker configured; broadcast campaigns will not be sent")
+		return adminHandler, userHandler, nil
+	}
+
+	dispatchUC := broadcastusecase.NewDispatchCampaignsUseCase(campaignRepo, kycUsers, pubsub, broadcastusecase.DispatchConfig{
+		Interval: cfg.BroadcastInterval,
+	}, logging.WithComponent(logger, "broadcast-dispatch"))
+	worker := workers.NewBroadcastDispatcherWorker(dispatchUC, logging.WithComponent(logger, "broadcast-dispatcher"), cfg.BroadcastInterval)
+
+	return adminHandler, userHandler, worker
+}
+
+// listRegistryAssets publishes the active assets of the registry so rates, trading pairs and
+// portfolios accept listed tokens besides the native assets. Assets listed later take effect on
+// restart.
+func listRegistryAssets(pool *pgxpool.Pool, logger *slog.Logger) {
+	if pool == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	assets, err := postgres.NewAssetRepository(pool, logging.WithComponent(logger, "asset-repository")).List(ctx, true)
+	if err != nil {
+		logger.Warn("failed to load asset registry; only native assets are supported", slog.String("error", err.Error()))
+		return
+	}
+	entities.ListAssets(assets)
+}
+
+// buildAuditLogger records audit entries in the audit database, falling back to the log alone
+// when the audit pool is unavailable.
+func buildAuditLogger(pool *pgxpool.Pool, logger *slog.Logger) *audit.Logger {
+	if pool == nil {
+		logger.Warn("audit database unavailable; audit entries are only written to the log")
+		return audit.NewLogger(logger)
+	}
+	return audit.NewPersistentLogger(postgres.NewAuditLogRepository(pool, logging.WithComponent(logger, "audit-repository")), logger)
+}
+
+// buildAuditComponents wires compliance audit search; archival only runs when AUDIT_ARCHIVE_DIR is set.
+func buildAuditComponents(cfg appConfig, pool *pgxpool.Pool, logger *slog.Logger) (*handlers.AuditHandler, *workers.AuditRetentionWorker) {
+	if pool == nil {
+		return nil, nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	auditRepo := postgres.NewAuditLogRepository(pool, logging.WithComponent(logger, "audit-repository"))
+
+	handler := handlers.NewAuditHandler(handlers.AuditHandlerConfig{
+		SearchUseCase:       auditusecase.NewSearchAuditLogsUseCase(auditRepo, logging.WithComponent(logger, "audit-search")),
+		ExportUseCase:       auditusecase.NewExportAuditLogsUseCase(auditRepo, logging.WithComponent(logger, "audit-export")),
+		VerifyUseCase:       auditusecase.NewVerifyAuditChainUseCase(auditRepo, logging.WithComponent(logger, "audit-verify")),
+		ListArchivesUseCase: auditusecase.NewListAuditArchivesUseCase(auditRepo, logging.WithComponent(logger, "audit-archives")),
+		Logger:              logging.WithComponent(logger, "audit-handler"),
+	})
+
+	if cfg.AuditArchiveDir == "" {
+		logger.Warn("audit archive directory not configured; audit retention disabled")
+		return handler, nil
+	}
+
+	store, err := audit.NewFileArchiveStore(cfg.AuditArchiveDir)
+	if err != nil {
+		logger.Error("failed to initialise audit archive store", slog.String("error", err.Error()))
+		return handler, nil
+	}
+
+	archiveUC := auditusecase.NewArchiveAuditLogsUseCase(auditRepo, store, cfg.AuditRetention, logging.WithComponent(logger, "audit-archive"))
+	worker := workers.NewAuditRetentionWorker(archiveUC, logging.WithComponent(logger, "audit-retention"), cfg.AuditArchivePeriod)
+
+	return handler, worker
+}
+
+// buildEventExportComponents wires the domain event export; the worker and backfill endpoint are
+// disabled when EVENT_EXPORT_SINK is not set.
+func buildEventExportComponents(cfg appConfig, pool *pgxpool.Pool, logger *slog.Logger) (*handlers.EventExportHandler, *workers.EventExportWorker) {
+	if pool == nil {
+		return nil, nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	sink, err := buildEventSink(cfg)
+	if err != nil {
+		logger.Warn("event export disabled", slog.String("error", err.Error()))
+		return nil, nil
+	}
+	if sink == nil {
+		logger.Info("EVENT_EXPORT_SINK not configured; event export disabled")
+		return nil, nil
+	}
+
+	outbox := postgres.NewEventOutboxRepository(pool, logging.WithComponent(logger, "event-outbox-repository"))
+	exportUC := eventexportusecase.NewExportEventsUseCase(outbox, sink, cfg.EventExport.BatchSize, logging.WithComponent(logger, "event-export"))
+
+	handler := handlers.NewEventExportHandler(handlers.EventExportHandlerConfig{
+		BackfillUseCase: eventexportusecase.NewBackfillEventsUseCase(outbox, sink, cfg.EventExport.BatchSize, logging.WithComponent(logger, "event-backfill")),
+		Logger:          logging.WithComponent(logger, "event-export-handler"),
+	})
+	worker := workers.NewEventExportWorker(exportUC, logging.WithComponent(logger, "event-export-worker"), cfg.EventExport.Interval)
+
+	return handler, worker
+}
+
+// buildAdminOperationsHandler wires bulk staff operations. Without ADMIN_CONFIRMATION_SECRET only
+// dry runs are accepted.
+func buildAdminOperationsHandler(cfg appConfig, pool *pgxpool.Pool, budgets *budget.Budgets, budgetMetrics *monitoring.BudgetMetrics, auditLogger *audit.Logger, logger *slog.Logger) *handlers.AdminOperationsHandler {
+	if pool == nil {
+		return nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	tokens, err := adminusecase.NewConfirmationTokens(cfg.AdminConfirmSecret, cfg.AdminConfirmTTL)
+	if err != nil {
+		logger.Warn("ADMIN_CONFIRMATION_SECRET not configured; admin operations limited to dry runs")
+		tokens = nil
+	}
+
+	walletRepo := postgres.NewWalletRepository(pool, logging.WithComponent(logger, "wallet-repository"))
+	exportRepo := postgres.NewExportJobRepository(pool, logging.WithComponent(logger, "export-repository"))
+	exchangeService := services.NewExchangeService(
+		postgres.NewExchangeOperationRepository(pool, logging.WithComponent(logger, "exchange-repository")),
+		postgres.NewTradingPairRepository(pool, logging.WithComponent(logger, "trading-pair-repository")),
+		cachedWalletRepository(cfg, walletRepo, logger),
+		postgres.NewAssetRepository(pool, logging.WithComponent(logger, "asset-repository")),
+		services.PricingStrategies{},
+		postgres.NewUnitOfWork(pool),
+		postgres.NewTradingPairLiquidityRepository(pool, logging.WithComponent(logger, "trading-pair-liquidity-repository")),
+	)
+
+	return handlers.NewAdminOperationsHandler(handlers.AdminOperationsHandlerConfig{
+		FreezeUserWalletsUseCase: adminusecase.NewFreezeUserWalletsUseCase(walletRepo, tokens, auditLogger, logging.WithComponent(logger, "admin-freeze-wallets")),
+		PurgeExportsUseCase:      adminusecase.NewPurgeExportsUseCase(exportRepo, tokens, auditLogger, logging.WithComponent(logger, "admin-purge-exports")),
+		CancelExchangesUseCase:   adminusecase.NewCancelPendingExchangesUseCase(exchangeService, tokens, auditLogger, logging.WithComponent(logger, "admin-cancel-exchanges")),
+		SimulatePricingUseCase:   adminusecase.NewSimulatePricingUseCase(exchangeService, logging.WithComponent(logger, "admin-simulate-pricing")),
+		ListBudgetsUseCase:       adminusecase.NewListExecutionBudgetsUseCase(budgets, budgetMetrics),
+		ListLiquidityUseCase:     adminusecase.NewListLiquidityUseCase(exchangeService),
+		GetLiquidityUseCase:      adminusecase.NewGetLiquidityUseCase(exchangeService),
+		TopUpLiquidityUseCase:    adminusecase.NewTopUpLiquidityUseCase(exchangeService, auditLogger, logging.WithComponent(logger, "admin-liquidity-top-up")),
+		Logger:                   logging.WithComponent(logger, "admin-operations-handler"),
+	})
+}
+
+func buildEventSink(cfg appConfig) (eventexportusecase.Sink, error) {
+	return eventexport.NewSink(eventexport.SinkConfig{
+		Kind:   cfg.EventExport.Sink,
+		Dir:    cfg.EventExport.Dir,
+		Prefix: cfg.EventExport.Prefix,
+		S3: eventexport.S3Config{
+			Bucket:          cfg.EventExport.S3Bucket,
+			Region:          cfg.EventExport.S3Region,
+			Endpoint:        cfg.EventExport.S3Endpoint,
+			AccessKeyID:     cfg.EventExport.S3AccessKeyID,
+			SecretAccessKey: cfg.EventExport.S3SecretKey,
+			SessionToken:    cfg.EventExport.S3SessionToken,
+		},
+		Kafka:            kafkaConfig(cfg),
+		KafkaTopicPrefix: cfg.EventExport.KafkaTopicPrefix,
+	})
+}
+
+// buildAnomalyMonitor wires operational alerting; it is disabled when no alert channel is configured.
+func buildAnomalyMonitor(cfg appConfig, metrics *monitoring.Metrics, pools *database.PoolManager, corePool *pgxpool.Pool, logger *slog.Logger) *workers.AnomalyMonitorWorker {
+	if metrics == nil {
+		return nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	channels := make([]monitoring.Channel, 0, 2)
+	if cfg.AlertSlackWebhook != "" {
+		if channel, err := monitoring.NewSlackChannel(cfg.AlertSlackWebhook); err == nil {
+			channels = append(channels, channel)
+		}
+	}
+	if cfg.AlertPagerDutyKey != "" {
+		if channel, err := monitoring.NewPagerDutyChannel(cfg.AlertPagerDutyKey); err == nil {
+			channels = append(channels, channel)
+		}
+	}
+
+	queues := make(map[string]monitoring.QueueDepthFunc)
+	if corePool != nil {
+		exportRepo := postgres.NewExportJobRepository(corePool, logging.WithComponent(logger, "alert-export-repository"))
+		queues["exports"] = exportRepo.CountQueued
+	}
+
+	monitor := monitoring.NewMonitor(monitoring.MonitorConfig{
+		Metrics:                  metrics,
+		Pools:                    pools,
+		Queues:                   queues,
+		Channels:                 channels,
+		ErrorRateThreshold:       float64(cfg.AlertErrorRatePct) / 100,
+		MinRequests:              int64(cfg.AlertMinRequests),
+		PoolUtilisationThreshold: float64(cfg.AlertPoolUsagePct) / 100,
+		QueueBacklogThreshold:    int64(cfg.AlertQueueBacklog),
+		RepeatInterval:           cfg.AlertRepeat,
+		Logger:                   logging.WithComponent(logger, "alerting"),
+	})
+
+	return workers.NewAnomalyMonitorWorker(monitor, logging.WithComponent(logger, "anomaly-monitor"), cfg.AlertInterval)
+}
+
+// buildAnalyticsHandler wires the analytics endpoints and the public handler serving portfolio
+// share links.
+func buildAnalyticsHandler(cfg appConfig, corePool, ratesPool *pgxpool.Pool, currencyConverter *services.CurrencyConverter, budgets *budget.Budgets, logger *slog.Logger) (*handlers.AnalyticsHandler, *handlers.SharedPortfolioHandler, *workers.ScheduledReportWorker) {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	var transactionHistoryUC *transactionusecase.GetTransactionHistoryUseCase
+	var exportTransactionsUC *exportusecase.RequestTransactionExportUseCase
+	var summaryUC *analyticsusecase.PortfolioSummaryUseCase
+	var performanceUC *analyticsusecase.PortfolioPerformanceUseCase
+	var rebalanceUC *analyticsusecase.RebalanceSuggestionsUseCase
+	var taxReportUC *analyticsusecase.TaxReportUseCase
+	var getScheduleUC *analyticsusecase.GetReportScheduleUseCase
+	var setScheduleUC *analyticsusecase.SetReportScheduleUseCase
+	var deleteScheduleUC *analyticsusecase.DeleteReportScheduleUseCase
+	var reportWorker *workers.ScheduledReportWorker
+	var reportCatalogUC *analyticsusecase.ReportCatalogUseCase
+	var runReportUC *analyticsusecase.RunReportUseCase
+	var listReportsUC *analyticsusecase.ListSavedReportsUseCase
+	var getReportUC *analyticsusecase.GetSavedReportUseCase
+	var createReportUC *analyticsusecase.CreateSavedReportUseCase
+	var updateReportUC *analyticsusecase.UpdateSavedReportUseCase
+	var deleteReportUC *analyticsusecase.DeleteSavedReportUseCase
+	var createShareUC *analyticsusecase.CreatePortfolioShareUseCase
+	var listSharesUC *analyticsusecase.ListPortfolioSharesUseCase
+	var revokeShareUC *analyticsusecase.RevokePortfolioShareUseCase
+	var listShareAccessesUC *analyticsusecase.ListPortfolioShareAccessesUseCase
+	var sharedPortfolioHandler *handlers.SharedPortfolioHandler
+
+	if corePool != nil {
+		txRepo := postgres.NewPostgresTransactionRepository(corePool)
+		transactionHistoryUC = transactionusecase.NewGetTransactionHistoryUseCase(txRepo, logging.WithComponent(logger, "analytics-transaction-history"))
+		exportJobRepo := postgres.NewExportJobRepository(corePool, logging.WithComponent(logger, "analytics-export-repository"))
+		exportWalletRepo := postgres.NewWalletRepository(corePool, logging.WithComponent(logger, "analytics-export-wallet-repository"))
+		exportTransactionsUC = exportusecase.NewRequestTransactionExportUseCase(exportJobRepo, exportWalletRepo, logging.WithComponent(logger, "analytics-transaction-export"))
+
+		savedReportRepo := postgres.NewSavedReportRepository(corePool, logging.WithComponent(logger, "analytics-saved-report-repository"))
+		reportQueryRepo := postgres.NewReportQueryRepository(corePool, logging.WithComponent(logger, "analytics-report-query-repository"))
+		reportQueue := exportusecase.NewRequestReportExportUseCase(exportJobRepo, logging.WithComponent(logger, "analytics-report-export"))
+		reportCatalogUC = analyticsusecase.NewReportCatalogUseCase()
+		runReportUC = analyticsusecase.NewRunReportUseCase(reportQueryRepo, reportQueue, savedReportRepo, cfg.ReportSyncTimeout, logging.WithComponent(logger, "analytics-run-report"))
+		listReportsUC = analyticsusecase.NewListSavedReportsUseCase(savedReportRepo, logging.WithComponent(logger, "analytics-list-reports"))
+		getReportUC = analyticsusecase.NewGetSavedReportUseCase(savedReportRepo, logging.WithComponent(logger, "analytics-get-report"))
+		createReportUC = analyticsusecase.NewCreateSavedReportUseCase(savedReportRepo, logging.WithComponent(logger, "analytics-create-report"))
+		updateReportUC = analyticsusecase.NewUpdateSavedReportUseCase(savedReportRepo, logging.WithComponent(logger, "analytics-update-report"))
+		deleteReportUC = analyticsusecase.NewDeleteSavedReportUseCase(savedReportRepo, logging.WithComponent(logger, "analytics-delete-report"))
+	}
+
+	if corePool != nil && ratesPool != nil {
+		walletRepo := cachedWalletRepository(cfg, postgres.NewWalletRepository(corePool, logging.WithComponent(logger, "analytics-wallet-repository")), logger)
+		rateRepo := cachedRateRepository(cfg, postgres.NewRateRepository(ratesPool, logging.WithComponent(logger, "analytics-rate-repository")), logger)
+		userRepo := postgres.NewPostgresUserRepository(corePool)
+		summaryUC = analyticsusecase.NewPortfolioSummaryUseCase(walletRepo, rateRepo, userRepo, currencyConverter, logging.WithComponent(logger, "analytics-portfolio-summary"))
+		portfolioValueRepo := postgres.NewPortfolioValueRepository(corePool, logging.WithComponent(logger, "analytics-portfolio-value-repository"))
+		performanceUC = analyticsusecase.NewPortfolioPerformanceUseCase(walletRepo, rateRepo, portfolioValueRepo, userRepo, currencyConverter, budgets, logging.WithComponent(logger, "analytics-portfolio-performance"))
+		exchangeService := services.NewExchangeService(
+			postgres.NewExchangeOperationRepository(corePool, logging.WithComponent(logger, "analytics-exchange-repository")),
+			postgres.NewTradingPairRepository(corePool, logging.WithComponent(logger, "analytics-trading-pair-repository")),
+			walletRepo,
+			postgres.NewAssetRepository(corePool, logging.WithComponent(logger, "analytics-asset-repository")),
+			services.PricingStrategies{},
+			postgres.NewUnitOfWork(corePool),
+			postgres.NewTradingPairLiquidityRepository(corePool, logging.WithComponent(logger, "analytics-trading-pair-liquidity-repository")),
+		)
+		rebalanceUC = analyticsusecase.NewRebalanceSuggestionsUseCase(summaryUC, exchangeService, logging.WithComponent(logger, "analytics-rebalance"))
+		taxReportUC = analyticsusecase.NewTaxReportUseCase(walletRepo, postgres.NewPostgresTransactionRepository(corePool), rateRepo, logging.WithComponent(logger, "analytics-tax-report"))
+
+		// Report schedules can only be managed while an email driver is configured to deliver them.
+		if cfg.Email.Driver != "" {
+			if sender, err := buildEmailSender(cfg, logger); err != nil {
+				logger.Warn("failed to initialise email sender; scheduled reports are disabled", slog.String("error", err.Error()))
+			} else {
+				scheduleRepo := postgres.NewReportScheduleRepository(corePool, logging.WithComponent(logger, "report-schedule-repository"))
+				preferencesRepo := postgres.NewUserPreferencesRepository(corePool, logging.WithComponent(logger, "preferences-repository"))
+				getScheduleUC = analyticsusecase.NewGetReportScheduleUseCase(scheduleRepo)
+				setScheduleUC = analyticsusecase.NewSetReportScheduleUseCase(scheduleRepo, preferencesRepo, logging.WithComponent(logger, "analytics-set-report-schedule"))
+				deleteScheduleUC = analyticsusecase.NewDeleteReportScheduleUseCase(scheduleRepo)
+				sendReportsUC := analyticsusecase.NewSendScheduledReportsUseCase(analyticsusecase.SendScheduledReportsConfig{
+					Schedules:   scheduleRepo,
+					Users:       userRepo,
+					Summary:     summaryUC,
+					Performance: performanceUC,
+					Sender:      sender,
+					Preferences: preferencesRepo,
+					AppName:     cfg.TwoFactorIssuer,
+					Logger:      logging.WithComponent(logger, "analytics-scheduled-reports"),
+				})
+				reportWorker = workers.NewScheduledReportWorker(sendReportsUC, logging.WithComponent(logger, "scheduled-report-worker"), cfg.Email.ReportInterval)
+			}
+		}
+
+		shareRepo := postgres.NewPortfolioShareRepository(corePool, logging.WithComponent(logger, "analytics-portfolio-share-repository"))
+		createShareUC = analyticsusecase.NewCreatePortfolioShareUseCase(shareRepo, cfg.PortfolioShareURL, logging.WithComponent(logger, "analytics-create-share"))
+		listSharesUC = analyticsusecase.NewListPortfolioSharesUseCase(shareRepo)
+		revokeShareUC = analyticsusecase.NewRevokePortfolioShareUseCase(shareRepo, logging.WithComponent(logger, "analytics-revoke-share"))
+		listShareAccessesUC = analyticsusecase.NewListPortfolioShareAccessesUseCase(shareRepo)
+		sharedPortfolioHandler = handlers.NewSharedPortfolioHandler(handlers.SharedPortfolioHandlerConfig{
+			UseCase: analyticsusecase.NewSharedPortfolioUseCase(analyticsusecase.SharedPortfolioConfig{
+				Shares:       shareRepo,
+				Summary:      summaryUC,
+				Performance:  performanceUC,
+				Wallets:      walletRepo,
+				Transactions: postgres.NewPostgresTransactionRepository(corePool),
+				Logger:       logging.WithComponent(logger, "analytics-shared-portfolio"),
+			}),
+			RateLimitMaxRequests: cfg.PublicAPIRateLimit,
+			RateLimitWindow:      cfg.PublicAPIRateWindow,
+			Logger:               logging.WithComponent(logger, "shared-portfolio-handler"),
+		})
+	} else if ratesPool == nil {
+		logger.Warn("rates database unavailable for analytics handler")
+	}
+
+	if transactionHistoryUC == nil && exportTransactionsUC == nil && summaryUC == nil && performanceUC == nil && runReportUC == nil {
+		return nil, nil, nil
+	}
+
+	return handlers.NewAnalyticsHandler(handlers.AnalyticsHandlerConfig{
+		TransactionHistoryUseCase:   transactionHistoryUC,
+		ExportTransactionsUseCase:   exportTransactionsUC,
+		PortfolioSummaryUseCase:     summaryUC,
+		PortfolioPerformanceUseCase: performanceUC,
+		RebalanceUseCase:            rebalanceUC,
+		TaxReportUseCase:            taxReportUC,
+		GetReportScheduleUseCase:    getScheduleUC,
+		SetReportScheduleUseCase:    setScheduleUC,
+		DeleteReportScheduleUseCase: deleteScheduleUC,
+		ReportCatalogUseCase:        reportCatalogUC,
+		RunReportUseCase:            runReportUC,
+		ListSavedReportsUseCase:     listReportsUC,
+		GetSavedReportUseCase:       getReportUC,
+		CreateSavedReportUseCase:    createReportUC,
+		UpdateSavedReportUseCase:    updateReportUC,
+		DeleteSavedReportUseCase:    deleteReportUC,
+		CreateShareUseCase:          createShareUC,
+		ListSharesUseCase:           listSharesUC,
+		RevokeShareUseCase:          revokeShareUC,
+		ListShareAccessesUseCase:    listShareAccessesUC,
+	}), sharedPortfolioHandler, reportWorker
+}
+
+// buildEmailSender constructs the configured transactional email driver.
+func buildEmailSender(cfg appConfig, logger *slog.Logger) (external.EmailSender, error) {
+	return external.NewEmailSender(external.EmailSenderConfig{
+		Driver:      cfg.Email.Driver,
+		FromAddress: cfg.Email.FromAddress,
+		FromName:    cfg.Email.FromName,
+		SMTP:        cfg.Email.SMTP,
+		SES:         cfg.Email.SES,
+		SendGrid:    external.SendGridConfig{APIKey: cfg.Email.SendGridAPIKey},
+		Logger:      logging.WithComponent(logger, "email-sender"),
+	})
+}
+
+func buildRateHandler(cfg appConfig, ratesPool *pgxpool.Pool, logger *slog.Logger) *handlers.RateHandler {
+	if ratesPool == nil {
+		return nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	rateRepo := cachedRateRepository(cfg, postgres.NewRateRepository(ratesPool, logging.WithComponent(logger, "rate-repository")), logger)
+
+	return handlers.NewRateHandler(
+		ratesusecase.NewGetCurrentRatesUseCase(rateRepo, logging.WithComponent(logger, "rates-current")),
+		ratesusecase.NewGetPriceHistoryUseCase(rateRepo, logging.WithComponent(logger, "rates-history")),
+		ratesusecase.NewGetCandlesUseCase(rateRepo, logging.WithComponent(logger, "rates-candles")),
+		ratesusecase.NewGetSymbolCatalogUseCase(),
+		ratesusecase.NewGetSparklinesUseCase(rateRepo, ratesusecase.DefaultSparklineCacheTTL, logging.WithComponent(logger, "rates-sparklines")),
+		logging.WithComponent(logger, "rate-handler"),
+	)
+}
+
+// buildRateSyncWorker wires the job that pulls CoinGecko prices into the rates database and, when
+// Redis is configured, broadcasts them on the price channels.
+func buildRateSyncWorker(cfg appConfig, ratesPool *pgxpool.Pool, logger *slog.Logger) *workers.RateSyncWorker {
+	if !cfg.RateSyncEnabled || ratesPool == nil {
+		return nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	var publisher ratesusecase.PricePublisher
+	if strings.TrimSpace(cfg.RedisAddr) != "" {
+		manager, err := messaging.NewRedisPubSubManager(messaging.RedisPubSubConfig{
+			RedisClient: newRedisClient(cfg),
+			Logger:      logging.WithComponent(logger, "price-pubsub"),
+		})
+		if err != nil {
+			logger.Warn("failed to initialise price pubsub; price updates will not be broadcast", slog.String("error", err.Error()))
+		} else {
+			publisher = manager
+		}
+	}
+
+	syncUC := ratesusecase.NewSyncRatesUseCase(
+		external.NewCoinGeckoClient(external.CoinGeckoConfig{
+			APIKey:  cfg.CoinGeckoAPIKey,
+			CoinIDs: entities.ListedCoingeckoIDs(),
+			Logger:  logging.WithComponent(logger, "coingecko"),
+		}),
+		cachedRateRepository(cfg, postgres.NewRateRepository(ratesPool, logging.WithComponent(logger, "rate-sync-repository")), logger),
+		publisher,
+		nil,
+		logging.WithComponent(logger, "rates-sync"),
+	)
+	return workers.NewRateSyncWorker(syncUC, logging.WithComponent(logger, "rate-sync-worker"), cfg.RateSyncInterval)
+}
+
+// buildFiatRateSyncWorker wires the job that pulls fiat reference rates used to show portfolio
+// values in users' preferred currencies.
+func buildFiatRateSyncWorker(cfg appConfig, ratesPool *pgxpool.Pool, currencyConverter *services.CurrencyConverter, logger *slog.Logger) *workers.FiatRateSyncWorker {
+	if !cfg.FiatRateSyncEnabled || ratesPool == nil {
+		return nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	client, err := external.NewFXRateClient(external.FXProviderConfig{
+		BaseURL: cfg.FXProviderURL,
+		Logger:  logging.WithComponent(logger, "fx-provider"),
+	})
+	if err != nil {
+		logger.Warn("fiat rate sync disabled", slog.String("error", err.Error()))
+		return nil
+	}
+
+	var cache ratesusecase.FiatRateInvalidator
+	if currencyConverter != nil {
+		cache = currencyConverter
+	}
+
+	syncUC := ratesusecase.NewSyncFiatRatesUseCase(
+		client,
+		postgres.NewFiatRateRepository(ratesPool, logging.WithComponent(logger, "fiat-rate-sync-repository")),
+		cache,
+		"",
+		logging.WithComponent(logger, "fiat-rates-sync"),
+	)
+	return workers.NewFiatRateSyncWorker(syncUC, logging.WithComponent(logger, "fiat-rate-sync-worker"), cfg.FiatRateInterval)
+}
+
+// buildTransactionNoteHandler wires the sync endpoints for end-to-end encrypted transaction notes.
+func buildTransactionNoteHandler(pool *pgxpool.Pool, logger *slog.Logger) *handlers.TransactionNoteHandler {
+	if pool == nil {
+		return nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	walletRepo := postgres.NewWalletRepository(pool, logging.WithComponent(logger, "transaction-note-wallets"))
+	walletService := services.NewWalletService(services.WalletServiceConfig{
+		Repository:  walletRepo,
+		Permissions: postgres.NewWalletPermissionRepository(pool, logging.WithComponent(logger, "transaction-note-permissions")),
+		Logger:      logging.WithComponent(logger, "transaction-note-wallet-service"),
+	})
+
+	return handlers.NewTransactionNoteHandler(handlers.TransactionNoteHandlerConfig{
+		SyncUseCase: transactionusecase.NewSyncTransactionNotesUseCase(
+			postgres.NewTransactionNoteRepository(pool, logging.WithComponent(logger, "transaction-note-repository")),
+			postgres.NewPostgresTransactionRepository(pool),
+			walletRepo,
+			walletService,
+			logging.WithComponent(logger, "transaction-note-sync"),
+		),
+		Logger: logging.WithComponent(logger, "transaction-note-handler"),
+	})
+}
+
+// buildTransactionSearchHandler wires transaction search. Sending, listing and status lookups are
+// not configured on this handler and answer 501.
+func buildTransactionSearchHandler(pool *pgxpool.Pool, logger *slog.Logger) *handlers.TransactionHandler {
+	if pool == nil {
+		return nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	walletRepo := postgres.NewWalletRepository(pool, logging.WithComponent(logger, "transaction-search-wallets"))
+	walletService := services.NewWalletService(services.WalletServiceConfig{
+		Repository:  walletRepo,
+		Permissions: postgres.NewWalletPermissionRepository(pool, logging.WithComponent(logger, "transaction-search-permissions")),
+		Logger:      logging.WithComponent(logger, "transaction-search-wallet-service"),
+	})
+
+	return handlers.NewTransactionHandler(handlers.TransactionHandlerConfig{
+		SearchUseCase: transactionusecase.NewSearchTransactionsUseCase(
+			postgres.NewPostgresTransactionRepository(pool),
+			walletRepo,
+			walletService,
+			logging.WithComponent(logger, "transaction-search"),
+		),
+		Logger: logging.WithComponent(logger, "transaction-handler"),
+	})
+}
+
+// buildGasOracleComponents wires the gas price endpoints and, when an Ethereum node is configured,
+// the worker that samples its fee history.
+func buildGasOracleComponents(cfg appConfig, pool *pgxpool.Pool, chains *blockchain.Registry, logger *slog.Logger) (*handlers.GasHandler, *workers.GasOracleWorker) {
+	if pool == nil {
+		return nil, nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	repo := postgres.NewGasPriceRepository(pool, logging.WithComponent(logger, "gas-repository"))
+	handler := handlers.NewGasHandler(handlers.GasHandlerConfig{
+		OracleUseCase:        gasusecase.NewGetGasOracleUseCase(repo, logging.WithComponent(logger, "gas-oracle")),
+		GetPreferenceUseCase: gasusecase.NewGetGasPreferenceUseCase(repo, logging.WithComponent(logger, "gas-preference")),
+		SetPreferenceUseCase: gasusecase.NewSetGasPreferenceUseCase(repo, logging.WithComponent(logger, "gas-preference")),
+		Logger:               logging.WithComponent(logger, "gas-handler"),
+	})
+
+	ethFees, err := blockchain.NewEVMFeeHistoryClient(blockchain.EVMFeeHistoryConfig{
+		Chain:  entities.ChainETH,
+		RPCURL: cfg.Blockchain.Ethereum.RPCURL,
+		RPC:    chains.RPCClient(entities.ChainETH),
+	}, logging.WithComponent(logger, "eth-fee-history"))
+	if err != nil {
+		logger.Warn("gas oracle sampling disabled", slog.String("error", err.Error()))
+		return handler, nil
+	}
+
+	syncUC := gasusecase.NewSyncGasPricesUseCase(
+		[]blockchain.FeeHistoryProvider{ethFees},
+		repo,
+		cfg.GasHistoryRetention,
+		logging.WithComponent(logger, "gas-sync"),
+	)
+	return handler, workers.NewGasOracleWorker(syncUC, logging.WithComponent(logger, "gas-oracle-worker"), cfg.GasOracleInterval)
+}
+
+func buildPublicMarketHandler(cfg appConfig, corePool, ratesPool *pgxpool.Pool, logger *slog.Logger) *handlers.PublicMarketHandler {
+	if !cfg.PublicAPIEnabled || (corePool == nil && ratesPool == nil) {
+		return nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	var ratesUC *ratesusecase.GetCurrentRatesUseCase
+	if ratesPool != nil {
+		rateRepo := postgres.NewRateRepository(ratesPool, logging.WithComponent(logger, "public-rate-repository"))
+		ratesUC = ratesusecase.NewGetCurrentRatesUseCase(rateRepo, logging.WithComponent(logger, "public-rates"))
+	}
+
+	var pairsUC *ratesusecase.GetTradingPairsUseCase
+	if corePool != nil {
+		pairRepo := postgres.NewTradingPairRepository(corePool, logging.WithComponent(logger, "public-pair-repository"))
+		pairsUC = ratesusecase.NewGetTradingPairsUseCase(pairRepo, logging.WithComponent(logger, "public-pairs"))
+	}
+
+	return handlers.NewPublicMarketHandler(handlers.PublicMarketHandlerConfig{
+		RatesUseCase:         ratesUC,
+		PairsUseCase:         pairsUC,
+		CacheTTL:             cfg.PublicAPICacheTTL,
+		RateLimitMaxRequests: cfg.PublicAPIRateLimit,
+		RateLimitWindow:      cfg.PublicAPIRateWindow,
+		Logger:               logging.WithComponent(logger, "public-market-handler"),
+	})
+}
+
+// buildLimitEnforcementService returns the KYC limit service, or nil when KYC_LIMIT_MODE is off,
+// invalid, or a database it reads from is not configured.
+func buildLimitEnforcementService(cfg appConfig, corePool, kycPool, ratesPool *pgxpool.Pool, logger *slog.Logger) *services.LimitEnforcementService {
+	if cfg.KYCLimitMode == "off" {
+		return nil
+	}
+	componentLogger := logging.WithComponent(logger, "kyc-limits")
+	mode, ok := services.ParseLimitMode(cfg.KYCLimitMode)
+	if !ok {
+		componentLogger.Error("invalid KYC_LIMIT_MODE; limit enforcement disabled", slog.String("mode", cfg.KYCLimitMode))
+		return nil
+	}
+	if corePool == nil || kycPool == nil || ratesPool == nil {
+		componentLogger.Warn("limit enforcement disabled: core, kyc and rates databases are required")
+		return nil
+	}
+
+	return services.NewLimitEnforcementService(services.LimitEnforcementServiceConfig{
+		Usage:   postgres.NewLimitUsageRepository(corePool, logging.WithComponent(logger, "limit-usage-repository")),
+		Wallets: postgres.NewWalletRepository(corePool, logging.WithComponent(logger, "limit-wallet-repository")),
+		KYC:     postgres.NewKYCRepository(kycPool, logging.WithComponent(logger, "limit-kyc-repository")),
+		Rates:   postgres.NewRateRepository(ratesPool, logging.WithComponent(logger, "limit-rate-repository")),
+		Mode:    mode,
+		Logger:  componentLogger,
+	})
+}
+
+func buildKYCComponents(cfg appConfig, pool, corePool *pgxpool.Pool, kycKey *masterKey, limitService *services.LimitEnforcementService, notifier messaging.EventNotifier, auditLogger *audit.Logger, logger *slog.Logger) (*handlers.KYCHandler, *handlers.KYCComplianceHandler, *handlers.KYCReviewHandler, *handlers.AMLReviewHandler, *handlers.KYCDocumentDownloadHandler, *httpmiddleware.KYCEnforcer, []backgroundWorker) {
+    if pool == nil {
+        return nil, nil, nil, nil, nil, nil, nil
+    }
+    if logger == nil {
+        logger = slog.Default()
+    }
+
+    componentLogger := logging.WithComponent(logger, "kyc")
+    if kycKey == nil {
+        componentLogger.Error("KYC disabled: no usable master key")
+        return nil, nil, nil, nil, nil, nil, nil
+    }
+
+    // Personal data is encrypted under per-user keys so account purges can crypto-shred it.
+    cipher := security.NewUserKeyCipher(kycKey.provider, postgres.NewUserDataKeyRepository(pool, logging.WithComponent(logger, "kyc-data-key-repository")), kycKey.legacy...)
+
+    var err error
+
+    repo := postgres.NewKYCRepository(pool, logging.WithComponent(logger, "kyc-repository"))
+
+    var provider external.KYCProviderClient
+    if strings.TrimSpace(cfg.KYCProvider.BaseURL) != "" && strings.TrimSpace(cfg.KYCProvider.APIKey) != "" {
         provider, err = external.NewKYCProviderClient(external.KYCProviderConfig{
             BaseURL: cfg.KYCProvider.BaseURL,
             APIKey:  cfg.KYCProvider.APIKey,
@@ -456,15 +2717,51 @@ func buildKYCComponents(cfg appConfig, pool *pgxpool.Pool, logger *slog.Logger)
         }
     }
 
-    submitUC := kycusecase.NewSubmitKYCUseCase(repo, encryptor, provider, logging.WithComponent(logger, "kyc-submit"))
-    uploadUC := kycusecase.NewUploadDocumentUseCase(repo, encryptor, provider, logging.WithComponent(logger, "kyc-upload"))
+    submitUC := kycusecase.NewSubmitKYCUseCase(repo, cipher, provider, logging.WithComponent(logger, "kyc-submit"))
+    documentStore, err := buildKYCDocumentStore(cfg)
+    if err != nil {
+        componentLogger.Error("KYC document storage disabled", slog.String("error", err.Error()))
+        documentStore = nil
+    }
+    scanner, err := buildKYCDocumentScanner(cfg, logger)
+    if err != nil {
+        componentLogger.Error("KYC document scanning disabled", slog.String("error", err.Error()))
+        scanner = nil
+    }
+    uploadUC := kycusecase.NewUploadDocumentUseCase(repo, cipher, documentStore, scanner, provider, auditLogger, logging.WithComponent(logger, "kyc-upload"))
     statusUC := kycusecase.NewGetKYCStatusUseCase(repo, logging.WithComponent(logger, "kyc-status"))
 
+    startUpgradeUC := kycusecase.NewStartUpgradeUseCase(repo, auditLogger, logging.WithComponent(logger, "kyc-upgrade"))
+    upgradeStatusUC := kycusecase.NewGetUpgradeStatusUseCase(repo, logging.WithComponent(logger, "kyc-upgrade"))
+    submitUpgradeUC := kycusecase.NewSubmitUpgradeUseCase(repo, cipher, provider, auditLogger, logging.WithComponent(logger, "kyc-upgrade"))
+    requirementsUC := kycusecase.NewGetKYCRequirementsUseCase(repo, cipher, logging.WithComponent(logger, "kyc-requirements"))
+    documentTypesUC := kycusecase.NewListDocumentTypesUseCase(repo, logging.WithComponent(logger, "kyc-document-types"))
+
+    var limitsUC *kycusecase.GetLimitHeadroomUseCase
+    if limitService != nil {
+        limitsUC = kycusecase.NewGetLimitHeadroomUseCase(limitService, logging.WithComponent(logger, "kyc-limits"))
+    }
+
     handler := handlers.NewKYCHandler(handlers.KYCHandlerConfig{
-        SubmitUseCase: submitUC,
-        UploadUseCase: uploadUC,
-        StatusUseCase: statusUC,
-        Logger:        logging.WithComponent(logger, "kyc-handler"),
+        SubmitUseCase:        submitUC,
+        UploadUseCase:        uploadUC,
+        StatusUseCase:        statusUC,
+        StartUpgradeUseCase:  startUpgradeUC,
+        UpgradeStatusUseCase: upgradeStatusUC,
+        SubmitUpgradeUseCase: submitUpgradeUC,
+        RequirementsUseCase:  requirementsUC,
+        DocumentTypesUseCase: documentTypesUC,
+        LimitsUseCase:        limitsUC,
+        Logger:               logging.WithComponent(logger, "kyc-handler"),
+    })
+
+    complianceHandler := handlers.NewKYCComplianceHandler(handlers.KYCComplianceHandlerConfig{
+        ListUseCase:     kycusecase.NewListCountryRequirementsUseCase(repo, logging.WithComponent(logger, "kyc-compliance")),
+        UpsertUseCase:   kycusecase.NewUpsertCountryRequirementUseCase(repo, auditLogger, logging.WithComponent(logger, "kyc-compliance")),
+        DeleteUseCase:   kycusecase.NewDeleteCountryRequirementUseCase(repo, auditLogger, logging.WithComponent(logger, "kyc-compliance")),
+        SnapshotUseCase: kycusecase.NewGetLimitSnapshotUseCase(repo, logging.WithComponent(logger, "kyc-limit-history")),
+        HistoryUseCase:  kycusecase.NewListLimitHistoryUseCase(repo, logging.WithComponent(logger, "kyc-limit-history")),
+        Logger:          logging.WithComponent(logger, "kyc-compliance-handler"),
     })
 
     enforcer := httpmiddleware.NewKYCEnforcer(httpmiddleware.KYCEnforcerConfig{
@@ -472,7 +2769,249 @@ func buildKYCComponents(cfg appConfig, pool *pgxpool.Pool, logger *slog.Logger)
         Logger:     logging.WithComponent(logger, "kyc-enforcer"),
     })
 
-    return handler, enforcer
+    var kycNotifier kycusecase.Notifier
+    if notifier != nil {
+        kycNotifier = notifier
+    }
+
+    // Stored documents are only readable through short-lived signed links issued to reviewers.
+    var (
+        documentLinkUC  *kycusecase.CreateDocumentDownloadLinkUseCase
+        downloadHandler *handlers.KYCDocumentDownloadHandler
+    )
+    if documentStore != nil {
+        signer, err := kycusecase.NewDocumentLinkSigner(cfg.KYCDocuments.LinkSecret, cfg.KYCDocuments.LinkTTL)
+        if err != nil {
+            componentLogger.Warn("KYC_DOCUMENT_LINK_SECRET not configured; document downloads disabled")
+        } else {
+            documentLinkUC = kycusecase.NewCreateDocumentDownloadLinkUseCase(repo, cipher, signer, auditLogger, cfg.KYCDocuments.DownloadURL, logging.WithComponent(logger, "kyc-document-link"))
+            downloadHandler = handlers.NewKYCDocumentDownloadHandler(handlers.KYCDocumentDownloadHandlerConfig{
+                UseCase: kycusecase.NewDownloadDocumentUseCase(repo, cipher, documentStore, signer, auditLogger, logging.WithComponent(logger, "kyc-document-download")),
+                Logger:  logging.WithComponent(logger, "kyc-document-download-handler"),
+            })
+        }
+    }
+
+    reviewHandler := handlers.NewKYCReviewHandler(handlers.KYCReviewHandlerConfig{
+        ListUseCase:         kycusecase.NewListProfilesForReviewUseCase(repo, logging.WithComponent(logger, "kyc-review")),
+        GetUseCase:          kycusecase.NewGetProfileForReviewUseCase(repo, cipher, auditLogger, logging.WithComponent(logger, "kyc-review")),
+        ApproveUseCase:      kycusecase.NewApproveProfileUseCase(repo, cipher, auditLogger, kycNotifier, logging.WithComponent(logger, "kyc-review")),
+        RejectUseCase:       kycusecase.NewRejectProfileUseCase(repo, auditLogger, kycNotifier, logging.WithComponent(logger, "kyc-review")),
+        UpgradeUseCase:      kycusecase.NewUpgradeProfileLimitsUseCase(repo, cipher, auditLogger, kycNotifier, logging.WithComponent(logger, "kyc-review")),
+        DocumentLinkUseCase: documentLinkUC,
+        Logger:              logging.WithComponent(logger, "kyc-review-handler"),
+    })
+    amlReviewHandler := handlers.NewAMLReviewHandler(handlers.AMLReviewHandlerConfig{
+        ListUseCase:     kycusecase.NewListComplianceReviewsUseCase(repo, logging.WithComponent(logger, "aml-review")),
+        GetUseCase:      kycusecase.NewGetComplianceReviewUseCase(repo),
+        ResolveUseCase:  kycusecase.NewResolveComplianceReviewUseCase(repo, auditLogger, logging.WithComponent(logger, "aml-review")),
+        UserRiskUseCase: kycusecase.NewGetAMLUserRiskUseCase(repo),
+        Logger:          logging.WithComponent(logger, "aml-review-handler"),
+    })
+    expireUC := kycusecase.NewExpireApprovalsUseCase(repo, kycNotifier, auditLogger, logging.WithComponent(logger, "kyc-expire"))
+    backgroundWorkers := []backgroundWorker{
+        workers.NewKYCExpirationWorker(expireUC, logging.WithComponent(logger, "kyc-expiration"), cfg.KYCExpiryInterval),
+    }
+
+    // Upgrades submitted without a provider wait for manual review, so there is nothing to poll.
+    if provider != nil {
+        processUC := kycusecase.NewProcessUpgradeDecisionsUseCase(repo, cipher, provider, kycNotifier, auditLogger, logging.WithComponent(logger, "kyc-upgrade-review"))
+        backgroundWorkers = append(backgroundWorkers, workers.NewKYCUpgradeReviewWorker(processUC, logging.WithComponent(logger, "kyc-upgrade-review"), cfg.KYCUpgradeInterval))
+    }
+
+    if strings.TrimSpace(cfg.AMLProvider.BaseURL) != "" && strings.TrimSpace(cfg.AMLProvider.APIKey) != "" {
+        screening, err := external.NewAMLScreeningClient(external.AMLProviderConfig{
+            BaseURL: cfg.AMLProvider.BaseURL,
+            APIKey:  cfg.AMLProvider.APIKey,
+            Logger:  logging.WithComponent(logger, "aml-provider"),
+        })
+        if err != nil {
+            componentLogger.Warn("failed to initialise AML screening client", slog.String("error", err.Error()))
+        } else {
+            // Wallets live in the core database; without it escalations are queued but nothing is frozen.
+            var wallets kycusecase.WalletStore
+            if corePool != nil {
+                wallets = postgres.NewWalletRepository(corePool, logging.WithComponent(logger, "aml-wallet-repository"))
+            }
+            policy := kycusecase.AMLEscalationPolicy{
+                FreezeAtLevel:     entities.RiskLevel(cfg.AMLProvider.FreezeAtLevel),
+                FreezeOnSanctions: cfg.AMLProvider.FreezeOnSanctions,
+            }
+            rescreenUC := kycusecase.NewRescreenRiskScoresUseCase(repo, cipher, screening, wallets, policy, auditLogger, logging.WithComponent(logger, "aml-rescreen"))
+            backgroundWorkers = append(backgroundWorkers, workers.NewAMLRescreeningWorker(rescreenUC, logging.WithComponent(logger, "aml-rescreen"), cfg.AMLRescreenInterval))
+        }
+    }
+
+    return handler, complianceHandler, reviewHandler, amlReviewHandler, downloadHandler, enforcer, backgroundWorkers
+}
+
+// buildKYCDocumentScanner returns the malware scanner for KYC uploads, or nil when
+// KYC_DOCUMENT_SCANNER is not set and uploads are stored unscanned.
+func buildKYCDocumentScanner(cfg appConfig, logger *slog.Logger) (external.MalwareScanner, error) {
+    switch cfg.KYCScanner.Backend {
+    case "":
+        return nil, nil
+    case "clamav":
+        return external.NewClamAVScanner(external.ClamAVConfig{
+            Address: cfg.KYCScanner.ClamAVAddress,
+            Timeout: cfg.KYCScanner.Timeout,
+        })
+    case "http":
+        return external.NewHTTPMalwareScanner(external.HTTPMalwareScannerConfig{
+            URL:     cfg.KYCScanner.URL,
+            APIKey:  cfg.KYCScanner.APIKey,
+            Timeout: cfg.KYCScanner.Timeout,
+            Logger:  logging.WithComponent(logger, "kyc-scanner"),
+        })
+    default:
+        return nil, fmt.Errorf("unsupported KYC_DOCUMENT_SCANNER %q", cfg.KYCScanner.Backend)
+    }
+}
+
+// buildKYCDocumentStore returns the store for KYC document content, or nil when KYC_DOCUMENT_STORAGE
+// is not set and only document hashes are recorded.
+func buildKYCDocumentStore(cfg appConfig) (kycusecase.DocumentStore, error) {
+    switch cfg.KYCDocuments.Backend {
+    case "":
+        return nil, nil
+    case "s3":
+        return objectstore.NewS3Store(objectstore.S3Config{
+            Bucket:          cfg.KYCDocuments.S3Bucket,
+            Region:          cfg.KYCDocuments.S3Region,
+            Endpoint:        cfg.KYCDocuments.S3Endpoint,
+            AccessKeyID:     cfg.KYCDocuments.S3AccessKeyID,
+            SecretAccessKey: cfg.KYCDocuments.S3SecretKey,
+            SessionToken:    cfg.KYCDocuments.S3SessionToken,
+        })
+    case "gcs":
+        return objectstore.NewGCSStore(objectstore.GCSConfig{
+            Bucket:      cfg.KYCDocuments.GCSBucket,
+            AccessToken: cfg.KYCDocuments.GCSAccessToken,
+            Endpoint:    cfg.KYCDocuments.GCSEndpoint,
+        })
+    case "local":
+        return objectstore.NewLocalStore(cfg.KYCDocuments.Dir)
+    default:
+        return nil, fmt.Errorf("unsupported KYC_DOCUMENT_STORAGE %q", cfg.KYCDocuments.Backend)
+    }
+}
+
+// masterKey is a component's master key and the env keys it may still unwrap with.
+type masterKey struct {
+	provider security.KeyProvider
+	legacy   []security.KeyWrapper
+}
+
+// remoteMasterKeys reports whether master keys live in a KMS or HSM rather than the environment.
+func remoteMasterKeys(cfg appConfig) bool {
+	return cfg.MasterKeys.Backend != "" && cfg.MasterKeys.Backend != security.KeyProviderLocal
+}
+
+func buildWalletMasterKey(cfg appConfig, logger *slog.Logger) *masterKey {
+	componentLogger := logging.WithComponent(logger, "wallet-master-key")
+
+	var (
+		localKey []byte
+		err      error
+	)
+	if remoteMasterKeys(cfg) {
+		// The env key is optional here; it is kept to read data wrapped before the move.
+		if strings.TrimSpace(cfg.WalletEncryptionKey) != "" {
+			localKey, err = resolveStrictEncryptionKey("WALLET_ENCRYPTION_KEY", cfg.WalletEncryptionKey, componentLogger)
+		}
+	} else {
+		localKey, err = resolveEncryptionKey(cfg.WalletEncryptionKey, componentLogger)
+	}
+	if err != nil {
+		componentLogger.Error("failed to resolve wallet encryption key", slog.String("error", err.Error()))
+		return nil
+	}
+
+	key, err := openMasterKey(cfg, cfg.WalletKeyID, localKey, componentLogger)
+	if err != nil {
+		componentLogger.Error("wallets disabled: master key unavailable", slog.String("error", err.Error()))
+		return nil
+	}
+
+	if strings.TrimSpace(cfg.WalletPreviousKey) != "" {
+		previousKey, err := resolveStrictEncryptionKey("WALLET_ENCRYPTION_KEY_PREVIOUS", cfg.WalletPreviousKey, componentLogger)
+		if err != nil {
+			componentLogger.Error("failed to resolve previous wallet encryption key", slog.String("error", err.Error()))
+			return nil
+		}
+		previous, err := security.NewAESGCMEncryptor(security.AESGCMConfig{Key: previousKey})
+		if err != nil {
+			componentLogger.Error("failed to initialise previous wallet encryptor", slog.String("error", err.Error()))
+			return nil
+		}
+		key.legacy = append(key.legacy, previous)
+	}
+	return key
+}
+
+func buildKYCMasterKey(cfg appConfig, logger *slog.Logger) *masterKey {
+	componentLogger := logging.WithComponent(logger, "kyc-master-key")
+
+	var (
+		localKey []byte
+		err      error
+	)
+	if !remoteMasterKeys(cfg) || strings.TrimSpace(cfg.KYCEncryptionKey) != "" {
+		localKey, err = resolveStrictEncryptionKey("KYC_ENCRYPTION_KEY", cfg.KYCEncryptionKey, componentLogger)
+		if err != nil {
+			componentLogger.Error("failed to resolve KYC encryption key", slog.String("error", err.Error()))
+			return nil
+		}
+	}
+
+	key, err := openMasterKey(cfg, cfg.KYCKeyID, localKey, componentLogger)
+	if err != nil {
+		componentLogger.Error("KYC disabled: master key unavailable", slog.String("error", err.Error()))
+		return nil
+	}
+	return key
+}
+
+// openMasterKey opens the configured provider for keyID. When it is remote, localKey is kept as a
+// legacy unwrap-only key for data wrapped before the move, and MASTER_KEY_FALLBACK lets it stand
+// in for a provider that fails its startup check.
+func openMasterKey(cfg appConfig, keyID string, localKey []byte, logger *slog.Logger) (*masterKey, error) {
+	providerCfg := cfg.MasterKeys
+	providerCfg.KeyID = keyID
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	provider, err := security.OpenKeyProvider(ctx, providerCfg, localKey, cfg.MasterKeyFallback, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &masterKey{provider: provider}
+	if provider.Name() != security.KeyProviderLocal && len(localKey) > 0 {
+		legacy, err := security.NewAESGCMEncryptor(security.AESGCMConfig{Key: localKey})
+		if err != nil {
+			return nil, err
+		}
+		key.legacy = append(key.legacy, legacy)
+	}
+	logger.Info("master key ready", slog.String("provider", provider.Name()))
+	return key, nil
+}
+
+// buildKeyProviderHealthWorker watches the remote master keys in use; local keys need no checks.
+func buildKeyProviderHealthWorker(cfg appConfig, logger *slog.Logger, walletKey, kycKey *masterKey) *workers.KeyProviderHealthWorker {
+	providers := make(map[string]workers.KeyHealthChecker)
+	if walletKey != nil && walletKey.provider.Name() != security.KeyProviderLocal {
+		providers["wallet"] = walletKey.provider
+	}
+	if kycKey != nil && kycKey.provider.Name() != security.KeyProviderLocal {
+		providers["kyc"] = kycKey.provider
+	}
+	if len(providers) == 0 {
+		return nil
+	}
+	return workers.NewKeyProviderHealthWorker(providers, logging.WithComponent(logger, "key-provider-health-worker"), cfg.MasterKeyHealthInterval)
 }
 
 func resolveEncryptionKey(encoded string, logger *slog.Logger) ([]byte, error) {
@@ -500,23 +3039,23 @@ func resolveEncryptionKey(encoded string, logger *slog.Logger) ([]byte, error) {
 	return key, nil
 }
 
-func resolveStrictEncryptionKey(encoded string, logger *slog.Logger) ([]byte, error) {
+func resolveStrictEncryptionKey(name, encoded string, logger *slog.Logger) ([]byte, error) {
 	if logger == nil {
 		logger = slog.Default()
 	}
 
 	trimmed := strings.TrimSpace(encoded)
 	if trimmed == "" {
-		return nil, errors.New("KYC_ENCRYPTION_KEY must be configured")
+		return nil, fmt.Errorf("%s must be configured", name)
 	}
 
 	key, err := base64.StdEncoding.DecodeString(trimmed)
 	if err != nil {
-		return nil, fmt.Errorf("decode kyc encryption key: %w", err)
+		return nil, fmt.Errorf("decode %s: %w", name, err)
 	}
 
 	if len(key) != security.AES256KeySize {
-		return nil, fmt.Errorf("kyc encryption key must be %d bytes", security.AES256KeySize)
+		return nil, fmt.Errorf("%s must be %d bytes", name, security.AES256KeySize)
 	}
 
 	return key, nil
@@ -566,6 +3105,97 @@ func getEnvAsDuration(key string, fallback time.Duration) time.Duration {
 	return duration
 }
 
+func getEnvAsFloat(key string, fallback float64) float64 {
+	value := strings.TrimSpace(os.Getenv(key))
+	if value == "" {
+		return fallback
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fallback
+	}
+	return parsed
+}
+
+// parseUUIDList parses a comma-separated list of UUIDs, skipping malformed entries.
+func parseUUIDList(value string) []uuid.UUID {
+	ids := make([]uuid.UUID, 0)
+	for _, part := range splitAndTrim(value) {
+		if id, err := uuid.Parse(part); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// parseKeyValueList parses "a=b,c=d" into a map, skipping malformed entries.
+func parseKeyValueList(value string) map[string]string {
+	result := make(map[string]string)
+	for _, part := range splitAndTrim(value) {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok || strings.TrimSpace(key) == "" || strings.TrimSpace(val) == "" {
+			continue
+		}
+		result[strings.TrimSpace(key)] = strings.TrimSpace(val)
+	}
+	return result
+}
+
+// parsePriceHistoryRetention parses per-interval retention such as "1m=168h,5m=720h,1d=0" over
+// the defaults, skipping malformed entries; a zero duration keeps the interval indefinitely. A single
+// duration applies to every interval.
+func parsePriceHistoryRetention(value string) map[entities.IntervalType]time.Duration {
+	retention := entities.DefaultPriceHistoryRetention()
+	if value == "" {
+		return retention
+	}
+	if duration, err := time.ParseDuration(value); err == nil {
+		for _, interval := range entities.SupportedIntervals() {
+			retention[interval] = duration
+		}
+		return retention
+	}
+	for key, raw := range parseKeyValueList(value) {
+		interval := entities.IntervalType(strings.ToLower(key))
+		duration, err := time.ParseDuration(raw)
+		if !entities.IsValidInterval(interval) || err != nil || duration < 0 {
+			continue
+		}
+		retention[interval] = duration
+	}
+	return retention
+}
+
+// parseDurationList parses a comma-separated list of durations, skipping malformed entries. The
+// fallback is used when the value is empty or nothing parses.
+func parseDurationList(value string, fallback []time.Duration) []time.Duration {
+	durations := make([]time.Duration, 0)
+	for _, part := range splitAndTrim(value) {
+		if duration, err := time.ParseDuration(part); err == nil {
+			durations = append(durations, duration)
+		}
+	}
+	if len(durations) == 0 {
+		return fallback
+	}
+	return durations
+}
+
+// buildExecutionBudgets applies the configured overrides to the default use case budgets. Unknown
+// names and malformed durations are ignored; "0" leaves a use case unbounded.
+func buildExecutionBudgets(cfg appConfig, metrics *monitoring.BudgetMetrics, logger *slog.Logger) *budget.Budgets {
+	overrides := make(map[string]time.Duration, len(cfg.ExecutionBudgets))
+	for name, value := range cfg.ExecutionBudgets {
+		limit, err := time.ParseDuration(value)
+		if _, known := budget.Defaults[name]; !known || err != nil {
+			logger.Warn("ignoring invalid execution budget", slog.String("name", name), slog.String("value", value))
+			continue
+		}
+		overrides[name] = limit
+	}
+	return budget.New(overrides, metrics)
+}
+
 func splitAndTrim(value string) []string {
 	parts := strings.Split(value, ",")
 	result := make([]string, 0, len(parts))
@@ -576,3 +3206,358 @@ func splitAndTrim(value string) []string {
 	}
 	return result
 }
+
+// buildDormancyPolicy returns the configured dormancy policy, falling back to the defaults when the
+// threshold is invalid.
+func buildDormancyPolicy(cfg appConfig, logger *slog.Logger) entities.DormancyPolicy {
+	policy, err := entities.NewDormancyPolicy(cfg.DormancyAfter, cfg.DormancyReminders)
+	if err != nil {
+		logger.Warn("invalid WALLET_DORMANCY_AFTER; using default", slog.String("error", err.Error()))
+		policy, _ = entities.NewDormancyPolicy(entities.DefaultDormancyInactiveAfter, cfg.DormancyReminders)
+	}
+	return policy
+}
+
+// buildWalletDormancyWorker wires the worker that reminds owners of inactive wallets and marks
+// them dormant. It returns nil when dormancy is disabled.
+func buildWalletDormancyWorker(cfg appConfig, pool *pgxpool.Pool, notifier messaging.EventNotifier, auditLogger *audit.Logger, logger *slog.Logger) *workers.WalletDormancyWorker {
+	if pool == nil || !cfg.DormancyEnabled {
+		return nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	var walletNotifier wallet.Notifier
+	if notifier != nil {
+		walletNotifier = notifier
+	}
+
+	componentLogger := logging.WithComponent(logger, "wallet-dormancy")
+	dormancyUC := wallet.NewApplyDormancyPolicyUseCase(
+		postgres.NewWalletDormancyRepository(pool, logging.WithComponent(logger, "wallet-dormancy-repository")),
+		buildDormancyPolicy(cfg, componentLogger),
+		walletNotifier,
+		auditLogger,
+		componentLogger,
+	)
+	return workers.NewWalletDormancyWorker(dormancyUC, componentLogger, cfg.DormancyInterval)
+}
+
+// buildActivityHandler wires the merged transaction and exchange feed used by the dashboard.
+func buildActivityHandler(pool *pgxpool.Pool, logger *slog.Logger) *handlers.ActivityHandler {
+	if pool == nil {
+		return nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return handlers.NewActivityHandler(handlers.ActivityHandlerConfig{
+		RecentActivityUseCase: activityusecase.NewGetRecentActivityUseCase(
+			postgres.NewActivityRepository(pool, logging.WithComponent(logger, "activity-repository")),
+			logging.WithComponent(logger, "activity-recent"),
+		),
+		Logger: logging.WithComponent(logger, "activity-handler"),
+	})
+}
+
+// buildGraphQLHandler serves the GraphQL endpoint. It needs the core and rates databases; the
+// portfolio fields additionally use the currency converter when one is configured.
+func buildGraphQLHandler(cfg appConfig, corePool, ratesPool *pgxpool.Pool, currencyConverter *services.CurrencyConverter, logger *slog.Logger) *handlers.GraphQLHandler {
+	if corePool == nil || ratesPool == nil {
+		return nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	walletRepo := cachedWalletRepository(cfg, postgres.NewWalletRepository(corePool, logging.WithComponent(logger, "graphql-wallet-repository")), logger)
+	rateRepo := cachedRateRepository(cfg, postgres.NewRateRepository(ratesPool, logging.WithComponent(logger, "graphql-rate-repository")), logger)
+	userRepo := postgres.NewPostgresUserRepository(corePool)
+	schema, err := graphqlapi.NewSchema(graphqlapi.SchemaConfig{
+		Users:         userRepo,
+		Wallets:       walletRepo,
+		Transactions:  postgres.NewPostgresTransactionRepository(corePool),
+		Rates:         rateRepo,
+		Portfolio:     analyticsusecase.NewPortfolioSummaryUseCase(walletRepo, rateRepo, userRepo, currencyConverter, logging.WithComponent(logger, "graphql-portfolio-summary")),
+		Introspection: cfg.Environment != "production",
+		Logger:        logging.WithComponent(logger, "graphql"),
+	})
+	if err != nil {
+		logger.Error("failed to build graphql schema; graphql endpoint disabled", slog.String("error", err.Error()))
+		return nil
+	}
+
+	return handlers.NewGraphQLHandler(handlers.GraphQLHandlerConfig{
+		Schema: schema,
+		Logger: logging.WithComponent(logger, "graphql-handler"),
+	})
+}
+
+// buildScheduler runs the maintenance jobs: quote expiry every minute, the daily volume reset at UTC
+// midnight, daily portfolio values, price history downsampling and export cleanup. Instances elect a leader through a Postgres
+// advisory lock, so each job runs once however many instances are up.
+func buildScheduler(cfg appConfig, corePool, ratesPool *pgxpool.Pool, logger *slog.Logger) *scheduler.Scheduler {
+	if !cfg.SchedulerEnabled || corePool == nil {
+		return nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	walletRepo := postgres.NewWalletRepository(corePool, logging.WithComponent(logger, "wallet-repository"))
+	exchangeService := services.NewExchangeService(
+		postgres.NewExchangeOperationRepository(corePool, logging.WithComponent(logger, "exchange-repository")),
+		postgres.NewTradingPairRepository(corePool, logging.WithComponent(logger, "trading-pair-repository")),
+		walletRepo,
+		postgres.NewAssetRepository(corePool, logging.WithComponent(logger, "asset-repository")),
+		services.PricingStrategies{},
+		postgres.NewUnitOfWork(corePool),
+		postgres.NewTradingPairLiquidityRepository(corePool, logging.WithComponent(logger, "trading-pair-liquidity-repository")),
+	)
+	quoteExpiry := workers.NewQuoteExpirationWorker(exchangeService, nil, logging.WithComponent(logger, "quote-expiration"), time.Minute, 0)
+	exportRepo := postgres.NewExportJobRepository(corePool, logging.WithComponent(logger, "export-repository"))
+
+	jobs := []scheduler.Job{
+		{Name: "exchange.expire_quotes", Schedule: scheduler.Every(time.Minute), Timeout: time.Minute, Run: quoteExpiry.ExpireQuotes},
+		{Name: "exchange.reset_daily_volumes", Schedule: scheduler.DailyAt(0, 0), Run: exchangeService.ResetDailyVolumes},
+		{Name: "export.cleanup", Schedule: scheduler.Every(time.Hour), Run: func(ctx context.Context) error {
+			return cleanupExports(ctx, exportRepo, cfg.ExportHistoryRetention, logger)
+		}},
+	}
+	if ratesPool != nil {
+		downsample := ratesusecase.NewDownsamplePriceHistoryUseCase(
+			postgres.NewRateRepository(ratesPool, logging.WithComponent(logger, "rate-repository")),
+			cfg.PriceHistoryRetention,
+			logging.WithComponent(logger, "price-history-downsample"),
+		)
+		jobs = append(jobs, scheduler.Job{Name: "rates.downsample_price_history", Schedule: scheduler.DailyAt(3, 0), Timeout: time.Hour, Run: func(ctx context.Context) error {
+			_, err := downsample.Execute(ctx)
+			return err
+		}})
+
+		portfolioValues := analyticsusecase.NewRecordPortfolioValuesUseCase(
+			walletRepo,
+			postgres.NewRateRepository(ratesPool, logging.WithComponent(logger, "rate-repository")),
+			postgres.NewPortfolioValueRepository(corePool, logging.WithComponent(logger, "portfolio-value-repository")),
+			logging.WithComponent(logger, "portfolio-values"),
+		)
+		jobs = append(jobs, scheduler.Job{Name: "analytics.record_portfolio_values", Schedule: scheduler.DailyAt(0, 5), Timeout: time.Hour, Run: func(ctx context.Context) error {
+			_, err := portfolioValues.Execute(ctx)
+			return err
+		}})
+	}
+
+	return scheduler.New(scheduler.Config{
+		Jobs:   jobs,
+		Leader: scheduler.NewPostgresLeaderLock(corePool, "scheduler:leader"),
+		Logger: logging.WithComponent(logger, "scheduler"),
+	})
+}
+
+// exportCleanupBatch bounds how many finished export jobs one delete removes.
+const exportCleanupBatch = 1000
+
+// cleanupExports drops expired export files and deletes finished jobs older than the retention.
+func cleanupExports(ctx context.Context, exports *postgres.ExportJobRepository, retention time.Duration, logger *slog.Logger) error {
+	now := time.Now().UTC()
+	purged, err := exports.PurgeExpired(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	var deleted int64
+	for {
+		ids, err := exports.ListFinishedBefore(ctx, now.Add(-retention), exportCleanupBatch)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			break
+		}
+		count, err := exports.DeleteFinished(ctx, ids)
+		if err != nil {
+			return err
+		}
+		deleted += count
+		if len(ids) < exportCleanupBatch || count == 0 {
+			break
+		}
+	}
+
+	if purged > 0 || deleted > 0 {
+		logger.Info("cleaned up exports", slog.Int64("files_purged", purged), slog.Int64("jobs_deleted", deleted))
+	}
+	return nil
+}
+
+// buildExchangeComponents serves exchange execution. With Redis configured, executions are queued
+// for the worker, retried on failure and dead-lettered after EXCHANGE_JOB_MAX_ATTEMPTS; without it
+// they run in the request. Settled executions are announced through the event notifier, which
+// also feeds WebSocket clients.
+func buildExchangeComponents(cfg appConfig, corePool *pgxpool.Pool, walletService *services.WalletService, notifier events.Publisher, auditLogger *audit.Logger, limitService *services.LimitEnforcementService, logger *slog.Logger) (*handlers.ExchangeExecutionHandler, *workers.ExchangeExecutionWorker) {
+	if corePool == nil || walletService == nil {
+		return nil, nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	walletRepo := postgres.NewWalletRepository(corePool, logging.WithComponent(logger, "wallet-repository"))
+	exchangeService := services.NewExchangeService(
+		postgres.NewExchangeOperationRepository(corePool, logging.WithComponent(logger, "exchange-repository")),
+		postgres.NewTradingPairRepository(corePool, logging.WithComponent(logger, "trading-pair-repository")),
+		cachedWalletRepository(cfg, walletRepo, logger),
+		postgres.NewAssetRepository(corePool, logging.WithComponent(logger, "asset-repository")),
+		services.PricingStrategies{},
+		postgres.NewUnitOfWork(corePool),
+		postgres.NewTradingPairLiquidityRepository(corePool, logging.WithComponent(logger, "trading-pair-liquidity-repository")),
+	)
+
+	// Optional dependencies stay untyped nils so the use case can tell they are missing.
+	var (
+		eventNotifier exchangeusecase.Notifier
+		limits        exchangeusecase.LimitPolicy
+		queue         *jobqueue.RedisStreamQueue
+		executions    exchangeusecase.ExecutionQueue
+	)
+	if notifier != nil {
+		eventNotifier = notifier
+	}
+	if limitService != nil {
+		limits = limitService
+	}
+	if strings.TrimSpace(cfg.RedisAddr) != "" {
+		q, err := jobqueue.NewRedisStreamQueue(jobqueue.RedisStreamQueueConfig{
+			Client:      newRedisClient(cfg),
+			Stream:      "exchange:executions",
+			Group:       "exchange-workers",
+			MaxAttempts: cfg.ExchangeJobAttempts,
+			RetryDelay:  cfg.ExchangeJobRetryDelay,
+			Logger:      logging.WithComponent(logger, "exchange-queue"),
+		})
+		if err != nil {
+			logger.Error("failed to build exchange queue; exchanges execute in the request", slog.String("error", err.Error()))
+		} else {
+			queue, executions = q, q
+		}
+	} else {
+		logger.Warn("REDIS_ADDR not configured; exchanges execute in the request")
+	}
+
+	quotes, err := exchangeusecase.NewQuoteSigner(cfg.ExchangeQuoteSecret)
+	if err != nil {
+		logger.Warn("EXCHANGE_QUOTE_SECRET not configured; exchange quotes are stored when issued and carry no quote token")
+	}
+
+	swaps := exchangeusecase.NewSwapTokens(exchangeService, walletService, auditLogger, eventNotifier, limits, executions, quotes, logging.WithComponent(logger, "exchange-swap"))
+	handler := handlers.NewExchangeExecutionHandler(handlers.ExchangeExecutionHandlerConfig{
+		SwapTokens: swaps,
+		Logger:     logging.WithComponent(logger, "exchange-handler"),
+	})
+	if queue == nil {
+		return handler, nil
+	}
+	return handler, workers.NewExchangeExecutionWorker(queue, swaps, logging.WithComponent(logger, "exchange-execution-worker"))
+}
+
+// buildInternalApp assembles the server-to-server API served over mutual TLS. The listener only
+// accepts client certificates signed by the configured CA; registered consumers are then matched
+// by certificate fingerprint.
+func buildInternalApp(cfg appConfig, corePool *pgxpool.Pool, logger *slog.Logger) *fiber.App {
+	if cfg.InternalAPI.Addr == "" {
+		return nil
+	}
+	if cfg.InternalAPI.CertFile == "" || cfg.InternalAPI.KeyFile == "" || cfg.InternalAPI.ClientCAFile == "" {
+		logger.Warn("internal API requires INTERNAL_API_TLS_CERT_FILE, INTERNAL_API_TLS_KEY_FILE and INTERNAL_API_CLIENT_CA_FILE; internal API disabled")
+		return nil
+	}
+	if corePool == nil {
+		logger.Warn("core database unavailable; internal API disabled")
+		return nil
+	}
+
+	outbox := postgres.NewEventOutboxRepository(corePool, logging.WithComponent(logger, "event-outbox-repository"))
+	handler := handlers.NewInternalEventHandler(handlers.InternalEventHandlerConfig{
+		StreamUseCase: internaleventsusecase.NewStreamEventsUseCase(outbox, logging.WithComponent(logger, "internal-event-stream")),
+		OffsetUseCase: internaleventsusecase.NewConsumerOffsetUseCase(outbox, logging.WithComponent(logger, "internal-event-offset")),
+		Logger:        logging.WithComponent(logger, "internal-event-handler"),
+	})
+	consumerAuth := httpmiddleware.NewInternalConsumerAuthMiddleware(httpmiddleware.InternalConsumerAuthConfig{
+		Consumers: postgres.NewInternalEventConsumerRepository(corePool, logging.WithComponent(logger, "internal-consumer-repository")),
+		Logger:    logging.WithComponent(logger, "internal-consumer-auth"),
+	})
+
+	app := fiber.New(fiber.Config{
+		AppName:      "crypto-wallet-internal",
+		ReadTimeout:  30 * time.Second,
+		WriteTimeout: 60 * time.Second,
+		ErrorHandler: func(c *fiber.Ctx, err error) error {
+			resp, status := utils.ToErrorResponse(err)
+			return c.Status(status).JSON(resp)
+		},
+	})
+	if cfg.Tracing.Enabled {
+		app.Use(httpmiddleware.NewTracingMiddleware())
+	}
+	app.Use(httpmiddleware.NewRequestContextMiddleware(logging.WithComponent(logger, "internal-request")))
+
+	httproutes.RegisterInternalRoutes(app, httproutes.InternalRouteOptions{
+		Logger:               logging.WithComponent(logger, "internal-routes"),
+		ConsumerAuth:         consumerAuth,
+		InternalEventHandler: handler,
+	})
+	return app
+}
+
+// buildInternalGRPCServer assembles the internal gRPC API. It shares the internal REST API's mutual
+// TLS certificates and consumer registry, and serves the same use cases as the REST handlers.
+func buildInternalGRPCServer(cfg appConfig, corePool, ratesPool *pgxpool.Pool, walletService *services.WalletService, logger *slog.Logger) *grpc.Server {
+	if cfg.InternalAPI.GRPCAddr == "" {
+		return nil
+	}
+	if corePool == nil {
+		logger.Warn("core database unavailable; internal gRPC API disabled")
+		return nil
+	}
+
+	wallets := grpcapi.WalletServerConfig{Logger: logging.WithComponent(logger, "grpc-wallet-server")}
+	if walletService != nil {
+		wallets.ListWalletsUseCase = wallet.NewListWalletsUseCase(walletService, logging.WithComponent(logger, "grpc-wallet-list"))
+		wallets.BalanceUseCase = wallet.NewGetWalletBalanceUseCase(walletService, logging.WithComponent(logger, "grpc-wallet-balance"))
+
+		walletRepo := postgres.NewWalletRepository(corePool, logging.WithComponent(logger, "wallet-repository"))
+		exchangeService := services.NewExchangeService(
+			postgres.NewExchangeOperationRepository(corePool, logging.WithComponent(logger, "exchange-repository")),
+			postgres.NewTradingPairRepository(corePool, logging.WithComponent(logger, "trading-pair-repository")),
+			cachedWalletRepository(cfg, walletRepo, logger),
+			postgres.NewAssetRepository(corePool, logging.WithComponent(logger, "asset-repository")),
+			services.PricingStrategies{},
+			postgres.NewUnitOfWork(corePool),
+			postgres.NewTradingPairLiquidityRepository(corePool, logging.WithComponent(logger, "trading-pair-liquidity-repository")),
+		)
+		wallets.QuoteUseCase = exchangeusecase.NewSwapTokens(exchangeService, walletService, nil, nil, nil, nil, nil, logging.WithComponent(logger, "grpc-exchange-quote"))
+	}
+	if ratesPool != nil {
+		rateRepo := cachedRateRepository(cfg, postgres.NewRateRepository(ratesPool, logging.WithComponent(logger, "rate-repository")), logger)
+		wallets.CurrentRatesUseCase = ratesusecase.NewGetCurrentRatesUseCase(rateRepo, logging.WithComponent(logger, "grpc-rates-current"))
+	}
+
+	server, err := grpcapi.NewServer(grpcapi.ServerConfig{
+		CertFile:     cfg.InternalAPI.CertFile,
+		KeyFile:      cfg.InternalAPI.KeyFile,
+		ClientCAFile: cfg.InternalAPI.ClientCAFile,
+		Authenticator: internaleventsusecase.NewAuthenticateConsumerUseCase(
+			postgres.NewInternalEventConsumerRepository(corePool, logging.WithComponent(logger, "internal-consumer-repository")),
+			logging.WithComponent(logger, "grpc-consumer-auth"),
+		),
+		Wallets: wallets,
+		Logger:  logging.WithComponent(logger, "grpc"),
+	})
+	if err != nil {
+		logger.Warn("internal gRPC API disabled", slog.String("error", err.Error()))
+		return nil
+	}
+	return server
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/crypto-wallet/backend/db/migrations"
+	"github.com/crypto-wallet/backend/internal/infrastructure/database"
+	"github.com/crypto-wallet/backend/internal/infrastructure/logging"
+)
+
+// migrationOrder is the order databases are migrated in.
+var migrationOrder = []string{"core", "kyc", "rates", "audit"}
+
+const migrateUsage = `usage: server migrate <command> [database...]
+
+Commands:
+  up [database...]           apply pending migrations (all configured databases by default)
+  version [database...]      print the current schema version
+  force <database> <version> record a version without running migrations
+
+Databases: core, kyc, rates, audit. Each is configured by its <NAME>_DB_DSN variable.
+`
+
+// runMigrate implements the migrate subcommand with the embedded migrations.
+func runMigrate(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, migrateUsage)
+		return 2
+	}
+
+	logger, err := logging.NewLogger(logging.Config{Level: getEnv("LOG_LEVEL", "info"), Format: "text", Output: stderr})
+	if err != nil {
+		fmt.Fprintf(stderr, "migrate: %v\n", err)
+		return 1
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	command, targets := args[0], args[1:]
+	switch command {
+	case "up", "version":
+	case "force":
+		if len(targets) != 2 {
+			fmt.Fprint(stderr, migrateUsage)
+			return 2
+		}
+	default:
+		fmt.Fprintf(stderr, "migrate: unknown command %q\n\n%s", command, migrateUsage)
+		return 2
+	}
+
+	if err := runMigrateCommand(ctx, command, targets, stdout, logger); err != nil {
+		fmt.Fprintf(stderr, "migrate %s: %v\n", command, err)
+		return 1
+	}
+	return 0
+}
+
+func runMigrateCommand(ctx context.Context, command string, targets []string, stdout io.Writer, logger *slog.Logger) error {
+	var version int
+	if command == "force" {
+		var err error
+		if version, err = strconv.Atoi(targets[1]); err != nil {
+			return fmt.Errorf("invalid version %q", targets[1])
+		}
+		targets = targets[:1]
+	}
+
+	dsns, err := selectDSNs(loadDatabaseDSNs(), targets)
+	if err != nil {
+		return err
+	}
+
+	switch command {
+	case "up":
+		return migrateDatabases(ctx, dsns, logger)
+	case "version":
+		return printVersions(ctx, stdout, dsns, logger)
+	default:
+		migrator, err := newMigrator(dsns, logger)
+		if err != nil {
+			return err
+		}
+		return migrator.Force(ctx, targets[0], version)
+	}
+}
+
+// migrateDatabases applies pending migrations to each database with a DSN.
+func migrateDatabases(ctx context.Context, dsns map[string]string, logger *slog.Logger) error {
+	migrator, err := newMigrator(dsns, logger)
+	if err != nil {
+		return err
+	}
+	return migrator.Up(ctx)
+}
+
+func printVersions(ctx context.Context, out io.Writer, dsns map[string]string, logger *slog.Logger) error {
+	migrator, err := newMigrator(dsns, logger)
+	if err != nil {
+		return err
+	}
+	for _, name := range migrationOrder {
+		if _, ok := dsns[name]; !ok {
+			continue
+		}
+		version, dirty, err := migrator.Version(ctx, name)
+		if err != nil {
+			return fmt.Errorf("database %q: %w", name, err)
+		}
+		state := ""
+		if dirty {
+			state = " (dirty)"
+		}
+		fmt.Fprintf(out, "%s\t%d%s\n", name, version, state)
+	}
+	return nil
+}
+
+// newMigrator configures the embedded migrations for each database with a DSN, in migration order.
+func newMigrator(dsns map[string]string, logger *slog.Logger) (*database.Migrator, error) {
+	configs := make([]database.DatabaseConfig, 0, len(dsns))
+	for _, name := range migrationOrder {
+		dsn, ok := dsns[name]
+		if !ok {
+			continue
+		}
+		configs = append(configs, database.DatabaseConfig{
+			Name:          name,
+			DSN:           dsn,
+			MigrationsDir: migrations.Dirs[name],
+			Source:        migrations.FS,
+		})
+	}
+	if len(configs) == 0 {
+		return nil, errors.New("no databases configured; set CORE_DB_DSN, KYC_DB_DSN, RATES_DB_DSN or AUDIT_DB_DSN")
+	}
+	return database.NewMigrator(configs, slog.NewLogLogger(logger.Handler(), slog.LevelInfo))
+}
+
+// selectDSNs returns the DSNs of the named databases, or of every configured database when none
+// are named.
+func selectDSNs(dsns map[string]string, names []string) (map[string]string, error) {
+	selected := make(map[string]string)
+	if len(names) == 0 {
+		for name, dsn := range dsns {
+			if strings.TrimSpace(dsn) != "" {
+				selected[name] = dsn
+			}
+		}
+		return selected, nil
+	}
+	for _, name := range names {
+		if _, ok := migrations.Dirs[name]; !ok {
+			return nil, fmt.Errorf("unknown database %q", name)
+		}
+		dsn := strings.TrimSpace(dsns[name])
+		if dsn == "" {
+			return nil, fmt.Errorf("%s database is not configured (set %s_DB_DSN)", name, strings.ToUpper(name))
+		}
+		selected[name] = dsn
+	}
+	return selected, nil
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// invocationAuditor records every walletctl invocation. Records go to the audit database when it
+// is configured and always to the structured log.
+type invocationAuditor struct {
+	repo     repositories.AuditLogRepository
+	logger   *slog.Logger
+	operator string
+	hostname string
+}
+
+func newInvocationAuditor(repo repositories.AuditLogRepository, operator string, logger *slog.Logger) *invocationAuditor {
+	hostname, _ := os.Hostname()
+	return &invocationAuditor{
+		repo:     repo,
+		logger:   logger,
+		operator: operator,
+		hostname: hostname,
+	}
+}
+
+func (a *invocationAuditor) record(ctx context.Context, command string, args []string, started time.Time, runErr error) {
+	record := &entities.AuditRecord{
+		Action:       "walletctl." + strings.ReplaceAll(command, " ", "."),
+		ResourceType: "walletctl",
+		Details: map[string]any{
+			"operator":    a.operator,
+			"host":        a.hostname,
+			"args":        args,
+			"duration_ms": time.Since(started).Milliseconds(),
+		},
+		UserAgent: "walletctl",
+		Result:    "success",
+		Timestamp: started.UTC(),
+	}
+	if runErr != nil {
+		record.Result = "failure"
+		record.ErrorMessage = runErr.Error()
+	}
+
+	a.logger.Info("walletctl invocation",
+		slog.String("action", record.Action),
+		slog.String("operator", a.operator),
+		slog.String("result", record.Result))
+
+	if a.repo == nil {
+		return
+	}
+	// Use a fresh context so a cancelled command is still audited.
+	auditCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	if err := a.repo.Append(auditCtx, record); err != nil {
+		a.logger.Error("failed to record walletctl invocation", slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,829 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	adminusecase "github.com/crypto-wallet/backend/internal/application/usecases/admin"
+	eventexportusecase "github.com/crypto-wallet/backend/internal/application/usecases/eventexport"
+	internaleventsusecase "github.com/crypto-wallet/backend/internal/application/usecases/internalevents"
+	ratesusecase "github.com/crypto-wallet/backend/internal/application/usecases/rates"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/services"
+	"github.com/crypto-wallet/backend/internal/infrastructure/blockchain"
+	"github.com/crypto-wallet/backend/internal/infrastructure/eventexport"
+	"github.com/crypto-wallet/backend/internal/infrastructure/external"
+	"github.com/crypto-wallet/backend/internal/infrastructure/logging"
+	"github.com/crypto-wallet/backend/internal/infrastructure/messaging"
+	"github.com/crypto-wallet/backend/internal/infrastructure/repository/postgres"
+	"github.com/crypto-wallet/backend/internal/infrastructure/security"
+)
+
+// maxReplayPages bounds one replay invocation; rerun with -after to continue.
+const maxReplayPages = 100
+
+func commands() map[string]command {
+	list := []command{
+		{name: "user inspect", usage: "user inspect <user-id|email>", summary: "show a user's profile, KYC state and wallets", run: runUserInspect},
+		{name: "wallet freeze", usage: "wallet freeze -reason <text> <wallet-id>", summary: "block outgoing activity on a wallet", run: runWalletFreeze},
+		{name: "wallet unfreeze", usage: "wallet unfreeze [-reason <text>] <wallet-id>", summary: "return a frozen wallet to active", run: runWalletUnfreeze},
+		{name: "events replay", usage: "events replay -from <rfc3339> -to <rfc3339>", summary: "re-export outbox events to the configured sink", run: runEventsReplay},
+		{name: "rates sync", usage: "rates sync", summary: "fetch and store current prices now", run: runRatesSync},
+		{name: "rates backfill", usage: "rates backfill -symbol <sym> -interval <interval> <candles.csv|->", summary: "bulk-load historical candles from a CSV export", run: runRatesBackfill},
+		{name: "rates backfill-history", usage: "rates backfill-history -symbol <sym> -from <date> [-to <date>] [-interval <interval>] [-pace <duration>]", summary: "fetch historical candles from CoinGecko, resuming an interrupted run", run: runRatesBackfillHistory},
+		{name: "quotes expire", usage: "quotes expire [-batch <n>]", summary: "cancel pending exchange quotes past their deadline", run: runQuotesExpire},
+		{name: "wallet reconcile", usage: "wallet reconcile [-dry-run|-confirm <token>] <user-id>", summary: "correct stored wallet balances from the chain", run: runWalletReconcile},
+		{name: "wallet freeze-all", usage: "wallet freeze-all -reason <text> [-dry-run|-confirm <token>] <user-id>", summary: "freeze every active wallet of a user", run: runWalletFreezeAll},
+		{name: "exports purge", usage: "exports purge [-before <rfc3339>] [-dry-run|-confirm <token>]", summary: "delete finished export jobs created before the cutoff", run: runExportsPurge},
+		{name: "consumers register", usage: "consumers register -name <name> -types <pattern,...> <cert.pem>", summary: "allow an internal service to read the event stream", run: runConsumersRegister},
+		{name: "consumers list", usage: "consumers list", summary: "list internal event consumers", run: runConsumersList},
+		{name: "wallet rotate-key", usage: "wallet rotate-key [-dry-run|-confirm <token>]", summary: "re-wrap wallet data keys under the current wallet master key", run: runWalletRotateKey},
+		{name: "kyc rotate-key", usage: "kyc rotate-key [-dry-run|-confirm <token>]", summary: "re-wrap KYC data keys under KYC_MASTER_KEY_ID", run: runKYCRotateKey},
+		{name: "webhooks rotate-key", usage: "webhooks rotate-key [-dry-run|-confirm <token>]", summary: "re-encrypt webhook secrets under WEBHOOK_SECRET_ENCRYPTION_KEY_NEXT", run: runWebhooksRotateKey},
+		{name: "keys backup-request", usage: "keys backup-request -reason <text> <recipient.pem>", summary: "request a disaster-recovery export of wallet key material", run: runKeysBackupRequest},
+		{name: "keys backup-approve", usage: "keys backup-approve -fingerprint <sha256> -key <approver-key.pem> <request-id>", summary: "approve a key backup request", run: runKeysBackupApprove},
+		{name: "keys backup-status", usage: "keys backup-status <request-id>", summary: "show a key backup request and its approvals", run: runKeysBackupStatus},
+		{name: "keys backup-export", usage: "keys backup-export -out <file> <request-id>", summary: "write the archive of an approved key backup", run: runKeysBackupExport},
+		{name: "keys backup-verify", usage: "keys backup-verify [-offline] [-public-key <pem>] [-private-key <pem>] <archive>", summary: "check a key backup archive's integrity", run: runKeysBackupVerify},
+		{name: "sandbox profile", usage: "sandbox profile -out <file> [-days <n>] [-min-group <n>]", summary: "summarise activity distributions for sandbox data", run: runSandboxProfile},
+		{name: "sandbox generate", usage: "sandbox generate -profile <file> -out <seed.sql> [-users <n>] [-seed <n>]", summary: "write a synthetic core database seed from a profile", run: runSandboxGenerate},
+	}
+
+	result := make(map[string]command, len(list))
+	for _, cmd := range list {
+		result[cmd.name] = cmd
+	}
+	return result
+}
+
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
+
+func singleArg(fs *flag.FlagSet, what string) (string, error) {
+	if fs.NArg() != 1 {
+		return "", fmt.Errorf("expected exactly one %s argument", what)
+	}
+	return strings.TrimSpace(fs.Arg(0)), nil
+}
+
+func runUserInspect(ctx context.Context, env *environment, args []string) (result, error) {
+	fs := newFlagSet("user inspect")
+	if err := fs.Parse(args); err != nil {
+		return result{}, err
+	}
+	identifier, err := singleArg(fs, "user")
+	if err != nil {
+		return result{}, err
+	}
+
+	corePool, err := env.pool(ctx, "core")
+	if err != nil {
+		return result{}, err
+	}
+
+	var kycReader adminusecase.KYCProfileReader
+	if strings.TrimSpace(env.cfg.DatabaseDSNs["kyc"]) != "" {
+		if kycPool, err := env.pool(ctx, "kyc"); err == nil {
+			kycReader = postgres.NewKYCRepository(kycPool, logging.WithComponent(env.logger, "kyc-repository"))
+		}
+	}
+
+	uc := adminusecase.NewInspectUserUseCase(
+		postgres.NewPostgresUserRepository(corePool),
+		postgres.NewWalletRepository(corePool, logging.WithComponent(env.logger, "wallet-repository")),
+		kycReader,
+		logging.WithComponent(env.logger, "admin-inspect-user"),
+	)
+	report, err := uc.Execute(ctx, identifier)
+	if err != nil {
+		return result{}, err
+	}
+
+	rows := keyValueRows(
+		"id", report.ID.String(),
+		"email", report.Email,
+		"name", report.Name,
+		"status", report.Status,
+		"email_verified", strconv.FormatBool(report.EmailVerified),
+		"two_factor", strconv.FormatBool(report.TwoFactorEnabled),
+		"created_at", report.CreatedAt.Format(time.RFC3339),
+	)
+	if report.KYC != nil {
+		rows = append(rows, keyValueRows(
+			"kyc_status", report.KYC.Status,
+			"kyc_level", report.KYC.Level,
+			"kyc_daily_limit_usd", report.KYC.DailyLimitUSD,
+		)...)
+	}
+	for _, wallet := range report.Wallets {
+		rows = append(rows, []string{"wallet", fmt.Sprintf("%s %s %s %s (%s)", wallet.ID, wallet.Chain, wallet.Address, wallet.Balance, wallet.Status)})
+	}
+
+	return result{Value: report, Headers: []string{"FIELD", "VALUE"}, Rows: rows}, nil
+}
+
+func runWalletFreeze(ctx context.Context, env *environment, args []string) (result, error) {
+	return setWalletFrozen(ctx, env, "wallet freeze", args, true)
+}
+
+func runWalletUnfreeze(ctx context.Context, env *environment, args []string) (result, error) {
+	return setWalletFrozen(ctx, env, "wallet unfreeze", args, false)
+}
+
+func setWalletFrozen(ctx context.Context, env *environment, name string, args []string, frozen bool) (result, error) {
+	fs := newFlagSet(name)
+	reason := fs.String("reason", "", "why the wallet is being frozen or released")
+	if err := fs.Parse(args); err != nil {
+		return result{}, err
+	}
+	raw, err := singleArg(fs, "wallet")
+	if err != nil {
+		return result{}, err
+	}
+	walletID, err := uuid.Parse(raw)
+	if err != nil {
+		return result{}, fmt.Errorf("invalid wallet id %q", raw)
+	}
+
+	corePool, err := env.pool(ctx, "core")
+	if err != nil {
+		return result{}, err
+	}
+
+	uc := adminusecase.NewSetWalletFrozenUseCase(
+		postgres.NewWalletRepository(corePool, logging.WithComponent(env.logger, "wallet-repository")),
+		env.audit,
+		logging.WithComponent(env.logger, "admin-wallet-freeze"),
+	)
+	wallet, err := uc.Execute(ctx, adminusecase.SetWalletFrozenInput{
+		WalletID: walletID,
+		Frozen:   frozen,
+		Reason:   *reason,
+		Actor:    env.operator,
+	})
+	if err != nil {
+		return result{}, err
+	}
+
+	return walletResult(wallet), nil
+}
+
+func walletResult(wallet dto.Wallet) result {
+	return result{
+		Value:   wallet,
+		Headers: []string{"ID", "CHAIN", "ADDRESS", "BALANCE", "STATUS"},
+		Rows:    [][]string{{wallet.ID.String(), wallet.Chain, wallet.Address, wallet.Balance, wallet.Status}},
+	}
+}
+
+func runEventsReplay(ctx context.Context, env *environment, args []string) (result, error) {
+	fs := newFlagSet("events replay")
+	from := fs.String("from", "", "start of the range (RFC3339, inclusive)")
+	to := fs.String("to", "", "end of the range (RFC3339, exclusive)")
+	after := fs.Int64("after", 0, "resume after this outbox sequence")
+	limit := fs.Int("limit", 0, "events per page")
+	if err := fs.Parse(args); err != nil {
+		return result{}, err
+	}
+
+	sink, err := eventexport.NewSink(env.cfg.EventSink)
+	if err != nil {
+		return result{}, err
+	}
+	if sink == nil {
+		return result{}, errors.New("event export sink is not configured (set EVENT_EXPORT_SINK)")
+	}
+
+	corePool, err := env.pool(ctx, "core")
+	if err != nil {
+		return result{}, err
+	}
+
+	uc := eventexportusecase.NewBackfillEventsUseCase(
+		postgres.NewEventOutboxRepository(corePool, logging.WithComponent(env.logger, "event-outbox-repository")),
+		sink,
+		*limit,
+		logging.WithComponent(env.logger, "event-backfill"),
+	)
+
+	summary := dto.EventBackfillResponse{Sink: sink.Name(), Partitions: make([]string, 0), NextSequence: *after}
+	for page := 0; page < maxReplayPages && !summary.Done; page++ {
+		resp, err := uc.Execute(ctx, dto.EventBackfillRequest{
+			From:          *from,
+			To:            *to,
+			AfterSequence: summary.NextSequence,
+			Limit:         *limit,
+		})
+		if err != nil {
+			return result{}, err
+		}
+		summary.Exported += resp.Exported
+		summary.Partitions = append(summary.Partitions, resp.Partitions...)
+		summary.NextSequence = resp.NextSequence
+		summary.Done = resp.Done
+	}
+
+	return result{
+		Value:   summary,
+		Headers: []string{"SINK", "EXPORTED", "NEXT_SEQUENCE", "DONE"},
+		Rows: [][]string{{
+			summary.Sink,
+			strconv.Itoa(summary.Exported),
+			strconv.FormatInt(summary.NextSequence, 10),
+			strconv.FormatBool(summary.Done),
+		}},
+	}, nil
+}
+
+func runRatesSync(ctx context.Context, env *environment, args []string) (result, error) {
+	fs := newFlagSet("rates sync")
+	if err := fs.Parse(args); err != nil {
+		return result{}, err
+	}
+
+	ratesPool, err := env.pool(ctx, "rates")
+	if err != nil {
+		return result{}, err
+	}
+
+	// Broadcasting is best effort: subscribers only matter when the server is running.
+	var pubsub messaging.RedisPubSubManager
+	if strings.TrimSpace(env.cfg.RedisAddr) != "" {
+		pubsub, err = messaging.NewRedisPubSubManager(messaging.RedisPubSubConfig{
+			RedisClient: redis.NewClient(&redis.Options{Addr: env.cfg.RedisAddr}),
+			Logger:      logging.WithComponent(env.logger, "pubsub"),
+		})
+		if err != nil {
+			return result{}, err
+		}
+		defer pubsub.Close()
+	}
+
+	if err := loadListedAssets(ctx, env); err != nil {
+		return result{}, err
+	}
+
+	rateRepo := postgres.NewRateRepository(ratesPool, logging.WithComponent(env.logger, "rate-repository"))
+	source := external.NewCoinGeckoClient(external.CoinGeckoConfig{
+		APIKey:  env.cfg.CoinGeckoAPIKey,
+		CoinIDs: entities.ListedCoingeckoIDs(),
+		Logger:  logging.WithComponent(env.logger, "coingecko"),
+	})
+	var publisher ratesusecase.PricePublisher
+	if pubsub != nil {
+		publisher = pubsub
+	}
+	syncUC := ratesusecase.NewSyncRatesUseCase(source, rateRepo, publisher, nil, logging.WithComponent(env.logger, "rate-sync"))
+	if _, err := syncUC.Execute(ctx); err != nil {
+		return result{}, err
+	}
+
+	rates, err := rateRepo.GetRatesBySymbols(ctx, entities.SupportedSymbols())
+	if err != nil {
+		return result{}, err
+	}
+	prices := make(map[string]*external.CoinGeckoPriceData, len(rates))
+	rows := make([][]string, 0, len(rates))
+	for _, rate := range rates {
+		prices[rate.GetSymbol()] = &external.CoinGeckoPriceData{
+			Symbol:         rate.GetSymbol(),
+			PriceUSD:       rate.GetPriceUSD(),
+			PriceChange24h: rate.GetPriceChange24h(),
+			Volume24h:      rate.GetVolume24h(),
+			MarketCap:      rate.GetMarketCap(),
+			LastUpdated:    rate.GetLastUpdated(),
+		}
+		rows = append(rows, []string{rate.GetSymbol(), rate.GetPriceUSD().String(), rate.GetLastUpdated().UTC().Format(time.RFC3339)})
+	}
+
+	return result{Value: prices, Headers: []string{"SYMBOL", "PRICE_USD", "UPDATED_AT"}, Rows: rows}, nil
+}
+
+func runQuotesExpire(ctx context.Context, env *environment, args []string) (result, error) {
+	fs := newFlagSet("quotes expire")
+	batch := fs.Int("batch", services.DefaultQuoteExpiryBatch, "maximum number of quotes to cancel in this run")
+	if err := fs.Parse(args); err != nil {
+		return result{}, err
+	}
+
+	corePool, err := env.pool(ctx, "core")
+	if err != nil {
+		return result{}, err
+	}
+
+	exchangeService := services.NewExchangeService(
+		postgres.NewExchangeOperationRepository(corePool, logging.WithComponent(env.logger, "exchange-repository")),
+		postgres.NewTradingPairRepository(corePool, logging.WithComponent(env.logger, "trading-pair-repository")),
+		postgres.NewWalletRepository(corePool, logging.WithComponent(env.logger, "wallet-repository")),
+		postgres.NewAssetRepository(corePool, logging.WithComponent(env.logger, "asset-repository")),
+		services.PricingStrategies{},
+		postgres.NewUnitOfWork(corePool),
+		postgres.NewTradingPairLiquidityRepository(corePool, logging.WithComponent(env.logger, "trading-pair-liquidity-repository")),
+	)
+	uc := adminusecase.NewExpireQuotesUseCase(exchangeService, logging.WithComponent(env.logger, "admin-expire-quotes"))
+	expired, err := uc.Execute(ctx, *batch)
+	if err != nil {
+		return result{}, err
+	}
+
+	rows := make([][]string, 0, len(expired.ExpiredIDs))
+	for _, id := range expired.ExpiredIDs {
+		rows = append(rows, []string{id})
+	}
+	return result{
+		Value: map[string]any{
+			"expired":  expired.ExpiredIDs,
+			"count":    len(expired.ExpiredIDs),
+			"failed":   expired.Failed,
+			"has_more": expired.HasMore,
+		},
+		Headers: []string{"EXPIRED_OPERATION_ID"},
+		Rows:    rows,
+	}, nil
+}
+
+func runWalletReconcile(ctx context.Context, env *environment, args []string) (result, error) {
+	fs := newFlagSet("wallet reconcile")
+	options := destructiveFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return result{}, err
+	}
+	raw, err := singleArg(fs, "user")
+	if err != nil {
+		return result{}, err
+	}
+	userID, err := uuid.Parse(raw)
+	if err != nil {
+		return result{}, fmt.Errorf("invalid user id %q", raw)
+	}
+
+	corePool, err := env.pool(ctx, "core")
+	if err != nil {
+		return result{}, err
+	}
+
+	chains := env.cfg.Blockchain
+	adapters := map[entities.Chain]adminusecase.BalanceReader{
+		entities.ChainBTC: blockchain.NewBitcoinAdapter(chains.Bitcoin, logging.WithComponent(env.logger, "blockchain-btc")),
+		entities.ChainETH: blockchain.NewEthereumAdapter(chains.Ethereum, logging.WithComponent(env.logger, "blockchain-eth")),
+		entities.ChainSOL: blockchain.NewSolanaAdapter(chains.Solana, logging.WithComponent(env.logger, "blockchain-sol")),
+		entities.ChainXLM: blockchain.NewStellarAdapter(chains.Stellar, logging.WithComponent(env.logger, "blockchain-xlm")),
+	}
+
+	uc := adminusecase.NewReconcileBalancesUseCase(
+		postgres.NewWalletRepository(corePool, logging.WithComponent(env.logger, "wallet-repository")),
+		adapters,
+		env.confirmationTokens(),
+		env.audit,
+		logging.WithComponent(env.logger, "admin-reconcile"),
+	)
+	report, err := uc.Execute(ctx, adminusecase.ReconcileBalancesInput{
+		DestructiveOptions: options(),
+		UserID:             userID,
+		Actor:              env.operator,
+	})
+	if err != nil {
+		return result{}, err
+	}
+
+	rows := make([][]string, 0, len(report.Lines))
+	for _, line := range report.Lines {
+		status := line.Status
+		if line.Error != "" {
+			status += ": " + line.Error
+		}
+		rows = append(rows, []string{line.WalletID.String(), line.Chain, line.StoredBalance, line.OnChainBalance, line.Difference, status})
+	}
+	return result{
+		Value:   report,
+		Headers: []string{"WALLET", "CHAIN", "STORED", "ON_CHAIN", "DIFF", "STATUS"},
+		Rows:    rows,
+		Notes:   operationNotes("wallet reconcile", report.Operation),
+	}, nil
+}
+
+func runWalletFreezeAll(ctx context.Context, env *environment, args []string) (result, error) {
+	fs := newFlagSet("wallet freeze-all")
+	reason := fs.String("reason", "", "why the wallets are being frozen")
+	options := destructiveFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return result{}, err
+	}
+	raw, err := singleArg(fs, "user")
+	if err != nil {
+		return result{}, err
+	}
+	userID, err := uuid.Parse(raw)
+	if err != nil {
+		return result{}, fmt.Errorf("invalid user id %q", raw)
+	}
+
+	corePool, err := env.pool(ctx, "core")
+	if err != nil {
+		return result{}, err
+	}
+
+	uc := adminusecase.NewFreezeUserWalletsUseCase(
+		postgres.NewWalletRepository(corePool, logging.WithComponent(env.logger, "wallet-repository")),
+		env.confirmationTokens(),
+		env.audit,
+		logging.WithComponent(env.logger, "admin-freeze-wallets"),
+	)
+	res, err := uc.Execute(ctx, adminusecase.FreezeUserWalletsInput{
+		DestructiveOptions: options(),
+		UserID:             userID,
+		Reason:             *reason,
+		Actor:              env.operator,
+	})
+	if err != nil {
+		return result{}, err
+	}
+	return operationResult("wallet freeze-all", res), nil
+}
+
+func runExportsPurge(ctx context.Context, env *environment, args []string) (result, error) {
+	fs := newFlagSet("exports purge")
+	before := fs.String("before", "", "only purge jobs created before this time (RFC3339, default now)")
+	options := destructiveFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return result{}, err
+	}
+
+	input := adminusecase.PurgeExportsInput{DestructiveOptions: options(), Actor: env.operator}
+	if strings.TrimSpace(*before) != "" {
+		cutoff, err := time.Parse(time.RFC3339, strings.TrimSpace(*before))
+		if err != nil {
+			return result{}, fmt.Errorf("invalid -before %q: use RFC3339", *before)
+		}
+		input.Before = cutoff
+	}
+
+	corePool, err := env.pool(ctx, "core")
+	if err != nil {
+		return result{}, err
+	}
+
+	uc := adminusecase.NewPurgeExportsUseCase(
+		postgres.NewExportJobRepository(corePool, logging.WithComponent(env.logger, "export-repository")),
+		env.confirmationTokens(),
+		env.audit,
+		logging.WithComponent(env.logger, "admin-purge-exports"),
+	)
+	res, err := uc.Execute(ctx, input)
+	if err != nil {
+		return result{}, err
+	}
+	return operationResult("exports purge", res), nil
+}
+
+func runWebhooksRotateKey(ctx context.Context, env *environment, args []string) (result, error) {
+	fs := newFlagSet("webhooks rotate-key")
+	options := destructiveFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return result{}, err
+	}
+
+	current, err := masterKeyCipher("WEBHOOK_SECRET_ENCRYPTION_KEY", env.cfg.WebhookSecretKey)
+	if err != nil {
+		return result{}, err
+	}
+	next, err := masterKeyCipher("WEBHOOK_SECRET_ENCRYPTION_KEY_NEXT", env.cfg.WebhookNextKey)
+	if err != nil {
+		return result{}, err
+	}
+
+	corePool, err := env.pool(ctx, "core")
+	if err != nil {
+		return result{}, err
+	}
+
+	uc := adminusecase.NewRotateWebhookKeyUseCase(
+		postgres.NewWebhookEndpointRepository(corePool, logging.WithComponent(env.logger, "webhook-repository")),
+		current,
+		next,
+		env.confirmationTokens(),
+		env.audit,
+		logging.WithComponent(env.logger, "admin-rotate-webhook-key"),
+	)
+	res, err := uc.Execute(ctx, adminusecase.RotateWebhookKeyInput{DestructiveOptions: options(), Actor: env.operator})
+	if err != nil {
+		return result{}, err
+	}
+
+	out := operationResult("webhooks rotate-key", res)
+	if !res.DryRun && res.AffectedCount > 0 {
+		out.Notes = append(out.Notes, "switch the server's WEBHOOK_SECRET_ENCRYPTION_KEY to the next key now")
+	}
+	return out, nil
+}
+
+func runWalletRotateKey(ctx context.Context, env *environment, args []string) (result, error) {
+	fs := newFlagSet("wallet rotate-key")
+	options := destructiveFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return result{}, err
+	}
+
+	// Without a previous key the run only seals secrets stored before envelope encryption.
+	primary, previous, err := rotationMasterKeys(ctx, env, env.cfg.WalletKeyID,
+		"WALLET_ENCRYPTION_KEY", env.cfg.WalletEncryptionKey,
+		"WALLET_ENCRYPTION_KEY_PREVIOUS", env.cfg.WalletPreviousKey)
+	if err != nil {
+		return result{}, err
+	}
+
+	corePool, err := env.pool(ctx, "core")
+	if err != nil {
+		return result{}, err
+	}
+
+	uc := adminusecase.NewRotateWalletKeyUseCase(
+		postgres.NewWalletRepository(corePool, logging.WithComponent(env.logger, "wallet-repository")),
+		postgres.NewHDSeedRepository(corePool, logging.WithComponent(env.logger, "hd-seed-repository")),
+		security.NewEnvelopeEncryptor(primary, previous...),
+		env.confirmationTokens(),
+		env.audit,
+		logging.WithComponent(env.logger, "admin-rotate-wallet-key"),
+	)
+	res, err := uc.Execute(ctx, adminusecase.RotateWalletKeyInput{DestructiveOptions: options(), Actor: env.operator})
+	if err != nil {
+		return result{}, err
+	}
+
+	out := operationResult("wallet rotate-key", res)
+	if !res.DryRun && len(res.Failures) == 0 {
+		if remoteMasterKeys(env.cfg.MasterKeys) {
+			out.Notes = append(out.Notes, "every data key is wrapped by WALLET_MASTER_KEY_ID; WALLET_ENCRYPTION_KEY and WALLET_ENCRYPTION_KEY_PREVIOUS can be removed from the server")
+		} else {
+			out.Notes = append(out.Notes, "every data key is wrapped by WALLET_ENCRYPTION_KEY; WALLET_ENCRYPTION_KEY_PREVIOUS can be removed from the server")
+		}
+	}
+	return out, nil
+}
+
+func runKYCRotateKey(ctx context.Context, env *environment, args []string) (result, error) {
+	fs := newFlagSet("kyc rotate-key")
+	options := destructiveFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return result{}, err
+	}
+
+	// KYC has a single env key, so the only rotation is from it into the key provider.
+	if !remoteMasterKeys(env.cfg.MasterKeys) {
+		return result{}, errors.New("kyc rotate-key moves KYC data keys to MASTER_KEY_PROVIDER; it is not set to a remote provider")
+	}
+	primary, previous, err := rotationMasterKeys(ctx, env, env.cfg.KYCKeyID,
+		"KYC_ENCRYPTION_KEY", env.cfg.KYCEncryptionKey, "", "")
+	if err != nil {
+		return result{}, err
+	}
+
+	kycPool, err := env.pool(ctx, "kyc")
+	if err != nil {
+		return result{}, err
+	}
+
+	keys := postgres.NewUserDataKeyRepository(kycPool, logging.WithComponent(env.logger, "user-data-key-repository"))
+	uc := adminusecase.NewRotateKYCKeyUseCase(
+		keys,
+		security.NewUserKeyCipher(primary, keys, previous...),
+		env.confirmationTokens(),
+		env.audit,
+		logging.WithComponent(env.logger, "admin-rotate-kyc-key"),
+	)
+	res, err := uc.Execute(ctx, adminusecase.RotateKYCKeyInput{DestructiveOptions: options(), Actor: env.operator})
+	if err != nil {
+		return result{}, err
+	}
+
+	out := operationResult("kyc rotate-key", res)
+	if !res.DryRun && len(res.Failures) == 0 {
+		out.Notes = append(out.Notes, "every KYC data key is wrapped by KYC_MASTER_KEY_ID; keep KYC_ENCRYPTION_KEY only while records submitted before per-user keys remain")
+	}
+	return out, nil
+}
+
+func runConsumersRegister(ctx context.Context, env *environment, args []string) (result, error) {
+	fs := newFlagSet("consumers register")
+	name := fs.String("name", "", "consumer name, lowercase letters, digits and dashes")
+	types := fs.String("types", "", "comma-separated event type patterns, e.g. exchange.*,transaction.confirmed")
+	if err := fs.Parse(args); err != nil {
+		return result{}, err
+	}
+	certPath, err := singleArg(fs, "certificate")
+	if err != nil {
+		return result{}, err
+	}
+	certPEM, err := os.ReadFile(certPath)
+	if err != nil {
+		return result{}, fmt.Errorf("read certificate: %w", err)
+	}
+
+	var patterns []string
+	for _, pattern := range strings.Split(*types, ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	corePool, err := env.pool(ctx, "core")
+	if err != nil {
+		return result{}, err
+	}
+
+	uc := internaleventsusecase.NewRegisterConsumerUseCase(
+		postgres.NewInternalEventConsumerRepository(corePool, logging.WithComponent(env.logger, "internal-consumer-repository")),
+		env.audit,
+		logging.WithComponent(env.logger, "internal-consumer-register"),
+	)
+	consumer, err := uc.Execute(ctx, internaleventsusecase.RegisterConsumerInput{
+		Name:           *name,
+		CertificatePEM: certPEM,
+		EventTypes:     patterns,
+		Actor:          env.operator,
+	})
+	if err != nil {
+		return result{}, err
+	}
+
+	return consumersResult([]entities.InternalEventConsumer{consumer}), nil
+}
+
+func runConsumersList(ctx context.Context, env *environment, args []string) (result, error) {
+	fs := newFlagSet("consumers list")
+	if err := fs.Parse(args); err != nil {
+		return result{}, err
+	}
+
+	corePool, err := env.pool(ctx, "core")
+	if err != nil {
+		return result{}, err
+	}
+
+	consumers, err := postgres.NewInternalEventConsumerRepository(corePool, logging.WithComponent(env.logger, "internal-consumer-repository")).List(ctx)
+	if err != nil {
+		return result{}, err
+	}
+	return consumersResult(consumers), nil
+}
+
+func consumersResult(consumers []entities.InternalEventConsumer) result {
+	rows := make([][]string, 0, len(consumers))
+	for _, consumer := range consumers {
+		lastSeen := "never"
+		if consumer.LastSeenAt != nil {
+			lastSeen = consumer.LastSeenAt.Format(time.RFC3339)
+		}
+		rows = append(rows, []string{
+			consumer.Name,
+			strings.Join(consumer.EventTypes, ","),
+			strconv.FormatBool(consumer.IsActive),
+			consumer.CertFingerprint,
+			lastSeen,
+		})
+	}
+	return result{
+		Value:   consumers,
+		Headers: []string{"NAME", "EVENT_TYPES", "ACTIVE", "CERT_SHA256", "LAST_SEEN"},
+		Rows:    rows,
+	}
+}
+
+// destructiveFlags registers the -dry-run and -confirm flags shared by destructive commands.
+func destructiveFlags(fs *flag.FlagSet) func() adminusecase.DestructiveOptions {
+	dryRun := fs.Bool("dry-run", false, "report the affected records and print a confirmation token")
+	confirm := fs.String("confirm", "", "confirmation token printed by the dry run")
+	return func() adminusecase.DestructiveOptions {
+		return adminusecase.DestructiveOptions{DryRun: *dryRun, ConfirmationToken: *confirm}
+	}
+}
+
+func operationResult(name string, res dto.AdminOperationResult) result {
+	rows := make([][]string, 0, len(res.AffectedIDs)+len(res.Failures))
+	status := "changed"
+	if res.DryRun {
+		status = "would change"
+	}
+	for _, id := range res.AffectedIDs {
+		rows = append(rows, []string{id, status})
+	}
+	failed := make([]string, 0, len(res.Failures))
+	for id := range res.Failures {
+		failed = append(failed, id)
+	}
+	sort.Strings(failed)
+	for _, id := range failed {
+		rows = append(rows, []string{id, "failed: " + res.Failures[id]})
+	}
+
+	return result{
+		Value:   res,
+		Headers: []string{"ID", "STATUS"},
+		Rows:    rows,
+		Notes:   operationNotes(name, res),
+	}
+}
+
+// operationNotes summarises an operation and, after a dry run, how to confirm it.
+func operationNotes(name string, res dto.AdminOperationResult) []string {
+	if !res.DryRun {
+		return []string{fmt.Sprintf("%s: %d record(s) changed", res.Operation, res.AffectedCount)}
+	}
+
+	notes := []string{fmt.Sprintf("dry run: %d record(s) would change; nothing was written", res.AffectedCount)}
+	if res.ConfirmationToken == "" {
+		if res.AffectedCount > 0 {
+			notes = append(notes, "set ADMIN_CONFIRMATION_SECRET to receive a confirmation token")
+		}
+		return notes
+	}
+
+	// The default cutoff moves with the clock, so the real run has to pin the one the dry run used.
+	pinned := ""
+	if before, ok := res.Scope["before"]; ok {
+		pinned = " -before " + before
+	}
+	notes = append(notes, fmt.Sprintf("to proceed, rerun %q without -dry-run and with%s -confirm %s (expires %s)",
+		"walletctl "+name, pinned, res.ConfirmationToken, res.ConfirmationExpiresAt.Format(time.RFC3339)))
+	return notes
+}
+
+// remoteMasterKeys reports whether master keys live in a KMS or HSM rather than the environment.
+func remoteMasterKeys(cfg security.KeyProviderConfig) bool {
+	backend := strings.ToLower(strings.TrimSpace(cfg.Backend))
+	return backend != "" && backend != security.KeyProviderLocal
+}
+
+// rotationMasterKeys returns the master key a rotation wraps into and the keys it may unwrap
+// with. With a remote provider the remote key is the target and the env keys are migrated away
+// from; otherwise the primary env key is the target and the previous one is migrated away from.
+func rotationMasterKeys(ctx context.Context, env *environment, keyID, primaryName, primaryKey, previousName, previousKey string) (security.KeyWrapper, []security.KeyWrapper, error) {
+	var (
+		primary  security.KeyWrapper
+		previous []security.KeyWrapper
+	)
+	if remoteMasterKeys(env.cfg.MasterKeys) {
+		cfg := env.cfg.MasterKeys
+		cfg.KeyID = keyID
+		provider, err := security.NewKeyProvider(cfg, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := provider.HealthCheck(ctx); err != nil {
+			return nil, nil, fmt.Errorf("master key provider health check: %w", err)
+		}
+		primary = provider
+		if strings.TrimSpace(primaryKey) != "" {
+			key, err := masterKeyCipher(primaryName, primaryKey)
+			if err != nil {
+				return nil, nil, err
+			}
+			previous = append(previous, key)
+		}
+	} else {
+		key, err := masterKeyCipher(primaryName, primaryKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		primary = key
+	}
+
+	if previousName != "" && strings.TrimSpace(previousKey) != "" {
+		key, err := masterKeyCipher(previousName, previousKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		previous = append(previous, key)
+	}
+	return primary, previous, nil
+}
+
+func masterKeyCipher(name, encoded string) (*security.AESGCMEncryptor, error) {
+	if strings.TrimSpace(encoded) == "" {
+		return nil, fmt.Errorf("%s must be configured", name)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("decode %s: %w", name, err)
+	}
+	if len(key) != security.AES256KeySize {
+		return nil, fmt.Errorf("%s must be %d bytes", name, security.AES256KeySize)
+	}
+	return security.NewAESGCMEncryptor(security.AESGCMConfig{Key: key})
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	adminusecase "github.com/crypto-wallet/backend/internal/application/usecases/admin"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/infrastructure/blockchain"
+	"github.com/crypto-wallet/backend/internal/infrastructure/eventexport"
+	"github.com/crypto-wallet/backend/internal/infrastructure/messaging"
+	"github.com/crypto-wallet/backend/internal/infrastructure/security"
+)
+
+// ctlConfig is read from the same environment variables as the server so operators can run
+// walletctl inside the server's deployment environment unchanged.
+type ctlConfig struct {
+	LogLevel        string
+	DatabaseDSNs    map[string]string
+	CoinGeckoAPIKey string
+	RedisAddr       string
+	EventSink       eventexport.SinkConfig
+	// ConfirmSecret must match across invocations so a dry run's token is accepted by the real run.
+	ConfirmSecret    string
+	ConfirmTTL       time.Duration
+	WebhookSecretKey string
+	WebhookNextKey   string
+	// WalletEncryptionKey is the primary wallet master key; WalletPreviousKey is the one being
+	// rotated away from.
+	WalletEncryptionKey string
+	WalletPreviousKey   string
+	KYCEncryptionKey    string
+	// MasterKeys selects where master keys live; WalletKeyID and KYCKeyID name the remote keys.
+	// With a remote provider the env keys above are only used to unwrap data they still protect.
+	MasterKeys  security.KeyProviderConfig
+	WalletKeyID string
+	KYCKeyID    string
+	// KeyBackup is the M-of-N approval rule for disaster-recovery key backups; its approvers are
+	// loaded from the public key files in KeyBackupApproverKeys, keyed by approver name.
+	KeyBackup             adminusecase.KeyBackupPolicy
+	KeyBackupApproverKeys map[string]string
+	// SandboxPassword, when set, is the sign-in password of every generated sandbox user.
+	SandboxPassword string
+	Blockchain      struct {
+		Bitcoin  blockchain.BitcoinConfig
+		Ethereum blockchain.EthereumConfig
+		Solana   blockchain.SolanaConfig
+		Stellar  blockchain.StellarConfig
+	}
+}
+
+func loadConfig() ctlConfig {
+	cfg := ctlConfig{
+		LogLevel: getEnv("WALLETCTL_LOG_LEVEL", "warn"),
+		DatabaseDSNs: map[string]string{
+			"core":  getEnv("CORE_DB_DSN", ""),
+			"kyc":   getEnv("KYC_DB_DSN", ""),
+			"rates": getEnv("RATES_DB_DSN", ""),
+			"audit": getEnv("AUDIT_DB_DSN", ""),
+		},
+		CoinGeckoAPIKey:     getEnv("COINGECKO_API_KEY", ""),
+		RedisAddr:           getEnv("REDIS_ADDR", ""),
+		ConfirmSecret:       getEnv("ADMIN_CONFIRMATION_SECRET", ""),
+		ConfirmTTL:          getEnvAsDuration("ADMIN_CONFIRMATION_TTL", 0),
+		WebhookSecretKey:    getEnv("WEBHOOK_SECRET_ENCRYPTION_KEY", ""),
+		WebhookNextKey:      getEnv("WEBHOOK_SECRET_ENCRYPTION_KEY_NEXT", ""),
+		WalletEncryptionKey: getEnv("WALLET_ENCRYPTION_KEY", ""),
+		WalletPreviousKey:   getEnv("WALLET_ENCRYPTION_KEY_PREVIOUS", ""),
+		KYCEncryptionKey:    getEnv("KYC_ENCRYPTION_KEY", ""),
+		WalletKeyID:         getEnv("WALLET_MASTER_KEY_ID", ""),
+		KYCKeyID:            getEnv("KYC_MASTER_KEY_ID", ""),
+		SandboxPassword:     getEnv("SANDBOX_USER_PASSWORD", ""),
+	}
+
+	cfg.KeyBackup = adminusecase.KeyBackupPolicy{
+		Quorum:         getEnvAsInt("KEY_BACKUP_QUORUM", 2),
+		ApprovalWindow: getEnvAsDuration("KEY_BACKUP_APPROVAL_WINDOW", entities.DefaultKeyBackupApprovalWindow),
+	}
+	cfg.KeyBackupApproverKeys = make(map[string]string)
+	for _, entry := range strings.Split(getEnv("KEY_BACKUP_APPROVERS", ""), ",") {
+		name, keyPath, _ := strings.Cut(entry, "=")
+		if name = strings.TrimSpace(name); name != "" {
+			cfg.KeyBackupApproverKeys[name] = strings.TrimSpace(keyPath)
+		}
+	}
+
+	cfg.MasterKeys = security.KeyProviderConfig{
+		Backend: getEnv("MASTER_KEY_PROVIDER", security.KeyProviderLocal),
+		Timeout: getEnvAsDuration("MASTER_KEY_TIMEOUT", 0),
+		AWS: security.AWSKMSConfig{
+			Region:   getEnv("AWS_KMS_REGION", getEnv("AWS_REGION", "")),
+			Endpoint: getEnv("AWS_KMS_ENDPOINT", ""),
+		},
+		GCP: security.GCPKMSConfig{
+			AccessToken: getEnv("GCP_KMS_ACCESS_TOKEN", ""),
+			Endpoint:    getEnv("GCP_KMS_ENDPOINT", ""),
+		},
+		Vault: security.VaultTransitConfig{
+			Address:   getEnv("VAULT_ADDR", ""),
+			Token:     getEnv("VAULT_TOKEN", ""),
+			Namespace: getEnv("VAULT_NAMESPACE", ""),
+			Mount:     getEnv("VAULT_TRANSIT_MOUNT", ""),
+		},
+	}
+
+	cfg.EventSink = eventexport.SinkConfig{
+		Kind:   getEnv("EVENT_EXPORT_SINK", ""),
+		Dir:    getEnv("EVENT_EXPORT_DIR", ""),
+		Prefix: getEnv("EVENT_EXPORT_PREFIX", "events"),
+		S3: eventexport.S3Config{
+			Bucket:          getEnv("EVENT_EXPORT_S3_BUCKET", ""),
+			Region:          getEnv("EVENT_EXPORT_S3_REGION", getEnv("AWS_REGION", "")),
+			Endpoint:        getEnv("EVENT_EXPORT_S3_ENDPOINT", ""),
+			AccessKeyID:     getEnv("AWS_ACCESS_KEY_ID", ""),
+			SecretAccessKey: getEnv("AWS_SECRET_ACCESS_KEY", ""),
+			SessionToken:    getEnv("AWS_SESSION_TOKEN", ""),
+		},
+		Kafka: messaging.KafkaConfig{
+			Transport:     getEnv("KAFKA_TRANSPORT", messaging.KafkaTransportNative),
+			Brokers:       strings.Split(getEnv("KAFKA_BROKERS", ""), ","),
+			ClientID:      getEnv("KAFKA_CLIENT_ID", "walletctl"),
+			SASLMechanism: getEnv("KAFKA_SASL_MECHANISM", ""),
+			Username:      getEnv("KAFKA_USERNAME", ""),
+			Password:      getEnv("KAFKA_PASSWORD", ""),
+			TLS:           getEnvAsBool("KAFKA_TLS", false),
+			REST:          messaging.KafkaRESTConfig{BaseURL: getEnv("KAFKA_REST_URL", "")},
+		},
+		KafkaTopicPrefix: getEnv("EVENT_EXPORT_KAFKA_TOPIC_PREFIX", "wallet.events"),
+	}
+
+	cfg.Blockchain.Bitcoin = blockchain.BitcoinConfig{
+		RPCURL:                getEnv("BTC_RPC_URL", ""),
+		RPCUser:               getEnv("BTC_RPC_USER", ""),
+		RPCPassword:           getEnv("BTC_RPC_PASSWORD", ""),
+		Network:               getEnv("BTC_NETWORK", "mainnet"),
+		ConfirmationThreshold: getEnvAsInt("BTC_CONFIRMATIONS", 6),
+	}
+	cfg.Blockchain.Ethereum = blockchain.EthereumConfig{
+		RPCURL:                getEnv("ETH_RPC_URL", ""),
+		Network:               getEnv("ETH_NETWORK", "mainnet"),
+		ChainID:               int64(getEnvAsInt("ETH_CHAIN_ID", 1)),
+		ConfirmationThreshold: getEnvAsInt("ETH_CONFIRMATIONS", 12),
+	}
+	cfg.Blockchain.Solana = blockchain.SolanaConfig{
+		RPCURL:                getEnv("SOL_RPC_URL", ""),
+		Network:               getEnv("SOL_NETWORK", "mainnet"),
+		ConfirmationThreshold: getEnvAsInt("SOL_CONFIRMATIONS", 32),
+		Commitment:            getEnv("SOL_COMMITMENT", "finalized"),
+	}
+	cfg.Blockchain.Stellar = blockchain.StellarConfig{
+		HorizonURL:            getEnv("XLM_HORIZON_URL", ""),
+		Network:               getEnv("XLM_NETWORK", "public"),
+		ConfirmationThreshold: getEnvAsInt("XLM_CONFIRMATIONS", 1),
+	}
+
+	return cfg
+}
+
+func getEnv(key string, fallback string) string {
+	value := strings.TrimSpace(os.Getenv(key))
+	if value == "" {
+		return fallback
+	}
+	return value
+}
+
+func getEnvAsBool(key string, fallback bool) bool {
+	value := strings.TrimSpace(os.Getenv(key))
+	if value == "" {
+		return fallback
+	}
+	boolVal, err := strconv.ParseBool(value)
+	if err != nil {
+		return fallback
+	}
+	return boolVal
+}
+
+func getEnvAsInt(key string, fallback int) int {
+	value := strings.TrimSpace(os.Getenv(key))
+	if value == "" {
+		return fallback
+	}
+	intVal, err := strconv.Atoi(value)
+	if err != nil {
+		return fallback
+	}
+	return intVal
+}
+
+func getEnvAsDuration(key string, fallback time.Duration) time.Duration {
+	value := strings.TrimSpace(os.Getenv(key))
+	if value == "" {
+		return fallback
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return fallback
+	}
+	return duration
+}
@@ -0,0 +1,335 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	adminusecase "github.com/crypto-wallet/backend/internal/application/usecases/admin"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/internal/infrastructure/keybackup"
+	"github.com/crypto-wallet/backend/internal/infrastructure/logging"
+	"github.com/crypto-wallet/backend/internal/infrastructure/repository/postgres"
+)
+
+// Disaster-recovery key backups: one operator requests a backup for an offline recipient key,
+// KEY_BACKUP_QUORUM of the KEY_BACKUP_APPROVERS approve it by signing the request with their own
+// Ed25519 key, and the requester or an approver exports the archive. Archives hold wallet keys as stored, still encrypted under the wallet
+// encryption key, and are wrapped for the recipient key on top of that.
+
+func runKeysBackupRequest(ctx context.Context, env *environment, args []string) (result, error) {
+	fs := newFlagSet("keys backup-request")
+	reason := fs.String("reason", "", "why the key material is being exported")
+	if err := fs.Parse(args); err != nil {
+		return result{}, err
+	}
+	keyPath, err := singleArg(fs, "recipient public key")
+	if err != nil {
+		return result{}, err
+	}
+	keyPEM, err := os.ReadFile(keyPath)
+	if err != nil {
+		return result{}, fmt.Errorf("read recipient public key: %w", err)
+	}
+
+	store, auditLogger, err := keyBackupDeps(ctx, env)
+	if err != nil {
+		return result{}, err
+	}
+	policy, err := keyBackupPolicy(env.cfg)
+	if err != nil {
+		return result{}, err
+	}
+	uc := adminusecase.NewRequestKeyBackupUseCase(store, policy, auditLogger, logging.WithComponent(env.logger, "admin-key-backup-request"))
+	request, err := uc.Execute(ctx, adminusecase.RequestKeyBackupInput{
+		RecipientPublicKeyPEM: keyPEM,
+		Reason:                *reason,
+		Actor:                 env.operator,
+	})
+	if err != nil {
+		return result{}, err
+	}
+
+	out := keyBackupResult(request, env.cfg.KeyBackup.Quorum)
+	out.Notes = append(out.Notes, fmt.Sprintf("approvers run: walletctl keys backup-approve -fingerprint <recipient fingerprint> -key <approver key.pem> %s", request.ID))
+	return out, nil
+}
+
+func runKeysBackupApprove(ctx context.Context, env *environment, args []string) (result, error) {
+	fs := newFlagSet("keys backup-approve")
+	fingerprint := fs.String("fingerprint", "", "SHA-256 fingerprint of the recipient key, checked against the offline key")
+	keyPath := fs.String("key", "", "approver's Ed25519 private key (PEM PKCS#8) that signs the approval")
+	if err := fs.Parse(args); err != nil {
+		return result{}, err
+	}
+	requestID, err := keyBackupRequestArg(fs.Args())
+	if err != nil {
+		return result{}, err
+	}
+	if strings.TrimSpace(*fingerprint) == "" {
+		return result{}, errors.New("-fingerprint is required")
+	}
+	if strings.TrimSpace(*keyPath) == "" {
+		return result{}, errors.New("-key is required")
+	}
+	keyPEM, err := os.ReadFile(*keyPath)
+	if err != nil {
+		return result{}, fmt.Errorf("read approver key: %w", err)
+	}
+	signingKey, err := keybackup.ParseApproverPrivateKey(keyPEM)
+	if err != nil {
+		return result{}, err
+	}
+
+	policy, err := keyBackupPolicy(env.cfg)
+	if err != nil {
+		return result{}, err
+	}
+	store, auditLogger, err := keyBackupDeps(ctx, env)
+	if err != nil {
+		return result{}, err
+	}
+	uc := adminusecase.NewApproveKeyBackupUseCase(store, policy, auditLogger, logging.WithComponent(env.logger, "admin-key-backup-approve"))
+	request, err := uc.Execute(ctx, adminusecase.ApproveKeyBackupInput{
+		RequestID:   requestID,
+		Fingerprint: *fingerprint,
+		Signature:   keybackup.SignApproval(signingKey, requestID, *fingerprint),
+	})
+	if err != nil {
+		return result{}, err
+	}
+	return keyBackupResult(request, env.cfg.KeyBackup.Quorum), nil
+}
+
+func runKeysBackupStatus(ctx context.Context, env *environment, args []string) (result, error) {
+	fs := newFlagSet("keys backup-status")
+	if err := fs.Parse(args); err != nil {
+		return result{}, err
+	}
+	requestID, err := keyBackupRequestArg(fs.Args())
+	if err != nil {
+		return result{}, err
+	}
+
+	corePool, err := env.pool(ctx, "core")
+	if err != nil {
+		return result{}, err
+	}
+	request, err := postgres.NewKeyBackupRepository(corePool, logging.WithComponent(env.logger, "key-backup-repository")).GetByID(ctx, requestID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return result{}, fmt.Errorf("key backup request %s not found", requestID)
+		}
+		return result{}, err
+	}
+	return keyBackupResult(request, env.cfg.KeyBackup.Quorum), nil
+}
+
+func runKeysBackupExport(ctx context.Context, env *environment, args []string) (result, error) {
+	fs := newFlagSet("keys backup-export")
+	outPath := fs.String("out", "", "archive file to create")
+	if err := fs.Parse(args); err != nil {
+		return result{}, err
+	}
+	requestID, err := keyBackupRequestArg(fs.Args())
+	if err != nil {
+		return result{}, err
+	}
+	if strings.TrimSpace(*outPath) == "" {
+		return result{}, errors.New("-out is required")
+	}
+
+	store, auditLogger, err := keyBackupDeps(ctx, env)
+	if err != nil {
+		return result{}, err
+	}
+
+	policy, err := keyBackupPolicy(env.cfg)
+	if err != nil {
+		return result{}, err
+	}
+
+	file, err := os.OpenFile(*outPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return result{}, fmt.Errorf("create archive: %w", err)
+	}
+	uc := adminusecase.NewExportKeyBackupUseCase(store, policy, auditLogger, logging.WithComponent(env.logger, "admin-key-backup-export"))
+	exported, err := uc.Execute(ctx, adminusecase.ExportKeyBackupInput{RequestID: requestID, Actor: env.operator}, file)
+	if closeErr := file.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("write archive: %w", closeErr)
+	}
+	if err != nil {
+		os.Remove(*outPath)
+		return result{}, err
+	}
+
+	return result{
+		Value:   exported,
+		Headers: []string{"FIELD", "VALUE"},
+		Rows: keyValueRows(
+			"request_id", exported.RequestID.String(),
+			"archive", *outPath,
+			"archive_sha256", exported.ArchiveSHA256,
+			"wallets", strconv.Itoa(exported.WalletCount),
+			"recipient_fingerprint", exported.RecipientFingerprint,
+			"exported_at", exported.ExportedAt.Format(time.RFC3339),
+		),
+		Notes: []string{
+			"verify the copy on the offline machine with: walletctl keys backup-verify -offline -private-key <key.pem> <archive>",
+			"restoring also requires WALLET_ENCRYPTION_KEY, which is not part of the archive",
+		},
+	}, nil
+}
+
+func runKeysBackupVerify(ctx context.Context, env *environment, args []string) (result, error) {
+	fs := newFlagSet("keys backup-verify")
+	publicKeyPath := fs.String("public-key", "", "recipient public key the archive must be wrapped for")
+	privateKeyPath := fs.String("private-key", "", "offline private key; decrypts and checks every segment")
+	offline := fs.Bool("offline", false, "do not compare the archive with the export record in the core database")
+	if err := fs.Parse(args); err != nil {
+		return result{}, err
+	}
+	archivePath, err := singleArg(fs, "archive")
+	if err != nil {
+		return result{}, err
+	}
+
+	input := adminusecase.VerifyKeyBackupInput{}
+	if *publicKeyPath != "" {
+		if input.RecipientPublicKeyPEM, err = os.ReadFile(*publicKeyPath); err != nil {
+			return result{}, fmt.Errorf("read public key: %w", err)
+		}
+	}
+	if *privateKeyPath != "" {
+		if input.PrivateKeyPEM, err = os.ReadFile(*privateKeyPath); err != nil {
+			return result{}, fmt.Errorf("read private key: %w", err)
+		}
+	}
+
+	var store repositories.KeyBackupRepository
+	if !*offline {
+		corePool, err := env.pool(ctx, "core")
+		if err != nil {
+			return result{}, fmt.Errorf("%w (use -offline to check the archive on its own)", err)
+		}
+		store = postgres.NewKeyBackupRepository(corePool, logging.WithComponent(env.logger, "key-backup-repository"))
+	}
+
+	archive, err := os.Open(archivePath)
+	if err != nil {
+		return result{}, fmt.Errorf("open archive: %w", err)
+	}
+	defer archive.Close()
+	input.Archive = archive
+
+	uc := adminusecase.NewVerifyKeyBackupUseCase(store, logging.WithComponent(env.logger, "admin-key-backup-verify"))
+	verification, err := uc.Execute(ctx, input)
+	if err != nil {
+		return result{}, err
+	}
+
+	rows := keyValueRows(
+		"request_id", verification.RequestID.String(),
+		"created_at", verification.CreatedAt.Format(time.RFC3339),
+		"recipient_fingerprint", verification.RecipientFingerprint,
+		"segments", strconv.Itoa(verification.Segments),
+		"archive_sha256", verification.ArchiveSHA256,
+		"decrypted", strconv.FormatBool(verification.Decrypted),
+		"matches_export_record", strconv.FormatBool(verification.RecordChecked),
+	)
+	if verification.Decrypted {
+		rows = append(rows, []string{"wallets", strconv.Itoa(verification.WalletCount)})
+		for _, chain := range verification.ChainNames() {
+			rows = append(rows, []string{"wallets_" + strings.ToLower(chain), strconv.Itoa(verification.Chains[chain])})
+		}
+	}
+	return result{Value: verification, Headers: []string{"FIELD", "VALUE"}, Rows: rows}, nil
+}
+
+// keyBackupDeps opens the key backup store and the audit log every key backup step is recorded in.
+func keyBackupDeps(ctx context.Context, env *environment) (repositories.KeyBackupRepository, *audit.Logger, error) {
+	auditLogger, err := env.durableAudit(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	corePool, err := env.pool(ctx, "core")
+	if err != nil {
+		return nil, nil, err
+	}
+	return postgres.NewKeyBackupRepository(corePool, logging.WithComponent(env.logger, "key-backup-repository")), auditLogger, nil
+}
+
+// keyBackupPolicy loads each approver's Ed25519 public key from the file named for them in
+// KEY_BACKUP_APPROVERS.
+func keyBackupPolicy(cfg ctlConfig) (adminusecase.KeyBackupPolicy, error) {
+	policy := cfg.KeyBackup
+	names := make([]string, 0, len(cfg.KeyBackupApproverKeys))
+	for name := range cfg.KeyBackupApproverKeys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	policy.Approvers = make([]adminusecase.KeyBackupApprover, 0, len(names))
+	for _, name := range names {
+		keyPath := cfg.KeyBackupApproverKeys[name]
+		if keyPath == "" {
+			return policy, fmt.Errorf("key backup approver %q has no public key file (set KEY_BACKUP_APPROVERS to name=path entries)", name)
+		}
+		keyPEM, err := os.ReadFile(keyPath)
+		if err != nil {
+			return policy, fmt.Errorf("read public key of key backup approver %q: %w", name, err)
+		}
+		key, err := keybackup.ParseApproverPublicKey(keyPEM)
+		if err != nil {
+			return policy, fmt.Errorf("key backup approver %q: %w", name, err)
+		}
+		policy.Approvers = append(policy.Approvers, adminusecase.KeyBackupApprover{Name: name, PublicKey: key})
+	}
+	return policy, nil
+}
+
+func keyBackupRequestArg(args []string) (uuid.UUID, error) {
+	if len(args) != 1 {
+		return uuid.Nil, errors.New("expected exactly one request id argument")
+	}
+	id, err := uuid.Parse(strings.TrimSpace(args[0]))
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid request id %q", args[0])
+	}
+	return id, nil
+}
+
+func keyBackupResult(request entities.KeyBackupRequest, quorum int) result {
+	approvers := make([]string, 0, len(request.Approvals))
+	for _, approval := range request.Approvals {
+		approvers = append(approvers, approval.Approver)
+	}
+	rows := keyValueRows(
+		"id", request.ID.String(),
+		"status", string(request.Status),
+		"requested_by", request.RequestedBy,
+		"reason", request.Reason,
+		"recipient_fingerprint", request.RecipientFingerprint,
+		"approvals", fmt.Sprintf("%d of %d (%s)", len(request.Approvals), max(request.Quorum, quorum), strings.Join(approvers, ", ")),
+		"expires_at", request.ExpiresAt.Format(time.RFC3339),
+	)
+	if request.ArchiveSHA256 != "" {
+		rows = append(rows, keyValueRows(
+			"exported_by", request.ExportedBy,
+			"archive_sha256", request.ArchiveSHA256,
+			"wallets", strconv.Itoa(request.WalletCount),
+		)...)
+	}
+	// The recipient key itself is only needed by the export; keep the JSON output short.
+	request.RecipientPublicKey = ""
+	return result{Value: request, Headers: []string{"FIELD", "VALUE"}, Rows: rows}
+}
@@ -0,0 +1,204 @@
+// Command walletctl performs operational tasks against the wallet backend's databases using the
+// same repositories and use cases as the server.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	adminusecase "github.com/crypto-wallet/backend/internal/application/usecases/admin"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/internal/infrastructure/database"
+	"github.com/crypto-wallet/backend/internal/infrastructure/logging"
+	"github.com/crypto-wallet/backend/internal/infrastructure/repository/postgres"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// command is one walletctl subcommand, addressed as "<group> <action>".
+type command struct {
+	name    string
+	usage   string
+	summary string
+	run     func(ctx context.Context, env *environment, args []string) (result, error)
+}
+
+// environment carries shared dependencies; database pools are opened on first use.
+type environment struct {
+	cfg      ctlConfig
+	logger   *slog.Logger
+	pools    *database.PoolManager
+	operator string
+	audit    *audit.Logger
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	global := flag.NewFlagSet("walletctl", flag.ContinueOnError)
+	global.SetOutput(stderr)
+	format := global.String("o", formatTable, "output format: table or json")
+	operator := global.String("operator", defaultOperator(), "operator name recorded in the audit log")
+	global.Usage = func() { printUsage(stderr) }
+	if err := global.Parse(args); err != nil {
+		return 2
+	}
+
+	rest := global.Args()
+	if len(rest) < 2 {
+		printUsage(stderr)
+		return 2
+	}
+	cmd, ok := commands()[rest[0]+" "+rest[1]]
+	if !ok {
+		fmt.Fprintf(stderr, "walletctl: unknown command %q\n\n", strings.Join(rest[:2], " "))
+		printUsage(stderr)
+		return 2
+	}
+	if *format != formatTable && *format != formatJSON {
+		fmt.Fprintf(stderr, "walletctl: unsupported output format %q\n", *format)
+		return 2
+	}
+	if strings.TrimSpace(*operator) == "" {
+		fmt.Fprintln(stderr, "walletctl: --operator is required when USER is not set")
+		return 2
+	}
+
+	cfg := loadConfig()
+	logger, err := logging.NewLogger(logging.Config{Level: cfg.LogLevel, Format: "text", Output: stderr})
+	if err != nil {
+		fmt.Fprintf(stderr, "walletctl: %v\n", err)
+		return 1
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	env := &environment{
+		cfg:      cfg,
+		logger:   logger,
+		pools:    database.NewPoolManager(logging.WithComponent(logger, "database")),
+		operator: *operator,
+		audit:    audit.NewLogger(logger),
+	}
+	defer env.pools.CloseAll()
+
+	started := time.Now()
+	res, runErr := cmd.run(ctx, env, rest[2:])
+	env.auditor().record(ctx, cmd.name, rest[2:], started, runErr)
+
+	if runErr != nil {
+		if errors.Is(runErr, flag.ErrHelp) {
+			return 2
+		}
+		fmt.Fprintf(stderr, "walletctl %s: %s\n", cmd.name, describeError(runErr))
+		return 1
+	}
+	if err := render(stdout, *format, res); err != nil {
+		fmt.Fprintf(stderr, "walletctl: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// pool opens the named database on first use.
+func (e *environment) pool(ctx context.Context, name string) (*pgxpool.Pool, error) {
+	if pool, err := e.pools.Get(name); err == nil {
+		return pool, nil
+	}
+
+	dsn := strings.TrimSpace(e.cfg.DatabaseDSNs[name])
+	if dsn == "" {
+		return nil, fmt.Errorf("%s database is not configured (set %s_DB_DSN)", name, strings.ToUpper(name))
+	}
+	if err := e.pools.Register(ctx, name, database.PoolConfig{DSN: dsn, MaxConns: 2}); err != nil {
+		return nil, err
+	}
+	return e.pools.Get(name)
+}
+
+// auditor records to the audit database when it is reachable, otherwise only to the log.
+func (e *environment) auditor() *invocationAuditor {
+	var repo repositories.AuditLogRepository
+	if strings.TrimSpace(e.cfg.DatabaseDSNs["audit"]) != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if pool, err := e.pool(ctx, "audit"); err == nil {
+			repo = postgres.NewAuditLogRepository(pool, logging.WithComponent(e.logger, "audit-repository"))
+		} else {
+			e.logger.Warn("audit database unavailable; invocation logged only", slog.String("error", err.Error()))
+		}
+	}
+	return newInvocationAuditor(repo, e.operator, logging.WithComponent(e.logger, "walletctl-audit"))
+}
+
+// durableAudit returns an audit logger that persists to the audit database, for workflows that
+// must not run unless every step is recorded there.
+func (e *environment) durableAudit(ctx context.Context) (*audit.Logger, error) {
+	pool, err := e.pool(ctx, "audit")
+	if err != nil {
+		return nil, fmt.Errorf("audit database is required: %w", err)
+	}
+	return audit.NewPersistentLogger(postgres.NewAuditLogRepository(pool, logging.WithComponent(e.logger, "audit-repository")), e.logger), nil
+}
+
+// confirmationTokens returns nil without ADMIN_CONFIRMATION_SECRET, which limits destructive
+// commands to dry runs.
+func (e *environment) confirmationTokens() *adminusecase.ConfirmationTokens {
+	tokens, err := adminusecase.NewConfirmationTokens(e.cfg.ConfirmSecret, e.cfg.ConfirmTTL)
+	if err != nil {
+		return nil
+	}
+	return tokens
+}
+
+func describeError(err error) string {
+	var appErr *utils.AppError
+	if errors.As(err, &appErr) {
+		if appErr.Details != nil {
+			return fmt.Sprintf("%s (%s) %v", appErr.Message, appErr.Code, appErr.Details)
+		}
+		return fmt.Sprintf("%s (%s)", appErr.Message, appErr.Code)
+	}
+	return err.Error()
+}
+
+func defaultOperator() string {
+	if operator := strings.TrimSpace(os.Getenv("WALLETCTL_OPERATOR")); operator != "" {
+		return operator
+	}
+	return strings.TrimSpace(os.Getenv("USER"))
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "usage: walletctl [-o table|json] [-operator name] <command> [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "commands:")
+
+	all := commands()
+	names := make([]string, 0, len(all))
+	for name := range all {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, name := range names {
+		fmt.Fprintf(tw, "  %s\t%s\n", all[name].usage, all[name].summary)
+	}
+	tw.Flush()
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+)
+
+// result is what a command produced: Value is rendered as JSON, Headers/Rows as a table. Notes are
+// printed under the table only.
+type result struct {
+	Value   any
+	Headers []string
+	Rows    [][]string
+	Notes   []string
+}
+
+func render(w io.Writer, format string, res result) error {
+	switch format {
+	case formatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(res.Value)
+	case formatTable:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		if len(res.Headers) > 0 {
+			fmt.Fprintln(tw, strings.Join(res.Headers, "\t"))
+		}
+		for _, row := range res.Rows {
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+		if len(res.Notes) > 0 {
+			fmt.Fprintln(w)
+		}
+		for _, note := range res.Notes {
+			fmt.Fprintln(w, note)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format %q (use table or json)", format)
+	}
+}
+
+// keyValueRows renders ordered key/value pairs as a two-column table.
+func keyValueRows(pairs ...string) [][]string {
+	rows := make([][]string, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		rows = append(rows, []string{pairs[i], pairs[i+1]})
+	}
+	return rows
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	ratesusecase "github.com/crypto-wallet/backend/internal/application/usecases/rates"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/infrastructure/external"
+	"github.com/crypto-wallet/backend/internal/infrastructure/logging"
+	"github.com/crypto-wallet/backend/internal/infrastructure/repository/postgres"
+)
+
+// backfillChunk is the number of CSV rows validated and stored together; memory stays flat however
+// long the file is.
+const backfillChunk = 20000
+
+// loadListedAssets registers the listed tokens of the core asset registry so they count as supported
+// symbols; without a core database only native assets are known.
+func loadListedAssets(ctx context.Context, env *environment) error {
+	corePool, err := env.pool(ctx, "core")
+	if err != nil {
+		return nil
+	}
+	assets, err := postgres.NewAssetRepository(corePool, logging.WithComponent(env.logger, "asset-repository")).List(ctx, true)
+	if err != nil {
+		return err
+	}
+	entities.ListAssets(assets)
+	return nil
+}
+
+func runRatesBackfill(ctx context.Context, env *environment, args []string) (result, error) {
+	fs := newFlagSet("rates backfill")
+	symbol := fs.String("symbol", "", "symbol the candles belong to")
+	interval := fs.String("interval", "", "candle interval: 1m, 5m, 15m, 1h, 4h, 1d or 1w")
+	if err := fs.Parse(args); err != nil {
+		return result{}, err
+	}
+	if strings.TrimSpace(*symbol) == "" || strings.TrimSpace(*interval) == "" {
+		return result{}, errors.New("-symbol and -interval are required")
+	}
+	path, err := singleArg(fs, "candles file")
+	if err != nil {
+		return result{}, err
+	}
+
+	var input io.Reader = os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return result{}, fmt.Errorf("open candles: %w", err)
+		}
+		defer file.Close()
+		input = file
+	}
+
+	ratesPool, err := env.pool(ctx, "rates")
+	if err != nil {
+		return result{}, err
+	}
+	if err := loadListedAssets(ctx, env); err != nil {
+		return result{}, err
+	}
+	backfill := ratesusecase.NewBackfillPriceHistoryUseCase(
+		postgres.NewRateRepository(ratesPool, logging.WithComponent(env.logger, "rate-repository")),
+		logging.WithComponent(env.logger, "rate-backfill"),
+	)
+
+	reader := csv.NewReader(input)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	var total ratesusecase.BackfillPriceHistoryResult
+	candles := make([]external.OHLCVData, 0, backfillChunk)
+	firstLine := 0
+	flush := func() error {
+		if len(candles) == 0 {
+			return nil
+		}
+		batch, err := backfill.Execute(ctx, ratesusecase.BackfillPriceHistoryInput{Symbol: *symbol, Interval: *interval, Candles: candles})
+		if err != nil {
+			return fmt.Errorf("rows starting at line %d: %w", firstLine, err)
+		}
+		total.Received += batch.Received
+		total.Inserted += batch.Inserted
+		total.Existing += batch.Existing
+		candles = candles[:0]
+		return nil
+	}
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return result{}, fmt.Errorf("read candles: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+		candle, err := parseCandleRecord(record)
+		if err != nil {
+			// A leading header row is skipped.
+			if line == 1 {
+				continue
+			}
+			return result{}, fmt.Errorf("line %d: %w", line, err)
+		}
+		if len(candles) == 0 {
+			firstLine = line
+		}
+		candles = append(candles, candle)
+		if len(candles) == backfillChunk {
+			if err := flush(); err != nil {
+				return result{}, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return result{}, err
+	}
+
+	return result{
+		Value:   total,
+		Headers: []string{"FIELD", "VALUE"},
+		Rows: keyValueRows(
+			"symbol", strings.ToUpper(strings.TrimSpace(*symbol)),
+			"interval", strings.ToLower(strings.TrimSpace(*interval)),
+			"received", strconv.Itoa(total.Received),
+			"inserted", strconv.FormatInt(total.Inserted, 10),
+			"existing", strconv.FormatInt(total.Existing, 10),
+		),
+	}, nil
+}
+
+func runRatesBackfillHistory(ctx context.Context, env *environment, args []string) (result, error) {
+	fs := newFlagSet("rates backfill-history")
+	symbol := fs.String("symbol", "", "symbol to backfill")
+	interval := fs.String("interval", string(entities.Interval1d), "candle interval: 1h, 4h, 1d or 1w")
+	from := fs.String("from", "", "first day to backfill (YYYY-MM-DD)")
+	to := fs.String("to", "", "day to stop before (YYYY-MM-DD); defaults to now")
+	pace := fs.Duration("pace", ratesusecase.DefaultBackfillPace, "minimum time between provider requests")
+	if err := fs.Parse(args); err != nil {
+		return result{}, err
+	}
+	if strings.TrimSpace(*symbol) == "" || strings.TrimSpace(*from) == "" {
+		return result{}, errors.New("-symbol and -from are required")
+	}
+
+	input := ratesusecase.RunPriceBackfillInput{Symbol: *symbol, Interval: *interval}
+	var err error
+	if input.From, err = time.Parse(time.DateOnly, strings.TrimSpace(*from)); err != nil {
+		return result{}, fmt.Errorf("invalid -from: %w", err)
+	}
+	if strings.TrimSpace(*to) != "" {
+		if input.To, err = time.Parse(time.DateOnly, strings.TrimSpace(*to)); err != nil {
+			return result{}, fmt.Errorf("invalid -to: %w", err)
+		}
+	}
+
+	ratesPool, err := env.pool(ctx, "rates")
+	if err != nil {
+		return result{}, err
+	}
+	if err := loadListedAssets(ctx, env); err != nil {
+		return result{}, err
+	}
+	rateRepo := postgres.NewRateRepository(ratesPool, logging.WithComponent(env.logger, "rate-repository"))
+	backfill := ratesusecase.NewRunPriceBackfillUseCase(ratesusecase.RunPriceBackfillConfig{
+		Source: external.NewCoinGeckoClient(external.CoinGeckoConfig{
+			APIKey:  env.cfg.CoinGeckoAPIKey,
+			CoinIDs: entities.ListedCoingeckoIDs(),
+			Logger:  logging.WithComponent(env.logger, "coingecko"),
+		}),
+		Jobs:    postgres.NewPriceBackfillRepository(ratesPool, logging.WithComponent(env.logger, "price-backfill-repository")),
+		Candles: ratesusecase.NewBackfillPriceHistoryUseCase(rateRepo, logging.WithComponent(env.logger, "rate-backfill")),
+		Pace:    *pace,
+		Logger:  logging.WithComponent(env.logger, "price-backfill"),
+	})
+
+	job, err := backfill.Execute(ctx, input)
+	if err != nil {
+		if job.ID == uuid.Nil {
+			return result{}, err
+		}
+		return result{}, fmt.Errorf("%w (stopped at %s; rerun with the same -symbol, -interval and -from to resume)",
+			err, job.Cursor.Format(time.RFC3339))
+	}
+
+	return result{
+		Value:   job,
+		Headers: []string{"FIELD", "VALUE"},
+		Rows: keyValueRows(
+			"job_id", job.ID.String(),
+			"symbol", job.Symbol,
+			"interval", string(job.Interval),
+			"range_start", job.RangeStart.Format(time.RFC3339),
+			"range_end", job.RangeEnd.Format(time.RFC3339),
+			"status", string(job.Status),
+			"candles_inserted", strconv.FormatInt(job.CandlesInserted, 10),
+		),
+	}, nil
+}
+
+// parseCandleRecord reads "timestamp,open,high,low,close[,volume]". The timestamp is the bucket start
+// as RFC 3339 or Unix seconds or milliseconds.
+func parseCandleRecord(record []string) (external.OHLCVData, error) {
+	if len(record) != 5 && len(record) != 6 {
+		return external.OHLCVData{}, fmt.Errorf("expected 5 or 6 fields, got %d", len(record))
+	}
+
+	timestamp, err := parseCandleTime(strings.TrimSpace(record[0]))
+	if err != nil {
+		return external.OHLCVData{}, err
+	}
+
+	names := []string{"open", "high", "low", "close", "volume"}
+	values := make([]decimal.Decimal, len(names))
+	for i, field := range record[1:] {
+		if values[i], err = decimal.NewFromString(strings.TrimSpace(field)); err != nil {
+			return external.OHLCVData{}, fmt.Errorf("invalid %s %q", names[i], field)
+		}
+	}
+
+	return external.OHLCVData{
+		Timestamp: timestamp,
+		Open:      values[0],
+		High:      values[1],
+		Low:       values[2],
+		Close:     values[3],
+		Volume:    values[4],
+	}, nil
+}
+
+func parseCandleTime(value string) (time.Time, error) {
+	if unix, err := strconv.ParseInt(value, 10, 64); err == nil {
+		// Second timestamps stay below 1e11 until the year 5138.
+		if unix >= 1e11 {
+			return time.UnixMilli(unix).UTC(), nil
+		}
+		return time.Unix(unix, 0).UTC(), nil
+	}
+	timestamp, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp %q", value)
+	}
+	return timestamp.UTC(), nil
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/infrastructure/logging"
+	"github.com/crypto-wallet/backend/internal/infrastructure/repository/postgres"
+	"github.com/crypto-wallet/backend/internal/infrastructure/sandbox"
+	"github.com/crypto-wallet/backend/internal/infrastructure/security"
+)
+
+func runSandboxProfile(ctx context.Context, env *environment, args []string) (result, error) {
+	fs := newFlagSet("sandbox profile")
+	days := fs.Int("days", 90, "window of activity to summarise, in days")
+	minGroup := fs.Int("min-group", entities.DefaultSandboxMinGroupSize, "smallest population a statistic may describe")
+	outPath := fs.String("out", "", "profile file to create")
+	if err := fs.Parse(args); err != nil {
+		return result{}, err
+	}
+	if strings.TrimSpace(*outPath) == "" {
+		return result{}, errors.New("-out is required")
+	}
+	if *days <= 0 {
+		return result{}, errors.New("-days must be positive")
+	}
+	if *minGroup < entities.DefaultSandboxMinGroupSize {
+		return result{}, fmt.Errorf("-min-group must be at least %d", entities.DefaultSandboxMinGroupSize)
+	}
+
+	corePool, err := env.pool(ctx, "core")
+	if err != nil {
+		return result{}, err
+	}
+	capturedAt := time.Now().UTC()
+	repo := postgres.NewSandboxProfileRepository(corePool, logging.WithComponent(env.logger, "sandbox-profile-repository"))
+	profile, err := repo.Capture(ctx, capturedAt.AddDate(0, 0, -*days), *minGroup)
+	if err != nil {
+		return result{}, err
+	}
+	profile.Version = entities.SandboxProfileVersion
+	profile.CapturedAt = capturedAt
+	profile.WindowDays = *days
+
+	encoded, err := json.MarshalIndent(profile, "", "  ")
+	if err != nil {
+		return result{}, fmt.Errorf("encode profile: %w", err)
+	}
+	if err := writeNewFile(*outPath, append(encoded, '\n')); err != nil {
+		return result{}, err
+	}
+
+	return result{
+		Value:   profile,
+		Headers: []string{"FIELD", "VALUE"},
+		Rows: keyValueRows(
+			"profile", *outPath,
+			"window_days", strconv.Itoa(profile.WindowDays),
+			"min_group_size", strconv.Itoa(profile.MinGroupSize),
+			"wallet_chains", strconv.Itoa(len(profile.Wallets)),
+			"transaction_chains", strconv.Itoa(len(profile.Transactions)),
+			"swap_pairs", strconv.Itoa(len(profile.SwapPairs)),
+		),
+		Notes: []string{"the profile holds only shares and quantiles; generate a dataset with: walletctl sandbox generate -profile <file> -out <seed.sql>"},
+	}, nil
+}
+
+func runSandboxGenerate(_ context.Context, env *environment, args []string) (result, error) {
+	fs := newFlagSet("sandbox generate")
+	profilePath := fs.String("profile", "", "profile written by sandbox profile")
+	users := fs.Int("users", 100, "users to generate")
+	seed := fs.Uint64("seed", 1, "random seed; the same seed reproduces the same dataset")
+	days := fs.Int("days", 0, "span of generated activity in days (default: the profile's window)")
+	end := fs.String("end", "", "last day of generated activity, YYYY-MM-DD (default: today)")
+	outPath := fs.String("out", "", "SQL file to create")
+	if err := fs.Parse(args); err != nil {
+		return result{}, err
+	}
+	if strings.TrimSpace(*profilePath) == "" || strings.TrimSpace(*outPath) == "" {
+		return result{}, errors.New("-profile and -out are required")
+	}
+
+	opts := sandbox.Options{Users: *users, Seed: *seed, Days: *days}
+	if strings.TrimSpace(*end) != "" {
+		day, err := time.Parse(time.DateOnly, strings.TrimSpace(*end))
+		if err != nil {
+			return result{}, fmt.Errorf("invalid -end: %w", err)
+		}
+		opts.End = day.AddDate(0, 0, 1)
+	}
+	if env.cfg.SandboxPassword != "" {
+		hasher, err := security.NewBcryptHasher(0)
+		if err != nil {
+			return result{}, err
+		}
+		if opts.PasswordHash, err = hasher.Hash(env.cfg.SandboxPassword); err != nil {
+			return result{}, fmt.Errorf("SANDBOX_USER_PASSWORD: %w", err)
+		}
+	}
+
+	raw, err := os.ReadFile(*profilePath)
+	if err != nil {
+		return result{}, fmt.Errorf("read profile: %w", err)
+	}
+	var profile entities.SandboxProfile
+	if err := json.Unmarshal(raw, &profile); err != nil {
+		return result{}, fmt.Errorf("decode profile: %w", err)
+	}
+
+	file, err := os.OpenFile(*outPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return result{}, fmt.Errorf("create seed: %w", err)
+	}
+	summary, err := sandbox.Generate(file, profile, opts)
+	if closeErr := file.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("write seed: %w", closeErr)
+	}
+	if err != nil {
+		os.Remove(*outPath)
+		return result{}, err
+	}
+
+	notes := []string{"load it with: ./database/seed/run-seeds.sh --sandbox " + *outPath}
+	if opts.PasswordHash == "" {
+		notes = append(notes, "password sign-in is disabled for generated users; set SANDBOX_USER_PASSWORD to enable it")
+	}
+	return result{
+		Value:   summary,
+		Headers: []string{"FIELD", "VALUE"},
+		Rows: keyValueRows(
+			"seed_file", *outPath,
+			"users", strconv.Itoa(summary.Users),
+			"wallets", strconv.Itoa(summary.Wallets),
+			"transactions", strconv.Itoa(summary.Transactions),
+			"swaps", strconv.Itoa(summary.Swaps),
+		),
+		Notes: notes,
+	}, nil
+}
+
+// writeNewFile refuses to overwrite an existing file.
+func writeNewFile(path string, data []byte) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return fmt.Errorf("create %s: %w", path, err)
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		os.Remove(path)
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	return file.Close()
+}
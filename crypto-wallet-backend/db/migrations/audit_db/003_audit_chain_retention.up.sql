@@ -0,0 +1,39 @@
+-- +goose Up
+-- Hash chaining for tamper evidence and archival bookkeeping for retention.
+
+ALTER TABLE audit_logs
+    ADD COLUMN sequence BIGINT GENERATED ALWAYS AS IDENTITY,
+    ADD COLUMN prev_hash CHAR(64),
+    ADD COLUMN record_hash CHAR(64);
+
+CREATE UNIQUE INDEX idx_audit_logs_sequence ON audit_logs(sequence);
+CREATE INDEX idx_audit_logs_action_timestamp ON audit_logs(action, timestamp DESC);
+CREATE INDEX idx_audit_logs_resource_id ON audit_logs(resource_id) WHERE resource_id IS NOT NULL;
+
+-- Records are append-only; retention removes archived rows but nothing may rewrite them.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION audit_logs_reject_update() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_logs records are immutable';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER audit_logs_immutable
+    BEFORE UPDATE ON audit_logs
+    FOR EACH ROW EXECUTE FUNCTION audit_logs_reject_update();
+
+CREATE TABLE audit_log_archives (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    from_sequence BIGINT NOT NULL,
+    to_sequence BIGINT NOT NULL,
+    from_timestamp TIMESTAMP WITH TIME ZONE NOT NULL,
+    to_timestamp TIMESTAMP WITH TIME ZONE NOT NULL,
+    record_count INTEGER NOT NULL,
+    location TEXT NOT NULL,
+    checksum CHAR(64) NOT NULL,
+    last_hash CHAR(64),
+    archived_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_audit_log_archives_to_sequence ON audit_log_archives(to_sequence);
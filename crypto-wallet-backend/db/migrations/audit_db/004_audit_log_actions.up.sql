@@ -0,0 +1,11 @@
+-- +goose Up
+-- Application services now write their own audit entries, and their action names outgrew the
+-- fixed enum. Store actions as text so new operations do not need a schema change.
+
+ALTER TABLE audit_logs
+    ALTER COLUMN action TYPE VARCHAR(100) USING action::TEXT;
+
+DROP TYPE audit_action;
+
+CREATE INDEX idx_audit_logs_request_id ON audit_logs((details->>'request_id'))
+    WHERE details ? 'request_id';
@@ -0,0 +1,39 @@
+-- +goose Up
+-- Off-chain transfers between platform users.
+
+ALTER TYPE transaction_type ADD VALUE IF NOT EXISTS 'p2p_send';
+ALTER TYPE transaction_type ADD VALUE IF NOT EXISTS 'p2p_receive';
+
+CREATE TYPE p2p_transfer_status AS ENUM ('completed', 'pending_claim', 'returned');
+
+CREATE TABLE p2p_transfers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    sender_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    sender_wallet_id UUID NOT NULL REFERENCES wallets(id) ON DELETE CASCADE,
+    recipient_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    recipient_wallet_id UUID REFERENCES wallets(id) ON DELETE SET NULL,
+    recipient_email VARCHAR(255) NOT NULL,
+    chain blockchain_chain NOT NULL,
+    amount DECIMAL(36, 18) NOT NULL CHECK (amount > 0),
+    note VARCHAR(280),
+    status p2p_transfer_status NOT NULL,
+    claim_code_hash VARCHAR(128),
+    expires_at TIMESTAMP WITH TIME ZONE,
+    settled_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_p2p_transfers_sender_created
+    ON p2p_transfers(sender_user_id, created_at DESC);
+
+CREATE INDEX idx_p2p_transfers_recipient_created
+    ON p2p_transfers(recipient_user_id, created_at DESC);
+
+CREATE UNIQUE INDEX idx_p2p_transfers_claim_code_hash
+    ON p2p_transfers(claim_code_hash)
+    WHERE claim_code_hash IS NOT NULL;
+
+CREATE INDEX idx_p2p_transfers_pending_expiry
+    ON p2p_transfers(expires_at)
+    WHERE status = 'pending_claim';
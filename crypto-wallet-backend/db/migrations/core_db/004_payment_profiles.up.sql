@@ -0,0 +1,31 @@
+-- +goose Up
+-- User handles and optional public payment profiles.
+
+CREATE TABLE payment_profiles (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    handle VARCHAR(30) NOT NULL,
+    display_name VARCHAR(60),
+    accepted_assets TEXT[] NOT NULL DEFAULT '{}',
+    is_public BOOLEAN NOT NULL DEFAULT FALSE,
+    allow_handle_payments BOOLEAN NOT NULL DEFAULT TRUE,
+    handle_changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_payment_profiles_handle
+    ON payment_profiles(LOWER(handle));
+
+-- Handles given up by a user stay unavailable to others until available_at.
+CREATE TABLE released_handles (
+    handle VARCHAR(30) PRIMARY KEY,
+    previous_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    available_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    released_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_released_handles_available_at
+    ON released_handles(available_at);
+
+-- Transfers addressed by handle keep the handle so the recipient's email is not shown to the sender.
+ALTER TABLE p2p_transfers ADD COLUMN recipient_handle VARCHAR(30);
@@ -0,0 +1,48 @@
+-- +goose Up
+-- Disputes raised on settled P2P transfers and their audit trail.
+
+CREATE TYPE p2p_dispute_status AS ENUM ('open', 'held', 'resolved');
+
+CREATE TABLE p2p_disputes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    transfer_id UUID NOT NULL REFERENCES p2p_transfers(id) ON DELETE CASCADE,
+    opened_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    sender_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    recipient_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason VARCHAR(32) NOT NULL,
+    description TEXT,
+    status p2p_dispute_status NOT NULL DEFAULT 'open',
+    outcome VARCHAR(32),
+    resolution_note TEXT,
+    held_at TIMESTAMP WITH TIME ZONE,
+    resolved_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    resolved_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- A transfer can only have one unresolved dispute at a time.
+CREATE UNIQUE INDEX idx_p2p_disputes_active_transfer
+    ON p2p_disputes(transfer_id)
+    WHERE status <> 'resolved';
+
+CREATE INDEX idx_p2p_disputes_sender_created
+    ON p2p_disputes(sender_user_id, created_at DESC);
+
+CREATE INDEX idx_p2p_disputes_recipient_created
+    ON p2p_disputes(recipient_user_id, created_at DESC);
+
+CREATE INDEX idx_p2p_disputes_status_created
+    ON p2p_disputes(status, created_at);
+
+CREATE TABLE p2p_dispute_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    dispute_id UUID NOT NULL REFERENCES p2p_disputes(id) ON DELETE CASCADE,
+    actor_id UUID NOT NULL,
+    action VARCHAR(32) NOT NULL,
+    note TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_p2p_dispute_events_dispute
+    ON p2p_dispute_events(dispute_id, created_at);
@@ -0,0 +1,49 @@
+-- +goose Up
+-- Asynchronous export jobs and per-user accounting account mappings.
+
+CREATE TYPE export_job_status AS ENUM ('queued', 'processing', 'completed', 'failed');
+
+CREATE TABLE export_jobs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(32) NOT NULL,
+    format VARCHAR(32) NOT NULL,
+    parameters JSONB NOT NULL DEFAULT '{}'::jsonb,
+    status export_job_status NOT NULL DEFAULT 'queued',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    filename VARCHAR(255),
+    content_type VARCHAR(100),
+    content BYTEA,
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    row_count INTEGER NOT NULL DEFAULT 0,
+    error_message TEXT,
+    started_at TIMESTAMP WITH TIME ZONE,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_export_jobs_user_created
+    ON export_jobs(user_id, created_at DESC);
+
+-- Workers claim the oldest queued job first.
+CREATE INDEX idx_export_jobs_queue
+    ON export_jobs(created_at)
+    WHERE status IN ('queued', 'processing');
+
+CREATE INDEX idx_export_jobs_expires
+    ON export_jobs(expires_at)
+    WHERE expires_at IS NOT NULL;
+
+-- An empty chain holds the user's fallback mapping for chains without their own row.
+CREATE TABLE accounting_account_mappings (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    chain VARCHAR(10) NOT NULL DEFAULT '',
+    asset_account VARCHAR(100) NOT NULL,
+    clearing_account VARCHAR(100) NOT NULL,
+    fee_account VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, chain)
+);
@@ -0,0 +1,22 @@
+-- +goose Up
+-- Domain event outbox for data warehouse export, and per-sink export progress.
+
+CREATE TABLE event_outbox (
+    sequence BIGSERIAL PRIMARY KEY,
+    event_id UUID NOT NULL UNIQUE,
+    event_type VARCHAR(100) NOT NULL,
+    schema_version INTEGER NOT NULL DEFAULT 1,
+    payload JSONB NOT NULL DEFAULT '{}'::jsonb,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Backfills select by time window.
+CREATE INDEX idx_event_outbox_occurred_at
+    ON event_outbox(occurred_at, sequence);
+
+CREATE TABLE event_export_cursors (
+    sink VARCHAR(50) PRIMARY KEY,
+    last_sequence BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,4 @@
+-- +goose Up
+-- Operators can freeze a wallet; frozen wallets cannot send.
+
+ALTER TYPE wallet_status ADD VALUE IF NOT EXISTS 'frozen';
@@ -0,0 +1,19 @@
+-- +goose Up
+-- Soft launch of new chains: per-chain rollout settings and users who opted into a chain's beta.
+-- Chains without a rollout row are available to everyone.
+
+CREATE TABLE chain_rollouts (
+    chain blockchain_chain PRIMARY KEY,
+    percentage SMALLINT NOT NULL DEFAULT 0 CHECK (percentage BETWEEN 0 AND 100),
+    allowlist UUID[] NOT NULL DEFAULT '{}',
+    beta_opt_in BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_by UUID,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE chain_beta_opt_ins (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    chain blockchain_chain NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, chain)
+);
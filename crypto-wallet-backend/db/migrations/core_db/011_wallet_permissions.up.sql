@@ -0,0 +1,17 @@
+-- +goose Up
+-- Per-member permissions on shared wallets. Owners hold every permission and have no row.
+
+CREATE TABLE wallet_permissions (
+    wallet_id UUID NOT NULL REFERENCES wallets(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    permissions TEXT[] NOT NULL CHECK (
+        cardinality(permissions) > 0
+        AND permissions <@ ARRAY['view', 'initiate', 'approve']::TEXT[]
+    ),
+    granted_by UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (wallet_id, user_id)
+);
+
+CREATE INDEX idx_wallet_permissions_user_id ON wallet_permissions(user_id);
@@ -0,0 +1,26 @@
+-- +goose Up
+-- Third-party apps and the scopes users granted them. Clients are registered by operators.
+
+CREATE TABLE oauth_clients (
+    client_id VARCHAR(64) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    allowed_scopes TEXT[] NOT NULL CHECK (
+        cardinality(allowed_scopes) > 0
+        AND allowed_scopes <@ ARRAY['read:wallets', 'read:transactions', 'trade']::TEXT[]
+    ),
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE app_consents (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    client_id VARCHAR(64) NOT NULL REFERENCES oauth_clients(client_id) ON DELETE CASCADE,
+    scopes TEXT[] NOT NULL CHECK (cardinality(scopes) > 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    revoked_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_app_consents_user_active
+    ON app_consents(user_id, created_at DESC)
+    WHERE revoked_at IS NULL;
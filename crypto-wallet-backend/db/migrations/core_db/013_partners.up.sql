@@ -0,0 +1,21 @@
+-- +goose Up
+-- White-label partners authenticate with an API key (stored as a SHA-256 hash) and reference
+-- their users by their own identifiers.
+
+CREATE TABLE partners (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(100) NOT NULL,
+    api_key_hash CHAR(64) NOT NULL UNIQUE,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE partner_users (
+    partner_id UUID NOT NULL REFERENCES partners(id) ON DELETE CASCADE,
+    user_ref VARCHAR(128) NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (partner_id, user_ref)
+);
+
+CREATE INDEX idx_partner_users_user ON partner_users(user_id);
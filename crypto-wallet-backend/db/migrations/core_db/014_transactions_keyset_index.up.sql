@@ -0,0 +1,9 @@
+-- +goose Up
+-- Keyset pagination orders transactions by (created_at, id); these indexes let deep pages seek
+-- straight to the boundary row.
+
+CREATE INDEX IF NOT EXISTS idx_transactions_wallet_created_id
+    ON transactions(wallet_id, created_at DESC, id DESC);
+
+CREATE INDEX IF NOT EXISTS idx_transactions_created_id
+    ON transactions(created_at DESC, id DESC);
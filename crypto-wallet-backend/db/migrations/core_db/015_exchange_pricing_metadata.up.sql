@@ -0,0 +1,9 @@
+-- +goose Up
+-- Record which pricing strategy produced each quote and the inputs it used, so historical
+-- quotes stay explainable after trading pair rates or strategy settings change. Quotes created
+-- before strategies existed were priced at the mid rate with the pair fee, which is what
+-- fixed_spread does with a zero spread.
+
+ALTER TABLE exchange_operations
+    ADD COLUMN IF NOT EXISTS pricing_strategy VARCHAR(64) NOT NULL DEFAULT 'fixed_spread',
+    ADD COLUMN IF NOT EXISTS pricing_metadata JSONB NOT NULL DEFAULT '{}'::jsonb;
@@ -0,0 +1,50 @@
+-- +goose Up
+-- Asset registry: what a wallet holds and what trading pairs quote. Wallets used to be
+-- identified only by chain, which broke as soon as a chain carried more than one asset.
+-- Native assets use fixed identifiers so the rates database can reference them as well.
+
+CREATE TABLE assets (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    symbol VARCHAR(20) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    chain blockchain_chain NOT NULL,
+    contract_address VARCHAR(255),
+    decimals INTEGER NOT NULL CHECK (decimals BETWEEN 0 AND 36),
+    is_native BOOLEAN NOT NULL DEFAULT FALSE,
+    coingecko_id VARCHAR(100),
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CHECK (is_native = (contract_address IS NULL))
+);
+
+CREATE UNIQUE INDEX idx_assets_native_per_chain ON assets(chain) WHERE is_native;
+CREATE UNIQUE INDEX idx_assets_chain_contract ON assets(chain, lower(contract_address)) WHERE contract_address IS NOT NULL;
+CREATE INDEX idx_assets_symbol ON assets(symbol);
+
+INSERT INTO assets (id, symbol, name, chain, decimals, is_native, coingecko_id)
+VALUES
+    ('00000000-0000-4000-8000-000000000001', 'BTC', 'Bitcoin', 'BTC', 8, TRUE, 'bitcoin'),
+    ('00000000-0000-4000-8000-000000000002', 'ETH', 'Ethereum', 'ETH', 18, TRUE, 'ethereum'),
+    ('00000000-0000-4000-8000-000000000003', 'SOL', 'Solana', 'SOL', 9, TRUE, 'solana'),
+    ('00000000-0000-4000-8000-000000000004', 'XLM', 'Stellar Lumens', 'XLM', 7, TRUE, 'stellar');
+
+-- Carry over any non-native tokens already catalogued.
+INSERT INTO assets (symbol, name, chain, contract_address, decimals, is_native, coingecko_id, is_active)
+SELECT t.symbol, t.name, c.symbol, t.contract_address, t.decimals, FALSE, t.coingecko_id, t.is_active
+FROM tokens t
+JOIN chains c ON c.id = t.chain_id
+WHERE NOT t.is_native AND t.contract_address IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+-- Existing wallets hold their chain's native asset.
+ALTER TABLE wallets ADD COLUMN asset_id UUID REFERENCES assets(id);
+
+UPDATE wallets w
+SET asset_id = a.id
+FROM assets a
+WHERE a.chain = w.chain AND a.is_native AND w.asset_id IS NULL;
+
+ALTER TABLE wallets ALTER COLUMN asset_id SET NOT NULL;
+
+CREATE INDEX idx_wallets_asset_id ON wallets(asset_id);
@@ -0,0 +1,49 @@
+-- +goose Up
+-- Staff broadcast campaigns. A campaign stores its segment as JSON and, when its send time
+-- arrives, fixes the matching users as deliveries so later sign-ups or attribute changes do not
+-- alter who receives it. Deliveries double as the per-recipient open tracking record.
+
+CREATE TYPE broadcast_campaign_status AS ENUM ('scheduled', 'sending', 'completed', 'cancelled');
+CREATE TYPE broadcast_delivery_status AS ENUM ('pending', 'delivered', 'failed');
+
+CREATE TABLE broadcast_campaigns (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(200) NOT NULL,
+    title VARCHAR(200) NOT NULL,
+    body TEXT NOT NULL,
+    segment JSONB NOT NULL DEFAULT '{}'::jsonb,
+    status broadcast_campaign_status NOT NULL DEFAULT 'scheduled',
+    scheduled_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    throttle_per_minute INTEGER NOT NULL CHECK (throttle_per_minute > 0),
+    recipient_count BIGINT NOT NULL DEFAULT 0,
+    created_by UUID NOT NULL,
+    started_at TIMESTAMP WITH TIME ZONE,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    cancelled_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_broadcast_campaigns_created ON broadcast_campaigns(created_at DESC);
+
+-- The dispatcher looks for due and in-flight campaigns only.
+CREATE INDEX idx_broadcast_campaigns_active
+    ON broadcast_campaigns(status, scheduled_at)
+    WHERE status IN ('scheduled', 'sending');
+
+CREATE TABLE broadcast_deliveries (
+    campaign_id UUID NOT NULL REFERENCES broadcast_campaigns(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status broadcast_delivery_status NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_attempt_at TIMESTAMP WITH TIME ZONE,
+    error_message TEXT,
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    opened_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (campaign_id, user_id)
+);
+
+CREATE INDEX idx_broadcast_deliveries_pending
+    ON broadcast_deliveries(campaign_id, last_attempt_at NULLS FIRST)
+    WHERE status = 'pending';
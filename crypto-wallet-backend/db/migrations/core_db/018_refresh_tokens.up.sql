@@ -0,0 +1,21 @@
+-- +goose Up
+-- Rotating refresh tokens. Each sign-in starts a family; every refresh marks the presented token
+-- rotated and issues a successor in the same family. Presenting a rotated token revokes the family.
+
+CREATE TABLE refresh_tokens (
+    id UUID PRIMARY KEY,
+    family_id UUID NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    issued_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    rotated_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    CHECK (expires_at > issued_at)
+);
+
+CREATE INDEX idx_refresh_tokens_family
+    ON refresh_tokens(family_id)
+    WHERE revoked_at IS NULL;
+
+CREATE INDEX idx_refresh_tokens_user
+    ON refresh_tokens(user_id, issued_at DESC);
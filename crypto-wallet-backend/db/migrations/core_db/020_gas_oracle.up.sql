@@ -0,0 +1,23 @@
+-- +goose Up
+-- Gas oracle: short-term EVM fee history for estimates and charts, and each user's default speed
+-- for sends. History rows are purged by the oracle once they age out of its retention window.
+
+CREATE TABLE gas_price_history (
+    chain blockchain_chain NOT NULL,
+    block_number BIGINT NOT NULL,
+    base_fee_wei NUMERIC(78, 0) NOT NULL,
+    slow_priority_fee_wei NUMERIC(78, 0) NOT NULL,
+    normal_priority_fee_wei NUMERIC(78, 0) NOT NULL,
+    fast_priority_fee_wei NUMERIC(78, 0) NOT NULL,
+    gas_used_ratio DOUBLE PRECISION NOT NULL,
+    observed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (chain, block_number)
+);
+
+CREATE INDEX idx_gas_price_history_observed ON gas_price_history(chain, observed_at DESC);
+
+CREATE TABLE user_gas_preferences (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    speed VARCHAR(10) NOT NULL CHECK (speed IN ('slow', 'normal', 'fast')),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
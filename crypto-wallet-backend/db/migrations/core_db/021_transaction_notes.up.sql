@@ -0,0 +1,23 @@
+-- +goose Up
+-- Private transaction notes, end-to-end encrypted by the user's devices. The server stores opaque
+-- ciphertext only. version guards concurrent edits of one note; revision, drawn from a shared
+-- sequence, orders every note write so devices can sync incrementally.
+
+CREATE SEQUENCE transaction_note_revision_seq;
+
+CREATE TABLE transaction_notes (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    transaction_id UUID NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    ciphertext BYTEA,
+    key_id VARCHAR(100),
+    device_id VARCHAR(100),
+    version BIGINT NOT NULL DEFAULT 1 CHECK (version > 0),
+    revision BIGINT NOT NULL DEFAULT nextval('transaction_note_revision_seq'),
+    deleted BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, transaction_id),
+    CHECK (deleted OR (ciphertext IS NOT NULL AND key_id IS NOT NULL))
+);
+
+CREATE INDEX idx_transaction_notes_user_revision ON transaction_notes(user_id, revision);
@@ -0,0 +1,16 @@
+-- +goose Up
+-- Keyset indexes for the merged activity feed, which pages transactions and exchange operations
+-- together by (created_at, id) newest first.
+
+CREATE INDEX IF NOT EXISTS idx_transactions_wallet_created_id
+    ON transactions(wallet_id, created_at DESC, id DESC);
+
+CREATE INDEX IF NOT EXISTS idx_exchange_operations_user_created_id
+    ON exchange_operations(user_id, created_at DESC, id DESC);
+
+-- Transactions that are legs of an exchange are shown through the exchange instead.
+CREATE INDEX IF NOT EXISTS idx_exchange_operations_from_transaction_id
+    ON exchange_operations(from_transaction_id) WHERE from_transaction_id IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_exchange_operations_to_transaction_id
+    ON exchange_operations(to_transaction_id) WHERE to_transaction_id IS NOT NULL;
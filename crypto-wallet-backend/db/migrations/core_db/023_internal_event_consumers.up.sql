@@ -0,0 +1,16 @@
+-- +goose Up
+-- Internal services (analytics, data science) read the event outbox over the mTLS internal API.
+-- A consumer is identified by the SHA-256 fingerprint of its client certificate and may only read
+-- the event types matching its patterns ("exchange.*", "transaction.confirmed", "*"). Its committed
+-- offset is kept in event_export_cursors under the sink "internal:<name>".
+
+CREATE TABLE internal_event_consumers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(40) NOT NULL UNIQUE,
+    cert_fingerprint CHAR(64) NOT NULL UNIQUE,
+    event_types TEXT[] NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_seen_at TIMESTAMP WITH TIME ZONE
+);
+
@@ -0,0 +1,5 @@
+-- +goose Up
+-- Exports written to object storage keep only their key here; content stays NULL for them.
+
+ALTER TABLE export_jobs
+    ADD COLUMN storage_key VARCHAR(512);
@@ -0,0 +1,29 @@
+-- +goose Up
+-- Saved recipient addresses and the per-user whitelist-only send setting.
+
+CREATE TABLE address_book_entries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    chain blockchain_chain NOT NULL,
+    address VARCHAR(128) NOT NULL,
+    -- Matching form of the address: lowercased on EVM chains, verbatim elsewhere.
+    address_key VARCHAR(128) NOT NULL,
+    label VARCHAR(100) NOT NULL,
+    memo VARCHAR(255),
+    usable_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_address_book_entries_user_address
+    ON address_book_entries(user_id, chain, address_key);
+
+CREATE INDEX idx_address_book_entries_user_created
+    ON address_book_entries(user_id, created_at DESC);
+
+CREATE TABLE address_book_settings (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    whitelist_only BOOLEAN NOT NULL DEFAULT FALSE,
+    whitelist_off_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,25 @@
+-- +goose Up
+-- Disaster-recovery exports of encrypted wallet key material and their operator approvals.
+
+CREATE TABLE key_backup_requests (
+    id UUID PRIMARY KEY,
+    requested_by VARCHAR(100) NOT NULL,
+    reason TEXT NOT NULL,
+    recipient_public_key TEXT NOT NULL,
+    recipient_fingerprint CHAR(64) NOT NULL,
+    quorum INTEGER NOT NULL CHECK (quorum >= 2),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    exported_by VARCHAR(100),
+    archive_sha256 CHAR(64),
+    wallet_count INTEGER,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    exported_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE TABLE key_backup_approvals (
+    request_id UUID NOT NULL REFERENCES key_backup_requests(id) ON DELETE CASCADE,
+    approver VARCHAR(100) NOT NULL,
+    approved_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (request_id, approver)
+);
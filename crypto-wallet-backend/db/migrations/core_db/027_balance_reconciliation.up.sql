@@ -0,0 +1,47 @@
+-- +goose Up
+-- Nightly comparison of stored wallet balances against the chain, and the discrepancies it finds.
+
+CREATE TABLE balance_reconciliation_runs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    chain blockchain_chain NOT NULL,
+    mode VARCHAR(16) NOT NULL CHECK (mode IN ('sample', 'full')),
+    scanned INTEGER NOT NULL DEFAULT 0,
+    matched INTEGER NOT NULL DEFAULT 0,
+    corrected INTEGER NOT NULL DEFAULT 0,
+    escalated INTEGER NOT NULL DEFAULT 0,
+    failed INTEGER NOT NULL DEFAULT 0,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_balance_reconciliation_runs_started
+    ON balance_reconciliation_runs(started_at DESC);
+
+CREATE TABLE balance_discrepancies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    run_id UUID NOT NULL REFERENCES balance_reconciliation_runs(id) ON DELETE CASCADE,
+    wallet_id UUID NOT NULL REFERENCES wallets(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    chain blockchain_chain NOT NULL,
+    address VARCHAR(255) NOT NULL,
+    stored_balance DECIMAL(36, 18) NOT NULL,
+    on_chain_balance DECIMAL(36, 18) NOT NULL,
+    -- On-chain minus stored; magnitude is its absolute value.
+    difference DECIMAL(36, 18) NOT NULL,
+    magnitude DECIMAL(36, 18) NOT NULL,
+    status VARCHAR(16) NOT NULL CHECK (status IN ('corrected', 'open', 'resolved')),
+    resolved_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    resolution TEXT,
+    detected_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMP WITH TIME ZONE
+);
+
+-- A wallet has at most one open review item; later runs refresh it instead of adding another.
+CREATE UNIQUE INDEX idx_balance_discrepancies_open_wallet
+    ON balance_discrepancies(wallet_id) WHERE status = 'open';
+
+CREATE INDEX idx_balance_discrepancies_status_detected
+    ON balance_discrepancies(status, detected_at DESC);
+
+CREATE INDEX idx_balance_discrepancies_run
+    ON balance_discrepancies(run_id);
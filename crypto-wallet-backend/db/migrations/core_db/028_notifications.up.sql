@@ -0,0 +1,21 @@
+-- +goose Up
+-- In-app notification inbox fed by domain events.
+
+CREATE TABLE notifications (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type VARCHAR(32) NOT NULL,
+    event VARCHAR(64) NOT NULL,
+    title VARCHAR(140) NOT NULL,
+    body TEXT NOT NULL DEFAULT '',
+    data JSONB NOT NULL DEFAULT '{}'::jsonb,
+    read_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_notifications_user_created
+    ON notifications(user_id, created_at DESC);
+
+-- Keeps unread counts cheap for users with long histories.
+CREATE INDEX idx_notifications_user_unread
+    ON notifications(user_id, created_at DESC) WHERE read_at IS NULL;
@@ -0,0 +1,5 @@
+-- +goose Up
+-- Pricing simulations replay a trading pair's completed swaps over a recent execution window.
+
+CREATE INDEX IF NOT EXISTS idx_exchange_operations_completed_executed
+    ON exchange_operations(executed_at, id) WHERE status = 'completed';
@@ -0,0 +1,48 @@
+-- +goose Up
+-- Custom report builder: saved report definitions and the read models reports query. Reports
+-- only ever select from these views, always filtered by user_id.
+
+CREATE TABLE saved_reports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    definition JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, name)
+);
+
+CREATE VIEW analytics_report_transactions AS
+SELECT
+    t.id,
+    w.user_id,
+    t.wallet_id,
+    t.chain::text AS chain,
+    t.type::text AS type,
+    t.status::text AS status,
+    t.amount,
+    t.fee,
+    t.created_at
+FROM transactions t
+JOIN wallets w ON w.id = t.wallet_id;
+
+CREATE VIEW analytics_report_exchanges AS
+SELECT
+    e.id,
+    e.user_id,
+    fw.chain::text AS from_chain,
+    tw.chain::text AS to_chain,
+    e.status::text AS status,
+    e.from_amount,
+    e.to_amount,
+    e.fee_amount,
+    e.exchange_rate,
+    e.created_at
+FROM exchange_operations e
+JOIN wallets fw ON fw.id = e.from_wallet_id
+JOIN wallets tw ON tw.id = e.to_wallet_id;
+
+-- Exchange reports scan one user's operations by time.
+CREATE INDEX IF NOT EXISTS idx_exchange_operations_user_created
+    ON exchange_operations(user_id, created_at DESC);
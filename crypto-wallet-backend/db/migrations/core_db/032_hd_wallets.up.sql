@@ -0,0 +1,24 @@
+-- +goose Up
+-- HD wallets: one encrypted BIP39 seed per user and the next account index per chain. Wallets
+-- derived from the seed keep an empty encrypted_private_key; their key is re-derived from the seed
+-- at derivation_path when needed.
+
+CREATE TABLE hd_wallet_seeds (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    encrypted_seed TEXT NOT NULL,
+    source VARCHAR(16) NOT NULL CHECK (source IN ('generated', 'imported')),
+    backup_confirmed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE hd_wallet_account_indexes (
+    user_id UUID NOT NULL REFERENCES hd_wallet_seeds(user_id) ON DELETE CASCADE,
+    chain blockchain_chain NOT NULL,
+    next_index INTEGER NOT NULL DEFAULT 0 CHECK (next_index >= 0),
+    PRIMARY KEY (user_id, chain)
+);
+
+ALTER TABLE wallets
+    ADD CONSTRAINT wallets_key_material_present
+    CHECK (encrypted_private_key <> '' OR derivation_path IS NOT NULL);
@@ -0,0 +1,30 @@
+-- +goose Up
+-- Read-only portfolio share links and the log of their use. Only token hashes are stored.
+
+CREATE TABLE portfolio_shares (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    label VARCHAR(100) NOT NULL DEFAULT '',
+    scope VARCHAR(16) NOT NULL CHECK (scope IN ('summary', 'full_history')),
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    last_accessed_at TIMESTAMP WITH TIME ZONE,
+    CHECK (expires_at > created_at)
+);
+
+CREATE INDEX idx_portfolio_shares_user
+    ON portfolio_shares(user_id, created_at DESC);
+
+CREATE TABLE portfolio_share_accesses (
+    id UUID PRIMARY KEY,
+    share_id UUID NOT NULL REFERENCES portfolio_shares(id) ON DELETE CASCADE,
+    resource VARCHAR(32) NOT NULL,
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    accessed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_portfolio_share_accesses_share
+    ON portfolio_share_accesses(share_id, accessed_at DESC);
@@ -0,0 +1,13 @@
+-- +goose Up
+-- Deposit scanning: the last processed block per chain, and a transaction key that lets a deposit
+-- share its hash with the send row when both sides of a transfer are platform wallets.
+
+CREATE TABLE deposit_scan_cursors (
+    chain blockchain_chain PRIMARY KEY,
+    last_block BIGINT NOT NULL CHECK (last_block >= 0),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE transactions DROP CONSTRAINT transactions_chain_tx_hash_key;
+ALTER TABLE transactions
+    ADD CONSTRAINT transactions_chain_tx_hash_wallet_type_key UNIQUE (chain, tx_hash, wallet_id, type);
@@ -0,0 +1,11 @@
+-- +goose Up
+-- Wallet dormancy: long-inactive wallets become dormant and cannot send until their owner
+-- reactivates them with two-factor authentication. Activity itself is derived from sign-ins and
+-- transactions; these columns only record the dormancy lifecycle.
+
+ALTER TYPE wallet_status ADD VALUE IF NOT EXISTS 'dormant';
+
+ALTER TABLE wallets
+    ADD COLUMN dormant_since TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN dormancy_reminded_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN reactivated_at TIMESTAMP WITH TIME ZONE;
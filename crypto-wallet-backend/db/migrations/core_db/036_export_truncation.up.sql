@@ -0,0 +1,6 @@
+-- +goose Up
+-- Transaction exports that run out of their time budget complete with the rows written so far;
+-- truncated tells the requester the file is partial.
+
+ALTER TABLE export_jobs
+    ADD COLUMN truncated BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- List the USDC and USDT stablecoins on Ethereum and Solana. The rates database and trading pairs
+-- refer to them by trading symbol, which both chains share; fixed identifiers keep them
+-- addressable from databases that cannot join the registry.
+
+INSERT INTO assets (id, symbol, name, chain, contract_address, decimals, is_native, coingecko_id)
+VALUES
+    ('00000000-0000-4000-8000-000000000101', 'USDC', 'USD Coin', 'ETH', '0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48', 6, FALSE, 'usd-coin'),
+    ('00000000-0000-4000-8000-000000000102', 'USDC', 'USD Coin', 'SOL', 'EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v', 6, FALSE, 'usd-coin'),
+    ('00000000-0000-4000-8000-000000000103', 'USDT', 'Tether', 'ETH', '0xdAC17F958D2ee523a2206206994597C13D831ec7', 6, FALSE, 'tether'),
+    ('00000000-0000-4000-8000-000000000104', 'USDT', 'Tether', 'SOL', 'Es9vMFrzaCERmJfrF4H2FYD4KCoNkY11McCe8BenwNYB', 6, FALSE, 'tether')
+ON CONFLICT DO NOTHING;
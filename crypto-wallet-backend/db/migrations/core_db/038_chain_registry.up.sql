@@ -0,0 +1,8 @@
+-- +goose Up
+-- Staff manage chain adapters through the chains table. Rows seeded with placeholder endpoints
+-- must not override the environment, so a chain drives its adapter only once it is managed.
+
+ALTER TABLE chains ADD COLUMN managed BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE chains ADD CONSTRAINT chains_network_type_check
+    CHECK (network_type IN ('mainnet', 'testnet'));
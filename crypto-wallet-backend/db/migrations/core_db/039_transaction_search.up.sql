@@ -0,0 +1,20 @@
+-- +goose Up
+-- Transaction search matches hash prefixes and addresses ignoring case, metadata keys and values,
+-- and words in memos. Each index covers the exact expression the search query uses.
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_tx_hash_lower_prefix
+    ON transactions(lower(tx_hash) text_pattern_ops);
+
+CREATE INDEX IF NOT EXISTS idx_transactions_from_address_lower
+    ON transactions(lower(from_address));
+
+CREATE INDEX IF NOT EXISTS idx_transactions_to_address_lower
+    ON transactions(lower(to_address));
+
+CREATE INDEX IF NOT EXISTS idx_transactions_metadata
+    ON transactions USING GIN (metadata);
+
+CREATE INDEX IF NOT EXISTS idx_transactions_memo_trgm
+    ON transactions USING GIN ((metadata->>'memo') gin_trgm_ops);
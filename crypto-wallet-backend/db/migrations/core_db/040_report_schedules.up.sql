@@ -0,0 +1,19 @@
+-- +goose Up
+-- Users opt into periodic portfolio report emails. next_run_at is the next delivery in UTC,
+-- computed from the user's timezone and local hour; the worker claims due rows by advancing it.
+
+CREATE TABLE report_schedules (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    frequency VARCHAR(16) NOT NULL CHECK (frequency IN ('daily', 'weekly')),
+    timezone VARCHAR(64) NOT NULL,
+    hour SMALLINT NOT NULL CHECK (hour BETWEEN 0 AND 23),
+    weekday SMALLINT NOT NULL DEFAULT 1 CHECK (weekday BETWEEN 0 AND 6),
+    currency VARCHAR(3) NOT NULL DEFAULT '',
+    next_run_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_sent_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_report_schedules_next_run
+    ON report_schedules(next_run_at);
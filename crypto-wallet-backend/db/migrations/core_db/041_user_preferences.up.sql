@@ -0,0 +1,9 @@
+-- +goose Up
+-- Per-user settings beyond the preferred currency, stored as one JSONB document so new settings
+-- do not need a migration. A missing row means the user keeps the defaults.
+
+CREATE TABLE user_preferences (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    preferences JSONB NOT NULL DEFAULT '{}'::jsonb,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,15 @@
+-- +goose Up
+-- Account deletion requests. The user row is soft-deleted when the request is made and removed,
+-- cascading to the user's data, once purge_after passes. Rows outlive the user, without a foreign
+-- key, as the record that the purge ran.
+
+CREATE TABLE account_deletions (
+    user_id UUID PRIMARY KEY,
+    requested_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    purge_after TIMESTAMP WITH TIME ZONE NOT NULL,
+    purged_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_account_deletions_due
+    ON account_deletions(purge_after)
+    WHERE purged_at IS NULL;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- Support and admin join compliance as grantable staff roles. The user role every account holds
+-- is implicit and never stored.
+
+ALTER TABLE user_roles DROP CONSTRAINT IF EXISTS user_roles_role_check;
+ALTER TABLE user_roles ADD CONSTRAINT user_roles_role_check
+    CHECK (role IN ('support', 'compliance', 'admin'));
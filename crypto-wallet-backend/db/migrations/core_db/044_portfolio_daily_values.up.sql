@@ -0,0 +1,12 @@
+-- +goose Up
+-- Daily portfolio values, recorded shortly after each UTC midnight from the balances held and the
+-- prices at that time. Portfolio performance reads them instead of scanning price history per
+-- request once they cover the requested period.
+
+CREATE TABLE portfolio_daily_values (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    value_usd NUMERIC(30, 2) NOT NULL CHECK (value_usd >= 0),
+    computed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, day)
+);
@@ -0,0 +1,43 @@
+-- +goose Up
+-- Liquidity ledger per trading pair. The quote reserve pays exchanges out: accepted quotes hold
+-- their payout against it, executions consume the hold and collect the base asset, and
+-- cancellations release it. A pair whose available reserve falls below its low threshold stops
+-- quoting until staff top it up. Pairs live in the rates database, so pair_id is not a foreign
+-- key; pairs without a ledger row are not liquidity-managed.
+
+CREATE TABLE trading_pair_liquidity (
+    pair_id UUID PRIMARY KEY,
+    quote_reserve DECIMAL(36, 18) NOT NULL DEFAULT 0 CHECK (quote_reserve >= 0),
+    quote_held DECIMAL(36, 18) NOT NULL DEFAULT 0 CHECK (quote_held >= 0),
+    base_reserve DECIMAL(36, 18) NOT NULL DEFAULT 0 CHECK (base_reserve >= 0),
+    quote_funded DECIMAL(36, 18) NOT NULL DEFAULT 0 CHECK (quote_funded >= 0),
+    low_threshold DECIMAL(36, 18) NOT NULL DEFAULT 0 CHECK (low_threshold >= 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CHECK (quote_held <= quote_reserve)
+);
+
+CREATE TABLE trading_pair_liquidity_holds (
+    operation_id UUID PRIMARY KEY,
+    pair_id UUID NOT NULL REFERENCES trading_pair_liquidity(pair_id) ON DELETE CASCADE,
+    amount DECIMAL(36, 18) NOT NULL CHECK (amount > 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_trading_pair_liquidity_holds_pair_id ON trading_pair_liquidity_holds(pair_id);
+
+CREATE TABLE trading_pair_liquidity_entries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    pair_id UUID NOT NULL REFERENCES trading_pair_liquidity(pair_id) ON DELETE CASCADE,
+    operation_id UUID,
+    kind VARCHAR(16) NOT NULL CHECK (kind IN ('top_up', 'hold', 'release', 'settle')),
+    quote_delta DECIMAL(36, 18) NOT NULL DEFAULT 0,
+    held_delta DECIMAL(36, 18) NOT NULL DEFAULT 0,
+    base_delta DECIMAL(36, 18) NOT NULL DEFAULT 0,
+    quote_reserve DECIMAL(36, 18) NOT NULL,
+    actor VARCHAR(255) NOT NULL DEFAULT '',
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_trading_pair_liquidity_entries_pair_created ON trading_pair_liquidity_entries(pair_id, created_at DESC);
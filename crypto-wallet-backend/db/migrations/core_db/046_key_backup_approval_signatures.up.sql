@@ -0,0 +1,6 @@
+-- +goose Up
+-- Key backup approvals carry the approver's Ed25519 signature of the request ID and recipient
+-- fingerprint. Approvals recorded before signatures were required have none and never count
+-- towards a quorum.
+
+ALTER TABLE key_backup_approvals ADD COLUMN signature BYTEA;
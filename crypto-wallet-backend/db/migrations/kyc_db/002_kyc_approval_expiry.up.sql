@@ -0,0 +1,4 @@
+-- +goose Up
+-- Supports the scheduled sweep that expires overdue approvals.
+
+CREATE INDEX idx_kyc_profiles_approved_expiry ON kyc_profiles(expires_at) WHERE status = 'approved';
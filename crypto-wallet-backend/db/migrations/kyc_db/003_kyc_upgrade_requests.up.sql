@@ -0,0 +1,30 @@
+-- +goose Up
+-- Verification-level upgrades are tracked separately so the user's approved profile
+-- keeps its current level and limits until the upgrade is decided.
+
+CREATE TYPE kyc_upgrade_status AS ENUM ('awaiting_documents', 'under_review', 'approved', 'rejected');
+
+CREATE TABLE kyc_upgrade_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    kyc_profile_id UUID NOT NULL REFERENCES kyc_profiles(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    from_level verification_level NOT NULL,
+    target_level verification_level NOT NULL,
+    status kyc_upgrade_status NOT NULL DEFAULT 'awaiting_documents',
+    required_documents document_type[] NOT NULL,
+    provider_application_id VARCHAR(255),
+    submitted_at TIMESTAMP WITH TIME ZONE,
+    decided_at TIMESTAMP WITH TIME ZONE,
+    rejection_reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- At most one open upgrade per user.
+CREATE UNIQUE INDEX idx_kyc_upgrade_requests_open_user
+    ON kyc_upgrade_requests(user_id)
+    WHERE status IN ('awaiting_documents', 'under_review');
+
+CREATE INDEX idx_kyc_upgrade_requests_user_created ON kyc_upgrade_requests(user_id, created_at DESC);
+CREATE INDEX idx_kyc_upgrade_requests_under_review ON kyc_upgrade_requests(submitted_at)
+    WHERE status = 'under_review' AND provider_application_id IS NOT NULL;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- Manual review queue that periodic PEP/sanctions/adverse media re-screening escalates to.
+
+CREATE TYPE compliance_review_status AS ENUM ('open', 'resolved');
+
+CREATE TABLE compliance_review_queue (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,
+    source VARCHAR(50) NOT NULL,
+    reason TEXT NOT NULL,
+    risk_level risk_level NOT NULL,
+    details JSONB NOT NULL DEFAULT '{}'::JSONB,
+    status compliance_review_status NOT NULL DEFAULT 'open',
+    resolved_by UUID,
+    resolution TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_compliance_review_queue_open ON compliance_review_queue(created_at) WHERE status = 'open';
+CREATE INDEX idx_compliance_review_queue_user ON compliance_review_queue(user_id, created_at DESC);
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Compliance matrix: per-nationality document requirements, limit caps and blocks.
+-- The '*' row, when present, applies to countries without their own entry.
+
+CREATE TABLE kyc_country_requirements (
+    country_code VARCHAR(2) PRIMARY KEY CHECK (country_code = '*' OR country_code ~ '^[A-Z]{2}$'),
+    required_documents document_type[] NOT NULL DEFAULT '{}',
+    max_daily_limit_usd DECIMAL(15, 2) NOT NULL DEFAULT 0 CHECK (max_daily_limit_usd >= 0),
+    max_monthly_limit_usd DECIMAL(15, 2) NOT NULL DEFAULT 0 CHECK (max_monthly_limit_usd >= 0),
+    blocked BOOLEAN NOT NULL DEFAULT FALSE,
+    notes TEXT,
+    updated_by UUID,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Per-document-type capture rules. A row replaces the built-in defaults for its type;
+-- labels maps a locale to the display name, e.g. {"en": "Passport", "th": "หนังสือเดินทาง"}.
+
+CREATE TABLE kyc_document_type_specs (
+    document_type document_type PRIMARY KEY,
+    labels JSONB NOT NULL DEFAULT '{}',
+    accepted_mime_types TEXT[] NOT NULL CHECK (cardinality(accepted_mime_types) > 0),
+    max_size_bytes BIGINT NOT NULL CHECK (max_size_bytes > 0),
+    double_sided BOOLEAN NOT NULL DEFAULT FALSE,
+    requires_selfie_with_document BOOLEAN NOT NULL DEFAULT FALSE,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
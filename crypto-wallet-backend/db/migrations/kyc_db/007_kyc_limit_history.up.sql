@@ -0,0 +1,105 @@
+-- +goose Up
+-- Append-only history of each user's verification level, KYC status, limits and risk level, so
+-- compliance can answer "what were this user's limits at time X?". Rows are written by triggers,
+-- so every code path that changes a profile or risk level is captured. A user's history starts
+-- with their KYC profile; risk changes before a profile exists are folded into its first row.
+
+CREATE TABLE kyc_limit_history (
+    id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL,
+    verification_level verification_level NOT NULL,
+    status kyc_status NOT NULL,
+    daily_limit_usd DECIMAL(15, 2) NOT NULL,
+    monthly_limit_usd DECIMAL(15, 2) NOT NULL,
+    risk_level risk_level,
+    risk_score INTEGER,
+    change_source VARCHAR(30) NOT NULL,
+    effective_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    recorded_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_kyc_limit_history_user_effective
+    ON kyc_limit_history(user_id, effective_at DESC, id DESC);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION kyc_limit_history_reject_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'kyc_limit_history records are append-only';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER kyc_limit_history_immutable
+    BEFORE UPDATE OR DELETE ON kyc_limit_history
+    FOR EACH ROW EXECUTE FUNCTION kyc_limit_history_reject_change();
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION kyc_profiles_record_limits() RETURNS trigger AS $$
+DECLARE
+    score user_risk_scores%ROWTYPE;
+BEGIN
+    IF TG_OP = 'UPDATE'
+        AND NEW.verification_level IS NOT DISTINCT FROM OLD.verification_level
+        AND NEW.status IS NOT DISTINCT FROM OLD.status
+        AND NEW.daily_limit_usd IS NOT DISTINCT FROM OLD.daily_limit_usd
+        AND NEW.monthly_limit_usd IS NOT DISTINCT FROM OLD.monthly_limit_usd THEN
+        RETURN NEW;
+    END IF;
+
+    SELECT * INTO score FROM user_risk_scores WHERE user_id = NEW.user_id;
+
+    INSERT INTO kyc_limit_history (
+        user_id, verification_level, status, daily_limit_usd, monthly_limit_usd,
+        risk_level, risk_score, change_source, effective_at
+    ) VALUES (
+        NEW.user_id, NEW.verification_level, NEW.status, NEW.daily_limit_usd, NEW.monthly_limit_usd,
+        score.risk_level, score.risk_score, 'kyc_profile', NEW.updated_at
+    );
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER kyc_profiles_limit_history
+    AFTER INSERT OR UPDATE ON kyc_profiles
+    FOR EACH ROW EXECUTE FUNCTION kyc_profiles_record_limits();
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION user_risk_scores_record_limits() RETURNS trigger AS $$
+DECLARE
+    profile kyc_profiles%ROWTYPE;
+BEGIN
+    IF TG_OP = 'UPDATE' AND NEW.risk_level IS NOT DISTINCT FROM OLD.risk_level THEN
+        RETURN NEW;
+    END IF;
+
+    SELECT * INTO profile FROM kyc_profiles WHERE user_id = NEW.user_id;
+    IF NOT FOUND THEN
+        RETURN NEW;
+    END IF;
+
+    INSERT INTO kyc_limit_history (
+        user_id, verification_level, status, daily_limit_usd, monthly_limit_usd,
+        risk_level, risk_score, change_source, effective_at
+    ) VALUES (
+        NEW.user_id, profile.verification_level, profile.status, profile.daily_limit_usd, profile.monthly_limit_usd,
+        NEW.risk_level, NEW.risk_score, 'risk_score', NEW.updated_at
+    );
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER user_risk_scores_limit_history
+    AFTER INSERT OR UPDATE ON user_risk_scores
+    FOR EACH ROW EXECUTE FUNCTION user_risk_scores_record_limits();
+
+-- Seed the history with the current state; earlier changes were not recorded.
+INSERT INTO kyc_limit_history (
+    user_id, verification_level, status, daily_limit_usd, monthly_limit_usd,
+    risk_level, risk_score, change_source, effective_at
+)
+SELECT p.user_id, p.verification_level, p.status, p.daily_limit_usd, p.monthly_limit_usd,
+       s.risk_level, s.risk_score, 'backfill', GREATEST(p.updated_at, COALESCE(s.updated_at, p.updated_at))
+FROM kyc_profiles p
+LEFT JOIN user_risk_scores s ON s.user_id = p.user_id;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- Per-user data encryption keys for KYC personal data, wrapped by KYC_ENCRYPTION_KEY. Deleting a
+-- user's row crypto-shreds their PII, including copies in backups and replicas.
+
+CREATE TABLE user_data_keys (
+    user_id UUID PRIMARY KEY,
+    wrapped_key TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,10 @@
+-- +goose Up
+-- Malware scanning of uploaded documents. Uploads are recorded as quarantined until the scanner
+-- clears them; documents uploaded before scanning was introduced are not_scanned.
+
+ALTER TABLE kyc_documents
+    ADD COLUMN scan_status TEXT NOT NULL DEFAULT 'not_scanned'
+        CHECK (scan_status IN ('not_scanned', 'quarantined', 'scanned', 'stored', 'infected', 'scan_failed')),
+    ADD COLUMN scanned_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX idx_kyc_documents_quarantined ON kyc_documents(uploaded_at) WHERE scan_status = 'quarantined';
@@ -0,0 +1,26 @@
+-- +goose Up
+-- Travel-rule originator and beneficiary information captured on withdrawals at or above the
+-- configured USD threshold. Party details are encrypted under the sender's data key; the
+-- transaction lives in the core database and is linked once the withdrawal is broadcast.
+
+CREATE TABLE travel_rule_records (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,
+    wallet_id UUID NOT NULL,
+    transaction_id UUID,
+    chain VARCHAR(20) NOT NULL,
+    to_address TEXT NOT NULL,
+    amount DECIMAL(36, 18) NOT NULL,
+    amount_usd DECIMAL(20, 2) NOT NULL,
+    threshold_usd DECIMAL(20, 2) NOT NULL,
+    originator_encrypted TEXT NOT NULL,
+    beneficiary_encrypted TEXT NOT NULL,
+    beneficiary_vasp TEXT,
+    status TEXT NOT NULL CHECK (status IN ('captured', 'transmitted', 'failed', 'rejected')),
+    provider_reference TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    transmitted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_travel_rule_records_user ON travel_rule_records(user_id, created_at DESC);
+CREATE UNIQUE INDEX idx_travel_rule_records_transaction ON travel_rule_records(transaction_id) WHERE transaction_id IS NOT NULL;
@@ -0,0 +1,18 @@
+// Package migrations embeds the SQL migrations of each database so the server binary can apply
+// them without the source tree.
+package migrations
+
+import "embed"
+
+// FS holds one directory of migrations per database.
+//
+//go:embed core_db/*.sql kyc_db/*.sql rates_db/*.sql audit_db/*.sql
+var FS embed.FS
+
+// Dirs maps each database name, as used for its DSN, to its directory in FS.
+var Dirs = map[string]string{
+	"core":  "core_db",
+	"kyc":   "kyc_db",
+	"rates": "rates_db",
+	"audit": "audit_db",
+}
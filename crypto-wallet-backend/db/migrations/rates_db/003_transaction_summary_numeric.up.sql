@@ -0,0 +1,8 @@
+-- +goose Up
+-- Transaction summary amounts were capped at two fractional digits, which truncated sums of
+-- 18-decimal assets. Widen them so aggregation stays exact in NUMERIC end-to-end.
+
+ALTER TABLE transaction_summary
+    ALTER COLUMN total_volume_usd TYPE NUMERIC(38, 18),
+    ALTER COLUMN avg_transaction_value_usd TYPE NUMERIC(38, 18),
+    ALTER COLUMN total_fees_usd TYPE NUMERIC(38, 18);
@@ -0,0 +1,37 @@
+-- +goose Up
+-- Trading pairs and rates reference assets from the core asset registry instead of relying on
+-- symbols that happened to equal chain names. The registry lives in the core database, so these
+-- columns carry no foreign key; native assets have fixed identifiers and are backfilled here.
+
+ALTER TABLE trading_pairs
+    ADD COLUMN base_asset_id UUID,
+    ADD COLUMN quote_asset_id UUID;
+
+ALTER TABLE exchange_rates ADD COLUMN asset_id UUID;
+
+CREATE TEMPORARY TABLE native_asset_ids (symbol VARCHAR(20) PRIMARY KEY, asset_id UUID NOT NULL) ON COMMIT DROP;
+INSERT INTO native_asset_ids (symbol, asset_id)
+VALUES
+    ('BTC', '00000000-0000-4000-8000-000000000001'),
+    ('ETH', '00000000-0000-4000-8000-000000000002'),
+    ('SOL', '00000000-0000-4000-8000-000000000003'),
+    ('XLM', '00000000-0000-4000-8000-000000000004');
+
+UPDATE trading_pairs tp
+SET base_asset_id = n.asset_id
+FROM native_asset_ids n
+WHERE n.symbol = tp.base_symbol AND tp.base_asset_id IS NULL;
+
+UPDATE trading_pairs tp
+SET quote_asset_id = n.asset_id
+FROM native_asset_ids n
+WHERE n.symbol = tp.quote_symbol AND tp.quote_asset_id IS NULL;
+
+UPDATE exchange_rates er
+SET asset_id = n.asset_id
+FROM native_asset_ids n
+WHERE n.symbol = er.symbol AND er.asset_id IS NULL;
+
+CREATE UNIQUE INDEX idx_trading_pairs_assets ON trading_pairs(base_asset_id, quote_asset_id)
+    WHERE base_asset_id IS NOT NULL AND quote_asset_id IS NOT NULL;
+CREATE INDEX idx_exchange_rates_asset_id ON exchange_rates(asset_id);
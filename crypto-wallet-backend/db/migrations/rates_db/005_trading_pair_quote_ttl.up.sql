@@ -0,0 +1,7 @@
+-- +goose Up
+-- Quote TTL is configured per trading pair instead of a hard-coded 60 seconds, so volatile pairs
+-- can hand out shorter-lived quotes. Existing pairs keep the previous 60 second window.
+
+ALTER TABLE trading_pairs
+    ADD COLUMN quote_ttl_seconds INTEGER NOT NULL DEFAULT 60
+        CHECK (quote_ttl_seconds BETWEEN 1 AND 3600);
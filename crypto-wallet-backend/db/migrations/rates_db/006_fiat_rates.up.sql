@@ -0,0 +1,12 @@
+-- +goose Up
+-- Fiat reference rates used to show portfolio values in a user's preferred currency. Each row is
+-- the number of units of the currency one US dollar buys; USD is seeded as the identity rate.
+
+CREATE TABLE fiat_rates (
+    currency VARCHAR(3) PRIMARY KEY CHECK (currency IN ('USD', 'EUR', 'THB', 'GBP', 'JPY')),
+    rate_per_usd NUMERIC(30, 10) NOT NULL CHECK (rate_per_usd > 0),
+    source VARCHAR(50) NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO fiat_rates (currency, rate_per_usd, source) VALUES ('USD', 1, 'identity');
@@ -0,0 +1,22 @@
+-- +goose Up
+-- Progress of historical price backfills. A job covers one symbol and interval from range_start;
+-- cursor is the start of the first bucket not yet fetched, so an interrupted run resumes where it
+-- stopped, and a later run with the same start extends range_end instead of starting over.
+
+CREATE TABLE price_backfill_jobs (
+    id UUID PRIMARY KEY,
+    symbol VARCHAR(20) NOT NULL,
+    interval price_interval NOT NULL,
+    range_start TIMESTAMP WITH TIME ZONE NOT NULL,
+    range_end TIMESTAMP WITH TIME ZONE NOT NULL,
+    cursor TIMESTAMP WITH TIME ZONE NOT NULL,
+    candles_inserted BIGINT NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('running', 'completed', 'failed')),
+    last_error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CHECK (range_start < range_end),
+    CHECK (cursor BETWEEN range_start AND range_end)
+);
+
+CREATE UNIQUE INDEX idx_price_backfill_jobs_range ON price_backfill_jobs(symbol, interval, range_start);
@@ -0,0 +1,10 @@
+package dto
+
+import "time"
+
+// AccountDeletionResponse confirms a deletion request and when the account will be purged.
+type AccountDeletionResponse struct {
+	Status      string    `json:"status"`
+	RequestedAt time.Time `json:"requested_at"`
+	PurgeAfter  time.Time `json:"purge_after"`
+}
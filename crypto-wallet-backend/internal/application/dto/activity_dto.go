@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// ActivityExchangeLeg is the receiving side of an exchange in the activity feed.
+type ActivityExchangeLeg struct {
+	WalletID uuid.UUID `json:"walletId"`
+	Chain    string    `json:"chain"`
+	Amount   string    `json:"amount"`
+}
+
+// ActivityItemResponse is one entry of the activity feed. Kind is "transaction" or "exchange";
+// Hash is set for transactions and To for exchanges, whose Amount and Chain describe the side
+// that was sold.
+type ActivityItemResponse struct {
+	Kind       string               `json:"kind"`
+	ID         uuid.UUID            `json:"id"`
+	OccurredAt string               `json:"occurredAt"`
+	Status     string               `json:"status"`
+	Type       string               `json:"type"`
+	WalletID   uuid.UUID            `json:"walletId"`
+	Chain      string               `json:"chain"`
+	Amount     string               `json:"amount"`
+	Fee        string               `json:"fee"`
+	Hash       string               `json:"hash,omitempty"`
+	To         *ActivityExchangeLeg `json:"to,omitempty"`
+}
+
+// NewActivityItemResponse maps a feed entry to its API representation.
+func NewActivityItemResponse(item entities.ActivityItem) ActivityItemResponse {
+	response := ActivityItemResponse{
+		Kind:       string(item.Kind),
+		ID:         item.ID,
+		OccurredAt: item.OccurredAt.UTC().Format(time.RFC3339Nano),
+		Status:     item.Status,
+		Type:       item.Type,
+		WalletID:   item.WalletID,
+		Chain:      string(item.Chain),
+		Amount:     item.Amount.String(),
+		Fee:        item.Fee.String(),
+		Hash:       item.TxHash,
+	}
+	if item.Kind == entities.ActivityKindExchange && item.ToWalletID != nil {
+		leg := &ActivityExchangeLeg{WalletID: *item.ToWalletID, Chain: string(item.ToChain)}
+		if item.ToAmount != nil {
+			leg.Amount = item.ToAmount.String()
+		}
+		response.To = leg
+	}
+	return response
+}
+
+// ActivityListResponse is one page of the activity feed.
+type ActivityListResponse struct {
+	Items []ActivityItemResponse `json:"items"`
+	Limit int                    `json:"limit"`
+	// NextCursor continues the feed by keyset; empty on the last page.
+	NextCursor string `json:"nextCursor,omitempty"`
+}
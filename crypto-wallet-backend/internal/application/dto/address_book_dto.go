@@ -0,0 +1,215 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// AddressBookEntryRequest captures the payload for saving a recipient address.
+type AddressBookEntryRequest struct {
+	Chain   string `json:"chain"`
+	Address string `json:"address"`
+	Label   string `json:"label"`
+	Memo    string `json:"memo,omitempty"`
+}
+
+// Validate enforces request invariants.
+func (r AddressBookEntryRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	utils.Require(&errs, "chain", r.Chain)
+	if strings.TrimSpace(r.Chain) != "" && entities.NormalizeChain(r.Chain) == "" {
+		errs.Add("chain", "must be one of BTC, ETH, SOL, XLM")
+	}
+	utils.Require(&errs, "address", r.Address)
+	if address := strings.TrimSpace(r.Address); address != "" && !entities.IsPlausibleAddress(address) {
+		errs.Add("address", "must be a valid address")
+	}
+	utils.Require(&errs, "label", r.Label)
+	utils.RequireMaxLength(&errs, "label", strings.TrimSpace(r.Label), 100)
+	utils.RequireMaxLength(&errs, "memo", strings.TrimSpace(r.Memo), 255)
+	return errs
+}
+
+// UpdateAddressBookEntryRequest renames a saved address. The address itself cannot be edited; save
+// a new entry instead so the cooldown applies to it.
+type UpdateAddressBookEntryRequest struct {
+	Label string `json:"label"`
+	Memo  string `json:"memo,omitempty"`
+}
+
+// Validate enforces request invariants.
+func (r UpdateAddressBookEntryRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	utils.Require(&errs, "label", r.Label)
+	utils.RequireMaxLength(&errs, "label", strings.TrimSpace(r.Label), 100)
+	utils.RequireMaxLength(&errs, "memo", strings.TrimSpace(r.Memo), 255)
+	return errs
+}
+
+// AddressBookEntry describes a saved recipient. Usable is false until the new-address cooldown ends.
+type AddressBookEntry struct {
+	ID        uuid.UUID `json:"id"`
+	Chain     string    `json:"chain"`
+	Address   string    `json:"address"`
+	Label     string    `json:"label"`
+	Memo      string    `json:"memo,omitempty"`
+	Usable    bool      `json:"usable"`
+	UsableAt  time.Time `json:"usableAt"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// MapAddressBookEntry converts an entry entity into DTO.
+func MapAddressBookEntry(entry entities.AddressBookEntry, now time.Time) AddressBookEntry {
+	if entry == nil {
+		return AddressBookEntry{}
+	}
+	return AddressBookEntry{
+		ID:        entry.GetID(),
+		Chain:     string(entry.GetChain()),
+		Address:   entry.GetAddress(),
+		Label:     entry.GetLabel(),
+		Memo:      entry.GetMemo(),
+		Usable:    entry.IsUsable(now),
+		UsableAt:  entry.GetUsableAt(),
+		CreatedAt: entry.GetCreatedAt(),
+		UpdatedAt: entry.GetUpdatedAt(),
+	}
+}
+
+// AddressBookSettingsRequest toggles whitelist-only sends.
+type AddressBookSettingsRequest struct {
+	WhitelistOnly *bool `json:"whitelistOnly"`
+}
+
+// Validate enforces request invariants.
+func (r AddressBookSettingsRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	if r.WhitelistOnly == nil {
+		errs.Add("whitelistOnly", "is required")
+	}
+	return errs
+}
+
+// AddressBookSettingsResponse reports the recipient restrictions. WhitelistOnly is the setting in
+// force now; WhitelistOffAt is set while switching it off is pending.
+type AddressBookSettingsResponse struct {
+	WhitelistOnly   bool       `json:"whitelistOnly"`
+	WhitelistOffAt  *time.Time `json:"whitelistOffAt,omitempty"`
+	CooldownSeconds int64      `json:"cooldownSeconds"`
+}
+
+// MapAddressBookSettings converts settings into DTO.
+func MapAddressBookSettings(settings entities.AddressBookSettings, cooldown time.Duration, now time.Time) AddressBookSettingsResponse {
+	response := AddressBookSettingsResponse{
+		WhitelistOnly:   settings.WhitelistEnforced(now),
+		CooldownSeconds: int64(cooldown / time.Second),
+	}
+	if response.WhitelistOnly && settings.WhitelistOffAt != nil {
+		offAt := *settings.WhitelistOffAt
+		response.WhitelistOffAt = &offAt
+	}
+	return response
+}
+
+// Address book transfer limits. Exports larger than AddressBookQRMaxBytes still work as files but
+// no longer fit in a single QR code.
+const (
+	// MaxAddressBookTransferBytes bounds an export payload, which fits a full address book.
+	MaxAddressBookTransferBytes = 192 << 10
+	// AddressBookQRMaxBytes is the byte-mode capacity of the largest QR code (version 40, level L).
+	AddressBookQRMaxBytes = 2953
+)
+
+// Conflict strategies for address book imports. A conflict is an imported address that is already
+// saved with a different label or memo.
+const (
+	// AddressBookConflictSkip keeps the saved entry. It is the default.
+	AddressBookConflictSkip = "skip"
+	// AddressBookConflictReplace overwrites the saved label and memo; the address is unchanged so
+	// its cooldown is not restarted.
+	AddressBookConflictReplace = "replace"
+	// AddressBookConflictFail aborts the import without saving anything.
+	AddressBookConflictFail = "fail"
+)
+
+// AddressBookExport is a signed, versioned copy of the address book for moving it to another
+// device, either as a file or as a QR code when QRCompatible is true.
+type AddressBookExport struct {
+	Version      int       `json:"version"`
+	Payload      string    `json:"payload"`
+	EntryCount   int       `json:"entryCount"`
+	Size         int       `json:"size"`
+	QRCompatible bool      `json:"qrCompatible"`
+	ExportedAt   time.Time `json:"exportedAt"`
+}
+
+// AddressBookImportRequest carries an export payload. DryRun reports what the import would do
+// without saving anything.
+type AddressBookImportRequest struct {
+	Payload    string `json:"payload"`
+	OnConflict string `json:"onConflict,omitempty"`
+	DryRun     bool   `json:"dryRun,omitempty"`
+}
+
+// Validate enforces request invariants.
+func (r AddressBookImportRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	utils.Require(&errs, "payload", r.Payload)
+	if len(r.Payload) > MaxAddressBookTransferBytes {
+		errs.Add("payload", "must be at most 192 KiB")
+	}
+	switch r.ConflictStrategy() {
+	case AddressBookConflictSkip, AddressBookConflictReplace, AddressBookConflictFail:
+	default:
+		errs.Add("onConflict", "must be one of skip, replace, fail")
+	}
+	return errs
+}
+
+// ConflictStrategy returns the normalised strategy, defaulting to AddressBookConflictSkip.
+func (r AddressBookImportRequest) ConflictStrategy() string {
+	strategy := strings.ToLower(strings.TrimSpace(r.OnConflict))
+	if strategy == "" {
+		return AddressBookConflictSkip
+	}
+	return strategy
+}
+
+// Outcomes of one imported address.
+const (
+	AddressBookImportAdded     = "added"
+	AddressBookImportUpdated   = "updated"
+	AddressBookImportUnchanged = "unchanged"
+	AddressBookImportSkipped   = "skipped"
+	AddressBookImportConflict  = "conflict"
+	AddressBookImportRejected  = "rejected"
+)
+
+// AddressBookImportItem reports what happened to one imported address.
+type AddressBookImportItem struct {
+	Chain   string     `json:"chain"`
+	Address string     `json:"address"`
+	Label   string     `json:"label"`
+	Outcome string     `json:"outcome"`
+	Reason  string     `json:"reason,omitempty"`
+	EntryID *uuid.UUID `json:"entryId,omitempty"`
+}
+
+// AddressBookImportResult summarises an import. Added entries start their cooldown at import time,
+// not at the time they were first saved on the other device.
+type AddressBookImportResult struct {
+	DryRun     bool                    `json:"dryRun"`
+	OnConflict string                  `json:"onConflict"`
+	Added      int                     `json:"added"`
+	Updated    int                     `json:"updated"`
+	Unchanged  int                     `json:"unchanged"`
+	Skipped    int                     `json:"skipped"`
+	Rejected   int                     `json:"rejected"`
+	Items      []AddressBookImportItem `json:"items"`
+}
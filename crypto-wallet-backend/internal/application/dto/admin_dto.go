@@ -0,0 +1,200 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AdminUserReport is the operator view of an account: profile, wallets and KYC state.
+type AdminUserReport struct {
+	ID               uuid.UUID  `json:"id"`
+	Email            string     `json:"email"`
+	Name             string     `json:"name"`
+	Status           string     `json:"status"`
+	EmailVerified    bool       `json:"email_verified"`
+	TwoFactorEnabled bool       `json:"two_factor_enabled"`
+	CreatedAt        time.Time  `json:"created_at"`
+	LastLoginAt      *time.Time `json:"last_login_at,omitempty"`
+	KYC              *AdminKYC  `json:"kyc,omitempty"`
+	Wallets          []Wallet   `json:"wallets"`
+}
+
+// AdminKYC summarises the user's KYC profile without decrypting personal data.
+type AdminKYC struct {
+	Status          string     `json:"status"`
+	Level           string     `json:"level"`
+	DailyLimitUSD   string     `json:"daily_limit_usd"`
+	MonthlyLimitUSD string     `json:"monthly_limit_usd"`
+	ExpiresAt       *time.Time `json:"expires_at,omitempty"`
+}
+
+// AdminReconciliationLine compares one wallet's stored balance with the chain.
+type AdminReconciliationLine struct {
+	WalletID       uuid.UUID `json:"wallet_id"`
+	Chain          string    `json:"chain"`
+	Address        string    `json:"address"`
+	StoredBalance  string    `json:"stored_balance"`
+	OnChainBalance string    `json:"on_chain_balance,omitempty"`
+	Difference     string    `json:"difference,omitempty"`
+	Status         string    `json:"status"`
+	Error          string    `json:"error,omitempty"`
+}
+
+// Reconciliation line statuses.
+const (
+	ReconciliationMatched    = "matched"
+	ReconciliationMismatched = "mismatched"
+	ReconciliationCorrected  = "corrected"
+	ReconciliationFailed     = "failed"
+)
+
+// AdminReconciliationReport lists reconciliation results for a user's wallets. Operation covers the
+// corrections: the mismatched wallets in a dry run, the corrected ones otherwise.
+type AdminReconciliationReport struct {
+	UserID     uuid.UUID                 `json:"user_id"`
+	Lines      []AdminReconciliationLine `json:"lines"`
+	Mismatched int                       `json:"mismatched"`
+	Operation  AdminOperationResult      `json:"operation"`
+}
+
+// AdminOperationResult reports the records a destructive operation changed or, in a dry run, would
+// change. Dry runs carry the confirmation token the real run must present together with the same
+// Scope parameters.
+type AdminOperationResult struct {
+	Operation             string            `json:"operation"`
+	DryRun                bool              `json:"dry_run"`
+	Scope                 map[string]string `json:"scope,omitempty"`
+	AffectedCount         int               `json:"affected_count"`
+	AffectedIDs           []string          `json:"affected_ids"`
+	Failures              map[string]string `json:"failures,omitempty"`
+	ConfirmationToken     string            `json:"confirmation_token,omitempty"`
+	ConfirmationExpiresAt *time.Time        `json:"confirmation_expires_at,omitempty"`
+}
+
+// AdminFreezeUserWalletsRequest is the body of a bulk freeze; DryRun is taken from the query string.
+type AdminFreezeUserWalletsRequest struct {
+	Reason            string `json:"reason"`
+	ConfirmationToken string `json:"confirmation_token"`
+}
+
+// AdminPurgeExportsRequest is the body of an export purge. Before defaults to now; pass the value
+// echoed by the dry run so the real run covers the same files.
+type AdminPurgeExportsRequest struct {
+	Before            string `json:"before"`
+	ConfirmationToken string `json:"confirmation_token"`
+}
+
+// AdminCancelExchangesRequest is the body of a bulk quote cancellation; DryRun is taken from the query
+// string. The real run passes back the operation IDs and confirmation token from the dry run. PairID
+// narrows a per-user cancellation to one trading pair.
+type AdminCancelExchangesRequest struct {
+	Reason            string   `json:"reason"`
+	PairID            string   `json:"pair_id"`
+	OperationIDs      []string `json:"operation_ids"`
+	ConfirmationToken string   `json:"confirmation_token"`
+}
+
+// AdminExchangeCancellationResult reports a bulk quote cancellation. AffectedIDs lists the operations
+// cancelled or, in a dry run, still pending; AlreadyFinal maps operations that left pending before
+// they could be cancelled to the status they reached. HasMore is set when the dry run hit its batch
+// limit and another run is needed afterwards.
+type AdminExchangeCancellationResult struct {
+	AdminOperationResult
+	AlreadyFinal map[string]string `json:"already_final,omitempty"`
+	HasMore      bool              `json:"has_more"`
+}
+
+// AdminPricingSimulationRequest proposes pricing for a trading pair. Days defaults to 30; at least one
+// of FeePercentage and SpreadBps is required, and an omitted one keeps what each swap was charged.
+type AdminPricingSimulationRequest struct {
+	Days          int    `json:"days"`
+	FeePercentage string `json:"fee_percentage"`
+	SpreadBps     string `json:"spread_bps"`
+}
+
+// AdminPricingTotals sums a set of swaps. FeeRevenue is in the pair's base asset; SpreadRevenue,
+// UserCost and ToAmount are in its quote asset. UserCost is what users received below the mid rate,
+// and UserCostBps expresses it relative to the swaps' value at mid.
+type AdminPricingTotals struct {
+	FeeRevenue    string `json:"fee_revenue"`
+	SpreadRevenue string `json:"spread_revenue"`
+	UserCost      string `json:"user_cost"`
+	UserCostBps   string `json:"user_cost_bps"`
+	ToAmount      string `json:"to_amount"`
+}
+
+// AdminPricingSimulationResult compares what a pair's completed swaps earned and cost with what they
+// would have under the proposed pricing. WithoutMidRate counts swaps whose quotes did not record a mid
+// rate and were replayed at their executed rate; Truncated is set when the window held more swaps
+// than one simulation replays.
+type AdminPricingSimulationResult struct {
+	PairID         uuid.UUID          `json:"pair_id"`
+	Pair           string             `json:"pair"`
+	FeePercentage  string             `json:"fee_percentage,omitempty"`
+	SpreadBps      string             `json:"spread_bps,omitempty"`
+	Days           int                `json:"days"`
+	WindowStart    time.Time          `json:"window_start"`
+	WindowEnd      time.Time          `json:"window_end"`
+	Swaps          int                `json:"swaps"`
+	Volume         string             `json:"volume"`
+	WithoutMidRate int                `json:"without_mid_rate"`
+	Truncated      bool               `json:"truncated"`
+	Current        AdminPricingTotals `json:"current"`
+	Projected      AdminPricingTotals `json:"projected"`
+	Delta          AdminPricingTotals `json:"delta"`
+}
+
+// AdminExecutionBudget reports one use case's time budget and how its runs fared against it since
+// the server started. LimitMs is zero for an unbounded use case.
+type AdminExecutionBudget struct {
+	Name            string     `json:"name"`
+	LimitMs         int64      `json:"limit_ms"`
+	Runs            int64      `json:"runs"`
+	Exhausted       int64      `json:"exhausted"`
+	AvgDurationMs   int64      `json:"avg_duration_ms"`
+	MaxDurationMs   int64      `json:"max_duration_ms"`
+	LastExhaustedAt *time.Time `json:"last_exhausted_at,omitempty"`
+}
+
+// AdminLiquidityTopUpRequest adds Amount of a trading pair's quote asset to its liquidity reserve.
+// LowThreshold, when set, replaces the available reserve below which the pair stops quoting.
+type AdminLiquidityTopUpRequest struct {
+	Amount       string `json:"amount"`
+	LowThreshold string `json:"low_threshold"`
+	Note         string `json:"note"`
+}
+
+// AdminTradingPairLiquidity reports a trading pair's liquidity ledger. BaseReserve is in the pair's
+// base asset and every other amount in its quote asset. Available is the reserve not held for
+// accepted quotes; Utilization is the share of the funded reserve paid out or held, from 0 to 1.
+// Entries lists the latest ledger changes when a single pair is requested.
+type AdminTradingPairLiquidity struct {
+	PairID       uuid.UUID             `json:"pair_id"`
+	Pair         string                `json:"pair,omitempty"`
+	HasLiquidity bool                  `json:"has_liquidity"`
+	QuoteReserve string                `json:"quote_reserve"`
+	QuoteHeld    string                `json:"quote_held"`
+	Available    string                `json:"available"`
+	BaseReserve  string                `json:"base_reserve"`
+	QuoteFunded  string                `json:"quote_funded"`
+	LowThreshold string                `json:"low_threshold"`
+	Utilization  string                `json:"utilization"`
+	UpdatedAt    time.Time             `json:"updated_at"`
+	Entries      []AdminLiquidityEntry `json:"entries,omitempty"`
+}
+
+// AdminLiquidityEntry is one change to a liquidity ledger. The deltas are signed changes to the
+// quote reserve, the held amount and the base reserve; QuoteReserve is the reserve after it.
+type AdminLiquidityEntry struct {
+	ID           uuid.UUID  `json:"id"`
+	Kind         string     `json:"kind"`
+	OperationID  *uuid.UUID `json:"operation_id,omitempty"`
+	QuoteDelta   string     `json:"quote_delta"`
+	HeldDelta    string     `json:"held_delta"`
+	BaseDelta    string     `json:"base_delta"`
+	QuoteReserve string     `json:"quote_reserve"`
+	Actor        string     `json:"actor,omitempty"`
+	Note         string     `json:"note,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
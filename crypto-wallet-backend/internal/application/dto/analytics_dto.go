@@ -1,75 +1,43 @@
 package dto
 
 import (
-	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 
 	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/pkg/money"
 	"github.com/crypto-wallet/backend/pkg/utils"
 )
 
-// formatFloat formats a float64 as a string with 2 decimal places
-func formatFloat(value float64) string {
-	return fmt.Sprintf("%.2f", value)
-}
-
 // GetTransactionHistoryRequest captures query parameters for transaction history.
 type GetTransactionHistoryRequest struct {
-	WalletID  string `json:"walletId,omitempty"`
-	Chain     string `json:"chain,omitempty"`
-	Type      string `json:"type,omitempty"`
-	Status    string `json:"status,omitempty"`
-	StartDate string `json:"startDate,omitempty"`
-	EndDate   string `json:"endDate,omitempty"`
-	Limit     int    `json:"limit"`
-	Offset    int    `json:"offset"`
+	WalletID  string `json:"walletId,omitempty" query:"walletId" validate:"omitempty,uuid"`
+	Chain     string `json:"chain,omitempty" query:"chain"`
+	Type      string `json:"type,omitempty" query:"type"`
+	Status    string `json:"status,omitempty" query:"status"`
+	StartDate string `json:"startDate,omitempty" query:"startDate" validate:"omitempty,rfc3339"`
+	EndDate   string `json:"endDate,omitempty" query:"endDate" validate:"omitempty,rfc3339"`
+	Limit     int    `json:"limit" query:"limit" validate:"min=1,max=100"`
+	Offset    int    `json:"offset" query:"offset" validate:"gte=0"`
+	Cursor    string `json:"cursor,omitempty" query:"cursor"`
 }
 
-// Validate enforces request invariants.
-func (r GetTransactionHistoryRequest) Validate() utils.ValidationErrors {
-	errs := utils.ValidationErrors{}
-
-	if r.WalletID != "" {
-		utils.RequireUUID(&errs, "walletId", r.WalletID)
-	}
-
-	if r.Chain != "" {
-		utils.Require(&errs, "chain", r.Chain)
-	}
+// DefaultTransactionHistoryLimit is the page size used when the request does not set one.
+const DefaultTransactionHistoryLimit = 50
 
-	if r.Type != "" {
-		utils.Require(&errs, "type", r.Type)
-	}
-
-	if r.Status != "" {
-		utils.Require(&errs, "status", r.Status)
-	}
-
-	if r.StartDate != "" {
-		if _, err := time.Parse(time.RFC3339, r.StartDate); err != nil {
-			errs.Add("startDate", "must be a valid RFC3339 datetime")
-		}
-	}
-
-	if r.EndDate != "" {
-		if _, err := time.Parse(time.RFC3339, r.EndDate); err != nil {
-			errs.Add("endDate", "must be a valid RFC3339 datetime")
-		}
-	}
-
-	if r.Limit <= 0 {
-		r.Limit = 50 // default limit
-	} else if r.Limit > 1000 {
-		errs.Add("limit", "cannot exceed 1000")
-	}
-
-	if r.Offset < 0 {
-		errs.Add("offset", "cannot be negative")
+// SetDefaults fills in the page size when it was omitted.
+func (r *GetTransactionHistoryRequest) SetDefaults() {
+	if r.Limit == 0 {
+		r.Limit = DefaultTransactionHistoryLimit
 	}
+}
 
-	return errs
+// Validate enforces request invariants.
+func (r GetTransactionHistoryRequest) Validate() utils.ValidationErrors {
+	return utils.ValidateStruct(r)
 }
 
 // ExportTransactionsRequest captures query parameters for transaction export.
@@ -80,7 +48,7 @@ type ExportTransactionsRequest struct {
 	Status    string `json:"status,omitempty"`
 	StartDate string `json:"startDate,omitempty"`
 	EndDate   string `json:"endDate,omitempty"`
-	Format    string `json:"format"` // csv, json
+	Format    string `json:"format"` // csv (default), json, xlsx
 }
 
 // Validate enforces request invariants.
@@ -92,33 +60,53 @@ func (r ExportTransactionsRequest) Validate() utils.ValidationErrors {
 	}
 
 	if r.Chain != "" {
-		utils.Require(&errs, "chain", r.Chain)
+		utils.RequireInSet(&errs, "chain", strings.ToUpper(r.Chain), chainStrings(entities.SupportedChains()))
 	}
 
 	if r.Type != "" {
-		utils.Require(&errs, "type", r.Type)
+		utils.RequireInSet(&errs, "type", strings.ToLower(r.Type), []string{
+			string(entities.TransactionTypeSend),
+			string(entities.TransactionTypeReceive),
+			string(entities.TransactionTypeSwapIn),
+			string(entities.TransactionTypeSwapOut),
+			string(entities.TransactionTypeP2PSend),
+			string(entities.TransactionTypeP2PReceive),
+		})
 	}
 
 	if r.Status != "" {
-		utils.Require(&errs, "status", r.Status)
+		utils.RequireInSet(&errs, "status", strings.ToLower(r.Status), []string{
+			string(entities.TransactionStatusPending),
+			string(entities.TransactionStatusConfirming),
+			string(entities.TransactionStatusConfirmed),
+			string(entities.TransactionStatusFailed),
+			string(entities.TransactionStatusCancelled),
+		})
 	}
 
+	var start, end time.Time
 	if r.StartDate != "" {
-		if _, err := time.Parse(time.RFC3339, r.StartDate); err != nil {
+		parsed, err := time.Parse(time.RFC3339, r.StartDate)
+		if err != nil {
 			errs.Add("startDate", "must be a valid RFC3339 datetime")
 		}
+		start = parsed
 	}
 
 	if r.EndDate != "" {
-		if _, err := time.Parse(time.RFC3339, r.EndDate); err != nil {
+		parsed, err := time.Parse(time.RFC3339, r.EndDate)
+		if err != nil {
 			errs.Add("endDate", "must be a valid RFC3339 datetime")
 		}
+		end = parsed
 	}
 
-	if r.Format == "" {
-		r.Format = "csv" // default format
-	} else if r.Format != "csv" && r.Format != "json" {
-		errs.Add("format", "must be either 'csv' or 'json'")
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		errs.Add("endDate", "must not be before startDate")
+	}
+
+	if r.Format != "" {
+		utils.RequireInSet(&errs, "format", strings.ToLower(r.Format), []string{"csv", "json", "xlsx"})
 	}
 
 	return errs
@@ -126,44 +114,23 @@ func (r ExportTransactionsRequest) Validate() utils.ValidationErrors {
 
 // GetTransactionAnalyticsRequest captures query parameters for transaction analytics.
 type GetTransactionAnalyticsRequest struct {
-	WalletID  string `json:"walletId,omitempty"`
-	Chain     string `json:"chain,omitempty"`
-	StartDate string `json:"startDate,omitempty"`
-	EndDate   string `json:"endDate,omitempty"`
-	Period    string `json:"period"` // daily, weekly, monthly
+	WalletID  string `json:"walletId,omitempty" query:"walletId" validate:"omitempty,uuid"`
+	Chain     string `json:"chain,omitempty" query:"chain"`
+	StartDate string `json:"startDate,omitempty" query:"startDate" validate:"omitempty,rfc3339"`
+	EndDate   string `json:"endDate,omitempty" query:"endDate" validate:"omitempty,rfc3339"`
+	Period    string `json:"period" query:"period" validate:"omitempty,oneof=daily weekly monthly"`
 }
 
-// Validate enforces request invariants.
-func (r GetTransactionAnalyticsRequest) Validate() utils.ValidationErrors {
-	errs := utils.ValidationErrors{}
-
-	if r.WalletID != "" {
-		utils.RequireUUID(&errs, "walletId", r.WalletID)
-	}
-
-	if r.Chain != "" {
-		utils.Require(&errs, "chain", r.Chain)
-	}
-
-	if r.StartDate != "" {
-		if _, err := time.Parse(time.RFC3339, r.StartDate); err != nil {
-			errs.Add("startDate", "must be a valid RFC3339 datetime")
-		}
-	}
-
-	if r.EndDate != "" {
-		if _, err := time.Parse(time.RFC3339, r.EndDate); err != nil {
-			errs.Add("endDate", "must be a valid RFC3339 datetime")
-		}
-	}
-
+// SetDefaults reports daily figures when no period was requested.
+func (r *GetTransactionAnalyticsRequest) SetDefaults() {
 	if r.Period == "" {
-		r.Period = "daily" // default period
-	} else if r.Period != "daily" && r.Period != "weekly" && r.Period != "monthly" {
-		errs.Add("period", "must be either 'daily', 'weekly', or 'monthly'")
+		r.Period = "daily"
 	}
+}
 
-	return errs
+// Validate enforces request invariants.
+func (r GetTransactionAnalyticsRequest) Validate() utils.ValidationErrors {
+	return utils.ValidateStruct(r)
 }
 
 // TransactionAnalyticsResponse provides aggregated transaction analytics.
@@ -173,46 +140,53 @@ type TransactionAnalyticsResponse struct {
 	Period                 string                   `json:"period"`
 	StartDate              string                   `json:"startDate"`
 	EndDate                string                   `json:"endDate"`
+	Currency               string                   `json:"currency"`
 	TotalTransactions      int64                    `json:"totalTransactions"`
-	TotalVolume            string                   `json:"totalVolume"`
-	AverageTransactionSize string                   `json:"averageTransactionSize"`
+	TotalVolume            money.Money              `json:"totalVolume"`
+	AverageTransactionSize money.Money              `json:"averageTransactionSize"`
 	SwapTransactions       int64                    `json:"swapTransactions"`
-	SwapPercentage         float64                  `json:"swapPercentage"`
-	TotalFees              string                   `json:"totalFees"`
-	AverageFee             string                   `json:"averageFee"`
-	FeePercentage          float64                  `json:"feePercentage"`
+	SwapPercentage         string                   `json:"swapPercentage"`
+	TotalFees              money.Money              `json:"totalFees"`
+	AverageFee             money.Money              `json:"averageFee"`
+	FeePercentage          string                   `json:"feePercentage"`
 	DailyData              []DailyAnalyticsResponse `json:"dailyData,omitempty"`
 }
 
 // DailyAnalyticsResponse provides daily breakdown of analytics.
 type DailyAnalyticsResponse struct {
-	Date                   string  `json:"date"`
-	TransactionCount       int64   `json:"transactionCount"`
-	Volume                 string  `json:"volume"`
-	AverageTransactionSize string  `json:"averageTransactionSize"`
-	SwapCount              int64   `json:"swapCount"`
-	SwapPercentage         float64 `json:"swapPercentage"`
-	TotalFees              string  `json:"totalFees"`
-	AverageFee             string  `json:"averageFee"`
-	FeePercentage          float64 `json:"feePercentage"`
-}
-
-// NewTransactionAnalyticsResponse maps domain entities to API response.
-func NewTransactionAnalyticsResponse(summary entities.TransactionSummary, dailyData []entities.TransactionSummary, period string, startDate, endDate time.Time) TransactionAnalyticsResponse {
+	Date                   string      `json:"date"`
+	TransactionCount       int64       `json:"transactionCount"`
+	Volume                 money.Money `json:"volume"`
+	AverageTransactionSize money.Money `json:"averageTransactionSize"`
+	SwapCount              int64       `json:"swapCount"`
+	SwapPercentage         string      `json:"swapPercentage"`
+	TotalFees              money.Money `json:"totalFees"`
+	AverageFee             money.Money `json:"averageFee"`
+	FeePercentage          string      `json:"feePercentage"`
+}
+
+// NewTransactionAnalyticsResponse maps domain entities to API response. The summaries hold USD
+// amounts, which are converted with rate (entities.USDFiatRate keeps them in USD) and serialized
+// as money.Money; percentages are two-decimal strings.
+func NewTransactionAnalyticsResponse(summary entities.TransactionSummary, dailyData []entities.TransactionSummary, period string, startDate, endDate time.Time, rate entities.FiatRate) TransactionAnalyticsResponse {
+	if rate.Currency == "" {
+		rate = entities.USDFiatRate(endDate)
+	}
 	chain := string(summary.GetChain())
 	response := TransactionAnalyticsResponse{
 		Chain:                  &chain,
 		Period:                 period,
 		StartDate:              startDate.UTC().Format(time.RFC3339Nano),
 		EndDate:                endDate.UTC().Format(time.RFC3339Nano),
+		Currency:               string(rate.Currency),
 		TotalTransactions:      summary.GetTotalTransactions(),
-		TotalVolume:            formatFloat(summary.GetTotalVolume()),
-		AverageTransactionSize: formatFloat(summary.GetAverageTransactionSize()),
+		TotalVolume:            fiatAmount(summary.GetTotalVolume(), rate),
+		AverageTransactionSize: fiatAmount(summary.GetAverageTransactionSize(), rate),
 		SwapTransactions:       summary.GetSwapTransactions(),
-		SwapPercentage:         summary.GetSwapPercentage(),
-		TotalFees:              formatFloat(summary.GetTotalFees()),
-		AverageFee:             formatFloat(summary.GetAverageFee()),
-		FeePercentage:          summary.GetFeePercentage(),
+		SwapPercentage:         summary.GetSwapPercentage().StringFixed(2),
+		TotalFees:              fiatAmount(summary.GetTotalFees(), rate),
+		AverageFee:             fiatAmount(summary.GetAverageFee(), rate),
+		FeePercentage:          summary.GetFeePercentage().StringFixed(2),
 	}
 
 	if len(dailyData) > 0 {
@@ -221,13 +195,13 @@ func NewTransactionAnalyticsResponse(summary entities.TransactionSummary, dailyD
 			response.DailyData[i] = DailyAnalyticsResponse{
 				Date:                   daily.GetDate().Format(time.RFC3339Nano),
 				TransactionCount:       daily.GetTotalTransactions(),
-				Volume:                 formatFloat(daily.GetTotalVolume()),
-				AverageTransactionSize: formatFloat(daily.GetAverageTransactionSize()),
+				Volume:                 fiatAmount(daily.GetTotalVolume(), rate),
+				AverageTransactionSize: fiatAmount(daily.GetAverageTransactionSize(), rate),
 				SwapCount:              daily.GetSwapTransactions(),
-				SwapPercentage:         daily.GetSwapPercentage(),
-				TotalFees:              formatFloat(daily.GetTotalFees()),
-				AverageFee:             formatFloat(daily.GetAverageFee()),
-				FeePercentage:          daily.GetFeePercentage(),
+				SwapPercentage:         daily.GetSwapPercentage().StringFixed(2),
+				TotalFees:              fiatAmount(daily.GetTotalFees(), rate),
+				AverageFee:             fiatAmount(daily.GetAverageFee(), rate),
+				FeePercentage:          daily.GetFeePercentage().StringFixed(2),
 			}
 		}
 	}
@@ -235,43 +209,343 @@ func NewTransactionAnalyticsResponse(summary entities.TransactionSummary, dailyD
 	return response
 }
 
-// ExportResponse provides export download information.
-type ExportResponse struct {
-	DownloadURL string `json:"downloadUrl"`
-	Filename    string `json:"filename"`
-	Size        int64  `json:"size"`
-	Format      string `json:"format"`
-	CreatedAt   string `json:"createdAt"`
+// fiatAmount converts a USD amount with rate, rendered with the target currency's precision.
+func fiatAmount(amountUSD decimal.Decimal, rate entities.FiatRate) money.Money {
+	if rate.Currency == entities.CurrencyUSD {
+		return money.USD(amountUSD)
+	}
+	return money.Must(rate.FromUSD(amountUSD), string(rate.Currency), entities.CurrencyPrecision(rate.Currency))
 }
 
 // PortfolioAsset represents allocation information for a single asset.
 type PortfolioAsset struct {
-	Symbol     string `json:"symbol"`
-	Balance    string `json:"balance"`
-	BalanceUSD string `json:"balance_usd"`
-	Percentage string `json:"percentage"`
+	Symbol          string     `json:"symbol"`
+	Balance         string     `json:"balance"`
+	BalanceUSD      string     `json:"balance_usd"`
+	Value           string     `json:"value"`
+	Percentage      string     `json:"percentage"`
+	RateSource      string     `json:"rate_source,omitempty"`
+	RateLastUpdated *time.Time `json:"rate_last_updated,omitempty"`
+	RateStale       bool       `json:"rate_stale"`
+}
+
+// FXRateInfo describes the fiat rate used to express USD values in the display currency.
+type FXRateInfo struct {
+	Currency  string    `json:"currency"`
+	PerUSD    string    `json:"per_usd"`
+	Source    string    `json:"source"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Stale     bool      `json:"stale"`
 }
 
-// PortfolioSummary aggregates portfolio value and allocation details.
+// NewFXRateInfo maps a fiat rate to its API representation.
+func NewFXRateInfo(rate entities.FiatRate, stale bool) *FXRateInfo {
+	return &FXRateInfo{
+		Currency:  string(rate.Currency),
+		PerUSD:    rate.PerUSD.String(),
+		Source:    rate.Source,
+		UpdatedAt: rate.UpdatedAt.UTC(),
+		Stale:     stale,
+	}
+}
+
+// PortfolioSummary aggregates portfolio value and allocation details. The *_usd fields and
+// TotalChange24h are always USD; TotalBalance, TotalChange (the 24h change) and each asset's Value
+// are in Currency.
 type PortfolioSummary struct {
 	TotalBalanceUSD            string           `json:"total_balance_usd"`
 	TotalChange24h             string           `json:"total_change_24h"`
 	TotalChangePercentage24h   string           `json:"total_change_percentage_24h"`
+	Currency                   string           `json:"currency"`
+	TotalBalance               string           `json:"total_balance"`
+	TotalChange                string           `json:"total_change"`
+	FXRate                     *FXRateInfo      `json:"fx_rate,omitempty"`
 	Assets                     []PortfolioAsset `json:"assets"`
+	FreshnessWarning           string           `json:"freshness_warning,omitempty"`
+}
+
+// DefaultRebalanceTolerance is the allocation drift, in percentage points, tolerated before a
+// rebalance suggests moving an asset.
+const DefaultRebalanceTolerance = "1"
+
+// RebalanceRequest captures the target allocation for rebalancing suggestions. Targets lists
+// SYMBOL:PERCENT pairs separated by commas, e.g. BTC:50,ETH:30,USDC:20, and must add up to 100.
+// Held assets without a target are sold.
+type RebalanceRequest struct {
+	Targets   string `json:"targets" query:"targets" validate:"required,max=500"`
+	Tolerance string `json:"tolerance,omitempty" query:"tolerance" validate:"omitempty,gte=0,lte=100"`
+}
+
+// SetDefaults applies the default tolerance.
+func (r *RebalanceRequest) SetDefaults() {
+	if strings.TrimSpace(r.Tolerance) == "" {
+		r.Tolerance = DefaultRebalanceTolerance
+	}
+}
+
+// Validate enforces request invariants.
+func (r RebalanceRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidateStruct(r)
+	if strings.TrimSpace(r.Targets) != "" {
+		if _, targetErrs := r.TargetAllocations(); !targetErrs.IsEmpty() {
+			errs = append(errs, targetErrs...)
+		}
+	}
+	return errs
+}
+
+// TargetAllocations parses Targets into percentages per symbol.
+func (r RebalanceRequest) TargetAllocations() (map[string]decimal.Decimal, utils.ValidationErrors) {
+	errs := utils.ValidationErrors{}
+	targets := make(map[string]decimal.Decimal)
+	total := decimal.Zero
+
+	for _, entry := range strings.Split(r.Targets, ",") {
+		symbol, rawPercent, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		symbol = strings.ToUpper(strings.TrimSpace(symbol))
+		if !ok || symbol == "" {
+			errs.Add("targets", "must list SYMBOL:PERCENT pairs separated by commas")
+			return nil, errs
+		}
+		if !entities.IsSupportedSymbol(symbol) {
+			errs.Add("targets", symbol+" is not a supported asset")
+			continue
+		}
+		if _, duplicate := targets[symbol]; duplicate {
+			errs.Add("targets", symbol+" is listed more than once")
+			continue
+		}
+		percent, err := decimal.NewFromString(strings.TrimSpace(rawPercent))
+		if err != nil || percent.IsNegative() || percent.GreaterThan(decimal.NewFromInt(100)) {
+			errs.Add("targets", symbol+" must have a percentage between 0 and 100")
+			continue
+		}
+		targets[symbol] = percent
+		total = total.Add(percent)
+	}
+
+	if errs.IsEmpty() && total.Sub(decimal.NewFromInt(100)).Abs().GreaterThan(decimal.RequireFromString("0.01")) {
+		errs.Add("targets", "percentages must add up to 100")
+	}
+	if !errs.IsEmpty() {
+		return nil, errs
+	}
+	return targets, errs
+}
+
+// RebalanceSuggestions compares a portfolio's allocation with its targets and suggests the swaps
+// that close the gap. Values are in USD; swaps are estimates priced like an exchange quote and
+// are not reserved.
+type RebalanceSuggestions struct {
+	TotalBalanceUSD  string                `json:"total_balance_usd"`
+	Tolerance        string                `json:"tolerance"`
+	Allocations      []RebalanceAllocation `json:"allocations"`
+	Swaps            []RebalanceSwap       `json:"swaps"`
+	EstimatedFeesUSD string                `json:"estimated_fees_usd"`
+	FreshnessWarning string                `json:"freshness_warning,omitempty"`
+}
+
+// RebalanceAllocation reports one asset's current and target share of the portfolio. Drift is the
+// current share less the target, in percentage points.
+type RebalanceAllocation struct {
+	Symbol            string `json:"symbol"`
+	CurrentPercentage string `json:"current_percentage"`
+	TargetPercentage  string `json:"target_percentage"`
+	Drift             string `json:"drift"`
+	CurrentValueUSD   string `json:"current_value_usd"`
+	TargetValueUSD    string `json:"target_value_usd"`
+}
+
+// RebalanceSwap is one suggested exchange. Executable is false, with the reason in Note, when no
+// active trading pair connects the assets or the amount falls outside the pair's limits.
+type RebalanceSwap struct {
+	FromSymbol        string `json:"from_symbol"`
+	ToSymbol          string `json:"to_symbol"`
+	FromAmount        string `json:"from_amount"`
+	ValueUSD          string `json:"value_usd"`
+	EstimatedToAmount string `json:"estimated_to_amount,omitempty"`
+	ExchangeRate      string `json:"exchange_rate,omitempty"`
+	FeePercentage     string `json:"fee_percentage,omitempty"`
+	EstimatedFee      string `json:"estimated_fee,omitempty"`
+	EstimatedFeeUSD   string `json:"estimated_fee_usd,omitempty"`
+	Executable        bool   `json:"executable"`
+	Note              string `json:"note,omitempty"`
+}
+
+// Tax report output formats.
+const (
+	TaxReportFormatJSON = "json"
+	TaxReportFormatCSV  = "csv"
+)
+
+// TaxReportRequest captures the query parameters of a tax report. Method picks the lots a
+// disposal consumes: fifo (default), lifo or hifo.
+type TaxReportRequest struct {
+	Year   int    `json:"year" query:"year" validate:"required,gte=2009"`
+	Method string `json:"method,omitempty" query:"method" validate:"omitempty,oneof=fifo lifo hifo"`
+	Format string `json:"format,omitempty" query:"format" validate:"omitempty,oneof=json csv"`
+}
+
+// SetDefaults applies the default method and format.
+func (r *TaxReportRequest) SetDefaults() {
+	r.Method = strings.ToLower(strings.TrimSpace(r.Method))
+	if r.Method == "" {
+		r.Method = string(entities.CostBasisFIFO)
+	}
+	r.Format = strings.ToLower(strings.TrimSpace(r.Format))
+	if r.Format == "" {
+		r.Format = TaxReportFormatJSON
+	}
+}
+
+// Validate enforces request invariants.
+func (r TaxReportRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidateStruct(r)
+	if r.Year > time.Now().UTC().Year() {
+		errs.Add("year", "must not be in the future")
+	}
+	return errs
+}
+
+// TaxReport summarises the gains realized by a user's disposals in one calendar year. Values are
+// in USD; disposals are split per consumed lot.
+type TaxReport struct {
+	Year           int             `json:"year"`
+	Method         string          `json:"method"`
+	Currency       string          `json:"currency"`
+	TotalProceeds  string          `json:"total_proceeds"`
+	TotalCostBasis string          `json:"total_cost_basis"`
+	ShortTermGain  string          `json:"short_term_gain"`
+	LongTermGain   string          `json:"long_term_gain"`
+	TotalGain      string          `json:"total_gain"`
+	Disposals      []TaxDisposal   `json:"disposals"`
+	OpenLots       []TaxLotHolding `json:"open_lots"`
+	Warnings       []string        `json:"warnings,omitempty"`
+	GeneratedAt    time.Time       `json:"generated_at"`
+}
+
+// TaxDisposal is the part of a disposal matched against one acquisition lot. AcquiredAt is empty
+// for quantity disposed beyond the recorded acquisitions, which carries a zero cost basis.
+type TaxDisposal struct {
+	Symbol        string     `json:"symbol"`
+	TransactionID uuid.UUID  `json:"transaction_id"`
+	Quantity      string     `json:"quantity"`
+	AcquiredAt    *time.Time `json:"acquired_at,omitempty"`
+	DisposedAt    time.Time  `json:"disposed_at"`
+	Proceeds      string     `json:"proceeds"`
+	CostBasis     string     `json:"cost_basis"`
+	Gain          string     `json:"gain"`
+	Term          string     `json:"term"`
+}
+
+// TaxLotHolding is an acquisition lot still open at the end of the report year.
+type TaxLotHolding struct {
+	Symbol        string    `json:"symbol"`
+	TransactionID uuid.UUID `json:"transaction_id"`
+	AcquiredAt    time.Time `json:"acquired_at"`
+	Quantity      string    `json:"quantity"`
+	UnitCost      string    `json:"unit_cost"`
+	CostBasis     string    `json:"cost_basis"`
+}
+
+// ReportScheduleRequest opts the user into portfolio report emails. Hour is the local hour in
+// Timezone, an IANA name such as Asia/Bangkok, defaulting to the user's preferred timezone; weekly
+// reports go out on Weekday, default monday.
+type ReportScheduleRequest struct {
+	Frequency string `json:"frequency" validate:"required,oneof=daily weekly"`
+	Timezone  string `json:"timezone,omitempty" validate:"omitempty,max=64"`
+	Hour      int    `json:"hour" validate:"gte=0,lte=23"`
+	Weekday   string `json:"weekday,omitempty" validate:"omitempty,oneof=sunday monday tuesday wednesday thursday friday saturday"`
+	Currency  string `json:"currency,omitempty" validate:"omitempty,len=3"`
+}
+
+// SetDefaults normalises the enumerations and defaults weekly reports to Mondays.
+func (r *ReportScheduleRequest) SetDefaults() {
+	r.Frequency = strings.ToLower(strings.TrimSpace(r.Frequency))
+	r.Timezone = strings.TrimSpace(r.Timezone)
+	r.Weekday = strings.ToLower(strings.TrimSpace(r.Weekday))
+	if r.Weekday == "" {
+		r.Weekday = strings.ToLower(time.Monday.String())
+	}
+	r.Currency = strings.ToUpper(strings.TrimSpace(r.Currency))
+}
+
+// Validate enforces request invariants.
+func (r ReportScheduleRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidateStruct(r)
+	if r.Timezone != "" {
+		if _, err := time.LoadLocation(r.Timezone); err != nil {
+			errs.Add("timezone", "must be an IANA timezone name")
+		}
+	}
+	if r.Currency != "" {
+		if _, ok := entities.ParseCurrencyCode(r.Currency); !ok {
+			errs.Add("currency", "is not a supported currency")
+		}
+	}
+	return errs
+}
+
+// WeekdayValue returns the requested weekday, Monday when it is not recognised.
+func (r ReportScheduleRequest) WeekdayValue() time.Weekday {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(day.String(), r.Weekday) {
+			return day
+		}
+	}
+	return time.Monday
+}
+
+// ReportScheduleResponse describes the user's report schedule.
+type ReportScheduleResponse struct {
+	Frequency  string     `json:"frequency"`
+	Timezone   string     `json:"timezone"`
+	Hour       int        `json:"hour"`
+	Weekday    string     `json:"weekday,omitempty"`
+	Currency   string     `json:"currency,omitempty"`
+	NextRunAt  time.Time  `json:"next_run_at"`
+	LastSentAt *time.Time `json:"last_sent_at,omitempty"`
+}
+
+// NewReportScheduleResponse maps a schedule to its API representation.
+func NewReportScheduleResponse(schedule entities.ReportSchedule) ReportScheduleResponse {
+	response := ReportScheduleResponse{
+		Frequency:  string(schedule.Frequency),
+		Timezone:   schedule.Timezone,
+		Hour:       schedule.Hour,
+		Currency:   string(schedule.Currency),
+		NextRunAt:  schedule.NextRunAt,
+		LastSentAt: schedule.LastSentAt,
+	}
+	if schedule.Frequency == entities.ReportFrequencyWeekly {
+		response.Weekday = strings.ToLower(schedule.Weekday.String())
+	}
+	return response
 }
 
 // PortfolioPerformancePoint represents a historical portfolio value datapoint.
 type PortfolioPerformancePoint struct {
 	Timestamp string `json:"timestamp"`
 	ValueUSD  string `json:"value_usd"`
+	Value     string `json:"value"`
 }
 
-// PortfolioPerformance summarises historical portfolio performance for a selected period.
+// PortfolioPerformance summarises historical portfolio performance for a selected period. Values
+// in Currency are converted at the current fiat rate, so gains reflect the portfolio rather than
+// currency movements over the period.
 type PortfolioPerformance struct {
 	Period             string                       `json:"period"`
 	InitialValueUSD    string                       `json:"initial_value_usd"`
 	FinalValueUSD      string                       `json:"final_value_usd"`
 	GainLossUSD        string                       `json:"gain_loss_usd"`
 	GainLossPercentage string                       `json:"gain_loss_percentage"`
+	Currency           string                       `json:"currency"`
+	InitialValue       string                       `json:"initial_value"`
+	FinalValue         string                       `json:"final_value"`
+	GainLoss           string                       `json:"gain_loss"`
+	FXRate             *FXRateInfo                  `json:"fx_rate,omitempty"`
 	DataPoints         []PortfolioPerformancePoint  `json:"data_points"`
+	// Truncated is set when the time budget ran out before every asset's price history loaded;
+	// those assets contribute only their current value.
+	Truncated bool `json:"truncated"`
 }
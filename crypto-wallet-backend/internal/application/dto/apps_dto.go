@@ -0,0 +1,66 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// AppConsentRequest grants a third-party app access to the caller's account.
+type AppConsentRequest struct {
+	ClientID string   `json:"client_id"`
+	Scopes   []string `json:"scopes"`
+}
+
+// Validate enforces request invariants.
+func (r AppConsentRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	utils.Require(&errs, "client_id", r.ClientID)
+	if len(r.Scopes) == 0 {
+		errs.Add("scopes", "is required")
+	}
+	for _, scope := range r.Scopes {
+		if entities.ParseOAuthScope(scope) == "" {
+			errs.Add("scopes", "must contain only read:wallets, read:transactions or trade")
+			break
+		}
+	}
+	return errs
+}
+
+// AppConsentResponse returns the stored consent and the access token issued to the app.
+type AppConsentResponse struct {
+	App         ConnectedApp `json:"app"`
+	AccessToken string       `json:"access_token"`
+	TokenType   string       `json:"token_type"`
+	ExpiresIn   int64        `json:"expires_in"`
+	Scope       string       `json:"scope"`
+}
+
+// ConnectedApp describes a third-party app the user has granted access to.
+type ConnectedApp struct {
+	ConsentID  uuid.UUID `json:"consent_id"`
+	ClientID   string    `json:"client_id"`
+	ClientName string    `json:"client_name"`
+	Scopes     []string  `json:"scopes"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// MapConnectedApp converts a consent entity into DTO.
+func MapConnectedApp(consent entities.AppConsent) ConnectedApp {
+	scopes := make([]string, len(consent.Scopes))
+	for i, scope := range consent.Scopes {
+		scopes[i] = string(scope)
+	}
+	return ConnectedApp{
+		ConsentID:  consent.ID,
+		ClientID:   consent.ClientID,
+		ClientName: strings.TrimSpace(consent.ClientName),
+		Scopes:     scopes,
+		CreatedAt:  consent.CreatedAt.UTC(),
+	}
+}
@@ -0,0 +1,141 @@
+package dto
+
+import (
+	"net"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// AuditLogQuery captures audit log search filters as received on the query string.
+type AuditLogQuery struct {
+	Actor        string `query:"actor"`
+	Action       string `query:"action"` // comma separated
+	ResourceType string `query:"resource_type"`
+	ResourceID   string `query:"resource_id"`
+	IP           string `query:"ip"` // address or CIDR range
+	From         string `query:"from"`
+	To           string `query:"to"`
+	Limit        int    `query:"limit"`
+	Offset       int    `query:"offset"`
+}
+
+// Validate enforces query invariants.
+func (q AuditLogQuery) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+
+	if q.Actor != "" {
+		utils.RequireUUID(&errs, "actor", q.Actor)
+	}
+	if q.ResourceID != "" {
+		utils.RequireUUID(&errs, "resource_id", q.ResourceID)
+	}
+	if q.IP != "" {
+		if _, _, err := net.ParseCIDR(q.IP); err != nil && net.ParseIP(q.IP) == nil {
+			errs.Add("ip", "must be an IP address or CIDR range")
+		}
+	}
+
+	from, fromErr := parseOptionalTime(q.From)
+	if fromErr != nil {
+		errs.Add("from", "must be an RFC3339 timestamp")
+	}
+	to, toErr := parseOptionalTime(q.To)
+	if toErr != nil {
+		errs.Add("to", "must be an RFC3339 timestamp")
+	}
+	if from != nil && to != nil && to.Before(*from) {
+		errs.Add("to", "must not be before from")
+	}
+
+	return errs
+}
+
+// Actions splits the comma separated action filter.
+func (q AuditLogQuery) Actions() []string {
+	if strings.TrimSpace(q.Action) == "" {
+		return nil
+	}
+	actions := make([]string, 0)
+	for _, action := range strings.Split(q.Action, ",") {
+		if action = strings.TrimSpace(action); action != "" {
+			actions = append(actions, action)
+		}
+	}
+	return actions
+}
+
+// ActorID returns the parsed actor filter, if any.
+func (q AuditLogQuery) ActorID() *uuid.UUID {
+	return parseOptionalUUID(q.Actor)
+}
+
+// ResourceUUID returns the parsed resource filter, if any.
+func (q AuditLogQuery) ResourceUUID() *uuid.UUID {
+	return parseOptionalUUID(q.ResourceID)
+}
+
+// FromTime returns the parsed lower time bound, if any.
+func (q AuditLogQuery) FromTime() *time.Time {
+	from, _ := parseOptionalTime(q.From)
+	return from
+}
+
+// ToTime returns the parsed upper time bound, if any.
+func (q AuditLogQuery) ToTime() *time.Time {
+	to, _ := parseOptionalTime(q.To)
+	return to
+}
+
+// AuditLogList groups audit records with paging metadata.
+type AuditLogList struct {
+	Records []entities.AuditRecord `json:"records"`
+	Total   int64                  `json:"total"`
+	Limit   int                    `json:"limit"`
+	Offset  int                    `json:"offset"`
+}
+
+// AuditChainVerification reports the outcome of walking the audit hash chain.
+type AuditChainVerification struct {
+	Valid            bool      `json:"valid"`
+	RecordsChecked   int       `json:"recordsChecked"`
+	FirstSequence    int64     `json:"firstSequence,omitempty"`
+	LastSequence     int64     `json:"lastSequence,omitempty"`
+	BrokenAtSequence int64     `json:"brokenAtSequence,omitempty"`
+	AnchoredArchive  string    `json:"anchoredArchive,omitempty"`
+	VerifiedAt       time.Time `json:"verifiedAt"`
+}
+
+// AuditArchiveList groups archive metadata with paging metadata.
+type AuditArchiveList struct {
+	Archives []entities.AuditArchive `json:"archives"`
+	Limit    int                     `json:"limit"`
+	Offset   int                     `json:"offset"`
+}
+
+func parseOptionalUUID(value string) *uuid.UUID {
+	if value == "" {
+		return nil
+	}
+	parsed, err := uuid.Parse(value)
+	if err != nil {
+		return nil
+	}
+	return &parsed
+}
+
+func parseOptionalTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	parsed = parsed.UTC()
+	return &parsed, nil
+}
@@ -1,7 +1,6 @@
 package dto
 
 import (
-	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,27 +10,72 @@ import (
 )
 
 type RegisterRequest struct {
-	Email           string `json:"email"`
-	Password        string `json:"password"`
-	ConfirmPassword string `json:"confirmPassword"`
+	Email           string `json:"email" validate:"required,email"`
+	Password        string `json:"password" validate:"min=12"`
+	ConfirmPassword string `json:"confirmPassword" validate:"required,eqfield=Password"`
 	FirstName       string `json:"firstName"`
 	LastName        string `json:"lastName"`
 	PhoneNumber     string `json:"phoneNumber"`
 }
 
 type LoginRequest struct {
-	Email      string `json:"email"`
-	Password   string `json:"password"`
+	Email      string `json:"email" validate:"required,email"`
+	Password   string `json:"password" validate:"required"`
 	RememberMe bool   `json:"rememberMe"`
+	// UserAgent and IPAddress describe the signing-in device; they are set from the request, not the body.
+	UserAgent string `json:"-"`
+	IPAddress string `json:"-"`
 }
 
 type LogoutRequest struct {
 	UserID uuid.UUID `json:"userId"`
 }
 
+// RefreshTokenRequest exchanges a refresh token for a new token pair.
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refreshToken" validate:"required"`
+}
+
+func (r RefreshTokenRequest) Validate() utils.ValidationErrors {
+	return utils.ValidateStruct(r)
+}
+
+// RevokeTokenRequest ends the session a refresh token belongs to.
+type RevokeTokenRequest struct {
+	RefreshToken string `json:"refreshToken" validate:"required"`
+}
+
+func (r RevokeTokenRequest) Validate() utils.ValidationErrors {
+	return utils.ValidateStruct(r)
+}
+
+// VerifyEmailRequest redeems the token from an emailed verification link.
+type VerifyEmailRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+func (r VerifyEmailRequest) Validate() utils.ValidationErrors {
+	return utils.ValidateStruct(r)
+}
+
+// EmailVerificationSentResponse reports where a verification link was sent and until when it works.
+type EmailVerificationSentResponse struct {
+	Email     string    `json:"email"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// EmailVerificationStatusResponse reports the outcome of redeeming a verification link.
+type EmailVerificationStatusResponse struct {
+	Verified   bool       `json:"verified"`
+	VerifiedAt *time.Time `json:"verifiedAt,omitempty"`
+}
+
+// AuthTokens carries a session's tokens. In cookie-auth mode the tokens are set as httpOnly
+// cookies instead and only the CSRF token is returned.
 type AuthTokens struct {
-	AccessToken      string    `json:"accessToken"`
+	AccessToken      string    `json:"accessToken,omitempty"`
 	RefreshToken     string    `json:"refreshToken,omitempty"`
+	CSRFToken        string    `json:"csrfToken,omitempty"`
 	ExpiresAt        time.Time `json:"expiresAt"`
 	RefreshExpiresAt time.Time `json:"refreshExpiresAt,omitempty"`
 }
@@ -57,27 +101,11 @@ type AuthResponse struct {
 }
 
 func (r RegisterRequest) Validate() utils.ValidationErrors {
-	errs := utils.ValidationErrors{}
-	utils.RequireEmail(&errs, "email", r.Email)
-	utils.RequireMinLength(&errs, "password", r.Password, 12)
-	if strings.TrimSpace(r.ConfirmPassword) == "" {
-		errs.Add("confirmPassword", "is required")
-	} else if r.Password != r.ConfirmPassword {
-		errs.Add("confirmPassword", "does not match password")
-	}
-
-	if !errs.IsEmpty() {
-		return errs
-	}
-
-	return errs
+	return utils.ValidateStruct(r)
 }
 
 func (r LoginRequest) Validate() utils.ValidationErrors {
-	errs := utils.ValidationErrors{}
-	utils.RequireEmail(&errs, "email", r.Email)
-	utils.Require(&errs, "password", r.Password)
-	return errs
+	return utils.ValidateStruct(r)
 }
 
 func NewAuthUser(user entities.User) AuthUser {
@@ -105,28 +133,20 @@ type TwoFactorSetupResponse struct {
 
 // EnableTwoFactorRequest carries the verification code provided by the user.
 type EnableTwoFactorRequest struct {
-    Code string `json:"code"`
+    Code string `json:"code" validate:"required,len=6,numeric"`
 }
 
 func (r EnableTwoFactorRequest) Validate() utils.ValidationErrors {
-    errs := utils.ValidationErrors{}
-    if len(strings.TrimSpace(r.Code)) != 6 {
-        errs.Add("code", "must be a 6-digit verification code")
-    }
-    return errs
+    return utils.ValidateStruct(r)
 }
 
 // DisableTwoFactorRequest optionally includes a verification code for confirmation.
 type DisableTwoFactorRequest struct {
-    Code string `json:"code,omitempty"`
+    Code string `json:"code,omitempty" validate:"omitempty,len=6,numeric"`
 }
 
 func (r DisableTwoFactorRequest) Validate() utils.ValidationErrors {
-    errs := utils.ValidationErrors{}
-    if strings.TrimSpace(r.Code) != "" && len(strings.TrimSpace(r.Code)) != 6 {
-        errs.Add("code", "must be a 6-digit verification code")
-    }
-    return errs
+    return utils.ValidateStruct(r)
 }
 
 // TwoFactorStatusResponse reports the updated 2FA state for the account.
@@ -0,0 +1,121 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// Balance discrepancy review outcomes.
+const (
+	// BalanceDiscrepancyActionCorrect overwrites the stored balance with the current on-chain balance.
+	BalanceDiscrepancyActionCorrect = "correct"
+	// BalanceDiscrepancyActionDismiss closes the item without touching the wallet.
+	BalanceDiscrepancyActionDismiss = "dismiss"
+)
+
+// ResolveBalanceDiscrepancyRequest closes an open balance discrepancy.
+type ResolveBalanceDiscrepancyRequest struct {
+	Action string `json:"action"`
+	Note   string `json:"note"`
+}
+
+// Validate enforces request invariants.
+func (r ResolveBalanceDiscrepancyRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	utils.Require(&errs, "action", r.Action)
+	utils.RequireInSet(&errs, "action", r.Action, []string{
+		BalanceDiscrepancyActionCorrect,
+		BalanceDiscrepancyActionDismiss,
+	})
+	utils.Require(&errs, "note", r.Note)
+	utils.RequireMaxLength(&errs, "note", r.Note, 2000)
+	return errs
+}
+
+// BalanceDiscrepancyResponse describes a stored balance that disagreed with the chain.
+type BalanceDiscrepancyResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	RunID          uuid.UUID  `json:"runId"`
+	WalletID       uuid.UUID  `json:"walletId"`
+	UserID         uuid.UUID  `json:"userId"`
+	Chain          string     `json:"chain"`
+	Address        string     `json:"address"`
+	StoredBalance  string     `json:"storedBalance"`
+	OnChainBalance string     `json:"onChainBalance"`
+	Difference     string     `json:"difference"`
+	Magnitude      string     `json:"magnitude"`
+	Status         string     `json:"status"`
+	ResolvedBy     *uuid.UUID `json:"resolvedBy,omitempty"`
+	Resolution     string     `json:"resolution,omitempty"`
+	DetectedAt     time.Time  `json:"detectedAt"`
+	ResolvedAt     *time.Time `json:"resolvedAt,omitempty"`
+}
+
+// NewBalanceDiscrepancyResponse maps a discrepancy to an API response.
+func NewBalanceDiscrepancyResponse(discrepancy entities.BalanceDiscrepancy) BalanceDiscrepancyResponse {
+	return BalanceDiscrepancyResponse{
+		ID:             discrepancy.ID,
+		RunID:          discrepancy.RunID,
+		WalletID:       discrepancy.WalletID,
+		UserID:         discrepancy.UserID,
+		Chain:          string(discrepancy.Chain),
+		Address:        discrepancy.Address,
+		StoredBalance:  discrepancy.StoredBalance.String(),
+		OnChainBalance: discrepancy.OnChainBalance.String(),
+		Difference:     discrepancy.Difference.String(),
+		Magnitude:      discrepancy.Magnitude.String(),
+		Status:         string(discrepancy.Status),
+		ResolvedBy:     discrepancy.ResolvedBy,
+		Resolution:     discrepancy.Resolution,
+		DetectedAt:     discrepancy.DetectedAt,
+		ResolvedAt:     discrepancy.ResolvedAt,
+	}
+}
+
+// BalanceDiscrepancyList groups discrepancies with paging metadata.
+type BalanceDiscrepancyList struct {
+	Discrepancies []BalanceDiscrepancyResponse `json:"discrepancies"`
+	Limit         int                          `json:"limit"`
+	Offset        int                          `json:"offset"`
+}
+
+// BalanceReconciliationRunResponse summarises one reconciliation pass over a chain.
+type BalanceReconciliationRunResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	Chain      string     `json:"chain"`
+	Mode       string     `json:"mode"`
+	Scanned    int        `json:"scanned"`
+	Matched    int        `json:"matched"`
+	Corrected  int        `json:"corrected"`
+	Escalated  int        `json:"escalated"`
+	Failed     int        `json:"failed"`
+	StartedAt  time.Time  `json:"startedAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
+// NewBalanceReconciliationRunResponse maps a run to an API response.
+func NewBalanceReconciliationRunResponse(run entities.BalanceReconciliationRun) BalanceReconciliationRunResponse {
+	return BalanceReconciliationRunResponse{
+		ID:         run.ID,
+		Chain:      string(run.Chain),
+		Mode:       string(run.Mode),
+		Scanned:    run.Scanned,
+		Matched:    run.Matched,
+		Corrected:  run.Corrected,
+		Escalated:  run.Escalated,
+		Failed:     run.Failed,
+		StartedAt:  run.StartedAt,
+		FinishedAt: run.FinishedAt,
+	}
+}
+
+// BalanceReconciliationRunList groups runs with paging metadata.
+type BalanceReconciliationRunList struct {
+	Runs   []BalanceReconciliationRunResponse `json:"runs"`
+	Limit  int                                `json:"limit"`
+	Offset int                                `json:"offset"`
+}
@@ -0,0 +1,130 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// MaxBroadcastThrottle caps the delivery rate staff may request for a campaign.
+const MaxBroadcastThrottle = 10000
+
+// CreateBroadcastRequest captures a new broadcast campaign. An empty ScheduledAt sends as soon as
+// the dispatcher next runs; an empty ThrottlePerMinute uses the configured default.
+type CreateBroadcastRequest struct {
+	Name              string                    `json:"name"`
+	Title             string                    `json:"title"`
+	Body              string                    `json:"body"`
+	Segment           entities.BroadcastSegment `json:"segment"`
+	ScheduledAt       *time.Time                `json:"scheduledAt,omitempty"`
+	ThrottlePerMinute int                       `json:"throttlePerMinute,omitempty"`
+}
+
+// Validate enforces request invariants.
+func (r CreateBroadcastRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	utils.Require(&errs, "name", r.Name)
+	utils.RequireMaxLength(&errs, "name", r.Name, 200)
+	utils.Require(&errs, "title", r.Title)
+	utils.RequireMaxLength(&errs, "title", r.Title, 200)
+	utils.Require(&errs, "body", r.Body)
+	utils.RequireMaxLength(&errs, "body", r.Body, 5000)
+	if r.ThrottlePerMinute < 0 || r.ThrottlePerMinute > MaxBroadcastThrottle {
+		errs.Add("throttlePerMinute", "throttlePerMinute must be between 1 and 10000")
+	}
+	if err := r.Segment.Validate(); err != nil {
+		errs.Add("segment", err.Error())
+	}
+	return errs
+}
+
+// PreviewBroadcastSegmentRequest asks how many users a segment matches.
+type PreviewBroadcastSegmentRequest struct {
+	Segment entities.BroadcastSegment `json:"segment"`
+}
+
+// BroadcastSegmentPreview reports a segment's current size.
+type BroadcastSegmentPreview struct {
+	Recipients int64 `json:"recipients"`
+}
+
+// BroadcastStatsResponse summarises a campaign's deliveries. Rates are fractions between 0 and 1.
+type BroadcastStatsResponse struct {
+	Recipients   int64   `json:"recipients"`
+	Pending      int64   `json:"pending"`
+	Delivered    int64   `json:"delivered"`
+	Failed       int64   `json:"failed"`
+	Opened       int64   `json:"opened"`
+	DeliveryRate float64 `json:"deliveryRate"`
+	OpenRate     float64 `json:"openRate"`
+}
+
+// NewBroadcastStatsResponse maps delivery counts to an API response. The open rate is measured
+// against delivered messages.
+func NewBroadcastStatsResponse(stats entities.BroadcastStats) BroadcastStatsResponse {
+	response := BroadcastStatsResponse{
+		Recipients: stats.Recipients,
+		Pending:    stats.Pending,
+		Delivered:  stats.Delivered,
+		Failed:     stats.Failed,
+		Opened:     stats.Opened,
+	}
+	if stats.Recipients > 0 {
+		response.DeliveryRate = float64(stats.Delivered) / float64(stats.Recipients)
+	}
+	if stats.Delivered > 0 {
+		response.OpenRate = float64(stats.Opened) / float64(stats.Delivered)
+	}
+	return response
+}
+
+// BroadcastCampaignResponse describes a campaign; Stats is only included on single-campaign reads.
+type BroadcastCampaignResponse struct {
+	ID                uuid.UUID                 `json:"id"`
+	Name              string                    `json:"name"`
+	Title             string                    `json:"title"`
+	Body              string                    `json:"body"`
+	Segment           entities.BroadcastSegment `json:"segment"`
+	Status            string                    `json:"status"`
+	ScheduledAt       time.Time                 `json:"scheduledAt"`
+	ThrottlePerMinute int                       `json:"throttlePerMinute"`
+	RecipientCount    int64                     `json:"recipientCount"`
+	CreatedBy         uuid.UUID                 `json:"createdBy"`
+	StartedAt         *time.Time                `json:"startedAt,omitempty"`
+	CompletedAt       *time.Time                `json:"completedAt,omitempty"`
+	CancelledAt       *time.Time                `json:"cancelledAt,omitempty"`
+	CreatedAt         time.Time                 `json:"createdAt"`
+	UpdatedAt         time.Time                 `json:"updatedAt"`
+	Stats             *BroadcastStatsResponse   `json:"stats,omitempty"`
+}
+
+// NewBroadcastCampaignResponse maps a domain campaign to an API response.
+func NewBroadcastCampaignResponse(campaign entities.BroadcastCampaign) BroadcastCampaignResponse {
+	return BroadcastCampaignResponse{
+		ID:                campaign.GetID(),
+		Name:              campaign.GetName(),
+		Title:             campaign.GetTitle(),
+		Body:              campaign.GetBody(),
+		Segment:           campaign.GetSegment(),
+		Status:            string(campaign.GetStatus()),
+		ScheduledAt:       campaign.GetScheduledAt(),
+		ThrottlePerMinute: campaign.GetThrottlePerMinute(),
+		RecipientCount:    campaign.GetRecipientCount(),
+		CreatedBy:         campaign.GetCreatedBy(),
+		StartedAt:         campaign.GetStartedAt(),
+		CompletedAt:       campaign.GetCompletedAt(),
+		CancelledAt:       campaign.GetCancelledAt(),
+		CreatedAt:         campaign.GetCreatedAt(),
+		UpdatedAt:         campaign.GetUpdatedAt(),
+	}
+}
+
+// BroadcastCampaignList is a page of campaigns.
+type BroadcastCampaignList struct {
+	Campaigns []BroadcastCampaignResponse `json:"campaigns"`
+	Limit     int                         `json:"limit"`
+	Offset    int                         `json:"offset"`
+}
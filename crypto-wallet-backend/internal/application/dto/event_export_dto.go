@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// maxEventBackfillWindow bounds a single backfill range; larger replays are split by the caller.
+const maxEventBackfillWindow = 31 * 24 * time.Hour
+
+// EventBackfillRequest asks for outbox events in [from, to) to be re-exported. AfterSequence resumes
+// a backfill from the nextSequence of a previous page.
+type EventBackfillRequest struct {
+	From          string `json:"from"`
+	To            string `json:"to"`
+	AfterSequence int64  `json:"afterSequence,omitempty"`
+	Limit         int    `json:"limit,omitempty"`
+}
+
+// Validate enforces request invariants.
+func (r EventBackfillRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+
+	from, fromErr := time.Parse(time.RFC3339, r.From)
+	if fromErr != nil {
+		errs.Add("from", "must be a valid RFC3339 datetime")
+	}
+
+	to, toErr := time.Parse(time.RFC3339, r.To)
+	if toErr != nil {
+		errs.Add("to", "must be a valid RFC3339 datetime")
+	}
+
+	if fromErr == nil && toErr == nil {
+		if !to.After(from) {
+			errs.Add("to", "must be after from")
+		} else if to.Sub(from) > maxEventBackfillWindow {
+			errs.Add("to", "range cannot exceed 31 days")
+		}
+	}
+
+	if r.AfterSequence < 0 {
+		errs.Add("afterSequence", "cannot be negative")
+	}
+
+	if r.Limit < 0 {
+		errs.Add("limit", "cannot be negative")
+	}
+
+	return errs
+}
+
+// FromTime returns the parsed range start; call after Validate.
+func (r EventBackfillRequest) FromTime() time.Time {
+	t, _ := time.Parse(time.RFC3339, r.From)
+	return t.UTC()
+}
+
+// ToTime returns the parsed range end; call after Validate.
+func (r EventBackfillRequest) ToTime() time.Time {
+	t, _ := time.Parse(time.RFC3339, r.To)
+	return t.UTC()
+}
+
+// EventBackfillResponse reports one backfill page. Callers repeat the request with
+// afterSequence=nextSequence until done is true.
+type EventBackfillResponse struct {
+	Sink         string   `json:"sink"`
+	Exported     int      `json:"exported"`
+	Partitions   []string `json:"partitions"`
+	NextSequence int64    `json:"nextSequence"`
+	Done         bool     `json:"done"`
+}
@@ -18,14 +18,45 @@ type ExchangeRateResponse struct {
 	MaxSwapAmount *decimal.Decimal `json:"max_swap_amount,omitempty"`
 	IsActive      bool             `json:"is_active"`
 	HasLiquidity  bool             `json:"has_liquidity"`
+	Source        string           `json:"source"`
 	LastUpdated   time.Time        `json:"last_updated"`
+	Stale         bool             `json:"stale"`
 }
 
-// QuoteRequest represents the request for getting an exchange quote.
+// QuoteRequest represents the request for getting an exchange quote. Exactly one of FromAmount,
+// the amount to spend, and ToAmount, the amount to receive, is set.
 type QuoteRequest struct {
 	FromWalletID uuid.UUID `json:"from_wallet_id" validate:"required"`
 	ToWalletID   uuid.UUID `json:"to_wallet_id" validate:"required"`
-	FromAmount   string    `json:"from_amount" validate:"required,numeric"`
+	FromAmount   string    `json:"from_amount,omitempty" validate:"omitempty,numeric"`
+	ToAmount     string    `json:"to_amount,omitempty" validate:"omitempty,numeric"`
+}
+
+// Sides a quote is priced from.
+const (
+	QuoteSideFrom = "from_amount"
+	QuoteSideTo   = "to_amount"
+)
+
+// QuoteFeeBreakdown itemises the fee on a quote. The fee is taken from the source amount before
+// the remainder is converted.
+type QuoteFeeBreakdown struct {
+	Percentage decimal.Decimal `json:"percentage"`
+	// Amount is charged in the source asset; ConvertedAmount is what it would have bought.
+	Amount          decimal.Decimal `json:"amount"`
+	ConvertedAmount decimal.Decimal `json:"converted_amount"`
+	// NetFromAmount is the part of the source amount that is converted.
+	NetFromAmount decimal.Decimal `json:"net_from_amount"`
+	// UndiscountedPercentage is the fee before the user's tier discount, when one applied.
+	UndiscountedPercentage *decimal.Decimal `json:"undiscounted_percentage,omitempty"`
+}
+
+// QuoteLimits checks a quote's source amount against its trading pair's swap limits.
+type QuoteLimits struct {
+	MinSwapAmount decimal.Decimal  `json:"min_swap_amount"`
+	MaxSwapAmount *decimal.Decimal `json:"max_swap_amount,omitempty"`
+	MeetsMinimum  bool             `json:"meets_minimum"`
+	WithinMaximum bool             `json:"within_maximum"`
 }
 
 // QuoteResponse represents the response for an exchange quote.
@@ -39,12 +70,26 @@ type QuoteResponse struct {
 	FeePercentage  decimal.Decimal `json:"fee_percentage"`
 	FeeAmount      decimal.Decimal `json:"fee_amount"`
 	QuoteExpiresAt time.Time       `json:"quote_expires_at"`
+	QuoteTTL       int             `json:"quote_ttl_seconds"`  // Validity window the pair grants its quotes
 	ExpiresIn      int             `json:"expires_in_seconds"` // Seconds until expiration
+	// PricingStrategy and PricingMetadata explain how the rate and fee were derived.
+	PricingStrategy string            `json:"pricing_strategy,omitempty"`
+	PricingMetadata map[string]string `json:"pricing_metadata,omitempty"`
+	// PricedFrom is the side the quote was priced from. A quote priced from the amount to receive
+	// echoes it in RequestedToAmount; ToAmount may exceed it by the source asset's rounding.
+	PricedFrom        string            `json:"priced_from"`
+	RequestedToAmount *decimal.Decimal  `json:"requested_to_amount,omitempty"`
+	Fee               QuoteFeeBreakdown `json:"fee"`
+	Limits            QuoteLimits       `json:"limits"`
+	// QuoteToken carries the signed terms of the quote; pass it to execute the quote as priced.
+	QuoteToken string `json:"quote_token,omitempty"`
 }
 
-// ExecuteExchangeRequest represents the request to execute an exchange.
+// ExecuteExchangeRequest represents the request to execute an exchange, identified by the quote
+// token it was priced with or by the ID of an operation already stored.
 type ExecuteExchangeRequest struct {
-	OperationID uuid.UUID `json:"operation_id" validate:"required"`
+	OperationID uuid.UUID `json:"operation_id,omitempty"`
+	QuoteToken  string    `json:"quote_token,omitempty"`
 }
 
 // ExecuteExchangeResponse represents the response after executing an exchange.
@@ -61,6 +106,9 @@ type ExecuteExchangeResponse struct {
 	FromTransactionID *uuid.UUID      `json:"from_transaction_id,omitempty"`
 	ToTransactionID   *uuid.UUID      `json:"to_transaction_id,omitempty"`
 	ErrorMessage      string          `json:"error_message,omitempty"`
+	// Queued is set when the execution was accepted to run in the background; poll the operation
+	// for its outcome.
+	Queued bool `json:"queued,omitempty"`
 }
 
 // CancelExchangeRequest represents the request to cancel an exchange.
@@ -79,23 +127,25 @@ type CancelExchangeResponse struct {
 
 // ExchangeOperationResponse represents a single exchange operation in the history.
 type ExchangeOperationResponse struct {
-	ID                uuid.UUID       `json:"id"`
-	UserID            uuid.UUID       `json:"user_id"`
-	FromWalletID      uuid.UUID       `json:"from_wallet_id"`
-	ToWalletID        uuid.UUID       `json:"to_wallet_id"`
-	FromAmount        decimal.Decimal `json:"from_amount"`
-	ToAmount          decimal.Decimal `json:"to_amount"`
-	ExchangeRate      decimal.Decimal `json:"exchange_rate"`
-	FeePercentage     decimal.Decimal `json:"fee_percentage"`
-	FeeAmount         decimal.Decimal `json:"fee_amount"`
-	Status            string          `json:"status"`
-	QuoteExpiresAt    time.Time       `json:"quote_expires_at"`
-	ExecutedAt        *time.Time      `json:"executed_at,omitempty"`
-	FromTransactionID *uuid.UUID      `json:"from_transaction_id,omitempty"`
-	ToTransactionID   *uuid.UUID      `json:"to_transaction_id,omitempty"`
-	ErrorMessage      string          `json:"error_message,omitempty"`
-	CreatedAt         time.Time       `json:"created_at"`
-	UpdatedAt         time.Time       `json:"updated_at"`
+	ID                uuid.UUID         `json:"id"`
+	UserID            uuid.UUID         `json:"user_id"`
+	FromWalletID      uuid.UUID         `json:"from_wallet_id"`
+	ToWalletID        uuid.UUID         `json:"to_wallet_id"`
+	FromAmount        decimal.Decimal   `json:"from_amount"`
+	ToAmount          decimal.Decimal   `json:"to_amount"`
+	ExchangeRate      decimal.Decimal   `json:"exchange_rate"`
+	FeePercentage     decimal.Decimal   `json:"fee_percentage"`
+	FeeAmount         decimal.Decimal   `json:"fee_amount"`
+	Status            string            `json:"status"`
+	QuoteExpiresAt    time.Time         `json:"quote_expires_at"`
+	ExecutedAt        *time.Time        `json:"executed_at,omitempty"`
+	FromTransactionID *uuid.UUID        `json:"from_transaction_id,omitempty"`
+	ToTransactionID   *uuid.UUID        `json:"to_transaction_id,omitempty"`
+	ErrorMessage      string            `json:"error_message,omitempty"`
+	PricingStrategy   string            `json:"pricing_strategy,omitempty"`
+	PricingMetadata   map[string]string `json:"pricing_metadata,omitempty"`
+	CreatedAt         time.Time         `json:"created_at"`
+	UpdatedAt         time.Time         `json:"updated_at"`
 }
 
 // ExchangeHistoryRequest represents the request for getting exchange history.
@@ -122,7 +172,8 @@ type ExchangeHistoryResponse struct {
 
 // TradingPairsResponse represents the response for getting active trading pairs.
 type TradingPairsResponse struct {
-	Pairs []ExchangeRateResponse `json:"pairs"`
+	Pairs            []ExchangeRateResponse `json:"pairs"`
+	FreshnessWarning string                 `json:"freshness_warning,omitempty"`
 }
 
 // ExchangeStatsResponse represents exchange statistics for a user.
@@ -0,0 +1,186 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// AccountingExportRequest captures the payload for queueing an accounting export.
+type AccountingExportRequest struct {
+	Format    string `json:"format"` // quickbooks, xero
+	WalletID  string `json:"walletId,omitempty"`
+	Chain     string `json:"chain,omitempty"`
+	StartDate string `json:"startDate,omitempty"`
+	EndDate   string `json:"endDate,omitempty"`
+}
+
+// Validate enforces request invariants.
+func (r AccountingExportRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	utils.Require(&errs, "format", r.Format)
+	utils.RequireInSet(&errs, "format", strings.ToLower(r.Format), []string{
+		string(entities.AccountingFormatQuickBooks),
+		string(entities.AccountingFormatXero),
+	})
+
+	if r.WalletID != "" {
+		utils.RequireUUID(&errs, "walletId", r.WalletID)
+	}
+
+	if r.Chain != "" {
+		utils.RequireInSet(&errs, "chain", strings.ToUpper(r.Chain), chainStrings(entities.SupportedChains()))
+	}
+
+	var start, end time.Time
+	if r.StartDate != "" {
+		parsed, err := time.Parse(time.RFC3339, r.StartDate)
+		if err != nil {
+			errs.Add("startDate", "must be an RFC3339 timestamp")
+		}
+		start = parsed
+	}
+	if r.EndDate != "" {
+		parsed, err := time.Parse(time.RFC3339, r.EndDate)
+		if err != nil {
+			errs.Add("endDate", "must be an RFC3339 timestamp")
+		}
+		end = parsed
+	}
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		errs.Add("endDate", "must not be before startDate")
+	}
+
+	return errs
+}
+
+// AccountMappingItem maps one chain (or the fallback, when chain is empty) to ledger accounts.
+type AccountMappingItem struct {
+	Chain           string `json:"chain,omitempty"`
+	AssetAccount    string `json:"assetAccount"`
+	ClearingAccount string `json:"clearingAccount"`
+	FeeAccount      string `json:"feeAccount"`
+}
+
+// UpdateAccountMappingsRequest replaces the caller's full set of account mappings.
+type UpdateAccountMappingsRequest struct {
+	Mappings []AccountMappingItem `json:"mappings"`
+}
+
+// Validate enforces request invariants.
+func (r UpdateAccountMappingsRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	seen := make(map[string]struct{}, len(r.Mappings))
+	chains := chainStrings(entities.SupportedChains())
+
+	for _, item := range r.Mappings {
+		chain := strings.ToUpper(strings.TrimSpace(item.Chain))
+		if chain != "" {
+			utils.RequireInSet(&errs, "mappings.chain", chain, chains)
+		}
+		if _, ok := seen[chain]; ok {
+			errs.Add("mappings.chain", "each chain may only be mapped once")
+		}
+		seen[chain] = struct{}{}
+
+		utils.Require(&errs, "mappings.assetAccount", item.AssetAccount)
+		utils.RequireMaxLength(&errs, "mappings.assetAccount", item.AssetAccount, 100)
+		utils.Require(&errs, "mappings.clearingAccount", item.ClearingAccount)
+		utils.RequireMaxLength(&errs, "mappings.clearingAccount", item.ClearingAccount, 100)
+		utils.Require(&errs, "mappings.feeAccount", item.FeeAccount)
+		utils.RequireMaxLength(&errs, "mappings.feeAccount", item.FeeAccount, 100)
+	}
+
+	return errs
+}
+
+// AccountMappingsResponse lists the caller's configured mappings and the accounts each chain resolves to.
+type AccountMappingsResponse struct {
+	Mappings  []AccountMappingItem `json:"mappings"`
+	Effective []AccountMappingItem `json:"effective"`
+}
+
+// NewAccountMappingsResponse maps configured mappings to an API response.
+func NewAccountMappingsResponse(mappings []entities.AccountMapping) AccountMappingsResponse {
+	configured := make([]AccountMappingItem, 0, len(mappings))
+	for _, mapping := range mappings {
+		configured = append(configured, newAccountMappingItem(mapping))
+	}
+
+	effective := make([]AccountMappingItem, 0)
+	for _, chain := range entities.SupportedChains() {
+		effective = append(effective, newAccountMappingItem(entities.ResolveAccountMapping(mappings, chain)))
+	}
+
+	return AccountMappingsResponse{
+		Mappings:  configured,
+		Effective: effective,
+	}
+}
+
+func newAccountMappingItem(mapping entities.AccountMapping) AccountMappingItem {
+	return AccountMappingItem{
+		Chain:           string(mapping.Chain),
+		AssetAccount:    mapping.AssetAccount,
+		ClearingAccount: mapping.ClearingAccount,
+		FeeAccount:      mapping.FeeAccount,
+	}
+}
+
+// ExportJobResponse describes an asynchronous export and, once ready, where to download it.
+type ExportJobResponse struct {
+	ID           uuid.UUID         `json:"id"`
+	Kind         string            `json:"kind"`
+	Format       string            `json:"format"`
+	Status       string            `json:"status"`
+	Parameters   map[string]string `json:"parameters,omitempty"`
+	Filename     string            `json:"filename,omitempty"`
+	Size         int64             `json:"size,omitempty"`
+	RowCount     int               `json:"rowCount"`
+	DownloadURL  string            `json:"downloadUrl,omitempty"`
+	ErrorMessage string            `json:"error,omitempty"`
+	CreatedAt    time.Time         `json:"createdAt"`
+	CompletedAt  *time.Time        `json:"completedAt,omitempty"`
+	ExpiresAt    *time.Time        `json:"expiresAt,omitempty"`
+	// DownloadURLExpiresAt is set when DownloadURL is a presigned object storage link.
+	DownloadURLExpiresAt *time.Time `json:"downloadUrlExpiresAt,omitempty"`
+	// Truncated is set when generation ran out of its time budget and the file holds only the
+	// rows written until then.
+	Truncated bool `json:"truncated"`
+}
+
+// NewExportJobResponse maps a domain export job to an API response.
+func NewExportJobResponse(job entities.ExportJob, now time.Time) ExportJobResponse {
+	response := ExportJobResponse{
+		ID:           job.GetID(),
+		Kind:         string(job.GetKind()),
+		Format:       job.GetFormat(),
+		Status:       string(job.GetStatus()),
+		Parameters:   job.GetParameters(),
+		Filename:     job.GetFilename(),
+		Size:         job.GetSize(),
+		RowCount:     job.GetRowCount(),
+		Truncated:    job.IsTruncated(),
+		ErrorMessage: job.GetErrorMessage(),
+		CreatedAt:    job.GetCreatedAt(),
+		CompletedAt:  job.GetCompletedAt(),
+		ExpiresAt:    job.GetExpiresAt(),
+	}
+
+	if job.GetStatus() == entities.ExportJobStatusCompleted && (job.GetExpiresAt() == nil || now.Before(*job.GetExpiresAt())) {
+		response.DownloadURL = "/api/v1/exports/" + job.GetID().String() + "/download"
+	}
+
+	return response
+}
+
+// ExportJobList groups export jobs with paging metadata.
+type ExportJobList struct {
+	Jobs   []ExportJobResponse `json:"jobs"`
+	Limit  int                 `json:"limit"`
+	Offset int                 `json:"offset"`
+}
@@ -0,0 +1,84 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// GasFeeEstimate is the suggested EIP-1559 fee for one speed. TransferFee is the most a plain
+// transfer can cost at that fee, in the chain's native token.
+type GasFeeEstimate struct {
+	Speed              string `json:"speed"`
+	MaxPriorityFeeGwei string `json:"max_priority_fee_gwei"`
+	MaxFeeGwei         string `json:"max_fee_gwei"`
+	TransferFee        string `json:"transfer_fee"`
+	EstimatedSeconds   int    `json:"estimated_seconds"`
+}
+
+// GasPricePoint is one block of fee history for charting.
+type GasPricePoint struct {
+	BlockNumber           int64     `json:"block_number"`
+	BaseFeeGwei           string    `json:"base_fee_gwei"`
+	SlowPriorityFeeGwei   string    `json:"slow_priority_fee_gwei"`
+	NormalPriorityFeeGwei string    `json:"normal_priority_fee_gwei"`
+	FastPriorityFeeGwei   string    `json:"fast_priority_fee_gwei"`
+	GasUsedRatio          float64   `json:"gas_used_ratio"`
+	ObservedAt            time.Time `json:"observed_at"`
+}
+
+// GasOracleResponse reports current fee estimates for a chain and its recent fee history, oldest
+// block first. Stale is set when the oracle has not seen a new block recently.
+type GasOracleResponse struct {
+	Chain          string           `json:"chain"`
+	BaseFeeGwei    string           `json:"base_fee_gwei"`
+	Estimates      []GasFeeEstimate `json:"estimates"`
+	PreferredSpeed string           `json:"preferred_speed"`
+	History        []GasPricePoint  `json:"history"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+	Stale          bool             `json:"stale"`
+}
+
+// GasPreferenceRequest sets the user's default gas speed.
+type GasPreferenceRequest struct {
+	Speed string `json:"speed"`
+}
+
+// Validate enforces request invariants.
+func (r GasPreferenceRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	if _, ok := entities.ParseGasSpeed(r.Speed); !ok {
+		errs.Add("speed", "must be one of slow, normal, fast")
+	}
+	return errs
+}
+
+// GasPreferenceResponse reports the user's default gas speed. IsDefault is set when the user has
+// not chosen one.
+type GasPreferenceResponse struct {
+	Speed     string `json:"speed"`
+	IsDefault bool   `json:"is_default"`
+}
+
+// GasFeeQuote is the fee a send is built with on an EVM chain. Fee is the maximum network fee in
+// the chain's native token.
+type GasFeeQuote struct {
+	Speed                   entities.GasSpeed
+	GasLimit                int64
+	MaxFeePerGasWei         decimal.Decimal
+	MaxPriorityFeePerGasWei decimal.Decimal
+	Fee                     decimal.Decimal
+}
+
+// Metadata describes the quote for the blockchain adapter and the stored transaction.
+func (q GasFeeQuote) Metadata() map[string]any {
+	return map[string]any{
+		"gas_speed":                    string(q.Speed),
+		"gas_limit":                    q.GasLimit,
+		"max_fee_per_gas_wei":          q.MaxFeePerGasWei.String(),
+		"max_priority_fee_per_gas_wei": q.MaxPriorityFeePerGasWei.String(),
+	}
+}
@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// InternalEventResponse is one outbox event delivered to an internal consumer. Offset is the
+// outbox sequence; consumers resume by passing the last offset they processed.
+type InternalEventResponse struct {
+	Offset        int64          `json:"offset"`
+	ID            uuid.UUID      `json:"eventId"`
+	Type          string         `json:"eventType"`
+	SchemaVersion int            `json:"schemaVersion"`
+	Payload       map[string]any `json:"payload"`
+	OccurredAt    time.Time      `json:"occurredAt"`
+}
+
+// InternalEventStreamResponse is one page of a consumer's event stream.
+type InternalEventStreamResponse struct {
+	Consumer   string                  `json:"consumer"`
+	Events     []InternalEventResponse `json:"events"`
+	NextOffset int64                   `json:"nextOffset"`
+	HasMore    bool                    `json:"hasMore"`
+}
+
+// InternalEventOffsetRequest commits the offset a consumer has processed up to.
+type InternalEventOffsetRequest struct {
+	Offset int64 `json:"offset"`
+}
+
+// InternalEventOffsetResponse reports a consumer's committed offset.
+type InternalEventOffsetResponse struct {
+	Consumer string `json:"consumer"`
+	Offset   int64  `json:"offset"`
+}
+
+// NewInternalEventResponse converts an outbox event for the internal event API.
+func NewInternalEventResponse(event entities.DomainEvent) InternalEventResponse {
+	payload := event.Payload
+	if payload == nil {
+		payload = map[string]any{}
+	}
+	return InternalEventResponse{
+		Offset:        event.Sequence,
+		ID:            event.ID,
+		Type:          event.Type,
+		SchemaVersion: event.SchemaVersion,
+		Payload:       payload,
+		OccurredAt:    event.OccurredAt,
+	}
+}
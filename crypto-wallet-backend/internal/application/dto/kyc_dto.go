@@ -67,6 +67,8 @@ type KYCDocument struct {
 	FileName    string     `json:"fileName"`
 	MimeType    string     `json:"mimeType"`
 	FileSize    int        `json:"fileSize"`
+	// ScanStatus is the malware scan state: quarantined, scanned, stored, infected, scan_failed or not_scanned.
+	ScanStatus  string     `json:"scanStatus"`
 }
 
 // KYCDocumentUploadResponse summarises a document upload operation.
@@ -130,6 +132,7 @@ func MapKYCDocument(document entities.KYCDocument) KYCDocument {
 		FileName:       document.GetEncryptedFileName(),
 		MimeType:       document.GetMimeType(),
 		FileSize:       document.GetFileSize(),
+		ScanStatus:     string(document.GetScanStatus()),
 	}
 }
 
@@ -154,3 +157,432 @@ func formatDecimal(value decimal.Decimal) string {
 	}
 	return value.StringFixedBank(2)
 }
+
+// KYCUpgrade describes a verification-level upgrade and what is still outstanding.
+type KYCUpgrade struct {
+	ID                    uuid.UUID  `json:"id"`
+	FromLevel             string     `json:"fromLevel"`
+	TargetLevel           string     `json:"targetLevel"`
+	Status                string     `json:"status"`
+	RequiredDocuments     []string   `json:"requiredDocuments"`
+	MissingDocuments      []string   `json:"missingDocuments"`
+	TargetDailyLimitUSD   string     `json:"targetDailyLimitUsd"`
+	TargetMonthlyLimitUSD string     `json:"targetMonthlyLimitUsd"`
+	SubmittedAt           *time.Time `json:"submittedAt,omitempty"`
+	DecidedAt             *time.Time `json:"decidedAt,omitempty"`
+	RejectionReason       string     `json:"rejectionReason,omitempty"`
+	CreatedAt             time.Time  `json:"createdAt"`
+}
+
+// KYCUpgradeResponse pairs an upgrade with the profile it applies to. The profile keeps its
+// current level and limits until the upgrade is approved.
+type KYCUpgradeResponse struct {
+	Profile KYCProfile `json:"profile"`
+	Upgrade KYCUpgrade `json:"upgrade"`
+}
+
+// MapKYCUpgrade converts an upgrade request entity into DTO.
+func MapKYCUpgrade(upgrade entities.KYCUpgradeRequest, missing []entities.DocumentType) KYCUpgrade {
+	if upgrade == nil {
+		return KYCUpgrade{}
+	}
+	daily, monthly := entities.KYCLimitsForLevel(upgrade.GetTargetLevel())
+	return KYCUpgrade{
+		ID:                    upgrade.GetID(),
+		FromLevel:             string(upgrade.GetFromLevel()),
+		TargetLevel:           string(upgrade.GetTargetLevel()),
+		Status:                string(upgrade.GetStatus()),
+		RequiredDocuments:     documentTypeStrings(upgrade.GetRequiredDocuments()),
+		MissingDocuments:      documentTypeStrings(missing),
+		TargetDailyLimitUSD:   formatDecimal(daily),
+		TargetMonthlyLimitUSD: formatDecimal(monthly),
+		SubmittedAt:           upgrade.GetSubmittedAt(),
+		DecidedAt:             upgrade.GetDecidedAt(),
+		RejectionReason:       upgrade.GetRejectionReason(),
+		CreatedAt:             upgrade.GetCreatedAt(),
+	}
+}
+
+func documentTypeStrings(types []entities.DocumentType) []string {
+	values := make([]string, 0, len(types))
+	for _, docType := range types {
+		values = append(values, string(docType))
+	}
+	return values
+}
+
+// KYCLevelLimits describes the limits a user would receive at a verification level.
+type KYCLevelLimits struct {
+	Level           string `json:"level"`
+	DailyLimitUSD   string `json:"dailyLimitUsd"`
+	MonthlyLimitUSD string `json:"monthlyLimitUsd"`
+}
+
+// KYCRequirementsResponse describes what a user must provide to verify and the limits available to them.
+type KYCRequirementsResponse struct {
+	Country           string           `json:"country"`
+	Blocked           bool             `json:"blocked"`
+	RequiredDocuments []string         `json:"requiredDocuments"`
+	MissingDocuments  []string         `json:"missingDocuments"`
+	Limits            []KYCLevelLimits `json:"limits"`
+}
+
+// MapKYCRequirements converts a compliance matrix entry into the user-facing requirements.
+func MapKYCRequirements(requirement entities.KYCCountryRequirement, missing []entities.DocumentType) KYCRequirementsResponse {
+	response := KYCRequirementsResponse{
+		Country:           requirement.CountryCode,
+		Blocked:           requirement.Blocked,
+		RequiredDocuments: documentTypeStrings(requirement.RequiredDocuments),
+		MissingDocuments:  documentTypeStrings(missing),
+		Limits:            []KYCLevelLimits{},
+	}
+	if requirement.Blocked {
+		return response
+	}
+	for _, level := range []entities.VerificationLevel{entities.VerificationLevelBasic, entities.VerificationLevelFull} {
+		daily, monthly := requirement.CapLimits(entities.KYCLimitsForLevel(level))
+		response.Limits = append(response.Limits, KYCLevelLimits{
+			Level:           string(level),
+			DailyLimitUSD:   formatDecimal(daily),
+			MonthlyLimitUSD: formatDecimal(monthly),
+		})
+	}
+	return response
+}
+
+// KYCCountryRequirement is the admin view of a compliance matrix entry.
+type KYCCountryRequirement struct {
+	CountryCode        string     `json:"countryCode"`
+	RequiredDocuments  []string   `json:"requiredDocuments"`
+	MaxDailyLimitUSD   string     `json:"maxDailyLimitUsd"`
+	MaxMonthlyLimitUSD string     `json:"maxMonthlyLimitUsd"`
+	Blocked            bool       `json:"blocked"`
+	Notes              string     `json:"notes,omitempty"`
+	UpdatedBy          *uuid.UUID `json:"updatedBy,omitempty"`
+	UpdatedAt          time.Time  `json:"updatedAt"`
+}
+
+// MapKYCCountryRequirement converts a compliance matrix entry into DTO.
+func MapKYCCountryRequirement(requirement entities.KYCCountryRequirement) KYCCountryRequirement {
+	return KYCCountryRequirement{
+		CountryCode:        requirement.CountryCode,
+		RequiredDocuments:  documentTypeStrings(requirement.RequiredDocuments),
+		MaxDailyLimitUSD:   formatDecimal(requirement.MaxDailyLimitUSD),
+		MaxMonthlyLimitUSD: formatDecimal(requirement.MaxMonthlyLimitUSD),
+		Blocked:            requirement.Blocked,
+		Notes:              requirement.Notes,
+		UpdatedBy:          requirement.UpdatedBy,
+		UpdatedAt:          requirement.UpdatedAt,
+	}
+}
+
+// KYCCountryRequirementRequest replaces a compliance matrix entry. Zero limits mean no cap.
+type KYCCountryRequirementRequest struct {
+	RequiredDocuments  []string        `json:"requiredDocuments"`
+	MaxDailyLimitUSD   decimal.Decimal `json:"maxDailyLimitUsd"`
+	MaxMonthlyLimitUSD decimal.Decimal `json:"maxMonthlyLimitUsd"`
+	Blocked            bool            `json:"blocked"`
+	Notes              string          `json:"notes"`
+}
+
+// Validate enforces request invariants.
+func (r KYCCountryRequirementRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	allowed := []string{
+		string(entities.DocumentTypePassport),
+		string(entities.DocumentTypeNationalID),
+		string(entities.DocumentTypeDriversLicense),
+		string(entities.DocumentTypeProofOfAddress),
+		string(entities.DocumentTypeSelfie),
+	}
+	for _, docType := range r.RequiredDocuments {
+		utils.RequireInSet(&errs, "requiredDocuments", docType, allowed)
+	}
+	utils.RequireNonNegativeDecimal(&errs, "maxDailyLimitUsd", r.MaxDailyLimitUSD)
+	utils.RequireNonNegativeDecimal(&errs, "maxMonthlyLimitUsd", r.MaxMonthlyLimitUSD)
+	utils.RequireMaxLength(&errs, "notes", r.Notes, 2000)
+	return errs
+}
+
+// KYCDocumentType describes how a document type must be captured, for building upload forms.
+type KYCDocumentType struct {
+	DocumentType               string   `json:"documentType"`
+	Label                      string   `json:"label"`
+	AcceptedMimeTypes          []string `json:"acceptedMimeTypes"`
+	MaxSizeBytes               int64    `json:"maxSizeBytes"`
+	DoubleSided                bool     `json:"doubleSided"`
+	RequiresSelfieWithDocument bool     `json:"requiresSelfieWithDocument"`
+	Sides                      []string `json:"sides"`
+}
+
+// MapKYCDocumentType converts a document type spec into DTO using the label for the locale.
+func MapKYCDocumentType(spec entities.KYCDocumentTypeSpec, locale string) KYCDocumentType {
+	sides := make([]string, 0, 3)
+	for _, side := range spec.Sides() {
+		sides = append(sides, string(side))
+	}
+	return KYCDocumentType{
+		DocumentType:               string(spec.DocumentType),
+		Label:                      spec.Label(locale),
+		AcceptedMimeTypes:          spec.AcceptedMimeTypes,
+		MaxSizeBytes:               spec.MaxSizeBytes,
+		DoubleSided:                spec.DoubleSided,
+		RequiresSelfieWithDocument: spec.RequiresSelfieWithDocument,
+		Sides:                      sides,
+	}
+}
+
+// PartnerKYCStatusRequest lists the partner's own user references to look up.
+type PartnerKYCStatusRequest struct {
+	UserRefs []string `json:"userRefs"`
+}
+
+// Validate enforces request invariants.
+func (r PartnerKYCStatusRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	if len(r.UserRefs) == 0 {
+		errs.Add("userRefs", "is required")
+	}
+	if len(r.UserRefs) > entities.MaxPartnerKYCBatchSize {
+		errs.Add("userRefs", "must not contain more than 500 references")
+	}
+	for _, ref := range r.UserRefs {
+		trimmed := strings.TrimSpace(ref)
+		if trimmed == "" || len(trimmed) > 128 {
+			errs.Add("userRefs", "must contain only non-empty references of at most 128 characters")
+			break
+		}
+	}
+	return errs
+}
+
+// PartnerKYCStatus reports one user's verification state to a partner. Only Found is set for
+// references the partner has not linked.
+type PartnerKYCStatus struct {
+	UserRef           string     `json:"userRef"`
+	Found             bool       `json:"found"`
+	Status            string     `json:"status,omitempty"`
+	VerificationLevel string     `json:"verificationLevel,omitempty"`
+	DailyLimitUSD     string     `json:"dailyLimitUsd,omitempty"`
+	MonthlyLimitUSD   string     `json:"monthlyLimitUsd,omitempty"`
+	ExpiresAt         *time.Time `json:"expiresAt,omitempty"`
+}
+
+// PartnerKYCStatusResponse returns statuses in request order.
+type PartnerKYCStatusResponse struct {
+	Items []PartnerKYCStatus `json:"items"`
+}
+
+// MapPartnerKYCStatus converts a linked user's profile into DTO. Users without a profile are
+// reported as not started at the unverified level.
+func MapPartnerKYCStatus(ref string, profile entities.KYCProfile) PartnerKYCStatus {
+	if profile == nil {
+		daily, monthly := entities.KYCLimitsForLevel(entities.VerificationLevelUnverified)
+		return PartnerKYCStatus{
+			UserRef:           ref,
+			Found:             true,
+			Status:            string(entities.KYCStatusNotStarted),
+			VerificationLevel: string(entities.VerificationLevelUnverified),
+			DailyLimitUSD:     formatDecimal(daily),
+			MonthlyLimitUSD:   formatDecimal(monthly),
+		}
+	}
+	return PartnerKYCStatus{
+		UserRef:           ref,
+		Found:             true,
+		Status:            string(profile.GetStatus()),
+		VerificationLevel: string(profile.GetVerificationLevel()),
+		DailyLimitUSD:     formatDecimal(profile.GetDailyLimitUSD()),
+		MonthlyLimitUSD:   formatDecimal(profile.GetMonthlyLimitUSD()),
+		ExpiresAt:         profile.GetExpiresAt(),
+	}
+}
+
+// KYCLimitSnapshot is the compliance view of a user's verification level, limits and risk level
+// from EffectiveAt until the next snapshot.
+type KYCLimitSnapshot struct {
+	UserID            uuid.UUID `json:"userId"`
+	VerificationLevel string    `json:"verificationLevel"`
+	Status            string    `json:"status"`
+	DailyLimitUSD     string    `json:"dailyLimitUsd"`
+	MonthlyLimitUSD   string    `json:"monthlyLimitUsd"`
+	RiskLevel         string    `json:"riskLevel,omitempty"`
+	RiskScore         *int      `json:"riskScore,omitempty"`
+	Source            string    `json:"source"`
+	EffectiveAt       time.Time `json:"effectiveAt"`
+	RecordedAt        time.Time `json:"recordedAt"`
+}
+
+// KYCLimitSnapshotAtResponse answers a point-in-time limits query.
+type KYCLimitSnapshotAtResponse struct {
+	At       time.Time        `json:"at"`
+	Snapshot KYCLimitSnapshot `json:"snapshot"`
+}
+
+// MapKYCLimitSnapshot converts a limit history entry into DTO.
+func MapKYCLimitSnapshot(snapshot entities.KYCLimitSnapshot) KYCLimitSnapshot {
+	result := KYCLimitSnapshot{
+		UserID:            snapshot.UserID,
+		VerificationLevel: string(snapshot.VerificationLevel),
+		Status:            string(snapshot.Status),
+		DailyLimitUSD:     formatDecimal(snapshot.DailyLimitUSD),
+		MonthlyLimitUSD:   formatDecimal(snapshot.MonthlyLimitUSD),
+		RiskScore:         snapshot.RiskScore,
+		Source:            string(snapshot.Source),
+		EffectiveAt:       snapshot.EffectiveAt,
+		RecordedAt:        snapshot.RecordedAt,
+	}
+	if snapshot.RiskLevel != nil {
+		result.RiskLevel = string(*snapshot.RiskLevel)
+	}
+	return result
+}
+
+// KYCReviewProfile is a profile in the compliance review queue.
+type KYCReviewProfile struct {
+	UserID  uuid.UUID  `json:"userId"`
+	Profile KYCProfile `json:"profile"`
+}
+
+// KYCReviewPII is the decrypted identity on a profile, shown to compliance reviewers only.
+type KYCReviewPII struct {
+	FirstName      string      `json:"firstName,omitempty"`
+	LastName       string      `json:"lastName,omitempty"`
+	DateOfBirth    string      `json:"dateOfBirth,omitempty"`
+	Nationality    string      `json:"nationality,omitempty"`
+	DocumentNumber string      `json:"documentNumber,omitempty"`
+	Address        *KYCAddress `json:"address,omitempty"`
+}
+
+// KYCReviewDetail is everything a reviewer needs to decide a profile.
+type KYCReviewDetail struct {
+	UserID    uuid.UUID     `json:"userId"`
+	Profile   KYCProfile    `json:"profile"`
+	PII       KYCReviewPII  `json:"pii"`
+	Documents []KYCDocument `json:"documents"`
+	RiskScore *RiskScore    `json:"riskScore,omitempty"`
+}
+
+// KYCDocumentDownloadLink is a short-lived link to a decrypted verification document. URL is
+// empty when no download page is configured; the token can then be sent to the download API.
+type KYCDocumentDownloadLink struct {
+	URL       string    `json:"url,omitempty"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// ComplianceReview is an item in the manual compliance review queue, such as an AML screening hit.
+type ComplianceReview struct {
+	ID         uuid.UUID      `json:"id"`
+	UserID     uuid.UUID      `json:"userId"`
+	Source     string         `json:"source"`
+	Reason     string         `json:"reason"`
+	RiskLevel  string         `json:"riskLevel"`
+	Details    map[string]any `json:"details,omitempty"`
+	Status     string         `json:"status"`
+	ResolvedBy *uuid.UUID     `json:"resolvedBy,omitempty"`
+	Resolution string         `json:"resolution,omitempty"`
+	CreatedAt  time.Time      `json:"createdAt"`
+	ResolvedAt *time.Time     `json:"resolvedAt,omitempty"`
+}
+
+// MapComplianceReview converts a review queue item into its DTO.
+func MapComplianceReview(item entities.ComplianceReviewItem) ComplianceReview {
+	return ComplianceReview{
+		ID:         item.ID,
+		UserID:     item.UserID,
+		Source:     string(item.Source),
+		Reason:     item.Reason,
+		RiskLevel:  string(item.RiskLevel),
+		Details:    item.Details,
+		Status:     string(item.Status),
+		ResolvedBy: item.ResolvedBy,
+		Resolution: item.Resolution,
+		CreatedAt:  item.CreatedAt,
+		ResolvedAt: item.ResolvedAt,
+	}
+}
+
+// AMLUserRisk is a user's AML screening state with their open review items.
+type AMLUserRisk struct {
+	UserID      uuid.UUID          `json:"userId"`
+	RiskScore   *RiskScore         `json:"riskScore,omitempty"`
+	OpenReviews []ComplianceReview `json:"openReviews"`
+}
+
+// ComplianceReviewResolveRequest closes a review item with the reviewer's conclusion.
+type ComplianceReviewResolveRequest struct {
+	Resolution string `json:"resolution"`
+}
+
+// Validate enforces request invariants.
+func (r ComplianceReviewResolveRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	utils.Require(&errs, "resolution", r.Resolution)
+	utils.RequireMaxLength(&errs, "resolution", r.Resolution, 2000)
+	return errs
+}
+
+// KYCApproveRequest approves a profile at a verification level (basic when empty).
+type KYCApproveRequest struct {
+	Level         string `json:"level"`
+	ReviewerNotes string `json:"reviewerNotes"`
+}
+
+// Validate enforces request invariants.
+func (r KYCApproveRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	if strings.TrimSpace(r.Level) != "" {
+		utils.RequireInSet(&errs, "level", r.Level, []string{string(entities.VerificationLevelBasic), string(entities.VerificationLevelFull)})
+	}
+	utils.RequireMaxLength(&errs, "reviewerNotes", r.ReviewerNotes, 2000)
+	return errs
+}
+
+// KYCRejectRequest rejects a profile; the reason is shown to the user, the notes are internal.
+type KYCRejectRequest struct {
+	Reason        string `json:"reason"`
+	ReviewerNotes string `json:"reviewerNotes"`
+}
+
+// Validate enforces request invariants.
+func (r KYCRejectRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	utils.Require(&errs, "reason", r.Reason)
+	utils.RequireMaxLength(&errs, "reason", r.Reason, 500)
+	utils.RequireMaxLength(&errs, "reviewerNotes", r.ReviewerNotes, 2000)
+	return errs
+}
+
+// KYCLimitUpgradeRequest raises an approved profile to a higher verification level.
+type KYCLimitUpgradeRequest struct {
+	Level         string `json:"level"`
+	ReviewerNotes string `json:"reviewerNotes"`
+}
+
+// Validate enforces request invariants.
+func (r KYCLimitUpgradeRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	utils.RequireInSet(&errs, "level", r.Level, []string{string(entities.VerificationLevelBasic), string(entities.VerificationLevelFull)})
+	utils.RequireMaxLength(&errs, "reviewerNotes", r.ReviewerNotes, 2000)
+	return errs
+}
+
+// KYCLimitWindow is the usage of one rolling limit window, valued in USD at current rates.
+type KYCLimitWindow struct {
+	LimitUSD     string    `json:"limitUsd"`
+	UsedUSD      string    `json:"usedUsd"`
+	RemainingUSD string    `json:"remainingUsd"`
+	Since        time.Time `json:"since"`
+}
+
+// KYCLimitHeadroomResponse reports how much a user can still send or exchange before reaching
+// their KYC limits. Mode is "enforce" when operations over a limit are rejected and "flag" when
+// they are allowed but reviewed.
+type KYCLimitHeadroomResponse struct {
+	VerificationLevel string         `json:"verificationLevel"`
+	Mode              string         `json:"mode"`
+	Daily             KYCLimitWindow `json:"daily"`
+	Monthly           KYCLimitWindow `json:"monthly"`
+	CalculatedAt      time.Time      `json:"calculatedAt"`
+}
@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// NotificationResponse describes an in-app notification.
+type NotificationResponse struct {
+	ID        uuid.UUID      `json:"id"`
+	Type      string         `json:"type"`
+	Event     string         `json:"event"`
+	Title     string         `json:"title"`
+	Body      string         `json:"body"`
+	Data      map[string]any `json:"data,omitempty"`
+	Read      bool           `json:"read"`
+	ReadAt    *time.Time     `json:"readAt,omitempty"`
+	CreatedAt time.Time      `json:"createdAt"`
+}
+
+// NewNotificationResponse maps a notification to an API response.
+func NewNotificationResponse(notification entities.Notification) NotificationResponse {
+	return NotificationResponse{
+		ID:        notification.ID,
+		Type:      string(notification.Type),
+		Event:     notification.Event,
+		Title:     notification.Title,
+		Body:      notification.Body,
+		Data:      notification.Data,
+		Read:      notification.IsRead(),
+		ReadAt:    notification.ReadAt,
+		CreatedAt: notification.CreatedAt,
+	}
+}
+
+// NotificationList groups a page of notifications with the user's unread count.
+type NotificationList struct {
+	Notifications []NotificationResponse `json:"notifications"`
+	UnreadCount   int                    `json:"unreadCount"`
+	Limit         int                    `json:"limit"`
+	Offset        int                    `json:"offset"`
+}
+
+// NotificationReadResponse returns a notification after it was marked read.
+type NotificationReadResponse struct {
+	Notification NotificationResponse `json:"notification"`
+	UnreadCount  int                  `json:"unreadCount"`
+}
@@ -0,0 +1,138 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// OpenP2PDisputeRequest captures the payload for disputing a transfer.
+type OpenP2PDisputeRequest struct {
+	Reason      string `json:"reason"`
+	Description string `json:"description"`
+}
+
+// Validate enforces request invariants.
+func (r OpenP2PDisputeRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	utils.Require(&errs, "reason", r.Reason)
+	utils.RequireInSet(&errs, "reason", r.Reason, []string{
+		string(entities.P2PDisputeReasonUnauthorized),
+		string(entities.P2PDisputeReasonWrongRecipient),
+		string(entities.P2PDisputeReasonWrongAmount),
+		string(entities.P2PDisputeReasonNotDelivered),
+		string(entities.P2PDisputeReasonOther),
+	})
+	utils.RequireMaxLength(&errs, "description", r.Description, 2000)
+	return errs
+}
+
+// HoldP2PDisputeRequest captures the support note recorded when funds are held.
+type HoldP2PDisputeRequest struct {
+	Note string `json:"note"`
+}
+
+// Validate enforces request invariants.
+func (r HoldP2PDisputeRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	utils.RequireMaxLength(&errs, "note", r.Note, 2000)
+	return errs
+}
+
+// ResolveP2PDisputeRequest captures the documented outcome of a dispute.
+type ResolveP2PDisputeRequest struct {
+	Outcome string `json:"outcome"`
+	Note    string `json:"note"`
+}
+
+// Validate enforces request invariants.
+func (r ResolveP2PDisputeRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	utils.Require(&errs, "outcome", r.Outcome)
+	utils.RequireInSet(&errs, "outcome", r.Outcome, []string{
+		string(entities.P2PDisputeOutcomeRefundSender),
+		string(entities.P2PDisputeOutcomeNoAction),
+	})
+	utils.Require(&errs, "note", r.Note)
+	utils.RequireMaxLength(&errs, "note", r.Note, 2000)
+	return errs
+}
+
+// P2PDisputeResponse describes a dispute.
+type P2PDisputeResponse struct {
+	ID              uuid.UUID  `json:"id"`
+	TransferID      uuid.UUID  `json:"transferId"`
+	OpenedBy        uuid.UUID  `json:"openedBy"`
+	SenderUserID    uuid.UUID  `json:"senderUserId"`
+	RecipientUserID uuid.UUID  `json:"recipientUserId"`
+	Reason          string     `json:"reason"`
+	Description     string     `json:"description,omitempty"`
+	Status          string     `json:"status"`
+	Outcome         string     `json:"outcome,omitempty"`
+	ResolutionNote  string     `json:"resolutionNote,omitempty"`
+	HeldAt          *time.Time `json:"heldAt,omitempty"`
+	ResolvedAt      *time.Time `json:"resolvedAt,omitempty"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
+}
+
+// NewP2PDisputeResponse maps a domain dispute to an API response.
+func NewP2PDisputeResponse(dispute entities.P2PDispute) P2PDisputeResponse {
+	return P2PDisputeResponse{
+		ID:              dispute.GetID(),
+		TransferID:      dispute.GetTransferID(),
+		OpenedBy:        dispute.GetOpenedBy(),
+		SenderUserID:    dispute.GetSenderUserID(),
+		RecipientUserID: dispute.GetRecipientUserID(),
+		Reason:          string(dispute.GetReason()),
+		Description:     dispute.GetDescription(),
+		Status:          string(dispute.GetStatus()),
+		Outcome:         string(dispute.GetOutcome()),
+		ResolutionNote:  dispute.GetResolutionNote(),
+		HeldAt:          dispute.GetHeldAt(),
+		ResolvedAt:      dispute.GetResolvedAt(),
+		CreatedAt:       dispute.GetCreatedAt(),
+		UpdatedAt:       dispute.GetUpdatedAt(),
+	}
+}
+
+// P2PDisputeEventResponse describes one entry of a dispute's audit trail.
+type P2PDisputeEventResponse struct {
+	ActorID   uuid.UUID `json:"actorId"`
+	Action    string    `json:"action"`
+	Note      string    `json:"note,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// P2PDisputeDetail combines a dispute with its audit trail.
+type P2PDisputeDetail struct {
+	P2PDisputeResponse
+	Events []P2PDisputeEventResponse `json:"events"`
+}
+
+// NewP2PDisputeDetail maps a dispute and its events to an API response.
+func NewP2PDisputeDetail(dispute entities.P2PDispute, events []entities.P2PDisputeEvent) P2PDisputeDetail {
+	items := make([]P2PDisputeEventResponse, 0, len(events))
+	for _, event := range events {
+		items = append(items, P2PDisputeEventResponse{
+			ActorID:   event.ActorID,
+			Action:    string(event.Action),
+			Note:      event.Note,
+			CreatedAt: event.CreatedAt,
+		})
+	}
+	return P2PDisputeDetail{
+		P2PDisputeResponse: NewP2PDisputeResponse(dispute),
+		Events:             items,
+	}
+}
+
+// P2PDisputeList groups disputes with paging metadata.
+type P2PDisputeList struct {
+	Disputes []P2PDisputeResponse `json:"disputes"`
+	Limit    int                  `json:"limit"`
+	Offset   int                  `json:"offset"`
+}
@@ -0,0 +1,118 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// P2P transfer directions relative to the requesting user.
+const (
+	P2PDirectionSent     = "sent"
+	P2PDirectionReceived = "received"
+)
+
+// SendP2PTransferRequest captures the payload for sending funds to another platform user.
+type SendP2PTransferRequest struct {
+	WalletID  string `json:"walletId"`
+	Recipient string `json:"recipient"`
+	Amount    string `json:"amount"`
+	Note      string `json:"note,omitempty"`
+}
+
+// Validate enforces request invariants.
+func (r SendP2PTransferRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	utils.RequireUUID(&errs, "walletId", r.WalletID)
+	utils.Require(&errs, "recipient", r.Recipient)
+	utils.RequireMaxLength(&errs, "recipient", r.Recipient, 255)
+	utils.Require(&errs, "amount", r.Amount)
+	utils.RequireMaxLength(&errs, "note", r.Note, 280)
+
+	if strings.TrimSpace(r.Amount) != "" {
+		if amount, err := decimal.NewFromString(strings.TrimSpace(r.Amount)); err != nil {
+			errs.Add("amount", "must be a valid decimal string")
+		} else {
+			utils.RequirePositiveDecimal(&errs, "amount", amount)
+		}
+	}
+
+	return errs
+}
+
+// ClaimP2PTransferRequest captures the payload for claiming held funds.
+type ClaimP2PTransferRequest struct {
+	ClaimCode string `json:"claimCode"`
+	WalletID  string `json:"walletId"`
+}
+
+// Validate enforces request invariants.
+func (r ClaimP2PTransferRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	utils.Require(&errs, "claimCode", r.ClaimCode)
+	utils.RequireUUID(&errs, "walletId", r.WalletID)
+	return errs
+}
+
+// P2PTransferResponse describes a transfer from the point of view of the requesting user.
+type P2PTransferResponse struct {
+	ID                uuid.UUID  `json:"id"`
+	Direction         string     `json:"direction"`
+	Status            string     `json:"status"`
+	Chain             string     `json:"chain"`
+	Amount            string     `json:"amount"`
+	Note              string     `json:"note,omitempty"`
+	SenderWalletID    *uuid.UUID `json:"senderWalletId,omitempty"`
+	RecipientEmail    string     `json:"recipientEmail,omitempty"`
+	RecipientHandle   string     `json:"recipientHandle,omitempty"`
+	RecipientWalletID *uuid.UUID `json:"recipientWalletId,omitempty"`
+	ExpiresAt         *time.Time `json:"expiresAt,omitempty"`
+	SettledAt         *time.Time `json:"settledAt,omitempty"`
+	CreatedAt         time.Time  `json:"createdAt"`
+}
+
+// NewP2PTransferResponse maps a domain transfer to an API response for the given viewer.
+// Wallet identifiers of the counterparty are not disclosed, nor is the email of a recipient paid by handle.
+func NewP2PTransferResponse(transfer entities.P2PTransfer, viewerID uuid.UUID) P2PTransferResponse {
+	response := P2PTransferResponse{
+		ID:        transfer.GetID(),
+		Direction: P2PDirectionReceived,
+		Status:    string(transfer.GetStatus()),
+		Chain:     string(transfer.GetChain()),
+		Amount:    transfer.GetAmount().String(),
+		Note:      transfer.GetNote(),
+		SettledAt: transfer.GetSettledAt(),
+		CreatedAt: transfer.GetCreatedAt(),
+	}
+
+	if transfer.GetStatus() == entities.P2PTransferStatusPendingClaim {
+		response.ExpiresAt = transfer.GetExpiresAt()
+	}
+
+	if transfer.GetSenderUserID() == viewerID {
+		senderWalletID := transfer.GetSenderWalletID()
+		response.Direction = P2PDirectionSent
+		response.SenderWalletID = &senderWalletID
+		if handle := transfer.GetRecipientHandle(); handle != "" {
+			response.RecipientHandle = handle
+		} else {
+			response.RecipientEmail = transfer.GetRecipientEmail()
+		}
+		return response
+	}
+
+	response.RecipientWalletID = transfer.GetRecipientWalletID()
+	return response
+}
+
+// P2PTransferList groups transfers with paging metadata.
+type P2PTransferList struct {
+	Transfers []P2PTransferResponse `json:"transfers"`
+	Limit     int                   `json:"limit"`
+	Offset    int                   `json:"offset"`
+}
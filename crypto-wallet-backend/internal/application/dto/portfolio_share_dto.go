@@ -0,0 +1,118 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// Portfolio share statuses.
+const (
+	PortfolioShareActive  = "active"
+	PortfolioShareExpired = "expired"
+	PortfolioShareRevoked = "revoked"
+)
+
+// CreatePortfolioShareRequest creates a read-only portfolio link. Scope is summary or
+// full_history; ExpiresAt defaults to seven days from now and may be at most 90 days away.
+type CreatePortfolioShareRequest struct {
+	Label     string     `json:"label,omitempty"`
+	Scope     string     `json:"scope"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// Validate enforces request invariants that do not depend on the current time.
+func (r CreatePortfolioShareRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	utils.Require(&errs, "scope", r.Scope)
+	if strings.TrimSpace(r.Scope) != "" {
+		utils.RequireInSet(&errs, "scope", strings.ToLower(strings.TrimSpace(r.Scope)), []string{
+			string(entities.PortfolioShareScopeSummary),
+			string(entities.PortfolioShareScopeFullHistory),
+		})
+	}
+	utils.RequireMaxLength(&errs, "label", strings.TrimSpace(r.Label), 100)
+	return errs
+}
+
+// PortfolioShareResponse describes a share link to its owner. The token is never included.
+type PortfolioShareResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	Label          string     `json:"label,omitempty"`
+	Scope          string     `json:"scope"`
+	Status         string     `json:"status"`
+	CreatedAt      time.Time  `json:"created_at"`
+	ExpiresAt      time.Time  `json:"expires_at"`
+	RevokedAt      *time.Time `json:"revoked_at,omitempty"`
+	LastAccessedAt *time.Time `json:"last_accessed_at,omitempty"`
+}
+
+// NewPortfolioShareResponse maps a share to its API representation as of the given time.
+func NewPortfolioShareResponse(share entities.PortfolioShare, at time.Time) PortfolioShareResponse {
+	status := PortfolioShareActive
+	switch {
+	case share.RevokedAt != nil:
+		status = PortfolioShareRevoked
+	case !share.IsActive(at):
+		status = PortfolioShareExpired
+	}
+	return PortfolioShareResponse{
+		ID:             share.ID,
+		Label:          share.Label,
+		Scope:          string(share.Scope),
+		Status:         status,
+		CreatedAt:      share.CreatedAt.UTC(),
+		ExpiresAt:      share.ExpiresAt.UTC(),
+		RevokedAt:      share.RevokedAt,
+		LastAccessedAt: share.LastAccessedAt,
+	}
+}
+
+// CreatedPortfolioShare is returned once, when a link is created. The token cannot be retrieved
+// again; URL is set when a share page is configured.
+type CreatedPortfolioShare struct {
+	PortfolioShareResponse
+	Token string `json:"token"`
+	URL   string `json:"url,omitempty"`
+}
+
+// PortfolioShareAccessResponse is one entry of a share's access log.
+type PortfolioShareAccessResponse struct {
+	Resource   string    `json:"resource"`
+	IPAddress  string    `json:"ip_address,omitempty"`
+	UserAgent  string    `json:"user_agent,omitempty"`
+	AccessedAt time.Time `json:"accessed_at"`
+}
+
+// SharedPortfolio is what a share link reveals about the portfolio: allocation and totals, without
+// wallet addresses or anything identifying the owner.
+type SharedPortfolio struct {
+	Label     string           `json:"label,omitempty"`
+	Scope     string           `json:"scope"`
+	ExpiresAt time.Time        `json:"expires_at"`
+	Summary   PortfolioSummary `json:"summary"`
+}
+
+// SharedTransaction is a transaction as shown through a full_history share link. Addresses,
+// hashes, memos and metadata are left out.
+type SharedTransaction struct {
+	Chain       string     `json:"chain"`
+	Type        string     `json:"type"`
+	Amount      string     `json:"amount"`
+	Fee         string     `json:"fee"`
+	Status      string     `json:"status"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ConfirmedAt *time.Time `json:"confirmed_at,omitempty"`
+}
+
+// SharedTransactionList is a page of transactions shown through a share link.
+type SharedTransactionList struct {
+	Transactions []SharedTransaction `json:"transactions"`
+	Total        int64               `json:"total"`
+	Limit        int                 `json:"limit"`
+	Offset       int                 `json:"offset"`
+}
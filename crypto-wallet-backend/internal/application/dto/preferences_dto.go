@@ -0,0 +1,118 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// UpdatePreferencesRequest patches the user's preferences. Nil fields keep their current value;
+// an empty string clears the setting. Notifications toggles only the types it lists.
+type UpdatePreferencesRequest struct {
+	Notifications        map[string]bool `json:"notifications,omitempty"`
+	DefaultChain         *string         `json:"default_chain,omitempty" validate:"omitempty,oneof=BTC ETH SOL XLM"`
+	HideSmallBalancesUSD *string         `json:"hide_small_balances_usd,omitempty" validate:"omitempty,numeric"`
+	Language             *string         `json:"language,omitempty" validate:"omitempty,max=16"`
+	Timezone             *string         `json:"timezone,omitempty" validate:"omitempty,max=64"`
+}
+
+// SetDefaults trims the provided fields and upper-cases the chain.
+func (r *UpdatePreferencesRequest) SetDefaults() {
+	for _, value := range []*string{r.DefaultChain, r.HideSmallBalancesUSD, r.Language, r.Timezone} {
+		if value != nil {
+			*value = strings.TrimSpace(*value)
+		}
+	}
+	if r.DefaultChain != nil {
+		*r.DefaultChain = strings.ToUpper(*r.DefaultChain)
+	}
+}
+
+// Validate enforces request invariants.
+func (r UpdatePreferencesRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidateStruct(r)
+	for key := range r.Notifications {
+		if !entities.NotificationType(key).IsValid() {
+			errs.Add("notifications", "unknown notification type "+key)
+		}
+	}
+	if r.HideSmallBalancesUSD != nil && *r.HideSmallBalancesUSD != "" {
+		if threshold, err := decimal.NewFromString(*r.HideSmallBalancesUSD); err == nil && threshold.IsNegative() {
+			errs.Add("hide_small_balances_usd", "must not be negative")
+		}
+	}
+	if r.Language != nil && *r.Language != "" && !entities.IsValidLanguageTag(*r.Language) {
+		errs.Add("language", "must be a language tag such as en or th-TH")
+	}
+	if r.Timezone != nil && *r.Timezone != "" {
+		if _, err := time.LoadLocation(*r.Timezone); err != nil {
+			errs.Add("timezone", "must be an IANA timezone name")
+		}
+	}
+	return errs
+}
+
+// Apply merges the patch into the stored preferences. Call it only after Validate succeeds.
+func (r UpdatePreferencesRequest) Apply(preferences entities.UserPreferences) entities.UserPreferences {
+	if len(r.Notifications) > 0 {
+		notifications := make(map[entities.NotificationType]bool, len(preferences.Notifications)+len(r.Notifications))
+		for notificationType, enabled := range preferences.Notifications {
+			notifications[notificationType] = enabled
+		}
+		for key, enabled := range r.Notifications {
+			notifications[entities.NotificationType(key)] = enabled
+		}
+		preferences.Notifications = notifications
+	}
+	if r.DefaultChain != nil {
+		preferences.DefaultChain = entities.Chain(*r.DefaultChain)
+	}
+	if r.HideSmallBalancesUSD != nil {
+		preferences.HideSmallBalancesUSD = decimal.Zero
+		if *r.HideSmallBalancesUSD != "" {
+			preferences.HideSmallBalancesUSD = decimal.RequireFromString(*r.HideSmallBalancesUSD)
+		}
+	}
+	if r.Language != nil {
+		preferences.Language = *r.Language
+	}
+	if r.Timezone != nil {
+		preferences.Timezone = *r.Timezone
+	}
+	return preferences
+}
+
+// PreferencesResponse describes the user's preferences with every notification type listed.
+type PreferencesResponse struct {
+	Notifications        map[string]bool `json:"notifications"`
+	DefaultChain         string          `json:"default_chain,omitempty"`
+	HideSmallBalancesUSD string          `json:"hide_small_balances_usd"`
+	Language             string          `json:"language,omitempty"`
+	Timezone             string          `json:"timezone,omitempty"`
+	UpdatedAt            *time.Time      `json:"updated_at,omitempty"`
+}
+
+// NewPreferencesResponse maps preferences to their API representation.
+func NewPreferencesResponse(preferences entities.UserPreferences) PreferencesResponse {
+	notifications := make(map[string]bool)
+	for _, notificationType := range entities.NotificationTypes() {
+		notifications[string(notificationType)] = preferences.NotificationEnabled(notificationType)
+	}
+
+	response := PreferencesResponse{
+		Notifications:        notifications,
+		DefaultChain:         string(preferences.DefaultChain),
+		HideSmallBalancesUSD: preferences.HideSmallBalancesUSD.String(),
+		Language:             preferences.Language,
+		Timezone:             preferences.Timezone,
+	}
+	if !preferences.UpdatedAt.IsZero() {
+		updatedAt := preferences.UpdatedAt
+		response.UpdatedAt = &updatedAt
+	}
+	return response
+}
@@ -0,0 +1,116 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// PaymentURIScheme prefixes the payment URI clients encode into profile QR codes.
+const PaymentURIScheme = "atlaswallet://pay/@"
+
+// ClaimHandleRequest captures the payload for claiming or changing a handle.
+type ClaimHandleRequest struct {
+	Handle string `json:"handle"`
+}
+
+// Validate enforces request invariants.
+func (r ClaimHandleRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	utils.Require(&errs, "handle", r.Handle)
+	if r.Handle != "" {
+		if err := entities.ValidateHandle(entities.NormalizeHandle(r.Handle)); err != nil {
+			errs.Add("handle", err.Error())
+		}
+	}
+	return errs
+}
+
+// UpdatePaymentProfileRequest captures editable profile fields; nil flags keep their current value.
+type UpdatePaymentProfileRequest struct {
+	DisplayName         string   `json:"displayName"`
+	AcceptedAssets      []string `json:"acceptedAssets"`
+	Public              *bool    `json:"public,omitempty"`
+	AllowHandlePayments *bool    `json:"allowHandlePayments,omitempty"`
+}
+
+// Validate enforces request invariants.
+func (r UpdatePaymentProfileRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	utils.RequireMaxLength(&errs, "displayName", r.DisplayName, 60)
+
+	allowed := make([]string, 0)
+	for _, chain := range entities.SupportedChains() {
+		allowed = append(allowed, string(chain))
+	}
+	for _, asset := range r.AcceptedAssets {
+		utils.RequireInSet(&errs, "acceptedAssets", strings.ToUpper(strings.TrimSpace(asset)), allowed)
+	}
+
+	return errs
+}
+
+// PaymentProfileResponse describes the requesting user's own profile, including privacy settings.
+type PaymentProfileResponse struct {
+	Handle                  string    `json:"handle"`
+	DisplayName             string    `json:"displayName,omitempty"`
+	AcceptedAssets          []string  `json:"acceptedAssets"`
+	Public                  bool      `json:"public"`
+	AllowHandlePayments     bool      `json:"allowHandlePayments"`
+	PaymentURI              string    `json:"paymentUri"`
+	HandleChangeAvailableAt time.Time `json:"handleChangeAvailableAt"`
+	CreatedAt               time.Time `json:"createdAt"`
+	UpdatedAt               time.Time `json:"updatedAt"`
+}
+
+// NewPaymentProfileResponse maps a domain profile to the owner's view.
+func NewPaymentProfileResponse(profile entities.PaymentProfile) PaymentProfileResponse {
+	return PaymentProfileResponse{
+		Handle:                  profile.GetHandle(),
+		DisplayName:             profile.GetDisplayName(),
+		AcceptedAssets:          chainStrings(profile.GetAcceptedAssets()),
+		Public:                  profile.IsPublic(),
+		AllowHandlePayments:     profile.AllowsHandlePayments(),
+		PaymentURI:              PaymentURIScheme + profile.GetHandle(),
+		HandleChangeAvailableAt: profile.GetHandleChangedAt().Add(entities.HandleChangeCooldown),
+		CreatedAt:               profile.GetCreatedAt(),
+		UpdatedAt:               profile.GetUpdatedAt(),
+	}
+}
+
+// PublicPaymentProfileResponse is the view of a profile shown to other users.
+type PublicPaymentProfileResponse struct {
+	Handle                string   `json:"handle"`
+	DisplayName           string   `json:"displayName,omitempty"`
+	AcceptedAssets        []string `json:"acceptedAssets"`
+	AcceptsHandlePayments bool     `json:"acceptsHandlePayments"`
+	PaymentURI            string   `json:"paymentUri"`
+}
+
+// NewPublicPaymentProfileResponse maps a domain profile to its public view.
+func NewPublicPaymentProfileResponse(profile entities.PaymentProfile) PublicPaymentProfileResponse {
+	return PublicPaymentProfileResponse{
+		Handle:                profile.GetHandle(),
+		DisplayName:           profile.GetDisplayName(),
+		AcceptedAssets:        chainStrings(profile.GetAcceptedAssets()),
+		AcceptsHandlePayments: profile.AllowsHandlePayments(),
+		PaymentURI:            PaymentURIScheme + profile.GetHandle(),
+	}
+}
+
+// HandleAvailabilityResponse reports whether a handle can be claimed.
+type HandleAvailabilityResponse struct {
+	Handle    string `json:"handle"`
+	Available bool   `json:"available"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+func chainStrings(chains []entities.Chain) []string {
+	values := make([]string, 0, len(chains))
+	for _, chain := range chains {
+		values = append(values, string(chain))
+	}
+	return values
+}
@@ -1,6 +1,8 @@
 package dto
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,13 +15,25 @@ type ExchangeRate struct {
 	PriceChange24h string    `json:"price_change_24h"`
 	Volume24h      string    `json:"volume_24h,omitempty"`
 	MarketCap      string    `json:"market_cap,omitempty"`
+	Source         string    `json:"source"`
 	LastUpdated    time.Time `json:"last_updated"`
+	Stale          bool      `json:"stale"`
 }
 
 // ExchangeRateList groups a collection of exchange rates.
 type ExchangeRateList struct {
-	Rates       []ExchangeRate `json:"rates"`
-	LastUpdated time.Time      `json:"last_updated"`
+	Rates            []ExchangeRate `json:"rates"`
+	LastUpdated      time.Time      `json:"last_updated"`
+	FreshnessWarning string         `json:"freshness_warning,omitempty"`
+}
+
+// RateFreshnessWarning builds the client-facing warning emitted when any constituent rate is stale.
+// It returns an empty string when no symbols are stale.
+func RateFreshnessWarning(staleSymbols []string) string {
+	if len(staleSymbols) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("prices for %s may be outdated", strings.Join(staleSymbols, ", "))
 }
 
 // GetRatesRequest models the request for fetching exchange rates.
@@ -55,3 +69,49 @@ type GetPriceHistoryRequest struct {
 	Limit    int        `json:"limit,omitempty" form:"limit"`
 	Offset   int        `json:"offset,omitempty" form:"offset"`
 }
+
+// Candle represents a single OHLCV bucket in a chart series.
+type Candle struct {
+	Timestamp time.Time `json:"timestamp"`
+	Open      string    `json:"open"`
+	High      string    `json:"high"`
+	Low       string    `json:"low"`
+	Close     string    `json:"close"`
+	Volume    string    `json:"volume"`
+	Filled    bool      `json:"filled,omitempty"`
+}
+
+// CandleSeries groups candles for a symbol over a time range.
+type CandleSeries struct {
+	Symbol            string    `json:"symbol"`
+	Interval          string    `json:"interval"`
+	RequestedInterval string    `json:"requested_interval"`
+	From              time.Time `json:"from"`
+	To                time.Time `json:"to"`
+	Candles           []Candle  `json:"candles"`
+}
+
+// RateSymbol describes a symbol available for chart queries.
+type RateSymbol struct {
+	Symbol    string   `json:"symbol"`
+	Intervals []string `json:"intervals"`
+}
+
+// RateSymbolCatalog lists symbols and intervals available for chart queries.
+type RateSymbolCatalog struct {
+	Symbols    []RateSymbol `json:"symbols"`
+	MaxCandles int          `json:"max_candles"`
+}
+
+// Sparkline represents a compact close-price series for a symbol.
+type Sparkline struct {
+	Symbol   string   `json:"symbol"`
+	Interval string   `json:"interval"`
+	Points   []string `json:"points"`
+}
+
+// SparklineList groups sparkline series for multiple symbols.
+type SparklineList struct {
+	Sparklines  []Sparkline `json:"sparklines"`
+	GeneratedAt time.Time   `json:"generated_at"`
+}
@@ -0,0 +1,115 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// Report run statuses.
+const (
+	ReportRunCompleted = "completed"
+	ReportRunQueued    = "queued"
+)
+
+// ReportCatalogResponse lists the datasets custom reports can query and what each one exposes.
+type ReportCatalogResponse struct {
+	Datasets      []entities.ReportDatasetSpec `json:"datasets"`
+	FilterOps     []entities.ReportFilterOp    `json:"filterOps"`
+	DefaultLimit  int                          `json:"defaultLimit"`
+	MaxLimit      int                          `json:"maxLimit"`
+	MaxSavedCount int                          `json:"maxSavedReports"`
+}
+
+// NewReportCatalogResponse describes the report catalogue.
+func NewReportCatalogResponse() ReportCatalogResponse {
+	return ReportCatalogResponse{
+		Datasets: entities.ReportDatasetSpecs(),
+		FilterOps: []entities.ReportFilterOp{
+			entities.ReportFilterEq,
+			entities.ReportFilterNeq,
+			entities.ReportFilterIn,
+			entities.ReportFilterGt,
+			entities.ReportFilterGte,
+			entities.ReportFilterLt,
+			entities.ReportFilterLte,
+		},
+		DefaultLimit:  entities.DefaultReportRowLimit,
+		MaxLimit:      entities.MaxReportRows,
+		MaxSavedCount: entities.MaxSavedReportsPerUser,
+	}
+}
+
+// ReportRunOptions controls how a report is executed. Async forces background execution; Format
+// picks the file produced when the report runs in the background: csv (default) or json.
+type ReportRunOptions struct {
+	Async  bool   `json:"async,omitempty"`
+	Format string `json:"format,omitempty"`
+}
+
+// Validate enforces option invariants.
+func (o ReportRunOptions) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	if o.Format != "" {
+		utils.RequireInSet(&errs, "format", strings.ToLower(o.Format), []string{"csv", "json"})
+	}
+	return errs
+}
+
+// RunReportRequest runs an ad-hoc report definition.
+type RunReportRequest struct {
+	Definition entities.ReportDefinition `json:"definition"`
+	ReportRunOptions
+}
+
+// SaveReportRequest creates or replaces a saved report.
+type SaveReportRequest struct {
+	Name        string                    `json:"name"`
+	Description string                    `json:"description,omitempty"`
+	Definition  entities.ReportDefinition `json:"definition"`
+}
+
+// Validate enforces request invariants other than the definition's, which the catalogue checks.
+func (r SaveReportRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	utils.Require(&errs, "name", r.Name)
+	utils.RequireMaxLength(&errs, "name", strings.TrimSpace(r.Name), 100)
+	utils.RequireMaxLength(&errs, "description", strings.TrimSpace(r.Description), 500)
+	return errs
+}
+
+// SavedReportResponse is a user's saved report definition.
+type SavedReportResponse struct {
+	ID          uuid.UUID                 `json:"id"`
+	Name        string                    `json:"name"`
+	Description string                    `json:"description,omitempty"`
+	Definition  entities.ReportDefinition `json:"definition"`
+	CreatedAt   time.Time                 `json:"createdAt"`
+	UpdatedAt   time.Time                 `json:"updatedAt"`
+}
+
+// NewSavedReportResponse maps a saved report to an API response.
+func NewSavedReportResponse(report entities.SavedReport) SavedReportResponse {
+	return SavedReportResponse{
+		ID:          report.ID,
+		Name:        report.Name,
+		Description: report.Description,
+		Definition:  report.Definition,
+		CreatedAt:   report.CreatedAt.UTC(),
+		UpdatedAt:   report.UpdatedAt.UTC(),
+	}
+}
+
+// ReportRunResponse is the outcome of running a report. Completed runs carry the Result; reports
+// too heavy to answer inline are queued as an export Job whose file holds the result.
+type ReportRunResponse struct {
+	Status     string                    `json:"status"`
+	Definition entities.ReportDefinition `json:"definition"`
+	Result     *entities.ReportResult    `json:"result,omitempty"`
+	Job        *ExportJobResponse        `json:"job,omitempty"`
+	Reason     string                    `json:"reason,omitempty"`
+}
@@ -18,8 +18,44 @@ type SendTransactionRequest struct {
     ToAddress  string            `json:"toAddress"`
     Amount     string            `json:"amount"`
     Fee        string            `json:"fee,omitempty"`
+    // Speed picks the gas oracle fee on EVM chains when Fee is empty; it defaults to the user's
+    // preferred speed.
+    Speed      string            `json:"speed,omitempty"`
     Memo       string            `json:"memo,omitempty"`
     Metadata   map[string]any    `json:"metadata,omitempty"`
+    // TravelRule carries originator and beneficiary information. It is required for sends at or
+    // above the travel-rule threshold and ignored below it.
+    TravelRule *TravelRuleInfo   `json:"travelRule,omitempty"`
+}
+
+// TravelRuleParty identifies the originator or beneficiary of a send for travel-rule reporting.
+type TravelRuleParty struct {
+    Name        string `json:"name"`
+    Address     string `json:"address,omitempty"`
+    DateOfBirth string `json:"dateOfBirth,omitempty"`
+    NationalID  string `json:"nationalId,omitempty"`
+}
+
+// TravelRuleInfo is the travel-rule data for a send. BeneficiaryVASP identifies the VASP that
+// hosts the destination address; it is empty for unhosted wallets.
+type TravelRuleInfo struct {
+    Originator      TravelRuleParty `json:"originator"`
+    Beneficiary     TravelRuleParty `json:"beneficiary"`
+    BeneficiaryVASP string          `json:"beneficiaryVasp,omitempty"`
+}
+
+func (t TravelRuleInfo) validate(errs *utils.ValidationErrors) {
+    t.Originator.validate(errs, "travelRule.originator")
+    t.Beneficiary.validate(errs, "travelRule.beneficiary")
+    utils.RequireMaxLength(errs, "travelRule.beneficiaryVasp", t.BeneficiaryVASP, 200)
+}
+
+func (p TravelRuleParty) validate(errs *utils.ValidationErrors, field string) {
+    utils.Require(errs, field+".name", p.Name)
+    utils.RequireMaxLength(errs, field+".name", p.Name, 200)
+    utils.RequireMaxLength(errs, field+".address", p.Address, 500)
+    utils.RequireMaxLength(errs, field+".dateOfBirth", p.DateOfBirth, 10)
+    utils.RequireMaxLength(errs, field+".nationalId", p.NationalID, 100)
 }
 
 // Validate enforces request invariants.
@@ -40,6 +76,14 @@ func (r SendTransactionRequest) Validate() utils.ValidationErrors {
             errs.Add("fee", "cannot be negative")
         }
     }
+    if strings.TrimSpace(r.Speed) != "" {
+        if _, ok := entities.ParseGasSpeed(r.Speed); !ok {
+            errs.Add("speed", "must be one of slow, normal, fast")
+        }
+    }
+    if r.TravelRule != nil {
+        r.TravelRule.validate(&errs)
+    }
 
     return errs
 }
@@ -97,10 +141,68 @@ func NewTransactionStatusResponse(tx entities.Transaction) TransactionStatusResp
     }
 }
 
+// DefaultTransactionSearchLimit is the page size of a search that does not set one.
+const DefaultTransactionSearchLimit = 50
+
+// SearchTransactionsRequest captures the query parameters of a transaction search. Every given
+// criterion must match and at least one of hash, address, minAmount, maxAmount, metadata or q is
+// required. A metadata entry is a key that must be present or key:value that must match exactly;
+// q matches memos containing each of its words.
+type SearchTransactionsRequest struct {
+    WalletID  string   `json:"walletId,omitempty" query:"walletId" validate:"omitempty,uuid"`
+    Chain     string   `json:"chain,omitempty" query:"chain"`
+    Type      string   `json:"type,omitempty" query:"type" validate:"omitempty,oneof=send receive swap_in swap_out p2p_send p2p_receive"`
+    Status    string   `json:"status,omitempty" query:"status" validate:"omitempty,oneof=pending confirming confirmed failed cancelled"`
+    Hash      string   `json:"hash,omitempty" query:"hash" validate:"omitempty,min=3,max=255"`
+    Address   string   `json:"address,omitempty" query:"address" validate:"omitempty,max=255"`
+    MinAmount string   `json:"minAmount,omitempty" query:"minAmount" validate:"omitempty,gte=0"`
+    MaxAmount string   `json:"maxAmount,omitempty" query:"maxAmount" validate:"omitempty,gte=0"`
+    Metadata  []string `json:"metadata,omitempty" query:"metadata" validate:"max=10,dive,min=1,max=200"`
+    Query     string   `json:"q,omitempty" query:"q" validate:"omitempty,min=3,max=200"`
+    StartDate string   `json:"startDate,omitempty" query:"startDate" validate:"omitempty,rfc3339"`
+    EndDate   string   `json:"endDate,omitempty" query:"endDate" validate:"omitempty,rfc3339"`
+    Limit     int      `json:"limit" query:"limit" validate:"min=1,max=200"`
+    Offset    int      `json:"offset" query:"offset" validate:"gte=0,lte=10000"`
+}
+
+// SetDefaults fills in the page size when it was omitted.
+func (r *SearchTransactionsRequest) SetDefaults() {
+    if r.Limit == 0 {
+        r.Limit = DefaultTransactionSearchLimit
+    }
+}
+
+// Validate enforces request invariants.
+func (r SearchTransactionsRequest) Validate() utils.ValidationErrors {
+    errs := utils.ValidateStruct(r)
+
+    if strings.TrimSpace(r.Hash) == "" && strings.TrimSpace(r.Address) == "" && strings.TrimSpace(r.MinAmount) == "" &&
+        strings.TrimSpace(r.MaxAmount) == "" && len(r.Metadata) == 0 && strings.TrimSpace(r.Query) == "" {
+        errs.Add("q", "at least one of hash, address, minAmount, maxAmount, metadata or q is required")
+    }
+    if r.Chain != "" && entities.NormalizeChain(r.Chain) == "" {
+        errs.Add("chain", "is not supported")
+    }
+    if lower, err := decimal.NewFromString(r.MinAmount); err == nil {
+        if upper, err := decimal.NewFromString(r.MaxAmount); err == nil && upper.LessThan(lower) {
+            errs.Add("maxAmount", "must not be less than minAmount")
+        }
+    }
+    if start, err := time.Parse(time.RFC3339, r.StartDate); err == nil {
+        if end, err := time.Parse(time.RFC3339, r.EndDate); err == nil && end.Before(start) {
+            errs.Add("endDate", "must not be before startDate")
+        }
+    }
+
+    return errs
+}
+
 // TransactionListResponse aggregates paginated transactions.
 type TransactionListResponse struct {
     Items      []TransactionStatusResponse `json:"items"`
     Total      int64                       `json:"total"`
     Limit      int                         `json:"limit"`
     Offset     int                         `json:"offset"`
+    // NextCursor continues the listing by keyset; empty on the last page.
+    NextCursor string                      `json:"nextCursor,omitempty"`
 }
@@ -0,0 +1,139 @@
+package dto
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// MaxTransactionNoteChanges bounds how many note changes one sync request may push.
+const MaxTransactionNoteChanges = 200
+
+// TransactionNote is an encrypted note as exchanged with clients. Ciphertext is base64 and is
+// omitted for deleted notes.
+type TransactionNote struct {
+	TransactionID uuid.UUID `json:"transactionId"`
+	Ciphertext    string    `json:"ciphertext,omitempty"`
+	KeyID         string    `json:"keyId,omitempty"`
+	DeviceID      string    `json:"deviceId,omitempty"`
+	Version       int64     `json:"version"`
+	Revision      int64     `json:"revision"`
+	Deleted       bool      `json:"deleted"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+// NewTransactionNote maps a stored note for clients.
+func NewTransactionNote(note entities.TransactionNote) TransactionNote {
+	result := TransactionNote{
+		TransactionID: note.TransactionID,
+		KeyID:         note.KeyID,
+		DeviceID:      note.DeviceID,
+		Version:       note.Version,
+		Revision:      note.Revision,
+		Deleted:       note.Deleted,
+		UpdatedAt:     note.UpdatedAt,
+	}
+	if !note.Deleted {
+		result.Ciphertext = base64.StdEncoding.EncodeToString(note.Ciphertext)
+	}
+	return result
+}
+
+// TransactionNoteChange is one device edit pushed during sync. BaseVersion is the version the edit
+// was made on, or zero for a new note.
+type TransactionNoteChange struct {
+	TransactionID string `json:"transactionId"`
+	BaseVersion   int64  `json:"baseVersion"`
+	Ciphertext    string `json:"ciphertext,omitempty"`
+	KeyID         string `json:"keyId,omitempty"`
+	Deleted       bool   `json:"deleted"`
+}
+
+// SyncTransactionNotesRequest pushes a device's pending edits and pulls every note written after
+// Cursor, the nextCursor of the device's previous sync or zero on first sync.
+type SyncTransactionNotesRequest struct {
+	DeviceID string                  `json:"deviceId,omitempty"`
+	Cursor   int64                   `json:"cursor"`
+	Limit    int                     `json:"limit,omitempty"`
+	Changes  []TransactionNoteChange `json:"changes"`
+}
+
+// Validate enforces request invariants.
+func (r SyncTransactionNotesRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	if r.Cursor < 0 {
+		errs.Add("cursor", "cannot be negative")
+	}
+	if len(r.DeviceID) > 100 {
+		errs.Add("deviceId", "must be at most 100 characters")
+	}
+	if len(r.Changes) > MaxTransactionNoteChanges {
+		errs.Add("changes", fmt.Sprintf("must contain at most %d changes", MaxTransactionNoteChanges))
+	}
+
+	seen := make(map[string]struct{}, len(r.Changes))
+	for i, change := range r.Changes {
+		field := fmt.Sprintf("changes[%d]", i)
+		id, err := uuid.Parse(strings.TrimSpace(change.TransactionID))
+		if err != nil {
+			errs.Add(field+".transactionId", "must be a valid UUID")
+		} else if _, dup := seen[id.String()]; dup {
+			errs.Add(field+".transactionId", "appears more than once")
+		} else {
+			seen[id.String()] = struct{}{}
+		}
+		if change.BaseVersion < 0 {
+			errs.Add(field+".baseVersion", "cannot be negative")
+		}
+		if change.Deleted {
+			if change.BaseVersion == 0 {
+				errs.Add(field+".baseVersion", "is required to delete a note")
+			}
+			continue
+		}
+		if _, err := change.DecodeCiphertext(); err != nil {
+			errs.Add(field+".ciphertext", "must be base64 encoded")
+		}
+		if strings.TrimSpace(change.KeyID) == "" {
+			errs.Add(field+".keyId", "is required")
+		}
+	}
+	return errs
+}
+
+// DecodeCiphertext returns the raw ciphertext.
+func (c TransactionNoteChange) DecodeCiphertext() ([]byte, error) {
+	return base64.StdEncoding.DecodeString(strings.TrimSpace(c.Ciphertext))
+}
+
+// TransactionNoteConflict reports a pushed change that lost to a newer write. Current is the stored
+// note the device must merge with and push again on its version.
+type TransactionNoteConflict struct {
+	TransactionID uuid.UUID        `json:"transactionId"`
+	BaseVersion   int64            `json:"baseVersion"`
+	Current       *TransactionNote `json:"current,omitempty"`
+}
+
+// TransactionNoteRejection reports a pushed change that cannot be applied.
+type TransactionNoteRejection struct {
+	TransactionID string `json:"transactionId"`
+	Code          string `json:"code"`
+	Message       string `json:"message"`
+}
+
+// SyncTransactionNotesResponse reports the outcome of each pushed change and the notes written
+// since the request cursor. Clients keep syncing with NextCursor while HasMore is set.
+type SyncTransactionNotesResponse struct {
+	Applied    []TransactionNote          `json:"applied"`
+	Conflicts  []TransactionNoteConflict  `json:"conflicts"`
+	Rejected   []TransactionNoteRejection `json:"rejected"`
+	Changes    []TransactionNote          `json:"changes"`
+	NextCursor int64                      `json:"nextCursor"`
+	HasMore    bool                       `json:"hasMore"`
+}
@@ -1,9 +1,15 @@
 package dto
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/pkg/utils"
 )
 
 // CreateWalletRequest models the payload for wallet creation.
@@ -49,3 +55,205 @@ type WalletBalance struct {
 	Confirmations int       `json:"confirmations"`
 	LastUpdated   time.Time `json:"last_updated"`
 }
+
+// ChainAvailability tells a user whether they can create wallets on a chain during its soft launch.
+type ChainAvailability struct {
+	Chain     string `json:"chain"`
+	Available bool   `json:"available"`
+	Cohort    string `json:"cohort"`
+	BetaOpen  bool   `json:"beta_open"`
+	OptedIn   bool   `json:"opted_in"`
+}
+
+// ChainRolloutRequest replaces a chain's rollout.
+type ChainRolloutRequest struct {
+	Percentage int      `json:"percentage"`
+	Allowlist  []string `json:"allowlist"`
+	BetaOptIn  bool     `json:"beta_opt_in"`
+}
+
+// Validate enforces request invariants.
+func (r ChainRolloutRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	if r.Percentage < 0 || r.Percentage > 100 {
+		errs.Add("percentage", "must be between 0 and 100")
+	}
+	if len(r.Allowlist) > entities.MaxChainRolloutAllowlist {
+		errs.Add("allowlist", fmt.Sprintf("must contain at most %d users", entities.MaxChainRolloutAllowlist))
+	}
+	for _, id := range r.Allowlist {
+		utils.RequireUUID(&errs, "allowlist", id)
+	}
+	return errs
+}
+
+// ChainRollout is the staff view of a chain's rollout and its cohort metrics since the server started.
+type ChainRollout struct {
+	Chain      string                     `json:"chain"`
+	Percentage int                        `json:"percentage"`
+	Allowlist  []uuid.UUID                `json:"allowlist"`
+	BetaOptIn  bool                       `json:"beta_opt_in"`
+	UpdatedBy  *uuid.UUID                 `json:"updated_by,omitempty"`
+	UpdatedAt  time.Time                  `json:"updated_at"`
+	Cohorts    []ChainRolloutCohortMetric `json:"cohorts"`
+}
+
+// ChainRolloutCohortMetric counts wallet creation decisions and outcomes for one cohort.
+type ChainRolloutCohortMetric struct {
+	Cohort  string `json:"cohort"`
+	Allowed int64  `json:"allowed"`
+	Denied  int64  `json:"denied"`
+	Created int64  `json:"created"`
+	Failed  int64  `json:"failed"`
+}
+
+// ChainConfigRequest registers a chain or updates its registry entry. Omitted fields keep their
+// current value.
+type ChainConfigRequest struct {
+	Name                    *string `json:"name,omitempty"`
+	RPCURL                  *string `json:"rpc_url,omitempty"`
+	RPCURLFallback          *string `json:"rpc_url_fallback,omitempty"`
+	ExplorerURL             *string `json:"explorer_url,omitempty"`
+	NativeTokenSymbol       *string `json:"native_token_symbol,omitempty"`
+	NativeTokenDecimals     *int    `json:"native_token_decimals,omitempty"`
+	ConfirmationThreshold   *int    `json:"confirmation_threshold,omitempty"`
+	AverageBlockTimeSeconds *int    `json:"average_block_time_seconds,omitempty"`
+	NetworkType             *string `json:"network_type,omitempty"`
+	IsActive                *bool   `json:"is_active,omitempty"`
+}
+
+// Validate enforces request invariants.
+func (r ChainConfigRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	requireChainURL(&errs, "rpc_url", r.RPCURL)
+	requireChainURL(&errs, "rpc_url_fallback", r.RPCURLFallback)
+	requireChainURL(&errs, "explorer_url", r.ExplorerURL)
+	if r.NativeTokenDecimals != nil && (*r.NativeTokenDecimals < 0 || *r.NativeTokenDecimals > 18) {
+		errs.Add("native_token_decimals", "must be between 0 and 18")
+	}
+	if r.ConfirmationThreshold != nil && *r.ConfirmationThreshold <= 0 {
+		errs.Add("confirmation_threshold", "must be greater than zero")
+	}
+	if r.AverageBlockTimeSeconds != nil && *r.AverageBlockTimeSeconds < 0 {
+		errs.Add("average_block_time_seconds", "must not be negative")
+	}
+	if r.NetworkType != nil {
+		switch entities.ChainNetworkType(strings.ToLower(strings.TrimSpace(*r.NetworkType))) {
+		case entities.ChainNetworkMainnet, entities.ChainNetworkTestnet:
+		default:
+			errs.Add("network_type", "must be mainnet or testnet")
+		}
+	}
+	return errs
+}
+
+func requireChainURL(errs *utils.ValidationErrors, field string, value *string) {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return
+	}
+	if parsed, err := url.Parse(strings.TrimSpace(*value)); err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		errs.Add(field, "must be an absolute URL")
+	}
+}
+
+// ChainConfig is the staff view of a chain's registry entry.
+type ChainConfig struct {
+	Chain                   string    `json:"chain"`
+	Name                    string    `json:"name"`
+	RPCURL                  string    `json:"rpc_url"`
+	RPCURLFallback          string    `json:"rpc_url_fallback,omitempty"`
+	ExplorerURL             string    `json:"explorer_url"`
+	NativeTokenSymbol       string    `json:"native_token_symbol"`
+	NativeTokenDecimals     int       `json:"native_token_decimals"`
+	ConfirmationThreshold   int       `json:"confirmation_threshold"`
+	AverageBlockTimeSeconds int       `json:"average_block_time_seconds"`
+	NetworkType             string    `json:"network_type"`
+	IsActive                bool      `json:"is_active"`
+	Managed                 bool      `json:"managed"`
+	UpdatedAt               time.Time `json:"updated_at"`
+}
+
+// WalletPermissionRequest replaces a member's permissions on a wallet.
+type WalletPermissionRequest struct {
+	Permissions []string `json:"permissions"`
+}
+
+// Validate enforces request invariants.
+func (r WalletPermissionRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	if len(r.Permissions) == 0 {
+		errs.Add("permissions", "is required")
+	}
+	for _, permission := range r.Permissions {
+		if entities.ParseWalletPermission(permission) == "" {
+			errs.Add("permissions", "must contain only view, initiate or approve")
+			break
+		}
+	}
+	return errs
+}
+
+// WalletPermissionGrant describes what a member may do on a shared wallet.
+type WalletPermissionGrant struct {
+	WalletID    uuid.UUID `json:"wallet_id"`
+	UserID      uuid.UUID `json:"user_id"`
+	Permissions []string  `json:"permissions"`
+	GrantedBy   uuid.UUID `json:"granted_by"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// MnemonicRequest carries a BIP39 mnemonic, for importing a seed or confirming its backup.
+type MnemonicRequest struct {
+	Mnemonic string `json:"mnemonic"`
+}
+
+// Validate enforces request invariants; the phrase itself is checked by the wallet service.
+func (r MnemonicRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	if strings.TrimSpace(r.Mnemonic) == "" {
+		errs.Add("mnemonic", "is required")
+	}
+	return errs
+}
+
+// HDWalletStatus describes a user's HD wallet seed without revealing it.
+type HDWalletStatus struct {
+	Source            string     `json:"source"`
+	BackupConfirmed   bool       `json:"backup_confirmed"`
+	BackupConfirmedAt *time.Time `json:"backup_confirmed_at,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+// GeneratedMnemonic is returned once, when a mnemonic is generated. The phrase cannot be retrieved
+// again.
+type GeneratedMnemonic struct {
+	Mnemonic string         `json:"mnemonic"`
+	Status   HDWalletStatus `json:"status"`
+}
+
+// WalletActivity reports the activity that keeps a wallet out of dormancy. DormantAt is when an
+// active wallet turns dormant without further activity; DormantSince is set once it has.
+type WalletActivity struct {
+	WalletID          uuid.UUID  `json:"wallet_id"`
+	Status            string     `json:"status"`
+	LastActivityAt    time.Time  `json:"last_activity_at"`
+	LastTransactionAt *time.Time `json:"last_transaction_at,omitempty"`
+	LastLoginAt       *time.Time `json:"last_login_at,omitempty"`
+	DormantAt         *time.Time `json:"dormant_at,omitempty"`
+	DormantSince      *time.Time `json:"dormant_since,omitempty"`
+}
+
+// ReactivateWalletRequest carries the two-factor code that confirms reactivating a dormant wallet.
+type ReactivateWalletRequest struct {
+	Code string `json:"code"`
+}
+
+// Validate ensures a code was supplied.
+func (r ReactivateWalletRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	if strings.TrimSpace(r.Code) == "" {
+		errs.Add("code", "is required")
+	}
+	return errs
+}
@@ -0,0 +1,100 @@
+package dto
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// WebhookEndpointRequest captures the payload for registering a webhook endpoint.
+type WebhookEndpointRequest struct {
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+// Validate enforces request invariants.
+func (r WebhookEndpointRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	utils.Require(&errs, "url", r.URL)
+	if strings.TrimSpace(r.URL) != "" && !entities.IsValidWebhookURL(r.URL) {
+		errs.Add("url", "must be an absolute https URL")
+	}
+	utils.RequireMaxLength(&errs, "description", r.Description, 255)
+	return errs
+}
+
+// WebhookEndpoint describes a registered endpoint. The secret is never returned after creation.
+type WebhookEndpoint struct {
+	ID               uuid.UUID `json:"id"`
+	URL              string    `json:"url"`
+	Description      string    `json:"description,omitempty"`
+	Active           bool      `json:"active"`
+	SignatureVersion string    `json:"signatureVersion"`
+	CreatedAt        time.Time `json:"createdAt"`
+}
+
+// WebhookEndpointCreatedResponse returns the new endpoint with its signing secret, shown only once.
+type WebhookEndpointCreatedResponse struct {
+	Endpoint WebhookEndpoint `json:"endpoint"`
+	Secret   string          `json:"secret"`
+}
+
+// MapWebhookEndpoint converts an endpoint entity into DTO.
+func MapWebhookEndpoint(endpoint entities.WebhookEndpoint, signatureVersion string) WebhookEndpoint {
+	if endpoint == nil {
+		return WebhookEndpoint{}
+	}
+	return WebhookEndpoint{
+		ID:               endpoint.GetID(),
+		URL:              endpoint.GetURL(),
+		Description:      endpoint.GetDescription(),
+		Active:           endpoint.IsActive(),
+		SignatureVersion: signatureVersion,
+		CreatedAt:        endpoint.GetCreatedAt(),
+	}
+}
+
+// WebhookTestDeliveryResponse reports the outcome of a signed sample delivery.
+type WebhookTestDeliveryResponse struct {
+	DeliveryID      string            `json:"deliveryId"`
+	Event           string            `json:"event"`
+	Delivered       bool              `json:"delivered"`
+	StatusCode      int               `json:"statusCode,omitempty"`
+	ResponseExcerpt string            `json:"responseExcerpt,omitempty"`
+	DurationMs      int64             `json:"durationMs"`
+	Error           string            `json:"error,omitempty"`
+	Headers         map[string]string `json:"headers"`
+	Payload         json.RawMessage   `json:"payload"`
+}
+
+// WebhookVerifyRequest carries a payload and the signature an integrator computed for it.
+type WebhookVerifyRequest struct {
+	Payload   string `json:"payload"`
+	Timestamp string `json:"timestamp"`
+	Signature string `json:"signature"`
+}
+
+// Validate enforces request invariants.
+func (r WebhookVerifyRequest) Validate() utils.ValidationErrors {
+	errs := utils.ValidationErrors{}
+	utils.Require(&errs, "payload", r.Payload)
+	utils.RequirePattern(&errs, "timestamp", r.Timestamp, `^\d+$`, "must be a Unix timestamp in seconds")
+	utils.Require(&errs, "signature", r.Signature)
+	utils.RequireMaxLength(&errs, "payload", r.Payload, 256*1024)
+	return errs
+}
+
+// WebhookVerifyResponse tells an integrator whether their signature matches the server's signer.
+type WebhookVerifyResponse struct {
+	Valid             bool     `json:"valid"`
+	Reason            string   `json:"reason,omitempty"`
+	SignatureVersion  string   `json:"signatureVersion"`
+	ExpectedSignature string   `json:"expectedSignature"`
+	SignedContent     string   `json:"signedContent"`
+	SupportedVersions []string `json:"supportedVersions"`
+}
@@ -0,0 +1,125 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+const walletPageSize = 100
+
+// DeleteAccountUseCase soft-deletes the requesting user's account and schedules it to be purged
+// once the retention window has passed.
+type DeleteAccountUseCase struct {
+	users     UserStore
+	wallets   WalletLookup
+	deletions repositories.AccountDeletionRepository
+	retention time.Duration
+	logger    *slog.Logger
+}
+
+// NewDeleteAccountUseCase constructs the use case. A non-positive retention uses
+// entities.DefaultAccountDeletionRetention.
+func NewDeleteAccountUseCase(users UserStore, wallets WalletLookup, deletions repositories.AccountDeletionRepository, retention time.Duration, logger *slog.Logger) *DeleteAccountUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if retention <= 0 {
+		retention = entities.DefaultAccountDeletionRetention
+	}
+	return &DeleteAccountUseCase{
+		users:     users,
+		wallets:   wallets,
+		deletions: deletions,
+		retention: retention,
+		logger:    logger,
+	}
+}
+
+// Execute deletes the account. Accounts still holding a balance are refused so funds are not
+// stranded; repeating the request returns the existing schedule.
+func (uc *DeleteAccountUseCase) Execute(ctx context.Context, userID uuid.UUID) (dto.AccountDeletionResponse, error) {
+	user, err := uc.users.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return dto.AccountDeletionResponse{}, utils.NewAppError(
+				"USER_NOT_FOUND",
+				"user not found",
+				fiber.StatusNotFound,
+				err,
+				nil,
+			)
+		}
+		return dto.AccountDeletionResponse{}, err
+	}
+
+	if user.GetStatus() != entities.UserStatusDeleted {
+		if err := uc.ensureNoBalance(ctx, userID); err != nil {
+			return dto.AccountDeletionResponse{}, err
+		}
+		if err := uc.users.Delete(ctx, userID); err != nil {
+			uc.logger.Error("failed to soft-delete account",
+				slog.String("user_id", userID.String()),
+				slog.String("error", err.Error()))
+			return dto.AccountDeletionResponse{}, err
+		}
+	}
+
+	now := time.Now().UTC()
+	deletion, err := uc.deletions.Schedule(ctx, entities.AccountDeletion{
+		UserID:      userID,
+		RequestedAt: now,
+		PurgeAfter:  now.Add(uc.retention),
+	})
+	if err != nil {
+		uc.logger.Error("failed to schedule account purge",
+			slog.String("user_id", userID.String()),
+			slog.String("error", err.Error()))
+		return dto.AccountDeletionResponse{}, err
+	}
+
+	uc.logger.Info("account deleted",
+		slog.String("user_id", userID.String()),
+		slog.Time("purge_after", deletion.PurgeAfter))
+
+	return dto.AccountDeletionResponse{
+		Status:      string(entities.UserStatusDeleted),
+		RequestedAt: deletion.RequestedAt,
+		PurgeAfter:  deletion.PurgeAfter,
+	}, nil
+}
+
+func (uc *DeleteAccountUseCase) ensureNoBalance(ctx context.Context, userID uuid.UUID) error {
+	for offset := 0; ; offset += walletPageSize {
+		wallets, err := uc.wallets.ListByUser(ctx, userID, repositories.WalletFilter{}, repositories.ListOptions{
+			Limit:  walletPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return err
+		}
+		for _, wallet := range wallets {
+			if !wallet.GetBalance().IsZero() {
+				return utils.NewAppError(
+					"ACCOUNT_HAS_BALANCE",
+					"withdraw all funds before deleting the account",
+					fiber.StatusConflict,
+					nil,
+					map[string]any{"wallet_id": wallet.GetID(), "chain": wallet.GetChain()},
+				)
+			}
+		}
+		if len(wallets) < walletPageSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const defaultPurgeBatchSize = 50
+
+// PurgeDeletedAccountsResult summarises one purge run.
+type PurgeDeletedAccountsResult struct {
+	Purged int
+	Failed int
+}
+
+// PurgeDeletedAccountsUseCase permanently removes accounts whose retention window has passed.
+// KYC personal data is crypto-shredded by destroying the user's data key, then cleared from the
+// KYC records, before the user and their core data are hard-deleted.
+type PurgeDeletedAccountsUseCase struct {
+	users     UserStore
+	deletions repositories.AccountDeletionRepository
+	keys      KeyShredder
+	kyc       KYCDataPurger
+	batchSize int
+	logger    *slog.Logger
+}
+
+// NewPurgeDeletedAccountsUseCase constructs the use case. keys and kyc may be nil when KYC is not
+// configured.
+func NewPurgeDeletedAccountsUseCase(users UserStore, deletions repositories.AccountDeletionRepository, keys KeyShredder, kyc KYCDataPurger, logger *slog.Logger) *PurgeDeletedAccountsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &PurgeDeletedAccountsUseCase{
+		users:     users,
+		deletions: deletions,
+		keys:      keys,
+		kyc:       kyc,
+		batchSize: defaultPurgeBatchSize,
+		logger:    logger,
+	}
+}
+
+// Execute purges the deletions due at now. A failed account is logged and retried on the next run.
+func (uc *PurgeDeletedAccountsUseCase) Execute(ctx context.Context, now time.Time) (PurgeDeletedAccountsResult, error) {
+	due, err := uc.deletions.ListDue(ctx, now, uc.batchSize)
+	if err != nil {
+		return PurgeDeletedAccountsResult{}, err
+	}
+
+	var result PurgeDeletedAccountsResult
+	for _, deletion := range due {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		if err := uc.purge(ctx, deletion, now); err != nil {
+			result.Failed++
+			uc.logger.Error("failed to purge account",
+				slog.String("user_id", deletion.UserID.String()),
+				slog.String("error", err.Error()))
+			continue
+		}
+		result.Purged++
+		uc.logger.Info("account purged", slog.String("user_id", deletion.UserID.String()))
+	}
+
+	return result, nil
+}
+
+func (uc *PurgeDeletedAccountsUseCase) purge(ctx context.Context, deletion entities.AccountDeletion, now time.Time) error {
+	// A deletion whose user is already gone was interrupted after the core purge and only
+	// needs marking; one whose user is active again was reinstated and is dropped.
+	user, err := uc.users.GetByID(ctx, deletion.UserID)
+	if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+		return err
+	}
+	if err == nil && user.GetStatus() != entities.UserStatusDeleted {
+		uc.logger.Info("dropping deletion of reinstated account", slog.String("user_id", deletion.UserID.String()))
+		return uc.deletions.Cancel(ctx, deletion.UserID)
+	}
+
+	if uc.keys != nil {
+		if err := uc.keys.Shred(ctx, deletion.UserID); err != nil {
+			return err
+		}
+	}
+	if uc.kyc != nil {
+		if err := uc.kyc.PurgePersonalData(ctx, deletion.UserID); err != nil {
+			return err
+		}
+	}
+
+	return uc.deletions.Purge(ctx, deletion.UserID, now)
+}
@@ -0,0 +1,32 @@
+package account
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// UserStore loads and soft-deletes accounts.
+type UserStore interface {
+	GetByID(ctx context.Context, id uuid.UUID) (entities.User, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// WalletLookup lists the wallets a user holds so deletion can refuse while funds remain.
+type WalletLookup interface {
+	ListByUser(ctx context.Context, userID uuid.UUID, filter repositories.WalletFilter, opts repositories.ListOptions) ([]entities.Wallet, error)
+}
+
+// KeyShredder destroys a user's personal data key, leaving ciphertexts under it unreadable.
+type KeyShredder interface {
+	Shred(ctx context.Context, userID uuid.UUID) error
+}
+
+// KYCDataPurger clears personal data from a user's KYC records while keeping their status
+// history for compliance reporting.
+type KYCDataPurger interface {
+	PurgePersonalData(ctx context.Context, userID uuid.UUID) error
+}
@@ -0,0 +1,136 @@
+package activity
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+const (
+	defaultActivityLimit = 20
+	maxActivityLimit     = 100
+)
+
+var errActivityRepositoryRequired = errors.New("recent activity: activity repository not configured")
+
+// GetRecentActivityInput selects a page of the user's activity feed. Kinds and WalletID narrow the
+// feed; Cursor continues from a previous page's nextCursor.
+type GetRecentActivityInput struct {
+	UserID   uuid.UUID
+	Kinds    []string
+	WalletID string
+	Limit    int
+	Cursor   string
+}
+
+// GetRecentActivityUseCase returns the user's transactions and exchanges as one newest-first list.
+type GetRecentActivityUseCase struct {
+	activity repositories.ActivityRepository
+	logger   *slog.Logger
+}
+
+// NewGetRecentActivityUseCase constructs the use case.
+func NewGetRecentActivityUseCase(activity repositories.ActivityRepository, logger *slog.Logger) *GetRecentActivityUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GetRecentActivityUseCase{
+		activity: activity,
+		logger:   logger,
+	}
+}
+
+// Execute returns one page of the activity feed.
+func (uc *GetRecentActivityUseCase) Execute(ctx context.Context, input GetRecentActivityInput) (dto.ActivityListResponse, error) {
+	if uc.activity == nil {
+		return dto.ActivityListResponse{}, errActivityRepositoryRequired
+	}
+
+	var errs utils.ValidationErrors
+	if input.UserID == uuid.Nil {
+		errs.Add("userId", "is required")
+	}
+
+	var filter repositories.ActivityFilter
+	for _, raw := range input.Kinds {
+		kind := entities.ActivityKind(strings.ToLower(strings.TrimSpace(raw)))
+		if kind == "" {
+			continue
+		}
+		if !kind.IsValid() {
+			errs.Add("kind", "must be 'transaction' or 'exchange'")
+			break
+		}
+		filter.Kinds = append(filter.Kinds, kind)
+	}
+	if walletID := strings.TrimSpace(input.WalletID); walletID != "" {
+		parsed, err := uuid.Parse(walletID)
+		if err != nil {
+			errs.Add("walletId", "must be a valid UUID")
+		} else {
+			filter.WalletID = &parsed
+		}
+	}
+
+	var after *repositories.PageCursor
+	if strings.TrimSpace(input.Cursor) != "" {
+		cursor, err := repositories.ParsePageCursor(input.Cursor)
+		if err != nil {
+			errs.Add("cursor", "is invalid; pass nextCursor from a previous response unchanged")
+		} else {
+			after = &cursor
+		}
+	}
+
+	if !errs.IsEmpty() {
+		return dto.ActivityListResponse{}, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"invalid activity request",
+			fiber.StatusBadRequest,
+			nil,
+			map[string]any{"errors": errs},
+		)
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultActivityLimit
+	}
+	if limit > maxActivityLimit {
+		limit = maxActivityLimit
+	}
+
+	items, err := uc.activity.ListRecentActivity(ctx, input.UserID, filter, after, limit)
+	if err != nil {
+		uc.logger.Error("failed to list recent activity", slog.String("user_id", input.UserID.String()), slog.String("error", err.Error()))
+		return dto.ActivityListResponse{}, utils.NewAppError(
+			"DATABASE_ERROR",
+			"unable to load recent activity",
+			fiber.StatusInternalServerError,
+			err,
+			nil,
+		)
+	}
+
+	response := dto.ActivityListResponse{
+		Items: make([]dto.ActivityItemResponse, 0, len(items)),
+		Limit: limit,
+	}
+	for _, item := range items {
+		response.Items = append(response.Items, dto.NewActivityItemResponse(item))
+	}
+	if len(items) == limit {
+		last := items[len(items)-1]
+		response.NextCursor = repositories.PageCursor{CreatedAt: last.OccurredAt, ID: last.ID}.Encode()
+	}
+	return response, nil
+}
@@ -0,0 +1,115 @@
+package addressbook
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// AddEntryUseCase saves a recipient address. New addresses only become usable for whitelist-only
+// sends once the cooldown has passed.
+type AddEntryUseCase struct {
+	entries  repositories.AddressBookRepository
+	cooldown time.Duration
+	audit    AuditLogger
+	logger   *slog.Logger
+	now      func() time.Time
+}
+
+// NewAddEntryUseCase constructs the use case. A non-positive cooldown uses entities.DefaultAddressBookCooldown.
+func NewAddEntryUseCase(entries repositories.AddressBookRepository, cooldown time.Duration, auditLogger AuditLogger, logger *slog.Logger) *AddEntryUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &AddEntryUseCase{
+		entries:  entries,
+		cooldown: normalizeCooldown(cooldown),
+		audit:    auditLogger,
+		logger:   logger,
+		now:      time.Now,
+	}
+}
+
+// Execute validates the request and stores the entry.
+func (uc *AddEntryUseCase) Execute(ctx context.Context, userID uuid.UUID, req dto.AddressBookEntryRequest) (dto.AddressBookEntry, error) {
+	if errs := req.Validate(); !errs.IsEmpty() {
+		return dto.AddressBookEntry{}, wrapValidationError(errs)
+	}
+
+	count, err := uc.entries.CountByUser(ctx, userID)
+	if err != nil {
+		return dto.AddressBookEntry{}, err
+	}
+	if count >= entities.MaxAddressBookEntriesPerUser {
+		return dto.AddressBookEntry{}, utils.NewAppError(
+			"ADDRESS_BOOK_LIMIT",
+			"address book entry limit reached",
+			fiber.StatusConflict,
+			nil,
+			map[string]any{"max": entities.MaxAddressBookEntriesPerUser},
+		)
+	}
+
+	now := uc.now().UTC()
+	entry, err := entities.NewAddressBookEntryEntity(entities.AddressBookEntryParams{
+		UserID:    userID,
+		Chain:     entities.NormalizeChain(req.Chain),
+		Address:   req.Address,
+		Label:     req.Label,
+		Memo:      req.Memo,
+		UsableAt:  now.Add(uc.cooldown),
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		return dto.AddressBookEntry{}, utils.NewAppError(
+			"ADDRESS_BOOK_ENTRY_INVALID",
+			"address book entry is invalid",
+			fiber.StatusBadRequest,
+			err,
+			nil,
+		)
+	}
+
+	if err := uc.entries.Create(ctx, entry); err != nil {
+		if errors.Is(err, repositories.ErrDuplicate) {
+			return dto.AddressBookEntry{}, utils.NewAppError(
+				"ADDRESS_BOOK_ENTRY_EXISTS",
+				"address is already in the address book",
+				fiber.StatusConflict,
+				err,
+				nil,
+			)
+		}
+		return dto.AddressBookEntry{}, err
+	}
+
+	recordAudit(ctx, uc.audit, uc.logger, audit.Entry{
+		ActorID:      userID.String(),
+		Action:       AuditActionEntryAdded,
+		ResourceType: "address_book_entry",
+		TargetID:     entry.GetID().String(),
+		Metadata: map[string]any{
+			"chain":     string(entry.GetChain()),
+			"address":   entry.GetAddress(),
+			"usable_at": entry.GetUsableAt(),
+		},
+	})
+
+	uc.logger.Info("address book entry added",
+		slog.String("entry_id", entry.GetID().String()),
+		slog.String("user_id", userID.String()),
+		slog.String("chain", string(entry.GetChain())))
+
+	return dto.MapAddressBookEntry(entry, now), nil
+}
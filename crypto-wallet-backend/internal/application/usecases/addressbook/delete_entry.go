@@ -0,0 +1,53 @@
+package addressbook
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+)
+
+// DeleteEntryUseCase removes one of the user's saved addresses.
+type DeleteEntryUseCase struct {
+	entries repositories.AddressBookRepository
+	audit   AuditLogger
+	logger  *slog.Logger
+}
+
+// NewDeleteEntryUseCase constructs the use case.
+func NewDeleteEntryUseCase(entries repositories.AddressBookRepository, auditLogger AuditLogger, logger *slog.Logger) *DeleteEntryUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &DeleteEntryUseCase{
+		entries: entries,
+		audit:   auditLogger,
+		logger:  logger,
+	}
+}
+
+// Execute deletes the entry.
+func (uc *DeleteEntryUseCase) Execute(ctx context.Context, userID, entryID uuid.UUID) error {
+	entry, err := loadOwnedEntry(ctx, uc.entries, userID, entryID)
+	if err != nil {
+		return err
+	}
+	if err := uc.entries.Delete(ctx, entryID); err != nil {
+		return err
+	}
+
+	recordAudit(ctx, uc.audit, uc.logger, audit.Entry{
+		ActorID:      userID.String(),
+		Action:       AuditActionEntryDeleted,
+		ResourceType: "address_book_entry",
+		TargetID:     entryID.String(),
+		Metadata: map[string]any{
+			"chain":   string(entry.GetChain()),
+			"address": entry.GetAddress(),
+		},
+	})
+	return nil
+}
@@ -0,0 +1,103 @@
+package addressbook
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// ExportEntriesUseCase produces a signed export of the user's address book.
+type ExportEntriesUseCase struct {
+	entries repositories.AddressBookRepository
+	signer  *TransferSigner
+	audit   AuditLogger
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+// NewExportEntriesUseCase constructs the use case.
+func NewExportEntriesUseCase(entries repositories.AddressBookRepository, signer *TransferSigner, auditLogger AuditLogger, logger *slog.Logger) *ExportEntriesUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ExportEntriesUseCase{
+		entries: entries,
+		signer:  signer,
+		audit:   auditLogger,
+		logger:  logger,
+		now:     time.Now,
+	}
+}
+
+// Execute exports the entries, optionally restricted to one chain so the payload fits a QR code.
+// Cooldowns are not exported; the importing side starts its own.
+func (uc *ExportEntriesUseCase) Execute(ctx context.Context, userID uuid.UUID, chain string) (dto.AddressBookExport, error) {
+	if uc.signer == nil {
+		return dto.AddressBookExport{}, errors.New("export address book: transfer signer not configured")
+	}
+
+	var filter *entities.Chain
+	if chain != "" {
+		normalized := entities.NormalizeChain(chain)
+		if normalized == "" {
+			var errs utils.ValidationErrors
+			errs.Add("chain", "must be one of BTC, ETH, SOL, XLM")
+			return dto.AddressBookExport{}, wrapValidationError(errs)
+		}
+		filter = &normalized
+	}
+
+	entries, err := uc.entries.ListByUser(ctx, userID, filter)
+	if err != nil {
+		return dto.AddressBookExport{}, err
+	}
+
+	now := uc.now().UTC().Truncate(time.Second)
+	doc := transferDocument{
+		Version:    TransferVersion,
+		ExportedAt: now.Unix(),
+		Entries:    make([]transferEntry, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		doc.Entries = append(doc.Entries, transferEntry{
+			Chain:   string(entry.GetChain()),
+			Address: entry.GetAddress(),
+			Label:   entry.GetLabel(),
+			Memo:    entry.GetMemo(),
+		})
+	}
+
+	payload, err := uc.signer.encode(userID, doc)
+	if err != nil {
+		return dto.AddressBookExport{}, err
+	}
+
+	recordAudit(ctx, uc.audit, uc.logger, audit.Entry{
+		ActorID:      userID.String(),
+		Action:       AuditActionExported,
+		ResourceType: "address_book",
+		TargetID:     userID.String(),
+		Metadata: map[string]any{
+			"chain":   chain,
+			"entries": len(doc.Entries),
+		},
+	})
+
+	return dto.AddressBookExport{
+		Version:      TransferVersion,
+		Payload:      payload,
+		EntryCount:   len(doc.Entries),
+		Size:         len(payload),
+		QRCompatible: len(payload) <= dto.AddressBookQRMaxBytes,
+		ExportedAt:   now,
+	}, nil
+}
@@ -0,0 +1,276 @@
+package addressbook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// ImportEntriesUseCase restores an address book export into the user's address book.
+type ImportEntriesUseCase struct {
+	entries    repositories.AddressBookRepository
+	signer     *TransferSigner
+	validators map[entities.Chain]AddressValidator
+	cooldown   time.Duration
+	audit      AuditLogger
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+// NewImportEntriesUseCase constructs the use case. Validators check addresses per chain; a
+// non-positive cooldown uses entities.DefaultAddressBookCooldown.
+func NewImportEntriesUseCase(entries repositories.AddressBookRepository, signer *TransferSigner, validators map[entities.Chain]AddressValidator, cooldown time.Duration, auditLogger AuditLogger, logger *slog.Logger) *ImportEntriesUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ImportEntriesUseCase{
+		entries:    entries,
+		signer:     signer,
+		validators: validators,
+		cooldown:   normalizeCooldown(cooldown),
+		audit:      auditLogger,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// importPlan pairs a reported item with the saved entry it touches, if any.
+type importPlan struct {
+	item     dto.AddressBookImportItem
+	memo     string
+	chain    entities.Chain
+	existing *entities.AddressBookEntryEntity
+}
+
+// Execute verifies the payload, plans every entry and, unless the request is a dry run, applies
+// the plan. Invalid entries are reported and left out rather than failing the import; conflicts
+// are resolved with the requested strategy. Nothing is written when the plan fails as a whole.
+func (uc *ImportEntriesUseCase) Execute(ctx context.Context, userID uuid.UUID, req dto.AddressBookImportRequest) (dto.AddressBookImportResult, error) {
+	if errs := req.Validate(); !errs.IsEmpty() {
+		return dto.AddressBookImportResult{}, wrapValidationError(errs)
+	}
+	if uc.signer == nil {
+		return dto.AddressBookImportResult{}, errors.New("import address book: transfer signer not configured")
+	}
+
+	doc, err := uc.signer.decode(userID, req.Payload)
+	if err != nil {
+		return dto.AddressBookImportResult{}, err
+	}
+
+	saved, err := uc.entries.ListByUser(ctx, userID, nil)
+	if err != nil {
+		return dto.AddressBookImportResult{}, err
+	}
+	existing := make(map[string]*entities.AddressBookEntryEntity, len(saved))
+	for _, entry := range saved {
+		entity, ok := entry.(*entities.AddressBookEntryEntity)
+		if !ok {
+			return dto.AddressBookImportResult{}, errors.New("import address book: unexpected entity type")
+		}
+		existing[entities.AddressBookKey(entity.GetChain(), entity.GetAddress())] = entity
+	}
+
+	strategy := req.ConflictStrategy()
+	plans, err := uc.plan(ctx, doc.Entries, existing, strategy)
+	if err != nil {
+		return dto.AddressBookImportResult{}, err
+	}
+
+	added, conflicts := 0, 0
+	for _, plan := range plans {
+		switch plan.item.Outcome {
+		case dto.AddressBookImportAdded:
+			added++
+		case dto.AddressBookImportConflict:
+			conflicts++
+		}
+	}
+	if available := entities.MaxAddressBookEntriesPerUser - len(saved); added > available {
+		return dto.AddressBookImportResult{}, utils.NewAppError(
+			"ADDRESS_BOOK_LIMIT",
+			"address book entry limit reached",
+			fiber.StatusConflict,
+			nil,
+			map[string]any{"max": entities.MaxAddressBookEntriesPerUser, "available": max(available, 0), "requested": added},
+		)
+	}
+	if conflicts > 0 && !req.DryRun {
+		return dto.AddressBookImportResult{}, utils.NewAppError(
+			"ADDRESS_BOOK_IMPORT_CONFLICT",
+			"imported addresses conflict with saved entries",
+			fiber.StatusConflict,
+			nil,
+			map[string]any{"conflicts": conflicts},
+		)
+	}
+
+	if !req.DryRun {
+		if err := uc.apply(ctx, userID, plans); err != nil {
+			return dto.AddressBookImportResult{}, err
+		}
+	}
+
+	result := dto.AddressBookImportResult{
+		DryRun:     req.DryRun,
+		OnConflict: strategy,
+		Items:      make([]dto.AddressBookImportItem, 0, len(plans)),
+	}
+	for _, plan := range plans {
+		switch plan.item.Outcome {
+		case dto.AddressBookImportAdded:
+			result.Added++
+		case dto.AddressBookImportUpdated:
+			result.Updated++
+		case dto.AddressBookImportUnchanged:
+			result.Unchanged++
+		case dto.AddressBookImportSkipped, dto.AddressBookImportConflict:
+			result.Skipped++
+		case dto.AddressBookImportRejected:
+			result.Rejected++
+		}
+		result.Items = append(result.Items, plan.item)
+	}
+
+	if !req.DryRun {
+		recordAudit(ctx, uc.audit, uc.logger, audit.Entry{
+			ActorID:      userID.String(),
+			Action:       AuditActionImported,
+			ResourceType: "address_book",
+			TargetID:     userID.String(),
+			Metadata: map[string]any{
+				"version":     doc.Version,
+				"on_conflict": strategy,
+				"added":       result.Added,
+				"updated":     result.Updated,
+				"rejected":    result.Rejected,
+			},
+		})
+		uc.logger.Info("address book imported",
+			slog.String("user_id", userID.String()),
+			slog.Int("added", result.Added),
+			slog.Int("updated", result.Updated),
+			slog.Int("rejected", result.Rejected))
+	}
+
+	return result, nil
+}
+
+// plan decides the outcome of every imported entry without writing anything.
+func (uc *ImportEntriesUseCase) plan(ctx context.Context, imported []transferEntry, existing map[string]*entities.AddressBookEntryEntity, strategy string) ([]importPlan, error) {
+	plans := make([]importPlan, 0, len(imported))
+	seen := make(map[string]struct{}, len(imported))
+	for _, raw := range imported {
+		plan := importPlan{
+			item: dto.AddressBookImportItem{Chain: raw.Chain, Address: raw.Address, Label: raw.Label},
+			memo: raw.Memo,
+		}
+		request := dto.AddressBookEntryRequest{Chain: raw.Chain, Address: raw.Address, Label: raw.Label, Memo: raw.Memo}
+		if errs := request.Validate(); !errs.IsEmpty() {
+			plan.item.Outcome, plan.item.Reason = dto.AddressBookImportRejected, errs.Error()
+			plans = append(plans, plan)
+			continue
+		}
+
+		plan.chain = entities.NormalizeChain(raw.Chain)
+		plan.item.Chain = string(plan.chain)
+		valid, err := checkChainAddress(ctx, uc.validators, plan.chain, raw.Address)
+		if err != nil {
+			return nil, fmt.Errorf("import address book: validate %s address: %w", plan.chain, err)
+		}
+		if !valid {
+			plan.item.Outcome, plan.item.Reason = dto.AddressBookImportRejected, fmt.Sprintf("not a valid %s address", plan.chain)
+			plans = append(plans, plan)
+			continue
+		}
+
+		key := entities.AddressBookKey(plan.chain, raw.Address)
+		if _, dup := seen[key]; dup {
+			plan.item.Outcome, plan.item.Reason = dto.AddressBookImportRejected, "address appears more than once in the export"
+			plans = append(plans, plan)
+			continue
+		}
+		seen[key] = struct{}{}
+
+		current, ok := existing[key]
+		if !ok {
+			plan.item.Outcome = dto.AddressBookImportAdded
+			plans = append(plans, plan)
+			continue
+		}
+
+		plan.existing = current
+		id := current.GetID()
+		plan.item.EntryID = &id
+		switch {
+		case current.GetLabel() == raw.Label && current.GetMemo() == raw.Memo:
+			plan.item.Outcome = dto.AddressBookImportUnchanged
+		case strategy == dto.AddressBookConflictReplace:
+			plan.item.Outcome = dto.AddressBookImportUpdated
+		case strategy == dto.AddressBookConflictFail:
+			plan.item.Outcome, plan.item.Reason = dto.AddressBookImportConflict, "already saved with a different label or memo"
+		default:
+			plan.item.Outcome, plan.item.Reason = dto.AddressBookImportSkipped, "already saved with a different label or memo"
+		}
+		plans = append(plans, plan)
+	}
+	return plans, nil
+}
+
+// apply writes the planned additions and label updates. New entries start their cooldown now.
+func (uc *ImportEntriesUseCase) apply(ctx context.Context, userID uuid.UUID, plans []importPlan) error {
+	now := uc.now().UTC()
+	for i := range plans {
+		plan := &plans[i]
+		switch plan.item.Outcome {
+		case dto.AddressBookImportAdded:
+			entry, err := entities.NewAddressBookEntryEntity(entities.AddressBookEntryParams{
+				UserID:    userID,
+				Chain:     plan.chain,
+				Address:   plan.item.Address,
+				Label:     plan.item.Label,
+				Memo:      plan.memo,
+				UsableAt:  now.Add(uc.cooldown),
+				CreatedAt: now,
+				UpdatedAt: now,
+			})
+			if err != nil {
+				plan.item.Outcome, plan.item.Reason = dto.AddressBookImportRejected, err.Error()
+				continue
+			}
+			if err := uc.entries.Create(ctx, entry); err != nil {
+				if errors.Is(err, repositories.ErrDuplicate) {
+					plan.item.Outcome, plan.item.Reason = dto.AddressBookImportSkipped, "address was saved during the import"
+					continue
+				}
+				return err
+			}
+			id := entry.GetID()
+			plan.item.EntryID = &id
+		case dto.AddressBookImportUpdated:
+			if err := plan.existing.Rename(plan.item.Label, plan.memo, now); err != nil {
+				plan.item.Outcome, plan.item.Reason = dto.AddressBookImportRejected, err.Error()
+				continue
+			}
+			if err := uc.entries.UpdateLabel(ctx, plan.existing); err != nil {
+				if errors.Is(err, repositories.ErrNotFound) {
+					plan.item.Outcome, plan.item.Reason = dto.AddressBookImportSkipped, "saved entry was deleted during the import"
+					continue
+				}
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package addressbook
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// ListEntriesUseCase lists the user's saved addresses.
+type ListEntriesUseCase struct {
+	entries repositories.AddressBookRepository
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+// NewListEntriesUseCase constructs the use case.
+func NewListEntriesUseCase(entries repositories.AddressBookRepository, logger *slog.Logger) *ListEntriesUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ListEntriesUseCase{
+		entries: entries,
+		logger:  logger,
+		now:     time.Now,
+	}
+}
+
+// Execute loads the entries, optionally restricted to one chain.
+func (uc *ListEntriesUseCase) Execute(ctx context.Context, userID uuid.UUID, chain string) ([]dto.AddressBookEntry, error) {
+	var filter *entities.Chain
+	if chain != "" {
+		normalized := entities.NormalizeChain(chain)
+		if normalized == "" {
+			var errs utils.ValidationErrors
+			errs.Add("chain", "must be one of BTC, ETH, SOL, XLM")
+			return nil, wrapValidationError(errs)
+		}
+		filter = &normalized
+	}
+
+	entries, err := uc.entries.ListByUser(ctx, userID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.now().UTC()
+	items := make([]dto.AddressBookEntry, 0, len(entries))
+	for _, entry := range entries {
+		items = append(items, dto.MapAddressBookEntry(entry, now))
+	}
+	return items, nil
+}
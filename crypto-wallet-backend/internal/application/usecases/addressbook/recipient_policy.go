@@ -0,0 +1,76 @@
+package addressbook
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// RecipientPolicy restricts withdrawals to saved addresses for users who turned whitelist-only
+// sends on. It satisfies the send workflow's recipient check.
+type RecipientPolicy struct {
+	entries repositories.AddressBookRepository
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+// NewRecipientPolicy constructs the policy.
+func NewRecipientPolicy(entries repositories.AddressBookRepository, logger *slog.Logger) *RecipientPolicy {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RecipientPolicy{
+		entries: entries,
+		logger:  logger,
+		now:     time.Now,
+	}
+}
+
+// CheckRecipient returns an error when whitelist-only sends are in force and the address is not
+// saved, or was saved too recently to be used yet.
+func (p *RecipientPolicy) CheckRecipient(ctx context.Context, userID uuid.UUID, chain entities.Chain, address string) error {
+	settings, err := p.entries.GetSettings(ctx, userID)
+	if err != nil {
+		return err
+	}
+	now := p.now().UTC()
+	if !settings.WhitelistEnforced(now) {
+		return nil
+	}
+
+	entry, err := p.entries.FindByAddress(ctx, userID, chain, address)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			p.logger.Info("send blocked by address whitelist",
+				slog.String("user_id", userID.String()),
+				slog.String("chain", string(chain)))
+			return utils.NewAppError(
+				"RECIPIENT_NOT_WHITELISTED",
+				"whitelist-only sends are enabled and the address is not in the address book",
+				fiber.StatusForbidden,
+				nil,
+				nil,
+			)
+		}
+		return err
+	}
+
+	if !entry.IsUsable(now) {
+		return utils.NewAppError(
+			"RECIPIENT_COOLDOWN",
+			"the address was added recently and cannot be used yet",
+			fiber.StatusForbidden,
+			nil,
+			map[string]any{"usableAt": entry.GetUsableAt()},
+		)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package addressbook
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+)
+
+// GetSettingsUseCase reports the user's recipient restrictions.
+type GetSettingsUseCase struct {
+	entries  repositories.AddressBookRepository
+	cooldown time.Duration
+	logger   *slog.Logger
+	now      func() time.Time
+}
+
+// NewGetSettingsUseCase constructs the use case.
+func NewGetSettingsUseCase(entries repositories.AddressBookRepository, cooldown time.Duration, logger *slog.Logger) *GetSettingsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GetSettingsUseCase{
+		entries:  entries,
+		cooldown: normalizeCooldown(cooldown),
+		logger:   logger,
+		now:      time.Now,
+	}
+}
+
+// Execute loads the settings.
+func (uc *GetSettingsUseCase) Execute(ctx context.Context, userID uuid.UUID) (dto.AddressBookSettingsResponse, error) {
+	settings, err := uc.entries.GetSettings(ctx, userID)
+	if err != nil {
+		return dto.AddressBookSettingsResponse{}, err
+	}
+	return dto.MapAddressBookSettings(settings, uc.cooldown, uc.now().UTC()), nil
+}
+
+// UpdateSettingsUseCase switches whitelist-only sends on or off. Switching on applies at once;
+// switching off is scheduled one cooldown ahead, and asking again does not move that time.
+type UpdateSettingsUseCase struct {
+	entries  repositories.AddressBookRepository
+	cooldown time.Duration
+	audit    AuditLogger
+	logger   *slog.Logger
+	now      func() time.Time
+}
+
+// NewUpdateSettingsUseCase constructs the use case. A non-positive cooldown uses entities.DefaultAddressBookCooldown.
+func NewUpdateSettingsUseCase(entries repositories.AddressBookRepository, cooldown time.Duration, auditLogger AuditLogger, logger *slog.Logger) *UpdateSettingsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &UpdateSettingsUseCase{
+		entries:  entries,
+		cooldown: normalizeCooldown(cooldown),
+		audit:    auditLogger,
+		logger:   logger,
+		now:      time.Now,
+	}
+}
+
+// Execute applies the requested setting.
+func (uc *UpdateSettingsUseCase) Execute(ctx context.Context, userID uuid.UUID, req dto.AddressBookSettingsRequest) (dto.AddressBookSettingsResponse, error) {
+	if errs := req.Validate(); !errs.IsEmpty() {
+		return dto.AddressBookSettingsResponse{}, wrapValidationError(errs)
+	}
+
+	settings, err := uc.entries.GetSettings(ctx, userID)
+	if err != nil {
+		return dto.AddressBookSettingsResponse{}, err
+	}
+
+	now := uc.now().UTC()
+	enforced := settings.WhitelistEnforced(now)
+	action := ""
+	switch {
+	case *req.WhitelistOnly:
+		if !enforced || settings.WhitelistOffAt != nil {
+			action = AuditActionWhitelistEnabled
+		}
+		settings.WhitelistOnly = true
+		settings.WhitelistOffAt = nil
+	case !enforced:
+		settings.WhitelistOnly = false
+		settings.WhitelistOffAt = nil
+	case settings.WhitelistOffAt == nil:
+		offAt := now.Add(uc.cooldown)
+		settings.WhitelistOffAt = &offAt
+		action = AuditActionWhitelistDisabled
+	}
+	settings.UserID = userID
+	settings.UpdatedAt = now
+
+	if err := uc.entries.SaveSettings(ctx, settings); err != nil {
+		return dto.AddressBookSettingsResponse{}, err
+	}
+
+	if action != "" {
+		metadata := map[string]any{}
+		if settings.WhitelistOffAt != nil {
+			metadata["effective_at"] = *settings.WhitelistOffAt
+		}
+		recordAudit(ctx, uc.audit, uc.logger, audit.Entry{
+			ActorID:      userID.String(),
+			Action:       action,
+			ResourceType: "address_book_settings",
+			TargetID:     userID.String(),
+			Metadata:     metadata,
+		})
+		uc.logger.Info("address book settings changed",
+			slog.String("user_id", userID.String()),
+			slog.String("action", action))
+	}
+
+	return dto.MapAddressBookSettings(settings, uc.cooldown, now), nil
+}
@@ -0,0 +1,87 @@
+package addressbook
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// Audit actions recorded by address book workflows.
+const (
+	AuditActionEntryAdded        = "address_book.entry_added"
+	AuditActionEntryUpdated      = "address_book.entry_updated"
+	AuditActionEntryDeleted      = "address_book.entry_deleted"
+	AuditActionWhitelistEnabled  = "address_book.whitelist_enabled"
+	AuditActionWhitelistDisabled = "address_book.whitelist_disable_scheduled"
+	AuditActionExported          = "address_book.exported"
+	AuditActionImported          = "address_book.imported"
+)
+
+// AuditLogger captures audit events for compliance.
+type AuditLogger interface {
+	Record(ctx context.Context, entry audit.Entry) error
+}
+
+// normalizeCooldown applies the default cooldown when none is configured.
+func normalizeCooldown(cooldown time.Duration) time.Duration {
+	if cooldown <= 0 {
+		return entities.DefaultAddressBookCooldown
+	}
+	return cooldown
+}
+
+// loadOwnedEntry fetches an entry and hides entries belonging to other users.
+func loadOwnedEntry(ctx context.Context, entries repositories.AddressBookRepository, userID, entryID uuid.UUID) (entities.AddressBookEntry, error) {
+	entry, err := entries.GetByID(ctx, entryID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, entryNotFoundError()
+		}
+		return nil, err
+	}
+	if entry.GetUserID() != userID {
+		return nil, entryNotFoundError()
+	}
+	return entry, nil
+}
+
+func recordAudit(ctx context.Context, auditLogger AuditLogger, logger *slog.Logger, entry audit.Entry) {
+	if auditLogger == nil {
+		return
+	}
+	if err := auditLogger.Record(ctx, entry); err != nil {
+		logger.Warn("failed to record audit entry", slog.String("action", entry.Action), slog.String("error", err.Error()))
+	}
+}
+
+func entryNotFoundError() error {
+	return utils.NewAppError(
+		"ADDRESS_BOOK_ENTRY_NOT_FOUND",
+		"address book entry not found",
+		fiber.StatusNotFound,
+		nil,
+		nil,
+	)
+}
+
+func wrapValidationError(errs utils.ValidationErrors) error {
+	if errs.IsEmpty() {
+		return nil
+	}
+	return utils.NewAppError(
+		"VALIDATION_ERROR",
+		"address book payload invalid",
+		fiber.StatusBadRequest,
+		errs,
+		map[string]any{"errors": errs},
+	)
+}
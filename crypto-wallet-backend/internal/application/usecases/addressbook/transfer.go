@@ -0,0 +1,189 @@
+package addressbook
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/infrastructure/blockchain"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+const (
+	// TransferVersion is the document version written by exports. Imports accept it and every
+	// earlier version, and reject later ones so an old server never half-reads a newer export.
+	TransferVersion = 1
+	// TransferPrefix starts every exported payload so scanners can recognise address book QR codes.
+	TransferPrefix = "cwab"
+	// MaxTransferDecodedBytes bounds the inflated document so a small payload cannot expand into
+	// an arbitrarily large one.
+	MaxTransferDecodedBytes = 256 << 10
+)
+
+// AddressValidator checks an address against the rules of one chain.
+type AddressValidator interface {
+	ValidateAddress(ctx context.Context, address string) (bool, error)
+}
+
+// transferDocument is the signed content of an export. Keys are kept short because the payload
+// often travels as a QR code; unknown keys are ignored so later versions can add fields.
+type transferDocument struct {
+	Version    int             `json:"v"`
+	ExportedAt int64           `json:"t"`
+	Entries    []transferEntry `json:"e"`
+}
+
+type transferEntry struct {
+	Chain   string `json:"c"`
+	Address string `json:"a"`
+	Label   string `json:"l"`
+	Memo    string `json:"m,omitempty"`
+}
+
+// TransferSigner encodes and verifies address book exports. A payload is
+// "cwab.<base64url deflated JSON>.<base64url HMAC-SHA256>". The MAC also covers the owner's user
+// ID, so an export only imports into the account it was taken from and a payload edited on the
+// way, for example with a swapped address, is rejected.
+type TransferSigner struct {
+	secret []byte
+}
+
+// NewTransferSigner constructs a TransferSigner for the supplied secret.
+func NewTransferSigner(secret string) (*TransferSigner, error) {
+	if strings.TrimSpace(secret) == "" {
+		return nil, errors.New("addressbook: transfer signing secret is required")
+	}
+	return &TransferSigner{secret: []byte(secret)}, nil
+}
+
+func (s *TransferSigner) encode(userID uuid.UUID, doc transferDocument) (string, error) {
+	raw, err := json.Marshal(doc)
+	if err != nil {
+		return "", fmt.Errorf("encode address book export: %w", err)
+	}
+
+	var compressed bytes.Buffer
+	writer, err := flate.NewWriter(&compressed, flate.BestCompression)
+	if err != nil {
+		return "", fmt.Errorf("compress address book export: %w", err)
+	}
+	if _, err := writer.Write(raw); err != nil {
+		return "", fmt.Errorf("compress address book export: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return "", fmt.Errorf("compress address book export: %w", err)
+	}
+
+	body := base64.RawURLEncoding.EncodeToString(compressed.Bytes())
+	return TransferPrefix + "." + body + "." + base64.RawURLEncoding.EncodeToString(s.mac(userID, body)), nil
+}
+
+// decode verifies the payload's signature and returns its document. Version checks happen here so
+// callers only ever see documents they understand.
+func (s *TransferSigner) decode(userID uuid.UUID, payload string) (transferDocument, error) {
+	prefix, rest, ok := strings.Cut(strings.TrimSpace(payload), ".")
+	if !ok || prefix != TransferPrefix {
+		return transferDocument{}, transferInvalidError(nil)
+	}
+	body, rawSignature, ok := strings.Cut(rest, ".")
+	if !ok || body == "" {
+		return transferDocument{}, transferInvalidError(nil)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(rawSignature)
+	if err != nil || !hmac.Equal(signature, s.mac(userID, body)) {
+		return transferDocument{}, transferInvalidError(err)
+	}
+
+	compressed, err := base64.RawURLEncoding.DecodeString(body)
+	if err != nil {
+		return transferDocument{}, transferInvalidError(err)
+	}
+	raw, err := io.ReadAll(io.LimitReader(flate.NewReader(bytes.NewReader(compressed)), MaxTransferDecodedBytes+1))
+	if err != nil {
+		return transferDocument{}, transferInvalidError(err)
+	}
+	if len(raw) > MaxTransferDecodedBytes {
+		return transferDocument{}, transferTooLargeError()
+	}
+
+	var doc transferDocument
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return transferDocument{}, transferInvalidError(err)
+	}
+	switch {
+	case doc.Version < 1:
+		return transferDocument{}, transferInvalidError(nil)
+	case doc.Version > TransferVersion:
+		return transferDocument{}, utils.NewAppError(
+			"ADDRESS_BOOK_IMPORT_UNSUPPORTED_VERSION",
+			"address book export was created by a newer version of the app",
+			fiber.StatusUnprocessableEntity,
+			nil,
+			map[string]any{"version": doc.Version, "supported": TransferVersion},
+		)
+	}
+	if len(doc.Entries) > entities.MaxAddressBookEntriesPerUser {
+		return transferDocument{}, transferTooLargeError()
+	}
+	return doc, nil
+}
+
+func (s *TransferSigner) mac(userID uuid.UUID, body string) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	fmt.Fprintf(mac, "%s\n%s\n%s", TransferPrefix, userID, body)
+	return mac.Sum(nil)
+}
+
+// checkChainAddress asks the chain's adapter whether address is valid. Chains without an adapter,
+// or whose adapter cannot validate addresses yet, fall back to entities.IsPlausibleAddress, which
+// the caller has already applied.
+func checkChainAddress(ctx context.Context, validators map[entities.Chain]AddressValidator, chain entities.Chain, address string) (bool, error) {
+	validator, ok := validators[chain]
+	if !ok || validator == nil {
+		return true, nil
+	}
+	valid, err := validator.ValidateAddress(ctx, address)
+	if err != nil {
+		if errors.Is(err, blockchain.ErrNotImplemented) {
+			return true, nil
+		}
+		if errors.Is(err, blockchain.ErrInvalidAddress) {
+			return false, nil
+		}
+		return false, err
+	}
+	return valid, nil
+}
+
+func transferInvalidError(err error) error {
+	return utils.NewAppError(
+		"ADDRESS_BOOK_IMPORT_INVALID",
+		"address book export is malformed, was modified or belongs to another account",
+		fiber.StatusBadRequest,
+		err,
+		nil,
+	)
+}
+
+func transferTooLargeError() error {
+	return utils.NewAppError(
+		"ADDRESS_BOOK_IMPORT_TOO_LARGE",
+		"address book export is too large",
+		fiber.StatusRequestEntityTooLarge,
+		nil,
+		map[string]any{"maxBytes": dto.MaxAddressBookTransferBytes, "maxEntries": entities.MaxAddressBookEntriesPerUser},
+	)
+}
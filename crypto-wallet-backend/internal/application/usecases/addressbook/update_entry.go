@@ -0,0 +1,75 @@
+package addressbook
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+)
+
+// UpdateEntryUseCase renames one of the user's saved addresses.
+type UpdateEntryUseCase struct {
+	entries repositories.AddressBookRepository
+	audit   AuditLogger
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+// NewUpdateEntryUseCase constructs the use case.
+func NewUpdateEntryUseCase(entries repositories.AddressBookRepository, auditLogger AuditLogger, logger *slog.Logger) *UpdateEntryUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &UpdateEntryUseCase{
+		entries: entries,
+		audit:   auditLogger,
+		logger:  logger,
+		now:     time.Now,
+	}
+}
+
+// Execute replaces the entry's label and memo.
+func (uc *UpdateEntryUseCase) Execute(ctx context.Context, userID, entryID uuid.UUID, req dto.UpdateAddressBookEntryRequest) (dto.AddressBookEntry, error) {
+	if errs := req.Validate(); !errs.IsEmpty() {
+		return dto.AddressBookEntry{}, wrapValidationError(errs)
+	}
+
+	current, err := loadOwnedEntry(ctx, uc.entries, userID, entryID)
+	if err != nil {
+		return dto.AddressBookEntry{}, err
+	}
+	entry, ok := current.(*entities.AddressBookEntryEntity)
+	if !ok {
+		return dto.AddressBookEntry{}, errors.New("update address book entry: unexpected entity type")
+	}
+
+	previousLabel := entry.GetLabel()
+	now := uc.now().UTC()
+	if err := entry.Rename(req.Label, req.Memo, now); err != nil {
+		return dto.AddressBookEntry{}, err
+	}
+	if err := uc.entries.UpdateLabel(ctx, entry); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return dto.AddressBookEntry{}, entryNotFoundError()
+		}
+		return dto.AddressBookEntry{}, err
+	}
+
+	recordAudit(ctx, uc.audit, uc.logger, audit.Entry{
+		ActorID:      userID.String(),
+		Action:       AuditActionEntryUpdated,
+		ResourceType: "address_book_entry",
+		TargetID:     entry.GetID().String(),
+		Before:       map[string]any{"label": previousLabel},
+		After:        map[string]any{"label": entry.GetLabel()},
+	})
+
+	return dto.MapAddressBookEntry(entry, now), nil
+}
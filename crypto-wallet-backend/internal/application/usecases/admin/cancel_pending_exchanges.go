@@ -0,0 +1,213 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/domain/services"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// AuditActionPendingExchangesCancelled is recorded when pending quotes are cancelled in bulk.
+const AuditActionPendingExchangesCancelled = "admin.pending_exchanges_cancelled"
+
+// maxCancelBatch bounds how many quotes one bulk cancellation covers; rerun to continue.
+const maxCancelBatch = 1000
+
+// PendingExchangeCanceller lists and cancels pending exchange operations.
+type PendingExchangeCanceller interface {
+	GetTradingPair(ctx context.Context, pairID uuid.UUID) (entities.TradingPair, error)
+	ListPendingExchanges(ctx context.Context, filter repositories.PendingExchangeFilter, limit int) ([]entities.ExchangeOperation, error)
+	CancelPendingExchange(ctx context.Context, operationID uuid.UUID, reason string) (entities.ExchangeOperation, bool, error)
+}
+
+// CancelPendingExchangesInput selects the pending quotes to cancel: a user's, a trading pair's, or a
+// user's on one pair. Quotes are short-lived, so the real run cancels the OperationIDs listed by the
+// dry run rather than recomputing them; the confirmation token binds those IDs to the scope.
+type CancelPendingExchangesInput struct {
+	DestructiveOptions
+	UserID       uuid.UUID
+	PairID       uuid.UUID
+	OperationIDs []string
+	Reason       string
+	Actor        string
+}
+
+// CancelPendingExchangesUseCase cancels pending exchange quotes in bulk, for example after a fraud review.
+type CancelPendingExchangesUseCase struct {
+	exchanges PendingExchangeCanceller
+	tokens    *ConfirmationTokens
+	audit     AuditLogger
+	logger    *slog.Logger
+}
+
+// NewCancelPendingExchangesUseCase constructs the use case. Without tokens only dry runs are allowed.
+func NewCancelPendingExchangesUseCase(exchanges PendingExchangeCanceller, tokens *ConfirmationTokens, auditLogger AuditLogger, logger *slog.Logger) *CancelPendingExchangesUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &CancelPendingExchangesUseCase{
+		exchanges: exchanges,
+		tokens:    tokens,
+		audit:     auditLogger,
+		logger:    logger,
+	}
+}
+
+// Execute lists the pending quotes in scope on a dry run and cancels the confirmed ones on a real
+// run. Quotes that were executed, expired or cancelled in between are reported as already final.
+func (uc *CancelPendingExchangesUseCase) Execute(ctx context.Context, input CancelPendingExchangesInput) (dto.AdminExchangeCancellationResult, error) {
+	reason := strings.TrimSpace(input.Reason)
+
+	var errs utils.ValidationErrors
+	if input.UserID == uuid.Nil && input.PairID == uuid.Nil {
+		errs.Add("scope", "a user or trading pair is required")
+	}
+	if reason == "" {
+		errs.Add("reason", "a reason is required to cancel quotes")
+	}
+	operationIDs, ok := parseOperationIDs(input.OperationIDs)
+	if !ok {
+		errs.Add("operation_ids", "must be valid UUIDs")
+	}
+	if !input.DryRun && len(operationIDs) == 0 {
+		errs.Add("operation_ids", "pass the operation IDs listed by the dry run")
+	}
+	if err := wrapValidationError(errs); err != nil {
+		return dto.AdminExchangeCancellationResult{}, err
+	}
+
+	scope := make(map[string]string, 2)
+	if input.UserID != uuid.Nil {
+		scope["user_id"] = input.UserID.String()
+	}
+	if input.PairID != uuid.Nil {
+		scope["pair_id"] = input.PairID.String()
+	}
+
+	var hasMore bool
+	if input.DryRun {
+		pending, err := uc.listPending(ctx, input.UserID, input.PairID)
+		if err != nil {
+			return dto.AdminExchangeCancellationResult{}, err
+		}
+		hasMore = len(pending) == maxCancelBatch
+		operationIDs = make([]uuid.UUID, 0, len(pending))
+		for _, operation := range pending {
+			operationIDs = append(operationIDs, operation.GetID())
+		}
+	}
+
+	ids := make([]string, 0, len(operationIDs))
+	for _, id := range operationIDs {
+		ids = append(ids, id.String())
+	}
+
+	operation, proceed, err := confirmOperation(uc.tokens, input.DestructiveOptions, operationPlan{
+		Operation:   OperationCancelExchanges,
+		Actor:       input.Actor,
+		Scope:       scope,
+		AffectedIDs: ids,
+	})
+	result := dto.AdminExchangeCancellationResult{AdminOperationResult: operation, HasMore: hasMore}
+	if err != nil || !proceed {
+		return result, err
+	}
+
+	cancelled := make([]string, 0, len(operationIDs))
+	for _, id := range operationIDs {
+		current, ok, err := uc.exchanges.CancelPendingExchange(ctx, id, reason)
+		switch {
+		case err != nil:
+			if result.Failures == nil {
+				result.Failures = make(map[string]string)
+			}
+			result.Failures[id.String()] = err.Error()
+		case ok:
+			cancelled = append(cancelled, id.String())
+		default:
+			if result.AlreadyFinal == nil {
+				result.AlreadyFinal = make(map[string]string)
+			}
+			result.AlreadyFinal[id.String()] = string(current.GetStatus())
+		}
+	}
+	result.AffectedIDs = cancelled
+	result.AffectedCount = len(cancelled)
+
+	if len(cancelled) > 0 && uc.audit != nil {
+		metadata := map[string]any{
+			"operation_ids": cancelled,
+			"reason":        reason,
+		}
+		for key, value := range scope {
+			metadata[key] = value
+		}
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID:  input.Actor,
+			Action:   AuditActionPendingExchangesCancelled,
+			TargetID: scope["user_id"],
+			Metadata: metadata,
+		}); err != nil {
+			uc.logger.Warn("failed to record audit entry", slog.String("action", AuditActionPendingExchangesCancelled), slog.String("error", err.Error()))
+		}
+	}
+
+	uc.logger.Info("pending exchanges cancelled",
+		slog.Any("scope", scope),
+		slog.Int("cancelled", len(cancelled)),
+		slog.Int("already_final", len(result.AlreadyFinal)),
+		slog.String("actor", input.Actor))
+
+	return result, nil
+}
+
+func (uc *CancelPendingExchangesUseCase) listPending(ctx context.Context, userID, pairID uuid.UUID) ([]entities.ExchangeOperation, error) {
+	var filter repositories.PendingExchangeFilter
+	if userID != uuid.Nil {
+		filter.UserID = &userID
+	}
+	if pairID != uuid.Nil {
+		pair, err := uc.exchanges.GetTradingPair(ctx, pairID)
+		if err != nil {
+			if errors.Is(err, services.ErrExchangeInvalidTradingPair) {
+				return nil, notFoundError("trading pair", err)
+			}
+			return nil, err
+		}
+		baseAssetID, quoteAssetID := pair.GetBaseAssetID(), pair.GetQuoteAssetID()
+		if baseAssetID == uuid.Nil || quoteAssetID == uuid.Nil {
+			var errs utils.ValidationErrors
+			errs.Add("pair_id", "trading pair is not linked to registry assets")
+			return nil, wrapValidationError(errs)
+		}
+		filter.BaseAssetID = &baseAssetID
+		filter.QuoteAssetID = &quoteAssetID
+	}
+	return uc.exchanges.ListPendingExchanges(ctx, filter, maxCancelBatch)
+}
+
+func parseOperationIDs(raw []string) ([]uuid.UUID, bool) {
+	ids := make([]uuid.UUID, 0, len(raw))
+	seen := make(map[uuid.UUID]struct{}, len(raw))
+	for _, value := range raw {
+		id, err := uuid.Parse(strings.TrimSpace(value))
+		if err != nil {
+			return nil, false
+		}
+		if _, dup := seen[id]; dup {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	return ids, true
+}
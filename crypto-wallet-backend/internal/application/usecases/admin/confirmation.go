@@ -0,0 +1,183 @@
+package admin
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// Destructive operations guarded by dry runs and confirmation tokens.
+const (
+	OperationFreezeUserWallets = "wallets.freeze_all"
+	OperationPurgeExports      = "exports.purge"
+	OperationRotateWebhookKey  = "webhooks.rotate_key"
+	OperationRotateWalletKey   = "wallets.rotate_key"
+	OperationRotateKYCKey      = "kyc.rotate_key"
+	OperationReconcileBalances = "balances.reconcile"
+	OperationCancelExchanges   = "exchanges.cancel_pending"
+)
+
+// DefaultConfirmationTTL bounds how long a dry run's confirmation token stays usable.
+const DefaultConfirmationTTL = 15 * time.Minute
+
+// DestructiveOptions is embedded in the input of every destructive operation. A dry run computes
+// the affected records and returns a confirmation token without writing anything; the real run
+// recomputes them and only proceeds when the token matches.
+type DestructiveOptions struct {
+	DryRun            bool
+	ConfirmationToken string
+}
+
+// operationPlan is what a confirmation token is bound to: the operation, the operator, the
+// parameters that select the records and the records themselves.
+type operationPlan struct {
+	Operation   string
+	Actor       string
+	Scope       map[string]string
+	AffectedIDs []string
+}
+
+// ConfirmationTokens issues and checks stateless confirmation tokens. A token is
+// "<expiry unix>.<hex HMAC-SHA256>" over the plan, so it is invalidated by a different operator,
+// different parameters or any change to the affected records.
+type ConfirmationTokens struct {
+	secret []byte
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+// NewConfirmationTokens constructs a ConfirmationTokens; ttl defaults to DefaultConfirmationTTL.
+func NewConfirmationTokens(secret string, ttl time.Duration) (*ConfirmationTokens, error) {
+	if strings.TrimSpace(secret) == "" {
+		return nil, errors.New("admin: confirmation secret is required")
+	}
+	if ttl <= 0 {
+		ttl = DefaultConfirmationTTL
+	}
+	return &ConfirmationTokens{
+		secret: []byte(secret),
+		ttl:    ttl,
+		now:    time.Now,
+	}, nil
+}
+
+func (t *ConfirmationTokens) issue(plan operationPlan) (string, time.Time) {
+	expiresAt := t.now().UTC().Add(t.ttl).Truncate(time.Second)
+	return strconv.FormatInt(expiresAt.Unix(), 10) + "." + t.digest(plan, expiresAt.Unix()), expiresAt
+}
+
+func (t *ConfirmationTokens) verify(token string, plan operationPlan) error {
+	rawExpiry, provided, ok := strings.Cut(strings.TrimSpace(token), ".")
+	if !ok {
+		return confirmationInvalidError()
+	}
+	expiry, err := strconv.ParseInt(rawExpiry, 10, 64)
+	if err != nil {
+		return confirmationInvalidError()
+	}
+	if !hmac.Equal([]byte(t.digest(plan, expiry)), []byte(strings.ToLower(provided))) {
+		return confirmationInvalidError()
+	}
+	if t.now().Unix() > expiry {
+		return utils.NewAppError(
+			"CONFIRMATION_EXPIRED",
+			"confirmation token has expired; run the operation as a dry run again",
+			fiber.StatusConflict,
+			nil,
+			nil,
+		)
+	}
+	return nil
+}
+
+func (t *ConfirmationTokens) digest(plan operationPlan, expiry int64) string {
+	ids := append([]string(nil), plan.AffectedIDs...)
+	sort.Strings(ids)
+	keys := make([]string, 0, len(plan.Scope))
+	for key := range plan.Scope {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	mac := hmac.New(sha256.New, t.secret)
+	fmt.Fprintf(mac, "%s\n%s\n%d\n", plan.Operation, plan.Actor, expiry)
+	for _, key := range keys {
+		fmt.Fprintf(mac, "%s=%s\n", key, plan.Scope[key])
+	}
+	for _, id := range ids {
+		fmt.Fprintf(mac, "%s\n", id)
+	}
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// confirmOperation applies the dry-run convention to a computed plan. It returns the result to
+// report and whether the caller should go on to make its changes: dry runs and empty plans stop
+// here, real runs need a token issued for the same plan.
+func confirmOperation(tokens *ConfirmationTokens, opts DestructiveOptions, plan operationPlan) (dto.AdminOperationResult, bool, error) {
+	result := dto.AdminOperationResult{
+		Operation:     plan.Operation,
+		DryRun:        opts.DryRun,
+		Scope:         plan.Scope,
+		AffectedCount: len(plan.AffectedIDs),
+		AffectedIDs:   plan.AffectedIDs,
+	}
+	if result.AffectedIDs == nil {
+		result.AffectedIDs = []string{}
+	}
+
+	if opts.DryRun {
+		if tokens != nil && len(plan.AffectedIDs) > 0 {
+			token, expiresAt := tokens.issue(plan)
+			result.ConfirmationToken = token
+			result.ConfirmationExpiresAt = &expiresAt
+		}
+		return result, false, nil
+	}
+	if len(plan.AffectedIDs) == 0 {
+		return result, false, nil
+	}
+
+	if tokens == nil {
+		return dto.AdminOperationResult{}, false, utils.NewAppError(
+			"CONFIRMATION_UNAVAILABLE",
+			"confirmation tokens are not configured; only dry runs are allowed",
+			fiber.StatusServiceUnavailable,
+			nil,
+			nil,
+		)
+	}
+	if strings.TrimSpace(opts.ConfirmationToken) == "" {
+		return dto.AdminOperationResult{}, false, utils.NewAppError(
+			"CONFIRMATION_REQUIRED",
+			"run the operation as a dry run first and pass its confirmation token",
+			fiber.StatusPreconditionRequired,
+			nil,
+			map[string]any{"affected_count": len(plan.AffectedIDs)},
+		)
+	}
+	if err := tokens.verify(opts.ConfirmationToken, plan); err != nil {
+		return dto.AdminOperationResult{}, false, err
+	}
+	return result, true, nil
+}
+
+func confirmationInvalidError() error {
+	return utils.NewAppError(
+		"CONFIRMATION_INVALID",
+		"confirmation token does not match this operation or the affected records changed; run the dry run again",
+		fiber.StatusConflict,
+		nil,
+		nil,
+	)
+}
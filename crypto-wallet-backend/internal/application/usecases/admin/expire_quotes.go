@@ -0,0 +1,57 @@
+package admin
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/crypto-wallet/backend/internal/domain/services"
+)
+
+// QuoteExpirer expires pending exchange quotes past their deadline.
+type QuoteExpirer interface {
+	ExpirePendingQuotes(ctx context.Context, batchSize int) (services.QuoteExpiryResult, error)
+}
+
+// ExpireQuotesResult lists the operations one expiry run cancelled. HasMore is set when the batch
+// limit was reached and another run is needed.
+type ExpireQuotesResult struct {
+	ExpiredIDs []string
+	Failed     int
+	HasMore    bool
+}
+
+// ExpireQuotesUseCase runs quote expiry on demand, outside the worker schedule.
+type ExpireQuotesUseCase struct {
+	quotes QuoteExpirer
+	logger *slog.Logger
+}
+
+// NewExpireQuotesUseCase constructs the use case.
+func NewExpireQuotesUseCase(quotes QuoteExpirer, logger *slog.Logger) *ExpireQuotesUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ExpireQuotesUseCase{
+		quotes: quotes,
+		logger: logger,
+	}
+}
+
+// Execute expires up to batchSize overdue quotes; a non-positive batchSize uses the service default.
+func (uc *ExpireQuotesUseCase) Execute(ctx context.Context, batchSize int) (ExpireQuotesResult, error) {
+	expired, err := uc.quotes.ExpirePendingQuotes(ctx, batchSize)
+	if err != nil {
+		return ExpireQuotesResult{}, err
+	}
+
+	ids := make([]string, 0, len(expired.Expired))
+	for _, operation := range expired.Expired {
+		ids = append(ids, operation.GetID().String())
+	}
+
+	uc.logger.Info("expired pending quotes",
+		slog.Int("count", len(ids)),
+		slog.Int("failed", expired.Failed),
+		slog.Bool("has_more", expired.HasMore))
+	return ExpireQuotesResult{ExpiredIDs: ids, Failed: expired.Failed, HasMore: expired.HasMore}, nil
+}
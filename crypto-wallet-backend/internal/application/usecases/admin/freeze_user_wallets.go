@@ -0,0 +1,127 @@
+package admin
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// AuditActionUserWalletsFrozen is recorded when every active wallet of a user is frozen.
+const AuditActionUserWalletsFrozen = "admin.user_wallets_frozen"
+
+// FreezeUserWalletsInput selects the user whose active wallets are frozen.
+type FreezeUserWalletsInput struct {
+	DestructiveOptions
+	UserID uuid.UUID
+	Reason string
+	Actor  string
+}
+
+// FreezeUserWalletsUseCase freezes all of a user's active wallets at once.
+type FreezeUserWalletsUseCase struct {
+	wallets WalletRepo
+	tokens  *ConfirmationTokens
+	audit   AuditLogger
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+// NewFreezeUserWalletsUseCase constructs the use case. Without tokens only dry runs are allowed.
+func NewFreezeUserWalletsUseCase(wallets WalletRepo, tokens *ConfirmationTokens, auditLogger AuditLogger, logger *slog.Logger) *FreezeUserWalletsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &FreezeUserWalletsUseCase{
+		wallets: wallets,
+		tokens:  tokens,
+		audit:   auditLogger,
+		logger:  logger,
+		now:     time.Now,
+	}
+}
+
+// Execute freezes the user's active wallets; wallets that fail to update are reported per wallet.
+func (uc *FreezeUserWalletsUseCase) Execute(ctx context.Context, input FreezeUserWalletsInput) (dto.AdminOperationResult, error) {
+	var errs utils.ValidationErrors
+	if input.UserID == uuid.Nil {
+		errs.Add("user", "user ID is required")
+	}
+	if strings.TrimSpace(input.Reason) == "" {
+		errs.Add("reason", "a reason is required to freeze wallets")
+	}
+	if err := wrapValidationError(errs); err != nil {
+		return dto.AdminOperationResult{}, err
+	}
+
+	wallets, err := listUserWallets(ctx, uc.wallets, input.UserID)
+	if err != nil {
+		return dto.AdminOperationResult{}, err
+	}
+
+	active := make([]entities.Wallet, 0, len(wallets))
+	ids := make([]string, 0, len(wallets))
+	for _, wallet := range wallets {
+		if wallet.GetStatus() == entities.WalletStatusActive {
+			active = append(active, wallet)
+			ids = append(ids, wallet.GetID().String())
+		}
+	}
+
+	result, proceed, err := confirmOperation(uc.tokens, input.DestructiveOptions, operationPlan{
+		Operation:   OperationFreezeUserWallets,
+		Actor:       input.Actor,
+		Scope:       map[string]string{"user_id": input.UserID.String()},
+		AffectedIDs: ids,
+	})
+	if err != nil || !proceed {
+		return result, err
+	}
+
+	now := uc.now().UTC()
+	frozen := make([]string, 0, len(active))
+	for _, wallet := range active {
+		if err := wallet.SetStatus(entities.WalletStatusFrozen); err == nil {
+			wallet.Touch(now)
+			err = uc.wallets.Update(ctx, wallet)
+		}
+		if err != nil {
+			if result.Failures == nil {
+				result.Failures = make(map[string]string)
+			}
+			result.Failures[wallet.GetID().String()] = err.Error()
+			continue
+		}
+		frozen = append(frozen, wallet.GetID().String())
+	}
+	result.AffectedIDs = frozen
+	result.AffectedCount = len(frozen)
+
+	if len(frozen) > 0 && uc.audit != nil {
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID:  input.Actor,
+			Action:   AuditActionUserWalletsFrozen,
+			TargetID: input.UserID.String(),
+			Metadata: map[string]any{
+				"wallet_ids": frozen,
+				"reason":     strings.TrimSpace(input.Reason),
+			},
+		}); err != nil {
+			uc.logger.Warn("failed to record audit entry", slog.String("action", AuditActionUserWalletsFrozen), slog.String("error", err.Error()))
+		}
+	}
+
+	uc.logger.Info("user wallets frozen",
+		slog.String("user_id", input.UserID.String()),
+		slog.Int("count", len(frozen)),
+		slog.String("actor", input.Actor))
+
+	return result, nil
+}
@@ -0,0 +1,101 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// InspectUserUseCase gathers an account's profile, wallets and KYC state for operators.
+type InspectUserUseCase struct {
+	users   UserRepo
+	wallets WalletRepo
+	kyc     KYCProfileReader
+	logger  *slog.Logger
+}
+
+// NewInspectUserUseCase constructs the use case; kyc may be nil when the KYC database is unavailable.
+func NewInspectUserUseCase(users UserRepo, wallets WalletRepo, kyc KYCProfileReader, logger *slog.Logger) *InspectUserUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &InspectUserUseCase{
+		users:   users,
+		wallets: wallets,
+		kyc:     kyc,
+		logger:  logger,
+	}
+}
+
+// Execute looks the user up by ID or email.
+func (uc *InspectUserUseCase) Execute(ctx context.Context, identifier string) (dto.AdminUserReport, error) {
+	identifier = strings.TrimSpace(identifier)
+	if identifier == "" {
+		var errs utils.ValidationErrors
+		errs.Add("user", "user ID or email is required")
+		return dto.AdminUserReport{}, wrapValidationError(errs)
+	}
+
+	var (
+		user entities.User
+		err  error
+	)
+	if id, parseErr := uuid.Parse(identifier); parseErr == nil {
+		user, err = uc.users.GetByID(ctx, id)
+	} else {
+		user, err = uc.users.GetByEmail(ctx, identifier)
+	}
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return dto.AdminUserReport{}, notFoundError("user", err)
+		}
+		return dto.AdminUserReport{}, err
+	}
+
+	report := dto.AdminUserReport{
+		ID:               user.GetID(),
+		Email:            user.GetEmail(),
+		Name:             strings.TrimSpace(user.GetFirstName() + " " + user.GetLastName()),
+		Status:           string(user.GetStatus()),
+		EmailVerified:    user.IsEmailVerified(),
+		TwoFactorEnabled: user.IsTwoFactorEnabled(),
+		CreatedAt:        user.GetCreatedAt().UTC(),
+		LastLoginAt:      user.GetLastLoginAt(),
+		Wallets:          make([]dto.Wallet, 0),
+	}
+
+	wallets, err := listUserWallets(ctx, uc.wallets, user.GetID())
+	if err != nil {
+		return dto.AdminUserReport{}, err
+	}
+	for _, wallet := range wallets {
+		report.Wallets = append(report.Wallets, mapWallet(wallet))
+	}
+
+	if uc.kyc != nil {
+		profile, err := uc.kyc.GetProfileByUserID(ctx, user.GetID())
+		switch {
+		case err == nil:
+			report.KYC = &dto.AdminKYC{
+				Status:          string(profile.GetStatus()),
+				Level:           string(profile.GetVerificationLevel()),
+				DailyLimitUSD:   profile.GetDailyLimitUSD().String(),
+				MonthlyLimitUSD: profile.GetMonthlyLimitUSD().String(),
+				ExpiresAt:       profile.GetExpiresAt(),
+			}
+		case errors.Is(err, repositories.ErrNotFound):
+		default:
+			uc.logger.Warn("failed to load kyc profile", slog.String("user_id", user.GetID().String()), slog.String("error", err.Error()))
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,513 @@
+package admin
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/internal/infrastructure/keybackup"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// Audit actions recorded by disaster-recovery key backups. Every step must reach the audit log, so
+// these workflows fail instead of continuing when an entry cannot be recorded.
+const (
+	AuditActionKeyBackupRequested     = "admin.key_backup_requested"
+	AuditActionKeyBackupApproved      = "admin.key_backup_approved"
+	AuditActionKeyBackupExportStarted = "admin.key_backup_export_started"
+	AuditActionKeyBackupExported      = "admin.key_backup_exported"
+	AuditActionKeyBackupExportFailed  = "admin.key_backup_export_failed"
+)
+
+// walletKeyPageSize bounds each read of wallet key material during an export.
+const walletKeyPageSize = 500
+
+// KeyBackupApprover is an operator allowed to approve key backups and the Ed25519 key they sign
+// approvals with.
+type KeyBackupApprover struct {
+	Name      string
+	PublicKey ed25519.PublicKey
+}
+
+// KeyBackupPolicy is the M-of-N rule for key backups: Quorum of the named Approvers, other than the
+// requester, must approve a request within ApprovalWindow. An approval counts only when it is
+// signed with the approver's key, so the operator name alone never proves who approved.
+type KeyBackupPolicy struct {
+	Approvers      []KeyBackupApprover
+	Quorum         int
+	ApprovalWindow time.Duration
+}
+
+func (p KeyBackupPolicy) validate() error {
+	if p.Quorum < 2 {
+		return errors.New("key backup quorum must be at least 2 (set KEY_BACKUP_QUORUM)")
+	}
+	if len(p.Approvers) < p.Quorum {
+		return fmt.Errorf("key backup needs at least %d approvers (set KEY_BACKUP_APPROVERS)", p.Quorum)
+	}
+	owners := make(map[string]string, len(p.Approvers))
+	for _, approver := range p.Approvers {
+		if len(approver.PublicKey) != ed25519.PublicKeySize {
+			return fmt.Errorf("key backup approver %q has no Ed25519 public key", approver.Name)
+		}
+		if owner, ok := owners[string(approver.PublicKey)]; ok {
+			return fmt.Errorf("key backup approvers %q and %q share a public key", owner, approver.Name)
+		}
+		owners[string(approver.PublicKey)] = approver.Name
+	}
+	return nil
+}
+
+func (p KeyBackupPolicy) approver(name string) (KeyBackupApprover, bool) {
+	for _, approver := range p.Approvers {
+		if approver.Name == name {
+			return approver, true
+		}
+	}
+	return KeyBackupApprover{}, false
+}
+
+// signer returns the approver whose key made signature over the request.
+func (p KeyBackupPolicy) signer(request entities.KeyBackupRequest, signature []byte) (KeyBackupApprover, bool) {
+	for _, approver := range p.Approvers {
+		if keybackup.VerifyApproval(approver.PublicKey, request.ID, request.RecipientFingerprint, signature) {
+			return approver, true
+		}
+	}
+	return KeyBackupApprover{}, false
+}
+
+// validApprovals counts the distinct approver keys that signed the request, leaving out approvals
+// by the requester and by operators who are no longer approvers.
+func (p KeyBackupPolicy) validApprovals(request entities.KeyBackupRequest) int {
+	signed := make(map[string]struct{}, len(request.Approvals))
+	for _, approval := range request.Approvals {
+		if approval.Approver == request.RequestedBy {
+			continue
+		}
+		approver, ok := p.approver(approval.Approver)
+		if !ok || !keybackup.VerifyApproval(approver.PublicKey, request.ID, request.RecipientFingerprint, approval.Signature) {
+			continue
+		}
+		signed[string(approver.PublicKey)] = struct{}{}
+	}
+	return len(signed)
+}
+
+// RequestKeyBackupInput starts a key backup for an offline recipient key.
+type RequestKeyBackupInput struct {
+	RecipientPublicKeyPEM []byte
+	Reason                string
+	Actor                 string
+}
+
+// RequestKeyBackupUseCase opens a key backup request for approval.
+type RequestKeyBackupUseCase struct {
+	store  repositories.KeyBackupRepository
+	policy KeyBackupPolicy
+	audit  AuditLogger
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewRequestKeyBackupUseCase constructs the use case.
+func NewRequestKeyBackupUseCase(store repositories.KeyBackupRepository, policy KeyBackupPolicy, auditLogger AuditLogger, logger *slog.Logger) *RequestKeyBackupUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if policy.ApprovalWindow <= 0 {
+		policy.ApprovalWindow = entities.DefaultKeyBackupApprovalWindow
+	}
+	return &RequestKeyBackupUseCase{
+		store:  store,
+		policy: policy,
+		audit:  auditLogger,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Execute validates the recipient key and stores the request.
+func (uc *RequestKeyBackupUseCase) Execute(ctx context.Context, input RequestKeyBackupInput) (entities.KeyBackupRequest, error) {
+	if err := uc.policy.validate(); err != nil {
+		return entities.KeyBackupRequest{}, err
+	}
+
+	var errs utils.ValidationErrors
+	reason := strings.TrimSpace(input.Reason)
+	if reason == "" {
+		errs.Add("reason", "a reason is required to back up key material")
+	}
+	recipient, err := keybackup.ParsePublicKey(input.RecipientPublicKeyPEM)
+	if err != nil {
+		errs.Add("recipient", err.Error())
+	}
+	if err := wrapValidationError(errs); err != nil {
+		return entities.KeyBackupRequest{}, err
+	}
+	fingerprint, err := keybackup.Fingerprint(recipient)
+	if err != nil {
+		return entities.KeyBackupRequest{}, err
+	}
+
+	now := uc.now().UTC()
+	request := entities.KeyBackupRequest{
+		ID:                   uuid.New(),
+		RequestedBy:          input.Actor,
+		Reason:               reason,
+		RecipientPublicKey:   string(input.RecipientPublicKeyPEM),
+		RecipientFingerprint: fingerprint,
+		Quorum:               uc.policy.Quorum,
+		Status:               entities.KeyBackupStatusPending,
+		Approvals:            []entities.KeyBackupApproval{},
+		CreatedAt:            now,
+		ExpiresAt:            now.Add(uc.policy.ApprovalWindow),
+	}
+	if err := uc.store.Create(ctx, request); err != nil {
+		return entities.KeyBackupRequest{}, err
+	}
+
+	if err := recordKeyBackupAudit(ctx, uc.audit, input.Actor, AuditActionKeyBackupRequested, request, map[string]any{
+		"reason":     reason,
+		"quorum":     request.Quorum,
+		"expires_at": request.ExpiresAt,
+	}); err != nil {
+		return entities.KeyBackupRequest{}, err
+	}
+
+	uc.logger.Info("key backup requested",
+		slog.String("request_id", request.ID.String()),
+		slog.String("recipient_fingerprint", fingerprint),
+		slog.String("actor", input.Actor))
+	return request, nil
+}
+
+// ApproveKeyBackupInput approves a request. Fingerprint must repeat the recipient key fingerprint,
+// which the approver is expected to have checked against the offline key out of band. Signature
+// is the approver's signature of keybackup.ApprovalMessage and decides who is approving.
+type ApproveKeyBackupInput struct {
+	RequestID   uuid.UUID
+	Fingerprint string
+	Signature   []byte
+}
+
+// ApproveKeyBackupUseCase records one approver's approval of a key backup request.
+type ApproveKeyBackupUseCase struct {
+	store  repositories.KeyBackupRepository
+	policy KeyBackupPolicy
+	audit  AuditLogger
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewApproveKeyBackupUseCase constructs the use case.
+func NewApproveKeyBackupUseCase(store repositories.KeyBackupRepository, policy KeyBackupPolicy, auditLogger AuditLogger, logger *slog.Logger) *ApproveKeyBackupUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ApproveKeyBackupUseCase{
+		store:  store,
+		policy: policy,
+		audit:  auditLogger,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Execute records the approval of the approver whose key signed it and returns the request with
+// its approvals.
+func (uc *ApproveKeyBackupUseCase) Execute(ctx context.Context, input ApproveKeyBackupInput) (entities.KeyBackupRequest, error) {
+	if err := uc.policy.validate(); err != nil {
+		return entities.KeyBackupRequest{}, err
+	}
+
+	request, err := loadPendingKeyBackup(ctx, uc.store, input.RequestID, uc.now().UTC())
+	if err != nil {
+		return entities.KeyBackupRequest{}, err
+	}
+	if !strings.EqualFold(strings.TrimSpace(input.Fingerprint), request.RecipientFingerprint) {
+		return entities.KeyBackupRequest{}, utils.NewAppError(
+			"KEY_BACKUP_FINGERPRINT_MISMATCH",
+			"fingerprint does not match the request's recipient key",
+			fiber.StatusBadRequest,
+			nil,
+			nil,
+		)
+	}
+	approver, ok := uc.policy.signer(request, input.Signature)
+	if !ok {
+		return entities.KeyBackupRequest{}, keyBackupForbidden("approval is not signed by a key backup approver's key")
+	}
+	if request.RequestedBy == approver.Name {
+		return entities.KeyBackupRequest{}, keyBackupForbidden("the requester cannot approve their own key backup")
+	}
+
+	approval := entities.KeyBackupApproval{Approver: approver.Name, Signature: input.Signature, ApprovedAt: uc.now().UTC()}
+	if err := uc.store.AddApproval(ctx, request.ID, approval); err != nil {
+		if errors.Is(err, repositories.ErrDuplicate) {
+			return entities.KeyBackupRequest{}, utils.NewAppError(
+				"KEY_BACKUP_ALREADY_APPROVED",
+				"operator already approved this key backup",
+				fiber.StatusConflict,
+				err,
+				nil,
+			)
+		}
+		return entities.KeyBackupRequest{}, err
+	}
+	request.Approvals = append(request.Approvals, approval)
+
+	if err := recordKeyBackupAudit(ctx, uc.audit, approver.Name, AuditActionKeyBackupApproved, request, map[string]any{
+		"approvals": uc.policy.validApprovals(request),
+		"quorum":    request.Quorum,
+	}); err != nil {
+		// An approval that is not in the audit log does not count.
+		if removeErr := uc.store.RemoveApproval(context.WithoutCancel(ctx), request.ID, approver.Name); removeErr != nil {
+			uc.logger.Error("failed to withdraw unaudited key backup approval",
+				slog.String("request_id", request.ID.String()),
+				slog.String("error", removeErr.Error()))
+		}
+		return entities.KeyBackupRequest{}, err
+	}
+
+	uc.logger.Info("key backup approved",
+		slog.String("request_id", request.ID.String()),
+		slog.String("actor", approver.Name),
+		slog.Int("approvals", uc.policy.validApprovals(request)),
+		slog.Int("quorum", request.Quorum))
+	return request, nil
+}
+
+// ExportKeyBackupInput exports an approved request.
+type ExportKeyBackupInput struct {
+	RequestID uuid.UUID
+	Actor     string
+}
+
+// KeyBackupExportResult describes a written archive.
+type KeyBackupExportResult struct {
+	RequestID            uuid.UUID `json:"request_id"`
+	RecipientFingerprint string    `json:"recipient_fingerprint"`
+	WalletCount          int       `json:"wallet_count"`
+	Segments             int       `json:"segments"`
+	ArchiveSHA256        string    `json:"archive_sha256"`
+	ExportedAt           time.Time `json:"exported_at"`
+}
+
+// ExportKeyBackupUseCase writes the archive of an approved request. Requests are single use: a
+// failed export marks the request failed and a new request must be approved.
+type ExportKeyBackupUseCase struct {
+	store  repositories.KeyBackupRepository
+	policy KeyBackupPolicy
+	audit  AuditLogger
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewExportKeyBackupUseCase constructs the use case.
+func NewExportKeyBackupUseCase(store repositories.KeyBackupRepository, policy KeyBackupPolicy, auditLogger AuditLogger, logger *slog.Logger) *ExportKeyBackupUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ExportKeyBackupUseCase{
+		store:  store,
+		policy: policy,
+		audit:  auditLogger,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Execute checks the quorum, claims the request and streams every wallet's encrypted key material
+// into w. Only the requester or one of the request's approvers may export it.
+func (uc *ExportKeyBackupUseCase) Execute(ctx context.Context, input ExportKeyBackupInput, w io.Writer) (KeyBackupExportResult, error) {
+	if err := uc.policy.validate(); err != nil {
+		return KeyBackupExportResult{}, err
+	}
+
+	now := uc.now().UTC()
+	request, err := loadPendingKeyBackup(ctx, uc.store, input.RequestID, now)
+	if err != nil {
+		return KeyBackupExportResult{}, err
+	}
+	if input.Actor != request.RequestedBy && !request.ApprovedBy(input.Actor) {
+		return KeyBackupExportResult{}, keyBackupForbidden("only the requester or an approver can export a key backup")
+	}
+	quorum := max(request.Quorum, uc.policy.Quorum)
+	if approvals := uc.policy.validApprovals(request); approvals < quorum {
+		return KeyBackupExportResult{}, utils.NewAppError(
+			"KEY_BACKUP_QUORUM_NOT_MET",
+			"key backup does not have enough approvals",
+			fiber.StatusConflict,
+			nil,
+			map[string]any{"approvals": approvals, "quorum": quorum},
+		)
+	}
+
+	recipient, err := keybackup.ParsePublicKey([]byte(request.RecipientPublicKey))
+	if err != nil {
+		return KeyBackupExportResult{}, err
+	}
+	if fingerprint, err := keybackup.Fingerprint(recipient); err != nil || fingerprint != request.RecipientFingerprint {
+		return KeyBackupExportResult{}, errors.New("key backup: stored recipient key does not match its approved fingerprint")
+	}
+
+	claimed, err := uc.store.ClaimExport(ctx, request.ID, input.Actor, now)
+	if err != nil {
+		return KeyBackupExportResult{}, err
+	}
+	if !claimed {
+		return KeyBackupExportResult{}, keyBackupNotPending(request.Status)
+	}
+
+	if err := recordKeyBackupAudit(ctx, uc.audit, input.Actor, AuditActionKeyBackupExportStarted, request, map[string]any{
+		"approvers": approverNames(request),
+	}); err != nil {
+		uc.failExport(ctx, request, input.Actor, err)
+		return KeyBackupExportResult{}, err
+	}
+
+	summary, err := uc.writeArchive(ctx, request, recipient, now, w)
+	if err != nil {
+		uc.failExport(ctx, request, input.Actor, err)
+		return KeyBackupExportResult{}, err
+	}
+
+	exportedAt := uc.now().UTC()
+	if err := uc.store.CompleteExport(ctx, request.ID, summary.ArchiveSHA256, summary.WalletCount, exportedAt); err != nil {
+		uc.failExport(ctx, request, input.Actor, err)
+		return KeyBackupExportResult{}, err
+	}
+
+	if err := recordKeyBackupAudit(ctx, uc.audit, input.Actor, AuditActionKeyBackupExported, request, map[string]any{
+		"archive_sha256": summary.ArchiveSHA256,
+		"wallet_count":   summary.WalletCount,
+	}); err != nil {
+		// The start of the export is already in the audit log and the digest is stored on the request.
+		uc.logger.Error("failed to record key backup completion", slog.String("request_id", request.ID.String()), slog.String("error", err.Error()))
+	}
+
+	uc.logger.Info("key backup exported",
+		slog.String("request_id", request.ID.String()),
+		slog.Int("wallets", summary.WalletCount),
+		slog.String("archive_sha256", summary.ArchiveSHA256),
+		slog.String("actor", input.Actor))
+
+	return KeyBackupExportResult{
+		RequestID:            request.ID,
+		RecipientFingerprint: request.RecipientFingerprint,
+		WalletCount:          summary.WalletCount,
+		Segments:             summary.Segments,
+		ArchiveSHA256:        summary.ArchiveSHA256,
+		ExportedAt:           exportedAt,
+	}, nil
+}
+
+func (uc *ExportKeyBackupUseCase) writeArchive(ctx context.Context, request entities.KeyBackupRequest, recipient *rsa.PublicKey, now time.Time, w io.Writer) (keybackup.Summary, error) {
+	writer, err := keybackup.NewWriter(w, recipient, request.ID, now)
+	if err != nil {
+		return keybackup.Summary{}, err
+	}
+
+	after := uuid.Nil
+	for {
+		records, err := uc.store.ListWalletKeys(ctx, after, walletKeyPageSize)
+		if err != nil {
+			return keybackup.Summary{}, err
+		}
+		for _, record := range records {
+			if err := writer.Add(record); err != nil {
+				return keybackup.Summary{}, err
+			}
+		}
+		if len(records) < walletKeyPageSize {
+			break
+		}
+		after = records[len(records)-1].WalletID
+	}
+	return writer.Close()
+}
+
+func (uc *ExportKeyBackupUseCase) failExport(ctx context.Context, request entities.KeyBackupRequest, actor string, cause error) {
+	ctx = context.WithoutCancel(ctx)
+	if err := uc.store.FailExport(ctx, request.ID); err != nil {
+		uc.logger.Error("failed to mark key backup failed", slog.String("request_id", request.ID.String()), slog.String("error", err.Error()))
+	}
+	if err := recordKeyBackupAudit(ctx, uc.audit, actor, AuditActionKeyBackupExportFailed, request, map[string]any{
+		"error": cause.Error(),
+	}); err != nil {
+		uc.logger.Error("failed to record key backup failure", slog.String("request_id", request.ID.String()), slog.String("error", err.Error()))
+	}
+}
+
+func loadPendingKeyBackup(ctx context.Context, store repositories.KeyBackupRepository, id uuid.UUID, now time.Time) (entities.KeyBackupRequest, error) {
+	request, err := store.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return entities.KeyBackupRequest{}, notFoundError("key backup request", err)
+		}
+		return entities.KeyBackupRequest{}, err
+	}
+	if request.Status != entities.KeyBackupStatusPending {
+		return entities.KeyBackupRequest{}, keyBackupNotPending(request.Status)
+	}
+	if request.IsExpired(now) {
+		return entities.KeyBackupRequest{}, utils.NewAppError(
+			"KEY_BACKUP_EXPIRED",
+			"key backup request expired; open a new request",
+			fiber.StatusGone,
+			nil,
+			map[string]any{"expires_at": request.ExpiresAt},
+		)
+	}
+	return request, nil
+}
+
+func recordKeyBackupAudit(ctx context.Context, auditLogger AuditLogger, actor, action string, request entities.KeyBackupRequest, metadata map[string]any) error {
+	if auditLogger == nil {
+		return errors.New("key backup: audit log is required")
+	}
+	metadata["recipient_fingerprint"] = request.RecipientFingerprint
+	metadata["requested_by"] = request.RequestedBy
+	return auditLogger.Record(ctx, audit.Entry{
+		ActorID:      actor,
+		Action:       action,
+		ResourceType: "key_backup_request",
+		TargetID:     request.ID.String(),
+		Metadata:     metadata,
+	})
+}
+
+func approverNames(request entities.KeyBackupRequest) []string {
+	names := make([]string, 0, len(request.Approvals))
+	for _, approval := range request.Approvals {
+		names = append(names, approval.Approver)
+	}
+	return names
+}
+
+func keyBackupForbidden(message string) error {
+	return utils.NewAppError("KEY_BACKUP_FORBIDDEN", message, fiber.StatusForbidden, nil, nil)
+}
+
+func keyBackupNotPending(status entities.KeyBackupStatus) error {
+	return utils.NewAppError(
+		"KEY_BACKUP_NOT_PENDING",
+		"key backup request is no longer pending",
+		fiber.StatusConflict,
+		nil,
+		map[string]any{"status": string(status)},
+	)
+}
@@ -0,0 +1,124 @@
+package admin
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/internal/infrastructure/keybackup"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+type memoryKeyBackupStore struct {
+	repositories.KeyBackupRepository
+	request entities.KeyBackupRequest
+}
+
+func (s *memoryKeyBackupStore) GetByID(context.Context, uuid.UUID) (entities.KeyBackupRequest, error) {
+	return s.request, nil
+}
+
+func (s *memoryKeyBackupStore) AddApproval(_ context.Context, _ uuid.UUID, approval entities.KeyBackupApproval) error {
+	if s.request.ApprovedBy(approval.Approver) {
+		return repositories.ErrDuplicate
+	}
+	s.request.Approvals = append(s.request.Approvals, approval)
+	return nil
+}
+
+type discardAudit struct{}
+
+func (discardAudit) Record(context.Context, audit.Entry) error { return nil }
+
+func newApproverKey(t *testing.T, name string) (KeyBackupApprover, ed25519.PrivateKey) {
+	t.Helper()
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return KeyBackupApprover{Name: name, PublicKey: public}, private
+}
+
+func TestApproveKeyBackupCountsOnlySignedDistinctApprovers(t *testing.T) {
+	alice, aliceKey := newApproverKey(t, "alice")
+	bob, bobKey := newApproverKey(t, "bob")
+	_, outsiderKey := newApproverKey(t, "mallory")
+	policy := KeyBackupPolicy{Approvers: []KeyBackupApprover{alice, bob}, Quorum: 2}
+
+	now := time.Now().UTC()
+	store := &memoryKeyBackupStore{request: entities.KeyBackupRequest{
+		ID:                   uuid.New(),
+		RequestedBy:          "carol",
+		RecipientFingerprint: strings.Repeat("ab", 32),
+		Quorum:               2,
+		Status:               entities.KeyBackupStatusPending,
+		CreatedAt:            now,
+		ExpiresAt:            now.Add(time.Hour),
+	}}
+	request := store.request
+	uc := NewApproveKeyBackupUseCase(store, policy, discardAudit{}, nil)
+	approve := func(key ed25519.PrivateKey) error {
+		_, err := uc.Execute(context.Background(), ApproveKeyBackupInput{
+			RequestID:   request.ID,
+			Fingerprint: request.RecipientFingerprint,
+			Signature:   keybackup.SignApproval(key, request.ID, request.RecipientFingerprint),
+		})
+		return err
+	}
+
+	var appErr *utils.AppError
+	if err := approve(outsiderKey); !errors.As(err, &appErr) || appErr.Code != "KEY_BACKUP_FORBIDDEN" {
+		t.Fatalf("approval signed by an unlisted key = %v, want KEY_BACKUP_FORBIDDEN", err)
+	}
+	if err := approve(aliceKey); err != nil {
+		t.Fatalf("alice's approval error = %v", err)
+	}
+	if err := approve(aliceKey); !errors.As(err, &appErr) || appErr.Code != "KEY_BACKUP_ALREADY_APPROVED" {
+		t.Fatalf("alice's second approval = %v, want KEY_BACKUP_ALREADY_APPROVED", err)
+	}
+
+	// Approvals written straight to the store under other names, without a signature from the
+	// named approver's key, do not count.
+	store.request.Approvals = append(store.request.Approvals,
+		entities.KeyBackupApproval{Approver: "bob", ApprovedAt: now},
+		entities.KeyBackupApproval{Approver: "bob", Signature: keybackup.SignApproval(aliceKey, request.ID, request.RecipientFingerprint), ApprovedAt: now},
+	)
+	if got := policy.validApprovals(store.request); got != 1 {
+		t.Fatalf("valid approvals with forged entries = %d, want 1", got)
+	}
+
+	store.request.Approvals = store.request.Approvals[:1]
+	if err := approve(bobKey); err != nil {
+		t.Fatalf("bob's approval error = %v", err)
+	}
+	if got := policy.validApprovals(store.request); got != 2 {
+		t.Errorf("valid approvals = %d, want 2", got)
+	}
+
+	// A signature over another request does not carry over.
+	other := store.request
+	other.ID = uuid.New()
+	if got := policy.validApprovals(other); got != 0 {
+		t.Errorf("valid approvals for another request = %d, want 0", got)
+	}
+}
+
+func TestKeyBackupPolicyRejectsSharedApproverKeys(t *testing.T) {
+	alice, _ := newApproverKey(t, "alice")
+	policy := KeyBackupPolicy{
+		Approvers: []KeyBackupApprover{alice, {Name: "alice-again", PublicKey: alice.PublicKey}},
+		Quorum:    2,
+	}
+	if err := policy.validate(); err == nil || !strings.Contains(err.Error(), "share a public key") {
+		t.Fatalf("validate() = %v, want a shared key error", err)
+	}
+}
@@ -0,0 +1,54 @@
+package admin
+
+import (
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/infrastructure/budget"
+	"github.com/crypto-wallet/backend/internal/infrastructure/monitoring"
+)
+
+// BudgetMetrics exposes the execution budget counters collected since the server started.
+type BudgetMetrics interface {
+	Snapshot() []monitoring.BudgetSample
+}
+
+// ListExecutionBudgetsUseCase reports every configured execution budget with its run counters, so
+// operators can see which paths run out of time.
+type ListExecutionBudgetsUseCase struct {
+	budgets *budget.Budgets
+	metrics BudgetMetrics
+}
+
+// NewListExecutionBudgetsUseCase constructs the use case; metrics are optional.
+func NewListExecutionBudgetsUseCase(budgets *budget.Budgets, metrics BudgetMetrics) *ListExecutionBudgetsUseCase {
+	return &ListExecutionBudgetsUseCase{budgets: budgets, metrics: metrics}
+}
+
+// Execute lists use cases that have run first, most often exhausted first, followed by configured
+// budgets that have not run yet.
+func (uc *ListExecutionBudgetsUseCase) Execute() []dto.AdminExecutionBudget {
+	items := make([]dto.AdminExecutionBudget, 0)
+	seen := make(map[string]bool)
+	if uc.metrics != nil {
+		for _, sample := range uc.metrics.Snapshot() {
+			seen[sample.Name] = true
+			item := dto.AdminExecutionBudget{
+				Name:            sample.Name,
+				LimitMs:         uc.budgets.Limit(sample.Name).Milliseconds(),
+				Runs:            sample.Runs,
+				Exhausted:       sample.Exhausted,
+				MaxDurationMs:   sample.MaxDuration.Milliseconds(),
+				LastExhaustedAt: sample.LastExhaustedAt,
+			}
+			if sample.Runs > 0 {
+				item.AvgDurationMs = sample.TotalDuration.Milliseconds() / sample.Runs
+			}
+			items = append(items, item)
+		}
+	}
+	for _, name := range uc.budgets.Names() {
+		if !seen[name] {
+			items = append(items, dto.AdminExecutionBudget{Name: name, LimitMs: uc.budgets.Limit(name).Milliseconds()})
+		}
+	}
+	return items
+}
@@ -0,0 +1,115 @@
+package admin
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// AuditActionExportsPurged is recorded when finished export jobs are deleted.
+const AuditActionExportsPurged = "admin.exports_purged"
+
+// maxPurgeBatch bounds how many export jobs one purge removes; rerun to continue.
+const maxPurgeBatch = 10000
+
+// ExportPurger lists and deletes finished export jobs.
+type ExportPurger interface {
+	ListFinishedBefore(ctx context.Context, before time.Time, limit int) ([]uuid.UUID, error)
+	DeleteFinished(ctx context.Context, ids []uuid.UUID) (int64, error)
+}
+
+// PurgeExportsInput selects finished export jobs created before the cutoff. A zero Before means now;
+// the dry run reports the cutoff it used in the result scope.
+type PurgeExportsInput struct {
+	DestructiveOptions
+	Before time.Time
+	Actor  string
+}
+
+// PurgeExportsUseCase deletes completed and failed export jobs, including their history.
+type PurgeExportsUseCase struct {
+	exports ExportPurger
+	tokens  *ConfirmationTokens
+	audit   AuditLogger
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+// NewPurgeExportsUseCase constructs the use case. Without tokens only dry runs are allowed.
+func NewPurgeExportsUseCase(exports ExportPurger, tokens *ConfirmationTokens, auditLogger AuditLogger, logger *slog.Logger) *PurgeExportsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &PurgeExportsUseCase{
+		exports: exports,
+		tokens:  tokens,
+		audit:   auditLogger,
+		logger:  logger,
+		now:     time.Now,
+	}
+}
+
+// Execute deletes up to maxPurgeBatch finished jobs created before the cutoff.
+func (uc *PurgeExportsUseCase) Execute(ctx context.Context, input PurgeExportsInput) (dto.AdminOperationResult, error) {
+	now := uc.now().UTC()
+	before := input.Before.UTC().Truncate(time.Second)
+	if input.Before.IsZero() {
+		before = now.Truncate(time.Second)
+	}
+	if before.After(now) {
+		var errs utils.ValidationErrors
+		errs.Add("before", "cutoff must not be in the future")
+		return dto.AdminOperationResult{}, wrapValidationError(errs)
+	}
+
+	jobIDs, err := uc.exports.ListFinishedBefore(ctx, before, maxPurgeBatch)
+	if err != nil {
+		return dto.AdminOperationResult{}, err
+	}
+	ids := make([]string, 0, len(jobIDs))
+	for _, id := range jobIDs {
+		ids = append(ids, id.String())
+	}
+
+	result, proceed, err := confirmOperation(uc.tokens, input.DestructiveOptions, operationPlan{
+		Operation:   OperationPurgeExports,
+		Actor:       input.Actor,
+		Scope:       map[string]string{"before": before.Format(time.RFC3339)},
+		AffectedIDs: ids,
+	})
+	if err != nil || !proceed {
+		return result, err
+	}
+
+	deleted, err := uc.exports.DeleteFinished(ctx, jobIDs)
+	if err != nil {
+		return dto.AdminOperationResult{}, err
+	}
+	result.AffectedCount = int(deleted)
+
+	if uc.audit != nil {
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID: input.Actor,
+			Action:  AuditActionExportsPurged,
+			Metadata: map[string]any{
+				"before":  before.Format(time.RFC3339),
+				"deleted": deleted,
+			},
+		}); err != nil {
+			uc.logger.Warn("failed to record audit entry", slog.String("action", AuditActionExportsPurged), slog.String("error", err.Error()))
+		}
+	}
+
+	uc.logger.Info("export jobs purged",
+		slog.Int64("count", deleted),
+		slog.String("before", before.Format(time.RFC3339)),
+		slog.String("actor", input.Actor))
+
+	return result, nil
+}
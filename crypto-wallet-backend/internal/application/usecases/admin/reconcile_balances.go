@@ -0,0 +1,199 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/internal/infrastructure/blockchain"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// AuditActionBalancesReconciled is recorded when reconciliation corrects stored balances.
+const AuditActionBalancesReconciled = "admin.balances_reconciled"
+
+// BalanceReader fetches on-chain balances.
+type BalanceReader interface {
+	GetBalance(ctx context.Context, address string) (*blockchain.Balance, error)
+}
+
+// ReconcileBalancesInput selects the user to reconcile. A real run overwrites mismatched stored
+// balances with the on-chain value; the dry run only reports them.
+type ReconcileBalancesInput struct {
+	DestructiveOptions
+	UserID uuid.UUID
+	Actor  string
+}
+
+// ReconcileBalancesUseCase compares stored wallet balances with the chain.
+type ReconcileBalancesUseCase struct {
+	wallets  WalletRepo
+	adapters map[entities.Chain]BalanceReader
+	tokens   *ConfirmationTokens
+	audit    AuditLogger
+	logger   *slog.Logger
+	now      func() time.Time
+}
+
+// NewReconcileBalancesUseCase constructs the use case. Without tokens only dry runs are allowed.
+func NewReconcileBalancesUseCase(wallets WalletRepo, adapters map[entities.Chain]BalanceReader, tokens *ConfirmationTokens, auditLogger AuditLogger, logger *slog.Logger) *ReconcileBalancesUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ReconcileBalancesUseCase{
+		wallets:  wallets,
+		adapters: adapters,
+		tokens:   tokens,
+		audit:    auditLogger,
+		logger:   logger,
+		now:      time.Now,
+	}
+}
+
+// Execute reconciles every non-archived wallet of the user. Per-wallet failures are reported in the
+// result rather than aborting the run.
+func (uc *ReconcileBalancesUseCase) Execute(ctx context.Context, input ReconcileBalancesInput) (dto.AdminReconciliationReport, error) {
+	if input.UserID == uuid.Nil {
+		var errs utils.ValidationErrors
+		errs.Add("user", "user ID is required")
+		return dto.AdminReconciliationReport{}, wrapValidationError(errs)
+	}
+
+	wallets, err := listUserWallets(ctx, uc.wallets, input.UserID)
+	if err != nil {
+		return dto.AdminReconciliationReport{}, err
+	}
+
+	report := dto.AdminReconciliationReport{
+		UserID: input.UserID,
+		Lines:  make([]dto.AdminReconciliationLine, 0, len(wallets)),
+	}
+	mismatched := make(map[int]entities.Wallet)
+	ids := make([]string, 0)
+
+	for _, wallet := range wallets {
+		if wallet.GetStatus() == entities.WalletStatusArchived {
+			continue
+		}
+		// Once the request is gone every remaining chain call would fail the same way.
+		if err := ctx.Err(); err != nil {
+			return dto.AdminReconciliationReport{}, context.Cause(ctx)
+		}
+
+		line := uc.compare(ctx, wallet)
+		if line.Status == dto.ReconciliationMismatched {
+			mismatched[len(report.Lines)] = wallet
+			ids = append(ids, wallet.GetID().String())
+		}
+		report.Lines = append(report.Lines, line)
+	}
+	report.Mismatched = len(ids)
+
+	result, proceed, err := confirmOperation(uc.tokens, input.DestructiveOptions, operationPlan{
+		Operation:   OperationReconcileBalances,
+		Actor:       input.Actor,
+		Scope:       map[string]string{"user_id": input.UserID.String()},
+		AffectedIDs: ids,
+	})
+	if err != nil {
+		return dto.AdminReconciliationReport{}, err
+	}
+	report.Operation = result
+	if !proceed {
+		return report, nil
+	}
+
+	corrected := make([]string, 0, len(mismatched))
+	now := uc.now().UTC()
+	for index, wallet := range mismatched {
+		line := &report.Lines[index]
+		onChain, err := decimal.NewFromString(line.OnChainBalance)
+		if err == nil {
+			err = wallet.UpdateBalance(onChain, now)
+		}
+		if err == nil {
+			wallet.Touch(now)
+			err = uc.wallets.Update(ctx, wallet)
+		}
+		if err != nil {
+			line.Error = err.Error()
+			continue
+		}
+		line.Status = dto.ReconciliationCorrected
+		corrected = append(corrected, wallet.GetID().String())
+	}
+	sort.Strings(corrected)
+	report.Operation.AffectedIDs = corrected
+	report.Operation.AffectedCount = len(corrected)
+
+	if len(corrected) > 0 && uc.audit != nil {
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID:  input.Actor,
+			Action:   AuditActionBalancesReconciled,
+			TargetID: input.UserID.String(),
+			Metadata: map[string]any{"wallet_ids": corrected},
+		}); err != nil {
+			uc.logger.Warn("failed to record audit entry", slog.String("action", AuditActionBalancesReconciled), slog.String("error", err.Error()))
+		}
+	}
+
+	return report, nil
+}
+
+func (uc *ReconcileBalancesUseCase) compare(ctx context.Context, wallet entities.Wallet) dto.AdminReconciliationLine {
+	line := dto.AdminReconciliationLine{
+		WalletID:      wallet.GetID(),
+		Chain:         string(wallet.GetChain()),
+		Address:       wallet.GetAddress(),
+		StoredBalance: wallet.GetBalance().String(),
+	}
+
+	onChain, err := uc.onChainBalance(ctx, wallet)
+	if err != nil {
+		line.Status = dto.ReconciliationFailed
+		line.Error = err.Error()
+		return line
+	}
+
+	line.OnChainBalance = onChain.String()
+	diff := onChain.Sub(wallet.GetBalance())
+	line.Difference = diff.String()
+	if diff.IsZero() {
+		line.Status = dto.ReconciliationMatched
+		return line
+	}
+
+	line.Status = dto.ReconciliationMismatched
+	return line
+}
+
+func (uc *ReconcileBalancesUseCase) onChainBalance(ctx context.Context, wallet entities.Wallet) (decimal.Decimal, error) {
+	adapter, ok := uc.adapters[wallet.GetChain()]
+	if !ok || adapter == nil {
+		return decimal.Zero, fmt.Errorf("no blockchain adapter for %s", wallet.GetChain())
+	}
+
+	balance, err := adapter.GetBalance(ctx, wallet.GetAddress())
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if balance == nil {
+		return decimal.Zero, errors.New("blockchain returned empty balance")
+	}
+
+	value := strings.TrimSpace(balance.Balance)
+	if value == "" {
+		return decimal.Zero, nil
+	}
+	return decimal.NewFromString(value)
+}
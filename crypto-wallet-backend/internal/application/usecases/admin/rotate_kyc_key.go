@@ -0,0 +1,162 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+)
+
+// AuditActionKYCKeyRotated is recorded when per-user KYC data keys are re-wrapped under a new master key.
+const AuditActionKYCKeyRotated = "admin.kyc_key_rotated"
+
+// UserDataKeyStore pages through wrapped per-user data keys and replaces them.
+type UserDataKeyStore interface {
+	List(ctx context.Context, after uuid.UUID, limit int) ([]repositories.UserDataKey, error)
+	Replace(ctx context.Context, userID uuid.UUID, current, replacement string) error
+}
+
+// UserKeyRewrapper re-wraps a user's data key under the current master key, reporting whether it
+// changed. security.UserKeyCipher satisfies it.
+type UserKeyRewrapper interface {
+	RewrapKey(ctx context.Context, userID uuid.UUID, wrapped string) (string, bool, error)
+}
+
+// RotateKYCKeyInput carries the options of a KYC master key rotation.
+type RotateKYCKeyInput struct {
+	DestructiveOptions
+	Actor string
+}
+
+// RotateKYCKeyUseCase re-wraps every per-user KYC data key under the current master key. The
+// personal data itself is not re-encrypted. Keys already under the current master are skipped, so
+// an interrupted rotation can be rerun.
+type RotateKYCKeyUseCase struct {
+	keys   UserDataKeyStore
+	cipher UserKeyRewrapper
+	tokens *ConfirmationTokens
+	audit  AuditLogger
+	logger *slog.Logger
+}
+
+// NewRotateKYCKeyUseCase constructs the use case. Without tokens only dry runs are allowed.
+func NewRotateKYCKeyUseCase(keys UserDataKeyStore, cipher UserKeyRewrapper, tokens *ConfirmationTokens, auditLogger AuditLogger, logger *slog.Logger) *RotateKYCKeyUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RotateKYCKeyUseCase{
+		keys:   keys,
+		cipher: cipher,
+		tokens: tokens,
+		audit:  auditLogger,
+		logger: logger,
+	}
+}
+
+// Execute re-wraps every key readable with one of the configured master keys. Keys readable with
+// none of them are reported as failures and left untouched.
+func (uc *RotateKYCKeyUseCase) Execute(ctx context.Context, input RotateKYCKeyInput) (dto.AdminOperationResult, error) {
+	if uc.keys == nil || uc.cipher == nil {
+		return dto.AdminOperationResult{}, errors.New("admin: user data key store and cipher are required")
+	}
+
+	failures := make(map[string]string)
+	pending, err := uc.collect(ctx, failures)
+	if err != nil {
+		return dto.AdminOperationResult{}, err
+	}
+
+	ids := make([]string, 0, len(pending))
+	for _, target := range pending {
+		ids = append(ids, target.id)
+	}
+
+	result, proceed, err := confirmOperation(uc.tokens, input.DestructiveOptions, operationPlan{
+		Operation:   OperationRotateKYCKey,
+		Actor:       input.Actor,
+		AffectedIDs: ids,
+	})
+	if err != nil {
+		return dto.AdminOperationResult{}, err
+	}
+	if len(failures) > 0 {
+		result.Failures = failures
+	}
+	if !proceed {
+		return result, nil
+	}
+
+	rotated := make([]string, 0, len(pending))
+	for _, target := range pending {
+		if err := target.replace(ctx, target.current, target.rewrapped); err != nil {
+			if result.Failures == nil {
+				result.Failures = make(map[string]string)
+			}
+			result.Failures[target.id] = err.Error()
+			continue
+		}
+		rotated = append(rotated, target.id)
+	}
+	result.AffectedIDs = rotated
+	result.AffectedCount = len(rotated)
+
+	if uc.audit != nil {
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID: input.Actor,
+			Action:  AuditActionKYCKeyRotated,
+			Metadata: map[string]any{
+				"rotated": len(rotated),
+				"failed":  len(result.Failures),
+			},
+		}); err != nil {
+			uc.logger.Warn("failed to record audit entry", slog.String("action", AuditActionKYCKeyRotated), slog.String("error", err.Error()))
+		}
+	}
+
+	uc.logger.Info("kyc data keys re-wrapped",
+		slog.Int("count", len(rotated)),
+		slog.Int("failed", len(result.Failures)),
+		slog.String("actor", input.Actor))
+
+	return result, nil
+}
+
+func (uc *RotateKYCKeyUseCase) collect(ctx context.Context, failures map[string]string) ([]rewrapTarget, error) {
+	pending := make([]rewrapTarget, 0)
+	after := uuid.Nil
+	for {
+		page, err := uc.keys.List(ctx, after, walletKeyRotationPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range page {
+			userID := key.UserID
+			id := "user_data_key:" + userID.String()
+			rewrapped, changed, err := uc.cipher.RewrapKey(ctx, userID, key.WrappedKey)
+			if err != nil {
+				failures[id] = "data key is not readable with any configured master key"
+				continue
+			}
+			if !changed {
+				continue
+			}
+			pending = append(pending, rewrapTarget{
+				id:        id,
+				current:   key.WrappedKey,
+				rewrapped: rewrapped,
+				replace: func(ctx context.Context, current, replacement string) error {
+					return uc.keys.Replace(ctx, userID, current, replacement)
+				},
+			})
+		}
+		if len(page) < walletKeyRotationPageSize {
+			return pending, nil
+		}
+		after = page[len(page)-1].UserID
+	}
+}
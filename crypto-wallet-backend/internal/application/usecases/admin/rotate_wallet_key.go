@@ -0,0 +1,222 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+)
+
+// AuditActionWalletKeyRotated is recorded when wallet data keys are re-wrapped under a new master key.
+const AuditActionWalletKeyRotated = "admin.wallet_key_rotated"
+
+const walletKeyRotationPageSize = 500
+
+// WalletKeyStore pages through wallets with stored private keys and replaces their ciphertexts.
+type WalletKeyStore interface {
+	ListWithEncryptedKeys(ctx context.Context, after uuid.UUID, limit int) ([]entities.Wallet, error)
+	ReplaceEncryptedKey(ctx context.Context, id uuid.UUID, current, replacement string) error
+}
+
+// SeedKeyStore pages through HD wallet seeds and replaces their ciphertexts.
+type SeedKeyStore interface {
+	List(ctx context.Context, after uuid.UUID, limit int) ([]entities.HDSeed, error)
+	ReplaceEncryptedSeed(ctx context.Context, userID uuid.UUID, current, replacement string) error
+}
+
+// KeyRewrapper re-wraps an envelope ciphertext's data key under the primary master key, reporting
+// whether it changed.
+type KeyRewrapper interface {
+	Rewrap(ctx context.Context, payload string, additionalData []byte) (string, bool, error)
+}
+
+// RotateWalletKeyInput carries the options of a master key rotation.
+type RotateWalletKeyInput struct {
+	DestructiveOptions
+	Actor string
+}
+
+// rewrapTarget is one stored secret whose data key is not yet wrapped by the primary master key.
+type rewrapTarget struct {
+	id        string
+	current   string
+	rewrapped string
+	replace   func(ctx context.Context, current, replacement string) error
+}
+
+// RotateWalletKeyUseCase re-wraps the data keys of wallet private keys and HD seeds under the
+// primary master key. Only the wrapped data keys change; secrets stored before envelope
+// encryption are sealed into envelopes on the way. Secrets already under the primary key are
+// skipped, so an interrupted rotation can be rerun.
+type RotateWalletKeyUseCase struct {
+	wallets WalletKeyStore
+	seeds   SeedKeyStore
+	cipher  KeyRewrapper
+	tokens  *ConfirmationTokens
+	audit   AuditLogger
+	logger  *slog.Logger
+}
+
+// NewRotateWalletKeyUseCase constructs the use case. Without tokens only dry runs are allowed.
+func NewRotateWalletKeyUseCase(wallets WalletKeyStore, seeds SeedKeyStore, cipher KeyRewrapper, tokens *ConfirmationTokens, auditLogger AuditLogger, logger *slog.Logger) *RotateWalletKeyUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RotateWalletKeyUseCase{
+		wallets: wallets,
+		seeds:   seeds,
+		cipher:  cipher,
+		tokens:  tokens,
+		audit:   auditLogger,
+		logger:  logger,
+	}
+}
+
+// Execute re-wraps every secret readable with one of the configured master keys. Secrets readable
+// with none of them are reported as failures and left untouched.
+func (uc *RotateWalletKeyUseCase) Execute(ctx context.Context, input RotateWalletKeyInput) (dto.AdminOperationResult, error) {
+	if uc.wallets == nil || uc.seeds == nil || uc.cipher == nil {
+		return dto.AdminOperationResult{}, errors.New("admin: wallet key stores and cipher are required")
+	}
+
+	failures := make(map[string]string)
+	pending, err := uc.collectWallets(ctx, failures)
+	if err != nil {
+		return dto.AdminOperationResult{}, err
+	}
+	seeds, err := uc.collectSeeds(ctx, failures)
+	if err != nil {
+		return dto.AdminOperationResult{}, err
+	}
+	pending = append(pending, seeds...)
+
+	ids := make([]string, 0, len(pending))
+	for _, target := range pending {
+		ids = append(ids, target.id)
+	}
+
+	result, proceed, err := confirmOperation(uc.tokens, input.DestructiveOptions, operationPlan{
+		Operation:   OperationRotateWalletKey,
+		Actor:       input.Actor,
+		AffectedIDs: ids,
+	})
+	if err != nil {
+		return dto.AdminOperationResult{}, err
+	}
+	if len(failures) > 0 {
+		result.Failures = failures
+	}
+	if !proceed {
+		return result, nil
+	}
+
+	rotated := make([]string, 0, len(pending))
+	for _, target := range pending {
+		if err := target.replace(ctx, target.current, target.rewrapped); err != nil {
+			if result.Failures == nil {
+				result.Failures = make(map[string]string)
+			}
+			result.Failures[target.id] = err.Error()
+			continue
+		}
+		rotated = append(rotated, target.id)
+	}
+	result.AffectedIDs = rotated
+	result.AffectedCount = len(rotated)
+
+	if uc.audit != nil {
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID: input.Actor,
+			Action:  AuditActionWalletKeyRotated,
+			Metadata: map[string]any{
+				"rotated": len(rotated),
+				"failed":  len(result.Failures),
+			},
+		}); err != nil {
+			uc.logger.Warn("failed to record audit entry", slog.String("action", AuditActionWalletKeyRotated), slog.String("error", err.Error()))
+		}
+	}
+
+	uc.logger.Info("wallet data keys re-wrapped",
+		slog.Int("count", len(rotated)),
+		slog.Int("failed", len(result.Failures)),
+		slog.String("actor", input.Actor))
+
+	return result, nil
+}
+
+func (uc *RotateWalletKeyUseCase) collectWallets(ctx context.Context, failures map[string]string) ([]rewrapTarget, error) {
+	pending := make([]rewrapTarget, 0)
+	after := uuid.Nil
+	for {
+		page, err := uc.wallets.ListWithEncryptedKeys(ctx, after, walletKeyRotationPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, wallet := range page {
+			walletID := wallet.GetID()
+			id := "wallet:" + walletID.String()
+			current := wallet.GetEncryptedPrivateKey()
+			rewrapped, changed, err := uc.cipher.Rewrap(ctx, current, []byte(wallet.GetAddress()))
+			if err != nil {
+				failures[id] = "private key is not readable with any configured master key"
+				continue
+			}
+			if !changed {
+				continue
+			}
+			pending = append(pending, rewrapTarget{
+				id:        id,
+				current:   current,
+				rewrapped: rewrapped,
+				replace: func(ctx context.Context, current, replacement string) error {
+					return uc.wallets.ReplaceEncryptedKey(ctx, walletID, current, replacement)
+				},
+			})
+		}
+		if len(page) < walletKeyRotationPageSize {
+			return pending, nil
+		}
+		after = page[len(page)-1].GetID()
+	}
+}
+
+func (uc *RotateWalletKeyUseCase) collectSeeds(ctx context.Context, failures map[string]string) ([]rewrapTarget, error) {
+	pending := make([]rewrapTarget, 0)
+	after := uuid.Nil
+	for {
+		page, err := uc.seeds.List(ctx, after, walletKeyRotationPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, seed := range page {
+			userID := seed.UserID
+			id := "hd_seed:" + userID.String()
+			rewrapped, changed, err := uc.cipher.Rewrap(ctx, seed.EncryptedSeed, entities.HDSeedAssociatedData(userID))
+			if err != nil {
+				failures[id] = "seed is not readable with any configured master key"
+				continue
+			}
+			if !changed {
+				continue
+			}
+			pending = append(pending, rewrapTarget{
+				id:        id,
+				current:   seed.EncryptedSeed,
+				rewrapped: rewrapped,
+				replace: func(ctx context.Context, current, replacement string) error {
+					return uc.seeds.ReplaceEncryptedSeed(ctx, userID, current, replacement)
+				},
+			})
+		}
+		if len(page) < walletKeyRotationPageSize {
+			return pending, nil
+		}
+		after = page[len(page)-1].UserID
+	}
+}
@@ -0,0 +1,146 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+)
+
+// AuditActionWebhookKeyRotated is recorded when webhook secrets are re-encrypted under a new key.
+const AuditActionWebhookKeyRotated = "admin.webhook_key_rotated"
+
+// WebhookSecretStore lists endpoints and replaces their encrypted signing secrets.
+type WebhookSecretStore interface {
+	ListAll(ctx context.Context) ([]entities.WebhookEndpoint, error)
+	UpdateSecret(ctx context.Context, id uuid.UUID, encryptedSecret string) error
+}
+
+// SecretCipher encrypts and decrypts stored secrets.
+type SecretCipher interface {
+	EncryptToString(plaintext, additionalData []byte) (string, error)
+	DecryptString(payload string, additionalData []byte) ([]byte, error)
+}
+
+// RotateWebhookKeyInput carries the options of a key rotation.
+type RotateWebhookKeyInput struct {
+	DestructiveOptions
+	Actor string
+}
+
+// RotateWebhookKeyUseCase re-encrypts every webhook signing secret from the current key to the next
+// one. Endpoints already readable with the next key are skipped, so an interrupted rotation can be
+// rerun; the server must be switched to the next key once the rotation completes.
+type RotateWebhookKeyUseCase struct {
+	endpoints WebhookSecretStore
+	current   SecretCipher
+	next      SecretCipher
+	tokens    *ConfirmationTokens
+	audit     AuditLogger
+	logger    *slog.Logger
+}
+
+// NewRotateWebhookKeyUseCase constructs the use case. Without tokens only dry runs are allowed.
+func NewRotateWebhookKeyUseCase(endpoints WebhookSecretStore, current, next SecretCipher, tokens *ConfirmationTokens, auditLogger AuditLogger, logger *slog.Logger) *RotateWebhookKeyUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RotateWebhookKeyUseCase{
+		endpoints: endpoints,
+		current:   current,
+		next:      next,
+		tokens:    tokens,
+		audit:     auditLogger,
+		logger:    logger,
+	}
+}
+
+// Execute re-encrypts the secrets readable with the current key. Endpoints readable with neither
+// key are reported as failures and left untouched.
+func (uc *RotateWebhookKeyUseCase) Execute(ctx context.Context, input RotateWebhookKeyInput) (dto.AdminOperationResult, error) {
+	if uc.current == nil || uc.next == nil {
+		return dto.AdminOperationResult{}, errors.New("admin: current and next webhook keys are required")
+	}
+
+	endpoints, err := uc.endpoints.ListAll(ctx)
+	if err != nil {
+		return dto.AdminOperationResult{}, err
+	}
+
+	pending := make([]entities.WebhookEndpoint, 0, len(endpoints))
+	secrets := make(map[uuid.UUID][]byte, len(endpoints))
+	ids := make([]string, 0, len(endpoints))
+	failures := make(map[string]string)
+	for _, endpoint := range endpoints {
+		aad := []byte(endpoint.GetID().String())
+		if _, err := uc.next.DecryptString(endpoint.GetEncryptedSecret(), aad); err == nil {
+			continue
+		}
+		plain, err := uc.current.DecryptString(endpoint.GetEncryptedSecret(), aad)
+		if err != nil {
+			failures[endpoint.GetID().String()] = "secret is not readable with the current or next key"
+			continue
+		}
+		pending = append(pending, endpoint)
+		secrets[endpoint.GetID()] = plain
+		ids = append(ids, endpoint.GetID().String())
+	}
+
+	result, proceed, err := confirmOperation(uc.tokens, input.DestructiveOptions, operationPlan{
+		Operation:   OperationRotateWebhookKey,
+		Actor:       input.Actor,
+		AffectedIDs: ids,
+	})
+	if err != nil {
+		return dto.AdminOperationResult{}, err
+	}
+	if len(failures) > 0 {
+		result.Failures = failures
+	}
+	if !proceed {
+		return result, nil
+	}
+
+	rotated := make([]string, 0, len(pending))
+	for _, endpoint := range pending {
+		encrypted, err := uc.next.EncryptToString(secrets[endpoint.GetID()], []byte(endpoint.GetID().String()))
+		if err == nil {
+			err = uc.endpoints.UpdateSecret(ctx, endpoint.GetID(), encrypted)
+		}
+		if err != nil {
+			if result.Failures == nil {
+				result.Failures = make(map[string]string)
+			}
+			result.Failures[endpoint.GetID().String()] = err.Error()
+			continue
+		}
+		rotated = append(rotated, endpoint.GetID().String())
+	}
+	result.AffectedIDs = rotated
+	result.AffectedCount = len(rotated)
+
+	if uc.audit != nil {
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID: input.Actor,
+			Action:  AuditActionWebhookKeyRotated,
+			Metadata: map[string]any{
+				"rotated": len(rotated),
+				"failed":  len(result.Failures),
+			},
+		}); err != nil {
+			uc.logger.Warn("failed to record audit entry", slog.String("action", AuditActionWebhookKeyRotated), slog.String("error", err.Error()))
+		}
+	}
+
+	uc.logger.Info("webhook secrets re-encrypted",
+		slog.Int("count", len(rotated)),
+		slog.Int("failed", len(result.Failures)),
+		slog.String("actor", input.Actor))
+
+	return result, nil
+}
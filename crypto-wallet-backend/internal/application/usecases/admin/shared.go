@@ -0,0 +1,88 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// AuditLogger captures audit events for compliance.
+type AuditLogger interface {
+	Record(ctx context.Context, entry audit.Entry) error
+}
+
+// UserRepo exposes the user lookups operators need.
+type UserRepo interface {
+	GetByID(ctx context.Context, id uuid.UUID) (entities.User, error)
+	GetByEmail(ctx context.Context, email string) (entities.User, error)
+}
+
+// WalletRepo exposes wallet reads and updates used by operational tasks.
+type WalletRepo interface {
+	GetByID(ctx context.Context, id uuid.UUID) (entities.Wallet, error)
+	ListByUser(ctx context.Context, userID uuid.UUID, filter repositories.WalletFilter, opts repositories.ListOptions) ([]entities.Wallet, error)
+	Update(ctx context.Context, wallet entities.Wallet) error
+}
+
+// KYCProfileReader exposes the KYC profile lookup used when inspecting a user.
+type KYCProfileReader interface {
+	GetProfileByUserID(ctx context.Context, userID uuid.UUID) (entities.KYCProfile, error)
+}
+
+// maxWalletsPerUser bounds wallet listings; users hold a handful of wallets in practice.
+const maxWalletsPerUser = 500
+
+func listUserWallets(ctx context.Context, wallets WalletRepo, userID uuid.UUID) ([]entities.Wallet, error) {
+	return wallets.ListByUser(ctx, userID, repositories.WalletFilter{}, repositories.ListOptions{Limit: maxWalletsPerUser})
+}
+
+func mapWallet(wallet entities.Wallet) dto.Wallet {
+	var balanceUpdated *time.Time
+	if at := wallet.GetBalanceUpdatedAt(); at != nil {
+		value := at.UTC()
+		balanceUpdated = &value
+	}
+
+	return dto.Wallet{
+		ID:               wallet.GetID(),
+		Chain:            string(wallet.GetChain()),
+		Address:          wallet.GetAddress(),
+		Label:            wallet.GetLabel(),
+		Balance:          wallet.GetBalance().String(),
+		Status:           string(wallet.GetStatus()),
+		CreatedAt:        wallet.GetCreatedAt().UTC(),
+		UpdatedAt:        wallet.GetUpdatedAt().UTC(),
+		BalanceUpdatedAt: balanceUpdated,
+	}
+}
+
+func wrapValidationError(errs utils.ValidationErrors) error {
+	if errs.IsEmpty() {
+		return nil
+	}
+	return utils.NewAppError(
+		"VALIDATION_ERROR",
+		"admin request invalid",
+		fiber.StatusBadRequest,
+		errs,
+		map[string]any{"errors": errs},
+	)
+}
+
+func notFoundError(resource string, err error) error {
+	return utils.NewAppError(
+		"NOT_FOUND",
+		resource+" not found",
+		fiber.StatusNotFound,
+		err,
+		nil,
+	)
+}
@@ -0,0 +1,262 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/domain/services"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+const (
+	defaultSimulationDays = 30
+	maxSimulationDays     = 90
+	// maxSimulationSwaps bounds how many swaps one simulation replays; results over a busier
+	// window are reported as truncated.
+	maxSimulationSwaps = 50000
+)
+
+var (
+	basisPointsPerUnit = decimal.NewFromInt(10000)
+	hundred            = decimal.NewFromInt(100)
+)
+
+// ExecutedExchangeReader lists the completed swaps of a trading pair.
+type ExecutedExchangeReader interface {
+	GetTradingPair(ctx context.Context, pairID uuid.UUID) (entities.TradingPair, error)
+	ListExecutedExchanges(ctx context.Context, filter repositories.ExecutedExchangeFilter, limit int) ([]entities.ExchangeOperation, error)
+}
+
+// SimulatePricingInput proposes a fee percentage and/or spread in basis points for a trading pair.
+type SimulatePricingInput struct {
+	PairID  uuid.UUID
+	Payload dto.AdminPricingSimulationRequest
+	Actor   string
+}
+
+// SimulatePricingUseCase replays a trading pair's recent swaps against proposed fee and spread
+// parameters. It only reads executed swaps; neither the pair nor the pricing configuration changes.
+type SimulatePricingUseCase struct {
+	exchanges ExecutedExchangeReader
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+// NewSimulatePricingUseCase constructs the use case.
+func NewSimulatePricingUseCase(exchanges ExecutedExchangeReader, logger *slog.Logger) *SimulatePricingUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SimulatePricingUseCase{
+		exchanges: exchanges,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+// Execute re-prices every swap the pair completed in the last Days days. Each swap keeps its amount
+// and the mid rate recorded on its quote; a proposed spread replaces the spread it was quoted at and a
+// proposed fee replaces its fee, less any tier discount the user received.
+func (uc *SimulatePricingUseCase) Execute(ctx context.Context, input SimulatePricingInput) (dto.AdminPricingSimulationResult, error) {
+	payload := input.Payload
+
+	var errs utils.ValidationErrors
+	if input.PairID == uuid.Nil {
+		errs.Add("pair_id", "a trading pair is required")
+	}
+	days := payload.Days
+	if days == 0 {
+		days = defaultSimulationDays
+	}
+	if days < 1 || days > maxSimulationDays {
+		errs.Add("days", "must be between 1 and 90")
+	}
+	feePercentage, feeSet, ok := parseProposal(payload.FeePercentage, hundred)
+	if !ok {
+		errs.Add("fee_percentage", "must be a number from 0 up to 100")
+	}
+	spreadBps, spreadSet, ok := parseProposal(payload.SpreadBps, basisPointsPerUnit)
+	if !ok {
+		errs.Add("spread_bps", "must be a number from 0 up to 10000")
+	}
+	if !feeSet && !spreadSet && errs.IsEmpty() {
+		errs.Add("fee_percentage", "propose a fee percentage, a spread, or both")
+	}
+	if err := wrapValidationError(errs); err != nil {
+		return dto.AdminPricingSimulationResult{}, err
+	}
+
+	pair, err := uc.exchanges.GetTradingPair(ctx, input.PairID)
+	if err != nil {
+		if errors.Is(err, services.ErrExchangeInvalidTradingPair) {
+			return dto.AdminPricingSimulationResult{}, notFoundError("trading pair", err)
+		}
+		return dto.AdminPricingSimulationResult{}, err
+	}
+	baseAssetID, quoteAssetID := pair.GetBaseAssetID(), pair.GetQuoteAssetID()
+	if baseAssetID == uuid.Nil || quoteAssetID == uuid.Nil {
+		errs.Add("pair_id", "trading pair is not linked to registry assets")
+		return dto.AdminPricingSimulationResult{}, wrapValidationError(errs)
+	}
+
+	windowEnd := uc.now().UTC()
+	windowStart := windowEnd.AddDate(0, 0, -days)
+	swaps, err := uc.exchanges.ListExecutedExchanges(ctx, repositories.ExecutedExchangeFilter{
+		BaseAssetID:  &baseAssetID,
+		QuoteAssetID: &quoteAssetID,
+		ExecutedFrom: &windowStart,
+		ExecutedTo:   &windowEnd,
+	}, maxSimulationSwaps)
+	if err != nil {
+		return dto.AdminPricingSimulationResult{}, err
+	}
+
+	var current, projected pricingTotals
+	volume := decimal.Zero
+	withoutMidRate := 0
+	for _, swap := range swaps {
+		fromAmount := swap.GetFromAmount()
+		metadata := swap.GetPricingMetadata()
+		volume = volume.Add(fromAmount)
+
+		mid, ok := metadataDecimal(metadata, "mid_rate")
+		if !ok || !mid.IsPositive() {
+			// Quotes priced before strategies recorded their inputs are replayed at their executed rate.
+			mid = swap.GetExchangeRate()
+			withoutMidRate++
+		}
+		current.add(fromAmount, mid, swap.GetFeeAmount(), swap.GetExchangeRate(), swap.GetToAmount())
+
+		rate := swap.GetExchangeRate()
+		if spreadSet {
+			rate = mid.Mul(decimal.NewFromInt(1).Sub(spreadBps.Div(basisPointsPerUnit)))
+		}
+		fee := swap.GetFeePercentage()
+		if feeSet {
+			fee = feePercentage
+			if discount, ok := metadataDecimal(metadata, "tier_discount"); ok && discount.IsPositive() && discount.LessThanOrEqual(decimal.NewFromInt(1)) {
+				fee = fee.Mul(decimal.NewFromInt(1).Sub(discount))
+			}
+		}
+		feeAmount := fee.Div(hundred).Mul(fromAmount)
+		projected.add(fromAmount, mid, feeAmount, rate, fromAmount.Sub(feeAmount).Mul(rate))
+	}
+
+	result := dto.AdminPricingSimulationResult{
+		PairID:         pair.GetID(),
+		Pair:           pair.GetBaseSymbol() + "/" + pair.GetQuoteSymbol(),
+		WindowStart:    windowStart,
+		WindowEnd:      windowEnd,
+		Days:           days,
+		Swaps:          len(swaps),
+		Volume:         volume.String(),
+		WithoutMidRate: withoutMidRate,
+		Truncated:      len(swaps) == maxSimulationSwaps,
+		Current:        current.dto(),
+		Projected:      projected.dto(),
+		Delta:          projected.deltaDTO(current),
+	}
+	if feeSet {
+		result.FeePercentage = feePercentage.String()
+	}
+	if spreadSet {
+		result.SpreadBps = spreadBps.String()
+	}
+
+	uc.logger.Info("pricing simulated",
+		slog.String("pair_id", input.PairID.String()),
+		slog.Int("days", days),
+		slog.Int("swaps", len(swaps)),
+		slog.String("actor", input.Actor))
+
+	return result, nil
+}
+
+// pricingTotals sums what swaps earned and cost. Fee revenue is in the base asset; spread revenue and
+// user cost are in the quote asset. User cost is what users received below the mid rate, so it covers
+// the fee converted at mid as well as the spread.
+type pricingTotals struct {
+	feeRevenue    decimal.Decimal
+	spreadRevenue decimal.Decimal
+	userCost      decimal.Decimal
+	midValue      decimal.Decimal
+	toAmount      decimal.Decimal
+}
+
+func (t *pricingTotals) add(fromAmount, mid, feeAmount, rate, toAmount decimal.Decimal) {
+	midValue := fromAmount.Mul(mid)
+	t.feeRevenue = t.feeRevenue.Add(feeAmount)
+	t.spreadRevenue = t.spreadRevenue.Add(fromAmount.Sub(feeAmount).Mul(mid.Sub(rate)))
+	t.userCost = t.userCost.Add(midValue.Sub(toAmount))
+	t.midValue = t.midValue.Add(midValue)
+	t.toAmount = t.toAmount.Add(toAmount)
+}
+
+func (t pricingTotals) sub(other pricingTotals) pricingTotals {
+	return pricingTotals{
+		feeRevenue:    t.feeRevenue.Sub(other.feeRevenue),
+		spreadRevenue: t.spreadRevenue.Sub(other.spreadRevenue),
+		userCost:      t.userCost.Sub(other.userCost),
+		midValue:      t.midValue.Sub(other.midValue),
+		toAmount:      t.toAmount.Sub(other.toAmount),
+	}
+}
+
+// costBps is the user cost relative to the swaps' value at mid.
+func (t pricingTotals) costBps() decimal.Decimal {
+	if t.midValue.IsZero() {
+		return decimal.Zero
+	}
+	return t.userCost.Div(t.midValue).Mul(basisPointsPerUnit)
+}
+
+func (t pricingTotals) dto() dto.AdminPricingTotals {
+	return dto.AdminPricingTotals{
+		FeeRevenue:    t.feeRevenue.String(),
+		SpreadRevenue: t.spreadRevenue.String(),
+		UserCost:      t.userCost.String(),
+		UserCostBps:   t.costBps().StringFixed(2),
+		ToAmount:      t.toAmount.String(),
+	}
+}
+
+// deltaDTO reports t less base; its user cost in basis points is the change in the average cost.
+func (t pricingTotals) deltaDTO(base pricingTotals) dto.AdminPricingTotals {
+	delta := t.sub(base).dto()
+	delta.UserCostBps = t.costBps().Sub(base.costBps()).StringFixed(2)
+	return delta
+}
+
+// parseProposal parses an optional non-negative value below limit; set reports whether one was given.
+func parseProposal(raw string, limit decimal.Decimal) (value decimal.Decimal, set, ok bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return decimal.Zero, false, true
+	}
+	value, err := decimal.NewFromString(raw)
+	if err != nil || value.IsNegative() || value.GreaterThanOrEqual(limit) {
+		return decimal.Zero, true, false
+	}
+	return value, true, true
+}
+
+func metadataDecimal(metadata map[string]string, key string) (decimal.Decimal, bool) {
+	raw, ok := metadata[key]
+	if !ok {
+		return decimal.Zero, false
+	}
+	value, err := decimal.NewFromString(raw)
+	if err != nil {
+		return decimal.Zero, false
+	}
+	return value, true
+}
@@ -0,0 +1,233 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/services"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// AuditActionLiquidityToppedUp is recorded when staff add to a trading pair's liquidity reserve.
+const AuditActionLiquidityToppedUp = "admin.trading_pair_liquidity_topped_up"
+
+// liquidityEntryLimit bounds the ledger entries reported for one pair.
+const liquidityEntryLimit = 50
+
+// LiquidityManager reads and tops up trading pair liquidity ledgers.
+type LiquidityManager interface {
+	GetTradingPair(ctx context.Context, pairID uuid.UUID) (entities.TradingPair, error)
+	ListLiquidity(ctx context.Context) ([]entities.TradingPairLiquidity, error)
+	GetLiquidity(ctx context.Context, pairID uuid.UUID, entryLimit int) (entities.TradingPairLiquidity, []entities.LiquidityEntry, error)
+	TopUpLiquidity(ctx context.Context, input services.LiquidityTopUpInput) (entities.TradingPairLiquidity, entities.TradingPair, error)
+}
+
+// ListLiquidityUseCase reports the liquidity ledger of every liquidity-managed trading pair.
+type ListLiquidityUseCase struct {
+	liquidity LiquidityManager
+}
+
+// NewListLiquidityUseCase constructs the use case.
+func NewListLiquidityUseCase(liquidity LiquidityManager) *ListLiquidityUseCase {
+	return &ListLiquidityUseCase{liquidity: liquidity}
+}
+
+// Execute returns every ledger with its pair's symbols and liquidity flag. Ledgers whose pair has
+// since been removed are reported without them.
+func (uc *ListLiquidityUseCase) Execute(ctx context.Context) ([]dto.AdminTradingPairLiquidity, error) {
+	ledgers, err := uc.liquidity.ListLiquidity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dto.AdminTradingPairLiquidity, 0, len(ledgers))
+	for _, ledger := range ledgers {
+		pair, err := uc.liquidity.GetTradingPair(ctx, ledger.PairID)
+		if err != nil && !errors.Is(err, services.ErrExchangeInvalidTradingPair) {
+			return nil, err
+		}
+		result = append(result, mapLiquidity(ledger, pair))
+	}
+	return result, nil
+}
+
+// GetLiquidityUseCase reports one trading pair's liquidity ledger with its latest entries.
+type GetLiquidityUseCase struct {
+	liquidity LiquidityManager
+}
+
+// NewGetLiquidityUseCase constructs the use case.
+func NewGetLiquidityUseCase(liquidity LiquidityManager) *GetLiquidityUseCase {
+	return &GetLiquidityUseCase{liquidity: liquidity}
+}
+
+// Execute returns the pair's ledger and up to 50 of its latest entries, newest first.
+func (uc *GetLiquidityUseCase) Execute(ctx context.Context, pairID uuid.UUID) (dto.AdminTradingPairLiquidity, error) {
+	pair, err := uc.liquidity.GetTradingPair(ctx, pairID)
+	if err != nil {
+		if errors.Is(err, services.ErrExchangeInvalidTradingPair) {
+			return dto.AdminTradingPairLiquidity{}, notFoundError("trading pair", err)
+		}
+		return dto.AdminTradingPairLiquidity{}, err
+	}
+
+	ledger, entries, err := uc.liquidity.GetLiquidity(ctx, pairID, liquidityEntryLimit)
+	if err != nil {
+		if errors.Is(err, services.ErrExchangeLiquidityNotFound) {
+			return dto.AdminTradingPairLiquidity{}, notFoundError("liquidity ledger", err)
+		}
+		return dto.AdminTradingPairLiquidity{}, err
+	}
+
+	result := mapLiquidity(ledger, pair)
+	result.Entries = make([]dto.AdminLiquidityEntry, 0, len(entries))
+	for _, entry := range entries {
+		result.Entries = append(result.Entries, mapLiquidityEntry(entry))
+	}
+	return result, nil
+}
+
+// TopUpLiquidityInput adds to a trading pair's liquidity reserve.
+type TopUpLiquidityInput struct {
+	PairID  uuid.UUID
+	Payload dto.AdminLiquidityTopUpRequest
+	Actor   string
+}
+
+// TopUpLiquidityUseCase adds to a trading pair's liquidity reserve. The first top-up creates the
+// pair's ledger, after which its exchanges are paid out of the reserve, and a pair that stopped
+// quoting because its reserve ran low resumes once the top-up covers its threshold.
+type TopUpLiquidityUseCase struct {
+	liquidity LiquidityManager
+	audit     AuditLogger
+	logger    *slog.Logger
+}
+
+// NewTopUpLiquidityUseCase constructs the use case.
+func NewTopUpLiquidityUseCase(liquidity LiquidityManager, auditLogger AuditLogger, logger *slog.Logger) *TopUpLiquidityUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &TopUpLiquidityUseCase{
+		liquidity: liquidity,
+		audit:     auditLogger,
+		logger:    logger,
+	}
+}
+
+// Execute validates the top-up, applies it and records it in the audit log.
+func (uc *TopUpLiquidityUseCase) Execute(ctx context.Context, input TopUpLiquidityInput) (dto.AdminTradingPairLiquidity, error) {
+	payload := input.Payload
+	note := strings.TrimSpace(payload.Note)
+
+	var errs utils.ValidationErrors
+	if input.PairID == uuid.Nil {
+		errs.Add("pair_id", "a trading pair is required")
+	}
+	amount, err := decimal.NewFromString(strings.TrimSpace(payload.Amount))
+	if err != nil || !amount.IsPositive() {
+		errs.Add("amount", "must be a positive number")
+	}
+	var threshold *decimal.Decimal
+	if raw := strings.TrimSpace(payload.LowThreshold); raw != "" {
+		value, err := decimal.NewFromString(raw)
+		if err != nil || value.IsNegative() {
+			errs.Add("low_threshold", "must be a number from 0")
+		}
+		threshold = &value
+	}
+	if note == "" {
+		errs.Add("note", "a note is required to top up liquidity")
+	}
+	if err := wrapValidationError(errs); err != nil {
+		return dto.AdminTradingPairLiquidity{}, err
+	}
+
+	ledger, pair, err := uc.liquidity.TopUpLiquidity(ctx, services.LiquidityTopUpInput{
+		PairID:       input.PairID,
+		Amount:       amount,
+		LowThreshold: threshold,
+		Actor:        input.Actor,
+		Note:         note,
+	})
+	if err != nil {
+		if errors.Is(err, services.ErrExchangeInvalidTradingPair) {
+			return dto.AdminTradingPairLiquidity{}, notFoundError("trading pair", err)
+		}
+		return dto.AdminTradingPairLiquidity{}, err
+	}
+
+	if uc.audit != nil {
+		metadata := map[string]any{
+			"amount":        amount.String(),
+			"quote_reserve": ledger.QuoteReserve.String(),
+			"note":          note,
+		}
+		if threshold != nil {
+			metadata["low_threshold"] = threshold.String()
+		}
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID:  input.Actor,
+			Action:   AuditActionLiquidityToppedUp,
+			TargetID: input.PairID.String(),
+			Metadata: metadata,
+		}); err != nil {
+			uc.logger.Warn("failed to record audit entry", slog.String("action", AuditActionLiquidityToppedUp), slog.String("error", err.Error()))
+		}
+	}
+
+	uc.logger.Info("trading pair liquidity topped up",
+		slog.String("pair_id", input.PairID.String()),
+		slog.String("amount", amount.String()),
+		slog.String("quote_reserve", ledger.QuoteReserve.String()),
+		slog.Bool("has_liquidity", pair.HasLiquidity()),
+		slog.String("actor", input.Actor))
+
+	return mapLiquidity(ledger, pair), nil
+}
+
+func mapLiquidity(ledger entities.TradingPairLiquidity, pair entities.TradingPair) dto.AdminTradingPairLiquidity {
+	result := dto.AdminTradingPairLiquidity{
+		PairID:       ledger.PairID,
+		QuoteReserve: ledger.QuoteReserve.String(),
+		QuoteHeld:    ledger.QuoteHeld.String(),
+		Available:    ledger.Available().String(),
+		BaseReserve:  ledger.BaseReserve.String(),
+		QuoteFunded:  ledger.QuoteFunded.String(),
+		LowThreshold: ledger.LowThreshold.String(),
+		Utilization:  ledger.Utilization().StringFixed(4),
+		UpdatedAt:    ledger.UpdatedAt.UTC(),
+	}
+	if pair != nil {
+		result.Pair = pair.GetBaseSymbol() + "/" + pair.GetQuoteSymbol()
+		result.HasLiquidity = pair.HasLiquidity()
+	}
+	return result
+}
+
+func mapLiquidityEntry(entry entities.LiquidityEntry) dto.AdminLiquidityEntry {
+	result := dto.AdminLiquidityEntry{
+		ID:           entry.ID,
+		Kind:         string(entry.Kind),
+		QuoteDelta:   entry.QuoteDelta.String(),
+		HeldDelta:    entry.HeldDelta.String(),
+		BaseDelta:    entry.BaseDelta.String(),
+		QuoteReserve: entry.QuoteReserve.String(),
+		Actor:        entry.Actor,
+		Note:         entry.Note,
+		CreatedAt:    entry.CreatedAt.UTC(),
+	}
+	if entry.OperationID != uuid.Nil {
+		operationID := entry.OperationID
+		result.OperationID = &operationID
+	}
+	return result
+}
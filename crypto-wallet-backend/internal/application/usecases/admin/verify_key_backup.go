@@ -0,0 +1,99 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/keybackup"
+)
+
+// VerifyKeyBackupInput carries an archive and the optional keys to check it with. With only the
+// public key the archive's structure, digest and recipient are checked; with the offline private
+// key every segment is decrypted and checked against the manifest. Key material is never returned.
+type VerifyKeyBackupInput struct {
+	Archive               io.Reader
+	RecipientPublicKeyPEM []byte
+	PrivateKeyPEM         []byte
+}
+
+// KeyBackupVerification reports a verified archive and, when the request could be looked up,
+// whether it matches what was recorded at export.
+type KeyBackupVerification struct {
+	keybackup.Report
+	RecordChecked bool   `json:"record_checked"`
+	ExportedBy    string `json:"exported_by,omitempty"`
+}
+
+// VerifyKeyBackupUseCase checks a key backup archive's integrity.
+type VerifyKeyBackupUseCase struct {
+	store  repositories.KeyBackupRepository
+	logger *slog.Logger
+}
+
+// NewVerifyKeyBackupUseCase constructs the use case. Without a store the archive is checked on its
+// own, for example on an offline machine.
+func NewVerifyKeyBackupUseCase(store repositories.KeyBackupRepository, logger *slog.Logger) *VerifyKeyBackupUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &VerifyKeyBackupUseCase{
+		store:  store,
+		logger: logger,
+	}
+}
+
+// Execute verifies the archive and compares it with the recorded export.
+func (uc *VerifyKeyBackupUseCase) Execute(ctx context.Context, input VerifyKeyBackupInput) (KeyBackupVerification, error) {
+	var opts keybackup.VerifyOptions
+	if len(input.RecipientPublicKeyPEM) > 0 {
+		key, err := keybackup.ParsePublicKey(input.RecipientPublicKeyPEM)
+		if err != nil {
+			return KeyBackupVerification{}, err
+		}
+		opts.Recipient = key
+	}
+	if len(input.PrivateKeyPEM) > 0 {
+		key, err := keybackup.ParsePrivateKey(input.PrivateKeyPEM)
+		if err != nil {
+			return KeyBackupVerification{}, err
+		}
+		opts.PrivateKey = key
+	}
+
+	report, err := keybackup.Verify(input.Archive, opts)
+	if err != nil {
+		return KeyBackupVerification{Report: report}, err
+	}
+	verification := KeyBackupVerification{Report: report}
+	if uc.store == nil {
+		return verification, nil
+	}
+
+	request, err := uc.store.GetByID(ctx, report.RequestID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return verification, notFoundError("key backup request", err)
+		}
+		return verification, err
+	}
+	if request.Status != entities.KeyBackupStatusExported {
+		return verification, keyBackupNotExported(request.Status)
+	}
+	if request.ArchiveSHA256 != report.ArchiveSHA256 || request.RecipientFingerprint != report.RecipientFingerprint {
+		return verification, errors.New("key backup: archive does not match the digest recorded at export")
+	}
+	if report.Decrypted && request.WalletCount != report.WalletCount {
+		return verification, errors.New("key backup: wallet count does not match the export record")
+	}
+	verification.RecordChecked = true
+	verification.ExportedBy = request.ExportedBy
+	return verification, nil
+}
+
+func keyBackupNotExported(status entities.KeyBackupStatus) error {
+	return errors.New("key backup: request was not exported (status " + string(status) + ")")
+}
@@ -0,0 +1,129 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/services"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// UserLookup loads the user whose preferred currency applies. repositories.UserRepository satisfies it.
+type UserLookup interface {
+	GetByID(ctx context.Context, id uuid.UUID) (entities.User, error)
+}
+
+// FiatRateProvider resolves USD conversion rates. services.CurrencyConverter satisfies it.
+type FiatRateProvider interface {
+	Rate(ctx context.Context, currency entities.CurrencyCode) (entities.FiatRate, error)
+}
+
+// displayCurrency converts USD valuations into the currency a response is shown in.
+type displayCurrency struct {
+	rate  entities.FiatRate
+	stale bool
+}
+
+func (d displayCurrency) code() string {
+	return string(d.rate.Currency)
+}
+
+// format converts a USD amount and renders it with the currency's precision.
+func (d displayCurrency) format(amountUSD decimal.Decimal) string {
+	return d.rate.FromUSD(amountUSD).StringFixedBank(entities.CurrencyPrecision(d.rate.Currency))
+}
+
+// info describes the rate in responses; nothing is reported for USD.
+func (d displayCurrency) info() *dto.FXRateInfo {
+	if d.rate.Currency == entities.CurrencyUSD {
+		return nil
+	}
+	return dto.NewFXRateInfo(d.rate, d.stale)
+}
+
+// resolveDisplayCurrency picks the currency for a response: the requested override, else the
+// user's preferred currency, else USD. An unknown override is a validation error and a missing
+// rate for it is reported as unavailable; when the preference cannot be honoured the response
+// falls back to USD instead of failing.
+func resolveDisplayCurrency(ctx context.Context, users UserLookup, rates FiatRateProvider, logger *slog.Logger, userID uuid.UUID, override string, now time.Time) (displayCurrency, error) {
+	usd := displayCurrency{rate: entities.USDFiatRate(now)}
+
+	if override != "" {
+		code, ok := entities.ParseCurrencyCode(override)
+		if !ok {
+			return displayCurrency{}, utils.NewAppError(
+				"VALIDATION_ERROR",
+				"unsupported currency",
+				fiber.StatusBadRequest,
+				nil,
+				map[string]any{"currency": override, "supported": entities.SupportedCurrencies()},
+			)
+		}
+		if code == entities.CurrencyUSD {
+			return usd, nil
+		}
+		if rates == nil {
+			return displayCurrency{}, fxUnavailableError(code, services.ErrFiatRateUnavailable)
+		}
+		rate, err := rates.Rate(ctx, code)
+		if err != nil {
+			return displayCurrency{}, fxUnavailableError(code, err)
+		}
+		return displayCurrency{rate: rate, stale: rate.IsStale(now, entities.DefaultFiatRateStaleAfter)}, nil
+	}
+
+	if users == nil || rates == nil {
+		return usd, nil
+	}
+	user, err := users.GetByID(ctx, userID)
+	if err != nil {
+		logger.Warn("failed to load preferred currency; using USD", slog.String("error", err.Error()))
+		return usd, nil
+	}
+	code := user.GetPreferredCurrency()
+	if code == "" || code == entities.CurrencyUSD {
+		return usd, nil
+	}
+	rate, err := rates.Rate(ctx, code)
+	if err != nil {
+		logger.Warn("fiat rate unavailable for preferred currency; using USD",
+			slog.String("currency", string(code)),
+			slog.String("error", err.Error()))
+		return usd, nil
+	}
+	return displayCurrency{rate: rate, stale: rate.IsStale(now, entities.DefaultFiatRateStaleAfter)}, nil
+}
+
+func fxUnavailableError(code entities.CurrencyCode, err error) error {
+	status := fiber.StatusInternalServerError
+	if errors.Is(err, services.ErrFiatRateUnavailable) {
+		status = fiber.StatusServiceUnavailable
+	}
+	return utils.NewAppError(
+		"FX_RATE_UNAVAILABLE",
+		"exchange rate for the requested currency is unavailable",
+		status,
+		err,
+		map[string]any{"currency": string(code)},
+	)
+}
+
+// withFreshnessWarning extends a price freshness warning with a note about a stale fiat rate.
+func (d displayCurrency) withFreshnessWarning(warning string) string {
+	if !d.stale {
+		return warning
+	}
+	note := "exchange rate for " + d.code() + " may be outdated"
+	if warning == "" {
+		return note
+	}
+	return warning + "; " + note
+}
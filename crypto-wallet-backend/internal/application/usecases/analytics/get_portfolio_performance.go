@@ -15,6 +15,7 @@ import (
 	"github.com/crypto-wallet/backend/internal/application/dto"
 	"github.com/crypto-wallet/backend/internal/domain/entities"
 	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/budget"
 	appLogging "github.com/crypto-wallet/backend/internal/infrastructure/logging"
 	"github.com/crypto-wallet/backend/pkg/utils"
 )
@@ -24,6 +25,10 @@ var (
 	errPerformanceRateRepo   = errors.New("portfolio performance: rate repository not configured")
 )
 
+// storedValueMaxAge is how long after its day the latest recorded portfolio value may be before
+// performance is computed from price history instead; values are recorded once a day.
+const storedValueMaxAge = 72 * time.Hour
+
 type periodConfig struct {
 	label    string
 	duration time.Duration
@@ -34,25 +39,40 @@ type periodConfig struct {
 type PortfolioPerformanceUseCase struct {
 	wallets repositories.WalletRepository
 	rates   repositories.RateRepository
+	values  repositories.PortfolioValueRepository
+	users   UserLookup
+	fx      FiatRateProvider
+	budgets *budget.Budgets
 	logger  *slog.Logger
 	now     func() time.Time
 }
 
-// NewPortfolioPerformanceUseCase constructs the use case.
-func NewPortfolioPerformanceUseCase(wallets repositories.WalletRepository, rates repositories.RateRepository, logger *slog.Logger) *PortfolioPerformanceUseCase {
+// NewPortfolioPerformanceUseCase constructs the use case. values may be nil, in which case every
+// period is computed from price history. users and fx may be nil, in which case values are reported
+// in USD unless a currency is requested explicitly. budgets may be nil, which leaves the calculation
+// unbounded.
+func NewPortfolioPerformanceUseCase(wallets repositories.WalletRepository, rates repositories.RateRepository, values repositories.PortfolioValueRepository, users UserLookup, fx FiatRateProvider, budgets *budget.Budgets, logger *slog.Logger) *PortfolioPerformanceUseCase {
 	if logger == nil {
 		logger = slog.Default()
 	}
 	return &PortfolioPerformanceUseCase{
 		wallets: wallets,
 		rates:   rates,
+		values:  values,
+		users:   users,
+		fx:      fx,
+		budgets: budgets,
 		logger:  logger,
 		now:     func() time.Time { return time.Now().UTC() },
 	}
 }
 
-// Execute returns the portfolio performance for the provided user and period identifier.
-func (uc *PortfolioPerformanceUseCase) Execute(ctx context.Context, userID uuid.UUID, period string) (dto.PortfolioPerformance, error) {
+// Execute returns the portfolio performance for the provided user and period identifier. currency
+// overrides the user's preferred display currency when set. Daily and weekly periods are read from
+// the recorded daily values when they cover the period; otherwise the current holdings are valued
+// over price history. When the time budget runs out while price history is loading, the assets not
+// yet loaded contribute only their current value and the result is marked truncated.
+func (uc *PortfolioPerformanceUseCase) Execute(ctx context.Context, userID uuid.UUID, period, currency string) (dto.PortfolioPerformance, error) {
 	if uc.wallets == nil {
 		return dto.PortfolioPerformance{}, errPerformanceWalletRepo
 	}
@@ -74,13 +94,27 @@ func (uc *PortfolioPerformanceUseCase) Execute(ctx context.Context, userID uuid.
 		period = config.label
 	}
 
+	// The budget only truncates the series; requestCtx is checked in the loops below so a canceled
+	// or timed out request stops rather than finishing work nobody will read.
+	requestCtx := ctx
+	ctx, run := uc.budgets.Start(ctx, budget.PortfolioPerformance)
+	defer run.End()
+
 	ctxLogger := appLogging.LoggerFromContext(ctx, uc.logger).With(
 		slog.String("user_id", userID.String()),
 		slog.String("period", period),
 	)
 
+	display, err := resolveDisplayCurrency(ctx, uc.users, uc.fx, ctxLogger, userID, currency, uc.now())
+	if err != nil {
+		return dto.PortfolioPerformance{}, err
+	}
+
 	wallets, err := uc.wallets.ListByUser(ctx, userID, repositories.WalletFilter{}, repositories.ListOptions{Limit: 1000, SortBy: "created_at", SortOrder: repositories.SortDescending})
 	if err != nil {
+		if run.Exhausted() {
+			return dto.PortfolioPerformance{}, budgetExhaustedError(budget.PortfolioPerformance)
+		}
 		ctxLogger.Error("failed to list wallets for portfolio performance", slog.String("error", err.Error()))
         return dto.PortfolioPerformance{}, utils.NewAppError(
             "DATABASE_ERROR",
@@ -92,55 +126,41 @@ func (uc *PortfolioPerformanceUseCase) Execute(ctx context.Context, userID uuid.
 	}
 
 	if len(wallets) == 0 {
-		return dto.PortfolioPerformance{
-			Period:             config.label,
-			InitialValueUSD:    "0.00",
-			FinalValueUSD:      "0.00",
-			GainLossUSD:        "0.00",
-			GainLossPercentage: "0.00",
-			DataPoints: []dto.PortfolioPerformancePoint{
-				{Timestamp: uc.now().Format(time.RFC3339Nano), ValueUSD: "0.00"},
-			},
-		}, nil
+		return uc.emptyPerformance(config.label, display), nil
 	}
 
 	assetBalances := make(map[string]decimal.Decimal)
 	for _, wallet := range wallets {
-		chain := strings.ToUpper(string(wallet.GetChain()))
-		if !entities.IsSupportedSymbol(chain) {
+		symbol := entities.WalletAssetSymbol(wallet)
+		if !entities.IsSupportedSymbol(symbol) {
 			continue
 		}
 		balance := wallet.GetBalance()
 		if balance.IsZero() {
 			continue
 		}
-		if current, ok := assetBalances[chain]; ok {
-			assetBalances[chain] = current.Add(balance)
+		if current, ok := assetBalances[symbol]; ok {
+			assetBalances[symbol] = current.Add(balance)
 		} else {
-			assetBalances[chain] = balance
+			assetBalances[symbol] = balance
 		}
 	}
 
 	if len(assetBalances) == 0 {
-		return dto.PortfolioPerformance{
-			Period:             config.label,
-			InitialValueUSD:    "0.00",
-			FinalValueUSD:      "0.00",
-			GainLossUSD:        "0.00",
-			GainLossPercentage: "0.00",
-			DataPoints: []dto.PortfolioPerformancePoint{
-				{Timestamp: uc.now().Format(time.RFC3339Nano), ValueUSD: "0.00"},
-			},
-		}, nil
+		return uc.emptyPerformance(config.label, display), nil
 	}
 
 	symbols := make([]string, 0, len(assetBalances))
 	for symbol := range assetBalances {
 		symbols = append(symbols, symbol)
 	}
+	sort.Strings(symbols)
 
     rates, err := uc.rates.GetRatesBySymbols(ctx, symbols)
     if err != nil {
+		if run.Exhausted() {
+			return dto.PortfolioPerformance{}, budgetExhaustedError(budget.PortfolioPerformance)
+		}
         ctxLogger.Error("failed to load exchange rates for portfolio performance", slog.String("error", err.Error()))
         return dto.PortfolioPerformance{}, utils.NewAppError(
             "RATE_LOOKUP_FAILED",
@@ -166,40 +186,74 @@ func (uc *PortfolioPerformanceUseCase) Execute(ctx context.Context, userID uuid.
 		fromTime = now.Add(-config.duration)
 	}
 
-	for _, symbol := range symbols {
-		balance := assetBalances[symbol]
-        priceHistory, histErr := uc.loadPriceHistory(ctx, symbol, config.interval, fromTime, now)
-        if histErr != nil {
-            ctxLogger.Warn("failed to load price history", slog.String("symbol", symbol), slog.String("error", histErr.Error()))
-        }
-
-		points := make([]seriesPoint, 0, len(priceHistory)+1)
-		for _, entry := range priceHistory {
-			value := balance.Mul(entry.price)
-			points = append(points, seriesPoint{timestamp: entry.timestamp, value: value})
+	holdingSince := now
+	for _, wallet := range wallets {
+		if created := wallet.GetCreatedAt(); created.Before(holdingSince) {
+			holdingSince = created
 		}
+	}
 
-		rate, ok := rateMap[symbol]
-		if ok && rate != nil {
-			currentValue := balance.Mul(rate.GetPriceUSD())
-			points = append(points, seriesPoint{timestamp: now, value: currentValue})
+	truncated := false
+	if stored, ok := uc.storedSeries(ctx, ctxLogger, userID, config, fromTime, holdingSince, now); ok {
+		current := decimal.Zero
+		for symbol, balance := range assetBalances {
+			if rate, ok := rateMap[symbol]; ok && rate != nil {
+				current = current.Add(balance.Mul(rate.GetPriceUSD()))
+			}
 		}
+		seriesByAsset["portfolio"] = append(stored, seriesPoint{timestamp: now, value: current})
+	} else {
+		for _, symbol := range symbols {
+			if err := requestCtx.Err(); err != nil {
+				return dto.PortfolioPerformance{}, context.Cause(requestCtx)
+			}
+			balance := assetBalances[symbol]
+			var priceHistory []pricePoint
+			if run.Exhausted() {
+				truncated = true
+			} else {
+				history, histErr := uc.loadPriceHistory(ctx, symbol, config.interval, fromTime, now)
+				switch {
+				case histErr == nil:
+					priceHistory = history
+				case run.Exhausted():
+					truncated = true
+				default:
+					ctxLogger.Warn("failed to load price history", slog.String("symbol", symbol), slog.String("error", histErr.Error()))
+				}
+			}
 
-		if len(points) == 0 {
-			// fallback to zero-value point to participate in aggregation
-			points = append(points, seriesPoint{timestamp: now, value: decimal.Zero})
-		}
+			points := make([]seriesPoint, 0, len(priceHistory)+1)
+			for _, entry := range priceHistory {
+				value := balance.Mul(entry.price)
+				points = append(points, seriesPoint{timestamp: entry.timestamp, value: value})
+			}
 
-		sort.Slice(points, func(i, j int) bool {
-			return points[i].timestamp.Before(points[j].timestamp)
-		})
+			rate, ok := rateMap[symbol]
+			if ok && rate != nil {
+				currentValue := balance.Mul(rate.GetPriceUSD())
+				points = append(points, seriesPoint{timestamp: now, value: currentValue})
+			}
 
-		seriesByAsset[symbol] = points
+			if len(points) == 0 {
+				// fallback to zero-value point to participate in aggregation
+				points = append(points, seriesPoint{timestamp: now, value: decimal.Zero})
+			}
+
+			sort.Slice(points, func(i, j int) bool {
+				return points[i].timestamp.Before(points[j].timestamp)
+			})
+
+			seriesByAsset[symbol] = points
+		}
 	}
 
-	dataPoints := aggregateSeries(seriesByAsset)
+	dataPoints, err := aggregateSeries(requestCtx, seriesByAsset, display)
+	if err != nil {
+		return dto.PortfolioPerformance{}, err
+	}
 	if len(dataPoints) == 0 {
-		dataPoints = append(dataPoints, dto.PortfolioPerformancePoint{Timestamp: now.Format(time.RFC3339Nano), ValueUSD: "0.00"})
+		dataPoints = append(dataPoints, dto.PortfolioPerformancePoint{Timestamp: now.Format(time.RFC3339Nano), ValueUSD: "0.00", Value: display.format(decimal.Zero)})
 	}
 
 	initialValue, _ := decimal.NewFromString(dataPoints[0].ValueUSD)
@@ -213,6 +267,8 @@ func (uc *PortfolioPerformanceUseCase) Execute(ctx context.Context, userID uuid.
 	ctxLogger.Info("portfolio performance calculated",
 		slog.String("initial_value_usd", initialValue.StringFixedBank(2)),
 		slog.String("gain_loss_usd", gainLoss.StringFixedBank(2)),
+		slog.String("currency", display.code()),
+		slog.Bool("truncated", truncated),
 	)
 
 	return dto.PortfolioPerformance{
@@ -221,10 +277,35 @@ func (uc *PortfolioPerformanceUseCase) Execute(ctx context.Context, userID uuid.
 		FinalValueUSD:      finalValue.StringFixedBank(2),
 		GainLossUSD:        gainLoss.StringFixedBank(2),
 		GainLossPercentage: gainPercentage.StringFixedBank(2),
+		Currency:           display.code(),
+		InitialValue:       display.format(initialValue),
+		FinalValue:         display.format(finalValue),
+		GainLoss:           display.format(gainLoss),
+		FXRate:             display.info(),
 		DataPoints:         dataPoints,
+		Truncated:          truncated,
 	}, nil
 }
 
+func (uc *PortfolioPerformanceUseCase) emptyPerformance(period string, display displayCurrency) dto.PortfolioPerformance {
+	zero := display.format(decimal.Zero)
+	return dto.PortfolioPerformance{
+		Period:             period,
+		InitialValueUSD:    "0.00",
+		FinalValueUSD:      "0.00",
+		GainLossUSD:        "0.00",
+		GainLossPercentage: "0.00",
+		Currency:           display.code(),
+		InitialValue:       zero,
+		FinalValue:         zero,
+		GainLoss:           zero,
+		FXRate:             display.info(),
+		DataPoints: []dto.PortfolioPerformancePoint{
+			{Timestamp: uc.now().Format(time.RFC3339Nano), ValueUSD: "0.00", Value: zero},
+		},
+	}
+}
+
 type pricePoint struct {
 	timestamp time.Time
 	price     decimal.Decimal
@@ -235,6 +316,43 @@ type seriesPoint struct {
 	value     decimal.Decimal
 }
 
+// storedSeries returns the recorded daily values of the period, one per interval bucket, and false
+// when the period is finer than a day or the records do not reach back to its start or are stale.
+func (uc *PortfolioPerformanceUseCase) storedSeries(ctx context.Context, logger *slog.Logger, userID uuid.UUID, config periodConfig, from, holdingSince, now time.Time) ([]seriesPoint, bool) {
+	if uc.values == nil || config.interval.Duration() < entities.Interval1d.Duration() {
+		return nil, false
+	}
+
+	day := 24 * time.Hour
+	start := from
+	if start.IsZero() || holdingSince.After(start) {
+		start = holdingSince
+	}
+	start = start.Truncate(day)
+
+	values, err := uc.values.ListPortfolioValues(ctx, userID, start)
+	if err != nil {
+		logger.Warn("failed to load recorded portfolio values", slog.String("error", err.Error()))
+		return nil, false
+	}
+	if len(values) == 0 || values[0].Day.After(start.Add(day)) || now.Sub(values[len(values)-1].Day) > storedValueMaxAge {
+		return nil, false
+	}
+
+	step := config.interval.Duration()
+	points := make([]seriesPoint, 0, len(values))
+	for _, value := range values {
+		// A bucket shows the value recorded for its last day.
+		bucket := value.Day.Truncate(step)
+		if n := len(points); n > 0 && points[n-1].timestamp.Equal(bucket) {
+			points[n-1].value = value.ValueUSD
+			continue
+		}
+		points = append(points, seriesPoint{timestamp: bucket, value: value.ValueUSD})
+	}
+	return points, true
+}
+
 func (uc *PortfolioPerformanceUseCase) loadPriceHistory(ctx context.Context, symbol string, interval entities.IntervalType, from time.Time, to time.Time) ([]pricePoint, error) {
 	if uc.rates == nil {
 		return nil, errPerformanceRateRepo
@@ -267,9 +385,12 @@ func (uc *PortfolioPerformanceUseCase) loadPriceHistory(ctx context.Context, sym
 	return results, nil
 }
 
-func aggregateSeries(series map[string][]seriesPoint) []dto.PortfolioPerformancePoint {
+// aggregateCheckEvery is how many timestamps aggregateSeries sums between cancellation checks.
+const aggregateCheckEvery = 256
+
+func aggregateSeries(ctx context.Context, series map[string][]seriesPoint, display displayCurrency) ([]dto.PortfolioPerformancePoint, error) {
 	if len(series) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	timestampSet := make(map[int64]time.Time)
@@ -295,7 +416,10 @@ func aggregateSeries(series map[string][]seriesPoint) []dto.PortfolioPerformance
 	}
 
 	results := make([]dto.PortfolioPerformancePoint, 0, len(keys))
-	for _, key := range keys {
+	for i, key := range keys {
+		if i%aggregateCheckEvery == 0 && ctx.Err() != nil {
+			return nil, context.Cause(ctx)
+		}
 		timestamp := timestampSet[key]
 		total := decimal.Zero
 
@@ -312,10 +436,11 @@ func aggregateSeries(series map[string][]seriesPoint) []dto.PortfolioPerformance
 		results = append(results, dto.PortfolioPerformancePoint{
 			Timestamp: timestamp.Format(time.RFC3339Nano),
 			ValueUSD:  total.StringFixedBank(2),
+			Value:     display.format(total),
 		})
 	}
 
-	return results
+	return results, nil
 }
 
 func resolvePeriod(period string) periodConfig {
@@ -336,3 +461,14 @@ func resolvePeriod(period string) periodConfig {
 		return periodConfig{label: "30d", duration: 30 * 24 * time.Hour, interval: entities.Interval1d}
 	}
 }
+
+// budgetExhaustedError reports a budget that ran out before anything worth returning was loaded.
+func budgetExhaustedError(name string) error {
+	return utils.NewAppError(
+		"EXECUTION_BUDGET_EXHAUSTED",
+		"request took too long; try a shorter period",
+		fiber.StatusServiceUnavailable,
+		budget.ErrExhausted,
+		map[string]any{"budget": name},
+	)
+}
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
@@ -27,23 +28,29 @@ var (
 type PortfolioSummaryUseCase struct {
 	wallets repositories.WalletRepository
 	rates   repositories.RateRepository
+	users   UserLookup
+	fx      FiatRateProvider
 	logger  *slog.Logger
 }
 
-// NewPortfolioSummaryUseCase constructs the use case.
-func NewPortfolioSummaryUseCase(wallets repositories.WalletRepository, rates repositories.RateRepository, logger *slog.Logger) *PortfolioSummaryUseCase {
+// NewPortfolioSummaryUseCase constructs the use case. users and fx may be nil, in which case
+// values are reported in USD unless a currency is requested explicitly.
+func NewPortfolioSummaryUseCase(wallets repositories.WalletRepository, rates repositories.RateRepository, users UserLookup, fx FiatRateProvider, logger *slog.Logger) *PortfolioSummaryUseCase {
 	if logger == nil {
 		logger = slog.Default()
 	}
 	return &PortfolioSummaryUseCase{
 		wallets: wallets,
 		rates:   rates,
+		users:   users,
+		fx:      fx,
 		logger:  logger,
 	}
 }
 
-// Execute returns the aggregated portfolio summary for the supplied user.
-func (uc *PortfolioSummaryUseCase) Execute(ctx context.Context, userID uuid.UUID) (dto.PortfolioSummary, error) {
+// Execute returns the aggregated portfolio summary for the supplied user. currency overrides the
+// user's preferred display currency when set.
+func (uc *PortfolioSummaryUseCase) Execute(ctx context.Context, userID uuid.UUID, currency string) (dto.PortfolioSummary, error) {
 	if uc.wallets == nil {
 		return dto.PortfolioSummary{}, errWalletRepositoryRequired
 	}
@@ -63,6 +70,11 @@ func (uc *PortfolioSummaryUseCase) Execute(ctx context.Context, userID uuid.UUID
 	ctxLogger := appLogging.LoggerFromContext(ctx, uc.logger).With(slog.String("user_id", userID.String()))
 	ctxLogger.Debug("compiling portfolio summary")
 
+	display, err := resolveDisplayCurrency(ctx, uc.users, uc.fx, ctxLogger, userID, currency, time.Now().UTC())
+	if err != nil {
+		return dto.PortfolioSummary{}, err
+	}
+
 	wallets, err := uc.wallets.ListByUser(ctx, userID, repositories.WalletFilter{}, repositories.ListOptions{Limit: 1000, SortBy: "created_at", SortOrder: repositories.SortDescending})
 	if err != nil {
 		ctxLogger.Error("failed to list wallets for portfolio summary", slog.String("error", err.Error()))
@@ -76,38 +88,28 @@ func (uc *PortfolioSummaryUseCase) Execute(ctx context.Context, userID uuid.UUID
 	}
 
 	if len(wallets) == 0 {
-		return dto.PortfolioSummary{
-			TotalBalanceUSD:          "0.00",
-			TotalChange24h:           "0.00",
-			TotalChangePercentage24h: "0.00",
-			Assets:                   []dto.PortfolioAsset{},
-		}, nil
+		return emptyPortfolioSummary(display), nil
 	}
 
 	assetBalances := make(map[string]decimal.Decimal)
 	for _, wallet := range wallets {
-		chain := strings.ToUpper(string(wallet.GetChain()))
-		if !entities.IsSupportedSymbol(chain) {
+		symbol := entities.WalletAssetSymbol(wallet)
+		if !entities.IsSupportedSymbol(symbol) {
 			continue
 		}
 		balance := wallet.GetBalance()
 		if balance.IsZero() {
 			continue
 		}
-		if current, ok := assetBalances[chain]; ok {
-			assetBalances[chain] = current.Add(balance)
+		if current, ok := assetBalances[symbol]; ok {
+			assetBalances[symbol] = current.Add(balance)
 		} else {
-			assetBalances[chain] = balance
+			assetBalances[symbol] = balance
 		}
 	}
 
 	if len(assetBalances) == 0 {
-		return dto.PortfolioSummary{
-			TotalBalanceUSD:          "0.00",
-			TotalChange24h:           "0.00",
-			TotalChangePercentage24h: "0.00",
-			Assets:                   []dto.PortfolioAsset{},
-		}, nil
+		return emptyPortfolioSummary(display), nil
 	}
 
 	symbols := make([]string, 0, len(assetBalances))
@@ -139,15 +141,28 @@ func (uc *PortfolioSummaryUseCase) Execute(ctx context.Context, userID uuid.UUID
 	totalChangeUSD := decimal.Zero
 	previousTotalUSD := decimal.Zero
 	assets := make([]dto.PortfolioAsset, 0, len(assetBalances))
+	now := time.Now().UTC()
+	staleSymbols := make([]string, 0)
 
 	for _, symbol := range symbols {
 		balance := assetBalances[symbol]
 		rate, ok := rateMap[symbol]
 		price := decimal.Zero
 		change24h := decimal.Zero
+		asset := dto.PortfolioAsset{
+			Symbol:    symbol,
+			RateStale: true,
+		}
 		if ok && rate != nil {
 			price = rate.GetPriceUSD()
 			change24h = rate.GetPriceChange24h()
+			lastUpdated := rate.GetLastUpdated().UTC()
+			asset.RateSource = rate.GetSource()
+			asset.RateLastUpdated = &lastUpdated
+			asset.RateStale = rate.IsStale(now, entities.DefaultRateStaleAfter)
+		}
+		if asset.RateStale {
+			staleSymbols = append(staleSymbols, symbol)
 		}
 
 		valueUSD := balance.Mul(price)
@@ -162,21 +177,28 @@ func (uc *PortfolioSummaryUseCase) Execute(ctx context.Context, userID uuid.UUID
 		}
 		previousTotalUSD = previousTotalUSD.Add(balance.Mul(previousPrice))
 
-		assets = append(assets, dto.PortfolioAsset{
-			Symbol:     symbol,
-			Balance:    balance.StringFixedBank(8),
-			BalanceUSD: valueUSD.StringFixedBank(2),
-			Percentage: "0.00",
-		})
+		asset.Balance = balance.StringFixedBank(8)
+		asset.BalanceUSD = valueUSD.StringFixedBank(2)
+		asset.Value = display.format(valueUSD)
+		asset.Percentage = "0.00"
+		assets = append(assets, asset)
 	}
 
+	sort.Strings(staleSymbols)
+	freshnessWarning := display.withFreshnessWarning(dto.RateFreshnessWarning(staleSymbols))
+
 	if totalBalanceUSD.IsZero() {
 		// If balances cancel out, ensure totals are consistent
 		return dto.PortfolioSummary{
 			TotalBalanceUSD:          "0.00",
 			TotalChange24h:           totalChangeUSD.StringFixedBank(2),
 			TotalChangePercentage24h: "0.00",
+			Currency:                 display.code(),
+			TotalBalance:             display.format(decimal.Zero),
+			TotalChange:              display.format(totalChangeUSD),
+			FXRate:                   display.info(),
 			Assets:                   assets,
+			FreshnessWarning:         freshnessWarning,
 		}, nil
 	}
 
@@ -206,6 +228,7 @@ func (uc *PortfolioSummaryUseCase) Execute(ctx context.Context, userID uuid.UUID
 
 	ctxLogger.Info("portfolio summary calculated",
 		slog.String("total_balance_usd", totalBalanceUSD.StringFixedBank(2)),
+		slog.String("currency", display.code()),
 		slog.Int("asset_count", len(assets)),
 	)
 
@@ -213,6 +236,24 @@ func (uc *PortfolioSummaryUseCase) Execute(ctx context.Context, userID uuid.UUID
         TotalBalanceUSD:          totalBalanceUSD.StringFixedBank(2),
         TotalChange24h:           totalChangeUSD.StringFixedBank(2),
         TotalChangePercentage24h: changePercentage.StringFixedBank(2),
+        Currency:                 display.code(),
+        TotalBalance:             display.format(totalBalanceUSD),
+        TotalChange:              display.format(totalChangeUSD),
+        FXRate:                   display.info(),
         Assets:                   assets,
+        FreshnessWarning:         freshnessWarning,
 	}, nil
 }
+
+func emptyPortfolioSummary(display displayCurrency) dto.PortfolioSummary {
+	return dto.PortfolioSummary{
+		TotalBalanceUSD:          "0.00",
+		TotalChange24h:           "0.00",
+		TotalChangePercentage24h: "0.00",
+		Currency:                 display.code(),
+		TotalBalance:             display.format(decimal.Zero),
+		TotalChange:              display.format(decimal.Zero),
+		FXRate:                   display.info(),
+		Assets:                   []dto.PortfolioAsset{},
+	}
+}
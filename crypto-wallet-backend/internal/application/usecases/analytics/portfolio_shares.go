@@ -0,0 +1,470 @@
+package analytics
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+var errPortfolioShareRepositoryRequired = errors.New("portfolio shares: repository not configured")
+
+// Resources recorded in a share's access log.
+const (
+	SharedResourceSummary      = "summary"
+	SharedResourcePerformance  = "performance"
+	SharedResourceTransactions = "transactions"
+)
+
+// CreatePortfolioShareUseCase issues read-only portfolio links.
+type CreatePortfolioShareUseCase struct {
+	shares  repositories.PortfolioShareRepository
+	linkURL string
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+// NewCreatePortfolioShareUseCase constructs the use case. When linkURL is set, created links are
+// returned as linkURL?token=...; otherwise only the token is returned.
+func NewCreatePortfolioShareUseCase(shares repositories.PortfolioShareRepository, linkURL string, logger *slog.Logger) *CreatePortfolioShareUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &CreatePortfolioShareUseCase{
+		shares:  shares,
+		linkURL: strings.TrimSpace(linkURL),
+		logger:  logger,
+		now:     func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Execute validates the request and stores the share. The token is only ever returned here.
+func (uc *CreatePortfolioShareUseCase) Execute(ctx context.Context, userID uuid.UUID, req dto.CreatePortfolioShareRequest) (dto.CreatedPortfolioShare, error) {
+	if uc.shares == nil {
+		return dto.CreatedPortfolioShare{}, errPortfolioShareRepositoryRequired
+	}
+
+	now := uc.now()
+	errs := req.Validate()
+	expiresAt := now.Add(entities.DefaultPortfolioShareTTL)
+	if req.ExpiresAt != nil {
+		expiresAt = req.ExpiresAt.UTC()
+		switch {
+		case !expiresAt.After(now):
+			errs.Add("expires_at", "must be in the future")
+		case expiresAt.After(now.Add(entities.MaxPortfolioShareTTL)):
+			errs.Add("expires_at", "must be at most 90 days away")
+		}
+	}
+	if !errs.IsEmpty() {
+		return dto.CreatedPortfolioShare{}, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"invalid portfolio share",
+			fiber.StatusBadRequest,
+			errs,
+			map[string]any{"errors": errs},
+		)
+	}
+
+	active, err := uc.shares.CountActiveByUser(ctx, userID, now)
+	if err != nil {
+		return dto.CreatedPortfolioShare{}, err
+	}
+	if active >= entities.MaxActivePortfolioShares {
+		return dto.CreatedPortfolioShare{}, utils.NewAppError(
+			"PORTFOLIO_SHARE_LIMIT_REACHED",
+			"active share link limit reached; revoke a link first",
+			fiber.StatusConflict,
+			nil,
+			map[string]any{"limit": entities.MaxActivePortfolioShares},
+		)
+	}
+
+	token, err := generatePortfolioShareToken()
+	if err != nil {
+		return dto.CreatedPortfolioShare{}, fmt.Errorf("portfolio shares: generate token: %w", err)
+	}
+
+	share := entities.PortfolioShare{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Label:     strings.TrimSpace(req.Label),
+		Scope:     entities.PortfolioShareScope(strings.ToLower(strings.TrimSpace(req.Scope))),
+		TokenHash: entities.HashPortfolioShareToken(token),
+		CreatedAt: now,
+		ExpiresAt: expiresAt,
+	}
+	if err := uc.shares.Create(ctx, share); err != nil {
+		uc.logger.Error("failed to create portfolio share", slog.String("user_id", userID.String()), slog.String("error", err.Error()))
+		return dto.CreatedPortfolioShare{}, err
+	}
+
+	uc.logger.Info("portfolio share created",
+		slog.String("user_id", userID.String()),
+		slog.String("share_id", share.ID.String()),
+		slog.String("scope", string(share.Scope)))
+
+	return dto.CreatedPortfolioShare{
+		PortfolioShareResponse: dto.NewPortfolioShareResponse(share, now),
+		Token:                  token,
+		URL:                    uc.shareLink(token),
+	}, nil
+}
+
+func (uc *CreatePortfolioShareUseCase) shareLink(token string) string {
+	if uc.linkURL == "" {
+		return ""
+	}
+	link, err := url.Parse(uc.linkURL)
+	if err != nil {
+		uc.logger.Warn("invalid portfolio share link url", slog.String("error", err.Error()))
+		return ""
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+	return link.String()
+}
+
+// ListPortfolioSharesUseCase lists a user's share links, including expired and revoked ones.
+type ListPortfolioSharesUseCase struct {
+	shares repositories.PortfolioShareRepository
+	now    func() time.Time
+}
+
+// NewListPortfolioSharesUseCase constructs the use case.
+func NewListPortfolioSharesUseCase(shares repositories.PortfolioShareRepository) *ListPortfolioSharesUseCase {
+	return &ListPortfolioSharesUseCase{
+		shares: shares,
+		now:    func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Execute returns the user's shares, newest first.
+func (uc *ListPortfolioSharesUseCase) Execute(ctx context.Context, userID uuid.UUID) ([]dto.PortfolioShareResponse, error) {
+	if uc.shares == nil {
+		return nil, errPortfolioShareRepositoryRequired
+	}
+	shares, err := uc.shares.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	now := uc.now()
+	responses := make([]dto.PortfolioShareResponse, 0, len(shares))
+	for _, share := range shares {
+		responses = append(responses, dto.NewPortfolioShareResponse(share, now))
+	}
+	return responses, nil
+}
+
+// RevokePortfolioShareUseCase disables a share link immediately.
+type RevokePortfolioShareUseCase struct {
+	shares repositories.PortfolioShareRepository
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewRevokePortfolioShareUseCase constructs the use case.
+func NewRevokePortfolioShareUseCase(shares repositories.PortfolioShareRepository, logger *slog.Logger) *RevokePortfolioShareUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RevokePortfolioShareUseCase{
+		shares: shares,
+		logger: logger,
+		now:    func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Execute revokes the user's share. Revoking an already revoked share is a no-op.
+func (uc *RevokePortfolioShareUseCase) Execute(ctx context.Context, userID, shareID uuid.UUID) error {
+	if uc.shares == nil {
+		return errPortfolioShareRepositoryRequired
+	}
+	err := uc.shares.Revoke(ctx, userID, shareID, uc.now())
+	switch {
+	case err == nil:
+		uc.logger.Info("portfolio share revoked", slog.String("user_id", userID.String()), slog.String("share_id", shareID.String()))
+		return nil
+	case errors.Is(err, repositories.ErrConflict):
+		return nil
+	case errors.Is(err, repositories.ErrNotFound):
+		return portfolioShareNotFoundError()
+	default:
+		return err
+	}
+}
+
+// ListPortfolioShareAccessesUseCase shows the owner who used one of their share links.
+type ListPortfolioShareAccessesUseCase struct {
+	shares repositories.PortfolioShareRepository
+}
+
+// NewListPortfolioShareAccessesUseCase constructs the use case.
+func NewListPortfolioShareAccessesUseCase(shares repositories.PortfolioShareRepository) *ListPortfolioShareAccessesUseCase {
+	return &ListPortfolioShareAccessesUseCase{shares: shares}
+}
+
+// Execute returns a page of the share's access log, newest first.
+func (uc *ListPortfolioShareAccessesUseCase) Execute(ctx context.Context, userID, shareID uuid.UUID, opts repositories.ListOptions) ([]dto.PortfolioShareAccessResponse, error) {
+	if uc.shares == nil {
+		return nil, errPortfolioShareRepositoryRequired
+	}
+	if err := opts.CheckCaps(); err != nil {
+		return nil, err
+	}
+	opts = opts.WithDefaults()
+
+	if _, err := uc.shares.GetByID(ctx, userID, shareID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, portfolioShareNotFoundError()
+		}
+		return nil, err
+	}
+
+	accesses, err := uc.shares.ListAccesses(ctx, shareID, opts)
+	if err != nil {
+		return nil, err
+	}
+	responses := make([]dto.PortfolioShareAccessResponse, 0, len(accesses))
+	for _, access := range accesses {
+		responses = append(responses, dto.PortfolioShareAccessResponse{
+			Resource:   access.Resource,
+			IPAddress:  access.IPAddress,
+			UserAgent:  access.UserAgent,
+			AccessedAt: access.AccessedAt,
+		})
+	}
+	return responses, nil
+}
+
+// PortfolioSummarizer computes a user's portfolio summary.
+type PortfolioSummarizer interface {
+	Execute(ctx context.Context, userID uuid.UUID, currency string) (dto.PortfolioSummary, error)
+}
+
+// PortfolioPerformanceReporter computes a user's portfolio performance over a period.
+type PortfolioPerformanceReporter interface {
+	Execute(ctx context.Context, userID uuid.UUID, period, currency string) (dto.PortfolioPerformance, error)
+}
+
+// SharedAccess identifies a request made through a share link.
+type SharedAccess struct {
+	Token     string
+	IPAddress string
+	UserAgent string
+}
+
+// SharedPortfolioUseCase answers read-only requests authenticated by a share link token. Every
+// successful request is written to the share's access log.
+type SharedPortfolioUseCase struct {
+	shares       repositories.PortfolioShareRepository
+	summary      PortfolioSummarizer
+	performance  PortfolioPerformanceReporter
+	wallets      repositories.WalletRepository
+	transactions repositories.TransactionRepository
+	logger       *slog.Logger
+	now          func() time.Time
+}
+
+// SharedPortfolioConfig configures a SharedPortfolioUseCase. Transactions are only served when
+// both Wallets and Transactions are set.
+type SharedPortfolioConfig struct {
+	Shares       repositories.PortfolioShareRepository
+	Summary      PortfolioSummarizer
+	Performance  PortfolioPerformanceReporter
+	Wallets      repositories.WalletRepository
+	Transactions repositories.TransactionRepository
+	Logger       *slog.Logger
+}
+
+// NewSharedPortfolioUseCase constructs the use case.
+func NewSharedPortfolioUseCase(cfg SharedPortfolioConfig) *SharedPortfolioUseCase {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SharedPortfolioUseCase{
+		shares:       cfg.Shares,
+		summary:      cfg.Summary,
+		performance:  cfg.Performance,
+		wallets:      cfg.Wallets,
+		transactions: cfg.Transactions,
+		logger:       logger,
+		now:          func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Summary returns the shared portfolio's allocation and totals.
+func (uc *SharedPortfolioUseCase) Summary(ctx context.Context, access SharedAccess, currency string) (dto.SharedPortfolio, error) {
+	if uc.summary == nil {
+		return dto.SharedPortfolio{}, fiber.NewError(fiber.StatusNotImplemented, "portfolio summary not configured")
+	}
+	share, err := uc.authorize(ctx, access, entities.PortfolioShareScopeSummary, SharedResourceSummary)
+	if err != nil {
+		return dto.SharedPortfolio{}, err
+	}
+	summary, err := uc.summary.Execute(ctx, share.UserID, currency)
+	if err != nil {
+		return dto.SharedPortfolio{}, err
+	}
+	return dto.SharedPortfolio{
+		Label:     share.Label,
+		Scope:     string(share.Scope),
+		ExpiresAt: share.ExpiresAt.UTC(),
+		Summary:   summary,
+	}, nil
+}
+
+// Performance returns the shared portfolio's performance over the period.
+func (uc *SharedPortfolioUseCase) Performance(ctx context.Context, access SharedAccess, period, currency string) (dto.PortfolioPerformance, error) {
+	if uc.performance == nil {
+		return dto.PortfolioPerformance{}, fiber.NewError(fiber.StatusNotImplemented, "portfolio performance not configured")
+	}
+	share, err := uc.authorize(ctx, access, entities.PortfolioShareScopeSummary, SharedResourcePerformance)
+	if err != nil {
+		return dto.PortfolioPerformance{}, err
+	}
+	return uc.performance.Execute(ctx, share.UserID, period, currency)
+}
+
+// Transactions returns a page of the owner's transactions, newest first. Requires a full_history
+// share.
+func (uc *SharedPortfolioUseCase) Transactions(ctx context.Context, access SharedAccess, opts repositories.ListOptions) (dto.SharedTransactionList, error) {
+	if uc.wallets == nil || uc.transactions == nil {
+		return dto.SharedTransactionList{}, fiber.NewError(fiber.StatusNotImplemented, "shared transaction history not configured")
+	}
+	if err := opts.CheckCaps(); err != nil {
+		return dto.SharedTransactionList{}, err
+	}
+	opts = opts.WithDefaults()
+	opts.SortBy = "created_at"
+	opts.SortOrder = repositories.SortDescending
+
+	share, err := uc.authorize(ctx, access, entities.PortfolioShareScopeFullHistory, SharedResourceTransactions)
+	if err != nil {
+		return dto.SharedTransactionList{}, err
+	}
+
+	result := dto.SharedTransactionList{
+		Transactions: []dto.SharedTransaction{},
+		Limit:        opts.Limit,
+		Offset:       opts.Offset,
+	}
+
+	wallets, err := uc.wallets.ListByUser(ctx, share.UserID, repositories.WalletFilter{}, repositories.ListOptions{Limit: 1000})
+	if err != nil {
+		return dto.SharedTransactionList{}, err
+	}
+	if len(wallets) == 0 {
+		return result, nil
+	}
+	walletIDs := make([]uuid.UUID, 0, len(wallets))
+	for _, wallet := range wallets {
+		walletIDs = append(walletIDs, wallet.GetID())
+	}
+
+	transactions, total, err := uc.transactions.ListWithFilters(ctx, repositories.TransactionFilter{WalletIDs: walletIDs}, opts)
+	if err != nil {
+		return dto.SharedTransactionList{}, err
+	}
+	result.Total = total
+	for _, tx := range transactions {
+		result.Transactions = append(result.Transactions, dto.SharedTransaction{
+			Chain:       string(tx.GetChain()),
+			Type:        string(tx.GetType()),
+			Amount:      tx.GetAmount().String(),
+			Fee:         tx.GetFee().String(),
+			Status:      string(tx.GetStatus()),
+			CreatedAt:   tx.GetCreatedAt().UTC(),
+			ConfirmedAt: tx.GetConfirmedAt(),
+		})
+	}
+	return result, nil
+}
+
+// authorize resolves the token to an active share covering the scope and logs the access. Unknown,
+// expired and revoked tokens all look the same to the caller.
+func (uc *SharedPortfolioUseCase) authorize(ctx context.Context, access SharedAccess, scope entities.PortfolioShareScope, resource string) (entities.PortfolioShare, error) {
+	if uc.shares == nil {
+		return entities.PortfolioShare{}, errPortfolioShareRepositoryRequired
+	}
+	token := strings.TrimSpace(access.Token)
+	if token == "" {
+		return entities.PortfolioShare{}, portfolioShareNotFoundError()
+	}
+
+	share, err := uc.shares.GetByTokenHash(ctx, entities.HashPortfolioShareToken(token))
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return entities.PortfolioShare{}, portfolioShareNotFoundError()
+		}
+		return entities.PortfolioShare{}, err
+	}
+	now := uc.now()
+	if !share.IsActive(now) {
+		return entities.PortfolioShare{}, portfolioShareNotFoundError()
+	}
+	if !share.Allows(scope) {
+		return entities.PortfolioShare{}, utils.NewAppError(
+			"PORTFOLIO_SHARE_SCOPE_INSUFFICIENT",
+			"this share link does not include transaction history",
+			fiber.StatusForbidden,
+			nil,
+			map[string]any{"scope": string(share.Scope)},
+		)
+	}
+
+	if err := uc.shares.RecordAccess(ctx, entities.PortfolioShareAccess{
+		ID:         uuid.New(),
+		ShareID:    share.ID,
+		Resource:   resource,
+		IPAddress:  access.IPAddress,
+		UserAgent:  truncateUserAgent(access.UserAgent),
+		AccessedAt: now,
+	}); err != nil {
+		uc.logger.Warn("failed to record portfolio share access",
+			slog.String("share_id", share.ID.String()),
+			slog.String("error", err.Error()))
+	}
+	return share, nil
+}
+
+func portfolioShareNotFoundError() error {
+	return utils.NewAppError(
+		"PORTFOLIO_SHARE_NOT_FOUND",
+		"share link not found or no longer valid",
+		fiber.StatusNotFound,
+		nil,
+		nil,
+	)
+}
+
+func generatePortfolioShareToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func truncateUserAgent(userAgent string) string {
+	userAgent = strings.TrimSpace(userAgent)
+	if runes := []rune(userAgent); len(runes) > 512 {
+		return string(runes[:512])
+	}
+	return userAgent
+}
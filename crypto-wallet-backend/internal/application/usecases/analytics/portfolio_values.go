@@ -0,0 +1,108 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// portfolioValueBatch bounds the wallets read and the values written per query.
+const portfolioValueBatch = 500
+
+var errPortfolioValueRepos = errors.New("portfolio values: wallet, rate and value repositories are required")
+
+// RecordPortfolioValuesUseCase records the value of every user's holdings for the UTC day that
+// closed most recently. It runs shortly after midnight, so current balances and prices stand for
+// the day's close.
+type RecordPortfolioValuesUseCase struct {
+	wallets repositories.WalletRepository
+	rates   repositories.RateRepository
+	values  repositories.PortfolioValueRepository
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+// NewRecordPortfolioValuesUseCase constructs a RecordPortfolioValuesUseCase.
+func NewRecordPortfolioValuesUseCase(wallets repositories.WalletRepository, rates repositories.RateRepository, values repositories.PortfolioValueRepository, logger *slog.Logger) *RecordPortfolioValuesUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RecordPortfolioValuesUseCase{
+		wallets: wallets,
+		rates:   rates,
+		values:  values,
+		logger:  logger,
+		now:     func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Execute records one value per user holding a non-archived wallet and returns how many it stored.
+// Holdings without a current rate count as zero.
+func (uc *RecordPortfolioValuesUseCase) Execute(ctx context.Context) (int, error) {
+	if uc.wallets == nil || uc.rates == nil || uc.values == nil {
+		return 0, errPortfolioValueRepos
+	}
+
+	now := uc.now()
+	day := now.Truncate(24 * time.Hour).Add(-24 * time.Hour)
+
+	rates, err := uc.rates.GetRatesBySymbols(ctx, entities.SupportedSymbols())
+	if err != nil {
+		return 0, err
+	}
+	prices := make(map[string]decimal.Decimal, len(rates))
+	for _, rate := range rates {
+		if rate != nil {
+			prices[strings.ToUpper(strings.TrimSpace(rate.GetSymbol()))] = rate.GetPriceUSD()
+		}
+	}
+
+	totals := make(map[uuid.UUID]decimal.Decimal)
+	for _, chain := range entities.SupportedChains() {
+		after := uuid.Nil
+		for {
+			wallets, err := uc.wallets.ListByChain(ctx, chain, after, portfolioValueBatch)
+			if err != nil {
+				return 0, err
+			}
+			for _, wallet := range wallets {
+				total := totals[wallet.GetUserID()]
+				if price, ok := prices[entities.WalletAssetSymbol(wallet)]; ok {
+					total = total.Add(wallet.GetBalance().Mul(price))
+				}
+				totals[wallet.GetUserID()] = total
+			}
+			if len(wallets) < portfolioValueBatch {
+				break
+			}
+			after = wallets[len(wallets)-1].GetID()
+		}
+	}
+
+	batch := make([]entities.PortfolioDailyValue, 0, portfolioValueBatch)
+	for userID, total := range totals {
+		batch = append(batch, entities.PortfolioDailyValue{UserID: userID, Day: day, ValueUSD: total, ComputedAt: now})
+		if len(batch) == portfolioValueBatch {
+			if err := uc.values.UpsertPortfolioValues(ctx, batch); err != nil {
+				return 0, err
+			}
+			batch = batch[:0]
+		}
+	}
+	if err := uc.values.UpsertPortfolioValues(ctx, batch); err != nil {
+		return 0, err
+	}
+
+	uc.logger.Info("recorded portfolio values",
+		slog.String("day", day.Format(time.DateOnly)),
+		slog.Int("users", len(totals)))
+	return len(totals), nil
+}
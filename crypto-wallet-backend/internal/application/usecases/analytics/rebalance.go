@@ -0,0 +1,232 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/services"
+	appLogging "github.com/crypto-wallet/backend/internal/infrastructure/logging"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// minRebalanceSwapUSD is the smallest transfer worth suggesting.
+var minRebalanceSwapUSD = decimal.RequireFromString("0.01")
+
+// SwapQuoter prices a prospective exchange between two assets. services.ExchangeService
+// satisfies it.
+type SwapQuoter interface {
+	PreviewQuote(ctx context.Context, userID uuid.UUID, baseSymbol, quoteSymbol string, fromAmount decimal.Decimal) (entities.TradingPair, services.PricingResult, error)
+}
+
+// RebalanceSuggestionsUseCase suggests the swaps that move a user's portfolio to a target
+// allocation.
+type RebalanceSuggestionsUseCase struct {
+	summary PortfolioSummarizer
+	quotes  SwapQuoter
+	logger  *slog.Logger
+}
+
+// NewRebalanceSuggestionsUseCase constructs the use case.
+func NewRebalanceSuggestionsUseCase(summary PortfolioSummarizer, quotes SwapQuoter, logger *slog.Logger) *RebalanceSuggestionsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RebalanceSuggestionsUseCase{
+		summary: summary,
+		quotes:  quotes,
+		logger:  logger,
+	}
+}
+
+// rebalanceHolding is one asset's position in the rebalance.
+type rebalanceHolding struct {
+	symbol    string
+	balance   decimal.Decimal
+	valueUSD  decimal.Decimal
+	targetUSD decimal.Decimal
+	// gapUSD is the value to sell while positive and to buy while negative.
+	gapUSD decimal.Decimal
+}
+
+// Execute compares the current allocation with the targets. Assets drifting no more than the
+// tolerance are left alone; the remaining surplus is matched against the remaining deficits,
+// largest first, and each transfer is priced like an exchange quote.
+func (uc *RebalanceSuggestionsUseCase) Execute(ctx context.Context, userID uuid.UUID, req dto.RebalanceRequest) (dto.RebalanceSuggestions, error) {
+	if uc.summary == nil || uc.quotes == nil {
+		return dto.RebalanceSuggestions{}, errors.New("rebalance suggestions: dependencies not configured")
+	}
+
+	req.SetDefaults()
+	if errs := req.Validate(); !errs.IsEmpty() {
+		return dto.RebalanceSuggestions{}, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"invalid rebalance targets",
+			fiber.StatusBadRequest,
+			errs,
+			map[string]any{"errors": errs},
+		)
+	}
+	targets, _ := req.TargetAllocations()
+	tolerance, _ := decimal.NewFromString(req.Tolerance)
+
+	summary, err := uc.summary.Execute(ctx, userID, string(entities.CurrencyUSD))
+	if err != nil {
+		return dto.RebalanceSuggestions{}, err
+	}
+
+	logger := appLogging.LoggerFromContext(ctx, uc.logger).With(slog.String("user_id", userID.String()))
+	total, _ := decimal.NewFromString(summary.TotalBalanceUSD)
+	holdings := rebalanceHoldings(summary, targets, total)
+
+	result := dto.RebalanceSuggestions{
+		TotalBalanceUSD:  total.StringFixedBank(2),
+		Tolerance:        tolerance.String(),
+		Allocations:      make([]dto.RebalanceAllocation, 0, len(holdings)),
+		Swaps:            []dto.RebalanceSwap{},
+		EstimatedFeesUSD: "0.00",
+		FreshnessWarning: summary.FreshnessWarning,
+	}
+
+	hundred := decimal.NewFromInt(100)
+	sellers := make([]*rebalanceHolding, 0)
+	buyers := make([]*rebalanceHolding, 0)
+	for _, holding := range holdings {
+		current, target := decimal.Zero, targets[holding.symbol]
+		if total.IsPositive() {
+			current = holding.valueUSD.Div(total).Mul(hundred)
+		}
+		drift := current.Sub(target)
+		result.Allocations = append(result.Allocations, dto.RebalanceAllocation{
+			Symbol:            holding.symbol,
+			CurrentPercentage: current.StringFixedBank(2),
+			TargetPercentage:  target.StringFixedBank(2),
+			Drift:             drift.StringFixedBank(2),
+			CurrentValueUSD:   holding.valueUSD.StringFixedBank(2),
+			TargetValueUSD:    holding.targetUSD.StringFixedBank(2),
+		})
+
+		if drift.Abs().LessThanOrEqual(tolerance) {
+			continue
+		}
+		if holding.gapUSD.IsPositive() {
+			sellers = append(sellers, holding)
+		} else {
+			buyers = append(buyers, holding)
+		}
+	}
+
+	sortByGap := func(items []*rebalanceHolding) {
+		sort.SliceStable(items, func(i, j int) bool {
+			return items[i].gapUSD.Abs().GreaterThan(items[j].gapUSD.Abs())
+		})
+	}
+	sortByGap(sellers)
+	sortByGap(buyers)
+
+	feesUSD := decimal.Zero
+	for si, bi := 0, 0; si < len(sellers) && bi < len(buyers); {
+		seller, buyer := sellers[si], buyers[bi]
+		valueUSD := decimal.Min(seller.gapUSD, buyer.gapUSD.Neg())
+		seller.gapUSD = seller.gapUSD.Sub(valueUSD)
+		buyer.gapUSD = buyer.gapUSD.Add(valueUSD)
+		if !seller.gapUSD.IsPositive() {
+			si++
+		}
+		if !buyer.gapUSD.IsNegative() {
+			bi++
+		}
+		if valueUSD.LessThan(minRebalanceSwapUSD) {
+			continue
+		}
+
+		swap := uc.priceSwap(ctx, logger, userID, seller, buyer.symbol, valueUSD)
+		if fee, err := decimal.NewFromString(swap.EstimatedFeeUSD); err == nil {
+			feesUSD = feesUSD.Add(fee)
+		}
+		result.Swaps = append(result.Swaps, swap)
+	}
+	result.EstimatedFeesUSD = feesUSD.StringFixedBank(2)
+
+	logger.Debug("rebalance suggestions calculated", slog.Int("swap_count", len(result.Swaps)))
+	return result, nil
+}
+
+// priceSwap sizes a transfer of valueUSD out of the seller's holding and quotes it. The amount is
+// the matching share of the holding, so a full exit sells the whole balance.
+func (uc *RebalanceSuggestionsUseCase) priceSwap(ctx context.Context, logger *slog.Logger, userID uuid.UUID, seller *rebalanceHolding, toSymbol string, valueUSD decimal.Decimal) dto.RebalanceSwap {
+	fromAmount := seller.balance
+	if valueUSD.LessThan(seller.valueUSD) {
+		fromAmount = seller.balance.Mul(valueUSD).Div(seller.valueUSD).RoundDown(8)
+	}
+
+	swap := dto.RebalanceSwap{
+		FromSymbol: seller.symbol,
+		ToSymbol:   toSymbol,
+		FromAmount: fromAmount.String(),
+		ValueUSD:   valueUSD.StringFixedBank(2),
+	}
+
+	pair, priced, err := uc.quotes.PreviewQuote(ctx, userID, seller.symbol, toSymbol, fromAmount)
+	if err != nil {
+		if errors.Is(err, services.ErrExchangeInvalidTradingPair) {
+			swap.Note = "no active trading pair for " + seller.symbol + "/" + toSymbol
+		} else {
+			logger.Warn("failed to price rebalance swap",
+				slog.String("pair", seller.symbol+"/"+toSymbol),
+				slog.String("error", err.Error()),
+			)
+			swap.Note = "swap could not be priced"
+		}
+		return swap
+	}
+
+	swap.EstimatedToAmount = priced.ToAmount.RoundDown(8).String()
+	swap.ExchangeRate = priced.ExchangeRate.String()
+	swap.FeePercentage = priced.FeePercentage.String()
+	swap.EstimatedFee = priced.FeeAmount.RoundUp(8).String()
+	if fromAmount.IsPositive() {
+		swap.EstimatedFeeUSD = priced.FeeAmount.Mul(valueUSD).Div(fromAmount).StringFixedBank(2)
+	}
+
+	switch {
+	case fromAmount.LessThan(pair.GetMinSwapAmount()):
+		swap.Note = "amount is below the pair's minimum swap of " + pair.GetMinSwapAmount().String()
+	case pair.GetMaxSwapAmount() != nil && fromAmount.GreaterThan(*pair.GetMaxSwapAmount()):
+		swap.Note = "amount exceeds the pair's maximum swap of " + pair.GetMaxSwapAmount().String()
+	default:
+		swap.Executable = true
+	}
+	return swap
+}
+
+// rebalanceHoldings lists every held or targeted asset, ordered by symbol.
+func rebalanceHoldings(summary dto.PortfolioSummary, targets map[string]decimal.Decimal, total decimal.Decimal) []*rebalanceHolding {
+	bySymbol := make(map[string]*rebalanceHolding)
+	for _, asset := range summary.Assets {
+		balance, _ := decimal.NewFromString(asset.Balance)
+		value, _ := decimal.NewFromString(asset.BalanceUSD)
+		bySymbol[asset.Symbol] = &rebalanceHolding{symbol: asset.Symbol, balance: balance, valueUSD: value}
+	}
+	for symbol := range targets {
+		if _, ok := bySymbol[symbol]; !ok {
+			bySymbol[symbol] = &rebalanceHolding{symbol: symbol}
+		}
+	}
+
+	holdings := make([]*rebalanceHolding, 0, len(bySymbol))
+	for symbol, holding := range bySymbol {
+		holding.targetUSD = total.Mul(targets[symbol]).Div(decimal.NewFromInt(100))
+		holding.gapUSD = holding.valueUSD.Sub(holding.targetUSD)
+		holdings = append(holdings, holding)
+	}
+	sort.Slice(holdings, func(i, j int) bool { return holdings[i].symbol < holdings[j].symbol })
+	return holdings
+}
@@ -0,0 +1,427 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	htmltemplate "html/template"
+	"log/slog"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/external"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// scheduledReportBatchSize bounds how many due reports one run sends.
+const scheduledReportBatchSize = 100
+
+var errReportScheduleRepositoryRequired = errors.New("report schedules: repository not configured")
+
+// ReportEmailSender delivers report emails.
+type ReportEmailSender interface {
+	Send(ctx context.Context, message external.EmailMessage) error
+}
+
+// PreferencesLookup reads a user's preferences. repositories.UserPreferencesRepository satisfies it.
+type PreferencesLookup interface {
+	GetByUser(ctx context.Context, userID uuid.UUID) (entities.UserPreferences, error)
+}
+
+// GetReportScheduleUseCase returns a user's report schedule.
+type GetReportScheduleUseCase struct {
+	schedules repositories.ReportScheduleRepository
+}
+
+// NewGetReportScheduleUseCase constructs the use case.
+func NewGetReportScheduleUseCase(schedules repositories.ReportScheduleRepository) *GetReportScheduleUseCase {
+	return &GetReportScheduleUseCase{schedules: schedules}
+}
+
+// Execute returns the schedule, or REPORT_SCHEDULE_NOT_FOUND when the user has not opted in.
+func (uc *GetReportScheduleUseCase) Execute(ctx context.Context, userID uuid.UUID) (dto.ReportScheduleResponse, error) {
+	if uc.schedules == nil {
+		return dto.ReportScheduleResponse{}, errReportScheduleRepositoryRequired
+	}
+	schedule, err := uc.schedules.GetByUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return dto.ReportScheduleResponse{}, reportScheduleNotFoundError()
+		}
+		return dto.ReportScheduleResponse{}, err
+	}
+	return dto.NewReportScheduleResponse(schedule), nil
+}
+
+// SetReportScheduleUseCase opts a user into report emails or changes when they arrive.
+type SetReportScheduleUseCase struct {
+	schedules   repositories.ReportScheduleRepository
+	preferences PreferencesLookup
+	logger      *slog.Logger
+	now         func() time.Time
+}
+
+// NewSetReportScheduleUseCase constructs the use case. preferences supplies the timezone of
+// requests that omit one and may be nil.
+func NewSetReportScheduleUseCase(schedules repositories.ReportScheduleRepository, preferences PreferencesLookup, logger *slog.Logger) *SetReportScheduleUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SetReportScheduleUseCase{
+		schedules:   schedules,
+		preferences: preferences,
+		logger:      logger,
+		now:         func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Execute validates and stores the schedule. Without a timezone the schedule follows the user's
+// preferred timezone, or UTC. The first report goes out at the next matching local time.
+func (uc *SetReportScheduleUseCase) Execute(ctx context.Context, userID uuid.UUID, req dto.ReportScheduleRequest) (dto.ReportScheduleResponse, error) {
+	if uc.schedules == nil {
+		return dto.ReportScheduleResponse{}, errReportScheduleRepositoryRequired
+	}
+
+	req.SetDefaults()
+	if err := wrapReportValidationError(req.Validate()); err != nil {
+		return dto.ReportScheduleResponse{}, err
+	}
+
+	timezone := req.Timezone
+	if timezone == "" {
+		timezone = uc.preferredTimezone(ctx, userID)
+	}
+
+	now := uc.now()
+	schedule := entities.ReportSchedule{
+		UserID:    userID,
+		Frequency: entities.ReportFrequency(req.Frequency),
+		Timezone:  timezone,
+		Hour:      req.Hour,
+		Weekday:   req.WeekdayValue(),
+		Currency:  entities.CurrencyCode(req.Currency),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	schedule.NextRunAt = schedule.NextRunAfter(now)
+
+	if err := uc.schedules.Upsert(ctx, schedule); err != nil {
+		uc.logger.Error("failed to save report schedule", slog.String("user_id", userID.String()), slog.String("error", err.Error()))
+		return dto.ReportScheduleResponse{}, err
+	}
+	return dto.NewReportScheduleResponse(schedule), nil
+}
+
+func (uc *SetReportScheduleUseCase) preferredTimezone(ctx context.Context, userID uuid.UUID) string {
+	if uc.preferences != nil {
+		preferences, err := uc.preferences.GetByUser(ctx, userID)
+		if err != nil {
+			uc.logger.Warn("failed to load preferred timezone", slog.String("user_id", userID.String()), slog.String("error", err.Error()))
+		} else if preferences.Timezone != "" {
+			return preferences.Timezone
+		}
+	}
+	return time.UTC.String()
+}
+
+// DeleteReportScheduleUseCase opts a user out of report emails.
+type DeleteReportScheduleUseCase struct {
+	schedules repositories.ReportScheduleRepository
+}
+
+// NewDeleteReportScheduleUseCase constructs the use case.
+func NewDeleteReportScheduleUseCase(schedules repositories.ReportScheduleRepository) *DeleteReportScheduleUseCase {
+	return &DeleteReportScheduleUseCase{schedules: schedules}
+}
+
+// Execute removes the schedule.
+func (uc *DeleteReportScheduleUseCase) Execute(ctx context.Context, userID uuid.UUID) error {
+	if uc.schedules == nil {
+		return errReportScheduleRepositoryRequired
+	}
+	if err := uc.schedules.Delete(ctx, userID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return reportScheduleNotFoundError()
+		}
+		return err
+	}
+	return nil
+}
+
+// ScheduledReportsResult summarises one delivery run.
+type ScheduledReportsResult struct {
+	Sent    int
+	Skipped int
+	Failed  int
+}
+
+// SendScheduledReportsConfig groups the dependencies of SendScheduledReportsUseCase.
+type SendScheduledReportsConfig struct {
+	Schedules   repositories.ReportScheduleRepository
+	Users       UserLookup
+	Summary     PortfolioSummarizer
+	Performance PortfolioPerformanceReporter
+	Sender      ReportEmailSender
+	// Preferences hides small balances from reports; nil lists every asset.
+	Preferences PreferencesLookup
+	AppName     string
+	Logger      *slog.Logger
+}
+
+// SendScheduledReportsUseCase emails the portfolio reports that are due.
+type SendScheduledReportsUseCase struct {
+	schedules   repositories.ReportScheduleRepository
+	users       UserLookup
+	summary     PortfolioSummarizer
+	performance PortfolioPerformanceReporter
+	sender      ReportEmailSender
+	preferences PreferencesLookup
+	appName     string
+	logger      *slog.Logger
+	now         func() time.Time
+}
+
+// NewSendScheduledReportsUseCase constructs the use case.
+func NewSendScheduledReportsUseCase(cfg SendScheduledReportsConfig) *SendScheduledReportsUseCase {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	appName := strings.TrimSpace(cfg.AppName)
+	if appName == "" {
+		appName = "Crypto Wallet"
+	}
+	return &SendScheduledReportsUseCase{
+		schedules:   cfg.Schedules,
+		users:       cfg.Users,
+		summary:     cfg.Summary,
+		performance: cfg.Performance,
+		sender:      cfg.Sender,
+		preferences: cfg.Preferences,
+		appName:     appName,
+		logger:      logger,
+		now:         func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Execute sends the due reports. Each schedule is claimed by advancing its next run before the
+// report is rendered, so concurrent workers never send it twice; a failed delivery is logged and
+// not retried until the next run.
+func (uc *SendScheduledReportsUseCase) Execute(ctx context.Context) (ScheduledReportsResult, error) {
+	if uc.schedules == nil || uc.users == nil || uc.summary == nil || uc.sender == nil {
+		return ScheduledReportsResult{}, errors.New("scheduled reports: dependencies not configured")
+	}
+
+	now := uc.now()
+	due, err := uc.schedules.ListDue(ctx, now, scheduledReportBatchSize)
+	if err != nil {
+		return ScheduledReportsResult{}, err
+	}
+
+	var result ScheduledReportsResult
+	for _, schedule := range due {
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+
+		claimed, err := uc.schedules.Claim(ctx, schedule.UserID, schedule.NextRunAt, schedule.NextRunAfter(now), now)
+		if err != nil {
+			return result, err
+		}
+		if !claimed {
+			result.Skipped++
+			continue
+		}
+
+		logger := uc.logger.With(slog.String("user_id", schedule.UserID.String()))
+		sent, err := uc.send(ctx, schedule, now)
+		switch {
+		case err != nil:
+			result.Failed++
+			logger.Warn("failed to send scheduled report", slog.String("error", err.Error()))
+		case sent:
+			result.Sent++
+		default:
+			result.Skipped++
+		}
+	}
+	return result, nil
+}
+
+// send renders and delivers one report. Users that are no longer active get nothing.
+func (uc *SendScheduledReportsUseCase) send(ctx context.Context, schedule entities.ReportSchedule, now time.Time) (bool, error) {
+	user, err := uc.users.GetByID(ctx, schedule.UserID)
+	if err != nil {
+		return false, err
+	}
+	if user.GetStatus() != entities.UserStatusActive {
+		return false, nil
+	}
+
+	summary, err := uc.summary.Execute(ctx, schedule.UserID, string(schedule.Currency))
+	if err != nil {
+		return false, err
+	}
+	hidden := uc.hideSmallBalances(ctx, schedule.UserID, &summary)
+
+	var performance *dto.PortfolioPerformance
+	if uc.performance != nil {
+		result, err := uc.performance.Execute(ctx, schedule.UserID, schedule.ReportPeriod(), string(schedule.Currency))
+		if err != nil {
+			uc.logger.Warn("sending scheduled report without performance",
+				slog.String("user_id", schedule.UserID.String()),
+				slog.String("error", err.Error()),
+			)
+		} else {
+			performance = &result
+		}
+	}
+
+	loc, err := schedule.Location()
+	if err != nil {
+		loc = time.UTC
+	}
+	message, err := portfolioReportEmail.render(user.GetEmail(), portfolioReportData{
+		AppName:     uc.appName,
+		Name:        user.GetFirstName(),
+		Frequency:   string(schedule.Frequency),
+		Date:        now.In(loc).Format("Monday, 2 January 2006"),
+		Summary:     summary,
+		Performance: performance,
+		Hidden:      hidden,
+	})
+	if err != nil {
+		return false, err
+	}
+	if err := uc.sender.Send(ctx, message); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// hideSmallBalances drops the assets below the user's small balance threshold from the summary
+// and returns how many it dropped. Totals still include them.
+func (uc *SendScheduledReportsUseCase) hideSmallBalances(ctx context.Context, userID uuid.UUID, summary *dto.PortfolioSummary) int {
+	if uc.preferences == nil {
+		return 0
+	}
+	preferences, err := uc.preferences.GetByUser(ctx, userID)
+	if err != nil {
+		uc.logger.Warn("listing every asset in scheduled report",
+			slog.String("user_id", userID.String()),
+			slog.String("error", err.Error()),
+		)
+		return 0
+	}
+	if !preferences.HideSmallBalancesUSD.IsPositive() {
+		return 0
+	}
+
+	visible := make([]dto.PortfolioAsset, 0, len(summary.Assets))
+	for _, asset := range summary.Assets {
+		valueUSD, err := decimal.NewFromString(asset.BalanceUSD)
+		if err == nil && preferences.HidesBalance(valueUSD) {
+			continue
+		}
+		visible = append(visible, asset)
+	}
+	hidden := len(summary.Assets) - len(visible)
+	summary.Assets = visible
+	return hidden
+}
+
+func reportScheduleNotFoundError() error {
+	return utils.NewAppError(
+		"REPORT_SCHEDULE_NOT_FOUND",
+		"no report schedule configured",
+		fiber.StatusNotFound,
+		nil,
+		nil,
+	)
+}
+
+// portfolioReportData is the template data of a portfolio report email.
+type portfolioReportData struct {
+	AppName     string
+	Name        string
+	Frequency   string
+	Date        string
+	Summary     dto.PortfolioSummary
+	Performance *dto.PortfolioPerformance
+	// Hidden counts the assets left out under the user's small balance threshold.
+	Hidden int
+}
+
+// reportEmailTemplate renders one email. Subjects and text bodies use text/template; HTML bodies
+// use html/template.
+type reportEmailTemplate struct {
+	subject *texttemplate.Template
+	text    *texttemplate.Template
+	html    *htmltemplate.Template
+}
+
+func newReportEmailTemplate(name, subject, text, html string) reportEmailTemplate {
+	return reportEmailTemplate{
+		subject: texttemplate.Must(texttemplate.New(name + ".subject").Parse(subject)),
+		text:    texttemplate.Must(texttemplate.New(name + ".text").Parse(text)),
+		html:    htmltemplate.Must(htmltemplate.New(name + ".html").Parse(html)),
+	}
+}
+
+func (t reportEmailTemplate) render(to string, data any) (external.EmailMessage, error) {
+	var subject, text, html bytes.Buffer
+	if err := t.subject.Execute(&subject, data); err != nil {
+		return external.EmailMessage{}, err
+	}
+	if err := t.text.Execute(&text, data); err != nil {
+		return external.EmailMessage{}, err
+	}
+	if err := t.html.Execute(&html, data); err != nil {
+		return external.EmailMessage{}, err
+	}
+	return external.EmailMessage{
+		To:       to,
+		Subject:  strings.TrimSpace(subject.String()),
+		TextBody: text.String(),
+		HTMLBody: html.String(),
+	}, nil
+}
+
+var portfolioReportEmail = newReportEmailTemplate("portfolio_report",
+	`Your {{.Frequency}} {{.AppName}} portfolio report`,
+	`Hi {{.Name}},
+
+Here is your portfolio for {{.Date}}.
+
+Total value: {{.Summary.TotalBalance}} {{.Summary.Currency}}
+{{- with .Performance}}
+Change over {{.Period}}: {{.GainLoss}} {{.Currency}} ({{.GainLossPercentage}}%)
+{{- end}}
+
+{{range .Summary.Assets}}{{.Symbol}}: {{.Balance}} ({{.Value}} {{$.Summary.Currency}}, {{.Percentage}}%)
+{{end}}{{with .Hidden}}{{.}} small balance(s) hidden.
+{{end}}{{with .Summary.FreshnessWarning}}
+{{.}}
+{{end}}
+You receive this email because you subscribed to {{.Frequency}} reports. Change or cancel the schedule in your {{.AppName}} settings.
+`,
+	`<p>Hi {{.Name}},</p>
+<p>Here is your portfolio for {{.Date}}.</p>
+<p><strong>Total value: {{.Summary.TotalBalance}} {{.Summary.Currency}}</strong>
+{{- with .Performance}}<br>Change over {{.Period}}: {{.GainLoss}} {{.Currency}} ({{.GainLossPercentage}}%){{end}}</p>
+<table cellpadding="4" cellspacing="0" border="1">
+<tr><th align="left">Asset</th><th align="right">Balance</th><th align="right">Value ({{.Summary.Currency}})</th><th align="right">Allocation</th></tr>
+{{range .Summary.Assets}}<tr><td>{{.Symbol}}</td><td align="right">{{.Balance}}</td><td align="right">{{.Value}}</td><td align="right">{{.Percentage}}%</td></tr>
+{{end}}</table>
+{{with .Hidden}}<p>{{.}} small balance(s) hidden.</p>
+{{end}}{{with .Summary.FreshnessWarning}}<p><em>{{.}}</em></p>
+{{end}}<p>You receive this email because you subscribed to {{.Frequency}} reports. Change or cancel the schedule in your {{.AppName}} settings.</p>
+`)
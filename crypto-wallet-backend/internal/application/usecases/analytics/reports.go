@@ -0,0 +1,94 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// ReportRunner executes custom report definitions. postgres.ReportQueryRepository satisfies it.
+type ReportRunner interface {
+	Run(ctx context.Context, userID uuid.UUID, definition entities.ReportDefinition) (entities.ReportResult, error)
+}
+
+// ReportQueue hands reports over to the export worker. export.RequestReportExportUseCase
+// satisfies it.
+type ReportQueue interface {
+	Execute(ctx context.Context, userID uuid.UUID, name string, definition entities.ReportDefinition, format string) (dto.ExportJobResponse, error)
+}
+
+// ReportCatalogUseCase describes what custom reports can query.
+type ReportCatalogUseCase struct{}
+
+// NewReportCatalogUseCase constructs the use case.
+func NewReportCatalogUseCase() *ReportCatalogUseCase {
+	return &ReportCatalogUseCase{}
+}
+
+// Execute returns the report catalogue.
+func (uc *ReportCatalogUseCase) Execute() dto.ReportCatalogResponse {
+	return dto.NewReportCatalogResponse()
+}
+
+// prepareReportDefinition normalises a definition and reports every way it breaks the catalogue
+// as validation errors on field.
+func prepareReportDefinition(field string, definition entities.ReportDefinition) (entities.ReportDefinition, utils.ValidationErrors) {
+	errs := utils.ValidationErrors{}
+	definition = definition.Normalized()
+	if err := definition.Validate(); err != nil {
+		causes := []error{err}
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			causes = joined.Unwrap()
+		}
+		for _, cause := range causes {
+			errs.Add(field, strings.TrimPrefix(cause.Error(), "report: "))
+		}
+	}
+	return definition, errs
+}
+
+// loadOwnedReport fetches a saved report and hides reports belonging to other users.
+func loadOwnedReport(ctx context.Context, reports repositories.SavedReportRepository, userID, reportID uuid.UUID) (entities.SavedReport, error) {
+	report, err := reports.GetByID(ctx, reportID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return entities.SavedReport{}, reportNotFoundError()
+		}
+		return entities.SavedReport{}, err
+	}
+	if report.UserID != userID {
+		return entities.SavedReport{}, reportNotFoundError()
+	}
+	return report, nil
+}
+
+func reportNotFoundError() error {
+	return utils.NewAppError(
+		"REPORT_NOT_FOUND",
+		"report not found",
+		fiber.StatusNotFound,
+		nil,
+		nil,
+	)
+}
+
+func wrapReportValidationError(errs utils.ValidationErrors) error {
+	if errs.IsEmpty() {
+		return nil
+	}
+	return utils.NewAppError(
+		"VALIDATION_ERROR",
+		"report payload invalid",
+		fiber.StatusBadRequest,
+		errs,
+		map[string]any{"errors": errs},
+	)
+}
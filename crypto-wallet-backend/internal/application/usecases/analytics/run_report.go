@@ -0,0 +1,163 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	appLogging "github.com/crypto-wallet/backend/internal/infrastructure/logging"
+)
+
+const (
+	// DefaultReportSyncTimeout bounds how long a report may run inline before it is moved to the
+	// background.
+	DefaultReportSyncTimeout = 5 * time.Second
+	// maxSyncReportSpan is the widest time range a report may cover and still run inline.
+	maxSyncReportSpan = 92 * 24 * time.Hour
+)
+
+// Reasons a report was queued instead of answered inline.
+const (
+	reportQueuedRequested = "requested"
+	reportQueuedUnbounded = "time range is unbounded or wider than 92 days"
+	reportQueuedTimeout   = "report exceeded the inline time budget"
+)
+
+var (
+	errReportRunnerRequired = errors.New("run report: report runner not configured")
+	errReportQueueRequired  = errors.New("run report: report queue not configured")
+)
+
+// RunReportUseCase executes custom reports. Light reports are answered inline; heavy ones, and
+// any that overrun the inline time budget, are queued as report exports for the export worker.
+type RunReportUseCase struct {
+	runner      ReportRunner
+	queue       ReportQueue
+	reports     repositories.SavedReportRepository
+	syncTimeout time.Duration
+	logger      *slog.Logger
+	clock       func() time.Time
+}
+
+// NewRunReportUseCase constructs the use case. A non-positive syncTimeout selects
+// DefaultReportSyncTimeout.
+func NewRunReportUseCase(runner ReportRunner, queue ReportQueue, reports repositories.SavedReportRepository, syncTimeout time.Duration, logger *slog.Logger) *RunReportUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if syncTimeout <= 0 {
+		syncTimeout = DefaultReportSyncTimeout
+	}
+	return &RunReportUseCase{
+		runner:      runner,
+		queue:       queue,
+		reports:     reports,
+		syncTimeout: syncTimeout,
+		logger:      logger,
+		clock:       time.Now,
+	}
+}
+
+// Execute validates and runs an ad-hoc report definition.
+func (uc *RunReportUseCase) Execute(ctx context.Context, userID uuid.UUID, req dto.RunReportRequest) (dto.ReportRunResponse, error) {
+	errs := req.ReportRunOptions.Validate()
+	definition, definitionErrs := prepareReportDefinition("definition", req.Definition)
+	errs = append(errs, definitionErrs...)
+	if err := wrapReportValidationError(errs); err != nil {
+		return dto.ReportRunResponse{}, err
+	}
+	return uc.run(ctx, userID, "", definition, req.ReportRunOptions)
+}
+
+// ExecuteSaved runs one of the user's saved reports.
+func (uc *RunReportUseCase) ExecuteSaved(ctx context.Context, userID, reportID uuid.UUID, opts dto.ReportRunOptions) (dto.ReportRunResponse, error) {
+	if err := wrapReportValidationError(opts.Validate()); err != nil {
+		return dto.ReportRunResponse{}, err
+	}
+	if uc.reports == nil {
+		return dto.ReportRunResponse{}, errSavedReportRepositoryRequired
+	}
+
+	report, err := loadOwnedReport(ctx, uc.reports, userID, reportID)
+	if err != nil {
+		return dto.ReportRunResponse{}, err
+	}
+	// Saved definitions were valid when stored, but the catalogue may have changed since.
+	definition, errs := prepareReportDefinition("definition", report.Definition)
+	if err := wrapReportValidationError(errs); err != nil {
+		return dto.ReportRunResponse{}, err
+	}
+	return uc.run(ctx, userID, report.Name, definition, opts)
+}
+
+func (uc *RunReportUseCase) run(ctx context.Context, userID uuid.UUID, name string, definition entities.ReportDefinition, opts dto.ReportRunOptions) (dto.ReportRunResponse, error) {
+	if uc.runner == nil {
+		return dto.ReportRunResponse{}, errReportRunnerRequired
+	}
+
+	ctxLogger := appLogging.LoggerFromContext(ctx, uc.logger).With(
+		slog.String("user_id", userID.String()),
+		slog.String("dataset", string(definition.Dataset)),
+	)
+
+	if opts.Async {
+		return uc.enqueue(ctx, userID, name, definition, opts.Format, reportQueuedRequested)
+	}
+	if uc.isHeavy(definition) {
+		return uc.enqueue(ctx, userID, name, definition, opts.Format, reportQueuedUnbounded)
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, uc.syncTimeout)
+	defer cancel()
+
+	result, err := uc.runner.Run(runCtx, userID, definition)
+	if err != nil {
+		if ctx.Err() == nil && errors.Is(runCtx.Err(), context.DeadlineExceeded) {
+			ctxLogger.Info("report exceeded inline time budget; queueing", slog.Duration("timeout", uc.syncTimeout))
+			return uc.enqueue(ctx, userID, name, definition, opts.Format, reportQueuedTimeout)
+		}
+		ctxLogger.Error("failed to run report", slog.String("error", err.Error()))
+		return dto.ReportRunResponse{}, err
+	}
+
+	return dto.ReportRunResponse{
+		Status:     dto.ReportRunCompleted,
+		Definition: definition,
+		Result:     &result,
+	}, nil
+}
+
+// isHeavy reports whether a definition scans too much history to answer inline.
+func (uc *RunReportUseCase) isHeavy(definition entities.ReportDefinition) bool {
+	from, to := definition.TimeRange()
+	if from == nil {
+		return true
+	}
+	end := uc.clock().UTC()
+	if to != nil && to.Before(end) {
+		end = *to
+	}
+	return end.Sub(*from) > maxSyncReportSpan
+}
+
+func (uc *RunReportUseCase) enqueue(ctx context.Context, userID uuid.UUID, name string, definition entities.ReportDefinition, format, reason string) (dto.ReportRunResponse, error) {
+	if uc.queue == nil {
+		return dto.ReportRunResponse{}, errReportQueueRequired
+	}
+	job, err := uc.queue.Execute(ctx, userID, name, definition, format)
+	if err != nil {
+		return dto.ReportRunResponse{}, err
+	}
+	return dto.ReportRunResponse{
+		Status:     dto.ReportRunQueued,
+		Definition: definition,
+		Job:        &job,
+		Reason:     reason,
+	}, nil
+}
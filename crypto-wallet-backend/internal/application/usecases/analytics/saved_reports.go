@@ -0,0 +1,239 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+var errSavedReportRepositoryRequired = errors.New("saved reports: repository not configured")
+
+// ListSavedReportsUseCase lists a user's saved reports.
+type ListSavedReportsUseCase struct {
+	reports repositories.SavedReportRepository
+	logger  *slog.Logger
+}
+
+// NewListSavedReportsUseCase constructs the use case.
+func NewListSavedReportsUseCase(reports repositories.SavedReportRepository, logger *slog.Logger) *ListSavedReportsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ListSavedReportsUseCase{reports: reports, logger: logger}
+}
+
+// Execute returns the user's saved reports ordered by name.
+func (uc *ListSavedReportsUseCase) Execute(ctx context.Context, userID uuid.UUID) ([]dto.SavedReportResponse, error) {
+	if uc.reports == nil {
+		return nil, errSavedReportRepositoryRequired
+	}
+	reports, err := uc.reports.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	responses := make([]dto.SavedReportResponse, 0, len(reports))
+	for _, report := range reports {
+		responses = append(responses, dto.NewSavedReportResponse(report))
+	}
+	return responses, nil
+}
+
+// GetSavedReportUseCase returns one of a user's saved reports.
+type GetSavedReportUseCase struct {
+	reports repositories.SavedReportRepository
+	logger  *slog.Logger
+}
+
+// NewGetSavedReportUseCase constructs the use case.
+func NewGetSavedReportUseCase(reports repositories.SavedReportRepository, logger *slog.Logger) *GetSavedReportUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GetSavedReportUseCase{reports: reports, logger: logger}
+}
+
+// Execute returns the saved report; reports of other users are reported as not found.
+func (uc *GetSavedReportUseCase) Execute(ctx context.Context, userID, reportID uuid.UUID) (dto.SavedReportResponse, error) {
+	if uc.reports == nil {
+		return dto.SavedReportResponse{}, errSavedReportRepositoryRequired
+	}
+	report, err := loadOwnedReport(ctx, uc.reports, userID, reportID)
+	if err != nil {
+		return dto.SavedReportResponse{}, err
+	}
+	return dto.NewSavedReportResponse(report), nil
+}
+
+// CreateSavedReportUseCase saves a new report definition for a user.
+type CreateSavedReportUseCase struct {
+	reports repositories.SavedReportRepository
+	logger  *slog.Logger
+}
+
+// NewCreateSavedReportUseCase constructs the use case.
+func NewCreateSavedReportUseCase(reports repositories.SavedReportRepository, logger *slog.Logger) *CreateSavedReportUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &CreateSavedReportUseCase{reports: reports, logger: logger}
+}
+
+// Execute validates and stores the report. Names are unique per user.
+func (uc *CreateSavedReportUseCase) Execute(ctx context.Context, userID uuid.UUID, req dto.SaveReportRequest) (dto.SavedReportResponse, error) {
+	if uc.reports == nil {
+		return dto.SavedReportResponse{}, errSavedReportRepositoryRequired
+	}
+
+	report, err := newSavedReport(userID, req)
+	if err != nil {
+		return dto.SavedReportResponse{}, err
+	}
+
+	count, err := uc.reports.CountByUser(ctx, userID)
+	if err != nil {
+		return dto.SavedReportResponse{}, err
+	}
+	if count >= entities.MaxSavedReportsPerUser {
+		return dto.SavedReportResponse{}, utils.NewAppError(
+			"REPORT_LIMIT_REACHED",
+			"saved report limit reached; delete a report first",
+			fiber.StatusConflict,
+			nil,
+			map[string]any{"limit": entities.MaxSavedReportsPerUser},
+		)
+	}
+
+	report.ID = uuid.New()
+	report.CreatedAt = time.Now().UTC()
+	report.UpdatedAt = report.CreatedAt
+	if err := uc.reports.Create(ctx, &report); err != nil {
+		if errors.Is(err, repositories.ErrDuplicate) {
+			return dto.SavedReportResponse{}, duplicateReportNameError(report.Name)
+		}
+		uc.logger.Error("failed to save report", slog.String("user_id", userID.String()), slog.String("error", err.Error()))
+		return dto.SavedReportResponse{}, err
+	}
+
+	return dto.NewSavedReportResponse(report), nil
+}
+
+// UpdateSavedReportUseCase replaces a saved report's name, description and definition.
+type UpdateSavedReportUseCase struct {
+	reports repositories.SavedReportRepository
+	logger  *slog.Logger
+}
+
+// NewUpdateSavedReportUseCase constructs the use case.
+func NewUpdateSavedReportUseCase(reports repositories.SavedReportRepository, logger *slog.Logger) *UpdateSavedReportUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &UpdateSavedReportUseCase{reports: reports, logger: logger}
+}
+
+// Execute validates and stores the new version of the report.
+func (uc *UpdateSavedReportUseCase) Execute(ctx context.Context, userID, reportID uuid.UUID, req dto.SaveReportRequest) (dto.SavedReportResponse, error) {
+	if uc.reports == nil {
+		return dto.SavedReportResponse{}, errSavedReportRepositoryRequired
+	}
+
+	existing, err := loadOwnedReport(ctx, uc.reports, userID, reportID)
+	if err != nil {
+		return dto.SavedReportResponse{}, err
+	}
+	report, err := newSavedReport(userID, req)
+	if err != nil {
+		return dto.SavedReportResponse{}, err
+	}
+
+	report.ID = existing.ID
+	report.CreatedAt = existing.CreatedAt
+	report.UpdatedAt = time.Now().UTC()
+	if err := uc.reports.Update(ctx, &report); err != nil {
+		switch {
+		case errors.Is(err, repositories.ErrNotFound):
+			return dto.SavedReportResponse{}, reportNotFoundError()
+		case errors.Is(err, repositories.ErrDuplicate):
+			return dto.SavedReportResponse{}, duplicateReportNameError(report.Name)
+		}
+		uc.logger.Error("failed to update report", slog.String("report_id", reportID.String()), slog.String("error", err.Error()))
+		return dto.SavedReportResponse{}, err
+	}
+
+	return dto.NewSavedReportResponse(report), nil
+}
+
+// DeleteSavedReportUseCase removes a saved report.
+type DeleteSavedReportUseCase struct {
+	reports repositories.SavedReportRepository
+	logger  *slog.Logger
+}
+
+// NewDeleteSavedReportUseCase constructs the use case.
+func NewDeleteSavedReportUseCase(reports repositories.SavedReportRepository, logger *slog.Logger) *DeleteSavedReportUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &DeleteSavedReportUseCase{reports: reports, logger: logger}
+}
+
+// Execute deletes the user's report. Report exports already queued from it are unaffected.
+func (uc *DeleteSavedReportUseCase) Execute(ctx context.Context, userID, reportID uuid.UUID) error {
+	if uc.reports == nil {
+		return errSavedReportRepositoryRequired
+	}
+	if err := uc.reports.Delete(ctx, userID, reportID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return reportNotFoundError()
+		}
+		return err
+	}
+	return nil
+}
+
+// newSavedReport validates the request and builds the report it describes.
+func newSavedReport(userID uuid.UUID, req dto.SaveReportRequest) (entities.SavedReport, error) {
+	errs := req.Validate()
+	definition, definitionErrs := prepareReportDefinition("definition", req.Definition)
+	errs = append(errs, definitionErrs...)
+	if err := wrapReportValidationError(errs); err != nil {
+		return entities.SavedReport{}, err
+	}
+
+	report := entities.SavedReport{
+		UserID:      userID,
+		Name:        strings.TrimSpace(req.Name),
+		Description: strings.TrimSpace(req.Description),
+		Definition:  definition,
+	}
+	if err := report.Validate(); err != nil {
+		return entities.SavedReport{}, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"report payload invalid",
+			fiber.StatusBadRequest,
+			err,
+			nil,
+		)
+	}
+	return report, nil
+}
+
+func duplicateReportNameError(name string) error {
+	return utils.NewAppError(
+		"REPORT_NAME_TAKEN",
+		"a saved report with this name already exists",
+		fiber.StatusConflict,
+		nil,
+		map[string]any{"name": name},
+	)
+}
@@ -0,0 +1,359 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	appLogging "github.com/crypto-wallet/backend/internal/infrastructure/logging"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+const (
+	taxReportPageSize = 500
+	// maxTaxReportTransactions bounds the history replayed for one report.
+	maxTaxReportTransactions = 50000
+	// maxTaxReportPricePoints bounds the daily closes loaded per asset, about 27 years.
+	maxTaxReportPricePoints = 10000
+)
+
+var (
+	errTaxReportDependencies = errors.New("tax report: dependencies not configured")
+
+	// taxReportCSVHeader follows the columns of IRS Form 8949, which tax software imports.
+	taxReportCSVHeader = []string{
+		"Description of property", "Date acquired", "Date sold or disposed",
+		"Proceeds", "Cost or other basis", "Gain or (loss)", "Term",
+	}
+)
+
+// TaxReportUseCase computes the cost basis and realized gains of a user's disposals in a
+// calendar year by replaying their confirmed transactions since the first one.
+type TaxReportUseCase struct {
+	wallets      repositories.WalletRepository
+	transactions repositories.TransactionRepository
+	rates        repositories.RateRepository
+	logger       *slog.Logger
+	now          func() time.Time
+}
+
+// NewTaxReportUseCase constructs the use case.
+func NewTaxReportUseCase(wallets repositories.WalletRepository, transactions repositories.TransactionRepository, rates repositories.RateRepository, logger *slog.Logger) *TaxReportUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &TaxReportUseCase{
+		wallets:      wallets,
+		transactions: transactions,
+		rates:        rates,
+		logger:       logger,
+		now:          func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Execute builds the report. Receives and swap-ins open lots and sends and swap-outs dispose of
+// them, both valued at the asset's daily USD close on the transaction date. Network fees are not
+// treated as disposals.
+func (uc *TaxReportUseCase) Execute(ctx context.Context, userID uuid.UUID, req dto.TaxReportRequest) (dto.TaxReport, error) {
+	if uc.wallets == nil || uc.transactions == nil || uc.rates == nil {
+		return dto.TaxReport{}, errTaxReportDependencies
+	}
+
+	req.SetDefaults()
+	if errs := req.Validate(); !errs.IsEmpty() {
+		return dto.TaxReport{}, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"invalid tax report parameters",
+			fiber.StatusBadRequest,
+			errs,
+			map[string]any{"errors": errs},
+		)
+	}
+	method, _ := entities.ParseCostBasisMethod(req.Method)
+	yearStart := time.Date(req.Year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	yearEnd := yearStart.AddDate(1, 0, 0).Add(-time.Nanosecond)
+
+	logger := appLogging.LoggerFromContext(ctx, uc.logger).With(
+		slog.String("user_id", userID.String()),
+		slog.Int("year", req.Year),
+	)
+
+	symbols, err := uc.walletSymbols(ctx, userID)
+	if err != nil {
+		return dto.TaxReport{}, err
+	}
+	history, err := uc.history(ctx, symbols, yearEnd)
+	if err != nil {
+		logger.Error("failed to load transactions for tax report", slog.String("error", err.Error()))
+		return dto.TaxReport{}, err
+	}
+
+	report := dto.TaxReport{
+		Year:        req.Year,
+		Method:      string(method),
+		Currency:    string(entities.CurrencyUSD),
+		Disposals:   []dto.TaxDisposal{},
+		OpenLots:    []dto.TaxLotHolding{},
+		GeneratedAt: uc.now(),
+	}
+
+	prices, missing, err := uc.dailyCloses(ctx, history, symbols, yearEnd)
+	if err != nil {
+		logger.Error("failed to load prices for tax report", slog.String("error", err.Error()))
+		return dto.TaxReport{}, err
+	}
+	for _, symbol := range missing {
+		report.Warnings = append(report.Warnings, "no price history for "+symbol+"; its transactions are valued at zero")
+	}
+
+	ledger := entities.NewCostBasisLedger(method)
+	gains := make([]entities.RealizedGain, 0)
+	unmatched := make(map[string]bool)
+	for _, tx := range history {
+		symbol := symbols[tx.GetWalletID()]
+		at := tx.GetCreatedAt().UTC()
+		value := tx.GetAmount().Mul(prices.at(symbol, at))
+
+		switch tx.GetType() {
+		case entities.TransactionTypeReceive, entities.TransactionTypeSwapIn, entities.TransactionTypeP2PReceive:
+			ledger.Acquire(entities.TaxLot{
+				Symbol:        symbol,
+				TransactionID: tx.GetID(),
+				AcquiredAt:    at,
+				Quantity:      tx.GetAmount(),
+				UnitCost:      prices.at(symbol, at),
+			})
+		case entities.TransactionTypeSend, entities.TransactionTypeSwapOut, entities.TransactionTypeP2PSend:
+			disposed := ledger.Dispose(symbol, tx.GetID(), at, tx.GetAmount(), value)
+			if at.Before(yearStart) {
+				continue
+			}
+			for _, gain := range disposed {
+				if gain.Unmatched && !unmatched[symbol] {
+					unmatched[symbol] = true
+					report.Warnings = append(report.Warnings, "disposals of "+symbol+" exceed its recorded acquisitions; the excess has a zero cost basis")
+				}
+			}
+			gains = append(gains, disposed...)
+		}
+	}
+
+	summariseGains(&report, gains)
+	for _, lot := range ledger.OpenLots() {
+		report.OpenLots = append(report.OpenLots, dto.TaxLotHolding{
+			Symbol:        lot.Symbol,
+			TransactionID: lot.TransactionID,
+			AcquiredAt:    lot.AcquiredAt,
+			Quantity:      lot.Quantity.String(),
+			UnitCost:      lot.UnitCost.StringFixedBank(2),
+			CostBasis:     lot.CostBasis().StringFixedBank(2),
+		})
+	}
+
+	logger.Debug("tax report calculated",
+		slog.Int("transaction_count", len(history)),
+		slog.Int("disposal_count", len(report.Disposals)),
+	)
+	return report, nil
+}
+
+// walletSymbols maps each of the user's wallets to the asset it holds.
+func (uc *TaxReportUseCase) walletSymbols(ctx context.Context, userID uuid.UUID) (map[uuid.UUID]string, error) {
+	wallets, err := uc.wallets.ListByUser(ctx, userID, repositories.WalletFilter{}, repositories.ListOptions{Limit: 1000})
+	if err != nil {
+		return nil, utils.NewAppError(
+			"DATABASE_ERROR",
+			"unable to load wallets",
+			fiber.StatusInternalServerError,
+			err,
+			map[string]any{"userId": userID.String()},
+		)
+	}
+
+	symbols := make(map[uuid.UUID]string, len(wallets))
+	for _, wallet := range wallets {
+		if symbol := entities.WalletAssetSymbol(wallet); symbol != "" {
+			symbols[wallet.GetID()] = symbol
+		}
+	}
+	return symbols, nil
+}
+
+// history returns the confirmed transactions of the wallets up to the end date, oldest first.
+func (uc *TaxReportUseCase) history(ctx context.Context, symbols map[uuid.UUID]string, end time.Time) ([]entities.Transaction, error) {
+	if len(symbols) == 0 {
+		return nil, nil
+	}
+
+	status := entities.TransactionStatusConfirmed
+	filter := repositories.TransactionFilter{Status: &status, EndDate: &end}
+	for walletID := range symbols {
+		filter.WalletIDs = append(filter.WalletIDs, walletID)
+	}
+
+	history := make([]entities.Transaction, 0)
+	var after *repositories.PageCursor
+	for {
+		page, err := uc.transactions.ListChronological(ctx, filter, after, taxReportPageSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(history)+len(page) > maxTaxReportTransactions {
+			return nil, utils.NewAppError(
+				"TAX_REPORT_TOO_LARGE",
+				fmt.Sprintf("transaction history exceeds %d transactions", maxTaxReportTransactions),
+				fiber.StatusUnprocessableEntity,
+				nil,
+				nil,
+			)
+		}
+		history = append(history, page...)
+		if len(page) < taxReportPageSize {
+			return history, nil
+		}
+		last := page[len(page)-1]
+		after = &repositories.PageCursor{CreatedAt: last.GetCreatedAt(), ID: last.GetID()}
+	}
+}
+
+// dailyCloses loads the daily closes of every asset in the history, from the day before its first
+// transaction, and lists the assets without any.
+func (uc *TaxReportUseCase) dailyCloses(ctx context.Context, history []entities.Transaction, symbols map[uuid.UUID]string, end time.Time) (priceSeries, []string, error) {
+	first := make(map[string]time.Time)
+	for _, tx := range history {
+		symbol := symbols[tx.GetWalletID()]
+		if _, ok := first[symbol]; !ok {
+			first[symbol] = tx.GetCreatedAt().UTC()
+		}
+	}
+
+	series := make(priceSeries, len(first))
+	missing := make([]string, 0)
+	for symbol, start := range first {
+		from := start.AddDate(0, 0, -1)
+		points, err := uc.rates.ListPriceHistory(ctx, repositories.PriceHistoryFilter{
+			Symbol:   symbol,
+			Interval: entities.Interval1d,
+			From:     &from,
+			To:       &end,
+		}, repositories.ListOptions{
+			Limit:     maxTaxReportPricePoints,
+			SortBy:    "timestamp",
+			SortOrder: repositories.SortAscending,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(points) == 0 {
+			missing = append(missing, symbol)
+			continue
+		}
+		series[symbol] = points
+	}
+	sort.Strings(missing)
+	return series, missing, nil
+}
+
+// priceSeries holds ascending daily closes per asset.
+type priceSeries map[string][]entities.PriceHistory
+
+// at returns the latest close at or before t, falling back to the earliest close after it, or zero
+// when the asset has no prices.
+func (s priceSeries) at(symbol string, t time.Time) decimal.Decimal {
+	points := s[symbol]
+	if len(points) == 0 {
+		return decimal.Zero
+	}
+	idx := sort.Search(len(points), func(i int) bool { return points[i].GetTimestamp().After(t) })
+	if idx == 0 {
+		return points[0].GetClose()
+	}
+	return points[idx-1].GetClose()
+}
+
+// summariseGains adds the realized gains to the report with their totals.
+func summariseGains(report *dto.TaxReport, gains []entities.RealizedGain) {
+	proceeds, costBasis, shortTerm, longTerm := decimal.Zero, decimal.Zero, decimal.Zero, decimal.Zero
+	for _, gain := range gains {
+		disposal := dto.TaxDisposal{
+			Symbol:        gain.Symbol,
+			TransactionID: gain.TransactionID,
+			Quantity:      gain.Quantity.String(),
+			DisposedAt:    gain.DisposedAt,
+			Proceeds:      gain.Proceeds.StringFixedBank(2),
+			CostBasis:     gain.CostBasis.StringFixedBank(2),
+			Gain:          gain.Gain().StringFixedBank(2),
+			Term:          "short",
+		}
+		if !gain.Unmatched {
+			acquired := gain.AcquiredAt
+			disposal.AcquiredAt = &acquired
+		}
+		if gain.LongTerm() {
+			disposal.Term = "long"
+			longTerm = longTerm.Add(gain.Gain())
+		} else {
+			shortTerm = shortTerm.Add(gain.Gain())
+		}
+		proceeds = proceeds.Add(gain.Proceeds)
+		costBasis = costBasis.Add(gain.CostBasis)
+		report.Disposals = append(report.Disposals, disposal)
+	}
+
+	report.TotalProceeds = proceeds.StringFixedBank(2)
+	report.TotalCostBasis = costBasis.StringFixedBank(2)
+	report.ShortTermGain = shortTerm.StringFixedBank(2)
+	report.LongTermGain = longTerm.StringFixedBank(2)
+	report.TotalGain = shortTerm.Add(longTerm).StringFixedBank(2)
+}
+
+// TaxReportCSV renders the report's disposals with the columns of IRS Form 8949. Disposals beyond
+// the recorded acquisitions leave the acquisition date blank.
+func TaxReportCSV(report dto.TaxReport) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(taxReportCSVHeader); err != nil {
+		return nil, fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, disposal := range report.Disposals {
+		acquired := ""
+		if disposal.AcquiredAt != nil {
+			acquired = disposal.AcquiredAt.UTC().Format("01/02/2006")
+		}
+		term := "Short-term"
+		if disposal.Term == "long" {
+			term = "Long-term"
+		}
+		record := []string{
+			disposal.Quantity + " " + disposal.Symbol,
+			acquired,
+			disposal.DisposedAt.UTC().Format("01/02/2006"),
+			disposal.Proceeds,
+			disposal.CostBasis,
+			disposal.Gain,
+			term,
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, fmt.Errorf("failed to flush CSV: %w", err)
+	}
+	return buf.Bytes(), nil
+}
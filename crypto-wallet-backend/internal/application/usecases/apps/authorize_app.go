@@ -0,0 +1,158 @@
+package apps
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// AuthorizeAppInput encapsulates the arguments required to connect a third-party app.
+type AuthorizeAppInput struct {
+	UserID  string
+	Request dto.AppConsentRequest
+}
+
+// AuthorizeAppUseCase records the user's consent and issues the app a scoped access token.
+type AuthorizeAppUseCase struct {
+	consents repositories.AppConsentRepository
+	tokens   TokenIssuer
+	audit    AuditLogger
+	tokenTTL time.Duration
+	logger   *slog.Logger
+	now      func() time.Time
+}
+
+// NewAuthorizeAppUseCase constructs the use case; a non-positive TTL falls back to DefaultAccessTokenTTL.
+func NewAuthorizeAppUseCase(consents repositories.AppConsentRepository, tokens TokenIssuer, auditLogger AuditLogger, tokenTTL time.Duration, logger *slog.Logger) *AuthorizeAppUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if tokenTTL <= 0 {
+		tokenTTL = DefaultAccessTokenTTL
+	}
+	return &AuthorizeAppUseCase{
+		consents: consents,
+		tokens:   tokens,
+		audit:    auditLogger,
+		tokenTTL: tokenTTL,
+		logger:   logger,
+		now:      time.Now,
+	}
+}
+
+// Execute checks the requested scopes against the client's registration, stores the consent and
+// returns a token limited to those scopes.
+func (uc *AuthorizeAppUseCase) Execute(ctx context.Context, input AuthorizeAppInput) (*dto.AppConsentResponse, error) {
+	if uc.consents == nil || uc.tokens == nil {
+		return nil, errors.New("authorize app: dependencies not configured")
+	}
+
+	userID, err := parseUserID(input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := wrapValidationError(input.Request.Validate()); err != nil {
+		return nil, err
+	}
+
+	clientID := strings.TrimSpace(input.Request.ClientID)
+	client, err := uc.consents.GetClient(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, appNotFoundError(clientID)
+		}
+		return nil, err
+	}
+	if !client.IsActive {
+		return nil, appNotFoundError(clientID)
+	}
+
+	scopes := make([]entities.OAuthScope, 0, len(input.Request.Scopes))
+	for _, raw := range input.Request.Scopes {
+		scope := entities.ParseOAuthScope(raw)
+		if !client.Allows(scope) {
+			return nil, utils.NewAppError(
+				"APP_SCOPE_NOT_ALLOWED",
+				"app is not registered for the requested scope",
+				fiber.StatusBadRequest,
+				nil,
+				map[string]any{"client_id": clientID, "scope": scope},
+			)
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	consent := entities.AppConsent{
+		ID:         uuid.New(),
+		UserID:     userID,
+		ClientID:   client.ID,
+		ClientName: client.Name,
+		Scopes:     scopes,
+		CreatedAt:  uc.now().UTC(),
+	}
+	if err := consent.Validate(); err != nil {
+		return nil, utils.NewAppError(
+			"APP_CONSENT_INVALID",
+			"app consent is invalid",
+			fiber.StatusBadRequest,
+			err,
+			nil,
+		)
+	}
+
+	if err := uc.consents.CreateConsent(ctx, consent); err != nil {
+		return nil, err
+	}
+
+	app := dto.MapConnectedApp(consent)
+	token, err := uc.tokens.GenerateScopedToken(ctx, userID.String(), client.ID, consent.ID.String(), app.Scopes, uc.tokenTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.audit != nil {
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID:  userID.String(),
+			Action:   "app_consent_granted",
+			TargetID: consent.ID.String(),
+			Metadata: map[string]any{
+				"client_id": client.ID,
+				"scopes":    app.Scopes,
+			},
+		}); err != nil {
+			uc.logger.Warn("failed to record app consent audit entry", slog.String("error", err.Error()))
+		}
+	}
+
+	return &dto.AppConsentResponse{
+		App:         app,
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(uc.tokenTTL.Seconds()),
+		Scope:       strings.Join(app.Scopes, " "),
+	}, nil
+}
+
+func appNotFoundError(clientID string) error {
+	return utils.NewAppError(
+		"APP_NOT_FOUND",
+		"app not found",
+		fiber.StatusNotFound,
+		nil,
+		map[string]any{"client_id": clientID},
+	)
+}
@@ -0,0 +1,123 @@
+package apps
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// ListConnectedAppsUseCase returns the apps a user currently has connected.
+type ListConnectedAppsUseCase struct {
+	consents repositories.AppConsentRepository
+}
+
+// NewListConnectedAppsUseCase constructs the use case.
+func NewListConnectedAppsUseCase(consents repositories.AppConsentRepository) *ListConnectedAppsUseCase {
+	return &ListConnectedAppsUseCase{consents: consents}
+}
+
+// Execute lists the user's active consents.
+func (uc *ListConnectedAppsUseCase) Execute(ctx context.Context, rawUserID string) ([]dto.ConnectedApp, error) {
+	if uc.consents == nil {
+		return nil, errors.New("list connected apps: repository not configured")
+	}
+
+	userID, err := parseUserID(rawUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	consents, err := uc.consents.ListActiveConsents(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.ConnectedApp, 0, len(consents))
+	for _, consent := range consents {
+		items = append(items, dto.MapConnectedApp(consent))
+	}
+	return items, nil
+}
+
+// RevokeConnectedAppInput encapsulates the arguments required to disconnect an app.
+type RevokeConnectedAppInput struct {
+	UserID    string
+	ConsentID string
+}
+
+// RevokeConnectedAppUseCase revokes a consent; tokens issued under it are rejected from then on.
+type RevokeConnectedAppUseCase struct {
+	consents repositories.AppConsentRepository
+	audit    AuditLogger
+	logger   *slog.Logger
+	now      func() time.Time
+}
+
+// NewRevokeConnectedAppUseCase constructs the use case.
+func NewRevokeConnectedAppUseCase(consents repositories.AppConsentRepository, auditLogger AuditLogger, logger *slog.Logger) *RevokeConnectedAppUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RevokeConnectedAppUseCase{
+		consents: consents,
+		audit:    auditLogger,
+		logger:   logger,
+		now:      time.Now,
+	}
+}
+
+// Execute revokes one of the user's active consents.
+func (uc *RevokeConnectedAppUseCase) Execute(ctx context.Context, input RevokeConnectedAppInput) error {
+	if uc.consents == nil {
+		return errors.New("revoke connected app: repository not configured")
+	}
+
+	userID, err := parseUserID(input.UserID)
+	if err != nil {
+		return err
+	}
+	consentID, err := uuid.Parse(strings.TrimSpace(input.ConsentID))
+	if err != nil {
+		return utils.NewAppError(
+			"INVALID_CONSENT_ID",
+			"invalid consent identifier",
+			fiber.StatusBadRequest,
+			err,
+			nil,
+		)
+	}
+
+	if err := uc.consents.RevokeConsent(ctx, userID, consentID, uc.now().UTC()); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return utils.NewAppError(
+				"APP_CONSENT_NOT_FOUND",
+				"connected app not found",
+				fiber.StatusNotFound,
+				err,
+				map[string]any{"consent_id": consentID},
+			)
+		}
+		return err
+	}
+
+	if uc.audit != nil {
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID:  userID.String(),
+			Action:   "app_consent_revoked",
+			TargetID: consentID.String(),
+		}); err != nil {
+			uc.logger.Warn("failed to record app consent audit entry", slog.String("error", err.Error()))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package apps
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// DefaultAccessTokenTTL bounds how long an app token stays valid without the user re-consenting.
+const DefaultAccessTokenTTL = time.Hour
+
+// AuditLogger captures audit events for compliance.
+type AuditLogger interface {
+	Record(ctx context.Context, entry audit.Entry) error
+}
+
+// TokenIssuer signs access tokens scoped to a consent.
+type TokenIssuer interface {
+	GenerateScopedToken(ctx context.Context, subject, clientID, consentID string, scopes []string, ttl time.Duration) (string, error)
+}
+
+func parseUserID(raw string) (uuid.UUID, error) {
+	userID, err := uuid.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return uuid.Nil, utils.NewAppError(
+			"INVALID_USER_ID",
+			"invalid user identifier",
+			fiber.StatusBadRequest,
+			err,
+			nil,
+		)
+	}
+	return userID, nil
+}
+
+func wrapValidationError(errs utils.ValidationErrors) error {
+	if errs.IsEmpty() {
+		return nil
+	}
+	return utils.NewAppError(
+		"VALIDATION_ERROR",
+		"app consent payload invalid",
+		fiber.StatusBadRequest,
+		errs,
+		map[string]any{"errors": errs},
+	)
+}
@@ -0,0 +1,160 @@
+package audit
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const (
+	archiveBatchSize = 5000
+	// maxArchiveBatchesPerRun keeps a single run bounded when a large backlog has built up.
+	maxArchiveBatchesPerRun = 20
+)
+
+// ArchiveAuditLogsUseCase moves audit records older than the retention period to cold storage.
+type ArchiveAuditLogsUseCase struct {
+	logs      repositories.AuditLogRepository
+	store     ArchiveStore
+	retention time.Duration
+	logger    *slog.Logger
+}
+
+// NewArchiveAuditLogsUseCase constructs the use case.
+func NewArchiveAuditLogsUseCase(logs repositories.AuditLogRepository, store ArchiveStore, retention time.Duration, logger *slog.Logger) *ArchiveAuditLogsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if retention <= 0 {
+		retention = entities.DefaultAuditRetention
+	}
+	return &ArchiveAuditLogsUseCase{
+		logs:      logs,
+		store:     store,
+		retention: retention,
+		logger:    logger,
+	}
+}
+
+// Execute archives expired records in batches and reports how many records were archived.
+// Each batch is written to the store as gzipped JSON lines before the records are deleted,
+// and the archive keeps the last hash so the remaining chain stays verifiable.
+func (uc *ArchiveAuditLogsUseCase) Execute(ctx context.Context) (int, error) {
+	if uc.store == nil {
+		return 0, errors.New("audit archive store is not configured")
+	}
+
+	cutoff := time.Now().UTC().Add(-uc.retention)
+	archived := 0
+	for batch := 0; batch < maxArchiveBatchesPerRun; batch++ {
+		records, err := uc.logs.ListBefore(ctx, cutoff, archiveBatchSize)
+		if err != nil {
+			return archived, err
+		}
+		if len(records) == 0 {
+			break
+		}
+
+		if err := uc.archive(ctx, records); err != nil {
+			return archived, err
+		}
+		archived += len(records)
+
+		if len(records) < archiveBatchSize {
+			break
+		}
+	}
+
+	return archived, nil
+}
+
+func (uc *ArchiveAuditLogsUseCase) archive(ctx context.Context, records []entities.AuditRecord) error {
+	first := records[0]
+	last := records[len(records)-1]
+
+	content, err := encodeArchive(records)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(content)
+
+	key := fmt.Sprintf("audit-%020d-%020d.jsonl.gz", first.Sequence, last.Sequence)
+	location, err := uc.store.Put(ctx, key, content)
+	if err != nil {
+		return fmt.Errorf("failed to store audit archive: %w", err)
+	}
+
+	lastHash, err := uc.chainHead(ctx, records)
+	if err != nil {
+		return err
+	}
+
+	archive := entities.AuditArchive{
+		ID:            uuid.New(),
+		FromSequence:  first.Sequence,
+		ToSequence:    last.Sequence,
+		FromTimestamp: first.Timestamp,
+		ToTimestamp:   last.Timestamp,
+		RecordCount:   len(records),
+		Location:      location,
+		Checksum:      hex.EncodeToString(sum[:]),
+		LastHash:      lastHash,
+		ArchivedAt:    time.Now().UTC(),
+	}
+	if err := uc.logs.RecordArchive(ctx, archive); err != nil {
+		return fmt.Errorf("failed to record audit archive: %w", err)
+	}
+
+	uc.logger.Info("archived audit records",
+		slog.Int64("from_sequence", archive.FromSequence),
+		slog.Int64("to_sequence", archive.ToSequence),
+		slog.Int("count", archive.RecordCount),
+		slog.String("location", location))
+	return nil
+}
+
+// chainHead returns the hash the remaining records chain onto once this batch is removed.
+// Records written before hash chaining carry no hash, so the previous anchor is kept for them.
+func (uc *ArchiveAuditLogsUseCase) chainHead(ctx context.Context, records []entities.AuditRecord) (string, error) {
+	for i := len(records) - 1; i >= 0; i-- {
+		if records[i].Hash != "" {
+			return records[i].Hash, nil
+		}
+	}
+
+	previous, err := uc.logs.LatestArchive(ctx)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return previous.LastHash, nil
+}
+
+func encodeArchive(records []entities.AuditRecord) ([]byte, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	encoder := json.NewEncoder(gz)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return nil, fmt.Errorf("failed to encode audit record: %w", err)
+		}
+	}
+	if err := gz.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress audit archive: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,49 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// ListAuditArchivesUseCase returns archived audit batches, newest first.
+type ListAuditArchivesUseCase struct {
+	logs   repositories.AuditLogRepository
+	logger *slog.Logger
+}
+
+// NewListAuditArchivesUseCase constructs the use case.
+func NewListAuditArchivesUseCase(logs repositories.AuditLogRepository, logger *slog.Logger) *ListAuditArchivesUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ListAuditArchivesUseCase{
+		logs:   logs,
+		logger: logger,
+	}
+}
+
+// auditArchiveListCaps bounds archive listing.
+var auditArchiveListCaps = repositories.PaginationCaps{MaxLimit: 100, MaxOffset: 5000}
+
+// Execute lists the archives.
+func (uc *ListAuditArchivesUseCase) Execute(ctx context.Context, limit, offset int) (dto.AuditArchiveList, error) {
+	opts := repositories.ListOptions{Limit: limit, Offset: offset, Caps: auditArchiveListCaps}
+	if err := opts.CheckCaps(); err != nil {
+		return dto.AuditArchiveList{}, err
+	}
+	opts = opts.WithDefaults()
+
+	archives, err := uc.logs.ListArchives(ctx, opts)
+	if err != nil {
+		return dto.AuditArchiveList{}, err
+	}
+
+	return dto.AuditArchiveList{
+		Archives: archives,
+		Limit:    opts.Limit,
+		Offset:   opts.Offset,
+	}, nil
+}
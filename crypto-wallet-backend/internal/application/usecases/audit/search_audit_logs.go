@@ -0,0 +1,54 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// SearchAuditLogsUseCase returns audit records matching compliance filters, newest first.
+type SearchAuditLogsUseCase struct {
+	logs   repositories.AuditLogRepository
+	logger *slog.Logger
+}
+
+// NewSearchAuditLogsUseCase constructs the use case.
+func NewSearchAuditLogsUseCase(logs repositories.AuditLogRepository, logger *slog.Logger) *SearchAuditLogsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SearchAuditLogsUseCase{
+		logs:   logs,
+		logger: logger,
+	}
+}
+
+// auditSearchCaps bounds audit searches; deeper investigations should narrow the filter or export.
+var auditSearchCaps = repositories.PaginationCaps{MaxLimit: 200, MaxOffset: 10000}
+
+// Execute validates the query and runs the search.
+func (uc *SearchAuditLogsUseCase) Execute(ctx context.Context, query dto.AuditLogQuery) (dto.AuditLogList, error) {
+	if err := wrapValidationError(query.Validate()); err != nil {
+		return dto.AuditLogList{}, err
+	}
+
+	opts := repositories.ListOptions{Limit: query.Limit, Offset: query.Offset, Caps: auditSearchCaps}
+	if err := opts.CheckCaps(); err != nil {
+		return dto.AuditLogList{}, err
+	}
+	opts = opts.WithDefaults()
+
+	records, total, err := uc.logs.Search(ctx, filterFromQuery(query), opts)
+	if err != nil {
+		return dto.AuditLogList{}, err
+	}
+
+	return dto.AuditLogList{
+		Records: records,
+		Total:   total,
+		Limit:   opts.Limit,
+		Offset:  opts.Offset,
+	}, nil
+}
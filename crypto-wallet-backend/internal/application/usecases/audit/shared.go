@@ -0,0 +1,42 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// ArchiveStore writes archived audit batches to cold storage and returns where they were stored.
+type ArchiveStore interface {
+	Put(ctx context.Context, key string, data []byte) (string, error)
+}
+
+// filterFromQuery converts a validated query into a repository filter.
+func filterFromQuery(query dto.AuditLogQuery) repositories.AuditLogFilter {
+	return repositories.AuditLogFilter{
+		ActorID:      query.ActorID(),
+		Actions:      query.Actions(),
+		ResourceType: query.ResourceType,
+		ResourceID:   query.ResourceUUID(),
+		IPAddress:    query.IP,
+		From:         query.FromTime(),
+		To:           query.ToTime(),
+	}
+}
+
+func wrapValidationError(errs utils.ValidationErrors) error {
+	if errs.IsEmpty() {
+		return nil
+	}
+	return utils.NewAppError(
+		"VALIDATION_ERROR",
+		"audit query invalid",
+		fiber.StatusBadRequest,
+		errs,
+		map[string]any{"errors": errs},
+	)
+}
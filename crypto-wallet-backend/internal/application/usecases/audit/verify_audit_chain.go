@@ -0,0 +1,82 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const verifyPageSize = 1000
+
+// VerifyAuditChainUseCase walks the stored audit records and checks every hash link.
+type VerifyAuditChainUseCase struct {
+	logs   repositories.AuditLogRepository
+	logger *slog.Logger
+}
+
+// NewVerifyAuditChainUseCase constructs the use case.
+func NewVerifyAuditChainUseCase(logs repositories.AuditLogRepository, logger *slog.Logger) *VerifyAuditChainUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &VerifyAuditChainUseCase{
+		logs:   logs,
+		logger: logger,
+	}
+}
+
+// Execute verifies the chain starting from the latest archive, or from genesis when nothing was archived.
+// It stops at the first record whose link or hash does not match.
+func (uc *VerifyAuditChainUseCase) Execute(ctx context.Context) (dto.AuditChainVerification, error) {
+	result := dto.AuditChainVerification{Valid: true}
+
+	prevHash := entities.AuditGenesisHash
+	var afterSequence int64
+	archive, err := uc.logs.LatestArchive(ctx)
+	switch {
+	case err == nil:
+		if archive.LastHash != "" {
+			prevHash = archive.LastHash
+		}
+		afterSequence = archive.ToSequence
+		result.AnchoredArchive = archive.ID.String()
+	case !errors.Is(err, repositories.ErrNotFound):
+		return dto.AuditChainVerification{}, err
+	}
+
+	for {
+		records, err := uc.logs.ListAfterSequence(ctx, afterSequence, verifyPageSize)
+		if err != nil {
+			return dto.AuditChainVerification{}, err
+		}
+
+		for _, record := range records {
+			if result.FirstSequence == 0 {
+				result.FirstSequence = record.Sequence
+			}
+			if !record.VerifyChain(prevHash) {
+				result.Valid = false
+				result.BrokenAtSequence = record.Sequence
+				result.VerifiedAt = time.Now().UTC()
+				uc.logger.Error("audit chain verification failed", slog.Int64("sequence", record.Sequence))
+				return result, nil
+			}
+			prevHash = record.Hash
+			afterSequence = record.Sequence
+			result.LastSequence = record.Sequence
+			result.RecordsChecked++
+		}
+
+		if len(records) < verifyPageSize {
+			break
+		}
+	}
+
+	result.VerifiedAt = time.Now().UTC()
+	return result, nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/crypto-wallet/backend/internal/application/dto"
 	"github.com/crypto-wallet/backend/internal/domain/entities"
 	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
 	"github.com/crypto-wallet/backend/internal/infrastructure/security"
 	"github.com/crypto-wallet/backend/pkg/utils"
 )
@@ -25,16 +26,18 @@ type DisableTwoFactorInput struct {
 
 // DisableTwoFactorUseCase handles deactivating two-factor authentication.
 type DisableTwoFactorUseCase struct {
-	users  repositories.UserRepository
-	logger *slog.Logger
+	users    repositories.UserRepository
+	notifier SecurityNotifier
+	audit    AuditLogger
+	logger   *slog.Logger
 }
 
-// NewDisableTwoFactorUseCase constructs the use case.
-func NewDisableTwoFactorUseCase(users repositories.UserRepository, logger *slog.Logger) *DisableTwoFactorUseCase {
+// NewDisableTwoFactorUseCase constructs the use case. The security notifier is optional.
+func NewDisableTwoFactorUseCase(users repositories.UserRepository, notifier SecurityNotifier, auditLogger AuditLogger, logger *slog.Logger) *DisableTwoFactorUseCase {
 	if logger == nil {
 		logger = slog.Default()
 	}
-	return &DisableTwoFactorUseCase{users: users, logger: logger}
+	return &DisableTwoFactorUseCase{users: users, notifier: notifier, audit: auditLogger, logger: logger}
 }
 
 // Execute disables two-factor authentication after optional verification.
@@ -58,6 +61,7 @@ func (uc *DisableTwoFactorUseCase) Execute(ctx context.Context, input DisableTwo
 	if err != nil {
 		return nil, err
 	}
+	before := user.IsTwoFactorEnabled()
 
 	if errs := input.Payload.Validate(); !errs.IsEmpty() {
 		return nil, utils.NewAppError(
@@ -73,6 +77,12 @@ func (uc *DisableTwoFactorUseCase) Execute(ctx context.Context, input DisableTwo
 	if code != "" {
 		secret := strings.TrimSpace(user.GetTwoFactorSecret())
 		if secret == "" || !security.ValidateTOTP(secret, code) {
+			recordAudit(ctx, uc.audit, uc.logger, audit.Entry{
+				ActorID:  userID,
+				Action:   AuditActionTwoFactorDisable,
+				TargetID: userID.String(),
+				Error:    "invalid verification code",
+			})
 			return nil, utils.NewAppError(
 				"TWO_FACTOR_CODE_INVALID",
 				"verification code is invalid or expired",
@@ -89,11 +99,26 @@ func (uc *DisableTwoFactorUseCase) Execute(ctx context.Context, input DisableTwo
 	}
 
 	entity.DisableTwoFactor()
-	entity.Touch(time.Now().UTC())
+	now := time.Now().UTC()
+	entity.Touch(now)
 
 	if err := uc.users.Update(ctx, entity); err != nil {
 		return nil, err
 	}
 
+	recordAudit(ctx, uc.audit, uc.logger, audit.Entry{
+		ActorID:  userID,
+		Action:   AuditActionTwoFactorDisable,
+		TargetID: userID.String(),
+		Before:   twoFactorSnapshot(before),
+		After:    twoFactorSnapshot(false),
+	})
+
+	if uc.notifier != nil {
+		notifySecurity(ctx, uc.logger, AuditActionTwoFactorDisable, func(ctx context.Context) error {
+			return uc.notifier.TwoFactorChanged(ctx, entity, false, now)
+		})
+	}
+
 	return &dto.TwoFactorStatusResponse{Enabled: false}, nil
 }
@@ -0,0 +1,268 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// emailVerificationCooldown limits how often a user can request a new verification link.
+const emailVerificationCooldown = time.Minute
+
+// SendEmailVerificationUseCase issues a verification token and emails the link to the user.
+type SendEmailVerificationUseCase struct {
+	users   repositories.UserRepository
+	tokens  repositories.EmailVerificationRepository
+	emails  *SecurityEmails
+	linkURL string
+	ttl     time.Duration
+	logger  *slog.Logger
+	clock   func() time.Time
+}
+
+// NewSendEmailVerificationUseCase constructs the use case. linkURL is the page that redeems the
+// token; the token is appended as the "token" query parameter.
+func NewSendEmailVerificationUseCase(
+	users repositories.UserRepository,
+	tokens repositories.EmailVerificationRepository,
+	emails *SecurityEmails,
+	linkURL string,
+	logger *slog.Logger,
+) *SendEmailVerificationUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SendEmailVerificationUseCase{
+		users:   users,
+		tokens:  tokens,
+		emails:  emails,
+		linkURL: strings.TrimSpace(linkURL),
+		ttl:     entities.DefaultEmailVerificationTTL,
+		logger:  logger,
+		clock:   time.Now,
+	}
+}
+
+// Execute sends a fresh verification link to the user's current email address.
+func (uc *SendEmailVerificationUseCase) Execute(ctx context.Context, userID uuid.UUID) (*dto.EmailVerificationSentResponse, error) {
+	if uc.users == nil || uc.tokens == nil || uc.emails == nil {
+		return nil, errors.New("email verification: dependencies not configured")
+	}
+
+	user, err := uc.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsEmailVerified() {
+		return nil, utils.NewAppError(
+			"EMAIL_ALREADY_VERIFIED",
+			"email address is already verified",
+			fiber.StatusConflict,
+			nil,
+			nil,
+		)
+	}
+
+	now := uc.clock().UTC()
+	latest, err := uc.tokens.GetLatestByUser(ctx, userID)
+	switch {
+	case err == nil:
+		if retryAt := latest.CreatedAt.Add(emailVerificationCooldown); now.Before(retryAt) {
+			return nil, utils.NewAppError(
+				"EMAIL_VERIFICATION_COOLDOWN",
+				"a verification email was sent recently",
+				fiber.StatusTooManyRequests,
+				nil,
+				map[string]any{"availableAt": retryAt},
+			)
+		}
+	case !errors.Is(err, repositories.ErrNotFound):
+		return nil, err
+	}
+
+	rawToken, err := generateEmailVerificationToken()
+	if err != nil {
+		return nil, utils.NewAppError(
+			"EMAIL_VERIFICATION_FAILED",
+			"unable to generate verification token",
+			http.StatusInternalServerError,
+			err,
+			nil,
+		)
+	}
+
+	token := entities.EmailVerificationToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Email:     user.GetEmail(),
+		TokenHash: entities.HashEmailVerificationToken(rawToken),
+		CreatedAt: now,
+		ExpiresAt: now.Add(uc.ttl),
+	}
+	if err := uc.tokens.Create(ctx, token); err != nil {
+		return nil, err
+	}
+
+	link, err := uc.verificationLink(rawToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.emails.EmailVerification(ctx, user, link, token.ExpiresAt); err != nil {
+		uc.logger.Error("failed to send verification email", slog.String("user_id", userID.String()), slog.String("error", err.Error()))
+		return nil, utils.NewAppError(
+			"EMAIL_DELIVERY_FAILED",
+			"unable to send verification email",
+			http.StatusBadGateway,
+			err,
+			nil,
+		)
+	}
+
+	return &dto.EmailVerificationSentResponse{Email: token.Email, ExpiresAt: token.ExpiresAt}, nil
+}
+
+func (uc *SendEmailVerificationUseCase) verificationLink(token string) (string, error) {
+	if uc.linkURL == "" {
+		return "", errors.New("email verification: link url not configured")
+	}
+	link, err := url.Parse(uc.linkURL)
+	if err != nil {
+		return "", fmt.Errorf("email verification: parse link url: %w", err)
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+	return link.String(), nil
+}
+
+func generateEmailVerificationToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// VerifyEmailUseCase redeems a verification token and marks the user's email address as verified.
+type VerifyEmailUseCase struct {
+	users  repositories.UserRepository
+	tokens repositories.EmailVerificationRepository
+	audit  AuditLogger
+	logger *slog.Logger
+	clock  func() time.Time
+}
+
+// NewVerifyEmailUseCase constructs the use case. The audit logger is optional.
+func NewVerifyEmailUseCase(
+	users repositories.UserRepository,
+	tokens repositories.EmailVerificationRepository,
+	auditLogger AuditLogger,
+	logger *slog.Logger,
+) *VerifyEmailUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &VerifyEmailUseCase{
+		users:  users,
+		tokens: tokens,
+		audit:  auditLogger,
+		logger: logger,
+		clock:  time.Now,
+	}
+}
+
+// Execute validates the token and verifies the address it was sent to. Tokens are single use and
+// stop working once the user changes their email address.
+func (uc *VerifyEmailUseCase) Execute(ctx context.Context, input dto.VerifyEmailRequest) (*dto.EmailVerificationStatusResponse, error) {
+	if uc.users == nil || uc.tokens == nil {
+		return nil, errors.New("verify email: dependencies not configured")
+	}
+
+	if errs := input.Validate(); !errs.IsEmpty() {
+		return nil, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"verification payload invalid",
+			http.StatusBadRequest,
+			nil,
+			validationErrorsToDetails(errs),
+		)
+	}
+
+	now := uc.clock().UTC()
+	token, err := uc.tokens.GetByTokenHash(ctx, entities.HashEmailVerificationToken(input.Token))
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, invalidVerificationTokenError()
+		}
+		return nil, err
+	}
+	if !token.IsUsable(now) {
+		return nil, invalidVerificationTokenError()
+	}
+
+	user, err := uc.users.GetByID(ctx, token.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(strings.TrimSpace(user.GetEmail()), strings.TrimSpace(token.Email)) {
+		return nil, invalidVerificationTokenError()
+	}
+	if user.IsEmailVerified() {
+		return &dto.EmailVerificationStatusResponse{Verified: true, VerifiedAt: user.GetEmailVerifiedAt()}, nil
+	}
+
+	entity, ok := user.(*entities.UserEntity)
+	if !ok {
+		return nil, errors.New("verify email: unexpected user implementation")
+	}
+
+	if err := uc.tokens.MarkUsed(ctx, token.ID, now); err != nil {
+		if errors.Is(err, repositories.ErrConflict) {
+			return nil, invalidVerificationTokenError()
+		}
+		return nil, err
+	}
+
+	entity.MarkEmailVerified(now)
+	entity.Touch(now)
+	if err := uc.users.Update(ctx, entity); err != nil {
+		return nil, err
+	}
+
+	recordAudit(ctx, uc.audit, uc.logger, audit.Entry{
+		ActorID:  entity.GetID(),
+		Action:   AuditActionEmailVerified,
+		TargetID: entity.GetID().String(),
+		Metadata: map[string]any{"email": token.Email},
+		Occurred: now,
+	})
+
+	return &dto.EmailVerificationStatusResponse{Verified: true, VerifiedAt: entity.GetEmailVerifiedAt()}, nil
+}
+
+func invalidVerificationTokenError() error {
+	return utils.NewAppError(
+		"INVALID_VERIFICATION_TOKEN",
+		"verification link is invalid or has expired",
+		http.StatusBadRequest,
+		nil,
+		nil,
+	)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/crypto-wallet/backend/internal/application/dto"
 	"github.com/crypto-wallet/backend/internal/domain/entities"
 	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
 	"github.com/crypto-wallet/backend/internal/infrastructure/security"
 	"github.com/crypto-wallet/backend/pkg/utils"
 )
@@ -26,16 +27,18 @@ type EnableTwoFactorInput struct {
 
 // EnableTwoFactorUseCase verifies the provided code and enables TOTP.
 type EnableTwoFactorUseCase struct {
-	users  repositories.UserRepository
-	logger *slog.Logger
+	users    repositories.UserRepository
+	notifier SecurityNotifier
+	audit    AuditLogger
+	logger   *slog.Logger
 }
 
-// NewEnableTwoFactorUseCase constructs the use case.
-func NewEnableTwoFactorUseCase(users repositories.UserRepository, logger *slog.Logger) *EnableTwoFactorUseCase {
+// NewEnableTwoFactorUseCase constructs the use case. The security notifier is optional.
+func NewEnableTwoFactorUseCase(users repositories.UserRepository, notifier SecurityNotifier, auditLogger AuditLogger, logger *slog.Logger) *EnableTwoFactorUseCase {
 	if logger == nil {
 		logger = slog.Default()
 	}
-	return &EnableTwoFactorUseCase{users: users, logger: logger}
+	return &EnableTwoFactorUseCase{users: users, notifier: notifier, audit: auditLogger, logger: logger}
 }
 
 // Execute validates the verification code and enables two-factor authentication.
@@ -69,6 +72,7 @@ func (uc *EnableTwoFactorUseCase) Execute(ctx context.Context, input EnableTwoFa
 	if err != nil {
 		return nil, err
 	}
+	before := user.IsTwoFactorEnabled()
 
 	secret := strings.TrimSpace(user.GetTwoFactorSecret())
 	if secret == "" {
@@ -82,6 +86,12 @@ func (uc *EnableTwoFactorUseCase) Execute(ctx context.Context, input EnableTwoFa
 	}
 
 	if !security.ValidateTOTP(secret, input.Payload.Code) {
+		recordAudit(ctx, uc.audit, uc.logger, audit.Entry{
+			ActorID:  userID,
+			Action:   AuditActionTwoFactorEnable,
+			TargetID: userID.String(),
+			Error:    "invalid verification code",
+		})
 		return nil, utils.NewAppError(
 			"TWO_FACTOR_CODE_INVALID",
 			"verification code is invalid or expired",
@@ -105,11 +115,26 @@ func (uc *EnableTwoFactorUseCase) Execute(ctx context.Context, input EnableTwoFa
 			nil,
 		)
 	}
-	entity.Touch(time.Now().UTC())
+	now := time.Now().UTC()
+	entity.Touch(now)
 
 	if err := uc.users.Update(ctx, entity); err != nil {
 		return nil, err
 	}
 
+	recordAudit(ctx, uc.audit, uc.logger, audit.Entry{
+		ActorID:  userID,
+		Action:   AuditActionTwoFactorEnable,
+		TargetID: userID.String(),
+		Before:   twoFactorSnapshot(before),
+		After:    twoFactorSnapshot(true),
+	})
+
+	if uc.notifier != nil {
+		notifySecurity(ctx, uc.logger, AuditActionTwoFactorEnable, func(ctx context.Context) error {
+			return uc.notifier.TwoFactorChanged(ctx, entity, true, now)
+		})
+	}
+
 	return &dto.TwoFactorStatusResponse{Enabled: true}, nil
 }
@@ -3,48 +3,61 @@ package auth
 import (
 	"context"
 	"errors"
+	"log/slog"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/crypto-wallet/backend/internal/application/dto"
 	"github.com/crypto-wallet/backend/internal/domain/entities"
 	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
 	"github.com/crypto-wallet/backend/internal/infrastructure/security"
 	"github.com/crypto-wallet/backend/pkg/utils"
 )
 
-// LoginUseCase authenticates an existing user.
+// LoginUseCase authenticates an existing user. Successful and failed attempts are audited, and users
+// are alerted when they sign in from a device they have not used before.
 type LoginUseCase struct {
-	users       repositories.UserRepository
-	hasher      security.PasswordHasher
-	tokenIssuer *security.JWTService
-	accessTTL   time.Duration
-	refreshTTL  time.Duration
-	clock       func() time.Time
+	users    repositories.UserRepository
+	hasher   security.PasswordHasher
+	tokens   sessionTokens
+	devices  repositories.UserDeviceRepository
+	notifier SecurityNotifier
+	audit    AuditLogger
+	logger   *slog.Logger
+	clock    func() time.Time
 }
 
-// NewLoginUseCase creates a new login use case instance.
+// NewLoginUseCase creates a new login use case instance. The device repository, security notifier
+// and audit logger are optional.
 func NewLoginUseCase(
 	users repositories.UserRepository,
 	hasher security.PasswordHasher,
 	tokenIssuer *security.JWTService,
+	refreshTokens repositories.RefreshTokenRepository,
+	roles repositories.UserRoleRepository,
 	accessTTL time.Duration,
 	refreshTTL time.Duration,
+	devices repositories.UserDeviceRepository,
+	notifier SecurityNotifier,
+	auditLogger AuditLogger,
+	logger *slog.Logger,
 ) *LoginUseCase {
-	if accessTTL <= 0 {
-		accessTTL = time.Hour
-	}
-	if refreshTTL <= 0 {
-		refreshTTL = 24 * time.Hour * 7
+	if logger == nil {
+		logger = slog.Default()
 	}
-
 	return &LoginUseCase{
-		users:       users,
-		hasher:      hasher,
-		tokenIssuer: tokenIssuer,
-		accessTTL:   accessTTL,
-		refreshTTL:  refreshTTL,
-		clock:       time.Now,
+		users:    users,
+		hasher:   hasher,
+		tokens:   newSessionTokens(tokenIssuer, refreshTokens, roles, accessTTL, refreshTTL),
+		devices:  devices,
+		notifier: notifier,
+		audit:    auditLogger,
+		logger:   logger,
+		clock:    time.Now,
 	}
 }
 
@@ -64,12 +77,20 @@ func (uc *LoginUseCase) Execute(ctx context.Context, input dto.LoginRequest) (*d
 	user, err := uc.users.GetByEmail(ctx, input.Email)
 	if err != nil {
 		if errors.Is(err, repositories.ErrNotFound) {
+			uc.recordFailure(ctx, uuid.Nil, input.Email, "unknown_email")
 			return nil, invalidCredentialsError()
 		}
 		return nil, err
 	}
 
 	if err := uc.hasher.Compare(user.GetPasswordHash(), input.Password); err != nil {
+		uc.recordFailure(ctx, user.GetID(), input.Email, "wrong_password")
+		return nil, invalidCredentialsError()
+	}
+
+	// Deleted accounts wait out their retention window before purging; they cannot sign in.
+	if user.GetStatus() == entities.UserStatusDeleted {
+		uc.recordFailure(ctx, user.GetID(), input.Email, "account_deleted")
 		return nil, invalidCredentialsError()
 	}
 
@@ -82,36 +103,78 @@ func (uc *LoginUseCase) Execute(ctx context.Context, input dto.LoginRequest) (*d
 		}
 	}
 
-	accessTokenExpires := uc.clock().Add(uc.accessTTL)
-	accessToken, err := uc.tokenIssuer.GenerateToken(ctx, user.GetID().String(), uc.accessTTL, map[string]any{
-		"email": user.GetEmail(),
-		"type":  "access",
-	})
+	tokens, err := uc.tokens.start(ctx, user, now)
 	if err != nil {
 		return nil, err
 	}
 
-	refreshTokenExpires := uc.clock().Add(uc.refreshTTL)
-	refreshToken, err := uc.tokenIssuer.GenerateToken(ctx, user.GetID().String(), uc.refreshTTL, map[string]any{
-		"type": "refresh",
+	recordAudit(ctx, uc.audit, uc.logger, audit.Entry{
+		ActorID:  user.GetID(),
+		Action:   AuditActionLogin,
+		TargetID: user.GetID().String(),
+		Metadata: map[string]any{"two_factor_enabled": user.IsTwoFactorEnabled()},
+		Occurred: now,
 	})
-	if err != nil {
-		return nil, err
-	}
+
+	uc.trackDevice(ctx, user, input, now)
 
 	response := &dto.AuthResponse{
-		User: dto.NewAuthUser(user),
-		Tokens: dto.AuthTokens{
-			AccessToken:      accessToken,
-			RefreshToken:     refreshToken,
-			ExpiresAt:        accessTokenExpires,
-			RefreshExpiresAt: refreshTokenExpires,
-		},
+		User:   dto.NewAuthUser(user),
+		Tokens: tokens,
 	}
 
 	return response, nil
 }
 
+// trackDevice records the device used to sign in and alerts the user when it is new. The first
+// device an account signs in from is not reported. Failures are logged rather than failing the login.
+func (uc *LoginUseCase) trackDevice(ctx context.Context, user entities.User, input dto.LoginRequest, now time.Time) {
+	if uc.devices == nil {
+		return
+	}
+
+	known, err := uc.devices.CountByUser(ctx, user.GetID())
+	if err != nil {
+		uc.logger.Warn("failed to count user devices", slog.String("user_id", user.GetID().String()), slog.String("error", err.Error()))
+		return
+	}
+
+	device := entities.UserDevice{
+		UserID:      user.GetID(),
+		Fingerprint: entities.DeviceFingerprint(input.UserAgent),
+		UserAgent:   strings.TrimSpace(input.UserAgent),
+		IPAddress:   strings.TrimSpace(input.IPAddress),
+		FirstSeenAt: now,
+		LastSeenAt:  now,
+	}
+	created, err := uc.devices.Touch(ctx, device)
+	if err != nil {
+		uc.logger.Warn("failed to record user device", slog.String("user_id", user.GetID().String()), slog.String("error", err.Error()))
+		return
+	}
+
+	if created && known > 0 && uc.notifier != nil {
+		notifySecurity(ctx, uc.logger, "new_device_login", func(ctx context.Context) error {
+			return uc.notifier.NewDeviceLogin(ctx, user, device)
+		})
+	}
+}
+
+// recordFailure audits a rejected login. userID is nil when no account matched the email.
+func (uc *LoginUseCase) recordFailure(ctx context.Context, userID uuid.UUID, email, reason string) {
+	entry := audit.Entry{
+		Action:   AuditActionLogin,
+		Metadata: map[string]any{"email": email, "reason": reason},
+		Error:    "invalid credentials",
+		Occurred: uc.clock().UTC(),
+	}
+	if userID != uuid.Nil {
+		entry.ActorID = userID
+		entry.TargetID = userID.String()
+	}
+	recordAudit(ctx, uc.audit, uc.logger, entry)
+}
+
 func invalidCredentialsError() error {
 	return utils.NewAppError(
 		"INVALID_CREDENTIALS",
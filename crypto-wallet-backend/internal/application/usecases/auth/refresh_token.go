@@ -0,0 +1,207 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/security"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+var errRefreshTokenReused = errors.New("auth: refresh token reused")
+
+// RefreshTokenUseCase exchanges a refresh token for a new token pair, rotating the refresh token.
+// Presenting a token that was already rotated revokes every token of its session.
+type RefreshTokenUseCase struct {
+	users         repositories.UserRepository
+	refreshTokens repositories.RefreshTokenRepository
+	tokenIssuer   *security.JWTService
+	tokens        sessionTokens
+	logger        *slog.Logger
+	clock         func() time.Time
+}
+
+// NewRefreshTokenUseCase constructs the use case.
+func NewRefreshTokenUseCase(
+	users repositories.UserRepository,
+	tokenIssuer *security.JWTService,
+	refreshTokens repositories.RefreshTokenRepository,
+	roles repositories.UserRoleRepository,
+	accessTTL time.Duration,
+	refreshTTL time.Duration,
+	logger *slog.Logger,
+) *RefreshTokenUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RefreshTokenUseCase{
+		users:         users,
+		refreshTokens: refreshTokens,
+		tokenIssuer:   tokenIssuer,
+		tokens:        newSessionTokens(tokenIssuer, refreshTokens, roles, accessTTL, refreshTTL),
+		logger:        logger,
+		clock:         time.Now,
+	}
+}
+
+// Execute validates the refresh token and returns a new token pair.
+func (uc *RefreshTokenUseCase) Execute(ctx context.Context, input dto.RefreshTokenRequest) (*dto.AuthResponse, error) {
+	errs := input.Validate()
+	if !errs.IsEmpty() {
+		return nil, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"refresh payload invalid",
+			http.StatusBadRequest,
+			nil,
+			validationErrorsToDetails(errs),
+		)
+	}
+
+	current, err := lookupRefreshToken(ctx, uc.tokenIssuer, uc.refreshTokens, input.RefreshToken)
+	if err != nil {
+		if isInvalidRefreshToken(err) {
+			return nil, invalidRefreshTokenError(err)
+		}
+		return nil, err
+	}
+
+	now := uc.clock().UTC()
+	if current.IsRevoked() || current.IsExpired(now) {
+		return nil, invalidRefreshTokenError(nil)
+	}
+	if current.IsRotated() {
+		return nil, uc.revokeReused(ctx, current, now)
+	}
+
+	user, err := uc.users.GetByID(ctx, current.UserID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, invalidRefreshTokenError(err)
+		}
+		return nil, err
+	}
+	if user.GetStatus() != entities.UserStatusActive {
+		if err := uc.refreshTokens.RevokeFamily(ctx, current.FamilyID, now); err != nil {
+			return nil, err
+		}
+		return nil, invalidRefreshTokenError(nil)
+	}
+
+	tokens, err := uc.tokens.rotate(ctx, user, current, now)
+	if err != nil {
+		// Another request rotated the token first: it was presented twice.
+		if errors.Is(err, repositories.ErrConflict) {
+			return nil, uc.revokeReused(ctx, current, now)
+		}
+		return nil, err
+	}
+
+	return &dto.AuthResponse{
+		User:   dto.NewAuthUser(user),
+		Tokens: tokens,
+	}, nil
+}
+
+func (uc *RefreshTokenUseCase) revokeReused(ctx context.Context, token entities.RefreshToken, now time.Time) error {
+	uc.logger.Warn("refresh token reuse detected; revoking session",
+		slog.String("user_id", token.UserID.String()),
+		slog.String("family_id", token.FamilyID.String()))
+
+	if err := uc.refreshTokens.RevokeFamily(ctx, token.FamilyID, now); err != nil {
+		return err
+	}
+	return utils.NewAppError(
+		"REFRESH_TOKEN_REUSED",
+		"refresh token was already used; sign in again",
+		http.StatusUnauthorized,
+		errRefreshTokenReused,
+		nil,
+	)
+}
+
+// RevokeTokenUseCase ends the session a refresh token belongs to. Following RFC 7009, unknown or
+// invalid tokens are not reported as errors.
+type RevokeTokenUseCase struct {
+	refreshTokens repositories.RefreshTokenRepository
+	tokenIssuer   *security.JWTService
+	logger        *slog.Logger
+	clock         func() time.Time
+}
+
+// NewRevokeTokenUseCase constructs the use case.
+func NewRevokeTokenUseCase(tokenIssuer *security.JWTService, refreshTokens repositories.RefreshTokenRepository, logger *slog.Logger) *RevokeTokenUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RevokeTokenUseCase{
+		refreshTokens: refreshTokens,
+		tokenIssuer:   tokenIssuer,
+		logger:        logger,
+		clock:         time.Now,
+	}
+}
+
+// Execute revokes the token's family.
+func (uc *RevokeTokenUseCase) Execute(ctx context.Context, input dto.RevokeTokenRequest) error {
+	errs := input.Validate()
+	if !errs.IsEmpty() {
+		return utils.NewAppError(
+			"VALIDATION_ERROR",
+			"revoke payload invalid",
+			http.StatusBadRequest,
+			nil,
+			validationErrorsToDetails(errs),
+		)
+	}
+
+	token, err := lookupRefreshToken(ctx, uc.tokenIssuer, uc.refreshTokens, input.RefreshToken)
+	if err != nil {
+		if isInvalidRefreshToken(err) {
+			uc.logger.Debug("ignoring revocation of invalid refresh token", slog.String("error", err.Error()))
+			return nil
+		}
+		return err
+	}
+	if token.IsRevoked() {
+		return nil
+	}
+
+	return uc.refreshTokens.RevokeFamily(ctx, token.FamilyID, uc.clock().UTC())
+}
+
+// lookupRefreshToken verifies the token's signature and returns its stored record. The claims
+// must match the record, so a token cannot be replayed against another user's family.
+func lookupRefreshToken(ctx context.Context, issuer *security.JWTService, refreshTokens repositories.RefreshTokenRepository, raw string) (entities.RefreshToken, error) {
+	claims, err := issuer.ParseRefreshToken(ctx, raw)
+	if err != nil {
+		return entities.RefreshToken{}, err
+	}
+
+	tokenID, err := uuid.Parse(claims.ID)
+	if err != nil {
+		return entities.RefreshToken{}, security.ErrTokenInvalid
+	}
+
+	record, err := refreshTokens.Get(ctx, tokenID)
+	if err != nil {
+		return entities.RefreshToken{}, err
+	}
+	if record.UserID.String() != claims.Subject || record.FamilyID.String() != claims.FamilyID {
+		return entities.RefreshToken{}, security.ErrTokenInvalid
+	}
+	return record, nil
+}
+
+func isInvalidRefreshToken(err error) bool {
+	return errors.Is(err, security.ErrTokenInvalid) ||
+		errors.Is(err, security.ErrTokenExpired) ||
+		errors.Is(err, repositories.ErrNotFound)
+}
@@ -18,12 +18,10 @@ import (
 
 // RegisterUseCase handles user registration lifecycle.
 type RegisterUseCase struct {
-	users       repositories.UserRepository
-	hasher      security.PasswordHasher
-	tokenIssuer *security.JWTService
-	accessTTL   time.Duration
-	refreshTTL  time.Duration
-	clock       func() time.Time
+	users  repositories.UserRepository
+	hasher security.PasswordHasher
+	tokens sessionTokens
+	clock  func() time.Time
 }
 
 // NewRegisterUseCase constructs the use case with sane defaults.
@@ -31,23 +29,16 @@ func NewRegisterUseCase(
 	users repositories.UserRepository,
 	hasher security.PasswordHasher,
 	tokenIssuer *security.JWTService,
+	refreshTokens repositories.RefreshTokenRepository,
 	accessTTL time.Duration,
 	refreshTTL time.Duration,
 ) *RegisterUseCase {
-	if accessTTL <= 0 {
-		accessTTL = time.Hour
-	}
-	if refreshTTL <= 0 {
-		refreshTTL = 24 * time.Hour * 7
-	}
-
 	return &RegisterUseCase{
-		users:       users,
-		hasher:      hasher,
-		tokenIssuer: tokenIssuer,
-		accessTTL:   accessTTL,
-		refreshTTL:  refreshTTL,
-		clock:       time.Now,
+		users:  users,
+		hasher: hasher,
+		// New accounts have no staff roles.
+		tokens: newSessionTokens(tokenIssuer, refreshTokens, nil, accessTTL, refreshTTL),
+		clock:  time.Now,
 	}
 }
 
@@ -111,32 +102,14 @@ func (uc *RegisterUseCase) Execute(ctx context.Context, input dto.RegisterReques
 		return nil, err
 	}
 
-	now = uc.clock()
-	accessTokenExpires := now.Add(uc.accessTTL)
-	accessToken, err := uc.tokenIssuer.GenerateToken(ctx, entity.GetID().String(), uc.accessTTL, map[string]any{
-		"email": entity.GetEmail(),
-		"type":  "access",
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	refreshTokenExpires := now.Add(uc.refreshTTL)
-	refreshToken, err := uc.tokenIssuer.GenerateToken(ctx, entity.GetID().String(), uc.refreshTTL, map[string]any{
-		"type": "refresh",
-	})
+	tokens, err := uc.tokens.start(ctx, entity, now)
 	if err != nil {
 		return nil, err
 	}
 
 	response := &dto.AuthResponse{
-		User: dto.NewAuthUser(entity),
-		Tokens: dto.AuthTokens{
-			AccessToken:      accessToken,
-			RefreshToken:     refreshToken,
-			ExpiresAt:        accessTokenExpires,
-			RefreshExpiresAt: refreshTokenExpires,
-		},
+		User:   dto.NewAuthUser(entity),
+		Tokens: tokens,
 	}
 
 	return response, nil
@@ -0,0 +1,215 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"log/slog"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/infrastructure/external"
+)
+
+// EmailSender delivers transactional email.
+type EmailSender interface {
+	Send(ctx context.Context, message external.EmailMessage) error
+}
+
+// SecurityNotifier tells users about security-relevant changes to their account.
+type SecurityNotifier interface {
+	NewDeviceLogin(ctx context.Context, user entities.User, device entities.UserDevice) error
+	TwoFactorChanged(ctx context.Context, user entities.User, enabled bool, at time.Time) error
+}
+
+// emailTemplate renders one email. Subjects and text bodies use text/template; HTML bodies use
+// html/template so user-controlled values such as the user agent are escaped.
+type emailTemplate struct {
+	subject *texttemplate.Template
+	text    *texttemplate.Template
+	html    *htmltemplate.Template
+}
+
+func newEmailTemplate(name, subject, text, html string) emailTemplate {
+	return emailTemplate{
+		subject: texttemplate.Must(texttemplate.New(name + ".subject").Parse(subject)),
+		text:    texttemplate.Must(texttemplate.New(name + ".text").Parse(text)),
+		html:    htmltemplate.Must(htmltemplate.New(name + ".html").Parse(html)),
+	}
+}
+
+func (t emailTemplate) render(to string, data any) (external.EmailMessage, error) {
+	var subject, text, html bytes.Buffer
+	if err := t.subject.Execute(&subject, data); err != nil {
+		return external.EmailMessage{}, err
+	}
+	if err := t.text.Execute(&text, data); err != nil {
+		return external.EmailMessage{}, err
+	}
+	if err := t.html.Execute(&html, data); err != nil {
+		return external.EmailMessage{}, err
+	}
+	return external.EmailMessage{
+		To:       to,
+		Subject:  strings.TrimSpace(subject.String()),
+		TextBody: text.String(),
+		HTMLBody: html.String(),
+	}, nil
+}
+
+var (
+	verificationEmail = newEmailTemplate("verify_email",
+		`Verify your {{.AppName}} email address`,
+		`Hi {{.Name}},
+
+Confirm that this is your email address by opening the link below:
+
+{{.Link}}
+
+The link expires at {{.ExpiresAt}}. If you did not create a {{.AppName}} account, you can ignore this email.
+`,
+		`<p>Hi {{.Name}},</p>
+<p>Confirm that this is your email address:</p>
+<p><a href="{{.Link}}">Verify email address</a></p>
+<p>The link expires at {{.ExpiresAt}}. If you did not create a {{.AppName}} account, you can ignore this email.</p>
+`)
+
+	newDeviceEmail = newEmailTemplate("new_device_login",
+		`New sign-in to your {{.AppName}} account`,
+		`Hi {{.Name}},
+
+Your {{.AppName}} account was just signed in to from a device we have not seen before.
+
+Time: {{.At}}
+Device: {{.UserAgent}}
+IP address: {{.IPAddress}}
+
+If this was you, there is nothing to do. If not, change your password immediately and enable two-factor authentication.
+`,
+		`<p>Hi {{.Name}},</p>
+<p>Your {{.AppName}} account was just signed in to from a device we have not seen before.</p>
+<ul>
+<li>Time: {{.At}}</li>
+<li>Device: {{.UserAgent}}</li>
+<li>IP address: {{.IPAddress}}</li>
+</ul>
+<p>If this was you, there is nothing to do. If not, change your password immediately and enable two-factor authentication.</p>
+`)
+
+	twoFactorEnabledEmail = newEmailTemplate("two_factor_enabled",
+		`Two-factor authentication enabled on your {{.AppName}} account`,
+		`Hi {{.Name}},
+
+Two-factor authentication was enabled on your {{.AppName}} account at {{.At}}. Sign-ins now require a code from your authenticator app.
+
+If you did not make this change, contact support immediately.
+`,
+		`<p>Hi {{.Name}},</p>
+<p>Two-factor authentication was enabled on your {{.AppName}} account at {{.At}}. Sign-ins now require a code from your authenticator app.</p>
+<p>If you did not make this change, contact support immediately.</p>
+`)
+
+	twoFactorDisabledEmail = newEmailTemplate("two_factor_disabled",
+		`Two-factor authentication disabled on your {{.AppName}} account`,
+		`Hi {{.Name}},
+
+Two-factor authentication was disabled on your {{.AppName}} account at {{.At}}. Your account is now protected by your password alone.
+
+If you did not make this change, change your password and contact support immediately.
+`,
+		`<p>Hi {{.Name}},</p>
+<p>Two-factor authentication was disabled on your {{.AppName}} account at {{.At}}. Your account is now protected by your password alone.</p>
+<p>If you did not make this change, change your password and contact support immediately.</p>
+`)
+)
+
+// SecurityEmails renders and sends account emails: verification links and security alerts.
+type SecurityEmails struct {
+	sender  EmailSender
+	appName string
+	logger  *slog.Logger
+}
+
+// NewSecurityEmails constructs SecurityEmails; appName is used in subjects and greetings.
+func NewSecurityEmails(sender EmailSender, appName string, logger *slog.Logger) *SecurityEmails {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	appName = strings.TrimSpace(appName)
+	if appName == "" {
+		appName = "Atlas Wallet"
+	}
+	return &SecurityEmails{sender: sender, appName: appName, logger: logger}
+}
+
+// EmailVerification sends a verification link to the user's address.
+func (e *SecurityEmails) EmailVerification(ctx context.Context, user entities.User, link string, expiresAt time.Time) error {
+	return e.send(ctx, verificationEmail, user, map[string]any{
+		"Link":      link,
+		"ExpiresAt": formatEmailTime(expiresAt),
+	})
+}
+
+// NewDeviceLogin implements SecurityNotifier.
+func (e *SecurityEmails) NewDeviceLogin(ctx context.Context, user entities.User, device entities.UserDevice) error {
+	userAgent := strings.TrimSpace(device.UserAgent)
+	if userAgent == "" {
+		userAgent = "Unknown device"
+	}
+	ipAddress := strings.TrimSpace(device.IPAddress)
+	if ipAddress == "" {
+		ipAddress = "Unknown"
+	}
+	return e.send(ctx, newDeviceEmail, user, map[string]any{
+		"At":        formatEmailTime(device.LastSeenAt),
+		"UserAgent": userAgent,
+		"IPAddress": ipAddress,
+	})
+}
+
+// TwoFactorChanged implements SecurityNotifier.
+func (e *SecurityEmails) TwoFactorChanged(ctx context.Context, user entities.User, enabled bool, at time.Time) error {
+	template := twoFactorDisabledEmail
+	if enabled {
+		template = twoFactorEnabledEmail
+	}
+	return e.send(ctx, template, user, map[string]any{"At": formatEmailTime(at)})
+}
+
+func (e *SecurityEmails) send(ctx context.Context, template emailTemplate, user entities.User, data map[string]any) error {
+	if e.sender == nil {
+		return errors.New("security emails: sender not configured")
+	}
+
+	name := strings.TrimSpace(user.GetFirstName())
+	if name == "" {
+		name = "there"
+	}
+	data["Name"] = name
+	data["AppName"] = e.appName
+
+	message, err := template.render(user.GetEmail(), data)
+	if err != nil {
+		return fmt.Errorf("security emails: render: %w", err)
+	}
+	return e.sender.Send(ctx, message)
+}
+
+func formatEmailTime(at time.Time) string {
+	if at.IsZero() {
+		at = time.Now()
+	}
+	return at.UTC().Format("2 Jan 2006 15:04 MST")
+}
+
+// notifySecurity delivers a security alert. The request has already succeeded, so delivery outlives
+// a cancelled request and failures are only logged.
+func notifySecurity(ctx context.Context, logger *slog.Logger, event string, deliver func(context.Context) error) {
+	if err := deliver(context.WithoutCancel(ctx)); err != nil {
+		logger.Warn("failed to send security email", slog.String("event", event), slog.String("error", err.Error()))
+	}
+}
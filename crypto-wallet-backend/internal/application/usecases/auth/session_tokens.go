@@ -0,0 +1,133 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/security"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// sessionTokens issues access tokens together with stored, rotating refresh tokens. Access tokens
+// carry the user's staff roles when a role repository is configured.
+type sessionTokens struct {
+	issuer        *security.JWTService
+	refreshTokens repositories.RefreshTokenRepository
+	roles         repositories.UserRoleRepository
+	accessTTL     time.Duration
+	refreshTTL    time.Duration
+}
+
+func newSessionTokens(issuer *security.JWTService, refreshTokens repositories.RefreshTokenRepository, roles repositories.UserRoleRepository, accessTTL, refreshTTL time.Duration) sessionTokens {
+	if accessTTL <= 0 {
+		accessTTL = entities.DefaultAccessTokenTTL
+	}
+	if refreshTTL <= 0 {
+		refreshTTL = entities.DefaultRefreshTokenTTL
+	}
+	return sessionTokens{
+		issuer:        issuer,
+		refreshTokens: refreshTokens,
+		roles:         roles,
+		accessTTL:     accessTTL,
+		refreshTTL:    refreshTTL,
+	}
+}
+
+// start issues the first token pair of a new session.
+func (s sessionTokens) start(ctx context.Context, user entities.User, now time.Time) (dto.AuthTokens, error) {
+	record := s.newRecord(user.GetID(), uuid.New(), now)
+	tokens, err := s.sign(ctx, user, record, now)
+	if err != nil {
+		return dto.AuthTokens{}, err
+	}
+	if err := s.refreshTokens.Create(ctx, record); err != nil {
+		return dto.AuthTokens{}, err
+	}
+	return tokens, nil
+}
+
+// rotate exchanges current for a new token pair in the same family. Returns ErrConflict when
+// current was already rotated or revoked.
+func (s sessionTokens) rotate(ctx context.Context, user entities.User, current entities.RefreshToken, now time.Time) (dto.AuthTokens, error) {
+	record := s.newRecord(user.GetID(), current.FamilyID, now)
+	tokens, err := s.sign(ctx, user, record, now)
+	if err != nil {
+		return dto.AuthTokens{}, err
+	}
+	if err := s.refreshTokens.Rotate(ctx, current.ID, record); err != nil {
+		return dto.AuthTokens{}, err
+	}
+	return tokens, nil
+}
+
+func (s sessionTokens) newRecord(userID, familyID uuid.UUID, now time.Time) entities.RefreshToken {
+	return entities.RefreshToken{
+		ID:        uuid.New(),
+		FamilyID:  familyID,
+		UserID:    userID,
+		IssuedAt:  now,
+		ExpiresAt: now.Add(s.refreshTTL),
+	}
+}
+
+func (s sessionTokens) sign(ctx context.Context, user entities.User, record entities.RefreshToken, now time.Time) (dto.AuthTokens, error) {
+	roles, err := s.userRoles(ctx, user)
+	if err != nil {
+		return dto.AuthTokens{}, err
+	}
+
+	accessToken, err := s.issuer.GenerateTokenWithRoles(ctx, user.GetID().String(), roles, s.accessTTL, map[string]any{
+		"email": user.GetEmail(),
+		"type":  "access",
+	})
+	if err != nil {
+		return dto.AuthTokens{}, err
+	}
+
+	refreshToken, err := s.issuer.GenerateRefreshToken(ctx, user.GetID().String(), record.ID.String(), record.FamilyID.String(), s.refreshTTL)
+	if err != nil {
+		return dto.AuthTokens{}, err
+	}
+
+	return dto.AuthTokens{
+		AccessToken:      accessToken,
+		RefreshToken:     refreshToken,
+		ExpiresAt:        now.Add(s.accessTTL),
+		RefreshExpiresAt: record.ExpiresAt,
+	}, nil
+}
+
+// userRoles returns the implicit user role followed by the account's granted staff roles.
+func (s sessionTokens) userRoles(ctx context.Context, user entities.User) ([]string, error) {
+	roles := []string{string(entities.UserRoleUser)}
+	if s.roles == nil {
+		return roles, nil
+	}
+	granted, err := s.roles.ListRoles(ctx, user.GetID())
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range granted {
+		if role.IsGrantable() {
+			roles = append(roles, string(role))
+		}
+	}
+	return roles, nil
+}
+
+func invalidRefreshTokenError(err error) error {
+	return utils.NewAppError(
+		"INVALID_REFRESH_TOKEN",
+		"refresh token is invalid or expired",
+		http.StatusUnauthorized,
+		err,
+		nil,
+	)
+}
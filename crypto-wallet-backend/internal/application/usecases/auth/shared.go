@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+)
+
+// Audit actions recorded by the authentication use cases.
+const (
+	AuditActionLogin            = "user_login"
+	AuditActionTwoFactorEnable  = "2fa_enable"
+	AuditActionTwoFactorDisable = "2fa_disable"
+	AuditActionEmailVerified    = "email_verified"
+)
+
+// AuditLogger captures security-relevant account events.
+type AuditLogger interface {
+	Record(ctx context.Context, entry audit.Entry) error
+}
+
+// recordAudit writes an audit entry when a logger is configured. Audit failures are logged
+// rather than failing the user's request.
+func recordAudit(ctx context.Context, auditLogger AuditLogger, logger *slog.Logger, entry audit.Entry) {
+	if auditLogger == nil {
+		return
+	}
+	if entry.ResourceType == "" {
+		entry.ResourceType = "user"
+	}
+	if err := auditLogger.Record(ctx, entry); err != nil {
+		logger.Warn("failed to record auth audit entry", slog.String("action", entry.Action), slog.String("error", err.Error()))
+	}
+}
+
+func twoFactorSnapshot(enabled bool) map[string]any {
+	return map[string]any{"two_factor_enabled": enabled}
+}
@@ -0,0 +1,168 @@
+package broadcast
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// scheduleSkew tolerates clocks slightly behind the server when staff schedule "now".
+const scheduleSkew = time.Minute
+
+// CreateCampaignInput captures a staff request to schedule a campaign.
+type CreateCampaignInput struct {
+	ActorID uuid.UUID
+	Payload dto.CreateBroadcastRequest
+}
+
+// CreateCampaignUseCase schedules a broadcast campaign. Recipients are resolved when it is sent.
+type CreateCampaignUseCase struct {
+	campaigns       repositories.BroadcastRepository
+	kyc             KYCUserLister
+	audit           AuditLogger
+	defaultThrottle int
+	logger          *slog.Logger
+	now             func() time.Time
+}
+
+// NewCreateCampaignUseCase constructs the use case. kyc may be nil, in which case segments
+// cannot filter by KYC status.
+func NewCreateCampaignUseCase(campaigns repositories.BroadcastRepository, kyc KYCUserLister, auditLogger AuditLogger, defaultThrottle int, logger *slog.Logger) *CreateCampaignUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if defaultThrottle <= 0 {
+		defaultThrottle = entities.DefaultBroadcastThrottle
+	}
+	return &CreateCampaignUseCase{
+		campaigns:       campaigns,
+		kyc:             kyc,
+		audit:           auditLogger,
+		defaultThrottle: defaultThrottle,
+		logger:          logger,
+		now:             time.Now,
+	}
+}
+
+// Execute validates and stores the campaign.
+func (uc *CreateCampaignUseCase) Execute(ctx context.Context, input CreateCampaignInput) (dto.BroadcastCampaignResponse, error) {
+	validation := input.Payload.Validate()
+	now := uc.now().UTC()
+	scheduledAt := now
+	if input.Payload.ScheduledAt != nil {
+		scheduledAt = input.Payload.ScheduledAt.UTC()
+		if scheduledAt.Before(now.Add(-scheduleSkew)) {
+			validation.Add("scheduledAt", "scheduledAt cannot be in the past")
+		}
+	}
+	if err := wrapValidationError(validation); err != nil {
+		return dto.BroadcastCampaignResponse{}, err
+	}
+	if len(input.Payload.Segment.KYCStatuses) > 0 && uc.kyc == nil {
+		return dto.BroadcastCampaignResponse{}, kycSegmentUnavailableError()
+	}
+
+	throttle := input.Payload.ThrottlePerMinute
+	if throttle == 0 {
+		throttle = uc.defaultThrottle
+	}
+
+	campaign, err := entities.NewBroadcastCampaignEntity(entities.BroadcastCampaignParams{
+		Name:              input.Payload.Name,
+		Title:             input.Payload.Title,
+		Body:              input.Payload.Body,
+		Segment:           input.Payload.Segment,
+		ScheduledAt:       scheduledAt,
+		ThrottlePerMinute: throttle,
+		CreatedBy:         input.ActorID,
+		CreatedAt:         now,
+	})
+	if err != nil {
+		return dto.BroadcastCampaignResponse{}, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"broadcast request invalid",
+			fiber.StatusBadRequest,
+			err,
+			nil,
+		)
+	}
+
+	if err := uc.campaigns.Create(ctx, campaign); err != nil {
+		uc.logger.Error("failed to create broadcast campaign", slog.String("error", err.Error()))
+		return dto.BroadcastCampaignResponse{}, err
+	}
+
+	if uc.audit != nil {
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID:  input.ActorID.String(),
+			Action:   AuditActionBroadcastCreated,
+			TargetID: campaign.GetID().String(),
+			Metadata: map[string]any{
+				"name":         campaign.GetName(),
+				"scheduled_at": campaign.GetScheduledAt(),
+			},
+		}); err != nil {
+			uc.logger.Warn("failed to record audit entry", slog.String("action", AuditActionBroadcastCreated), slog.String("error", err.Error()))
+		}
+	}
+
+	uc.logger.Info("broadcast campaign scheduled",
+		slog.String("campaign_id", campaign.GetID().String()),
+		slog.Time("scheduled_at", campaign.GetScheduledAt()),
+		slog.String("actor", input.ActorID.String()))
+
+	return dto.NewBroadcastCampaignResponse(campaign), nil
+}
+
+// PreviewSegmentUseCase reports how many users a segment matches right now.
+type PreviewSegmentUseCase struct {
+	campaigns repositories.BroadcastRepository
+	kyc       KYCUserLister
+	logger    *slog.Logger
+}
+
+// NewPreviewSegmentUseCase constructs the use case.
+func NewPreviewSegmentUseCase(campaigns repositories.BroadcastRepository, kyc KYCUserLister, logger *slog.Logger) *PreviewSegmentUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &PreviewSegmentUseCase{
+		campaigns: campaigns,
+		kyc:       kyc,
+		logger:    logger,
+	}
+}
+
+// Execute counts the segment's users.
+func (uc *PreviewSegmentUseCase) Execute(ctx context.Context, payload dto.PreviewBroadcastSegmentRequest) (dto.BroadcastSegmentPreview, error) {
+	if err := payload.Segment.Validate(); err != nil {
+		validation := utils.ValidationErrors{}
+		validation.Add("segment", err.Error())
+		return dto.BroadcastSegmentPreview{}, wrapValidationError(validation)
+	}
+
+	scope, err := resolveScope(ctx, uc.kyc, payload.Segment)
+	if err != nil {
+		if errors.Is(err, errKYCSegmentUnavailable) {
+			return dto.BroadcastSegmentPreview{}, kycSegmentUnavailableError()
+		}
+		return dto.BroadcastSegmentPreview{}, err
+	}
+
+	recipients, err := uc.campaigns.CountRecipients(ctx, scope)
+	if err != nil {
+		uc.logger.Error("failed to count broadcast segment", slog.String("error", err.Error()))
+		return dto.BroadcastSegmentPreview{}, err
+	}
+	return dto.BroadcastSegmentPreview{Recipients: recipients}, nil
+}
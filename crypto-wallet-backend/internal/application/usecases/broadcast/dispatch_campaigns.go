@@ -0,0 +1,178 @@
+package broadcast
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	appLogging "github.com/crypto-wallet/backend/internal/infrastructure/logging"
+)
+
+const (
+	defaultDispatchInterval = 10 * time.Second
+	defaultMaxAttempts      = 3
+	defaultRetryAfter       = time.Minute
+	dispatchCampaignBatch   = 20
+)
+
+// DispatchConfig tunes the dispatcher. Interval must match how often the worker runs, since the
+// per-campaign throttle is spread across ticks.
+type DispatchConfig struct {
+	Interval    time.Duration
+	MaxAttempts int
+	RetryAfter  time.Duration
+}
+
+func (c DispatchConfig) withDefaults() DispatchConfig {
+	if c.Interval <= 0 {
+		c.Interval = defaultDispatchInterval
+	}
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = defaultMaxAttempts
+	}
+	if c.RetryAfter <= 0 {
+		c.RetryAfter = defaultRetryAfter
+	}
+	return c
+}
+
+// DispatchCampaignsUseCase starts due campaigns and delivers their messages through the notifier,
+// never sending more than a campaign's throttle allows per minute.
+type DispatchCampaignsUseCase struct {
+	campaigns repositories.BroadcastRepository
+	kyc       KYCUserLister
+	notifier  Notifier
+	config    DispatchConfig
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+// NewDispatchCampaignsUseCase constructs the use case.
+func NewDispatchCampaignsUseCase(campaigns repositories.BroadcastRepository, kyc KYCUserLister, notifier Notifier, config DispatchConfig, logger *slog.Logger) *DispatchCampaignsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &DispatchCampaignsUseCase{
+		campaigns: campaigns,
+		kyc:       kyc,
+		notifier:  notifier,
+		config:    config.withDefaults(),
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+// Execute runs one dispatch pass and returns how many messages were delivered.
+func (uc *DispatchCampaignsUseCase) Execute(ctx context.Context) (int, error) {
+	logger := appLogging.LoggerFromContext(ctx, uc.logger)
+	now := uc.now().UTC()
+
+	due, err := uc.campaigns.ListDue(ctx, now, dispatchCampaignBatch)
+	if err != nil {
+		return 0, err
+	}
+	for _, campaign := range due {
+		uc.start(ctx, logger, campaign, now)
+	}
+
+	sending, err := uc.campaigns.ListSending(ctx, dispatchCampaignBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	delivered := 0
+	for _, campaign := range sending {
+		if ctx.Err() != nil {
+			return delivered, ctx.Err()
+		}
+		count, err := uc.deliver(ctx, logger, campaign, now)
+		delivered += count
+		if err != nil {
+			logger.Error("broadcast delivery pass failed",
+				slog.String("campaign_id", campaign.GetID().String()),
+				slog.String("error", err.Error()))
+		}
+	}
+	return delivered, nil
+}
+
+// start fixes a due campaign's recipients. Failures are logged and retried on the next pass.
+func (uc *DispatchCampaignsUseCase) start(ctx context.Context, logger *slog.Logger, campaign entities.BroadcastCampaign, now time.Time) {
+	scope, err := resolveScope(ctx, uc.kyc, campaign.GetSegment())
+	if err != nil {
+		logger.Error("failed to resolve broadcast segment",
+			slog.String("campaign_id", campaign.GetID().String()),
+			slog.String("error", err.Error()))
+		return
+	}
+
+	recipients, err := uc.campaigns.Start(ctx, campaign.GetID(), scope, now)
+	if err != nil {
+		if errors.Is(err, repositories.ErrConflict) {
+			return
+		}
+		logger.Error("failed to start broadcast campaign",
+			slog.String("campaign_id", campaign.GetID().String()),
+			slog.String("error", err.Error()))
+		return
+	}
+	logger.Info("broadcast campaign started",
+		slog.String("campaign_id", campaign.GetID().String()),
+		slog.Int64("recipients", recipients))
+}
+
+func (uc *DispatchCampaignsUseCase) deliver(ctx context.Context, logger *slog.Logger, campaign entities.BroadcastCampaign, now time.Time) (int, error) {
+	userIDs, err := uc.campaigns.ClaimDeliveries(ctx, campaign.GetID(), now, now.Add(-uc.config.RetryAfter), uc.allowance(campaign))
+	if err != nil {
+		return 0, err
+	}
+
+	delivered := 0
+	for _, userID := range userIDs {
+		if err := uc.notify(ctx, campaign, userID); err != nil {
+			if markErr := uc.campaigns.MarkDeliveryFailed(ctx, campaign.GetID(), userID, err.Error(), uc.config.MaxAttempts); markErr != nil {
+				return delivered, markErr
+			}
+			continue
+		}
+		if err := uc.campaigns.MarkDelivered(ctx, campaign.GetID(), userID, now); err != nil {
+			return delivered, err
+		}
+		delivered++
+	}
+
+	completed, err := uc.campaigns.CompleteIfDrained(ctx, campaign.GetID(), now)
+	if err != nil {
+		return delivered, err
+	}
+	if completed {
+		logger.Info("broadcast campaign completed", slog.String("campaign_id", campaign.GetID().String()))
+	}
+	return delivered, nil
+}
+
+// allowance converts the campaign's per-minute throttle into a per-tick batch size.
+func (uc *DispatchCampaignsUseCase) allowance(campaign entities.BroadcastCampaign) int {
+	allowance := int(int64(campaign.GetThrottlePerMinute()) * int64(uc.config.Interval) / int64(time.Minute))
+	if allowance < 1 {
+		return 1
+	}
+	return allowance
+}
+
+func (uc *DispatchCampaignsUseCase) notify(ctx context.Context, campaign entities.BroadcastCampaign, userID uuid.UUID) error {
+	if uc.notifier == nil {
+		return errors.New("broadcast: notifier not configured")
+	}
+	return uc.notifier.Notify(ctx, EventBroadcastMessage, map[string]any{
+		"user_id":     userID.String(),
+		"campaign_id": campaign.GetID().String(),
+		"title":       campaign.GetTitle(),
+		"body":        campaign.GetBody(),
+	})
+}
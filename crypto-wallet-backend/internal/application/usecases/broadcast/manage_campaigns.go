@@ -0,0 +1,197 @@
+package broadcast
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// campaignListCaps bounds campaign listings.
+var campaignListCaps = repositories.PaginationCaps{MaxLimit: 100, MaxOffset: 5000}
+
+// ListCampaignsInput captures paging parameters, optionally filtered by status.
+type ListCampaignsInput struct {
+	Status string
+	Limit  int
+	Offset int
+}
+
+// ListCampaignsUseCase lists broadcast campaigns, newest first.
+type ListCampaignsUseCase struct {
+	campaigns repositories.BroadcastRepository
+	logger    *slog.Logger
+}
+
+// NewListCampaignsUseCase constructs the use case.
+func NewListCampaignsUseCase(campaigns repositories.BroadcastRepository, logger *slog.Logger) *ListCampaignsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ListCampaignsUseCase{
+		campaigns: campaigns,
+		logger:    logger,
+	}
+}
+
+// Execute returns a page of campaigns.
+func (uc *ListCampaignsUseCase) Execute(ctx context.Context, input ListCampaignsInput) (dto.BroadcastCampaignList, error) {
+	opts := repositories.ListOptions{
+		Limit:  input.Limit,
+		Offset: input.Offset,
+		Caps:   campaignListCaps,
+	}
+	if err := opts.CheckCaps(); err != nil {
+		return dto.BroadcastCampaignList{}, err
+	}
+	opts = opts.WithDefaults()
+
+	filter := repositories.BroadcastFilter{}
+	if input.Status != "" {
+		validation := utils.ValidationErrors{}
+		utils.RequireInSet(&validation, "status", input.Status, []string{
+			string(entities.BroadcastStatusScheduled),
+			string(entities.BroadcastStatusSending),
+			string(entities.BroadcastStatusCompleted),
+			string(entities.BroadcastStatusCancelled),
+		})
+		if err := wrapValidationError(validation); err != nil {
+			return dto.BroadcastCampaignList{}, err
+		}
+		status := entities.BroadcastCampaignStatus(input.Status)
+		filter.Status = &status
+	}
+
+	campaigns, err := uc.campaigns.List(ctx, filter, opts)
+	if err != nil {
+		uc.logger.Error("failed to list broadcast campaigns", slog.String("error", err.Error()))
+		return dto.BroadcastCampaignList{}, err
+	}
+
+	items := make([]dto.BroadcastCampaignResponse, 0, len(campaigns))
+	for _, campaign := range campaigns {
+		items = append(items, dto.NewBroadcastCampaignResponse(campaign))
+	}
+
+	return dto.BroadcastCampaignList{
+		Campaigns: items,
+		Limit:     opts.Limit,
+		Offset:    opts.Offset,
+	}, nil
+}
+
+// GetCampaignUseCase returns a campaign together with its delivery and open stats.
+type GetCampaignUseCase struct {
+	campaigns repositories.BroadcastRepository
+	logger    *slog.Logger
+}
+
+// NewGetCampaignUseCase constructs the use case.
+func NewGetCampaignUseCase(campaigns repositories.BroadcastRepository, logger *slog.Logger) *GetCampaignUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GetCampaignUseCase{
+		campaigns: campaigns,
+		logger:    logger,
+	}
+}
+
+// Execute loads the campaign and its stats.
+func (uc *GetCampaignUseCase) Execute(ctx context.Context, campaignID uuid.UUID) (dto.BroadcastCampaignResponse, error) {
+	campaign, err := loadCampaign(ctx, uc.campaigns, campaignID)
+	if err != nil {
+		return dto.BroadcastCampaignResponse{}, err
+	}
+
+	stats, err := uc.campaigns.Stats(ctx, campaign.GetID())
+	if err != nil {
+		uc.logger.Error("failed to load broadcast stats",
+			slog.String("campaign_id", campaign.GetID().String()),
+			slog.String("error", err.Error()))
+		return dto.BroadcastCampaignResponse{}, err
+	}
+
+	response := dto.NewBroadcastCampaignResponse(campaign)
+	statsResponse := dto.NewBroadcastStatsResponse(stats)
+	response.Stats = &statsResponse
+	return response, nil
+}
+
+// CancelCampaignInput identifies the campaign to cancel and the operator cancelling it.
+type CancelCampaignInput struct {
+	ActorID    uuid.UUID
+	CampaignID uuid.UUID
+}
+
+// CancelCampaignUseCase stops a scheduled or sending campaign. Messages already delivered stay delivered.
+type CancelCampaignUseCase struct {
+	campaigns repositories.BroadcastRepository
+	audit     AuditLogger
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+// NewCancelCampaignUseCase constructs the use case.
+func NewCancelCampaignUseCase(campaigns repositories.BroadcastRepository, auditLogger AuditLogger, logger *slog.Logger) *CancelCampaignUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &CancelCampaignUseCase{
+		campaigns: campaigns,
+		audit:     auditLogger,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+// Execute cancels the campaign.
+func (uc *CancelCampaignUseCase) Execute(ctx context.Context, input CancelCampaignInput) (dto.BroadcastCampaignResponse, error) {
+	campaign, err := loadCampaign(ctx, uc.campaigns, input.CampaignID)
+	if err != nil {
+		return dto.BroadcastCampaignResponse{}, err
+	}
+
+	previous := campaign.GetStatus()
+	if err := campaign.Cancel(uc.now().UTC()); err != nil {
+		return dto.BroadcastCampaignResponse{}, campaignConflictError(previous, err)
+	}
+	if err := uc.campaigns.Cancel(ctx, campaign); err != nil {
+		if errors.Is(err, repositories.ErrConflict) {
+			return dto.BroadcastCampaignResponse{}, campaignConflictError(previous, err)
+		}
+		return dto.BroadcastCampaignResponse{}, err
+	}
+
+	if uc.audit != nil {
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID:  input.ActorID.String(),
+			Action:   AuditActionBroadcastCancelled,
+			TargetID: campaign.GetID().String(),
+			Metadata: map[string]any{"previous_status": string(previous)},
+		}); err != nil {
+			uc.logger.Warn("failed to record audit entry", slog.String("action", AuditActionBroadcastCancelled), slog.String("error", err.Error()))
+		}
+	}
+
+	return dto.NewBroadcastCampaignResponse(campaign), nil
+}
+
+func campaignConflictError(status entities.BroadcastCampaignStatus, err error) error {
+	return utils.NewAppError(
+		"BROADCAST_NOT_CANCELLABLE",
+		"only scheduled or sending campaigns can be cancelled",
+		fiber.StatusConflict,
+		err,
+		map[string]any{"status": status},
+	)
+}
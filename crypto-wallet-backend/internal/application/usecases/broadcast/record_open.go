@@ -0,0 +1,51 @@
+package broadcast
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// RecordOpenInput identifies the message a user opened.
+type RecordOpenInput struct {
+	UserID     uuid.UUID
+	CampaignID uuid.UUID
+}
+
+// RecordOpenUseCase records that a user opened a broadcast message. Repeat opens are ignored.
+type RecordOpenUseCase struct {
+	campaigns repositories.BroadcastRepository
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+// NewRecordOpenUseCase constructs the use case.
+func NewRecordOpenUseCase(campaigns repositories.BroadcastRepository, logger *slog.Logger) *RecordOpenUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RecordOpenUseCase{
+		campaigns: campaigns,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+// Execute records the open.
+func (uc *RecordOpenUseCase) Execute(ctx context.Context, input RecordOpenInput) error {
+	if err := uc.campaigns.MarkOpened(ctx, input.CampaignID, input.UserID, uc.now().UTC()); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return notFoundError("broadcast message", err)
+		}
+		uc.logger.Error("failed to record broadcast open",
+			slog.String("campaign_id", input.CampaignID.String()),
+			slog.String("error", err.Error()))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package broadcast
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// EventBroadcastMessage is published once per recipient of a campaign.
+const EventBroadcastMessage = "broadcast.message"
+
+// Audit actions recorded for campaign management.
+const (
+	AuditActionBroadcastCreated   = "admin.broadcast_created"
+	AuditActionBroadcastCancelled = "admin.broadcast_cancelled"
+)
+
+// Notifier delivers user-facing notifications.
+type Notifier interface {
+	Notify(ctx context.Context, event string, data map[string]any) error
+}
+
+// AuditLogger captures audit events for compliance.
+type AuditLogger interface {
+	Record(ctx context.Context, entry audit.Entry) error
+}
+
+// KYCUserLister resolves KYC statuses, which live in the KYC database, to user IDs.
+type KYCUserLister interface {
+	ListUserIDsByStatus(ctx context.Context, statuses []entities.KYCStatus) ([]uuid.UUID, error)
+}
+
+var errKYCSegmentUnavailable = errors.New("broadcast: kyc segmentation is not configured")
+
+// resolveScope turns a segment into a scope the broadcast repository can evaluate on its own.
+func resolveScope(ctx context.Context, kyc KYCUserLister, segment entities.BroadcastSegment) (repositories.SegmentScope, error) {
+	scope := repositories.SegmentScope{Segment: segment}
+	if len(segment.KYCStatuses) == 0 {
+		return scope, nil
+	}
+	if kyc == nil {
+		return repositories.SegmentScope{}, errKYCSegmentUnavailable
+	}
+
+	userIDs, err := kyc.ListUserIDsByStatus(ctx, segment.KYCStatuses)
+	if err != nil {
+		return repositories.SegmentScope{}, err
+	}
+	scope.UserIDs = userIDs
+	scope.Restricted = true
+	return scope, nil
+}
+
+func kycSegmentUnavailableError() error {
+	return utils.NewAppError(
+		"KYC_SEGMENT_UNAVAILABLE",
+		"segmenting by kyc status is not available",
+		fiber.StatusUnprocessableEntity,
+		errKYCSegmentUnavailable,
+		nil,
+	)
+}
+
+func loadCampaign(ctx context.Context, campaigns repositories.BroadcastRepository, id uuid.UUID) (*entities.BroadcastCampaignEntity, error) {
+	campaign, err := campaigns.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, notFoundError("broadcast campaign", err)
+		}
+		return nil, err
+	}
+	entity, ok := campaign.(*entities.BroadcastCampaignEntity)
+	if !ok {
+		return nil, errors.New("broadcast: unexpected campaign implementation")
+	}
+	return entity, nil
+}
+
+func wrapValidationError(errs utils.ValidationErrors) error {
+	if errs.IsEmpty() {
+		return nil
+	}
+	return utils.NewAppError(
+		"VALIDATION_ERROR",
+		"broadcast request invalid",
+		fiber.StatusBadRequest,
+		errs,
+		map[string]any{"errors": errs},
+	)
+}
+
+func notFoundError(resource string, err error) error {
+	return utils.NewAppError(
+		"NOT_FOUND",
+		resource+" not found",
+		fiber.StatusNotFound,
+		err,
+		nil,
+	)
+}
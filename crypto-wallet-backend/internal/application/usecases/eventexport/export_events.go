@@ -0,0 +1,82 @@
+package eventexport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// maxExportPagesPerRun keeps a single run bounded when a large backlog has built up.
+const maxExportPagesPerRun = 20
+
+// ExportEventsUseCase ships new outbox events to the configured sink and advances the sink's cursor.
+type ExportEventsUseCase struct {
+	outbox    repositories.EventOutboxRepository
+	sink      Sink
+	batchSize int
+	logger    *slog.Logger
+}
+
+// NewExportEventsUseCase constructs the use case.
+func NewExportEventsUseCase(outbox repositories.EventOutboxRepository, sink Sink, batchSize int, logger *slog.Logger) *ExportEventsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ExportEventsUseCase{
+		outbox:    outbox,
+		sink:      sink,
+		batchSize: normalizeBatchSize(batchSize),
+		logger:    logger,
+	}
+}
+
+// Execute exports events recorded since the last run and reports how many were exported. The
+// cursor only moves after every partition of a page has been written, so a failed run is retried
+// from the same point on the next tick.
+func (uc *ExportEventsUseCase) Execute(ctx context.Context) (int, error) {
+	if uc.sink == nil {
+		return 0, errors.New("event export sink is not configured")
+	}
+
+	cursor, err := uc.outbox.GetCursor(ctx, uc.sink.Name())
+	if err != nil {
+		return 0, fmt.Errorf("failed to load export cursor: %w", err)
+	}
+
+	exported := 0
+	for page := 0; page < maxExportPagesPerRun; page++ {
+		events, err := uc.outbox.ListAfter(ctx, cursor, uc.batchSize)
+		if err != nil {
+			return exported, err
+		}
+		if len(events) == 0 {
+			break
+		}
+
+		for _, batch := range partitionEvents(events) {
+			location, err := uc.sink.Write(ctx, batch)
+			if err != nil {
+				return exported, fmt.Errorf("failed to export %s events: %w", batch.EventType, err)
+			}
+			uc.logger.Debug("exported event batch",
+				slog.String("partition", batch.PartitionPath()),
+				slog.Int("count", len(batch.Events)),
+				slog.String("location", location))
+		}
+
+		cursor = events[len(events)-1].Sequence
+		if err := uc.outbox.SaveCursor(ctx, uc.sink.Name(), cursor); err != nil {
+			return exported, fmt.Errorf("failed to save export cursor: %w", err)
+		}
+		exported += len(events)
+
+		if len(events) < uc.batchSize {
+			break
+		}
+	}
+
+	return exported, nil
+}
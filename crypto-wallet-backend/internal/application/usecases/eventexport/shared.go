@@ -0,0 +1,139 @@
+package eventexport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+const (
+	defaultBatchSize = 1000
+	maxBatchSize     = 10000
+	// partitionDateLayout names the daily partition an event lands in.
+	partitionDateLayout = "2006-01-02"
+)
+
+// Sink writes batches of events to the warehouse landing zone (object storage or a topic).
+type Sink interface {
+	// Name identifies the sink; export progress is tracked per name.
+	Name() string
+	// Write stores the batch and returns where it was written. Writing the same batch twice must be
+	// safe: consumers de-duplicate on event_id.
+	Write(ctx context.Context, batch Batch) (string, error)
+}
+
+// Batch is a run of events sharing one partition: event type, UTC date and schema version.
+type Batch struct {
+	EventType     string
+	SchemaVersion int
+	Date          time.Time
+	Events        []entities.DomainEvent
+}
+
+// FirstSequence returns the lowest sequence in the batch.
+func (b Batch) FirstSequence() int64 {
+	if len(b.Events) == 0 {
+		return 0
+	}
+	return b.Events[0].Sequence
+}
+
+// LastSequence returns the highest sequence in the batch.
+func (b Batch) LastSequence() int64 {
+	if len(b.Events) == 0 {
+		return 0
+	}
+	return b.Events[len(b.Events)-1].Sequence
+}
+
+// PartitionPath returns the Hive-style partition the batch belongs to, e.g.
+// "event_type=p2p.transfer.sent/dt=2024-05-01/schema_version=1".
+func (b Batch) PartitionPath() string {
+	return fmt.Sprintf("event_type=%s/dt=%s/schema_version=%d",
+		sanitizePartitionValue(b.EventType),
+		b.Date.UTC().Format(partitionDateLayout),
+		b.SchemaVersion)
+}
+
+// ObjectKey returns a deterministic object name for the batch so re-exports overwrite rather than duplicate.
+func (b Batch) ObjectKey() string {
+	return fmt.Sprintf("%s/events-%020d-%020d.ndjson", b.PartitionPath(), b.FirstSequence(), b.LastSequence())
+}
+
+// NDJSON encodes the batch as newline-delimited JSON, one event per line.
+func (b Batch) NDJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, event := range b.Events {
+		if err := encoder.Encode(event); err != nil {
+			return nil, fmt.Errorf("failed to encode event %s: %w", event.ID, err)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// partitionEvents groups events by partition, keeping sequence order inside each batch and
+// ordering batches by their first sequence.
+func partitionEvents(events []entities.DomainEvent) []Batch {
+	batches := make([]Batch, 0)
+	index := make(map[string]int)
+	for _, event := range events {
+		date := event.OccurredAt.UTC().Truncate(24 * time.Hour)
+		key := fmt.Sprintf("%s|%s|%d", event.Type, date.Format(partitionDateLayout), event.SchemaVersion)
+
+		i, ok := index[key]
+		if !ok {
+			i = len(batches)
+			index[key] = i
+			batches = append(batches, Batch{
+				EventType:     event.Type,
+				SchemaVersion: event.SchemaVersion,
+				Date:          date,
+			})
+		}
+		batches[i].Events = append(batches[i].Events, event)
+	}
+	return batches
+}
+
+func sanitizePartitionValue(value string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '_', r == '-':
+			return r
+		default:
+			return '_'
+		}
+	}, value)
+}
+
+func normalizeBatchSize(size int) int {
+	if size <= 0 {
+		return defaultBatchSize
+	}
+	if size > maxBatchSize {
+		return maxBatchSize
+	}
+	return size
+}
+
+func wrapValidationError(errs utils.ValidationErrors) error {
+	if errs.IsEmpty() {
+		return nil
+	}
+	return utils.NewAppError(
+		"VALIDATION_ERROR",
+		"event backfill request invalid",
+		fiber.StatusBadRequest,
+		errs,
+		map[string]any{"errors": errs},
+	)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
@@ -27,6 +28,9 @@ func NewGetExchangeHistory(exchangeService *services.ExchangeService) *GetExchan
 	}
 }
 
+// exchangeHistoryCaps bounds exchange history pages; page * page_size must stay within MaxOffset.
+var exchangeHistoryCaps = repositories.PaginationCaps{MaxLimit: 100, MaxOffset: 10000}
+
 // Execute retrieves exchange history for the specified user with pagination and filtering.
 func (uc *GetExchangeHistory) Execute(ctx context.Context, userID uuid.UUID, req *dto.ExchangeHistoryRequest) (*dto.ExchangeHistoryResponse, error) {
 	// Validate request
@@ -36,9 +40,6 @@ func (uc *GetExchangeHistory) Execute(ctx context.Context, userID uuid.UUID, req
 	if req.PageSize < 1 {
 		req.PageSize = 10
 	}
-	if req.PageSize > 100 {
-		req.PageSize = 100
-	}
 
 	// Build filter from request
 	filter := repositories.ExchangeOperationFilter{}
@@ -92,6 +93,10 @@ func (uc *GetExchangeHistory) Execute(ctx context.Context, userID uuid.UUID, req
 		Offset:    offset,
 		SortBy:    "created_at",
 		SortOrder: repositories.SortDescending,
+		Caps:      exchangeHistoryCaps,
+	}
+	if err := opts.CheckCaps(); err != nil {
+		return nil, err
 	}
 
 	// Get operations and total count
@@ -108,25 +113,7 @@ func (uc *GetExchangeHistory) Execute(ctx context.Context, userID uuid.UUID, req
 	// Convert to response DTO
 	operationResponses := make([]dto.ExchangeOperationResponse, len(operations))
 	for i, op := range operations {
-		operationResponses[i] = dto.ExchangeOperationResponse{
-			ID:                op.GetID(),
-			UserID:            op.GetUserID(),
-			FromWalletID:      op.GetFromWalletID(),
-			ToWalletID:        op.GetToWalletID(),
-			FromAmount:        op.GetFromAmount(),
-			ToAmount:          op.GetToAmount(),
-			ExchangeRate:      op.GetExchangeRate(),
-			FeePercentage:     op.GetFeePercentage(),
-			FeeAmount:         op.GetFeeAmount(),
-			Status:            string(op.GetStatus()),
-			QuoteExpiresAt:    op.GetQuoteExpiresAt(),
-			ExecutedAt:        op.GetExecutedAt(),
-			FromTransactionID: op.GetFromTransactionID(),
-			ToTransactionID:   op.GetToTransactionID(),
-			ErrorMessage:      op.GetErrorMessage(),
-			CreatedAt:         op.GetCreatedAt(),
-			UpdatedAt:         op.GetUpdatedAt(),
-		}
+		operationResponses[i] = mapExchangeOperation(op)
 	}
 
 	// Calculate total pages
@@ -155,8 +142,14 @@ func (uc *GetExchangeHistory) GetActiveTradingPairs(ctx context.Context) (*dto.T
 		return nil, fmt.Errorf("failed to get active trading pairs: %w", err)
 	}
 
+	now := time.Now().UTC()
+	staleSymbols := make([]string, 0)
 	pairResponses := make([]dto.ExchangeRateResponse, len(pairs))
 	for i, pair := range pairs {
+		stale := entities.IsRateStale(pair.GetLastUpdated(), now, entities.DefaultRateStaleAfter)
+		if stale {
+			staleSymbols = append(staleSymbols, pair.GetBaseSymbol()+"/"+pair.GetQuoteSymbol())
+		}
 		pairResponses[i] = dto.ExchangeRateResponse{
 			BaseSymbol:    pair.GetBaseSymbol(),
 			QuoteSymbol:   pair.GetQuoteSymbol(),
@@ -167,12 +160,15 @@ func (uc *GetExchangeHistory) GetActiveTradingPairs(ctx context.Context) (*dto.T
 			MaxSwapAmount: pair.GetMaxSwapAmount(),
 			IsActive:      pair.IsActive(),
 			HasLiquidity:  pair.HasLiquidity(),
+			Source:        entities.RateSourceInternal,
 			LastUpdated:   pair.GetLastUpdated(),
+			Stale:         stale,
 		}
 	}
 
 	response := &dto.TradingPairsResponse{
-		Pairs: pairResponses,
+		Pairs:            pairResponses,
+		FreshnessWarning: dto.RateFreshnessWarning(staleSymbols),
 	}
 
 	return response, nil
@@ -187,3 +183,27 @@ func isValidExchangeStatus(status entities.ExchangeStatus) bool {
 		return false
 	}
 }
+
+func mapExchangeOperation(op entities.ExchangeOperation) dto.ExchangeOperationResponse {
+	return dto.ExchangeOperationResponse{
+		ID:                op.GetID(),
+		UserID:            op.GetUserID(),
+		FromWalletID:      op.GetFromWalletID(),
+		ToWalletID:        op.GetToWalletID(),
+		FromAmount:        op.GetFromAmount(),
+		ToAmount:          op.GetToAmount(),
+		ExchangeRate:      op.GetExchangeRate(),
+		FeePercentage:     op.GetFeePercentage(),
+		FeeAmount:         op.GetFeeAmount(),
+		Status:            string(op.GetStatus()),
+		QuoteExpiresAt:    op.GetQuoteExpiresAt(),
+		ExecutedAt:        op.GetExecutedAt(),
+		FromTransactionID: op.GetFromTransactionID(),
+		ToTransactionID:   op.GetToTransactionID(),
+		ErrorMessage:      op.GetErrorMessage(),
+		PricingStrategy:   op.GetPricingStrategy(),
+		PricingMetadata:   op.GetPricingMetadata(),
+		CreatedAt:         op.GetCreatedAt(),
+		UpdatedAt:         op.GetUpdatedAt(),
+	}
+}
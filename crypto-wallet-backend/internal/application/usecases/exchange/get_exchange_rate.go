@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
 	"github.com/crypto-wallet/backend/internal/domain/services"
 )
 
@@ -54,7 +56,9 @@ func (uc *GetExchangeRate) Execute(ctx context.Context, baseSymbol, quoteSymbol
 		MaxSwapAmount: pair.GetMaxSwapAmount(),
 		IsActive:      pair.IsActive(),
 		HasLiquidity:  pair.HasLiquidity(),
+		Source:        entities.RateSourceInternal,
 		LastUpdated:   pair.GetLastUpdated(),
+		Stale:         entities.IsRateStale(pair.GetLastUpdated(), time.Now().UTC(), entities.DefaultRateStaleAfter),
 	}
 
 	return response, nil
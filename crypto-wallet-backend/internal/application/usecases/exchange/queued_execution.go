@@ -0,0 +1,219 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/services"
+	"github.com/crypto-wallet/backend/internal/infrastructure/tracing"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// expiredBeforeExecutionReason cancels a queued exchange whose quote ran out before a worker got to it.
+const expiredBeforeExecutionReason = "Quote expired before execution"
+
+// ExecutionQueue holds exchange executions for background workers.
+type ExecutionQueue interface {
+	Enqueue(ctx context.Context, payload []byte) (string, error)
+}
+
+// ExecutionJob is the queued request to execute an exchange.
+type ExecutionJob struct {
+	OperationID uuid.UUID `json:"operation_id"`
+	UserID      uuid.UUID `json:"user_id"`
+	RequestedAt time.Time `json:"requested_at"`
+}
+
+// RequestExecution accepts a quoted exchange for execution. Authorization and limits are checked
+// here, once, and the execution is queued; clients follow it with GetSwap or the exchange events.
+// An operation that has already left pending is returned as it stands. Without a queue the
+// exchange executes before returning, as ExecuteSwap does.
+func (uc *SwapTokens) RequestExecution(ctx context.Context, userID uuid.UUID, req *dto.ExecuteExchangeRequest) (_ *dto.ExecuteExchangeResponse, err error) {
+	if uc.queue == nil {
+		return uc.ExecuteSwap(ctx, userID, req)
+	}
+
+	if err := uc.acceptQuoteToken(ctx, userID, req); err != nil {
+		return nil, err
+	}
+
+	ctx, span := tracing.Start(ctx, "exchange.RequestExecution", attribute.String("exchange.operation_id", req.OperationID.String()))
+	defer func() { tracing.End(span, err) }()
+
+	if req.OperationID == uuid.Nil {
+		return nil, utils.NewAppError("VALIDATION_ERROR", "operation ID or quote token is required", fiber.StatusBadRequest, nil, nil)
+	}
+
+	quoted, err := uc.loadOperation(ctx, req.OperationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Executing a quote spends from the source wallet, so members need the approve permission
+	if err := uc.authorizeOperation(ctx, userID, quoted, entities.WalletPermissionApprove); err != nil {
+		return nil, err
+	}
+
+	if quoted.GetStatus() != entities.ExchangeStatusPending {
+		return executionResponse(quoted), nil
+	}
+	if !time.Now().UTC().Before(quoted.GetQuoteExpiresAt()) {
+		return nil, quoteExpiredError()
+	}
+
+	if err := uc.checkLimits(ctx, quoted); err != nil {
+		if errors.Is(err, services.ErrLimitExceeded) {
+			return nil, utils.NewAppError("LIMIT_EXCEEDED", "exchange exceeds your limits", fiber.StatusForbidden, err, nil)
+		}
+		return nil, err
+	}
+
+	payload, err := json.Marshal(ExecutionJob{
+		OperationID: quoted.GetID(),
+		UserID:      userID,
+		RequestedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode exchange job: %w", err)
+	}
+	jobID, err := uc.queue.Enqueue(ctx, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to queue exchange: %w", err)
+	}
+	uc.logger.Info("exchange execution queued",
+		slog.String("operation_id", quoted.GetID().String()),
+		slog.String("job_id", jobID))
+
+	response := executionResponse(quoted)
+	response.Queued = true
+	return response, nil
+}
+
+// ProcessExecution runs a queued execution. It returns nil once the operation reaches a state
+// retrying cannot change, and an error for the queue to retry otherwise. A quote that expired
+// while queued is cancelled so that clients polling the operation see a final status.
+func (uc *SwapTokens) ProcessExecution(ctx context.Context, job ExecutionJob) (err error) {
+	ctx, span := tracing.Start(ctx, "exchange.ProcessExecution", attribute.String("exchange.operation_id", job.OperationID.String()))
+	defer func() { tracing.End(span, err) }()
+
+	logger := uc.logger.With(slog.String("operation_id", job.OperationID.String()))
+
+	before, err := uc.exchangeService.GetExchangeOperation(ctx, job.OperationID)
+	if err != nil {
+		if errors.Is(err, services.ErrExchangeNotFound) {
+			logger.Warn("queued exchange no longer exists")
+			return nil
+		}
+		return err
+	}
+
+	operation, err := uc.exchangeService.ExecuteExchange(ctx, job.OperationID)
+	uc.recordExecution(ctx, job.UserID, job.OperationID, before, operation, err)
+	uc.notifyExecution(ctx, before, operation)
+
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, services.ErrExchangeQuoteExpired):
+		if _, _, cancelErr := uc.exchangeService.CancelPendingExchange(ctx, job.OperationID, expiredBeforeExecutionReason); cancelErr != nil {
+			return fmt.Errorf("failed to cancel expired exchange: %w", cancelErr)
+		}
+		logger.Info("queued exchange expired before execution")
+		return nil
+	case errors.Is(err, services.ErrExchangeInvalidStatus), errors.Is(err, services.ErrExchangeNotFound):
+		logger.Info("queued exchange can no longer be executed", slog.String("error", err.Error()))
+		return nil
+	default:
+		return fmt.Errorf("failed to execute exchange: %w", err)
+	}
+}
+
+// GetSwap returns an exchange operation the user may view.
+func (uc *SwapTokens) GetSwap(ctx context.Context, userID, operationID uuid.UUID) (*dto.ExchangeOperationResponse, error) {
+	operation, err := uc.loadOperation(ctx, operationID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizeOperation(ctx, userID, operation, entities.WalletPermissionView); err != nil {
+		return nil, err
+	}
+	response := mapExchangeOperation(operation)
+	return &response, nil
+}
+
+func (uc *SwapTokens) loadOperation(ctx context.Context, operationID uuid.UUID) (entities.ExchangeOperation, error) {
+	operation, err := uc.exchangeService.GetExchangeOperation(ctx, operationID)
+	if err != nil {
+		if errors.Is(err, services.ErrExchangeNotFound) {
+			return nil, exchangeNotFoundError()
+		}
+		return nil, err
+	}
+	return operation, nil
+}
+
+// authorizeOperation checks the user's permission on the operation's source wallet, or that they
+// own the operation when there is no authorizer. Operations the user may not view are reported as
+// missing.
+func (uc *SwapTokens) authorizeOperation(ctx context.Context, userID uuid.UUID, operation entities.ExchangeOperation, permission entities.WalletPermission) error {
+	if uc.authorizer == nil {
+		if operation.GetUserID() != userID {
+			return exchangeNotFoundError()
+		}
+		return nil
+	}
+
+	wallet, err := uc.authorizer.AuthorizeWallet(ctx, operation.GetFromWalletID(), userID, permission)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrWalletNotFound):
+			return exchangeNotFoundError()
+		case errors.Is(err, services.ErrWalletPermissionDenied) && permission == entities.WalletPermissionView:
+			return exchangeNotFoundError()
+		case errors.Is(err, services.ErrWalletPermissionDenied):
+			return utils.NewAppError(
+				"WALLET_PERMISSION_DENIED",
+				fmt.Sprintf("you do not have %s permission on this wallet", permission),
+				fiber.StatusForbidden,
+				err,
+				nil,
+			)
+		default:
+			return fmt.Errorf("failed to authorize wallet: %w", err)
+		}
+	}
+	if permission != entities.WalletPermissionView && wallet.GetStatus() == entities.WalletStatusDormant {
+		return utils.NewAppError(
+			"WALLET_DORMANT",
+			"wallet is dormant; reactivate it with two-factor authentication first",
+			fiber.StatusConflict,
+			nil,
+			nil,
+		)
+	}
+	return nil
+}
+
+func quoteExpiredError() error {
+	return utils.NewAppError("EXCHANGE_QUOTE_EXPIRED", "quote has expired, please get a new quote", fiber.StatusConflict, nil, nil)
+}
+
+func exchangeNotFoundError() error {
+	return utils.NewAppError(
+		"EXCHANGE_NOT_FOUND",
+		"exchange operation not found",
+		fiber.StatusNotFound,
+		nil,
+		nil,
+	)
+}
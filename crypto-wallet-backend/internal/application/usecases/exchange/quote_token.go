@@ -0,0 +1,182 @@
+package exchange
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/services"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// quoteTerms is the signed content of a quote token: everything needed to store the quoted
+// operation at execution time. Keys are kept short because the token travels in every request.
+type quoteTerms struct {
+	OperationID     uuid.UUID         `json:"id"`
+	UserID          uuid.UUID         `json:"u"`
+	FromWalletID    uuid.UUID         `json:"fw"`
+	ToWalletID      uuid.UUID         `json:"tw"`
+	FromAmount      decimal.Decimal   `json:"fa"`
+	ToAmount        decimal.Decimal   `json:"ta"`
+	ExchangeRate    decimal.Decimal   `json:"r"`
+	FeePercentage   decimal.Decimal   `json:"fp"`
+	FeeAmount       decimal.Decimal   `json:"f"`
+	PricingStrategy string            `json:"ps,omitempty"`
+	PricingMetadata map[string]string `json:"pm,omitempty"`
+	QuotedAt        int64             `json:"q"`
+	ExpiresAt       int64             `json:"e"`
+}
+
+// QuoteSigner issues and verifies quote tokens. A token is
+// "<base64url JSON terms>.<base64url HMAC-SHA256>", so quotes need no storage until they are
+// executed and a client that edits an amount, the rate or the expiry before executing is rejected.
+type QuoteSigner struct {
+	secret []byte
+}
+
+// NewQuoteSigner constructs a QuoteSigner for the supplied secret.
+func NewQuoteSigner(secret string) (*QuoteSigner, error) {
+	if strings.TrimSpace(secret) == "" {
+		return nil, errors.New("exchange: quote signing secret is required")
+	}
+	return &QuoteSigner{secret: []byte(secret)}, nil
+}
+
+func (s *QuoteSigner) sign(operation entities.ExchangeOperation) (string, error) {
+	raw, err := json.Marshal(quoteTerms{
+		OperationID:     operation.GetID(),
+		UserID:          operation.GetUserID(),
+		FromWalletID:    operation.GetFromWalletID(),
+		ToWalletID:      operation.GetToWalletID(),
+		FromAmount:      operation.GetFromAmount(),
+		ToAmount:        operation.GetToAmount(),
+		ExchangeRate:    operation.GetExchangeRate(),
+		FeePercentage:   operation.GetFeePercentage(),
+		FeeAmount:       operation.GetFeeAmount(),
+		PricingStrategy: operation.GetPricingStrategy(),
+		PricingMetadata: operation.GetPricingMetadata(),
+		QuotedAt:        operation.GetCreatedAt().UnixMilli(),
+		ExpiresAt:       operation.GetQuoteExpiresAt().UnixMilli(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("encode quote token: %w", err)
+	}
+	body := base64.RawURLEncoding.EncodeToString(raw)
+	return body + "." + s.mac(body), nil
+}
+
+// verify checks the token's signature and returns the quoted operation as it is stored, pending.
+// Expiry is left to the caller, which reports it separately.
+func (s *QuoteSigner) verify(token string) (*entities.ExchangeOperationEntity, error) {
+	body, provided, ok := strings.Cut(strings.TrimSpace(token), ".")
+	if !ok || !hmac.Equal([]byte(s.mac(body)), []byte(provided)) {
+		return nil, quoteTokenInvalidError()
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(body)
+	if err != nil {
+		return nil, quoteTokenInvalidError()
+	}
+	var terms quoteTerms
+	if err := json.Unmarshal(raw, &terms); err != nil {
+		return nil, quoteTokenInvalidError()
+	}
+
+	quotedAt := time.UnixMilli(terms.QuotedAt).UTC()
+	operation, err := entities.NewExchangeOperationEntity(entities.ExchangeOperationParams{
+		ID:              terms.OperationID,
+		UserID:          terms.UserID,
+		FromWalletID:    terms.FromWalletID,
+		ToWalletID:      terms.ToWalletID,
+		FromAmount:      terms.FromAmount,
+		ToAmount:        terms.ToAmount,
+		ExchangeRate:    terms.ExchangeRate,
+		FeePercentage:   terms.FeePercentage,
+		FeeAmount:       terms.FeeAmount,
+		Status:          entities.ExchangeStatusPending,
+		QuoteExpiresAt:  time.UnixMilli(terms.ExpiresAt).UTC(),
+		PricingStrategy: terms.PricingStrategy,
+		PricingMetadata: terms.PricingMetadata,
+		CreatedAt:       quotedAt,
+		UpdatedAt:       quotedAt,
+	})
+	if err != nil {
+		return nil, quoteTokenInvalidError()
+	}
+	return operation, nil
+}
+
+// acceptQuoteToken stores the operation a quote token was issued for and points the request at it.
+// Requests without a token name a stored operation themselves. A token for a quote that has since
+// expired still resolves an operation that was already stored, so retries see its final state.
+// The user must be allowed to execute the quote before it holds any liquidity, so a token that
+// leaks to another user cannot tie up the pair's reserve.
+func (uc *SwapTokens) acceptQuoteToken(ctx context.Context, userID uuid.UUID, req *dto.ExecuteExchangeRequest) error {
+	if strings.TrimSpace(req.QuoteToken) == "" {
+		return nil
+	}
+	if uc.quotes == nil {
+		return utils.NewAppError(
+			"EXCHANGE_QUOTE_TOKENS_UNAVAILABLE",
+			"quote tokens are not configured; execute the exchange by operation ID",
+			fiber.StatusServiceUnavailable,
+			nil,
+			nil,
+		)
+	}
+
+	operation, err := uc.quotes.verify(req.QuoteToken)
+	if err != nil {
+		return err
+	}
+	if req.OperationID != uuid.Nil && req.OperationID != operation.GetID() {
+		return quoteTokenInvalidError()
+	}
+	if err := uc.authorizeOperation(ctx, userID, operation, entities.WalletPermissionApprove); err != nil {
+		return err
+	}
+
+	if !time.Now().UTC().Before(operation.GetQuoteExpiresAt()) {
+		if _, err := uc.exchangeService.GetExchangeOperation(ctx, operation.GetID()); err != nil {
+			if errors.Is(err, services.ErrExchangeNotFound) {
+				return quoteExpiredError()
+			}
+			return err
+		}
+	} else if _, err := uc.exchangeService.AcceptQuote(ctx, operation); err != nil {
+		if errors.Is(err, services.ErrExchangeNoLiquidity) {
+			return quoteError(err)
+		}
+		return fmt.Errorf("failed to accept quote: %w", err)
+	}
+
+	req.OperationID = operation.GetID()
+	return nil
+}
+
+func (s *QuoteSigner) mac(body string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(body))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func quoteTokenInvalidError() error {
+	return utils.NewAppError(
+		"EXCHANGE_QUOTE_INVALID",
+		"quote token is invalid or was modified, please get a new quote",
+		fiber.StatusBadRequest,
+		nil,
+		nil,
+	)
+}
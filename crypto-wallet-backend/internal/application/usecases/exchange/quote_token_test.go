@@ -0,0 +1,86 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/domain/services"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// recordingExchangeRepo stores nothing and counts the reads and writes a quote token causes.
+type recordingExchangeRepo struct {
+	repositories.ExchangeOperationRepository
+	reads, creates atomic.Int32
+}
+
+func (r *recordingExchangeRepo) GetByID(context.Context, uuid.UUID) (entities.ExchangeOperation, error) {
+	r.reads.Add(1)
+	return nil, repositories.ErrNotFound
+}
+
+func (r *recordingExchangeRepo) Create(context.Context, *entities.ExchangeOperationEntity) error {
+	r.creates.Add(1)
+	return nil
+}
+
+// recordingLiquidityRepo counts the holds placed on the pair's reserve.
+type recordingLiquidityRepo struct {
+	repositories.TradingPairLiquidityRepository
+	holds atomic.Int32
+}
+
+func (r *recordingLiquidityRepo) Hold(context.Context, uuid.UUID, uuid.UUID, decimal.Decimal, time.Time) (entities.TradingPairLiquidity, bool, error) {
+	r.holds.Add(1)
+	return entities.TradingPairLiquidity{}, false, repositories.ErrNotFound
+}
+
+func TestExecuteSwapRejectsQuoteTokenOfAnotherUser(t *testing.T) {
+	now := time.Now().UTC()
+	operation := entities.HydrateExchangeOperationEntity(entities.ExchangeOperationParams{
+		ID:             uuid.New(),
+		UserID:         uuid.New(),
+		FromWalletID:   uuid.New(),
+		ToWalletID:     uuid.New(),
+		FromAmount:     decimal.RequireFromString("2"),
+		ToAmount:       decimal.RequireFromString("0.05"),
+		ExchangeRate:   decimal.RequireFromString("0.025"),
+		Status:         entities.ExchangeStatusPending,
+		QuoteExpiresAt: now.Add(time.Minute),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	})
+	signer, err := NewQuoteSigner("test-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := signer.sign(operation)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exchanges, liquidity := &recordingExchangeRepo{}, &recordingLiquidityRepo{}
+	service := services.NewExchangeService(exchanges, nil, nil, nil, services.PricingStrategies{}, nil, liquidity)
+	uc := NewSwapTokens(service, nil, nil, nil, nil, nil, signer, nil)
+
+	_, err = uc.ExecuteSwap(context.Background(), uuid.New(), &dto.ExecuteExchangeRequest{QuoteToken: token})
+	var appErr *utils.AppError
+	if !errors.As(err, &appErr) || appErr.Code != "EXCHANGE_NOT_FOUND" {
+		t.Fatalf("ExecuteSwap() error = %v, want EXCHANGE_NOT_FOUND", err)
+	}
+	if got := liquidity.holds.Load(); got != 0 {
+		t.Errorf("liquidity holds = %d, want 0", got)
+	}
+	if got := exchanges.reads.Load() + exchanges.creates.Load(); got != 0 {
+		t.Errorf("exchange repository calls = %d, want 0", got)
+	}
+}
@@ -4,107 +4,197 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"strings"
 	"time"
 
+	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/crypto-wallet/backend/internal/application/dto"
 	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/events"
 	"github.com/crypto-wallet/backend/internal/domain/services"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/internal/infrastructure/tracing"
+	"github.com/crypto-wallet/backend/pkg/utils"
 )
 
+// Events emitted once an exchange execution settles.
+const (
+	EventExchangeCompleted = events.ExchangeCompletedEvent
+	EventExchangeFailed    = "exchange.failed"
+)
+
+// WalletAuthorizer checks that a user may act on a wallet they own or were granted access to.
+type WalletAuthorizer interface {
+	AuthorizeWallet(ctx context.Context, walletID, userID uuid.UUID, permission entities.WalletPermission) (entities.Wallet, error)
+}
+
+// AuditLogger captures exchange executions for compliance.
+type AuditLogger interface {
+	Record(ctx context.Context, entry audit.Entry) error
+}
+
+// LimitPolicy checks an outflow from a wallet against its owner's KYC limits.
+type LimitPolicy interface {
+	CheckOutflow(ctx context.Context, walletID uuid.UUID, amount decimal.Decimal, operation string) error
+}
+
+// Notifier publishes exchange events, typically through the event dispatcher.
+type Notifier interface {
+	Publish(ctx context.Context, event events.Event) error
+}
+
 // SwapTokens handles the complete token swap process from quote to execution.
 type SwapTokens struct {
 	exchangeService *services.ExchangeService
+	authorizer      WalletAuthorizer
+	audit           AuditLogger
+	notifier        Notifier
+	limits          LimitPolicy
+	queue           ExecutionQueue
+	quotes          *QuoteSigner
+	logger          *slog.Logger
 }
 
-// NewSwapTokens creates a new SwapTokens use case.
-func NewSwapTokens(exchangeService *services.ExchangeService) *SwapTokens {
+// NewSwapTokens creates a new SwapTokens use case. When an authorizer is supplied, members of shared
+// wallets may quote with the initiate permission and execute with the approve permission.
+// Executions are audited when an audit logger is supplied and announced when a notifier is. When a
+// limit policy is supplied, the source amount must fit the wallet owner's KYC limits to execute.
+// When a queue is supplied, RequestExecution queues executions for ProcessExecution to run. When a
+// quote signer is supplied, quotes carry a signed token and execute without being stored first;
+// otherwise each quote is stored as it is issued.
+func NewSwapTokens(exchangeService *services.ExchangeService, authorizer WalletAuthorizer, auditLogger AuditLogger, notifier Notifier, limits LimitPolicy, queue ExecutionQueue, quotes *QuoteSigner, logger *slog.Logger) *SwapTokens {
+	if logger == nil {
+		logger = slog.Default()
+	}
 	return &SwapTokens{
 		exchangeService: exchangeService,
+		authorizer:      authorizer,
+		audit:           auditLogger,
+		notifier:        notifier,
+		limits:          limits,
+		queue:           queue,
+		quotes:          quotes,
+		logger:          logger,
 	}
 }
 
-// GetQuote generates an exchange quote for the specified parameters.
-func (uc *SwapTokens) GetQuote(ctx context.Context, userID uuid.UUID, req *dto.QuoteRequest) (*dto.QuoteResponse, error) {
-	// Validate request
-	if req.FromWalletID == uuid.Nil {
-		return nil, errors.New("from wallet ID is required")
-	}
-	if req.ToWalletID == uuid.Nil {
-		return nil, errors.New("to wallet ID is required")
-	}
-	if req.FromAmount == "" {
-		return nil, errors.New("from amount is required")
-	}
+// GetQuote prices an exchange from either the amount to spend or the amount to receive. The quote
+// itemises its fee and checks the source amount against the pair's swap limits; an amount outside
+// them is rejected with the same check in the error details. When a quote signer is configured the
+// quote carries a token that ExecuteSwap and RequestExecution accept in place of an operation ID;
+// without one the quote is stored, holding the pair's liquidity, so its operation ID executes.
+func (uc *SwapTokens) GetQuote(ctx context.Context, userID uuid.UUID, req *dto.QuoteRequest) (_ *dto.QuoteResponse, err error) {
+	ctx, span := tracing.Start(ctx, "exchange.GetQuote")
+	defer func() { tracing.End(span, err) }()
 
-	// Parse from amount
-	fromAmount, err := decimal.NewFromString(req.FromAmount)
+	fromAmount, toAmount, err := parseQuoteRequest(req)
 	if err != nil {
-		return nil, fmt.Errorf("invalid from amount: %w", err)
+		return nil, err
 	}
 
-	if fromAmount.LessThanOrEqual(decimal.Zero) {
-		return nil, errors.New("from amount must be positive")
-	}
-
-	// Calculate quote using domain service
-	operation, err := uc.exchangeService.CalculateQuote(ctx, userID, req.FromWalletID, req.ToWalletID, fromAmount)
-	if err != nil {
-		if errors.Is(err, services.ErrExchangeSameWallets) {
-			return nil, errors.New("cannot exchange between the same wallet")
-		}
-		if errors.Is(err, services.ErrExchangeInsufficientBalance) {
-			return nil, errors.New("insufficient balance in source wallet")
+	// Shared wallets are quoted on behalf of their owner once the member is authorized
+	quoteUserID := userID
+	if uc.authorizer != nil {
+		fromWallet, err := uc.authorizeWallet(ctx, req.FromWalletID, userID, entities.WalletPermissionInitiate)
+		if err != nil {
+			return nil, err
 		}
-		if errors.Is(err, services.ErrExchangeInvalidTradingPair) {
-			return nil, errors.New("trading pair is not available or inactive")
+		if _, err := uc.authorizeWallet(ctx, req.ToWalletID, userID, entities.WalletPermissionInitiate); err != nil {
+			return nil, err
 		}
-		if errors.Is(err, services.ErrExchangeAmountTooSmall) {
-			return nil, errors.New("amount is below minimum swap requirement")
-		}
-		if errors.Is(err, services.ErrExchangeAmountTooLarge) {
-			return nil, errors.New("amount exceeds maximum swap limit")
-		}
-		if errors.Is(err, services.ErrExchangeNoLiquidity) {
-			return nil, errors.New("insufficient liquidity for this trading pair")
-		}
-		return nil, fmt.Errorf("failed to calculate quote: %w", err)
+		quoteUserID = fromWallet.GetUserID()
 	}
 
-	// Calculate expiration time in seconds
-	expiresIn := int(operation.GetQuoteExpiresAt().Sub(time.Now().UTC()).Seconds())
-	if expiresIn < 0 {
-		expiresIn = 0
+	// Calculate quote using domain service
+	quote, err := uc.exchangeService.CalculateQuote(ctx, services.QuoteInput{
+		UserID:       quoteUserID,
+		FromWalletID: req.FromWalletID,
+		ToWalletID:   req.ToWalletID,
+		FromAmount:   fromAmount,
+		ToAmount:     toAmount,
+	})
+	if err != nil {
+		return nil, quoteError(err)
 	}
+	operation := quote.Operation
 
 	// Convert to response DTO
 	response := &dto.QuoteResponse{
-		OperationID:    operation.GetID(),
-		FromWalletID:   operation.GetFromWalletID(),
-		ToWalletID:     operation.GetToWalletID(),
-		FromAmount:     operation.GetFromAmount(),
-		ToAmount:       operation.GetToAmount(),
-		ExchangeRate:   operation.GetExchangeRate(),
-		FeePercentage:  operation.GetFeePercentage(),
-		FeeAmount:      operation.GetFeeAmount(),
-		QuoteExpiresAt: operation.GetQuoteExpiresAt(),
-		ExpiresIn:      expiresIn,
+		OperationID:     operation.GetID(),
+		FromWalletID:    operation.GetFromWalletID(),
+		ToWalletID:      operation.GetToWalletID(),
+		FromAmount:      operation.GetFromAmount(),
+		ToAmount:        operation.GetToAmount(),
+		ExchangeRate:    operation.GetExchangeRate(),
+		FeePercentage:   operation.GetFeePercentage(),
+		FeeAmount:       operation.GetFeeAmount(),
+		QuoteExpiresAt:  operation.GetQuoteExpiresAt(),
+		QuoteTTL:        int(operation.QuoteTTL().Seconds()),
+		ExpiresIn:       operation.QuoteSecondsRemaining(time.Now().UTC()),
+		PricingStrategy: operation.GetPricingStrategy(),
+		PricingMetadata: operation.GetPricingMetadata(),
+		PricedFrom:      dto.QuoteSideFrom,
+		Fee:             quoteFee(operation),
+		Limits:          quoteLimits(operation.GetFromAmount(), quote.MinSwapAmount, quote.MaxSwapAmount),
+	}
+	if toAmount.IsPositive() {
+		response.PricedFrom = dto.QuoteSideTo
+		response.RequestedToAmount = &toAmount
+	}
+	if uc.quotes != nil {
+		if response.QuoteToken, err = uc.quotes.sign(operation); err != nil {
+			return nil, err
+		}
+	} else if _, err := uc.exchangeService.AcceptQuote(ctx, operation); err != nil {
+		return nil, quoteError(err)
 	}
 
 	return response, nil
 }
 
 // ExecuteSwap executes a previously quoted exchange operation.
-func (uc *SwapTokens) ExecuteSwap(ctx context.Context, req *dto.ExecuteExchangeRequest) (*dto.ExecuteExchangeResponse, error) {
+func (uc *SwapTokens) ExecuteSwap(ctx context.Context, userID uuid.UUID, req *dto.ExecuteExchangeRequest) (_ *dto.ExecuteExchangeResponse, err error) {
+	ctx, span := tracing.Start(ctx, "exchange.ExecuteSwap", attribute.String("exchange.operation_id", req.OperationID.String()))
+	defer func() { tracing.End(span, err) }()
+
+	if err := uc.acceptQuoteToken(ctx, userID, req); err != nil {
+		return nil, err
+	}
+
 	// Validate request
 	if req.OperationID == uuid.Nil {
-		return nil, errors.New("operation ID is required")
+		return nil, errors.New("operation ID or quote token is required")
+	}
+
+	var quoted entities.ExchangeOperation
+	if uc.authorizer != nil || uc.audit != nil || uc.notifier != nil || uc.limits != nil {
+		var err error
+		if quoted, err = uc.exchangeService.GetExchangeOperation(ctx, req.OperationID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Executing a quote spends from the source wallet, so members need the approve permission
+	if uc.authorizer != nil {
+		if _, err := uc.authorizeWallet(ctx, quoted.GetFromWalletID(), userID, entities.WalletPermissionApprove); err != nil {
+			return nil, err
+		}
+	}
+
+	// Limits are checked at execution rather than quoting, since only executed exchanges count
+	if err := uc.checkLimits(ctx, quoted); err != nil {
+		return nil, err
 	}
 
 	// Execute the exchange using domain service
 	operation, err := uc.exchangeService.ExecuteExchange(ctx, req.OperationID)
+	uc.recordExecution(ctx, userID, req.OperationID, quoted, operation, err)
+	uc.notifyExecution(ctx, quoted, operation)
 	if err != nil {
 		if errors.Is(err, services.ErrExchangeQuoteExpired) {
 			return nil, errors.New("quote has expired, please get a new quote")
@@ -112,26 +202,13 @@ func (uc *SwapTokens) ExecuteSwap(ctx context.Context, req *dto.ExecuteExchangeR
 		if errors.Is(err, services.ErrExchangeInvalidStatus) {
 			return nil, errors.New("exchange operation is not in a valid state for execution")
 		}
+		if errors.Is(err, services.ErrExchangeExecutionInProgress) {
+			return nil, errors.New("exchange is already being executed, check its status shortly")
+		}
 		return nil, fmt.Errorf("failed to execute exchange: %w", err)
 	}
 
-	// Convert to response DTO
-	response := &dto.ExecuteExchangeResponse{
-		OperationID:       operation.GetID(),
-		Status:            string(operation.GetStatus()),
-		FromWalletID:      operation.GetFromWalletID(),
-		ToWalletID:        operation.GetToWalletID(),
-		FromAmount:        operation.GetFromAmount(),
-		ToAmount:          operation.GetToAmount(),
-		ExchangeRate:      operation.GetExchangeRate(),
-		FeeAmount:         operation.GetFeeAmount(),
-		ExecutedAt:        operation.GetExecutedAt(),
-		FromTransactionID: operation.GetFromTransactionID(),
-		ToTransactionID:   operation.GetToTransactionID(),
-		ErrorMessage:      operation.GetErrorMessage(),
-	}
-
-	return response, nil
+	return executionResponse(operation), nil
 }
 
 // CancelSwap cancels a pending exchange operation.
@@ -160,3 +237,227 @@ func (uc *SwapTokens) CancelSwap(ctx context.Context, req *dto.CancelExchangeReq
 
 	return response, nil
 }
+
+// recordExecution audits an execution attempt with the operation before and after it ran.
+func (uc *SwapTokens) recordExecution(ctx context.Context, userID, operationID uuid.UUID, before entities.ExchangeOperation, after *entities.ExchangeOperationEntity, execErr error) {
+	if uc.audit == nil {
+		return
+	}
+	entry := audit.Entry{
+		ActorID:      userID,
+		Action:       "exchange_execute",
+		ResourceType: "exchange_operation",
+		TargetID:     operationID.String(),
+	}
+	if before != nil {
+		entry.Before = exchangeSnapshot(before)
+	}
+	if after != nil {
+		entry.After = exchangeSnapshot(after)
+	}
+	if execErr != nil {
+		entry.Error = execErr.Error()
+	}
+	if err := uc.audit.Record(ctx, entry); err != nil {
+		uc.logger.Warn("failed to record exchange audit entry",
+			slog.String("operation_id", operationID.String()),
+			slog.String("error", err.Error()))
+	}
+}
+
+// notifyExecution announces an execution that reached a final status. Retries of an execution that
+// had already settled are not announced again. Notification failures are logged; the exchange
+// itself has already settled.
+func (uc *SwapTokens) notifyExecution(ctx context.Context, before entities.ExchangeOperation, operation *entities.ExchangeOperationEntity) {
+	if uc.notifier == nil || operation == nil {
+		return
+	}
+	if before != nil && before.GetStatus() == operation.GetStatus() {
+		return
+	}
+
+	now := time.Now().UTC()
+	var event events.Event
+	switch operation.GetStatus() {
+	case entities.ExchangeStatusCompleted:
+		event = events.ExchangeCompleted{
+			OperationID:  operation.GetID(),
+			OwnerID:      operation.GetUserID(),
+			FromWalletID: operation.GetFromWalletID(),
+			ToWalletID:   operation.GetToWalletID(),
+			FromAmount:   operation.GetFromAmount(),
+			ToAmount:     operation.GetToAmount(),
+			ExchangeRate: operation.GetExchangeRate(),
+			FeeAmount:    operation.GetFeeAmount(),
+			ExecutedAt:   operation.GetExecutedAt(),
+			At:           now,
+		}
+	case entities.ExchangeStatusFailed:
+		data := exchangeSnapshot(operation)
+		data["operation_id"] = operation.GetID().String()
+		data["user_id"] = operation.GetUserID().String()
+		if executedAt := operation.GetExecutedAt(); executedAt != nil {
+			data["executed_at"] = executedAt.UTC().Format(time.RFC3339)
+		}
+		if message := operation.GetErrorMessage(); message != "" {
+			data["error_message"] = message
+		}
+		event = events.Named{Name: EventExchangeFailed, Data: data, At: now}
+	default:
+		return
+	}
+
+	if err := uc.notifier.Publish(ctx, event); err != nil {
+		uc.logger.Warn("failed to publish exchange event",
+			slog.String("operation_id", operation.GetID().String()),
+			slog.String("event", event.EventName()),
+			slog.String("error", err.Error()))
+	}
+}
+
+// checkLimits checks the quoted source amount against the wallet owner's limits.
+func (uc *SwapTokens) checkLimits(ctx context.Context, quoted entities.ExchangeOperation) error {
+	if uc.limits == nil {
+		return nil
+	}
+	if err := uc.limits.CheckOutflow(ctx, quoted.GetFromWalletID(), quoted.GetFromAmount(), "exchange"); err != nil {
+		if errors.Is(err, services.ErrLimitExceeded) {
+			return fmt.Errorf("exchange exceeds your limits: %w", err)
+		}
+		return fmt.Errorf("failed to check exchange limits: %w", err)
+	}
+	return nil
+}
+
+func executionResponse(operation entities.ExchangeOperation) *dto.ExecuteExchangeResponse {
+	return &dto.ExecuteExchangeResponse{
+		OperationID:       operation.GetID(),
+		Status:            string(operation.GetStatus()),
+		FromWalletID:      operation.GetFromWalletID(),
+		ToWalletID:        operation.GetToWalletID(),
+		FromAmount:        operation.GetFromAmount(),
+		ToAmount:          operation.GetToAmount(),
+		ExchangeRate:      operation.GetExchangeRate(),
+		FeeAmount:         operation.GetFeeAmount(),
+		ExecutedAt:        operation.GetExecutedAt(),
+		FromTransactionID: operation.GetFromTransactionID(),
+		ToTransactionID:   operation.GetToTransactionID(),
+		ErrorMessage:      operation.GetErrorMessage(),
+	}
+}
+
+func exchangeSnapshot(operation entities.ExchangeOperation) map[string]any {
+	return map[string]any{
+		"status":         string(operation.GetStatus()),
+		"from_wallet_id": operation.GetFromWalletID().String(),
+		"to_wallet_id":   operation.GetToWalletID().String(),
+		"from_amount":    operation.GetFromAmount().String(),
+		"to_amount":      operation.GetToAmount().String(),
+		"exchange_rate":  operation.GetExchangeRate().String(),
+		"fee_amount":     operation.GetFeeAmount().String(),
+	}
+}
+
+// parseQuoteRequest reads the amount a quote is priced from; exactly one of the two is set.
+func parseQuoteRequest(req *dto.QuoteRequest) (fromAmount, toAmount decimal.Decimal, err error) {
+	validation := utils.ValidateStruct(req)
+	if validation.IsEmpty() {
+		rawFrom, rawTo := strings.TrimSpace(req.FromAmount), strings.TrimSpace(req.ToAmount)
+		switch {
+		case rawFrom == "" && rawTo == "":
+			validation.Add("from_amount", "either from_amount or to_amount is required")
+		case rawFrom != "" && rawTo != "":
+			validation.Add("to_amount", "must not be set together with from_amount")
+		case rawFrom != "":
+			if fromAmount, err = decimal.NewFromString(rawFrom); err != nil || !fromAmount.IsPositive() {
+				validation.Add("from_amount", "must be a positive amount")
+			}
+		default:
+			if toAmount, err = decimal.NewFromString(rawTo); err != nil || !toAmount.IsPositive() {
+				validation.Add("to_amount", "must be a positive amount")
+			}
+		}
+	}
+	if !validation.IsEmpty() {
+		return decimal.Zero, decimal.Zero, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"invalid exchange quote request",
+			fiber.StatusBadRequest,
+			validation,
+			map[string]any{"errors": validation},
+		)
+	}
+	return fromAmount, toAmount, nil
+}
+
+// quoteError maps quote failures to client errors. An amount outside the pair's limits reports the
+// limit check the quote would have carried.
+func quoteError(err error) error {
+	var amountErr *services.SwapAmountError
+	switch {
+	case errors.As(err, &amountErr):
+		return utils.NewAppError(
+			"EXCHANGE_AMOUNT_OUT_OF_RANGE",
+			"amount is outside the swap limits for this trading pair",
+			fiber.StatusUnprocessableEntity,
+			err,
+			map[string]any{
+				"from_amount": amountErr.Amount.String(),
+				"limits":      quoteLimits(amountErr.Amount, amountErr.MinSwapAmount, amountErr.MaxSwapAmount),
+			},
+		)
+	case errors.Is(err, services.ErrExchangeSameWallets):
+		return utils.NewAppError("VALIDATION_ERROR", "cannot exchange between the same wallet", fiber.StatusBadRequest, err, nil)
+	case errors.Is(err, services.ErrExchangeInsufficientBalance):
+		return utils.NewAppError("INSUFFICIENT_FUNDS", "insufficient balance in source wallet", fiber.StatusUnprocessableEntity, err, nil)
+	case errors.Is(err, services.ErrExchangeInvalidTradingPair):
+		return utils.NewAppError("EXCHANGE_PAIR_UNAVAILABLE", "trading pair is not available or inactive", fiber.StatusUnprocessableEntity, err, nil)
+	case errors.Is(err, services.ErrExchangeNoLiquidity):
+		return utils.NewAppError("EXCHANGE_PAIR_UNAVAILABLE", "insufficient liquidity for this trading pair", fiber.StatusUnprocessableEntity, err, nil)
+	default:
+		return fmt.Errorf("failed to calculate quote: %w", err)
+	}
+}
+
+// quoteFee itemises the fee the way pricing settles it: charged on the source amount, with the
+// remainder converted at the quoted rate.
+func quoteFee(operation entities.ExchangeOperation) dto.QuoteFeeBreakdown {
+	fee := dto.QuoteFeeBreakdown{
+		Percentage:      operation.GetFeePercentage(),
+		Amount:          operation.GetFeeAmount(),
+		ConvertedAmount: operation.GetFeeAmount().Mul(operation.GetExchangeRate()),
+		NetFromAmount:   operation.GetFromAmount().Sub(operation.GetFeeAmount()),
+	}
+	if raw, ok := operation.GetPricingMetadata()["undiscounted_fee_percentage"]; ok {
+		if undiscounted, err := decimal.NewFromString(raw); err == nil {
+			fee.UndiscountedPercentage = &undiscounted
+		}
+	}
+	return fee
+}
+
+func quoteLimits(amount, minSwapAmount decimal.Decimal, maxSwapAmount *decimal.Decimal) dto.QuoteLimits {
+	return dto.QuoteLimits{
+		MinSwapAmount: minSwapAmount,
+		MaxSwapAmount: maxSwapAmount,
+		MeetsMinimum:  amount.GreaterThanOrEqual(minSwapAmount),
+		WithinMaximum: maxSwapAmount == nil || amount.LessThanOrEqual(*maxSwapAmount),
+	}
+}
+
+func (uc *SwapTokens) authorizeWallet(ctx context.Context, walletID, userID uuid.UUID, permission entities.WalletPermission) (entities.Wallet, error) {
+	wallet, err := uc.authorizer.AuthorizeWallet(ctx, walletID, userID, permission)
+	if err != nil {
+		if errors.Is(err, services.ErrWalletNotFound) {
+			return nil, errors.New("wallet not found")
+		}
+		if errors.Is(err, services.ErrWalletPermissionDenied) {
+			return nil, fmt.Errorf("you do not have %s permission on this wallet", permission)
+		}
+		return nil, fmt.Errorf("failed to authorize wallet: %w", err)
+	}
+	if wallet.GetStatus() == entities.WalletStatusDormant {
+		return nil, errors.New("wallet is dormant; reactivate it with two-factor authentication first")
+	}
+	return wallet, nil
+}
@@ -0,0 +1,91 @@
+package export
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// GetAccountMappingsUseCase returns the user's accounting account mappings.
+type GetAccountMappingsUseCase struct {
+	mappings repositories.AccountMappingRepository
+	logger   *slog.Logger
+}
+
+// NewGetAccountMappingsUseCase constructs the use case.
+func NewGetAccountMappingsUseCase(mappings repositories.AccountMappingRepository, logger *slog.Logger) *GetAccountMappingsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GetAccountMappingsUseCase{
+		mappings: mappings,
+		logger:   logger,
+	}
+}
+
+// Execute loads the mappings.
+func (uc *GetAccountMappingsUseCase) Execute(ctx context.Context, userID uuid.UUID) (dto.AccountMappingsResponse, error) {
+	mappings, err := uc.mappings.ListByUser(ctx, userID)
+	if err != nil {
+		return dto.AccountMappingsResponse{}, err
+	}
+	return dto.NewAccountMappingsResponse(mappings), nil
+}
+
+// UpdateAccountMappingsInput captures the user and their replacement mappings.
+type UpdateAccountMappingsInput struct {
+	UserID  uuid.UUID
+	Payload dto.UpdateAccountMappingsRequest
+}
+
+// UpdateAccountMappingsUseCase replaces the user's accounting account mappings.
+type UpdateAccountMappingsUseCase struct {
+	mappings repositories.AccountMappingRepository
+	logger   *slog.Logger
+}
+
+// NewUpdateAccountMappingsUseCase constructs the use case.
+func NewUpdateAccountMappingsUseCase(mappings repositories.AccountMappingRepository, logger *slog.Logger) *UpdateAccountMappingsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &UpdateAccountMappingsUseCase{
+		mappings: mappings,
+		logger:   logger,
+	}
+}
+
+// Execute validates and stores the mappings.
+func (uc *UpdateAccountMappingsUseCase) Execute(ctx context.Context, input UpdateAccountMappingsInput) (dto.AccountMappingsResponse, error) {
+	if err := wrapValidationError(input.Payload.Validate()); err != nil {
+		return dto.AccountMappingsResponse{}, err
+	}
+
+	now := time.Now().UTC()
+	mappings := make([]entities.AccountMapping, 0, len(input.Payload.Mappings))
+	for _, item := range input.Payload.Mappings {
+		mapping := entities.AccountMapping{
+			AssetAccount:    strings.TrimSpace(item.AssetAccount),
+			ClearingAccount: strings.TrimSpace(item.ClearingAccount),
+			FeeAccount:      strings.TrimSpace(item.FeeAccount),
+			UpdatedAt:       now,
+		}
+		if chain := strings.TrimSpace(item.Chain); chain != "" {
+			mapping.Chain = entities.NormalizeChain(chain)
+		}
+		mappings = append(mappings, mapping)
+	}
+
+	if err := uc.mappings.ReplaceForUser(ctx, input.UserID, mappings); err != nil {
+		return dto.AccountMappingsResponse{}, err
+	}
+
+	return dto.NewAccountMappingsResponse(mappings), nil
+}
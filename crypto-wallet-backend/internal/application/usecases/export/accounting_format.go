@@ -0,0 +1,196 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// xeroTaxRate is the tax rate applied to every journal line; crypto movements are not taxable supplies.
+const xeroTaxRate = "Tax Exempt"
+
+// journalLine is one side of a journal entry; exactly one of Debit and Credit is non-zero.
+type journalLine struct {
+	Account string
+	Debit   decimal.Decimal
+	Credit  decimal.Decimal
+}
+
+// journalEntry is a balanced double-entry record derived from one wallet transaction.
+// Amounts are in the chain's native units, so each asset account tracks a single currency.
+type journalEntry struct {
+	Number      int
+	Date        time.Time
+	Currency    string
+	Reference   string
+	Description string
+	Lines       []journalLine
+}
+
+// buildJournalEntries turns confirmed transactions into balanced journal entries, oldest first.
+// Outgoing transfers move value from the asset account to the clearing account and book network
+// fees as an expense; incoming transfers move value from the clearing account into the asset account.
+func buildJournalEntries(transactions []entities.Transaction, mappings []entities.AccountMapping) []journalEntry {
+	ordered := make([]entities.Transaction, 0, len(transactions))
+	for _, tx := range transactions {
+		if tx.GetStatus() == entities.TransactionStatusConfirmed {
+			ordered = append(ordered, tx)
+		}
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].GetCreatedAt().Before(ordered[j].GetCreatedAt())
+	})
+
+	entries := make([]journalEntry, 0, len(ordered))
+	for _, tx := range ordered {
+		mapping := entities.ResolveAccountMapping(mappings, tx.GetChain())
+		amount := tx.GetAmount()
+		fee := tx.GetFee()
+		if !amount.IsPositive() {
+			continue
+		}
+
+		entry := journalEntry{
+			Number:    len(entries) + 1,
+			Date:      tx.GetCreatedAt().UTC(),
+			Currency:  string(tx.GetChain()),
+			Reference: tx.GetHash(),
+		}
+		if entry.Reference == "" {
+			entry.Reference = tx.GetID().String()
+		}
+
+		switch tx.GetType() {
+		case entities.TransactionTypeSend, entities.TransactionTypeSwapOut, entities.TransactionTypeP2PSend:
+			entry.Description = describeTransaction(tx, "to", tx.GetToAddress())
+			entry.Lines = append(entry.Lines,
+				journalLine{Account: mapping.ClearingAccount, Debit: amount},
+				journalLine{Account: mapping.AssetAccount, Credit: amount},
+			)
+			if fee.IsPositive() {
+				entry.Lines = append(entry.Lines,
+					journalLine{Account: mapping.FeeAccount, Debit: fee},
+					journalLine{Account: mapping.AssetAccount, Credit: fee},
+				)
+			}
+		case entities.TransactionTypeReceive, entities.TransactionTypeSwapIn, entities.TransactionTypeP2PReceive:
+			entry.Description = describeTransaction(tx, "from", tx.GetFromAddress())
+			entry.Lines = append(entry.Lines,
+				journalLine{Account: mapping.AssetAccount, Debit: amount},
+				journalLine{Account: mapping.ClearingAccount, Credit: amount},
+			)
+		default:
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func describeTransaction(tx entities.Transaction, direction, counterparty string) string {
+	description := fmt.Sprintf("%s %s %s", tx.GetType(), tx.GetAmount().String(), tx.GetChain())
+	if counterparty != "" {
+		description += " " + direction + " " + counterparty
+	}
+	return description
+}
+
+// writeAccountingCSV renders journal entries in the import layout of the requested accounting package.
+func writeAccountingCSV(format entities.AccountingFormat, entries []journalEntry) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	var err error
+	switch format {
+	case entities.AccountingFormatQuickBooks:
+		err = writeQuickBooksJournal(writer, entries)
+	case entities.AccountingFormatXero:
+		err = writeXeroJournal(writer, entries)
+	default:
+		err = fmt.Errorf("unsupported accounting format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, fmt.Errorf("failed to flush CSV writer: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// writeQuickBooksJournal follows the QuickBooks Online journal entry import: one row per line,
+// grouped by journal number, with separate debit and credit columns.
+func writeQuickBooksJournal(writer *csv.Writer, entries []journalEntry) error {
+	header := []string{"JournalNo", "JournalDate", "Currency", "AccountName", "Debits", "Credits", "Description", "Memo"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, entry := range entries {
+		for _, line := range entry.Lines {
+			record := []string{
+				strconv.Itoa(entry.Number),
+				entry.Date.Format("01/02/2006"),
+				entry.Currency,
+				line.Account,
+				amountOrBlank(line.Debit),
+				amountOrBlank(line.Credit),
+				entry.Description,
+				entry.Reference,
+			}
+			if err := writer.Write(record); err != nil {
+				return fmt.Errorf("failed to write CSV record: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// writeXeroJournal follows the Xero manual journal import: lines sharing a narration and date form
+// one journal, with debits as positive and credits as negative amounts.
+func writeXeroJournal(writer *csv.Writer, entries []journalEntry) error {
+	header := []string{"*Narration", "*Date", "Description", "*AccountCode", "*TaxRate", "*Amount"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, entry := range entries {
+		narration := fmt.Sprintf("#%d %s %s", entry.Number, entry.Currency, entry.Reference)
+		for _, line := range entry.Lines {
+			amount := line.Debit
+			if line.Credit.IsPositive() {
+				amount = line.Credit.Neg()
+			}
+			record := []string{
+				narration,
+				entry.Date.Format("02/01/2006"),
+				entry.Description,
+				line.Account,
+				xeroTaxRate,
+				amount.String(),
+			}
+			if err := writer.Write(record); err != nil {
+				return fmt.Errorf("failed to write CSV record: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func amountOrBlank(value decimal.Decimal) string {
+	if value.IsZero() {
+		return ""
+	}
+	return value.String()
+}
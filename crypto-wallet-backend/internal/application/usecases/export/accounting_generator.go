@@ -0,0 +1,178 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// Parameter keys stored on accounting export jobs.
+const (
+	paramWalletID  = "wallet_id"
+	paramChain     = "chain"
+	paramStartDate = "start_date"
+	paramEndDate   = "end_date"
+)
+
+const (
+	accountingPageSize = 500
+	// maxAccountingTransactions keeps a single export within what accounting imports accept.
+	maxAccountingTransactions = 50000
+)
+
+var errAccountingTooLarge = fmt.Errorf("export exceeds %d transactions; narrow the date range", maxAccountingTransactions)
+
+// AccountingGenerator produces double-entry journal CSVs from a user's wallet transactions.
+type AccountingGenerator struct {
+	wallets      WalletRepo
+	transactions TransactionRepo
+	mappings     repositories.AccountMappingRepository
+	logger       *slog.Logger
+}
+
+// NewAccountingGenerator constructs the generator.
+func NewAccountingGenerator(wallets WalletRepo, transactions TransactionRepo, mappings repositories.AccountMappingRepository, logger *slog.Logger) *AccountingGenerator {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &AccountingGenerator{
+		wallets:      wallets,
+		transactions: transactions,
+		mappings:     mappings,
+		logger:       logger,
+	}
+}
+
+// Generate builds the journal for the job's wallets and period using the user's account mappings.
+// Journal entries pair legs across the whole period, so the file is assembled before it is written.
+func (g *AccountingGenerator) Generate(ctx context.Context, job entities.ExportJob, w io.Writer) (File, error) {
+	format := entities.AccountingFormat(job.GetFormat())
+	if !entities.IsValidAccountingFormat(format) {
+		return File{}, fmt.Errorf("unsupported accounting format %q", job.GetFormat())
+	}
+
+	params := job.GetParameters()
+	filter, err := accountingFilter(params)
+	if err != nil {
+		return File{}, err
+	}
+
+	walletIDs, err := ownedWalletIDs(ctx, g.wallets, job.GetUserID(), params)
+	if err != nil {
+		return File{}, err
+	}
+
+	mappings, err := g.mappings.ListByUser(ctx, job.GetUserID())
+	if err != nil {
+		return File{}, err
+	}
+
+	transactions := make([]entities.Transaction, 0)
+	for _, walletID := range walletIDs {
+		walletID := walletID
+		filter.WalletID = &walletID
+		for offset := 0; ; offset += accountingPageSize {
+			page, _, err := g.transactions.ListWithFilters(ctx, filter, repositories.ListOptions{
+				Limit:     accountingPageSize,
+				Offset:    offset,
+				SortBy:    "created_at",
+				SortOrder: repositories.SortAscending,
+			})
+			if err != nil {
+				return File{}, err
+			}
+			transactions = append(transactions, page...)
+			if len(transactions) > maxAccountingTransactions {
+				return File{}, errAccountingTooLarge
+			}
+			if len(page) < accountingPageSize {
+				break
+			}
+		}
+	}
+
+	entries := buildJournalEntries(transactions, mappings)
+	content, err := writeAccountingCSV(format, entries)
+	if err != nil {
+		return File{}, err
+	}
+	if _, err := w.Write(content); err != nil {
+		return File{}, err
+	}
+
+	return File{
+		Filename:    fmt.Sprintf("%s_journal_%s.csv", format, job.GetCreatedAt().UTC().Format("20060102_150405")),
+		ContentType: "text/csv",
+		RowCount:    len(entries),
+	}, nil
+}
+
+// ownedWalletIDs resolves the wallets covered by an export, re-checking ownership at generation time.
+func ownedWalletIDs(ctx context.Context, wallets WalletRepo, userID uuid.UUID, params map[string]string) ([]uuid.UUID, error) {
+	if raw := params[paramWalletID]; raw != "" {
+		walletID, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid wallet id %q", raw)
+		}
+		wallet, err := wallets.GetByID(ctx, walletID)
+		if err != nil {
+			return nil, err
+		}
+		if wallet.GetUserID() != userID {
+			return nil, errors.New("wallet does not belong to the export owner")
+		}
+		return []uuid.UUID{walletID}, nil
+	}
+
+	walletFilter := repositories.WalletFilter{}
+	if chain := params[paramChain]; chain != "" {
+		normalized := entities.NormalizeChain(chain)
+		walletFilter.Chain = &normalized
+	}
+
+	owned, err := wallets.ListByUser(ctx, userID, walletFilter, repositories.ListOptions{Limit: 100})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(owned))
+	for _, wallet := range owned {
+		ids = append(ids, wallet.GetID())
+	}
+	return ids, nil
+}
+
+func accountingFilter(params map[string]string) (repositories.TransactionFilter, error) {
+	status := entities.TransactionStatusConfirmed
+	filter := repositories.TransactionFilter{Status: &status}
+
+	if chain := strings.TrimSpace(params[paramChain]); chain != "" {
+		normalized := entities.NormalizeChain(chain)
+		filter.Chain = &normalized
+	}
+	if raw := params[paramStartDate]; raw != "" {
+		start, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return filter, fmt.Errorf("invalid start date %q", raw)
+		}
+		filter.StartDate = &start
+	}
+	if raw := params[paramEndDate]; raw != "" {
+		end, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return filter, fmt.Errorf("invalid end date %q", raw)
+		}
+		filter.EndDate = &end
+	}
+
+	return filter, nil
+}
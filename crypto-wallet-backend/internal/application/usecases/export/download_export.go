@@ -0,0 +1,116 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// DownloadExportUseCase returns the generated file of a completed export.
+type DownloadExportUseCase struct {
+	jobs   repositories.ExportJobRepository
+	store  FileStore
+	urlTTL time.Duration
+	logger *slog.Logger
+}
+
+// NewDownloadExportUseCase constructs the use case. store may be nil when exports are kept in the
+// database; urlTTL bounds presigned download links and defaults to DefaultDownloadURLTTL.
+func NewDownloadExportUseCase(jobs repositories.ExportJobRepository, store FileStore, urlTTL time.Duration, logger *slog.Logger) *DownloadExportUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if urlTTL <= 0 {
+		urlTTL = DefaultDownloadURLTTL
+	}
+	return &DownloadExportUseCase{
+		jobs:   jobs,
+		store:  store,
+		urlTTL: urlTTL,
+		logger: logger,
+	}
+}
+
+// Execute loads the file, rejecting exports that are still running or whose download window has
+// passed. Files in object storage are returned as a presigned DownloadURL instead of their content.
+func (uc *DownloadExportUseCase) Execute(ctx context.Context, userID, jobID uuid.UUID) (File, error) {
+	job, err := loadOwnedJob(ctx, uc.jobs, userID, jobID)
+	if err != nil {
+		return File{}, err
+	}
+
+	entity, ok := job.(*entities.ExportJobEntity)
+	if !ok {
+		return File{}, jobNotFoundError()
+	}
+
+	if entity.GetStatus() != entities.ExportJobStatusCompleted {
+		return File{}, utils.NewAppError(
+			"EXPORT_NOT_READY",
+			"export has not completed",
+			fiber.StatusConflict,
+			nil,
+			map[string]any{"status": string(entity.GetStatus())},
+		)
+	}
+
+	now := time.Now().UTC()
+	if !entity.IsDownloadable(now) {
+		return File{}, exportExpiredError()
+	}
+
+	if entity.GetStorageKey() != "" {
+		if uc.store == nil {
+			return File{}, utils.NewAppError(
+				"EXPORT_STORAGE_UNAVAILABLE",
+				"export storage is not configured",
+				fiber.StatusServiceUnavailable,
+				nil,
+				nil,
+			)
+		}
+		url, _, _, err := presignDownload(uc.store, entity, uc.urlTTL, now)
+		if err != nil {
+			return File{}, err
+		}
+		return File{
+			Filename:    entity.GetFilename(),
+			ContentType: entity.GetContentType(),
+			DownloadURL: url,
+			RowCount:    entity.GetRowCount(),
+		}, nil
+	}
+
+	content, err := uc.jobs.GetContent(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return File{}, exportExpiredError()
+		}
+		return File{}, err
+	}
+
+	return File{
+		Filename:    entity.GetFilename(),
+		ContentType: entity.GetContentType(),
+		Content:     content,
+		RowCount:    entity.GetRowCount(),
+	}, nil
+}
+
+func exportExpiredError() error {
+	return utils.NewAppError(
+		"EXPORT_EXPIRED",
+		"export is no longer available; request a new one",
+		fiber.StatusGone,
+		nil,
+		nil,
+	)
+}
@@ -0,0 +1,65 @@
+package export
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// GetExportUseCase reports the status of one of the user's exports.
+type GetExportUseCase struct {
+	jobs   repositories.ExportJobRepository
+	store  FileStore
+	urlTTL time.Duration
+	logger *slog.Logger
+}
+
+// NewGetExportUseCase constructs the use case. store may be nil when exports are kept in the
+// database; urlTTL bounds presigned download links and defaults to DefaultDownloadURLTTL.
+func NewGetExportUseCase(jobs repositories.ExportJobRepository, store FileStore, urlTTL time.Duration, logger *slog.Logger) *GetExportUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if urlTTL <= 0 {
+		urlTTL = DefaultDownloadURLTTL
+	}
+	return &GetExportUseCase{
+		jobs:   jobs,
+		store:  store,
+		urlTTL: urlTTL,
+		logger: logger,
+	}
+}
+
+// Execute loads the job. A completed export in object storage gets a presigned download link in
+// place of the API download route.
+func (uc *GetExportUseCase) Execute(ctx context.Context, userID, jobID uuid.UUID) (dto.ExportJobResponse, error) {
+	job, err := loadOwnedJob(ctx, uc.jobs, userID, jobID)
+	if err != nil {
+		return dto.ExportJobResponse{}, err
+	}
+
+	now := time.Now().UTC()
+	response := dto.NewExportJobResponse(job, now)
+	if response.DownloadURL == "" {
+		return response, nil
+	}
+
+	url, expiresAt, ok, err := presignDownload(uc.store, job, uc.urlTTL, now)
+	if err != nil {
+		uc.logger.Warn("failed to presign export download",
+			slog.String("job_id", jobID.String()),
+			slog.String("error", err.Error()))
+		return response, nil
+	}
+	if ok {
+		response.DownloadURL = url
+		response.DownloadURLExpiresAt = &expiresAt
+	}
+	return response, nil
+}
@@ -0,0 +1,65 @@
+package export
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// ListExportsInput captures paging for the user's export history.
+type ListExportsInput struct {
+	UserID uuid.UUID
+	Limit  int
+	Offset int
+}
+
+// ListExportsUseCase returns the user's exports, newest first.
+type ListExportsUseCase struct {
+	jobs   repositories.ExportJobRepository
+	logger *slog.Logger
+}
+
+// NewListExportsUseCase constructs the use case.
+func NewListExportsUseCase(jobs repositories.ExportJobRepository, logger *slog.Logger) *ListExportsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ListExportsUseCase{
+		jobs:   jobs,
+		logger: logger,
+	}
+}
+
+// exportListCaps bounds export job listing; users rarely have more than a few pages of jobs.
+var exportListCaps = repositories.PaginationCaps{MaxLimit: 100, MaxOffset: 1000}
+
+// Execute lists the jobs.
+func (uc *ListExportsUseCase) Execute(ctx context.Context, input ListExportsInput) (dto.ExportJobList, error) {
+	opts := repositories.ListOptions{Limit: input.Limit, Offset: input.Offset, Caps: exportListCaps}
+	if err := opts.CheckCaps(); err != nil {
+		return dto.ExportJobList{}, err
+	}
+	opts = opts.WithDefaults()
+
+	jobs, err := uc.jobs.ListByUser(ctx, input.UserID, opts)
+	if err != nil {
+		return dto.ExportJobList{}, err
+	}
+
+	now := time.Now().UTC()
+	items := make([]dto.ExportJobResponse, 0, len(jobs))
+	for _, job := range jobs {
+		items = append(items, dto.NewExportJobResponse(job, now))
+	}
+
+	return dto.ExportJobList{
+		Jobs:   items,
+		Limit:  opts.Limit,
+		Offset: opts.Offset,
+	}, nil
+}
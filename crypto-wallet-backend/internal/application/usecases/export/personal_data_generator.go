@@ -0,0 +1,221 @@
+package export
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// PersonalDataFormatZip is the only format personal data archives are produced in.
+const PersonalDataFormatZip = "zip"
+
+const personalDataWalletPageSize = 100
+
+// UserLookup loads the account a personal data archive is assembled for.
+type UserLookup interface {
+	GetByID(ctx context.Context, id uuid.UUID) (entities.User, error)
+}
+
+// KYCProfileLookup loads a user's verification profile. Only status metadata is exported; the
+// encrypted identity fields never leave the KYC database.
+type KYCProfileLookup interface {
+	GetProfileByUserID(ctx context.Context, userID uuid.UUID) (entities.KYCProfile, error)
+}
+
+// personalDataKYC is the KYC section of the archive.
+type personalDataKYC struct {
+	VerificationLevel string     `json:"verification_level"`
+	Status            string     `json:"status"`
+	SubmittedAt       *time.Time `json:"submitted_at,omitempty"`
+	ApprovedAt        *time.Time `json:"approved_at,omitempty"`
+	ReviewedAt        *time.Time `json:"reviewed_at,omitempty"`
+	ExpiresAt         *time.Time `json:"expires_at,omitempty"`
+	RejectionReason   string     `json:"rejection_reason,omitempty"`
+}
+
+// PersonalDataGenerator assembles a zip archive of everything held about a user: their profile,
+// wallets without key material, full transaction history and KYC status.
+type PersonalDataGenerator struct {
+	users        UserLookup
+	wallets      WalletRepo
+	transactions TransactionRepo
+	kyc          KYCProfileLookup
+	logger       *slog.Logger
+}
+
+// NewPersonalDataGenerator constructs the generator. kyc may be nil when KYC is not configured.
+func NewPersonalDataGenerator(users UserLookup, wallets WalletRepo, transactions TransactionRepo, kyc KYCProfileLookup, logger *slog.Logger) *PersonalDataGenerator {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &PersonalDataGenerator{
+		users:        users,
+		wallets:      wallets,
+		transactions: transactions,
+		kyc:          kyc,
+		logger:       logger,
+	}
+}
+
+// Generate writes the archive to w. Unlike other exports it has no time budget cut-off: a partial
+// archive would not answer a data access request, so the job fails and is retried instead.
+func (g *PersonalDataGenerator) Generate(ctx context.Context, job entities.ExportJob, w io.Writer) (File, error) {
+	if job.GetFormat() != PersonalDataFormatZip {
+		return File{}, fmt.Errorf("unsupported personal data format %q", job.GetFormat())
+	}
+
+	userID := job.GetUserID()
+	user, err := g.users.GetByID(ctx, userID)
+	if err != nil {
+		return File{}, err
+	}
+
+	wallets, err := g.listWallets(ctx, userID)
+	if err != nil {
+		return File{}, err
+	}
+
+	archive := zip.NewWriter(w)
+
+	if err := writeArchiveJSON(archive, "profile.json", dto.NewAuthUser(user)); err != nil {
+		return File{}, err
+	}
+
+	walletRecords := make([]dto.Wallet, 0, len(wallets))
+	walletIDs := make([]uuid.UUID, 0, len(wallets))
+	for _, wallet := range wallets {
+		walletRecords = append(walletRecords, dto.Wallet{
+			ID:               wallet.GetID(),
+			Chain:            string(wallet.GetChain()),
+			Address:          wallet.GetAddress(),
+			Label:            wallet.GetLabel(),
+			Balance:          wallet.GetBalance().String(),
+			Status:           string(wallet.GetStatus()),
+			CreatedAt:        wallet.GetCreatedAt(),
+			UpdatedAt:        wallet.GetUpdatedAt(),
+			BalanceUpdatedAt: wallet.GetBalanceUpdatedAt(),
+		})
+		walletIDs = append(walletIDs, wallet.GetID())
+	}
+	if err := writeArchiveJSON(archive, "wallets.json", walletRecords); err != nil {
+		return File{}, err
+	}
+
+	count, err := g.writeTransactions(ctx, archive, walletIDs)
+	if err != nil {
+		return File{}, err
+	}
+
+	if g.kyc != nil {
+		section, err := g.kycSection(ctx, userID)
+		if err != nil {
+			return File{}, err
+		}
+		if err := writeArchiveJSON(archive, "kyc.json", section); err != nil {
+			return File{}, err
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return File{}, err
+	}
+
+	return File{
+		Filename:    fmt.Sprintf("personal_data_%s.zip", job.GetCreatedAt().UTC().Format("20060102_150405")),
+		ContentType: "application/zip",
+		RowCount:    count,
+	}, nil
+}
+
+func (g *PersonalDataGenerator) listWallets(ctx context.Context, userID uuid.UUID) ([]entities.Wallet, error) {
+	wallets := make([]entities.Wallet, 0)
+	for offset := 0; ; offset += personalDataWalletPageSize {
+		page, err := g.wallets.ListByUser(ctx, userID, repositories.WalletFilter{}, repositories.ListOptions{
+			Limit:  personalDataWalletPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		wallets = append(wallets, page...)
+		if len(page) < personalDataWalletPageSize {
+			return wallets, nil
+		}
+	}
+}
+
+func (g *PersonalDataGenerator) writeTransactions(ctx context.Context, archive *zip.Writer, walletIDs []uuid.UUID) (int, error) {
+	entry, err := archive.Create("transactions.json")
+	if err != nil {
+		return 0, err
+	}
+	rows, _, err := newTransactionRowWriter(TransactionExportFormatJSON, entry, time.Now().UTC())
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	if len(walletIDs) > 0 {
+		filter := repositories.TransactionFilter{WalletIDs: walletIDs}
+		var after *repositories.PageCursor
+		for {
+			page, err := g.transactions.ListChronological(ctx, filter, after, transactionExportPageSize)
+			if err != nil {
+				return 0, err
+			}
+			for _, tx := range page {
+				if err := rows.WriteRow(tx); err != nil {
+					return 0, err
+				}
+			}
+			count += len(page)
+			if len(page) < transactionExportPageSize {
+				break
+			}
+			last := page[len(page)-1]
+			after = &repositories.PageCursor{CreatedAt: last.GetCreatedAt(), ID: last.GetID()}
+		}
+	}
+
+	return count, rows.Close()
+}
+
+func (g *PersonalDataGenerator) kycSection(ctx context.Context, userID uuid.UUID) (*personalDataKYC, error) {
+	profile, err := g.kyc.GetProfileByUserID(ctx, userID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &personalDataKYC{
+		VerificationLevel: string(profile.GetVerificationLevel()),
+		Status:            string(profile.GetStatus()),
+		SubmittedAt:       profile.GetSubmittedAt(),
+		ApprovedAt:        profile.GetApprovedAt(),
+		ReviewedAt:        profile.GetReviewedAt(),
+		ExpiresAt:         profile.GetExpiresAt(),
+		RejectionReason:   profile.GetRejectionReason(),
+	}, nil
+}
+
+func writeArchiveJSON(archive *zip.Writer, name string, value any) error {
+	entry, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(entry)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
@@ -0,0 +1,190 @@
+package export
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/budget"
+)
+
+const (
+	processExportsBatchSize = 10
+	// staleProcessingAfter is how long a job may stay processing before another worker reclaims it.
+	staleProcessingAfter = 15 * time.Minute
+	// maxDatabaseExportSize bounds files kept in the database when no object store is configured.
+	maxDatabaseExportSize = 64 << 20
+)
+
+// ProcessExportsUseCase generates queued exports using the generator registered for each job kind.
+// Generators write to a temporary spool file, which is then uploaded to the file store when one is
+// configured and otherwise stored alongside the job. Generation runs under the execution budget
+// named "export.<kind>"; uploading the finished file does not count against it.
+type ProcessExportsUseCase struct {
+	jobs          repositories.ExportJobRepository
+	generators    map[entities.ExportJobKind]Generator
+	store         FileStore
+	storagePrefix string
+	retention     time.Duration
+	budgets       *budget.Budgets
+	logger        *slog.Logger
+}
+
+// NewProcessExportsUseCase constructs the use case. store may be nil; files are then kept in the
+// database. storagePrefix is prepended to object keys. budgets may be nil, which leaves generation
+// unbounded.
+func NewProcessExportsUseCase(jobs repositories.ExportJobRepository, generators map[entities.ExportJobKind]Generator, store FileStore, storagePrefix string, retention time.Duration, budgets *budget.Budgets, logger *slog.Logger) *ProcessExportsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if retention <= 0 {
+		retention = entities.DefaultExportRetention
+	}
+	return &ProcessExportsUseCase{
+		jobs:          jobs,
+		generators:    generators,
+		store:         store,
+		storagePrefix: storagePrefix,
+		retention:     retention,
+		budgets:       budgets,
+		logger:        logger,
+	}
+}
+
+// Execute processes one batch of jobs, purges expired files and reports how many jobs were processed.
+func (uc *ProcessExportsUseCase) Execute(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	if purged, err := uc.jobs.PurgeExpired(ctx, now); err != nil {
+		uc.logger.Warn("failed to purge expired exports", slog.String("error", err.Error()))
+	} else if purged > 0 {
+		uc.logger.Info("purged expired exports", slog.Int64("count", purged))
+	}
+
+	processed := 0
+	for processed < processExportsBatchSize {
+		claimedAt := time.Now().UTC()
+		claimed, err := uc.jobs.ClaimNext(ctx, claimedAt, claimedAt.Add(-staleProcessingAfter))
+		if err != nil {
+			if errors.Is(err, repositories.ErrNotFound) {
+				break
+			}
+			return processed, err
+		}
+
+		job, ok := claimed.(*entities.ExportJobEntity)
+		if !ok {
+			continue
+		}
+
+		uc.process(ctx, job)
+		processed++
+	}
+
+	return processed, nil
+}
+
+func (uc *ProcessExportsUseCase) process(ctx context.Context, job *entities.ExportJobEntity) {
+	logger := uc.logger.With(
+		slog.String("job_id", job.GetID().String()),
+		slog.String("kind", string(job.GetKind())),
+		slog.String("format", job.GetFormat()))
+
+	stored, err := uc.generate(ctx, job)
+	at := time.Now().UTC()
+	if err != nil {
+		logger.Error("export generation failed", slog.String("error", err.Error()))
+		if markErr := job.MarkFailed(err.Error(), at); markErr != nil {
+			return
+		}
+		if err := uc.jobs.Fail(ctx, job); err != nil {
+			logger.Error("failed to record export failure", slog.String("error", err.Error()))
+		}
+		return
+	}
+
+	if err := job.MarkCompleted(stored.file.Filename, stored.file.ContentType, stored.storageKey, stored.size, stored.file.RowCount, stored.file.Truncated, at, uc.retention); err != nil {
+		return
+	}
+	if err := uc.jobs.Complete(ctx, job, stored.file.Content); err != nil {
+		logger.Error("failed to store export", slog.String("error", err.Error()))
+		return
+	}
+
+	logger.Info("export completed",
+		slog.Int("rows", stored.file.RowCount),
+		slog.Int64("size", stored.size),
+		slog.Bool("truncated", stored.file.Truncated),
+		slog.Bool("object_storage", stored.storageKey != ""))
+}
+
+// storedFile is a generated file after it has been placed in its final location.
+type storedFile struct {
+	file       File
+	storageKey string
+	size       int64
+}
+
+func (uc *ProcessExportsUseCase) generate(ctx context.Context, job entities.ExportJob) (storedFile, error) {
+	generator, ok := uc.generators[job.GetKind()]
+	if !ok || generator == nil {
+		return storedFile{}, fmt.Errorf("no generator registered for export kind %q", job.GetKind())
+	}
+
+	spool, err := os.CreateTemp("", "export-*")
+	if err != nil {
+		return storedFile{}, fmt.Errorf("create export spool file: %w", err)
+	}
+	defer func() {
+		spool.Close()
+		os.Remove(spool.Name())
+	}()
+
+	name := "export." + string(job.GetKind())
+	genCtx, run := uc.budgets.Start(ctx, name)
+	buffered := bufio.NewWriterSize(spool, 64<<10)
+	file, err := generator.Generate(genCtx, job, buffered)
+	exhausted := run.Exhausted()
+	run.End()
+	if err != nil {
+		if exhausted {
+			return storedFile{}, fmt.Errorf("export exceeded its %s time budget; narrow the filters", uc.budgets.Limit(name))
+		}
+		return storedFile{}, err
+	}
+	if err := buffered.Flush(); err != nil {
+		return storedFile{}, fmt.Errorf("write export spool file: %w", err)
+	}
+
+	size, err := spool.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return storedFile{}, fmt.Errorf("measure export spool file: %w", err)
+	}
+	if _, err := spool.Seek(0, io.SeekStart); err != nil {
+		return storedFile{}, fmt.Errorf("rewind export spool file: %w", err)
+	}
+
+	if uc.store != nil {
+		key := path.Join(uc.storagePrefix, job.GetUserID().String(), job.GetID().String(), file.Filename)
+		if err := uc.store.Upload(ctx, key, file.ContentType, spool, size); err != nil {
+			return storedFile{}, fmt.Errorf("upload export: %w", err)
+		}
+		return storedFile{file: file, storageKey: key, size: size}, nil
+	}
+
+	if size > maxDatabaseExportSize {
+		return storedFile{}, fmt.Errorf("export is %d bytes, above the %d byte limit without object storage; narrow the filters", size, maxDatabaseExportSize)
+	}
+	file.Content, err = io.ReadAll(spool)
+	if err != nil {
+		return storedFile{}, fmt.Errorf("read export spool file: %w", err)
+	}
+	return storedFile{file: file, size: size}, nil
+}
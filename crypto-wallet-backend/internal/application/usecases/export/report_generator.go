@@ -0,0 +1,135 @@
+package export
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// Parameter keys stored on report export jobs.
+const (
+	ParamReportDefinition = "definition"
+	ParamReportName       = "report_name"
+)
+
+// Formats accepted for report exports.
+const (
+	ReportExportFormatCSV  = "csv"
+	ReportExportFormatJSON = "json"
+)
+
+// ReportRunner executes custom report definitions.
+type ReportRunner interface {
+	Run(ctx context.Context, userID uuid.UUID, definition entities.ReportDefinition) (entities.ReportResult, error)
+}
+
+// ReportGenerator runs custom analytics reports that were too heavy to answer inline and writes
+// the result as CSV or JSON.
+type ReportGenerator struct {
+	reports ReportRunner
+	logger  *slog.Logger
+}
+
+// NewReportGenerator constructs the generator.
+func NewReportGenerator(reports ReportRunner, logger *slog.Logger) *ReportGenerator {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ReportGenerator{
+		reports: reports,
+		logger:  logger,
+	}
+}
+
+// Generate runs the job's report definition and writes its rows to w.
+func (g *ReportGenerator) Generate(ctx context.Context, job entities.ExportJob, w io.Writer) (File, error) {
+	params := job.GetParameters()
+
+	var definition entities.ReportDefinition
+	if err := json.Unmarshal([]byte(params[ParamReportDefinition]), &definition); err != nil {
+		return File{}, fmt.Errorf("decode report definition: %w", err)
+	}
+	definition = definition.Normalized()
+	if err := definition.Validate(); err != nil {
+		return File{}, err
+	}
+
+	result, err := g.reports.Run(ctx, job.GetUserID(), definition)
+	if err != nil {
+		return File{}, err
+	}
+
+	var contentType string
+	switch job.GetFormat() {
+	case ReportExportFormatCSV:
+		contentType = "text/csv"
+		err = writeReportCSV(w, result)
+	case ReportExportFormatJSON:
+		contentType = "application/json"
+		err = json.NewEncoder(w).Encode(struct {
+			Name        string                    `json:"name,omitempty"`
+			GeneratedAt time.Time                 `json:"generatedAt"`
+			Definition  entities.ReportDefinition `json:"definition"`
+			entities.ReportResult
+		}{
+			Name:         params[ParamReportName],
+			GeneratedAt:  time.Now().UTC(),
+			Definition:   definition,
+			ReportResult: result,
+		})
+	default:
+		return File{}, fmt.Errorf("unsupported report export format %q", job.GetFormat())
+	}
+	if err != nil {
+		return File{}, err
+	}
+
+	return File{
+		Filename:    fmt.Sprintf("%s_%s.%s", reportFilePrefix(params[ParamReportName]), job.GetCreatedAt().UTC().Format("20060102_150405"), job.GetFormat()),
+		ContentType: contentType,
+		RowCount:    len(result.Rows),
+	}, nil
+}
+
+func writeReportCSV(w io.Writer, result entities.ReportResult) error {
+	writer := csv.NewWriter(w)
+	header := make([]string, 0, len(result.Columns))
+	for _, column := range result.Columns {
+		header = append(header, column.Name)
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	return writer.WriteAll(result.Rows)
+}
+
+// reportFilePrefix turns a saved report's name into a filename-safe prefix.
+func reportFilePrefix(name string) string {
+	prefix := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + ('a' - 'A')
+		default:
+			return '_'
+		}
+	}, strings.TrimSpace(name))
+	prefix = strings.Trim(prefix, "_")
+	if prefix == "" {
+		return "report"
+	}
+	if len(prefix) > 50 {
+		prefix = prefix[:50]
+	}
+	return prefix
+}
@@ -0,0 +1,101 @@
+package export
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// RequestAccountingExportInput captures the requester and export parameters.
+type RequestAccountingExportInput struct {
+	UserID  uuid.UUID
+	Payload dto.AccountingExportRequest
+}
+
+// RequestAccountingExportUseCase queues an accounting export for background generation.
+type RequestAccountingExportUseCase struct {
+	jobs    repositories.ExportJobRepository
+	wallets WalletRepo
+	logger  *slog.Logger
+}
+
+// NewRequestAccountingExportUseCase constructs the use case.
+func NewRequestAccountingExportUseCase(jobs repositories.ExportJobRepository, wallets WalletRepo, logger *slog.Logger) *RequestAccountingExportUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RequestAccountingExportUseCase{
+		jobs:    jobs,
+		wallets: wallets,
+		logger:  logger,
+	}
+}
+
+// Execute validates the request and queues the job.
+func (uc *RequestAccountingExportUseCase) Execute(ctx context.Context, input RequestAccountingExportInput) (dto.ExportJobResponse, error) {
+	if err := wrapValidationError(input.Payload.Validate()); err != nil {
+		return dto.ExportJobResponse{}, err
+	}
+
+	params := map[string]string{}
+	if input.Payload.WalletID != "" {
+		walletID, err := requireOwnedWallet(ctx, uc.wallets, input.UserID, input.Payload.WalletID)
+		if err != nil {
+			return dto.ExportJobResponse{}, err
+		}
+		params[paramWalletID] = walletID.String()
+	}
+	if input.Payload.Chain != "" {
+		params[paramChain] = strings.ToUpper(input.Payload.Chain)
+	}
+	if input.Payload.StartDate != "" {
+		params[paramStartDate] = input.Payload.StartDate
+	}
+	if input.Payload.EndDate != "" {
+		params[paramEndDate] = input.Payload.EndDate
+	}
+
+	if err := checkActiveJobLimit(ctx, uc.jobs, input.UserID); err != nil {
+		return dto.ExportJobResponse{}, err
+	}
+
+	now := time.Now().UTC()
+	job, err := entities.NewExportJobEntity(entities.ExportJobParams{
+		UserID:     input.UserID,
+		Kind:       entities.ExportJobKindAccounting,
+		Format:     input.Payload.Format,
+		Parameters: params,
+		CreatedAt:  now,
+	})
+	if err != nil {
+		return dto.ExportJobResponse{}, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"export payload invalid",
+			fiber.StatusBadRequest,
+			err,
+			nil,
+		)
+	}
+
+	if err := uc.jobs.Create(ctx, job); err != nil {
+		uc.logger.Error("failed to queue accounting export",
+			slog.String("user_id", input.UserID.String()),
+			slog.String("error", err.Error()))
+		return dto.ExportJobResponse{}, err
+	}
+
+	uc.logger.Info("queued accounting export",
+		slog.String("job_id", job.GetID().String()),
+		slog.String("format", job.GetFormat()))
+
+	return dto.NewExportJobResponse(job, now), nil
+}
@@ -0,0 +1,61 @@
+package export
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// RequestPersonalDataExportUseCase queues an archive of all data held about the requesting user.
+type RequestPersonalDataExportUseCase struct {
+	jobs   repositories.ExportJobRepository
+	logger *slog.Logger
+}
+
+// NewRequestPersonalDataExportUseCase constructs the use case.
+func NewRequestPersonalDataExportUseCase(jobs repositories.ExportJobRepository, logger *slog.Logger) *RequestPersonalDataExportUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RequestPersonalDataExportUseCase{
+		jobs:   jobs,
+		logger: logger,
+	}
+}
+
+// Execute queues the archive; it is downloaded through the regular export endpoints once ready.
+func (uc *RequestPersonalDataExportUseCase) Execute(ctx context.Context, userID uuid.UUID) (dto.ExportJobResponse, error) {
+	if err := checkActiveJobLimit(ctx, uc.jobs, userID); err != nil {
+		return dto.ExportJobResponse{}, err
+	}
+
+	now := time.Now().UTC()
+	job, err := entities.NewExportJobEntity(entities.ExportJobParams{
+		UserID:    userID,
+		Kind:      entities.ExportJobKindPersonalData,
+		Format:    PersonalDataFormatZip,
+		CreatedAt: now,
+	})
+	if err != nil {
+		return dto.ExportJobResponse{}, err
+	}
+
+	if err := uc.jobs.Create(ctx, job); err != nil {
+		uc.logger.Error("failed to queue personal data export",
+			slog.String("user_id", userID.String()),
+			slog.String("error", err.Error()))
+		return dto.ExportJobResponse{}, err
+	}
+
+	uc.logger.Info("queued personal data export",
+		slog.String("job_id", job.GetID().String()),
+		slog.String("user_id", userID.String()))
+
+	return dto.NewExportJobResponse(job, now), nil
+}
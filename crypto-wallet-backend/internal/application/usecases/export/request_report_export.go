@@ -0,0 +1,98 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// RequestReportExportUseCase queues a custom analytics report for background execution.
+type RequestReportExportUseCase struct {
+	jobs   repositories.ExportJobRepository
+	logger *slog.Logger
+}
+
+// NewRequestReportExportUseCase constructs the use case.
+func NewRequestReportExportUseCase(jobs repositories.ExportJobRepository, logger *slog.Logger) *RequestReportExportUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RequestReportExportUseCase{
+		jobs:   jobs,
+		logger: logger,
+	}
+}
+
+// Execute queues the definition, which the caller has already validated. name labels the file
+// and may be empty; the format defaults to CSV.
+func (uc *RequestReportExportUseCase) Execute(ctx context.Context, userID uuid.UUID, name string, definition entities.ReportDefinition, format string) (dto.ExportJobResponse, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		format = ReportExportFormatCSV
+	}
+	if format != ReportExportFormatCSV && format != ReportExportFormatJSON {
+		return dto.ExportJobResponse{}, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"export payload invalid",
+			fiber.StatusBadRequest,
+			nil,
+			map[string]any{"format": "must be one of: csv, json"},
+		)
+	}
+
+	encoded, err := json.Marshal(definition)
+	if err != nil {
+		return dto.ExportJobResponse{}, fmt.Errorf("encode report definition: %w", err)
+	}
+	params := map[string]string{ParamReportDefinition: string(encoded)}
+	if name = strings.TrimSpace(name); name != "" {
+		params[ParamReportName] = name
+	}
+
+	if err := checkActiveJobLimit(ctx, uc.jobs, userID); err != nil {
+		return dto.ExportJobResponse{}, err
+	}
+
+	now := time.Now().UTC()
+	job, err := entities.NewExportJobEntity(entities.ExportJobParams{
+		UserID:     userID,
+		Kind:       entities.ExportJobKindReport,
+		Format:     format,
+		Parameters: params,
+		CreatedAt:  now,
+	})
+	if err != nil {
+		return dto.ExportJobResponse{}, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"export payload invalid",
+			fiber.StatusBadRequest,
+			err,
+			nil,
+		)
+	}
+
+	if err := uc.jobs.Create(ctx, job); err != nil {
+		uc.logger.Error("failed to queue report export",
+			slog.String("user_id", userID.String()),
+			slog.String("error", err.Error()))
+		return dto.ExportJobResponse{}, err
+	}
+
+	uc.logger.Info("queued report export",
+		slog.String("job_id", job.GetID().String()),
+		slog.String("dataset", string(definition.Dataset)),
+		slog.String("format", job.GetFormat()))
+
+	return dto.NewExportJobResponse(job, now), nil
+}
@@ -0,0 +1,112 @@
+package export
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// RequestTransactionExportInput captures the requester and export filters.
+type RequestTransactionExportInput struct {
+	UserID  uuid.UUID
+	Payload dto.ExportTransactionsRequest
+}
+
+// RequestTransactionExportUseCase queues a transaction history export for background generation.
+type RequestTransactionExportUseCase struct {
+	jobs    repositories.ExportJobRepository
+	wallets WalletRepo
+	logger  *slog.Logger
+}
+
+// NewRequestTransactionExportUseCase constructs the use case.
+func NewRequestTransactionExportUseCase(jobs repositories.ExportJobRepository, wallets WalletRepo, logger *slog.Logger) *RequestTransactionExportUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RequestTransactionExportUseCase{
+		jobs:    jobs,
+		wallets: wallets,
+		logger:  logger,
+	}
+}
+
+// Execute validates the filters and queues the job; the format defaults to CSV.
+func (uc *RequestTransactionExportUseCase) Execute(ctx context.Context, input RequestTransactionExportInput) (dto.ExportJobResponse, error) {
+	if err := wrapValidationError(input.Payload.Validate()); err != nil {
+		return dto.ExportJobResponse{}, err
+	}
+
+	format := strings.ToLower(input.Payload.Format)
+	if format == "" {
+		format = TransactionExportFormatCSV
+	}
+
+	params := map[string]string{}
+	if input.Payload.WalletID != "" {
+		walletID, err := requireOwnedWallet(ctx, uc.wallets, input.UserID, input.Payload.WalletID)
+		if err != nil {
+			return dto.ExportJobResponse{}, err
+		}
+		params[paramWalletID] = walletID.String()
+	}
+	if input.Payload.Chain != "" {
+		params[paramChain] = strings.ToUpper(input.Payload.Chain)
+	}
+	if input.Payload.Type != "" {
+		params[paramType] = strings.ToLower(input.Payload.Type)
+	}
+	if input.Payload.Status != "" {
+		params[paramStatus] = strings.ToLower(input.Payload.Status)
+	}
+	if input.Payload.StartDate != "" {
+		params[paramStartDate] = input.Payload.StartDate
+	}
+	if input.Payload.EndDate != "" {
+		params[paramEndDate] = input.Payload.EndDate
+	}
+
+	if err := checkActiveJobLimit(ctx, uc.jobs, input.UserID); err != nil {
+		return dto.ExportJobResponse{}, err
+	}
+
+	now := time.Now().UTC()
+	job, err := entities.NewExportJobEntity(entities.ExportJobParams{
+		UserID:     input.UserID,
+		Kind:       entities.ExportJobKindTransactions,
+		Format:     format,
+		Parameters: params,
+		CreatedAt:  now,
+	})
+	if err != nil {
+		return dto.ExportJobResponse{}, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"export payload invalid",
+			fiber.StatusBadRequest,
+			err,
+			nil,
+		)
+	}
+
+	if err := uc.jobs.Create(ctx, job); err != nil {
+		uc.logger.Error("failed to queue transaction export",
+			slog.String("user_id", input.UserID.String()),
+			slog.String("error", err.Error()))
+		return dto.ExportJobResponse{}, err
+	}
+
+	uc.logger.Info("queued transaction export",
+		slog.String("job_id", job.GetID().String()),
+		slog.String("format", job.GetFormat()))
+
+	return dto.NewExportJobResponse(job, now), nil
+}
@@ -0,0 +1,151 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// maxActiveJobsPerUser bounds how many exports a user may have queued or processing at once.
+const maxActiveJobsPerUser = 3
+
+// DefaultDownloadURLTTL is how long a presigned object storage download link stays valid.
+const DefaultDownloadURLTTL = 15 * time.Minute
+
+// WalletRepo exposes wallet lookups needed to scope exports to the requesting user.
+type WalletRepo interface {
+	GetByID(ctx context.Context, id uuid.UUID) (entities.Wallet, error)
+	ListByUser(ctx context.Context, userID uuid.UUID, filter repositories.WalletFilter, opts repositories.ListOptions) ([]entities.Wallet, error)
+}
+
+// TransactionRepo exposes the transaction queries exports are generated from.
+type TransactionRepo interface {
+	ListWithFilters(ctx context.Context, filter repositories.TransactionFilter, opts repositories.ListOptions) ([]entities.Transaction, int64, error)
+	ListChronological(ctx context.Context, filter repositories.TransactionFilter, after *repositories.PageCursor, limit int) ([]entities.Transaction, error)
+}
+
+// FileStore keeps generated files in object storage and hands out time-limited download links.
+type FileStore interface {
+	Upload(ctx context.Context, key, contentType string, body io.Reader, size int64) error
+	PresignGet(key, filename string, ttl time.Duration) (string, error)
+}
+
+// File describes a generated export. Content holds the bytes of files kept in the database and
+// DownloadURL links to files kept in object storage.
+type File struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+	DownloadURL string
+	RowCount    int
+	// Truncated is set when the generator stopped early because its time budget ran out.
+	Truncated bool
+}
+
+// Generator writes the file for one kind of export job to w. The returned File carries the
+// file's metadata; its Content is left empty.
+type Generator interface {
+	Generate(ctx context.Context, job entities.ExportJob, w io.Writer) (File, error)
+}
+
+// requireOwnedWallet parses a requested wallet ID and hides wallets belonging to other users.
+func requireOwnedWallet(ctx context.Context, wallets WalletRepo, userID uuid.UUID, raw string) (uuid.UUID, error) {
+	walletID, _ := uuid.Parse(raw)
+	wallet, err := wallets.GetByID(ctx, walletID)
+	if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+		return uuid.Nil, err
+	}
+	if err != nil || wallet.GetUserID() != userID {
+		return uuid.Nil, utils.NewAppError(
+			"WALLET_NOT_FOUND",
+			"wallet not found",
+			fiber.StatusNotFound,
+			nil,
+			nil,
+		)
+	}
+	return walletID, nil
+}
+
+// checkActiveJobLimit rejects a new export while the user already has the maximum in progress.
+func checkActiveJobLimit(ctx context.Context, jobs repositories.ExportJobRepository, userID uuid.UUID) error {
+	active, err := jobs.CountActiveByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if active >= maxActiveJobsPerUser {
+		return utils.NewAppError(
+			"EXPORT_LIMIT_REACHED",
+			"too many exports in progress; wait for one to finish",
+			fiber.StatusTooManyRequests,
+			nil,
+			map[string]any{"limit": maxActiveJobsPerUser},
+		)
+	}
+	return nil
+}
+
+// loadOwnedJob fetches a job and hides jobs belonging to other users.
+func loadOwnedJob(ctx context.Context, jobs repositories.ExportJobRepository, userID, jobID uuid.UUID) (entities.ExportJob, error) {
+	job, err := jobs.GetByID(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, jobNotFoundError()
+		}
+		return nil, err
+	}
+	if job.GetUserID() != userID {
+		return nil, jobNotFoundError()
+	}
+	return job, nil
+}
+
+// presignDownload returns a download link for a job whose file lives in object storage, valid for
+// ttl but never beyond the job's own expiry. ok is false when the file is kept in the database.
+func presignDownload(store FileStore, job entities.ExportJob, ttl time.Duration, now time.Time) (string, time.Time, bool, error) {
+	if store == nil || job.GetStorageKey() == "" {
+		return "", time.Time{}, false, nil
+	}
+	if expiresAt := job.GetExpiresAt(); expiresAt != nil && expiresAt.Sub(now) < ttl {
+		ttl = expiresAt.Sub(now).Truncate(time.Second)
+	}
+	if ttl < time.Second {
+		return "", time.Time{}, false, exportExpiredError()
+	}
+	url, err := store.PresignGet(job.GetStorageKey(), job.GetFilename(), ttl)
+	if err != nil {
+		return "", time.Time{}, false, err
+	}
+	return url, now.Add(ttl), true, nil
+}
+
+func jobNotFoundError() error {
+	return utils.NewAppError(
+		"EXPORT_NOT_FOUND",
+		"export not found",
+		fiber.StatusNotFound,
+		nil,
+		nil,
+	)
+}
+
+func wrapValidationError(errs utils.ValidationErrors) error {
+	if errs.IsEmpty() {
+		return nil
+	}
+	return utils.NewAppError(
+		"VALIDATION_ERROR",
+		"export payload invalid",
+		fiber.StatusBadRequest,
+		errs,
+		map[string]any{"errors": errs},
+	)
+}
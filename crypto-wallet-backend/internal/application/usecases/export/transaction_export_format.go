@@ -0,0 +1,217 @@
+package export
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// Formats accepted for transaction exports.
+const (
+	TransactionExportFormatCSV  = "csv"
+	TransactionExportFormatJSON = "json"
+	TransactionExportFormatXLSX = "xlsx"
+)
+
+var transactionExportHeader = []string{
+	"ID", "Wallet ID", "Chain", "Hash", "Type", "Amount", "Fee",
+	"Status", "Confirmations", "From Address", "To Address",
+	"Block Number", "Error Message", "Created At", "Confirmed At",
+}
+
+// transactionRowWriter streams transactions into one export format. Close finishes the document
+// but does not close the underlying writer.
+type transactionRowWriter interface {
+	WriteRow(tx entities.Transaction) error
+	Close() error
+}
+
+// newTransactionRowWriter starts a document in the given format and returns the writer with the
+// file's content type.
+func newTransactionRowWriter(format string, w io.Writer, exportedAt time.Time) (transactionRowWriter, string, error) {
+	switch format {
+	case TransactionExportFormatCSV:
+		writer, err := newTransactionCSVWriter(w)
+		return writer, "text/csv", err
+	case TransactionExportFormatJSON:
+		writer, err := newTransactionJSONWriter(w, exportedAt)
+		return writer, "application/json", err
+	case TransactionExportFormatXLSX:
+		writer, err := newTransactionXLSXWriter(w)
+		return writer, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", err
+	default:
+		return nil, "", fmt.Errorf("unsupported transaction export format %q", format)
+	}
+}
+
+func transactionExportRecord(tx entities.Transaction) []string {
+	blockNumber := ""
+	if number := tx.GetBlockNumber(); number != nil {
+		blockNumber = strconv.FormatUint(*number, 10)
+	}
+	confirmedAt := ""
+	if at := tx.GetConfirmedAt(); at != nil {
+		confirmedAt = at.UTC().Format(time.RFC3339Nano)
+	}
+	return []string{
+		tx.GetID().String(),
+		tx.GetWalletID().String(),
+		string(tx.GetChain()),
+		tx.GetHash(),
+		string(tx.GetType()),
+		tx.GetAmount().String(),
+		tx.GetFee().String(),
+		string(tx.GetStatus()),
+		strconv.Itoa(tx.GetConfirmations()),
+		tx.GetFromAddress(),
+		tx.GetToAddress(),
+		blockNumber,
+		tx.GetErrorMessage(),
+		tx.GetCreatedAt().UTC().Format(time.RFC3339Nano),
+		confirmedAt,
+	}
+}
+
+type transactionCSVWriter struct {
+	writer *csv.Writer
+}
+
+func newTransactionCSVWriter(w io.Writer) (*transactionCSVWriter, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(transactionExportHeader); err != nil {
+		return nil, err
+	}
+	return &transactionCSVWriter{writer: writer}, nil
+}
+
+func (c *transactionCSVWriter) WriteRow(tx entities.Transaction) error {
+	return c.writer.Write(transactionExportRecord(tx))
+}
+
+func (c *transactionCSVWriter) Close() error {
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+// transactionJSONWriter writes {"exportedAt", "transactions": [...], "totalCount"} one element at a
+// time; the count comes last because it is only known once every row has been written.
+type transactionJSONWriter struct {
+	w     io.Writer
+	count int
+}
+
+func newTransactionJSONWriter(w io.Writer, exportedAt time.Time) (*transactionJSONWriter, error) {
+	if _, err := fmt.Fprintf(w, "{\"exportedAt\":%q,\"transactions\":[", exportedAt.UTC().Format(time.RFC3339Nano)); err != nil {
+		return nil, err
+	}
+	return &transactionJSONWriter{w: w}, nil
+}
+
+func (j *transactionJSONWriter) WriteRow(tx entities.Transaction) error {
+	encoded, err := json.Marshal(dto.NewTransactionStatusResponse(tx))
+	if err != nil {
+		return err
+	}
+	if j.count > 0 {
+		if _, err := io.WriteString(j.w, ","); err != nil {
+			return err
+		}
+	}
+	if _, err := j.w.Write(encoded); err != nil {
+		return err
+	}
+	j.count++
+	return nil
+}
+
+func (j *transactionJSONWriter) Close() error {
+	_, err := fmt.Fprintf(j.w, "],\"totalCount\":%d}\n", j.count)
+	return err
+}
+
+// transactionXLSXWriter writes a single-sheet workbook. The worksheet is the last zip entry, so
+// rows go straight into the archive; cells are inline strings, which keeps full decimal precision
+// for amounts that a spreadsheet's floating-point numbers would round.
+type transactionXLSXWriter struct {
+	archive *zip.Writer
+	sheet   io.Writer
+	row     int
+}
+
+var xlsxStaticParts = []struct {
+	name    string
+	content string
+}{
+	{"[Content_Types].xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/></Types>`},
+	{"_rels/.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`},
+	{"xl/workbook.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Transactions" sheetId="1" r:id="rId1"/></sheets></workbook>`},
+	{"xl/_rels/workbook.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`},
+}
+
+func newTransactionXLSXWriter(w io.Writer) (*transactionXLSXWriter, error) {
+	archive := zip.NewWriter(w)
+	for _, part := range xlsxStaticParts {
+		entry, err := archive.Create(part.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(entry, part.content); err != nil {
+			return nil, err
+		}
+	}
+
+	sheet, err := archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.WriteString(sheet, `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`); err != nil {
+		return nil, err
+	}
+
+	writer := &transactionXLSXWriter{archive: archive, sheet: sheet}
+	if err := writer.writeCells(transactionExportHeader); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+func (x *transactionXLSXWriter) WriteRow(tx entities.Transaction) error {
+	return x.writeCells(transactionExportRecord(tx))
+}
+
+func (x *transactionXLSXWriter) writeCells(values []string) error {
+	x.row++
+	var b strings.Builder
+	fmt.Fprintf(&b, `<row r="%d">`, x.row)
+	for _, value := range values {
+		b.WriteString(`<c t="inlineStr"><is><t xml:space="preserve">`)
+		if err := xml.EscapeText(&b, []byte(value)); err != nil {
+			return err
+		}
+		b.WriteString(`</t></is></c>`)
+	}
+	b.WriteString(`</row>`)
+	_, err := io.WriteString(x.sheet, b.String())
+	return err
+}
+
+func (x *transactionXLSXWriter) Close() error {
+	if _, err := io.WriteString(x.sheet, `</sheetData></worksheet>`); err != nil {
+		return err
+	}
+	return x.archive.Close()
+}
@@ -0,0 +1,147 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/budget"
+)
+
+// Parameter keys stored on transaction export jobs, in addition to the accounting ones.
+const (
+	paramType   = "type"
+	paramStatus = "status"
+)
+
+const (
+	transactionExportPageSize = 1000
+	// maxExportTransactions keeps an export within a single spreadsheet's row limit.
+	maxExportTransactions = 1000000
+)
+
+var errTransactionsExportTooLarge = fmt.Errorf("export exceeds %d transactions; narrow the filters", maxExportTransactions)
+
+// TransactionsGenerator streams a user's wallet transactions as CSV, JSON or XLSX, reading them
+// page by page so memory use does not grow with the export.
+type TransactionsGenerator struct {
+	wallets      WalletRepo
+	transactions TransactionRepo
+	logger       *slog.Logger
+}
+
+// NewTransactionsGenerator constructs the generator.
+func NewTransactionsGenerator(wallets WalletRepo, transactions TransactionRepo, logger *slog.Logger) *TransactionsGenerator {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &TransactionsGenerator{
+		wallets:      wallets,
+		transactions: transactions,
+		logger:       logger,
+	}
+}
+
+// Generate writes the job's transactions to w, oldest first. When ctx's execution budget runs out
+// between pages, the rows written so far are closed off and the file is marked truncated.
+func (g *TransactionsGenerator) Generate(ctx context.Context, job entities.ExportJob, w io.Writer) (File, error) {
+	params := job.GetParameters()
+	filter, err := transactionExportFilter(params)
+	if err != nil {
+		return File{}, err
+	}
+
+	walletIDs, err := ownedWalletIDs(ctx, g.wallets, job.GetUserID(), params)
+	if err != nil {
+		return File{}, err
+	}
+
+	rows, contentType, err := newTransactionRowWriter(job.GetFormat(), w, time.Now().UTC())
+	if err != nil {
+		return File{}, err
+	}
+
+	count := 0
+	truncated := false
+	if len(walletIDs) > 0 {
+		filter.WalletIDs = walletIDs
+		var after *repositories.PageCursor
+		for {
+			if budget.Exhausted(ctx) {
+				truncated = true
+				break
+			}
+			page, err := g.transactions.ListChronological(ctx, filter, after, transactionExportPageSize)
+			if err != nil {
+				if budget.Exhausted(ctx) {
+					truncated = true
+					break
+				}
+				return File{}, err
+			}
+			if count+len(page) > maxExportTransactions {
+				return File{}, errTransactionsExportTooLarge
+			}
+			for _, tx := range page {
+				if err := rows.WriteRow(tx); err != nil {
+					return File{}, err
+				}
+			}
+			count += len(page)
+			if len(page) < transactionExportPageSize {
+				break
+			}
+			last := page[len(page)-1]
+			after = &repositories.PageCursor{CreatedAt: last.GetCreatedAt(), ID: last.GetID()}
+		}
+	}
+
+	if err := rows.Close(); err != nil {
+		return File{}, err
+	}
+
+	return File{
+		Filename:    fmt.Sprintf("transactions_%s.%s", job.GetCreatedAt().UTC().Format("20060102_150405"), job.GetFormat()),
+		ContentType: contentType,
+		RowCount:    count,
+		Truncated:   truncated,
+	}, nil
+}
+
+func transactionExportFilter(params map[string]string) (repositories.TransactionFilter, error) {
+	var filter repositories.TransactionFilter
+
+	if chain := strings.TrimSpace(params[paramChain]); chain != "" {
+		normalized := entities.NormalizeChain(chain)
+		filter.Chain = &normalized
+	}
+	if raw := params[paramType]; raw != "" {
+		txType := entities.TransactionType(raw)
+		filter.Type = &txType
+	}
+	if raw := params[paramStatus]; raw != "" {
+		status := entities.TransactionStatus(raw)
+		filter.Status = &status
+	}
+	if raw := params[paramStartDate]; raw != "" {
+		start, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return filter, fmt.Errorf("invalid start date %q", raw)
+		}
+		filter.StartDate = &start
+	}
+	if raw := params[paramEndDate]; raw != "" {
+		end, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return filter, fmt.Errorf("invalid end date %q", raw)
+		}
+		filter.EndDate = &end
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,103 @@
+package gas
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// GetGasPreferenceUseCase returns the user's default gas speed.
+type GetGasPreferenceUseCase struct {
+	repository repositories.GasPriceRepository
+	logger     *slog.Logger
+}
+
+// NewGetGasPreferenceUseCase constructs the use case.
+func NewGetGasPreferenceUseCase(repository repositories.GasPriceRepository, logger *slog.Logger) *GetGasPreferenceUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GetGasPreferenceUseCase{
+		repository: repository,
+		logger:     logger,
+	}
+}
+
+// Execute returns the preference, falling back to the default speed.
+func (uc *GetGasPreferenceUseCase) Execute(ctx context.Context, rawUserID string) (dto.GasPreferenceResponse, error) {
+	userID, err := parseUserID(rawUserID)
+	if err != nil {
+		return dto.GasPreferenceResponse{}, err
+	}
+	speed, isDefault, err := resolveSpeed(ctx, uc.repository, userID)
+	if err != nil {
+		return dto.GasPreferenceResponse{}, err
+	}
+	return dto.GasPreferenceResponse{Speed: string(speed), IsDefault: isDefault}, nil
+}
+
+// SetGasPreferenceInput captures a user's new default gas speed.
+type SetGasPreferenceInput struct {
+	UserID  string
+	Request dto.GasPreferenceRequest
+}
+
+// SetGasPreferenceUseCase stores the user's default gas speed, used for sends that do not set a fee.
+type SetGasPreferenceUseCase struct {
+	repository repositories.GasPriceRepository
+	logger     *slog.Logger
+}
+
+// NewSetGasPreferenceUseCase constructs the use case.
+func NewSetGasPreferenceUseCase(repository repositories.GasPriceRepository, logger *slog.Logger) *SetGasPreferenceUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SetGasPreferenceUseCase{
+		repository: repository,
+		logger:     logger,
+	}
+}
+
+// Execute validates and stores the preference.
+func (uc *SetGasPreferenceUseCase) Execute(ctx context.Context, input SetGasPreferenceInput) (dto.GasPreferenceResponse, error) {
+	if uc.repository == nil {
+		return dto.GasPreferenceResponse{}, errors.New("set gas preference: repository not configured")
+	}
+	validation := input.Request.Validate()
+	userID, err := parseUserID(input.UserID)
+	if err != nil {
+		return dto.GasPreferenceResponse{}, err
+	}
+	if !validation.IsEmpty() {
+		return dto.GasPreferenceResponse{}, wrapValidationError(validation)
+	}
+
+	speed, _ := entities.ParseGasSpeed(input.Request.Speed)
+	if err := uc.repository.SetSpeedPreference(ctx, userID, speed); err != nil {
+		return dto.GasPreferenceResponse{}, err
+	}
+	return dto.GasPreferenceResponse{Speed: string(speed)}, nil
+}
+
+// resolveSpeed returns the user's default gas speed and whether it is the platform default. An
+// anonymous user gets the platform default.
+func resolveSpeed(ctx context.Context, repository repositories.GasPriceRepository, userID uuid.UUID) (entities.GasSpeed, bool, error) {
+	if repository == nil || userID == uuid.Nil {
+		return entities.DefaultGasSpeed, true, nil
+	}
+	speed, err := repository.GetSpeedPreference(ctx, userID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return entities.DefaultGasSpeed, true, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return speed, false, nil
+}
@@ -0,0 +1,206 @@
+package gas
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+const (
+	defaultHistoryWindow = time.Hour
+	maxHistoryWindow     = 24 * time.Hour
+	// maxHistoryPoints bounds the chart; longer windows are thinned evenly.
+	maxHistoryPoints = 360
+	// maxHistoryRows covers a full day of Ethereum blocks.
+	maxHistoryRows = 7500
+)
+
+// GetGasOracleInput selects the chain and how much fee history to chart. Window is a Go duration
+// such as "1h"; it defaults to one hour and is capped at a day.
+type GetGasOracleInput struct {
+	UserID uuid.UUID
+	Chain  string
+	Window string
+}
+
+// GetGasOracleUseCase suggests EIP-1559 fees per speed from recently sampled blocks and quotes the
+// fee that sends are built with.
+type GetGasOracleUseCase struct {
+	repository repositories.GasPriceRepository
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+// NewGetGasOracleUseCase constructs the use case.
+func NewGetGasOracleUseCase(repository repositories.GasPriceRepository, logger *slog.Logger) *GetGasOracleUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GetGasOracleUseCase{
+		repository: repository,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// Execute returns the chain's estimates, the user's preferred speed and the fee history.
+func (uc *GetGasOracleUseCase) Execute(ctx context.Context, input GetGasOracleInput) (*dto.GasOracleResponse, error) {
+	chain, err := parseEVMChain(input.Chain)
+	if err != nil {
+		return nil, err
+	}
+
+	window := defaultHistoryWindow
+	if raw := strings.TrimSpace(input.Window); raw != "" {
+		parsed, parseErr := time.ParseDuration(raw)
+		if parseErr != nil || parsed <= 0 || parsed > maxHistoryWindow {
+			return nil, utils.NewAppError(
+				"INVALID_WINDOW",
+				"window must be a positive duration of at most 24h",
+				fiber.StatusBadRequest,
+				parseErr,
+				map[string]any{"window": raw},
+			)
+		}
+		window = parsed
+	}
+
+	recent, err := uc.recentSamples(ctx, chain)
+	if err != nil {
+		return nil, err
+	}
+
+	preferred, _, err := resolveSpeed(ctx, uc.repository, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.now().UTC()
+	history, err := uc.repository.ListSamples(ctx, chain, now.Add(-window), maxHistoryRows)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := recent[0]
+	response := &dto.GasOracleResponse{
+		Chain:          string(chain),
+		BaseFeeGwei:    weiToGwei(latest.BaseFeeWei),
+		Estimates:      make([]dto.GasFeeEstimate, 0, len(entities.GasSpeeds())),
+		PreferredSpeed: string(preferred),
+		History:        thinHistory(history),
+		UpdatedAt:      latest.ObservedAt,
+		Stale:          now.Sub(latest.ObservedAt) > staleAfter,
+	}
+	for _, speed := range entities.GasSpeeds() {
+		quote := quoteFromSamples(recent, speed)
+		response.Estimates = append(response.Estimates, dto.GasFeeEstimate{
+			Speed:              string(speed),
+			MaxPriorityFeeGwei: weiToGwei(quote.MaxPriorityFeePerGasWei),
+			MaxFeeGwei:         weiToGwei(quote.MaxFeePerGasWei),
+			TransferFee:        quote.Fee.String(),
+			EstimatedSeconds:   speedWaitSeconds[speed],
+		})
+	}
+	return response, nil
+}
+
+// QuoteFee returns the fee for a plain transfer at the requested speed, or at the user's default
+// speed when none is requested. Quotes are refused while the oracle is stale so sends are never
+// built on outdated prices.
+func (uc *GetGasOracleUseCase) QuoteFee(ctx context.Context, userID uuid.UUID, chain entities.Chain, speed string) (dto.GasFeeQuote, error) {
+	if !entities.IsEVMChain(chain) {
+		return dto.GasFeeQuote{}, oracleUnavailableError(chain)
+	}
+
+	resolved, ok := entities.ParseGasSpeed(speed)
+	if strings.TrimSpace(speed) == "" {
+		var err error
+		if resolved, _, err = resolveSpeed(ctx, uc.repository, userID); err != nil {
+			return dto.GasFeeQuote{}, err
+		}
+	} else if !ok {
+		errs := utils.ValidationErrors{}
+		errs.Add("speed", "must be one of slow, normal, fast")
+		return dto.GasFeeQuote{}, wrapValidationError(errs)
+	}
+
+	recent, err := uc.recentSamples(ctx, chain)
+	if err != nil {
+		return dto.GasFeeQuote{}, err
+	}
+	if uc.now().UTC().Sub(recent[0].ObservedAt) > staleAfter {
+		return dto.GasFeeQuote{}, oracleUnavailableError(chain)
+	}
+	return quoteFromSamples(recent, resolved), nil
+}
+
+func (uc *GetGasOracleUseCase) recentSamples(ctx context.Context, chain entities.Chain) ([]entities.GasPriceSample, error) {
+	if uc.repository == nil {
+		return nil, errors.New("gas oracle: repository not configured")
+	}
+	samples, err := uc.repository.ListSamples(ctx, chain, time.Time{}, estimateBlocks)
+	if err != nil {
+		return nil, err
+	}
+	if len(samples) == 0 {
+		return nil, oracleUnavailableError(chain)
+	}
+	return samples, nil
+}
+
+// quoteFromSamples takes the median priority fee paid at the speed's percentile and allows the base
+// fee of the newest block to double, which covers six consecutive full blocks.
+func quoteFromSamples(samples []entities.GasPriceSample, speed entities.GasSpeed) dto.GasFeeQuote {
+	fees := make([]decimal.Decimal, 0, len(samples))
+	for _, sample := range samples {
+		fees = append(fees, sample.PriorityFeeWei(speed))
+	}
+	priority := medianWei(fees)
+	maxFee := samples[0].BaseFeeWei.Mul(decimal.NewFromInt(2)).Add(priority)
+
+	return dto.GasFeeQuote{
+		Speed:                   speed,
+		GasLimit:                transferGasLimit,
+		MaxFeePerGasWei:         maxFee,
+		MaxPriorityFeePerGasWei: priority,
+		Fee:                     maxFee.Mul(decimal.NewFromInt(transferGasLimit)).Div(weiPerEther),
+	}
+}
+
+// thinHistory returns the samples oldest first, keeping evenly spaced points when there are more
+// than the chart can use. The newest sample is always kept.
+func thinHistory(samples []entities.GasPriceSample) []dto.GasPricePoint {
+	step := 1
+	if len(samples) > maxHistoryPoints {
+		step = (len(samples) + maxHistoryPoints - 1) / maxHistoryPoints
+	}
+
+	points := make([]dto.GasPricePoint, 0, len(samples)/step+1)
+	for i := len(samples) - 1; i >= 0; i-- {
+		if i%step != 0 {
+			continue
+		}
+		sample := samples[i]
+		points = append(points, dto.GasPricePoint{
+			BlockNumber:           sample.BlockNumber,
+			BaseFeeGwei:           weiToGwei(sample.BaseFeeWei),
+			SlowPriorityFeeGwei:   weiToGwei(sample.SlowPriorityFeeWei),
+			NormalPriorityFeeGwei: weiToGwei(sample.NormalPriorityFeeWei),
+			FastPriorityFeeGwei:   weiToGwei(sample.FastPriorityFeeWei),
+			GasUsedRatio:          sample.GasUsedRatio,
+			ObservedAt:            sample.ObservedAt,
+		})
+	}
+	return points
+}
@@ -0,0 +1,104 @@
+package gas
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+const (
+	// transferGasLimit is the gas used by a plain native-token transfer.
+	transferGasLimit = 21000
+	// estimateBlocks is how many recent blocks the priority fee estimates are drawn from.
+	estimateBlocks = 20
+	// staleAfter marks estimates stale when no block has been sampled for this long.
+	staleAfter = 2 * time.Minute
+)
+
+var (
+	weiPerGwei  = decimal.New(1, 9)
+	weiPerEther = decimal.New(1, 18)
+)
+
+// speedPercentiles are the priority fee percentiles sampled per block, in GasSpeeds order.
+var speedPercentiles = []float64{10, 50, 90}
+
+// speedWaitSeconds is the typical inclusion time for each speed.
+var speedWaitSeconds = map[entities.GasSpeed]int{
+	entities.GasSpeedSlow:   120,
+	entities.GasSpeedNormal: 45,
+	entities.GasSpeedFast:   15,
+}
+
+func parseUserID(raw string) (uuid.UUID, error) {
+	id, err := uuid.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return uuid.Nil, utils.NewAppError(
+			"INVALID_USER_ID",
+			"user id must be a valid UUID",
+			fiber.StatusBadRequest,
+			err,
+			nil,
+		)
+	}
+	return id, nil
+}
+
+func parseEVMChain(raw string) (entities.Chain, error) {
+	chain := entities.NormalizeChain(raw)
+	if !entities.IsEVMChain(chain) {
+		return "", utils.NewAppError(
+			"GAS_ORACLE_UNSUPPORTED_CHAIN",
+			"gas prices are only available for EVM chains",
+			fiber.StatusNotFound,
+			nil,
+			map[string]any{"chain": raw},
+		)
+	}
+	return chain, nil
+}
+
+func wrapValidationError(errs utils.ValidationErrors) error {
+	if errs.IsEmpty() {
+		return nil
+	}
+	return utils.NewAppError(
+		"VALIDATION_ERROR",
+		"gas request invalid",
+		fiber.StatusBadRequest,
+		errs,
+		map[string]any{"errors": errs},
+	)
+}
+
+func oracleUnavailableError(chain entities.Chain) error {
+	return utils.NewAppError(
+		"GAS_ORACLE_UNAVAILABLE",
+		"no recent gas prices are available; set the fee explicitly",
+		fiber.StatusServiceUnavailable,
+		nil,
+		map[string]any{"chain": string(chain)},
+	)
+}
+
+func weiToGwei(wei decimal.Decimal) string {
+	return wei.Div(weiPerGwei).StringFixed(3)
+}
+
+// medianWei returns the median of values, which must not be empty.
+func medianWei(values []decimal.Decimal) decimal.Decimal {
+	sorted := append([]decimal.Decimal(nil), values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].LessThan(sorted[j]) })
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return sorted[mid-1].Add(sorted[mid]).Div(decimal.NewFromInt(2)).Floor()
+}
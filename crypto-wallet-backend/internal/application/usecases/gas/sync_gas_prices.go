@@ -0,0 +1,97 @@
+package gas
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/blockchain"
+)
+
+// defaultHistoryRetention bounds how long fee history is kept for charts.
+const defaultHistoryRetention = 24 * time.Hour
+
+// SyncGasPricesUseCase samples the fee market of each EVM chain into the gas price history and
+// purges samples older than the retention window.
+type SyncGasPricesUseCase struct {
+	sources    []blockchain.FeeHistoryProvider
+	repository repositories.GasPriceRepository
+	retention  time.Duration
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+// NewSyncGasPricesUseCase constructs the use case. A zero retention keeps a day of history.
+func NewSyncGasPricesUseCase(sources []blockchain.FeeHistoryProvider, repository repositories.GasPriceRepository, retention time.Duration, logger *slog.Logger) *SyncGasPricesUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if retention <= 0 {
+		retention = defaultHistoryRetention
+	}
+	return &SyncGasPricesUseCase{
+		sources:    sources,
+		repository: repository,
+		retention:  retention,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// Execute runs one sampling pass and returns how many blocks were sampled. A chain whose node
+// cannot be reached is skipped; the pass fails only when every chain failed.
+func (uc *SyncGasPricesUseCase) Execute(ctx context.Context) (int, error) {
+	now := uc.now().UTC()
+	sampled := 0
+	var lastErr error
+	for _, source := range uc.sources {
+		count, err := uc.syncChain(ctx, source, now)
+		if err != nil {
+			uc.logger.Error("failed to sample gas prices",
+				slog.String("chain", string(source.GetChain())),
+				slog.String("error", err.Error()))
+			lastErr = err
+			continue
+		}
+		sampled += count
+	}
+
+	if purged, err := uc.repository.PurgeSamplesBefore(ctx, now.Add(-uc.retention)); err != nil {
+		uc.logger.Warn("failed to purge gas price history", slog.String("error", err.Error()))
+	} else if purged > 0 {
+		uc.logger.Debug("purged gas price history", slog.Int64("count", purged))
+	}
+
+	if sampled == 0 && lastErr != nil {
+		return 0, lastErr
+	}
+	return sampled, nil
+}
+
+func (uc *SyncGasPricesUseCase) syncChain(ctx context.Context, source blockchain.FeeHistoryProvider, now time.Time) (int, error) {
+	history, err := source.FeeHistory(ctx, estimateBlocks, speedPercentiles)
+	if err != nil {
+		return 0, fmt.Errorf("fetch fee history: %w", err)
+	}
+
+	samples := make([]entities.GasPriceSample, 0, len(history.Blocks))
+	for _, block := range history.Blocks {
+		samples = append(samples, entities.GasPriceSample{
+			Chain:                source.GetChain(),
+			BlockNumber:          int64(block.Number),
+			BaseFeeWei:           block.BaseFeeWei,
+			SlowPriorityFeeWei:   block.PriorityFeesWei[0],
+			NormalPriorityFeeWei: block.PriorityFeesWei[1],
+			FastPriorityFeeWei:   block.PriorityFeesWei[2],
+			GasUsedRatio:         block.GasUsedRatio,
+			ObservedAt:           now,
+		})
+	}
+	if err := uc.repository.SaveSamples(ctx, samples); err != nil {
+		return 0, fmt.Errorf("store samples: %w", err)
+	}
+	return len(samples), nil
+}
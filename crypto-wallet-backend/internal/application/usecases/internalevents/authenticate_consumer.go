@@ -0,0 +1,70 @@
+package internalevents
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// consumerSeenInterval bounds how often a consumer's last-seen time is written.
+const consumerSeenInterval = time.Minute
+
+// ErrConsumerNotAuthenticated indicates the client certificate does not belong to an active
+// registered consumer.
+var ErrConsumerNotAuthenticated = errors.New("internal consumer not authenticated")
+
+// AuthenticateConsumerUseCase maps a verified client certificate to a registered, active internal
+// consumer. Both the internal REST listener and the gRPC listener authenticate through it.
+type AuthenticateConsumerUseCase struct {
+	consumers repositories.InternalEventConsumerRepository
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+// NewAuthenticateConsumerUseCase constructs the use case.
+func NewAuthenticateConsumerUseCase(consumers repositories.InternalEventConsumerRepository, logger *slog.Logger) *AuthenticateConsumerUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &AuthenticateConsumerUseCase{
+		consumers: consumers,
+		logger:    logger,
+		now:       func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Execute returns the consumer holding the DER-encoded leaf certificate, or
+// ErrConsumerNotAuthenticated. The listener must already have verified the certificate chain.
+func (uc *AuthenticateConsumerUseCase) Execute(ctx context.Context, certificate []byte) (entities.InternalEventConsumer, error) {
+	if len(certificate) == 0 || uc.consumers == nil {
+		return entities.InternalEventConsumer{}, ErrConsumerNotAuthenticated
+	}
+
+	fingerprint := entities.CertificateFingerprint(certificate)
+	consumer, err := uc.consumers.GetByFingerprint(ctx, fingerprint)
+	if err != nil {
+		if !errors.Is(err, repositories.ErrNotFound) {
+			uc.logger.Error("internal consumer lookup failed", slog.String("error", err.Error()))
+		}
+		uc.logger.Warn("internal consumer authentication failed", slog.String("fingerprint", fingerprint))
+		return entities.InternalEventConsumer{}, ErrConsumerNotAuthenticated
+	}
+	if !consumer.IsActive {
+		uc.logger.Warn("inactive internal consumer rejected", slog.String("consumer", consumer.Name))
+		return entities.InternalEventConsumer{}, ErrConsumerNotAuthenticated
+	}
+
+	// Last-seen is informational, so it is refreshed at most once per interval and a failure
+	// does not fail the request.
+	now := uc.now()
+	if consumer.LastSeenAt == nil || now.Sub(*consumer.LastSeenAt) >= consumerSeenInterval {
+		if err := uc.consumers.TouchLastSeen(ctx, consumer.ID, now); err != nil {
+			uc.logger.Warn("failed to record internal consumer activity", slog.String("consumer", consumer.Name), slog.String("error", err.Error()))
+		}
+	}
+	return consumer, nil
+}
@@ -0,0 +1,61 @@
+package internalevents
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// ConsumerOffsetUseCase reads and commits the offset an internal consumer has processed up to.
+// The offset is kept with the warehouse export cursors, one per consumer.
+type ConsumerOffsetUseCase struct {
+	outbox repositories.EventOutboxRepository
+	logger *slog.Logger
+}
+
+// NewConsumerOffsetUseCase constructs the use case.
+func NewConsumerOffsetUseCase(outbox repositories.EventOutboxRepository, logger *slog.Logger) *ConsumerOffsetUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ConsumerOffsetUseCase{
+		outbox: outbox,
+		logger: logger,
+	}
+}
+
+// Get returns the consumer's committed offset, zero when it has never committed.
+func (uc *ConsumerOffsetUseCase) Get(ctx context.Context, consumer entities.InternalEventConsumer) (*dto.InternalEventOffsetResponse, error) {
+	offset, err := uc.outbox.GetCursor(ctx, consumer.CursorSink())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load consumer offset: %w", err)
+	}
+	return &dto.InternalEventOffsetResponse{Consumer: consumer.Name, Offset: offset}, nil
+}
+
+// Commit stores the consumer's offset. Moving it backwards is allowed so a consumer can rewind
+// after losing processed data.
+func (uc *ConsumerOffsetUseCase) Commit(ctx context.Context, consumer entities.InternalEventConsumer, req dto.InternalEventOffsetRequest) (*dto.InternalEventOffsetResponse, error) {
+	var errs utils.ValidationErrors
+	if req.Offset < 0 {
+		errs.Add("offset", "must not be negative")
+	}
+	if err := wrapValidationError(errs); err != nil {
+		return nil, err
+	}
+
+	if err := uc.outbox.SaveCursor(ctx, consumer.CursorSink(), req.Offset); err != nil {
+		return nil, fmt.Errorf("failed to commit consumer offset: %w", err)
+	}
+
+	uc.logger.Info("internal consumer offset committed",
+		slog.String("consumer", consumer.Name),
+		slog.Int64("offset", req.Offset))
+
+	return &dto.InternalEventOffsetResponse{Consumer: consumer.Name, Offset: req.Offset}, nil
+}
@@ -0,0 +1,124 @@
+package internalevents
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// AuditActionConsumerRegistered is recorded when an internal event consumer is registered.
+const AuditActionConsumerRegistered = "internal_events.consumer_registered"
+
+// AuditLogger captures consumer registrations.
+type AuditLogger interface {
+	Record(ctx context.Context, entry audit.Entry) error
+}
+
+// RegisterConsumerInput describes a new internal consumer. CertificatePEM is the client certificate
+// the consumer will present; only its fingerprint is stored.
+type RegisterConsumerInput struct {
+	Name           string
+	CertificatePEM []byte
+	EventTypes     []string
+	Actor          string
+}
+
+// RegisterConsumerUseCase registers internal services allowed to read the event stream.
+type RegisterConsumerUseCase struct {
+	consumers repositories.InternalEventConsumerRepository
+	audit     AuditLogger
+	logger    *slog.Logger
+}
+
+// NewRegisterConsumerUseCase constructs the use case.
+func NewRegisterConsumerUseCase(consumers repositories.InternalEventConsumerRepository, auditLogger AuditLogger, logger *slog.Logger) *RegisterConsumerUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RegisterConsumerUseCase{
+		consumers: consumers,
+		audit:     auditLogger,
+		logger:    logger,
+	}
+}
+
+// Execute validates and stores the consumer.
+func (uc *RegisterConsumerUseCase) Execute(ctx context.Context, input RegisterConsumerInput) (entities.InternalEventConsumer, error) {
+	consumer := entities.InternalEventConsumer{
+		Name:       strings.ToLower(strings.TrimSpace(input.Name)),
+		EventTypes: input.EventTypes,
+		IsActive:   true,
+	}
+
+	var errs utils.ValidationErrors
+	if fingerprint, err := certificateFingerprint(input.CertificatePEM); err != nil {
+		errs.Add("certificate", err.Error())
+	} else {
+		consumer.CertFingerprint = fingerprint
+	}
+	if err := consumer.Validate(); err != nil && errs.IsEmpty() {
+		errs.Add("consumer", err.Error())
+	}
+	if err := wrapValidationError(errs); err != nil {
+		return entities.InternalEventConsumer{}, err
+	}
+
+	created, err := uc.consumers.Create(ctx, consumer)
+	if err != nil {
+		if errors.Is(err, repositories.ErrDuplicate) {
+			return entities.InternalEventConsumer{}, utils.NewAppError(
+				"CONSUMER_EXISTS",
+				"a consumer with this name or certificate is already registered",
+				fiber.StatusConflict,
+				err,
+				nil,
+			)
+		}
+		return entities.InternalEventConsumer{}, err
+	}
+
+	if uc.audit != nil {
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID:      input.Actor,
+			Action:       AuditActionConsumerRegistered,
+			ResourceType: "internal_event_consumer",
+			TargetID:     created.ID.String(),
+			Metadata: map[string]any{
+				"name":             created.Name,
+				"cert_fingerprint": created.CertFingerprint,
+				"event_types":      created.EventTypes,
+			},
+		}); err != nil {
+			uc.logger.Warn("failed to record audit entry", slog.String("action", AuditActionConsumerRegistered), slog.String("error", err.Error()))
+		}
+	}
+
+	uc.logger.Info("internal event consumer registered",
+		slog.String("consumer", created.Name),
+		slog.Any("event_types", created.EventTypes),
+		slog.String("actor", input.Actor))
+
+	return created, nil
+}
+
+// certificateFingerprint returns the fingerprint of the first certificate in a PEM bundle.
+func certificateFingerprint(data []byte) (string, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return "", errors.New("must be a PEM-encoded certificate")
+	}
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return "", errors.New("certificate could not be parsed")
+	}
+	return entities.CertificateFingerprint(block.Bytes), nil
+}
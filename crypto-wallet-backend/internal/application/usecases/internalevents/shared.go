@@ -0,0 +1,35 @@
+package internalevents
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+const (
+	defaultStreamLimit = 100
+	maxStreamLimit     = 1000
+)
+
+func normalizeStreamLimit(limit int) int {
+	if limit <= 0 {
+		return defaultStreamLimit
+	}
+	if limit > maxStreamLimit {
+		return maxStreamLimit
+	}
+	return limit
+}
+
+func wrapValidationError(errs utils.ValidationErrors) error {
+	if errs.IsEmpty() {
+		return nil
+	}
+	return utils.NewAppError(
+		"VALIDATION_ERROR",
+		"internal event request invalid",
+		fiber.StatusBadRequest,
+		errs,
+		map[string]any{"errors": errs},
+	)
+}
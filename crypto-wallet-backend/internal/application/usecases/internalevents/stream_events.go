@@ -0,0 +1,91 @@
+package internalevents
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// StreamEventsInput selects a page of a consumer's stream. Without After the page starts at the
+// consumer's committed offset; passing it replays from any earlier (or later) point. Types narrows
+// the stream further within what the consumer is allowed to read.
+type StreamEventsInput struct {
+	After *int64
+	Types []string
+	Limit int
+}
+
+// StreamEventsUseCase reads outbox events for an internal consumer. Reading never moves the
+// committed offset; consumers commit once they have processed a page.
+type StreamEventsUseCase struct {
+	outbox repositories.EventOutboxRepository
+	logger *slog.Logger
+}
+
+// NewStreamEventsUseCase constructs the use case.
+func NewStreamEventsUseCase(outbox repositories.EventOutboxRepository, logger *slog.Logger) *StreamEventsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &StreamEventsUseCase{
+		outbox: outbox,
+		logger: logger,
+	}
+}
+
+// Execute returns the next page of events the consumer may read.
+func (uc *StreamEventsUseCase) Execute(ctx context.Context, consumer entities.InternalEventConsumer, input StreamEventsInput) (*dto.InternalEventStreamResponse, error) {
+	var errs utils.ValidationErrors
+	if input.After != nil && *input.After < 0 {
+		errs.Add("after", "must not be negative")
+	}
+	if err := entities.ValidateEventTypePatterns(input.Types); err != nil {
+		errs.Add("types", err.Error())
+	}
+	if err := wrapValidationError(errs); err != nil {
+		return nil, err
+	}
+
+	after := int64(0)
+	if input.After != nil {
+		after = *input.After
+	} else {
+		committed, err := uc.outbox.GetCursor(ctx, consumer.CursorSink())
+		if err != nil {
+			return nil, fmt.Errorf("failed to load consumer offset: %w", err)
+		}
+		after = committed
+	}
+
+	limit := normalizeStreamLimit(input.Limit)
+	events, err := uc.outbox.ListAfterMatching(ctx, after, consumer.EventTypes, input.Types, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.InternalEventStreamResponse{
+		Consumer:   consumer.Name,
+		Events:     make([]dto.InternalEventResponse, 0, len(events)),
+		NextOffset: after,
+		HasMore:    len(events) == limit,
+	}
+	for _, event := range events {
+		resp.Events = append(resp.Events, dto.NewInternalEventResponse(event))
+	}
+	if len(events) > 0 {
+		resp.NextOffset = events[len(events)-1].Sequence
+	}
+
+	uc.logger.Debug("streamed internal events",
+		slog.String("consumer", consumer.Name),
+		slog.Int64("after", after),
+		slog.Int("count", len(events)),
+		slog.Int64("next_offset", resp.NextOffset))
+
+	return resp, nil
+}
@@ -0,0 +1,278 @@
+package kyc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// maxOpenReviewsPerUser bounds the open items shown with a user's AML risk.
+const maxOpenReviewsPerUser = 100
+
+// ListComplianceReviewsInput filters the compliance review queue. An empty status lists open items
+// and an empty source lists every source.
+type ListComplianceReviewsInput struct {
+	Status string
+	Source string
+	Limit  int
+	Offset int
+}
+
+// ListComplianceReviewsUseCase lists AML hits and other items awaiting a compliance reviewer.
+type ListComplianceReviewsUseCase struct {
+	repository repositories.KYCRepository
+	logger     *slog.Logger
+}
+
+// NewListComplianceReviewsUseCase constructs the use case.
+func NewListComplianceReviewsUseCase(repo repositories.KYCRepository, logger *slog.Logger) *ListComplianceReviewsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ListComplianceReviewsUseCase{
+		repository: repo,
+		logger:     logger,
+	}
+}
+
+// Execute lists the queue, oldest first.
+func (uc *ListComplianceReviewsUseCase) Execute(ctx context.Context, input ListComplianceReviewsInput) ([]dto.ComplianceReview, error) {
+	if uc.repository == nil {
+		return nil, errors.New("list compliance reviews: repository not configured")
+	}
+
+	status := entities.ComplianceReviewStatusOpen
+	if raw := strings.TrimSpace(input.Status); raw != "" {
+		status = entities.ComplianceReviewStatus(raw)
+		if status != entities.ComplianceReviewStatusOpen && status != entities.ComplianceReviewStatusResolved {
+			return nil, utils.NewAppError(
+				"INVALID_REVIEW_STATUS",
+				"status must be open or resolved",
+				http.StatusBadRequest,
+				nil,
+				map[string]any{"status": raw},
+			)
+		}
+	}
+	filter := repositories.ComplianceReviewFilter{Status: &status}
+	if raw := strings.TrimSpace(input.Source); raw != "" {
+		source := entities.ComplianceReviewSource(raw)
+		filter.Source = &source
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultReviewPageSize
+	}
+	if limit > maxReviewPageSize {
+		limit = maxReviewPageSize
+	}
+
+	items, err := uc.repository.ListComplianceReviews(ctx, filter, limit, input.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews := make([]dto.ComplianceReview, 0, len(items))
+	for _, item := range items {
+		reviews = append(reviews, dto.MapComplianceReview(item))
+	}
+	return reviews, nil
+}
+
+// GetComplianceReviewUseCase returns a single review queue item.
+type GetComplianceReviewUseCase struct {
+	repository repositories.KYCRepository
+}
+
+// NewGetComplianceReviewUseCase constructs the use case.
+func NewGetComplianceReviewUseCase(repo repositories.KYCRepository) *GetComplianceReviewUseCase {
+	return &GetComplianceReviewUseCase{repository: repo}
+}
+
+// Execute loads the item.
+func (uc *GetComplianceReviewUseCase) Execute(ctx context.Context, reviewID string) (*dto.ComplianceReview, error) {
+	if uc.repository == nil {
+		return nil, errors.New("get compliance review: repository not configured")
+	}
+	id, err := parseReviewID(reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := uc.repository.GetComplianceReview(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, reviewNotFoundError(err)
+		}
+		return nil, err
+	}
+	result := dto.MapComplianceReview(item)
+	return &result, nil
+}
+
+// GetAMLUserRiskUseCase returns a user's AML risk score and screening hits with their open review items.
+type GetAMLUserRiskUseCase struct {
+	repository repositories.KYCRepository
+}
+
+// NewGetAMLUserRiskUseCase constructs the use case.
+func NewGetAMLUserRiskUseCase(repo repositories.KYCRepository) *GetAMLUserRiskUseCase {
+	return &GetAMLUserRiskUseCase{repository: repo}
+}
+
+// Execute loads the user's risk. The risk score is omitted when the user has not been screened yet.
+func (uc *GetAMLUserRiskUseCase) Execute(ctx context.Context, userIDRaw string) (*dto.AMLUserRisk, error) {
+	if uc.repository == nil {
+		return nil, errors.New("get aml user risk: repository not configured")
+	}
+	userID, err := parseUserID(userIDRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.AMLUserRisk{UserID: userID}
+	if score, scoreErr := uc.repository.GetRiskScoreByUserID(ctx, userID); scoreErr == nil {
+		result.RiskScore = dto.MapRiskScore(score)
+	} else if !errors.Is(scoreErr, repositories.ErrNotFound) {
+		return nil, scoreErr
+	}
+
+	open := entities.ComplianceReviewStatusOpen
+	items, err := uc.repository.ListComplianceReviews(ctx, repositories.ComplianceReviewFilter{
+		Status: &open,
+		UserID: &userID,
+	}, maxOpenReviewsPerUser, 0)
+	if err != nil {
+		return nil, err
+	}
+	result.OpenReviews = make([]dto.ComplianceReview, 0, len(items))
+	for _, item := range items {
+		result.OpenReviews = append(result.OpenReviews, dto.MapComplianceReview(item))
+	}
+	return result, nil
+}
+
+// ResolveComplianceReviewInput carries a reviewer's conclusion on a review item.
+type ResolveComplianceReviewInput struct {
+	ActorID  string
+	ReviewID string
+	Request  dto.ComplianceReviewResolveRequest
+}
+
+// ResolveComplianceReviewUseCase closes an open review item. Wallets frozen by the escalation stay
+// frozen; reviewers unfreeze them through the admin wallet operations once cleared.
+type ResolveComplianceReviewUseCase struct {
+	repository repositories.KYCRepository
+	audit      AuditLogger
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+// NewResolveComplianceReviewUseCase constructs the use case.
+func NewResolveComplianceReviewUseCase(repo repositories.KYCRepository, auditLogger AuditLogger, logger *slog.Logger) *ResolveComplianceReviewUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ResolveComplianceReviewUseCase{
+		repository: repo,
+		audit:      auditLogger,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// Execute resolves the item and returns it.
+func (uc *ResolveComplianceReviewUseCase) Execute(ctx context.Context, input ResolveComplianceReviewInput) (*dto.ComplianceReview, error) {
+	if uc.repository == nil {
+		return nil, errors.New("resolve compliance review: repository not configured")
+	}
+	if errs := input.Request.Validate(); !errs.IsEmpty() {
+		return nil, wrapValidationError(errs)
+	}
+	actorID, err := parseUserID(input.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseReviewID(input.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	resolution := strings.TrimSpace(input.Request.Resolution)
+	if err := uc.repository.ResolveComplianceReview(ctx, id, actorID, resolution, uc.now().UTC()); err != nil {
+		switch {
+		case errors.Is(err, repositories.ErrNotFound):
+			return nil, reviewNotFoundError(err)
+		case errors.Is(err, repositories.ErrConflict):
+			return nil, utils.NewAppError(
+				"REVIEW_ALREADY_RESOLVED",
+				"compliance review is already resolved",
+				http.StatusConflict,
+				err,
+				nil,
+			)
+		}
+		return nil, err
+	}
+
+	item, err := uc.repository.GetComplianceReview(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.audit != nil {
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID:      actorID.String(),
+			Action:       "compliance_review_resolved",
+			ResourceType: "compliance_review",
+			TargetID:     item.ID.String(),
+			Metadata: map[string]any{
+				"user_id":    item.UserID.String(),
+				"source":     string(item.Source),
+				"risk_level": string(item.RiskLevel),
+				"resolution": resolution,
+			},
+		}); err != nil {
+			uc.logger.Warn("failed to record compliance review audit entry", slog.String("error", err.Error()))
+		}
+	}
+
+	result := dto.MapComplianceReview(item)
+	return &result, nil
+}
+
+func parseReviewID(raw string) (uuid.UUID, error) {
+	id, err := uuid.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return uuid.Nil, utils.NewAppError(
+			"INVALID_REVIEW_ID",
+			"review id must be a valid uuid",
+			http.StatusBadRequest,
+			err,
+			nil,
+		)
+	}
+	return id, nil
+}
+
+func reviewNotFoundError(err error) error {
+	return utils.NewAppError(
+		"REVIEW_NOT_FOUND",
+		"compliance review not found",
+		http.StatusNotFound,
+		err,
+		nil,
+	)
+}
@@ -0,0 +1,448 @@
+package kyc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/internal/infrastructure/security"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// DefaultDocumentLinkTTL is how long a document download link stays valid when no TTL is configured.
+const DefaultDocumentLinkTTL = 5 * time.Minute
+
+// documentLink is what a download token grants: one reviewer reading one user's document.
+type documentLink struct {
+	DocumentID uuid.UUID
+	UserID     uuid.UUID
+	ActorID    uuid.UUID
+	ExpiresAt  time.Time
+}
+
+// DocumentLinkSigner issues and checks stateless document download tokens. A token is
+// "<document id>.<user id>.<reviewer id>.<expiry unix>.<hex HMAC-SHA256>", so it cannot be
+// moved to another document or outlive its expiry.
+type DocumentLinkSigner struct {
+	secret []byte
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+// NewDocumentLinkSigner constructs a DocumentLinkSigner; ttl defaults to DefaultDocumentLinkTTL.
+func NewDocumentLinkSigner(secret string, ttl time.Duration) (*DocumentLinkSigner, error) {
+	if strings.TrimSpace(secret) == "" {
+		return nil, errors.New("kyc: document link secret is required")
+	}
+	if ttl <= 0 {
+		ttl = DefaultDocumentLinkTTL
+	}
+	return &DocumentLinkSigner{
+		secret: []byte(secret),
+		ttl:    ttl,
+		now:    time.Now,
+	}, nil
+}
+
+func (s *DocumentLinkSigner) issue(documentID, userID, actorID uuid.UUID) (string, time.Time) {
+	expiresAt := s.now().UTC().Add(s.ttl).Truncate(time.Second)
+	payload := fmt.Sprintf("%s.%s.%s.%d", documentID, userID, actorID, expiresAt.Unix())
+	return payload + "." + s.digest(payload), expiresAt
+}
+
+func (s *DocumentLinkSigner) verify(token string) (documentLink, error) {
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	if len(parts) != 5 {
+		return documentLink{}, documentLinkInvalidError()
+	}
+	payload := strings.Join(parts[:4], ".")
+	if !hmac.Equal([]byte(s.digest(payload)), []byte(strings.ToLower(parts[4]))) {
+		return documentLink{}, documentLinkInvalidError()
+	}
+
+	var (
+		link documentLink
+		err  error
+	)
+	if link.DocumentID, err = uuid.Parse(parts[0]); err != nil {
+		return documentLink{}, documentLinkInvalidError()
+	}
+	if link.UserID, err = uuid.Parse(parts[1]); err != nil {
+		return documentLink{}, documentLinkInvalidError()
+	}
+	if link.ActorID, err = uuid.Parse(parts[2]); err != nil {
+		return documentLink{}, documentLinkInvalidError()
+	}
+	expiry, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return documentLink{}, documentLinkInvalidError()
+	}
+	link.ExpiresAt = time.Unix(expiry, 0).UTC()
+
+	if s.now().Unix() > expiry {
+		return documentLink{}, utils.NewAppError(
+			"DOCUMENT_LINK_EXPIRED",
+			"document link has expired; request a new one",
+			http.StatusGone,
+			nil,
+			nil,
+		)
+	}
+	return link, nil
+}
+
+func (s *DocumentLinkSigner) digest(payload string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func documentLinkInvalidError() error {
+	return utils.NewAppError(
+		"DOCUMENT_LINK_INVALID",
+		"document link is invalid",
+		http.StatusUnauthorized,
+		nil,
+		nil,
+	)
+}
+
+// DocumentDownloadLinkInput identifies the reviewer and the document they want to read.
+type DocumentDownloadLinkInput struct {
+	ActorID    string
+	UserID     string
+	DocumentID string
+}
+
+// CreateDocumentDownloadLinkUseCase issues short-lived download links to compliance reviewers.
+// Issuing a link is audited and refused when the audit entry cannot be written.
+type CreateDocumentDownloadLinkUseCase struct {
+	repository repositories.KYCRepository
+	encryptor  PIICipher
+	signer     *DocumentLinkSigner
+	audit      AuditLogger
+	linkURL    string
+	logger     *slog.Logger
+}
+
+// NewCreateDocumentDownloadLinkUseCase constructs the use case. When linkURL is set, links are
+// returned as linkURL?token=...; otherwise only the token is returned.
+func NewCreateDocumentDownloadLinkUseCase(
+	repo repositories.KYCRepository,
+	encryptor PIICipher,
+	signer *DocumentLinkSigner,
+	auditLogger AuditLogger,
+	linkURL string,
+	logger *slog.Logger,
+) *CreateDocumentDownloadLinkUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &CreateDocumentDownloadLinkUseCase{
+		repository: repo,
+		encryptor:  encryptor,
+		signer:     signer,
+		audit:      auditLogger,
+		linkURL:    strings.TrimSpace(linkURL),
+		logger:     logger,
+	}
+}
+
+// Execute checks the document is held in storage and signs a link to it.
+func (uc *CreateDocumentDownloadLinkUseCase) Execute(ctx context.Context, input DocumentDownloadLinkInput) (*dto.KYCDocumentDownloadLink, error) {
+	if uc.repository == nil {
+		return nil, errors.New("document download link: repository not configured")
+	}
+	if uc.encryptor == nil {
+		return nil, errors.New("document download link: encryptor not configured")
+	}
+	if uc.signer == nil {
+		return nil, errors.New("document download link: signer not configured")
+	}
+	if uc.audit == nil {
+		return nil, errors.New("document download link: audit logger not configured")
+	}
+
+	actorID, err := parseUserID(input.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseUserID(input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	documentID, err := parseDocumentID(input.DocumentID)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := loadUserDocument(ctx, uc.repository, userID, documentID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := decryptStoredDocument(ctx, uc.encryptor, userID, document); err != nil {
+		return nil, err
+	}
+
+	token, expiresAt := uc.signer.issue(documentID, userID, actorID)
+	if err := uc.audit.Record(ctx, audit.Entry{
+		ActorID:  actorID.String(),
+		Action:   "kyc_document_link_issued",
+		TargetID: userID.String(),
+		Metadata: map[string]any{
+			"document_id":   documentID.String(),
+			"document_type": string(document.GetDocumentType()),
+			"expires_at":    expiresAt.Format(time.RFC3339),
+		},
+	}); err != nil {
+		uc.logger.Error("failed to record kyc document link; withholding link",
+			slog.String("document_id", documentID.String()),
+			slog.String("error", err.Error()))
+		return nil, auditUnavailableError(err)
+	}
+
+	return &dto.KYCDocumentDownloadLink{
+		URL:       uc.link(token),
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (uc *CreateDocumentDownloadLinkUseCase) link(token string) string {
+	if uc.linkURL == "" {
+		return ""
+	}
+	link, err := url.Parse(uc.linkURL)
+	if err != nil {
+		uc.logger.Warn("invalid kyc document link url", slog.String("error", err.Error()))
+		return ""
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+	return link.String()
+}
+
+// DocumentDownload is a decrypted document being streamed to a reviewer. Content must be closed.
+type DocumentDownload struct {
+	FileName string
+	MimeType string
+	Size     int64
+	Content  io.ReadCloser
+}
+
+// DownloadDocumentUseCase redeems a download link, decrypting the document as it is read from
+// storage. Every download is audited and refused when the audit entry cannot be written.
+type DownloadDocumentUseCase struct {
+	repository repositories.KYCRepository
+	encryptor  PIICipher
+	store      DocumentStore
+	signer     *DocumentLinkSigner
+	audit      AuditLogger
+	logger     *slog.Logger
+}
+
+// NewDownloadDocumentUseCase constructs the use case.
+func NewDownloadDocumentUseCase(
+	repo repositories.KYCRepository,
+	encryptor PIICipher,
+	store DocumentStore,
+	signer *DocumentLinkSigner,
+	auditLogger AuditLogger,
+	logger *slog.Logger,
+) *DownloadDocumentUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &DownloadDocumentUseCase{
+		repository: repo,
+		encryptor:  encryptor,
+		store:      store,
+		signer:     signer,
+		audit:      auditLogger,
+		logger:     logger,
+	}
+}
+
+// Execute verifies the token and opens the document.
+func (uc *DownloadDocumentUseCase) Execute(ctx context.Context, token string) (*DocumentDownload, error) {
+	if uc.repository == nil {
+		return nil, errors.New("download document: repository not configured")
+	}
+	if uc.encryptor == nil {
+		return nil, errors.New("download document: encryptor not configured")
+	}
+	if uc.store == nil {
+		return nil, errors.New("download document: store not configured")
+	}
+	if uc.signer == nil {
+		return nil, errors.New("download document: signer not configured")
+	}
+	if uc.audit == nil {
+		return nil, errors.New("download document: audit logger not configured")
+	}
+
+	link, err := uc.signer.verify(token)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := loadUserDocument(ctx, uc.repository, link.UserID, link.DocumentID)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := decryptStoredDocument(ctx, uc.encryptor, link.UserID, document)
+	if err != nil {
+		return nil, err
+	}
+
+	fileName := link.DocumentID.String()
+	if encrypted := document.GetEncryptedFileName(); encrypted != "" {
+		if plain, decryptErr := uc.encryptor.Decrypt(ctx, link.UserID, encrypted); decryptErr == nil && len(plain) > 0 {
+			fileName = string(plain)
+		}
+	}
+
+	if err := uc.audit.Record(ctx, audit.Entry{
+		ActorID:  link.ActorID.String(),
+		Action:   "kyc_document_downloaded",
+		TargetID: link.UserID.String(),
+		Metadata: map[string]any{
+			"document_id":   link.DocumentID.String(),
+			"document_type": string(document.GetDocumentType()),
+		},
+	}); err != nil {
+		uc.logger.Error("failed to record kyc document download; withholding document",
+			slog.String("document_id", link.DocumentID.String()),
+			slog.String("error", err.Error()))
+		return nil, auditUnavailableError(err)
+	}
+
+	object, err := uc.store.Open(ctx, stored.Key)
+	if err != nil {
+		uc.logger.Error("failed to open kyc document",
+			slog.String("document_id", link.DocumentID.String()),
+			slog.String("error", err.Error()))
+		return nil, utils.NewAppError(
+			"DOCUMENT_STORAGE_UNAVAILABLE",
+			"document could not be read from storage",
+			http.StatusServiceUnavailable,
+			err,
+			nil,
+		)
+	}
+	plaintext, err := security.NewStreamDecrypter(object, stored.DataKey, []byte(link.DocumentID.String()))
+	if err != nil {
+		object.Close()
+		return nil, utils.NewAppError(
+			"KYC_ENCRYPTION_ERROR",
+			"failed to read protected information",
+			http.StatusInternalServerError,
+			err,
+			map[string]any{"field": "document"},
+		)
+	}
+
+	return &DocumentDownload{
+		FileName: fileName,
+		MimeType: document.GetMimeType(),
+		Size:     int64(document.GetFileSize()),
+		Content: struct {
+			io.Reader
+			io.Closer
+		}{plaintext, object},
+	}, nil
+}
+
+func parseDocumentID(raw string) (uuid.UUID, error) {
+	documentID, err := uuid.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return uuid.Nil, utils.NewAppError(
+			"INVALID_DOCUMENT_ID",
+			"document id must be a valid uuid",
+			http.StatusBadRequest,
+			err,
+			nil,
+		)
+	}
+	return documentID, nil
+}
+
+// loadUserDocument loads a document, treating documents on another user's profile as missing.
+func loadUserDocument(ctx context.Context, repo repositories.KYCRepository, userID, documentID uuid.UUID) (entities.KYCDocument, error) {
+	profile, err := loadProfile(ctx, repo, userID)
+	if err != nil {
+		return nil, err
+	}
+	document, err := repo.GetDocumentByID(ctx, documentID)
+	if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+		return nil, err
+	}
+	if err != nil || document.GetKYCProfileID() != profile.GetID() {
+		return nil, utils.NewAppError(
+			"DOCUMENT_NOT_FOUND",
+			"document not found",
+			http.StatusNotFound,
+			err,
+			nil,
+		)
+	}
+	return document, nil
+}
+
+// decryptStoredDocument reads the storage locator of a document. Documents uploaded before
+// document storage was configured, or whose content has been purged, have none.
+func decryptStoredDocument(ctx context.Context, encryptor PIICipher, userID uuid.UUID, document entities.KYCDocument) (storedDocument, error) {
+	notStored := utils.NewAppError(
+		"DOCUMENT_NOT_STORED",
+		"document content is not held in document storage",
+		http.StatusConflict,
+		nil,
+		nil,
+	)
+	if document.GetEncryptedFilePath() == "" {
+		return storedDocument{}, notStored
+	}
+	plain, err := encryptor.Decrypt(ctx, userID, document.GetEncryptedFilePath())
+	if err != nil {
+		return storedDocument{}, utils.NewAppError(
+			"KYC_ENCRYPTION_ERROR",
+			"failed to read protected information",
+			http.StatusInternalServerError,
+			err,
+			map[string]any{"field": "storage path"},
+		)
+	}
+	var stored storedDocument
+	if err := json.Unmarshal(plain, &stored); err != nil || stored.Key == "" || len(stored.DataKey) != security.AES256KeySize {
+		return storedDocument{}, notStored
+	}
+	return stored, nil
+}
+
+func auditUnavailableError(err error) error {
+	return utils.NewAppError(
+		"AUDIT_UNAVAILABLE",
+		"personal information cannot be shown while audit logging is unavailable",
+		http.StatusServiceUnavailable,
+		err,
+		nil,
+	)
+}
@@ -0,0 +1,117 @@
+package kyc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+)
+
+const expireApprovalsBatchSize = 100
+
+// ExpireApprovalsUseCase expires approvals past their expiry date, downgrading the user's
+// verification level and limits until they re-verify.
+type ExpireApprovalsUseCase struct {
+	repository repositories.KYCRepository
+	notifier   Notifier
+	audit      AuditLogger
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+// NewExpireApprovalsUseCase constructs the use case.
+func NewExpireApprovalsUseCase(
+	repo repositories.KYCRepository,
+	notifier Notifier,
+	auditLogger AuditLogger,
+	logger *slog.Logger,
+) *ExpireApprovalsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ExpireApprovalsUseCase{
+		repository: repo,
+		notifier:   notifier,
+		audit:      auditLogger,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// Execute expires one batch of overdue approvals and reports how many profiles were expired.
+func (uc *ExpireApprovalsUseCase) Execute(ctx context.Context) (int, error) {
+	if uc.repository == nil {
+		return 0, errors.New("expire kyc approvals: repository not configured")
+	}
+
+	now := uc.now().UTC()
+	profiles, err := uc.repository.ListExpiredApprovals(ctx, now, expireApprovalsBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, profile := range profiles {
+		entity, ok := profile.(*entities.KYCProfileEntity)
+		if !ok {
+			continue
+		}
+
+		logger := uc.logger.With(
+			slog.String("profile_id", entity.GetID().String()),
+			slog.String("user_id", entity.GetUserID().String()))
+
+		previousLevel := entity.GetVerificationLevel()
+		if err := entity.ExpireApproval(now); err != nil {
+			logger.Warn("failed to expire kyc approval", slog.String("error", err.Error()))
+			continue
+		}
+		if err := uc.repository.UpdateProfile(ctx, entity); err != nil {
+			logger.Error("failed to persist expired kyc approval", slog.String("error", err.Error()))
+			continue
+		}
+		expired++
+
+		uc.recordAudit(ctx, logger, entity, previousLevel)
+		uc.notify(ctx, logger, entity, previousLevel)
+	}
+
+	return expired, nil
+}
+
+func (uc *ExpireApprovalsUseCase) recordAudit(ctx context.Context, logger *slog.Logger, profile entities.KYCProfile, previousLevel entities.VerificationLevel) {
+	if uc.audit == nil {
+		return
+	}
+	if err := uc.audit.Record(ctx, audit.Entry{
+		ActorID:  "system",
+		Action:   "kyc_approval_expired",
+		TargetID: profile.GetID().String(),
+		Metadata: map[string]any{
+			"user_id":        profile.GetUserID().String(),
+			"previous_level": string(previousLevel),
+			"new_level":      string(profile.GetVerificationLevel()),
+			"expires_at":     profile.GetExpiresAt(),
+		},
+	}); err != nil {
+		logger.Warn("failed to record kyc expiry audit entry", slog.String("error", err.Error()))
+	}
+}
+
+func (uc *ExpireApprovalsUseCase) notify(ctx context.Context, logger *slog.Logger, profile entities.KYCProfile, previousLevel entities.VerificationLevel) {
+	if uc.notifier == nil {
+		return
+	}
+	if err := uc.notifier.Notify(ctx, EventKYCExpired, map[string]any{
+		"user_id":        profile.GetUserID().String(),
+		"previous_level": string(previousLevel),
+		"expires_at":     profile.GetExpiresAt(),
+		"action":         "reverify",
+	}); err != nil {
+		logger.Warn("failed to notify user of kyc expiry", slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,85 @@
+package kyc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/services"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// LimitHeadroomReader measures a user's usage of their KYC limits.
+type LimitHeadroomReader interface {
+	Headroom(ctx context.Context, userID uuid.UUID) (services.LimitHeadroom, error)
+}
+
+// GetLimitHeadroomInput identifies the user whose headroom is requested.
+type GetLimitHeadroomInput struct {
+	UserID string
+}
+
+// GetLimitHeadroomUseCase reports how much of the daily and monthly limits a user has left.
+type GetLimitHeadroomUseCase struct {
+	limits LimitHeadroomReader
+	logger *slog.Logger
+}
+
+// NewGetLimitHeadroomUseCase constructs the use case.
+func NewGetLimitHeadroomUseCase(limits LimitHeadroomReader, logger *slog.Logger) *GetLimitHeadroomUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GetLimitHeadroomUseCase{
+		limits: limits,
+		logger: logger,
+	}
+}
+
+// Execute returns the user's current headroom.
+func (uc *GetLimitHeadroomUseCase) Execute(ctx context.Context, input GetLimitHeadroomInput) (*dto.KYCLimitHeadroomResponse, error) {
+	if uc.limits == nil {
+		return nil, errors.New("get kyc limit headroom: limit service not configured")
+	}
+
+	userID, err := parseUserID(input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	headroom, err := uc.limits.Headroom(ctx, userID)
+	if err != nil {
+		if errors.Is(err, services.ErrLimitRateUnavailable) {
+			return nil, utils.NewAppError(
+				"LIMIT_RATE_UNAVAILABLE",
+				"limit usage cannot be valued until exchange rates are available",
+				http.StatusServiceUnavailable,
+				err,
+				nil,
+			)
+		}
+		uc.logger.Error("failed to measure limit headroom", slog.String("user_id", userID.String()), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &dto.KYCLimitHeadroomResponse{
+		VerificationLevel: string(headroom.VerificationLevel),
+		Mode:              string(headroom.Mode),
+		Daily:             mapLimitWindow(headroom.Daily),
+		Monthly:           mapLimitWindow(headroom.Monthly),
+		CalculatedAt:      headroom.CalculatedAt,
+	}, nil
+}
+
+func mapLimitWindow(window services.LimitWindowUsage) dto.KYCLimitWindow {
+	return dto.KYCLimitWindow{
+		LimitUSD:     window.LimitUSD.StringFixed(2),
+		UsedUSD:      window.UsedUSD.StringFixed(2),
+		RemainingUSD: window.RemainingUSD.StringFixed(2),
+		Since:        window.Since,
+	}
+}
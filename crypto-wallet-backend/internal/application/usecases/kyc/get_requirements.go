@@ -0,0 +1,129 @@
+package kyc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// GetKYCRequirementsInput encapsulates the arguments required to look up verification requirements.
+// Country defaults to the nationality on the user's profile.
+type GetKYCRequirementsInput struct {
+	UserID  string
+	Country string
+}
+
+// GetKYCRequirementsUseCase reports the documents and limits that apply to a user's nationality.
+type GetKYCRequirementsUseCase struct {
+	repository repositories.KYCRepository
+	encryptor  PIICipher
+	logger     *slog.Logger
+}
+
+// NewGetKYCRequirementsUseCase constructs the use case.
+func NewGetKYCRequirementsUseCase(repo repositories.KYCRepository, encryptor PIICipher, logger *slog.Logger) *GetKYCRequirementsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GetKYCRequirementsUseCase{
+		repository: repo,
+		encryptor:  encryptor,
+		logger:     logger,
+	}
+}
+
+// Execute resolves the compliance matrix entry for the user and the documents still outstanding.
+func (uc *GetKYCRequirementsUseCase) Execute(ctx context.Context, input GetKYCRequirementsInput) (*dto.KYCRequirementsResponse, error) {
+	if uc.repository == nil {
+		return nil, errors.New("get kyc requirements: repository not configured")
+	}
+
+	userID, err := parseUserID(input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	country := entities.NormaliseCountryCode(input.Country)
+	if country != "" && len(country) != 2 {
+		errs := utils.ValidationErrors{}
+		errs.Add("country", "must be a 2-letter country code")
+		return nil, wrapValidationError(errs)
+	}
+
+	profile, err := uc.repository.GetProfileByUserID(ctx, userID)
+	if err != nil {
+		if !errors.Is(err, repositories.ErrNotFound) {
+			return nil, err
+		}
+		profile = nil
+	}
+
+	if country == "" {
+		if profile == nil {
+			return nil, utils.NewAppError(
+				"KYC_COUNTRY_REQUIRED",
+				"country is required until verification has been submitted",
+				http.StatusBadRequest,
+				nil,
+				nil,
+			)
+		}
+		if uc.encryptor == nil {
+			return nil, errors.New("get kyc requirements: encryptor not configured")
+		}
+		identity, err := decryptProfileIdentity(ctx, uc.encryptor, profile)
+		if err != nil {
+			return nil, err
+		}
+		country = strings.ToUpper(identity.Nationality)
+	}
+
+	requirement, err := resolveCountryRequirement(ctx, uc.repository, country)
+	if err != nil {
+		return nil, err
+	}
+
+	missing := requirement.RequiredDocuments
+	if profile != nil {
+		missing, err = uc.missingDocuments(ctx, profile.GetID(), requirement.RequiredDocuments)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	response := dto.MapKYCRequirements(requirement, missing)
+	return &response, nil
+}
+
+// missingDocuments reports which required documents have no upload; rejected uploads do not count.
+func (uc *GetKYCRequirementsUseCase) missingDocuments(ctx context.Context, profileID uuid.UUID, required []entities.DocumentType) ([]entities.DocumentType, error) {
+	documents, err := uc.repository.ListDocumentsByProfile(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	uploaded := make(map[entities.DocumentType]bool, len(documents))
+	for _, document := range documents {
+		if document.GetStatus() == entities.DocumentStatusRejected {
+			continue
+		}
+		uploaded[document.GetDocumentType()] = true
+	}
+
+	missing := make([]entities.DocumentType, 0)
+	for _, docType := range required {
+		if !uploaded[docType] {
+			missing = append(missing, docType)
+		}
+	}
+	return missing, nil
+}
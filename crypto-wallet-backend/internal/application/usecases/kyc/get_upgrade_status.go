@@ -0,0 +1,56 @@
+package kyc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// GetUpgradeStatusInput encapsulates the arguments required to track an upgrade.
+type GetUpgradeStatusInput struct {
+	UserID string
+}
+
+// GetUpgradeStatusUseCase reports the user's latest verification upgrade and its outstanding documents.
+type GetUpgradeStatusUseCase struct {
+	repository repositories.KYCRepository
+	logger     *slog.Logger
+}
+
+// NewGetUpgradeStatusUseCase constructs the use case.
+func NewGetUpgradeStatusUseCase(repo repositories.KYCRepository, logger *slog.Logger) *GetUpgradeStatusUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GetUpgradeStatusUseCase{
+		repository: repo,
+		logger:     logger,
+	}
+}
+
+// Execute loads the latest upgrade for the supplied user.
+func (uc *GetUpgradeStatusUseCase) Execute(ctx context.Context, input GetUpgradeStatusInput) (*dto.KYCUpgradeResponse, error) {
+	if uc.repository == nil {
+		return nil, errors.New("get kyc upgrade status: repository not configured")
+	}
+
+	userID, err := parseUserID(input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := loadProfile(ctx, uc.repository, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	upgrade, err := loadLatestUpgrade(ctx, uc.repository, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return upgradeResponse(ctx, uc.repository, profile, upgrade)
+}
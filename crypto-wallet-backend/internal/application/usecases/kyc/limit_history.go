@@ -0,0 +1,145 @@
+package kyc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+const (
+	defaultLimitHistoryPageSize = 100
+	maxLimitHistoryPageSize     = 500
+)
+
+// GetLimitSnapshotInput selects the user and the moment to look up; a nil At means now.
+type GetLimitSnapshotInput struct {
+	UserID string
+	At     *time.Time
+}
+
+// GetLimitSnapshotUseCase answers which verification level, limits and risk level applied to a
+// user at a point in time.
+type GetLimitSnapshotUseCase struct {
+	repository repositories.KYCRepository
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+// NewGetLimitSnapshotUseCase constructs the use case.
+func NewGetLimitSnapshotUseCase(repo repositories.KYCRepository, logger *slog.Logger) *GetLimitSnapshotUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GetLimitSnapshotUseCase{
+		repository: repo,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// Execute returns the snapshot in force at the requested time.
+func (uc *GetLimitSnapshotUseCase) Execute(ctx context.Context, input GetLimitSnapshotInput) (*dto.KYCLimitSnapshotAtResponse, error) {
+	if uc.repository == nil {
+		return nil, errors.New("get kyc limit snapshot: repository not configured")
+	}
+
+	userID, err := parseUserID(input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	at := uc.now().UTC()
+	if input.At != nil {
+		at = input.At.UTC()
+	}
+
+	snapshot, err := uc.repository.GetLimitSnapshotAt(ctx, userID, at)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, utils.NewAppError(
+				"KYC_LIMIT_HISTORY_MISSING",
+				"no limit history for the user at the requested time",
+				http.StatusNotFound,
+				err,
+				nil,
+			)
+		}
+		return nil, err
+	}
+
+	return &dto.KYCLimitSnapshotAtResponse{
+		At:       at,
+		Snapshot: dto.MapKYCLimitSnapshot(snapshot),
+	}, nil
+}
+
+// ListLimitHistoryInput selects a user's history within optional bounds.
+type ListLimitHistoryInput struct {
+	UserID string
+	From   *time.Time
+	To     *time.Time
+	Limit  int
+}
+
+// ListLimitHistoryUseCase lists every recorded change to a user's limits, newest first.
+type ListLimitHistoryUseCase struct {
+	repository repositories.KYCRepository
+	logger     *slog.Logger
+}
+
+// NewListLimitHistoryUseCase constructs the use case.
+func NewListLimitHistoryUseCase(repo repositories.KYCRepository, logger *slog.Logger) *ListLimitHistoryUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ListLimitHistoryUseCase{
+		repository: repo,
+		logger:     logger,
+	}
+}
+
+// Execute lists the user's snapshots.
+func (uc *ListLimitHistoryUseCase) Execute(ctx context.Context, input ListLimitHistoryInput) ([]dto.KYCLimitSnapshot, error) {
+	if uc.repository == nil {
+		return nil, errors.New("list kyc limit history: repository not configured")
+	}
+
+	userID, err := parseUserID(input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if input.From != nil && input.To != nil && input.From.After(*input.To) {
+		return nil, utils.NewAppError(
+			"INVALID_TIME_RANGE",
+			"from must not be after to",
+			http.StatusBadRequest,
+			nil,
+			nil,
+		)
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultLimitHistoryPageSize
+	}
+	if limit > maxLimitHistoryPageSize {
+		limit = maxLimitHistoryPageSize
+	}
+
+	history, err := uc.repository.ListLimitHistory(ctx, userID, input.From, input.To, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.KYCLimitSnapshot, 0, len(history))
+	for _, snapshot := range history {
+		items = append(items, dto.MapKYCLimitSnapshot(snapshot))
+	}
+	return items, nil
+}
@@ -0,0 +1,49 @@
+package kyc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// ListDocumentTypesInput encapsulates the arguments required to list document types.
+type ListDocumentTypesInput struct {
+	Locale string
+}
+
+// ListDocumentTypesUseCase returns the enabled document types and their capture rules.
+type ListDocumentTypesUseCase struct {
+	repository repositories.KYCRepository
+	logger     *slog.Logger
+}
+
+// NewListDocumentTypesUseCase constructs the use case.
+func NewListDocumentTypesUseCase(repo repositories.KYCRepository, logger *slog.Logger) *ListDocumentTypesUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ListDocumentTypesUseCase{
+		repository: repo,
+		logger:     logger,
+	}
+}
+
+// Execute lists enabled document types with labels in the requested locale.
+func (uc *ListDocumentTypesUseCase) Execute(ctx context.Context, input ListDocumentTypesInput) ([]dto.KYCDocumentType, error) {
+	if uc.repository == nil {
+		return nil, errors.New("list kyc document types: repository not configured")
+	}
+
+	specs := resolveDocumentTypeSpecs(ctx, uc.repository, uc.logger)
+	items := make([]dto.KYCDocumentType, 0, len(specs))
+	for _, spec := range specs {
+		if !spec.Enabled {
+			continue
+		}
+		items = append(items, dto.MapKYCDocumentType(spec, input.Locale))
+	}
+	return items, nil
+}
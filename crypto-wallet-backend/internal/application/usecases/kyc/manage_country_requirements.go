@@ -0,0 +1,218 @@
+package kyc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// ListCountryRequirementsUseCase returns the configured compliance matrix.
+type ListCountryRequirementsUseCase struct {
+	repository repositories.KYCRepository
+	logger     *slog.Logger
+}
+
+// NewListCountryRequirementsUseCase constructs the use case.
+func NewListCountryRequirementsUseCase(repo repositories.KYCRepository, logger *slog.Logger) *ListCountryRequirementsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ListCountryRequirementsUseCase{
+		repository: repo,
+		logger:     logger,
+	}
+}
+
+// Execute lists every matrix entry.
+func (uc *ListCountryRequirementsUseCase) Execute(ctx context.Context) ([]dto.KYCCountryRequirement, error) {
+	if uc.repository == nil {
+		return nil, errors.New("list kyc country requirements: repository not configured")
+	}
+
+	requirements, err := uc.repository.ListCountryRequirements(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.KYCCountryRequirement, 0, len(requirements))
+	for _, requirement := range requirements {
+		items = append(items, dto.MapKYCCountryRequirement(requirement))
+	}
+	return items, nil
+}
+
+// UpsertCountryRequirementInput encapsulates the arguments required to replace a matrix entry.
+type UpsertCountryRequirementInput struct {
+	ActorID     string
+	CountryCode string
+	Request     dto.KYCCountryRequirementRequest
+}
+
+// UpsertCountryRequirementUseCase creates or replaces a compliance matrix entry.
+type UpsertCountryRequirementUseCase struct {
+	repository repositories.KYCRepository
+	audit      AuditLogger
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+// NewUpsertCountryRequirementUseCase constructs the use case.
+func NewUpsertCountryRequirementUseCase(repo repositories.KYCRepository, auditLogger AuditLogger, logger *slog.Logger) *UpsertCountryRequirementUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &UpsertCountryRequirementUseCase{
+		repository: repo,
+		audit:      auditLogger,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// Execute validates and stores the entry.
+func (uc *UpsertCountryRequirementUseCase) Execute(ctx context.Context, input UpsertCountryRequirementInput) (*dto.KYCCountryRequirement, error) {
+	if uc.repository == nil {
+		return nil, errors.New("upsert kyc country requirement: repository not configured")
+	}
+
+	actorID, err := parseUserID(input.ActorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if errs := input.Request.Validate(); !errs.IsEmpty() {
+		return nil, wrapValidationError(errs)
+	}
+
+	documents := make([]entities.DocumentType, 0, len(input.Request.RequiredDocuments))
+	seen := make(map[entities.DocumentType]bool, len(input.Request.RequiredDocuments))
+	for _, raw := range input.Request.RequiredDocuments {
+		docType := entities.DocumentType(raw)
+		if seen[docType] {
+			continue
+		}
+		seen[docType] = true
+		documents = append(documents, docType)
+	}
+
+	requirement := entities.KYCCountryRequirement{
+		CountryCode:        entities.NormaliseCountryCode(input.CountryCode),
+		RequiredDocuments:  documents,
+		MaxDailyLimitUSD:   input.Request.MaxDailyLimitUSD,
+		MaxMonthlyLimitUSD: input.Request.MaxMonthlyLimitUSD,
+		Blocked:            input.Request.Blocked,
+		Notes:              input.Request.Notes,
+		UpdatedBy:          &actorID,
+		UpdatedAt:          uc.now().UTC(),
+	}
+	if err := requirement.Validate(); err != nil {
+		return nil, utils.NewAppError(
+			"KYC_COUNTRY_REQUIREMENT_INVALID",
+			"country requirement is invalid",
+			http.StatusBadRequest,
+			err,
+			map[string]any{"country": requirement.CountryCode},
+		)
+	}
+
+	if err := uc.repository.UpsertCountryRequirement(ctx, requirement); err != nil {
+		return nil, err
+	}
+
+	if uc.audit != nil {
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID:  actorID.String(),
+			Action:   "kyc_country_requirement_updated",
+			TargetID: requirement.CountryCode,
+			Metadata: map[string]any{
+				"required_documents":    documentTypeValues(requirement.RequiredDocuments),
+				"max_daily_limit_usd":   requirement.MaxDailyLimitUSD.String(),
+				"max_monthly_limit_usd": requirement.MaxMonthlyLimitUSD.String(),
+				"blocked":               requirement.Blocked,
+			},
+		}); err != nil {
+			uc.logger.Warn("failed to record kyc country requirement audit entry", slog.String("error", err.Error()))
+		}
+	}
+
+	result := dto.MapKYCCountryRequirement(requirement)
+	return &result, nil
+}
+
+// DeleteCountryRequirementInput encapsulates the arguments required to remove a matrix entry.
+type DeleteCountryRequirementInput struct {
+	ActorID     string
+	CountryCode string
+}
+
+// DeleteCountryRequirementUseCase removes a compliance matrix entry so the country falls back to the default.
+type DeleteCountryRequirementUseCase struct {
+	repository repositories.KYCRepository
+	audit      AuditLogger
+	logger     *slog.Logger
+}
+
+// NewDeleteCountryRequirementUseCase constructs the use case.
+func NewDeleteCountryRequirementUseCase(repo repositories.KYCRepository, auditLogger AuditLogger, logger *slog.Logger) *DeleteCountryRequirementUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &DeleteCountryRequirementUseCase{
+		repository: repo,
+		audit:      auditLogger,
+		logger:     logger,
+	}
+}
+
+// Execute deletes the entry.
+func (uc *DeleteCountryRequirementUseCase) Execute(ctx context.Context, input DeleteCountryRequirementInput) error {
+	if uc.repository == nil {
+		return errors.New("delete kyc country requirement: repository not configured")
+	}
+
+	actorID, err := parseUserID(input.ActorID)
+	if err != nil {
+		return err
+	}
+
+	countryCode := entities.NormaliseCountryCode(input.CountryCode)
+	if err := uc.repository.DeleteCountryRequirement(ctx, countryCode); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return utils.NewAppError(
+				"KYC_COUNTRY_REQUIREMENT_NOT_FOUND",
+				"country requirement not found",
+				http.StatusNotFound,
+				err,
+				map[string]any{"country": countryCode},
+			)
+		}
+		return err
+	}
+
+	if uc.audit != nil {
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID:  actorID.String(),
+			Action:   "kyc_country_requirement_deleted",
+			TargetID: countryCode,
+		}); err != nil {
+			uc.logger.Warn("failed to record kyc country requirement audit entry", slog.String("error", err.Error()))
+		}
+	}
+	return nil
+}
+
+func documentTypeValues(types []entities.DocumentType) []string {
+	values := make([]string, 0, len(types))
+	for _, docType := range types {
+		values = append(values, string(docType))
+	}
+	return values
+}
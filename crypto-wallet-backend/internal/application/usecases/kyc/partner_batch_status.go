@@ -0,0 +1,120 @@
+package kyc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// GetPartnerKYCStatusesInput encapsulates the arguments required for a partner batch lookup.
+type GetPartnerKYCStatusesInput struct {
+	PartnerID uuid.UUID
+	Request   dto.PartnerKYCStatusRequest
+}
+
+// GetPartnerKYCStatusesUseCase reports verification status for many of a partner's users at once.
+type GetPartnerKYCStatusesUseCase struct {
+	repository repositories.KYCRepository
+	partners   repositories.PartnerRepository
+	logger     *slog.Logger
+}
+
+// NewGetPartnerKYCStatusesUseCase constructs the use case.
+func NewGetPartnerKYCStatusesUseCase(repo repositories.KYCRepository, partners repositories.PartnerRepository, logger *slog.Logger) *GetPartnerKYCStatusesUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GetPartnerKYCStatusesUseCase{
+		repository: repo,
+		partners:   partners,
+		logger:     logger,
+	}
+}
+
+// Execute resolves the references through the partner's own links only, so a partner cannot learn
+// anything about users it did not onboard. Unlinked references come back with Found false.
+func (uc *GetPartnerKYCStatusesUseCase) Execute(ctx context.Context, input GetPartnerKYCStatusesInput) (*dto.PartnerKYCStatusResponse, error) {
+	if uc.repository == nil || uc.partners == nil {
+		return nil, errors.New("get partner kyc statuses: repositories not configured")
+	}
+	if input.PartnerID == uuid.Nil {
+		return nil, utils.NewAppError(
+			"PARTNER_AUTH_REQUIRED",
+			"partner authentication required",
+			fiber.StatusUnauthorized,
+			nil,
+			nil,
+		)
+	}
+
+	if errs := input.Request.Validate(); !errs.IsEmpty() {
+		return nil, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"partner kyc status request invalid",
+			fiber.StatusBadRequest,
+			errs,
+			map[string]any{"errors": errs},
+		)
+	}
+
+	refs := make([]string, 0, len(input.Request.UserRefs))
+	seen := make(map[string]struct{}, len(input.Request.UserRefs))
+	for _, raw := range input.Request.UserRefs {
+		ref := strings.TrimSpace(raw)
+		if _, ok := seen[ref]; ok {
+			continue
+		}
+		seen[ref] = struct{}{}
+		refs = append(refs, ref)
+	}
+
+	links, err := uc.partners.ListUserLinks(ctx, input.PartnerID, refs)
+	if err != nil {
+		return nil, err
+	}
+
+	userByRef := make(map[string]uuid.UUID, len(links))
+	userIDs := make([]uuid.UUID, 0, len(links))
+	for _, link := range links {
+		if link.PartnerID != input.PartnerID {
+			continue
+		}
+		userByRef[link.UserRef] = link.UserID
+		userIDs = append(userIDs, link.UserID)
+	}
+
+	profiles, err := uc.repository.ListProfilesByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	profileByUser := make(map[uuid.UUID]entities.KYCProfile, len(profiles))
+	for _, profile := range profiles {
+		profileByUser[profile.GetUserID()] = profile
+	}
+
+	response := &dto.PartnerKYCStatusResponse{Items: make([]dto.PartnerKYCStatus, 0, len(refs))}
+	for _, ref := range refs {
+		userID, ok := userByRef[ref]
+		if !ok {
+			response.Items = append(response.Items, dto.PartnerKYCStatus{UserRef: ref})
+			continue
+		}
+		response.Items = append(response.Items, dto.MapPartnerKYCStatus(ref, profileByUser[userID]))
+	}
+
+	uc.logger.Info("partner kyc status batch served",
+		slog.String("partner_id", input.PartnerID.String()),
+		slog.Int("requested", len(refs)),
+		slog.Int("linked", len(userIDs)))
+
+	return response, nil
+}
@@ -0,0 +1,234 @@
+package kyc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/internal/infrastructure/external"
+)
+
+const upgradeDecisionsBatchSize = 50
+
+// ProcessUpgradeDecisionsUseCase polls the provider for upgrades under review and applies the
+// outcome. Approval raises the profile to the target level with that level's limits, capped by
+// the compliance matrix entry for the user's nationality.
+type ProcessUpgradeDecisionsUseCase struct {
+	repository repositories.KYCRepository
+	encryptor  PIICipher
+	provider   external.KYCProviderClient
+	notifier   Notifier
+	audit      AuditLogger
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+// NewProcessUpgradeDecisionsUseCase constructs the use case.
+func NewProcessUpgradeDecisionsUseCase(
+	repo repositories.KYCRepository,
+	encryptor PIICipher,
+	provider external.KYCProviderClient,
+	notifier Notifier,
+	auditLogger AuditLogger,
+	logger *slog.Logger,
+) *ProcessUpgradeDecisionsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ProcessUpgradeDecisionsUseCase{
+		repository: repo,
+		encryptor:  encryptor,
+		provider:   provider,
+		notifier:   notifier,
+		audit:      auditLogger,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// Execute checks one batch of upgrades and reports how many were decided.
+func (uc *ProcessUpgradeDecisionsUseCase) Execute(ctx context.Context) (int, error) {
+	if uc.repository == nil {
+		return 0, errors.New("process kyc upgrades: repository not configured")
+	}
+	if uc.provider == nil {
+		return 0, errors.New("process kyc upgrades: provider not configured")
+	}
+	if uc.encryptor == nil {
+		return 0, errors.New("process kyc upgrades: encryptor not configured")
+	}
+
+	upgrades, err := uc.repository.ListUpgradeRequestsUnderReview(ctx, upgradeDecisionsBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	decided := 0
+	for _, item := range upgrades {
+		upgrade, ok := item.(*entities.KYCUpgradeRequestEntity)
+		if !ok {
+			continue
+		}
+
+		logger := uc.logger.With(
+			slog.String("upgrade_id", upgrade.GetID().String()),
+			slog.String("user_id", upgrade.GetUserID().String()))
+
+		result, err := uc.provider.GetStatus(ctx, upgrade.GetProviderApplicationID())
+		if err != nil {
+			logger.Warn("failed to fetch kyc upgrade status", slog.String("error", err.Error()))
+			continue
+		}
+
+		approved, rejected, reason := upgradeDecision(result)
+		switch {
+		case approved:
+			if err := uc.approve(ctx, logger, upgrade); err != nil {
+				logger.Error("failed to approve kyc upgrade", slog.String("error", err.Error()))
+				continue
+			}
+		case rejected:
+			if err := uc.reject(ctx, logger, upgrade, reason); err != nil {
+				logger.Error("failed to reject kyc upgrade", slog.String("error", err.Error()))
+				continue
+			}
+		default:
+			continue
+		}
+		decided++
+	}
+
+	return decided, nil
+}
+
+func (uc *ProcessUpgradeDecisionsUseCase) approve(ctx context.Context, logger *slog.Logger, upgrade *entities.KYCUpgradeRequestEntity) error {
+	now := uc.now().UTC()
+
+	profile, err := loadProfile(ctx, uc.repository, upgrade.GetUserID())
+	if err != nil {
+		return err
+	}
+	previousLevel := profile.GetVerificationLevel()
+
+	identity, err := decryptProfileIdentity(ctx, uc.encryptor, profile)
+	if err != nil {
+		return err
+	}
+	requirement, err := resolveCountryRequirement(ctx, uc.repository, identity.Nationality)
+	if err != nil {
+		return err
+	}
+	if requirement.Blocked {
+		return uc.reject(ctx, logger, upgrade, "verification is not available for this nationality")
+	}
+
+	if err := profile.UpgradeVerificationLevel(upgrade.GetTargetLevel(), now); err != nil {
+		// The basic approval lapsed or was replaced while the upgrade was in review.
+		return uc.reject(ctx, logger, upgrade, "profile is no longer eligible for upgrade")
+	}
+	if err := profile.UpdateLimits(requirement.CapLimits(profile.GetDailyLimitUSD(), profile.GetMonthlyLimitUSD())); err != nil {
+		return err
+	}
+	if err := upgrade.Approve(now); err != nil {
+		return err
+	}
+
+	if err := uc.repository.CompleteUpgrade(ctx, upgrade, profile); err != nil {
+		if errors.Is(err, repositories.ErrConflict) {
+			_ = upgrade.Reject("profile is no longer eligible for upgrade", now)
+			return uc.finishRejection(ctx, logger, upgrade)
+		}
+		return err
+	}
+
+	uc.record(ctx, logger, "kyc_upgrade_approved", upgrade, map[string]any{
+		"previous_level":    string(previousLevel),
+		"new_level":         string(profile.GetVerificationLevel()),
+		"daily_limit_usd":   profile.GetDailyLimitUSD().String(),
+		"monthly_limit_usd": profile.GetMonthlyLimitUSD().String(),
+	})
+	uc.notify(ctx, logger, EventKYCUpgradeApproved, map[string]any{
+		"user_id":            upgrade.GetUserID().String(),
+		"verification_level": string(profile.GetVerificationLevel()),
+		"daily_limit_usd":    profile.GetDailyLimitUSD().String(),
+		"monthly_limit_usd":  profile.GetMonthlyLimitUSD().String(),
+	})
+	return nil
+}
+
+func (uc *ProcessUpgradeDecisionsUseCase) reject(ctx context.Context, logger *slog.Logger, upgrade *entities.KYCUpgradeRequestEntity, reason string) error {
+	if err := upgrade.Reject(reason, uc.now().UTC()); err != nil {
+		return err
+	}
+	return uc.finishRejection(ctx, logger, upgrade)
+}
+
+func (uc *ProcessUpgradeDecisionsUseCase) finishRejection(ctx context.Context, logger *slog.Logger, upgrade *entities.KYCUpgradeRequestEntity) error {
+	if err := uc.repository.UpdateUpgradeRequest(ctx, upgrade); err != nil {
+		return err
+	}
+
+	uc.record(ctx, logger, "kyc_upgrade_rejected", upgrade, map[string]any{
+		"reason": upgrade.GetRejectionReason(),
+	})
+	uc.notify(ctx, logger, EventKYCUpgradeRejected, map[string]any{
+		"user_id":            upgrade.GetUserID().String(),
+		"verification_level": string(upgrade.GetFromLevel()),
+		"reason":             upgrade.GetRejectionReason(),
+	})
+	return nil
+}
+
+func (uc *ProcessUpgradeDecisionsUseCase) record(ctx context.Context, logger *slog.Logger, action string, upgrade entities.KYCUpgradeRequest, metadata map[string]any) {
+	if uc.audit == nil {
+		return
+	}
+	metadata["user_id"] = upgrade.GetUserID().String()
+	metadata["profile_id"] = upgrade.GetProfileID().String()
+	metadata["provider_application_id"] = upgrade.GetProviderApplicationID()
+	if err := uc.audit.Record(ctx, audit.Entry{
+		ActorID:  "system",
+		Action:   action,
+		TargetID: upgrade.GetID().String(),
+		Metadata: metadata,
+	}); err != nil {
+		logger.Warn("failed to record kyc upgrade audit entry", slog.String("error", err.Error()))
+	}
+}
+
+func (uc *ProcessUpgradeDecisionsUseCase) notify(ctx context.Context, logger *slog.Logger, event string, data map[string]any) {
+	if uc.notifier == nil {
+		return
+	}
+	if err := uc.notifier.Notify(ctx, event, data); err != nil {
+		logger.Warn("failed to notify user of kyc upgrade decision", slog.String("error", err.Error()))
+	}
+}
+
+// upgradeDecision interprets a provider status. SumSub-style providers report a final answer
+// as reviewResult GREEN/RED; a plain approved/rejected status is accepted as well.
+func upgradeDecision(result *external.KYCStatusResult) (approved, rejected bool, reason string) {
+	if result == nil {
+		return false, false, ""
+	}
+	review := strings.ToUpper(strings.TrimSpace(result.ReviewResult))
+	status := strings.ToLower(strings.TrimSpace(result.Status))
+
+	switch {
+	case review == "GREEN" || status == "approved":
+		return true, false, ""
+	case review == "RED" || status == "rejected":
+		reason = strings.TrimSpace(result.RejectionCode)
+		if reason == "" {
+			reason = "rejected by verification provider"
+		}
+		return false, true, reason
+	default:
+		return false, false, ""
+	}
+}
@@ -0,0 +1,380 @@
+package kyc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/internal/infrastructure/external"
+)
+
+const (
+	rescreenBatchSize = 100
+	// rescreenRetryDelay postpones a user whose screening failed so the rest of the batch can progress.
+	rescreenRetryDelay = time.Hour
+	// rescreenScoreJump is the score increase treated as material even without a level change.
+	rescreenScoreJump = 20
+	// maxFrozenWalletsPerUser bounds the wallet listing when escalation freezes a user's wallets.
+	maxFrozenWalletsPerUser = 500
+)
+
+// unscreenedProfileStatuses are the profile statuses screened for the first time when they have no
+// risk score yet.
+var unscreenedProfileStatuses = []entities.KYCStatus{
+	entities.KYCStatusPending,
+	entities.KYCStatusUnderReview,
+	entities.KYCStatusApproved,
+}
+
+// screeningRiskFactors maps screening hit types to the risk factors they contribute.
+// Factors from other sources are preserved across re-screenings.
+var screeningRiskFactors = map[external.AMLHitType]string{
+	external.AMLHitTypePEP:          "pep_match",
+	external.AMLHitTypeSanctions:    "sanctions_match",
+	external.AMLHitTypeAdverseMedia: "adverse_media",
+}
+
+// AMLEscalationPolicy decides when an escalation also freezes the user's active wallets.
+type AMLEscalationPolicy struct {
+	// FreezeAtLevel freezes wallets when the risk level rises to or past it. Empty disables it.
+	FreezeAtLevel entities.RiskLevel
+	// FreezeOnSanctions freezes wallets on any new sanctions hit, whatever the risk level.
+	FreezeOnSanctions bool
+}
+
+// DefaultAMLEscalationPolicy freezes wallets on critical risk and on new sanctions hits.
+func DefaultAMLEscalationPolicy() AMLEscalationPolicy {
+	return AMLEscalationPolicy{
+		FreezeAtLevel:     entities.RiskLevelCritical,
+		FreezeOnSanctions: true,
+	}
+}
+
+// RescreenRiskScoresUseCase screens users against PEP, sanctions and adverse media sources when
+// their risk review is due, or for the first time when they have no risk score yet. It updates
+// their risk score, escalates material changes for manual review and freezes wallets when the
+// escalation policy calls for it.
+type RescreenRiskScoresUseCase struct {
+	repository repositories.KYCRepository
+	encryptor  PIICipher
+	screening  external.AMLScreeningClient
+	wallets    WalletStore
+	policy     AMLEscalationPolicy
+	audit      AuditLogger
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+// NewRescreenRiskScoresUseCase constructs the use case. A nil wallet store disables freezing.
+func NewRescreenRiskScoresUseCase(
+	repo repositories.KYCRepository,
+	encryptor PIICipher,
+	screening external.AMLScreeningClient,
+	wallets WalletStore,
+	policy AMLEscalationPolicy,
+	auditLogger AuditLogger,
+	logger *slog.Logger,
+) *RescreenRiskScoresUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RescreenRiskScoresUseCase{
+		repository: repo,
+		encryptor:  encryptor,
+		screening:  screening,
+		wallets:    wallets,
+		policy:     policy,
+		audit:      auditLogger,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// Execute screens one batch of due users, then fills the rest of the batch with users who were
+// never screened, and reports how many were screened.
+func (uc *RescreenRiskScoresUseCase) Execute(ctx context.Context) (int, error) {
+	if uc.repository == nil {
+		return 0, errors.New("rescreen risk scores: repository not configured")
+	}
+	if uc.screening == nil {
+		return 0, errors.New("rescreen risk scores: screening provider not configured")
+	}
+	if uc.encryptor == nil {
+		return 0, errors.New("rescreen risk scores: encryptor not configured")
+	}
+
+	now := uc.now().UTC()
+	scores, err := uc.repository.ListRiskScoresDueForReview(ctx, now, rescreenBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	batch := make([]*entities.UserRiskScoreEntity, 0, len(scores))
+	for _, item := range scores {
+		if score, ok := item.(*entities.UserRiskScoreEntity); ok {
+			batch = append(batch, score)
+		}
+	}
+
+	if remaining := rescreenBatchSize - len(scores); remaining > 0 {
+		profiles, err := uc.repository.ListProfilesWithoutRiskScore(ctx, unscreenedProfileStatuses, remaining)
+		if err != nil {
+			return 0, err
+		}
+		for _, profile := range profiles {
+			// A new score starts low and due now; screening sets the real score and schedule.
+			score, err := entities.NewUserRiskScoreEntity(entities.UserRiskScoreParams{
+				UserID:       profile.GetUserID(),
+				RiskLevel:    entities.RiskLevelLow,
+				NextReviewAt: now,
+				CreatedAt:    now,
+			})
+			if err != nil {
+				uc.logger.Warn("failed to initialise risk score",
+					slog.String("user_id", profile.GetUserID().String()),
+					slog.String("error", err.Error()))
+				continue
+			}
+			batch = append(batch, score)
+		}
+	}
+
+	screened := 0
+	for _, score := range batch {
+		logger := uc.logger.With(slog.String("user_id", score.GetUserID().String()))
+		if err := uc.rescreen(ctx, logger, score, now); err != nil {
+			logger.Warn("aml re-screening failed; retrying later", slog.String("error", err.Error()))
+			score.DeferReview(now.Add(rescreenRetryDelay))
+			if err := uc.repository.UpsertRiskScore(ctx, score); err != nil {
+				logger.Error("failed to defer risk review", slog.String("error", err.Error()))
+			}
+			continue
+		}
+		screened++
+	}
+
+	return screened, nil
+}
+
+func (uc *RescreenRiskScoresUseCase) rescreen(ctx context.Context, logger *slog.Logger, score *entities.UserRiskScoreEntity, now time.Time) error {
+	profile, err := uc.repository.GetProfileByUserID(ctx, score.GetUserID())
+	if err != nil {
+		return fmt.Errorf("load kyc profile: %w", err)
+	}
+
+	identity, err := decryptProfileIdentity(ctx, uc.encryptor, profile)
+	if err != nil {
+		return err
+	}
+
+	result, err := uc.screening.Screen(ctx, external.AMLScreeningRequest{
+		ExternalUserID: score.GetUserID().String(),
+		FullName:       strings.TrimSpace(identity.FirstName + " " + identity.LastName),
+		DateOfBirth:    identity.DateOfBirth,
+		Nationality:    identity.Nationality,
+		Types: []external.AMLHitType{
+			external.AMLHitTypePEP,
+			external.AMLHitTypeSanctions,
+			external.AMLHitTypeAdverseMedia,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	previousScore := score.GetScore()
+	previousLevel := score.GetLevel()
+	known := make(map[string]bool, len(score.GetAMLHits()))
+	for _, hit := range score.GetAMLHits() {
+		known[hit] = true
+	}
+
+	hitKeys := make([]string, 0, len(result.Hits))
+	var newHits []string
+	factors := make(map[string]bool)
+	for _, factor := range score.GetRiskFactors() {
+		if !isScreeningRiskFactor(factor) {
+			factors[factor] = true
+		}
+	}
+	newSanctions := false
+	for _, hit := range result.Hits {
+		key := hit.Key()
+		hitKeys = append(hitKeys, key)
+		if !known[key] {
+			newHits = append(newHits, key)
+			if hit.Type == external.AMLHitTypeSanctions {
+				newSanctions = true
+			}
+		}
+		if factor, ok := screeningRiskFactors[hit.Type]; ok {
+			factors[factor] = true
+		}
+	}
+	sort.Strings(hitKeys)
+	sort.Strings(newHits)
+
+	newLevel := entities.RiskLevelForScore(result.RiskScore)
+	var reasons []string
+	if entities.RiskLevelRank(newLevel) > entities.RiskLevelRank(previousLevel) {
+		reasons = append(reasons, fmt.Sprintf("risk level rose from %s to %s", previousLevel, newLevel))
+	}
+	if len(newHits) > 0 {
+		reasons = append(reasons, fmt.Sprintf("%d new screening hit(s)", len(newHits)))
+	}
+	if result.RiskScore-previousScore >= rescreenScoreJump {
+		reasons = append(reasons, fmt.Sprintf("risk score rose from %d to %d", previousScore, result.RiskScore))
+	}
+
+	// Escalate before saving so a failed freeze or enqueue leaves the hits unseen and they are
+	// raised again next run. Freezing first means a retry finds the wallets already frozen.
+	if len(reasons) > 0 {
+		var frozen []string
+		if freezeReason := uc.freezeReason(previousLevel, newLevel, newSanctions); freezeReason != "" {
+			frozen, err = uc.freezeWallets(ctx, score.GetUserID(), now)
+			if err != nil {
+				return fmt.Errorf("freeze wallets: %w", err)
+			}
+			if len(frozen) > 0 {
+				reasons = append(reasons, fmt.Sprintf("%d wallet(s) frozen: %s", len(frozen), freezeReason))
+				uc.recordFreeze(ctx, logger, score.GetUserID(), frozen, freezeReason)
+			}
+		}
+
+		item := &entities.ComplianceReviewItem{
+			UserID:    score.GetUserID(),
+			Source:    entities.ComplianceReviewSourceAMLRescreening,
+			Reason:    strings.Join(reasons, "; "),
+			RiskLevel: newLevel,
+			Details: map[string]any{
+				"previous_level": string(previousLevel),
+				"new_level":      string(newLevel),
+				"previous_score": previousScore,
+				"new_score":      result.RiskScore,
+				"new_hits":       newHits,
+				"reference_id":   result.ReferenceID,
+				"wallets_frozen": frozen,
+			},
+			CreatedAt: now,
+		}
+		if err := uc.repository.EnqueueComplianceReview(ctx, item); err != nil {
+			return fmt.Errorf("escalate for manual review: %w", err)
+		}
+		uc.recordEscalation(ctx, logger, item)
+	}
+
+	factorList := make([]string, 0, len(factors))
+	for factor := range factors {
+		factorList = append(factorList, factor)
+	}
+	sort.Strings(factorList)
+
+	score.UpdateScore(result.RiskScore, newLevel)
+	score.SetAMLHits(hitKeys)
+	score.SetRiskFactors(factorList)
+	score.MarkScreened(now, now.Add(entities.ReviewIntervalForLevel(newLevel)))
+	if err := uc.repository.UpsertRiskScore(ctx, score); err != nil {
+		return fmt.Errorf("save risk score: %w", err)
+	}
+
+	logger.Info("aml re-screening completed",
+		slog.Int("score", result.RiskScore),
+		slog.String("level", string(newLevel)),
+		slog.Bool("escalated", len(reasons) > 0))
+	return nil
+}
+
+// freezeReason explains why the policy freezes the user's wallets, or returns "" when it does not.
+func (uc *RescreenRiskScoresUseCase) freezeReason(previousLevel, newLevel entities.RiskLevel, newSanctions bool) string {
+	if uc.wallets == nil {
+		return ""
+	}
+	if newSanctions && uc.policy.FreezeOnSanctions {
+		return "new sanctions hit"
+	}
+	threshold := uc.policy.FreezeAtLevel
+	if threshold != "" &&
+		entities.RiskLevelRank(newLevel) >= entities.RiskLevelRank(threshold) &&
+		entities.RiskLevelRank(previousLevel) < entities.RiskLevelRank(threshold) {
+		return fmt.Sprintf("risk level reached %s", newLevel)
+	}
+	return ""
+}
+
+// freezeWallets freezes the user's active wallets and returns the IDs it froze.
+func (uc *RescreenRiskScoresUseCase) freezeWallets(ctx context.Context, userID uuid.UUID, now time.Time) ([]string, error) {
+	active := entities.WalletStatusActive
+	wallets, err := uc.wallets.ListByUser(ctx, userID,
+		repositories.WalletFilter{Status: &active},
+		repositories.ListOptions{Limit: maxFrozenWalletsPerUser})
+	if err != nil {
+		return nil, err
+	}
+
+	frozen := make([]string, 0, len(wallets))
+	for _, wallet := range wallets {
+		if err := wallet.SetStatus(entities.WalletStatusFrozen); err != nil {
+			return frozen, err
+		}
+		wallet.Touch(now)
+		if err := uc.wallets.Update(ctx, wallet); err != nil {
+			return frozen, err
+		}
+		frozen = append(frozen, wallet.GetID().String())
+	}
+	return frozen, nil
+}
+
+func (uc *RescreenRiskScoresUseCase) recordFreeze(ctx context.Context, logger *slog.Logger, userID uuid.UUID, walletIDs []string, reason string) {
+	logger.Warn("aml escalation froze wallets", slog.Int("count", len(walletIDs)), slog.String("reason", reason))
+	if uc.audit == nil {
+		return
+	}
+	if err := uc.audit.Record(ctx, audit.Entry{
+		ActorID:  "system",
+		Action:   "aml_wallets_frozen",
+		TargetID: userID.String(),
+		Metadata: map[string]any{
+			"wallet_ids": walletIDs,
+			"reason":     reason,
+		},
+	}); err != nil {
+		logger.Warn("failed to record aml wallet freeze audit entry", slog.String("error", err.Error()))
+	}
+}
+
+func (uc *RescreenRiskScoresUseCase) recordEscalation(ctx context.Context, logger *slog.Logger, item *entities.ComplianceReviewItem) {
+	if uc.audit == nil {
+		return
+	}
+	if err := uc.audit.Record(ctx, audit.Entry{
+		ActorID:  "system",
+		Action:   "aml_rescreening_escalated",
+		TargetID: item.ID.String(),
+		Metadata: map[string]any{
+			"user_id":    item.UserID.String(),
+			"reason":     item.Reason,
+			"risk_level": string(item.RiskLevel),
+		},
+	}); err != nil {
+		logger.Warn("failed to record aml escalation audit entry", slog.String("error", err.Error()))
+	}
+}
+
+func isScreeningRiskFactor(factor string) bool {
+	for _, known := range screeningRiskFactors {
+		if factor == known {
+			return true
+		}
+	}
+	return false
+}
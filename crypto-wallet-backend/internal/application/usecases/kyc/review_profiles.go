@@ -0,0 +1,590 @@
+package kyc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+const (
+	// EventKYCApproved is published when compliance approves a profile.
+	EventKYCApproved = "kyc.approved"
+	// EventKYCRejected is published when compliance rejects a profile.
+	EventKYCRejected = "kyc.rejected"
+
+	defaultReviewPageSize = 50
+	maxReviewPageSize     = 200
+)
+
+// reviewableStatuses are the statuses a reviewer can approve or reject from.
+var reviewableStatuses = []entities.KYCStatus{entities.KYCStatusPending, entities.KYCStatusUnderReview}
+
+// ListProfilesForReviewInput filters the review queue. An empty status lists pending and
+// under-review profiles.
+type ListProfilesForReviewInput struct {
+	Status string
+	Limit  int
+	Offset int
+}
+
+// ListProfilesForReviewUseCase lists profiles awaiting a compliance decision.
+type ListProfilesForReviewUseCase struct {
+	repository repositories.KYCRepository
+	logger     *slog.Logger
+}
+
+// NewListProfilesForReviewUseCase constructs the use case.
+func NewListProfilesForReviewUseCase(repo repositories.KYCRepository, logger *slog.Logger) *ListProfilesForReviewUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ListProfilesForReviewUseCase{
+		repository: repo,
+		logger:     logger,
+	}
+}
+
+// Execute lists the queue, oldest submission first.
+func (uc *ListProfilesForReviewUseCase) Execute(ctx context.Context, input ListProfilesForReviewInput) ([]dto.KYCReviewProfile, error) {
+	if uc.repository == nil {
+		return nil, errors.New("list kyc reviews: repository not configured")
+	}
+
+	statuses := reviewableStatuses
+	if raw := strings.TrimSpace(input.Status); raw != "" {
+		status := entities.KYCStatus(raw)
+		if !isReviewQueueStatus(status) {
+			return nil, utils.NewAppError(
+				"INVALID_KYC_STATUS",
+				"status must be a valid kyc status",
+				http.StatusBadRequest,
+				nil,
+				map[string]any{"status": raw},
+			)
+		}
+		statuses = []entities.KYCStatus{status}
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultReviewPageSize
+	}
+	if limit > maxReviewPageSize {
+		limit = maxReviewPageSize
+	}
+
+	profiles, err := uc.repository.ListProfilesForReview(ctx, statuses, limit, input.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.KYCReviewProfile, 0, len(profiles))
+	for _, profile := range profiles {
+		items = append(items, dto.KYCReviewProfile{
+			UserID:  profile.GetUserID(),
+			Profile: dto.MapKYCProfile(profile),
+		})
+	}
+	return items, nil
+}
+
+// ReviewProfileInput identifies the reviewer and the user under review.
+type ReviewProfileInput struct {
+	ActorID string
+	UserID  string
+}
+
+// GetProfileForReviewUseCase returns a profile with its decrypted PII. Every view is audited and
+// PII is withheld when the audit entry cannot be written.
+type GetProfileForReviewUseCase struct {
+	repository repositories.KYCRepository
+	encryptor  PIICipher
+	audit      AuditLogger
+	logger     *slog.Logger
+}
+
+// NewGetProfileForReviewUseCase constructs the use case.
+func NewGetProfileForReviewUseCase(repo repositories.KYCRepository, encryptor PIICipher, auditLogger AuditLogger, logger *slog.Logger) *GetProfileForReviewUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GetProfileForReviewUseCase{
+		repository: repo,
+		encryptor:  encryptor,
+		audit:      auditLogger,
+		logger:     logger,
+	}
+}
+
+// Execute loads and decrypts the profile.
+func (uc *GetProfileForReviewUseCase) Execute(ctx context.Context, input ReviewProfileInput) (*dto.KYCReviewDetail, error) {
+	if uc.repository == nil {
+		return nil, errors.New("get kyc review: repository not configured")
+	}
+	if uc.encryptor == nil {
+		return nil, errors.New("get kyc review: encryptor not configured")
+	}
+	if uc.audit == nil {
+		return nil, errors.New("get kyc review: audit logger not configured")
+	}
+
+	actorID, err := parseUserID(input.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseUserID(input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := loadProfile(ctx, uc.repository, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	pii, err := decryptReviewPII(ctx, uc.encryptor, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	documents, err := uc.repository.ListDocumentsByProfile(ctx, profile.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	var riskScore *dto.RiskScore
+	if score, scoreErr := uc.repository.GetRiskScoreByUserID(ctx, userID); scoreErr == nil {
+		riskScore = dto.MapRiskScore(score)
+	} else if !errors.Is(scoreErr, repositories.ErrNotFound) {
+		return nil, scoreErr
+	}
+
+	if err := uc.audit.Record(ctx, audit.Entry{
+		ActorID:  actorID.String(),
+		Action:   "kyc_pii_viewed",
+		TargetID: userID.String(),
+		Metadata: map[string]any{
+			"profile_id": profile.GetID().String(),
+			"status":     string(profile.GetStatus()),
+		},
+	}); err != nil {
+		uc.logger.Error("failed to record kyc pii view; withholding pii",
+			slog.String("user_id", userID.String()),
+			slog.String("error", err.Error()))
+		return nil, utils.NewAppError(
+			"AUDIT_UNAVAILABLE",
+			"personal information cannot be shown while audit logging is unavailable",
+			http.StatusServiceUnavailable,
+			err,
+			nil,
+		)
+	}
+
+	detail := &dto.KYCReviewDetail{
+		UserID:    userID,
+		Profile:   dto.MapKYCProfile(profile),
+		PII:       pii,
+		Documents: make([]dto.KYCDocument, 0, len(documents)),
+		RiskScore: riskScore,
+	}
+	for _, document := range documents {
+		detail.Documents = append(detail.Documents, dto.MapKYCDocument(document))
+	}
+	return detail, nil
+}
+
+// reviewDecision holds what the approve, reject and upgrade use cases share.
+type reviewDecision struct {
+	repository repositories.KYCRepository
+	encryptor  PIICipher
+	audit      AuditLogger
+	notifier   Notifier
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+func newReviewDecision(repo repositories.KYCRepository, encryptor PIICipher, auditLogger AuditLogger, notifier Notifier, logger *slog.Logger) reviewDecision {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return reviewDecision{
+		repository: repo,
+		encryptor:  encryptor,
+		audit:      auditLogger,
+		notifier:   notifier,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// load parses the identifiers and loads the profile.
+func (d reviewDecision) load(ctx context.Context, actorRaw, userRaw string) (string, *entities.KYCProfileEntity, error) {
+	if d.repository == nil {
+		return "", nil, errors.New("kyc review: repository not configured")
+	}
+	actorID, err := parseUserID(actorRaw)
+	if err != nil {
+		return "", nil, err
+	}
+	userID, err := parseUserID(userRaw)
+	if err != nil {
+		return "", nil, err
+	}
+	profile, err := loadProfile(ctx, d.repository, userID)
+	if err != nil {
+		return "", nil, err
+	}
+	return actorID.String(), profile, nil
+}
+
+// countryRequirement resolves the compliance matrix entry for the profile's nationality.
+func (d reviewDecision) countryRequirement(ctx context.Context, profile entities.KYCProfile) (entities.KYCCountryRequirement, error) {
+	if d.encryptor == nil {
+		return entities.KYCCountryRequirement{}, errors.New("kyc review: encryptor not configured")
+	}
+	identity, err := decryptProfileIdentity(ctx, d.encryptor, profile)
+	if err != nil {
+		return entities.KYCCountryRequirement{}, err
+	}
+	return resolveCountryRequirement(ctx, d.repository, identity.Nationality)
+}
+
+// record audits a review decision with the profile's review state before and after it.
+func (d reviewDecision) record(ctx context.Context, actorID, action string, before map[string]any, profile entities.KYCProfile, metadata map[string]any) {
+	if d.audit == nil {
+		return
+	}
+	metadata["profile_id"] = profile.GetID().String()
+	if err := d.audit.Record(ctx, audit.Entry{
+		ActorID:      actorID,
+		Action:       action,
+		ResourceType: "kyc_profile",
+		TargetID:     profile.GetUserID().String(),
+		Metadata:     metadata,
+		Before:       before,
+		After:        reviewSnapshot(profile),
+	}); err != nil {
+		d.logger.Warn("failed to record kyc review audit entry", slog.String("action", action), slog.String("error", err.Error()))
+	}
+}
+
+// reviewSnapshot captures the profile fields a review decision may change.
+func reviewSnapshot(profile entities.KYCProfile) map[string]any {
+	return map[string]any{
+		"status":             string(profile.GetStatus()),
+		"verification_level": string(profile.GetVerificationLevel()),
+		"daily_limit_usd":    profile.GetDailyLimitUSD().String(),
+		"monthly_limit_usd":  profile.GetMonthlyLimitUSD().String(),
+	}
+}
+
+func (d reviewDecision) notify(ctx context.Context, event string, data map[string]any) {
+	if d.notifier == nil {
+		return
+	}
+	if err := d.notifier.Notify(ctx, event, data); err != nil {
+		d.logger.Warn("failed to notify user of kyc review decision", slog.String("event", event), slog.String("error", err.Error()))
+	}
+}
+
+// ApproveProfileInput carries an approval decision.
+type ApproveProfileInput struct {
+	ActorID string
+	UserID  string
+	Request dto.KYCApproveRequest
+}
+
+// ApproveProfileUseCase approves a pending or under-review profile at a verification level and
+// grants that level's limits, capped by the nationality's compliance matrix entry.
+type ApproveProfileUseCase struct {
+	reviewDecision
+}
+
+// NewApproveProfileUseCase constructs the use case.
+func NewApproveProfileUseCase(repo repositories.KYCRepository, encryptor PIICipher, auditLogger AuditLogger, notifier Notifier, logger *slog.Logger) *ApproveProfileUseCase {
+	return &ApproveProfileUseCase{reviewDecision: newReviewDecision(repo, encryptor, auditLogger, notifier, logger)}
+}
+
+// Execute approves the profile.
+func (uc *ApproveProfileUseCase) Execute(ctx context.Context, input ApproveProfileInput) (*dto.KYCProfile, error) {
+	if errs := input.Request.Validate(); !errs.IsEmpty() {
+		return nil, wrapValidationError(errs)
+	}
+
+	actorID, profile, err := uc.load(ctx, input.ActorID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !isReviewable(profile.GetStatus()) {
+		return nil, notReviewableError(profile.GetStatus())
+	}
+	before := reviewSnapshot(profile)
+
+	level := entities.VerificationLevel(strings.TrimSpace(input.Request.Level))
+	if level == "" {
+		level = entities.VerificationLevelBasic
+	}
+
+	requirement, err := uc.countryRequirement(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+	if requirement.Blocked {
+		return nil, countryBlockedError(requirement.CountryCode)
+	}
+
+	now := uc.now().UTC()
+	profile.MarkReviewed(now)
+	if err := profile.SetVerificationLevel(level); err != nil {
+		return nil, err
+	}
+	if err := profile.UpdateLimits(requirement.CapLimits(entities.KYCLimitsForLevel(level))); err != nil {
+		return nil, err
+	}
+	profile.MarkApproved(now)
+	if profile.GetStatus() != entities.KYCStatusApproved {
+		return nil, notReviewableError(profile.GetStatus())
+	}
+	if notes := strings.TrimSpace(input.Request.ReviewerNotes); notes != "" {
+		profile.SetReviewerNotes(notes)
+	}
+
+	if err := uc.repository.UpdateProfile(ctx, profile); err != nil {
+		return nil, err
+	}
+
+	uc.record(ctx, actorID, "kyc_profile_approved", before, profile, map[string]any{
+		"verification_level": string(level),
+		"daily_limit_usd":    profile.GetDailyLimitUSD().String(),
+		"monthly_limit_usd":  profile.GetMonthlyLimitUSD().String(),
+	})
+	uc.notify(ctx, EventKYCApproved, map[string]any{
+		"user_id":            profile.GetUserID().String(),
+		"verification_level": string(level),
+		"daily_limit_usd":    profile.GetDailyLimitUSD().String(),
+		"monthly_limit_usd":  profile.GetMonthlyLimitUSD().String(),
+	})
+
+	result := dto.MapKYCProfile(profile)
+	return &result, nil
+}
+
+// RejectProfileInput carries a rejection decision.
+type RejectProfileInput struct {
+	ActorID string
+	UserID  string
+	Request dto.KYCRejectRequest
+}
+
+// RejectProfileUseCase rejects a pending or under-review profile. The user may resubmit.
+type RejectProfileUseCase struct {
+	reviewDecision
+}
+
+// NewRejectProfileUseCase constructs the use case.
+func NewRejectProfileUseCase(repo repositories.KYCRepository, auditLogger AuditLogger, notifier Notifier, logger *slog.Logger) *RejectProfileUseCase {
+	return &RejectProfileUseCase{reviewDecision: newReviewDecision(repo, nil, auditLogger, notifier, logger)}
+}
+
+// Execute rejects the profile.
+func (uc *RejectProfileUseCase) Execute(ctx context.Context, input RejectProfileInput) (*dto.KYCProfile, error) {
+	if errs := input.Request.Validate(); !errs.IsEmpty() {
+		return nil, wrapValidationError(errs)
+	}
+
+	actorID, profile, err := uc.load(ctx, input.ActorID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !isReviewable(profile.GetStatus()) {
+		return nil, notReviewableError(profile.GetStatus())
+	}
+	before := reviewSnapshot(profile)
+
+	profile.MarkReviewed(uc.now().UTC())
+	profile.Reject(input.Request.Reason, input.Request.ReviewerNotes)
+	if profile.GetStatus() != entities.KYCStatusRejected {
+		return nil, notReviewableError(profile.GetStatus())
+	}
+
+	if err := uc.repository.UpdateProfile(ctx, profile); err != nil {
+		return nil, err
+	}
+
+	uc.record(ctx, actorID, "kyc_profile_rejected", before, profile, map[string]any{
+		"reason": profile.GetRejectionReason(),
+	})
+	uc.notify(ctx, EventKYCRejected, map[string]any{
+		"user_id": profile.GetUserID().String(),
+		"reason":  profile.GetRejectionReason(),
+		"action":  "resubmit",
+	})
+
+	result := dto.MapKYCProfile(profile)
+	return &result, nil
+}
+
+// UpgradeProfileLimitsInput carries a manual limit upgrade.
+type UpgradeProfileLimitsInput struct {
+	ActorID string
+	UserID  string
+	Request dto.KYCLimitUpgradeRequest
+}
+
+// UpgradeProfileLimitsUseCase raises an approved profile to a higher verification level and its
+// limits, capped by the nationality's compliance matrix entry.
+type UpgradeProfileLimitsUseCase struct {
+	reviewDecision
+}
+
+// NewUpgradeProfileLimitsUseCase constructs the use case.
+func NewUpgradeProfileLimitsUseCase(repo repositories.KYCRepository, encryptor PIICipher, auditLogger AuditLogger, notifier Notifier, logger *slog.Logger) *UpgradeProfileLimitsUseCase {
+	return &UpgradeProfileLimitsUseCase{reviewDecision: newReviewDecision(repo, encryptor, auditLogger, notifier, logger)}
+}
+
+// Execute upgrades the profile.
+func (uc *UpgradeProfileLimitsUseCase) Execute(ctx context.Context, input UpgradeProfileLimitsInput) (*dto.KYCProfile, error) {
+	if errs := input.Request.Validate(); !errs.IsEmpty() {
+		return nil, wrapValidationError(errs)
+	}
+
+	actorID, profile, err := uc.load(ctx, input.ActorID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	previousLevel := profile.GetVerificationLevel()
+	before := reviewSnapshot(profile)
+
+	requirement, err := uc.countryRequirement(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+	if requirement.Blocked {
+		return nil, countryBlockedError(requirement.CountryCode)
+	}
+
+	if err := profile.UpgradeVerificationLevel(entities.VerificationLevel(input.Request.Level), uc.now().UTC()); err != nil {
+		return nil, utils.NewAppError(
+			"KYC_UPGRADE_NOT_ALLOWED",
+			"only approved profiles can be raised to a higher verification level",
+			http.StatusConflict,
+			err,
+			map[string]any{
+				"status":             string(profile.GetStatus()),
+				"verification_level": string(previousLevel),
+			},
+		)
+	}
+	if err := profile.UpdateLimits(requirement.CapLimits(profile.GetDailyLimitUSD(), profile.GetMonthlyLimitUSD())); err != nil {
+		return nil, err
+	}
+	if notes := strings.TrimSpace(input.Request.ReviewerNotes); notes != "" {
+		profile.SetReviewerNotes(notes)
+	}
+
+	if err := uc.repository.UpdateProfile(ctx, profile); err != nil {
+		return nil, err
+	}
+
+	uc.record(ctx, actorID, "kyc_limits_upgraded", before, profile, map[string]any{
+		"previous_level":    string(previousLevel),
+		"new_level":         string(profile.GetVerificationLevel()),
+		"daily_limit_usd":   profile.GetDailyLimitUSD().String(),
+		"monthly_limit_usd": profile.GetMonthlyLimitUSD().String(),
+	})
+	uc.notify(ctx, EventKYCUpgradeApproved, map[string]any{
+		"user_id":            profile.GetUserID().String(),
+		"verification_level": string(profile.GetVerificationLevel()),
+		"daily_limit_usd":    profile.GetDailyLimitUSD().String(),
+		"monthly_limit_usd":  profile.GetMonthlyLimitUSD().String(),
+	})
+
+	result := dto.MapKYCProfile(profile)
+	return &result, nil
+}
+
+func decryptReviewPII(ctx context.Context, encryptor PIICipher, profile entities.KYCProfile) (dto.KYCReviewPII, error) {
+	identity, err := decryptProfileIdentity(ctx, encryptor, profile)
+	if err != nil {
+		return dto.KYCReviewPII{}, err
+	}
+	pii := dto.KYCReviewPII{
+		FirstName:   identity.FirstName,
+		LastName:    identity.LastName,
+		DateOfBirth: identity.DateOfBirth,
+		Nationality: identity.Nationality,
+	}
+
+	if value := profile.GetEncryptedDocumentNumber(); value != "" {
+		plain, err := encryptor.Decrypt(ctx, profile.GetUserID(), value)
+		if err != nil {
+			return dto.KYCReviewPII{}, piiDecryptionError("document number", err)
+		}
+		pii.DocumentNumber = string(plain)
+	}
+	if value := profile.GetEncryptedAddress(); value != "" {
+		plain, err := encryptor.Decrypt(ctx, profile.GetUserID(), value)
+		if err != nil {
+			return dto.KYCReviewPII{}, piiDecryptionError("address", err)
+		}
+		var address dto.KYCAddress
+		if err := json.Unmarshal(plain, &address); err != nil {
+			return dto.KYCReviewPII{}, piiDecryptionError("address", err)
+		}
+		pii.Address = &address
+	}
+	return pii, nil
+}
+
+func piiDecryptionError(field string, err error) error {
+	return utils.NewAppError(
+		"KYC_ENCRYPTION_ERROR",
+		"failed to read protected information",
+		http.StatusInternalServerError,
+		err,
+		map[string]any{"field": field},
+	)
+}
+
+func isReviewable(status entities.KYCStatus) bool {
+	for _, reviewable := range reviewableStatuses {
+		if status == reviewable {
+			return true
+		}
+	}
+	return false
+}
+
+func isReviewQueueStatus(status entities.KYCStatus) bool {
+	switch status {
+	case entities.KYCStatusNotStarted,
+		entities.KYCStatusPending,
+		entities.KYCStatusUnderReview,
+		entities.KYCStatusApproved,
+		entities.KYCStatusRejected,
+		entities.KYCStatusExpired:
+		return true
+	default:
+		return false
+	}
+}
+
+func notReviewableError(status entities.KYCStatus) error {
+	return utils.NewAppError(
+		"KYC_PROFILE_NOT_REVIEWABLE",
+		"only pending or under-review profiles can be approved or rejected",
+		http.StatusConflict,
+		nil,
+		map[string]any{"status": string(status)},
+	)
+}
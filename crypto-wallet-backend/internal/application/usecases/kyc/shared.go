@@ -0,0 +1,276 @@
+package kyc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+const (
+	// EventKYCExpired is published when an approval lapses and the user must re-verify.
+	EventKYCExpired = "kyc.expired"
+	// EventKYCUpgradeApproved is published when a user moves up a verification level.
+	EventKYCUpgradeApproved = "kyc.upgrade.approved"
+	// EventKYCUpgradeRejected is published when an upgrade is declined; the user keeps their level.
+	EventKYCUpgradeRejected = "kyc.upgrade.rejected"
+)
+
+// upgradeRequiredDocuments lists the documents needed on top of a basic verification to reach full.
+var upgradeRequiredDocuments = []entities.DocumentType{entities.DocumentTypeProofOfAddress}
+
+// Notifier delivers user-facing notifications.
+type Notifier interface {
+	Notify(ctx context.Context, event string, data map[string]any) error
+}
+
+// AuditLogger captures audit events for compliance.
+type AuditLogger interface {
+	Record(ctx context.Context, entry audit.Entry) error
+}
+
+// PIICipher encrypts personal data under a key scoped to the user it belongs to, so destroying
+// that key makes the user's ciphertexts unreadable. security.UserKeyCipher satisfies it.
+type PIICipher interface {
+	Encrypt(ctx context.Context, userID uuid.UUID, plaintext []byte) (string, error)
+	Decrypt(ctx context.Context, userID uuid.UUID, ciphertext string) ([]byte, error)
+}
+
+// DocumentStore holds encrypted document content. The objectstore S3, GCS and local stores
+// satisfy it.
+type DocumentStore interface {
+	Upload(ctx context.Context, key, contentType string, body io.Reader, size int64) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// WalletStore loads and updates a user's wallets so AML escalation can freeze them.
+type WalletStore interface {
+	ListByUser(ctx context.Context, userID uuid.UUID, filter repositories.WalletFilter, opts repositories.ListOptions) ([]entities.Wallet, error)
+	Update(ctx context.Context, wallet entities.Wallet) error
+}
+
+// storedDocument locates a document's content in the DocumentStore. It is kept PII-encrypted in
+// the document's file path, so destroying the user's key also destroys the document's data key.
+type storedDocument struct {
+	Key     string `json:"key"`
+	DataKey []byte `json:"dataKey"`
+}
+
+func parseUserID(raw string) (uuid.UUID, error) {
+	userID, err := uuid.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return uuid.Nil, utils.NewAppError(
+			"INVALID_USER_ID",
+			"user id must be a valid uuid",
+			fiber.StatusBadRequest,
+			err,
+			nil,
+		)
+	}
+	return userID, nil
+}
+
+func loadProfile(ctx context.Context, repo repositories.KYCRepository, userID uuid.UUID) (*entities.KYCProfileEntity, error) {
+	profile, err := repo.GetProfileByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, utils.NewAppError(
+				"KYC_PROFILE_MISSING",
+				"kyc profile not found",
+				http.StatusNotFound,
+				err,
+				nil,
+			)
+		}
+		return nil, err
+	}
+	entity, ok := profile.(*entities.KYCProfileEntity)
+	if !ok {
+		return nil, errors.New("kyc: unexpected profile implementation")
+	}
+	return entity, nil
+}
+
+func loadLatestUpgrade(ctx context.Context, repo repositories.KYCRepository, userID uuid.UUID) (*entities.KYCUpgradeRequestEntity, error) {
+	upgrade, err := repo.GetLatestUpgradeRequest(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, utils.NewAppError(
+				"KYC_UPGRADE_NOT_FOUND",
+				"no verification upgrade has been started",
+				http.StatusNotFound,
+				err,
+				nil,
+			)
+		}
+		return nil, err
+	}
+	entity, ok := upgrade.(*entities.KYCUpgradeRequestEntity)
+	if !ok {
+		return nil, errors.New("kyc: unexpected upgrade request implementation")
+	}
+	return entity, nil
+}
+
+// missingUpgradeDocuments reports which required documents have not been uploaded since the
+// upgrade started. Rejected uploads do not count.
+func missingUpgradeDocuments(ctx context.Context, repo repositories.KYCRepository, upgrade entities.KYCUpgradeRequest) ([]entities.DocumentType, error) {
+	documents, err := repo.ListDocumentsByProfile(ctx, upgrade.GetProfileID())
+	if err != nil {
+		return nil, err
+	}
+
+	uploaded := make(map[entities.DocumentType]bool, len(documents))
+	for _, document := range documents {
+		if document.GetStatus() == entities.DocumentStatusRejected || document.GetUploadedAt().Before(upgrade.GetCreatedAt()) {
+			continue
+		}
+		uploaded[document.GetDocumentType()] = true
+	}
+
+	missing := make([]entities.DocumentType, 0)
+	for _, docType := range upgrade.GetRequiredDocuments() {
+		if !uploaded[docType] {
+			missing = append(missing, docType)
+		}
+	}
+	return missing, nil
+}
+
+func upgradeResponse(ctx context.Context, repo repositories.KYCRepository, profile entities.KYCProfile, upgrade entities.KYCUpgradeRequest) (*dto.KYCUpgradeResponse, error) {
+	missing, err := missingUpgradeDocuments(ctx, repo, upgrade)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.KYCUpgradeResponse{
+		Profile: dto.MapKYCProfile(profile),
+		Upgrade: dto.MapKYCUpgrade(upgrade, missing),
+	}, nil
+}
+
+// profileIdentity is the decrypted identity on a KYC profile, as sent to screening providers.
+type profileIdentity struct {
+	FirstName   string
+	LastName    string
+	DateOfBirth string
+	Nationality string
+}
+
+func decryptProfileIdentity(ctx context.Context, encryptor PIICipher, profile entities.KYCProfile) (profileIdentity, error) {
+	decrypt := func(field, value string) (string, error) {
+		if value == "" {
+			return "", nil
+		}
+		plain, err := encryptor.Decrypt(ctx, profile.GetUserID(), value)
+		if err != nil {
+			return "", utils.NewAppError(
+				"KYC_ENCRYPTION_ERROR",
+				"failed to read protected information",
+				http.StatusInternalServerError,
+				err,
+				map[string]any{"field": field},
+			)
+		}
+		return string(plain), nil
+	}
+
+	var (
+		identity profileIdentity
+		err      error
+	)
+	if identity.FirstName, err = decrypt("first name", profile.GetEncryptedFirstName()); err != nil {
+		return profileIdentity{}, err
+	}
+	if identity.LastName, err = decrypt("last name", profile.GetEncryptedLastName()); err != nil {
+		return profileIdentity{}, err
+	}
+	if identity.DateOfBirth, err = decrypt("date of birth", profile.GetEncryptedDateOfBirth()); err != nil {
+		return profileIdentity{}, err
+	}
+	if identity.Nationality, err = decrypt("nationality", profile.GetEncryptedNationality()); err != nil {
+		return profileIdentity{}, err
+	}
+	return identity, nil
+}
+
+// resolveCountryRequirement looks up the compliance matrix entry for a country, falling back to
+// the * entry and then to the built-in default.
+func resolveCountryRequirement(ctx context.Context, repo repositories.KYCRepository, countryCode string) (entities.KYCCountryRequirement, error) {
+	countryCode = entities.NormaliseCountryCode(countryCode)
+	for _, code := range []string{countryCode, entities.KYCDefaultCountryCode} {
+		if code == "" {
+			continue
+		}
+		requirement, err := repo.GetCountryRequirement(ctx, code)
+		if err == nil {
+			requirement.CountryCode = countryCode
+			return requirement, nil
+		}
+		if !errors.Is(err, repositories.ErrNotFound) {
+			return entities.KYCCountryRequirement{}, err
+		}
+	}
+	return entities.DefaultKYCCountryRequirement(countryCode), nil
+}
+
+func countryBlockedError(countryCode string) error {
+	return utils.NewAppError(
+		"KYC_COUNTRY_BLOCKED",
+		"verification is not available for this nationality",
+		http.StatusForbidden,
+		nil,
+		map[string]any{"country": countryCode},
+	)
+}
+
+func wrapValidationError(errs utils.ValidationErrors) error {
+	if errs.IsEmpty() {
+		return nil
+	}
+	return utils.NewAppError(
+		"VALIDATION_ERROR",
+		"kyc request invalid",
+		fiber.StatusBadRequest,
+		errs,
+		map[string]any{"errors": errs},
+	)
+}
+
+// resolveDocumentTypeSpecs merges stored document type rules over the built-in defaults. Stored rules
+// that fail validation, or a failed lookup, leave the defaults in place so uploads keep working.
+func resolveDocumentTypeSpecs(ctx context.Context, repo repositories.KYCRepository, logger *slog.Logger) []entities.KYCDocumentTypeSpec {
+	specs := entities.DefaultKYCDocumentTypeSpecs()
+
+	stored, err := repo.ListDocumentTypeSpecs(ctx)
+	if err != nil {
+		logger.Warn("failed to load kyc document type specs; using defaults", slog.String("error", err.Error()))
+		return specs
+	}
+
+	for _, override := range stored {
+		if err := override.Validate(); err != nil {
+			logger.Warn("ignoring invalid kyc document type spec",
+				slog.String("document_type", string(override.DocumentType)),
+				slog.String("error", err.Error()))
+			continue
+		}
+		for i := range specs {
+			if specs[i].DocumentType == override.DocumentType {
+				specs[i] = override
+			}
+		}
+	}
+	return specs
+}
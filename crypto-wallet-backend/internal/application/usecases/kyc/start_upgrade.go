@@ -0,0 +1,127 @@
+package kyc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// StartUpgradeInput encapsulates the arguments required to start an upgrade.
+type StartUpgradeInput struct {
+	UserID string
+}
+
+// StartUpgradeUseCase opens a basic → full verification upgrade for an approved basic profile.
+// The profile itself is left unchanged until the upgrade is approved.
+type StartUpgradeUseCase struct {
+	repository repositories.KYCRepository
+	audit      AuditLogger
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+// NewStartUpgradeUseCase constructs the use case.
+func NewStartUpgradeUseCase(repo repositories.KYCRepository, auditLogger AuditLogger, logger *slog.Logger) *StartUpgradeUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &StartUpgradeUseCase{
+		repository: repo,
+		audit:      auditLogger,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// Execute starts the upgrade. If one is already open it is returned instead.
+func (uc *StartUpgradeUseCase) Execute(ctx context.Context, input StartUpgradeInput) (*dto.KYCUpgradeResponse, error) {
+	if uc.repository == nil {
+		return nil, errors.New("start kyc upgrade: repository not configured")
+	}
+
+	userID, err := parseUserID(input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := loadProfile(ctx, uc.repository, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing, err := uc.repository.GetLatestUpgradeRequest(ctx, userID); err == nil && existing.IsOpen() {
+		return upgradeResponse(ctx, uc.repository, profile, existing)
+	} else if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+		return nil, err
+	}
+
+	if profile.GetStatus() != entities.KYCStatusApproved || profile.GetVerificationLevel() != entities.VerificationLevelBasic {
+		return nil, utils.NewAppError(
+			"KYC_UPGRADE_NOT_ELIGIBLE",
+			"only approved basic verifications can be upgraded",
+			http.StatusConflict,
+			nil,
+			map[string]any{
+				"status":            string(profile.GetStatus()),
+				"verificationLevel": string(profile.GetVerificationLevel()),
+			},
+		)
+	}
+
+	now := uc.now().UTC()
+	upgrade, err := entities.NewKYCUpgradeRequestEntity(entities.KYCUpgradeRequestParams{
+		ProfileID:         profile.GetID(),
+		UserID:            userID,
+		FromLevel:         profile.GetVerificationLevel(),
+		TargetLevel:       entities.VerificationLevelFull,
+		RequiredDocuments: upgradeRequiredDocuments,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	})
+	if err != nil {
+		return nil, utils.NewAppError(
+			"KYC_UPGRADE_INVALID",
+			"failed to start verification upgrade",
+			http.StatusInternalServerError,
+			err,
+			nil,
+		)
+	}
+
+	if err := uc.repository.CreateUpgradeRequest(ctx, upgrade); err != nil {
+		if errors.Is(err, repositories.ErrDuplicate) {
+			// A concurrent request opened the upgrade first.
+			existing, loadErr := loadLatestUpgrade(ctx, uc.repository, userID)
+			if loadErr != nil {
+				return nil, loadErr
+			}
+			return upgradeResponse(ctx, uc.repository, profile, existing)
+		}
+		return nil, err
+	}
+
+	if uc.audit != nil {
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID:  userID.String(),
+			Action:   "kyc_upgrade_started",
+			TargetID: upgrade.GetID().String(),
+			Metadata: map[string]any{
+				"profile_id":   profile.GetID().String(),
+				"from_level":   string(upgrade.GetFromLevel()),
+				"target_level": string(upgrade.GetTargetLevel()),
+			},
+		}); err != nil {
+			uc.logger.Warn("failed to record kyc upgrade audit entry", slog.String("error", err.Error()))
+		}
+	}
+
+	return upgradeResponse(ctx, uc.repository, profile, upgrade)
+}
@@ -11,13 +11,11 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
-	"github.com/shopspring/decimal"
 
 	"github.com/crypto-wallet/backend/internal/application/dto"
 	"github.com/crypto-wallet/backend/internal/domain/entities"
 	"github.com/crypto-wallet/backend/internal/domain/repositories"
 	"github.com/crypto-wallet/backend/internal/infrastructure/external"
-	"github.com/crypto-wallet/backend/internal/infrastructure/security"
 	"github.com/crypto-wallet/backend/pkg/utils"
 )
 
@@ -31,7 +29,7 @@ type SubmitKYCInput struct {
 // SubmitKYCUseCase coordinates KYC submission orchestration.
 type SubmitKYCUseCase struct {
 	repository repositories.KYCRepository
-	encryptor  *security.AESGCMEncryptor
+	encryptor  PIICipher
 	provider   external.KYCProviderClient
 	logger     *slog.Logger
 	now        func() time.Time
@@ -40,7 +38,7 @@ type SubmitKYCUseCase struct {
 // NewSubmitKYCUseCase constructs a SubmitKYCUseCase.
 func NewSubmitKYCUseCase(
 	repo repositories.KYCRepository,
-	encryptor *security.AESGCMEncryptor,
+	encryptor PIICipher,
 	provider external.KYCProviderClient,
 	logger *slog.Logger,
 ) *SubmitKYCUseCase {
@@ -87,6 +85,14 @@ func (uc *SubmitKYCUseCase) Execute(ctx context.Context, input SubmitKYCInput) (
 	}
 
 	nationality := strings.ToUpper(strings.TrimSpace(input.Payload.Nationality))
+	requirement, err := resolveCountryRequirement(ctx, uc.repository, nationality)
+	if err != nil {
+		return dto.KYCProfile{}, err
+	}
+	if requirement.Blocked {
+		return dto.KYCProfile{}, countryBlockedError(nationality)
+	}
+
 	addressBytes, err := json.Marshal(input.Payload.Address)
 	if err != nil {
 		return dto.KYCProfile{}, utils.NewAppError(
@@ -99,23 +105,24 @@ func (uc *SubmitKYCUseCase) Execute(ctx context.Context, input SubmitKYCInput) (
 	}
 
 	now := uc.now().UTC()
-	encryptedFirstName, err := uc.encryptor.EncryptToString([]byte(strings.TrimSpace(input.Payload.FirstName)), []byte(userID.String()))
+	dailyLimit, monthlyLimit := requirement.CapLimits(entities.KYCLimitsForLevel(entities.VerificationLevelBasic))
+	encryptedFirstName, err := uc.encryptor.Encrypt(ctx, userID, []byte(strings.TrimSpace(input.Payload.FirstName)))
 	if err != nil {
 		return dto.KYCProfile{}, uc.wrapEncryptionError("first name", err)
 	}
-	encryptedLastName, err := uc.encryptor.EncryptToString([]byte(strings.TrimSpace(input.Payload.LastName)), []byte(userID.String()))
+	encryptedLastName, err := uc.encryptor.Encrypt(ctx, userID, []byte(strings.TrimSpace(input.Payload.LastName)))
 	if err != nil {
 		return dto.KYCProfile{}, uc.wrapEncryptionError("last name", err)
 	}
-	encryptedDOB, err := uc.encryptor.EncryptToString([]byte(strings.TrimSpace(input.Payload.DateOfBirth)), []byte(userID.String()))
+	encryptedDOB, err := uc.encryptor.Encrypt(ctx, userID, []byte(strings.TrimSpace(input.Payload.DateOfBirth)))
 	if err != nil {
 		return dto.KYCProfile{}, uc.wrapEncryptionError("date of birth", err)
 	}
-	encryptedNationality, err := uc.encryptor.EncryptToString([]byte(nationality), []byte(userID.String()))
+	encryptedNationality, err := uc.encryptor.Encrypt(ctx, userID, []byte(nationality))
 	if err != nil {
 		return dto.KYCProfile{}, uc.wrapEncryptionError("nationality", err)
 	}
-	encryptedAddress, err := uc.encryptor.EncryptToString(addressBytes, []byte(userID.String()))
+	encryptedAddress, err := uc.encryptor.Encrypt(ctx, userID, addressBytes)
 	if err != nil {
 		return dto.KYCProfile{}, uc.wrapEncryptionError("address", err)
 	}
@@ -134,8 +141,8 @@ func (uc *SubmitKYCUseCase) Execute(ctx context.Context, input SubmitKYCInput) (
 				NationalityEncrypted:   encryptedNationality,
 				AddressEncrypted:       encryptedAddress,
 				SubmittedAt:            &now,
-				DailyLimitUSD:          decimal.NewFromInt(5000),
-				MonthlyLimitUSD:        decimal.NewFromInt(50000),
+				DailyLimitUSD:          dailyLimit,
+				MonthlyLimitUSD:        monthlyLimit,
 				CreatedAt:              now,
 				UpdatedAt:              now,
 			})
@@ -153,7 +160,7 @@ func (uc *SubmitKYCUseCase) Execute(ctx context.Context, input SubmitKYCInput) (
 		entity.UpdatePII(encryptedFirstName, encryptedLastName, encryptedDOB, encryptedNationality, "", encryptedAddress)
 		entity.MarkSubmitted(now)
 		_ = entity.SetVerificationLevel(entities.VerificationLevelBasic)
-		_ = entity.UpdateLimits(decimal.NewFromInt(5000), decimal.NewFromInt(50000))
+		_ = entity.UpdateLimits(dailyLimit, monthlyLimit)
 		if err := uc.repository.UpdateProfile(ctx, entity); err != nil {
 			return dto.KYCProfile{}, err
 		}
@@ -0,0 +1,203 @@
+package kyc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/internal/infrastructure/external"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// SubmitUpgradeInput encapsulates the arguments required to submit an upgrade for review.
+type SubmitUpgradeInput struct {
+	UserID string
+	Email  string
+}
+
+// SubmitUpgradeUseCase sends an upgrade with all required documents for provider re-screening.
+// Without a provider the upgrade waits for manual review.
+type SubmitUpgradeUseCase struct {
+	repository repositories.KYCRepository
+	encryptor  PIICipher
+	provider   external.KYCProviderClient
+	audit      AuditLogger
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+// NewSubmitUpgradeUseCase constructs the use case.
+func NewSubmitUpgradeUseCase(
+	repo repositories.KYCRepository,
+	encryptor PIICipher,
+	provider external.KYCProviderClient,
+	auditLogger AuditLogger,
+	logger *slog.Logger,
+) *SubmitUpgradeUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SubmitUpgradeUseCase{
+		repository: repo,
+		encryptor:  encryptor,
+		provider:   provider,
+		audit:      auditLogger,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// Execute checks the required documents are in and moves the upgrade to review.
+func (uc *SubmitUpgradeUseCase) Execute(ctx context.Context, input SubmitUpgradeInput) (*dto.KYCUpgradeResponse, error) {
+	if uc.repository == nil {
+		return nil, errors.New("submit kyc upgrade: repository not configured")
+	}
+
+	userID, err := parseUserID(input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := loadProfile(ctx, uc.repository, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	upgrade, err := loadLatestUpgrade(ctx, uc.repository, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch upgrade.GetStatus() {
+	case entities.KYCUpgradeStatusUnderReview:
+		return upgradeResponse(ctx, uc.repository, profile, upgrade)
+	case entities.KYCUpgradeStatusAwaitingDocuments:
+	default:
+		return nil, utils.NewAppError(
+			"KYC_UPGRADE_CLOSED",
+			"the latest verification upgrade has already been decided",
+			http.StatusConflict,
+			nil,
+			map[string]any{"status": string(upgrade.GetStatus())},
+		)
+	}
+
+	if profile.GetStatus() != entities.KYCStatusApproved {
+		return nil, utils.NewAppError(
+			"KYC_UPGRADE_NOT_ELIGIBLE",
+			"only approved verifications can be upgraded",
+			http.StatusConflict,
+			nil,
+			map[string]any{"status": string(profile.GetStatus())},
+		)
+	}
+
+	missing, err := missingUpgradeDocuments(ctx, uc.repository, upgrade)
+	if err != nil {
+		return nil, err
+	}
+	if len(missing) > 0 {
+		missingTypes := make([]string, 0, len(missing))
+		for _, docType := range missing {
+			missingTypes = append(missingTypes, string(docType))
+		}
+		return nil, utils.NewAppError(
+			"KYC_UPGRADE_DOCUMENTS_MISSING",
+			"upload the required documents before submitting the upgrade",
+			http.StatusUnprocessableEntity,
+			nil,
+			map[string]any{"missingDocuments": missingTypes},
+		)
+	}
+
+	now := uc.now().UTC()
+	applicationID := ""
+	if uc.provider != nil {
+		applicationID, err = uc.rescreen(ctx, input, profile, upgrade, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := upgrade.MarkSubmitted(applicationID, now); err != nil {
+		return nil, utils.NewAppError(
+			"KYC_UPGRADE_CLOSED",
+			"the verification upgrade cannot be submitted",
+			http.StatusConflict,
+			err,
+			nil,
+		)
+	}
+	if err := uc.repository.UpdateUpgradeRequest(ctx, upgrade); err != nil {
+		return nil, err
+	}
+
+	if uc.audit != nil {
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID:  userID.String(),
+			Action:   "kyc_upgrade_submitted",
+			TargetID: upgrade.GetID().String(),
+			Metadata: map[string]any{
+				"profile_id":              profile.GetID().String(),
+				"target_level":            string(upgrade.GetTargetLevel()),
+				"provider_application_id": applicationID,
+			},
+		}); err != nil {
+			uc.logger.Warn("failed to record kyc upgrade audit entry", slog.String("error", err.Error()))
+		}
+	}
+
+	return upgradeResponse(ctx, uc.repository, profile, upgrade)
+}
+
+// rescreen opens a provider application at the target level using the identity already on file.
+func (uc *SubmitUpgradeUseCase) rescreen(
+	ctx context.Context,
+	input SubmitUpgradeInput,
+	profile entities.KYCProfile,
+	upgrade entities.KYCUpgradeRequest,
+	now time.Time,
+) (string, error) {
+	if uc.encryptor == nil {
+		return "", errors.New("submit kyc upgrade: encryptor not configured")
+	}
+
+	identity, err := decryptProfileIdentity(ctx, uc.encryptor, profile)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := uc.provider.SubmitApplication(ctx, external.KYCSubmissionPayload{
+		ExternalUserID: profile.GetUserID().String(),
+		Email:          strings.TrimSpace(input.Email),
+		FirstName:      identity.FirstName,
+		LastName:       identity.LastName,
+		DateOfBirth:    identity.DateOfBirth,
+		Nationality:    identity.Nationality,
+		Metadata: map[string]string{
+			"submitted_at":  now.Format(time.RFC3339),
+			"level":         string(upgrade.GetTargetLevel()),
+			"upgrade_id":    upgrade.GetID().String(),
+			"current_level": string(upgrade.GetFromLevel()),
+		},
+	})
+	if err != nil {
+		uc.logger.Warn("kyc provider re-screening failed", slog.String("error", err.Error()))
+		return "", utils.NewAppError(
+			"KYC_PROVIDER_UNAVAILABLE",
+			"verification provider is unavailable, please retry",
+			http.StatusBadGateway,
+			err,
+			nil,
+		)
+	}
+
+	return strings.TrimSpace(result.ApplicationID), nil
+}
@@ -1,10 +1,14 @@
 package kyc
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"strings"
@@ -16,6 +20,7 @@ import (
 	"github.com/crypto-wallet/backend/internal/application/dto"
 	"github.com/crypto-wallet/backend/internal/domain/entities"
 	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
 	"github.com/crypto-wallet/backend/internal/infrastructure/external"
 	"github.com/crypto-wallet/backend/internal/infrastructure/security"
 	"github.com/crypto-wallet/backend/pkg/utils"
@@ -28,22 +33,35 @@ type UploadDocumentInput struct {
 	FileName     string
 	MimeType     string
 	Content      []byte
+	// Side identifies the image for double-sided or selfie-with-document types; defaults to front.
+	Side string
 }
 
+// documentKeyPrefix namespaces document objects in the DocumentStore.
+const documentKeyPrefix = "kyc-documents"
+
 // UploadDocumentUseCase handles verification document uploads.
 type UploadDocumentUseCase struct {
 	repository repositories.KYCRepository
-	encryptor  *security.AESGCMEncryptor
+	encryptor  PIICipher
+	store      DocumentStore
+	scanner    external.MalwareScanner
 	provider   external.KYCProviderClient
+	audit      AuditLogger
 	logger     *slog.Logger
 	now        func() time.Time
 }
 
-// NewUploadDocumentUseCase constructs an UploadDocumentUseCase.
+// NewUploadDocumentUseCase constructs an UploadDocumentUseCase. When store is set, document content
+// is encrypted under a per-document key and kept there; otherwise only its hash is recorded. When
+// scanner is set, uploads are held in quarantine until they pass a malware scan.
 func NewUploadDocumentUseCase(
 	repo repositories.KYCRepository,
-	encryptor *security.AESGCMEncryptor,
+	encryptor PIICipher,
+	store DocumentStore,
+	scanner external.MalwareScanner,
 	provider external.KYCProviderClient,
+	auditLogger AuditLogger,
 	logger *slog.Logger,
 ) *UploadDocumentUseCase {
 	if logger == nil {
@@ -52,7 +70,10 @@ func NewUploadDocumentUseCase(
 	return &UploadDocumentUseCase{
 		repository: repo,
 		encryptor:  encryptor,
+		store:      store,
+		scanner:    scanner,
 		provider:   provider,
+		audit:      auditLogger,
 		logger:     logger,
 		now:        time.Now,
 	}
@@ -78,14 +99,16 @@ func (uc *UploadDocumentUseCase) Execute(ctx context.Context, input UploadDocume
 	}
 
 	docType := entities.DocumentType(strings.TrimSpace(input.DocumentType))
-	if !isSupportedDocumentType(docType) {
-		return nil, utils.NewAppError(
-			"DOCUMENT_TYPE_INVALID",
-			"document type is not supported",
-			fiber.StatusBadRequest,
-			nil,
-			map[string]any{"documentType": input.DocumentType},
-		)
+	side := entities.KYCDocumentSide(strings.ToLower(strings.TrimSpace(input.Side)))
+	if side == "" {
+		side = entities.KYCDocumentSideFront
+	}
+	mimeType := strings.TrimSpace(input.MimeType)
+	if mimeType == "" || mimeType == "application/octet-stream" {
+		mimeType = http.DetectContentType(input.Content)
+	}
+	if err := uc.checkDocumentSpec(ctx, docType, side, mimeType, len(input.Content)); err != nil {
+		return nil, err
 	}
 
 	userID, err := uuid.Parse(strings.TrimSpace(input.UserID))
@@ -117,11 +140,49 @@ func (uc *UploadDocumentUseCase) Execute(ctx context.Context, input UploadDocume
 	hash := sha256.Sum256(input.Content)
 	hashHex := hex.EncodeToString(hash[:])
 
-	encryptedFileName, err := uc.encryptor.EncryptToString([]byte(strings.TrimSpace(input.FileName)), []byte(userID.String()))
+	encryptedFileName, err := uc.encryptor.Encrypt(ctx, userID, []byte(strings.TrimSpace(input.FileName)))
 	if err != nil {
 		return nil, uc.wrapEncryptionError("file name", err)
 	}
 
+	documentID := uuid.New()
+	metadata := map[string]any{
+		"originalFileName": strings.TrimSpace(input.FileName),
+		"mimeType":         mimeType,
+		"side":             string(side),
+		"hash":             hashHex,
+	}
+	params := entities.KYCDocumentParams{
+		ID:                documentID,
+		KYCProfileID:      profile.GetID(),
+		DocumentType:      docType,
+		FileNameEncrypted: encryptedFileName,
+		FileSizeBytes:     len(input.Content),
+		FileHash:          hashHex,
+		MimeType:          mimeType,
+		Status:            entities.DocumentStatusPending,
+		UploadedAt:        now,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+		Metadata:          metadata,
+	}
+
+	// With a scanner the upload is recorded as quarantined, and nothing reaches the provider or
+	// storage until the scanner clears it.
+	var entity *entities.KYCDocumentEntity
+	completed := false
+	if uc.scanner != nil {
+		entity, err = uc.scan(ctx, userID, params, input.Content)
+		if err != nil {
+			return nil, err
+		}
+		defer func(quarantined *entities.KYCDocumentEntity) {
+			if !completed {
+				uc.abandon(quarantined)
+			}
+		}(entity)
+	}
+
 	remotePath := hashHex
 	var providerResult *external.KYCDocumentUploadResult
 	if uc.provider != nil {
@@ -138,47 +199,57 @@ func (uc *UploadDocumentUseCase) Execute(ctx context.Context, input UploadDocume
 			providerResult = result
 			if strings.TrimSpace(result.DocumentID) != "" {
 				remotePath = result.DocumentID
+				metadata["providerDocumentId"] = result.DocumentID
 			}
 		}
 	}
 
-	encryptedPath, err := uc.encryptor.EncryptToString([]byte(remotePath), []byte(userID.String()))
-	if err != nil {
-		return nil, uc.wrapEncryptionError("storage path", err)
+	var storedKey string
+	if uc.store != nil {
+		stored, err := uc.storeContent(ctx, userID, documentID, input.Content)
+		if err != nil {
+			return nil, err
+		}
+		locator, err := json.Marshal(stored)
+		if err != nil {
+			return nil, fmt.Errorf("upload document: encode storage locator: %w", err)
+		}
+		remotePath = string(locator)
+		storedKey = stored.Key
 	}
 
-	entity, err := entities.NewKYCDocumentEntity(entities.KYCDocumentParams{
-		KYCProfileID:      profile.GetID(),
-		DocumentType:      docType,
-		FilePathEncrypted: encryptedPath,
-		FileNameEncrypted: encryptedFileName,
-		FileSizeBytes:     len(input.Content),
-		FileHash:          hashHex,
-		MimeType:          strings.TrimSpace(input.MimeType),
-		Status:            entities.DocumentStatusPending,
-		UploadedAt:        now,
-		CreatedAt:         now,
-		UpdatedAt:         now,
-		Metadata: map[string]any{
-			"originalFileName": strings.TrimSpace(input.FileName),
-			"mimeType":         strings.TrimSpace(input.MimeType),
-			"hash":             hashHex,
-		},
-	})
+	encryptedPath, err := uc.encryptor.Encrypt(ctx, userID, []byte(remotePath))
 	if err != nil {
-		return nil, utils.NewAppError(
-			"DOCUMENT_INVALID",
-			"failed to prepare document metadata",
-			http.StatusInternalServerError,
-			err,
-			nil,
-		)
+		uc.discardContent(storedKey)
+		return nil, uc.wrapEncryptionError("storage path", err)
 	}
 
-	if err := uc.repository.CreateDocument(ctx, entity); err != nil {
-		return nil, err
+	if entity != nil {
+		// Without a store the quarantine record keeps its hash path; the provider reference is
+		// in the metadata.
+		if storedKey != "" {
+			entity.MarkStored(encryptedPath, uc.now())
+		}
+		entity.UpdateMetadata(metadata)
+		if err := uc.repository.UpdateDocument(ctx, entity); err != nil {
+			uc.discardContent(storedKey)
+			return nil, err
+		}
+	} else {
+		params.FilePathEncrypted = encryptedPath
+		entity, err = entities.NewKYCDocumentEntity(params)
+		if err != nil {
+			uc.discardContent(storedKey)
+			return nil, documentInvalidError(err)
+		}
+
+		if err := uc.repository.CreateDocument(ctx, entity); err != nil {
+			uc.discardContent(storedKey)
+			return nil, err
+		}
 	}
 
+	completed = true
 	response := &dto.KYCDocumentUploadResponse{
 		Document: dto.MapKYCDocument(entity),
 	}
@@ -190,6 +261,157 @@ func (uc *UploadDocumentUseCase) Execute(ctx context.Context, input UploadDocume
 	return response, nil
 }
 
+// scan records the upload as quarantined and runs the malware scan. Infected uploads are rejected
+// and audited; uploads that cannot be scanned are rejected so the user can try again.
+func (uc *UploadDocumentUseCase) scan(ctx context.Context, userID uuid.UUID, params entities.KYCDocumentParams, content []byte) (*entities.KYCDocumentEntity, error) {
+	placeholder, err := uc.encryptor.Encrypt(ctx, userID, []byte(params.FileHash))
+	if err != nil {
+		return nil, uc.wrapEncryptionError("storage path", err)
+	}
+	params.FilePathEncrypted = placeholder
+	params.ScanStatus = entities.DocumentScanQuarantined
+
+	entity, err := entities.NewKYCDocumentEntity(params)
+	if err != nil {
+		return nil, documentInvalidError(err)
+	}
+	if err := uc.repository.CreateDocument(ctx, entity); err != nil {
+		return nil, err
+	}
+
+	result, err := uc.scanner.Scan(ctx, bytes.NewReader(content))
+	if err != nil {
+		uc.logger.Error("kyc document scan failed",
+			slog.String("document_id", entity.GetID().String()),
+			slog.String("error", err.Error()))
+		entity.MarkScanFailed(uc.now())
+		uc.saveRejection(entity)
+		return nil, utils.NewAppError(
+			"DOCUMENT_SCAN_UNAVAILABLE",
+			"document could not be scanned; try again later",
+			http.StatusServiceUnavailable,
+			err,
+			nil,
+		)
+	}
+
+	if !result.Clean {
+		uc.logger.Warn("kyc document failed malware scan",
+			slog.String("document_id", entity.GetID().String()),
+			slog.String("signature", result.Signature))
+		entity.MarkInfected(result.Signature, uc.now())
+		uc.saveRejection(entity)
+		if uc.audit != nil {
+			if err := uc.audit.Record(ctx, audit.Entry{
+				ActorID:  userID.String(),
+				Action:   "kyc_document_infected",
+				TargetID: userID.String(),
+				Metadata: map[string]any{
+					"document_id":   entity.GetID().String(),
+					"document_type": string(entity.GetDocumentType()),
+					"signature":     result.Signature,
+					"engine":        result.Engine,
+				},
+			}); err != nil {
+				uc.logger.Error("failed to record infected kyc document",
+					slog.String("document_id", entity.GetID().String()),
+					slog.String("error", err.Error()))
+			}
+		}
+		return nil, utils.NewAppError(
+			"DOCUMENT_INFECTED",
+			"document was rejected by the malware scan",
+			http.StatusUnprocessableEntity,
+			nil,
+			map[string]any{"documentId": entity.GetID().String()},
+		)
+	}
+
+	entity.MarkScanned(uc.now())
+	return entity, nil
+}
+
+// abandon rejects a quarantined document whose upload failed after it was scanned.
+func (uc *UploadDocumentUseCase) abandon(entity *entities.KYCDocumentEntity) {
+	entity.MarkRejected("document could not be stored; please upload it again", uc.now())
+	uc.saveRejection(entity)
+}
+
+// saveRejection persists a rejected upload, even when the request has been cancelled.
+func (uc *UploadDocumentUseCase) saveRejection(entity *entities.KYCDocumentEntity) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := uc.repository.UpdateDocument(ctx, entity); err != nil {
+		uc.logger.Error("failed to reject kyc document",
+			slog.String("document_id", entity.GetID().String()),
+			slog.String("error", err.Error()))
+	}
+}
+
+// storeContent encrypts content under a fresh data key while streaming it to the store, so the
+// plaintext never reaches storage and is not buffered a second time.
+func (uc *UploadDocumentUseCase) storeContent(ctx context.Context, userID, documentID uuid.UUID, content []byte) (storedDocument, error) {
+	dataKey, err := security.GenerateRandomKey()
+	if err != nil {
+		return storedDocument{}, uc.wrapEncryptionError("document", err)
+	}
+	stored := storedDocument{
+		Key:     fmt.Sprintf("%s/%s/%s", documentKeyPrefix, userID, documentID),
+		DataKey: dataKey,
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		encrypter, err := security.NewStreamEncrypter(writer, dataKey, []byte(documentID.String()))
+		if err == nil {
+			_, err = encrypter.Write(content)
+			if err == nil {
+				err = encrypter.Close()
+			}
+		}
+		writer.CloseWithError(err)
+	}()
+
+	size := security.StreamCiphertextSize(int64(len(content)))
+	err = uc.store.Upload(ctx, stored.Key, "application/octet-stream", reader, size)
+	reader.CloseWithError(err)
+	if err != nil {
+		uc.logger.Error("failed to store kyc document",
+			slog.String("document_id", documentID.String()),
+			slog.String("error", err.Error()))
+		return storedDocument{}, utils.NewAppError(
+			"DOCUMENT_STORAGE_UNAVAILABLE",
+			"document storage is unavailable; try again later",
+			http.StatusServiceUnavailable,
+			err,
+			nil,
+		)
+	}
+	return stored, nil
+}
+
+// discardContent removes stored content whose document record could not be saved.
+func (uc *UploadDocumentUseCase) discardContent(key string) {
+	if key == "" {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := uc.store.Delete(ctx, key); err != nil {
+		uc.logger.Warn("failed to delete orphaned kyc document", slog.String("key", key), slog.String("error", err.Error()))
+	}
+}
+
+func documentInvalidError(err error) error {
+	return utils.NewAppError(
+		"DOCUMENT_INVALID",
+		"failed to prepare document metadata",
+		http.StatusInternalServerError,
+		err,
+		nil,
+	)
+}
+
 func (uc *UploadDocumentUseCase) wrapEncryptionError(field string, err error) error {
 	return utils.NewAppError(
 		"KYC_ENCRYPTION_ERROR",
@@ -200,15 +422,70 @@ func (uc *UploadDocumentUseCase) wrapEncryptionError(field string, err error) er
 	)
 }
 
-func isSupportedDocumentType(docType entities.DocumentType) bool {
-	switch docType {
-	case entities.DocumentTypePassport,
-		entities.DocumentTypeNationalID,
-		entities.DocumentTypeDriversLicense,
-		entities.DocumentTypeProofOfAddress,
-		entities.DocumentTypeSelfie:
-		return true
-	default:
-		return false
+// checkDocumentSpec enforces the capture rules configured for the document type.
+func (uc *UploadDocumentUseCase) checkDocumentSpec(ctx context.Context, docType entities.DocumentType, side entities.KYCDocumentSide, mimeType string, size int) error {
+	var spec *entities.KYCDocumentTypeSpec
+	for _, candidate := range resolveDocumentTypeSpecs(ctx, uc.repository, uc.logger) {
+		if candidate.DocumentType == docType && candidate.Enabled {
+			spec = &candidate
+			break
+		}
+	}
+	if spec == nil {
+		return utils.NewAppError(
+			"DOCUMENT_TYPE_INVALID",
+			"document type is not supported",
+			fiber.StatusBadRequest,
+			nil,
+			map[string]any{"documentType": string(docType)},
+		)
+	}
+
+	if !spec.AcceptsMimeType(mimeType) {
+		return utils.NewAppError(
+			"DOCUMENT_MIME_TYPE_NOT_ACCEPTED",
+			fmt.Sprintf("%s must be uploaded as one of: %s", spec.Label("en"), strings.Join(spec.AcceptedMimeTypes, ", ")),
+			fiber.StatusUnsupportedMediaType,
+			nil,
+			map[string]any{
+				"documentType":      string(docType),
+				"mimeType":          mimeType,
+				"acceptedMimeTypes": spec.AcceptedMimeTypes,
+			},
+		)
+	}
+
+	if int64(size) > spec.MaxSizeBytes {
+		return utils.NewAppError(
+			"DOCUMENT_TOO_LARGE",
+			fmt.Sprintf("%s must not exceed %d bytes", spec.Label("en"), spec.MaxSizeBytes),
+			fiber.StatusRequestEntityTooLarge,
+			nil,
+			map[string]any{
+				"documentType": string(docType),
+				"sizeBytes":    size,
+				"maxSizeBytes": spec.MaxSizeBytes,
+			},
+		)
+	}
+
+	if !spec.AcceptsSide(side) {
+		sides := make([]string, 0, len(spec.Sides()))
+		for _, candidate := range spec.Sides() {
+			sides = append(sides, string(candidate))
+		}
+		return utils.NewAppError(
+			"DOCUMENT_SIDE_INVALID",
+			fmt.Sprintf("%s accepts the following sides: %s", spec.Label("en"), strings.Join(sides, ", ")),
+			fiber.StatusBadRequest,
+			nil,
+			map[string]any{
+				"documentType": string(docType),
+				"side":         string(side),
+				"sides":        sides,
+			},
+		)
 	}
+
+	return nil
 }
@@ -0,0 +1,73 @@
+package notification
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// notificationListCaps bounds inbox listings.
+var notificationListCaps = repositories.PaginationCaps{MaxLimit: 100, MaxOffset: 5000}
+
+// ListNotificationsInput captures paging parameters for the inbox.
+type ListNotificationsInput struct {
+	UserID     uuid.UUID
+	UnreadOnly bool
+	Limit      int
+	Offset     int
+}
+
+// ListNotificationsUseCase lists the user's in-app notifications with their unread count.
+type ListNotificationsUseCase struct {
+	notifications repositories.NotificationRepository
+	logger        *slog.Logger
+}
+
+// NewListNotificationsUseCase constructs the use case.
+func NewListNotificationsUseCase(notifications repositories.NotificationRepository, logger *slog.Logger) *ListNotificationsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ListNotificationsUseCase{
+		notifications: notifications,
+		logger:        logger,
+	}
+}
+
+// Execute returns a page of notifications, newest first.
+func (uc *ListNotificationsUseCase) Execute(ctx context.Context, input ListNotificationsInput) (dto.NotificationList, error) {
+	opts := repositories.ListOptions{
+		Limit:  input.Limit,
+		Offset: input.Offset,
+		Caps:   notificationListCaps,
+	}
+	if err := opts.CheckCaps(); err != nil {
+		return dto.NotificationList{}, err
+	}
+	opts = opts.WithDefaults()
+
+	notifications, err := uc.notifications.ListByUser(ctx, input.UserID, input.UnreadOnly, opts)
+	if err != nil {
+		return dto.NotificationList{}, err
+	}
+
+	unread, err := uc.notifications.CountUnread(ctx, input.UserID)
+	if err != nil {
+		return dto.NotificationList{}, err
+	}
+
+	items := make([]dto.NotificationResponse, 0, len(notifications))
+	for _, notification := range notifications {
+		items = append(items, dto.NewNotificationResponse(notification))
+	}
+	return dto.NotificationList{
+		Notifications: items,
+		UnreadCount:   unread,
+		Limit:         opts.Limit,
+		Offset:        opts.Offset,
+	}, nil
+}
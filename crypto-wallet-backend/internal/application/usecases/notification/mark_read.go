@@ -0,0 +1,62 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// MarkReadUseCase marks one of the user's notifications as read. Marking an already read
+// notification again succeeds and keeps the original read time.
+type MarkReadUseCase struct {
+	notifications repositories.NotificationRepository
+	logger        *slog.Logger
+	now           func() time.Time
+}
+
+// NewMarkReadUseCase constructs the use case.
+func NewMarkReadUseCase(notifications repositories.NotificationRepository, logger *slog.Logger) *MarkReadUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &MarkReadUseCase{
+		notifications: notifications,
+		logger:        logger,
+		now:           time.Now,
+	}
+}
+
+// Execute marks the notification read and returns it with the remaining unread count.
+func (uc *MarkReadUseCase) Execute(ctx context.Context, userID, notificationID uuid.UUID) (dto.NotificationReadResponse, error) {
+	notification, err := uc.notifications.MarkRead(ctx, userID, notificationID, uc.now().UTC())
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return dto.NotificationReadResponse{}, utils.NewAppError(
+				"NOTIFICATION_NOT_FOUND",
+				"notification not found",
+				fiber.StatusNotFound,
+				nil,
+				nil,
+			)
+		}
+		return dto.NotificationReadResponse{}, err
+	}
+
+	unread, err := uc.notifications.CountUnread(ctx, userID)
+	if err != nil {
+		return dto.NotificationReadResponse{}, err
+	}
+
+	return dto.NotificationReadResponse{
+		Notification: dto.NewNotificationResponse(*notification),
+		UnreadCount:  unread,
+	}, nil
+}
@@ -0,0 +1,205 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// EventNotifier publishes a named event with its payload.
+type EventNotifier interface {
+	Notify(ctx context.Context, event string, data map[string]any) error
+}
+
+// WalletLookup resolves the owner of a wallet for events that only carry a wallet ID.
+type WalletLookup interface {
+	GetByID(ctx context.Context, id uuid.UUID) (entities.Wallet, error)
+}
+
+// PreferencesLookup reads the notification types a user has switched off.
+type PreferencesLookup interface {
+	GetByUser(ctx context.Context, userID uuid.UUID) (entities.UserPreferences, error)
+}
+
+// template renders the inbox entry for one event from its payload.
+type template func(data map[string]any) (entities.NotificationType, string, string)
+
+// templates lists the events that reach the in-app inbox, keyed by the names their producers
+// publish under (transaction monitor, exchange, KYC and wallet dormancy use cases). Other events pass through.
+var templates = map[string]template{
+	"transaction.confirmed": func(data map[string]any) (entities.NotificationType, string, string) {
+		body := "Your transaction has been confirmed."
+		if chain := stringValue(data, "chain"); chain != "" {
+			body = fmt.Sprintf("Your %s transaction has been confirmed.", chain)
+		}
+		return entities.NotificationTypeTransactionConfirmed, "Transaction confirmed", body
+	},
+	"exchange.completed": func(data map[string]any) (entities.NotificationType, string, string) {
+		return entities.NotificationTypeExchangeCompleted, "Exchange completed", "Your exchange has completed and the funds are in your wallet."
+	},
+	"kyc.approved": func(data map[string]any) (entities.NotificationType, string, string) {
+		return entities.NotificationTypeKYCStatusChanged, "Identity verified", "Your identity verification was approved."
+	},
+	"kyc.rejected": func(data map[string]any) (entities.NotificationType, string, string) {
+		return entities.NotificationTypeKYCStatusChanged, "Identity verification declined", withReason("Your identity verification was not approved. Please review and resubmit your documents.", data)
+	},
+	"kyc.expired": func(data map[string]any) (entities.NotificationType, string, string) {
+		return entities.NotificationTypeKYCStatusChanged, "Identity verification expired", "Your identity verification has expired. Verify again to restore your limits."
+	},
+	"kyc.upgrade.approved": func(data map[string]any) (entities.NotificationType, string, string) {
+		body := "Your verification upgrade was approved."
+		if level := stringValue(data, "verification_level"); level != "" {
+			body = fmt.Sprintf("Your verification upgrade was approved. Your account is now at the %s level.", level)
+		}
+		return entities.NotificationTypeKYCStatusChanged, "Verification upgraded", body
+	},
+	"kyc.upgrade.rejected": func(data map[string]any) (entities.NotificationType, string, string) {
+		return entities.NotificationTypeKYCStatusChanged, "Verification upgrade declined", withReason("Your verification upgrade was not approved.", data)
+	},
+	"wallet.dormancy_reminder": func(data map[string]any) (entities.NotificationType, string, string) {
+		body := "Your wallet has been inactive and will soon become dormant. Sign in or make a transaction to keep it active."
+		if dormantAt, ok := data["dormant_at"].(time.Time); ok {
+			body = fmt.Sprintf("Your wallet has been inactive and becomes dormant on %s. Sign in or make a transaction to keep it active.", dormantAt.Format("January 2, 2006"))
+		}
+		return entities.NotificationTypeWalletDormancy, "Wallet inactive", body
+	},
+	"wallet.dormant": func(data map[string]any) (entities.NotificationType, string, string) {
+		return entities.NotificationTypeWalletDormancy, "Wallet dormant", "Your wallet is now dormant after a long period of inactivity. Reactivate it with your two-factor code to transact again."
+	},
+}
+
+// NotificationService stores an in-app notification for the events users care about before handing
+// every event to the next notifier, so the inbox shows what push and email deliveries announce.
+type NotificationService struct {
+	notifications repositories.NotificationRepository
+	wallets       WalletLookup
+	preferences   PreferencesLookup
+	next          EventNotifier
+	logger        *slog.Logger
+	now           func() time.Time
+}
+
+// NewNotificationService constructs a NotificationService; next may be nil when only the inbox is
+// wanted, and preferences nil when every user receives every notification type.
+func NewNotificationService(notifications repositories.NotificationRepository, wallets WalletLookup, preferences PreferencesLookup, next EventNotifier, logger *slog.Logger) *NotificationService {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &NotificationService{
+		notifications: notifications,
+		wallets:       wallets,
+		preferences:   preferences,
+		next:          next,
+		logger:        logger,
+		now:           time.Now,
+	}
+}
+
+// Notify stores the inbox entry for the event, if it has one and the user has not switched its type
+// off, and forwards the event. A failed store does not stop delivery.
+func (s *NotificationService) Notify(ctx context.Context, event string, data map[string]any) error {
+	var notifyErr error
+
+	if render, ok := templates[event]; ok {
+		if err := s.store(ctx, event, data, render); err != nil {
+			s.logger.Error("failed to store in-app notification", slog.String("event", event), slog.String("error", err.Error()))
+			notifyErr = fmt.Errorf("store %s notification: %w", event, err)
+		}
+	}
+
+	if s.next != nil {
+		notifyErr = errors.Join(notifyErr, s.next.Notify(ctx, event, data))
+	}
+
+	return notifyErr
+}
+
+func (s *NotificationService) store(ctx context.Context, event string, data map[string]any, render template) error {
+	userID, err := s.recipient(ctx, data)
+	if err != nil {
+		return err
+	}
+
+	notificationType, title, body := render(data)
+	if !s.wants(ctx, userID, notificationType) {
+		return nil
+	}
+
+	notification := &entities.Notification{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Type:      notificationType,
+		Event:     event,
+		Title:     title,
+		Body:      body,
+		Data:      data,
+		CreatedAt: s.now().UTC(),
+	}
+	if err := notification.Validate(); err != nil {
+		return err
+	}
+	return s.notifications.Create(ctx, notification)
+}
+
+// wants reports whether the user receives notifications of the type. Unreadable preferences keep
+// the notification rather than drop it.
+func (s *NotificationService) wants(ctx context.Context, userID uuid.UUID, notificationType entities.NotificationType) bool {
+	if s.preferences == nil {
+		return true
+	}
+	preferences, err := s.preferences.GetByUser(ctx, userID)
+	if err != nil {
+		s.logger.Warn("failed to load notification preferences", slog.String("user_id", userID.String()), slog.String("error", err.Error()))
+		return true
+	}
+	return preferences.NotificationEnabled(notificationType)
+}
+
+// recipient reads the user from the payload, falling back to the owner of its wallet.
+func (s *NotificationService) recipient(ctx context.Context, data map[string]any) (uuid.UUID, error) {
+	if raw := stringValue(data, "user_id"); raw != "" {
+		return uuid.Parse(raw)
+	}
+
+	raw := stringValue(data, "wallet_id")
+	if raw == "" {
+		return uuid.Nil, errors.New("event has neither user_id nor wallet_id")
+	}
+	walletID, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if s.wallets == nil {
+		return uuid.Nil, errors.New("wallet lookup not configured")
+	}
+	wallet, err := s.wallets.GetByID(ctx, walletID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return wallet.GetUserID(), nil
+}
+
+func stringValue(data map[string]any, key string) string {
+	switch value := data[key].(type) {
+	case string:
+		return value
+	case fmt.Stringer:
+		return value.String()
+	default:
+		return ""
+	}
+}
+
+func withReason(body string, data map[string]any) string {
+	if reason := stringValue(data, "reason"); reason != "" {
+		return body + " Reason: " + reason
+	}
+	return body
+}
@@ -0,0 +1,167 @@
+package p2p
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	appLogging "github.com/crypto-wallet/backend/internal/infrastructure/logging"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// ClaimTransferInput encapsulates a claim for held P2P funds.
+type ClaimTransferInput struct {
+	UserID  uuid.UUID
+	Payload dto.ClaimP2PTransferRequest
+}
+
+// ClaimTransferUseCase releases held funds into a wallet owned by the invited recipient.
+type ClaimTransferUseCase struct {
+	transfers repositories.P2PTransferRepository
+	users     UserRepo
+	wallets   WalletRepo
+	notifier  Notifier
+	logger    *slog.Logger
+}
+
+// NewClaimTransferUseCase constructs the use case.
+func NewClaimTransferUseCase(
+	transfers repositories.P2PTransferRepository,
+	users UserRepo,
+	wallets WalletRepo,
+	notifier Notifier,
+	logger *slog.Logger,
+) *ClaimTransferUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ClaimTransferUseCase{
+		transfers: transfers,
+		users:     users,
+		wallets:   wallets,
+		notifier:  notifier,
+		logger:    logger,
+	}
+}
+
+// Execute performs the claim workflow.
+func (uc *ClaimTransferUseCase) Execute(ctx context.Context, input ClaimTransferInput) (dto.P2PTransferResponse, error) {
+	logger := appLogging.LoggerFromContext(ctx, uc.logger)
+	validation := input.Payload.Validate()
+
+	if input.UserID == uuid.Nil {
+		validation.Add("userId", "is required")
+	}
+
+	if !validation.IsEmpty() {
+		return dto.P2PTransferResponse{}, wrapValidationError(validation)
+	}
+
+	walletID, _ := uuid.Parse(strings.TrimSpace(input.Payload.WalletID))
+
+	found, err := uc.transfers.GetByClaimCodeHash(ctx, hashClaimCode(input.Payload.ClaimCode))
+	if err != nil {
+		return dto.P2PTransferResponse{}, err
+	}
+
+	transfer, ok := found.(*entities.P2PTransferEntity)
+	if !ok {
+		return dto.P2PTransferResponse{}, repositories.ErrNotFound
+	}
+
+	// Only the invited address may claim; report a mismatch as not found to avoid leaking codes.
+	user, err := uc.users.GetByID(ctx, input.UserID)
+	if err != nil {
+		return dto.P2PTransferResponse{}, err
+	}
+	if !strings.EqualFold(user.GetEmail(), transfer.GetRecipientEmail()) {
+		return dto.P2PTransferResponse{}, repositories.ErrNotFound
+	}
+
+	now := time.Now().UTC()
+	if transfer.GetStatus() != entities.P2PTransferStatusPendingClaim {
+		return dto.P2PTransferResponse{}, claimUnavailableError(transfer.GetStatus())
+	}
+	if transfer.IsClaimExpired(now) {
+		return dto.P2PTransferResponse{}, utils.NewAppError(
+			"CLAIM_EXPIRED",
+			"claim window has expired; funds are being returned to the sender",
+			fiber.StatusGone,
+			nil,
+			nil,
+		)
+	}
+
+	wallet, err := loadOwnedWallet(ctx, uc.wallets, input.UserID, walletID)
+	if err != nil {
+		return dto.P2PTransferResponse{}, err
+	}
+	if wallet.GetChain() != transfer.GetChain() {
+		return dto.P2PTransferResponse{}, utils.NewAppError(
+			"CHAIN_MISMATCH",
+			"wallet chain mismatch",
+			fiber.StatusBadRequest,
+			nil,
+			map[string]any{"expected": transfer.GetChain(), "received": wallet.GetChain()},
+		)
+	}
+
+	sender, err := uc.wallets.GetByID(ctx, transfer.GetSenderWalletID())
+	if err != nil {
+		return dto.P2PTransferResponse{}, err
+	}
+
+	if err := transfer.MarkClaimed(input.UserID, wallet.GetID(), now); err != nil {
+		return dto.P2PTransferResponse{}, claimUnavailableError(transfer.GetStatus())
+	}
+
+	recipientTx, err := buildRecipientTransaction(transfer, sender, wallet, now)
+	if err != nil {
+		return dto.P2PTransferResponse{}, err
+	}
+
+	if err := uc.transfers.Claim(ctx, transfer, recipientTx); err != nil {
+		if errors.Is(err, repositories.ErrConflict) {
+			return dto.P2PTransferResponse{}, claimUnavailableError(entities.P2PTransferStatusReturned)
+		}
+		logger.Error("p2p claim settlement failed",
+			slog.String("transfer_id", transfer.GetID().String()),
+			slog.String("error", err.Error()))
+		return dto.P2PTransferResponse{}, err
+	}
+
+	logger.Info("p2p transfer claimed", slog.String("transfer_id", transfer.GetID().String()))
+
+	data := map[string]any{
+		"transfer_id": transfer.GetID().String(),
+		"chain":       string(transfer.GetChain()),
+		"amount":      transfer.GetAmount().String(),
+	}
+	for _, userID := range []uuid.UUID{transfer.GetSenderUserID(), input.UserID} {
+		payload := cloneData(data)
+		payload["user_id"] = userID.String()
+		if err := notify(ctx, uc.notifier, EventTransferClaimed, payload); err != nil {
+			logger.Warn("failed to notify p2p claim", slog.String("error", err.Error()))
+		}
+	}
+
+	return dto.NewP2PTransferResponse(transfer, input.UserID), nil
+}
+
+func claimUnavailableError(status entities.P2PTransferStatus) error {
+	return utils.NewAppError(
+		"CLAIM_UNAVAILABLE",
+		"transfer is no longer awaiting a claim",
+		fiber.StatusConflict,
+		nil,
+		map[string]any{"status": status},
+	)
+}
@@ -0,0 +1,143 @@
+package p2p
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+func disputeNotFoundError() error {
+	return utils.NewAppError(
+		"DISPUTE_NOT_FOUND",
+		"dispute not found",
+		fiber.StatusNotFound,
+		nil,
+		nil,
+	)
+}
+
+// loadDispute fetches a dispute as its concrete entity, mapping a missing row to DISPUTE_NOT_FOUND.
+func loadDispute(ctx context.Context, disputes repositories.P2PDisputeRepository, id uuid.UUID) (*entities.P2PDisputeEntity, error) {
+	found, err := disputes.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, disputeNotFoundError()
+		}
+		return nil, err
+	}
+
+	dispute, ok := found.(*entities.P2PDisputeEntity)
+	if !ok {
+		return nil, disputeNotFoundError()
+	}
+	return dispute, nil
+}
+
+// loadDisputeWallets returns the sender and recipient wallets of a settled transfer.
+func loadDisputeWallets(ctx context.Context, wallets WalletRepo, transfer entities.P2PTransfer) (entities.Wallet, entities.Wallet, error) {
+	if transfer.GetRecipientWalletID() == nil {
+		return nil, nil, utils.NewAppError(
+			"DISPUTE_FUNDS_UNAVAILABLE",
+			"recipient wallet for this transfer no longer exists",
+			fiber.StatusConflict,
+			nil,
+			nil,
+		)
+	}
+
+	sender, err := wallets.GetByID(ctx, transfer.GetSenderWalletID())
+	if err != nil {
+		return nil, nil, err
+	}
+	recipient, err := wallets.GetByID(ctx, *transfer.GetRecipientWalletID())
+	if err != nil {
+		return nil, nil, err
+	}
+	return sender, recipient, nil
+}
+
+// buildDisputeTransaction records a balance movement on wallet caused by a dispute action.
+func buildDisputeTransaction(
+	dispute *entities.P2PDisputeEntity,
+	transfer entities.P2PTransfer,
+	wallet, counterparty entities.Wallet,
+	txType entities.TransactionType,
+	hash string,
+	at time.Time,
+) (*entities.TransactionEntity, error) {
+	from, to := wallet.GetAddress(), counterparty.GetAddress()
+	if txType == entities.TransactionTypeP2PReceive {
+		from, to = to, from
+	}
+	return entities.NewTransactionEntity(entities.TransactionParams{
+		WalletID:    wallet.GetID(),
+		Chain:       transfer.GetChain(),
+		Hash:        hash,
+		Type:        txType,
+		Amount:      transfer.GetAmount(),
+		Status:      entities.TransactionStatusConfirmed,
+		FromAddress: from,
+		ToAddress:   to,
+		Metadata: map[string]any{
+			"p2p_transfer_id": transfer.GetID().String(),
+			"p2p_dispute_id":  dispute.GetID().String(),
+		},
+		CreatedAt:   at,
+		ConfirmedAt: &at,
+	})
+}
+
+// notifyDisputeParties sends the same dispute event to the sender and the recipient.
+func notifyDisputeParties(ctx context.Context, notifier Notifier, logger *slog.Logger, event string, dispute entities.P2PDispute) {
+	for _, userID := range []uuid.UUID{dispute.GetSenderUserID(), dispute.GetRecipientUserID()} {
+		if err := notify(ctx, notifier, event, map[string]any{
+			"user_id":     userID.String(),
+			"dispute_id":  dispute.GetID().String(),
+			"transfer_id": dispute.GetTransferID().String(),
+			"status":      string(dispute.GetStatus()),
+			"outcome":     string(dispute.GetOutcome()),
+		}); err != nil {
+			logger.Warn("failed to notify p2p dispute party",
+				slog.String("event", event),
+				slog.String("error", err.Error()))
+		}
+	}
+}
+
+func recordDisputeAudit(ctx context.Context, auditLogger AuditLogger, logger *slog.Logger, actorID uuid.UUID, action string, dispute entities.P2PDispute, note string) {
+	if auditLogger == nil {
+		return
+	}
+	if err := auditLogger.Record(ctx, audit.Entry{
+		ActorID:  actorID,
+		Action:   action,
+		TargetID: dispute.GetID().String(),
+		Metadata: map[string]any{
+			"transfer_id": dispute.GetTransferID().String(),
+			"status":      string(dispute.GetStatus()),
+			"outcome":     string(dispute.GetOutcome()),
+			"note":        note,
+		},
+	}); err != nil {
+		logger.Warn("failed to record p2p dispute audit entry", slog.String("error", err.Error()))
+	}
+}
+
+func disputeConflictError(err error) error {
+	return utils.NewAppError(
+		"DISPUTE_STATE_CHANGED",
+		"dispute was updated by another request",
+		fiber.StatusConflict,
+		err,
+		nil,
+	)
+}
@@ -0,0 +1,55 @@
+package p2p
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// GetDisputeInput identifies the dispute and the requester.
+// Support staff may view any dispute; other users only disputes they are a party to.
+type GetDisputeInput struct {
+	UserID    uuid.UUID
+	DisputeID uuid.UUID
+	Support   bool
+}
+
+// GetDisputeUseCase returns a dispute together with its audit trail.
+type GetDisputeUseCase struct {
+	disputes repositories.P2PDisputeRepository
+	logger   *slog.Logger
+}
+
+// NewGetDisputeUseCase constructs the use case.
+func NewGetDisputeUseCase(disputes repositories.P2PDisputeRepository, logger *slog.Logger) *GetDisputeUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GetDisputeUseCase{
+		disputes: disputes,
+		logger:   logger,
+	}
+}
+
+// Execute loads the dispute.
+func (uc *GetDisputeUseCase) Execute(ctx context.Context, input GetDisputeInput) (dto.P2PDisputeDetail, error) {
+	dispute, err := loadDispute(ctx, uc.disputes, input.DisputeID)
+	if err != nil {
+		return dto.P2PDisputeDetail{}, err
+	}
+
+	if !input.Support && !dispute.IsParty(input.UserID) {
+		return dto.P2PDisputeDetail{}, disputeNotFoundError()
+	}
+
+	events, err := uc.disputes.ListEvents(ctx, dispute.GetID())
+	if err != nil {
+		return dto.P2PDisputeDetail{}, err
+	}
+
+	return dto.NewP2PDisputeDetail(dispute, events), nil
+}
@@ -0,0 +1,130 @@
+package p2p
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	appLogging "github.com/crypto-wallet/backend/internal/infrastructure/logging"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// HoldDisputeInput encapsulates a support request to hold disputed funds.
+type HoldDisputeInput struct {
+	ActorID   uuid.UUID
+	DisputeID uuid.UUID
+	Payload   dto.HoldP2PDisputeRequest
+}
+
+// HoldDisputeUseCase moves the disputed amount out of the recipient's wallet until the dispute is resolved.
+type HoldDisputeUseCase struct {
+	transfers repositories.P2PTransferRepository
+	disputes  repositories.P2PDisputeRepository
+	wallets   WalletRepo
+	notifier  Notifier
+	audit     AuditLogger
+	logger    *slog.Logger
+}
+
+// NewHoldDisputeUseCase constructs the use case.
+func NewHoldDisputeUseCase(
+	transfers repositories.P2PTransferRepository,
+	disputes repositories.P2PDisputeRepository,
+	wallets WalletRepo,
+	notifier Notifier,
+	auditLogger AuditLogger,
+	logger *slog.Logger,
+) *HoldDisputeUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &HoldDisputeUseCase{
+		transfers: transfers,
+		disputes:  disputes,
+		wallets:   wallets,
+		notifier:  notifier,
+		audit:     auditLogger,
+		logger:    logger,
+	}
+}
+
+// Execute performs the hold workflow.
+func (uc *HoldDisputeUseCase) Execute(ctx context.Context, input HoldDisputeInput) (dto.P2PDisputeResponse, error) {
+	logger := appLogging.LoggerFromContext(ctx, uc.logger)
+	validation := input.Payload.Validate()
+	if !validation.IsEmpty() {
+		return dto.P2PDisputeResponse{}, wrapValidationError(validation)
+	}
+
+	dispute, err := loadDispute(ctx, uc.disputes, input.DisputeID)
+	if err != nil {
+		return dto.P2PDisputeResponse{}, err
+	}
+
+	transfer, err := uc.transfers.GetByID(ctx, dispute.GetTransferID())
+	if err != nil {
+		return dto.P2PDisputeResponse{}, err
+	}
+
+	senderWallet, recipientWallet, err := loadDisputeWallets(ctx, uc.wallets, transfer)
+	if err != nil {
+		return dto.P2PDisputeResponse{}, err
+	}
+
+	now := time.Now().UTC()
+	previous := dispute.GetStatus()
+	if err := dispute.MarkHeld(now); err != nil {
+		return dto.P2PDisputeResponse{}, utils.NewAppError(
+			"DISPUTE_NOT_OPEN",
+			"only open disputes can be held",
+			fiber.StatusConflict,
+			err,
+			map[string]any{"status": previous},
+		)
+	}
+
+	holdTx, err := buildDisputeTransaction(dispute, transfer, recipientWallet, senderWallet,
+		entities.TransactionTypeP2PSend, dispute.HoldTransactionHash(), now)
+	if err != nil {
+		return dto.P2PDisputeResponse{}, err
+	}
+
+	if err := uc.disputes.Transition(ctx, dispute, previous, entities.P2PDisputeEvent{
+		DisputeID: dispute.GetID(),
+		ActorID:   input.ActorID,
+		Action:    entities.P2PDisputeActionHeld,
+		Note:      input.Payload.Note,
+		CreatedAt: now,
+	}, holdTx); err != nil {
+		switch {
+		case errors.Is(err, repositories.ErrInsufficientFunds):
+			return dto.P2PDisputeResponse{}, utils.NewAppError(
+				"HOLD_INSUFFICIENT_FUNDS",
+				"recipient wallet cannot cover the disputed amount",
+				fiber.StatusUnprocessableEntity,
+				err,
+				map[string]any{"available": recipientWallet.GetBalance().String()},
+			)
+		case errors.Is(err, repositories.ErrConflict):
+			return dto.P2PDisputeResponse{}, disputeConflictError(err)
+		}
+		logger.Error("p2p dispute hold failed",
+			slog.String("dispute_id", dispute.GetID().String()),
+			slog.String("error", err.Error()))
+		return dto.P2PDisputeResponse{}, err
+	}
+
+	logger.Info("p2p dispute funds held", slog.String("dispute_id", dispute.GetID().String()))
+
+	recordDisputeAudit(ctx, uc.audit, logger, input.ActorID, "p2p_dispute_hold", dispute, input.Payload.Note)
+	notifyDisputeParties(ctx, uc.notifier, logger, EventDisputeHeld, dispute)
+
+	return dto.NewP2PDisputeResponse(dispute), nil
+}
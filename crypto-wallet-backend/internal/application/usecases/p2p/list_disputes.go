@@ -0,0 +1,102 @@
+package p2p
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// ListDisputesInput captures paging parameters for listing disputes.
+// When Support is set the whole review queue is listed, optionally filtered by Status.
+type ListDisputesInput struct {
+	UserID  uuid.UUID
+	Support bool
+	Status  string
+	Limit   int
+	Offset  int
+}
+
+// ListDisputesUseCase lists disputes for a party or for support review.
+type ListDisputesUseCase struct {
+	disputes repositories.P2PDisputeRepository
+	logger   *slog.Logger
+}
+
+// NewListDisputesUseCase constructs the use case.
+func NewListDisputesUseCase(disputes repositories.P2PDisputeRepository, logger *slog.Logger) *ListDisputesUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ListDisputesUseCase{
+		disputes: disputes,
+		logger:   logger,
+	}
+}
+
+// disputeListCaps bounds dispute listing for users and the support queue alike.
+var disputeListCaps = repositories.PaginationCaps{MaxLimit: 100, MaxOffset: 5000}
+
+// Execute returns a page of disputes.
+func (uc *ListDisputesUseCase) Execute(ctx context.Context, input ListDisputesInput) (dto.P2PDisputeList, error) {
+	opts := repositories.ListOptions{
+		Limit:  input.Limit,
+		Offset: input.Offset,
+		Caps:   disputeListCaps,
+	}
+	if err := opts.CheckCaps(); err != nil {
+		return dto.P2PDisputeList{}, err
+	}
+	opts = opts.WithDefaults()
+
+	var (
+		disputes []entities.P2PDispute
+		err      error
+	)
+	if input.Support {
+		filter := repositories.P2PDisputeFilter{}
+		if input.Status != "" {
+			validation := utils.ValidationErrors{}
+			utils.RequireInSet(&validation, "status", input.Status, []string{
+				string(entities.P2PDisputeStatusOpen),
+				string(entities.P2PDisputeStatusHeld),
+				string(entities.P2PDisputeStatusResolved),
+			})
+			if !validation.IsEmpty() {
+				return dto.P2PDisputeList{}, wrapValidationError(validation)
+			}
+			status := entities.P2PDisputeStatus(input.Status)
+			filter.Status = &status
+		}
+		disputes, err = uc.disputes.List(ctx, filter, opts)
+	} else {
+		disputes, err = uc.disputes.ListByUser(ctx, input.UserID, opts)
+	}
+	if err != nil {
+		uc.logger.Error("failed to list p2p disputes", slog.String("error", err.Error()))
+		return dto.P2PDisputeList{}, utils.NewAppError(
+			"DATABASE_ERROR",
+			"failed to list disputes",
+			fiber.StatusInternalServerError,
+			err,
+			nil,
+		)
+	}
+
+	items := make([]dto.P2PDisputeResponse, 0, len(disputes))
+	for _, dispute := range disputes {
+		items = append(items, dto.NewP2PDisputeResponse(dispute))
+	}
+
+	return dto.P2PDisputeList{
+		Disputes: items,
+		Limit:    opts.Limit,
+		Offset:   opts.Offset,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package p2p
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// ListTransfersInput captures paging parameters for listing a user's transfers.
+type ListTransfersInput struct {
+	UserID uuid.UUID
+	Limit  int
+	Offset int
+}
+
+// ListTransfersUseCase lists P2P transfers sent or received by a user.
+type ListTransfersUseCase struct {
+	transfers repositories.P2PTransferRepository
+	logger    *slog.Logger
+}
+
+// NewListTransfersUseCase constructs the use case.
+func NewListTransfersUseCase(transfers repositories.P2PTransferRepository, logger *slog.Logger) *ListTransfersUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ListTransfersUseCase{
+		transfers: transfers,
+		logger:    logger,
+	}
+}
+
+// transferListCaps bounds a user's transfer listing.
+var transferListCaps = repositories.PaginationCaps{MaxLimit: 100, MaxOffset: 5000}
+
+// Execute returns the user's transfers, newest first.
+func (uc *ListTransfersUseCase) Execute(ctx context.Context, input ListTransfersInput) (dto.P2PTransferList, error) {
+	opts := repositories.ListOptions{
+		Limit:  input.Limit,
+		Offset: input.Offset,
+		Caps:   transferListCaps,
+	}
+	if err := opts.CheckCaps(); err != nil {
+		return dto.P2PTransferList{}, err
+	}
+	opts = opts.WithDefaults()
+
+	transfers, err := uc.transfers.ListByUser(ctx, input.UserID, opts)
+	if err != nil {
+		uc.logger.Error("failed to list p2p transfers",
+			slog.String("user_id", input.UserID.String()),
+			slog.String("error", err.Error()))
+		return dto.P2PTransferList{}, utils.NewAppError(
+			"DATABASE_ERROR",
+			"failed to list transfers",
+			fiber.StatusInternalServerError,
+			err,
+			nil,
+		)
+	}
+
+	items := make([]dto.P2PTransferResponse, 0, len(transfers))
+	for _, transfer := range transfers {
+		items = append(items, dto.NewP2PTransferResponse(transfer, input.UserID))
+	}
+
+	return dto.P2PTransferList{
+		Transfers: items,
+		Limit:     opts.Limit,
+		Offset:    opts.Offset,
+	}, nil
+}
@@ -0,0 +1,149 @@
+package p2p
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	appLogging "github.com/crypto-wallet/backend/internal/infrastructure/logging"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// OpenDisputeInput encapsulates a request to dispute a transfer.
+type OpenDisputeInput struct {
+	UserID     uuid.UUID
+	TransferID uuid.UUID
+	Payload    dto.OpenP2PDisputeRequest
+}
+
+// OpenDisputeUseCase lets the sender or recipient of a settled transfer raise a dispute.
+type OpenDisputeUseCase struct {
+	transfers repositories.P2PTransferRepository
+	disputes  repositories.P2PDisputeRepository
+	notifier  Notifier
+	audit     AuditLogger
+	window    time.Duration
+	logger    *slog.Logger
+}
+
+// NewOpenDisputeUseCase constructs the use case. A non-positive window uses entities.DefaultP2PDisputeWindow.
+func NewOpenDisputeUseCase(
+	transfers repositories.P2PTransferRepository,
+	disputes repositories.P2PDisputeRepository,
+	notifier Notifier,
+	auditLogger AuditLogger,
+	window time.Duration,
+	logger *slog.Logger,
+) *OpenDisputeUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if window <= 0 {
+		window = entities.DefaultP2PDisputeWindow
+	}
+	return &OpenDisputeUseCase{
+		transfers: transfers,
+		disputes:  disputes,
+		notifier:  notifier,
+		audit:     auditLogger,
+		window:    window,
+		logger:    logger,
+	}
+}
+
+// Execute performs the open workflow.
+func (uc *OpenDisputeUseCase) Execute(ctx context.Context, input OpenDisputeInput) (dto.P2PDisputeResponse, error) {
+	logger := appLogging.LoggerFromContext(ctx, uc.logger)
+	validation := input.Payload.Validate()
+	if input.UserID == uuid.Nil {
+		validation.Add("userId", "is required")
+	}
+	if input.TransferID == uuid.Nil {
+		validation.Add("transferId", "is required")
+	}
+	if !validation.IsEmpty() {
+		return dto.P2PDisputeResponse{}, wrapValidationError(validation)
+	}
+
+	transfer, err := uc.transfers.GetByID(ctx, input.TransferID)
+	if err != nil {
+		return dto.P2PDisputeResponse{}, err
+	}
+
+	recipientID := transfer.GetRecipientUserID()
+	isParty := transfer.GetSenderUserID() == input.UserID || (recipientID != nil && *recipientID == input.UserID)
+	if !isParty {
+		return dto.P2PDisputeResponse{}, repositories.ErrNotFound
+	}
+
+	if transfer.GetStatus() != entities.P2PTransferStatusCompleted || recipientID == nil || transfer.GetSettledAt() == nil {
+		return dto.P2PDisputeResponse{}, utils.NewAppError(
+			"DISPUTE_NOT_ALLOWED",
+			"only settled transfers can be disputed",
+			fiber.StatusConflict,
+			nil,
+			map[string]any{"status": transfer.GetStatus()},
+		)
+	}
+
+	now := time.Now().UTC()
+	deadline := transfer.GetSettledAt().Add(uc.window)
+	if now.After(deadline) {
+		return dto.P2PDisputeResponse{}, utils.NewAppError(
+			"DISPUTE_WINDOW_CLOSED",
+			"the dispute window for this transfer has closed",
+			fiber.StatusUnprocessableEntity,
+			nil,
+			map[string]any{"closedAt": deadline},
+		)
+	}
+
+	dispute, err := entities.NewP2PDisputeEntity(entities.P2PDisputeParams{
+		TransferID:      transfer.GetID(),
+		OpenedBy:        input.UserID,
+		SenderUserID:    transfer.GetSenderUserID(),
+		RecipientUserID: *recipientID,
+		Reason:          entities.P2PDisputeReason(input.Payload.Reason),
+		Description:     input.Payload.Description,
+		CreatedAt:       now,
+	})
+	if err != nil {
+		validation.Add("dispute", err.Error())
+		return dto.P2PDisputeResponse{}, wrapValidationError(validation)
+	}
+
+	if err := uc.disputes.Create(ctx, dispute, entities.P2PDisputeEvent{
+		DisputeID: dispute.GetID(),
+		ActorID:   input.UserID,
+		Action:    entities.P2PDisputeActionOpened,
+		Note:      dispute.GetDescription(),
+		CreatedAt: now,
+	}); err != nil {
+		if errors.Is(err, repositories.ErrDuplicate) {
+			return dto.P2PDisputeResponse{}, utils.NewAppError(
+				"DISPUTE_EXISTS",
+				"this transfer already has an open dispute",
+				fiber.StatusConflict,
+				err,
+				nil,
+			)
+		}
+		return dto.P2PDisputeResponse{}, err
+	}
+
+	logger.Info("p2p dispute opened",
+		slog.String("dispute_id", dispute.GetID().String()),
+		slog.String("transfer_id", transfer.GetID().String()))
+
+	recordDisputeAudit(ctx, uc.audit, logger, input.UserID, "p2p_dispute_open", dispute, dispute.GetDescription())
+	notifyDisputeParties(ctx, uc.notifier, logger, EventDisputeOpened, dispute)
+
+	return dto.NewP2PDisputeResponse(dispute), nil
+}
@@ -0,0 +1,177 @@
+package p2p
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	appLogging "github.com/crypto-wallet/backend/internal/infrastructure/logging"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// ResolveDisputeInput encapsulates a support decision on a dispute.
+type ResolveDisputeInput struct {
+	ActorID   uuid.UUID
+	DisputeID uuid.UUID
+	Payload   dto.ResolveP2PDisputeRequest
+}
+
+// ResolveDisputeUseCase closes a dispute with a documented outcome and settles any held funds.
+//
+// A refund charges the amount back to the sender, from the hold when one exists and otherwise
+// from the recipient's wallet. No action releases a hold back to the recipient.
+type ResolveDisputeUseCase struct {
+	transfers repositories.P2PTransferRepository
+	disputes  repositories.P2PDisputeRepository
+	wallets   WalletRepo
+	notifier  Notifier
+	audit     AuditLogger
+	logger    *slog.Logger
+}
+
+// NewResolveDisputeUseCase constructs the use case.
+func NewResolveDisputeUseCase(
+	transfers repositories.P2PTransferRepository,
+	disputes repositories.P2PDisputeRepository,
+	wallets WalletRepo,
+	notifier Notifier,
+	auditLogger AuditLogger,
+	logger *slog.Logger,
+) *ResolveDisputeUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ResolveDisputeUseCase{
+		transfers: transfers,
+		disputes:  disputes,
+		wallets:   wallets,
+		notifier:  notifier,
+		audit:     auditLogger,
+		logger:    logger,
+	}
+}
+
+// Execute performs the resolution workflow.
+func (uc *ResolveDisputeUseCase) Execute(ctx context.Context, input ResolveDisputeInput) (dto.P2PDisputeResponse, error) {
+	logger := appLogging.LoggerFromContext(ctx, uc.logger)
+	validation := input.Payload.Validate()
+	if !validation.IsEmpty() {
+		return dto.P2PDisputeResponse{}, wrapValidationError(validation)
+	}
+
+	dispute, err := loadDispute(ctx, uc.disputes, input.DisputeID)
+	if err != nil {
+		return dto.P2PDisputeResponse{}, err
+	}
+
+	transfer, err := uc.transfers.GetByID(ctx, dispute.GetTransferID())
+	if err != nil {
+		return dto.P2PDisputeResponse{}, err
+	}
+
+	outcome := entities.P2PDisputeOutcome(input.Payload.Outcome)
+	previous := dispute.GetStatus()
+	wasHeld := dispute.IsHeld()
+
+	var ledger []*entities.TransactionEntity
+	if outcome == entities.P2PDisputeOutcomeRefundSender || wasHeld {
+		ledger, err = uc.buildLedger(ctx, dispute, transfer, outcome, wasHeld)
+		if err != nil {
+			return dto.P2PDisputeResponse{}, err
+		}
+	}
+
+	now := time.Now().UTC()
+	if err := dispute.Resolve(outcome, input.Payload.Note, input.ActorID, now); err != nil {
+		return dto.P2PDisputeResponse{}, utils.NewAppError(
+			"DISPUTE_NOT_RESOLVABLE",
+			"dispute cannot be resolved",
+			fiber.StatusConflict,
+			err,
+			map[string]any{"status": previous},
+		)
+	}
+
+	if err := uc.disputes.Transition(ctx, dispute, previous, entities.P2PDisputeEvent{
+		DisputeID: dispute.GetID(),
+		ActorID:   input.ActorID,
+		Action:    entities.P2PDisputeActionResolved,
+		Note:      string(outcome) + ": " + dispute.GetResolutionNote(),
+		CreatedAt: now,
+	}, ledger...); err != nil {
+		switch {
+		case errors.Is(err, repositories.ErrInsufficientFunds):
+			return dto.P2PDisputeResponse{}, utils.NewAppError(
+				"CHARGEBACK_INSUFFICIENT_FUNDS",
+				"recipient wallet cannot cover the chargeback; hold the funds or resolve with no action",
+				fiber.StatusUnprocessableEntity,
+				err,
+				nil,
+			)
+		case errors.Is(err, repositories.ErrConflict):
+			return dto.P2PDisputeResponse{}, disputeConflictError(err)
+		}
+		logger.Error("p2p dispute resolution failed",
+			slog.String("dispute_id", dispute.GetID().String()),
+			slog.String("error", err.Error()))
+		return dto.P2PDisputeResponse{}, err
+	}
+
+	logger.Info("p2p dispute resolved",
+		slog.String("dispute_id", dispute.GetID().String()),
+		slog.String("outcome", string(outcome)))
+
+	recordDisputeAudit(ctx, uc.audit, logger, input.ActorID, "p2p_dispute_resolve", dispute, dispute.GetResolutionNote())
+	notifyDisputeParties(ctx, uc.notifier, logger, EventDisputeResolved, dispute)
+
+	return dto.NewP2PDisputeResponse(dispute), nil
+}
+
+// buildLedger returns the balance movements implied by the outcome.
+func (uc *ResolveDisputeUseCase) buildLedger(
+	ctx context.Context,
+	dispute *entities.P2PDisputeEntity,
+	transfer entities.P2PTransfer,
+	outcome entities.P2PDisputeOutcome,
+	wasHeld bool,
+) ([]*entities.TransactionEntity, error) {
+	senderWallet, recipientWallet, err := loadDisputeWallets(ctx, uc.wallets, transfer)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	var ledger []*entities.TransactionEntity
+
+	if outcome == entities.P2PDisputeOutcomeNoAction {
+		release, err := buildDisputeTransaction(dispute, transfer, recipientWallet, senderWallet,
+			entities.TransactionTypeP2PReceive, dispute.ReleaseTransactionHash(), now)
+		if err != nil {
+			return nil, err
+		}
+		return append(ledger, release), nil
+	}
+
+	if !wasHeld {
+		chargeback, err := buildDisputeTransaction(dispute, transfer, recipientWallet, senderWallet,
+			entities.TransactionTypeP2PSend, dispute.ChargebackTransactionHash(), now)
+		if err != nil {
+			return nil, err
+		}
+		ledger = append(ledger, chargeback)
+	}
+
+	refund, err := buildDisputeTransaction(dispute, transfer, senderWallet, recipientWallet,
+		entities.TransactionTypeP2PReceive, dispute.RefundTransactionHash(), now)
+	if err != nil {
+		return nil, err
+	}
+	return append(ledger, refund), nil
+}
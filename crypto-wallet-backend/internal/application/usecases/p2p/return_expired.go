@@ -0,0 +1,78 @@
+package p2p
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const returnExpiredBatchSize = 100
+
+// ReturnExpiredTransfersUseCase credits unclaimed held transfers back to their senders.
+type ReturnExpiredTransfersUseCase struct {
+	transfers repositories.P2PTransferRepository
+	notifier  Notifier
+	logger    *slog.Logger
+}
+
+// NewReturnExpiredTransfersUseCase constructs the use case.
+func NewReturnExpiredTransfersUseCase(transfers repositories.P2PTransferRepository, notifier Notifier, logger *slog.Logger) *ReturnExpiredTransfersUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ReturnExpiredTransfersUseCase{
+		transfers: transfers,
+		notifier:  notifier,
+		logger:    logger,
+	}
+}
+
+// Execute returns one batch of expired holds and reports how many were returned.
+func (uc *ReturnExpiredTransfersUseCase) Execute(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+
+	expired, err := uc.transfers.ListExpiredClaims(ctx, now, returnExpiredBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	returned := 0
+	for _, item := range expired {
+		transfer, ok := item.(*entities.P2PTransferEntity)
+		if !ok {
+			continue
+		}
+
+		if err := transfer.MarkReturned(now); err != nil {
+			continue
+		}
+
+		if err := uc.transfers.Return(ctx, transfer); err != nil {
+			// A concurrent claim won the race; nothing to return.
+			if errors.Is(err, repositories.ErrConflict) {
+				continue
+			}
+			uc.logger.Error("failed to return expired p2p transfer",
+				slog.String("transfer_id", transfer.GetID().String()),
+				slog.String("error", err.Error()))
+			continue
+		}
+		returned++
+
+		if err := notify(ctx, uc.notifier, EventTransferReturned, map[string]any{
+			"transfer_id": transfer.GetID().String(),
+			"user_id":     transfer.GetSenderUserID().String(),
+			"recipient":   recipientLabel(transfer),
+			"chain":       string(transfer.GetChain()),
+			"amount":      transfer.GetAmount().String(),
+		}); err != nil {
+			uc.logger.Warn("failed to notify p2p return", slog.String("error", err.Error()))
+		}
+	}
+
+	return returned, nil
+}
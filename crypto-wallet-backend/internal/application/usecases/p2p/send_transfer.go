@@ -0,0 +1,395 @@
+package p2p
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	appLogging "github.com/crypto-wallet/backend/internal/infrastructure/logging"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// SendTransferInput encapsulates a P2P send request.
+type SendTransferInput struct {
+	UserID  uuid.UUID
+	Payload dto.SendP2PTransferRequest
+}
+
+// SendTransferUseCase moves funds to another platform user on the internal ledger.
+// Recipients without an account (or without a wallet on the chain) receive a claim code
+// and the funds are held until claimed or the claim window lapses.
+type SendTransferUseCase struct {
+	transfers   repositories.P2PTransferRepository
+	users       UserRepo
+	profiles    ProfileRepo
+	wallets     WalletRepo
+	notifier    Notifier
+	claimWindow time.Duration
+	logger      *slog.Logger
+}
+
+// NewSendTransferUseCase constructs the use case. A non-positive claimWindow uses entities.DefaultP2PClaimWindow.
+func NewSendTransferUseCase(
+	transfers repositories.P2PTransferRepository,
+	users UserRepo,
+	profiles ProfileRepo,
+	wallets WalletRepo,
+	notifier Notifier,
+	claimWindow time.Duration,
+	logger *slog.Logger,
+) *SendTransferUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if claimWindow <= 0 {
+		claimWindow = entities.DefaultP2PClaimWindow
+	}
+	return &SendTransferUseCase{
+		transfers:   transfers,
+		users:       users,
+		profiles:    profiles,
+		wallets:     wallets,
+		notifier:    notifier,
+		claimWindow: claimWindow,
+		logger:      logger,
+	}
+}
+
+// Execute performs the send workflow.
+func (uc *SendTransferUseCase) Execute(ctx context.Context, input SendTransferInput) (dto.P2PTransferResponse, error) {
+	logger := appLogging.LoggerFromContext(ctx, uc.logger)
+	validation := input.Payload.Validate()
+
+	if input.UserID == uuid.Nil {
+		validation.Add("userId", "is required")
+	}
+
+	walletID, _ := uuid.Parse(strings.TrimSpace(input.Payload.WalletID))
+	amount, _ := decimal.NewFromString(strings.TrimSpace(input.Payload.Amount))
+
+	if !validation.IsEmpty() {
+		return dto.P2PTransferResponse{}, wrapValidationError(validation)
+	}
+
+	wallet, err := loadOwnedWallet(ctx, uc.wallets, input.UserID, walletID)
+	if err != nil {
+		return dto.P2PTransferResponse{}, err
+	}
+
+	if wallet.GetBalance().LessThan(amount) {
+		return dto.P2PTransferResponse{}, insufficientFundsError(wallet.GetBalance())
+	}
+
+	recipient, recipientHandle, err := uc.resolveRecipient(ctx, input.Payload.Recipient)
+	if err != nil {
+		return dto.P2PTransferResponse{}, err
+	}
+
+	recipientEmail := strings.ToLower(strings.TrimSpace(input.Payload.Recipient))
+	var recipientUserID *uuid.UUID
+	var recipientWallet entities.Wallet
+
+	if recipient != nil {
+		if recipient.GetID() == input.UserID {
+			validation.Add("recipient", "cannot send to yourself")
+			return dto.P2PTransferResponse{}, wrapValidationError(validation)
+		}
+		id := recipient.GetID()
+		recipientUserID = &id
+		recipientEmail = recipient.GetEmail()
+		recipientWallet, err = uc.findRecipientWallet(ctx, id, wallet.GetChain())
+		if err != nil {
+			return dto.P2PTransferResponse{}, err
+		}
+	}
+
+	now := time.Now().UTC()
+	params := entities.P2PTransferParams{
+		SenderUserID:    input.UserID,
+		SenderWalletID:  wallet.GetID(),
+		RecipientEmail:  recipientEmail,
+		RecipientHandle: recipientHandle,
+		Chain:           wallet.GetChain(),
+		Amount:          amount,
+		Note:            input.Payload.Note,
+		CreatedAt:       now,
+	}
+
+	var claimCode string
+	if recipientWallet != nil {
+		recipientWalletID := recipientWallet.GetID()
+		params.RecipientUserID = recipientUserID
+		params.RecipientWalletID = &recipientWalletID
+		params.Status = entities.P2PTransferStatusCompleted
+	} else {
+		claimCode, err = generateClaimCode()
+		if err != nil {
+			return dto.P2PTransferResponse{}, err
+		}
+		expiresAt := now.Add(uc.claimWindow)
+		params.Status = entities.P2PTransferStatusPendingClaim
+		params.ClaimCodeHash = hashClaimCode(claimCode)
+		params.ExpiresAt = &expiresAt
+	}
+
+	transfer, err := entities.NewP2PTransferEntity(params)
+	if err != nil {
+		validation.Add("transfer", err.Error())
+		return dto.P2PTransferResponse{}, wrapValidationError(validation)
+	}
+
+	senderTx, err := buildSenderTransaction(transfer, wallet, recipientWallet)
+	if err != nil {
+		return dto.P2PTransferResponse{}, err
+	}
+
+	ledger := []*entities.TransactionEntity{senderTx}
+	if recipientWallet != nil {
+		recipientTx, err := buildRecipientTransaction(transfer, wallet, recipientWallet, now)
+		if err != nil {
+			return dto.P2PTransferResponse{}, err
+		}
+		ledger = append(ledger, recipientTx)
+	}
+
+	if err := uc.transfers.Settle(ctx, transfer, ledger...); err != nil {
+		if errors.Is(err, repositories.ErrInsufficientFunds) {
+			return dto.P2PTransferResponse{}, insufficientFundsError(wallet.GetBalance())
+		}
+		logger.Error("p2p transfer settlement failed",
+			slog.String("transfer_id", transfer.GetID().String()),
+			slog.String("error", err.Error()))
+		return dto.P2PTransferResponse{}, err
+	}
+
+	logger.Info("p2p transfer settled",
+		slog.String("transfer_id", transfer.GetID().String()),
+		slog.String("status", string(transfer.GetStatus())))
+
+	uc.notifyParties(ctx, logger, transfer, claimCode)
+
+	return dto.NewP2PTransferResponse(transfer, input.UserID), nil
+}
+
+// resolveRecipient looks up the recipient by email or handle ("@alice" or "alice").
+// A nil user means the email is not registered; the returned handle is set for handle payments.
+func (uc *SendTransferUseCase) resolveRecipient(ctx context.Context, recipient string) (entities.User, string, error) {
+	value := strings.TrimSpace(recipient)
+	if !strings.Contains(strings.TrimPrefix(value, "@"), "@") {
+		return uc.resolveHandle(ctx, value)
+	}
+
+	var validation utils.ValidationErrors
+	utils.RequireEmail(&validation, "recipient", value)
+	if !validation.IsEmpty() {
+		return nil, "", wrapValidationError(validation)
+	}
+
+	user, err := uc.users.GetByEmail(ctx, strings.ToLower(value))
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, "", nil
+		}
+		return nil, "", err
+	}
+
+	if err := ensureRecipientActive(user); err != nil {
+		return nil, "", err
+	}
+
+	return user, "", nil
+}
+
+// resolveHandle finds the user behind a handle. Unknown handles and profiles that
+// opted out of handle payments are reported identically to avoid disclosing either.
+func (uc *SendTransferUseCase) resolveHandle(ctx context.Context, value string) (entities.User, string, error) {
+	handle := entities.NormalizeHandle(value)
+
+	var validation utils.ValidationErrors
+	if err := entities.ValidateHandle(handle); err != nil {
+		validation.Add("recipient", "must be a valid email address or handle")
+		return nil, "", wrapValidationError(validation)
+	}
+
+	notFound := utils.NewAppError(
+		"RECIPIENT_NOT_FOUND",
+		"no user accepts payments at this handle",
+		fiber.StatusNotFound,
+		nil,
+		nil,
+	)
+
+	if uc.profiles == nil {
+		return nil, "", notFound
+	}
+
+	profile, err := uc.profiles.GetByHandle(ctx, handle)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, "", notFound
+		}
+		return nil, "", err
+	}
+	if !profile.AllowsHandlePayments() {
+		return nil, "", notFound
+	}
+
+	user, err := uc.users.GetByID(ctx, profile.GetUserID())
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, "", notFound
+		}
+		return nil, "", err
+	}
+
+	if err := ensureRecipientActive(user); err != nil {
+		return nil, "", err
+	}
+
+	return user, profile.GetHandle(), nil
+}
+
+func ensureRecipientActive(user entities.User) error {
+	if user.GetStatus() != entities.UserStatusActive {
+		return utils.NewAppError(
+			"RECIPIENT_UNAVAILABLE",
+			"recipient cannot receive transfers",
+			fiber.StatusUnprocessableEntity,
+			nil,
+			nil,
+		)
+	}
+	return nil
+}
+
+// findRecipientWallet returns the recipient's oldest active wallet on the chain, or nil when none exists.
+func (uc *SendTransferUseCase) findRecipientWallet(ctx context.Context, userID uuid.UUID, chain entities.Chain) (entities.Wallet, error) {
+	status := entities.WalletStatusActive
+	wallets, err := uc.wallets.ListByUser(ctx, userID, repositories.WalletFilter{
+		Chain:  &chain,
+		Status: &status,
+	}, repositories.ListOptions{
+		Limit:     1,
+		SortBy:    "created_at",
+		SortOrder: repositories.SortAscending,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(wallets) == 0 {
+		return nil, nil
+	}
+	return wallets[0], nil
+}
+
+func (uc *SendTransferUseCase) notifyParties(ctx context.Context, logger *slog.Logger, transfer *entities.P2PTransferEntity, claimCode string) {
+	base := map[string]any{
+		"transfer_id": transfer.GetID().String(),
+		"chain":       string(transfer.GetChain()),
+		"amount":      transfer.GetAmount().String(),
+		"note":        transfer.GetNote(),
+	}
+
+	sender := cloneData(base)
+	sender["user_id"] = transfer.GetSenderUserID().String()
+	sender["recipient"] = recipientLabel(transfer)
+	sender["status"] = string(transfer.GetStatus())
+	if err := notify(ctx, uc.notifier, EventTransferSent, sender); err != nil {
+		logger.Warn("failed to notify p2p sender", slog.String("error", err.Error()))
+	}
+
+	recipient := cloneData(base)
+	event := EventTransferReceived
+	if transfer.GetStatus() == entities.P2PTransferStatusPendingClaim {
+		event = EventClaimInvite
+		recipient["email"] = transfer.GetRecipientEmail()
+		recipient["claim_code"] = claimCode
+		recipient["expires_at"] = transfer.GetExpiresAt()
+	}
+	if transfer.GetRecipientUserID() != nil {
+		recipient["user_id"] = transfer.GetRecipientUserID().String()
+	}
+	if err := notify(ctx, uc.notifier, event, recipient); err != nil {
+		logger.Warn("failed to notify p2p recipient", slog.String("error", err.Error()))
+	}
+}
+
+func buildSenderTransaction(transfer *entities.P2PTransferEntity, from, to entities.Wallet) (*entities.TransactionEntity, error) {
+	status := entities.TransactionStatusConfirmed
+	toAddress := transfer.GetRecipientEmail()
+	var confirmedAt *time.Time
+
+	if to != nil {
+		toAddress = to.GetAddress()
+		confirmedAt = transfer.GetSettledAt()
+	} else {
+		status = entities.TransactionStatusPending
+	}
+
+	return entities.NewTransactionEntity(entities.TransactionParams{
+		WalletID:    from.GetID(),
+		Chain:       transfer.GetChain(),
+		Hash:        transfer.SenderTransactionHash(),
+		Type:        entities.TransactionTypeP2PSend,
+		Amount:      transfer.GetAmount(),
+		Status:      status,
+		FromAddress: from.GetAddress(),
+		ToAddress:   toAddress,
+		Metadata:    transferMetadata(transfer),
+		CreatedAt:   transfer.GetCreatedAt(),
+		ConfirmedAt: confirmedAt,
+	})
+}
+
+func buildRecipientTransaction(transfer *entities.P2PTransferEntity, from, to entities.Wallet, at time.Time) (*entities.TransactionEntity, error) {
+	return entities.NewTransactionEntity(entities.TransactionParams{
+		WalletID:    to.GetID(),
+		Chain:       transfer.GetChain(),
+		Hash:        transfer.RecipientTransactionHash(),
+		Type:        entities.TransactionTypeP2PReceive,
+		Amount:      transfer.GetAmount(),
+		Status:      entities.TransactionStatusConfirmed,
+		FromAddress: from.GetAddress(),
+		ToAddress:   to.GetAddress(),
+		Metadata:    transferMetadata(transfer),
+		CreatedAt:   at,
+		ConfirmedAt: &at,
+	})
+}
+
+func transferMetadata(transfer entities.P2PTransfer) map[string]any {
+	metadata := map[string]any{
+		"p2p_transfer_id": transfer.GetID().String(),
+	}
+	if transfer.GetNote() != "" {
+		metadata["note"] = transfer.GetNote()
+	}
+	return metadata
+}
+
+func insufficientFundsError(balance decimal.Decimal) error {
+	return utils.NewAppError(
+		"INSUFFICIENT_FUNDS",
+		"wallet balance is insufficient for this transfer",
+		fiber.StatusUnprocessableEntity,
+		repositories.ErrInsufficientFunds,
+		map[string]any{"available": balance.String()},
+	)
+}
+
+func cloneData(values map[string]any) map[string]any {
+	cloned := make(map[string]any, len(values))
+	for k, v := range values {
+		cloned[k] = v
+	}
+	return cloned
+}
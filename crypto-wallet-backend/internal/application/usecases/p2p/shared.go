@@ -0,0 +1,126 @@
+package p2p
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// Notification events published for P2P transfer activity.
+const (
+	EventTransferSent     = "p2p.sent"
+	EventTransferReceived = "p2p.received"
+	EventClaimInvite      = "p2p.claim_invite"
+	EventTransferClaimed  = "p2p.claimed"
+	EventTransferReturned = "p2p.returned"
+	EventDisputeOpened    = "p2p.dispute_opened"
+	EventDisputeHeld      = "p2p.dispute_held"
+	EventDisputeResolved  = "p2p.dispute_resolved"
+)
+
+// Notifier delivers user-facing notifications; payloads carry either a user_id or an email recipient.
+type Notifier interface {
+	Notify(ctx context.Context, event string, data map[string]any) error
+}
+
+// AuditLogger captures audit events for compliance.
+type AuditLogger interface {
+	Record(ctx context.Context, entry audit.Entry) error
+}
+
+// UserRepo exposes user lookups needed to resolve transfer recipients.
+type UserRepo interface {
+	GetByID(ctx context.Context, id uuid.UUID) (entities.User, error)
+	GetByEmail(ctx context.Context, email string) (entities.User, error)
+}
+
+// ProfileRepo exposes handle lookups used to address transfers by handle.
+type ProfileRepo interface {
+	GetByHandle(ctx context.Context, handle string) (entities.PaymentProfile, error)
+}
+
+// WalletRepo exposes wallet lookups needed to route transfers.
+type WalletRepo interface {
+	GetByID(ctx context.Context, id uuid.UUID) (entities.Wallet, error)
+	ListByUser(ctx context.Context, userID uuid.UUID, filter repositories.WalletFilter, opts repositories.ListOptions) ([]entities.Wallet, error)
+}
+
+var claimCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// generateClaimCode returns a random, human-transcribable claim code.
+func generateClaimCode() (string, error) {
+	buf := make([]byte, 10)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return claimCodeEncoding.EncodeToString(buf), nil
+}
+
+// hashClaimCode derives the lookup hash stored in place of the claim code.
+func hashClaimCode(code string) string {
+	sum := sha256.Sum256([]byte(strings.ToUpper(strings.TrimSpace(code))))
+	return hex.EncodeToString(sum[:])
+}
+
+// loadOwnedWallet fetches a wallet and ensures it is active and owned by the user.
+func loadOwnedWallet(ctx context.Context, wallets WalletRepo, userID, walletID uuid.UUID) (entities.Wallet, error) {
+	wallet, err := wallets.GetByID(ctx, walletID)
+	if err != nil {
+		return nil, err
+	}
+
+	if wallet.GetUserID() != userID {
+		return nil, repositories.ErrNotFound
+	}
+
+	if wallet.GetStatus() != entities.WalletStatusActive {
+		return nil, utils.NewAppError(
+			"WALLET_INACTIVE",
+			"wallet must be active to transfer funds",
+			fiber.StatusForbidden,
+			nil,
+			nil,
+		)
+	}
+
+	return wallet, nil
+}
+
+// recipientLabel identifies the recipient to the sender without exposing the email behind a handle.
+func recipientLabel(transfer entities.P2PTransfer) string {
+	if handle := transfer.GetRecipientHandle(); handle != "" {
+		return "@" + handle
+	}
+	return transfer.GetRecipientEmail()
+}
+
+func notify(ctx context.Context, notifier Notifier, event string, data map[string]any) error {
+	if notifier == nil {
+		return nil
+	}
+	return notifier.Notify(ctx, event, data)
+}
+
+func wrapValidationError(errs utils.ValidationErrors) error {
+	if errs.IsEmpty() {
+		return nil
+	}
+	return utils.NewAppError(
+		"VALIDATION_ERROR",
+		"p2p transfer payload invalid",
+		fiber.StatusBadRequest,
+		errs,
+		map[string]any{"errors": errs},
+	)
+}
@@ -0,0 +1,102 @@
+package preferences
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	appLogging "github.com/crypto-wallet/backend/internal/infrastructure/logging"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+var errPreferencesRepositoryRequired = errors.New("preferences: repository not configured")
+
+// GetPreferencesUseCase returns the user's preferences, falling back to the defaults.
+type GetPreferencesUseCase struct {
+	repository repositories.UserPreferencesRepository
+}
+
+// NewGetPreferencesUseCase constructs the use case.
+func NewGetPreferencesUseCase(repository repositories.UserPreferencesRepository) *GetPreferencesUseCase {
+	return &GetPreferencesUseCase{repository: repository}
+}
+
+// Execute loads the preferences.
+func (uc *GetPreferencesUseCase) Execute(ctx context.Context, userID uuid.UUID) (dto.PreferencesResponse, error) {
+	if uc.repository == nil {
+		return dto.PreferencesResponse{}, errPreferencesRepositoryRequired
+	}
+	preferences, err := uc.repository.GetByUser(ctx, userID)
+	if err != nil {
+		return dto.PreferencesResponse{}, err
+	}
+	return dto.NewPreferencesResponse(preferences), nil
+}
+
+// UpdatePreferencesUseCase applies a partial update to the user's preferences.
+type UpdatePreferencesUseCase struct {
+	repository repositories.UserPreferencesRepository
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+// NewUpdatePreferencesUseCase constructs the use case.
+func NewUpdatePreferencesUseCase(repository repositories.UserPreferencesRepository, logger *slog.Logger) *UpdatePreferencesUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &UpdatePreferencesUseCase{
+		repository: repository,
+		logger:     logger,
+		now:        func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Execute validates the patch, merges it into the stored preferences and saves the result.
+func (uc *UpdatePreferencesUseCase) Execute(ctx context.Context, userID uuid.UUID, req dto.UpdatePreferencesRequest) (dto.PreferencesResponse, error) {
+	if uc.repository == nil {
+		return dto.PreferencesResponse{}, errPreferencesRepositoryRequired
+	}
+
+	req.SetDefaults()
+	if err := wrapValidationError(req.Validate()); err != nil {
+		return dto.PreferencesResponse{}, err
+	}
+
+	current, err := uc.repository.GetByUser(ctx, userID)
+	if err != nil {
+		return dto.PreferencesResponse{}, err
+	}
+
+	updated := req.Apply(current)
+	updated.UserID = userID
+	updated.UpdatedAt = uc.now()
+
+	if err := uc.repository.Save(ctx, updated); err != nil {
+		appLogging.LoggerFromContext(ctx, uc.logger).Error("failed to save user preferences",
+			slog.String("user_id", userID.String()),
+			slog.String("error", err.Error()),
+		)
+		return dto.PreferencesResponse{}, err
+	}
+	return dto.NewPreferencesResponse(updated), nil
+}
+
+func wrapValidationError(errs utils.ValidationErrors) error {
+	if errs.IsEmpty() {
+		return nil
+	}
+	return utils.NewAppError(
+		"VALIDATION_ERROR",
+		"preferences payload invalid",
+		fiber.StatusBadRequest,
+		errs,
+		map[string]any{"errors": errs},
+	)
+}
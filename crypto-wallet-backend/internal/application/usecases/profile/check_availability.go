@@ -0,0 +1,70 @@
+package profile
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// Reasons reported when a handle cannot be claimed.
+const (
+	AvailabilityReasonInvalid     = "invalid"
+	AvailabilityReasonReserved    = "reserved"
+	AvailabilityReasonUnavailable = "unavailable"
+)
+
+// CheckAvailabilityInput identifies the handle to check and who is asking.
+type CheckAvailabilityInput struct {
+	UserID uuid.UUID
+	Handle string
+}
+
+// CheckAvailabilityUseCase reports whether a handle can be claimed by the requesting user.
+// Taken and held handles share a single reason so the check does not reveal release history.
+type CheckAvailabilityUseCase struct {
+	profiles repositories.PaymentProfileRepository
+	logger   *slog.Logger
+}
+
+// NewCheckAvailabilityUseCase constructs the use case.
+func NewCheckAvailabilityUseCase(profiles repositories.PaymentProfileRepository, logger *slog.Logger) *CheckAvailabilityUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &CheckAvailabilityUseCase{
+		profiles: profiles,
+		logger:   logger,
+	}
+}
+
+// Execute performs the check.
+func (uc *CheckAvailabilityUseCase) Execute(ctx context.Context, input CheckAvailabilityInput) (dto.HandleAvailabilityResponse, error) {
+	handle := entities.NormalizeHandle(input.Handle)
+	response := dto.HandleAvailabilityResponse{Handle: handle}
+
+	if entities.IsReservedHandle(handle) {
+		response.Reason = AvailabilityReasonReserved
+		return response, nil
+	}
+	if entities.ValidateHandle(handle) != nil {
+		response.Reason = AvailabilityReasonInvalid
+		return response, nil
+	}
+
+	available, err := uc.profiles.IsHandleAvailable(ctx, handle, input.UserID, time.Now().UTC())
+	if err != nil {
+		return dto.HandleAvailabilityResponse{}, err
+	}
+
+	response.Available = available
+	if !available {
+		response.Reason = AvailabilityReasonUnavailable
+	}
+	return response, nil
+}
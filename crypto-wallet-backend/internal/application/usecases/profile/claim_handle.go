@@ -0,0 +1,133 @@
+package profile
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	appLogging "github.com/crypto-wallet/backend/internal/infrastructure/logging"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// ClaimHandleInput encapsulates a request to claim or change a handle.
+type ClaimHandleInput struct {
+	UserID  uuid.UUID
+	Payload dto.ClaimHandleRequest
+}
+
+// ClaimHandleUseCase assigns a handle, creating the user's profile on first claim.
+// Changing an existing handle is rate limited and holds the old handle for entities.HandleReleaseHold.
+type ClaimHandleUseCase struct {
+	profiles repositories.PaymentProfileRepository
+	logger   *slog.Logger
+}
+
+// NewClaimHandleUseCase constructs the use case.
+func NewClaimHandleUseCase(profiles repositories.PaymentProfileRepository, logger *slog.Logger) *ClaimHandleUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ClaimHandleUseCase{
+		profiles: profiles,
+		logger:   logger,
+	}
+}
+
+// Execute performs the claim workflow.
+func (uc *ClaimHandleUseCase) Execute(ctx context.Context, input ClaimHandleInput) (dto.PaymentProfileResponse, error) {
+	logger := appLogging.LoggerFromContext(ctx, uc.logger)
+	validation := input.Payload.Validate()
+	if input.UserID == uuid.Nil {
+		validation.Add("userId", "is required")
+	}
+	if !validation.IsEmpty() {
+		return dto.PaymentProfileResponse{}, wrapValidationError(validation)
+	}
+
+	handle := entities.NormalizeHandle(input.Payload.Handle)
+	now := time.Now().UTC()
+
+	existing, err := uc.profiles.GetByUserID(ctx, input.UserID)
+	if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+		return dto.PaymentProfileResponse{}, err
+	}
+
+	if existing == nil {
+		profile, err := entities.NewPaymentProfileEntity(entities.PaymentProfileParams{
+			UserID:              input.UserID,
+			Handle:              handle,
+			AllowHandlePayments: true,
+			CreatedAt:           now,
+		})
+		if err != nil {
+			validation.Add("handle", err.Error())
+			return dto.PaymentProfileResponse{}, wrapValidationError(validation)
+		}
+
+		if err := uc.profiles.Create(ctx, profile); err != nil {
+			return dto.PaymentProfileResponse{}, mapHandleError(err)
+		}
+
+		logger.Info("handle claimed",
+			slog.String("user_id", input.UserID.String()),
+			slog.String("handle", profile.GetHandle()))
+		return dto.NewPaymentProfileResponse(profile), nil
+	}
+
+	profile, ok := existing.(*entities.PaymentProfileEntity)
+	if !ok {
+		return dto.PaymentProfileResponse{}, errors.New("unexpected payment profile implementation")
+	}
+
+	previous := profile.GetHandle()
+	if err := profile.ChangeHandle(handle, now); err != nil {
+		if entities.IsHandleCooldownError(err) {
+			return dto.PaymentProfileResponse{}, utils.NewAppError(
+				"HANDLE_CHANGE_COOLDOWN",
+				"handle was changed recently",
+				fiber.StatusTooManyRequests,
+				err,
+				map[string]any{"availableAt": profile.HandleChangeAvailableAt()},
+			)
+		}
+		validation.Add("handle", err.Error())
+		return dto.PaymentProfileResponse{}, wrapValidationError(validation)
+	}
+
+	if profile.GetHandle() == previous {
+		return dto.NewPaymentProfileResponse(profile), nil
+	}
+
+	if err := uc.profiles.ChangeHandle(ctx, profile, previous, now.Add(entities.HandleReleaseHold)); err != nil {
+		return dto.PaymentProfileResponse{}, mapHandleError(err)
+	}
+
+	logger.Info("handle changed",
+		slog.String("user_id", input.UserID.String()),
+		slog.String("previous_handle", previous),
+		slog.String("handle", profile.GetHandle()))
+
+	return dto.NewPaymentProfileResponse(profile), nil
+}
+
+func mapHandleError(err error) error {
+	switch {
+	case errors.Is(err, repositories.ErrDuplicate), errors.Is(err, repositories.ErrConflict):
+		return utils.NewAppError(
+			"HANDLE_UNAVAILABLE",
+			"handle is not available",
+			fiber.StatusConflict,
+			err,
+			nil,
+		)
+	default:
+		return err
+	}
+}
@@ -0,0 +1,42 @@
+package profile
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// GetProfileUseCase returns the requesting user's own payment profile.
+type GetProfileUseCase struct {
+	profiles repositories.PaymentProfileRepository
+	logger   *slog.Logger
+}
+
+// NewGetProfileUseCase constructs the use case.
+func NewGetProfileUseCase(profiles repositories.PaymentProfileRepository, logger *slog.Logger) *GetProfileUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GetProfileUseCase{
+		profiles: profiles,
+		logger:   logger,
+	}
+}
+
+// Execute loads the profile; users who have not claimed a handle yet receive PROFILE_NOT_FOUND.
+func (uc *GetProfileUseCase) Execute(ctx context.Context, userID uuid.UUID) (dto.PaymentProfileResponse, error) {
+	profile, err := uc.profiles.GetByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return dto.PaymentProfileResponse{}, profileNotFoundError()
+		}
+		return dto.PaymentProfileResponse{}, err
+	}
+
+	return dto.NewPaymentProfileResponse(profile), nil
+}
@@ -0,0 +1,59 @@
+package profile
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// LookupHandleInput identifies the handle to resolve and who is asking.
+type LookupHandleInput struct {
+	RequesterID uuid.UUID
+	Handle      string
+}
+
+// LookupHandleUseCase resolves a handle to its public payment profile.
+// Private profiles are indistinguishable from unknown handles, except to their owner.
+type LookupHandleUseCase struct {
+	profiles repositories.PaymentProfileRepository
+	logger   *slog.Logger
+}
+
+// NewLookupHandleUseCase constructs the use case.
+func NewLookupHandleUseCase(profiles repositories.PaymentProfileRepository, logger *slog.Logger) *LookupHandleUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &LookupHandleUseCase{
+		profiles: profiles,
+		logger:   logger,
+	}
+}
+
+// Execute performs the lookup.
+func (uc *LookupHandleUseCase) Execute(ctx context.Context, input LookupHandleInput) (dto.PublicPaymentProfileResponse, error) {
+	handle := entities.NormalizeHandle(input.Handle)
+	if entities.ValidateHandle(handle) != nil {
+		return dto.PublicPaymentProfileResponse{}, profileNotFoundError()
+	}
+
+	profile, err := uc.profiles.GetByHandle(ctx, handle)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return dto.PublicPaymentProfileResponse{}, profileNotFoundError()
+		}
+		return dto.PublicPaymentProfileResponse{}, err
+	}
+
+	if !profile.IsPublic() && profile.GetUserID() != input.RequesterID {
+		return dto.PublicPaymentProfileResponse{}, profileNotFoundError()
+	}
+
+	return dto.NewPublicPaymentProfileResponse(profile), nil
+}
@@ -0,0 +1,30 @@
+package profile
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+func profileNotFoundError() error {
+	return utils.NewAppError(
+		"PROFILE_NOT_FOUND",
+		"payment profile not found",
+		fiber.StatusNotFound,
+		nil,
+		nil,
+	)
+}
+
+func wrapValidationError(errs utils.ValidationErrors) error {
+	if errs.IsEmpty() {
+		return nil
+	}
+	return utils.NewAppError(
+		"VALIDATION_ERROR",
+		"payment profile payload invalid",
+		fiber.StatusBadRequest,
+		errs,
+		map[string]any{"errors": errs},
+	)
+}
@@ -0,0 +1,92 @@
+package profile
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// UpdateProfileInput encapsulates a profile update request.
+type UpdateProfileInput struct {
+	UserID  uuid.UUID
+	Payload dto.UpdatePaymentProfileRequest
+}
+
+// UpdateProfileUseCase edits the display details and privacy settings of a profile.
+type UpdateProfileUseCase struct {
+	profiles repositories.PaymentProfileRepository
+	logger   *slog.Logger
+}
+
+// NewUpdateProfileUseCase constructs the use case.
+func NewUpdateProfileUseCase(profiles repositories.PaymentProfileRepository, logger *slog.Logger) *UpdateProfileUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &UpdateProfileUseCase{
+		profiles: profiles,
+		logger:   logger,
+	}
+}
+
+// Execute applies the update; a handle must be claimed first.
+func (uc *UpdateProfileUseCase) Execute(ctx context.Context, input UpdateProfileInput) (dto.PaymentProfileResponse, error) {
+	validation := input.Payload.Validate()
+	if !validation.IsEmpty() {
+		return dto.PaymentProfileResponse{}, wrapValidationError(validation)
+	}
+
+	existing, err := uc.profiles.GetByUserID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return dto.PaymentProfileResponse{}, profileNotFoundError()
+		}
+		return dto.PaymentProfileResponse{}, err
+	}
+
+	profile, ok := existing.(*entities.PaymentProfileEntity)
+	if !ok {
+		return dto.PaymentProfileResponse{}, errors.New("unexpected payment profile implementation")
+	}
+
+	public := profile.IsPublic()
+	if input.Payload.Public != nil {
+		public = *input.Payload.Public
+	}
+	allowHandlePayments := profile.AllowsHandlePayments()
+	if input.Payload.AllowHandlePayments != nil {
+		allowHandlePayments = *input.Payload.AllowHandlePayments
+	}
+
+	assets := make([]entities.Chain, 0, len(input.Payload.AcceptedAssets))
+	seen := make(map[entities.Chain]struct{}, len(input.Payload.AcceptedAssets))
+	for _, asset := range input.Payload.AcceptedAssets {
+		chain := entities.NormalizeChain(asset)
+		if _, dup := seen[chain]; dup {
+			continue
+		}
+		seen[chain] = struct{}{}
+		assets = append(assets, chain)
+	}
+
+	if err := profile.UpdateDetails(input.Payload.DisplayName, assets, public, allowHandlePayments, time.Now().UTC()); err != nil {
+		validation.Add("profile", err.Error())
+		return dto.PaymentProfileResponse{}, wrapValidationError(validation)
+	}
+
+	if err := uc.profiles.Update(ctx, profile); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return dto.PaymentProfileResponse{}, profileNotFoundError()
+		}
+		return dto.PaymentProfileResponse{}, err
+	}
+
+	return dto.NewPaymentProfileResponse(profile), nil
+}
@@ -0,0 +1,122 @@
+package rates
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/external"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// BackfillPriceHistoryInput carries one batch of historical candles of a symbol and interval.
+// Candle timestamps are bucket starts.
+type BackfillPriceHistoryInput struct {
+	Symbol   string
+	Interval string
+	Candles  []external.OHLCVData
+}
+
+// BackfillPriceHistoryResult counts the candles of one backfill batch.
+type BackfillPriceHistoryResult struct {
+	Received int   `json:"received"`
+	Inserted int64 `json:"inserted"`
+	// Existing counts candles skipped because the bucket was already stored.
+	Existing int64 `json:"existing"`
+}
+
+// BackfillPriceHistoryUseCase stores historical candles in bulk. Buckets that already hold a candle
+// keep it, so a backfill can be re-run or overlap the live sync safely.
+type BackfillPriceHistoryUseCase struct {
+	repository repositories.RateRepository
+	logger     *slog.Logger
+}
+
+// NewBackfillPriceHistoryUseCase constructs a BackfillPriceHistoryUseCase.
+func NewBackfillPriceHistoryUseCase(repository repositories.RateRepository, logger *slog.Logger) *BackfillPriceHistoryUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &BackfillPriceHistoryUseCase{
+		repository: repository,
+		logger:     logger,
+	}
+}
+
+// Execute validates the batch and inserts it. A batch with any invalid candle is rejected whole.
+func (uc *BackfillPriceHistoryUseCase) Execute(ctx context.Context, input BackfillPriceHistoryInput) (BackfillPriceHistoryResult, error) {
+	var validation utils.ValidationErrors
+
+	symbol := strings.ToUpper(strings.TrimSpace(input.Symbol))
+	if symbol == "" {
+		validation.Add("symbol", "is required")
+	} else if !entities.IsSupportedSymbol(symbol) {
+		validation.Add("symbol", "must be a listed symbol")
+	}
+
+	interval := entities.IntervalType(strings.ToLower(strings.TrimSpace(input.Interval)))
+	if !entities.IsValidInterval(interval) {
+		validation.Add("interval", "must be one of 1m, 5m, 15m, 1h, 4h, 1d, 1w")
+	}
+
+	history := make([]*entities.PriceHistoryEntity, 0, len(input.Candles))
+	if validation.IsEmpty() {
+		step := interval.Duration()
+		for i, candle := range input.Candles {
+			field := fmt.Sprintf("candles[%d]", i)
+			if !candle.Timestamp.Truncate(step).Equal(candle.Timestamp) {
+				validation.Add(field+".timestamp", "must start a "+string(interval)+" bucket")
+				continue
+			}
+			entity, err := entities.NewPriceHistoryEntity(entities.PriceHistoryParams{
+				Symbol:    symbol,
+				Interval:  interval,
+				Timestamp: candle.Timestamp,
+				Open:      candle.Open,
+				High:      candle.High,
+				Low:       candle.Low,
+				Close:     candle.Close,
+				Volume:    candle.Volume,
+			})
+			if err != nil {
+				validation.Add(field, err.Error())
+				continue
+			}
+			history = append(history, entity)
+		}
+	}
+
+	if !validation.IsEmpty() {
+		return BackfillPriceHistoryResult{}, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"invalid price history backfill",
+			fiber.StatusBadRequest,
+			validation,
+			map[string]any{"errors": validation},
+		)
+	}
+
+	result := BackfillPriceHistoryResult{Received: len(history)}
+	if len(history) == 0 {
+		return result, nil
+	}
+
+	inserted, err := uc.repository.CreatePriceHistoryBatch(ctx, history)
+	if err != nil {
+		uc.logger.Error("failed to backfill price history",
+			slog.String("symbol", symbol),
+			slog.String("interval", string(interval)),
+			slog.Int("candles", len(history)),
+			slog.String("error", err.Error()))
+		return BackfillPriceHistoryResult{}, err
+	}
+	result.Inserted = inserted
+	result.Existing = int64(len(history)) - inserted
+
+	return result, nil
+}
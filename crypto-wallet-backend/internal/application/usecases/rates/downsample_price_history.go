@@ -0,0 +1,90 @@
+package rates
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// DownsamplePriceHistoryResult counts the candles one pass created and deleted per interval.
+type DownsamplePriceHistoryResult struct {
+	RolledUp map[entities.IntervalType]int64 `json:"rolled_up"`
+	Deleted  map[entities.IntervalType]int64 `json:"deleted"`
+}
+
+// DownsamplePriceHistoryUseCase applies the price history retention policy. Candles of an interval
+// that outlive its retention are first summarised into the interval's downsample target, so charts
+// over old ranges keep daily or weekly candles, and then deleted. Intervals without a retention are
+// kept indefinitely.
+type DownsamplePriceHistoryUseCase struct {
+	repository repositories.RateRepository
+	retention  map[entities.IntervalType]time.Duration
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+// NewDownsamplePriceHistoryUseCase constructs the use case. A nil retention applies
+// entities.DefaultPriceHistoryRetention; non-positive entries keep the interval indefinitely.
+func NewDownsamplePriceHistoryUseCase(repository repositories.RateRepository, retention map[entities.IntervalType]time.Duration, logger *slog.Logger) *DownsamplePriceHistoryUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if retention == nil {
+		retention = entities.DefaultPriceHistoryRetention()
+	}
+	return &DownsamplePriceHistoryUseCase{
+		repository: repository,
+		retention:  retention,
+		logger:     logger,
+		now:        func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Execute runs one pass from the finest interval to the coarsest, so candles rolled up into a
+// daily bucket are themselves rolled up into a weekly one when daily candles expire.
+func (uc *DownsamplePriceHistoryUseCase) Execute(ctx context.Context) (DownsamplePriceHistoryResult, error) {
+	result := DownsamplePriceHistoryResult{
+		RolledUp: make(map[entities.IntervalType]int64),
+		Deleted:  make(map[entities.IntervalType]int64),
+	}
+	now := uc.now()
+
+	for _, interval := range entities.SupportedIntervals() {
+		retention := uc.retention[interval]
+		if retention <= 0 {
+			continue
+		}
+		cutoff := now.Add(-retention)
+
+		if target, ok := interval.DownsampleTarget(); ok {
+			// Only whole target buckets are summarised; candles in the partial bucket at the
+			// cutoff wait for the next pass.
+			cutoff = cutoff.Truncate(target.Duration())
+			created, err := uc.repository.RollupPriceHistory(ctx, interval, target, cutoff)
+			if err != nil {
+				return result, fmt.Errorf("roll up %s candles into %s: %w", interval, target, err)
+			}
+			if created > 0 {
+				result.RolledUp[target] += created
+			}
+		}
+
+		deleted, err := uc.repository.DeleteOldPriceHistory(ctx, interval, cutoff)
+		if err != nil {
+			return result, fmt.Errorf("delete expired %s candles: %w", interval, err)
+		}
+		if deleted > 0 {
+			result.Deleted[interval] = deleted
+			uc.logger.Info("downsampled price history",
+				slog.String("interval", string(interval)),
+				slog.Time("before", cutoff),
+				slog.Int64("deleted", deleted))
+		}
+	}
+
+	return result, nil
+}
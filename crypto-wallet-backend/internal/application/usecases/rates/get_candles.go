@@ -0,0 +1,190 @@
+package rates
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+const (
+	// MaxCandlesPerSeries bounds the number of buckets returned for a single chart request.
+	MaxCandlesPerSeries = 500
+	// defaultCandleCount is the number of buckets returned when no range is supplied.
+	defaultCandleCount = 200
+)
+
+// GetCandlesInput captures query parameters for fetching OHLC candles.
+type GetCandlesInput struct {
+	Symbol   string
+	Interval string
+	From     *time.Time
+	To       *time.Time
+}
+
+// GetCandlesUseCase returns gap-filled OHLC candles for a cryptocurrency.
+type GetCandlesUseCase struct {
+	repository repositories.RateRepository
+	logger     *slog.Logger
+}
+
+// NewGetCandlesUseCase constructs a GetCandlesUseCase.
+func NewGetCandlesUseCase(repository repositories.RateRepository, logger *slog.Logger) *GetCandlesUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GetCandlesUseCase{
+		repository: repository,
+		logger:     logger,
+	}
+}
+
+// Execute runs the get candles workflow.
+func (uc *GetCandlesUseCase) Execute(ctx context.Context, input GetCandlesInput) (dto.CandleSeries, error) {
+	var validation utils.ValidationErrors
+
+	symbol := strings.ToUpper(strings.TrimSpace(input.Symbol))
+	if symbol == "" {
+		validation.Add("symbol", "is required")
+	} else if !entities.IsSupportedSymbol(symbol) {
+		validation.Add("symbol", "must be one of BTC, ETH, SOL, XLM")
+	}
+
+	requested := entities.Interval1h
+	if strings.TrimSpace(input.Interval) != "" {
+		requested = entities.IntervalType(strings.ToLower(strings.TrimSpace(input.Interval)))
+		if !entities.IsValidInterval(requested) {
+			validation.Add("interval", "must be one of 1m, 5m, 15m, 1h, 4h, 1d, 1w")
+		}
+	}
+
+	if input.From != nil && input.To != nil && !input.To.After(*input.From) {
+		validation.Add("to", "must be after from")
+	}
+
+	if !validation.IsEmpty() {
+		return dto.CandleSeries{}, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"invalid candles request",
+			fiber.StatusBadRequest,
+			validation,
+			map[string]any{"errors": validation},
+		)
+	}
+
+	to := time.Now().UTC()
+	if input.To != nil {
+		to = input.To.UTC()
+	}
+
+	from := to.Add(-time.Duration(defaultCandleCount) * requested.Duration())
+	if input.From != nil {
+		from = input.From.UTC()
+	}
+
+	// Downgrade the resolution until the range fits within the candle budget.
+	interval := resolveCandleInterval(requested, to.Sub(from))
+	step := interval.Duration()
+	from = from.Truncate(step)
+
+	filter := repositories.PriceHistoryFilter{
+		Symbol:   symbol,
+		Interval: interval,
+		From:     &from,
+		To:       &to,
+	}
+
+	opts := repositories.ListOptions{
+		Limit:     MaxCandlesPerSeries + 1,
+		SortBy:    "timestamp",
+		SortOrder: repositories.SortAscending,
+	}
+
+	history, err := uc.repository.ListPriceHistory(ctx, filter, opts)
+	if err != nil {
+		uc.logger.Error("Failed to fetch candles",
+			"symbol", symbol,
+			"interval", interval,
+			"error", err)
+		return dto.CandleSeries{}, utils.NewAppError(
+			"DATABASE_ERROR",
+			"failed to fetch candles",
+			fiber.StatusInternalServerError,
+			err,
+			nil,
+		)
+	}
+
+	return dto.CandleSeries{
+		Symbol:            symbol,
+		Interval:          string(interval),
+		RequestedInterval: string(requested),
+		From:              from,
+		To:                to,
+		Candles:           fillCandleGaps(history, from, to, step),
+	}, nil
+}
+
+// resolveCandleInterval selects the finest interval not exceeding the candle budget for the supplied range.
+func resolveCandleInterval(requested entities.IntervalType, span time.Duration) entities.IntervalType {
+	interval := requested
+	for span/interval.Duration() > MaxCandlesPerSeries {
+		coarser, ok := interval.Coarser()
+		if !ok {
+			break
+		}
+		interval = coarser
+	}
+	return interval
+}
+
+// fillCandleGaps emits one candle per bucket, carrying the previous close forward for empty buckets.
+func fillCandleGaps(history []entities.PriceHistory, from, to time.Time, step time.Duration) []dto.Candle {
+	byBucket := make(map[int64]entities.PriceHistory, len(history))
+	for _, point := range history {
+		byBucket[point.GetTimestamp().Truncate(step).Unix()] = point
+	}
+
+	candles := make([]dto.Candle, 0, len(history))
+	var previous entities.PriceHistory
+
+	for bucket := from; !bucket.After(to) && len(candles) < MaxCandlesPerSeries; bucket = bucket.Add(step) {
+		if point, ok := byBucket[bucket.Unix()]; ok {
+			candles = append(candles, dto.Candle{
+				Timestamp: bucket,
+				Open:      point.GetOpen().String(),
+				High:      point.GetHigh().String(),
+				Low:       point.GetLow().String(),
+				Close:     point.GetClose().String(),
+				Volume:    point.GetVolume().String(),
+			})
+			previous = point
+			continue
+		}
+
+		// No leading fill: the series starts at the first bucket with real data.
+		if previous == nil {
+			continue
+		}
+
+		closePrice := previous.GetClose().String()
+		candles = append(candles, dto.Candle{
+			Timestamp: bucket,
+			Open:      closePrice,
+			High:      closePrice,
+			Low:       closePrice,
+			Close:     closePrice,
+			Volume:    "0",
+			Filled:    true,
+		})
+	}
+
+	return candles
+}
@@ -92,15 +92,24 @@ func (uc *GetCurrentRatesUseCase) Execute(ctx context.Context, input GetCurrentR
 	// Map entities to DTOs
 	rateDTOs := make([]dto.ExchangeRate, len(rates))
 	var mostRecentUpdate time.Time
+	now := time.Now().UTC()
+	staleSymbols := make([]string, 0)
 
 	for i, rate := range rates {
+		stale := rate.IsStale(now, entities.DefaultRateStaleAfter)
+		if stale {
+			staleSymbols = append(staleSymbols, rate.GetSymbol())
+		}
+
 		rateDTOs[i] = dto.ExchangeRate{
 			Symbol:         rate.GetSymbol(),
 			PriceUSD:       rate.GetPriceUSD().String(),
 			PriceChange24h: rate.GetPriceChange24h().String(),
 			Volume24h:      rate.GetVolume24h().String(),
 			MarketCap:      rate.GetMarketCap().String(),
+			Source:         rate.GetSource(),
 			LastUpdated:    rate.GetLastUpdated(),
+			Stale:          stale,
 		}
 
 		// Track the most recent update time
@@ -110,7 +119,8 @@ func (uc *GetCurrentRatesUseCase) Execute(ctx context.Context, input GetCurrentR
 	}
 
 	return dto.ExchangeRateList{
-		Rates:       rateDTOs,
-		LastUpdated: mostRecentUpdate,
+		Rates:            rateDTOs,
+		LastUpdated:      mostRecentUpdate,
+		FreshnessWarning: dto.RateFreshnessWarning(staleSymbols),
 	}, nil
 }
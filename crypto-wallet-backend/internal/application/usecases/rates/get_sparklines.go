@@ -0,0 +1,170 @@
+package rates
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+const (
+	sparklineWindow   = 7 * 24 * time.Hour
+	sparklineInterval = entities.Interval1h
+	// DefaultSparklineCacheTTL controls how long computed sparklines are served from memory.
+	DefaultSparklineCacheTTL = 5 * time.Minute
+)
+
+// GetSparklinesInput captures query parameters for fetching sparklines.
+type GetSparklinesInput struct {
+	Symbols []string
+}
+
+type sparklineCacheEntry struct {
+	result    dto.SparklineList
+	expiresAt time.Time
+}
+
+// GetSparklinesUseCase returns 7-day hourly close-price series for several symbols in one call.
+type GetSparklinesUseCase struct {
+	repository repositories.RateRepository
+	logger     *slog.Logger
+	ttl        time.Duration
+
+	mu    sync.RWMutex
+	cache map[string]sparklineCacheEntry
+}
+
+// NewGetSparklinesUseCase constructs a GetSparklinesUseCase. A non-positive ttl uses DefaultSparklineCacheTTL.
+func NewGetSparklinesUseCase(repository repositories.RateRepository, ttl time.Duration, logger *slog.Logger) *GetSparklinesUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if ttl <= 0 {
+		ttl = DefaultSparklineCacheTTL
+	}
+	return &GetSparklinesUseCase{
+		repository: repository,
+		logger:     logger,
+		ttl:        ttl,
+		cache:      make(map[string]sparklineCacheEntry),
+	}
+}
+
+// Execute runs the get sparklines workflow.
+func (uc *GetSparklinesUseCase) Execute(ctx context.Context, input GetSparklinesInput) (dto.SparklineList, error) {
+	var validation utils.ValidationErrors
+
+	// Normalize, de-duplicate and validate symbols
+	seen := make(map[string]struct{}, len(input.Symbols))
+	symbols := make([]string, 0, len(input.Symbols))
+	for _, symbol := range input.Symbols {
+		normalized := strings.ToUpper(strings.TrimSpace(symbol))
+		if normalized == "" {
+			continue
+		}
+		if !entities.IsSupportedSymbol(normalized) {
+			validation.Add("symbols", "contains unsupported symbol: "+normalized)
+			continue
+		}
+		if _, ok := seen[normalized]; ok {
+			continue
+		}
+		seen[normalized] = struct{}{}
+		symbols = append(symbols, normalized)
+	}
+
+	if len(symbols) == 0 && validation.IsEmpty() {
+		symbols = entities.SupportedSymbols()
+	}
+
+	if !validation.IsEmpty() {
+		return dto.SparklineList{}, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"invalid sparklines request",
+			fiber.StatusBadRequest,
+			validation,
+			map[string]any{"errors": validation},
+		)
+	}
+
+	sort.Strings(symbols)
+	key := strings.Join(symbols, ",")
+	now := time.Now().UTC()
+
+	if cached, ok := uc.lookup(key, now); ok {
+		return cached, nil
+	}
+
+	series, err := uc.repository.ListCloseSeries(ctx, symbols, sparklineInterval, now.Add(-sparklineWindow))
+	if err != nil {
+		uc.logger.Error("Failed to fetch sparklines",
+			"symbols", symbols,
+			"error", err)
+		return dto.SparklineList{}, utils.NewAppError(
+			"DATABASE_ERROR",
+			"failed to fetch sparklines",
+			fiber.StatusInternalServerError,
+			err,
+			nil,
+		)
+	}
+
+	sparklines := make([]dto.Sparkline, 0, len(symbols))
+	for _, symbol := range symbols {
+		values := series[symbol]
+		points := make([]string, len(values))
+		for i, value := range values {
+			points[i] = value.String()
+		}
+		sparklines = append(sparklines, dto.Sparkline{
+			Symbol:   symbol,
+			Interval: string(sparklineInterval),
+			Points:   points,
+		})
+	}
+
+	result := dto.SparklineList{
+		Sparklines:  sparklines,
+		GeneratedAt: now,
+	}
+	uc.store(key, result, now)
+
+	return result, nil
+}
+
+func (uc *GetSparklinesUseCase) lookup(key string, now time.Time) (dto.SparklineList, bool) {
+	uc.mu.RLock()
+	defer uc.mu.RUnlock()
+
+	entry, ok := uc.cache[key]
+	if !ok || now.After(entry.expiresAt) {
+		return dto.SparklineList{}, false
+	}
+	return entry.result, true
+}
+
+func (uc *GetSparklinesUseCase) store(key string, result dto.SparklineList, now time.Time) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	// Drop expired entries so arbitrary symbol combinations cannot grow the cache unbounded.
+	for existing, entry := range uc.cache {
+		if now.After(entry.expiresAt) {
+			delete(uc.cache, existing)
+		}
+	}
+
+	uc.cache[key] = sparklineCacheEntry{
+		result:    result,
+		expiresAt: now.Add(uc.ttl),
+	}
+}
@@ -0,0 +1,37 @@
+package rates
+
+import (
+	"context"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// GetSymbolCatalogUseCase lists the symbols and candle intervals available for chart queries.
+type GetSymbolCatalogUseCase struct{}
+
+// NewGetSymbolCatalogUseCase constructs a GetSymbolCatalogUseCase.
+func NewGetSymbolCatalogUseCase() *GetSymbolCatalogUseCase {
+	return &GetSymbolCatalogUseCase{}
+}
+
+// Execute returns the symbol catalog.
+func (uc *GetSymbolCatalogUseCase) Execute(ctx context.Context) (dto.RateSymbolCatalog, error) {
+	intervals := make([]string, 0, len(entities.SupportedIntervals()))
+	for _, interval := range entities.SupportedIntervals() {
+		intervals = append(intervals, string(interval))
+	}
+
+	symbols := make([]dto.RateSymbol, 0, len(entities.SupportedSymbols()))
+	for _, symbol := range entities.SupportedSymbols() {
+		symbols = append(symbols, dto.RateSymbol{
+			Symbol:    symbol,
+			Intervals: intervals,
+		})
+	}
+
+	return dto.RateSymbolCatalog{
+		Symbols:    symbols,
+		MaxCandles: MaxCandlesPerSeries,
+	}, nil
+}
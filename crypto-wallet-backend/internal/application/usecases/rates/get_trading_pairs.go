@@ -0,0 +1,75 @@
+package rates
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// GetTradingPairsUseCase returns the active trading pairs with their current rates.
+type GetTradingPairsUseCase struct {
+	pairs  repositories.TradingPairRepository
+	logger *slog.Logger
+}
+
+// NewGetTradingPairsUseCase constructs a GetTradingPairsUseCase.
+func NewGetTradingPairsUseCase(pairs repositories.TradingPairRepository, logger *slog.Logger) *GetTradingPairsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GetTradingPairsUseCase{
+		pairs:  pairs,
+		logger: logger,
+	}
+}
+
+// Execute runs the get trading pairs workflow.
+func (uc *GetTradingPairsUseCase) Execute(ctx context.Context) (dto.TradingPairsResponse, error) {
+	pairs, err := uc.pairs.GetActivePairs(ctx)
+	if err != nil {
+		uc.logger.Error("Failed to fetch trading pairs", "error", err)
+		return dto.TradingPairsResponse{}, utils.NewAppError(
+			"DATABASE_ERROR",
+			"failed to fetch trading pairs",
+			fiber.StatusInternalServerError,
+			err,
+			nil,
+		)
+	}
+
+	now := time.Now().UTC()
+	staleSymbols := make([]string, 0)
+	pairResponses := make([]dto.ExchangeRateResponse, len(pairs))
+	for i, pair := range pairs {
+		stale := entities.IsRateStale(pair.GetLastUpdated(), now, entities.DefaultRateStaleAfter)
+		if stale {
+			staleSymbols = append(staleSymbols, pair.GetBaseSymbol()+"/"+pair.GetQuoteSymbol())
+		}
+		pairResponses[i] = dto.ExchangeRateResponse{
+			BaseSymbol:    pair.GetBaseSymbol(),
+			QuoteSymbol:   pair.GetQuoteSymbol(),
+			ExchangeRate:  pair.GetExchangeRate(),
+			InverseRate:   pair.GetInverseRate(),
+			FeePercentage: pair.GetFeePercentage(),
+			MinSwapAmount: pair.GetMinSwapAmount(),
+			MaxSwapAmount: pair.GetMaxSwapAmount(),
+			IsActive:      pair.IsActive(),
+			HasLiquidity:  pair.HasLiquidity(),
+			Source:        entities.RateSourceInternal,
+			LastUpdated:   pair.GetLastUpdated(),
+			Stale:         stale,
+		}
+	}
+
+	return dto.TradingPairsResponse{
+		Pairs:            pairResponses,
+		FreshnessWarning: dto.RateFreshnessWarning(staleSymbols),
+	}, nil
+}
@@ -0,0 +1,295 @@
+package rates
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/external"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+const (
+	// DefaultBackfillPace spaces provider requests to stay under the CoinGecko free tier limit of
+	// 30 calls a minute, leaving room for the live rate sync.
+	DefaultBackfillPace = 2500 * time.Millisecond
+	// defaultRateLimitBackoff is the first wait after the provider rejects a request as over its
+	// rate limit; it doubles with each consecutive rejection.
+	defaultRateLimitBackoff = 30 * time.Second
+	maxRateLimitRetries     = 5
+)
+
+// backfillWindows is the range fetched per provider request for each interval the provider keeps
+// history for. CoinGecko returns hourly samples for ranges up to 90 days and daily samples beyond,
+// so finer intervals cannot be backfilled from it. Windows are whole multiples of the interval.
+var backfillWindows = map[entities.IntervalType]time.Duration{
+	entities.Interval1h: 30 * 24 * time.Hour,
+	entities.Interval4h: 30 * 24 * time.Hour,
+	entities.Interval1d: 364 * 24 * time.Hour,
+	entities.Interval1w: 364 * 24 * time.Hour,
+}
+
+// HistoricalPriceSource fetches past price samples. external.CoinGeckoClient satisfies it.
+type HistoricalPriceSource interface {
+	GetPriceRange(ctx context.Context, symbol string, from, to time.Time) ([]external.PriceSample, error)
+}
+
+// RunPriceBackfillInput selects the candles to backfill. A zero To backfills up to the last closed
+// bucket.
+type RunPriceBackfillInput struct {
+	Symbol   string
+	Interval string
+	From     time.Time
+	To       time.Time
+}
+
+// RunPriceBackfillConfig configures RunPriceBackfillUseCase.
+type RunPriceBackfillConfig struct {
+	Source  HistoricalPriceSource
+	Jobs    repositories.PriceBackfillRepository
+	Candles *BackfillPriceHistoryUseCase
+	// Pace is the minimum time between provider requests; it defaults to DefaultBackfillPace.
+	Pace   time.Duration
+	Logger *slog.Logger
+}
+
+// RunPriceBackfillUseCase fills price_history from the provider's history, one window per request.
+// Progress is saved after every window, so a run that fails or is interrupted resumes from the
+// last stored window when started again with the same symbol, interval and start.
+type RunPriceBackfillUseCase struct {
+	source  HistoricalPriceSource
+	jobs    repositories.PriceBackfillRepository
+	candles *BackfillPriceHistoryUseCase
+	pace    time.Duration
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+// NewRunPriceBackfillUseCase constructs a RunPriceBackfillUseCase.
+func NewRunPriceBackfillUseCase(cfg RunPriceBackfillConfig) *RunPriceBackfillUseCase {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	pace := cfg.Pace
+	if pace <= 0 {
+		pace = DefaultBackfillPace
+	}
+	return &RunPriceBackfillUseCase{
+		source:  cfg.Source,
+		jobs:    cfg.Jobs,
+		candles: cfg.Candles,
+		pace:    pace,
+		logger:  logger,
+		now:     func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Execute runs or resumes the backfill and returns the job's final state. When a window fails the
+// job is saved as failed with the error and the error is returned.
+func (uc *RunPriceBackfillUseCase) Execute(ctx context.Context, input RunPriceBackfillInput) (entities.PriceBackfillJob, error) {
+	var validation utils.ValidationErrors
+
+	symbol := strings.ToUpper(strings.TrimSpace(input.Symbol))
+	if symbol == "" {
+		validation.Add("symbol", "is required")
+	} else if !entities.IsSupportedSymbol(symbol) {
+		validation.Add("symbol", "must be a listed symbol")
+	}
+
+	interval := entities.IntervalType(strings.ToLower(strings.TrimSpace(input.Interval)))
+	window, ok := backfillWindows[interval]
+	if !ok {
+		validation.Add("interval", "must be one of 1h, 4h, 1d, 1w")
+	}
+
+	now := uc.now()
+	var from, to time.Time
+	if input.From.IsZero() {
+		validation.Add("from", "is required")
+	} else if ok {
+		// Only closed buckets are backfilled; the live sync builds the current one.
+		step := interval.Duration()
+		from = input.From.UTC().Truncate(step)
+		to = now
+		if !input.To.IsZero() && input.To.Before(now) {
+			to = input.To.UTC()
+		}
+		to = to.Truncate(step)
+		if !to.After(from) {
+			validation.Add("to", "must leave at least one closed bucket after from")
+		}
+	}
+
+	if !validation.IsEmpty() {
+		return entities.PriceBackfillJob{}, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"invalid price backfill request",
+			fiber.StatusBadRequest,
+			validation,
+			map[string]any{"errors": validation},
+		)
+	}
+
+	job, err := uc.jobs.StartPriceBackfill(ctx, entities.PriceBackfillJob{
+		ID:         uuid.New(),
+		Symbol:     symbol,
+		Interval:   interval,
+		RangeStart: from,
+		RangeEnd:   to,
+		Cursor:     from,
+		Status:     entities.PriceBackfillStatusRunning,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	})
+	if err != nil {
+		return entities.PriceBackfillJob{}, err
+	}
+	if job.Cursor.After(job.RangeStart) {
+		uc.logger.Info("resuming price backfill",
+			slog.String("job_id", job.ID.String()),
+			slog.String("symbol", symbol),
+			slog.String("interval", string(interval)),
+			slog.Time("cursor", job.Cursor))
+	}
+
+	var lastRequest time.Time
+	for !job.Done() {
+		windowEnd := job.Cursor.Add(window)
+		if windowEnd.After(job.RangeEnd) {
+			windowEnd = job.RangeEnd
+		}
+
+		samples, err := uc.fetch(ctx, symbol, job.Cursor, windowEnd, &lastRequest)
+		if err != nil {
+			return uc.fail(job, err)
+		}
+		stored, err := uc.candles.Execute(ctx, BackfillPriceHistoryInput{
+			Symbol:   symbol,
+			Interval: string(interval),
+			Candles:  foldSamples(samples, job.Cursor, windowEnd, interval.Duration()),
+		})
+		if err != nil {
+			return uc.fail(job, err)
+		}
+
+		job.Cursor = windowEnd
+		job.CandlesInserted += stored.Inserted
+		job.Status = entities.PriceBackfillStatusRunning
+		job.LastError = ""
+		job.UpdatedAt = uc.now()
+		if job.Done() {
+			job.Status = entities.PriceBackfillStatusCompleted
+		}
+		if err := uc.jobs.UpdatePriceBackfill(ctx, job); err != nil {
+			return job, err
+		}
+
+		uc.logger.Info("price backfill window stored",
+			slog.String("job_id", job.ID.String()),
+			slog.String("symbol", symbol),
+			slog.String("interval", string(interval)),
+			slog.Time("cursor", job.Cursor),
+			slog.Int("samples", len(samples)),
+			slog.Int64("inserted", stored.Inserted))
+	}
+
+	return job, nil
+}
+
+// fetch requests one window, keeping to the pace after lastRequest and backing off while the
+// provider reports its rate limit as exceeded.
+func (uc *RunPriceBackfillUseCase) fetch(ctx context.Context, symbol string, from, to time.Time, lastRequest *time.Time) ([]external.PriceSample, error) {
+	backoff := defaultRateLimitBackoff
+	for attempt := 0; ; attempt++ {
+		if wait := uc.pace - uc.now().Sub(*lastRequest); wait > 0 {
+			if err := sleepContext(ctx, wait); err != nil {
+				return nil, err
+			}
+		}
+		*lastRequest = uc.now()
+
+		samples, err := uc.source.GetPriceRange(ctx, symbol, from, to)
+		if err == nil || !errors.Is(err, external.ErrRateLimitExceeded) || attempt == maxRateLimitRetries {
+			return samples, err
+		}
+
+		uc.logger.Warn("price provider rate limit reached, backing off",
+			slog.String("symbol", symbol),
+			slog.Duration("backoff", backoff))
+		if err := sleepContext(ctx, backoff); err != nil {
+			return nil, err
+		}
+		backoff *= 2
+	}
+}
+
+// fail records the error on the job. The save outlives a cancelled context so an interrupted run
+// still leaves its progress and reason behind.
+func (uc *RunPriceBackfillUseCase) fail(job entities.PriceBackfillJob, cause error) (entities.PriceBackfillJob, error) {
+	job.Status = entities.PriceBackfillStatusFailed
+	job.LastError = cause.Error()
+	job.UpdatedAt = uc.now()
+
+	saveCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := uc.jobs.UpdatePriceBackfill(saveCtx, job); err != nil {
+		uc.logger.Error("failed to record price backfill failure",
+			slog.String("job_id", job.ID.String()),
+			slog.String("error", err.Error()))
+	}
+	return job, cause
+}
+
+// foldSamples builds the candles of every bucket in [from, to) that holds at least one sample.
+func foldSamples(samples []external.PriceSample, from, to time.Time, step time.Duration) []external.OHLCVData {
+	sort.SliceStable(samples, func(i, j int) bool {
+		return samples[i].Timestamp.Before(samples[j].Timestamp)
+	})
+
+	candles := make([]external.OHLCVData, 0)
+	for _, sample := range samples {
+		if sample.Timestamp.Before(from) || !sample.Timestamp.Before(to) || !sample.Price.IsPositive() {
+			continue
+		}
+		bucket := sample.Timestamp.Truncate(step)
+		if n := len(candles); n > 0 && candles[n-1].Timestamp.Equal(bucket) {
+			candle := &candles[n-1]
+			if sample.Price.GreaterThan(candle.High) {
+				candle.High = sample.Price
+			}
+			if sample.Price.LessThan(candle.Low) {
+				candle.Low = sample.Price
+			}
+			candle.Close = sample.Price
+			continue
+		}
+		candles = append(candles, external.OHLCVData{
+			Timestamp: bucket,
+			Open:      sample.Price,
+			High:      sample.Price,
+			Low:       sample.Price,
+			Close:     sample.Price,
+		})
+	}
+	return candles
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,99 @@
+package rates
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/external"
+)
+
+// FiatRateSource fetches fiat reference rates. external.FXRateClient satisfies it.
+type FiatRateSource interface {
+	GetRates(ctx context.Context, base string, currencies []string) (*external.FXRates, error)
+}
+
+// FiatRateInvalidator drops cached fiat rates after a sync. services.CurrencyConverter satisfies it.
+type FiatRateInvalidator interface {
+	Invalidate()
+}
+
+// SyncFiatRatesUseCase pulls USD reference rates for every supported display currency into the
+// rates database.
+type SyncFiatRatesUseCase struct {
+	source     FiatRateSource
+	repository repositories.FiatRateRepository
+	cache      FiatRateInvalidator
+	sourceName string
+	logger     *slog.Logger
+}
+
+// NewSyncFiatRatesUseCase constructs the use case. cache may be nil; sourceName is stored with
+// each rate to record where it came from.
+func NewSyncFiatRatesUseCase(source FiatRateSource, repository repositories.FiatRateRepository, cache FiatRateInvalidator, sourceName string, logger *slog.Logger) *SyncFiatRatesUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if sourceName == "" {
+		sourceName = "fx-provider"
+	}
+	return &SyncFiatRatesUseCase{
+		source:     source,
+		repository: repository,
+		cache:      cache,
+		sourceName: sourceName,
+		logger:     logger,
+	}
+}
+
+// Execute runs one sync pass and returns how many rates were stored. Currencies the source does
+// not quote keep their previous rate.
+func (uc *SyncFiatRatesUseCase) Execute(ctx context.Context) (int, error) {
+	currencies := make([]string, 0, len(entities.SupportedCurrencies()))
+	for _, code := range entities.SupportedCurrencies() {
+		if code != entities.CurrencyUSD {
+			currencies = append(currencies, string(code))
+		}
+	}
+
+	fetched, err := uc.source.GetRates(ctx, string(entities.CurrencyUSD), currencies)
+	if err != nil {
+		return 0, fmt.Errorf("fetch fiat rates: %w", err)
+	}
+
+	asOf := fetched.AsOf
+	if asOf.IsZero() {
+		asOf = time.Now().UTC()
+	}
+
+	rates := make([]entities.FiatRate, 0, len(currencies))
+	for _, currency := range currencies {
+		value, ok := fetched.Rates[currency]
+		if !ok {
+			uc.logger.Warn("fiat rate missing from source", slog.String("currency", currency))
+			continue
+		}
+		rate := entities.FiatRate{
+			Currency:  entities.CurrencyCode(currency),
+			PerUSD:    value,
+			Source:    uc.sourceName,
+			UpdatedAt: asOf,
+		}
+		if err := rate.Validate(); err != nil {
+			uc.logger.Warn("skipping invalid fiat rate", slog.String("currency", currency), slog.String("error", err.Error()))
+			continue
+		}
+		rates = append(rates, rate)
+	}
+
+	if err := uc.repository.UpsertFiatRates(ctx, rates); err != nil {
+		return 0, fmt.Errorf("store fiat rates: %w", err)
+	}
+	if uc.cache != nil {
+		uc.cache.Invalidate()
+	}
+	return len(rates), nil
+}
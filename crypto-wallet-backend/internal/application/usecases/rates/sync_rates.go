@@ -0,0 +1,274 @@
+package rates
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/external"
+	"github.com/crypto-wallet/backend/internal/infrastructure/messaging"
+)
+
+// rollupBatchLimit comfortably covers the finer candles inside one coarser bucket (at most 7).
+const rollupBatchLimit = 16
+
+// PriceSource fetches current market prices. external.CoinGeckoClient satisfies it.
+type PriceSource interface {
+	GetPrices(ctx context.Context, symbols []string) (map[string]*external.CoinGeckoPriceData, error)
+}
+
+// PricePublisher broadcasts price updates to live subscribers. messaging.RedisPubSubManager satisfies it.
+type PricePublisher interface {
+	PublishPrice(ctx context.Context, symbol string, priceData *messaging.PriceUpdateMessage) error
+	PublishBatchPrices(ctx context.Context, prices []*messaging.PriceUpdateMessage) error
+}
+
+// openCandle accumulates the ticks of the current one-minute bucket of a symbol.
+type openCandle struct {
+	start time.Time
+	open  decimal.Decimal
+	high  decimal.Decimal
+	low   decimal.Decimal
+	close decimal.Decimal
+}
+
+func (c *openCandle) add(price decimal.Decimal) {
+	if price.GreaterThan(c.high) {
+		c.high = price
+	}
+	if price.LessThan(c.low) {
+		c.low = price
+	}
+	c.close = price
+}
+
+// SyncRatesUseCase pulls current prices into the rates database and broadcasts them. Each pass
+// upserts the latest rate per symbol and folds the price into one-minute candles; once a bucket
+// closes its candle is stored and rolled up into every coarser interval whose bucket closed with it.
+type SyncRatesUseCase struct {
+	source     PriceSource
+	repository repositories.RateRepository
+	publisher  PricePublisher
+	symbols    []string
+	logger     *slog.Logger
+	now        func() time.Time
+
+	mu      sync.Mutex
+	candles map[string]*openCandle
+}
+
+// NewSyncRatesUseCase constructs the use case. publisher may be nil to skip broadcasting; an empty
+// symbol list syncs every supported symbol.
+func NewSyncRatesUseCase(source PriceSource, repository repositories.RateRepository, publisher PricePublisher, symbols []string, logger *slog.Logger) *SyncRatesUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if len(symbols) == 0 {
+		symbols = entities.SupportedSymbols()
+	}
+	return &SyncRatesUseCase{
+		source:     source,
+		repository: repository,
+		publisher:  publisher,
+		symbols:    symbols,
+		logger:     logger,
+		now:        time.Now,
+		candles:    make(map[string]*openCandle),
+	}
+}
+
+// Execute runs one sync pass and returns how many rates were stored.
+func (uc *SyncRatesUseCase) Execute(ctx context.Context) (int, error) {
+	prices, err := uc.source.GetPrices(ctx, uc.symbols)
+	if err != nil {
+		return 0, fmt.Errorf("fetch prices: %w", err)
+	}
+
+	symbols := make([]string, 0, len(prices))
+	for symbol := range prices {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+
+	now := uc.now().UTC()
+	stored := 0
+	messages := make([]*messaging.PriceUpdateMessage, 0, len(symbols))
+	for _, symbol := range symbols {
+		if err := ctx.Err(); err != nil {
+			return stored, err
+		}
+		price := prices[symbol]
+		if price == nil {
+			continue
+		}
+
+		if err := uc.storeRate(ctx, symbol, price, now); err != nil {
+			uc.logger.Error("failed to store exchange rate", slog.String("symbol", symbol), slog.String("error", err.Error()))
+		} else {
+			stored++
+		}
+
+		uc.recordTick(ctx, symbol, price.PriceUSD, now)
+
+		messages = append(messages, &messaging.PriceUpdateMessage{
+			Symbol:         symbol,
+			PriceUSD:       price.PriceUSD.String(),
+			PriceChange24h: price.PriceChange24h.String(),
+			Volume24h:      price.Volume24h.String(),
+			Timestamp:      price.LastUpdated.UTC().Format(time.RFC3339),
+		})
+	}
+
+	uc.publish(ctx, messages)
+	return stored, nil
+}
+
+func (uc *SyncRatesUseCase) storeRate(ctx context.Context, symbol string, price *external.CoinGeckoPriceData, now time.Time) error {
+	lastUpdated := price.LastUpdated
+	if lastUpdated.IsZero() {
+		lastUpdated = now
+	}
+
+	rate, err := entities.NewExchangeRateEntity(entities.ExchangeRateParams{
+		Symbol:         symbol,
+		PriceUSD:       price.PriceUSD,
+		PriceChange24h: price.PriceChange24h,
+		Volume24h:      price.Volume24h,
+		MarketCap:      price.MarketCap,
+		LastUpdated:    lastUpdated,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	})
+	if err != nil {
+		return err
+	}
+	return uc.repository.UpsertRate(ctx, rate)
+}
+
+// recordTick folds a price into the symbol's open one-minute candle, closing the previous candle
+// when the tick falls into a later bucket. Candle failures are logged; they never fail the sync.
+func (uc *SyncRatesUseCase) recordTick(ctx context.Context, symbol string, price decimal.Decimal, at time.Time) {
+	if !price.IsPositive() {
+		return
+	}
+	bucket := at.Truncate(entities.Interval1m.Duration())
+
+	uc.mu.Lock()
+	current := uc.candles[symbol]
+	if current != nil && !bucket.After(current.start) {
+		current.add(price)
+		uc.mu.Unlock()
+		return
+	}
+	uc.candles[symbol] = &openCandle{start: bucket, open: price, high: price, low: price, close: price}
+	uc.mu.Unlock()
+
+	// The first bucket after start-up is partial, so nothing is written for it until it closes.
+	if current == nil {
+		return
+	}
+
+	uc.writeCandle(ctx, symbol, entities.Interval1m, current.start, current.open, current.high, current.low, current.close, decimal.Zero)
+
+	// Roll up every boundary crossed since the closed candle, including those skipped by missed passes.
+	step := entities.Interval1m.Duration()
+	for boundary := current.start.Add(step); !boundary.After(bucket); boundary = boundary.Add(step) {
+		uc.rollup(ctx, symbol, boundary)
+	}
+}
+
+// rollup builds the candles of each coarser interval whose bucket ends at boundary from the stored
+// candles one resolution finer.
+func (uc *SyncRatesUseCase) rollup(ctx context.Context, symbol string, boundary time.Time) {
+	finer := entities.Interval1m
+	for {
+		coarser, ok := finer.Coarser()
+		if !ok {
+			return
+		}
+		step := coarser.Duration()
+		if !boundary.Truncate(step).Equal(boundary) {
+			return
+		}
+
+		start := boundary.Add(-step)
+		to := boundary.Add(-time.Second)
+		history, err := uc.repository.ListPriceHistory(ctx, repositories.PriceHistoryFilter{
+			Symbol:   symbol,
+			Interval: finer,
+			From:     &start,
+			To:       &to,
+		}, repositories.ListOptions{
+			Limit:     rollupBatchLimit,
+			SortBy:    "timestamp",
+			SortOrder: repositories.SortAscending,
+		})
+		if err != nil {
+			uc.logger.Error("failed to load candles for rollup",
+				slog.String("symbol", symbol),
+				slog.String("interval", string(coarser)),
+				slog.String("error", err.Error()))
+			return
+		}
+		if len(history) == 0 {
+			return
+		}
+
+		high, low, volume := history[0].GetHigh(), history[0].GetLow(), decimal.Zero
+		for _, candle := range history {
+			high = decimal.Max(high, candle.GetHigh())
+			low = decimal.Min(low, candle.GetLow())
+			volume = volume.Add(candle.GetVolume())
+		}
+		uc.writeCandle(ctx, symbol, coarser, start, history[0].GetOpen(), high, low, history[len(history)-1].GetClose(), volume)
+
+		finer = coarser
+	}
+}
+
+func (uc *SyncRatesUseCase) writeCandle(ctx context.Context, symbol string, interval entities.IntervalType, start time.Time, open, high, low, closePrice, volume decimal.Decimal) {
+	candle, err := entities.NewPriceHistoryEntity(entities.PriceHistoryParams{
+		Symbol:    symbol,
+		Interval:  interval,
+		Timestamp: start,
+		Open:      open,
+		High:      high,
+		Low:       low,
+		Close:     closePrice,
+		Volume:    volume,
+	})
+	if err == nil {
+		err = uc.repository.CreatePriceHistory(ctx, candle)
+	}
+	if err != nil {
+		uc.logger.Error("failed to store candle",
+			slog.String("symbol", symbol),
+			slog.String("interval", string(interval)),
+			slog.Time("timestamp", start),
+			slog.String("error", err.Error()))
+	}
+}
+
+// publish broadcasts each price on its symbol channel and the whole set on the batch channel.
+// Broadcasting is best effort: the rates are already stored.
+func (uc *SyncRatesUseCase) publish(ctx context.Context, messages []*messaging.PriceUpdateMessage) {
+	if uc.publisher == nil || len(messages) == 0 {
+		return
+	}
+
+	for _, message := range messages {
+		if err := uc.publisher.PublishPrice(ctx, message.Symbol, message); err != nil {
+			uc.logger.Warn("failed to publish price update", slog.String("symbol", message.Symbol), slog.String("error", err.Error()))
+		}
+	}
+	if err := uc.publisher.PublishBatchPrices(ctx, messages); err != nil {
+		uc.logger.Warn("failed to publish batch price update", slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,165 @@
+package reconciliation
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// reportListCaps bounds run and discrepancy listings.
+var reportListCaps = repositories.PaginationCaps{MaxLimit: 200, MaxOffset: 10000}
+
+// ListDiscrepanciesInput filters the discrepancy report. Empty fields are not filtered on.
+type ListDiscrepanciesInput struct {
+	Status string
+	Chain  string
+	RunID  string
+	Limit  int
+	Offset int
+}
+
+// ListDiscrepanciesUseCase lists recorded discrepancies, largest first.
+type ListDiscrepanciesUseCase struct {
+	runs   repositories.BalanceReconciliationRepository
+	logger *slog.Logger
+}
+
+// NewListDiscrepanciesUseCase constructs the use case.
+func NewListDiscrepanciesUseCase(runs repositories.BalanceReconciliationRepository, logger *slog.Logger) *ListDiscrepanciesUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ListDiscrepanciesUseCase{
+		runs:   runs,
+		logger: logger,
+	}
+}
+
+// Execute returns a page of discrepancies.
+func (uc *ListDiscrepanciesUseCase) Execute(ctx context.Context, input ListDiscrepanciesInput) (dto.BalanceDiscrepancyList, error) {
+	opts := repositories.ListOptions{
+		Limit:  input.Limit,
+		Offset: input.Offset,
+		Caps:   reportListCaps,
+	}
+	if err := opts.CheckCaps(); err != nil {
+		return dto.BalanceDiscrepancyList{}, err
+	}
+	opts = opts.WithDefaults()
+
+	var (
+		errs   utils.ValidationErrors
+		filter repositories.BalanceDiscrepancyFilter
+	)
+	if input.Status != "" {
+		status := entities.BalanceDiscrepancyStatus(input.Status)
+		if status.IsValid() {
+			filter.Status = &status
+		} else {
+			errs.Add("status", "must be one of corrected, open, resolved")
+		}
+	}
+	filter.Chain = parseChainFilter(&errs, input.Chain)
+	if input.RunID != "" {
+		runID, err := uuid.Parse(input.RunID)
+		if err != nil {
+			errs.Add("run_id", "must be a valid UUID")
+		} else {
+			filter.RunID = &runID
+		}
+	}
+	if !errs.IsEmpty() {
+		return dto.BalanceDiscrepancyList{}, wrapValidationError(errs)
+	}
+
+	discrepancies, err := uc.runs.ListDiscrepancies(ctx, filter, opts)
+	if err != nil {
+		return dto.BalanceDiscrepancyList{}, err
+	}
+
+	items := make([]dto.BalanceDiscrepancyResponse, 0, len(discrepancies))
+	for _, discrepancy := range discrepancies {
+		items = append(items, dto.NewBalanceDiscrepancyResponse(discrepancy))
+	}
+	return dto.BalanceDiscrepancyList{
+		Discrepancies: items,
+		Limit:         opts.Limit,
+		Offset:        opts.Offset,
+	}, nil
+}
+
+// ListRunsInput filters the run history.
+type ListRunsInput struct {
+	Chain  string
+	Limit  int
+	Offset int
+}
+
+// ListRunsUseCase lists reconciliation runs, newest first.
+type ListRunsUseCase struct {
+	runs   repositories.BalanceReconciliationRepository
+	logger *slog.Logger
+}
+
+// NewListRunsUseCase constructs the use case.
+func NewListRunsUseCase(runs repositories.BalanceReconciliationRepository, logger *slog.Logger) *ListRunsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ListRunsUseCase{
+		runs:   runs,
+		logger: logger,
+	}
+}
+
+// Execute returns a page of runs.
+func (uc *ListRunsUseCase) Execute(ctx context.Context, input ListRunsInput) (dto.BalanceReconciliationRunList, error) {
+	opts := repositories.ListOptions{
+		Limit:  input.Limit,
+		Offset: input.Offset,
+		Caps:   reportListCaps,
+	}
+	if err := opts.CheckCaps(); err != nil {
+		return dto.BalanceReconciliationRunList{}, err
+	}
+	opts = opts.WithDefaults()
+
+	var errs utils.ValidationErrors
+	chain := parseChainFilter(&errs, input.Chain)
+	if !errs.IsEmpty() {
+		return dto.BalanceReconciliationRunList{}, wrapValidationError(errs)
+	}
+
+	runs, err := uc.runs.ListRuns(ctx, chain, opts)
+	if err != nil {
+		return dto.BalanceReconciliationRunList{}, err
+	}
+
+	items := make([]dto.BalanceReconciliationRunResponse, 0, len(runs))
+	for _, run := range runs {
+		items = append(items, dto.NewBalanceReconciliationRunResponse(run))
+	}
+	return dto.BalanceReconciliationRunList{
+		Runs:   items,
+		Limit:  opts.Limit,
+		Offset: opts.Offset,
+	}, nil
+}
+
+func parseChainFilter(errs *utils.ValidationErrors, value string) *entities.Chain {
+	if value == "" {
+		return nil
+	}
+	chain := entities.NormalizeChain(value)
+	if chain == "" {
+		errs.Add("chain", "must be one of BTC, ETH, SOL, XLM")
+		return nil
+	}
+	return &chain
+}
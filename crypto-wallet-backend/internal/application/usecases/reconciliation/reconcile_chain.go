@@ -0,0 +1,241 @@
+package reconciliation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+)
+
+// scanPageSize is how many wallets a full scan loads per query.
+const scanPageSize = 200
+
+// Policy controls how reconciliation samples wallets and which discrepancies it fixes on its own.
+// Drift whose magnitude is at most the chain's threshold is corrected from the chain; larger
+// drift, or any drift on a chain without a threshold, is left for review.
+type Policy struct {
+	Mode       entities.BalanceReconciliationMode
+	SampleSize int
+	Thresholds map[entities.Chain]decimal.Decimal
+}
+
+func (p Policy) withDefaults() Policy {
+	if !p.Mode.IsValid() {
+		p.Mode = entities.BalanceReconciliationModeSample
+	}
+	if p.SampleSize <= 0 {
+		p.SampleSize = entities.DefaultBalanceReconciliationSampleSize
+	}
+	return p
+}
+
+// ReconcileChainUseCase compares stored wallet balances of one chain with the chain itself.
+type ReconcileChainUseCase struct {
+	wallets  WalletRepo
+	runs     repositories.BalanceReconciliationRepository
+	adapters map[entities.Chain]BalanceReader
+	policy   Policy
+	audit    AuditLogger
+	logger   *slog.Logger
+	now      func() time.Time
+}
+
+// NewReconcileChainUseCase constructs the use case.
+func NewReconcileChainUseCase(wallets WalletRepo, runs repositories.BalanceReconciliationRepository, adapters map[entities.Chain]BalanceReader, policy Policy, auditLogger AuditLogger, logger *slog.Logger) *ReconcileChainUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ReconcileChainUseCase{
+		wallets:  wallets,
+		runs:     runs,
+		adapters: adapters,
+		policy:   policy.withDefaults(),
+		audit:    auditLogger,
+		logger:   logger,
+		now:      time.Now,
+	}
+}
+
+// Chains returns the chains that have an adapter to reconcile against, in a stable order.
+func (uc *ReconcileChainUseCase) Chains() []entities.Chain {
+	chains := make([]entities.Chain, 0, len(uc.adapters))
+	for _, chain := range entities.SupportedChains() {
+		if adapter, ok := uc.adapters[chain]; ok && adapter != nil {
+			chains = append(chains, chain)
+		}
+	}
+	return chains
+}
+
+// Execute runs one reconciliation pass over the chain. Wallets whose balance cannot be read are
+// counted as failed rather than aborting the run; the run is stored even when it ends early.
+func (uc *ReconcileChainUseCase) Execute(ctx context.Context, chain entities.Chain) (*entities.BalanceReconciliationRun, error) {
+	if !entities.IsSupportedChain(chain) {
+		return nil, fmt.Errorf("reconcile balances: unsupported chain %q", chain)
+	}
+
+	run := &entities.BalanceReconciliationRun{
+		ID:        uuid.New(),
+		Chain:     chain,
+		Mode:      uc.policy.Mode,
+		StartedAt: uc.now().UTC(),
+	}
+	if err := uc.runs.CreateRun(ctx, run); err != nil {
+		return nil, err
+	}
+
+	corrected := make([]string, 0)
+	visit := func(wallets []entities.Wallet) error {
+		for _, wallet := range wallets {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if uc.reconcileWallet(ctx, run, wallet) {
+				corrected = append(corrected, wallet.GetID().String())
+			}
+		}
+		return nil
+	}
+
+	var scanErr error
+	if uc.policy.Mode == entities.BalanceReconciliationModeFull {
+		after := uuid.Nil
+		for {
+			page, err := uc.wallets.ListByChain(ctx, chain, after, scanPageSize)
+			if err != nil {
+				scanErr = err
+				break
+			}
+			if err := visit(page); err != nil {
+				scanErr = err
+				break
+			}
+			if len(page) < scanPageSize {
+				break
+			}
+			after = page[len(page)-1].GetID()
+		}
+	} else {
+		sample, err := uc.wallets.SampleByChain(ctx, chain, uc.policy.SampleSize)
+		if err == nil {
+			err = visit(sample)
+		}
+		scanErr = err
+	}
+
+	finishedAt := uc.now().UTC()
+	run.FinishedAt = &finishedAt
+	// The run is finished even when the caller's context ended, so its counts are not lost.
+	if err := uc.runs.FinishRun(context.WithoutCancel(ctx), run); err != nil {
+		uc.logger.Error("failed to store reconciliation run", slog.String("run_id", run.ID.String()), slog.String("error", err.Error()))
+	}
+
+	if len(corrected) > 0 {
+		sort.Strings(corrected)
+		recordAudit(context.WithoutCancel(ctx), uc.audit, uc.logger, audit.Entry{
+			ActorID:      "system",
+			Action:       AuditActionBalancesCorrected,
+			ResourceType: "balance_reconciliation_run",
+			TargetID:     run.ID.String(),
+			Metadata: map[string]any{
+				"chain":      string(chain),
+				"wallet_ids": corrected,
+			},
+		})
+	}
+
+	if scanErr != nil {
+		return run, scanErr
+	}
+	return run, nil
+}
+
+// reconcileWallet compares one wallet and updates the run's counts, reporting whether the stored
+// balance was corrected.
+func (uc *ReconcileChainUseCase) reconcileWallet(ctx context.Context, run *entities.BalanceReconciliationRun, wallet entities.Wallet) bool {
+	run.Scanned++
+	logger := uc.logger.With(slog.String("wallet_id", wallet.GetID().String()), slog.String("chain", string(run.Chain)))
+
+	onChain, err := fetchOnChainBalance(ctx, uc.adapters, wallet)
+	if err != nil {
+		run.Failed++
+		logger.Warn("failed to read on-chain balance", slog.String("error", err.Error()))
+		return false
+	}
+
+	if onChain.Equal(wallet.GetBalance()) {
+		run.Matched++
+		return false
+	}
+
+	now := uc.now().UTC()
+	status := entities.BalanceDiscrepancyStatusOpen
+	if uc.withinThreshold(run.Chain, onChain.Sub(wallet.GetBalance()).Abs()) {
+		status = entities.BalanceDiscrepancyStatusCorrected
+	}
+
+	discrepancy, err := entities.NewBalanceDiscrepancy(run.ID, wallet, onChain, status, now)
+	if err != nil {
+		run.Failed++
+		logger.Warn("failed to build balance discrepancy", slog.String("error", err.Error()))
+		return false
+	}
+
+	if status == entities.BalanceDiscrepancyStatusCorrected {
+		if err := uc.correct(ctx, wallet, onChain, now); err != nil {
+			// Leave the drift for review rather than dropping it.
+			logger.Warn("failed to correct wallet balance", slog.String("error", err.Error()))
+			discrepancy.Status = entities.BalanceDiscrepancyStatusOpen
+			discrepancy.Resolution = ""
+			discrepancy.ResolvedAt = nil
+		}
+	}
+
+	if err := uc.runs.RecordDiscrepancy(ctx, discrepancy); err != nil {
+		run.Failed++
+		logger.Error("failed to record balance discrepancy", slog.String("error", err.Error()))
+		return discrepancy.Status == entities.BalanceDiscrepancyStatusCorrected
+	}
+
+	if discrepancy.Status == entities.BalanceDiscrepancyStatusCorrected {
+		run.Corrected++
+		return true
+	}
+	run.Escalated++
+	logger.Info("balance discrepancy escalated for review",
+		slog.String("discrepancy_id", discrepancy.ID.String()),
+		slog.String("magnitude", discrepancy.Magnitude.String()),
+	)
+	return false
+}
+
+func (uc *ReconcileChainUseCase) withinThreshold(chain entities.Chain, magnitude decimal.Decimal) bool {
+	threshold, ok := uc.policy.Thresholds[chain]
+	if !ok || !threshold.IsPositive() {
+		return false
+	}
+	return magnitude.LessThanOrEqual(threshold)
+}
+
+func (uc *ReconcileChainUseCase) correct(ctx context.Context, wallet entities.Wallet, onChain decimal.Decimal, now time.Time) error {
+	if err := wallet.UpdateBalance(onChain, now); err != nil {
+		return err
+	}
+	wallet.Touch(now)
+	if err := uc.wallets.Update(ctx, wallet); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return fmt.Errorf("wallet %s no longer exists", wallet.GetID())
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package reconciliation
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// ResolveDiscrepancyInput closes an open discrepancy on behalf of a support agent.
+type ResolveDiscrepancyInput struct {
+	ActorID       uuid.UUID
+	DiscrepancyID uuid.UUID
+	Payload       dto.ResolveBalanceDiscrepancyRequest
+}
+
+// ResolveDiscrepancyUseCase closes review items raised by reconciliation. Correcting re-reads the
+// chain rather than trusting the balance recorded at detection time, which may be hours old.
+type ResolveDiscrepancyUseCase struct {
+	wallets  WalletRepo
+	runs     repositories.BalanceReconciliationRepository
+	adapters map[entities.Chain]BalanceReader
+	audit    AuditLogger
+	logger   *slog.Logger
+	now      func() time.Time
+}
+
+// NewResolveDiscrepancyUseCase constructs the use case.
+func NewResolveDiscrepancyUseCase(wallets WalletRepo, runs repositories.BalanceReconciliationRepository, adapters map[entities.Chain]BalanceReader, auditLogger AuditLogger, logger *slog.Logger) *ResolveDiscrepancyUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ResolveDiscrepancyUseCase{
+		wallets:  wallets,
+		runs:     runs,
+		adapters: adapters,
+		audit:    auditLogger,
+		logger:   logger,
+		now:      time.Now,
+	}
+}
+
+// Execute resolves the discrepancy, correcting the wallet first when asked to.
+func (uc *ResolveDiscrepancyUseCase) Execute(ctx context.Context, input ResolveDiscrepancyInput) (dto.BalanceDiscrepancyResponse, error) {
+	validation := input.Payload.Validate()
+	if !validation.IsEmpty() {
+		return dto.BalanceDiscrepancyResponse{}, wrapValidationError(validation)
+	}
+
+	discrepancy, err := uc.runs.GetDiscrepancy(ctx, input.DiscrepancyID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return dto.BalanceDiscrepancyResponse{}, discrepancyNotFoundError()
+		}
+		return dto.BalanceDiscrepancyResponse{}, err
+	}
+	if discrepancy.Status != entities.BalanceDiscrepancyStatusOpen {
+		return dto.BalanceDiscrepancyResponse{}, discrepancyNotOpenError(discrepancy.Status)
+	}
+
+	now := uc.now().UTC()
+	metadata := map[string]any{
+		"action":    input.Payload.Action,
+		"wallet_id": discrepancy.WalletID.String(),
+		"magnitude": discrepancy.Magnitude.String(),
+	}
+	var before, after string
+
+	if input.Payload.Action == dto.BalanceDiscrepancyActionCorrect {
+		wallet, err := uc.wallets.GetByID(ctx, discrepancy.WalletID)
+		if err != nil {
+			return dto.BalanceDiscrepancyResponse{}, err
+		}
+		onChain, err := fetchOnChainBalance(ctx, uc.adapters, wallet)
+		if err != nil {
+			return dto.BalanceDiscrepancyResponse{}, utils.NewAppError(
+				"ON_CHAIN_BALANCE_UNAVAILABLE",
+				"on-chain balance could not be read",
+				fiber.StatusBadGateway,
+				err,
+				nil,
+			)
+		}
+
+		before = wallet.GetBalance().String()
+		if err := wallet.UpdateBalance(onChain, now); err != nil {
+			return dto.BalanceDiscrepancyResponse{}, err
+		}
+		wallet.Touch(now)
+		if err := uc.wallets.Update(ctx, wallet); err != nil {
+			return dto.BalanceDiscrepancyResponse{}, err
+		}
+		after = onChain.String()
+	}
+
+	actorID := input.ActorID
+	discrepancy.Status = entities.BalanceDiscrepancyStatusResolved
+	discrepancy.ResolvedBy = &actorID
+	discrepancy.Resolution = input.Payload.Action + ": " + input.Payload.Note
+	discrepancy.ResolvedAt = &now
+
+	if err := uc.runs.ResolveDiscrepancy(ctx, discrepancy); err != nil {
+		if errors.Is(err, repositories.ErrConflict) {
+			return dto.BalanceDiscrepancyResponse{}, discrepancyNotOpenError(entities.BalanceDiscrepancyStatusResolved)
+		}
+		return dto.BalanceDiscrepancyResponse{}, err
+	}
+
+	entry := audit.Entry{
+		ActorID:      input.ActorID,
+		Action:       AuditActionDiscrepancyResolved,
+		ResourceType: "balance_discrepancy",
+		TargetID:     discrepancy.ID.String(),
+		Metadata:     metadata,
+	}
+	if after != "" {
+		entry.Before = map[string]any{"balance": before}
+		entry.After = map[string]any{"balance": after}
+	}
+	recordAudit(ctx, uc.audit, uc.logger, entry)
+
+	return dto.NewBalanceDiscrepancyResponse(*discrepancy), nil
+}
+
+func discrepancyNotOpenError(status entities.BalanceDiscrepancyStatus) error {
+	return utils.NewAppError(
+		"BALANCE_DISCREPANCY_NOT_OPEN",
+		"balance discrepancy is not awaiting review",
+		fiber.StatusConflict,
+		nil,
+		map[string]any{"status": status},
+	)
+}
@@ -0,0 +1,96 @@
+package reconciliation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/internal/infrastructure/blockchain"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// Audit actions recorded by balance reconciliation.
+const (
+	AuditActionBalancesCorrected   = "reconciliation.balances_corrected"
+	AuditActionDiscrepancyResolved = "reconciliation.discrepancy_resolved"
+)
+
+// AuditLogger captures audit events for compliance.
+type AuditLogger interface {
+	Record(ctx context.Context, entry audit.Entry) error
+}
+
+// BalanceReader fetches on-chain balances.
+type BalanceReader interface {
+	GetBalance(ctx context.Context, address string) (*blockchain.Balance, error)
+}
+
+// WalletRepo exposes the wallet operations reconciliation needs.
+type WalletRepo interface {
+	GetByID(ctx context.Context, id uuid.UUID) (entities.Wallet, error)
+	ListByChain(ctx context.Context, chain entities.Chain, after uuid.UUID, limit int) ([]entities.Wallet, error)
+	SampleByChain(ctx context.Context, chain entities.Chain, limit int) ([]entities.Wallet, error)
+	Update(ctx context.Context, wallet entities.Wallet) error
+}
+
+// fetchOnChainBalance reads the wallet's balance from its chain adapter.
+func fetchOnChainBalance(ctx context.Context, adapters map[entities.Chain]BalanceReader, wallet entities.Wallet) (decimal.Decimal, error) {
+	adapter, ok := adapters[wallet.GetChain()]
+	if !ok || adapter == nil {
+		return decimal.Zero, fmt.Errorf("no blockchain adapter for %s", wallet.GetChain())
+	}
+
+	balance, err := adapter.GetBalance(ctx, wallet.GetAddress())
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if balance == nil {
+		return decimal.Zero, errors.New("blockchain returned empty balance")
+	}
+
+	value := strings.TrimSpace(balance.Balance)
+	if value == "" {
+		return decimal.Zero, nil
+	}
+	return decimal.NewFromString(value)
+}
+
+func recordAudit(ctx context.Context, auditLogger AuditLogger, logger *slog.Logger, entry audit.Entry) {
+	if auditLogger == nil {
+		return
+	}
+	if err := auditLogger.Record(ctx, entry); err != nil {
+		logger.Warn("failed to record audit entry", slog.String("action", entry.Action), slog.String("error", err.Error()))
+	}
+}
+
+func discrepancyNotFoundError() error {
+	return utils.NewAppError(
+		"BALANCE_DISCREPANCY_NOT_FOUND",
+		"balance discrepancy not found",
+		fiber.StatusNotFound,
+		nil,
+		nil,
+	)
+}
+
+func wrapValidationError(errs utils.ValidationErrors) error {
+	if errs.IsEmpty() {
+		return nil
+	}
+	return utils.NewAppError(
+		"VALIDATION_ERROR",
+		"balance reconciliation request invalid",
+		fiber.StatusBadRequest,
+		errs,
+		map[string]any{"errors": errs},
+	)
+}
@@ -27,6 +27,8 @@ type GetTransactionHistoryInput struct {
 	Offset    int
 	SortBy    string
 	SortOrder string
+	// Cursor continues from a previous page's nextCursor and replaces Offset.
+	Cursor string
 }
 
 // GetTransactionHistoryUseCase handles comprehensive transaction history retrieval with filtering.
@@ -55,12 +57,22 @@ func (uc *GetTransactionHistoryUseCase) Execute(ctx context.Context, input GetTr
 		EndDate:   input.EndDate,
 	}
 
+	after, err := parseListCursor(input.Cursor)
+	if err != nil {
+		return dto.TransactionListResponse{}, err
+	}
+
 	// Build list options with defaults
 	opts := repositories.ListOptions{
 		Limit:     input.Limit,
 		Offset:    input.Offset,
 		SortBy:    input.SortBy,
 		SortOrder: repositories.SortOrder(strings.ToUpper(strings.TrimSpace(input.SortOrder))),
+		After:     after,
+		Caps:      transactionHistoryCaps,
+	}
+	if err := opts.CheckCaps(); err != nil {
+		return dto.TransactionListResponse{}, err
 	}
 	opts = opts.WithDefaults()
 
@@ -79,10 +91,11 @@ func (uc *GetTransactionHistoryUseCase) Execute(ctx context.Context, input GetTr
 
 	// Map to response DTO
 	response := dto.TransactionListResponse{
-		Items:  mapTransactions(transactions),
-		Total:  total,
-		Limit:  opts.Limit,
-		Offset: opts.Offset,
+		Items:      mapTransactions(transactions),
+		Total:      total,
+		Limit:      opts.Limit,
+		Offset:     opts.Offset,
+		NextCursor: nextTransactionCursor(transactions, opts),
 	}
 
 	uc.logger.Info("successfully retrieved transaction history",
@@ -98,6 +111,7 @@ func (uc *GetTransactionHistoryUseCase) Execute(ctx context.Context, input GetTr
 // ExecuteFromRequest executes the use case from a DTO request.
 func (uc *GetTransactionHistoryUseCase) ExecuteFromRequest(ctx context.Context, req dto.GetTransactionHistoryRequest) (dto.TransactionListResponse, error) {
 	// Validate request
+	req.SetDefaults()
 	if errs := req.Validate(); len(errs) > 0 {
 		return dto.TransactionListResponse{}, utils.NewAppError(
 			"VALIDATION_ERROR",
@@ -184,6 +198,7 @@ func (uc *GetTransactionHistoryUseCase) ExecuteFromRequest(ctx context.Context,
 		EndDate:   endDate,
 		Limit:     req.Limit,
 		Offset:    req.Offset,
+		Cursor:    req.Cursor,
 	}
 
 	return uc.Execute(ctx, input)
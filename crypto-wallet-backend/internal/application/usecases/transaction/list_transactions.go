@@ -22,6 +22,8 @@ type ListTransactionsInput struct {
     Offset    int
     SortBy    string
     SortOrder string
+    // Cursor continues from a previous page's nextCursor and replaces Offset.
+    Cursor string
 }
 
 // ListTransactionsUseCase handles paginated transaction retrieval.
@@ -51,12 +53,23 @@ func (uc *ListTransactionsUseCase) Execute(ctx context.Context, input ListTransa
         )
     }
 
+    after, err := parseListCursor(input.Cursor)
+    if err != nil {
+        return dto.TransactionListResponse{}, err
+    }
+
     opts := repositories.ListOptions{
         Limit:     input.Limit,
         Offset:    input.Offset,
         SortBy:    input.SortBy,
         SortOrder: repositories.SortOrder(strings.ToUpper(strings.TrimSpace(input.SortOrder))),
+        After:     after,
+        Caps:      transactionListCaps,
+    }
+    if err := opts.CheckCaps(); err != nil {
+        return dto.TransactionListResponse{}, err
     }
+    opts = opts.WithDefaults()
 
     transactions, err := uc.transactions.ListByWallet(ctx, walletID, opts)
     if err != nil {
@@ -64,9 +77,10 @@ func (uc *ListTransactionsUseCase) Execute(ctx context.Context, input ListTransa
     }
 
     response := dto.TransactionListResponse{
-        Items:  mapTransactions(transactions),
-        Limit:  opts.WithDefaults().Limit,
-        Offset: opts.Offset,
+        Items:      mapTransactions(transactions),
+        Limit:      opts.Limit,
+        Offset:     opts.Offset,
+        NextCursor: nextTransactionCursor(transactions, opts),
     }
 
     response.Total = int64(len(response.Items))
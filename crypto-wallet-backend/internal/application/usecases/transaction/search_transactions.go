@@ -0,0 +1,185 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// searchWalletLimit bounds how many of a user's wallets a search without walletId covers.
+const searchWalletLimit = 100
+
+// transactionSearchCaps bounds search pages; searches are offset paged and counted.
+var transactionSearchCaps = repositories.PaginationCaps{MaxLimit: 200, MaxOffset: 10000}
+
+// OwnedWalletLister lists the wallets a user owns.
+type OwnedWalletLister interface {
+	ListByUser(ctx context.Context, userID uuid.UUID, filter repositories.WalletFilter, opts repositories.ListOptions) ([]entities.Wallet, error)
+}
+
+// SearchTransactionsInput encapsulates the arguments required to search transactions.
+type SearchTransactionsInput struct {
+	UserID  uuid.UUID
+	Request dto.SearchTransactionsRequest
+}
+
+// SearchTransactionsUseCase searches the transactions of the wallets a user can view: one wallet
+// they own or were granted view access to, or every wallet they own.
+type SearchTransactionsUseCase struct {
+	transactions TransactionRepo
+	wallets      OwnedWalletLister
+	authorizer   WalletAuthorizer
+	logger       *slog.Logger
+}
+
+// NewSearchTransactionsUseCase constructs the use case.
+func NewSearchTransactionsUseCase(repo TransactionRepo, wallets OwnedWalletLister, authorizer WalletAuthorizer, logger *slog.Logger) *SearchTransactionsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SearchTransactionsUseCase{
+		transactions: repo,
+		wallets:      wallets,
+		authorizer:   authorizer,
+		logger:       logger,
+	}
+}
+
+// Execute validates the request and returns the matching page, newest first.
+func (uc *SearchTransactionsUseCase) Execute(ctx context.Context, input SearchTransactionsInput) (dto.TransactionListResponse, error) {
+	if uc.transactions == nil || uc.wallets == nil || uc.authorizer == nil {
+		return dto.TransactionListResponse{}, errors.New("search transactions: dependencies not configured")
+	}
+
+	req := input.Request
+	req.SetDefaults()
+	if errs := req.Validate(); !errs.IsEmpty() {
+		return dto.TransactionListResponse{}, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"invalid search parameters",
+			fiber.StatusBadRequest,
+			errs,
+			map[string]any{"errors": errs},
+		)
+	}
+
+	search := searchCriteria(req)
+	walletIDs, err := uc.searchableWallets(ctx, input.UserID, req.WalletID, search.Chain)
+	if err != nil {
+		return dto.TransactionListResponse{}, err
+	}
+
+	opts := repositories.ListOptions{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+		Caps:   transactionSearchCaps,
+	}
+	if err := opts.CheckCaps(); err != nil {
+		return dto.TransactionListResponse{}, err
+	}
+	opts = opts.WithDefaults()
+
+	if len(walletIDs) == 0 {
+		return dto.TransactionListResponse{Items: mapTransactions(nil), Limit: opts.Limit, Offset: opts.Offset}, nil
+	}
+	search.WalletIDs = walletIDs
+
+	transactions, total, err := uc.transactions.Search(ctx, search, opts)
+	if err != nil {
+		uc.logger.Error("failed to search transactions", slog.String("error", err.Error()))
+		return dto.TransactionListResponse{}, err
+	}
+
+	return dto.TransactionListResponse{
+		Items:  mapTransactions(transactions),
+		Total:  total,
+		Limit:  opts.Limit,
+		Offset: opts.Offset,
+	}, nil
+}
+
+// searchableWallets resolves the wallets the search covers.
+func (uc *SearchTransactionsUseCase) searchableWallets(ctx context.Context, userID uuid.UUID, rawWalletID string, chain *entities.Chain) ([]uuid.UUID, error) {
+	if strings.TrimSpace(rawWalletID) != "" {
+		walletID, err := uuid.Parse(strings.TrimSpace(rawWalletID))
+		if err != nil {
+			return nil, err
+		}
+		if _, err := uc.authorizer.AuthorizeWallet(ctx, walletID, userID, entities.WalletPermissionView); err != nil {
+			return nil, walletAccessError(err, walletID)
+		}
+		return []uuid.UUID{walletID}, nil
+	}
+
+	owned, err := uc.wallets.ListByUser(ctx, userID, repositories.WalletFilter{Chain: chain}, repositories.ListOptions{Limit: searchWalletLimit})
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uuid.UUID, 0, len(owned))
+	for _, wallet := range owned {
+		ids = append(ids, wallet.GetID())
+	}
+	return ids, nil
+}
+
+// searchCriteria translates a validated request into repository criteria.
+func searchCriteria(req dto.SearchTransactionsRequest) repositories.TransactionSearch {
+	search := repositories.TransactionSearch{
+		HashPrefix: strings.TrimSpace(req.Hash),
+		Address:    strings.TrimSpace(req.Address),
+		Text:       strings.TrimSpace(req.Query),
+	}
+
+	if chain := entities.NormalizeChain(req.Chain); chain != "" {
+		search.Chain = &chain
+	}
+	if req.Type != "" {
+		txType := entities.TransactionType(req.Type)
+		search.Type = &txType
+	}
+	if req.Status != "" {
+		status := entities.TransactionStatus(req.Status)
+		search.Status = &status
+	}
+	if start, err := time.Parse(time.RFC3339, req.StartDate); err == nil {
+		search.StartDate = &start
+	}
+	if end, err := time.Parse(time.RFC3339, req.EndDate); err == nil {
+		search.EndDate = &end
+	}
+	if amount, err := decimal.NewFromString(strings.TrimSpace(req.MinAmount)); err == nil {
+		search.MinAmount = &amount
+	}
+	if amount, err := decimal.NewFromString(strings.TrimSpace(req.MaxAmount)); err == nil {
+		search.MaxAmount = &amount
+	}
+
+	for _, entry := range req.Metadata {
+		key, value, hasValue := strings.Cut(entry, ":")
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+		if !hasValue {
+			search.MetadataKeys = append(search.MetadataKeys, key)
+			continue
+		}
+		if search.Metadata == nil {
+			search.Metadata = make(map[string]string)
+		}
+		search.Metadata[key] = value
+	}
+
+	return search
+}
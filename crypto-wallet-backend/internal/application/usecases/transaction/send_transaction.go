@@ -16,6 +16,7 @@ import (
 	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
 	"github.com/crypto-wallet/backend/internal/infrastructure/blockchain"
 	appLogging "github.com/crypto-wallet/backend/internal/infrastructure/logging"
+	"github.com/crypto-wallet/backend/internal/infrastructure/tracing"
 	"github.com/crypto-wallet/backend/pkg/utils"
 )
 
@@ -30,20 +31,35 @@ type SendTransactionUseCase struct {
 	service      Service
 	transactions TransactionRepo
 	wallets      WalletRepo
+	authorizer   WalletAuthorizer
 	ledgerWriter LedgerWriter
 	resolver     BlockchainResolver
+	fees         FeeQuoter
+	recipients   RecipientPolicy
+	limits       LimitPolicy
+	travelRule   TravelRulePolicy
 	auditLogger  AuditLogger
 	logger       *slog.Logger
 	retryCfg     blockchain.RetryConfig
 }
 
-// NewSendTransactionUseCase constructs the use case.
+// NewSendTransactionUseCase constructs the use case. When an authorizer is supplied, members of a
+// shared wallet need the initiate permission; otherwise the wallet is loaded without checks. When a
+// fee quoter is supplied, EVM sends without an explicit fee are priced by the gas oracle. When a
+// recipient policy is supplied, the destination must pass it before anything is signed, and when a
+// limit policy is supplied, so must the amount. When a travel-rule policy is supplied, sends at or
+// above its threshold are only broadcast once their originator and beneficiary data is recorded.
 func NewSendTransactionUseCase(
 	service Service,
 	transactions TransactionRepo,
 	wallets WalletRepo,
+	authorizer WalletAuthorizer,
 	ledger LedgerWriter,
 	resolver BlockchainResolver,
+	fees FeeQuoter,
+	recipients RecipientPolicy,
+	limits LimitPolicy,
+	travelRule TravelRulePolicy,
 	auditLogger AuditLogger,
 	logger *slog.Logger,
 ) *SendTransactionUseCase {
@@ -54,8 +70,13 @@ func NewSendTransactionUseCase(
 		service:      service,
 		transactions: transactions,
 		wallets:      wallets,
+		authorizer:   authorizer,
 		ledgerWriter: ledger,
 		resolver:     resolver,
+		fees:         fees,
+		recipients:   recipients,
+		limits:       limits,
+		travelRule:   travelRule,
 		auditLogger:  auditLogger,
 		logger:       logger,
 		retryCfg:     blockchain.RetryConfig{Attempts: 3, Delay: 350 * time.Millisecond},
@@ -63,7 +84,10 @@ func NewSendTransactionUseCase(
 }
 
 // Execute performs the send transaction workflow end-to-end.
-func (uc *SendTransactionUseCase) Execute(ctx context.Context, input SendTransactionInput) (dto.TransactionStatusResponse, error) {
+func (uc *SendTransactionUseCase) Execute(ctx context.Context, input SendTransactionInput) (_ dto.TransactionStatusResponse, err error) {
+	ctx, span := tracing.Start(ctx, "transaction.Send")
+	defer func() { tracing.End(span, err) }()
+
 	logger := appLogging.LoggerFromContext(ctx, uc.logger)
 	validation := input.Payload.Validate()
 
@@ -108,12 +132,22 @@ func (uc *SendTransactionUseCase) Execute(ctx context.Context, input SendTransac
 		return dto.TransactionStatusResponse{}, wrapValidationError(validation)
 	}
 
-	wallet, err := uc.wallets.GetByID(ctx, walletID)
+	wallet, err := uc.loadWallet(ctx, walletID, userID)
 	if err != nil {
 		logger.Error("failed to load wallet", slog.String("error", err.Error()))
 		return dto.TransactionStatusResponse{}, err
 	}
 
+	if wallet.GetStatus() == entities.WalletStatusDormant {
+		return dto.TransactionStatusResponse{}, utils.NewAppError(
+			"WALLET_DORMANT",
+			"wallet is dormant; reactivate it with two-factor authentication before sending",
+			fiber.StatusForbidden,
+			nil,
+			map[string]any{"wallet_id": walletID},
+		)
+	}
+
 	if wallet.GetStatus() != entities.WalletStatusActive {
 		return dto.TransactionStatusResponse{}, utils.NewAppError(
 			"WALLET_INACTIVE",
@@ -134,6 +168,37 @@ func (uc *SendTransactionUseCase) Execute(ctx context.Context, input SendTransac
 		)
 	}
 
+	if uc.recipients != nil {
+		if err := uc.recipients.CheckRecipient(ctx, userID, chain, input.Payload.ToAddress); err != nil {
+			logger.Warn("recipient rejected", slog.String("error", err.Error()))
+			return dto.TransactionStatusResponse{}, err
+		}
+	}
+
+	if uc.limits != nil {
+		if err := uc.limits.CheckOutflow(ctx, wallet.GetID(), amount, string(entities.TransactionTypeSend)); err != nil {
+			logger.Warn("send rejected by limit policy", slog.String("error", err.Error()))
+			return dto.TransactionStatusResponse{}, limitError(err)
+		}
+	}
+
+	var travelRecord *entities.TravelRuleRecord
+	if uc.travelRule != nil {
+		travelRecord, err = uc.travelRule.Apply(ctx, TravelRuleSend{
+			UserID:      userID,
+			WalletID:    wallet.GetID(),
+			Chain:       chain,
+			FromAddress: wallet.GetAddress(),
+			ToAddress:   input.Payload.ToAddress,
+			Amount:      amount,
+			Info:        input.Payload.TravelRule,
+		})
+		if err != nil {
+			logger.Warn("send rejected by travel rule policy", slog.String("error", err.Error()))
+			return dto.TransactionStatusResponse{}, err
+		}
+	}
+
 	adapter, err := uc.resolver.Resolve(chain)
 	if err != nil {
 		logger.Error("blockchain adapter resolve failed", slog.String("error", err.Error()))
@@ -146,13 +211,25 @@ func (uc *SendTransactionUseCase) Execute(ctx context.Context, input SendTransac
 		)
 	}
 
+	var feeMetadata map[string]any
+	if strings.TrimSpace(input.Payload.Fee) == "" && uc.fees != nil && entities.IsEVMChain(chain) {
+		quote, err := uc.fees.QuoteFee(ctx, userID, chain, input.Payload.Speed)
+		if err != nil {
+			logger.Warn("gas fee quote failed", slog.String("error", err.Error()))
+			return dto.TransactionStatusResponse{}, err
+		}
+		fee = quote.Fee
+		feeMetadata = quote.Metadata()
+		logger.Debug("gas fee quoted", slog.String("speed", string(quote.Speed)), slog.String("fee", fee.String()))
+	}
+
 	txnRequest := &blockchain.TransactionRequest{
 		FromAddress: wallet.GetAddress(),
 		ToAddress:   input.Payload.ToAddress,
 		Amount:      amount.String(),
 		Fee:         fee.String(),
 		Memo:        input.Payload.Memo,
-		Metadata:    input.Payload.Metadata,
+		Metadata:    mergeMetadata(input.Payload.Metadata, feeMetadata),
 	}
 
 	logger.Debug("creating unsigned transaction")
@@ -186,7 +263,7 @@ func (uc *SendTransactionUseCase) Execute(ctx context.Context, input SendTransac
 		ToAddress:   input.Payload.ToAddress,
 		Amount:      amount,
 		Fee:         fee,
-		Metadata:    mergeMetadata(unsigned.Metadata, signed.Metadata, input.Payload.Metadata),
+		Metadata:    mergeMetadata(unsigned.Metadata, signed.Metadata, input.Payload.Metadata, feeMetadata),
 	})
 	if err != nil {
 		return dto.TransactionStatusResponse{}, err
@@ -206,6 +283,12 @@ func (uc *SendTransactionUseCase) Execute(ctx context.Context, input SendTransac
 		return dto.TransactionStatusResponse{}, err
 	}
 
+	if travelRecord != nil {
+		if err := uc.travelRule.Link(ctx, travelRecord, transaction.GetID()); err != nil {
+			logger.Warn("failed to link travel rule record", slog.String("travel_rule_id", travelRecord.ID.String()), slog.String("error", err.Error()))
+		}
+	}
+
 	if uc.ledgerWriter != nil {
 		entries := []*entities.LedgerEntryEntity{}
 		if domainResult.LedgerDebit != nil {
@@ -222,24 +305,40 @@ func (uc *SendTransactionUseCase) Execute(ctx context.Context, input SendTransac
 	}
 
 	if uc.auditLogger != nil {
+		metadata := map[string]any{
+			"wallet_id":    wallet.GetID().String(),
+			"chain":        chain,
+			"hash":         transaction.GetHash(),
+			"amount":       transaction.GetAmount().String(),
+			"to_address":   transaction.GetToAddress(),
+			"from_address": transaction.GetFromAddress(),
+		}
+		if travelRecord != nil {
+			metadata["travel_rule_id"] = travelRecord.ID.String()
+			metadata["travel_rule_status"] = string(travelRecord.Status)
+		}
 		_ = uc.auditLogger.Record(ctx, audit.Entry{
 			ActorID:  userID,
 			Action:   "transaction_send",
 			TargetID: transaction.GetID().String(),
-			Metadata: map[string]any{
-				"wallet_id":    wallet.GetID().String(),
-				"chain":        chain,
-				"hash":         transaction.GetHash(),
-				"amount":       transaction.GetAmount().String(),
-				"to_address":   transaction.GetToAddress(),
-				"from_address": transaction.GetFromAddress(),
-			},
+			Metadata: metadata,
 		})
 	}
 
 	return mapTransaction(transaction), nil
 }
 
+func (uc *SendTransactionUseCase) loadWallet(ctx context.Context, walletID, userID uuid.UUID) (entities.Wallet, error) {
+	if uc.authorizer == nil {
+		return uc.wallets.GetByID(ctx, walletID)
+	}
+	wallet, err := uc.authorizer.AuthorizeWallet(ctx, walletID, userID, entities.WalletPermissionInitiate)
+	if err != nil {
+		return nil, walletAccessError(err, walletID)
+	}
+	return wallet, nil
+}
+
 func mergeMetadata(values ...map[string]any) map[string]any {
 	merged := map[string]any{}
 	for _, value := range values {
@@ -2,6 +2,7 @@ package transaction
 
 import (
     "context"
+    "errors"
     "strings"
     "time"
 
@@ -33,6 +34,11 @@ type WalletRepo interface {
     GetByID(ctx context.Context, id uuid.UUID) (entities.Wallet, error)
 }
 
+// WalletAuthorizer checks that a user may act on a wallet they own or were granted access to.
+type WalletAuthorizer interface {
+    AuthorizeWallet(ctx context.Context, walletID, userID uuid.UUID, permission entities.WalletPermission) (entities.Wallet, error)
+}
+
 // LedgerWriter persists ledger entries generated by transaction workflows.
 type LedgerWriter interface {
     CreateEntries(ctx context.Context, entries ...*entities.LedgerEntryEntity) error
@@ -43,11 +49,73 @@ type BlockchainResolver interface {
     Resolve(chain entities.Chain) (blockchain.BlockchainAdapter, error)
 }
 
+// FeeQuoter prices sends on EVM chains from the gas oracle at the requested speed, or the user's
+// default speed when speed is empty.
+type FeeQuoter interface {
+    QuoteFee(ctx context.Context, userID uuid.UUID, chain entities.Chain, speed string) (dto.GasFeeQuote, error)
+}
+
+// RecipientPolicy rejects send destinations the user's settings do not allow, such as addresses
+// outside the address book when whitelist-only sends are enabled.
+type RecipientPolicy interface {
+    CheckRecipient(ctx context.Context, userID uuid.UUID, chain entities.Chain, address string) error
+}
+
+// LimitPolicy checks an outflow from a wallet against its owner's KYC limits. It returns an error
+// wrapping services.ErrLimitExceeded when the send must be rejected.
+type LimitPolicy interface {
+    CheckOutflow(ctx context.Context, walletID uuid.UUID, amount decimal.Decimal, operation string) error
+}
+
+// TravelRulePolicy captures and transmits originator and beneficiary information for sends at or
+// above the travel-rule threshold before they are broadcast. Apply returns a nil record for sends
+// below the threshold and an error when the send must not go ahead; Link attaches the broadcast
+// transaction to the record.
+type TravelRulePolicy interface {
+    Apply(ctx context.Context, send TravelRuleSend) (*entities.TravelRuleRecord, error)
+    Link(ctx context.Context, record *entities.TravelRuleRecord, transactionID uuid.UUID) error
+}
+
 // AuditLogger captures audit events for compliance.
 type AuditLogger interface {
     Record(ctx context.Context, entry audit.Entry) error
 }
 
+// transactionListCaps bounds transaction listings. Offsets past repositories.KeysetOffsetThreshold
+// are served by keyset internally; past MaxOffset clients must page with nextCursor.
+var transactionListCaps = repositories.PaginationCaps{MaxLimit: 200, MaxOffset: 10000}
+
+// transactionHistoryCaps allows the larger pages the analytics history endpoint has always served.
+var transactionHistoryCaps = repositories.PaginationCaps{MaxLimit: 1000, MaxOffset: 10000}
+
+// parseListCursor decodes the optional cursor query parameter.
+func parseListCursor(raw string) (*repositories.PageCursor, error) {
+    if strings.TrimSpace(raw) == "" {
+        return nil, nil
+    }
+    cursor, err := repositories.ParsePageCursor(raw)
+    if err != nil {
+        return nil, utils.NewAppError(
+            "VALIDATION_ERROR",
+            "cursor is invalid; pass nextCursor from a previous response unchanged",
+            fiber.StatusBadRequest,
+            err,
+            nil,
+        )
+    }
+    return &cursor, nil
+}
+
+// nextTransactionCursor returns the cursor for the page after items, or "" when the page was not
+// full or the listing is not sorted by created_at and so cannot continue by keyset.
+func nextTransactionCursor(items []entities.Transaction, opts repositories.ListOptions) string {
+    if len(items) == 0 || len(items) < opts.Limit || !strings.EqualFold(opts.SortBy, "created_at") {
+        return ""
+    }
+    last := items[len(items)-1]
+    return repositories.PageCursor{CreatedAt: last.GetCreatedAt(), ID: last.GetID()}.Encode()
+}
+
 func mapTransaction(tx entities.Transaction) dto.TransactionStatusResponse {
     if tx == nil {
         return dto.TransactionStatusResponse{}
@@ -90,6 +158,58 @@ func wrapValidationError(errs utils.ValidationErrors) error {
     )
 }
 
+func walletAccessError(err error, walletID uuid.UUID) error {
+    switch {
+    case errors.Is(err, domainservices.ErrWalletNotFound):
+        return utils.NewAppError(
+            "WALLET_NOT_FOUND",
+            "wallet not found",
+            fiber.StatusNotFound,
+            err,
+            map[string]any{"walletId": walletID},
+        )
+    case errors.Is(err, domainservices.ErrWalletPermissionDenied):
+        return utils.NewAppError(
+            "WALLET_PERMISSION_DENIED",
+            "you do not have permission to send from this wallet",
+            fiber.StatusForbidden,
+            err,
+            map[string]any{"walletId": walletID},
+        )
+    default:
+        return err
+    }
+}
+
+func limitError(err error) error {
+    var overrun *domainservices.LimitExceededError
+    switch {
+    case errors.As(err, &overrun):
+        return utils.NewAppError(
+            "LIMIT_EXCEEDED",
+            "send exceeds your "+overrun.Window+" limit",
+            fiber.StatusForbidden,
+            err,
+            map[string]any{
+                "window":       overrun.Window,
+                "limitUsd":     overrun.LimitUSD.StringFixed(2),
+                "remainingUsd": overrun.RemainingUSD().StringFixed(2),
+                "requestedUsd": overrun.RequestedUSD.StringFixed(2),
+            },
+        )
+    case errors.Is(err, domainservices.ErrLimitRateUnavailable):
+        return utils.NewAppError(
+            "LIMIT_RATE_UNAVAILABLE",
+            "send cannot be checked against your limits until exchange rates are available",
+            fiber.StatusServiceUnavailable,
+            err,
+            nil,
+        )
+    default:
+        return err
+    }
+}
+
 func pointerTime(value time.Time) *time.Time {
     if value.IsZero() {
         return nil
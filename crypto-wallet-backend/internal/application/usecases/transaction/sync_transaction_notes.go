@@ -0,0 +1,208 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	domainservices "github.com/crypto-wallet/backend/internal/domain/services"
+	appLogging "github.com/crypto-wallet/backend/internal/infrastructure/logging"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+const (
+	defaultNoteSyncLimit = 200
+	maxNoteSyncLimit     = 500
+)
+
+// SyncTransactionNotesInput carries one device's sync request.
+type SyncTransactionNotesInput struct {
+	UserID  uuid.UUID
+	Payload dto.SyncTransactionNotesRequest
+}
+
+// SyncTransactionNotesUseCase applies a device's encrypted note edits and returns the notes written
+// since its last sync. Notes are opaque to the server: it checks only that the user can see the
+// transaction and that the edit was made on the latest version of the note.
+type SyncTransactionNotesUseCase struct {
+	notes        repositories.TransactionNoteRepository
+	transactions TransactionRepo
+	wallets      WalletRepo
+	authorizer   WalletAuthorizer
+	logger       *slog.Logger
+}
+
+// NewSyncTransactionNotesUseCase constructs the use case. When an authorizer is supplied, members
+// of a shared wallet may annotate its transactions; otherwise only the owner may.
+func NewSyncTransactionNotesUseCase(
+	notes repositories.TransactionNoteRepository,
+	transactions TransactionRepo,
+	wallets WalletRepo,
+	authorizer WalletAuthorizer,
+	logger *slog.Logger,
+) *SyncTransactionNotesUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SyncTransactionNotesUseCase{
+		notes:        notes,
+		transactions: transactions,
+		wallets:      wallets,
+		authorizer:   authorizer,
+		logger:       logger,
+	}
+}
+
+// Execute pushes the changes in order, then pulls.
+func (uc *SyncTransactionNotesUseCase) Execute(ctx context.Context, input SyncTransactionNotesInput) (dto.SyncTransactionNotesResponse, error) {
+	if uc.notes == nil {
+		return dto.SyncTransactionNotesResponse{}, errors.New("sync transaction notes: repository not configured")
+	}
+	logger := appLogging.LoggerFromContext(ctx, uc.logger).With(slog.String("user_id", input.UserID.String()))
+
+	if validation := input.Payload.Validate(); !validation.IsEmpty() {
+		return dto.SyncTransactionNotesResponse{}, wrapValidationError(validation)
+	}
+
+	response := dto.SyncTransactionNotesResponse{
+		Applied:   make([]dto.TransactionNote, 0, len(input.Payload.Changes)),
+		Conflicts: make([]dto.TransactionNoteConflict, 0),
+		Rejected:  make([]dto.TransactionNoteRejection, 0),
+	}
+
+	deviceID := strings.TrimSpace(input.Payload.DeviceID)
+	for _, change := range input.Payload.Changes {
+		transactionID := uuid.MustParse(strings.TrimSpace(change.TransactionID))
+
+		if err := uc.authorizeTransaction(ctx, input.UserID, transactionID); err != nil {
+			var appErr *utils.AppError
+			if !errors.As(err, &appErr) {
+				return dto.SyncTransactionNotesResponse{}, err
+			}
+			response.Rejected = append(response.Rejected, dto.TransactionNoteRejection{
+				TransactionID: transactionID.String(),
+				Code:          appErr.Code,
+				Message:       appErr.Message,
+			})
+			continue
+		}
+
+		note := entities.TransactionNote{
+			UserID:        input.UserID,
+			TransactionID: transactionID,
+			KeyID:         strings.TrimSpace(change.KeyID),
+			DeviceID:      deviceID,
+			Deleted:       change.Deleted,
+		}
+		if !change.Deleted {
+			note.Ciphertext, _ = change.DecodeCiphertext()
+		}
+		if err := note.Validate(); err != nil {
+			response.Rejected = append(response.Rejected, dto.TransactionNoteRejection{
+				TransactionID: transactionID.String(),
+				Code:          "INVALID_NOTE",
+				Message:       err.Error(),
+			})
+			continue
+		}
+
+		saved, err := uc.notes.SaveNote(ctx, note, change.BaseVersion)
+		if errors.Is(err, repositories.ErrConflict) {
+			conflict := dto.TransactionNoteConflict{TransactionID: transactionID, BaseVersion: change.BaseVersion}
+			current, getErr := uc.notes.GetNote(ctx, input.UserID, transactionID)
+			switch {
+			case getErr == nil:
+				mapped := dto.NewTransactionNote(current)
+				conflict.Current = &mapped
+			case !errors.Is(getErr, repositories.ErrNotFound):
+				return dto.SyncTransactionNotesResponse{}, getErr
+			}
+			response.Conflicts = append(response.Conflicts, conflict)
+			continue
+		}
+		if err != nil {
+			logger.Error("failed to save transaction note", slog.String("transaction_id", transactionID.String()), slog.String("error", err.Error()))
+			return dto.SyncTransactionNotesResponse{}, err
+		}
+		response.Applied = append(response.Applied, dto.NewTransactionNote(saved))
+	}
+
+	limit := input.Payload.Limit
+	if limit <= 0 {
+		limit = defaultNoteSyncLimit
+	}
+	if limit > maxNoteSyncLimit {
+		limit = maxNoteSyncLimit
+	}
+
+	// One extra row tells whether another page follows.
+	changes, err := uc.notes.ListNoteChanges(ctx, input.UserID, input.Payload.Cursor, limit+1)
+	if err != nil {
+		return dto.SyncTransactionNotesResponse{}, err
+	}
+	if len(changes) > limit {
+		changes = changes[:limit]
+		response.HasMore = true
+	}
+
+	response.Changes = make([]dto.TransactionNote, 0, len(changes))
+	response.NextCursor = input.Payload.Cursor
+	for _, note := range changes {
+		response.Changes = append(response.Changes, dto.NewTransactionNote(note))
+		response.NextCursor = note.Revision
+	}
+
+	logger.Debug("transaction notes synced",
+		slog.Int("applied", len(response.Applied)),
+		slog.Int("conflicts", len(response.Conflicts)),
+		slog.Int("rejected", len(response.Rejected)),
+		slog.Int("pulled", len(response.Changes)))
+	return response, nil
+}
+
+// authorizeTransaction checks that the user can view the wallet the transaction belongs to. Failures
+// the device should be told about are returned as AppErrors.
+func (uc *SyncTransactionNotesUseCase) authorizeTransaction(ctx context.Context, userID, transactionID uuid.UUID) error {
+	notFound := utils.NewAppError(
+		"TRANSACTION_NOT_FOUND",
+		"transaction not found",
+		fiber.StatusNotFound,
+		nil,
+		map[string]any{"transactionId": transactionID},
+	)
+
+	tx, err := uc.transactions.GetByID(ctx, transactionID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return notFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if uc.authorizer != nil {
+		_, err = uc.authorizer.AuthorizeWallet(ctx, tx.GetWalletID(), userID, entities.WalletPermissionView)
+		if errors.Is(err, domainservices.ErrWalletNotFound) || errors.Is(err, domainservices.ErrWalletPermissionDenied) {
+			return notFound
+		}
+		return err
+	}
+
+	wallet, err := uc.wallets.GetByID(ctx, tx.GetWalletID())
+	if errors.Is(err, repositories.ErrNotFound) {
+		return notFound
+	}
+	if err != nil {
+		return err
+	}
+	if wallet.GetUserID() != userID {
+		return notFound
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+package transaction
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/external"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// DefaultTravelRuleThresholdUSD is the FATF travel-rule threshold most jurisdictions apply.
+var DefaultTravelRuleThresholdUSD = decimal.NewFromInt(1000)
+
+// TravelRuleCipher encrypts travel-rule party details under the sender's data key.
+// security.UserKeyCipher satisfies it.
+type TravelRuleCipher interface {
+	Encrypt(ctx context.Context, userID uuid.UUID, plaintext []byte) (string, error)
+}
+
+// TravelRuleSend describes a send checked against the travel rule.
+type TravelRuleSend struct {
+	UserID      uuid.UUID
+	WalletID    uuid.UUID
+	Chain       entities.Chain
+	FromAddress string
+	ToAddress   string
+	Amount      decimal.Decimal
+	Info        *dto.TravelRuleInfo
+}
+
+// TravelRuleServiceConfig configures the TravelRuleService.
+type TravelRuleServiceConfig struct {
+	Records repositories.TravelRuleRepository
+	Rates   repositories.RateRepository
+	Cipher  TravelRuleCipher
+	// Provider receives the data before the send is broadcast. Without one it is only stored.
+	Provider     external.TravelRuleClient
+	ThresholdUSD decimal.Decimal
+	Logger       *slog.Logger
+}
+
+// TravelRuleService captures originator and beneficiary information on sends valued at or above
+// the threshold, stores it encrypted and transmits it to the travel-rule provider. A send is only
+// broadcast once its data is stored and, with a provider, accepted.
+type TravelRuleService struct {
+	records   repositories.TravelRuleRepository
+	rates     repositories.RateRepository
+	cipher    TravelRuleCipher
+	provider  external.TravelRuleClient
+	threshold decimal.Decimal
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+// NewTravelRuleService constructs the service. A non-positive threshold falls back to
+// DefaultTravelRuleThresholdUSD.
+func NewTravelRuleService(cfg TravelRuleServiceConfig) *TravelRuleService {
+	threshold := cfg.ThresholdUSD
+	if !threshold.IsPositive() {
+		threshold = DefaultTravelRuleThresholdUSD
+	}
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &TravelRuleService{
+		records:   cfg.Records,
+		rates:     cfg.Rates,
+		cipher:    cfg.Cipher,
+		provider:  cfg.Provider,
+		threshold: threshold,
+		logger:    logger,
+		now:       func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Apply values the send in USD and, at or above the threshold, stores and transmits its
+// travel-rule data. It returns a nil record for sends below the threshold, and an error when the
+// send must not be broadcast: the data is missing, the send cannot be valued, or the provider is
+// unavailable or declines the transfer.
+func (s *TravelRuleService) Apply(ctx context.Context, send TravelRuleSend) (*entities.TravelRuleRecord, error) {
+	if s.records == nil || s.rates == nil || s.cipher == nil {
+		return nil, errors.New("travel rule: service not configured")
+	}
+
+	symbol := strings.ToUpper(string(send.Chain))
+	rate, err := s.rates.GetRateBySymbol(ctx, symbol)
+	if err != nil || rate == nil || !rate.GetPriceUSD().IsPositive() {
+		if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+			s.logger.Warn("travel rule rate lookup failed", slog.String("symbol", symbol), slog.String("error", err.Error()))
+		}
+		return nil, utils.NewAppError(
+			"TRAVEL_RULE_RATE_UNAVAILABLE",
+			"send cannot be checked against the travel rule until exchange rates are available",
+			fiber.StatusServiceUnavailable,
+			err,
+			nil,
+		)
+	}
+
+	amountUSD := send.Amount.Mul(rate.GetPriceUSD()).Round(2)
+	if amountUSD.LessThan(s.threshold) {
+		return nil, nil
+	}
+	if send.Info == nil {
+		return nil, utils.NewAppError(
+			"TRAVEL_RULE_INFO_REQUIRED",
+			"originator and beneficiary information is required for this send",
+			fiber.StatusUnprocessableEntity,
+			nil,
+			map[string]any{
+				"thresholdUsd": s.threshold.StringFixed(2),
+				"amountUsd":    amountUSD.StringFixed(2),
+			},
+		)
+	}
+
+	originator := travelRuleParty(send.Info.Originator, send.FromAddress)
+	beneficiary := travelRuleParty(send.Info.Beneficiary, send.ToAddress)
+	record := &entities.TravelRuleRecord{
+		ID:              uuid.New(),
+		UserID:          send.UserID,
+		WalletID:        send.WalletID,
+		Chain:           send.Chain,
+		ToAddress:       send.ToAddress,
+		Amount:          send.Amount,
+		AmountUSD:       amountUSD,
+		ThresholdUSD:    s.threshold,
+		BeneficiaryVASP: strings.TrimSpace(send.Info.BeneficiaryVASP),
+		Status:          entities.TravelRuleStatusCaptured,
+		CreatedAt:       s.now(),
+	}
+	if record.OriginatorEncrypted, err = s.encryptParty(ctx, send.UserID, originator); err != nil {
+		return nil, err
+	}
+	if record.BeneficiaryEncrypted, err = s.encryptParty(ctx, send.UserID, beneficiary); err != nil {
+		return nil, err
+	}
+	if err := s.records.Create(ctx, record); err != nil {
+		return nil, fmt.Errorf("travel rule: store record: %w", err)
+	}
+
+	logger := s.logger.With(
+		slog.String("user_id", send.UserID.String()),
+		slog.String("travel_rule_id", record.ID.String()))
+	if s.provider == nil {
+		logger.Info("travel rule data captured", slog.String("amount_usd", amountUSD.StringFixed(2)))
+		return record, nil
+	}
+
+	transmission, err := s.provider.Transmit(ctx, external.TravelRuleTransfer{
+		TransferRef:        record.ID.String(),
+		Asset:              symbol,
+		Amount:             send.Amount.String(),
+		AmountUSD:          amountUSD.StringFixed(2),
+		BeneficiaryVASP:    record.BeneficiaryVASP,
+		BeneficiaryAddress: send.ToAddress,
+		Originator:         originator,
+		Beneficiary:        beneficiary,
+	})
+	if transmission != nil {
+		record.ProviderReference = transmission.ReferenceID
+	}
+	switch {
+	case err == nil:
+		at := s.now()
+		record.Status = entities.TravelRuleStatusTransmitted
+		record.TransmittedAt = &at
+	case errors.Is(err, external.ErrTravelRuleTransferRejected), errors.Is(err, external.ErrTravelRuleRequest):
+		record.Status = entities.TravelRuleStatusRejected
+	default:
+		record.Status = entities.TravelRuleStatusFailed
+	}
+	if updateErr := s.records.Update(ctx, record); updateErr != nil {
+		logger.Error("failed to save travel rule transmission", slog.String("error", updateErr.Error()))
+		if err == nil {
+			return nil, fmt.Errorf("travel rule: save transmission: %w", updateErr)
+		}
+	}
+
+	switch record.Status {
+	case entities.TravelRuleStatusTransmitted:
+		logger.Info("travel rule data transmitted", slog.String("reference", record.ProviderReference))
+		return record, nil
+	case entities.TravelRuleStatusRejected:
+		logger.Warn("travel rule transfer rejected", slog.String("error", err.Error()))
+		return nil, utils.NewAppError(
+			"TRAVEL_RULE_REJECTED",
+			"the beneficiary's provider declined this transfer",
+			fiber.StatusUnprocessableEntity,
+			err,
+			map[string]any{"travelRuleId": record.ID},
+		)
+	default:
+		logger.Warn("travel rule transmission failed", slog.String("error", err.Error()))
+		return nil, utils.NewAppError(
+			"TRAVEL_RULE_UNAVAILABLE",
+			"travel rule data could not be transmitted; try again later",
+			fiber.StatusServiceUnavailable,
+			err,
+			nil,
+		)
+	}
+}
+
+// Link records the broadcast transaction on the travel-rule record.
+func (s *TravelRuleService) Link(ctx context.Context, record *entities.TravelRuleRecord, transactionID uuid.UUID) error {
+	if record == nil {
+		return nil
+	}
+	if s.records == nil {
+		return errors.New("travel rule: service not configured")
+	}
+	record.TransactionID = &transactionID
+	return s.records.Update(ctx, record)
+}
+
+func (s *TravelRuleService) encryptParty(ctx context.Context, userID uuid.UUID, party external.TravelRuleParty) (string, error) {
+	payload, err := json.Marshal(party)
+	if err != nil {
+		return "", fmt.Errorf("travel rule: encode party: %w", err)
+	}
+	ciphertext, err := s.cipher.Encrypt(ctx, userID, payload)
+	if err != nil {
+		return "", fmt.Errorf("travel rule: encrypt party: %w", err)
+	}
+	return ciphertext, nil
+}
+
+func travelRuleParty(party dto.TravelRuleParty, accountNumber string) external.TravelRuleParty {
+	return external.TravelRuleParty{
+		Name:              strings.TrimSpace(party.Name),
+		AccountNumber:     strings.TrimSpace(accountNumber),
+		GeographicAddress: strings.TrimSpace(party.Address),
+		DateOfBirth:       strings.TrimSpace(party.DateOfBirth),
+		NationalID:        strings.TrimSpace(party.NationalID),
+	}
+}
@@ -0,0 +1,150 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// ListChainAvailabilityUseCase reports which chains the user can create wallets on.
+type ListChainAvailabilityUseCase struct {
+	rollouts repositories.ChainRolloutRepository
+	logger   *slog.Logger
+}
+
+// NewListChainAvailabilityUseCase constructs a ListChainAvailabilityUseCase.
+func NewListChainAvailabilityUseCase(rollouts repositories.ChainRolloutRepository, logger *slog.Logger) *ListChainAvailabilityUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ListChainAvailabilityUseCase{
+		rollouts: rollouts,
+		logger:   logger,
+	}
+}
+
+// Execute evaluates every supported chain for the user.
+func (uc *ListChainAvailabilityUseCase) Execute(ctx context.Context, rawUserID string) ([]dto.ChainAvailability, error) {
+	userID, err := parseUserID(rawUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	chains := entities.SupportedChains()
+	items := make([]dto.ChainAvailability, 0, len(chains))
+	for _, chain := range chains {
+		state, err := evaluateRollout(ctx, uc.rollouts, userID, chain)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, mapChainAvailability(chain, state))
+	}
+	return items, nil
+}
+
+// SetBetaOptInInput captures a user's beta opt-in or opt-out for a chain.
+type SetBetaOptInInput struct {
+	UserID  string
+	Chain   string
+	OptedIn bool
+}
+
+// SetBetaOptInUseCase lets users join or leave a chain's beta while it is in soft launch.
+type SetBetaOptInUseCase struct {
+	rollouts repositories.ChainRolloutRepository
+	logger   *slog.Logger
+}
+
+// NewSetBetaOptInUseCase constructs a SetBetaOptInUseCase.
+func NewSetBetaOptInUseCase(rollouts repositories.ChainRolloutRepository, logger *slog.Logger) *SetBetaOptInUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SetBetaOptInUseCase{
+		rollouts: rollouts,
+		logger:   logger,
+	}
+}
+
+// Execute records the choice and returns the user's resulting availability. Joining requires the
+// chain's rollout to accept beta users; leaving is always allowed.
+func (uc *SetBetaOptInUseCase) Execute(ctx context.Context, input SetBetaOptInInput) (dto.ChainAvailability, error) {
+	if uc.rollouts == nil {
+		return dto.ChainAvailability{}, errors.New("set beta opt-in: rollout repository not configured")
+	}
+
+	userID, err := parseUserID(input.UserID)
+	if err != nil {
+		return dto.ChainAvailability{}, err
+	}
+	chain := entities.NormalizeChain(input.Chain)
+	if chain == "" {
+		return dto.ChainAvailability{}, invalidChainError()
+	}
+
+	if input.OptedIn {
+		state, err := evaluateRollout(ctx, uc.rollouts, userID, chain)
+		if err != nil {
+			return dto.ChainAvailability{}, err
+		}
+		if !state.betaOpen {
+			return dto.ChainAvailability{}, utils.NewAppError(
+				"CHAIN_BETA_CLOSED",
+				"this chain does not accept beta sign-ups",
+				fiber.StatusConflict,
+				nil,
+				map[string]any{"chain": chain},
+			)
+		}
+	}
+
+	if err := uc.rollouts.SetOptIn(ctx, userID, chain, input.OptedIn); err != nil {
+		return dto.ChainAvailability{}, err
+	}
+
+	uc.logger.Info("chain beta opt-in changed",
+		slog.String("user_id", userID.String()),
+		slog.String("chain", string(chain)),
+		slog.Bool("opted_in", input.OptedIn))
+
+	state, err := evaluateRollout(ctx, uc.rollouts, userID, chain)
+	if err != nil {
+		return dto.ChainAvailability{}, err
+	}
+	return mapChainAvailability(chain, state), nil
+}
+
+func mapChainAvailability(chain entities.Chain, state rolloutState) dto.ChainAvailability {
+	return dto.ChainAvailability{
+		Chain:     string(chain),
+		Available: state.allowed,
+		Cohort:    string(state.cohort),
+		BetaOpen:  state.betaOpen,
+		OptedIn:   state.optedIn,
+	}
+}
+
+func parseUserID(raw string) (uuid.UUID, error) {
+	userID, err := uuid.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		var validation utils.ValidationErrors
+		validation.Add("user_id", "must be a valid UUID")
+		return uuid.Nil, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"invalid user identifier",
+			fiber.StatusBadRequest,
+			validation,
+			map[string]any{"errors": validation},
+		)
+	}
+	return userID, nil
+}
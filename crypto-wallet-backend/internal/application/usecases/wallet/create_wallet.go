@@ -10,7 +10,10 @@ import (
 
 	"github.com/crypto-wallet/backend/internal/application/dto"
 	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/events"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
 	"github.com/crypto-wallet/backend/internal/domain/services"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
 	"github.com/crypto-wallet/backend/pkg/utils"
 )
 
@@ -22,19 +25,26 @@ type CreateWalletInput struct {
 }
 
 // CreateWalletUseCase coordinates wallet creation between the transport layer and domain service.
+// Chains in soft launch are limited to the users their rollout reaches. The wallet service raises
+// events.WalletCreated, which AuditWalletCreated records.
 type CreateWalletUseCase struct {
-	service Service
-	logger  *slog.Logger
+	service  Service
+	rollouts repositories.ChainRolloutRepository
+	metrics  RolloutRecorder
+	logger   *slog.Logger
 }
 
-// NewCreateWalletUseCase constructs a CreateWalletUseCase.
-func NewCreateWalletUseCase(service Service, logger *slog.Logger) *CreateWalletUseCase {
+// NewCreateWalletUseCase constructs a CreateWalletUseCase. A nil rollout repository makes every
+// chain generally available; metrics are optional.
+func NewCreateWalletUseCase(service Service, rollouts repositories.ChainRolloutRepository, metrics RolloutRecorder, logger *slog.Logger) *CreateWalletUseCase {
 	if logger == nil {
 		logger = slog.Default()
 	}
 	return &CreateWalletUseCase{
-		service: service,
-		logger:  logger,
+		service:  service,
+		rollouts: rollouts,
+		metrics:  metrics,
+		logger:   logger,
 	}
 }
 
@@ -62,14 +72,46 @@ func (uc *CreateWalletUseCase) Execute(ctx context.Context, input CreateWalletIn
 		)
 	}
 
+	rollout, err := evaluateRollout(ctx, uc.rollouts, userID, chain)
+	if err != nil {
+		return dto.Wallet{}, err
+	}
+	if uc.metrics != nil {
+		uc.metrics.RecordDecision(string(chain), string(rollout.cohort), rollout.allowed)
+	}
+	if !rollout.allowed {
+		uc.logger.Info("wallet creation outside chain rollout",
+			slog.String("user_id", userID.String()),
+			slog.String("chain", string(chain)))
+		return dto.Wallet{}, chainNotAvailableError(chain, rollout.betaOpen)
+	}
+
 	wallet, err := uc.service.CreateWallet(ctx, services.CreateWalletParams{
 		UserID: userID,
 		Chain:  chain,
 		Label:  input.Label,
 	})
+	if uc.metrics != nil {
+		uc.metrics.RecordOutcome(string(chain), string(rollout.cohort), err == nil)
+	}
 	if err != nil {
 		return dto.Wallet{}, err
 	}
 
 	return mapWalletEntity(wallet), nil
 }
+
+// AuditWalletCreated records a wallet_create audit entry, with the owner as actor, for each
+// events.WalletCreated.
+func AuditWalletCreated(auditLogger AuditLogger) events.Handler {
+	return events.Handle(func(ctx context.Context, event events.WalletCreated) error {
+		return auditLogger.Record(ctx, audit.Entry{
+			ActorID:      event.OwnerID.String(),
+			Action:       "wallet_create",
+			ResourceType: "wallet",
+			TargetID:     event.WalletID.String(),
+			After:        event.Payload(),
+			Occurred:     event.At,
+		})
+	})
+}
@@ -0,0 +1,421 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/internal/infrastructure/security"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+const (
+	// EventWalletDormancyReminder is published when wallets are about to turn dormant.
+	EventWalletDormancyReminder = "wallet.dormancy_reminder"
+	// EventWalletDormant is published when wallets turn dormant and need reactivating.
+	EventWalletDormant = "wallet.dormant"
+)
+
+const dormancyScanBatchSize = 200
+
+// Notifier publishes wallet events to the notification pipeline.
+type Notifier interface {
+	Notify(ctx context.Context, event string, data map[string]any) error
+}
+
+// DormancyRunResult reports what a dormancy policy run did.
+type DormancyRunResult struct {
+	Reminded int
+	Dormant  int
+}
+
+// ApplyDormancyPolicyUseCase reminds owners of wallets nearing dormancy and moves wallets that
+// reached it to the dormant status.
+type ApplyDormancyPolicyUseCase struct {
+	repository repositories.WalletDormancyRepository
+	policy     entities.DormancyPolicy
+	notifier   Notifier
+	audit      AuditLogger
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+// NewApplyDormancyPolicyUseCase constructs the use case; the notifier and audit logger are optional.
+func NewApplyDormancyPolicyUseCase(
+	repo repositories.WalletDormancyRepository,
+	policy entities.DormancyPolicy,
+	notifier Notifier,
+	auditLogger AuditLogger,
+	logger *slog.Logger,
+) *ApplyDormancyPolicyUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ApplyDormancyPolicyUseCase{
+		repository: repo,
+		policy:     policy,
+		notifier:   notifier,
+		audit:      auditLogger,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// dormancyBatch collects one user's wallets for a single notification.
+type dormancyBatch struct {
+	walletIDs []uuid.UUID
+	dormantAt time.Time
+}
+
+// Execute scans every wallet inside the reminder window. Reminders are grouped per owner and only
+// marked as sent once the notification is accepted, so a failed send is retried on the next run.
+func (uc *ApplyDormancyPolicyUseCase) Execute(ctx context.Context) (DormancyRunResult, error) {
+	var result DormancyRunResult
+	if uc.repository == nil {
+		return result, errors.New("apply wallet dormancy: repository not configured")
+	}
+	if uc.policy.InactiveAfter <= 0 {
+		return result, errors.New("apply wallet dormancy: policy not configured")
+	}
+
+	now := uc.now().UTC()
+	dormantCutoff := now.Add(-uc.policy.InactiveAfter)
+	scanSince := dormantCutoff.Add(uc.policy.ReminderWindow())
+
+	after := uuid.Nil
+	for {
+		activities, err := uc.repository.ListInactive(ctx, scanSince, after, dormancyScanBatchSize)
+		if err != nil {
+			return result, err
+		}
+		if len(activities) == 0 {
+			return result, nil
+		}
+
+		reminders := make(map[uuid.UUID]*dormancyBatch)
+		dormant := make(map[uuid.UUID]*dormancyBatch)
+		var order []uuid.UUID
+		for _, activity := range activities {
+			dormantAt := uc.policy.DormantAt(activity.LastActivityAt())
+			switch {
+			case !now.Before(dormantAt):
+				changed, err := uc.repository.MarkDormant(ctx, activity.WalletID, dormantCutoff, now)
+				if err != nil {
+					uc.logger.Error("failed to mark wallet dormant",
+						slog.String("wallet_id", activity.WalletID.String()),
+						slog.String("error", err.Error()))
+					continue
+				}
+				if !changed {
+					continue
+				}
+				result.Dormant++
+				uc.recordAudit(ctx, activity, now)
+				order = appendBatch(dormant, order, activity.UserID, activity.WalletID, now)
+			case uc.policy.ReminderDue(activity, now):
+				order = appendBatch(reminders, order, activity.UserID, activity.WalletID, dormantAt)
+			}
+		}
+
+		for _, userID := range order {
+			if batch, ok := reminders[userID]; ok {
+				if uc.notify(ctx, EventWalletDormancyReminder, userID, batch, "sign_in") {
+					if err := uc.repository.MarkReminded(ctx, batch.walletIDs, now); err != nil {
+						uc.logger.Error("failed to record dormancy reminder",
+							slog.String("user_id", userID.String()),
+							slog.String("error", err.Error()))
+					} else {
+						result.Reminded += len(batch.walletIDs)
+					}
+				}
+				delete(reminders, userID)
+			}
+			if batch, ok := dormant[userID]; ok {
+				uc.notify(ctx, EventWalletDormant, userID, batch, "reactivate")
+				delete(dormant, userID)
+			}
+		}
+
+		if len(activities) < dormancyScanBatchSize {
+			return result, nil
+		}
+		after = activities[len(activities)-1].WalletID
+	}
+}
+
+// appendBatch adds a wallet to the user's batch, keeping the earliest dormancy date, and returns
+// the user order extended with first-seen users.
+func appendBatch(batches map[uuid.UUID]*dormancyBatch, order []uuid.UUID, userID, walletID uuid.UUID, dormantAt time.Time) []uuid.UUID {
+	batch, ok := batches[userID]
+	if !ok {
+		batch = &dormancyBatch{dormantAt: dormantAt}
+		batches[userID] = batch
+		order = append(order, userID)
+	}
+	batch.walletIDs = append(batch.walletIDs, walletID)
+	if dormantAt.Before(batch.dormantAt) {
+		batch.dormantAt = dormantAt
+	}
+	return order
+}
+
+func (uc *ApplyDormancyPolicyUseCase) notify(ctx context.Context, event string, userID uuid.UUID, batch *dormancyBatch, action string) bool {
+	if uc.notifier == nil {
+		return true
+	}
+	walletIDs := make([]string, len(batch.walletIDs))
+	for i, id := range batch.walletIDs {
+		walletIDs[i] = id.String()
+	}
+	if err := uc.notifier.Notify(ctx, event, map[string]any{
+		"user_id":    userID.String(),
+		"wallet_ids": walletIDs,
+		"dormant_at": batch.dormantAt,
+		"action":     action,
+	}); err != nil {
+		uc.logger.Warn("failed to notify user of wallet dormancy",
+			slog.String("event", event),
+			slog.String("user_id", userID.String()),
+			slog.String("error", err.Error()))
+		return false
+	}
+	return true
+}
+
+func (uc *ApplyDormancyPolicyUseCase) recordAudit(ctx context.Context, activity entities.WalletActivity, now time.Time) {
+	if uc.audit == nil {
+		return
+	}
+	if err := uc.audit.Record(ctx, audit.Entry{
+		ActorID:      "system",
+		Action:       "wallet_dormant",
+		ResourceType: "wallet",
+		TargetID:     activity.WalletID.String(),
+		Metadata: map[string]any{
+			"user_id":          activity.UserID.String(),
+			"last_activity_at": activity.LastActivityAt(),
+			"dormant_since":    now,
+		},
+	}); err != nil {
+		uc.logger.Warn("failed to record wallet dormancy audit entry", slog.String("error", err.Error()))
+	}
+}
+
+// GetWalletActivityInput identifies the wallet whose activity is requested.
+type GetWalletActivityInput struct {
+	ActorID  string
+	WalletID string
+}
+
+// GetWalletActivityUseCase reports a wallet's last activity and when it turns dormant.
+type GetWalletActivityUseCase struct {
+	service    Service
+	repository repositories.WalletDormancyRepository
+	policy     entities.DormancyPolicy
+	logger     *slog.Logger
+}
+
+// NewGetWalletActivityUseCase constructs the use case.
+func NewGetWalletActivityUseCase(service Service, repo repositories.WalletDormancyRepository, policy entities.DormancyPolicy, logger *slog.Logger) *GetWalletActivityUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GetWalletActivityUseCase{
+		service:    service,
+		repository: repo,
+		policy:     policy,
+		logger:     logger,
+	}
+}
+
+// Execute returns the activity of a wallet the actor can view.
+func (uc *GetWalletActivityUseCase) Execute(ctx context.Context, input GetWalletActivityInput) (dto.WalletActivity, error) {
+	if uc.repository == nil {
+		return dto.WalletActivity{}, errors.New("get wallet activity: repository not configured")
+	}
+
+	actorID, walletID, err := parseWalletActor(input.ActorID, input.WalletID)
+	if err != nil {
+		return dto.WalletActivity{}, err
+	}
+	if _, err := uc.service.AuthorizeWallet(ctx, walletID, actorID, entities.WalletPermissionView); err != nil {
+		return dto.WalletActivity{}, walletAccessError(err, walletID)
+	}
+
+	activity, err := uc.repository.GetActivity(ctx, walletID)
+	if err != nil {
+		return dto.WalletActivity{}, err
+	}
+	return mapWalletActivity(activity, uc.policy), nil
+}
+
+// ReactivateWalletInput carries the reactivation request for a dormant wallet.
+type ReactivateWalletInput struct {
+	ActorID  string
+	WalletID string
+	Payload  dto.ReactivateWalletRequest
+}
+
+// ReactivateWalletUseCase returns a dormant wallet to active once its owner re-authenticates with
+// a two-factor code.
+type ReactivateWalletUseCase struct {
+	service    Service
+	repository repositories.WalletDormancyRepository
+	users      UserLookup
+	policy     entities.DormancyPolicy
+	audit      AuditLogger
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+// NewReactivateWalletUseCase constructs the use case; the audit logger is optional.
+func NewReactivateWalletUseCase(
+	service Service,
+	repo repositories.WalletDormancyRepository,
+	users UserLookup,
+	policy entities.DormancyPolicy,
+	auditLogger AuditLogger,
+	logger *slog.Logger,
+) *ReactivateWalletUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ReactivateWalletUseCase{
+		service:    service,
+		repository: repo,
+		users:      users,
+		policy:     policy,
+		audit:      auditLogger,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// Execute verifies the owner's two-factor code and reactivates the wallet. Users without two-factor
+// authentication must enable it first.
+func (uc *ReactivateWalletUseCase) Execute(ctx context.Context, input ReactivateWalletInput) (dto.WalletActivity, error) {
+	if uc.repository == nil || uc.users == nil {
+		return dto.WalletActivity{}, errors.New("reactivate wallet: dependencies not configured")
+	}
+
+	actorID, walletID, err := parseWalletActor(input.ActorID, input.WalletID)
+	if err != nil {
+		return dto.WalletActivity{}, err
+	}
+	if errs := input.Payload.Validate(); !errs.IsEmpty() {
+		return dto.WalletActivity{}, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"invalid reactivation request",
+			fiber.StatusBadRequest,
+			errs,
+			map[string]any{"errors": errs},
+		)
+	}
+
+	wallet, err := uc.service.AuthorizeWallet(ctx, walletID, actorID, entities.WalletPermissionView)
+	if err != nil {
+		return dto.WalletActivity{}, walletAccessError(err, walletID)
+	}
+	if wallet.GetUserID() != actorID {
+		return dto.WalletActivity{}, utils.NewAppError(
+			"WALLET_PERMISSION_DENIED",
+			"only the wallet owner can reactivate a wallet",
+			fiber.StatusForbidden,
+			nil,
+			map[string]any{"wallet_id": walletID},
+		)
+	}
+	if wallet.GetStatus() != entities.WalletStatusDormant {
+		return dto.WalletActivity{}, walletNotDormantError(walletID)
+	}
+
+	user, err := uc.users.GetByID(ctx, actorID)
+	if err != nil {
+		return dto.WalletActivity{}, err
+	}
+	if !user.IsTwoFactorEnabled() {
+		return dto.WalletActivity{}, utils.NewAppError(
+			"TWO_FACTOR_REQUIRED",
+			"enable two-factor authentication to reactivate a dormant wallet",
+			fiber.StatusPreconditionFailed,
+			nil,
+			map[string]any{"wallet_id": walletID},
+		)
+	}
+	secret := strings.TrimSpace(user.GetTwoFactorSecret())
+	if secret == "" || !security.ValidateTOTP(secret, strings.TrimSpace(input.Payload.Code)) {
+		uc.recordAudit(ctx, actorID, walletID, "invalid verification code")
+		return dto.WalletActivity{}, utils.NewAppError(
+			"TWO_FACTOR_CODE_INVALID",
+			"verification code is invalid or expired",
+			fiber.StatusUnauthorized,
+			nil,
+			nil,
+		)
+	}
+
+	changed, err := uc.repository.Reactivate(ctx, walletID, uc.now().UTC())
+	if err != nil {
+		return dto.WalletActivity{}, err
+	}
+	if !changed {
+		return dto.WalletActivity{}, walletNotDormantError(walletID)
+	}
+	uc.recordAudit(ctx, actorID, walletID, "")
+
+	activity, err := uc.repository.GetActivity(ctx, walletID)
+	if err != nil {
+		return dto.WalletActivity{}, err
+	}
+	return mapWalletActivity(activity, uc.policy), nil
+}
+
+func (uc *ReactivateWalletUseCase) recordAudit(ctx context.Context, actorID, walletID uuid.UUID, failure string) {
+	if uc.audit == nil {
+		return
+	}
+	if err := uc.audit.Record(ctx, audit.Entry{
+		ActorID:      actorID.String(),
+		Action:       "wallet_reactivated",
+		ResourceType: "wallet",
+		TargetID:     walletID.String(),
+		Error:        failure,
+	}); err != nil {
+		uc.logger.Warn("failed to record wallet reactivation audit entry", slog.String("error", err.Error()))
+	}
+}
+
+func walletNotDormantError(walletID uuid.UUID) error {
+	return utils.NewAppError(
+		"WALLET_NOT_DORMANT",
+		"wallet is not dormant",
+		fiber.StatusConflict,
+		nil,
+		map[string]any{"wallet_id": walletID},
+	)
+}
+
+func mapWalletActivity(activity entities.WalletActivity, policy entities.DormancyPolicy) dto.WalletActivity {
+	result := dto.WalletActivity{
+		WalletID:          activity.WalletID,
+		Status:            string(activity.Status),
+		LastActivityAt:    activity.LastActivityAt(),
+		LastTransactionAt: activity.LastTransactionAt,
+		LastLoginAt:       activity.LastLoginAt,
+		DormantSince:      activity.DormantSince,
+	}
+	if activity.Status == entities.WalletStatusActive && policy.InactiveAfter > 0 {
+		dormantAt := policy.DormantAt(result.LastActivityAt)
+		result.DormantAt = &dormantAt
+	}
+	return result
+}
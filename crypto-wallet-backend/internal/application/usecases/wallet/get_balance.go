@@ -10,15 +10,17 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
 	"github.com/crypto-wallet/backend/pkg/utils"
 )
 
 // GetWalletBalanceInput captures parameters for retrieving a wallet balance.
 type GetWalletBalanceInput struct {
+	UserID   string
 	WalletID string
 }
 
-// GetWalletBalanceUseCase refreshes and returns the balance for a wallet.
+// GetWalletBalanceUseCase refreshes and returns the balance for a wallet the user may view.
 type GetWalletBalanceUseCase struct {
 	service Service
 	logger  *slog.Logger
@@ -39,6 +41,11 @@ func NewGetWalletBalanceUseCase(service Service, logger *slog.Logger) *GetWallet
 func (uc *GetWalletBalanceUseCase) Execute(ctx context.Context, input GetWalletBalanceInput) (dto.WalletBalance, error) {
 	var validation utils.ValidationErrors
 
+	userID, err := uuid.Parse(strings.TrimSpace(input.UserID))
+	if err != nil {
+		validation.Add("user_id", "must be a valid UUID")
+	}
+
 	walletID, err := uuid.Parse(strings.TrimSpace(input.WalletID))
 	if err != nil {
 		validation.Add("wallet_id", "must be a valid UUID")
@@ -54,6 +61,10 @@ func (uc *GetWalletBalanceUseCase) Execute(ctx context.Context, input GetWalletB
 		)
 	}
 
+	if _, err := uc.service.AuthorizeWallet(ctx, walletID, userID, entities.WalletPermissionView); err != nil {
+		return dto.WalletBalance{}, walletAccessError(err, walletID)
+	}
+
 	wallet, balance, err := uc.service.RefreshWalletBalance(ctx, walletID)
 	if err != nil {
 		return dto.WalletBalance{}, err
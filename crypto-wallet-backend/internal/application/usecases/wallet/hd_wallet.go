@@ -0,0 +1,220 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/services"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// HDWalletService defines the HD seed operations required from the domain wallet service.
+type HDWalletService interface {
+	GetHDSeed(ctx context.Context, userID uuid.UUID) (entities.HDSeed, error)
+	GenerateMnemonic(ctx context.Context, userID uuid.UUID) (string, entities.HDSeed, error)
+	ImportMnemonic(ctx context.Context, userID uuid.UUID, mnemonic string) (entities.HDSeed, error)
+	ConfirmMnemonicBackup(ctx context.Context, userID uuid.UUID, mnemonic string) (entities.HDSeed, error)
+}
+
+// GetHDWalletStatusUseCase reports whether the user has an HD seed and whether it is backed up.
+type GetHDWalletStatusUseCase struct {
+	service HDWalletService
+}
+
+// NewGetHDWalletStatusUseCase constructs the use case.
+func NewGetHDWalletStatusUseCase(service HDWalletService) *GetHDWalletStatusUseCase {
+	return &GetHDWalletStatusUseCase{service: service}
+}
+
+// Execute returns the user's HD wallet status.
+func (uc *GetHDWalletStatusUseCase) Execute(ctx context.Context, rawUserID string) (dto.HDWalletStatus, error) {
+	userID, err := parseHDWalletUser(rawUserID)
+	if err != nil {
+		return dto.HDWalletStatus{}, err
+	}
+	seed, err := uc.service.GetHDSeed(ctx, userID)
+	if err != nil {
+		return dto.HDWalletStatus{}, hdWalletError(err)
+	}
+	return mapHDWalletStatus(seed), nil
+}
+
+// GenerateMnemonicUseCase creates a user's HD seed from a freshly generated mnemonic.
+type GenerateMnemonicUseCase struct {
+	service HDWalletService
+	audit   AuditLogger
+	logger  *slog.Logger
+}
+
+// NewGenerateMnemonicUseCase constructs the use case; the audit logger is optional.
+func NewGenerateMnemonicUseCase(service HDWalletService, auditLogger AuditLogger, logger *slog.Logger) *GenerateMnemonicUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GenerateMnemonicUseCase{
+		service: service,
+		audit:   auditLogger,
+		logger:  logger,
+	}
+}
+
+// Execute generates the mnemonic. The response is the only time the phrase is ever shown.
+func (uc *GenerateMnemonicUseCase) Execute(ctx context.Context, rawUserID string) (dto.GeneratedMnemonic, error) {
+	userID, err := parseHDWalletUser(rawUserID)
+	if err != nil {
+		return dto.GeneratedMnemonic{}, err
+	}
+	mnemonic, seed, err := uc.service.GenerateMnemonic(ctx, userID)
+	if err != nil {
+		return dto.GeneratedMnemonic{}, hdWalletError(err)
+	}
+	recordHDWalletAudit(ctx, uc.audit, uc.logger, userID, "hd_seed_generated")
+	return dto.GeneratedMnemonic{Mnemonic: mnemonic, Status: mapHDWalletStatus(seed)}, nil
+}
+
+// ImportMnemonicUseCase creates a user's HD seed from a mnemonic they already own.
+type ImportMnemonicUseCase struct {
+	service HDWalletService
+	audit   AuditLogger
+	logger  *slog.Logger
+}
+
+// NewImportMnemonicUseCase constructs the use case; the audit logger is optional.
+func NewImportMnemonicUseCase(service HDWalletService, auditLogger AuditLogger, logger *slog.Logger) *ImportMnemonicUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ImportMnemonicUseCase{
+		service: service,
+		audit:   auditLogger,
+		logger:  logger,
+	}
+}
+
+// Execute imports the mnemonic.
+func (uc *ImportMnemonicUseCase) Execute(ctx context.Context, rawUserID string, req dto.MnemonicRequest) (dto.HDWalletStatus, error) {
+	userID, err := parseHDWalletUser(rawUserID)
+	if err != nil {
+		return dto.HDWalletStatus{}, err
+	}
+	if errs := req.Validate(); !errs.IsEmpty() {
+		return dto.HDWalletStatus{}, mnemonicValidationError(errs)
+	}
+	seed, err := uc.service.ImportMnemonic(ctx, userID, req.Mnemonic)
+	if err != nil {
+		return dto.HDWalletStatus{}, hdWalletError(err)
+	}
+	recordHDWalletAudit(ctx, uc.audit, uc.logger, userID, "hd_seed_imported")
+	return mapHDWalletStatus(seed), nil
+}
+
+// ConfirmMnemonicBackupUseCase completes the one-time backup confirmation of a generated mnemonic.
+type ConfirmMnemonicBackupUseCase struct {
+	service HDWalletService
+	audit   AuditLogger
+	logger  *slog.Logger
+}
+
+// NewConfirmMnemonicBackupUseCase constructs the use case; the audit logger is optional.
+func NewConfirmMnemonicBackupUseCase(service HDWalletService, auditLogger AuditLogger, logger *slog.Logger) *ConfirmMnemonicBackupUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ConfirmMnemonicBackupUseCase{
+		service: service,
+		audit:   auditLogger,
+		logger:  logger,
+	}
+}
+
+// Execute checks the re-entered mnemonic against the stored seed and records the confirmation.
+func (uc *ConfirmMnemonicBackupUseCase) Execute(ctx context.Context, rawUserID string, req dto.MnemonicRequest) (dto.HDWalletStatus, error) {
+	userID, err := parseHDWalletUser(rawUserID)
+	if err != nil {
+		return dto.HDWalletStatus{}, err
+	}
+	if errs := req.Validate(); !errs.IsEmpty() {
+		return dto.HDWalletStatus{}, mnemonicValidationError(errs)
+	}
+	seed, err := uc.service.ConfirmMnemonicBackup(ctx, userID, req.Mnemonic)
+	if err != nil {
+		return dto.HDWalletStatus{}, hdWalletError(err)
+	}
+	recordHDWalletAudit(ctx, uc.audit, uc.logger, userID, "hd_seed_backup_confirmed")
+	return mapHDWalletStatus(seed), nil
+}
+
+func parseHDWalletUser(raw string) (uuid.UUID, error) {
+	userID, err := uuid.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		var validation utils.ValidationErrors
+		validation.Add("user_id", "must be a valid UUID")
+		return uuid.Nil, mnemonicValidationError(validation)
+	}
+	return userID, nil
+}
+
+func mnemonicValidationError(errs utils.ValidationErrors) error {
+	return utils.NewAppError(
+		"VALIDATION_ERROR",
+		"invalid hd wallet request",
+		fiber.StatusBadRequest,
+		errs,
+		map[string]any{"errors": errs},
+	)
+}
+
+// hdWalletError translates HD seed failures into API errors.
+func hdWalletError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrHDWalletNotConfigured):
+		return utils.NewAppError("HD_WALLET_UNAVAILABLE", "hd wallets are not available", fiber.StatusServiceUnavailable, err, nil)
+	case errors.Is(err, services.ErrHDSeedNotFound):
+		return utils.NewAppError("HD_SEED_NOT_FOUND", "no hd wallet seed has been set up", fiber.StatusNotFound, err, nil)
+	case errors.Is(err, services.ErrHDSeedExists):
+		return utils.NewAppError("HD_SEED_EXISTS", "an hd wallet seed already exists", fiber.StatusConflict, err, nil)
+	case errors.Is(err, services.ErrInvalidMnemonic):
+		return utils.NewAppError("INVALID_MNEMONIC", "mnemonic is not a valid BIP39 phrase", fiber.StatusBadRequest, err, nil)
+	case errors.Is(err, services.ErrMnemonicMismatch):
+		return utils.NewAppError("MNEMONIC_MISMATCH", "mnemonic does not match your wallet seed", fiber.StatusBadRequest, err, nil)
+	case errors.Is(err, services.ErrHDBackupConfirmed):
+		return utils.NewAppError("HD_BACKUP_ALREADY_CONFIRMED", "mnemonic backup was already confirmed", fiber.StatusConflict, err, nil)
+	default:
+		return err
+	}
+}
+
+func recordHDWalletAudit(ctx context.Context, auditLogger AuditLogger, logger *slog.Logger, userID uuid.UUID, action string) {
+	if auditLogger == nil {
+		return
+	}
+	if err := auditLogger.Record(ctx, audit.Entry{
+		ActorID:      userID.String(),
+		Action:       action,
+		ResourceType: "hd_seed",
+		TargetID:     userID.String(),
+	}); err != nil {
+		logger.Warn("failed to record hd wallet audit entry", slog.String("action", action), slog.String("error", err.Error()))
+	}
+}
+
+func mapHDWalletStatus(seed entities.HDSeed) dto.HDWalletStatus {
+	status := dto.HDWalletStatus{
+		Source:          string(seed.Source),
+		BackupConfirmed: seed.IsBackupConfirmed(),
+		CreatedAt:       seed.CreatedAt.UTC(),
+	}
+	if seed.BackupConfirmedAt != nil {
+		at := seed.BackupConfirmedAt.UTC()
+		status.BackupConfirmedAt = &at
+	}
+	return status
+}
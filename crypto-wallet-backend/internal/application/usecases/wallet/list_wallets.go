@@ -65,10 +65,10 @@ func (uc *ListWalletsUseCase) Execute(ctx context.Context, input ListWalletsInpu
 	if strings.TrimSpace(input.Status) != "" {
 		status := entities.WalletStatus(strings.ToLower(strings.TrimSpace(input.Status)))
 		switch status {
-		case entities.WalletStatusActive, entities.WalletStatusArchived:
+		case entities.WalletStatusActive, entities.WalletStatusArchived, entities.WalletStatusDormant:
 			statusPtr = &status
 		default:
-			validation.Add("status", "must be either active or archived")
+			validation.Add("status", "must be one of active, archived or dormant")
 		}
 	}
 
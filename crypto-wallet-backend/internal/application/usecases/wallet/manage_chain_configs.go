@@ -0,0 +1,355 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// ChainConfigApplier reconfigures the blockchain adapters from the chain registry.
+type ChainConfigApplier interface {
+	ApplyChainConfigs(configs []entities.ChainConfig)
+}
+
+// ReloadChainConfigsUseCase applies the chain registry to the blockchain adapters.
+type ReloadChainConfigsUseCase struct {
+	chains  repositories.ChainConfigRepository
+	applier ChainConfigApplier
+	logger  *slog.Logger
+}
+
+// NewReloadChainConfigsUseCase constructs the use case.
+func NewReloadChainConfigsUseCase(chains repositories.ChainConfigRepository, applier ChainConfigApplier, logger *slog.Logger) *ReloadChainConfigsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ReloadChainConfigsUseCase{
+		chains:  chains,
+		applier: applier,
+		logger:  logger,
+	}
+}
+
+// Execute loads the registry and applies it. The adapters keep their settings when loading fails.
+func (uc *ReloadChainConfigsUseCase) Execute(ctx context.Context) error {
+	if uc.chains == nil || uc.applier == nil {
+		return errors.New("reload chain configs: registry not configured")
+	}
+
+	configs, err := uc.chains.List(ctx)
+	if err != nil {
+		return err
+	}
+	uc.applier.ApplyChainConfigs(configs)
+	return nil
+}
+
+// ListChainConfigsUseCase returns the chain registry.
+type ListChainConfigsUseCase struct {
+	chains repositories.ChainConfigRepository
+}
+
+// NewListChainConfigsUseCase constructs the use case.
+func NewListChainConfigsUseCase(chains repositories.ChainConfigRepository) *ListChainConfigsUseCase {
+	return &ListChainConfigsUseCase{chains: chains}
+}
+
+// Execute lists every registered chain.
+func (uc *ListChainConfigsUseCase) Execute(ctx context.Context) ([]dto.ChainConfig, error) {
+	if uc.chains == nil {
+		return nil, errors.New("list chain configs: repository not configured")
+	}
+
+	configs, err := uc.chains.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.ChainConfig, 0, len(configs))
+	for _, config := range configs {
+		items = append(items, mapChainConfig(config))
+	}
+	return items, nil
+}
+
+// UpsertChainConfigInput encapsulates the arguments required to register or update a chain.
+type UpsertChainConfigInput struct {
+	ActorID string
+	Chain   string
+	Request dto.ChainConfigRequest
+}
+
+// UpsertChainConfigUseCase registers a chain or updates its registry entry and applies the
+// registry, so the change takes effect on this instance at once and on others at their next reload.
+type UpsertChainConfigUseCase struct {
+	chains repositories.ChainConfigRepository
+	reload *ReloadChainConfigsUseCase
+	audit  AuditLogger
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewUpsertChainConfigUseCase constructs the use case; reload is optional.
+func NewUpsertChainConfigUseCase(chains repositories.ChainConfigRepository, reload *ReloadChainConfigsUseCase, auditLogger AuditLogger, logger *slog.Logger) *UpsertChainConfigUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &UpsertChainConfigUseCase{
+		chains: chains,
+		reload: reload,
+		audit:  auditLogger,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Execute merges the request into the chain's entry and stores it as managed.
+func (uc *UpsertChainConfigUseCase) Execute(ctx context.Context, input UpsertChainConfigInput) (*dto.ChainConfig, error) {
+	if uc.chains == nil {
+		return nil, errors.New("upsert chain config: repository not configured")
+	}
+
+	actorID, err := parseUserID(input.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	chain := entities.NormalizeChain(input.Chain)
+	if chain == "" {
+		return nil, invalidChainError()
+	}
+
+	req := input.Request
+	if errs := req.Validate(); !errs.IsEmpty() {
+		return nil, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"invalid chain config",
+			fiber.StatusBadRequest,
+			errs,
+			map[string]any{"errors": errs},
+		)
+	}
+
+	now := uc.now().UTC()
+	params := entities.ChainParams{Symbol: chain, IsActive: true, CreatedAt: now}
+	existing, err := uc.chains.GetBySymbol(ctx, chain)
+	switch {
+	case err == nil:
+		params = chainParams(existing)
+	case !errors.Is(err, repositories.ErrNotFound):
+		return nil, err
+	}
+
+	if req.Name != nil {
+		params.Name = *req.Name
+	}
+	if req.RPCURL != nil {
+		params.RPCURL = *req.RPCURL
+	}
+	if req.RPCURLFallback != nil {
+		params.RPCURLFallback = *req.RPCURLFallback
+	}
+	if req.ExplorerURL != nil {
+		params.ExplorerURL = *req.ExplorerURL
+	}
+	if req.NativeTokenSymbol != nil {
+		params.NativeTokenSymbol = *req.NativeTokenSymbol
+	}
+	if req.NativeTokenDecimals != nil {
+		params.NativeTokenDecimals = *req.NativeTokenDecimals
+	}
+	if req.ConfirmationThreshold != nil {
+		params.ConfirmationThreshold = *req.ConfirmationThreshold
+	}
+	if req.AverageBlockTimeSeconds != nil {
+		params.AverageBlockTime = time.Duration(*req.AverageBlockTimeSeconds) * time.Second
+	}
+	if req.NetworkType != nil {
+		params.NetworkType = entities.ChainNetworkType(strings.ToLower(strings.TrimSpace(*req.NetworkType)))
+	}
+	if req.IsActive != nil {
+		params.IsActive = *req.IsActive
+	}
+	params.Managed = true
+	params.UpdatedAt = now
+
+	entity, err := entities.NewChainEntity(params)
+	if err != nil {
+		return nil, utils.NewAppError(
+			"CHAIN_CONFIG_INVALID",
+			"chain config is invalid",
+			fiber.StatusBadRequest,
+			err,
+			map[string]any{"chain": chain, "reason": err.Error()},
+		)
+	}
+	if err := uc.chains.Upsert(ctx, entity); err != nil {
+		return nil, err
+	}
+
+	if uc.audit != nil {
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID:  actorID.String(),
+			Action:   "chain_config_updated",
+			TargetID: string(chain),
+			Metadata: map[string]any{
+				"rpc_url":                entity.GetRPCURL(),
+				"network_type":           string(entity.GetNetworkType()),
+				"confirmation_threshold": entity.GetConfirmationThreshold(),
+				"is_active":              entity.IsActive(),
+			},
+		}); err != nil {
+			uc.logger.Warn("failed to record chain config audit entry", slog.String("error", err.Error()))
+		}
+	}
+
+	applyChainConfigs(ctx, uc.reload, uc.logger)
+
+	result := mapChainConfig(entity)
+	return &result, nil
+}
+
+// SetChainActiveInput encapsulates the arguments required to enable or disable a chain.
+type SetChainActiveInput struct {
+	ActorID string
+	Chain   string
+	Active  bool
+}
+
+// SetChainActiveUseCase enables or disables a registered chain. A disabled chain's adapter
+// rejects every call until the chain is enabled again.
+type SetChainActiveUseCase struct {
+	chains repositories.ChainConfigRepository
+	reload *ReloadChainConfigsUseCase
+	audit  AuditLogger
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewSetChainActiveUseCase constructs the use case; reload is optional.
+func NewSetChainActiveUseCase(chains repositories.ChainConfigRepository, reload *ReloadChainConfigsUseCase, auditLogger AuditLogger, logger *slog.Logger) *SetChainActiveUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SetChainActiveUseCase{
+		chains: chains,
+		reload: reload,
+		audit:  auditLogger,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Execute toggles the chain and applies the registry.
+func (uc *SetChainActiveUseCase) Execute(ctx context.Context, input SetChainActiveInput) (*dto.ChainConfig, error) {
+	if uc.chains == nil {
+		return nil, errors.New("set chain active: repository not configured")
+	}
+
+	actorID, err := parseUserID(input.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	chain := entities.NormalizeChain(input.Chain)
+	if chain == "" {
+		return nil, invalidChainError()
+	}
+
+	existing, err := uc.chains.GetBySymbol(ctx, chain)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, utils.NewAppError(
+				"CHAIN_CONFIG_NOT_FOUND",
+				"chain is not registered",
+				fiber.StatusNotFound,
+				err,
+				map[string]any{"chain": chain},
+			)
+		}
+		return nil, err
+	}
+
+	entity := entities.HydrateChainEntity(chainParams(existing))
+	entity.SetActive(input.Active)
+	entity.Touch(uc.now())
+	if err := uc.chains.Upsert(ctx, entity); err != nil {
+		return nil, err
+	}
+
+	action := "chain_disabled"
+	if input.Active {
+		action = "chain_enabled"
+	}
+	if uc.audit != nil {
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID:  actorID.String(),
+			Action:   action,
+			TargetID: string(chain),
+		}); err != nil {
+			uc.logger.Warn("failed to record chain config audit entry", slog.String("error", err.Error()))
+		}
+	}
+
+	applyChainConfigs(ctx, uc.reload, uc.logger)
+
+	result := mapChainConfig(entity)
+	return &result, nil
+}
+
+// applyChainConfigs reloads the registry after a change. The change is stored, so a failure here
+// is only logged; the reload worker applies it on its next run.
+func applyChainConfigs(ctx context.Context, reload *ReloadChainConfigsUseCase, logger *slog.Logger) {
+	if reload == nil {
+		return
+	}
+	if err := reload.Execute(ctx); err != nil {
+		logger.Warn("failed to apply chain registry", slog.String("error", err.Error()))
+	}
+}
+
+func chainParams(config entities.ChainConfig) entities.ChainParams {
+	return entities.ChainParams{
+		ID:                    config.GetID(),
+		Symbol:                config.GetSymbol(),
+		Name:                  config.GetName(),
+		RPCURL:                config.GetRPCURL(),
+		RPCURLFallback:        config.GetRPCFallbackURL(),
+		ExplorerURL:           config.GetExplorerURL(),
+		NativeTokenSymbol:     config.GetNativeTokenSymbol(),
+		NativeTokenDecimals:   config.GetNativeTokenDecimals(),
+		ConfirmationThreshold: config.GetConfirmationThreshold(),
+		AverageBlockTime:      config.GetAverageBlockTime(),
+		IsActive:              config.IsActive(),
+		Managed:               config.IsManaged(),
+		NetworkType:           config.GetNetworkType(),
+		CreatedAt:             config.GetCreatedAt(),
+		UpdatedAt:             config.GetUpdatedAt(),
+	}
+}
+
+func mapChainConfig(config entities.ChainConfig) dto.ChainConfig {
+	return dto.ChainConfig{
+		Chain:                   string(config.GetSymbol()),
+		Name:                    config.GetName(),
+		RPCURL:                  config.GetRPCURL(),
+		RPCURLFallback:          config.GetRPCFallbackURL(),
+		ExplorerURL:             config.GetExplorerURL(),
+		NativeTokenSymbol:       config.GetNativeTokenSymbol(),
+		NativeTokenDecimals:     config.GetNativeTokenDecimals(),
+		ConfirmationThreshold:   config.GetConfirmationThreshold(),
+		AverageBlockTimeSeconds: int(config.GetAverageBlockTime() / time.Second),
+		NetworkType:             string(config.GetNetworkType()),
+		IsActive:                config.IsActive(),
+		Managed:                 config.IsManaged(),
+		UpdatedAt:               config.GetUpdatedAt(),
+	}
+}
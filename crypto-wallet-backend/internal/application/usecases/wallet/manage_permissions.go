@@ -0,0 +1,340 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// UserLookup resolves users that permissions are granted to.
+type UserLookup interface {
+	GetByID(ctx context.Context, id uuid.UUID) (entities.User, error)
+}
+
+// ListWalletPermissionsInput encapsulates the arguments required to list a wallet's grants.
+type ListWalletPermissionsInput struct {
+	ActorID  string
+	WalletID string
+}
+
+// ListWalletPermissionsUseCase returns the members of a shared wallet and their permissions.
+type ListWalletPermissionsUseCase struct {
+	service     Service
+	permissions repositories.WalletPermissionRepository
+	logger      *slog.Logger
+}
+
+// NewListWalletPermissionsUseCase constructs the use case.
+func NewListWalletPermissionsUseCase(service Service, permissions repositories.WalletPermissionRepository, logger *slog.Logger) *ListWalletPermissionsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ListWalletPermissionsUseCase{
+		service:     service,
+		permissions: permissions,
+		logger:      logger,
+	}
+}
+
+// Execute lists the grants on a wallet the actor owns.
+func (uc *ListWalletPermissionsUseCase) Execute(ctx context.Context, input ListWalletPermissionsInput) ([]dto.WalletPermissionGrant, error) {
+	if uc.permissions == nil {
+		return nil, errors.New("list wallet permissions: repository not configured")
+	}
+
+	actorID, walletID, err := parseWalletActor(input.ActorID, input.WalletID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireWalletOwner(ctx, uc.service, walletID, actorID); err != nil {
+		return nil, err
+	}
+
+	grants, err := uc.permissions.ListByWallet(ctx, walletID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.WalletPermissionGrant, 0, len(grants))
+	for _, grant := range grants {
+		items = append(items, mapWalletPermissionGrant(grant))
+	}
+	return items, nil
+}
+
+// GrantWalletPermissionInput encapsulates the arguments required to set a member's permissions.
+type GrantWalletPermissionInput struct {
+	ActorID  string
+	WalletID string
+	UserID   string
+	Request  dto.WalletPermissionRequest
+}
+
+// GrantWalletPermissionUseCase creates or replaces a member's permissions on a wallet.
+type GrantWalletPermissionUseCase struct {
+	service     Service
+	permissions repositories.WalletPermissionRepository
+	users       UserLookup
+	audit       AuditLogger
+	logger      *slog.Logger
+	now         func() time.Time
+}
+
+// NewGrantWalletPermissionUseCase constructs the use case; the user lookup is optional.
+func NewGrantWalletPermissionUseCase(service Service, permissions repositories.WalletPermissionRepository, users UserLookup, auditLogger AuditLogger, logger *slog.Logger) *GrantWalletPermissionUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GrantWalletPermissionUseCase{
+		service:     service,
+		permissions: permissions,
+		users:       users,
+		audit:       auditLogger,
+		logger:      logger,
+		now:         time.Now,
+	}
+}
+
+// Execute validates and stores the member's permissions. Only the wallet owner may grant, and the
+// owner cannot grant to themselves since they already hold every permission.
+func (uc *GrantWalletPermissionUseCase) Execute(ctx context.Context, input GrantWalletPermissionInput) (*dto.WalletPermissionGrant, error) {
+	if uc.permissions == nil {
+		return nil, errors.New("grant wallet permission: repository not configured")
+	}
+
+	actorID, walletID, err := parseWalletActor(input.ActorID, input.WalletID)
+	if err != nil {
+		return nil, err
+	}
+
+	validation := input.Request.Validate()
+	memberID, parseErr := uuid.Parse(strings.TrimSpace(input.UserID))
+	if parseErr != nil {
+		validation.Add("user_id", "must be a valid UUID")
+	} else if memberID == actorID {
+		validation.Add("user_id", "must not be the wallet owner")
+	}
+	if !validation.IsEmpty() {
+		return nil, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"invalid wallet permission",
+			fiber.StatusBadRequest,
+			validation,
+			map[string]any{"errors": validation},
+		)
+	}
+
+	if err := requireWalletOwner(ctx, uc.service, walletID, actorID); err != nil {
+		return nil, err
+	}
+
+	if uc.users != nil {
+		if _, err := uc.users.GetByID(ctx, memberID); err != nil {
+			if errors.Is(err, repositories.ErrNotFound) {
+				return nil, utils.NewAppError(
+					"USER_NOT_FOUND",
+					"user not found",
+					fiber.StatusNotFound,
+					err,
+					map[string]any{"user_id": memberID},
+				)
+			}
+			return nil, err
+		}
+	}
+
+	permissions := make([]entities.WalletPermission, 0, len(input.Request.Permissions))
+	for _, raw := range input.Request.Permissions {
+		permission := entities.ParseWalletPermission(raw)
+		if !slices.Contains(permissions, permission) {
+			permissions = append(permissions, permission)
+		}
+	}
+
+	now := uc.now().UTC()
+	grant := entities.WalletPermissionGrant{
+		WalletID:    walletID,
+		UserID:      memberID,
+		Permissions: permissions,
+		GrantedBy:   actorID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := grant.Validate(); err != nil {
+		return nil, utils.NewAppError(
+			"WALLET_PERMISSION_INVALID",
+			"wallet permission is invalid",
+			fiber.StatusBadRequest,
+			err,
+			map[string]any{"wallet_id": walletID},
+		)
+	}
+
+	if err := uc.permissions.Upsert(ctx, grant); err != nil {
+		return nil, err
+	}
+
+	if uc.audit != nil {
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID:  actorID.String(),
+			Action:   "wallet_permission_granted",
+			TargetID: walletID.String(),
+			Metadata: map[string]any{
+				"user_id":     memberID.String(),
+				"permissions": permissionNames(permissions),
+			},
+		}); err != nil {
+			uc.logger.Warn("failed to record wallet permission audit entry", slog.String("error", err.Error()))
+		}
+	}
+
+	result := mapWalletPermissionGrant(grant)
+	return &result, nil
+}
+
+// RevokeWalletPermissionInput encapsulates the arguments required to remove a member from a wallet.
+type RevokeWalletPermissionInput struct {
+	ActorID  string
+	WalletID string
+	UserID   string
+}
+
+// RevokeWalletPermissionUseCase removes a member's permissions on a wallet.
+type RevokeWalletPermissionUseCase struct {
+	service     Service
+	permissions repositories.WalletPermissionRepository
+	audit       AuditLogger
+	logger      *slog.Logger
+}
+
+// NewRevokeWalletPermissionUseCase constructs the use case.
+func NewRevokeWalletPermissionUseCase(service Service, permissions repositories.WalletPermissionRepository, auditLogger AuditLogger, logger *slog.Logger) *RevokeWalletPermissionUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RevokeWalletPermissionUseCase{
+		service:     service,
+		permissions: permissions,
+		audit:       auditLogger,
+		logger:      logger,
+	}
+}
+
+// Execute deletes the member's grant on a wallet the actor owns.
+func (uc *RevokeWalletPermissionUseCase) Execute(ctx context.Context, input RevokeWalletPermissionInput) error {
+	if uc.permissions == nil {
+		return errors.New("revoke wallet permission: repository not configured")
+	}
+
+	actorID, walletID, err := parseWalletActor(input.ActorID, input.WalletID)
+	if err != nil {
+		return err
+	}
+	memberID, err := parseUserID(input.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := requireWalletOwner(ctx, uc.service, walletID, actorID); err != nil {
+		return err
+	}
+
+	if err := uc.permissions.Delete(ctx, walletID, memberID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return utils.NewAppError(
+				"WALLET_PERMISSION_NOT_FOUND",
+				"wallet permission not found",
+				fiber.StatusNotFound,
+				err,
+				map[string]any{"wallet_id": walletID, "user_id": memberID},
+			)
+		}
+		return err
+	}
+
+	if uc.audit != nil {
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID:  actorID.String(),
+			Action:   "wallet_permission_revoked",
+			TargetID: walletID.String(),
+			Metadata: map[string]any{"user_id": memberID.String()},
+		}); err != nil {
+			uc.logger.Warn("failed to record wallet permission audit entry", slog.String("error", err.Error()))
+		}
+	}
+	return nil
+}
+
+func parseWalletActor(rawActorID, rawWalletID string) (uuid.UUID, uuid.UUID, error) {
+	var validation utils.ValidationErrors
+
+	actorID, err := uuid.Parse(strings.TrimSpace(rawActorID))
+	if err != nil {
+		validation.Add("user_id", "must be a valid UUID")
+	}
+	walletID, err := uuid.Parse(strings.TrimSpace(rawWalletID))
+	if err != nil {
+		validation.Add("wallet_id", "must be a valid UUID")
+	}
+
+	if !validation.IsEmpty() {
+		return uuid.Nil, uuid.Nil, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"invalid wallet request",
+			fiber.StatusBadRequest,
+			validation,
+			map[string]any{"errors": validation},
+		)
+	}
+	return actorID, walletID, nil
+}
+
+// requireWalletOwner allows only the owner to manage a wallet's members. Members see 403, everyone
+// else sees the wallet as missing.
+func requireWalletOwner(ctx context.Context, service Service, walletID, actorID uuid.UUID) error {
+	wallet, err := service.AuthorizeWallet(ctx, walletID, actorID, entities.WalletPermissionView)
+	if err != nil {
+		return walletAccessError(err, walletID)
+	}
+	if wallet.GetUserID() != actorID {
+		return utils.NewAppError(
+			"WALLET_PERMISSION_DENIED",
+			"only the wallet owner can manage permissions",
+			fiber.StatusForbidden,
+			nil,
+			map[string]any{"wallet_id": walletID},
+		)
+	}
+	return nil
+}
+
+func permissionNames(permissions []entities.WalletPermission) []string {
+	names := make([]string, len(permissions))
+	for i, permission := range permissions {
+		names[i] = string(permission)
+	}
+	return names
+}
+
+func mapWalletPermissionGrant(grant entities.WalletPermissionGrant) dto.WalletPermissionGrant {
+	return dto.WalletPermissionGrant{
+		WalletID:    grant.WalletID,
+		UserID:      grant.UserID,
+		Permissions: permissionNames(grant.Permissions),
+		GrantedBy:   grant.GrantedBy,
+		CreatedAt:   grant.CreatedAt.UTC(),
+		UpdatedAt:   grant.UpdatedAt.UTC(),
+	}
+}
@@ -0,0 +1,257 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// ListChainRolloutsUseCase returns the configured rollouts with their cohort metrics.
+type ListChainRolloutsUseCase struct {
+	rollouts repositories.ChainRolloutRepository
+	metrics  RolloutRecorder
+	logger   *slog.Logger
+}
+
+// NewListChainRolloutsUseCase constructs the use case; metrics are optional.
+func NewListChainRolloutsUseCase(rollouts repositories.ChainRolloutRepository, metrics RolloutRecorder, logger *slog.Logger) *ListChainRolloutsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ListChainRolloutsUseCase{
+		rollouts: rollouts,
+		metrics:  metrics,
+		logger:   logger,
+	}
+}
+
+// Execute lists every rollout. Metrics of chains whose rollout was removed are not reported.
+func (uc *ListChainRolloutsUseCase) Execute(ctx context.Context) ([]dto.ChainRollout, error) {
+	if uc.rollouts == nil {
+		return nil, errors.New("list chain rollouts: repository not configured")
+	}
+
+	rollouts, err := uc.rollouts.ListRollouts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cohorts := make(map[string][]dto.ChainRolloutCohortMetric)
+	if uc.metrics != nil {
+		for _, sample := range uc.metrics.Snapshot() {
+			cohorts[sample.Chain] = append(cohorts[sample.Chain], dto.ChainRolloutCohortMetric{
+				Cohort:  sample.Cohort,
+				Allowed: sample.Allowed,
+				Denied:  sample.Denied,
+				Created: sample.Created,
+				Failed:  sample.Failed,
+			})
+		}
+	}
+
+	items := make([]dto.ChainRollout, 0, len(rollouts))
+	for _, rollout := range rollouts {
+		item := mapChainRollout(rollout)
+		if metrics, ok := cohorts[item.Chain]; ok {
+			item.Cohorts = metrics
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// UpsertChainRolloutInput encapsulates the arguments required to replace a chain's rollout.
+type UpsertChainRolloutInput struct {
+	ActorID string
+	Chain   string
+	Request dto.ChainRolloutRequest
+}
+
+// UpsertChainRolloutUseCase creates or replaces a chain's rollout.
+type UpsertChainRolloutUseCase struct {
+	rollouts repositories.ChainRolloutRepository
+	audit    AuditLogger
+	logger   *slog.Logger
+	now      func() time.Time
+}
+
+// NewUpsertChainRolloutUseCase constructs the use case.
+func NewUpsertChainRolloutUseCase(rollouts repositories.ChainRolloutRepository, auditLogger AuditLogger, logger *slog.Logger) *UpsertChainRolloutUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &UpsertChainRolloutUseCase{
+		rollouts: rollouts,
+		audit:    auditLogger,
+		logger:   logger,
+		now:      time.Now,
+	}
+}
+
+// Execute validates and stores the rollout.
+func (uc *UpsertChainRolloutUseCase) Execute(ctx context.Context, input UpsertChainRolloutInput) (*dto.ChainRollout, error) {
+	if uc.rollouts == nil {
+		return nil, errors.New("upsert chain rollout: repository not configured")
+	}
+
+	actorID, err := parseUserID(input.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	chain := entities.NormalizeChain(input.Chain)
+	if chain == "" {
+		return nil, invalidChainError()
+	}
+
+	if errs := input.Request.Validate(); !errs.IsEmpty() {
+		return nil, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"invalid chain rollout",
+			fiber.StatusBadRequest,
+			errs,
+			map[string]any{"errors": errs},
+		)
+	}
+
+	allowlist := make([]uuid.UUID, 0, len(input.Request.Allowlist))
+	seen := make(map[uuid.UUID]bool, len(input.Request.Allowlist))
+	for _, raw := range input.Request.Allowlist {
+		id := uuid.MustParse(raw)
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		allowlist = append(allowlist, id)
+	}
+
+	rollout := entities.ChainRollout{
+		Chain:      chain,
+		Percentage: input.Request.Percentage,
+		Allowlist:  allowlist,
+		BetaOptIn:  input.Request.BetaOptIn,
+		UpdatedBy:  &actorID,
+		UpdatedAt:  uc.now().UTC(),
+	}
+	if err := rollout.Validate(); err != nil {
+		return nil, utils.NewAppError(
+			"CHAIN_ROLLOUT_INVALID",
+			"chain rollout is invalid",
+			fiber.StatusBadRequest,
+			err,
+			map[string]any{"chain": chain},
+		)
+	}
+
+	if err := uc.rollouts.UpsertRollout(ctx, rollout); err != nil {
+		return nil, err
+	}
+
+	if uc.audit != nil {
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID:  actorID.String(),
+			Action:   "chain_rollout_updated",
+			TargetID: string(chain),
+			Metadata: map[string]any{
+				"percentage":      rollout.Percentage,
+				"allowlist_count": len(rollout.Allowlist),
+				"beta_opt_in":     rollout.BetaOptIn,
+			},
+		}); err != nil {
+			uc.logger.Warn("failed to record chain rollout audit entry", slog.String("error", err.Error()))
+		}
+	}
+
+	result := mapChainRollout(rollout)
+	return &result, nil
+}
+
+// DeleteChainRolloutInput encapsulates the arguments required to remove a chain's rollout.
+type DeleteChainRolloutInput struct {
+	ActorID string
+	Chain   string
+}
+
+// DeleteChainRolloutUseCase removes a chain's rollout, making the chain generally available.
+type DeleteChainRolloutUseCase struct {
+	rollouts repositories.ChainRolloutRepository
+	audit    AuditLogger
+	logger   *slog.Logger
+}
+
+// NewDeleteChainRolloutUseCase constructs the use case.
+func NewDeleteChainRolloutUseCase(rollouts repositories.ChainRolloutRepository, auditLogger AuditLogger, logger *slog.Logger) *DeleteChainRolloutUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &DeleteChainRolloutUseCase{
+		rollouts: rollouts,
+		audit:    auditLogger,
+		logger:   logger,
+	}
+}
+
+// Execute deletes the rollout.
+func (uc *DeleteChainRolloutUseCase) Execute(ctx context.Context, input DeleteChainRolloutInput) error {
+	if uc.rollouts == nil {
+		return errors.New("delete chain rollout: repository not configured")
+	}
+
+	actorID, err := parseUserID(input.ActorID)
+	if err != nil {
+		return err
+	}
+	chain := entities.NormalizeChain(input.Chain)
+	if chain == "" {
+		return invalidChainError()
+	}
+
+	if err := uc.rollouts.DeleteRollout(ctx, chain); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return utils.NewAppError(
+				"CHAIN_ROLLOUT_NOT_FOUND",
+				"chain rollout not found",
+				fiber.StatusNotFound,
+				err,
+				map[string]any{"chain": chain},
+			)
+		}
+		return err
+	}
+
+	if uc.audit != nil {
+		if err := uc.audit.Record(ctx, audit.Entry{
+			ActorID:  actorID.String(),
+			Action:   "chain_rollout_deleted",
+			TargetID: string(chain),
+		}); err != nil {
+			uc.logger.Warn("failed to record chain rollout audit entry", slog.String("error", err.Error()))
+		}
+	}
+	return nil
+}
+
+func mapChainRollout(rollout entities.ChainRollout) dto.ChainRollout {
+	allowlist := rollout.Allowlist
+	if allowlist == nil {
+		allowlist = []uuid.UUID{}
+	}
+	return dto.ChainRollout{
+		Chain:      string(rollout.Chain),
+		Percentage: rollout.Percentage,
+		Allowlist:  allowlist,
+		BetaOptIn:  rollout.BetaOptIn,
+		UpdatedBy:  rollout.UpdatedBy,
+		UpdatedAt:  rollout.UpdatedAt,
+		Cohorts:    []dto.ChainRolloutCohortMetric{},
+	}
+}
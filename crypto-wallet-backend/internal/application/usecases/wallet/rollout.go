@@ -0,0 +1,86 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/internal/infrastructure/monitoring"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// AuditLogger captures audit events for compliance.
+type AuditLogger interface {
+	Record(ctx context.Context, entry audit.Entry) error
+}
+
+// RolloutRecorder collects wallet creation metrics segmented by rollout cohort.
+type RolloutRecorder interface {
+	RecordDecision(chain, cohort string, allowed bool)
+	RecordOutcome(chain, cohort string, created bool)
+	Snapshot() []monitoring.RolloutSample
+}
+
+// rolloutState is the user's standing in a chain's rollout.
+type rolloutState struct {
+	cohort   entities.RolloutCohort
+	allowed  bool
+	betaOpen bool
+	optedIn  bool
+}
+
+// evaluateRollout places the user in the chain's rollout. Chains without a rollout, and every chain
+// when no repository is configured, are generally available.
+func evaluateRollout(ctx context.Context, rollouts repositories.ChainRolloutRepository, userID uuid.UUID, chain entities.Chain) (rolloutState, error) {
+	if rollouts == nil {
+		return rolloutState{cohort: entities.RolloutCohortGeneral, allowed: true}, nil
+	}
+
+	rollout, err := rollouts.GetRollout(ctx, chain)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return rolloutState{cohort: entities.RolloutCohortGeneral, allowed: true}, nil
+		}
+		return rolloutState{}, err
+	}
+
+	optedIn, err := rollouts.IsOptedIn(ctx, userID, chain)
+	if err != nil {
+		return rolloutState{}, err
+	}
+
+	cohort, allowed := rollout.Cohort(userID, optedIn)
+	return rolloutState{
+		cohort:   cohort,
+		allowed:  allowed,
+		betaOpen: rollout.BetaOptIn && rollout.Percentage < 100,
+		optedIn:  optedIn,
+	}, nil
+}
+
+func chainNotAvailableError(chain entities.Chain, betaOpen bool) error {
+	return utils.NewAppError(
+		"CHAIN_NOT_AVAILABLE",
+		"wallets on this chain are not available to your account yet",
+		fiber.StatusForbidden,
+		nil,
+		map[string]any{"chain": chain, "beta_open": betaOpen},
+	)
+}
+
+func invalidChainError() error {
+	var validation utils.ValidationErrors
+	validation.Add("chain", "must be one of BTC, ETH, SOL, XLM")
+	return utils.NewAppError(
+		"VALIDATION_ERROR",
+		"invalid chain",
+		fiber.StatusBadRequest,
+		validation,
+		map[string]any{"errors": validation},
+	)
+}
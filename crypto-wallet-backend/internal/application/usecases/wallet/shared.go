@@ -2,9 +2,11 @@ package wallet
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"time"
 
+	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 
 	"github.com/crypto-wallet/backend/internal/application/dto"
@@ -12,6 +14,7 @@ import (
 	"github.com/crypto-wallet/backend/internal/domain/repositories"
 	"github.com/crypto-wallet/backend/internal/domain/services"
 	"github.com/crypto-wallet/backend/internal/infrastructure/blockchain"
+	"github.com/crypto-wallet/backend/pkg/utils"
 )
 
 // Service defines the contract required from the domain wallet service.
@@ -20,6 +23,31 @@ type Service interface {
 	ListWallets(ctx context.Context, userID uuid.UUID, filter repositories.WalletFilter, opts repositories.ListOptions) ([]entities.Wallet, error)
 	GetWalletByID(ctx context.Context, id uuid.UUID) (entities.Wallet, error)
 	RefreshWalletBalance(ctx context.Context, walletID uuid.UUID) (entities.Wallet, *blockchain.Balance, error)
+	AuthorizeWallet(ctx context.Context, walletID, userID uuid.UUID, permission entities.WalletPermission) (entities.Wallet, error)
+}
+
+// walletAccessError translates wallet lookup and permission failures into API errors.
+func walletAccessError(err error, walletID uuid.UUID) error {
+	switch {
+	case errors.Is(err, services.ErrWalletNotFound):
+		return utils.NewAppError(
+			"WALLET_NOT_FOUND",
+			"wallet not found",
+			fiber.StatusNotFound,
+			err,
+			map[string]any{"wallet_id": walletID},
+		)
+	case errors.Is(err, services.ErrWalletPermissionDenied):
+		return utils.NewAppError(
+			"WALLET_PERMISSION_DENIED",
+			"you do not have permission for this wallet action",
+			fiber.StatusForbidden,
+			err,
+			map[string]any{"wallet_id": walletID},
+		)
+	default:
+		return err
+	}
 }
 
 func mapWalletEntity(entity entities.Wallet) dto.Wallet {
@@ -0,0 +1,35 @@
+package webhook
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// DeleteEndpointUseCase removes one of the user's webhook endpoints.
+type DeleteEndpointUseCase struct {
+	endpoints repositories.WebhookEndpointRepository
+	logger    *slog.Logger
+}
+
+// NewDeleteEndpointUseCase constructs the use case.
+func NewDeleteEndpointUseCase(endpoints repositories.WebhookEndpointRepository, logger *slog.Logger) *DeleteEndpointUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &DeleteEndpointUseCase{
+		endpoints: endpoints,
+		logger:    logger,
+	}
+}
+
+// Execute deletes the endpoint.
+func (uc *DeleteEndpointUseCase) Execute(ctx context.Context, userID, endpointID uuid.UUID) error {
+	if _, err := loadOwnedEndpoint(ctx, uc.endpoints, userID, endpointID); err != nil {
+		return err
+	}
+	return uc.endpoints.Delete(ctx, endpointID)
+}
@@ -0,0 +1,151 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/events"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/security"
+	infrawebhook "github.com/crypto-wallet/backend/internal/infrastructure/webhook"
+)
+
+// eventDeliveryTimeout bounds the delivery of one event to all of its user's endpoints.
+const eventDeliveryTimeout = 30 * time.Second
+
+// WalletLookup resolves the owner of events that only carry a wallet ID.
+type WalletLookup interface {
+	GetByID(ctx context.Context, id uuid.UUID) (entities.Wallet, error)
+}
+
+// DeliverEventsUseCase posts domain events to the active webhook endpoints of the user they
+// concern, in the envelope sample deliveries use.
+type DeliverEventsUseCase struct {
+	endpoints repositories.WebhookEndpointRepository
+	encryptor *security.AESGCMEncryptor
+	deliverer Deliverer
+	wallets   WalletLookup
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+// NewDeliverEventsUseCase constructs the use case. wallets may be nil, in which case events without
+// a user are not delivered.
+func NewDeliverEventsUseCase(
+	endpoints repositories.WebhookEndpointRepository,
+	encryptor *security.AESGCMEncryptor,
+	deliverer Deliverer,
+	wallets WalletLookup,
+	logger *slog.Logger,
+) *DeliverEventsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &DeliverEventsUseCase{
+		endpoints: endpoints,
+		encryptor: encryptor,
+		deliverer: deliverer,
+		wallets:   wallets,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+// Handle is the event dispatcher subscription. Delivery runs in the background, keeping the
+// context's values but not its deadline, so a slow receiver never holds up the operation that
+// raised the event. Failed deliveries are logged.
+func (uc *DeliverEventsUseCase) Handle(ctx context.Context, event events.Event) error {
+	if uc.endpoints == nil || uc.encryptor == nil || uc.deliverer == nil {
+		return nil
+	}
+	go uc.deliver(context.WithoutCancel(ctx), event)
+	return nil
+}
+
+func (uc *DeliverEventsUseCase) deliver(ctx context.Context, event events.Event) {
+	ctx, cancel := context.WithTimeout(ctx, eventDeliveryTimeout)
+	defer cancel()
+
+	logger := uc.logger.With(slog.String("event", event.EventName()))
+
+	userID, err := uc.owner(ctx, event)
+	if err != nil {
+		logger.Warn("failed to resolve webhook event owner", slog.String("error", err.Error()))
+		return
+	}
+	if userID == uuid.Nil {
+		return
+	}
+
+	endpoints, err := uc.endpoints.ListByUser(ctx, userID)
+	if err != nil {
+		logger.Error("failed to list webhook endpoints", slog.String("user_id", userID.String()), slog.String("error", err.Error()))
+		return
+	}
+
+	occurred := event.OccurredAt()
+	if occurred.IsZero() {
+		occurred = uc.now()
+	}
+	deliveryID := uuid.New().String()
+	payload, err := json.Marshal(map[string]any{
+		"id":         deliveryID,
+		"type":       event.EventName(),
+		"created_at": occurred.UTC().Format(time.RFC3339),
+		"data":       event.Payload(),
+	})
+	if err != nil {
+		logger.Error("failed to encode webhook event", slog.String("error", err.Error()))
+		return
+	}
+
+	for _, endpoint := range endpoints {
+		if !endpoint.IsActive() {
+			continue
+		}
+		endpointLogger := logger.With(slog.String("endpoint_id", endpoint.GetID().String()))
+
+		secret, err := decryptSecret(uc.encryptor, endpoint)
+		if err != nil {
+			endpointLogger.Error("failed to read webhook signing secret", slog.String("error", err.Error()))
+			continue
+		}
+
+		result, err := uc.deliverer.Deliver(ctx, infrawebhook.Delivery{
+			ID:        deliveryID,
+			Event:     event.EventName(),
+			URL:       endpoint.GetURL(),
+			Secret:    secret,
+			Payload:   payload,
+			Timestamp: uc.now().UTC(),
+		})
+		switch {
+		case err != nil:
+			endpointLogger.Warn("webhook event delivery failed", slog.String("error", err.Error()))
+		case !result.Succeeded():
+			endpointLogger.Warn("webhook event rejected by receiver", slog.Int("status", result.StatusCode))
+		}
+	}
+}
+
+// owner returns the event's user, falling back to the owner of the wallet in its payload.
+func (uc *DeliverEventsUseCase) owner(ctx context.Context, event events.Event) (uuid.UUID, error) {
+	if userID := event.UserID(); userID != uuid.Nil {
+		return userID, nil
+	}
+	raw, _ := event.Payload()["wallet_id"].(string)
+	walletID, err := uuid.Parse(raw)
+	if err != nil || uc.wallets == nil {
+		return uuid.Nil, nil
+	}
+	wallet, err := uc.wallets.GetByID(ctx, walletID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return wallet.GetUserID(), nil
+}
@@ -0,0 +1,43 @@
+package webhook
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	infrawebhook "github.com/crypto-wallet/backend/internal/infrastructure/webhook"
+)
+
+// ListEndpointsUseCase lists the user's webhook endpoints.
+type ListEndpointsUseCase struct {
+	endpoints repositories.WebhookEndpointRepository
+	logger    *slog.Logger
+}
+
+// NewListEndpointsUseCase constructs the use case.
+func NewListEndpointsUseCase(endpoints repositories.WebhookEndpointRepository, logger *slog.Logger) *ListEndpointsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ListEndpointsUseCase{
+		endpoints: endpoints,
+		logger:    logger,
+	}
+}
+
+// Execute loads the endpoints.
+func (uc *ListEndpointsUseCase) Execute(ctx context.Context, userID uuid.UUID) ([]dto.WebhookEndpoint, error) {
+	endpoints, err := uc.endpoints.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.WebhookEndpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		items = append(items, dto.MapWebhookEndpoint(endpoint, infrawebhook.CurrentSignatureVersion))
+	}
+	return items, nil
+}
@@ -0,0 +1,108 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/security"
+	infrawebhook "github.com/crypto-wallet/backend/internal/infrastructure/webhook"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// RegisterEndpointUseCase registers a webhook endpoint and issues its signing secret.
+type RegisterEndpointUseCase struct {
+	endpoints repositories.WebhookEndpointRepository
+	encryptor *security.AESGCMEncryptor
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+// NewRegisterEndpointUseCase constructs the use case.
+func NewRegisterEndpointUseCase(endpoints repositories.WebhookEndpointRepository, encryptor *security.AESGCMEncryptor, logger *slog.Logger) *RegisterEndpointUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RegisterEndpointUseCase{
+		endpoints: endpoints,
+		encryptor: encryptor,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+// Execute validates the request and stores the endpoint. The secret is only returned here.
+func (uc *RegisterEndpointUseCase) Execute(ctx context.Context, userID uuid.UUID, req dto.WebhookEndpointRequest) (dto.WebhookEndpointCreatedResponse, error) {
+	if uc.encryptor == nil {
+		return dto.WebhookEndpointCreatedResponse{}, errors.New("register webhook endpoint: encryptor not configured")
+	}
+	if errs := req.Validate(); !errs.IsEmpty() {
+		return dto.WebhookEndpointCreatedResponse{}, wrapValidationError(errs)
+	}
+
+	count, err := uc.endpoints.CountByUser(ctx, userID)
+	if err != nil {
+		return dto.WebhookEndpointCreatedResponse{}, err
+	}
+	if count >= entities.MaxWebhookEndpointsPerUser {
+		return dto.WebhookEndpointCreatedResponse{}, utils.NewAppError(
+			"WEBHOOK_ENDPOINT_LIMIT",
+			"webhook endpoint limit reached",
+			http.StatusConflict,
+			nil,
+			map[string]any{"max": entities.MaxWebhookEndpointsPerUser},
+		)
+	}
+
+	secret, err := generateSecret()
+	if err != nil {
+		return dto.WebhookEndpointCreatedResponse{}, err
+	}
+
+	id := uuid.New()
+	encryptedSecret, err := uc.encryptor.EncryptToString([]byte(secret), []byte(id.String()))
+	if err != nil {
+		return dto.WebhookEndpointCreatedResponse{}, err
+	}
+
+	now := uc.now().UTC()
+	endpoint, err := entities.NewWebhookEndpointEntity(entities.WebhookEndpointParams{
+		ID:              id,
+		UserID:          userID,
+		URL:             req.URL,
+		Description:     req.Description,
+		EncryptedSecret: encryptedSecret,
+		Active:          true,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	})
+	if err != nil {
+		return dto.WebhookEndpointCreatedResponse{}, utils.NewAppError(
+			"WEBHOOK_ENDPOINT_INVALID",
+			"webhook endpoint is invalid",
+			http.StatusBadRequest,
+			err,
+			nil,
+		)
+	}
+
+	if err := uc.endpoints.Create(ctx, endpoint); err != nil {
+		return dto.WebhookEndpointCreatedResponse{}, err
+	}
+
+	uc.logger.Info("webhook endpoint registered",
+		slog.String("endpoint_id", endpoint.GetID().String()),
+		slog.String("user_id", userID.String()))
+
+	return dto.WebhookEndpointCreatedResponse{
+		Endpoint: dto.MapWebhookEndpoint(endpoint, infrawebhook.CurrentSignatureVersion),
+		Secret:   secret,
+	}, nil
+}
@@ -0,0 +1,118 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/security"
+	infrawebhook "github.com/crypto-wallet/backend/internal/infrastructure/webhook"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// EventWebhookTest is the event type of sample deliveries.
+const EventWebhookTest = "webhook.test"
+
+// SendTestDeliveryUseCase sends a signed sample payload to a registered endpoint so integrators
+// can exercise their signature verification end to end.
+type SendTestDeliveryUseCase struct {
+	endpoints repositories.WebhookEndpointRepository
+	encryptor *security.AESGCMEncryptor
+	deliverer Deliverer
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+// NewSendTestDeliveryUseCase constructs the use case.
+func NewSendTestDeliveryUseCase(
+	endpoints repositories.WebhookEndpointRepository,
+	encryptor *security.AESGCMEncryptor,
+	deliverer Deliverer,
+	logger *slog.Logger,
+) *SendTestDeliveryUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SendTestDeliveryUseCase{
+		endpoints: endpoints,
+		encryptor: encryptor,
+		deliverer: deliverer,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+// Execute signs and posts the sample. A receiver error is reported in the response, not as a failure.
+func (uc *SendTestDeliveryUseCase) Execute(ctx context.Context, userID, endpointID uuid.UUID) (dto.WebhookTestDeliveryResponse, error) {
+	if uc.encryptor == nil || uc.deliverer == nil {
+		return dto.WebhookTestDeliveryResponse{}, errors.New("send webhook test delivery: not configured")
+	}
+
+	endpoint, err := loadOwnedEndpoint(ctx, uc.endpoints, userID, endpointID)
+	if err != nil {
+		return dto.WebhookTestDeliveryResponse{}, err
+	}
+	if !endpoint.IsActive() {
+		return dto.WebhookTestDeliveryResponse{}, utils.NewAppError(
+			"WEBHOOK_ENDPOINT_INACTIVE",
+			"webhook endpoint is disabled",
+			http.StatusConflict,
+			nil,
+			nil,
+		)
+	}
+
+	secret, err := decryptSecret(uc.encryptor, endpoint)
+	if err != nil {
+		return dto.WebhookTestDeliveryResponse{}, err
+	}
+
+	now := uc.now().UTC()
+	deliveryID := uuid.New().String()
+	payload, err := json.Marshal(map[string]any{
+		"id":         deliveryID,
+		"type":       EventWebhookTest,
+		"created_at": now.Format(time.RFC3339),
+		"data": map[string]any{
+			"endpoint_id": endpoint.GetID().String(),
+			"message":     "This is a test delivery. Verify its signature with your endpoint secret.",
+		},
+	})
+	if err != nil {
+		return dto.WebhookTestDeliveryResponse{}, err
+	}
+
+	result, deliverErr := uc.deliverer.Deliver(ctx, infrawebhook.Delivery{
+		ID:        deliveryID,
+		Event:     EventWebhookTest,
+		URL:       endpoint.GetURL(),
+		Secret:    secret,
+		Payload:   payload,
+		Timestamp: now,
+	})
+
+	response := dto.WebhookTestDeliveryResponse{
+		DeliveryID:      deliveryID,
+		Event:           EventWebhookTest,
+		Delivered:       deliverErr == nil && result.Succeeded(),
+		StatusCode:      result.StatusCode,
+		ResponseExcerpt: result.ResponseExcerpt,
+		DurationMs:      result.Duration.Milliseconds(),
+		Headers:         result.Headers,
+		Payload:         payload,
+	}
+	if deliverErr != nil {
+		response.Error = deliverErr.Error()
+		uc.logger.Info("webhook test delivery failed",
+			slog.String("endpoint_id", endpoint.GetID().String()),
+			slog.String("error", deliverErr.Error()))
+	}
+	return response, nil
+}
@@ -0,0 +1,88 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/security"
+	infrawebhook "github.com/crypto-wallet/backend/internal/infrastructure/webhook"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// secretPrefix marks webhook signing secrets so they are recognisable in integrator configuration.
+const secretPrefix = "whsec_"
+
+// Deliverer posts signed payloads to webhook endpoints.
+type Deliverer interface {
+	Deliver(ctx context.Context, delivery infrawebhook.Delivery) (infrawebhook.DeliveryResult, error)
+}
+
+// loadOwnedEndpoint fetches an endpoint and hides endpoints belonging to other users.
+func loadOwnedEndpoint(ctx context.Context, endpoints repositories.WebhookEndpointRepository, userID, endpointID uuid.UUID) (entities.WebhookEndpoint, error) {
+	endpoint, err := endpoints.GetByID(ctx, endpointID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, endpointNotFoundError()
+		}
+		return nil, err
+	}
+	if endpoint.GetUserID() != userID {
+		return nil, endpointNotFoundError()
+	}
+	return endpoint, nil
+}
+
+func endpointNotFoundError() error {
+	return utils.NewAppError(
+		"WEBHOOK_ENDPOINT_NOT_FOUND",
+		"webhook endpoint not found",
+		fiber.StatusNotFound,
+		nil,
+		nil,
+	)
+}
+
+// generateSecret returns a new random signing secret.
+func generateSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return secretPrefix + hex.EncodeToString(buf), nil
+}
+
+// decryptSecret recovers an endpoint's signing secret; the endpoint ID is bound as additional data.
+func decryptSecret(encryptor *security.AESGCMEncryptor, endpoint entities.WebhookEndpoint) (string, error) {
+	plain, err := encryptor.DecryptString(endpoint.GetEncryptedSecret(), []byte(endpoint.GetID().String()))
+	if err != nil {
+		return "", utils.NewAppError(
+			"WEBHOOK_SECRET_UNAVAILABLE",
+			"failed to read webhook signing secret",
+			http.StatusInternalServerError,
+			err,
+			nil,
+		)
+	}
+	return string(plain), nil
+}
+
+func wrapValidationError(errs utils.ValidationErrors) error {
+	if errs.IsEmpty() {
+		return nil
+	}
+	return utils.NewAppError(
+		"VALIDATION_ERROR",
+		"webhook payload invalid",
+		fiber.StatusBadRequest,
+		errs,
+		map[string]any{"errors": errs},
+	)
+}
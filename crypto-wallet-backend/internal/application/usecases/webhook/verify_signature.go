@@ -0,0 +1,97 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/security"
+	infrawebhook "github.com/crypto-wallet/backend/internal/infrastructure/webhook"
+)
+
+// VerifySignatureUseCase checks an integrator's signature for a payload against the server's signer,
+// returning the expected signature so mismatches can be debugged.
+type VerifySignatureUseCase struct {
+	endpoints repositories.WebhookEndpointRepository
+	encryptor *security.AESGCMEncryptor
+	logger    *slog.Logger
+}
+
+// NewVerifySignatureUseCase constructs the use case.
+func NewVerifySignatureUseCase(endpoints repositories.WebhookEndpointRepository, encryptor *security.AESGCMEncryptor, logger *slog.Logger) *VerifySignatureUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &VerifySignatureUseCase{
+		endpoints: endpoints,
+		encryptor: encryptor,
+		logger:    logger,
+	}
+}
+
+// Execute compares the signature. The timestamp is not checked for freshness so saved
+// deliveries can be replayed; receivers must still enforce a tolerance window.
+func (uc *VerifySignatureUseCase) Execute(ctx context.Context, userID, endpointID uuid.UUID, req dto.WebhookVerifyRequest) (dto.WebhookVerifyResponse, error) {
+	if uc.encryptor == nil {
+		return dto.WebhookVerifyResponse{}, errors.New("verify webhook signature: encryptor not configured")
+	}
+	if errs := req.Validate(); !errs.IsEmpty() {
+		return dto.WebhookVerifyResponse{}, wrapValidationError(errs)
+	}
+
+	endpoint, err := loadOwnedEndpoint(ctx, uc.endpoints, userID, endpointID)
+	if err != nil {
+		return dto.WebhookVerifyResponse{}, err
+	}
+
+	secret, err := decryptSecret(uc.encryptor, endpoint)
+	if err != nil {
+		return dto.WebhookVerifyResponse{}, err
+	}
+	signer, err := infrawebhook.NewSigner(secret)
+	if err != nil {
+		return dto.WebhookVerifyResponse{}, err
+	}
+
+	timestamp := strings.TrimSpace(req.Timestamp)
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return dto.WebhookVerifyResponse{}, err
+	}
+
+	// A bare digest is assumed to use the current scheme.
+	signature := strings.TrimSpace(req.Signature)
+	version := infrawebhook.CurrentSignatureVersion
+	if prefix, _, ok := strings.Cut(signature, "="); ok {
+		version = prefix
+	} else {
+		signature = version + "=" + signature
+	}
+
+	response := dto.WebhookVerifyResponse{
+		SignatureVersion:  version,
+		SignedContent:     timestamp + "." + req.Payload,
+		SupportedVersions: infrawebhook.SupportedVersions(),
+	}
+
+	expected, err := signer.Sign(version, time.Unix(unix, 0), []byte(req.Payload))
+	if err != nil {
+		response.Reason = err.Error()
+		return response, nil
+	}
+	response.ExpectedSignature = expected
+
+	if err := signer.Verify(signature, timestamp, []byte(req.Payload), time.Time{}, 0); err != nil {
+		response.Reason = err.Error()
+		return response, nil
+	}
+	response.Valid = true
+	return response, nil
+}
@@ -0,0 +1,19 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultAccountDeletionRetention is how long a deleted account is kept before it is purged.
+const DefaultAccountDeletionRetention = 30 * 24 * time.Hour
+
+// AccountDeletion tracks a user's request to delete their account. The account is soft-deleted
+// when requested and purged, together with its KYC personal data, once PurgeAfter has passed.
+type AccountDeletion struct {
+	UserID      uuid.UUID  `json:"user_id"`
+	RequestedAt time.Time  `json:"requested_at"`
+	PurgeAfter  time.Time  `json:"purge_after"`
+	PurgedAt    *time.Time `json:"purged_at,omitempty"`
+}
@@ -0,0 +1,102 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// AccountingFormat identifies an accounting package whose journal import layout we can produce.
+type AccountingFormat string
+
+const (
+	AccountingFormatQuickBooks AccountingFormat = "quickbooks"
+	AccountingFormatXero       AccountingFormat = "xero"
+)
+
+// Default ledger account names used when a user has not configured a mapping.
+const (
+	DefaultClearingAccount = "Crypto Clearing"
+	DefaultFeeAccount      = "Network Fees"
+)
+
+var (
+	errAccountMappingChainInvalid    = errors.New("account mapping chain is invalid")
+	errAccountMappingAssetRequired   = errors.New("account mapping asset account is required")
+	errAccountMappingClearingMissing = errors.New("account mapping clearing account is required")
+	errAccountMappingFeeRequired     = errors.New("account mapping fee account is required")
+)
+
+// AccountMapping routes a chain's wallet activity to accounts in the user's chart of accounts.
+// An empty Chain marks the user's fallback mapping for chains without their own entry.
+type AccountMapping struct {
+	Chain           Chain     `json:"chain"`
+	AssetAccount    string    `json:"asset_account"`
+	ClearingAccount string    `json:"clearing_account"`
+	FeeAccount      string    `json:"fee_account"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// Validate performs basic validation on the AccountMapping.
+func (m AccountMapping) Validate() error {
+	var validationErr error
+
+	if m.Chain != "" && !isValidChain(m.Chain) {
+		validationErr = errors.Join(validationErr, errAccountMappingChainInvalid)
+	}
+
+	if strings.TrimSpace(m.AssetAccount) == "" {
+		validationErr = errors.Join(validationErr, errAccountMappingAssetRequired)
+	}
+
+	if strings.TrimSpace(m.ClearingAccount) == "" {
+		validationErr = errors.Join(validationErr, errAccountMappingClearingMissing)
+	}
+
+	if strings.TrimSpace(m.FeeAccount) == "" {
+		validationErr = errors.Join(validationErr, errAccountMappingFeeRequired)
+	}
+
+	return validationErr
+}
+
+// DefaultAccountMapping returns the mapping applied to a chain when the user has configured nothing.
+func DefaultAccountMapping(chain Chain) AccountMapping {
+	return AccountMapping{
+		Chain:           chain,
+		AssetAccount:    "Crypto Assets - " + string(chain),
+		ClearingAccount: DefaultClearingAccount,
+		FeeAccount:      DefaultFeeAccount,
+	}
+}
+
+// ResolveAccountMapping picks the chain-specific mapping, then the user's fallback, then the defaults.
+func ResolveAccountMapping(mappings []AccountMapping, chain Chain) AccountMapping {
+	var fallback *AccountMapping
+	for i := range mappings {
+		switch mappings[i].Chain {
+		case chain:
+			return mappings[i]
+		case "":
+			fallback = &mappings[i]
+		}
+	}
+
+	if fallback != nil {
+		resolved := *fallback
+		resolved.Chain = chain
+		return resolved
+	}
+
+	return DefaultAccountMapping(chain)
+}
+
+// IsValidAccountingFormat reports whether the format is a supported accounting export layout.
+func IsValidAccountingFormat(format AccountingFormat) bool {
+	switch format {
+	case AccountingFormatQuickBooks, AccountingFormatXero:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,40 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ActivityKind distinguishes the records merged into a user's activity feed.
+type ActivityKind string
+
+const (
+	ActivityKindTransaction ActivityKind = "transaction"
+	ActivityKindExchange    ActivityKind = "exchange"
+)
+
+// IsValid reports whether the kind is known.
+func (k ActivityKind) IsValid() bool {
+	return k == ActivityKindTransaction || k == ActivityKindExchange
+}
+
+// ActivityItem is one entry of the merged activity feed: a transaction or an exchange operation.
+// Transactions fill the single-wallet fields (Type, TxHash, Fee); exchanges also fill the
+// counter-wallet fields (ToWalletID, ToChain, ToAmount) and report their fee in Fee.
+type ActivityItem struct {
+	Kind       ActivityKind
+	ID         uuid.UUID
+	OccurredAt time.Time
+	Status     string
+	Type       string
+	WalletID   uuid.UUID
+	Chain      Chain
+	Amount     decimal.Decimal
+	Fee        decimal.Decimal
+	TxHash     string
+	ToWalletID *uuid.UUID
+	ToChain    Chain
+	ToAmount   *decimal.Decimal
+}
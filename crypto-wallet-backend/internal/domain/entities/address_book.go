@@ -0,0 +1,228 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// MaxAddressBookEntriesPerUser bounds how many saved recipients one user may keep.
+const MaxAddressBookEntriesPerUser = 200
+
+// DefaultAddressBookCooldown is how long a newly saved address waits before whitelist-only sends
+// may use it, and how long switching whitelist-only sends off takes to apply.
+const DefaultAddressBookCooldown = 24 * time.Hour
+
+var (
+	errAddressBookUserIDRequired  = errors.New("address book entry user ID is required")
+	errAddressBookChainInvalid    = errors.New("address book entry chain is not supported")
+	errAddressBookAddressInvalid  = errors.New("address book entry address is invalid")
+	errAddressBookLabelRequired   = errors.New("address book entry label is required")
+	errAddressBookUsableAtInvalid = errors.New("address book entry usable time must not precede creation")
+)
+
+// AddressBookEntry exposes the behavior required by the application layer when working with saved recipients.
+type AddressBookEntry interface {
+	Entity
+	Identifiable
+	Timestamped
+
+	GetUserID() uuid.UUID
+	GetChain() Chain
+	GetAddress() string
+	GetLabel() string
+	GetMemo() string
+	// GetUsableAt returns when the cooldown for a newly added address ends.
+	GetUsableAt() time.Time
+	IsUsable(now time.Time) bool
+}
+
+// AddressBookEntryEntity is the default implementation of the AddressBookEntry interface.
+type AddressBookEntryEntity struct {
+	id        uuid.UUID
+	userID    uuid.UUID
+	chain     Chain
+	address   string
+	label     string
+	memo      string
+	usableAt  time.Time
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// AddressBookEntryParams captures the fields required to construct an AddressBookEntryEntity.
+type AddressBookEntryParams struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Chain     Chain
+	Address   string
+	Label     string
+	Memo      string
+	UsableAt  time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewAddressBookEntryEntity validates the supplied parameters and returns a new AddressBookEntryEntity.
+// Without an explicit UsableAt the entry is usable immediately.
+func NewAddressBookEntryEntity(params AddressBookEntryParams) (*AddressBookEntryEntity, error) {
+	if params.ID == uuid.Nil {
+		params.ID = uuid.New()
+	}
+
+	if params.CreatedAt.IsZero() {
+		params.CreatedAt = time.Now().UTC()
+	}
+
+	if params.UpdatedAt.IsZero() {
+		params.UpdatedAt = params.CreatedAt
+	}
+
+	if params.UsableAt.IsZero() {
+		params.UsableAt = params.CreatedAt
+	}
+
+	entity := HydrateAddressBookEntryEntity(params)
+	if err := entity.Validate(); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// HydrateAddressBookEntryEntity creates an AddressBookEntryEntity without re-validating invariants (used for repository hydration).
+func HydrateAddressBookEntryEntity(params AddressBookEntryParams) *AddressBookEntryEntity {
+	return &AddressBookEntryEntity{
+		id:        params.ID,
+		userID:    params.UserID,
+		chain:     NormalizeChain(string(params.Chain)),
+		address:   strings.TrimSpace(params.Address),
+		label:     strings.TrimSpace(params.Label),
+		memo:      strings.TrimSpace(params.Memo),
+		usableAt:  params.UsableAt,
+		createdAt: params.CreatedAt,
+		updatedAt: params.UpdatedAt,
+	}
+}
+
+// Validate ensures the entity adheres to domain invariants.
+func (a *AddressBookEntryEntity) Validate() error {
+	var validationErr error
+
+	if a.userID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errAddressBookUserIDRequired)
+	}
+
+	if !IsSupportedChain(a.chain) {
+		validationErr = errors.Join(validationErr, errAddressBookChainInvalid)
+	}
+
+	if !IsPlausibleAddress(a.address) {
+		validationErr = errors.Join(validationErr, errAddressBookAddressInvalid)
+	}
+
+	if a.label == "" {
+		validationErr = errors.Join(validationErr, errAddressBookLabelRequired)
+	}
+
+	if a.usableAt.Before(a.createdAt) {
+		validationErr = errors.Join(validationErr, errAddressBookUsableAtInvalid)
+	}
+
+	return validationErr
+}
+
+// Rename replaces the label and memo; the address and chain of a saved entry never change, so a
+// different address always goes through a fresh cooldown.
+func (a *AddressBookEntryEntity) Rename(label, memo string, at time.Time) error {
+	label = strings.TrimSpace(label)
+	if label == "" {
+		return errAddressBookLabelRequired
+	}
+	a.label = label
+	a.memo = strings.TrimSpace(memo)
+	a.updatedAt = at
+	return nil
+}
+
+// Getter implementations satisfy the AddressBookEntry interface.
+
+func (a *AddressBookEntryEntity) GetID() uuid.UUID {
+	return a.id
+}
+
+func (a *AddressBookEntryEntity) GetUserID() uuid.UUID {
+	return a.userID
+}
+
+func (a *AddressBookEntryEntity) GetChain() Chain {
+	return a.chain
+}
+
+func (a *AddressBookEntryEntity) GetAddress() string {
+	return a.address
+}
+
+func (a *AddressBookEntryEntity) GetLabel() string {
+	return a.label
+}
+
+func (a *AddressBookEntryEntity) GetMemo() string {
+	return a.memo
+}
+
+func (a *AddressBookEntryEntity) GetUsableAt() time.Time {
+	return a.usableAt
+}
+
+func (a *AddressBookEntryEntity) IsUsable(now time.Time) bool {
+	return !now.Before(a.usableAt)
+}
+
+func (a *AddressBookEntryEntity) GetCreatedAt() time.Time {
+	return a.createdAt
+}
+
+func (a *AddressBookEntryEntity) GetUpdatedAt() time.Time {
+	return a.updatedAt
+}
+
+// AddressBookKey returns the form of an address used to match it against saved entries. EVM
+// addresses are case-insensitive (the mixed case is only a checksum); other chains compare exactly.
+func AddressBookKey(chain Chain, address string) string {
+	address = strings.TrimSpace(address)
+	if IsEVMChain(chain) {
+		return strings.ToLower(address)
+	}
+	return address
+}
+
+// IsPlausibleAddress reports whether address could be a chain address: non-empty, at most 128
+// characters and free of whitespace. Chain-specific checks belong to the blockchain adapters.
+func IsPlausibleAddress(address string) bool {
+	if address == "" || len(address) > 128 {
+		return false
+	}
+	return strings.IndexFunc(address, unicode.IsSpace) < 0
+}
+
+// AddressBookSettings holds a user's recipient restrictions. Turning whitelist-only sends on applies
+// immediately; turning them off is scheduled for WhitelistOffAt so a hijacked session cannot lift
+// the restriction and withdraw at once.
+type AddressBookSettings struct {
+	UserID         uuid.UUID
+	WhitelistOnly  bool
+	WhitelistOffAt *time.Time
+	UpdatedAt      time.Time
+}
+
+// WhitelistEnforced reports whether sends are restricted to saved addresses at the given time.
+func (s AddressBookSettings) WhitelistEnforced(now time.Time) bool {
+	if !s.WhitelistOnly {
+		return false
+	}
+	return s.WhitelistOffAt == nil || now.Before(*s.WhitelistOffAt)
+}
@@ -0,0 +1,96 @@
+package entities
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OAuthScope names a slice of a user's account that a third-party app may access.
+type OAuthScope string
+
+const (
+	// OAuthScopeReadWallets allows reading wallets, balances and portfolio analytics.
+	OAuthScopeReadWallets OAuthScope = "read:wallets"
+	// OAuthScopeReadTransactions allows reading transaction history and status.
+	OAuthScopeReadTransactions OAuthScope = "read:transactions"
+	// OAuthScopeTrade allows sending transactions and executing exchanges.
+	OAuthScopeTrade OAuthScope = "trade"
+)
+
+var (
+	errAppConsentUserRequired   = errors.New("app consent: user id is required")
+	errAppConsentClientRequired = errors.New("app consent: client id is required")
+	errAppConsentScopesRequired = errors.New("app consent: at least one scope is required")
+	errAppConsentScopeInvalid   = errors.New("app consent: scope is invalid")
+)
+
+// ParseOAuthScope normalises a scope name, returning "" when it is unknown.
+func ParseOAuthScope(value string) OAuthScope {
+	switch scope := OAuthScope(strings.ToLower(strings.TrimSpace(value))); scope {
+	case OAuthScopeReadWallets, OAuthScopeReadTransactions, OAuthScopeTrade:
+		return scope
+	default:
+		return ""
+	}
+}
+
+// OAuthClient is a registered third-party app and the scopes it may ask users for.
+type OAuthClient struct {
+	ID            string       `json:"client_id"`
+	Name          string       `json:"name"`
+	AllowedScopes []OAuthScope `json:"allowed_scopes"`
+	IsActive      bool         `json:"is_active"`
+	CreatedAt     time.Time    `json:"created_at"`
+}
+
+// Allows reports whether users may grant the scope to the client.
+func (c OAuthClient) Allows(scope OAuthScope) bool {
+	return slices.Contains(c.AllowedScopes, scope)
+}
+
+// AppConsent records a user's grant of scopes to a third-party app. Tokens issued for the consent
+// stop working once it is revoked.
+type AppConsent struct {
+	ID         uuid.UUID    `json:"id"`
+	UserID     uuid.UUID    `json:"user_id"`
+	ClientID   string       `json:"client_id"`
+	ClientName string       `json:"client_name"`
+	Scopes     []OAuthScope `json:"scopes"`
+	CreatedAt  time.Time    `json:"created_at"`
+	RevokedAt  *time.Time   `json:"revoked_at,omitempty"`
+}
+
+// Validate performs basic validation on the AppConsent.
+func (a AppConsent) Validate() error {
+	var validationErr error
+
+	if a.UserID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errAppConsentUserRequired)
+	}
+
+	if strings.TrimSpace(a.ClientID) == "" {
+		validationErr = errors.Join(validationErr, errAppConsentClientRequired)
+	}
+
+	if len(a.Scopes) == 0 {
+		validationErr = errors.Join(validationErr, errAppConsentScopesRequired)
+	}
+
+	for _, scope := range a.Scopes {
+		if ParseOAuthScope(string(scope)) == "" {
+			validationErr = errors.Join(validationErr, errAppConsentScopeInvalid)
+			break
+		}
+	}
+
+	return validationErr
+}
+
+// IsActive reports whether the consent has not been revoked.
+func (a AppConsent) IsActive() bool {
+	return a.RevokedAt == nil
+}
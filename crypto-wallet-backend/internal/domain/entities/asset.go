@@ -0,0 +1,111 @@
+package entities
+
+import (
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Native asset identifiers are fixed so databases that cannot join the asset registry (rates)
+// can still reference native assets. They match the seed rows in the assets migrations.
+var nativeAssetIDs = map[Chain]uuid.UUID{
+	ChainBTC: uuid.MustParse("00000000-0000-4000-8000-000000000001"),
+	ChainETH: uuid.MustParse("00000000-0000-4000-8000-000000000002"),
+	ChainSOL: uuid.MustParse("00000000-0000-4000-8000-000000000003"),
+	ChainXLM: uuid.MustParse("00000000-0000-4000-8000-000000000004"),
+}
+
+// NativeAssetID returns the registry identifier of a chain's native asset, or uuid.Nil for an
+// unsupported chain.
+func NativeAssetID(chain Chain) uuid.UUID {
+	return nativeAssetIDs[NormalizeChain(string(chain))]
+}
+
+// Asset is an entry in the asset registry: something a wallet can hold and a trading pair can
+// quote. Several assets can live on one chain, so the chain never doubles as a trading symbol.
+type Asset struct {
+	ID              uuid.UUID `json:"id"`
+	Symbol          string    `json:"symbol"`
+	Name            string    `json:"name"`
+	Chain           Chain     `json:"chain"`
+	ContractAddress string    `json:"contract_address,omitempty"`
+	Decimals        int       `json:"decimals"`
+	IsNative        bool      `json:"is_native"`
+	CoingeckoID     string    `json:"coingecko_id,omitempty"`
+	IsActive        bool      `json:"is_active"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// TradingSymbol is the symbol used to look up trading pairs and rates for the asset.
+func (a Asset) TradingSymbol() string {
+	return strings.ToUpper(strings.TrimSpace(a.Symbol))
+}
+
+// assetListing is the set of registry assets loaded by ListAssets, indexed for the lookups that
+// cannot reach the registry themselves.
+type assetListing struct {
+	byID        map[uuid.UUID]Asset
+	symbols     []string
+	coingeckoID map[string]string
+}
+
+var listedAssets atomic.Pointer[assetListing]
+
+// ListAssets publishes the active registry assets so symbol checks, rate syncing and portfolio
+// valuation accept them alongside the native assets. It replaces any earlier listing and is safe
+// to call while the listing is being read.
+func ListAssets(assets []Asset) {
+	listing := &assetListing{
+		byID:        make(map[uuid.UUID]Asset, len(assets)),
+		coingeckoID: make(map[string]string, len(assets)),
+	}
+	for _, asset := range assets {
+		symbol := asset.TradingSymbol()
+		if !asset.IsActive || symbol == "" {
+			continue
+		}
+		listing.byID[asset.ID] = asset
+		if !slices.Contains(listing.symbols, symbol) {
+			listing.symbols = append(listing.symbols, symbol)
+		}
+		if id := strings.TrimSpace(asset.CoingeckoID); id != "" {
+			listing.coingeckoID[symbol] = id
+		}
+	}
+	slices.Sort(listing.symbols)
+	listedAssets.Store(listing)
+}
+
+// ListedAsset returns the listed asset with the identifier.
+func ListedAsset(id uuid.UUID) (Asset, bool) {
+	listing := listedAssets.Load()
+	if listing == nil {
+		return Asset{}, false
+	}
+	asset, ok := listing.byID[id]
+	return asset, ok
+}
+
+// ListedCoingeckoIDs maps the trading symbols of listed assets to their CoinGecko coin IDs.
+func ListedCoingeckoIDs() map[string]string {
+	ids := make(map[string]string)
+	if listing := listedAssets.Load(); listing != nil {
+		for symbol, id := range listing.coingeckoID {
+			ids[symbol] = id
+		}
+	}
+	return ids
+}
+
+// WalletAssetSymbol is the trading symbol of the asset a wallet holds. Wallets of assets that are
+// not listed fall back to their chain's native symbol.
+func WalletAssetSymbol(wallet Wallet) string {
+	if asset, ok := ListedAsset(wallet.GetAssetID()); ok {
+		return asset.TradingSymbol()
+	}
+	return strings.ToUpper(string(wallet.GetChain()))
+}
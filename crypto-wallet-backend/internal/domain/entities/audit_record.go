@@ -0,0 +1,151 @@
+package entities
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditGenesisHash anchors the hash chain before the first chained audit record.
+const AuditGenesisHash = "0000000000000000000000000000000000000000000000000000000000000000"
+
+// DefaultAuditRetention is how long audit records stay in the audit database before archival.
+const DefaultAuditRetention = 365 * 24 * time.Hour
+
+// AuditRecord is a persisted audit log entry. Each record stores the hash of its predecessor,
+// so altering or removing a record breaks every hash that follows it.
+type AuditRecord struct {
+	ID           uuid.UUID      `json:"id"`
+	Sequence     int64          `json:"sequence"`
+	UserID       *uuid.UUID     `json:"user_id,omitempty"`
+	Action       string         `json:"action"`
+	ResourceType string         `json:"resource_type,omitempty"`
+	ResourceID   *uuid.UUID     `json:"resource_id,omitempty"`
+	Details      map[string]any `json:"details,omitempty"`
+	IPAddress    string         `json:"ip_address,omitempty"`
+	UserAgent    string         `json:"user_agent,omitempty"`
+	SessionID    string         `json:"session_id,omitempty"`
+	Result       string         `json:"result"`
+	ErrorMessage string         `json:"error_message,omitempty"`
+	Timestamp    time.Time      `json:"timestamp"`
+	PrevHash     string         `json:"prev_hash,omitempty"`
+	Hash         string         `json:"hash,omitempty"`
+}
+
+// auditHashPayload fixes the fields and their order covered by the record hash.
+type auditHashPayload struct {
+	PrevHash     string          `json:"prev_hash"`
+	ID           string          `json:"id"`
+	UserID       string          `json:"user_id"`
+	Action       string          `json:"action"`
+	ResourceType string          `json:"resource_type"`
+	ResourceID   string          `json:"resource_id"`
+	Details      json.RawMessage `json:"details"`
+	IPAddress    string          `json:"ip_address"`
+	UserAgent    string          `json:"user_agent"`
+	SessionID    string          `json:"session_id"`
+	Result       string          `json:"result"`
+	ErrorMessage string          `json:"error_message"`
+	Timestamp    string          `json:"timestamp"`
+}
+
+// ComputeHash returns the chain hash of the record given its predecessor's hash.
+// Timestamps are truncated to the database's microsecond precision and details are
+// canonicalised so the hash survives a round trip through storage.
+func (r AuditRecord) ComputeHash(prevHash string) (string, error) {
+	details, err := canonicalAuditDetails(r.Details)
+	if err != nil {
+		return "", err
+	}
+
+	payload := auditHashPayload{
+		PrevHash:     prevHash,
+		ID:           r.ID.String(),
+		Action:       r.Action,
+		ResourceType: r.ResourceType,
+		Details:      details,
+		IPAddress:    r.IPAddress,
+		UserAgent:    r.UserAgent,
+		SessionID:    r.SessionID,
+		Result:       r.Result,
+		ErrorMessage: r.ErrorMessage,
+		Timestamp:    r.Timestamp.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano),
+	}
+	if r.UserID != nil {
+		payload.UserID = r.UserID.String()
+	}
+	if r.ResourceID != nil {
+		payload.ResourceID = r.ResourceID.String()
+	}
+
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Chain links the record to its predecessor and sets its hash.
+func (r *AuditRecord) Chain(prevHash string) error {
+	if strings.TrimSpace(prevHash) == "" {
+		prevHash = AuditGenesisHash
+	}
+	r.Timestamp = r.Timestamp.UTC().Truncate(time.Microsecond)
+
+	hash, err := r.ComputeHash(prevHash)
+	if err != nil {
+		return err
+	}
+	r.PrevHash = prevHash
+	r.Hash = hash
+	return nil
+}
+
+// VerifyChain reports whether the record links to prevHash and its stored hash matches its contents.
+func (r AuditRecord) VerifyChain(prevHash string) bool {
+	if r.PrevHash != prevHash {
+		return false
+	}
+	hash, err := r.ComputeHash(prevHash)
+	return err == nil && hash == r.Hash
+}
+
+// AuditArchive describes a batch of audit records moved to cold storage.
+// LastHash carries the chain forward so the records left in the database remain verifiable.
+type AuditArchive struct {
+	ID            uuid.UUID `json:"id"`
+	FromSequence  int64     `json:"from_sequence"`
+	ToSequence    int64     `json:"to_sequence"`
+	FromTimestamp time.Time `json:"from_timestamp"`
+	ToTimestamp   time.Time `json:"to_timestamp"`
+	RecordCount   int       `json:"record_count"`
+	Location      string    `json:"location"`
+	Checksum      string    `json:"checksum"`
+	LastHash      string    `json:"last_hash"`
+	ArchivedAt    time.Time `json:"archived_at"`
+}
+
+// canonicalAuditDetails encodes details in the form they take after a JSON round trip.
+func canonicalAuditDetails(details map[string]any) (json.RawMessage, error) {
+	if len(details) == 0 {
+		return json.RawMessage("{}"), nil
+	}
+
+	encoded, err := json.Marshal(details)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(decoded)
+}
@@ -0,0 +1,146 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BalanceReconciliationMode selects how many wallets of a chain a reconciliation run checks.
+type BalanceReconciliationMode string
+
+const (
+	// BalanceReconciliationModeSample checks a random subset of the chain's wallets.
+	BalanceReconciliationModeSample BalanceReconciliationMode = "sample"
+	// BalanceReconciliationModeFull checks every wallet of the chain.
+	BalanceReconciliationModeFull BalanceReconciliationMode = "full"
+)
+
+// DefaultBalanceReconciliationSampleSize is how many wallets per chain a sampling run checks.
+const DefaultBalanceReconciliationSampleSize = 500
+
+// IsValid reports whether the mode is recognised.
+func (m BalanceReconciliationMode) IsValid() bool {
+	return m == BalanceReconciliationModeSample || m == BalanceReconciliationModeFull
+}
+
+// BalanceReconciliationRun summarises one pass over the wallets of a chain.
+type BalanceReconciliationRun struct {
+	ID         uuid.UUID                 `json:"id"`
+	Chain      Chain                     `json:"chain"`
+	Mode       BalanceReconciliationMode `json:"mode"`
+	Scanned    int                       `json:"scanned"`
+	Matched    int                       `json:"matched"`
+	Corrected  int                       `json:"corrected"`
+	Escalated  int                       `json:"escalated"`
+	Failed     int                       `json:"failed"`
+	StartedAt  time.Time                 `json:"started_at"`
+	FinishedAt *time.Time                `json:"finished_at,omitempty"`
+}
+
+// BalanceDiscrepancyStatus tracks a discrepancy from detection to resolution.
+type BalanceDiscrepancyStatus string
+
+const (
+	// BalanceDiscrepancyStatusCorrected marks drift within the threshold that was fixed automatically.
+	BalanceDiscrepancyStatusCorrected BalanceDiscrepancyStatus = "corrected"
+	// BalanceDiscrepancyStatusOpen marks drift above the threshold awaiting manual review.
+	BalanceDiscrepancyStatusOpen BalanceDiscrepancyStatus = "open"
+	// BalanceDiscrepancyStatusResolved marks a reviewed discrepancy.
+	BalanceDiscrepancyStatusResolved BalanceDiscrepancyStatus = "resolved"
+)
+
+// IsValid reports whether the status is recognised.
+func (s BalanceDiscrepancyStatus) IsValid() bool {
+	switch s {
+	case BalanceDiscrepancyStatusCorrected, BalanceDiscrepancyStatusOpen, BalanceDiscrepancyStatusResolved:
+		return true
+	default:
+		return false
+	}
+}
+
+var (
+	errBalanceDiscrepancyWalletRequired = errors.New("balance discrepancy wallet ID is required")
+	errBalanceDiscrepancyChainInvalid   = errors.New("balance discrepancy chain is not supported")
+	errBalanceDiscrepancyStatusInvalid  = errors.New("balance discrepancy status is invalid")
+	errBalanceDiscrepancyNoDifference   = errors.New("balance discrepancy requires differing balances")
+)
+
+// BalanceDiscrepancy records a stored wallet balance that disagreed with the chain. Difference is
+// on-chain minus stored, so a positive value means the stored balance was too low; Magnitude is its
+// absolute value in the chain's native unit.
+type BalanceDiscrepancy struct {
+	ID             uuid.UUID                `json:"id"`
+	RunID          uuid.UUID                `json:"run_id"`
+	WalletID       uuid.UUID                `json:"wallet_id"`
+	UserID         uuid.UUID                `json:"user_id"`
+	Chain          Chain                    `json:"chain"`
+	Address        string                   `json:"address"`
+	StoredBalance  decimal.Decimal          `json:"stored_balance"`
+	OnChainBalance decimal.Decimal          `json:"on_chain_balance"`
+	Difference     decimal.Decimal          `json:"difference"`
+	Magnitude      decimal.Decimal          `json:"magnitude"`
+	Status         BalanceDiscrepancyStatus `json:"status"`
+	ResolvedBy     *uuid.UUID               `json:"resolved_by,omitempty"`
+	Resolution     string                   `json:"resolution,omitempty"`
+	DetectedAt     time.Time                `json:"detected_at"`
+	ResolvedAt     *time.Time               `json:"resolved_at,omitempty"`
+}
+
+// NewBalanceDiscrepancy derives the difference and magnitude of a mismatch found in a run.
+func NewBalanceDiscrepancy(runID uuid.UUID, wallet Wallet, onChain decimal.Decimal, status BalanceDiscrepancyStatus, at time.Time) (*BalanceDiscrepancy, error) {
+	if wallet == nil {
+		return nil, errBalanceDiscrepancyWalletRequired
+	}
+
+	difference := onChain.Sub(wallet.GetBalance())
+	discrepancy := &BalanceDiscrepancy{
+		ID:             uuid.New(),
+		RunID:          runID,
+		WalletID:       wallet.GetID(),
+		UserID:         wallet.GetUserID(),
+		Chain:          wallet.GetChain(),
+		Address:        wallet.GetAddress(),
+		StoredBalance:  wallet.GetBalance(),
+		OnChainBalance: onChain,
+		Difference:     difference,
+		Magnitude:      difference.Abs(),
+		Status:         status,
+		DetectedAt:     at,
+	}
+	if status == BalanceDiscrepancyStatusCorrected {
+		discrepancy.ResolvedAt = &at
+		discrepancy.Resolution = "corrected automatically within threshold"
+	}
+
+	if err := discrepancy.Validate(); err != nil {
+		return nil, err
+	}
+	return discrepancy, nil
+}
+
+// Validate ensures the discrepancy adheres to domain invariants.
+func (d BalanceDiscrepancy) Validate() error {
+	var validationErr error
+
+	if d.WalletID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errBalanceDiscrepancyWalletRequired)
+	}
+
+	if !IsSupportedChain(d.Chain) {
+		validationErr = errors.Join(validationErr, errBalanceDiscrepancyChainInvalid)
+	}
+
+	if !d.Status.IsValid() {
+		validationErr = errors.Join(validationErr, errBalanceDiscrepancyStatusInvalid)
+	}
+
+	if d.Magnitude.IsZero() {
+		validationErr = errors.Join(validationErr, errBalanceDiscrepancyNoDifference)
+	}
+
+	return validationErr
+}
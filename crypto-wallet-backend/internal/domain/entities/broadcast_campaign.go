@@ -0,0 +1,370 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BroadcastCampaignStatus enumerates the lifecycle states of a broadcast campaign.
+type BroadcastCampaignStatus string
+
+const (
+	// BroadcastStatusScheduled campaigns wait for their send time; recipients are not resolved yet.
+	BroadcastStatusScheduled BroadcastCampaignStatus = "scheduled"
+	// BroadcastStatusSending campaigns have a fixed recipient list that is being delivered.
+	BroadcastStatusSending   BroadcastCampaignStatus = "sending"
+	BroadcastStatusCompleted BroadcastCampaignStatus = "completed"
+	BroadcastStatusCancelled BroadcastCampaignStatus = "cancelled"
+)
+
+// BroadcastDeliveryStatus enumerates the states of one recipient's delivery.
+type BroadcastDeliveryStatus string
+
+const (
+	BroadcastDeliveryPending   BroadcastDeliveryStatus = "pending"
+	BroadcastDeliveryDelivered BroadcastDeliveryStatus = "delivered"
+	BroadcastDeliveryFailed    BroadcastDeliveryStatus = "failed"
+)
+
+// DefaultBroadcastThrottle is the delivery rate used when a campaign does not set one.
+const DefaultBroadcastThrottle = 600
+
+var (
+	errBroadcastNameRequired     = errors.New("broadcast campaign name is required")
+	errBroadcastTitleRequired    = errors.New("broadcast campaign title is required")
+	errBroadcastBodyRequired     = errors.New("broadcast campaign body is required")
+	errBroadcastStatusInvalid    = errors.New("broadcast campaign status is invalid")
+	errBroadcastScheduleRequired = errors.New("broadcast campaign send time is required")
+	errBroadcastThrottleInvalid  = errors.New("broadcast campaign throttle must be positive")
+	errBroadcastCreatorRequired  = errors.New("broadcast campaign creator is required")
+	errBroadcastNotCancellable   = errors.New("broadcast campaign can no longer be cancelled")
+
+	errBroadcastSegmentStatusInvalid   = errors.New("broadcast segment user status is invalid")
+	errBroadcastSegmentKYCInvalid      = errors.New("broadcast segment kyc status is invalid")
+	errBroadcastSegmentChainInvalid    = errors.New("broadcast segment chain is invalid")
+	errBroadcastSegmentCreatedRange    = errors.New("broadcast segment created range is empty")
+	errBroadcastSegmentLastLoginRange  = errors.New("broadcast segment last login range is empty")
+	errBroadcastSegmentCurrencyInvalid = errors.New("broadcast segment preferred currency is invalid")
+)
+
+// BroadcastSegment selects users by their attributes. Every set field narrows the segment; list
+// fields match any of their values. The zero segment selects every active user.
+type BroadcastSegment struct {
+	Statuses            []UserStatus   `json:"statuses,omitempty"`
+	KYCStatuses         []KYCStatus    `json:"kycStatuses,omitempty"`
+	Chains              []Chain        `json:"chains,omitempty"`
+	PreferredCurrencies []CurrencyCode `json:"preferredCurrencies,omitempty"`
+	EmailVerified       *bool          `json:"emailVerified,omitempty"`
+	TwoFactorEnabled    *bool          `json:"twoFactorEnabled,omitempty"`
+	CreatedAfter        *time.Time     `json:"createdAfter,omitempty"`
+	CreatedBefore       *time.Time     `json:"createdBefore,omitempty"`
+	LastLoginAfter      *time.Time     `json:"lastLoginAfter,omitempty"`
+	LastLoginBefore     *time.Time     `json:"lastLoginBefore,omitempty"`
+}
+
+// EffectiveStatuses returns the user statuses the segment matches; deleted and suspended users
+// are only reached when asked for explicitly.
+func (s BroadcastSegment) EffectiveStatuses() []UserStatus {
+	if len(s.Statuses) == 0 {
+		return []UserStatus{UserStatusActive}
+	}
+	return s.Statuses
+}
+
+// Validate ensures the segment only references known attribute values.
+func (s BroadcastSegment) Validate() error {
+	var validationErr error
+
+	for _, status := range s.Statuses {
+		switch status {
+		case UserStatusActive, UserStatusSuspended, UserStatusDeleted:
+		default:
+			validationErr = errors.Join(validationErr, errBroadcastSegmentStatusInvalid)
+		}
+	}
+
+	for _, status := range s.KYCStatuses {
+		switch status {
+		case KYCStatusPending, KYCStatusUnderReview, KYCStatusApproved, KYCStatusRejected, KYCStatusExpired:
+		default:
+			validationErr = errors.Join(validationErr, errBroadcastSegmentKYCInvalid)
+		}
+	}
+
+	for _, chain := range s.Chains {
+		if !IsSupportedChain(chain) {
+			validationErr = errors.Join(validationErr, errBroadcastSegmentChainInvalid)
+		}
+	}
+
+	for _, currency := range s.PreferredCurrencies {
+		if !isValidCurrencyCode(currency) {
+			validationErr = errors.Join(validationErr, errBroadcastSegmentCurrencyInvalid)
+		}
+	}
+
+	if s.CreatedAfter != nil && s.CreatedBefore != nil && !s.CreatedAfter.Before(*s.CreatedBefore) {
+		validationErr = errors.Join(validationErr, errBroadcastSegmentCreatedRange)
+	}
+
+	if s.LastLoginAfter != nil && s.LastLoginBefore != nil && !s.LastLoginAfter.Before(*s.LastLoginBefore) {
+		validationErr = errors.Join(validationErr, errBroadcastSegmentLastLoginRange)
+	}
+
+	return validationErr
+}
+
+// BroadcastStats summarises a campaign's deliveries.
+type BroadcastStats struct {
+	Recipients int64
+	Pending    int64
+	Delivered  int64
+	Failed     int64
+	Opened     int64
+}
+
+// BroadcastCampaign exposes the behavior required by the application layer when working with broadcast campaigns.
+type BroadcastCampaign interface {
+	Entity
+	Identifiable
+	Timestamped
+
+	GetName() string
+	GetTitle() string
+	GetBody() string
+	GetSegment() BroadcastSegment
+	GetStatus() BroadcastCampaignStatus
+	GetScheduledAt() time.Time
+	// GetThrottlePerMinute is the most deliveries the campaign may send per minute.
+	GetThrottlePerMinute() int
+	GetRecipientCount() int64
+	GetCreatedBy() uuid.UUID
+	GetStartedAt() *time.Time
+	GetCompletedAt() *time.Time
+	GetCancelledAt() *time.Time
+}
+
+// BroadcastCampaignEntity is the default implementation of the BroadcastCampaign interface.
+type BroadcastCampaignEntity struct {
+	id                uuid.UUID
+	name              string
+	title             string
+	body              string
+	segment           BroadcastSegment
+	status            BroadcastCampaignStatus
+	scheduledAt       time.Time
+	throttlePerMinute int
+	recipientCount    int64
+	createdBy         uuid.UUID
+	startedAt         *time.Time
+	completedAt       *time.Time
+	cancelledAt       *time.Time
+	createdAt         time.Time
+	updatedAt         time.Time
+}
+
+// BroadcastCampaignParams captures the fields required to construct a BroadcastCampaignEntity.
+type BroadcastCampaignParams struct {
+	ID                uuid.UUID
+	Name              string
+	Title             string
+	Body              string
+	Segment           BroadcastSegment
+	Status            BroadcastCampaignStatus
+	ScheduledAt       time.Time
+	ThrottlePerMinute int
+	RecipientCount    int64
+	CreatedBy         uuid.UUID
+	StartedAt         *time.Time
+	CompletedAt       *time.Time
+	CancelledAt       *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// NewBroadcastCampaignEntity validates the supplied parameters and returns a new scheduled campaign.
+// Campaigns without a send time are scheduled for their creation time.
+func NewBroadcastCampaignEntity(params BroadcastCampaignParams) (*BroadcastCampaignEntity, error) {
+	if params.ID == uuid.Nil {
+		params.ID = uuid.New()
+	}
+
+	if params.Status == "" {
+		params.Status = BroadcastStatusScheduled
+	}
+
+	if params.CreatedAt.IsZero() {
+		params.CreatedAt = time.Now().UTC()
+	}
+
+	if params.UpdatedAt.IsZero() {
+		params.UpdatedAt = params.CreatedAt
+	}
+
+	if params.ScheduledAt.IsZero() {
+		params.ScheduledAt = params.CreatedAt
+	}
+
+	if params.ThrottlePerMinute == 0 {
+		params.ThrottlePerMinute = DefaultBroadcastThrottle
+	}
+
+	entity := HydrateBroadcastCampaignEntity(params)
+	if err := entity.Validate(); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// HydrateBroadcastCampaignEntity creates a BroadcastCampaignEntity without re-validating invariants (used for repository hydration).
+func HydrateBroadcastCampaignEntity(params BroadcastCampaignParams) *BroadcastCampaignEntity {
+	return &BroadcastCampaignEntity{
+		id:                params.ID,
+		name:              strings.TrimSpace(params.Name),
+		title:             strings.TrimSpace(params.Title),
+		body:              strings.TrimSpace(params.Body),
+		segment:           params.Segment,
+		status:            params.Status,
+		scheduledAt:       params.ScheduledAt,
+		throttlePerMinute: params.ThrottlePerMinute,
+		recipientCount:    params.RecipientCount,
+		createdBy:         params.CreatedBy,
+		startedAt:         params.StartedAt,
+		completedAt:       params.CompletedAt,
+		cancelledAt:       params.CancelledAt,
+		createdAt:         params.CreatedAt,
+		updatedAt:         params.UpdatedAt,
+	}
+}
+
+// Validate ensures the entity adheres to domain invariants.
+func (b *BroadcastCampaignEntity) Validate() error {
+	var validationErr error
+
+	if b.name == "" {
+		validationErr = errors.Join(validationErr, errBroadcastNameRequired)
+	}
+
+	if b.title == "" {
+		validationErr = errors.Join(validationErr, errBroadcastTitleRequired)
+	}
+
+	if b.body == "" {
+		validationErr = errors.Join(validationErr, errBroadcastBodyRequired)
+	}
+
+	if !isValidBroadcastStatus(b.status) {
+		validationErr = errors.Join(validationErr, errBroadcastStatusInvalid)
+	}
+
+	if b.scheduledAt.IsZero() {
+		validationErr = errors.Join(validationErr, errBroadcastScheduleRequired)
+	}
+
+	if b.throttlePerMinute <= 0 {
+		validationErr = errors.Join(validationErr, errBroadcastThrottleInvalid)
+	}
+
+	if b.createdBy == uuid.Nil {
+		validationErr = errors.Join(validationErr, errBroadcastCreatorRequired)
+	}
+
+	if err := b.segment.Validate(); err != nil {
+		validationErr = errors.Join(validationErr, err)
+	}
+
+	return validationErr
+}
+
+// Getter implementations satisfy the BroadcastCampaign interface.
+
+func (b *BroadcastCampaignEntity) GetID() uuid.UUID {
+	return b.id
+}
+
+func (b *BroadcastCampaignEntity) GetName() string {
+	return b.name
+}
+
+func (b *BroadcastCampaignEntity) GetTitle() string {
+	return b.title
+}
+
+func (b *BroadcastCampaignEntity) GetBody() string {
+	return b.body
+}
+
+func (b *BroadcastCampaignEntity) GetSegment() BroadcastSegment {
+	return b.segment
+}
+
+func (b *BroadcastCampaignEntity) GetStatus() BroadcastCampaignStatus {
+	return b.status
+}
+
+func (b *BroadcastCampaignEntity) GetScheduledAt() time.Time {
+	return b.scheduledAt
+}
+
+func (b *BroadcastCampaignEntity) GetThrottlePerMinute() int {
+	return b.throttlePerMinute
+}
+
+func (b *BroadcastCampaignEntity) GetRecipientCount() int64 {
+	return b.recipientCount
+}
+
+func (b *BroadcastCampaignEntity) GetCreatedBy() uuid.UUID {
+	return b.createdBy
+}
+
+func (b *BroadcastCampaignEntity) GetStartedAt() *time.Time {
+	return b.startedAt
+}
+
+func (b *BroadcastCampaignEntity) GetCompletedAt() *time.Time {
+	return b.completedAt
+}
+
+func (b *BroadcastCampaignEntity) GetCancelledAt() *time.Time {
+	return b.cancelledAt
+}
+
+func (b *BroadcastCampaignEntity) GetCreatedAt() time.Time {
+	return b.createdAt
+}
+
+func (b *BroadcastCampaignEntity) GetUpdatedAt() time.Time {
+	return b.updatedAt
+}
+
+// Domain behavior helpers.
+
+// IsDue reports whether a scheduled campaign's send time has arrived.
+func (b *BroadcastCampaignEntity) IsDue(now time.Time) bool {
+	return b.status == BroadcastStatusScheduled && !now.Before(b.scheduledAt)
+}
+
+// Cancel stops a campaign that has not finished. Deliveries already sent are not recalled.
+func (b *BroadcastCampaignEntity) Cancel(at time.Time) error {
+	if b.status != BroadcastStatusScheduled && b.status != BroadcastStatusSending {
+		return errBroadcastNotCancellable
+	}
+	b.status = BroadcastStatusCancelled
+	b.cancelledAt = &at
+	b.updatedAt = at
+	return nil
+}
+
+func isValidBroadcastStatus(status BroadcastCampaignStatus) bool {
+	switch status {
+	case BroadcastStatusScheduled, BroadcastStatusSending, BroadcastStatusCompleted, BroadcastStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
@@ -52,6 +52,9 @@ type ChainConfig interface {
 	GetConfirmationThreshold() int
 	GetAverageBlockTime() time.Duration
 	IsActive() bool
+	// IsManaged reports whether staff configured the chain through the registry; unmanaged
+	// chains keep the adapter settings from the environment.
+	IsManaged() bool
 	GetNetworkType() ChainNetworkType
 	GetCreatedAt() time.Time
 	GetUpdatedAt() time.Time
@@ -70,6 +73,7 @@ type ChainEntity struct {
 	confirmationThreshold int
 	averageBlockTime      time.Duration
 	isActive              bool
+	managed               bool
 	networkType           ChainNetworkType
 	createdAt             time.Time
 	updatedAt             time.Time
@@ -88,6 +92,7 @@ type ChainParams struct {
 	ConfirmationThreshold int
 	AverageBlockTime      time.Duration
 	IsActive              bool
+	Managed               bool
 	NetworkType           ChainNetworkType
 	CreatedAt             time.Time
 	UpdatedAt             time.Time
@@ -130,6 +135,7 @@ func NewChainEntity(params ChainParams) (*ChainEntity, error) {
 		confirmationThreshold: params.ConfirmationThreshold,
 		averageBlockTime:      params.AverageBlockTime,
 		isActive:              params.IsActive,
+		managed:               params.Managed,
 		networkType:           params.NetworkType,
 		createdAt:             params.CreatedAt,
 		updatedAt:             params.UpdatedAt,
@@ -156,6 +162,7 @@ func HydrateChainEntity(params ChainParams) *ChainEntity {
 		confirmationThreshold: params.ConfirmationThreshold,
 		averageBlockTime:      params.AverageBlockTime,
 		isActive:              params.IsActive,
+		managed:               params.Managed,
 		networkType:           params.NetworkType,
 		createdAt:             params.CreatedAt,
 		updatedAt:             params.UpdatedAt,
@@ -255,6 +262,11 @@ func (c *ChainEntity) IsActive() bool {
 	return c.isActive
 }
 
+// IsManaged reports whether the chain's adapter settings come from the registry.
+func (c *ChainEntity) IsManaged() bool {
+	return c.managed
+}
+
 // GetNetworkType returns the configured network type (mainnet/testnet).
 func (c *ChainEntity) GetNetworkType() ChainNetworkType {
 	return c.networkType
@@ -0,0 +1,41 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ComplianceReviewStatus tracks a manual review item through the compliance queue.
+type ComplianceReviewStatus string
+
+const (
+	ComplianceReviewStatusOpen     ComplianceReviewStatus = "open"
+	ComplianceReviewStatusResolved ComplianceReviewStatus = "resolved"
+)
+
+// ComplianceReviewSource names the process that raised a review item.
+type ComplianceReviewSource string
+
+const (
+	// ComplianceReviewSourceAMLRescreening is raised when periodic screening finds a material change.
+	ComplianceReviewSourceAMLRescreening ComplianceReviewSource = "aml_rescreening"
+	// ComplianceReviewSourceLimitExceeded is raised when an operation over the user's KYC limits is
+	// let through because limits are only flagged.
+	ComplianceReviewSourceLimitExceeded ComplianceReviewSource = "limit_exceeded"
+)
+
+// ComplianceReviewItem is an entry in the manual compliance review queue.
+type ComplianceReviewItem struct {
+	ID         uuid.UUID              `json:"id"`
+	UserID     uuid.UUID              `json:"user_id"`
+	Source     ComplianceReviewSource `json:"source"`
+	Reason     string                 `json:"reason"`
+	RiskLevel  RiskLevel              `json:"risk_level"`
+	Details    map[string]any         `json:"details,omitempty"`
+	Status     ComplianceReviewStatus `json:"status"`
+	ResolvedBy *uuid.UUID             `json:"resolved_by,omitempty"`
+	Resolution string                 `json:"resolution,omitempty"`
+	CreatedAt  time.Time              `json:"created_at"`
+	ResolvedAt *time.Time             `json:"resolved_at,omitempty"`
+}
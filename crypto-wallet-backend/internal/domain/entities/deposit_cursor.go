@@ -0,0 +1,25 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+var errDepositCursorChainInvalid = errors.New("deposit cursor: chain is invalid")
+
+// DepositCursor records the last block of a chain the deposit scanner has fully processed. It is
+// saved in the same database transaction as the deposits found in that block, so a restart resumes
+// with the next block without missing or double-crediting any transfer.
+type DepositCursor struct {
+	Chain     Chain     `json:"chain"`
+	LastBlock uint64    `json:"last_block"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Validate ensures the cursor adheres to domain invariants.
+func (c DepositCursor) Validate() error {
+	if !isValidChain(c.Chain) {
+		return errDepositCursorChainInvalid
+	}
+	return nil
+}
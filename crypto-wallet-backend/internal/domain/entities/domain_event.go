@@ -0,0 +1,64 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// domainEventSchemaVersions records the current payload schema version of each event type.
+// Bump an entry whenever the payload of that event changes incompatibly; unlisted types are version 1.
+var domainEventSchemaVersions = map[string]int{}
+
+var (
+	errDomainEventTypeRequired = errors.New("domain event type is required")
+	errDomainEventSchemaNeeded = errors.New("domain event schema version must be positive")
+)
+
+// DomainEvent is an event recorded in the outbox for downstream export. Sequence is assigned on
+// insert and orders events for replay.
+type DomainEvent struct {
+	Sequence      int64          `json:"sequence"`
+	ID            uuid.UUID      `json:"event_id"`
+	Type          string         `json:"event_type"`
+	SchemaVersion int            `json:"schema_version"`
+	Payload       map[string]any `json:"payload"`
+	OccurredAt    time.Time      `json:"occurred_at"`
+}
+
+// NewDomainEvent builds an event stamped with the current schema version of its type.
+func NewDomainEvent(eventType string, payload map[string]any, at time.Time) DomainEvent {
+	eventType = strings.TrimSpace(eventType)
+	return DomainEvent{
+		ID:            uuid.New(),
+		Type:          eventType,
+		SchemaVersion: DomainEventSchemaVersion(eventType),
+		Payload:       payload,
+		OccurredAt:    at.UTC(),
+	}
+}
+
+// DomainEventSchemaVersion returns the current payload schema version for an event type.
+func DomainEventSchemaVersion(eventType string) int {
+	if version, ok := domainEventSchemaVersions[eventType]; ok {
+		return version
+	}
+	return 1
+}
+
+// Validate performs basic validation on the DomainEvent.
+func (e DomainEvent) Validate() error {
+	var validationErr error
+
+	if strings.TrimSpace(e.Type) == "" {
+		validationErr = errors.Join(validationErr, errDomainEventTypeRequired)
+	}
+
+	if e.SchemaVersion <= 0 {
+		validationErr = errors.Join(validationErr, errDomainEventSchemaNeeded)
+	}
+
+	return validationErr
+}
@@ -0,0 +1,73 @@
+package entities
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultEmailVerificationTTL is how long an emailed verification link stays usable.
+const DefaultEmailVerificationTTL = 24 * time.Hour
+
+var (
+	errEmailVerificationIDRequired    = errors.New("email verification: id is required")
+	errEmailVerificationUserRequired  = errors.New("email verification: user id is required")
+	errEmailVerificationEmailRequired = errors.New("email verification: email is required")
+	errEmailVerificationHashRequired  = errors.New("email verification: token hash is required")
+	errEmailVerificationExpiryInvalid = errors.New("email verification: expiry must be after creation")
+)
+
+// EmailVerificationToken records a verification link sent to a user. Only the hash of the token is
+// stored; Email pins the address the link was sent to, so a link stops working once the user changes
+// their address.
+type EmailVerificationToken struct {
+	ID        uuid.UUID  `json:"id"`
+	UserID    uuid.UUID  `json:"user_id"`
+	Email     string     `json:"email"`
+	TokenHash string     `json:"-"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+}
+
+// Validate performs basic validation on the EmailVerificationToken.
+func (t EmailVerificationToken) Validate() error {
+	var validationErr error
+
+	if t.ID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errEmailVerificationIDRequired)
+	}
+
+	if t.UserID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errEmailVerificationUserRequired)
+	}
+
+	if strings.TrimSpace(t.Email) == "" {
+		validationErr = errors.Join(validationErr, errEmailVerificationEmailRequired)
+	}
+
+	if strings.TrimSpace(t.TokenHash) == "" {
+		validationErr = errors.Join(validationErr, errEmailVerificationHashRequired)
+	}
+
+	if !t.ExpiresAt.After(t.CreatedAt) {
+		validationErr = errors.Join(validationErr, errEmailVerificationExpiryInvalid)
+	}
+
+	return validationErr
+}
+
+// IsUsable reports whether the token has neither been used nor expired at the given time.
+func (t EmailVerificationToken) IsUsable(at time.Time) bool {
+	return t.UsedAt == nil && at.Before(t.ExpiresAt)
+}
+
+// HashEmailVerificationToken derives the lookup hash stored in place of a verification token.
+func HashEmailVerificationToken(token string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(token)))
+	return hex.EncodeToString(sum[:])
+}
@@ -54,6 +54,8 @@ type ExchangeOperation interface {
 	GetQuoteExpiresAt() time.Time
 	GetExecutedAt() *time.Time
 	GetErrorMessage() string
+	GetPricingStrategy() string
+	GetPricingMetadata() map[string]string
 }
 
 // ExchangeOperationEntity is the default implementation of the ExchangeOperation interface.
@@ -73,6 +75,8 @@ type ExchangeOperationEntity struct {
 	quoteExpiresAt    time.Time
 	executedAt        *time.Time
 	errorMessage      string
+	pricingStrategy   string
+	pricingMetadata   map[string]string
 	createdAt         time.Time
 	updatedAt         time.Time
 }
@@ -94,8 +98,11 @@ type ExchangeOperationParams struct {
 	QuoteExpiresAt    time.Time
 	ExecutedAt        *time.Time
 	ErrorMessage      string
-	CreatedAt         time.Time
-	UpdatedAt         time.Time
+	// PricingStrategy and PricingMetadata record how the quote was priced.
+	PricingStrategy string
+	PricingMetadata map[string]string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
 // NewExchangeOperationEntity validates the supplied parameters and returns a new ExchangeOperationEntity instance.
@@ -113,8 +120,7 @@ func NewExchangeOperationEntity(params ExchangeOperationParams) (*ExchangeOperat
 	}
 
 	if params.QuoteExpiresAt.IsZero() {
-		// Default quote expiration: 60 seconds from creation
-		params.QuoteExpiresAt = params.CreatedAt.Add(60 * time.Second)
+		params.QuoteExpiresAt = params.CreatedAt.Add(DefaultQuoteTTL)
 	}
 
 	entity := &ExchangeOperationEntity{
@@ -133,6 +139,8 @@ func NewExchangeOperationEntity(params ExchangeOperationParams) (*ExchangeOperat
 		quoteExpiresAt:    params.QuoteExpiresAt,
 		executedAt:        params.ExecutedAt,
 		errorMessage:      strings.TrimSpace(params.ErrorMessage),
+		pricingStrategy:   params.PricingStrategy,
+		pricingMetadata:   params.PricingMetadata,
 		createdAt:         params.CreatedAt,
 		updatedAt:         params.UpdatedAt,
 	}
@@ -166,6 +174,8 @@ func HydrateExchangeOperationEntity(params ExchangeOperationParams) *ExchangeOpe
 		quoteExpiresAt:    params.QuoteExpiresAt,
 		executedAt:        params.ExecutedAt,
 		errorMessage:      strings.TrimSpace(params.ErrorMessage),
+		pricingStrategy:   params.PricingStrategy,
+		pricingMetadata:   params.PricingMetadata,
 		createdAt:         params.CreatedAt,
 		updatedAt:         params.UpdatedAt,
 	}
@@ -343,11 +353,45 @@ func (e *ExchangeOperationEntity) MarkCancelled() error {
 	return e.SetStatus(ExchangeStatusCancelled)
 }
 
+// GetPricingStrategy returns the name of the pricing strategy that produced the quote.
+func (e *ExchangeOperationEntity) GetPricingStrategy() string {
+	return e.pricingStrategy
+}
+
+// GetPricingMetadata returns the inputs the pricing strategy recorded for the quote.
+func (e *ExchangeOperationEntity) GetPricingMetadata() map[string]string {
+	return e.pricingMetadata
+}
+
+// IsFinal reports whether the operation has reached a status it can no longer leave.
+func (e *ExchangeOperationEntity) IsFinal() bool {
+	switch e.status {
+	case ExchangeStatusCompleted, ExchangeStatusFailed, ExchangeStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
 // IsQuoteExpired checks if the quote has expired.
 func (e *ExchangeOperationEntity) IsQuoteExpired() bool {
 	return time.Now().UTC().After(e.quoteExpiresAt)
 }
 
+// QuoteTTL returns how long the quote was valid for when it was issued.
+func (e *ExchangeOperationEntity) QuoteTTL() time.Duration {
+	return e.quoteExpiresAt.Sub(e.createdAt)
+}
+
+// QuoteSecondsRemaining returns the whole seconds left before the quote expires, never negative.
+func (e *ExchangeOperationEntity) QuoteSecondsRemaining(now time.Time) int {
+	remaining := int(e.quoteExpiresAt.Sub(now).Seconds())
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
 // ExtendQuoteExpiration extends the quote expiration time by the specified duration.
 func (e *ExchangeOperationEntity) ExtendQuoteExpiration(duration time.Duration) {
 	e.quoteExpiresAt = e.quoteExpiresAt.Add(duration)
@@ -2,6 +2,7 @@ package entities
 
 import (
 	"errors"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,6 +19,15 @@ var (
 	errExchangeRateLastUpdatedInvalid   = errors.New("exchange rate last updated is required")
 )
 
+const (
+	// RateSourceCoinGecko identifies rates ingested from the CoinGecko market data API.
+	RateSourceCoinGecko = "coingecko"
+	// RateSourceInternal identifies rates maintained by the platform itself (e.g. trading pairs).
+	RateSourceInternal = "internal"
+	// DefaultRateStaleAfter is the age beyond which a rate is reported as stale to clients.
+	DefaultRateStaleAfter = 2 * time.Minute
+)
+
 // ExchangeRate exposes the behavior required by the application layer when working with exchange rate entities.
 type ExchangeRate interface {
 	Entity
@@ -29,7 +39,9 @@ type ExchangeRate interface {
 	GetPriceChange24h() decimal.Decimal
 	GetVolume24h() decimal.Decimal
 	GetMarketCap() decimal.Decimal
+	GetSource() string
 	GetLastUpdated() time.Time
+	IsStale(now time.Time, threshold time.Duration) bool
 	UpdatePrice(priceUSD, priceChange24h, volume24h, marketCap decimal.Decimal, lastUpdated time.Time) error
 	Touch(at time.Time)
 }
@@ -42,6 +54,7 @@ type ExchangeRateEntity struct {
 	priceChange24h  decimal.Decimal
 	volume24h       decimal.Decimal
 	marketCap       decimal.Decimal
+	source          string
 	lastUpdated     time.Time
 	createdAt       time.Time
 	updatedAt       time.Time
@@ -55,6 +68,7 @@ type ExchangeRateParams struct {
 	PriceChange24h  decimal.Decimal
 	Volume24h       decimal.Decimal
 	MarketCap       decimal.Decimal
+	Source          string
 	LastUpdated     time.Time
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
@@ -78,6 +92,10 @@ func NewExchangeRateEntity(params ExchangeRateParams) (*ExchangeRateEntity, erro
 		params.LastUpdated = time.Now().UTC()
 	}
 
+	if strings.TrimSpace(params.Source) == "" {
+		params.Source = RateSourceCoinGecko
+	}
+
 	entity := &ExchangeRateEntity{
 		id:             params.ID,
 		symbol:         strings.ToUpper(strings.TrimSpace(params.Symbol)),
@@ -85,6 +103,7 @@ func NewExchangeRateEntity(params ExchangeRateParams) (*ExchangeRateEntity, erro
 		priceChange24h: params.PriceChange24h,
 		volume24h:      params.Volume24h,
 		marketCap:      params.MarketCap,
+		source:         strings.ToLower(strings.TrimSpace(params.Source)),
 		lastUpdated:    params.LastUpdated,
 		createdAt:      params.CreatedAt,
 		updatedAt:      params.UpdatedAt,
@@ -106,6 +125,7 @@ func HydrateExchangeRateEntity(params ExchangeRateParams) *ExchangeRateEntity {
 		priceChange24h: params.PriceChange24h,
 		volume24h:      params.Volume24h,
 		marketCap:      params.MarketCap,
+		source:         strings.ToLower(strings.TrimSpace(params.Source)),
 		lastUpdated:    params.LastUpdated,
 		createdAt:      params.CreatedAt,
 		updatedAt:      params.UpdatedAt,
@@ -165,10 +185,19 @@ func (e *ExchangeRateEntity) GetMarketCap() decimal.Decimal {
 	return e.marketCap
 }
 
+func (e *ExchangeRateEntity) GetSource() string {
+	return e.source
+}
+
 func (e *ExchangeRateEntity) GetLastUpdated() time.Time {
 	return e.lastUpdated
 }
 
+// IsStale reports whether the rate is older than the supplied threshold at the given instant.
+func (e *ExchangeRateEntity) IsStale(now time.Time, threshold time.Duration) bool {
+	return IsRateStale(e.lastUpdated, now, threshold)
+}
+
 func (e *ExchangeRateEntity) GetCreatedAt() time.Time {
 	return e.createdAt
 }
@@ -217,18 +246,37 @@ func (e *ExchangeRateEntity) Touch(at time.Time) {
 	e.updatedAt = at
 }
 
-// IsSupportedSymbol reports whether the supplied symbol is one of the supported cryptocurrencies.
+// nativeSymbols are the native assets of the supported chains, which are always supported.
+var nativeSymbols = []string{"BTC", "ETH", "SOL", "XLM"}
+
+// IsSupportedSymbol reports whether the supplied symbol is a native asset or a listed asset; see
+// ListAssets.
 func IsSupportedSymbol(symbol string) bool {
-	normalized := strings.ToUpper(strings.TrimSpace(symbol))
-	switch normalized {
-	case "BTC", "ETH", "SOL", "XLM":
-		return true
-	default:
-		return false
-	}
+	return slices.Contains(SupportedSymbols(), strings.ToUpper(strings.TrimSpace(symbol)))
 }
 
-// SupportedSymbols returns a slice of all supported cryptocurrency symbols.
+// SupportedSymbols returns the native asset symbols followed by the other listed symbols in
+// alphabetical order.
 func SupportedSymbols() []string {
-	return []string{"BTC", "ETH", "SOL", "XLM"}
+	symbols := slices.Clone(nativeSymbols)
+	if listing := listedAssets.Load(); listing != nil {
+		for _, symbol := range listing.symbols {
+			if !slices.Contains(symbols, symbol) {
+				symbols = append(symbols, symbol)
+			}
+		}
+	}
+	return symbols
+}
+
+// IsRateStale reports whether a rate last updated at lastUpdated is older than threshold at now.
+// A non-positive threshold falls back to DefaultRateStaleAfter.
+func IsRateStale(lastUpdated, now time.Time, threshold time.Duration) bool {
+	if threshold <= 0 {
+		threshold = DefaultRateStaleAfter
+	}
+	if lastUpdated.IsZero() {
+		return true
+	}
+	return now.Sub(lastUpdated) > threshold
 }
@@ -0,0 +1,341 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ExportJobKind identifies the generator responsible for producing an export.
+type ExportJobKind string
+
+const (
+	ExportJobKindAccounting   ExportJobKind = "accounting"
+	ExportJobKindTransactions ExportJobKind = "transactions"
+	// ExportJobKindReport runs a custom analytics report in the background.
+	ExportJobKindReport ExportJobKind = "report"
+	// ExportJobKindPersonalData assembles everything held about a user into a data access archive.
+	ExportJobKindPersonalData ExportJobKind = "personal_data"
+)
+
+// ExportJobStatus enumerates the lifecycle states of an asynchronous export.
+type ExportJobStatus string
+
+const (
+	ExportJobStatusQueued     ExportJobStatus = "queued"
+	ExportJobStatusProcessing ExportJobStatus = "processing"
+	ExportJobStatusCompleted  ExportJobStatus = "completed"
+	ExportJobStatusFailed     ExportJobStatus = "failed"
+)
+
+// DefaultExportRetention is how long a generated export remains downloadable.
+const DefaultExportRetention = 7 * 24 * time.Hour
+
+var (
+	errExportJobUserIDRequired = errors.New("export job user ID is required")
+	errExportJobKindInvalid    = errors.New("export job kind is invalid")
+	errExportJobFormatRequired = errors.New("export job format is required")
+	errExportJobStatusInvalid  = errors.New("export job status is invalid")
+	errExportJobNotProcessing  = errors.New("export job is not processing")
+)
+
+// ExportJob exposes the behavior required by the application layer when working with export jobs.
+type ExportJob interface {
+	Entity
+	Identifiable
+	Timestamped
+
+	GetUserID() uuid.UUID
+	GetKind() ExportJobKind
+	GetFormat() string
+	GetParameters() map[string]string
+	GetStatus() ExportJobStatus
+	GetAttempts() int
+	GetFilename() string
+	GetContentType() string
+	GetStorageKey() string
+	GetSize() int64
+	GetRowCount() int
+	IsTruncated() bool
+	GetErrorMessage() string
+	GetStartedAt() *time.Time
+	GetCompletedAt() *time.Time
+	GetExpiresAt() *time.Time
+}
+
+// ExportJobEntity is the default implementation of the ExportJob interface.
+type ExportJobEntity struct {
+	id           uuid.UUID
+	userID       uuid.UUID
+	kind         ExportJobKind
+	format       string
+	parameters   map[string]string
+	status       ExportJobStatus
+	attempts     int
+	filename     string
+	contentType  string
+	storageKey   string
+	size         int64
+	rowCount     int
+	truncated    bool
+	errorMessage string
+	startedAt    *time.Time
+	completedAt  *time.Time
+	expiresAt    *time.Time
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+// ExportJobParams captures the fields required to construct an ExportJobEntity.
+type ExportJobParams struct {
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	Kind         ExportJobKind
+	Format       string
+	Parameters   map[string]string
+	Status       ExportJobStatus
+	Attempts     int
+	Filename     string
+	ContentType  string
+	StorageKey   string
+	Size         int64
+	RowCount     int
+	Truncated    bool
+	ErrorMessage string
+	StartedAt    *time.Time
+	CompletedAt  *time.Time
+	ExpiresAt    *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// NewExportJobEntity validates the supplied parameters and returns a new queued ExportJobEntity.
+func NewExportJobEntity(params ExportJobParams) (*ExportJobEntity, error) {
+	if params.ID == uuid.Nil {
+		params.ID = uuid.New()
+	}
+
+	if params.Status == "" {
+		params.Status = ExportJobStatusQueued
+	}
+
+	if params.CreatedAt.IsZero() {
+		params.CreatedAt = time.Now().UTC()
+	}
+
+	if params.UpdatedAt.IsZero() {
+		params.UpdatedAt = params.CreatedAt
+	}
+
+	entity := HydrateExportJobEntity(params)
+	if err := entity.Validate(); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// HydrateExportJobEntity creates an ExportJobEntity without re-validating invariants (used for repository hydration).
+func HydrateExportJobEntity(params ExportJobParams) *ExportJobEntity {
+	parameters := make(map[string]string, len(params.Parameters))
+	for key, value := range params.Parameters {
+		parameters[key] = value
+	}
+
+	return &ExportJobEntity{
+		id:           params.ID,
+		userID:       params.UserID,
+		kind:         params.Kind,
+		format:       strings.ToLower(strings.TrimSpace(params.Format)),
+		parameters:   parameters,
+		status:       params.Status,
+		attempts:     params.Attempts,
+		filename:     params.Filename,
+		contentType:  params.ContentType,
+		storageKey:   params.StorageKey,
+		size:         params.Size,
+		rowCount:     params.RowCount,
+		truncated:    params.Truncated,
+		errorMessage: params.ErrorMessage,
+		startedAt:    params.StartedAt,
+		completedAt:  params.CompletedAt,
+		expiresAt:    params.ExpiresAt,
+		createdAt:    params.CreatedAt,
+		updatedAt:    params.UpdatedAt,
+	}
+}
+
+// Validate ensures the entity adheres to domain invariants.
+func (j *ExportJobEntity) Validate() error {
+	var validationErr error
+
+	if j.userID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errExportJobUserIDRequired)
+	}
+
+	if !isValidExportJobKind(j.kind) {
+		validationErr = errors.Join(validationErr, errExportJobKindInvalid)
+	}
+
+	if j.format == "" {
+		validationErr = errors.Join(validationErr, errExportJobFormatRequired)
+	}
+
+	if !isValidExportJobStatus(j.status) {
+		validationErr = errors.Join(validationErr, errExportJobStatusInvalid)
+	}
+
+	return validationErr
+}
+
+// Getter implementations satisfy the ExportJob interface.
+
+func (j *ExportJobEntity) GetID() uuid.UUID {
+	return j.id
+}
+
+func (j *ExportJobEntity) GetUserID() uuid.UUID {
+	return j.userID
+}
+
+func (j *ExportJobEntity) GetKind() ExportJobKind {
+	return j.kind
+}
+
+func (j *ExportJobEntity) GetFormat() string {
+	return j.format
+}
+
+func (j *ExportJobEntity) GetParameters() map[string]string {
+	parameters := make(map[string]string, len(j.parameters))
+	for key, value := range j.parameters {
+		parameters[key] = value
+	}
+	return parameters
+}
+
+func (j *ExportJobEntity) GetStatus() ExportJobStatus {
+	return j.status
+}
+
+func (j *ExportJobEntity) GetAttempts() int {
+	return j.attempts
+}
+
+func (j *ExportJobEntity) GetFilename() string {
+	return j.filename
+}
+
+func (j *ExportJobEntity) GetContentType() string {
+	return j.contentType
+}
+
+// GetStorageKey returns the object storage key of the generated file; empty when the file is kept
+// in the database.
+func (j *ExportJobEntity) GetStorageKey() string {
+	return j.storageKey
+}
+
+func (j *ExportJobEntity) GetSize() int64 {
+	return j.size
+}
+
+func (j *ExportJobEntity) GetRowCount() int {
+	return j.rowCount
+}
+
+// IsTruncated reports whether the file holds only the rows written before generation ran out of
+// its time budget.
+func (j *ExportJobEntity) IsTruncated() bool {
+	return j.truncated
+}
+
+func (j *ExportJobEntity) GetErrorMessage() string {
+	return j.errorMessage
+}
+
+func (j *ExportJobEntity) GetStartedAt() *time.Time {
+	return j.startedAt
+}
+
+func (j *ExportJobEntity) GetCompletedAt() *time.Time {
+	return j.completedAt
+}
+
+func (j *ExportJobEntity) GetExpiresAt() *time.Time {
+	return j.expiresAt
+}
+
+func (j *ExportJobEntity) GetCreatedAt() time.Time {
+	return j.createdAt
+}
+
+func (j *ExportJobEntity) GetUpdatedAt() time.Time {
+	return j.updatedAt
+}
+
+// Domain behavior helpers.
+
+// IsDownloadable reports whether the generated file can still be fetched.
+func (j *ExportJobEntity) IsDownloadable(now time.Time) bool {
+	if j.status != ExportJobStatusCompleted {
+		return false
+	}
+	return j.expiresAt == nil || now.Before(*j.expiresAt)
+}
+
+// MarkCompleted records the generated file's metadata and its download expiry. storageKey is empty
+// when the file is stored alongside the job; truncated marks a partial file.
+func (j *ExportJobEntity) MarkCompleted(filename, contentType, storageKey string, size int64, rowCount int, truncated bool, at time.Time, retention time.Duration) error {
+	if j.status != ExportJobStatusProcessing {
+		return errExportJobNotProcessing
+	}
+	if retention <= 0 {
+		retention = DefaultExportRetention
+	}
+	expiresAt := at.Add(retention)
+	j.status = ExportJobStatusCompleted
+	j.filename = filename
+	j.contentType = contentType
+	j.storageKey = storageKey
+	j.size = size
+	j.rowCount = rowCount
+	j.truncated = truncated
+	j.errorMessage = ""
+	j.completedAt = &at
+	j.expiresAt = &expiresAt
+	j.updatedAt = at
+	return nil
+}
+
+// MarkFailed records why generation did not produce a file.
+func (j *ExportJobEntity) MarkFailed(message string, at time.Time) error {
+	if j.status != ExportJobStatusProcessing {
+		return errExportJobNotProcessing
+	}
+	j.status = ExportJobStatusFailed
+	j.errorMessage = strings.TrimSpace(message)
+	j.completedAt = &at
+	j.updatedAt = at
+	return nil
+}
+
+func isValidExportJobKind(kind ExportJobKind) bool {
+	switch kind {
+	case ExportJobKindAccounting, ExportJobKindTransactions, ExportJobKindReport, ExportJobKindPersonalData:
+		return true
+	default:
+		return false
+	}
+}
+
+func isValidExportJobStatus(status ExportJobStatus) bool {
+	switch status {
+	case ExportJobStatusQueued, ExportJobStatusProcessing, ExportJobStatusCompleted, ExportJobStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,81 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// DefaultFiatRateStaleAfter is how old a fiat rate may be before converted values are flagged.
+// Reference rates are published once per business day, so this spans a weekend.
+const DefaultFiatRateStaleAfter = 96 * time.Hour
+
+var (
+	errFiatRateCurrencyInvalid = errors.New("fiat rate: currency is not supported")
+	errFiatRateNotPositive     = errors.New("fiat rate: rate must be positive")
+	errFiatRateTimestamp       = errors.New("fiat rate: updated at is required")
+)
+
+// FiatRate is the number of units of a fiat currency one US dollar buys.
+type FiatRate struct {
+	Currency  CurrencyCode    `json:"currency"`
+	PerUSD    decimal.Decimal `json:"per_usd"`
+	Source    string          `json:"source"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// USDFiatRate is the identity rate used for USD, which never needs a lookup.
+func USDFiatRate(now time.Time) FiatRate {
+	return FiatRate{Currency: CurrencyUSD, PerUSD: decimal.NewFromInt(1), Source: "identity", UpdatedAt: now}
+}
+
+// Validate performs basic validation on the FiatRate.
+func (r FiatRate) Validate() error {
+	var validationErr error
+
+	if !isValidCurrencyCode(r.Currency) {
+		validationErr = errors.Join(validationErr, errFiatRateCurrencyInvalid)
+	}
+	if !r.PerUSD.IsPositive() {
+		validationErr = errors.Join(validationErr, errFiatRateNotPositive)
+	}
+	if r.UpdatedAt.IsZero() {
+		validationErr = errors.Join(validationErr, errFiatRateTimestamp)
+	}
+
+	return validationErr
+}
+
+// IsStale reports whether the rate is older than maxAge at now. USD is never stale.
+func (r FiatRate) IsStale(now time.Time, maxAge time.Duration) bool {
+	if r.Currency == CurrencyUSD {
+		return false
+	}
+	return now.Sub(r.UpdatedAt) > maxAge
+}
+
+// FromUSD converts a US dollar amount into the rate's currency.
+func (r FiatRate) FromUSD(amount decimal.Decimal) decimal.Decimal {
+	return amount.Mul(r.PerUSD)
+}
+
+// SupportedCurrencies lists the display currencies in a stable order.
+func SupportedCurrencies() []CurrencyCode {
+	return []CurrencyCode{CurrencyUSD, CurrencyEUR, CurrencyTHB, CurrencyGBP, CurrencyJPY}
+}
+
+// ParseCurrencyCode normalises a currency code and reports whether it is supported.
+func ParseCurrencyCode(value string) (CurrencyCode, bool) {
+	code := CurrencyCode(strings.ToUpper(strings.TrimSpace(value)))
+	return code, isValidCurrencyCode(code)
+}
+
+// CurrencyPrecision is the number of fractional digits amounts in the currency are shown with.
+func CurrencyPrecision(code CurrencyCode) int32 {
+	if code == CurrencyJPY {
+		return 0
+	}
+	return 2
+}
@@ -0,0 +1,66 @@
+package entities
+
+import (
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// GasSpeed is a user's trade-off between fee and inclusion time on EVM chains.
+type GasSpeed string
+
+const (
+	GasSpeedSlow   GasSpeed = "slow"
+	GasSpeedNormal GasSpeed = "normal"
+	GasSpeedFast   GasSpeed = "fast"
+)
+
+// DefaultGasSpeed applies to users who have not chosen a speed.
+const DefaultGasSpeed = GasSpeedNormal
+
+// ParseGasSpeed normalises raw input and reports whether it names a known speed.
+func ParseGasSpeed(raw string) (GasSpeed, bool) {
+	speed := GasSpeed(strings.ToLower(strings.TrimSpace(raw)))
+	switch speed {
+	case GasSpeedSlow, GasSpeedNormal, GasSpeedFast:
+		return speed, true
+	default:
+		return "", false
+	}
+}
+
+// GasSpeeds lists the speeds from cheapest to fastest.
+func GasSpeeds() []GasSpeed {
+	return []GasSpeed{GasSpeedSlow, GasSpeedNormal, GasSpeedFast}
+}
+
+// IsEVMChain reports whether the chain prices gas with an EIP-1559 base fee and priority fee.
+func IsEVMChain(chain Chain) bool {
+	return chain == ChainETH
+}
+
+// GasPriceSample is the fee market of one EVM block: its base fee and the priority fees paid at the
+// slow, normal and fast percentiles of its transactions. Amounts are in wei.
+type GasPriceSample struct {
+	Chain                Chain           `json:"chain"`
+	BlockNumber          int64           `json:"block_number"`
+	BaseFeeWei           decimal.Decimal `json:"base_fee_wei"`
+	SlowPriorityFeeWei   decimal.Decimal `json:"slow_priority_fee_wei"`
+	NormalPriorityFeeWei decimal.Decimal `json:"normal_priority_fee_wei"`
+	FastPriorityFeeWei   decimal.Decimal `json:"fast_priority_fee_wei"`
+	GasUsedRatio         float64         `json:"gas_used_ratio"`
+	ObservedAt           time.Time       `json:"observed_at"`
+}
+
+// PriorityFeeWei returns the sample's priority fee for the speed.
+func (s GasPriceSample) PriorityFeeWei(speed GasSpeed) decimal.Decimal {
+	switch speed {
+	case GasSpeedSlow:
+		return s.SlowPriorityFeeWei
+	case GasSpeedFast:
+		return s.FastPriorityFeeWei
+	default:
+		return s.NormalPriorityFeeWei
+	}
+}
@@ -0,0 +1,68 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HDSeedSource records how a user's HD wallet seed came to exist.
+type HDSeedSource string
+
+const (
+	// HDSeedSourceGenerated seeds come from a mnemonic the platform generated and showed once.
+	HDSeedSourceGenerated HDSeedSource = "generated"
+	// HDSeedSourceImported seeds come from a mnemonic the user brought from another wallet.
+	HDSeedSourceImported HDSeedSource = "imported"
+)
+
+var (
+	errHDSeedUserRequired   = errors.New("hd seed: user id is required")
+	errHDSeedSecretRequired = errors.New("hd seed: encrypted seed is required")
+	errHDSeedSourceInvalid  = errors.New("hd seed: source is invalid")
+)
+
+// HDSeed is a user's BIP39 seed, from which all of their HD wallets are derived. Only the seed is
+// kept, encrypted under the wallet encryption key; the mnemonic itself is never stored, so the
+// user's backup of it is the only way to recover the phrase. BackupConfirmedAt is set once the
+// user proves they wrote the mnemonic down.
+type HDSeed struct {
+	UserID            uuid.UUID    `json:"user_id"`
+	EncryptedSeed     string       `json:"-"`
+	Source            HDSeedSource `json:"source"`
+	BackupConfirmedAt *time.Time   `json:"backup_confirmed_at,omitempty"`
+	CreatedAt         time.Time    `json:"created_at"`
+	UpdatedAt         time.Time    `json:"updated_at"`
+}
+
+// Validate ensures the seed adheres to domain invariants.
+func (s HDSeed) Validate() error {
+	var validationErr error
+
+	if s.UserID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errHDSeedUserRequired)
+	}
+
+	if strings.TrimSpace(s.EncryptedSeed) == "" {
+		validationErr = errors.Join(validationErr, errHDSeedSecretRequired)
+	}
+
+	if s.Source != HDSeedSourceGenerated && s.Source != HDSeedSourceImported {
+		validationErr = errors.Join(validationErr, errHDSeedSourceInvalid)
+	}
+
+	return validationErr
+}
+
+// IsBackupConfirmed reports whether the user has confirmed their mnemonic backup.
+func (s HDSeed) IsBackupConfirmed() bool {
+	return s.BackupConfirmedAt != nil
+}
+
+// HDSeedAssociatedData binds an encrypted seed to its owner so ciphertexts cannot be swapped
+// between users.
+func HDSeedAssociatedData(userID uuid.UUID) []byte {
+	return []byte("hd-seed:" + userID.String())
+}
@@ -0,0 +1,109 @@
+package entities
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxInternalEventConsumerNameLength bounds consumer names so their cursor sink fits the cursor table.
+const MaxInternalEventConsumerNameLength = 40
+
+// internalEventConsumerSinkPrefix namespaces consumer offsets among the outbox export cursors.
+const internalEventConsumerSinkPrefix = "internal:"
+
+var (
+	internalEventConsumerNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+	certFingerprintPattern           = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+	errInternalEventConsumerNameInvalid        = errors.New("internal event consumer name must be 1-40 lowercase letters, digits or dashes")
+	errInternalEventConsumerFingerprintInvalid = errors.New("internal event consumer certificate fingerprint must be a SHA-256 hex digest")
+	errInternalEventConsumerTypesRequired      = errors.New("internal event consumer must be allowed at least one event type")
+	errEventTypePatternInvalid                 = errors.New("event type patterns must be an exact type, a prefix ending in \".*\", or \"*\"")
+)
+
+// InternalEventConsumer is an internal service allowed to read the event outbox over the mTLS
+// internal API. It authenticates with a client certificate and only sees the event types matching
+// its EventTypes patterns.
+type InternalEventConsumer struct {
+	ID              uuid.UUID  `json:"id"`
+	Name            string     `json:"name"`
+	CertFingerprint string     `json:"cert_fingerprint"`
+	EventTypes      []string   `json:"event_types"`
+	IsActive        bool       `json:"is_active"`
+	CreatedAt       time.Time  `json:"created_at"`
+	LastSeenAt      *time.Time `json:"last_seen_at,omitempty"`
+}
+
+// Validate performs basic validation on the InternalEventConsumer.
+func (c InternalEventConsumer) Validate() error {
+	var validationErr error
+
+	if len(c.Name) > MaxInternalEventConsumerNameLength || !internalEventConsumerNamePattern.MatchString(c.Name) {
+		validationErr = errors.Join(validationErr, errInternalEventConsumerNameInvalid)
+	}
+
+	if !certFingerprintPattern.MatchString(c.CertFingerprint) {
+		validationErr = errors.Join(validationErr, errInternalEventConsumerFingerprintInvalid)
+	}
+
+	if len(c.EventTypes) == 0 {
+		validationErr = errors.Join(validationErr, errInternalEventConsumerTypesRequired)
+	}
+	if err := ValidateEventTypePatterns(c.EventTypes); err != nil {
+		validationErr = errors.Join(validationErr, err)
+	}
+
+	return validationErr
+}
+
+// Allows reports whether the consumer may read events of the given type.
+func (c InternalEventConsumer) Allows(eventType string) bool {
+	for _, pattern := range c.EventTypes {
+		if MatchEventType(pattern, eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+// CursorSink names the export cursor that holds the consumer's committed offset.
+func (c InternalEventConsumer) CursorSink() string {
+	return internalEventConsumerSinkPrefix + c.Name
+}
+
+// ValidateEventTypePatterns checks that every pattern is an exact type, a "prefix.*" or "*".
+func ValidateEventTypePatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		prefix, wildcard := strings.CutSuffix(pattern, "*")
+		switch {
+		case strings.TrimSpace(pattern) != pattern || pattern == "":
+			return errEventTypePatternInvalid
+		case strings.Contains(prefix, "*"):
+			return errEventTypePatternInvalid
+		case wildcard && prefix != "" && !strings.HasSuffix(prefix, "."):
+			return errEventTypePatternInvalid
+		}
+	}
+	return nil
+}
+
+// MatchEventType reports whether eventType matches pattern: "*" matches every type, "exchange.*"
+// every type starting with "exchange.", and anything else only itself.
+func MatchEventType(pattern, eventType string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(eventType, prefix)
+	}
+	return pattern == eventType
+}
+
+// CertificateFingerprint returns the lowercase hex SHA-256 digest of a DER-encoded certificate.
+func CertificateFingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:])
+}
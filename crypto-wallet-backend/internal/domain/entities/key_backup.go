@@ -0,0 +1,126 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultKeyBackupApprovalWindow bounds how long a disaster-recovery key backup request may
+// collect approvals and be exported.
+const DefaultKeyBackupApprovalWindow = 24 * time.Hour
+
+// KeyBackupStatus tracks a key backup request through approval and export.
+type KeyBackupStatus string
+
+const (
+	// KeyBackupStatusPending requests are collecting approvals.
+	KeyBackupStatusPending KeyBackupStatus = "pending"
+	// KeyBackupStatusExporting requests have been claimed by an export in progress.
+	KeyBackupStatusExporting KeyBackupStatus = "exporting"
+	// KeyBackupStatusExported requests produced their archive; requests are single use.
+	KeyBackupStatusExported KeyBackupStatus = "exported"
+	// KeyBackupStatusFailed requests were claimed but the export did not complete; operators start
+	// a new request rather than retrying with the old approvals.
+	KeyBackupStatusFailed KeyBackupStatus = "failed"
+)
+
+var (
+	errKeyBackupRequesterRequired   = errors.New("key backup request requires a requester")
+	errKeyBackupReasonRequired      = errors.New("key backup request requires a reason")
+	errKeyBackupRecipientRequired   = errors.New("key backup request requires a recipient public key")
+	errKeyBackupFingerprintInvalid  = errors.New("key backup recipient fingerprint must be a SHA-256 hex digest")
+	errKeyBackupQuorumInvalid       = errors.New("key backup quorum must be at least 2")
+	errKeyBackupExpiryBeforeCreated = errors.New("key backup request must expire after it is created")
+)
+
+// KeyBackupApproval records one operator's approval of a key backup request. Signature is the
+// approver's signature of the request ID and recipient fingerprint, checked against their key.
+type KeyBackupApproval struct {
+	Approver   string    `json:"approver"`
+	Signature  []byte    `json:"signature,omitempty"`
+	ApprovedAt time.Time `json:"approved_at"`
+}
+
+// KeyBackupRequest is an operator's request to export every wallet's encrypted key material for
+// disaster recovery. The archive is wrapped for RecipientPublicKey, whose private half is kept
+// offline, and can only be produced once Quorum distinct approvers other than the requester have
+// approved the request before ExpiresAt.
+type KeyBackupRequest struct {
+	ID                   uuid.UUID           `json:"id"`
+	RequestedBy          string              `json:"requested_by"`
+	Reason               string              `json:"reason"`
+	RecipientPublicKey   string              `json:"recipient_public_key"`
+	RecipientFingerprint string              `json:"recipient_fingerprint"`
+	Quorum               int                 `json:"quorum"`
+	Status               KeyBackupStatus     `json:"status"`
+	Approvals            []KeyBackupApproval `json:"approvals"`
+	ExportedBy           string              `json:"exported_by,omitempty"`
+	ArchiveSHA256        string              `json:"archive_sha256,omitempty"`
+	WalletCount          int                 `json:"wallet_count,omitempty"`
+	CreatedAt            time.Time           `json:"created_at"`
+	ExpiresAt            time.Time           `json:"expires_at"`
+	ExportedAt           *time.Time          `json:"exported_at,omitempty"`
+}
+
+// Validate performs basic validation on the KeyBackupRequest.
+func (r KeyBackupRequest) Validate() error {
+	var validationErr error
+
+	if strings.TrimSpace(r.RequestedBy) == "" {
+		validationErr = errors.Join(validationErr, errKeyBackupRequesterRequired)
+	}
+
+	if strings.TrimSpace(r.Reason) == "" {
+		validationErr = errors.Join(validationErr, errKeyBackupReasonRequired)
+	}
+
+	if strings.TrimSpace(r.RecipientPublicKey) == "" {
+		validationErr = errors.Join(validationErr, errKeyBackupRecipientRequired)
+	}
+
+	if !certFingerprintPattern.MatchString(r.RecipientFingerprint) {
+		validationErr = errors.Join(validationErr, errKeyBackupFingerprintInvalid)
+	}
+
+	if r.Quorum < 2 {
+		validationErr = errors.Join(validationErr, errKeyBackupQuorumInvalid)
+	}
+
+	if !r.ExpiresAt.After(r.CreatedAt) {
+		validationErr = errors.Join(validationErr, errKeyBackupExpiryBeforeCreated)
+	}
+
+	return validationErr
+}
+
+// IsExpired reports whether the request can no longer be approved or exported.
+func (r KeyBackupRequest) IsExpired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
+
+// ApprovedBy reports whether the operator has already approved the request.
+func (r KeyBackupRequest) ApprovedBy(operator string) bool {
+	for _, approval := range r.Approvals {
+		if approval.Approver == operator {
+			return true
+		}
+	}
+	return false
+}
+
+// WalletKeyRecord is one wallet's key material as it is stored: the private key stays encrypted
+// under the wallet encryption key inside a key backup archive. HD wallets have no private key of
+// their own; EncryptedSeed carries the owner's seed, from which the key is derived at
+// DerivationPath.
+type WalletKeyRecord struct {
+	WalletID            uuid.UUID `json:"wallet_id"`
+	UserID              uuid.UUID `json:"user_id"`
+	Chain               Chain     `json:"chain"`
+	Address             string    `json:"address"`
+	DerivationPath      string    `json:"derivation_path,omitempty"`
+	EncryptedPrivateKey string    `json:"encrypted_private_key"`
+	EncryptedSeed       string    `json:"encrypted_seed,omitempty"`
+}
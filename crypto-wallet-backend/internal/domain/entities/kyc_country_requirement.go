@@ -0,0 +1,86 @@
+package entities
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// KYCDefaultCountryCode keys the matrix entry applied to countries without their own entry.
+const KYCDefaultCountryCode = "*"
+
+var countryCodePattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+var (
+	errCountryRequirementCodeInvalid = errors.New("kyc country requirement: country code must be ISO 3166-1 alpha-2 or *")
+	errCountryRequirementDocInvalid  = errors.New("kyc country requirement: required document type is invalid")
+	errCountryRequirementLimitNeg    = errors.New("kyc country requirement: limits must be non-negative")
+	errCountryRequirementNotesLength = errors.New("kyc country requirement: notes are too long")
+)
+
+// KYCCountryRequirement is one row of the compliance matrix: what a nationality must provide
+// and the most it may transact. Zero limits mean the country adds no cap on top of the level limits.
+type KYCCountryRequirement struct {
+	CountryCode        string          `json:"country_code"`
+	RequiredDocuments  []DocumentType  `json:"required_documents"`
+	MaxDailyLimitUSD   decimal.Decimal `json:"max_daily_limit_usd"`
+	MaxMonthlyLimitUSD decimal.Decimal `json:"max_monthly_limit_usd"`
+	Blocked            bool            `json:"blocked"`
+	Notes              string          `json:"notes,omitempty"`
+	UpdatedBy          *uuid.UUID      `json:"updated_by,omitempty"`
+	UpdatedAt          time.Time       `json:"updated_at"`
+}
+
+// DefaultKYCCountryRequirement is applied when neither the country nor the * entry is configured.
+func DefaultKYCCountryRequirement(countryCode string) KYCCountryRequirement {
+	return KYCCountryRequirement{
+		CountryCode:       NormaliseCountryCode(countryCode),
+		RequiredDocuments: []DocumentType{DocumentTypePassport, DocumentTypeSelfie},
+	}
+}
+
+// NormaliseCountryCode upper-cases and trims a country code.
+func NormaliseCountryCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Validate performs basic validation on the KYCCountryRequirement.
+func (r KYCCountryRequirement) Validate() error {
+	var validationErr error
+
+	if r.CountryCode != KYCDefaultCountryCode && !countryCodePattern.MatchString(r.CountryCode) {
+		validationErr = errors.Join(validationErr, errCountryRequirementCodeInvalid)
+	}
+
+	for _, docType := range r.RequiredDocuments {
+		if !isValidDocumentType(docType) {
+			validationErr = errors.Join(validationErr, errCountryRequirementDocInvalid)
+			break
+		}
+	}
+
+	if r.MaxDailyLimitUSD.IsNegative() || r.MaxMonthlyLimitUSD.IsNegative() {
+		validationErr = errors.Join(validationErr, errCountryRequirementLimitNeg)
+	}
+
+	if len(r.Notes) > 2000 {
+		validationErr = errors.Join(validationErr, errCountryRequirementNotesLength)
+	}
+
+	return validationErr
+}
+
+// CapLimits applies the country's maximums to the supplied limits.
+func (r KYCCountryRequirement) CapLimits(daily, monthly decimal.Decimal) (decimal.Decimal, decimal.Decimal) {
+	if r.MaxDailyLimitUSD.IsPositive() && daily.GreaterThan(r.MaxDailyLimitUSD) {
+		daily = r.MaxDailyLimitUSD
+	}
+	if r.MaxMonthlyLimitUSD.IsPositive() && monthly.GreaterThan(r.MaxMonthlyLimitUSD) {
+		monthly = r.MaxMonthlyLimitUSD
+	}
+	return daily, monthly
+}
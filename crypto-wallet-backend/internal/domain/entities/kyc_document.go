@@ -28,6 +28,20 @@ const (
 	DocumentStatusRejected DocumentStatus = "rejected"
 )
 
+// DocumentScanStatus tracks an upload through malware scanning. Scanned uploads move from
+// quarantined to scanned to stored, or end as infected or scan_failed; documents uploaded
+// without a scanner are not_scanned.
+type DocumentScanStatus string
+
+const (
+	DocumentScanNotScanned  DocumentScanStatus = "not_scanned"
+	DocumentScanQuarantined DocumentScanStatus = "quarantined"
+	DocumentScanScanned     DocumentScanStatus = "scanned"
+	DocumentScanStored      DocumentScanStatus = "stored"
+	DocumentScanInfected    DocumentScanStatus = "infected"
+	DocumentScanFailed      DocumentScanStatus = "scan_failed"
+)
+
 var (
 	errKYCDocumentProfileIDRequired = errors.New("kyc document: profile ID is required")
 	errKYCDocumentTypeInvalid       = errors.New("kyc document: type is invalid")
@@ -37,6 +51,7 @@ var (
 	errKYCDocumentMimeRequired      = errors.New("kyc document: mime type is required")
 	errKYCDocumentSizeInvalid       = errors.New("kyc document: file size must be greater than zero")
 	errKYCDocumentStatusInvalid     = errors.New("kyc document: status is invalid")
+	errKYCDocumentScanStatusInvalid = errors.New("kyc document: scan status is invalid")
 )
 
 // KYCDocument exposes behaviours required by the application layer when working with documents.
@@ -57,9 +72,15 @@ type KYCDocument interface {
 	GetReviewedAt() *time.Time
 	GetRejectionReason() string
 	GetMetadata() map[string]any
+	GetScanStatus() DocumentScanStatus
+	GetScannedAt() *time.Time
 
 	MarkApproved(at time.Time)
 	MarkRejected(reason string, at time.Time)
+	MarkScanned(at time.Time)
+	MarkStored(encryptedFilePath string, at time.Time)
+	MarkInfected(signature string, at time.Time)
+	MarkScanFailed(at time.Time)
 	UpdateMetadata(metadata map[string]any)
 	SetStatus(status DocumentStatus) error
 	Touch(at time.Time)
@@ -80,6 +101,8 @@ type KYCDocumentEntity struct {
 	reviewedAt        *time.Time
 	rejectionReason   string
 	metadata          map[string]any
+	scanStatus        DocumentScanStatus
+	scannedAt         *time.Time
 	createdAt         time.Time
 	updatedAt         time.Time
 }
@@ -99,6 +122,8 @@ type KYCDocumentParams struct {
 	ReviewedAt        *time.Time
 	RejectionReason   string
 	Metadata          map[string]any
+	ScanStatus        DocumentScanStatus
+	ScannedAt         *time.Time
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
 }
@@ -120,6 +145,9 @@ func NewKYCDocumentEntity(params KYCDocumentParams) (*KYCDocumentEntity, error)
 	if params.Status == "" {
 		params.Status = DocumentStatusPending
 	}
+	if params.ScanStatus == "" {
+		params.ScanStatus = DocumentScanNotScanned
+	}
 
 	entity := &KYCDocumentEntity{
 		id:                params.ID,
@@ -135,6 +163,8 @@ func NewKYCDocumentEntity(params KYCDocumentParams) (*KYCDocumentEntity, error)
 		reviewedAt:        params.ReviewedAt,
 		rejectionReason:   strings.TrimSpace(params.RejectionReason),
 		metadata:          cloneMetadata(params.Metadata),
+		scanStatus:        params.ScanStatus,
+		scannedAt:         params.ScannedAt,
 		createdAt:         params.CreatedAt,
 		updatedAt:         params.UpdatedAt,
 	}
@@ -162,6 +192,8 @@ func HydrateKYCDocumentEntity(params KYCDocumentParams) *KYCDocumentEntity {
 		reviewedAt:        params.ReviewedAt,
 		rejectionReason:   strings.TrimSpace(params.RejectionReason),
 		metadata:          cloneMetadata(params.Metadata),
+		scanStatus:        params.ScanStatus,
+		scannedAt:         params.ScannedAt,
 		createdAt:         params.CreatedAt,
 		updatedAt:         params.UpdatedAt,
 	}
@@ -195,6 +227,9 @@ func (d *KYCDocumentEntity) Validate() error {
 	if !isValidDocumentStatus(d.status) {
 		validationErr = errors.Join(validationErr, errKYCDocumentStatusInvalid)
 	}
+	if !isValidDocumentScanStatus(d.scanStatus) {
+		validationErr = errors.Join(validationErr, errKYCDocumentScanStatusInvalid)
+	}
 	return validationErr
 }
 
@@ -252,6 +287,14 @@ func (d *KYCDocumentEntity) GetMetadata() map[string]any {
 	return cloneMetadata(d.metadata)
 }
 
+func (d *KYCDocumentEntity) GetScanStatus() DocumentScanStatus {
+	return d.scanStatus
+}
+
+func (d *KYCDocumentEntity) GetScannedAt() *time.Time {
+	return d.scannedAt
+}
+
 func (d *KYCDocumentEntity) GetCreatedAt() time.Time {
 	return d.createdAt
 }
@@ -278,6 +321,39 @@ func (d *KYCDocumentEntity) MarkRejected(reason string, at time.Time) {
 	d.Touch(t)
 }
 
+// MarkScanned records a clean scan verdict.
+func (d *KYCDocumentEntity) MarkScanned(at time.Time) {
+	t := normaliseTimestamp(at)
+	d.scanStatus = DocumentScanScanned
+	d.scannedAt = &t
+	d.Touch(t)
+}
+
+// MarkStored records where the scanned content was stored.
+func (d *KYCDocumentEntity) MarkStored(encryptedFilePath string, at time.Time) {
+	d.filePathEncrypted = strings.TrimSpace(encryptedFilePath)
+	d.scanStatus = DocumentScanStored
+	d.Touch(at)
+}
+
+// MarkInfected rejects a document the scanner found malware in.
+func (d *KYCDocumentEntity) MarkInfected(signature string, at time.Time) {
+	t := normaliseTimestamp(at)
+	d.scanStatus = DocumentScanInfected
+	d.scannedAt = &t
+	if d.metadata == nil {
+		d.metadata = make(map[string]any)
+	}
+	d.metadata["malwareSignature"] = strings.TrimSpace(signature)
+	d.MarkRejected("document failed the malware scan", t)
+}
+
+// MarkScanFailed rejects a document that could not be scanned; the user has to upload it again.
+func (d *KYCDocumentEntity) MarkScanFailed(at time.Time) {
+	d.scanStatus = DocumentScanFailed
+	d.MarkRejected("document could not be scanned; please upload it again", at)
+}
+
 func (d *KYCDocumentEntity) UpdateMetadata(metadata map[string]any) {
 	d.metadata = cloneMetadata(metadata)
 	d.Touch(time.Now().UTC())
@@ -318,6 +394,20 @@ func isValidDocumentStatus(status DocumentStatus) bool {
 	}
 }
 
+func isValidDocumentScanStatus(status DocumentScanStatus) bool {
+	switch status {
+	case DocumentScanNotScanned,
+		DocumentScanQuarantined,
+		DocumentScanScanned,
+		DocumentScanStored,
+		DocumentScanInfected,
+		DocumentScanFailed:
+		return true
+	default:
+		return false
+	}
+}
+
 func cloneMetadata(metadata map[string]any) map[string]any {
 	if metadata == nil {
 		return make(map[string]any)
@@ -0,0 +1,154 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// KYCDocumentMaxUploadBytes is the transport ceiling for a single document upload. Per-type limits may
+// only be lower.
+const KYCDocumentMaxUploadBytes = 10 * 1024 * 1024
+
+// KYCDocumentSide identifies which image of a document an upload carries.
+type KYCDocumentSide string
+
+const (
+	KYCDocumentSideFront              KYCDocumentSide = "front"
+	KYCDocumentSideBack               KYCDocumentSide = "back"
+	KYCDocumentSideSelfieWithDocument KYCDocumentSide = "selfie_with_document"
+)
+
+var (
+	errDocumentTypeSpecTypeInvalid = errors.New("kyc document type spec: document type is invalid")
+	errDocumentTypeSpecMimeMissing = errors.New("kyc document type spec: at least one mime type is required")
+	errDocumentTypeSpecSizeInvalid = errors.New("kyc document type spec: max size must be positive and within the upload limit")
+)
+
+// KYCDocumentTypeSpec describes how a document type must be captured. Labels maps a locale
+// (e.g. "en", "th") to the display name shown in upload forms.
+type KYCDocumentTypeSpec struct {
+	DocumentType               DocumentType      `json:"document_type"`
+	Labels                     map[string]string `json:"labels"`
+	AcceptedMimeTypes          []string          `json:"accepted_mime_types"`
+	MaxSizeBytes               int64             `json:"max_size_bytes"`
+	DoubleSided                bool              `json:"double_sided"`
+	RequiresSelfieWithDocument bool              `json:"requires_selfie_with_document"`
+	Enabled                    bool              `json:"enabled"`
+	UpdatedAt                  time.Time         `json:"updated_at"`
+}
+
+// DefaultKYCDocumentTypeSpecs returns the built-in specs used when no override is stored.
+func DefaultKYCDocumentTypeSpecs() []KYCDocumentTypeSpec {
+	images := []string{"image/jpeg", "image/png"}
+	imagesAndPDF := []string{"image/jpeg", "image/png", "application/pdf"}
+	return []KYCDocumentTypeSpec{
+		{
+			DocumentType:      DocumentTypePassport,
+			Labels:            map[string]string{"en": "Passport"},
+			AcceptedMimeTypes: imagesAndPDF,
+			MaxSizeBytes:      KYCDocumentMaxUploadBytes,
+			Enabled:           true,
+		},
+		{
+			DocumentType:      DocumentTypeNationalID,
+			Labels:            map[string]string{"en": "National ID card"},
+			AcceptedMimeTypes: images,
+			MaxSizeBytes:      KYCDocumentMaxUploadBytes,
+			DoubleSided:       true,
+			Enabled:           true,
+		},
+		{
+			DocumentType:      DocumentTypeDriversLicense,
+			Labels:            map[string]string{"en": "Driver's license"},
+			AcceptedMimeTypes: images,
+			MaxSizeBytes:      KYCDocumentMaxUploadBytes,
+			DoubleSided:       true,
+			Enabled:           true,
+		},
+		{
+			DocumentType:      DocumentTypeProofOfAddress,
+			Labels:            map[string]string{"en": "Proof of address"},
+			AcceptedMimeTypes: imagesAndPDF,
+			MaxSizeBytes:      KYCDocumentMaxUploadBytes,
+			Enabled:           true,
+		},
+		{
+			DocumentType:      DocumentTypeSelfie,
+			Labels:            map[string]string{"en": "Selfie"},
+			AcceptedMimeTypes: images,
+			MaxSizeBytes:      5 * 1024 * 1024,
+			Enabled:           true,
+		},
+	}
+}
+
+// Validate performs basic validation on the KYCDocumentTypeSpec.
+func (s KYCDocumentTypeSpec) Validate() error {
+	var validationErr error
+
+	if !isValidDocumentType(s.DocumentType) {
+		validationErr = errors.Join(validationErr, errDocumentTypeSpecTypeInvalid)
+	}
+	if len(s.AcceptedMimeTypes) == 0 {
+		validationErr = errors.Join(validationErr, errDocumentTypeSpecMimeMissing)
+	}
+	if s.MaxSizeBytes <= 0 || s.MaxSizeBytes > KYCDocumentMaxUploadBytes {
+		validationErr = errors.Join(validationErr, errDocumentTypeSpecSizeInvalid)
+	}
+
+	return validationErr
+}
+
+// AcceptsMimeType reports whether the mime type (parameters ignored) may be uploaded.
+func (s KYCDocumentTypeSpec) AcceptsMimeType(mimeType string) bool {
+	mimeType = strings.ToLower(strings.TrimSpace(mimeType))
+	if idx := strings.Index(mimeType, ";"); idx >= 0 {
+		mimeType = strings.TrimSpace(mimeType[:idx])
+	}
+	for _, accepted := range s.AcceptedMimeTypes {
+		if strings.EqualFold(accepted, mimeType) {
+			return true
+		}
+	}
+	return false
+}
+
+// Sides lists the images expected for the document type.
+func (s KYCDocumentTypeSpec) Sides() []KYCDocumentSide {
+	sides := []KYCDocumentSide{KYCDocumentSideFront}
+	if s.DoubleSided {
+		sides = append(sides, KYCDocumentSideBack)
+	}
+	if s.RequiresSelfieWithDocument {
+		sides = append(sides, KYCDocumentSideSelfieWithDocument)
+	}
+	return sides
+}
+
+// AcceptsSide reports whether an upload for the given side is expected.
+func (s KYCDocumentTypeSpec) AcceptsSide(side KYCDocumentSide) bool {
+	for _, candidate := range s.Sides() {
+		if candidate == side {
+			return true
+		}
+	}
+	return false
+}
+
+// Label returns the display name for a locale, falling back to English and then the type itself.
+func (s KYCDocumentTypeSpec) Label(locale string) string {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	if label, ok := s.Labels[locale]; ok && label != "" {
+		return label
+	}
+	if idx := strings.IndexAny(locale, "-_"); idx > 0 {
+		if label, ok := s.Labels[locale[:idx]]; ok && label != "" {
+			return label
+		}
+	}
+	if label, ok := s.Labels["en"]; ok && label != "" {
+		return label
+	}
+	return string(s.DocumentType)
+}
@@ -0,0 +1,34 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// KYCLimitChangeSource records what produced a limit snapshot.
+type KYCLimitChangeSource string
+
+const (
+	KYCLimitChangeProfile   KYCLimitChangeSource = "kyc_profile"
+	KYCLimitChangeRiskScore KYCLimitChangeSource = "risk_score"
+	KYCLimitChangeBackfill  KYCLimitChangeSource = "backfill"
+)
+
+// KYCLimitSnapshot is one append-only entry of a user's compliance history: the verification
+// level, status, limits and risk level in force from EffectiveAt until the next entry.
+// RiskLevel and RiskScore are nil when the user had not been risk scored.
+type KYCLimitSnapshot struct {
+	ID                int64                `json:"id"`
+	UserID            uuid.UUID            `json:"user_id"`
+	VerificationLevel VerificationLevel    `json:"verification_level"`
+	Status            KYCStatus            `json:"status"`
+	DailyLimitUSD     decimal.Decimal      `json:"daily_limit_usd"`
+	MonthlyLimitUSD   decimal.Decimal      `json:"monthly_limit_usd"`
+	RiskLevel         *RiskLevel           `json:"risk_level,omitempty"`
+	RiskScore         *int                 `json:"risk_score,omitempty"`
+	Source            KYCLimitChangeSource `json:"source"`
+	EffectiveAt       time.Time            `json:"effective_at"`
+	RecordedAt        time.Time            `json:"recorded_at"`
+}
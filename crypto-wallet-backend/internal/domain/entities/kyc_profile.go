@@ -37,8 +37,22 @@ var (
 	errKYCLimitNegative             = errors.New("kyc profile: limits must be non-negative")
 	errKYCTransitionInvalid         = errors.New("kyc profile: invalid status transition")
 	errKYCSubmissionTimestampNeeded = errors.New("kyc profile: submitted_at must be set when status is pending or under_review")
+	errKYCUpgradeNotApproved        = errors.New("kyc profile: only approved profiles can be upgraded")
+	errKYCUpgradeLevelInvalid       = errors.New("kyc profile: upgrade must target a higher verification level")
 )
 
+// KYCLimitsForLevel returns the daily and monthly USD limits granted at a verification level.
+func KYCLimitsForLevel(level VerificationLevel) (daily, monthly decimal.Decimal) {
+	switch level {
+	case VerificationLevelBasic:
+		return decimal.NewFromInt(5000), decimal.NewFromInt(50000)
+	case VerificationLevelFull:
+		return decimal.NewFromInt(50000), decimal.NewFromInt(500000)
+	default:
+		return decimal.Zero, decimal.Zero
+	}
+}
+
 // KYCProfile exposes the behaviours required by the application layer when working with KYC profiles.
 type KYCProfile interface {
 	Entity
@@ -372,6 +386,34 @@ func (k *KYCProfileEntity) MarkExpired(at time.Time) {
 	k.Touch(t)
 }
 
+// ExpireApproval moves an overdue approval to expired and drops the profile to unverified with no limits.
+// The original expiry timestamp is kept.
+func (k *KYCProfileEntity) ExpireApproval(at time.Time) error {
+	if err := k.SetStatus(KYCStatusExpired); err != nil {
+		return err
+	}
+	k.verificationLevel = VerificationLevelUnverified
+	k.dailyLimitUSD = decimal.Zero
+	k.monthlyLimitUSD = decimal.Zero
+	k.Touch(at)
+	return nil
+}
+
+// UpgradeVerificationLevel raises an approved profile to a higher level and applies that level's limits.
+// The approval itself, including its expiry, is left untouched.
+func (k *KYCProfileEntity) UpgradeVerificationLevel(level VerificationLevel, at time.Time) error {
+	if k.status != KYCStatusApproved {
+		return errKYCUpgradeNotApproved
+	}
+	if !isValidVerificationLevel(level) || verificationLevelRank(level) <= verificationLevelRank(k.verificationLevel) {
+		return errKYCUpgradeLevelInvalid
+	}
+	k.verificationLevel = level
+	k.dailyLimitUSD, k.monthlyLimitUSD = KYCLimitsForLevel(level)
+	k.Touch(at)
+	return nil
+}
+
 func (k *KYCProfileEntity) Reject(reason string, notes string) {
 	k.rejectionReason = strings.TrimSpace(reason)
 	k.reviewerNotes = strings.TrimSpace(notes)
@@ -408,6 +450,17 @@ func isValidVerificationLevel(level VerificationLevel) bool {
 	}
 }
 
+func verificationLevelRank(level VerificationLevel) int {
+	switch level {
+	case VerificationLevelBasic:
+		return 1
+	case VerificationLevelFull:
+		return 2
+	default:
+		return 0
+	}
+}
+
 func isValidKYCStatus(status KYCStatus) bool {
 	switch status {
 	case KYCStatusNotStarted,
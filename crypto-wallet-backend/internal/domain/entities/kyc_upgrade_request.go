@@ -0,0 +1,270 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// KYCUpgradeStatus models the review stage of a verification-level upgrade.
+type KYCUpgradeStatus string
+
+const (
+	KYCUpgradeStatusAwaitingDocuments KYCUpgradeStatus = "awaiting_documents"
+	KYCUpgradeStatusUnderReview       KYCUpgradeStatus = "under_review"
+	KYCUpgradeStatusApproved          KYCUpgradeStatus = "approved"
+	KYCUpgradeStatusRejected          KYCUpgradeStatus = "rejected"
+)
+
+var (
+	errKYCUpgradeProfileRequired = errors.New("kyc upgrade: profile ID is required")
+	errKYCUpgradeUserRequired    = errors.New("kyc upgrade: user ID is required")
+	errKYCUpgradeTargetInvalid   = errors.New("kyc upgrade: target level must be above the current level")
+	errKYCUpgradeStatusInvalid   = errors.New("kyc upgrade: status is invalid")
+	errKYCUpgradeDocumentsNeeded = errors.New("kyc upgrade: at least one required document must be listed")
+	errKYCUpgradeNotAwaiting     = errors.New("kyc upgrade: request is not awaiting documents")
+	errKYCUpgradeNotUnderReview  = errors.New("kyc upgrade: request is not under review")
+)
+
+// KYCUpgradeRequest exposes the behaviour required by the application layer when moving a
+// user between verification levels. The user's approved profile is only changed once the
+// upgrade itself is approved.
+type KYCUpgradeRequest interface {
+	Entity
+	Identifiable
+	Timestamped
+
+	GetProfileID() uuid.UUID
+	GetUserID() uuid.UUID
+	GetFromLevel() VerificationLevel
+	GetTargetLevel() VerificationLevel
+	GetStatus() KYCUpgradeStatus
+	GetRequiredDocuments() []DocumentType
+	GetProviderApplicationID() string
+	GetSubmittedAt() *time.Time
+	GetDecidedAt() *time.Time
+	GetRejectionReason() string
+	IsOpen() bool
+}
+
+// KYCUpgradeRequestEntity is the default implementation of the KYCUpgradeRequest interface.
+type KYCUpgradeRequestEntity struct {
+	id                    uuid.UUID
+	profileID             uuid.UUID
+	userID                uuid.UUID
+	fromLevel             VerificationLevel
+	targetLevel           VerificationLevel
+	status                KYCUpgradeStatus
+	requiredDocuments     []DocumentType
+	providerApplicationID string
+	submittedAt           *time.Time
+	decidedAt             *time.Time
+	rejectionReason       string
+	createdAt             time.Time
+	updatedAt             time.Time
+}
+
+// KYCUpgradeRequestParams captures the fields required to construct a KYCUpgradeRequestEntity.
+type KYCUpgradeRequestParams struct {
+	ID                    uuid.UUID
+	ProfileID             uuid.UUID
+	UserID                uuid.UUID
+	FromLevel             VerificationLevel
+	TargetLevel           VerificationLevel
+	Status                KYCUpgradeStatus
+	RequiredDocuments     []DocumentType
+	ProviderApplicationID string
+	SubmittedAt           *time.Time
+	DecidedAt             *time.Time
+	RejectionReason       string
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+// NewKYCUpgradeRequestEntity validates the supplied parameters and returns a new KYCUpgradeRequestEntity.
+func NewKYCUpgradeRequestEntity(params KYCUpgradeRequestParams) (*KYCUpgradeRequestEntity, error) {
+	if params.ID == uuid.Nil {
+		params.ID = uuid.New()
+	}
+
+	if params.CreatedAt.IsZero() {
+		params.CreatedAt = time.Now().UTC()
+	}
+
+	if params.UpdatedAt.IsZero() {
+		params.UpdatedAt = params.CreatedAt
+	}
+
+	if params.Status == "" {
+		params.Status = KYCUpgradeStatusAwaitingDocuments
+	}
+
+	entity := HydrateKYCUpgradeRequestEntity(params)
+
+	if err := entity.Validate(); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// HydrateKYCUpgradeRequestEntity creates a KYCUpgradeRequestEntity without re-validating invariants (used for repository hydration).
+func HydrateKYCUpgradeRequestEntity(params KYCUpgradeRequestParams) *KYCUpgradeRequestEntity {
+	required := make([]DocumentType, len(params.RequiredDocuments))
+	copy(required, params.RequiredDocuments)
+
+	return &KYCUpgradeRequestEntity{
+		id:                    params.ID,
+		profileID:             params.ProfileID,
+		userID:                params.UserID,
+		fromLevel:             params.FromLevel,
+		targetLevel:           params.TargetLevel,
+		status:                params.Status,
+		requiredDocuments:     required,
+		providerApplicationID: strings.TrimSpace(params.ProviderApplicationID),
+		submittedAt:           params.SubmittedAt,
+		decidedAt:             params.DecidedAt,
+		rejectionReason:       strings.TrimSpace(params.RejectionReason),
+		createdAt:             params.CreatedAt,
+		updatedAt:             params.UpdatedAt,
+	}
+}
+
+// Validate ensures the entity adheres to domain invariants.
+func (u *KYCUpgradeRequestEntity) Validate() error {
+	var validationErr error
+
+	if u.profileID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errKYCUpgradeProfileRequired)
+	}
+
+	if u.userID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errKYCUpgradeUserRequired)
+	}
+
+	if !isValidVerificationLevel(u.targetLevel) || verificationLevelRank(u.targetLevel) <= verificationLevelRank(u.fromLevel) {
+		validationErr = errors.Join(validationErr, errKYCUpgradeTargetInvalid)
+	}
+
+	if !isValidKYCUpgradeStatus(u.status) {
+		validationErr = errors.Join(validationErr, errKYCUpgradeStatusInvalid)
+	}
+
+	if len(u.requiredDocuments) == 0 {
+		validationErr = errors.Join(validationErr, errKYCUpgradeDocumentsNeeded)
+	}
+
+	return validationErr
+}
+
+func (u *KYCUpgradeRequestEntity) GetID() uuid.UUID {
+	return u.id
+}
+
+func (u *KYCUpgradeRequestEntity) GetProfileID() uuid.UUID {
+	return u.profileID
+}
+
+func (u *KYCUpgradeRequestEntity) GetUserID() uuid.UUID {
+	return u.userID
+}
+
+func (u *KYCUpgradeRequestEntity) GetFromLevel() VerificationLevel {
+	return u.fromLevel
+}
+
+func (u *KYCUpgradeRequestEntity) GetTargetLevel() VerificationLevel {
+	return u.targetLevel
+}
+
+func (u *KYCUpgradeRequestEntity) GetStatus() KYCUpgradeStatus {
+	return u.status
+}
+
+func (u *KYCUpgradeRequestEntity) GetRequiredDocuments() []DocumentType {
+	required := make([]DocumentType, len(u.requiredDocuments))
+	copy(required, u.requiredDocuments)
+	return required
+}
+
+func (u *KYCUpgradeRequestEntity) GetProviderApplicationID() string {
+	return u.providerApplicationID
+}
+
+func (u *KYCUpgradeRequestEntity) GetSubmittedAt() *time.Time {
+	return u.submittedAt
+}
+
+func (u *KYCUpgradeRequestEntity) GetDecidedAt() *time.Time {
+	return u.decidedAt
+}
+
+func (u *KYCUpgradeRequestEntity) GetRejectionReason() string {
+	return u.rejectionReason
+}
+
+func (u *KYCUpgradeRequestEntity) GetCreatedAt() time.Time {
+	return u.createdAt
+}
+
+func (u *KYCUpgradeRequestEntity) GetUpdatedAt() time.Time {
+	return u.updatedAt
+}
+
+// IsOpen reports whether the upgrade is still awaiting documents or a decision.
+func (u *KYCUpgradeRequestEntity) IsOpen() bool {
+	return u.status == KYCUpgradeStatusAwaitingDocuments || u.status == KYCUpgradeStatusUnderReview
+}
+
+// MarkSubmitted moves the upgrade to review once its documents are in.
+// applicationID references the provider re-screening, if any.
+func (u *KYCUpgradeRequestEntity) MarkSubmitted(applicationID string, at time.Time) error {
+	if u.status != KYCUpgradeStatusAwaitingDocuments {
+		return errKYCUpgradeNotAwaiting
+	}
+	t := normaliseTimestamp(at)
+	u.status = KYCUpgradeStatusUnderReview
+	u.providerApplicationID = strings.TrimSpace(applicationID)
+	u.submittedAt = &t
+	u.updatedAt = t
+	return nil
+}
+
+// Approve records a positive decision.
+func (u *KYCUpgradeRequestEntity) Approve(at time.Time) error {
+	if u.status != KYCUpgradeStatusUnderReview {
+		return errKYCUpgradeNotUnderReview
+	}
+	t := normaliseTimestamp(at)
+	u.status = KYCUpgradeStatusApproved
+	u.decidedAt = &t
+	u.updatedAt = t
+	return nil
+}
+
+// Reject records a negative decision; the user keeps their current level.
+func (u *KYCUpgradeRequestEntity) Reject(reason string, at time.Time) error {
+	if u.status != KYCUpgradeStatusUnderReview {
+		return errKYCUpgradeNotUnderReview
+	}
+	t := normaliseTimestamp(at)
+	u.status = KYCUpgradeStatusRejected
+	u.rejectionReason = strings.TrimSpace(reason)
+	u.decidedAt = &t
+	u.updatedAt = t
+	return nil
+}
+
+func isValidKYCUpgradeStatus(status KYCUpgradeStatus) bool {
+	switch status {
+	case KYCUpgradeStatusAwaitingDocuments,
+		KYCUpgradeStatusUnderReview,
+		KYCUpgradeStatusApproved,
+		KYCUpgradeStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,74 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationType categorises in-app notifications.
+type NotificationType string
+
+const (
+	NotificationTypeTransactionConfirmed NotificationType = "transaction_confirmed"
+	NotificationTypeExchangeCompleted    NotificationType = "exchange_completed"
+	NotificationTypeKYCStatusChanged     NotificationType = "kyc_status_changed"
+	NotificationTypeWalletDormancy       NotificationType = "wallet_dormancy"
+)
+
+// IsValid reports whether the type is recognised.
+func (t NotificationType) IsValid() bool {
+	switch t {
+	case NotificationTypeTransactionConfirmed, NotificationTypeExchangeCompleted, NotificationTypeKYCStatusChanged,
+		NotificationTypeWalletDormancy:
+		return true
+	default:
+		return false
+	}
+}
+
+var (
+	errNotificationUserIDRequired = errors.New("notification user ID is required")
+	errNotificationTypeInvalid    = errors.New("notification type is invalid")
+	errNotificationTitleRequired  = errors.New("notification title is required")
+)
+
+// Notification is a message shown in the user's in-app inbox. Event is the domain event that
+// produced it and Data its payload, so clients can link to the related record.
+type Notification struct {
+	ID        uuid.UUID        `json:"id"`
+	UserID    uuid.UUID        `json:"user_id"`
+	Type      NotificationType `json:"type"`
+	Event     string           `json:"event"`
+	Title     string           `json:"title"`
+	Body      string           `json:"body"`
+	Data      map[string]any   `json:"data,omitempty"`
+	ReadAt    *time.Time       `json:"read_at,omitempty"`
+	CreatedAt time.Time        `json:"created_at"`
+}
+
+// Validate ensures the notification adheres to domain invariants.
+func (n Notification) Validate() error {
+	var validationErr error
+
+	if n.UserID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errNotificationUserIDRequired)
+	}
+
+	if !n.Type.IsValid() {
+		validationErr = errors.Join(validationErr, errNotificationTypeInvalid)
+	}
+
+	if strings.TrimSpace(n.Title) == "" {
+		validationErr = errors.Join(validationErr, errNotificationTitleRequired)
+	}
+
+	return validationErr
+}
+
+// IsRead reports whether the user has read the notification.
+func (n Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
@@ -0,0 +1,379 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// P2PDisputeStatus enumerates the lifecycle states of a dispute raised on a P2P transfer.
+type P2PDisputeStatus string
+
+const (
+	P2PDisputeStatusOpen     P2PDisputeStatus = "open"
+	P2PDisputeStatusHeld     P2PDisputeStatus = "held"
+	P2PDisputeStatusResolved P2PDisputeStatus = "resolved"
+)
+
+// P2PDisputeReason classifies why a party disputed a transfer.
+type P2PDisputeReason string
+
+const (
+	P2PDisputeReasonUnauthorized   P2PDisputeReason = "unauthorized"
+	P2PDisputeReasonWrongRecipient P2PDisputeReason = "wrong_recipient"
+	P2PDisputeReasonWrongAmount    P2PDisputeReason = "wrong_amount"
+	P2PDisputeReasonNotDelivered   P2PDisputeReason = "goods_not_delivered"
+	P2PDisputeReasonOther          P2PDisputeReason = "other"
+)
+
+// P2PDisputeOutcome documents how support resolved a dispute.
+type P2PDisputeOutcome string
+
+const (
+	// P2PDisputeOutcomeRefundSender charges the amount back from the recipient to the sender.
+	P2PDisputeOutcomeRefundSender P2PDisputeOutcome = "refund_sender"
+	// P2PDisputeOutcomeNoAction keeps the transfer as settled and releases any hold to the recipient.
+	P2PDisputeOutcomeNoAction P2PDisputeOutcome = "no_action"
+)
+
+// P2PDisputeAction names the state changes recorded in a dispute's audit trail.
+type P2PDisputeAction string
+
+const (
+	P2PDisputeActionOpened   P2PDisputeAction = "opened"
+	P2PDisputeActionHeld     P2PDisputeAction = "held"
+	P2PDisputeActionResolved P2PDisputeAction = "resolved"
+)
+
+// DefaultP2PDisputeWindow is how long after settlement either party may open a dispute.
+const DefaultP2PDisputeWindow = 30 * 24 * time.Hour
+
+var (
+	errDisputeTransferRequired   = errors.New("p2p dispute transfer ID is required")
+	errDisputeOpenerRequired     = errors.New("p2p dispute opener is required")
+	errDisputePartiesRequired    = errors.New("p2p dispute sender and recipient are required")
+	errDisputeOpenerNotParty     = errors.New("p2p dispute must be opened by the sender or recipient")
+	errDisputeReasonInvalid      = errors.New("p2p dispute reason is invalid")
+	errDisputeDescriptionLength  = errors.New("p2p dispute description is too long")
+	errDisputeStatusInvalid      = errors.New("p2p dispute status is invalid")
+	errDisputeNotOpen            = errors.New("p2p dispute is not open")
+	errDisputeAlreadyResolved    = errors.New("p2p dispute is already resolved")
+	errDisputeOutcomeInvalid     = errors.New("p2p dispute outcome is invalid")
+	errDisputeResolutionRequired = errors.New("p2p dispute resolution note is required")
+	errDisputeActorRequired      = errors.New("p2p dispute actor is required")
+)
+
+const maxDisputeTextLength = 2000
+
+// P2PDispute exposes the behavior required by the application layer when working with transfer disputes.
+type P2PDispute interface {
+	Entity
+	Identifiable
+	Timestamped
+
+	GetTransferID() uuid.UUID
+	GetOpenedBy() uuid.UUID
+	GetSenderUserID() uuid.UUID
+	GetRecipientUserID() uuid.UUID
+	GetReason() P2PDisputeReason
+	GetDescription() string
+	GetStatus() P2PDisputeStatus
+	GetOutcome() P2PDisputeOutcome
+	GetResolutionNote() string
+	GetHeldAt() *time.Time
+	GetResolvedBy() *uuid.UUID
+	GetResolvedAt() *time.Time
+}
+
+// P2PDisputeEntity is the default implementation of the P2PDispute interface.
+type P2PDisputeEntity struct {
+	id              uuid.UUID
+	transferID      uuid.UUID
+	openedBy        uuid.UUID
+	senderUserID    uuid.UUID
+	recipientUserID uuid.UUID
+	reason          P2PDisputeReason
+	description     string
+	status          P2PDisputeStatus
+	outcome         P2PDisputeOutcome
+	resolutionNote  string
+	heldAt          *time.Time
+	resolvedBy      *uuid.UUID
+	resolvedAt      *time.Time
+	createdAt       time.Time
+	updatedAt       time.Time
+}
+
+// P2PDisputeParams captures the fields required to construct a P2PDisputeEntity.
+type P2PDisputeParams struct {
+	ID              uuid.UUID
+	TransferID      uuid.UUID
+	OpenedBy        uuid.UUID
+	SenderUserID    uuid.UUID
+	RecipientUserID uuid.UUID
+	Reason          P2PDisputeReason
+	Description     string
+	Status          P2PDisputeStatus
+	Outcome         P2PDisputeOutcome
+	ResolutionNote  string
+	HeldAt          *time.Time
+	ResolvedBy      *uuid.UUID
+	ResolvedAt      *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// P2PDisputeEvent is an append-only audit record of a dispute state change.
+type P2PDisputeEvent struct {
+	ID        uuid.UUID        `json:"id"`
+	DisputeID uuid.UUID        `json:"dispute_id"`
+	ActorID   uuid.UUID        `json:"actor_id"`
+	Action    P2PDisputeAction `json:"action"`
+	Note      string           `json:"note,omitempty"`
+	CreatedAt time.Time        `json:"created_at"`
+}
+
+// NewP2PDisputeEntity validates the supplied parameters and returns a new P2PDisputeEntity instance.
+func NewP2PDisputeEntity(params P2PDisputeParams) (*P2PDisputeEntity, error) {
+	if params.ID == uuid.Nil {
+		params.ID = uuid.New()
+	}
+
+	if params.CreatedAt.IsZero() {
+		params.CreatedAt = time.Now().UTC()
+	}
+
+	if params.UpdatedAt.IsZero() {
+		params.UpdatedAt = params.CreatedAt
+	}
+
+	if params.Status == "" {
+		params.Status = P2PDisputeStatusOpen
+	}
+
+	entity := HydrateP2PDisputeEntity(params)
+
+	if err := entity.Validate(); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// HydrateP2PDisputeEntity creates a P2PDisputeEntity without re-validating invariants (used for repository hydration).
+func HydrateP2PDisputeEntity(params P2PDisputeParams) *P2PDisputeEntity {
+	return &P2PDisputeEntity{
+		id:              params.ID,
+		transferID:      params.TransferID,
+		openedBy:        params.OpenedBy,
+		senderUserID:    params.SenderUserID,
+		recipientUserID: params.RecipientUserID,
+		reason:          params.Reason,
+		description:     strings.TrimSpace(params.Description),
+		status:          params.Status,
+		outcome:         params.Outcome,
+		resolutionNote:  strings.TrimSpace(params.ResolutionNote),
+		heldAt:          params.HeldAt,
+		resolvedBy:      params.ResolvedBy,
+		resolvedAt:      params.ResolvedAt,
+		createdAt:       params.CreatedAt,
+		updatedAt:       params.UpdatedAt,
+	}
+}
+
+// Validate ensures the entity adheres to domain invariants.
+func (d *P2PDisputeEntity) Validate() error {
+	var validationErr error
+
+	if d.transferID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errDisputeTransferRequired)
+	}
+
+	if d.openedBy == uuid.Nil {
+		validationErr = errors.Join(validationErr, errDisputeOpenerRequired)
+	}
+
+	if d.senderUserID == uuid.Nil || d.recipientUserID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errDisputePartiesRequired)
+	} else if !d.IsParty(d.openedBy) {
+		validationErr = errors.Join(validationErr, errDisputeOpenerNotParty)
+	}
+
+	if !IsValidP2PDisputeReason(d.reason) {
+		validationErr = errors.Join(validationErr, errDisputeReasonInvalid)
+	}
+
+	if len([]rune(d.description)) > maxDisputeTextLength {
+		validationErr = errors.Join(validationErr, errDisputeDescriptionLength)
+	}
+
+	if !isValidP2PDisputeStatus(d.status) {
+		validationErr = errors.Join(validationErr, errDisputeStatusInvalid)
+	}
+
+	return validationErr
+}
+
+// Getter implementations satisfy the P2PDispute interface.
+
+func (d *P2PDisputeEntity) GetID() uuid.UUID {
+	return d.id
+}
+
+func (d *P2PDisputeEntity) GetTransferID() uuid.UUID {
+	return d.transferID
+}
+
+func (d *P2PDisputeEntity) GetOpenedBy() uuid.UUID {
+	return d.openedBy
+}
+
+func (d *P2PDisputeEntity) GetSenderUserID() uuid.UUID {
+	return d.senderUserID
+}
+
+func (d *P2PDisputeEntity) GetRecipientUserID() uuid.UUID {
+	return d.recipientUserID
+}
+
+func (d *P2PDisputeEntity) GetReason() P2PDisputeReason {
+	return d.reason
+}
+
+func (d *P2PDisputeEntity) GetDescription() string {
+	return d.description
+}
+
+func (d *P2PDisputeEntity) GetStatus() P2PDisputeStatus {
+	return d.status
+}
+
+func (d *P2PDisputeEntity) GetOutcome() P2PDisputeOutcome {
+	return d.outcome
+}
+
+func (d *P2PDisputeEntity) GetResolutionNote() string {
+	return d.resolutionNote
+}
+
+func (d *P2PDisputeEntity) GetHeldAt() *time.Time {
+	return d.heldAt
+}
+
+func (d *P2PDisputeEntity) GetResolvedBy() *uuid.UUID {
+	return d.resolvedBy
+}
+
+func (d *P2PDisputeEntity) GetResolvedAt() *time.Time {
+	return d.resolvedAt
+}
+
+func (d *P2PDisputeEntity) GetCreatedAt() time.Time {
+	return d.createdAt
+}
+
+func (d *P2PDisputeEntity) GetUpdatedAt() time.Time {
+	return d.updatedAt
+}
+
+// Domain behavior helpers.
+
+// IsParty reports whether the user is the sender or recipient of the disputed transfer.
+func (d *P2PDisputeEntity) IsParty(userID uuid.UUID) bool {
+	return userID == d.senderUserID || userID == d.recipientUserID
+}
+
+// IsHeld reports whether the transfer amount is currently held from the recipient.
+func (d *P2PDisputeEntity) IsHeld() bool {
+	return d.status == P2PDisputeStatusHeld
+}
+
+// HoldTransactionHash returns the synthetic ledger hash recorded when funds are held.
+func (d *P2PDisputeEntity) HoldTransactionHash() string {
+	return "p2p-dispute:" + d.id.String() + ":hold"
+}
+
+// ChargebackTransactionHash returns the synthetic ledger hash recorded when unheld funds are charged back.
+func (d *P2PDisputeEntity) ChargebackTransactionHash() string {
+	return "p2p-dispute:" + d.id.String() + ":chargeback"
+}
+
+// RefundTransactionHash returns the synthetic ledger hash recorded when the sender is refunded.
+func (d *P2PDisputeEntity) RefundTransactionHash() string {
+	return "p2p-dispute:" + d.id.String() + ":refund"
+}
+
+// ReleaseTransactionHash returns the synthetic ledger hash recorded when held funds are released.
+func (d *P2PDisputeEntity) ReleaseTransactionHash() string {
+	return "p2p-dispute:" + d.id.String() + ":release"
+}
+
+// MarkHeld records that support has placed the disputed amount on hold.
+func (d *P2PDisputeEntity) MarkHeld(at time.Time) error {
+	if d.status != P2PDisputeStatusOpen {
+		return errDisputeNotOpen
+	}
+	d.status = P2PDisputeStatusHeld
+	d.heldAt = &at
+	d.updatedAt = at
+	return nil
+}
+
+// Resolve closes the dispute with a documented outcome.
+func (d *P2PDisputeEntity) Resolve(outcome P2PDisputeOutcome, note string, actorID uuid.UUID, at time.Time) error {
+	if d.status == P2PDisputeStatusResolved {
+		return errDisputeAlreadyResolved
+	}
+	if !IsValidP2PDisputeOutcome(outcome) {
+		return errDisputeOutcomeInvalid
+	}
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return errDisputeResolutionRequired
+	}
+	if len([]rune(note)) > maxDisputeTextLength {
+		return errDisputeDescriptionLength
+	}
+	if actorID == uuid.Nil {
+		return errDisputeActorRequired
+	}
+	d.status = P2PDisputeStatusResolved
+	d.outcome = outcome
+	d.resolutionNote = note
+	d.resolvedBy = &actorID
+	d.resolvedAt = &at
+	d.updatedAt = at
+	return nil
+}
+
+// IsValidP2PDisputeReason reports whether the reason is recognised.
+func IsValidP2PDisputeReason(reason P2PDisputeReason) bool {
+	switch reason {
+	case P2PDisputeReasonUnauthorized, P2PDisputeReasonWrongRecipient, P2PDisputeReasonWrongAmount,
+		P2PDisputeReasonNotDelivered, P2PDisputeReasonOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsValidP2PDisputeOutcome reports whether the outcome is recognised.
+func IsValidP2PDisputeOutcome(outcome P2PDisputeOutcome) bool {
+	switch outcome {
+	case P2PDisputeOutcomeRefundSender, P2PDisputeOutcomeNoAction:
+		return true
+	default:
+		return false
+	}
+}
+
+func isValidP2PDisputeStatus(status P2PDisputeStatus) bool {
+	switch status {
+	case P2PDisputeStatusOpen, P2PDisputeStatusHeld, P2PDisputeStatusResolved:
+		return true
+	default:
+		return false
+	}
+}
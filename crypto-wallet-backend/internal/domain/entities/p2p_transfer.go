@@ -0,0 +1,324 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// P2PTransferStatus enumerates the lifecycle states of an off-chain transfer between users.
+type P2PTransferStatus string
+
+const (
+	P2PTransferStatusCompleted    P2PTransferStatus = "completed"
+	P2PTransferStatusPendingClaim P2PTransferStatus = "pending_claim"
+	P2PTransferStatusReturned     P2PTransferStatus = "returned"
+)
+
+// DefaultP2PClaimWindow is how long funds sent to an unregistered recipient are held before being returned.
+const DefaultP2PClaimWindow = 7 * 24 * time.Hour
+
+var (
+	errP2PSenderUserIDRequired   = errors.New("p2p transfer sender user ID is required")
+	errP2PSenderWalletIDRequired = errors.New("p2p transfer sender wallet ID is required")
+	errP2PRecipientRequired      = errors.New("p2p transfer recipient email is required")
+	errP2PSelfTransfer           = errors.New("p2p transfer sender and recipient cannot be the same user")
+	errP2PChainInvalid           = errors.New("p2p transfer chain is invalid")
+	errP2PAmountInvalid          = errors.New("p2p transfer amount must be positive")
+	errP2PStatusInvalid          = errors.New("p2p transfer status is invalid")
+	errP2PClaimRequiresExpiry    = errors.New("p2p transfer pending claim requires an expiry")
+	errP2PNotPendingClaim        = errors.New("p2p transfer is not awaiting a claim")
+)
+
+// P2PTransfer exposes the behavior required by the application layer when working with P2P transfers.
+type P2PTransfer interface {
+	Entity
+	Identifiable
+	Timestamped
+
+	GetSenderUserID() uuid.UUID
+	GetSenderWalletID() uuid.UUID
+	GetRecipientUserID() *uuid.UUID
+	GetRecipientWalletID() *uuid.UUID
+	GetRecipientEmail() string
+	GetRecipientHandle() string
+	GetChain() Chain
+	GetAmount() decimal.Decimal
+	GetNote() string
+	GetStatus() P2PTransferStatus
+	GetClaimCodeHash() string
+	GetExpiresAt() *time.Time
+	GetSettledAt() *time.Time
+}
+
+// P2PTransferEntity is the default implementation of the P2PTransfer interface.
+type P2PTransferEntity struct {
+	id                uuid.UUID
+	senderUserID      uuid.UUID
+	senderWalletID    uuid.UUID
+	recipientUserID   *uuid.UUID
+	recipientWalletID *uuid.UUID
+	recipientEmail    string
+	recipientHandle   string
+	chain             Chain
+	amount            decimal.Decimal
+	note              string
+	status            P2PTransferStatus
+	claimCodeHash     string
+	expiresAt         *time.Time
+	settledAt         *time.Time
+	createdAt         time.Time
+	updatedAt         time.Time
+}
+
+// P2PTransferParams captures the fields required to construct a P2PTransferEntity.
+type P2PTransferParams struct {
+	ID                uuid.UUID
+	SenderUserID      uuid.UUID
+	SenderWalletID    uuid.UUID
+	RecipientUserID   *uuid.UUID
+	RecipientWalletID *uuid.UUID
+	RecipientEmail    string
+	RecipientHandle   string
+	Chain             Chain
+	Amount            decimal.Decimal
+	Note              string
+	Status            P2PTransferStatus
+	ClaimCodeHash     string
+	ExpiresAt         *time.Time
+	SettledAt         *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// NewP2PTransferEntity validates the supplied parameters and returns a new P2PTransferEntity instance.
+func NewP2PTransferEntity(params P2PTransferParams) (*P2PTransferEntity, error) {
+	if params.ID == uuid.Nil {
+		params.ID = uuid.New()
+	}
+
+	if params.CreatedAt.IsZero() {
+		params.CreatedAt = time.Now().UTC()
+	}
+
+	if params.UpdatedAt.IsZero() {
+		params.UpdatedAt = params.CreatedAt
+	}
+
+	entity := HydrateP2PTransferEntity(params)
+
+	if entity.status == "" {
+		entity.status = P2PTransferStatusCompleted
+		if entity.recipientUserID == nil {
+			entity.status = P2PTransferStatusPendingClaim
+		}
+	}
+
+	if entity.status == P2PTransferStatusPendingClaim && entity.expiresAt == nil {
+		expiresAt := entity.createdAt.Add(DefaultP2PClaimWindow)
+		entity.expiresAt = &expiresAt
+	}
+
+	if entity.status == P2PTransferStatusCompleted && entity.settledAt == nil {
+		settledAt := entity.createdAt
+		entity.settledAt = &settledAt
+	}
+
+	if err := entity.Validate(); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// HydrateP2PTransferEntity creates a P2PTransferEntity without re-validating invariants (used for repository hydration).
+func HydrateP2PTransferEntity(params P2PTransferParams) *P2PTransferEntity {
+	return &P2PTransferEntity{
+		id:                params.ID,
+		senderUserID:      params.SenderUserID,
+		senderWalletID:    params.SenderWalletID,
+		recipientUserID:   params.RecipientUserID,
+		recipientWalletID: params.RecipientWalletID,
+		recipientEmail:    strings.ToLower(strings.TrimSpace(params.RecipientEmail)),
+		recipientHandle:   NormalizeHandle(params.RecipientHandle),
+		chain:             NormalizeChain(string(params.Chain)),
+		amount:            params.Amount,
+		note:              strings.TrimSpace(params.Note),
+		status:            params.Status,
+		claimCodeHash:     strings.TrimSpace(params.ClaimCodeHash),
+		expiresAt:         params.ExpiresAt,
+		settledAt:         params.SettledAt,
+		createdAt:         params.CreatedAt,
+		updatedAt:         params.UpdatedAt,
+	}
+}
+
+// Validate ensures the entity adheres to domain invariants.
+func (t *P2PTransferEntity) Validate() error {
+	var validationErr error
+
+	if t.senderUserID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errP2PSenderUserIDRequired)
+	}
+
+	if t.senderWalletID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errP2PSenderWalletIDRequired)
+	}
+
+	if t.recipientEmail == "" {
+		validationErr = errors.Join(validationErr, errP2PRecipientRequired)
+	}
+
+	if t.recipientUserID != nil && *t.recipientUserID == t.senderUserID {
+		validationErr = errors.Join(validationErr, errP2PSelfTransfer)
+	}
+
+	if !isValidChain(t.chain) {
+		validationErr = errors.Join(validationErr, errP2PChainInvalid)
+	}
+
+	if t.amount.LessThanOrEqual(decimal.Zero) {
+		validationErr = errors.Join(validationErr, errP2PAmountInvalid)
+	}
+
+	if !isValidP2PTransferStatus(t.status) {
+		validationErr = errors.Join(validationErr, errP2PStatusInvalid)
+	}
+
+	if t.status == P2PTransferStatusPendingClaim && t.expiresAt == nil {
+		validationErr = errors.Join(validationErr, errP2PClaimRequiresExpiry)
+	}
+
+	return validationErr
+}
+
+// Getter implementations satisfy the P2PTransfer interface.
+
+func (t *P2PTransferEntity) GetID() uuid.UUID {
+	return t.id
+}
+
+func (t *P2PTransferEntity) GetSenderUserID() uuid.UUID {
+	return t.senderUserID
+}
+
+func (t *P2PTransferEntity) GetSenderWalletID() uuid.UUID {
+	return t.senderWalletID
+}
+
+func (t *P2PTransferEntity) GetRecipientUserID() *uuid.UUID {
+	return t.recipientUserID
+}
+
+func (t *P2PTransferEntity) GetRecipientWalletID() *uuid.UUID {
+	return t.recipientWalletID
+}
+
+func (t *P2PTransferEntity) GetRecipientEmail() string {
+	return t.recipientEmail
+}
+
+func (t *P2PTransferEntity) GetRecipientHandle() string {
+	return t.recipientHandle
+}
+
+func (t *P2PTransferEntity) GetChain() Chain {
+	return t.chain
+}
+
+func (t *P2PTransferEntity) GetAmount() decimal.Decimal {
+	return t.amount
+}
+
+func (t *P2PTransferEntity) GetNote() string {
+	return t.note
+}
+
+func (t *P2PTransferEntity) GetStatus() P2PTransferStatus {
+	return t.status
+}
+
+func (t *P2PTransferEntity) GetClaimCodeHash() string {
+	return t.claimCodeHash
+}
+
+func (t *P2PTransferEntity) GetExpiresAt() *time.Time {
+	return t.expiresAt
+}
+
+func (t *P2PTransferEntity) GetSettledAt() *time.Time {
+	return t.settledAt
+}
+
+func (t *P2PTransferEntity) GetCreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t *P2PTransferEntity) GetUpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+// Domain behavior helpers.
+
+// SenderTransactionHash returns the synthetic ledger hash recorded on the sender's wallet.
+func (t *P2PTransferEntity) SenderTransactionHash() string {
+	return "p2p:" + t.id.String() + ":send"
+}
+
+// RecipientTransactionHash returns the synthetic ledger hash recorded on the recipient's wallet.
+func (t *P2PTransferEntity) RecipientTransactionHash() string {
+	return "p2p:" + t.id.String() + ":receive"
+}
+
+// IsClaimExpired reports whether a held transfer can no longer be claimed.
+func (t *P2PTransferEntity) IsClaimExpired(now time.Time) bool {
+	return t.status == P2PTransferStatusPendingClaim && t.expiresAt != nil && !now.Before(*t.expiresAt)
+}
+
+// MarkClaimed settles a held transfer into the recipient's wallet.
+func (t *P2PTransferEntity) MarkClaimed(userID, walletID uuid.UUID, at time.Time) error {
+	if t.status != P2PTransferStatusPendingClaim {
+		return errP2PNotPendingClaim
+	}
+	if userID == t.senderUserID {
+		return errP2PSelfTransfer
+	}
+	t.recipientUserID = &userID
+	t.recipientWalletID = &walletID
+	t.status = P2PTransferStatusCompleted
+	t.settledAt = &at
+	t.updatedAt = at
+	return nil
+}
+
+// MarkReturned records that held funds were credited back to the sender.
+func (t *P2PTransferEntity) MarkReturned(at time.Time) error {
+	if t.status != P2PTransferStatusPendingClaim {
+		return errP2PNotPendingClaim
+	}
+	t.status = P2PTransferStatusReturned
+	t.settledAt = &at
+	t.updatedAt = at
+	return nil
+}
+
+// Touch refreshes the updatedAt timestamp.
+func (t *P2PTransferEntity) Touch(at time.Time) {
+	if at.IsZero() {
+		t.updatedAt = time.Now().UTC()
+		return
+	}
+	t.updatedAt = at
+}
+
+func isValidP2PTransferStatus(status P2PTransferStatus) bool {
+	switch status {
+	case P2PTransferStatusCompleted, P2PTransferStatusPendingClaim, P2PTransferStatusReturned:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxPartnerKYCBatchSize caps how many user references a partner may query in one request.
+const MaxPartnerKYCBatchSize = 500
+
+// Partner is a white-label partner that onboards users under its own references.
+type Partner struct {
+	ID         uuid.UUID `json:"id"`
+	Name       string    `json:"name"`
+	APIKeyHash string    `json:"-"`
+	IsActive   bool      `json:"is_active"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// PartnerUserLink maps a partner's own user reference to a platform user. A partner can only see
+// users linked to it.
+type PartnerUserLink struct {
+	PartnerID uuid.UUID `json:"partner_id"`
+	UserRef   string    `json:"user_ref"`
+	UserID    uuid.UUID `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// HashPartnerAPIKey derives the lookup hash stored in place of a partner API key.
+func HashPartnerAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(key)))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,242 @@
+package entities
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// HandleChangeCooldown limits how often a user may change their handle.
+	HandleChangeCooldown = 30 * 24 * time.Hour
+	// HandleReleaseHold keeps a released handle unavailable to other users so it cannot be used for impersonation.
+	HandleReleaseHold = 90 * 24 * time.Hour
+
+	maxDisplayNameLength = 60
+)
+
+var (
+	handlePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{2,29}$`)
+
+	// reservedHandles cannot be claimed by users because they imply platform authority.
+	reservedHandles = map[string]struct{}{
+		"admin": {}, "administrator": {}, "api": {}, "atlas": {}, "billing": {},
+		"compliance": {}, "help": {}, "info": {}, "moderator": {}, "official": {},
+		"operator": {}, "payments": {}, "root": {}, "security": {}, "staff": {},
+		"support": {}, "system": {}, "team": {}, "wallet": {},
+	}
+
+	errHandleInvalid            = errors.New("handle must be 3-30 characters, start with a letter and contain only lowercase letters, digits or single underscores")
+	errHandleReserved           = errors.New("handle is reserved")
+	errProfileUserIDRequired    = errors.New("payment profile user ID is required")
+	errProfileDisplayNameLength = errors.New("payment profile display name is too long")
+	errProfileAssetInvalid      = errors.New("payment profile accepted asset is not supported")
+	errHandleChangeCooldown     = errors.New("handle was changed recently")
+)
+
+// NormalizeHandle lowercases a handle and strips a leading "@".
+func NormalizeHandle(value string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(value), "@"))
+}
+
+// ValidateHandle checks a normalized handle against format and reservation rules.
+func ValidateHandle(handle string) error {
+	if !handlePattern.MatchString(handle) || strings.Contains(handle, "__") || strings.HasSuffix(handle, "_") {
+		return errHandleInvalid
+	}
+	if IsReservedHandle(handle) {
+		return errHandleReserved
+	}
+	return nil
+}
+
+// IsReservedHandle reports whether the normalized handle is reserved for the platform.
+func IsReservedHandle(handle string) bool {
+	_, reserved := reservedHandles[handle]
+	return reserved
+}
+
+// PaymentProfile exposes a user's handle and optional public payment details.
+type PaymentProfile interface {
+	Entity
+	Timestamped
+
+	GetUserID() uuid.UUID
+	GetHandle() string
+	GetDisplayName() string
+	GetAcceptedAssets() []Chain
+	IsPublic() bool
+	AllowsHandlePayments() bool
+	GetHandleChangedAt() time.Time
+}
+
+// PaymentProfileEntity is the default implementation of the PaymentProfile interface.
+type PaymentProfileEntity struct {
+	userID              uuid.UUID
+	handle              string
+	displayName         string
+	acceptedAssets      []Chain
+	public              bool
+	allowHandlePayments bool
+	handleChangedAt     time.Time
+	createdAt           time.Time
+	updatedAt           time.Time
+}
+
+// PaymentProfileParams captures the fields required to construct a PaymentProfileEntity.
+type PaymentProfileParams struct {
+	UserID              uuid.UUID
+	Handle              string
+	DisplayName         string
+	AcceptedAssets      []Chain
+	Public              bool
+	AllowHandlePayments bool
+	HandleChangedAt     time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// NewPaymentProfileEntity validates the supplied parameters and returns a new PaymentProfileEntity instance.
+func NewPaymentProfileEntity(params PaymentProfileParams) (*PaymentProfileEntity, error) {
+	if params.CreatedAt.IsZero() {
+		params.CreatedAt = time.Now().UTC()
+	}
+
+	if params.UpdatedAt.IsZero() {
+		params.UpdatedAt = params.CreatedAt
+	}
+
+	if params.HandleChangedAt.IsZero() {
+		params.HandleChangedAt = params.CreatedAt
+	}
+
+	entity := HydratePaymentProfileEntity(params)
+
+	if err := entity.Validate(); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// HydratePaymentProfileEntity creates a PaymentProfileEntity without re-validating invariants (used for repository hydration).
+func HydratePaymentProfileEntity(params PaymentProfileParams) *PaymentProfileEntity {
+	return &PaymentProfileEntity{
+		userID:              params.UserID,
+		handle:              NormalizeHandle(params.Handle),
+		displayName:         strings.TrimSpace(params.DisplayName),
+		acceptedAssets:      params.AcceptedAssets,
+		public:              params.Public,
+		allowHandlePayments: params.AllowHandlePayments,
+		handleChangedAt:     params.HandleChangedAt,
+		createdAt:           params.CreatedAt,
+		updatedAt:           params.UpdatedAt,
+	}
+}
+
+// Validate ensures the entity adheres to domain invariants.
+func (p *PaymentProfileEntity) Validate() error {
+	var validationErr error
+
+	if p.userID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errProfileUserIDRequired)
+	}
+
+	if err := ValidateHandle(p.handle); err != nil {
+		validationErr = errors.Join(validationErr, err)
+	}
+
+	if len([]rune(p.displayName)) > maxDisplayNameLength {
+		validationErr = errors.Join(validationErr, errProfileDisplayNameLength)
+	}
+
+	for _, asset := range p.acceptedAssets {
+		if !IsSupportedChain(asset) {
+			validationErr = errors.Join(validationErr, errProfileAssetInvalid)
+			break
+		}
+	}
+
+	return validationErr
+}
+
+// Getter implementations satisfy the PaymentProfile interface.
+
+func (p *PaymentProfileEntity) GetUserID() uuid.UUID {
+	return p.userID
+}
+
+func (p *PaymentProfileEntity) GetHandle() string {
+	return p.handle
+}
+
+func (p *PaymentProfileEntity) GetDisplayName() string {
+	return p.displayName
+}
+
+func (p *PaymentProfileEntity) GetAcceptedAssets() []Chain {
+	return p.acceptedAssets
+}
+
+func (p *PaymentProfileEntity) IsPublic() bool {
+	return p.public
+}
+
+func (p *PaymentProfileEntity) AllowsHandlePayments() bool {
+	return p.allowHandlePayments
+}
+
+func (p *PaymentProfileEntity) GetHandleChangedAt() time.Time {
+	return p.handleChangedAt
+}
+
+func (p *PaymentProfileEntity) GetCreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *PaymentProfileEntity) GetUpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+// Domain behavior helpers.
+
+// ChangeHandle assigns a new handle, enforcing the change cooldown.
+func (p *PaymentProfileEntity) ChangeHandle(handle string, at time.Time) error {
+	handle = NormalizeHandle(handle)
+	if err := ValidateHandle(handle); err != nil {
+		return err
+	}
+	if handle == p.handle {
+		return nil
+	}
+	if at.Before(p.handleChangedAt.Add(HandleChangeCooldown)) {
+		return errHandleChangeCooldown
+	}
+	p.handle = handle
+	p.handleChangedAt = at
+	p.updatedAt = at
+	return nil
+}
+
+// UpdateDetails replaces the public profile fields and privacy settings.
+func (p *PaymentProfileEntity) UpdateDetails(displayName string, assets []Chain, public, allowHandlePayments bool, at time.Time) error {
+	p.displayName = strings.TrimSpace(displayName)
+	p.acceptedAssets = assets
+	p.public = public
+	p.allowHandlePayments = allowHandlePayments
+	p.updatedAt = at
+	return p.Validate()
+}
+
+// HandleChangeAvailableAt reports when the handle may next be changed.
+func (p *PaymentProfileEntity) HandleChangeAvailableAt() time.Time {
+	return p.handleChangedAt.Add(HandleChangeCooldown)
+}
+
+// IsHandleCooldownError reports whether err was caused by the handle change cooldown.
+func IsHandleCooldownError(err error) bool {
+	return errors.Is(err, errHandleChangeCooldown)
+}
@@ -0,0 +1,124 @@
+package entities
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PortfolioShareScope limits what a portfolio share link reveals.
+type PortfolioShareScope string
+
+const (
+	// PortfolioShareScopeSummary exposes current allocation and performance only.
+	PortfolioShareScopeSummary PortfolioShareScope = "summary"
+	// PortfolioShareScopeFullHistory additionally exposes the transaction history.
+	PortfolioShareScopeFullHistory PortfolioShareScope = "full_history"
+)
+
+const (
+	// DefaultPortfolioShareTTL is how long a share link stays valid when no expiry is requested.
+	DefaultPortfolioShareTTL = 7 * 24 * time.Hour
+	// MaxPortfolioShareTTL bounds how far in the future a share link may expire.
+	MaxPortfolioShareTTL = 90 * 24 * time.Hour
+	// MaxActivePortfolioShares bounds how many unexpired, unrevoked links a user may hold.
+	MaxActivePortfolioShares = 20
+)
+
+var (
+	errPortfolioShareIDRequired     = errors.New("portfolio share: id is required")
+	errPortfolioShareUserRequired   = errors.New("portfolio share: user id is required")
+	errPortfolioShareHashRequired   = errors.New("portfolio share: token hash is required")
+	errPortfolioShareScopeInvalid   = errors.New("portfolio share: scope is invalid")
+	errPortfolioShareLabelTooLong   = errors.New("portfolio share: label must be at most 100 characters")
+	errPortfolioShareExpiryInvalid  = errors.New("portfolio share: expiry must be after creation")
+	errPortfolioShareAccessRequired = errors.New("portfolio share access: share id is required")
+)
+
+// PortfolioShare is a read-only link to a user's portfolio, handed to someone such as an advisor.
+// Only the hash of the link token is stored.
+type PortfolioShare struct {
+	ID             uuid.UUID           `json:"id"`
+	UserID         uuid.UUID           `json:"user_id"`
+	Label          string              `json:"label,omitempty"`
+	Scope          PortfolioShareScope `json:"scope"`
+	TokenHash      string              `json:"-"`
+	CreatedAt      time.Time           `json:"created_at"`
+	ExpiresAt      time.Time           `json:"expires_at"`
+	RevokedAt      *time.Time          `json:"revoked_at,omitempty"`
+	LastAccessedAt *time.Time          `json:"last_accessed_at,omitempty"`
+}
+
+// Validate ensures the share adheres to domain invariants.
+func (s PortfolioShare) Validate() error {
+	var validationErr error
+
+	if s.ID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errPortfolioShareIDRequired)
+	}
+
+	if s.UserID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errPortfolioShareUserRequired)
+	}
+
+	if strings.TrimSpace(s.TokenHash) == "" {
+		validationErr = errors.Join(validationErr, errPortfolioShareHashRequired)
+	}
+
+	if !s.Scope.IsValid() {
+		validationErr = errors.Join(validationErr, errPortfolioShareScopeInvalid)
+	}
+
+	if len([]rune(strings.TrimSpace(s.Label))) > 100 {
+		validationErr = errors.Join(validationErr, errPortfolioShareLabelTooLong)
+	}
+
+	if !s.ExpiresAt.After(s.CreatedAt) {
+		validationErr = errors.Join(validationErr, errPortfolioShareExpiryInvalid)
+	}
+
+	return validationErr
+}
+
+// IsActive reports whether the link can be used at the given time.
+func (s PortfolioShare) IsActive(at time.Time) bool {
+	return s.RevokedAt == nil && at.Before(s.ExpiresAt)
+}
+
+// Allows reports whether the share's scope covers the requested scope.
+func (s PortfolioShare) Allows(scope PortfolioShareScope) bool {
+	return scope == PortfolioShareScopeSummary || s.Scope == scope
+}
+
+// IsValid reports whether the scope is recognised.
+func (s PortfolioShareScope) IsValid() bool {
+	return s == PortfolioShareScopeSummary || s == PortfolioShareScopeFullHistory
+}
+
+// PortfolioShareAccess records one use of a share link, shown to the owner.
+type PortfolioShareAccess struct {
+	ID         uuid.UUID `json:"id"`
+	ShareID    uuid.UUID `json:"share_id"`
+	Resource   string    `json:"resource"`
+	IPAddress  string    `json:"ip_address,omitempty"`
+	UserAgent  string    `json:"user_agent,omitempty"`
+	AccessedAt time.Time `json:"accessed_at"`
+}
+
+// Validate ensures the access record adheres to domain invariants.
+func (a PortfolioShareAccess) Validate() error {
+	if a.ShareID == uuid.Nil {
+		return errPortfolioShareAccessRequired
+	}
+	return nil
+}
+
+// HashPortfolioShareToken derives the lookup hash stored in place of a share link token.
+func HashPortfolioShareToken(token string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(token)))
+	return hex.EncodeToString(sum[:])
+}
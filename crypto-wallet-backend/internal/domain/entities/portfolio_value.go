@@ -0,0 +1,41 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	errPortfolioValueUserRequired = errors.New("portfolio value: user ID is required")
+	errPortfolioValueDayRequired  = errors.New("portfolio value: day is required")
+	errPortfolioValueNegative     = errors.New("portfolio value: value must not be negative")
+)
+
+// PortfolioDailyValue is the USD value of a user's holdings at the close of a UTC day, priced when
+// the day closed. Day is the start of that day.
+type PortfolioDailyValue struct {
+	UserID     uuid.UUID       `json:"user_id"`
+	Day        time.Time       `json:"day"`
+	ValueUSD   decimal.Decimal `json:"value_usd"`
+	ComputedAt time.Time       `json:"computed_at"`
+}
+
+// Validate ensures the value adheres to domain invariants.
+func (v PortfolioDailyValue) Validate() error {
+	var validationErr error
+
+	if v.UserID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errPortfolioValueUserRequired)
+	}
+	if v.Day.IsZero() {
+		validationErr = errors.Join(validationErr, errPortfolioValueDayRequired)
+	}
+	if v.ValueUSD.IsNegative() {
+		validationErr = errors.Join(validationErr, errPortfolioValueNegative)
+	}
+
+	return validationErr
+}
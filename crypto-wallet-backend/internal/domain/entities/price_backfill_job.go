@@ -0,0 +1,67 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PriceBackfillStatus enumerates the states of a historical price backfill.
+type PriceBackfillStatus string
+
+const (
+	PriceBackfillStatusRunning   PriceBackfillStatus = "running"
+	PriceBackfillStatusCompleted PriceBackfillStatus = "completed"
+	// PriceBackfillStatusFailed jobs resume from their cursor when started again.
+	PriceBackfillStatusFailed PriceBackfillStatus = "failed"
+)
+
+var (
+	errPriceBackfillSymbolRequired  = errors.New("price backfill: symbol is required")
+	errPriceBackfillIntervalInvalid = errors.New("price backfill: interval is invalid")
+	errPriceBackfillRangeInvalid    = errors.New("price backfill: range end must be after its start")
+	errPriceBackfillCursorInvalid   = errors.New("price backfill: cursor must lie within the range")
+)
+
+// PriceBackfillJob tracks the fetching of historical candles for one symbol and interval from
+// RangeStart. Cursor is the start of the first bucket not yet stored; it advances after each
+// fetched window.
+type PriceBackfillJob struct {
+	ID              uuid.UUID           `json:"id"`
+	Symbol          string              `json:"symbol"`
+	Interval        IntervalType        `json:"interval"`
+	RangeStart      time.Time           `json:"range_start"`
+	RangeEnd        time.Time           `json:"range_end"`
+	Cursor          time.Time           `json:"cursor"`
+	CandlesInserted int64               `json:"candles_inserted"`
+	Status          PriceBackfillStatus `json:"status"`
+	LastError       string              `json:"last_error,omitempty"`
+	CreatedAt       time.Time           `json:"created_at"`
+	UpdatedAt       time.Time           `json:"updated_at"`
+}
+
+// Validate ensures the job adheres to domain invariants.
+func (j PriceBackfillJob) Validate() error {
+	var validationErr error
+
+	if strings.TrimSpace(j.Symbol) == "" {
+		validationErr = errors.Join(validationErr, errPriceBackfillSymbolRequired)
+	}
+	if !isValidInterval(j.Interval) {
+		validationErr = errors.Join(validationErr, errPriceBackfillIntervalInvalid)
+	}
+	if !j.RangeEnd.After(j.RangeStart) {
+		validationErr = errors.Join(validationErr, errPriceBackfillRangeInvalid)
+	} else if j.Cursor.Before(j.RangeStart) || j.Cursor.After(j.RangeEnd) {
+		validationErr = errors.Join(validationErr, errPriceBackfillCursorInvalid)
+	}
+
+	return validationErr
+}
+
+// Done reports whether every bucket of the range has been fetched.
+func (j PriceBackfillJob) Done() bool {
+	return !j.Cursor.Before(j.RangeEnd)
+}
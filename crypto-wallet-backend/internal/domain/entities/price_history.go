@@ -241,3 +241,72 @@ func isValidInterval(interval IntervalType) bool {
 		return false
 	}
 }
+
+// IsValidInterval reports whether the supplied interval is one of the supported candle resolutions.
+func IsValidInterval(interval IntervalType) bool {
+	return isValidInterval(interval)
+}
+
+// SupportedIntervals returns all supported intervals ordered from finest to coarsest resolution.
+func SupportedIntervals() []IntervalType {
+	return []IntervalType{Interval1m, Interval5m, Interval15m, Interval1h, Interval4h, Interval1d, Interval1w}
+}
+
+// Duration returns the length of a single bucket for the interval, or zero when the interval is unknown.
+func (i IntervalType) Duration() time.Duration {
+	switch i {
+	case Interval1m:
+		return time.Minute
+	case Interval5m:
+		return 5 * time.Minute
+	case Interval15m:
+		return 15 * time.Minute
+	case Interval1h:
+		return time.Hour
+	case Interval4h:
+		return 4 * time.Hour
+	case Interval1d:
+		return 24 * time.Hour
+	case Interval1w:
+		return 7 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// Coarser returns the next lower resolution interval and false when the interval is already the coarsest.
+func (i IntervalType) Coarser() (IntervalType, bool) {
+	intervals := SupportedIntervals()
+	for idx, candidate := range intervals {
+		if candidate == i && idx+1 < len(intervals) {
+			return intervals[idx+1], true
+		}
+	}
+	return i, false
+}
+
+// DefaultPriceHistoryRetention returns how long candles of each interval are kept before they are
+// downsampled and deleted. Daily and weekly candles have no entry and are kept indefinitely.
+func DefaultPriceHistoryRetention() map[IntervalType]time.Duration {
+	const day = 24 * time.Hour
+	return map[IntervalType]time.Duration{
+		Interval1m:  7 * day,
+		Interval5m:  30 * day,
+		Interval15m: 90 * day,
+		Interval1h:  365 * day,
+		Interval4h:  730 * day,
+	}
+}
+
+// DownsampleTarget returns the interval whose candles summarise this interval's once they expire:
+// daily for intraday intervals and weekly for daily candles. Weekly candles have none.
+func (i IntervalType) DownsampleTarget() (IntervalType, bool) {
+	switch {
+	case i == Interval1d:
+		return Interval1w, true
+	case isValidInterval(i) && i.Duration() < Interval1d.Duration():
+		return Interval1d, true
+	default:
+		return i, false
+	}
+}
@@ -0,0 +1,73 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultAccessTokenTTL keeps bearer tokens short-lived; clients renew them with a refresh token.
+	DefaultAccessTokenTTL = 15 * time.Minute
+	// DefaultRefreshTokenTTL bounds how long a session may sit idle before the user signs in again.
+	DefaultRefreshTokenTTL = 30 * 24 * time.Hour
+)
+
+var (
+	errRefreshTokenIDRequired     = errors.New("refresh token: id is required")
+	errRefreshTokenFamilyRequired = errors.New("refresh token: family id is required")
+	errRefreshTokenUserRequired   = errors.New("refresh token: user id is required")
+	errRefreshTokenExpiryInvalid  = errors.New("refresh token: expiry must be after issue time")
+)
+
+// RefreshToken records one refresh token of a sign-in session. Every refresh rotates the token:
+// the presented token is marked rotated and a successor is issued in the same family. A rotated
+// token presented again has leaked, so its whole family is revoked.
+type RefreshToken struct {
+	ID        uuid.UUID  `json:"id"`
+	FamilyID  uuid.UUID  `json:"family_id"`
+	UserID    uuid.UUID  `json:"user_id"`
+	IssuedAt  time.Time  `json:"issued_at"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	RotatedAt *time.Time `json:"rotated_at,omitempty"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+// Validate performs basic validation on the RefreshToken.
+func (t RefreshToken) Validate() error {
+	var validationErr error
+
+	if t.ID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errRefreshTokenIDRequired)
+	}
+
+	if t.FamilyID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errRefreshTokenFamilyRequired)
+	}
+
+	if t.UserID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errRefreshTokenUserRequired)
+	}
+
+	if !t.ExpiresAt.After(t.IssuedAt) {
+		validationErr = errors.Join(validationErr, errRefreshTokenExpiryInvalid)
+	}
+
+	return validationErr
+}
+
+// IsRotated reports whether the token has already been exchanged for a successor.
+func (t RefreshToken) IsRotated() bool {
+	return t.RotatedAt != nil
+}
+
+// IsRevoked reports whether the token's family was revoked.
+func (t RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+// IsExpired reports whether the token can no longer be exchanged at now.
+func (t RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
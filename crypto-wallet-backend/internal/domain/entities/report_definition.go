@@ -0,0 +1,377 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ReportDataset names an analytics read model custom reports can query. Every dataset only ever
+// exposes the requesting user's own records.
+type ReportDataset string
+
+const (
+	ReportDatasetTransactions ReportDataset = "transactions"
+	ReportDatasetExchanges    ReportDataset = "exchanges"
+)
+
+// ReportFieldType describes the values a report filter field holds.
+type ReportFieldType string
+
+const (
+	ReportFieldString  ReportFieldType = "string"
+	ReportFieldTime    ReportFieldType = "time"
+	ReportFieldDecimal ReportFieldType = "decimal"
+)
+
+// ReportFilterOp is a comparison a report filter applies.
+type ReportFilterOp string
+
+const (
+	ReportFilterEq  ReportFilterOp = "eq"
+	ReportFilterNeq ReportFilterOp = "neq"
+	ReportFilterIn  ReportFilterOp = "in"
+	ReportFilterGt  ReportFilterOp = "gt"
+	ReportFilterGte ReportFilterOp = "gte"
+	ReportFilterLt  ReportFilterOp = "lt"
+	ReportFilterLte ReportFilterOp = "lte"
+)
+
+// ReportTimeField is the timestamp every dataset is filtered and bucketed by.
+const ReportTimeField = "created_at"
+
+// Limits on report definitions. They keep generated queries small enough to plan and run safely.
+const (
+	DefaultReportRowLimit = 1000
+	MaxReportRows         = 10000
+	maxReportGroupBy      = 3
+	maxReportMetrics      = 10
+	maxReportFilters      = 20
+	maxReportFilterValues = 100
+)
+
+// ReportFieldSpec describes a field a report may filter on. Values, when set, lists the only
+// values the field can take.
+type ReportFieldSpec struct {
+	Name   string          `json:"name"`
+	Type   ReportFieldType `json:"type"`
+	Values []string        `json:"values,omitempty"`
+}
+
+// ReportDatasetSpec lists what a report over a dataset may group by, measure and filter on. The
+// day, week and month dimensions bucket ReportTimeField in UTC.
+type ReportDatasetSpec struct {
+	Dataset     ReportDataset     `json:"dataset"`
+	Description string            `json:"description"`
+	Dimensions  []string          `json:"dimensions"`
+	Metrics     []string          `json:"metrics"`
+	Filters     []ReportFieldSpec `json:"filters"`
+}
+
+var (
+	reportChainValues       = []string{string(ChainBTC), string(ChainETH), string(ChainSOL), string(ChainXLM)}
+	reportTransactionTypes  = []string{string(TransactionTypeSend), string(TransactionTypeReceive), string(TransactionTypeSwapIn), string(TransactionTypeSwapOut), string(TransactionTypeP2PSend), string(TransactionTypeP2PReceive)}
+	reportTransactionStatus = []string{string(TransactionStatusPending), string(TransactionStatusConfirming), string(TransactionStatusConfirmed), string(TransactionStatusFailed), string(TransactionStatusCancelled)}
+	reportExchangeStatus    = []string{string(ExchangeStatusPending), string(ExchangeStatusProcessing), string(ExchangeStatusCompleted), string(ExchangeStatusFailed), string(ExchangeStatusCancelled)}
+
+	reportDatasetSpecs = []ReportDatasetSpec{
+		{
+			Dataset:     ReportDatasetTransactions,
+			Description: "Transactions on the user's wallets. Amounts are in each chain's native unit, so group by chain before summing them.",
+			Dimensions:  []string{"chain", "type", "status", "wallet_id", "day", "week", "month"},
+			Metrics:     []string{"count", "total_amount", "avg_amount", "min_amount", "max_amount", "total_fee"},
+			Filters: []ReportFieldSpec{
+				{Name: "chain", Type: ReportFieldString, Values: reportChainValues},
+				{Name: "type", Type: ReportFieldString, Values: reportTransactionTypes},
+				{Name: "status", Type: ReportFieldString, Values: reportTransactionStatus},
+				{Name: "wallet_id", Type: ReportFieldString},
+				{Name: ReportTimeField, Type: ReportFieldTime},
+				{Name: "amount", Type: ReportFieldDecimal},
+			},
+		},
+		{
+			Dataset:     ReportDatasetExchanges,
+			Description: "The user's exchange operations. Amounts are in the native unit of the source or destination chain.",
+			Dimensions:  []string{"from_chain", "to_chain", "status", "day", "week", "month"},
+			Metrics:     []string{"count", "total_from_amount", "total_to_amount", "total_fee", "avg_rate"},
+			Filters: []ReportFieldSpec{
+				{Name: "from_chain", Type: ReportFieldString, Values: reportChainValues},
+				{Name: "to_chain", Type: ReportFieldString, Values: reportChainValues},
+				{Name: "status", Type: ReportFieldString, Values: reportExchangeStatus},
+				{Name: ReportTimeField, Type: ReportFieldTime},
+				{Name: "from_amount", Type: ReportFieldDecimal},
+			},
+		},
+	}
+)
+
+// ReportDatasetSpecs returns the catalogue of datasets custom reports can query.
+func ReportDatasetSpecs() []ReportDatasetSpec {
+	return reportDatasetSpecs
+}
+
+// LookupReportDataset returns the spec of the named dataset.
+func LookupReportDataset(dataset ReportDataset) (ReportDatasetSpec, bool) {
+	for _, spec := range reportDatasetSpecs {
+		if spec.Dataset == dataset {
+			return spec, true
+		}
+	}
+	return ReportDatasetSpec{}, false
+}
+
+func (s ReportDatasetSpec) hasDimension(name string) bool {
+	return slices.Contains(s.Dimensions, name)
+}
+
+func (s ReportDatasetSpec) hasMetric(name string) bool {
+	return slices.Contains(s.Metrics, name)
+}
+
+// FilterField returns the spec of the named filter field.
+func (s ReportDatasetSpec) FilterField(name string) (ReportFieldSpec, bool) {
+	for _, field := range s.Filters {
+		if field.Name == name {
+			return field, true
+		}
+	}
+	return ReportFieldSpec{}, false
+}
+
+// ReportFilter restricts the rows a report aggregates. Value holds the operand of single-value
+// operators and Values the list matched by "in". Times are RFC3339.
+type ReportFilter struct {
+	Field  string         `json:"field"`
+	Op     ReportFilterOp `json:"op"`
+	Value  string         `json:"value,omitempty"`
+	Values []string       `json:"values,omitempty"`
+}
+
+// ReportSort orders report rows by a group-by dimension or a metric.
+type ReportSort struct {
+	Field string `json:"field"`
+	Desc  bool   `json:"desc,omitempty"`
+}
+
+// ReportDefinition is a custom report: the dataset to read, the filters to apply, the dimensions
+// to group by and the metrics to compute for each group. Without group-by dimensions the report
+// is a single row of totals. Limit caps the rows returned; zero means DefaultReportRowLimit.
+type ReportDefinition struct {
+	Dataset ReportDataset  `json:"dataset"`
+	Filters []ReportFilter `json:"filters,omitempty"`
+	GroupBy []string       `json:"groupBy,omitempty"`
+	Metrics []string       `json:"metrics"`
+	Sort    []ReportSort   `json:"sort,omitempty"`
+	Limit   int            `json:"limit,omitempty"`
+}
+
+// Normalized returns the definition with names lower-cased, chain values upper-cased and the
+// default limit applied, as it is validated and executed.
+func (d ReportDefinition) Normalized() ReportDefinition {
+	normalized := ReportDefinition{
+		Dataset: ReportDataset(strings.ToLower(strings.TrimSpace(string(d.Dataset)))),
+		Limit:   d.Limit,
+	}
+	if normalized.Limit == 0 {
+		normalized.Limit = DefaultReportRowLimit
+	}
+	for _, name := range d.GroupBy {
+		normalized.GroupBy = append(normalized.GroupBy, normalizeReportName(name))
+	}
+	for _, name := range d.Metrics {
+		normalized.Metrics = append(normalized.Metrics, normalizeReportName(name))
+	}
+	for _, sort := range d.Sort {
+		normalized.Sort = append(normalized.Sort, ReportSort{Field: normalizeReportName(sort.Field), Desc: sort.Desc})
+	}
+	for _, filter := range d.Filters {
+		field := normalizeReportName(filter.Field)
+		normalizeValue := strings.TrimSpace
+		if strings.HasSuffix(field, "chain") {
+			normalizeValue = func(value string) string { return strings.ToUpper(strings.TrimSpace(value)) }
+		}
+		values := make([]string, 0, len(filter.Values))
+		for _, value := range filter.Values {
+			values = append(values, normalizeValue(value))
+		}
+		normalized.Filters = append(normalized.Filters, ReportFilter{
+			Field:  field,
+			Op:     ReportFilterOp(normalizeReportName(string(filter.Op))),
+			Value:  normalizeValue(filter.Value),
+			Values: values,
+		})
+	}
+	return normalized
+}
+
+// Validate checks the definition against its dataset's catalogue entry. Callers validate the
+// Normalized definition.
+func (d ReportDefinition) Validate() error {
+	spec, ok := LookupReportDataset(d.Dataset)
+	if !ok {
+		return fmt.Errorf("report: unknown dataset %q", d.Dataset)
+	}
+
+	var validationErr error
+	join := func(format string, args ...any) {
+		validationErr = errors.Join(validationErr, fmt.Errorf("report: "+format, args...))
+	}
+
+	if len(d.Metrics) == 0 {
+		join("at least one metric is required")
+	}
+	if len(d.Metrics) > maxReportMetrics {
+		join("at most %d metrics are allowed", maxReportMetrics)
+	}
+	seen := make(map[string]struct{}, len(d.Metrics)+len(d.GroupBy))
+	for _, metric := range d.Metrics {
+		if !spec.hasMetric(metric) {
+			join("unknown metric %q for dataset %s", metric, d.Dataset)
+		}
+		if _, dup := seen[metric]; dup {
+			join("metric %q is listed twice", metric)
+		}
+		seen[metric] = struct{}{}
+	}
+
+	if len(d.GroupBy) > maxReportGroupBy {
+		join("at most %d group-by dimensions are allowed", maxReportGroupBy)
+	}
+	for _, dimension := range d.GroupBy {
+		if !spec.hasDimension(dimension) {
+			join("unknown dimension %q for dataset %s", dimension, d.Dataset)
+		}
+		if _, dup := seen[dimension]; dup {
+			join("dimension %q is listed twice", dimension)
+		}
+		seen[dimension] = struct{}{}
+	}
+
+	for _, sort := range d.Sort {
+		if !slices.Contains(d.GroupBy, sort.Field) && !slices.Contains(d.Metrics, sort.Field) {
+			join("sort field %q must be a group-by dimension or metric of the report", sort.Field)
+		}
+	}
+
+	if len(d.Filters) > maxReportFilters {
+		join("at most %d filters are allowed", maxReportFilters)
+	}
+	for _, filter := range d.Filters {
+		field, ok := spec.FilterField(filter.Field)
+		if !ok {
+			join("unknown filter field %q for dataset %s", filter.Field, d.Dataset)
+			continue
+		}
+		if err := filter.validate(field); err != nil {
+			validationErr = errors.Join(validationErr, err)
+		}
+	}
+
+	if d.Limit < 1 || d.Limit > MaxReportRows {
+		join("limit must be between 1 and %d", MaxReportRows)
+	}
+
+	return validationErr
+}
+
+func (f ReportFilter) validate(field ReportFieldSpec) error {
+	switch f.Op {
+	case ReportFilterEq, ReportFilterNeq, ReportFilterIn:
+	case ReportFilterGt, ReportFilterGte, ReportFilterLt, ReportFilterLte:
+		if field.Type == ReportFieldString {
+			return fmt.Errorf("report: filter %q does not support %q", f.Field, f.Op)
+		}
+	default:
+		return fmt.Errorf("report: filter %q has unknown operator %q", f.Field, f.Op)
+	}
+
+	operands := []string{f.Value}
+	if f.Op == ReportFilterIn {
+		if len(f.Values) == 0 || len(f.Values) > maxReportFilterValues {
+			return fmt.Errorf("report: filter %q needs between 1 and %d values", f.Field, maxReportFilterValues)
+		}
+		operands = f.Values
+	}
+
+	for _, operand := range operands {
+		if operand == "" {
+			return fmt.Errorf("report: filter %q needs a value", f.Field)
+		}
+		switch field.Type {
+		case ReportFieldTime:
+			if _, err := time.Parse(time.RFC3339, operand); err != nil {
+				return fmt.Errorf("report: filter %q value %q is not an RFC3339 time", f.Field, operand)
+			}
+		case ReportFieldDecimal:
+			if _, err := decimal.NewFromString(operand); err != nil {
+				return fmt.Errorf("report: filter %q value %q is not a number", f.Field, operand)
+			}
+		default:
+			if len(field.Values) > 0 && !slices.Contains(field.Values, operand) {
+				return fmt.Errorf("report: filter %q value %q must be one of %s", f.Field, operand, strings.Join(field.Values, ", "))
+			}
+		}
+	}
+	return nil
+}
+
+// TimeRange returns the bounds the definition's filters put on ReportTimeField. Either bound is
+// nil when the report is open-ended on that side.
+func (d ReportDefinition) TimeRange() (from, to *time.Time) {
+	for _, filter := range d.Filters {
+		if filter.Field != ReportTimeField {
+			continue
+		}
+		at, err := time.Parse(time.RFC3339, filter.Value)
+		if err != nil {
+			continue
+		}
+		switch filter.Op {
+		case ReportFilterGt, ReportFilterGte:
+			if from == nil || at.After(*from) {
+				from = &at
+			}
+		case ReportFilterLt, ReportFilterLte:
+			if to == nil || at.Before(*to) {
+				to = &at
+			}
+		case ReportFilterEq:
+			from, to = &at, &at
+		}
+	}
+	return from, to
+}
+
+// ReportColumn describes one column of a report result; Kind is "dimension" or "metric".
+type ReportColumn struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+}
+
+// ReportResult holds the rows of an executed report. Values are strings so decimal metrics keep
+// their full precision; Truncated reports whether rows beyond the definition's limit were dropped.
+type ReportResult struct {
+	Columns   []ReportColumn `json:"columns"`
+	Rows      [][]string     `json:"rows"`
+	Truncated bool           `json:"truncated"`
+}
+
+// ReportColumns lists the result columns of the definition: its dimensions, then its metrics.
+func (d ReportDefinition) ReportColumns() []ReportColumn {
+	columns := make([]ReportColumn, 0, len(d.GroupBy)+len(d.Metrics))
+	for _, dimension := range d.GroupBy {
+		columns = append(columns, ReportColumn{Name: dimension, Kind: "dimension"})
+	}
+	for _, metric := range d.Metrics {
+		columns = append(columns, ReportColumn{Name: metric, Kind: "metric"})
+	}
+	return columns
+}
+
+func normalizeReportName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
@@ -0,0 +1,118 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReportFrequency is how often a scheduled portfolio report is delivered.
+type ReportFrequency string
+
+const (
+	ReportFrequencyDaily  ReportFrequency = "daily"
+	ReportFrequencyWeekly ReportFrequency = "weekly"
+)
+
+// IsValid reports whether the frequency is supported.
+func (f ReportFrequency) IsValid() bool {
+	return f == ReportFrequencyDaily || f == ReportFrequencyWeekly
+}
+
+var (
+	errReportScheduleUserRequired   = errors.New("report schedule: user id is required")
+	errReportScheduleFrequency      = errors.New("report schedule: frequency is invalid")
+	errReportScheduleTimezone       = errors.New("report schedule: timezone is invalid")
+	errReportScheduleHourInvalid    = errors.New("report schedule: hour must be between 0 and 23")
+	errReportScheduleWeekdayInvalid = errors.New("report schedule: weekday is invalid")
+	errReportScheduleCurrency       = errors.New("report schedule: currency is not supported")
+)
+
+// ReportSchedule is a user's opt-in to periodic portfolio report emails. Reports go out at Hour
+// in the user's Timezone, every day or on Weekday each week. Currency empty uses the user's
+// preferred currency.
+type ReportSchedule struct {
+	UserID     uuid.UUID       `json:"user_id"`
+	Frequency  ReportFrequency `json:"frequency"`
+	Timezone   string          `json:"timezone"`
+	Hour       int             `json:"hour"`
+	Weekday    time.Weekday    `json:"weekday"`
+	Currency   CurrencyCode    `json:"currency,omitempty"`
+	NextRunAt  time.Time       `json:"next_run_at"`
+	LastSentAt *time.Time      `json:"last_sent_at,omitempty"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+}
+
+// Validate ensures the schedule adheres to domain invariants.
+func (s ReportSchedule) Validate() error {
+	var validationErr error
+
+	if s.UserID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errReportScheduleUserRequired)
+	}
+
+	if !s.Frequency.IsValid() {
+		validationErr = errors.Join(validationErr, errReportScheduleFrequency)
+	}
+
+	if _, err := s.Location(); err != nil {
+		validationErr = errors.Join(validationErr, errReportScheduleTimezone)
+	}
+
+	if s.Hour < 0 || s.Hour > 23 {
+		validationErr = errors.Join(validationErr, errReportScheduleHourInvalid)
+	}
+
+	if s.Weekday < time.Sunday || s.Weekday > time.Saturday {
+		validationErr = errors.Join(validationErr, errReportScheduleWeekdayInvalid)
+	}
+
+	if s.Currency != "" && !isValidCurrencyCode(s.Currency) {
+		validationErr = errors.Join(validationErr, errReportScheduleCurrency)
+	}
+
+	return validationErr
+}
+
+// Location loads the schedule's IANA timezone.
+func (s ReportSchedule) Location() (*time.Location, error) {
+	name := strings.TrimSpace(s.Timezone)
+	if name == "" {
+		return nil, errReportScheduleTimezone
+	}
+	return time.LoadLocation(name)
+}
+
+// NextRunAfter returns the first delivery time strictly after the given instant, in UTC. Local
+// hours skipped by a daylight saving change resolve to the instant Go normalises them to.
+func (s ReportSchedule) NextRunAfter(after time.Time) time.Time {
+	loc, err := s.Location()
+	if err != nil {
+		loc = time.UTC
+	}
+
+	local := after.In(loc)
+	next := time.Date(local.Year(), local.Month(), local.Day(), s.Hour, 0, 0, 0, loc)
+	if s.Frequency == ReportFrequencyWeekly {
+		next = next.AddDate(0, 0, (int(s.Weekday)-int(next.Weekday())+7)%7)
+	}
+	for !next.After(after) {
+		if s.Frequency == ReportFrequencyWeekly {
+			next = time.Date(next.Year(), next.Month(), next.Day()+7, s.Hour, 0, 0, 0, loc)
+		} else {
+			next = time.Date(next.Year(), next.Month(), next.Day()+1, s.Hour, 0, 0, 0, loc)
+		}
+	}
+	return next.UTC()
+}
+
+// ReportPeriod is the performance period a report covers: the last day or the last week.
+func (s ReportSchedule) ReportPeriod() string {
+	if s.Frequency == ReportFrequencyWeekly {
+		return "7d"
+	}
+	return "24h"
+}
@@ -0,0 +1,89 @@
+package entities
+
+import "time"
+
+// SandboxProfileVersion identifies the SandboxProfile layout written by walletctl.
+const SandboxProfileVersion = 1
+
+// DefaultSandboxMinGroupSize is the smallest population a sandbox profile describes. Chains, pairs,
+// types or statuses seen fewer times are left out so no statistic reflects a handful of people.
+const DefaultSandboxMinGroupSize = 20
+
+// SandboxQuantiles are the probabilities captured for every distribution. They are evenly spaced,
+// so sampling interpolates uniformly between neighbours, and the tails are cut off so the largest
+// and smallest real values never appear in a profile.
+var SandboxQuantiles = []float64{
+	0.05, 0.10, 0.15, 0.20, 0.25, 0.30, 0.35, 0.40, 0.45, 0.50,
+	0.55, 0.60, 0.65, 0.70, 0.75, 0.80, 0.85, 0.90, 0.95,
+}
+
+// SandboxProfile summarises production activity as aggregate distributions, from which a fully
+// synthetic dataset is generated for QA and demo environments. It holds no identifiers,
+// addresses, hashes or individual records.
+type SandboxProfile struct {
+	Version      int       `json:"version"`
+	CapturedAt   time.Time `json:"captured_at"`
+	WindowDays   int       `json:"window_days"`
+	MinGroupSize int       `json:"min_group_size"`
+
+	// WalletsPerUser counts non-archived wallets of users holding at least one.
+	WalletsPerUser SandboxDistribution    `json:"wallets_per_user"`
+	Wallets        []SandboxWalletChain   `json:"wallets"`
+	Transactions   []SandboxChainActivity `json:"transactions"`
+
+	// ActiveWalletShare is the fraction of wallets with a transaction in the window, and
+	// TransactionsPerWallet the window's transaction count among those wallets.
+	ActiveWalletShare     float64             `json:"active_wallet_share"`
+	TransactionsPerWallet SandboxDistribution `json:"transactions_per_wallet"`
+
+	// SwapperShare is the fraction of wallet holders who swapped in the window, and SwapsPerUser
+	// the window's swap count among them.
+	SwapperShare float64             `json:"swapper_share"`
+	SwapsPerUser SandboxDistribution `json:"swaps_per_user"`
+	SwapStatuses []SandboxWeight     `json:"swap_statuses"`
+	SwapPairs    []SandboxSwapPair   `json:"swap_pairs"`
+}
+
+// SandboxDistribution is a distribution described by its values at SandboxQuantiles. It is empty
+// when the population was smaller than the profile's minimum group size.
+type SandboxDistribution struct {
+	Quantiles []float64 `json:"quantiles,omitempty"`
+}
+
+// IsEmpty reports whether the distribution carries no data.
+func (d SandboxDistribution) IsEmpty() bool {
+	return len(d.Quantiles) == 0
+}
+
+// SandboxWeight is one value of a categorical distribution with its relative frequency.
+type SandboxWeight struct {
+	Value  string  `json:"value"`
+	Weight float64 `json:"weight"`
+}
+
+// SandboxWalletChain describes wallets on one chain.
+type SandboxWalletChain struct {
+	Chain   Chain               `json:"chain"`
+	Weight  float64             `json:"weight"`
+	Balance SandboxDistribution `json:"balance"`
+}
+
+// SandboxChainActivity describes the window's transactions on one chain.
+type SandboxChainActivity struct {
+	Chain    Chain               `json:"chain"`
+	Amount   SandboxDistribution `json:"amount"`
+	Fee      SandboxDistribution `json:"fee"`
+	Types    []SandboxWeight     `json:"types"`
+	Statuses []SandboxWeight     `json:"statuses"`
+}
+
+// SandboxSwapPair describes the window's swaps between two chains. Rate and FeePercentage are
+// medians.
+type SandboxSwapPair struct {
+	FromChain     Chain               `json:"from_chain"`
+	ToChain       Chain               `json:"to_chain"`
+	Weight        float64             `json:"weight"`
+	FromAmount    SandboxDistribution `json:"from_amount"`
+	Rate          float64             `json:"rate"`
+	FeePercentage float64             `json:"fee_percentage"`
+}
@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxSavedReportsPerUser bounds how many report definitions a user may save.
+const MaxSavedReportsPerUser = 50
+
+var (
+	errSavedReportUserIDRequired = errors.New("saved report user ID is required")
+	errSavedReportNameRequired   = errors.New("saved report name is required")
+	errSavedReportNameTooLong    = errors.New("saved report name must be at most 100 characters")
+)
+
+// SavedReport is a custom report definition a user saved to run again later.
+type SavedReport struct {
+	ID          uuid.UUID        `json:"id"`
+	UserID      uuid.UUID        `json:"user_id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description,omitempty"`
+	Definition  ReportDefinition `json:"definition"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+// Validate ensures the saved report adheres to domain invariants, including its definition.
+func (r SavedReport) Validate() error {
+	var validationErr error
+
+	if r.UserID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errSavedReportUserIDRequired)
+	}
+
+	name := strings.TrimSpace(r.Name)
+	if name == "" {
+		validationErr = errors.Join(validationErr, errSavedReportNameRequired)
+	}
+	if len([]rune(name)) > 100 {
+		validationErr = errors.Join(validationErr, errSavedReportNameTooLong)
+	}
+
+	if err := r.Definition.Validate(); err != nil {
+		validationErr = errors.Join(validationErr, err)
+	}
+
+	return validationErr
+}
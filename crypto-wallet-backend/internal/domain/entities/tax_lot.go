@@ -0,0 +1,166 @@
+package entities
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CostBasisMethod selects which acquisition lots a disposal consumes first.
+type CostBasisMethod string
+
+const (
+	// CostBasisFIFO consumes the oldest lots first.
+	CostBasisFIFO CostBasisMethod = "fifo"
+	// CostBasisLIFO consumes the newest lots first.
+	CostBasisLIFO CostBasisMethod = "lifo"
+	// CostBasisHIFO consumes the lots with the highest unit cost first.
+	CostBasisHIFO CostBasisMethod = "hifo"
+)
+
+// ParseCostBasisMethod normalises a method name, reporting whether it is supported.
+func ParseCostBasisMethod(raw string) (CostBasisMethod, bool) {
+	method := CostBasisMethod(strings.ToLower(strings.TrimSpace(raw)))
+	switch method {
+	case CostBasisFIFO, CostBasisLIFO, CostBasisHIFO:
+		return method, true
+	default:
+		return "", false
+	}
+}
+
+// TaxLot is a quantity of an asset acquired in one transaction at one unit cost in USD.
+type TaxLot struct {
+	Symbol        string
+	TransactionID uuid.UUID
+	AcquiredAt    time.Time
+	Quantity      decimal.Decimal
+	UnitCost      decimal.Decimal
+}
+
+// CostBasis is the USD cost of the lot's remaining quantity.
+func (l TaxLot) CostBasis() decimal.Decimal {
+	return l.Quantity.Mul(l.UnitCost)
+}
+
+// RealizedGain is the part of a disposal matched against a single lot. Unmatched marks quantity
+// disposed beyond the recorded lots, which carries a zero cost basis and no acquisition date.
+type RealizedGain struct {
+	Symbol        string
+	TransactionID uuid.UUID
+	AcquiredAt    time.Time
+	DisposedAt    time.Time
+	Quantity      decimal.Decimal
+	Proceeds      decimal.Decimal
+	CostBasis     decimal.Decimal
+	Unmatched     bool
+}
+
+// Gain is the proceeds less the cost basis; negative values are losses.
+func (g RealizedGain) Gain() decimal.Decimal {
+	return g.Proceeds.Sub(g.CostBasis)
+}
+
+// LongTerm reports whether the asset was held for more than a year.
+func (g RealizedGain) LongTerm() bool {
+	return !g.Unmatched && g.DisposedAt.After(g.AcquiredAt.AddDate(1, 0, 0))
+}
+
+// CostBasisLedger tracks the open lots of each asset and matches disposals against them.
+// Acquisitions and disposals must be recorded in chronological order.
+type CostBasisLedger struct {
+	method CostBasisMethod
+	lots   map[string][]TaxLot
+}
+
+// NewCostBasisLedger returns an empty ledger, defaulting to FIFO for unsupported methods.
+func NewCostBasisLedger(method CostBasisMethod) *CostBasisLedger {
+	if _, ok := ParseCostBasisMethod(string(method)); !ok {
+		method = CostBasisFIFO
+	}
+	return &CostBasisLedger{method: method, lots: make(map[string][]TaxLot)}
+}
+
+// Acquire opens a lot. Non-positive quantities are ignored.
+func (l *CostBasisLedger) Acquire(lot TaxLot) {
+	if !lot.Quantity.IsPositive() {
+		return
+	}
+	l.lots[lot.Symbol] = append(l.lots[lot.Symbol], lot)
+}
+
+// Dispose consumes quantity of symbol from the open lots in the ledger's method order and returns
+// one gain per lot touched, splitting the proceeds in proportion to quantity.
+func (l *CostBasisLedger) Dispose(symbol string, transactionID uuid.UUID, disposedAt time.Time, quantity, proceeds decimal.Decimal) []RealizedGain {
+	if !quantity.IsPositive() {
+		return nil
+	}
+
+	open := l.lots[symbol]
+	l.order(open)
+
+	gains := make([]RealizedGain, 0, 1)
+	remaining := quantity
+	for len(open) > 0 && remaining.IsPositive() {
+		lot := &open[0]
+		used := decimal.Min(lot.Quantity, remaining)
+		gains = append(gains, RealizedGain{
+			Symbol:        symbol,
+			TransactionID: transactionID,
+			AcquiredAt:    lot.AcquiredAt,
+			DisposedAt:    disposedAt,
+			Quantity:      used,
+			Proceeds:      proceeds.Mul(used).Div(quantity),
+			CostBasis:     used.Mul(lot.UnitCost),
+		})
+		lot.Quantity = lot.Quantity.Sub(used)
+		remaining = remaining.Sub(used)
+		if !lot.Quantity.IsPositive() {
+			open = open[1:]
+		}
+	}
+	l.lots[symbol] = open
+
+	if remaining.IsPositive() {
+		gains = append(gains, RealizedGain{
+			Symbol:        symbol,
+			TransactionID: transactionID,
+			DisposedAt:    disposedAt,
+			Quantity:      remaining,
+			Proceeds:      proceeds.Mul(remaining).Div(quantity),
+			CostBasis:     decimal.Zero,
+			Unmatched:     true,
+		})
+	}
+	return gains
+}
+
+// OpenLots returns the remaining lots ordered by symbol and acquisition time.
+func (l *CostBasisLedger) OpenLots() []TaxLot {
+	lots := make([]TaxLot, 0)
+	for _, open := range l.lots {
+		lots = append(lots, open...)
+	}
+	sort.SliceStable(lots, func(i, j int) bool {
+		if lots[i].Symbol != lots[j].Symbol {
+			return lots[i].Symbol < lots[j].Symbol
+		}
+		return lots[i].AcquiredAt.Before(lots[j].AcquiredAt)
+	})
+	return lots
+}
+
+// order sorts open lots so the next lot to consume comes first. Ties keep acquisition order.
+func (l *CostBasisLedger) order(lots []TaxLot) {
+	switch l.method {
+	case CostBasisLIFO:
+		sort.SliceStable(lots, func(i, j int) bool { return lots[i].AcquiredAt.After(lots[j].AcquiredAt) })
+	case CostBasisHIFO:
+		sort.SliceStable(lots, func(i, j int) bool { return lots[i].UnitCost.GreaterThan(lots[j].UnitCost) })
+	default:
+		sort.SliceStable(lots, func(i, j int) bool { return lots[i].AcquiredAt.Before(lots[j].AcquiredAt) })
+	}
+}
@@ -19,6 +19,13 @@ var (
 	errTradingPairMinAmountInvalid    = errors.New("trading pair minimum swap amount cannot be negative")
 	errTradingPairMaxAmountInvalid    = errors.New("trading pair maximum swap amount must be greater than minimum")
 	errTradingPairDailyVolumeInvalid  = errors.New("trading pair daily volume cannot be negative")
+	errTradingPairQuoteTTLInvalid     = errors.New("trading pair quote TTL must be between 1 second and 1 hour")
+)
+
+const (
+	// DefaultQuoteTTL is how long a quote stays executable when its pair has no TTL configured.
+	DefaultQuoteTTL = 60 * time.Second
+	maxQuoteTTL     = time.Hour
 )
 
 // TradingPair exposes the behavior required by the application layer when working with trading pair entities.
@@ -29,12 +36,20 @@ type TradingPair interface {
 
 	GetBaseSymbol() string
 	GetQuoteSymbol() string
+	// GetBaseAssetID and GetQuoteAssetID identify the pair's assets in the asset registry; they are
+	// uuid.Nil for pairs that have not been linked to registry assets.
+	GetBaseAssetID() uuid.UUID
+	GetQuoteAssetID() uuid.UUID
 	GetExchangeRate() decimal.Decimal
 	GetInverseRate() decimal.Decimal
 	GetFeePercentage() decimal.Decimal
 	GetMinSwapAmount() decimal.Decimal
 	GetMaxSwapAmount() *decimal.Decimal
 	GetDailyVolume() decimal.Decimal
+	// GetQuoteTTL is how long quotes on the pair remain executable.
+	GetQuoteTTL() time.Duration
+	// CalculateRequiredAmount is the source amount that receives toAmount at the pair's rate and fee.
+	CalculateRequiredAmount(toAmount decimal.Decimal) decimal.Decimal
 	IsActive() bool
 	HasLiquidity() bool
 	GetLastUpdated() time.Time
@@ -45,12 +60,15 @@ type TradingPairEntity struct {
 	id            uuid.UUID
 	baseSymbol    string
 	quoteSymbol   string
+	baseAssetID   uuid.UUID
+	quoteAssetID  uuid.UUID
 	exchangeRate  decimal.Decimal
 	inverseRate   decimal.Decimal
 	feePercentage decimal.Decimal
 	minSwapAmount decimal.Decimal
 	maxSwapAmount *decimal.Decimal
 	dailyVolume   decimal.Decimal
+	quoteTTL      time.Duration
 	isActive      bool
 	hasLiquidity  bool
 	lastUpdated   time.Time
@@ -63,12 +81,15 @@ type TradingPairParams struct {
 	ID            uuid.UUID
 	BaseSymbol    string
 	QuoteSymbol   string
+	BaseAssetID   uuid.UUID
+	QuoteAssetID  uuid.UUID
 	ExchangeRate  decimal.Decimal
 	InverseRate   decimal.Decimal
 	FeePercentage decimal.Decimal
 	MinSwapAmount decimal.Decimal
 	MaxSwapAmount *decimal.Decimal
 	DailyVolume   decimal.Decimal
+	QuoteTTL      time.Duration
 	IsActive      bool
 	HasLiquidity  bool
 	LastUpdated   time.Time
@@ -98,12 +119,15 @@ func NewTradingPairEntity(params TradingPairParams) (*TradingPairEntity, error)
 		id:            params.ID,
 		baseSymbol:    strings.ToUpper(strings.TrimSpace(params.BaseSymbol)),
 		quoteSymbol:   strings.ToUpper(strings.TrimSpace(params.QuoteSymbol)),
+		baseAssetID:   params.BaseAssetID,
+		quoteAssetID:  params.QuoteAssetID,
 		exchangeRate:  params.ExchangeRate,
 		inverseRate:   params.InverseRate,
 		feePercentage: params.FeePercentage,
 		minSwapAmount: params.MinSwapAmount,
 		maxSwapAmount: params.MaxSwapAmount,
 		dailyVolume:   params.DailyVolume,
+		quoteTTL:      params.QuoteTTL,
 		isActive:      params.IsActive,
 		hasLiquidity:  params.HasLiquidity,
 		lastUpdated:   params.LastUpdated,
@@ -124,6 +148,10 @@ func NewTradingPairEntity(params TradingPairParams) (*TradingPairEntity, error)
 		entity.dailyVolume = decimal.Zero
 	}
 
+	if entity.quoteTTL == 0 {
+		entity.quoteTTL = DefaultQuoteTTL
+	}
+
 	entity.isActive = true
 	entity.hasLiquidity = true
 
@@ -140,12 +168,15 @@ func HydrateTradingPairEntity(params TradingPairParams) *TradingPairEntity {
 		id:            params.ID,
 		baseSymbol:    strings.ToUpper(strings.TrimSpace(params.BaseSymbol)),
 		quoteSymbol:   strings.ToUpper(strings.TrimSpace(params.QuoteSymbol)),
+		baseAssetID:   params.BaseAssetID,
+		quoteAssetID:  params.QuoteAssetID,
 		exchangeRate:  params.ExchangeRate,
 		inverseRate:   params.InverseRate,
 		feePercentage: params.FeePercentage,
 		minSwapAmount: params.MinSwapAmount,
 		maxSwapAmount: params.MaxSwapAmount,
 		dailyVolume:   params.DailyVolume,
+		quoteTTL:      params.QuoteTTL,
 		isActive:      params.IsActive,
 		hasLiquidity:  params.HasLiquidity,
 		lastUpdated:   params.LastUpdated,
@@ -194,6 +225,10 @@ func (t *TradingPairEntity) Validate() error {
 		validationErr = errors.Join(validationErr, errTradingPairDailyVolumeInvalid)
 	}
 
+	if !validQuoteTTL(t.quoteTTL) {
+		validationErr = errors.Join(validationErr, errTradingPairQuoteTTLInvalid)
+	}
+
 	return validationErr
 }
 
@@ -211,6 +246,14 @@ func (t *TradingPairEntity) GetQuoteSymbol() string {
 	return t.quoteSymbol
 }
 
+func (t *TradingPairEntity) GetBaseAssetID() uuid.UUID {
+	return t.baseAssetID
+}
+
+func (t *TradingPairEntity) GetQuoteAssetID() uuid.UUID {
+	return t.quoteAssetID
+}
+
 func (t *TradingPairEntity) GetExchangeRate() decimal.Decimal {
 	return t.exchangeRate
 }
@@ -235,6 +278,13 @@ func (t *TradingPairEntity) GetDailyVolume() decimal.Decimal {
 	return t.dailyVolume
 }
 
+func (t *TradingPairEntity) GetQuoteTTL() time.Duration {
+	if t.quoteTTL <= 0 {
+		return DefaultQuoteTTL
+	}
+	return t.quoteTTL
+}
+
 func (t *TradingPairEntity) IsActive() bool {
 	return t.isActive
 }
@@ -308,6 +358,20 @@ func (t *TradingPairEntity) SetMinMaxAmounts(min, max *decimal.Decimal) error {
 	return nil
 }
 
+// SetQuoteTTL sets how long quotes on the pair remain executable. Volatile pairs should use a
+// shorter TTL so users cannot hold a stale rate for long.
+func (t *TradingPairEntity) SetQuoteTTL(ttl time.Duration) error {
+	if !validQuoteTTL(ttl) {
+		return errTradingPairQuoteTTLInvalid
+	}
+
+	t.quoteTTL = ttl
+	t.lastUpdated = time.Now().UTC()
+	t.Touch(t.lastUpdated)
+
+	return nil
+}
+
 // SetActive sets the active status of the trading pair.
 func (t *TradingPairEntity) SetActive(active bool) {
 	t.isActive = active
@@ -394,3 +458,7 @@ func (t *TradingPairEntity) Touch(at time.Time) {
 	}
 	t.updatedAt = at
 }
+
+func validQuoteTTL(ttl time.Duration) bool {
+	return ttl >= time.Second && ttl <= maxQuoteTTL
+}
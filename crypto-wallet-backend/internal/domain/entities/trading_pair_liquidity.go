@@ -0,0 +1,79 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// LiquidityEntryKind names a change recorded in a trading pair's liquidity ledger.
+type LiquidityEntryKind string
+
+const (
+	// LiquidityEntryTopUp adds quote asset to the reserve.
+	LiquidityEntryTopUp LiquidityEntryKind = "top_up"
+	// LiquidityEntryHold holds an accepted quote's payout against the reserve.
+	LiquidityEntryHold LiquidityEntryKind = "hold"
+	// LiquidityEntryRelease returns a hold when its quote is cancelled, expires or fails.
+	LiquidityEntryRelease LiquidityEntryKind = "release"
+	// LiquidityEntrySettle pays an executed exchange out of the reserve.
+	LiquidityEntrySettle LiquidityEntryKind = "settle"
+)
+
+// TradingPairLiquidity is the liquidity ledger balance of a trading pair. QuoteReserve is the quote
+// asset available to pay exchanges out, of which QuoteHeld is held for accepted quotes; BaseReserve
+// is the base asset exchanges have paid in. QuoteFunded totals every top-up, and utilization is
+// measured against it.
+type TradingPairLiquidity struct {
+	PairID       uuid.UUID       `json:"pair_id"`
+	QuoteReserve decimal.Decimal `json:"quote_reserve"`
+	QuoteHeld    decimal.Decimal `json:"quote_held"`
+	BaseReserve  decimal.Decimal `json:"base_reserve"`
+	QuoteFunded  decimal.Decimal `json:"quote_funded"`
+	LowThreshold decimal.Decimal `json:"low_threshold"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+// Available is the part of the quote reserve that is not held for accepted quotes.
+func (l TradingPairLiquidity) Available() decimal.Decimal {
+	return l.QuoteReserve.Sub(l.QuoteHeld)
+}
+
+// Utilization is the share of the funded quote reserve that has been paid out or is held, from 0
+// to 1. A ledger that was never funded reports zero.
+func (l TradingPairLiquidity) Utilization() decimal.Decimal {
+	if !l.QuoteFunded.IsPositive() {
+		return decimal.Zero
+	}
+	used := decimal.NewFromInt(1).Sub(l.Available().Div(l.QuoteFunded))
+	if used.IsNegative() {
+		return decimal.Zero
+	}
+	return used
+}
+
+// Sufficient reports whether the pair can keep quoting: something is available and it has not
+// fallen below the low threshold.
+func (l TradingPairLiquidity) Sufficient() bool {
+	available := l.Available()
+	return available.IsPositive() && available.GreaterThanOrEqual(l.LowThreshold)
+}
+
+// LiquidityEntry records one change to a trading pair's liquidity ledger. The deltas are signed
+// changes to the quote reserve, the held amount and the base reserve; QuoteReserve is the reserve
+// after the change. OperationID is set for entries an exchange caused.
+type LiquidityEntry struct {
+	ID           uuid.UUID          `json:"id"`
+	PairID       uuid.UUID          `json:"pair_id"`
+	OperationID  uuid.UUID          `json:"operation_id"`
+	Kind         LiquidityEntryKind `json:"kind"`
+	QuoteDelta   decimal.Decimal    `json:"quote_delta"`
+	HeldDelta    decimal.Decimal    `json:"held_delta"`
+	BaseDelta    decimal.Decimal    `json:"base_delta"`
+	QuoteReserve decimal.Decimal    `json:"quote_reserve"`
+	Actor        string             `json:"actor"`
+	Note         string             `json:"note"`
+	CreatedAt    time.Time          `json:"created_at"`
+}
@@ -17,6 +17,9 @@ const (
 	TransactionTypeReceive  TransactionType = "receive"
 	TransactionTypeSwapIn   TransactionType = "swap_in"
 	TransactionTypeSwapOut  TransactionType = "swap_out"
+	// Off-chain transfers between platform users, settled on the internal ledger.
+	TransactionTypeP2PSend    TransactionType = "p2p_send"
+	TransactionTypeP2PReceive TransactionType = "p2p_receive"
 )
 
 // TransactionStatus captures the lifecycle of a blockchain transaction.
@@ -383,7 +386,8 @@ func isValidChain(chain Chain) bool {
 
 func isValidTransactionType(txType TransactionType) bool {
 	switch txType {
-	case TransactionTypeSend, TransactionTypeReceive, TransactionTypeSwapIn, TransactionTypeSwapOut:
+	case TransactionTypeSend, TransactionTypeReceive, TransactionTypeSwapIn, TransactionTypeSwapOut,
+		TransactionTypeP2PSend, TransactionTypeP2PReceive:
 		return true
 	default:
 		return false
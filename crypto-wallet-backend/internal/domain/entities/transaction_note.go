@@ -0,0 +1,73 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxTransactionNoteCiphertext bounds an encrypted note blob, in bytes.
+	MaxTransactionNoteCiphertext = 8 * 1024
+	// MaxTransactionNoteKeyID bounds the client key identifier.
+	MaxTransactionNoteKeyID = 100
+)
+
+var (
+	errTransactionNoteUserRequired        = errors.New("transaction note: user id is required")
+	errTransactionNoteTransactionRequired = errors.New("transaction note: transaction id is required")
+	errTransactionNoteCiphertextRequired  = errors.New("transaction note: ciphertext is required")
+	errTransactionNoteCiphertextTooLarge  = errors.New("transaction note: ciphertext is too large")
+	errTransactionNoteKeyIDRequired       = errors.New("transaction note: key id is required")
+	errTransactionNoteKeyIDTooLong        = errors.New("transaction note: key id is too long")
+)
+
+// TransactionNote is a user's private note on a transaction, encrypted on the client with a key the
+// server never sees; Ciphertext is opaque and KeyID only tells the user's devices which of their
+// keys decrypts it. Version increases with every write to the note and guards concurrent edits
+// from different devices. Revision orders all of a user's note writes for incremental sync.
+// Deleted notes are kept as tombstones without ciphertext so other devices learn of the deletion.
+type TransactionNote struct {
+	UserID        uuid.UUID `json:"user_id"`
+	TransactionID uuid.UUID `json:"transaction_id"`
+	Ciphertext    []byte    `json:"ciphertext,omitempty"`
+	KeyID         string    `json:"key_id,omitempty"`
+	DeviceID      string    `json:"device_id,omitempty"`
+	Version       int64     `json:"version"`
+	Revision      int64     `json:"revision"`
+	Deleted       bool      `json:"deleted"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// Validate performs basic validation on the TransactionNote.
+func (n TransactionNote) Validate() error {
+	var validationErr error
+
+	if n.UserID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errTransactionNoteUserRequired)
+	}
+
+	if n.TransactionID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errTransactionNoteTransactionRequired)
+	}
+
+	if n.Deleted {
+		return validationErr
+	}
+
+	if len(n.Ciphertext) == 0 {
+		validationErr = errors.Join(validationErr, errTransactionNoteCiphertextRequired)
+	} else if len(n.Ciphertext) > MaxTransactionNoteCiphertext {
+		validationErr = errors.Join(validationErr, errTransactionNoteCiphertextTooLarge)
+	}
+
+	if n.KeyID == "" {
+		validationErr = errors.Join(validationErr, errTransactionNoteKeyIDRequired)
+	} else if len(n.KeyID) > MaxTransactionNoteKeyID {
+		validationErr = errors.Join(validationErr, errTransactionNoteKeyIDTooLong)
+	}
+
+	return validationErr
+}
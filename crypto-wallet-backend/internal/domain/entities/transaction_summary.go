@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 var (
@@ -19,20 +20,23 @@ var (
 	errTransactionSummaryFeesInvalid        = errors.New("transaction summary fees cannot be negative")
 )
 
-// TransactionSummary represents aggregated transaction metrics for analytics
+var percentMultiplier = decimal.NewFromInt(100)
+
+// TransactionSummary represents aggregated transaction metrics for analytics.
+// USD amounts are kept as decimals so sums over 18-decimal assets never pass through float64.
 type TransactionSummary struct {
-	ID                     uuid.UUID `json:"id" db:"id"`
-	Chain                  Chain     `json:"chain" db:"chain"`
-	Date                   time.Time `json:"date" db:"date"`
-	TransactionCount       int       `json:"transaction_count" db:"transaction_count"`
-	TotalVolumeUSD         float64   `json:"total_volume_usd" db:"total_volume_usd"`
-	UniqueSenders          int       `json:"unique_senders" db:"unique_senders"`
-	UniqueReceivers        int       `json:"unique_receivers" db:"unique_receivers"`
-	AvgTransactionValueUSD float64   `json:"avg_transaction_value_usd" db:"avg_transaction_value_usd"`
-	SwapCount              int       `json:"swap_count" db:"swap_count"`
-	TotalFeesUSD           float64   `json:"total_fees_usd" db:"total_fees_usd"`
-	CreatedAt              time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt              time.Time `json:"updated_at" db:"updated_at"`
+	ID                     uuid.UUID       `json:"id" db:"id"`
+	Chain                  Chain           `json:"chain" db:"chain"`
+	Date                   time.Time       `json:"date" db:"date"`
+	TransactionCount       int             `json:"transaction_count" db:"transaction_count"`
+	TotalVolumeUSD         decimal.Decimal `json:"total_volume_usd" db:"total_volume_usd"`
+	UniqueSenders          int             `json:"unique_senders" db:"unique_senders"`
+	UniqueReceivers        int             `json:"unique_receivers" db:"unique_receivers"`
+	AvgTransactionValueUSD decimal.Decimal `json:"avg_transaction_value_usd" db:"avg_transaction_value_usd"`
+	SwapCount              int             `json:"swap_count" db:"swap_count"`
+	TotalFeesUSD           decimal.Decimal `json:"total_fees_usd" db:"total_fees_usd"`
+	CreatedAt              time.Time       `json:"created_at" db:"created_at"`
+	UpdatedAt              time.Time       `json:"updated_at" db:"updated_at"`
 }
 
 // TableName returns the database table name for TransactionSummary
@@ -58,7 +62,7 @@ func (ts *TransactionSummary) Validate() error {
 		validationErr = errors.Join(validationErr, errTransactionSummaryCountInvalid)
 	}
 
-	if ts.TotalVolumeUSD < 0 {
+	if ts.TotalVolumeUSD.IsNegative() {
 		validationErr = errors.Join(validationErr, errTransactionSummaryVolumeInvalid)
 	}
 
@@ -70,7 +74,7 @@ func (ts *TransactionSummary) Validate() error {
 		validationErr = errors.Join(validationErr, errTransactionSummaryUniqueCountInvalid)
 	}
 
-	if ts.AvgTransactionValueUSD < 0 {
+	if ts.AvgTransactionValueUSD.IsNegative() {
 		validationErr = errors.Join(validationErr, errTransactionSummaryAverageInvalid)
 	}
 
@@ -78,7 +82,7 @@ func (ts *TransactionSummary) Validate() error {
 		validationErr = errors.Join(validationErr, errTransactionSummarySwapCountInvalid)
 	}
 
-	if ts.TotalFeesUSD < 0 {
+	if ts.TotalFeesUSD.IsNegative() {
 		validationErr = errors.Join(validationErr, errTransactionSummaryFeesInvalid)
 	}
 
@@ -86,32 +90,34 @@ func (ts *TransactionSummary) Validate() error {
 }
 
 // GetDailyVolume returns the daily volume in USD for this summary
-func (ts *TransactionSummary) GetDailyVolume() float64 {
+func (ts *TransactionSummary) GetDailyVolume() decimal.Decimal {
 	return ts.TotalVolumeUSD
 }
 
 // GetAverageTransactionSize returns the average transaction size
-func (ts *TransactionSummary) GetAverageTransactionSize() float64 {
+func (ts *TransactionSummary) GetAverageTransactionSize() decimal.Decimal {
 	if ts.TransactionCount == 0 {
-		return 0
+		return decimal.Zero
 	}
-	return ts.TotalVolumeUSD / float64(ts.TransactionCount)
+	return ts.TotalVolumeUSD.Div(decimal.NewFromInt(int64(ts.TransactionCount)))
 }
 
 // GetSwapPercentage returns the percentage of transactions that are swaps
-func (ts *TransactionSummary) GetSwapPercentage() float64 {
+func (ts *TransactionSummary) GetSwapPercentage() decimal.Decimal {
 	if ts.TransactionCount == 0 {
-		return 0
+		return decimal.Zero
 	}
-	return float64(ts.SwapCount) / float64(ts.TransactionCount) * 100
+	return decimal.NewFromInt(int64(ts.SwapCount)).
+		Div(decimal.NewFromInt(int64(ts.TransactionCount))).
+		Mul(percentMultiplier)
 }
 
 // GetFeePercentage returns the fee percentage relative to total volume
-func (ts *TransactionSummary) GetFeePercentage() float64 {
-	if ts.TotalVolumeUSD == 0 {
-		return 0
+func (ts *TransactionSummary) GetFeePercentage() decimal.Decimal {
+	if ts.TotalVolumeUSD.IsZero() {
+		return decimal.Zero
 	}
-	return ts.TotalFeesUSD / ts.TotalVolumeUSD * 100
+	return ts.TotalFeesUSD.Div(ts.TotalVolumeUSD).Mul(percentMultiplier)
 }
 
 // GetID returns the ID of the transaction summary
@@ -135,7 +141,7 @@ func (ts *TransactionSummary) GetTotalTransactions() int64 {
 }
 
 // GetTotalVolume returns the total volume in USD
-func (ts *TransactionSummary) GetTotalVolume() float64 {
+func (ts *TransactionSummary) GetTotalVolume() decimal.Decimal {
 	return ts.TotalVolumeUSD
 }
 
@@ -145,14 +151,14 @@ func (ts *TransactionSummary) GetSwapTransactions() int64 {
 }
 
 // GetTotalFees returns the total fees in USD
-func (ts *TransactionSummary) GetTotalFees() float64 {
+func (ts *TransactionSummary) GetTotalFees() decimal.Decimal {
 	return ts.TotalFeesUSD
 }
 
 // GetAverageFee returns the average fee per transaction
-func (ts *TransactionSummary) GetAverageFee() float64 {
+func (ts *TransactionSummary) GetAverageFee() decimal.Decimal {
 	if ts.TransactionCount == 0 {
-		return 0
+		return decimal.Zero
 	}
-	return ts.TotalFeesUSD / float64(ts.TransactionCount)
+	return ts.TotalFeesUSD.Div(decimal.NewFromInt(int64(ts.TransactionCount)))
 }
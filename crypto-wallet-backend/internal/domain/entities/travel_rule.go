@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// TravelRuleStatus tracks what happened to the travel-rule data captured for a withdrawal.
+type TravelRuleStatus string
+
+const (
+	// TravelRuleStatusCaptured is stored data that no provider was configured to transmit.
+	TravelRuleStatusCaptured TravelRuleStatus = "captured"
+	// TravelRuleStatusTransmitted is data the provider accepted; the withdrawal may be broadcast.
+	TravelRuleStatusTransmitted TravelRuleStatus = "transmitted"
+	// TravelRuleStatusFailed is data the provider could not be reached to accept.
+	TravelRuleStatusFailed TravelRuleStatus = "failed"
+	// TravelRuleStatusRejected is data the provider or the beneficiary's VASP declined.
+	TravelRuleStatusRejected TravelRuleStatus = "rejected"
+)
+
+// TravelRuleRecord holds the originator and beneficiary information captured for a withdrawal at
+// or above the travel-rule threshold. Both parties are stored encrypted under the sender's data key.
+type TravelRuleRecord struct {
+	ID                   uuid.UUID        `json:"id"`
+	UserID               uuid.UUID        `json:"user_id"`
+	WalletID             uuid.UUID        `json:"wallet_id"`
+	TransactionID        *uuid.UUID       `json:"transaction_id,omitempty"`
+	Chain                Chain            `json:"chain"`
+	ToAddress            string           `json:"to_address"`
+	Amount               decimal.Decimal  `json:"amount"`
+	AmountUSD            decimal.Decimal  `json:"amount_usd"`
+	ThresholdUSD         decimal.Decimal  `json:"threshold_usd"`
+	OriginatorEncrypted  string           `json:"-"`
+	BeneficiaryEncrypted string           `json:"-"`
+	BeneficiaryVASP      string           `json:"beneficiary_vasp,omitempty"`
+	Status               TravelRuleStatus `json:"status"`
+	ProviderReference    string           `json:"provider_reference,omitempty"`
+	CreatedAt            time.Time        `json:"created_at"`
+	TransmittedAt        *time.Time       `json:"transmitted_at,omitempty"`
+}
@@ -0,0 +1,100 @@
+package entities
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	errPreferencesUserRequired     = errors.New("user preferences: user id is required")
+	errPreferencesNotificationType = errors.New("user preferences: notification type is invalid")
+	errPreferencesChainInvalid     = errors.New("user preferences: default chain is invalid")
+	errPreferencesThresholdInvalid = errors.New("user preferences: small balance threshold must not be negative")
+	errPreferencesLanguageInvalid  = errors.New("user preferences: language must be a tag such as en or th-TH")
+	errPreferencesTimezoneInvalid  = errors.New("user preferences: timezone is invalid")
+
+	languageTagPattern = regexp.MustCompile(`^[a-z]{2,3}(-[A-Z]{2})?$`)
+)
+
+// UserPreferences holds a user's settings beyond their preferred currency. The zero value is the
+// default: every notification enabled, no default chain, all balances shown, language and
+// timezone left to the client.
+type UserPreferences struct {
+	UserID uuid.UUID `json:"-"`
+	// Notifications disables in-app notifications by type; types not listed are enabled.
+	Notifications map[NotificationType]bool `json:"notifications,omitempty"`
+	DefaultChain  Chain                     `json:"default_chain,omitempty"`
+	// HideSmallBalancesUSD hides assets worth less than it in portfolio views and reports.
+	HideSmallBalancesUSD decimal.Decimal `json:"hide_small_balances_usd"`
+	Language             string          `json:"language,omitempty"`
+	// Timezone is an IANA name such as Asia/Bangkok.
+	Timezone  string    `json:"timezone,omitempty"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+// Validate ensures the preferences adhere to domain invariants.
+func (p UserPreferences) Validate() error {
+	var validationErr error
+
+	if p.UserID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errPreferencesUserRequired)
+	}
+
+	for notificationType := range p.Notifications {
+		if !notificationType.IsValid() {
+			validationErr = errors.Join(validationErr, errPreferencesNotificationType)
+			break
+		}
+	}
+
+	if p.DefaultChain != "" && !isValidChain(p.DefaultChain) {
+		validationErr = errors.Join(validationErr, errPreferencesChainInvalid)
+	}
+
+	if p.HideSmallBalancesUSD.IsNegative() {
+		validationErr = errors.Join(validationErr, errPreferencesThresholdInvalid)
+	}
+
+	if p.Language != "" && !IsValidLanguageTag(p.Language) {
+		validationErr = errors.Join(validationErr, errPreferencesLanguageInvalid)
+	}
+
+	if p.Timezone != "" {
+		if _, err := time.LoadLocation(p.Timezone); err != nil {
+			validationErr = errors.Join(validationErr, errPreferencesTimezoneInvalid)
+		}
+	}
+
+	return validationErr
+}
+
+// NotificationEnabled reports whether the user wants in-app notifications of the type.
+func (p UserPreferences) NotificationEnabled(notificationType NotificationType) bool {
+	enabled, ok := p.Notifications[notificationType]
+	return !ok || enabled
+}
+
+// HidesBalance reports whether an asset worth valueUSD is hidden by the small balance threshold.
+func (p UserPreferences) HidesBalance(valueUSD decimal.Decimal) bool {
+	return p.HideSmallBalancesUSD.IsPositive() && valueUSD.LessThan(p.HideSmallBalancesUSD)
+}
+
+// IsValidLanguageTag reports whether the value is a language tag such as en, fil or th-TH.
+func IsValidLanguageTag(value string) bool {
+	return languageTagPattern.MatchString(strings.TrimSpace(value))
+}
+
+// NotificationTypes lists the notification types users can toggle, in a stable order.
+func NotificationTypes() []NotificationType {
+	return []NotificationType{
+		NotificationTypeTransactionConfirmed,
+		NotificationTypeExchangeCompleted,
+		NotificationTypeKYCStatusChanged,
+		NotificationTypeWalletDormancy,
+	}
+}
@@ -25,6 +25,48 @@ var (
 	errNextReviewRequired      = errors.New("risk score: next review timestamp is required")
 )
 
+// RiskLevelForScore maps a 0-100 screening score onto a risk level.
+func RiskLevelForScore(score int) RiskLevel {
+	switch {
+	case score >= 75:
+		return RiskLevelCritical
+	case score >= 50:
+		return RiskLevelHigh
+	case score >= 25:
+		return RiskLevelMedium
+	default:
+		return RiskLevelLow
+	}
+}
+
+// ReviewIntervalForLevel returns how long a user at the given risk level may go before re-screening.
+func ReviewIntervalForLevel(level RiskLevel) time.Duration {
+	switch level {
+	case RiskLevelCritical:
+		return 30 * 24 * time.Hour
+	case RiskLevelHigh:
+		return 90 * 24 * time.Hour
+	case RiskLevelMedium:
+		return 180 * 24 * time.Hour
+	default:
+		return 365 * 24 * time.Hour
+	}
+}
+
+// RiskLevelRank orders risk levels so escalations can be detected.
+func RiskLevelRank(level RiskLevel) int {
+	switch level {
+	case RiskLevelMedium:
+		return 1
+	case RiskLevelHigh:
+		return 2
+	case RiskLevelCritical:
+		return 3
+	default:
+		return 0
+	}
+}
+
 // UserRiskScore exposes behaviours required by the application layer when working with risk scores.
 type UserRiskScore interface {
 	Entity
@@ -222,6 +264,12 @@ func (r *UserRiskScoreEntity) MarkScreened(at time.Time, nextReview time.Time) {
 	r.Touch(t)
 }
 
+// DeferReview pushes the next review out without recording a screening, e.g. after a provider failure.
+func (r *UserRiskScoreEntity) DeferReview(until time.Time) {
+	r.nextReviewAt = until.UTC()
+	r.Touch(time.Now().UTC())
+}
+
 func (r *UserRiskScoreEntity) Touch(at time.Time) {
 	r.updatedAt = normaliseTimestamp(at)
 }
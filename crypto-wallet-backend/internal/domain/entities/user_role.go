@@ -0,0 +1,60 @@
+package entities
+
+// UserRole grants a staff capability. Roles are carried in access tokens, so a change takes
+// effect on the user's next sign-in or token refresh.
+type UserRole string
+
+const (
+	// UserRoleUser is held by every account. It is embedded in tokens but never stored in user_roles.
+	UserRoleUser UserRole = "user"
+	// UserRoleSupport lets staff work disputes, read the audit trail and export events.
+	UserRoleSupport UserRole = "support"
+	// UserRoleCompliance lets staff review KYC profiles and AML hits and see their decrypted PII.
+	UserRoleCompliance UserRole = "compliance"
+	// UserRoleAdmin holds every permission, including platform operations.
+	UserRoleAdmin UserRole = "admin"
+)
+
+// Permission is a capability a route can require. Roles grant permissions; tokens only carry roles.
+type Permission string
+
+const (
+	// PermissionSupportAccess admits staff to the /support routes.
+	PermissionSupportAccess Permission = "support:access"
+	// PermissionComplianceReview covers KYC review, AML hits and compliance rules.
+	PermissionComplianceReview Permission = "compliance:review"
+	// PermissionPlatformAdmin covers operational controls: chains, broadcasts, reconciliation and
+	// admin operations.
+	PermissionPlatformAdmin Permission = "platform:admin"
+)
+
+var rolePermissions = map[UserRole][]Permission{
+	UserRoleSupport:    {PermissionSupportAccess},
+	UserRoleCompliance: {PermissionSupportAccess, PermissionComplianceReview},
+	UserRoleAdmin:      {PermissionSupportAccess, PermissionComplianceReview, PermissionPlatformAdmin},
+}
+
+// IsValidUserRole reports whether the role is known.
+func IsValidUserRole(role UserRole) bool {
+	switch role {
+	case UserRoleUser, UserRoleSupport, UserRoleCompliance, UserRoleAdmin:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsGrantable reports whether the role can be granted to an account; the user role is implicit.
+func (r UserRole) IsGrantable() bool {
+	return r != UserRoleUser && IsValidUserRole(r)
+}
+
+// HasPermission reports whether the role grants the permission.
+func (r UserRole) HasPermission(permission Permission) bool {
+	for _, granted := range rolePermissions[r] {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
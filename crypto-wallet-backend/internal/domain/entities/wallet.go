@@ -15,12 +15,17 @@ type WalletStatus string
 const (
 	WalletStatusActive   WalletStatus = "active"
 	WalletStatusArchived WalletStatus = "archived"
+	// WalletStatusFrozen blocks outgoing activity while an operator investigates the wallet.
+	WalletStatusFrozen WalletStatus = "frozen"
+	// WalletStatusDormant blocks outgoing activity after a long inactivity until the owner
+	// reactivates the wallet with two-factor authentication.
+	WalletStatusDormant WalletStatus = "dormant"
 )
 
 var (
 	errWalletUserIDRequired       = errors.New("wallet user ID is required")
 	errWalletAddressRequired      = errors.New("wallet address is required")
-	errWalletEncryptedKeyRequired = errors.New("wallet encrypted private key is required")
+	errWalletEncryptedKeyRequired = errors.New("wallet encrypted private key or derivation path is required")
 	errWalletChainInvalid         = errors.New("wallet chain is invalid")
 	errWalletStatusInvalid        = errors.New("wallet status is invalid")
 	errWalletBalanceNegative      = errors.New("wallet balance cannot be negative")
@@ -34,6 +39,8 @@ type Wallet interface {
 
 	GetUserID() uuid.UUID
 	GetChain() Chain
+	// GetAssetID identifies the asset the wallet holds; it defaults to the chain's native asset.
+	GetAssetID() uuid.UUID
 	GetAddress() string
 	GetEncryptedPrivateKey() string
 	GetDerivationPath() string
@@ -52,6 +59,7 @@ type WalletEntity struct {
 	id                  uuid.UUID
 	userID              uuid.UUID
 	chain               Chain
+	assetID             uuid.UUID
 	address             string
 	encryptedPrivateKey string
 	derivationPath      string
@@ -68,6 +76,7 @@ type WalletParams struct {
 	ID                  uuid.UUID
 	UserID              uuid.UUID
 	Chain               Chain
+	AssetID             uuid.UUID
 	Address             string
 	EncryptedPrivateKey string
 	DerivationPath      string
@@ -101,6 +110,7 @@ func NewWalletEntity(params WalletParams) (*WalletEntity, error) {
 		id:                  params.ID,
 		userID:              params.UserID,
 		chain:               params.Chain,
+		assetID:             walletAssetID(params.AssetID, params.Chain),
 		address:             strings.TrimSpace(params.Address),
 		encryptedPrivateKey: strings.TrimSpace(params.EncryptedPrivateKey),
 		derivationPath:      strings.TrimSpace(params.DerivationPath),
@@ -129,6 +139,7 @@ func HydrateWalletEntity(params WalletParams) *WalletEntity {
 		id:                  params.ID,
 		userID:              params.UserID,
 		chain:               params.Chain,
+		assetID:             walletAssetID(params.AssetID, params.Chain),
 		address:             strings.TrimSpace(params.Address),
 		encryptedPrivateKey: strings.TrimSpace(params.EncryptedPrivateKey),
 		derivationPath:      strings.TrimSpace(params.DerivationPath),
@@ -153,7 +164,8 @@ func (w *WalletEntity) Validate() error {
 		validationErr = errors.Join(validationErr, errWalletAddressRequired)
 	}
 
-	if strings.TrimSpace(w.encryptedPrivateKey) == "" {
+	// HD wallets store no key of their own; it is re-derived from the user's seed at the path.
+	if strings.TrimSpace(w.encryptedPrivateKey) == "" && strings.TrimSpace(w.derivationPath) == "" {
 		validationErr = errors.Join(validationErr, errWalletEncryptedKeyRequired)
 	}
 
@@ -186,6 +198,10 @@ func (w *WalletEntity) GetChain() Chain {
 	return w.chain
 }
 
+func (w *WalletEntity) GetAssetID() uuid.UUID {
+	return w.assetID
+}
+
 func (w *WalletEntity) GetAddress() string {
 	return w.address
 }
@@ -263,9 +279,16 @@ func (w *WalletEntity) Touch(at time.Time) {
 	w.updatedAt = at
 }
 
+func walletAssetID(assetID uuid.UUID, chain Chain) uuid.UUID {
+	if assetID != uuid.Nil {
+		return assetID
+	}
+	return NativeAssetID(chain)
+}
+
 func isValidWalletStatus(status WalletStatus) bool {
 	switch status {
-	case WalletStatusActive, WalletStatusArchived:
+	case WalletStatusActive, WalletStatusArchived, WalletStatusFrozen, WalletStatusDormant:
 		return true
 	default:
 		return false
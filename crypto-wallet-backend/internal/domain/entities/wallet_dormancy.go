@@ -0,0 +1,100 @@
+package entities
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultDormancyInactiveAfter is how long a wallet may go without activity before it turns dormant.
+const DefaultDormancyInactiveAfter = 365 * 24 * time.Hour
+
+// DefaultDormancyReminders are the lead times before dormancy at which the owner is reminded.
+var DefaultDormancyReminders = []time.Duration{30 * 24 * time.Hour, 7 * 24 * time.Hour}
+
+var errDormancyInactiveAfterInvalid = errors.New("dormancy policy: inactivity threshold must be positive")
+
+// DormancyPolicy decides when an inactive wallet turns dormant and when its owner is reminded.
+// Reminders are lead times before dormancy, each sent once per period of inactivity.
+type DormancyPolicy struct {
+	InactiveAfter time.Duration
+	Reminders     []time.Duration
+}
+
+// NewDormancyPolicy returns a validated policy with reminders ordered from the earliest. Lead
+// times that are not positive or not shorter than the threshold are dropped.
+func NewDormancyPolicy(inactiveAfter time.Duration, reminders []time.Duration) (DormancyPolicy, error) {
+	if inactiveAfter <= 0 {
+		return DormancyPolicy{}, errDormancyInactiveAfterInvalid
+	}
+	policy := DormancyPolicy{InactiveAfter: inactiveAfter}
+	for _, lead := range reminders {
+		if lead > 0 && lead < inactiveAfter && !slices.Contains(policy.Reminders, lead) {
+			policy.Reminders = append(policy.Reminders, lead)
+		}
+	}
+	slices.SortFunc(policy.Reminders, func(a, b time.Duration) int { return cmp.Compare(b, a) })
+	return policy, nil
+}
+
+// DormantAt returns when a wallet last active at lastActivity turns dormant.
+func (p DormancyPolicy) DormantAt(lastActivity time.Time) time.Time {
+	return lastActivity.Add(p.InactiveAfter)
+}
+
+// ReminderDue reports whether a reminder should be sent at now: a reminder stage has started and
+// the last reminder, if any, predates it. Only the latest started stage counts, so a wallet first
+// seen close to its dormancy date gets one reminder rather than every missed one.
+func (p DormancyPolicy) ReminderDue(activity WalletActivity, now time.Time) bool {
+	dormantAt := p.DormantAt(activity.LastActivityAt())
+	if !now.Before(dormantAt) {
+		return false
+	}
+	for i := len(p.Reminders) - 1; i >= 0; i-- {
+		stage := dormantAt.Add(-p.Reminders[i])
+		if now.Before(stage) {
+			continue
+		}
+		return activity.RemindedAt == nil || activity.RemindedAt.Before(stage)
+	}
+	return false
+}
+
+// ReminderWindow is the longest lead time, i.e. how long before dormancy wallets start being reminded.
+func (p DormancyPolicy) ReminderWindow() time.Duration {
+	if len(p.Reminders) == 0 {
+		return 0
+	}
+	return p.Reminders[0]
+}
+
+// WalletActivity tracks the activity that keeps a wallet out of dormancy: customer-initiated
+// transactions on the wallet and sign-ins by its owner. Deposits do not count, since they need no
+// action from the owner.
+type WalletActivity struct {
+	WalletID          uuid.UUID    `json:"wallet_id"`
+	UserID            uuid.UUID    `json:"user_id"`
+	Chain             Chain        `json:"chain"`
+	Status            WalletStatus `json:"status"`
+	CreatedAt         time.Time    `json:"created_at"`
+	LastTransactionAt *time.Time   `json:"last_transaction_at,omitempty"`
+	LastLoginAt       *time.Time   `json:"last_login_at,omitempty"`
+	ReactivatedAt     *time.Time   `json:"reactivated_at,omitempty"`
+	DormantSince      *time.Time   `json:"dormant_since,omitempty"`
+	RemindedAt        *time.Time   `json:"reminded_at,omitempty"`
+}
+
+// LastActivityAt returns the latest of the wallet's creation, reactivation, last transaction and
+// the owner's last sign-in.
+func (a WalletActivity) LastActivityAt() time.Time {
+	latest := a.CreatedAt
+	for _, at := range []*time.Time{a.LastTransactionAt, a.LastLoginAt, a.ReactivatedAt} {
+		if at != nil && at.After(latest) {
+			latest = *at
+		}
+	}
+	return latest
+}
@@ -0,0 +1,84 @@
+package entities
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WalletPermission names an action a member may take on a wallet they do not own.
+type WalletPermission string
+
+const (
+	// WalletPermissionView allows reading the wallet and its balance.
+	WalletPermissionView WalletPermission = "view"
+	// WalletPermissionInitiate allows sending from the wallet and quoting exchanges.
+	WalletPermissionInitiate WalletPermission = "initiate"
+	// WalletPermissionApprove allows executing exchanges quoted against the wallet.
+	WalletPermissionApprove WalletPermission = "approve"
+)
+
+var (
+	errWalletPermissionWalletRequired = errors.New("wallet permission: wallet id is required")
+	errWalletPermissionUserRequired   = errors.New("wallet permission: user id is required")
+	errWalletPermissionEmpty          = errors.New("wallet permission: at least one permission is required")
+	errWalletPermissionInvalid        = errors.New("wallet permission: permission is invalid")
+)
+
+// ParseWalletPermission normalises a permission name, returning "" when it is unknown.
+func ParseWalletPermission(value string) WalletPermission {
+	switch permission := WalletPermission(strings.ToLower(strings.TrimSpace(value))); permission {
+	case WalletPermissionView, WalletPermissionInitiate, WalletPermissionApprove:
+		return permission
+	default:
+		return ""
+	}
+}
+
+// WalletPermissionGrant lists what a member may do on a shared wallet. The wallet owner holds every
+// permission implicitly and never has a grant.
+type WalletPermissionGrant struct {
+	WalletID    uuid.UUID          `json:"wallet_id"`
+	UserID      uuid.UUID          `json:"user_id"`
+	Permissions []WalletPermission `json:"permissions"`
+	GrantedBy   uuid.UUID          `json:"granted_by"`
+	CreatedAt   time.Time          `json:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+}
+
+// Validate performs basic validation on the WalletPermissionGrant.
+func (g WalletPermissionGrant) Validate() error {
+	var validationErr error
+
+	if g.WalletID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errWalletPermissionWalletRequired)
+	}
+
+	if g.UserID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errWalletPermissionUserRequired)
+	}
+
+	if len(g.Permissions) == 0 {
+		validationErr = errors.Join(validationErr, errWalletPermissionEmpty)
+	}
+
+	for _, permission := range g.Permissions {
+		if ParseWalletPermission(string(permission)) == "" {
+			validationErr = errors.Join(validationErr, errWalletPermissionInvalid)
+			break
+		}
+	}
+
+	return validationErr
+}
+
+// Allows reports whether the grant covers the permission. Any grant implies view.
+func (g WalletPermissionGrant) Allows(permission WalletPermission) bool {
+	if permission == WalletPermissionView && len(g.Permissions) > 0 {
+		return true
+	}
+	return slices.Contains(g.Permissions, permission)
+}
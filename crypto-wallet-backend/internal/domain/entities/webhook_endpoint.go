@@ -0,0 +1,155 @@
+package entities
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxWebhookEndpointsPerUser bounds how many endpoints one user may register.
+const MaxWebhookEndpointsPerUser = 10
+
+var (
+	errWebhookEndpointUserIDRequired = errors.New("webhook endpoint user ID is required")
+	errWebhookEndpointURLInvalid     = errors.New("webhook endpoint URL must be an absolute https URL")
+	errWebhookEndpointSecretRequired = errors.New("webhook endpoint secret is required")
+)
+
+// WebhookEndpoint exposes the behavior required by the application layer when working with webhook endpoints.
+type WebhookEndpoint interface {
+	Entity
+	Identifiable
+	Timestamped
+
+	GetUserID() uuid.UUID
+	GetURL() string
+	GetDescription() string
+	// GetEncryptedSecret returns the signing secret encrypted at rest.
+	GetEncryptedSecret() string
+	IsActive() bool
+}
+
+// WebhookEndpointEntity is the default implementation of the WebhookEndpoint interface.
+type WebhookEndpointEntity struct {
+	id              uuid.UUID
+	userID          uuid.UUID
+	url             string
+	description     string
+	encryptedSecret string
+	active          bool
+	createdAt       time.Time
+	updatedAt       time.Time
+}
+
+// WebhookEndpointParams captures the fields required to construct a WebhookEndpointEntity.
+type WebhookEndpointParams struct {
+	ID              uuid.UUID
+	UserID          uuid.UUID
+	URL             string
+	Description     string
+	EncryptedSecret string
+	Active          bool
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// NewWebhookEndpointEntity validates the supplied parameters and returns a new WebhookEndpointEntity.
+func NewWebhookEndpointEntity(params WebhookEndpointParams) (*WebhookEndpointEntity, error) {
+	if params.ID == uuid.Nil {
+		params.ID = uuid.New()
+	}
+
+	if params.CreatedAt.IsZero() {
+		params.CreatedAt = time.Now().UTC()
+	}
+
+	if params.UpdatedAt.IsZero() {
+		params.UpdatedAt = params.CreatedAt
+	}
+
+	entity := HydrateWebhookEndpointEntity(params)
+	if err := entity.Validate(); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// HydrateWebhookEndpointEntity creates a WebhookEndpointEntity without re-validating invariants (used for repository hydration).
+func HydrateWebhookEndpointEntity(params WebhookEndpointParams) *WebhookEndpointEntity {
+	return &WebhookEndpointEntity{
+		id:              params.ID,
+		userID:          params.UserID,
+		url:             strings.TrimSpace(params.URL),
+		description:     strings.TrimSpace(params.Description),
+		encryptedSecret: params.EncryptedSecret,
+		active:          params.Active,
+		createdAt:       params.CreatedAt,
+		updatedAt:       params.UpdatedAt,
+	}
+}
+
+// Validate ensures the entity adheres to domain invariants.
+func (w *WebhookEndpointEntity) Validate() error {
+	var validationErr error
+
+	if w.userID == uuid.Nil {
+		validationErr = errors.Join(validationErr, errWebhookEndpointUserIDRequired)
+	}
+
+	if !IsValidWebhookURL(w.url) {
+		validationErr = errors.Join(validationErr, errWebhookEndpointURLInvalid)
+	}
+
+	if w.encryptedSecret == "" {
+		validationErr = errors.Join(validationErr, errWebhookEndpointSecretRequired)
+	}
+
+	return validationErr
+}
+
+// Getter implementations satisfy the WebhookEndpoint interface.
+
+func (w *WebhookEndpointEntity) GetID() uuid.UUID {
+	return w.id
+}
+
+func (w *WebhookEndpointEntity) GetUserID() uuid.UUID {
+	return w.userID
+}
+
+func (w *WebhookEndpointEntity) GetURL() string {
+	return w.url
+}
+
+func (w *WebhookEndpointEntity) GetDescription() string {
+	return w.description
+}
+
+func (w *WebhookEndpointEntity) GetEncryptedSecret() string {
+	return w.encryptedSecret
+}
+
+func (w *WebhookEndpointEntity) IsActive() bool {
+	return w.active
+}
+
+func (w *WebhookEndpointEntity) GetCreatedAt() time.Time {
+	return w.createdAt
+}
+
+func (w *WebhookEndpointEntity) GetUpdatedAt() time.Time {
+	return w.updatedAt
+}
+
+// IsValidWebhookURL reports whether raw is an absolute https URL without credentials.
+func IsValidWebhookURL(raw string) bool {
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return false
+	}
+	return parsed.Scheme == "https" && parsed.Host != "" && parsed.User == nil
+}
@@ -0,0 +1,116 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// Publisher is what producers depend on to raise events.
+type Publisher interface {
+	Publish(ctx context.Context, event Event) error
+}
+
+// Handler receives the events a subscription matches.
+type Handler func(ctx context.Context, event Event) error
+
+// Handle adapts a handler for one event type. Events of other types are ignored, so a typed
+// handler can share a pattern subscription with others.
+func Handle[E Event](handle func(ctx context.Context, event E) error) Handler {
+	return func(ctx context.Context, event Event) error {
+		typed, ok := event.(E)
+		if !ok {
+			return nil
+		}
+		return handle(ctx, typed)
+	}
+}
+
+// Notifier is the named-event interface the outbox, the notification inbox and pub/sub expose.
+type Notifier interface {
+	Notify(ctx context.Context, event string, data map[string]any) error
+}
+
+// Forward hands every event to a notifier under its name and payload.
+func Forward(notifier Notifier) Handler {
+	return func(ctx context.Context, event Event) error {
+		return notifier.Notify(ctx, event.EventName(), event.Payload())
+	}
+}
+
+type subscription struct {
+	name    string
+	pattern string
+	handler Handler
+}
+
+// Dispatcher delivers events to subscribers in the order they subscribed, synchronously and with
+// the publisher's context, so a subscriber sees the request's audit metadata and deadline.
+// Subscribers that do slow I/O, such as webhook delivery, hand the work off themselves.
+type Dispatcher struct {
+	mu            sync.RWMutex
+	subscriptions []subscription
+	logger        *slog.Logger
+	now           func() time.Time
+}
+
+// NewDispatcher constructs a Dispatcher without subscribers.
+func NewDispatcher(logger *slog.Logger) *Dispatcher {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Dispatcher{
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Subscribe registers handler, named for logs, for events whose name matches pattern as
+// entities.MatchEventType does: "*" for every event, "wallet.*" for a family, or one name.
+func (d *Dispatcher) Subscribe(name, pattern string, handler Handler) {
+	if handler == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.subscriptions = append(d.subscriptions, subscription{name: name, pattern: pattern, handler: handler})
+}
+
+// Publish hands the event to every matching subscriber. A failing subscriber does not stop the
+// others; their errors are logged and returned joined.
+func (d *Dispatcher) Publish(ctx context.Context, event Event) error {
+	if event == nil {
+		return nil
+	}
+	name := event.EventName()
+
+	d.mu.RLock()
+	subscriptions := d.subscriptions
+	d.mu.RUnlock()
+
+	var publishErr error
+	for _, sub := range subscriptions {
+		if !entities.MatchEventType(sub.pattern, name) {
+			continue
+		}
+		if err := sub.handler(ctx, event); err != nil {
+			d.logger.Error("event subscriber failed",
+				slog.String("event", name),
+				slog.String("subscriber", sub.name),
+				slog.String("error", err.Error()))
+			publishErr = errors.Join(publishErr, fmt.Errorf("%s: %w", sub.name, err))
+		}
+	}
+	return publishErr
+}
+
+// Notify publishes an untyped event, so producers that announce events by name go through the same
+// subscribers as typed ones.
+func (d *Dispatcher) Notify(ctx context.Context, event string, data map[string]any) error {
+	return d.Publish(ctx, Named{Name: event, Data: data, At: d.now().UTC()})
+}
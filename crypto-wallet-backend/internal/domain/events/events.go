@@ -0,0 +1,185 @@
+// Package events defines the domain events raised over a wallet's lifecycle and the in-process
+// dispatcher that hands them to subscribers such as the outbox, the notification inbox, audit and
+// webhooks.
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// Event names. They match the names these events were published under before they were typed, so
+// outbox consumers and notification templates keep matching them.
+const (
+	WalletCreatedEvent        = "wallet.created"
+	BalanceUpdatedEvent       = "wallet.balance_updated"
+	TransactionConfirmedEvent = "transaction.confirmed"
+	ExchangeCompletedEvent    = "exchange.completed"
+)
+
+// Event is something that happened in the domain.
+type Event interface {
+	EventName() string
+	OccurredAt() time.Time
+	// UserID is the account the event concerns, or uuid.Nil when the producer does not know it.
+	UserID() uuid.UUID
+	// Payload is the event's wire form for the outbox, notifications and webhooks.
+	Payload() map[string]any
+}
+
+// WalletCreated is raised once a new wallet has been stored.
+type WalletCreated struct {
+	WalletID uuid.UUID
+	OwnerID  uuid.UUID
+	Chain    entities.Chain
+	Address  string
+	Label    string
+	At       time.Time
+}
+
+func (e WalletCreated) EventName() string     { return WalletCreatedEvent }
+func (e WalletCreated) OccurredAt() time.Time { return e.At }
+func (e WalletCreated) UserID() uuid.UUID     { return e.OwnerID }
+
+func (e WalletCreated) Payload() map[string]any {
+	return map[string]any{
+		"wallet_id": e.WalletID.String(),
+		"user_id":   e.OwnerID.String(),
+		"chain":     string(e.Chain),
+		"address":   e.Address,
+		"label":     e.Label,
+	}
+}
+
+// BalanceUpdated is raised when a wallet's stored balance changes. TransactionID is set when a
+// transaction caused the change.
+type BalanceUpdated struct {
+	WalletID      uuid.UUID
+	OwnerID       uuid.UUID
+	Chain         entities.Chain
+	Previous      decimal.Decimal
+	Balance       decimal.Decimal
+	TransactionID uuid.UUID
+	At            time.Time
+}
+
+func (e BalanceUpdated) EventName() string     { return BalanceUpdatedEvent }
+func (e BalanceUpdated) OccurredAt() time.Time { return e.At }
+func (e BalanceUpdated) UserID() uuid.UUID     { return e.OwnerID }
+
+func (e BalanceUpdated) Payload() map[string]any {
+	payload := map[string]any{
+		"wallet_id":        e.WalletID.String(),
+		"user_id":          e.OwnerID.String(),
+		"chain":            string(e.Chain),
+		"balance":          e.Balance.String(),
+		"previous_balance": e.Previous.String(),
+	}
+	if e.TransactionID != uuid.Nil {
+		payload["transaction_id"] = e.TransactionID.String()
+	}
+	return payload
+}
+
+// TransactionConfirmed is raised when a transaction reaches its chain's confirmation threshold. The
+// transaction monitor does not load wallets, so OwnerID may be unset; subscribers resolve the owner
+// from WalletID when they need it.
+type TransactionConfirmed struct {
+	TransactionID uuid.UUID
+	WalletID      uuid.UUID
+	OwnerID       uuid.UUID
+	Chain         entities.Chain
+	Hash          string
+	Confirmations int
+	Threshold     int
+	BlockNumber   *uint64
+	At            time.Time
+}
+
+func (e TransactionConfirmed) EventName() string     { return TransactionConfirmedEvent }
+func (e TransactionConfirmed) OccurredAt() time.Time { return e.At }
+func (e TransactionConfirmed) UserID() uuid.UUID     { return e.OwnerID }
+
+func (e TransactionConfirmed) Payload() map[string]any {
+	payload := map[string]any{
+		"transaction_id":         e.TransactionID.String(),
+		"wallet_id":              e.WalletID.String(),
+		"chain":                  string(e.Chain),
+		"hash":                   e.Hash,
+		"status":                 string(entities.TransactionStatusConfirmed),
+		"confirmations":          e.Confirmations,
+		"confirmation_threshold": e.Threshold,
+	}
+	if e.OwnerID != uuid.Nil {
+		payload["user_id"] = e.OwnerID.String()
+	}
+	if e.BlockNumber != nil {
+		payload["block_number"] = *e.BlockNumber
+	}
+	return payload
+}
+
+// ExchangeCompleted is raised when an exchange operation has settled.
+type ExchangeCompleted struct {
+	OperationID  uuid.UUID
+	OwnerID      uuid.UUID
+	FromWalletID uuid.UUID
+	ToWalletID   uuid.UUID
+	FromAmount   decimal.Decimal
+	ToAmount     decimal.Decimal
+	ExchangeRate decimal.Decimal
+	FeeAmount    decimal.Decimal
+	ExecutedAt   *time.Time
+	At           time.Time
+}
+
+func (e ExchangeCompleted) EventName() string     { return ExchangeCompletedEvent }
+func (e ExchangeCompleted) OccurredAt() time.Time { return e.At }
+func (e ExchangeCompleted) UserID() uuid.UUID     { return e.OwnerID }
+
+func (e ExchangeCompleted) Payload() map[string]any {
+	payload := map[string]any{
+		"operation_id":   e.OperationID.String(),
+		"user_id":        e.OwnerID.String(),
+		"status":         string(entities.ExchangeStatusCompleted),
+		"from_wallet_id": e.FromWalletID.String(),
+		"to_wallet_id":   e.ToWalletID.String(),
+		"from_amount":    e.FromAmount.String(),
+		"to_amount":      e.ToAmount.String(),
+		"exchange_rate":  e.ExchangeRate.String(),
+		"fee_amount":     e.FeeAmount.String(),
+	}
+	if e.ExecutedAt != nil {
+		payload["executed_at"] = e.ExecutedAt.UTC().Format(time.RFC3339)
+	}
+	return payload
+}
+
+// Named carries events that have no type of their own yet, such as KYC decisions or failed
+// exchanges, under the name and payload their producers already publish.
+type Named struct {
+	Name string
+	Data map[string]any
+	At   time.Time
+}
+
+func (e Named) EventName() string       { return e.Name }
+func (e Named) OccurredAt() time.Time   { return e.At }
+func (e Named) Payload() map[string]any { return e.Data }
+
+// UserID reads the owner from the payload's user_id, which most producers include.
+func (e Named) UserID() uuid.UUID {
+	switch value := e.Data["user_id"].(type) {
+	case uuid.UUID:
+		return value
+	case string:
+		if id, err := uuid.Parse(value); err == nil {
+			return id
+		}
+	}
+	return uuid.Nil
+}
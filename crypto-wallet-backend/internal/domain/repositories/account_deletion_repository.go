@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// AccountDeletionRepository defines the persistence contract for scheduled account deletions.
+type AccountDeletionRepository interface {
+	// Schedule records the deletion unless the user already has one and returns the stored record.
+	Schedule(ctx context.Context, deletion entities.AccountDeletion) (entities.AccountDeletion, error)
+	// ListDue returns up to limit unpurged deletions whose retention ended at or before now,
+	// earliest first.
+	ListDue(ctx context.Context, now time.Time, limit int) ([]entities.AccountDeletion, error)
+	// Purge hard-deletes the soft-deleted user, cascading to their core data, and marks the
+	// deletion purged. Returns ErrConflict when the user is no longer soft-deleted.
+	Purge(ctx context.Context, userID uuid.UUID, at time.Time) error
+	// Cancel drops an unpurged deletion, for accounts reinstated before their purge.
+	Cancel(ctx context.Context, userID uuid.UUID) error
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// AccountMappingRepository defines the persistence contract for per-user accounting account mappings.
+type AccountMappingRepository interface {
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]entities.AccountMapping, error)
+	// ReplaceForUser swaps the user's full set of mappings atomically.
+	ReplaceForUser(ctx context.Context, userID uuid.UUID, mappings []entities.AccountMapping) error
+}
@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// ActivityFilter narrows the activity feed. An empty Kinds includes every kind.
+type ActivityFilter struct {
+	Kinds    []entities.ActivityKind
+	WalletID *uuid.UUID
+}
+
+// ActivityRepository reads the merged, newest-first feed of a user's transactions and exchanges.
+type ActivityRepository interface {
+	// ListRecentActivity returns up to limit items ordered by (occurred_at, id) descending,
+	// starting after the cursor when one is given.
+	ListRecentActivity(ctx context.Context, userID uuid.UUID, filter ActivityFilter, after *PageCursor, limit int) ([]entities.ActivityItem, error)
+}
@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// AddressBookRepository defines the persistence contract for saved recipient addresses and the
+// whitelist-only send setting.
+type AddressBookRepository interface {
+	// Create stores a new entry; ErrDuplicate is returned when the user already saved the address on that chain.
+	Create(ctx context.Context, entry *entities.AddressBookEntryEntity) error
+	GetByID(ctx context.Context, id uuid.UUID) (entities.AddressBookEntry, error)
+	// FindByAddress matches an address against the user's entries using entities.AddressBookKey.
+	FindByAddress(ctx context.Context, userID uuid.UUID, chain entities.Chain, address string) (entities.AddressBookEntry, error)
+	// ListByUser returns the user's entries, newest first, optionally restricted to one chain.
+	ListByUser(ctx context.Context, userID uuid.UUID, chain *entities.Chain) ([]entities.AddressBookEntry, error)
+	CountByUser(ctx context.Context, userID uuid.UUID) (int, error)
+	// UpdateLabel persists a renamed entry's label and memo.
+	UpdateLabel(ctx context.Context, entry *entities.AddressBookEntryEntity) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// GetSettings returns the user's settings, or zero settings for the user when none were saved.
+	GetSettings(ctx context.Context, userID uuid.UUID) (entities.AddressBookSettings, error)
+	SaveSettings(ctx context.Context, settings entities.AddressBookSettings) error
+}
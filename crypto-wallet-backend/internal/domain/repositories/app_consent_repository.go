@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// AppConsentRepository defines the persistence contract for third-party apps and user consents.
+type AppConsentRepository interface {
+	// GetClient returns ErrNotFound when no client is registered under the ID.
+	GetClient(ctx context.Context, clientID string) (entities.OAuthClient, error)
+
+	CreateConsent(ctx context.Context, consent entities.AppConsent) error
+	// GetConsent returns revoked consents too; callers check IsActive.
+	GetConsent(ctx context.Context, id uuid.UUID) (entities.AppConsent, error)
+	// ListActiveConsents returns the user's unrevoked consents, newest first.
+	ListActiveConsents(ctx context.Context, userID uuid.UUID) ([]entities.AppConsent, error)
+	// RevokeConsent marks the user's consent revoked; returns ErrNotFound when it is missing or already revoked.
+	RevokeConsent(ctx context.Context, userID, id uuid.UUID, at time.Time) error
+}
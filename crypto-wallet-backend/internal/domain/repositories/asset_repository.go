@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// AssetRepository defines read access to the asset registry.
+type AssetRepository interface {
+	// GetByID returns ErrNotFound when the asset is not registered.
+	GetByID(ctx context.Context, id uuid.UUID) (entities.Asset, error)
+	// GetNative returns the chain's native asset or ErrNotFound.
+	GetNative(ctx context.Context, chain entities.Chain) (entities.Asset, error)
+	// List returns registered assets ordered by chain and symbol.
+	List(ctx context.Context, activeOnly bool) ([]entities.Asset, error)
+}
@@ -0,0 +1,39 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// AuditLogFilter narrows audit log searches. Empty fields are ignored; IPAddress accepts
+// a single address or a CIDR range.
+type AuditLogFilter struct {
+	ActorID      *uuid.UUID
+	Actions      []string
+	ResourceType string
+	ResourceID   *uuid.UUID
+	IPAddress    string
+	From         *time.Time
+	To           *time.Time
+}
+
+// AuditLogRepository defines the persistence contract for the hash-chained audit log.
+type AuditLogRepository interface {
+	// Append chains the record onto the latest record and stores it; appends are serialised.
+	Append(ctx context.Context, record *entities.AuditRecord) error
+	Search(ctx context.Context, filter AuditLogFilter, opts ListOptions) ([]entities.AuditRecord, int64, error)
+	// ListAfterSequence returns chained records in sequence order, starting after the given sequence.
+	ListAfterSequence(ctx context.Context, afterSequence int64, limit int) ([]entities.AuditRecord, error)
+	// ListBefore returns the oldest records written before cutoff, in sequence order.
+	ListBefore(ctx context.Context, cutoff time.Time, limit int) ([]entities.AuditRecord, error)
+
+	// LatestArchive returns the most recent archive; ErrNotFound when nothing was archived yet.
+	LatestArchive(ctx context.Context) (entities.AuditArchive, error)
+	ListArchives(ctx context.Context, opts ListOptions) ([]entities.AuditArchive, error)
+	// RecordArchive stores the archive and deletes the records it covers in one transaction.
+	RecordArchive(ctx context.Context, archive entities.AuditArchive) error
+}
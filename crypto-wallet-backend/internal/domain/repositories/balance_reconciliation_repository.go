@@ -0,0 +1,35 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// BalanceDiscrepancyFilter narrows discrepancy listings for support review.
+type BalanceDiscrepancyFilter struct {
+	Status *entities.BalanceDiscrepancyStatus
+	Chain  *entities.Chain
+	RunID  *uuid.UUID
+}
+
+// BalanceReconciliationRepository defines the persistence contract for reconciliation runs and the
+// discrepancies they record.
+type BalanceReconciliationRepository interface {
+	CreateRun(ctx context.Context, run *entities.BalanceReconciliationRun) error
+	// FinishRun stores the run's final counts and finish time.
+	FinishRun(ctx context.Context, run *entities.BalanceReconciliationRun) error
+	// ListRuns returns runs, newest first.
+	ListRuns(ctx context.Context, chain *entities.Chain, opts ListOptions) ([]entities.BalanceReconciliationRun, error)
+
+	// RecordDiscrepancy stores a discrepancy. An open discrepancy for a wallet that already has one
+	// replaces the balances and run of the existing item, whose ID is written back.
+	RecordDiscrepancy(ctx context.Context, discrepancy *entities.BalanceDiscrepancy) error
+	GetDiscrepancy(ctx context.Context, id uuid.UUID) (*entities.BalanceDiscrepancy, error)
+	// ListDiscrepancies returns discrepancies, largest magnitude first.
+	ListDiscrepancies(ctx context.Context, filter BalanceDiscrepancyFilter, opts ListOptions) ([]entities.BalanceDiscrepancy, error)
+	// ResolveDiscrepancy closes an open discrepancy, returning ErrConflict when it is no longer open.
+	ResolveDiscrepancy(ctx context.Context, discrepancy *entities.BalanceDiscrepancy) error
+}
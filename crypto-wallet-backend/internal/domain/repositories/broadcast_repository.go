@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// SegmentScope is a segment ready to be evaluated against the users table. Attributes stored
+// outside the core database, such as KYC status, are resolved to user IDs beforehand: when
+// Restricted is set only users in UserIDs can match.
+type SegmentScope struct {
+	Segment    entities.BroadcastSegment
+	UserIDs    []uuid.UUID
+	Restricted bool
+}
+
+// BroadcastFilter narrows campaign listings.
+type BroadcastFilter struct {
+	Status *entities.BroadcastCampaignStatus
+}
+
+// BroadcastRepository defines the persistence contract for broadcast campaigns and their deliveries.
+type BroadcastRepository interface {
+	Create(ctx context.Context, campaign *entities.BroadcastCampaignEntity) error
+	GetByID(ctx context.Context, id uuid.UUID) (entities.BroadcastCampaign, error)
+	List(ctx context.Context, filter BroadcastFilter, opts ListOptions) ([]entities.BroadcastCampaign, error)
+	// Cancel stores a cancelled campaign and drops its undelivered recipients. Returns ErrConflict
+	// when the campaign finished in the meantime.
+	Cancel(ctx context.Context, campaign *entities.BroadcastCampaignEntity) error
+
+	// CountRecipients returns how many users the segment currently matches.
+	CountRecipients(ctx context.Context, scope SegmentScope) (int64, error)
+	// ListDue returns up to limit scheduled campaigns whose send time is at or before now, oldest first.
+	ListDue(ctx context.Context, now time.Time, limit int) ([]entities.BroadcastCampaign, error)
+	// Start fixes the campaign's recipient list from the segment and moves it to sending. Returns
+	// ErrConflict when the campaign is no longer scheduled.
+	Start(ctx context.Context, campaignID uuid.UUID, scope SegmentScope, at time.Time) (int64, error)
+	// ListSending returns campaigns that still have deliveries in flight.
+	ListSending(ctx context.Context, limit int) ([]entities.BroadcastCampaign, error)
+	// ClaimDeliveries takes up to limit pending recipients whose last attempt, if any, was before
+	// retryBefore and records the attempt. Several dispatchers may claim concurrently.
+	ClaimDeliveries(ctx context.Context, campaignID uuid.UUID, at, retryBefore time.Time, limit int) ([]uuid.UUID, error)
+	MarkDelivered(ctx context.Context, campaignID, userID uuid.UUID, at time.Time) error
+	// MarkDeliveryFailed records a failed attempt; the delivery stays pending until maxAttempts is reached.
+	MarkDeliveryFailed(ctx context.Context, campaignID, userID uuid.UUID, message string, maxAttempts int) error
+	// CompleteIfDrained marks a sending campaign completed once no deliveries are pending and reports whether it did.
+	CompleteIfDrained(ctx context.Context, campaignID uuid.UUID, at time.Time) (bool, error)
+
+	// MarkOpened records the first time a recipient opened a delivered message. Returns ErrNotFound
+	// when the user was not delivered the campaign.
+	MarkOpened(ctx context.Context, campaignID, userID uuid.UUID, at time.Time) error
+	// Stats summarises a campaign's deliveries.
+	Stats(ctx context.Context, campaignID uuid.UUID) (entities.BroadcastStats, error)
+}
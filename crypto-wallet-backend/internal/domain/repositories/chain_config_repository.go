@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// ChainConfigRepository defines the persistence contract for the chain registry.
+type ChainConfigRepository interface {
+	// GetBySymbol returns ErrNotFound when the chain is not registered.
+	GetBySymbol(ctx context.Context, chain entities.Chain) (entities.ChainConfig, error)
+	// List returns every registered chain ordered by symbol.
+	List(ctx context.Context) ([]entities.ChainConfig, error)
+	// Upsert registers the chain or replaces its configuration.
+	Upsert(ctx context.Context, chain *entities.ChainEntity) error
+}
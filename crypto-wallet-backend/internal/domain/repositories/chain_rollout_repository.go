@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// ChainRolloutRepository defines the persistence contract for chain soft-launch settings and beta opt-ins.
+type ChainRolloutRepository interface {
+	// GetRollout returns ErrNotFound when the chain has no rollout, i.e. it is generally available.
+	GetRollout(ctx context.Context, chain entities.Chain) (entities.ChainRollout, error)
+	ListRollouts(ctx context.Context) ([]entities.ChainRollout, error)
+	UpsertRollout(ctx context.Context, rollout entities.ChainRollout) error
+	DeleteRollout(ctx context.Context, chain entities.Chain) error
+
+	IsOptedIn(ctx context.Context, userID uuid.UUID, chain entities.Chain) (bool, error)
+	// SetOptIn records or withdraws the user's beta opt-in; both are idempotent.
+	SetOptIn(ctx context.Context, userID uuid.UUID, chain entities.Chain, optedIn bool) error
+}
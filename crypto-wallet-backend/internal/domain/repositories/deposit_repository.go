@@ -0,0 +1,27 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// CreditedDeposit is a deposit that was recorded and credited, with the wallet's balance after it.
+type CreditedDeposit struct {
+	Transaction entities.Transaction
+	UserID      uuid.UUID
+	Balance     decimal.Decimal
+}
+
+// DepositRepository persists inbound transfers found by the deposit scanner.
+type DepositRepository interface {
+	// GetCursor returns the chain's scan cursor, or ErrNotFound before the first scan.
+	GetCursor(ctx context.Context, chain entities.Chain) (entities.DepositCursor, error)
+	// RecordDeposits atomically stores the receive transactions, credits each one's wallet and
+	// advances the cursor. Deposits already recorded are skipped without crediting again; only
+	// the newly credited ones are returned.
+	RecordDeposits(ctx context.Context, cursor entities.DepositCursor, deposits []*entities.TransactionEntity) ([]CreditedDeposit, error)
+}
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// EmailVerificationRepository defines the persistence contract for email verification tokens.
+type EmailVerificationRepository interface {
+	Create(ctx context.Context, token entities.EmailVerificationToken) error
+	// GetByTokenHash returns the token whatever its state, or ErrNotFound.
+	GetByTokenHash(ctx context.Context, tokenHash string) (entities.EmailVerificationToken, error)
+	// GetLatestByUser returns the user's most recently issued token, or ErrNotFound.
+	GetLatestByUser(ctx context.Context, userID uuid.UUID) (entities.EmailVerificationToken, error)
+	// MarkUsed marks the token used. Returns ErrConflict when it was already used.
+	MarkUsed(ctx context.Context, id uuid.UUID, at time.Time) error
+}
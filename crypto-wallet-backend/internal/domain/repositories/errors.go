@@ -7,4 +7,8 @@ var (
 	ErrNotFound = errors.New("repository: entity not found")
 	// ErrDuplicate indicates that the entity being created already exists.
 	ErrDuplicate = errors.New("repository: duplicate entity")
+	// ErrInsufficientFunds indicates that a wallet balance cannot cover a debit.
+	ErrInsufficientFunds = errors.New("repository: insufficient funds")
+	// ErrConflict indicates that the entity changed state concurrently.
+	ErrConflict = errors.New("repository: entity state conflict")
 )
@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// EventOutboxRepository defines the persistence contract for the domain event outbox.
+type EventOutboxRepository interface {
+	Append(ctx context.Context, event entities.DomainEvent) error
+	// ListAfter returns events with a sequence greater than afterSequence in sequence order.
+	ListAfter(ctx context.Context, afterSequence int64, limit int) ([]entities.DomainEvent, error)
+	// ListRange returns events that occurred in [from, to) with a sequence greater than afterSequence.
+	ListRange(ctx context.Context, from, to time.Time, afterSequence int64, limit int) ([]entities.DomainEvent, error)
+	// ListAfterMatching is ListAfter restricted to events whose type matches one of the allowed
+	// patterns and, when requested is not empty, one of the requested patterns as well. Patterns
+	// follow entities.MatchEventType.
+	ListAfterMatching(ctx context.Context, afterSequence int64, allowed, requested []string, limit int) ([]entities.DomainEvent, error)
+
+	// GetCursor returns the last exported sequence for a sink, or zero when it has not exported yet.
+	GetCursor(ctx context.Context, sink string) (int64, error)
+	SaveCursor(ctx context.Context, sink string, sequence int64) error
+}
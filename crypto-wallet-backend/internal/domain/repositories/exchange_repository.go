@@ -21,6 +21,25 @@ type ExchangeOperationFilter struct {
 	MaxAmount    *decimal.Decimal
 }
 
+// PendingExchangeFilter selects pending exchange operations. BaseAssetID and QuoteAssetID match the
+// assets held by the source and destination wallets, which identifies a trading pair; ExpiredAt
+// keeps only quotes that had expired by then.
+type PendingExchangeFilter struct {
+	UserID       *uuid.UUID
+	BaseAssetID  *uuid.UUID
+	QuoteAssetID *uuid.UUID
+	ExpiredAt    *time.Time
+}
+
+// ExecutedExchangeFilter selects completed exchange operations by trading pair assets, as in
+// PendingExchangeFilter, and by execution time: ExecutedFrom inclusive, ExecutedTo exclusive.
+type ExecutedExchangeFilter struct {
+	BaseAssetID  *uuid.UUID
+	QuoteAssetID *uuid.UUID
+	ExecutedFrom *time.Time
+	ExecutedTo   *time.Time
+}
+
 // TradingPairFilter captures optional filters when listing trading pairs.
 type TradingPairFilter struct {
 	BaseSymbol   *string
@@ -35,9 +54,20 @@ type ExchangeOperationRepository interface {
 	GetByID(ctx context.Context, id uuid.UUID) (entities.ExchangeOperation, error)
 	GetByUser(ctx context.Context, userID uuid.UUID, filter ExchangeOperationFilter, opts ListOptions) ([]entities.ExchangeOperation, error)
 	GetPendingByUser(ctx context.Context, userID uuid.UUID) ([]entities.ExchangeOperation, error)
-	GetExpiredPending(ctx context.Context) ([]entities.ExchangeOperation, error)
+	// ListPending returns up to limit pending operations matching the filter, soonest expiry first.
+	ListPending(ctx context.Context, filter PendingExchangeFilter, limit int) ([]entities.ExchangeOperation, error)
+	// ListExecuted returns up to limit completed operations matching the filter, oldest execution first.
+	ListExecuted(ctx context.Context, filter ExecutedExchangeFilter, limit int) ([]entities.ExchangeOperation, error)
 	Create(ctx context.Context, operation *entities.ExchangeOperationEntity) error
 	Update(ctx context.Context, operation entities.ExchangeOperation) error
+	// ClaimForExecution serialises executors of one operation and moves it from pending to
+	// processing when its quote is still valid at the given time. claimed reports whether this
+	// caller made the transition; otherwise the operation is returned in its current state.
+	ClaimForExecution(ctx context.Context, id uuid.UUID, at time.Time) (operation entities.ExchangeOperation, claimed bool, err error)
+	// CancelPending moves the operation from pending to cancelled under the same lock, recording
+	// reason when it is not empty. cancelled reports whether this caller made the transition;
+	// otherwise the operation is returned in the state it had already reached.
+	CancelPending(ctx context.Context, id uuid.UUID, reason string, at time.Time) (operation entities.ExchangeOperation, cancelled bool, err error)
 	Delete(ctx context.Context, id uuid.UUID) error
 
 	// Statistics and analytics
@@ -50,6 +80,7 @@ type TradingPairRepository interface {
 	// Trading pairs
 	GetByID(ctx context.Context, id uuid.UUID) (entities.TradingPair, error)
 	GetBySymbols(ctx context.Context, baseSymbol, quoteSymbol string) (entities.TradingPair, error)
+	GetByAssets(ctx context.Context, baseAssetID, quoteAssetID uuid.UUID) (entities.TradingPair, error)
 	List(ctx context.Context, filter TradingPairFilter, opts ListOptions) ([]entities.TradingPair, error)
 	GetActivePairs(ctx context.Context) ([]entities.TradingPair, error)
 	GetPairsBySymbol(ctx context.Context, symbol string) ([]entities.TradingPair, error)
@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// ExportJobRepository defines the persistence contract for asynchronous export jobs.
+// Generated file contents are stored alongside the job and fetched separately from its metadata,
+// unless the file was written to object storage, in which case only its storage key is kept.
+type ExportJobRepository interface {
+	Create(ctx context.Context, job *entities.ExportJobEntity) error
+	GetByID(ctx context.Context, id uuid.UUID) (entities.ExportJob, error)
+	ListByUser(ctx context.Context, userID uuid.UUID, opts ListOptions) ([]entities.ExportJob, error)
+	// CountActiveByUser returns how many of the user's jobs are queued or processing.
+	CountActiveByUser(ctx context.Context, userID uuid.UUID) (int, error)
+	// CountQueued returns how many jobs are waiting to be claimed across all users.
+	CountQueued(ctx context.Context) (int64, error)
+
+	// ClaimNext moves the oldest queued job to processing, also reclaiming jobs whose processing
+	// started before staleBefore. Returns ErrNotFound when the queue is empty.
+	ClaimNext(ctx context.Context, now, staleBefore time.Time) (entities.ExportJob, error)
+	// Complete stores the generated file together with the job's completed state. content is nil
+	// when the file lives in object storage under the job's storage key.
+	Complete(ctx context.Context, job *entities.ExportJobEntity, content []byte) error
+	// Fail records a job that could not be generated.
+	Fail(ctx context.Context, job *entities.ExportJobEntity) error
+	// GetContent returns the generated file of a completed job.
+	GetContent(ctx context.Context, id uuid.UUID) ([]byte, error)
+	// PurgeExpired drops generated files whose download window has passed and reports how many were purged.
+	PurgeExpired(ctx context.Context, now time.Time) (int64, error)
+	// ListFinishedBefore returns up to limit completed or failed jobs created before the cutoff, oldest first.
+	ListFinishedBefore(ctx context.Context, before time.Time, limit int) ([]uuid.UUID, error)
+	// DeleteFinished removes the given jobs that are still completed or failed and reports how many were removed.
+	DeleteFinished(ctx context.Context, ids []uuid.UUID) (int64, error)
+}
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// FiatRateRepository defines the persistence contract for fiat reference rates.
+type FiatRateRepository interface {
+	// GetFiatRate returns ErrNotFound when no rate has been synced for the currency.
+	GetFiatRate(ctx context.Context, currency entities.CurrencyCode) (entities.FiatRate, error)
+	ListFiatRates(ctx context.Context) ([]entities.FiatRate, error)
+	UpsertFiatRates(ctx context.Context, rates []entities.FiatRate) error
+}
@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// GasPriceRepository defines the persistence contract for the gas oracle's fee history and users'
+// default gas speed.
+type GasPriceRepository interface {
+	// SaveSamples stores samples, ignoring blocks that were already recorded.
+	SaveSamples(ctx context.Context, samples []entities.GasPriceSample) error
+	// ListSamples returns the chain's samples observed at or after since, newest block first.
+	ListSamples(ctx context.Context, chain entities.Chain, since time.Time, limit int) ([]entities.GasPriceSample, error)
+	// PurgeSamplesBefore deletes samples observed before cutoff and returns how many were removed.
+	PurgeSamplesBefore(ctx context.Context, cutoff time.Time) (int64, error)
+
+	// GetSpeedPreference returns ErrNotFound when the user has not chosen a speed.
+	GetSpeedPreference(ctx context.Context, userID uuid.UUID) (entities.GasSpeed, error)
+	SetSpeedPreference(ctx context.Context, userID uuid.UUID, speed entities.GasSpeed) error
+}
@@ -0,0 +1,30 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// HDSeedRepository defines the persistence contract for users' HD wallet seeds and the account
+// indices derived from them.
+type HDSeedRepository interface {
+	// Create stores the user's seed. Returns ErrDuplicate when the user already has one.
+	Create(ctx context.Context, seed entities.HDSeed) error
+	// GetByUser returns the user's seed, or ErrNotFound.
+	GetByUser(ctx context.Context, userID uuid.UUID) (entities.HDSeed, error)
+	// ConfirmBackup records the backup confirmation. Returns ErrNotFound when the user has no seed
+	// and ErrConflict when the backup was already confirmed.
+	ConfirmBackup(ctx context.Context, userID uuid.UUID, at time.Time) error
+	// ReserveAccountIndex atomically hands out the next unused account index of the chain,
+	// starting at zero. Reserved indices are never reused.
+	ReserveAccountIndex(ctx context.Context, userID uuid.UUID, chain entities.Chain) (uint32, error)
+	// List returns up to limit seeds of users with IDs greater than after, ordered by user ID.
+	List(ctx context.Context, after uuid.UUID, limit int) ([]entities.HDSeed, error)
+	// ReplaceEncryptedSeed swaps the user's encrypted seed for replacement if it still equals
+	// current. Returns ErrConflict when it has changed and ErrNotFound when the seed is gone.
+	ReplaceEncryptedSeed(ctx context.Context, userID uuid.UUID, current, replacement string) error
+}
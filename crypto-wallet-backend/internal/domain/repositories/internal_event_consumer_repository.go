@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// InternalEventConsumerRepository defines the persistence contract for internal event consumers.
+type InternalEventConsumerRepository interface {
+	// Create returns ErrDuplicate when the name or certificate is already registered.
+	Create(ctx context.Context, consumer entities.InternalEventConsumer) (entities.InternalEventConsumer, error)
+	// GetByFingerprint returns ErrNotFound when no consumer holds the certificate.
+	GetByFingerprint(ctx context.Context, fingerprint string) (entities.InternalEventConsumer, error)
+	List(ctx context.Context) ([]entities.InternalEventConsumer, error)
+	TouchLastSeen(ctx context.Context, id uuid.UUID, at time.Time) error
+}
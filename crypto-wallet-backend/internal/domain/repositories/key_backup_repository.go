@@ -0,0 +1,27 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// KeyBackupRepository defines the persistence contract for disaster-recovery key backup requests.
+type KeyBackupRepository interface {
+	Create(ctx context.Context, request entities.KeyBackupRequest) error
+	// GetByID returns the request with its approvals, oldest first.
+	GetByID(ctx context.Context, id uuid.UUID) (entities.KeyBackupRequest, error)
+	// AddApproval returns ErrDuplicate when the operator already approved the request.
+	AddApproval(ctx context.Context, id uuid.UUID, approval entities.KeyBackupApproval) error
+	RemoveApproval(ctx context.Context, id uuid.UUID, approver string) error
+	// ClaimExport moves a pending, unexpired request to exporting and reports whether this caller
+	// won the claim.
+	ClaimExport(ctx context.Context, id uuid.UUID, exportedBy string, at time.Time) (bool, error)
+	CompleteExport(ctx context.Context, id uuid.UUID, archiveSHA256 string, walletCount int, at time.Time) error
+	FailExport(ctx context.Context, id uuid.UUID) error
+	// ListWalletKeys returns stored wallet key material ordered by wallet ID, after the given ID.
+	ListWalletKeys(ctx context.Context, afterID uuid.UUID, limit int) ([]entities.WalletKeyRecord, error)
+}
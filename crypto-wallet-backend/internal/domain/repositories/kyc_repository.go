@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -11,14 +12,67 @@ import (
 // KYCRepository defines persistence operations for compliance entities.
 type KYCRepository interface {
 	GetProfileByUserID(ctx context.Context, userID uuid.UUID) (entities.KYCProfile, error)
+	// ListProfilesByUserIDs returns the profiles that exist for the given users, in no particular order.
+	ListProfilesByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]entities.KYCProfile, error)
+	// ListUserIDsByStatus returns the users whose profile is in one of the statuses.
+	ListUserIDsByStatus(ctx context.Context, statuses []entities.KYCStatus) ([]uuid.UUID, error)
 	CreateProfile(ctx context.Context, profile *entities.KYCProfileEntity) error
 	UpdateProfile(ctx context.Context, profile entities.KYCProfile) error
+	// ListProfilesForReview returns profiles in one of the statuses, oldest submission first.
+	ListProfilesForReview(ctx context.Context, statuses []entities.KYCStatus, limit, offset int) ([]entities.KYCProfile, error)
+	// ListExpiredApprovals returns approved profiles whose expiry is at or before now, oldest expiry first.
+	ListExpiredApprovals(ctx context.Context, now time.Time, limit int) ([]entities.KYCProfile, error)
 
 	CreateDocument(ctx context.Context, document *entities.KYCDocumentEntity) error
 	GetDocumentByID(ctx context.Context, id uuid.UUID) (entities.KYCDocument, error)
 	ListDocumentsByProfile(ctx context.Context, profileID uuid.UUID) ([]entities.KYCDocument, error)
 	UpdateDocument(ctx context.Context, document entities.KYCDocument) error
 
+	CreateUpgradeRequest(ctx context.Context, upgrade *entities.KYCUpgradeRequestEntity) error
+	// GetLatestUpgradeRequest returns the user's most recent upgrade request, open or decided.
+	GetLatestUpgradeRequest(ctx context.Context, userID uuid.UUID) (entities.KYCUpgradeRequest, error)
+	UpdateUpgradeRequest(ctx context.Context, upgrade entities.KYCUpgradeRequest) error
+	// ListUpgradeRequestsUnderReview returns upgrades awaiting a provider decision, oldest submission first.
+	ListUpgradeRequestsUnderReview(ctx context.Context, limit int) ([]entities.KYCUpgradeRequest, error)
+	// CompleteUpgrade persists an approved upgrade together with the profile's new level and limits.
+	CompleteUpgrade(ctx context.Context, upgrade entities.KYCUpgradeRequest, profile entities.KYCProfile) error
+
 	GetRiskScoreByUserID(ctx context.Context, userID uuid.UUID) (entities.UserRiskScore, error)
 	UpsertRiskScore(ctx context.Context, score *entities.UserRiskScoreEntity) error
+	// ListRiskScoresDueForReview returns risk scores whose next review is at or before now, most overdue first.
+	ListRiskScoresDueForReview(ctx context.Context, now time.Time, limit int) ([]entities.UserRiskScore, error)
+	// ListProfilesWithoutRiskScore returns profiles in one of the statuses that have never been
+	// screened, oldest submission first.
+	ListProfilesWithoutRiskScore(ctx context.Context, statuses []entities.KYCStatus, limit int) ([]entities.KYCProfile, error)
+
+	// GetCountryRequirement returns the compliance matrix entry for a country code (or the * default entry).
+	GetCountryRequirement(ctx context.Context, countryCode string) (entities.KYCCountryRequirement, error)
+	ListCountryRequirements(ctx context.Context) ([]entities.KYCCountryRequirement, error)
+	UpsertCountryRequirement(ctx context.Context, requirement entities.KYCCountryRequirement) error
+	DeleteCountryRequirement(ctx context.Context, countryCode string) error
+
+	// ListDocumentTypeSpecs returns stored document type capture rules; types without a row use the defaults.
+	ListDocumentTypeSpecs(ctx context.Context) ([]entities.KYCDocumentTypeSpec, error)
+
+	// EnqueueComplianceReview adds an item to the manual compliance review queue.
+	EnqueueComplianceReview(ctx context.Context, item *entities.ComplianceReviewItem) error
+	// ListComplianceReviews returns queue items matching the filter, oldest first.
+	ListComplianceReviews(ctx context.Context, filter ComplianceReviewFilter, limit, offset int) ([]entities.ComplianceReviewItem, error)
+	GetComplianceReview(ctx context.Context, id uuid.UUID) (entities.ComplianceReviewItem, error)
+	// ResolveComplianceReview closes an open item. Returns ErrNotFound for an unknown item and
+	// ErrConflict when it is already resolved.
+	ResolveComplianceReview(ctx context.Context, id, resolvedBy uuid.UUID, resolution string, at time.Time) error
+
+	// GetLimitSnapshotAt returns the limit snapshot in force for the user at the given time, or
+	// ErrNotFound when their history starts later.
+	GetLimitSnapshotAt(ctx context.Context, userID uuid.UUID, at time.Time) (entities.KYCLimitSnapshot, error)
+	// ListLimitHistory returns the user's limit snapshots effective within the optional bounds, newest first.
+	ListLimitHistory(ctx context.Context, userID uuid.UUID, from, to *time.Time, limit int) ([]entities.KYCLimitSnapshot, error)
+}
+
+// ComplianceReviewFilter narrows compliance review queue listings; nil fields match everything.
+type ComplianceReviewFilter struct {
+	Status *entities.ComplianceReviewStatus
+	Source *entities.ComplianceReviewSource
+	UserID *uuid.UUID
 }
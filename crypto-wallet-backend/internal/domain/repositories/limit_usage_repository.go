@@ -0,0 +1,26 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// ChainOutflow is the amount that left a user's wallets on one chain, in the chain's native units.
+type ChainOutflow struct {
+	Chain   entities.Chain
+	Daily   decimal.Decimal
+	Monthly decimal.Decimal
+}
+
+// LimitUsageRepository totals the outflows counted against a user's KYC limits.
+type LimitUsageRepository interface {
+	// SumOutflows totals the sends and executed exchanges out of wallets owned by the user, per
+	// chain. Daily covers everything since dailySince and Monthly everything since monthlySince.
+	// Failed and cancelled operations, and exchange quotes that were never executed, are excluded.
+	SumOutflows(ctx context.Context, userID uuid.UUID, dailySince, monthlySince time.Time) ([]ChainOutflow, error)
+}
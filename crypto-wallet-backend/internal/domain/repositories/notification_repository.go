@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// NotificationRepository defines the persistence contract for in-app notifications.
+type NotificationRepository interface {
+	Create(ctx context.Context, notification *entities.Notification) error
+	// ListByUser returns the user's notifications, newest first, optionally only unread ones.
+	ListByUser(ctx context.Context, userID uuid.UUID, unreadOnly bool, opts ListOptions) ([]entities.Notification, error)
+	CountUnread(ctx context.Context, userID uuid.UUID) (int, error)
+	// MarkRead sets the read time of the user's notification unless it was already read and returns
+	// the stored notification; ErrNotFound is returned when the user has no such notification.
+	MarkRead(ctx context.Context, userID, id uuid.UUID, at time.Time) (*entities.Notification, error)
+}
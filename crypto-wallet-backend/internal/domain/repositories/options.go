@@ -1,11 +1,42 @@
 package repositories
 
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultListLimit is the page size used when a caller does not ask for one.
+	DefaultListLimit = 50
+	// DefaultMaxListLimit caps the page size for endpoints without their own caps.
+	DefaultMaxListLimit = 200
+	// DefaultMaxListOffset caps the offset for endpoints without their own caps; deeper pages must
+	// be fetched with a cursor.
+	DefaultMaxListOffset = 10000
+	// KeysetOffsetThreshold is the offset beyond which repositories that support it locate the page
+	// boundary through the sort index and continue by keyset instead of scanning full rows.
+	KeysetOffsetThreshold = 1000
+)
+
+// ErrPaginationCapExceeded indicates a list request asked for more than the endpoint allows.
+var ErrPaginationCapExceeded = errors.New("repository: pagination cap exceeded")
+
 // ListOptions captures common pagination and sorting parameters for repository queries.
 type ListOptions struct {
 	Limit     int
 	Offset    int
 	SortBy    string
 	SortOrder SortOrder
+	// After continues from a keyset position and takes precedence over Offset. Repositories that
+	// cannot seek by keyset ignore it.
+	After *PageCursor
+	Caps  PaginationCaps
 }
 
 // SortOrder defines the ordering direction for list queries.
@@ -16,11 +47,57 @@ const (
 	SortDescending SortOrder = "DESC"
 )
 
-// WithDefaults applies default pagination when unset.
+// PaginationCaps bounds the page size and offset an endpoint accepts. Zero fields use the defaults.
+type PaginationCaps struct {
+	MaxLimit  int
+	MaxOffset int
+}
+
+func (c PaginationCaps) withDefaults() PaginationCaps {
+	if c.MaxLimit <= 0 {
+		c.MaxLimit = DefaultMaxListLimit
+	}
+	if c.MaxOffset <= 0 {
+		c.MaxOffset = DefaultMaxListOffset
+	}
+	return c
+}
+
+// PaginationCapError reports which cap a list request exceeded.
+type PaginationCapError struct {
+	Field     string
+	Requested int
+	Max       int
+}
+
+// Error implements the error interface.
+func (e *PaginationCapError) Error() string {
+	return fmt.Sprintf("repository: %s %d exceeds maximum %d", e.Field, e.Requested, e.Max)
+}
+
+// Hint tells the client how to fetch what it asked for without exceeding the cap.
+func (e *PaginationCapError) Hint() string {
+	if e.Field == "offset" {
+		return fmt.Sprintf("narrow the filters, or page with cursor=<nextCursor> from the previous response instead of offset; "+
+			"cursor pages are served by keyset, which offsets past %d already use, and have no depth limit", KeysetOffsetThreshold)
+	}
+	return fmt.Sprintf("request at most %d items per page and follow nextCursor for the rest", e.Max)
+}
+
+// Is matches ErrPaginationCapExceeded.
+func (e *PaginationCapError) Is(target error) bool {
+	return target == ErrPaginationCapExceeded
+}
+
+// WithDefaults applies default pagination when unset and clamps the limit to an explicit MaxLimit.
+// Internal callers that leave Caps empty keep their limit; user-facing requests go through CheckCaps.
 func (o ListOptions) WithDefaults() ListOptions {
 	result := o
 	if result.Limit <= 0 {
-		result.Limit = 50
+		result.Limit = DefaultListLimit
+	}
+	if result.Caps.MaxLimit > 0 && result.Limit > result.Caps.MaxLimit {
+		result.Limit = result.Caps.MaxLimit
 	}
 	if result.SortBy == "" {
 		result.SortBy = "created_at"
@@ -33,3 +110,49 @@ func (o ListOptions) WithDefaults() ListOptions {
 	}
 	return result
 }
+
+// CheckCaps returns a *PaginationCapError when the requested limit or offset is beyond the caps.
+// Use cases call it before WithDefaults so oversized requests are rejected rather than clamped.
+func (o ListOptions) CheckCaps() error {
+	caps := o.Caps.withDefaults()
+	if o.Limit > caps.MaxLimit {
+		return &PaginationCapError{Field: "limit", Requested: o.Limit, Max: caps.MaxLimit}
+	}
+	if o.After == nil && o.Offset > caps.MaxOffset {
+		return &PaginationCapError{Field: "offset", Requested: o.Offset, Max: caps.MaxOffset}
+	}
+	return nil
+}
+
+// PageCursor is a keyset position: the created_at and ID of the last row of the previous page.
+type PageCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// Encode returns the opaque cursor string handed to clients.
+func (c PageCursor) Encode() string {
+	raw := strconv.FormatInt(c.CreatedAt.UTC().UnixNano(), 10) + ":" + c.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParsePageCursor decodes a cursor produced by PageCursor.Encode.
+func ParsePageCursor(value string) (PageCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(strings.TrimSpace(value))
+	if err != nil {
+		return PageCursor{}, fmt.Errorf("repository: cursor is malformed: %w", err)
+	}
+	nanos, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return PageCursor{}, errors.New("repository: cursor is malformed")
+	}
+	unixNano, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return PageCursor{}, fmt.Errorf("repository: cursor is malformed: %w", err)
+	}
+	parsedID, err := uuid.Parse(id)
+	if err != nil {
+		return PageCursor{}, fmt.Errorf("repository: cursor is malformed: %w", err)
+	}
+	return PageCursor{CreatedAt: time.Unix(0, unixNano).UTC(), ID: parsedID}, nil
+}
@@ -0,0 +1,116 @@
+package repositories
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestListOptionsCheckCaps(t *testing.T) {
+	cursor := &PageCursor{CreatedAt: time.Now(), ID: uuid.New()}
+
+	tests := []struct {
+		name      string
+		opts      ListOptions
+		wantField string
+		wantMax   int
+	}{
+		{name: "within defaults", opts: ListOptions{Limit: DefaultMaxListLimit, Offset: DefaultMaxListOffset}},
+		{name: "zero values", opts: ListOptions{}},
+		{name: "limit over default", opts: ListOptions{Limit: DefaultMaxListLimit + 1}, wantField: "limit", wantMax: DefaultMaxListLimit},
+		{name: "offset over default", opts: ListOptions{Offset: DefaultMaxListOffset + 1}, wantField: "offset", wantMax: DefaultMaxListOffset},
+		{name: "limit over explicit cap", opts: ListOptions{Limit: 51, Caps: PaginationCaps{MaxLimit: 50}}, wantField: "limit", wantMax: 50},
+		{name: "offset over explicit cap", opts: ListOptions{Offset: 501, Caps: PaginationCaps{MaxOffset: 500}}, wantField: "offset", wantMax: 500},
+		{name: "explicit cap raises the limit", opts: ListOptions{Limit: 1000, Caps: PaginationCaps{MaxLimit: 1000}}},
+		{name: "limit is checked before offset", opts: ListOptions{Limit: 300, Offset: 20000}, wantField: "limit", wantMax: DefaultMaxListLimit},
+		{name: "cursor lifts the offset cap", opts: ListOptions{Offset: DefaultMaxListOffset + 1, After: cursor}},
+		{name: "cursor does not lift the limit cap", opts: ListOptions{Limit: DefaultMaxListLimit + 1, After: cursor}, wantField: "limit", wantMax: DefaultMaxListLimit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.CheckCaps()
+			if tt.wantField == "" {
+				if err != nil {
+					t.Fatalf("CheckCaps() = %v, want nil", err)
+				}
+				return
+			}
+
+			var capErr *PaginationCapError
+			if !errors.As(err, &capErr) {
+				t.Fatalf("CheckCaps() = %v, want *PaginationCapError", err)
+			}
+			if !errors.Is(err, ErrPaginationCapExceeded) {
+				t.Errorf("CheckCaps() error does not match ErrPaginationCapExceeded")
+			}
+			if capErr.Field != tt.wantField || capErr.Max != tt.wantMax {
+				t.Errorf("CheckCaps() = %s max %d, want %s max %d", capErr.Field, capErr.Max, tt.wantField, tt.wantMax)
+			}
+			if !strings.Contains(capErr.Hint(), "nextCursor") {
+				t.Errorf("Hint() = %q, want it to point at nextCursor", capErr.Hint())
+			}
+		})
+	}
+}
+
+func TestPageCursorRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor PageCursor
+	}{
+		{name: "utc", cursor: PageCursor{CreatedAt: time.Date(2025, 3, 14, 15, 9, 26, 535897932, time.UTC), ID: uuid.New()}},
+		{name: "non-utc zone", cursor: PageCursor{CreatedAt: time.Date(2024, 12, 31, 23, 59, 59, 1, time.FixedZone("ICT", 7*3600)), ID: uuid.New()}},
+		{name: "before epoch", cursor: PageCursor{CreatedAt: time.Date(1969, 7, 20, 20, 17, 0, 0, time.UTC), ID: uuid.New()}},
+		{name: "nil id", cursor: PageCursor{CreatedAt: time.Unix(0, 0).UTC(), ID: uuid.Nil}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded := tt.cursor.Encode()
+			decoded, err := ParsePageCursor(encoded)
+			if err != nil {
+				t.Fatalf("ParsePageCursor(%q) error = %v", encoded, err)
+			}
+			if !decoded.CreatedAt.Equal(tt.cursor.CreatedAt) || decoded.ID != tt.cursor.ID {
+				t.Fatalf("ParsePageCursor(Encode()) = %+v, want %+v", decoded, tt.cursor)
+			}
+			if decoded.CreatedAt.Location() != time.UTC {
+				t.Errorf("decoded CreatedAt location = %v, want UTC", decoded.CreatedAt.Location())
+			}
+			if reencoded := decoded.Encode(); reencoded != encoded {
+				t.Errorf("re-encoded cursor = %q, want %q", reencoded, encoded)
+			}
+		})
+	}
+}
+
+func TestParsePageCursorRejectsMalformed(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{name: "empty", value: ""},
+		{name: "not base64", value: "not a cursor!"},
+		{name: "missing separator", value: encodeRawCursor("1700000000000000000")},
+		{name: "bad timestamp", value: encodeRawCursor("yesterday:" + uuid.NewString())},
+		{name: "bad id", value: encodeRawCursor("1700000000000000000:not-a-uuid")},
+		{name: "padded base64", value: base64.URLEncoding.EncodeToString([]byte("1:" + uuid.NewString()))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if cursor, err := ParsePageCursor(tt.value); err == nil {
+				t.Fatalf("ParsePageCursor(%q) = %+v, want error", tt.value, cursor)
+			}
+		})
+	}
+}
+
+func encodeRawCursor(raw string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
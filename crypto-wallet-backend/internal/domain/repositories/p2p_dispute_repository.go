@@ -0,0 +1,31 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// P2PDisputeFilter narrows dispute listings for support review.
+type P2PDisputeFilter struct {
+	Status *entities.P2PDisputeStatus
+}
+
+// P2PDisputeRepository defines the persistence contract for P2P transfer disputes.
+// Every state change is stored together with its audit event, and any ledger
+// transactions are applied to wallet balances in the same database transaction.
+type P2PDisputeRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (entities.P2PDispute, error)
+	ListByUser(ctx context.Context, userID uuid.UUID, opts ListOptions) ([]entities.P2PDispute, error)
+	List(ctx context.Context, filter P2PDisputeFilter, opts ListOptions) ([]entities.P2PDispute, error)
+	ListEvents(ctx context.Context, disputeID uuid.UUID) ([]entities.P2PDisputeEvent, error)
+
+	// Create stores a new dispute; returns ErrDuplicate when the transfer already has an unresolved dispute.
+	Create(ctx context.Context, dispute *entities.P2PDisputeEntity, event entities.P2PDisputeEvent) error
+	// Transition persists a status change from previous, returning ErrConflict when the dispute moved concurrently.
+	// P2P send transactions debit their wallet and P2P receive transactions credit it; ErrInsufficientFunds
+	// is returned when a debit cannot be covered.
+	Transition(ctx context.Context, dispute *entities.P2PDisputeEntity, previous entities.P2PDisputeStatus, event entities.P2PDisputeEvent, transactions ...*entities.TransactionEntity) error
+}
@@ -0,0 +1,26 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// P2PTransferRepository defines the persistence contract for off-chain transfers between users.
+// Settlement methods move wallet balances and record the ledger transactions atomically.
+type P2PTransferRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (entities.P2PTransfer, error)
+	GetByClaimCodeHash(ctx context.Context, hash string) (entities.P2PTransfer, error)
+	ListByUser(ctx context.Context, userID uuid.UUID, opts ListOptions) ([]entities.P2PTransfer, error)
+	ListExpiredClaims(ctx context.Context, now time.Time, limit int) ([]entities.P2PTransfer, error)
+
+	// Settle debits the sender and, for completed transfers, credits the recipient.
+	Settle(ctx context.Context, transfer *entities.P2PTransferEntity, transactions ...*entities.TransactionEntity) error
+	// Claim credits a held transfer to the recipient and confirms the sender's pending transaction.
+	Claim(ctx context.Context, transfer *entities.P2PTransferEntity, recipientTx *entities.TransactionEntity) error
+	// Return credits a held transfer back to the sender and cancels the sender's pending transaction.
+	Return(ctx context.Context, transfer *entities.P2PTransferEntity) error
+}
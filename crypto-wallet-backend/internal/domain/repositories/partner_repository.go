@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// PartnerRepository defines the persistence contract for white-label partners.
+type PartnerRepository interface {
+	// GetByAPIKeyHash returns ErrNotFound when no partner holds the key.
+	GetByAPIKeyHash(ctx context.Context, hash string) (entities.Partner, error)
+	// ListUserLinks returns the partner's links for the given references; references the partner
+	// has not linked are omitted.
+	ListUserLinks(ctx context.Context, partnerID uuid.UUID, userRefs []string) ([]entities.PartnerUserLink, error)
+}
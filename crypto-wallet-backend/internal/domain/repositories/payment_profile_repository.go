@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// PaymentProfileRepository defines the persistence contract for user handles and payment profiles.
+type PaymentProfileRepository interface {
+	GetByUserID(ctx context.Context, userID uuid.UUID) (entities.PaymentProfile, error)
+	GetByHandle(ctx context.Context, handle string) (entities.PaymentProfile, error)
+	// IsHandleAvailable reports whether the handle is unclaimed and not held after a release.
+	IsHandleAvailable(ctx context.Context, handle string, userID uuid.UUID, now time.Time) (bool, error)
+
+	// Create inserts a new profile; returns ErrDuplicate when the handle is taken and ErrConflict when it is held.
+	Create(ctx context.Context, profile *entities.PaymentProfileEntity) error
+	// Update persists profile details without changing the handle.
+	Update(ctx context.Context, profile *entities.PaymentProfileEntity) error
+	// ChangeHandle stores the profile's new handle and holds previousHandle until releaseUntil.
+	ChangeHandle(ctx context.Context, profile *entities.PaymentProfileEntity, previousHandle string, releaseUntil time.Time) error
+}
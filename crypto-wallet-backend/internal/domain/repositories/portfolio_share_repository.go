@@ -0,0 +1,31 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// PortfolioShareRepository defines the persistence contract for portfolio share links and their
+// access log.
+type PortfolioShareRepository interface {
+	Create(ctx context.Context, share entities.PortfolioShare) error
+	// GetByTokenHash returns the share whatever its state, or ErrNotFound.
+	GetByTokenHash(ctx context.Context, tokenHash string) (entities.PortfolioShare, error)
+	// GetByID returns the user's share, or ErrNotFound when the user has no such share.
+	GetByID(ctx context.Context, userID, id uuid.UUID) (entities.PortfolioShare, error)
+	// ListByUser returns the user's shares, newest first.
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]entities.PortfolioShare, error)
+	// CountActiveByUser counts the user's unrevoked shares expiring after at.
+	CountActiveByUser(ctx context.Context, userID uuid.UUID, at time.Time) (int, error)
+	// Revoke revokes the user's share. Returns ErrNotFound when the user has no such share and
+	// ErrConflict when it was already revoked.
+	Revoke(ctx context.Context, userID, id uuid.UUID, at time.Time) error
+	// RecordAccess appends to the share's access log and updates its last access time.
+	RecordAccess(ctx context.Context, access entities.PortfolioShareAccess) error
+	// ListAccesses returns the share's access log, newest first.
+	ListAccesses(ctx context.Context, shareID uuid.UUID, opts ListOptions) ([]entities.PortfolioShareAccess, error)
+}
@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// PortfolioValueRepository defines the persistence contract for recorded daily portfolio values.
+type PortfolioValueRepository interface {
+	// UpsertPortfolioValues stores the values, replacing any recorded for the same user and day.
+	UpsertPortfolioValues(ctx context.Context, values []entities.PortfolioDailyValue) error
+	// ListPortfolioValues returns the user's values from the day containing from, oldest first. A
+	// zero from returns every recorded day.
+	ListPortfolioValues(ctx context.Context, userID uuid.UUID, from time.Time) ([]entities.PortfolioDailyValue, error)
+}
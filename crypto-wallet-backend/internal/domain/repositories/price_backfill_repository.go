@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// PriceBackfillRepository defines the persistence contract for historical price backfill progress.
+type PriceBackfillRepository interface {
+	// StartPriceBackfill stores the job, or returns the job already recorded for the same symbol,
+	// interval and range start, extended to the later range end, so a rerun resumes it.
+	StartPriceBackfill(ctx context.Context, job entities.PriceBackfillJob) (entities.PriceBackfillJob, error)
+	// UpdatePriceBackfill saves the cursor, counters and status of a job.
+	UpdatePriceBackfill(ctx context.Context, job entities.PriceBackfillJob) error
+}
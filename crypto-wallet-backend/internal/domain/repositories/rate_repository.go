@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 
 	"github.com/crypto-wallet/backend/internal/domain/entities"
 )
@@ -17,6 +18,13 @@ type PriceHistoryFilter struct {
 	To       *time.Time
 }
 
+// TransactionSummaryFilter captures the chain and inclusive date range of summary rows.
+type TransactionSummaryFilter struct {
+	Chain entities.Chain
+	From  time.Time
+	To    time.Time
+}
+
 // RateRepository defines the persistence contract for exchange rate and price history aggregates.
 type RateRepository interface {
 	// ExchangeRate operations
@@ -32,5 +40,14 @@ type RateRepository interface {
 	GetPriceHistoryByID(ctx context.Context, id uuid.UUID) (entities.PriceHistory, error)
 	ListPriceHistory(ctx context.Context, filter PriceHistoryFilter, opts ListOptions) ([]entities.PriceHistory, error)
 	CreatePriceHistory(ctx context.Context, history *entities.PriceHistoryEntity) error
-	DeleteOldPriceHistory(ctx context.Context, before time.Time) (int64, error)
+	CreatePriceHistoryBatch(ctx context.Context, history []*entities.PriceHistoryEntity) (int64, error)
+	ListCloseSeries(ctx context.Context, symbols []string, interval entities.IntervalType, from time.Time) (map[string][]decimal.Decimal, error)
+	DeleteOldPriceHistory(ctx context.Context, interval entities.IntervalType, before time.Time) (int64, error)
+	// RollupPriceHistory aggregates source candles older than before into target candles, keeping
+	// target candles that already exist, and returns the number created.
+	RollupPriceHistory(ctx context.Context, source, target entities.IntervalType, before time.Time) (int64, error)
+
+	// TransactionSummary operations
+	ListTransactionSummaries(ctx context.Context, filter TransactionSummaryFilter) ([]entities.TransactionSummary, error)
+	AggregateTransactionSummary(ctx context.Context, filter TransactionSummaryFilter) (entities.TransactionSummary, error)
 }
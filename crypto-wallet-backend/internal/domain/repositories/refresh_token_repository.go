@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// RefreshTokenRepository defines the persistence contract for refresh token families.
+type RefreshTokenRepository interface {
+	// Create stores the first token of a new family.
+	Create(ctx context.Context, token entities.RefreshToken) error
+	// Get returns rotated and revoked tokens too; callers check their state.
+	Get(ctx context.Context, id uuid.UUID) (entities.RefreshToken, error)
+	// Rotate marks the current token rotated and stores its successor atomically. Returns
+	// ErrConflict when the current token was already rotated or revoked.
+	Rotate(ctx context.Context, currentID uuid.UUID, next entities.RefreshToken) error
+	// RevokeFamily revokes every unrevoked token of the family.
+	RevokeFamily(ctx context.Context, familyID uuid.UUID, at time.Time) error
+}
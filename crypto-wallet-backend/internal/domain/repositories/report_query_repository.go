@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// ReportQueryRepository executes custom report definitions over the analytics read models.
+type ReportQueryRepository interface {
+	// Run executes a validated, normalized definition over the user's own records. Rows beyond the
+	// definition's limit are dropped and reported as truncated.
+	Run(ctx context.Context, userID uuid.UUID, definition entities.ReportDefinition) (entities.ReportResult, error)
+}
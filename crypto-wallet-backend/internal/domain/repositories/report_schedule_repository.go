@@ -0,0 +1,26 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// ReportScheduleRepository defines the persistence contract for scheduled portfolio reports.
+type ReportScheduleRepository interface {
+	// GetByUser returns the user's schedule, or ErrNotFound when they have not opted in.
+	GetByUser(ctx context.Context, userID uuid.UUID) (entities.ReportSchedule, error)
+	// Upsert creates or replaces the user's schedule.
+	Upsert(ctx context.Context, schedule entities.ReportSchedule) error
+	// Delete removes the user's schedule. Returns ErrNotFound when they have none.
+	Delete(ctx context.Context, userID uuid.UUID) error
+	// ListDue returns up to limit schedules whose next run is at or before now, earliest first.
+	ListDue(ctx context.Context, now time.Time, limit int) ([]entities.ReportSchedule, error)
+	// Claim advances a due schedule from dueAt to nextRunAt and records sentAt. It reports false
+	// when the schedule no longer runs at dueAt because another worker claimed it or the user
+	// changed it.
+	Claim(ctx context.Context, userID uuid.UUID, dueAt, nextRunAt, sentAt time.Time) (bool, error)
+}
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// SandboxProfileRepository captures aggregate activity distributions for sandbox data generation.
+type SandboxProfileRepository interface {
+	// Capture summarises wallets and the activity since the given time. Groups smaller than
+	// minGroupSize are omitted; only counts, shares and quantiles are read.
+	Capture(ctx context.Context, since time.Time, minGroupSize int) (entities.SandboxProfile, error)
+}
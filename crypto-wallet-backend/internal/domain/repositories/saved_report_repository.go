@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// SavedReportRepository defines the persistence contract for users' saved report definitions.
+// Names are unique per user; Create and Update return ErrDuplicate when the name is taken.
+type SavedReportRepository interface {
+	Create(ctx context.Context, report *entities.SavedReport) error
+	Update(ctx context.Context, report *entities.SavedReport) error
+	GetByID(ctx context.Context, id uuid.UUID) (entities.SavedReport, error)
+	// ListByUser returns the user's saved reports ordered by name.
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]entities.SavedReport, error)
+	CountByUser(ctx context.Context, userID uuid.UUID) (int, error)
+	// Delete removes the user's saved report. Returns ErrNotFound when the user has no such report.
+	Delete(ctx context.Context, userID, id uuid.UUID) error
+}
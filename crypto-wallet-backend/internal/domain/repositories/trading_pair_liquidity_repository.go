@@ -0,0 +1,44 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// LiquidityTopUp adds amount of the quote asset to a pair's reserve, creating its ledger on the
+// first top-up. LowThreshold replaces the pair's threshold when set.
+type LiquidityTopUp struct {
+	PairID       uuid.UUID
+	Amount       decimal.Decimal
+	LowThreshold *decimal.Decimal
+	Actor        string
+	Note         string
+	At           time.Time
+}
+
+// TradingPairLiquidityRepository defines the persistence contract for trading pair liquidity
+// ledgers. Every change is recorded as a ledger entry in the same transaction, which is the unit of
+// work's when ctx carries one. Methods taking a pair return ErrNotFound when
+// the pair has no ledger.
+type TradingPairLiquidityRepository interface {
+	Get(ctx context.Context, pairID uuid.UUID) (entities.TradingPairLiquidity, error)
+	List(ctx context.Context) ([]entities.TradingPairLiquidity, error)
+	// ListEntries returns the pair's latest ledger entries, newest first.
+	ListEntries(ctx context.Context, pairID uuid.UUID, limit int) ([]entities.LiquidityEntry, error)
+	TopUp(ctx context.Context, topUp LiquidityTopUp) (entities.TradingPairLiquidity, error)
+	// Hold holds amount for the operation when that much is available. held is false, with the
+	// ledger unchanged, when it is not; an operation that already holds liquidity is held again
+	// without change.
+	Hold(ctx context.Context, pairID, operationID uuid.UUID, amount decimal.Decimal, at time.Time) (ledger entities.TradingPairLiquidity, held bool, err error)
+	// Release returns the operation's hold. released is false when the operation holds nothing.
+	Release(ctx context.Context, operationID uuid.UUID, at time.Time) (ledger entities.TradingPairLiquidity, released bool, err error)
+	// Settle pays paidOut from the quote reserve and collects received into the base reserve,
+	// consuming the operation's hold. Operations without a hold are paid from the available
+	// reserve; settled is false, with the ledger unchanged, when it does not cover paidOut.
+	Settle(ctx context.Context, pairID, operationID uuid.UUID, paidOut, received decimal.Decimal, at time.Time) (ledger entities.TradingPairLiquidity, settled bool, err error)
+}
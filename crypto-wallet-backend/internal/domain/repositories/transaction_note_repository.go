@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// TransactionNoteRepository defines the persistence contract for encrypted transaction notes.
+type TransactionNoteRepository interface {
+	GetNote(ctx context.Context, userID, transactionID uuid.UUID) (entities.TransactionNote, error)
+	// SaveNote writes the note when the stored version still equals baseVersion, where zero means
+	// the note must not exist yet, and returns the stored note with its new version and revision.
+	// It returns ErrConflict when another write got there first.
+	SaveNote(ctx context.Context, note entities.TransactionNote, baseVersion int64) (entities.TransactionNote, error)
+	// ListNoteChanges returns the user's notes written after the revision, oldest write first.
+	ListNoteChanges(ctx context.Context, userID uuid.UUID, afterRevision int64, limit int) ([]entities.TransactionNote, error)
+}
@@ -5,13 +5,16 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 
 	"github.com/crypto-wallet/backend/internal/domain/entities"
 )
 
 // TransactionFilter captures optional filters when listing transactions.
 type TransactionFilter struct {
-	WalletID  *uuid.UUID
+	WalletID *uuid.UUID
+	// WalletIDs restricts results to any of the given wallets; combined with WalletID both apply.
+	WalletIDs []uuid.UUID
 	Chain     *entities.Chain
 	Type      *entities.TransactionType
 	Status    *entities.TransactionStatus
@@ -19,13 +22,39 @@ type TransactionFilter struct {
 	EndDate   *time.Time
 }
 
+// TransactionSearch captures the criteria of a transaction search on top of the listing filter.
+// Every set criterion must match.
+type TransactionSearch struct {
+	TransactionFilter
+	// HashPrefix matches transactions whose hash starts with it, ignoring case.
+	HashPrefix string
+	// Address matches transactions sent from or to it, ignoring case.
+	Address   string
+	MinAmount *decimal.Decimal
+	MaxAmount *decimal.Decimal
+	// MetadataKeys must all be present in the metadata; Metadata entries must match string values.
+	MetadataKeys []string
+	Metadata     map[string]string
+	// Text matches memos containing every word of it, ignoring case.
+	Text string
+}
+
 // TransactionRepository defines the persistence contract for transaction aggregates.
 type TransactionRepository interface {
 	GetByID(ctx context.Context, id uuid.UUID) (entities.Transaction, error)
 	GetByHash(ctx context.Context, chain entities.Chain, hash string) (entities.Transaction, error)
 	ListByWallet(ctx context.Context, walletID uuid.UUID, opts ListOptions) ([]entities.Transaction, error)
 	ListWithFilters(ctx context.Context, filter TransactionFilter, opts ListOptions) ([]entities.Transaction, int64, error)
+	// ListChronological returns up to limit matching transactions oldest first, continuing after the
+	// cursor when one is given. Unlike ListWithFilters it does not count the total, so callers can
+	// walk large histories page by page.
+	ListChronological(ctx context.Context, filter TransactionFilter, after *PageCursor, limit int) ([]entities.Transaction, error)
+	// Search returns a page of transactions matching the search, with the total number of matches.
+	Search(ctx context.Context, search TransactionSearch, opts ListOptions) ([]entities.Transaction, int64, error)
 	ListPending(ctx context.Context, chain entities.Chain, limit int) ([]entities.Transaction, error)
+	// ListRecentByWallets returns up to perWallet of each wallet's newest transactions in one query,
+	// keyed by wallet ID. Wallets without transactions are absent from the map.
+	ListRecentByWallets(ctx context.Context, walletIDs []uuid.UUID, perWallet int) (map[uuid.UUID][]entities.Transaction, error)
 	Create(ctx context.Context, tx *entities.TransactionEntity) error
 	Update(ctx context.Context, tx entities.Transaction) error
 }
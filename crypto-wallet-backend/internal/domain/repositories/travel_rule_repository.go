@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// TravelRuleRepository persists the travel-rule data captured on withdrawals.
+type TravelRuleRepository interface {
+	Create(ctx context.Context, record *entities.TravelRuleRecord) error
+	// Update saves the record's transmission outcome and transaction link.
+	Update(ctx context.Context, record *entities.TravelRuleRecord) error
+	GetByTransactionID(ctx context.Context, transactionID uuid.UUID) (*entities.TravelRuleRecord, error)
+}
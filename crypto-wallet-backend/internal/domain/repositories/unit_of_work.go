@@ -0,0 +1,11 @@
+package repositories
+
+import "context"
+
+// UnitOfWork runs a function inside one database transaction. Repositories that support it join
+// the transaction when called with the context passed to fn, so their writes commit or roll back
+// together. The transaction commits when fn returns nil and rolls back otherwise; a nested Do
+// joins the outer transaction.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
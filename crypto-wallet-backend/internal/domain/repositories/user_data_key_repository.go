@@ -0,0 +1,29 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// UserDataKey is a user's data key as stored, wrapped by the master key.
+type UserDataKey struct {
+	UserID     uuid.UUID
+	WrappedKey string
+}
+
+// UserDataKeyRepository stores per-user data encryption keys, each wrapped by the master key.
+// Deleting a user's key crypto-shreds everything encrypted under it.
+type UserDataKeyRepository interface {
+	// Get returns the user's wrapped key, or ErrNotFound when the user has none.
+	Get(ctx context.Context, userID uuid.UUID) (string, error)
+	// Create stores the user's wrapped key. Returns ErrDuplicate when the user already has one.
+	Create(ctx context.Context, userID uuid.UUID, wrappedKey string) error
+	// Delete destroys the user's key. Returns ErrNotFound when the user has none.
+	Delete(ctx context.Context, userID uuid.UUID) error
+	// List returns up to limit keys of users with IDs greater than after, ordered by user ID.
+	List(ctx context.Context, after uuid.UUID, limit int) ([]UserDataKey, error)
+	// Replace swaps the user's wrapped key for replacement if it still equals current. Returns
+	// ErrConflict when it has changed and ErrNotFound when the key is gone.
+	Replace(ctx context.Context, userID uuid.UUID, current, replacement string) error
+}
@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// UserDeviceRepository records the devices users sign in from.
+type UserDeviceRepository interface {
+	// CountByUser returns how many devices the user has signed in from.
+	CountByUser(ctx context.Context, userID uuid.UUID) (int, error)
+	// Touch records a sign-in from the device, refreshing its last-seen time and IP address.
+	// created reports whether the device had not been seen before.
+	Touch(ctx context.Context, device entities.UserDevice) (created bool, err error)
+}
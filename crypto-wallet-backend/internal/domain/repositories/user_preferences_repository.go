@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// UserPreferencesRepository defines the persistence contract for user preferences.
+type UserPreferencesRepository interface {
+	// GetByUser returns the user's preferences, or the defaults when they have saved none.
+	GetByUser(ctx context.Context, userID uuid.UUID) (entities.UserPreferences, error)
+	// Save creates or replaces the user's preferences document.
+	Save(ctx context.Context, preferences entities.UserPreferences) error
+}
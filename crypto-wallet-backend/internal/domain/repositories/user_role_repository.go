@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// UserRoleRepository reads the staff roles granted to users.
+type UserRoleRepository interface {
+	// ListRoles returns the user's roles; users without roles get an empty slice.
+	ListRoles(ctx context.Context, userID uuid.UUID) ([]entities.UserRole, error)
+}
@@ -0,0 +1,27 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// WalletDormancyRepository tracks wallet inactivity and moves wallets in and out of dormancy.
+type WalletDormancyRepository interface {
+	// GetActivity returns the wallet's activity, or ErrNotFound.
+	GetActivity(ctx context.Context, walletID uuid.UUID) (entities.WalletActivity, error)
+	// ListInactive returns a keyset page, ordered by wallet ID, of active wallets with no activity
+	// since the given time.
+	ListInactive(ctx context.Context, since time.Time, after uuid.UUID, limit int) ([]entities.WalletActivity, error)
+	// MarkReminded records that the owners of the wallets were reminded at the given time.
+	MarkReminded(ctx context.Context, walletIDs []uuid.UUID, at time.Time) error
+	// MarkDormant moves an active wallet to dormant, provided it still has no activity since the
+	// given time. It reports whether the wallet changed.
+	MarkDormant(ctx context.Context, walletID uuid.UUID, since, at time.Time) (bool, error)
+	// Reactivate returns a dormant wallet to active, restarting its inactivity period at the given
+	// time. It reports whether the wallet changed.
+	Reactivate(ctx context.Context, walletID uuid.UUID, at time.Time) (bool, error)
+}
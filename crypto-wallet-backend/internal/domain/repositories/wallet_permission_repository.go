@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// WalletPermissionRepository defines the persistence contract for member permissions on shared wallets.
+type WalletPermissionRepository interface {
+	// Get returns ErrNotFound when the user holds no grant on the wallet.
+	Get(ctx context.Context, walletID, userID uuid.UUID) (entities.WalletPermissionGrant, error)
+	ListByWallet(ctx context.Context, walletID uuid.UUID) ([]entities.WalletPermissionGrant, error)
+	// Upsert creates the grant or replaces its permissions, keeping the original created_at.
+	Upsert(ctx context.Context, grant entities.WalletPermissionGrant) error
+	// Delete returns ErrNotFound when the user holds no grant on the wallet.
+	Delete(ctx context.Context, walletID, userID uuid.UUID) error
+}
@@ -17,8 +17,21 @@ type WalletFilter struct {
 // WalletRepository defines the persistence contract for wallet aggregates.
 type WalletRepository interface {
 	GetByID(ctx context.Context, id uuid.UUID) (entities.Wallet, error)
+	// GetByIDForUpdate returns the wallet and locks it until the enclosing unit of work ends.
+	GetByIDForUpdate(ctx context.Context, id uuid.UUID) (entities.Wallet, error)
 	GetByAddress(ctx context.Context, chain entities.Chain, address string) (entities.Wallet, error)
 	ListByUser(ctx context.Context, userID uuid.UUID, filter WalletFilter, opts ListOptions) ([]entities.Wallet, error)
+	// ListByChain returns up to limit non-archived wallets of the chain with IDs greater than after,
+	// ordered by ID; pass uuid.Nil to start from the beginning.
+	ListByChain(ctx context.Context, chain entities.Chain, after uuid.UUID, limit int) ([]entities.Wallet, error)
+	// SampleByChain returns up to limit non-archived wallets of the chain chosen at random.
+	SampleByChain(ctx context.Context, chain entities.Chain, limit int) ([]entities.Wallet, error)
+	// ListWithEncryptedKeys returns up to limit wallets of any status that store an encrypted
+	// private key, with IDs greater than after, ordered by ID.
+	ListWithEncryptedKeys(ctx context.Context, after uuid.UUID, limit int) ([]entities.Wallet, error)
+	// ReplaceEncryptedKey swaps the wallet's encrypted private key for replacement if it still
+	// equals current. Returns ErrConflict when it has changed and ErrNotFound when the wallet is gone.
+	ReplaceEncryptedKey(ctx context.Context, id uuid.UUID, current, replacement string) error
 	Create(ctx context.Context, wallet *entities.WalletEntity) error
 	Update(ctx context.Context, wallet entities.Wallet) error
 	Delete(ctx context.Context, id uuid.UUID) error
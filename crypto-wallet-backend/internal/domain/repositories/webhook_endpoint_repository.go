@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// WebhookEndpointRepository defines the persistence contract for third-party webhook endpoints.
+type WebhookEndpointRepository interface {
+	Create(ctx context.Context, endpoint *entities.WebhookEndpointEntity) error
+	GetByID(ctx context.Context, id uuid.UUID) (entities.WebhookEndpoint, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]entities.WebhookEndpoint, error)
+	CountByUser(ctx context.Context, userID uuid.UUID) (int, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// ListAll returns every endpoint, oldest first; used when re-encrypting secrets under a new key.
+	ListAll(ctx context.Context) ([]entities.WebhookEndpoint, error)
+	// UpdateSecret replaces an endpoint's encrypted signing secret.
+	UpdateSecret(ctx context.Context, id uuid.UUID, encryptedSecret string) error
+}
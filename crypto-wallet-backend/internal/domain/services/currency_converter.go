@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// DefaultFiatRateCacheTTL controls how long fiat rates are served from memory. Reference rates
+// change daily, so a short cache only bounds how long a fresh sync takes to show.
+const DefaultFiatRateCacheTTL = 10 * time.Minute
+
+var (
+	// ErrCurrencyUnsupported is returned for a currency outside entities.SupportedCurrencies.
+	ErrCurrencyUnsupported = errors.New("currency converter: currency is not supported")
+	// ErrFiatRateUnavailable is returned when no rate has been synced for a supported currency.
+	ErrFiatRateUnavailable = errors.New("currency converter: fiat rate unavailable")
+)
+
+type cachedFiatRate struct {
+	rate      entities.FiatRate
+	expiresAt time.Time
+}
+
+// CurrencyConverter resolves the fiat rates used to express USD valuations in other currencies.
+// It is safe for concurrent use.
+type CurrencyConverter struct {
+	rates repositories.FiatRateRepository
+	ttl   time.Duration
+	now   func() time.Time
+
+	mu    sync.RWMutex
+	cache map[entities.CurrencyCode]cachedFiatRate
+}
+
+// NewCurrencyConverter constructs a CurrencyConverter. A non-positive ttl uses DefaultFiatRateCacheTTL.
+func NewCurrencyConverter(rates repositories.FiatRateRepository, ttl time.Duration) *CurrencyConverter {
+	if ttl <= 0 {
+		ttl = DefaultFiatRateCacheTTL
+	}
+	return &CurrencyConverter{
+		rates: rates,
+		ttl:   ttl,
+		now:   func() time.Time { return time.Now().UTC() },
+		cache: make(map[entities.CurrencyCode]cachedFiatRate),
+	}
+}
+
+// Rate returns the rate converting USD into currency. USD resolves to the identity rate without
+// a lookup, so a converter with no repository still serves USD.
+func (c *CurrencyConverter) Rate(ctx context.Context, currency entities.CurrencyCode) (entities.FiatRate, error) {
+	code, ok := entities.ParseCurrencyCode(string(currency))
+	if !ok {
+		return entities.FiatRate{}, fmt.Errorf("%w: %q", ErrCurrencyUnsupported, currency)
+	}
+	now := c.now()
+	if code == entities.CurrencyUSD {
+		return entities.USDFiatRate(now), nil
+	}
+
+	c.mu.RLock()
+	cached, hit := c.cache[code]
+	c.mu.RUnlock()
+	if hit && now.Before(cached.expiresAt) {
+		return cached.rate, nil
+	}
+
+	if c.rates == nil {
+		return entities.FiatRate{}, fmt.Errorf("%w: %s", ErrFiatRateUnavailable, code)
+	}
+	rate, err := c.rates.GetFiatRate(ctx, code)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return entities.FiatRate{}, fmt.Errorf("%w: %s", ErrFiatRateUnavailable, code)
+		}
+		return entities.FiatRate{}, err
+	}
+
+	c.mu.Lock()
+	c.cache[code] = cachedFiatRate{rate: rate, expiresAt: now.Add(c.ttl)}
+	c.mu.Unlock()
+	return rate, nil
+}
+
+// Invalidate drops cached rates so the next lookup reads the repository.
+func (c *CurrencyConverter) Invalidate() {
+	c.mu.Lock()
+	c.cache = make(map[entities.CurrencyCode]cachedFiatRate)
+	c.mu.Unlock()
+}
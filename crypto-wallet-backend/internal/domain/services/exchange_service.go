@@ -23,25 +23,63 @@ var (
 	ErrExchangeNoLiquidity         = errors.New("exchange service: insufficient liquidity for this trading pair")
 	ErrExchangeQuoteExpired        = errors.New("exchange service: quote has expired")
 	ErrExchangeInvalidStatus       = errors.New("exchange service: invalid exchange operation status")
+	ErrExchangeExecutionInProgress = errors.New("exchange service: exchange operation is already being executed")
+	ErrExchangeNotFound            = errors.New("exchange service: exchange operation not found")
+	ErrExchangeQuoteAmount         = errors.New("exchange service: quote needs either a from amount or a to amount")
+	ErrExchangeLiquidityNotFound   = errors.New("exchange service: trading pair has no liquidity ledger")
 )
 
+// DefaultQuoteExpiryBatch bounds how many expired quotes one ExpirePendingQuotes call cancels.
+const DefaultQuoteExpiryBatch = 500
+
+const quoteExpiredReason = "Quote expired"
+
+var errExchangeLiquidityNotConfigured = errors.New("exchange service: liquidity ledger is not configured")
+
+// errExchangeAborted rolls back an execution whose failure reason is recorded on the operation.
+var errExchangeAborted = errors.New("exchange service: exchange aborted")
+
+// QuoteExpiryResult reports one pass of quote expiry. HasMore is set when the batch was full, so
+// further expired quotes may remain; Failed counts quotes that could not be cancelled.
+type QuoteExpiryResult struct {
+	Expired []entities.ExchangeOperation
+	Failed  int
+	HasMore bool
+}
+
 // ExchangeService provides domain-level business logic for cryptocurrency exchanges.
 type ExchangeService struct {
 	exchangeRepo    repositories.ExchangeOperationRepository
 	tradingPairRepo repositories.TradingPairRepository
 	walletRepo      repositories.WalletRepository
+	assetRepo       repositories.AssetRepository
+	pricing         PricingStrategies
+	uow             repositories.UnitOfWork
+	liquidity       repositories.TradingPairLiquidityRepository
 }
 
-// NewExchangeService creates a new ExchangeService instance.
+// NewExchangeService creates a new ExchangeService instance. The zero PricingStrategies quotes
+// every pair at its mid rate with the pair's fee. Wallets are mapped to trading pairs through the
+// asset registry in assetRepo. Executions commit their balance changes atomically through uow; a
+// nil uow applies them without a transaction. Exchanges on pairs with a ledger in liquidity are paid
+// out of its reserve; a nil liquidity leaves every pair's liquidity flag as it is.
 func NewExchangeService(
 	exchangeRepo repositories.ExchangeOperationRepository,
 	tradingPairRepo repositories.TradingPairRepository,
 	walletRepo repositories.WalletRepository,
+	assetRepo repositories.AssetRepository,
+	pricing PricingStrategies,
+	uow repositories.UnitOfWork,
+	liquidity repositories.TradingPairLiquidityRepository,
 ) *ExchangeService {
 	return &ExchangeService{
 		exchangeRepo:    exchangeRepo,
 		tradingPairRepo: tradingPairRepo,
 		walletRepo:      walletRepo,
+		assetRepo:       assetRepo,
+		pricing:         pricing,
+		uow:             uow,
+		liquidity:       liquidity,
 	}
 }
 
@@ -62,228 +100,433 @@ func (s *ExchangeService) GetExchangeRate(ctx context.Context, baseSymbol, quote
 	return pair, nil
 }
 
-// CalculateQuote calculates a quote for exchanging a specific amount.
-func (s *ExchangeService) CalculateQuote(
-	ctx context.Context,
-	userID uuid.UUID,
-	fromWalletID, toWalletID uuid.UUID,
-	fromAmount decimal.Decimal,
-) (*entities.ExchangeOperationEntity, error) {
+// QuoteInput selects the wallets of a quote and the side it is priced from: FromAmount, the amount
+// to spend, or ToAmount, the amount to receive. Exactly one of them is set.
+type QuoteInput struct {
+	UserID       uuid.UUID
+	FromWalletID uuid.UUID
+	ToWalletID   uuid.UUID
+	FromAmount   decimal.Decimal
+	ToAmount     decimal.Decimal
+}
+
+// Quote is a priced, unsaved exchange operation with the swap limits of its trading pair.
+type Quote struct {
+	Operation     *entities.ExchangeOperationEntity
+	MinSwapAmount decimal.Decimal
+	MaxSwapAmount *decimal.Decimal
+}
+
+// SwapAmountError reports a source amount outside its trading pair's swap limits.
+type SwapAmountError struct {
+	Amount        decimal.Decimal
+	MinSwapAmount decimal.Decimal
+	MaxSwapAmount *decimal.Decimal
+}
+
+func (e *SwapAmountError) Error() string {
+	if e.Amount.LessThan(e.MinSwapAmount) {
+		return fmt.Sprintf("exchange service: amount %s is below the minimum swap amount %s", e.Amount, e.MinSwapAmount)
+	}
+	return fmt.Sprintf("exchange service: amount %s exceeds the maximum swap amount %s", e.Amount, e.MaxSwapAmount)
+}
+
+// Unwrap lets errors.Is match ErrExchangeAmountTooSmall or ErrExchangeAmountTooLarge.
+func (e *SwapAmountError) Unwrap() error {
+	if e.Amount.LessThan(e.MinSwapAmount) {
+		return ErrExchangeAmountTooSmall
+	}
+	return ErrExchangeAmountTooLarge
+}
+
+// CalculateQuote prices an exchange between two of the user's wallets. A quote priced from the
+// amount to receive spends the smallest amount of the source asset, at its precision, that
+// receives at least that much.
+func (s *ExchangeService) CalculateQuote(ctx context.Context, input QuoteInput) (Quote, error) {
+	if input.FromAmount.IsPositive() == input.ToAmount.IsPositive() {
+		return Quote{}, ErrExchangeQuoteAmount
+	}
+
 	// Validate wallets are different
-	if fromWalletID == toWalletID {
-		return nil, ErrExchangeSameWallets
+	if input.FromWalletID == input.ToWalletID {
+		return Quote{}, ErrExchangeSameWallets
 	}
 
 	// Get wallets to determine symbols and check balance
-	fromWallet, err := s.walletRepo.GetByID(ctx, fromWalletID)
+	fromWallet, err := s.walletRepo.GetByID(ctx, input.FromWalletID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrNotFound) {
-			return nil, fmt.Errorf("exchange service: source wallet not found")
+			return Quote{}, fmt.Errorf("exchange service: source wallet not found")
 		}
-		return nil, fmt.Errorf("exchange service: get source wallet: %w", err)
+		return Quote{}, fmt.Errorf("exchange service: get source wallet: %w", err)
 	}
 
-	toWallet, err := s.walletRepo.GetByID(ctx, toWalletID)
+	toWallet, err := s.walletRepo.GetByID(ctx, input.ToWalletID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrNotFound) {
-			return nil, fmt.Errorf("exchange service: destination wallet not found")
+			return Quote{}, fmt.Errorf("exchange service: destination wallet not found")
 		}
-		return nil, fmt.Errorf("exchange service: get destination wallet: %w", err)
+		return Quote{}, fmt.Errorf("exchange service: get destination wallet: %w", err)
 	}
 
 	// Check if user owns both wallets
-	if fromWallet.GetUserID() != userID || toWallet.GetUserID() != userID {
-		return nil, fmt.Errorf("exchange service: wallet ownership mismatch")
+	if fromWallet.GetUserID() != input.UserID || toWallet.GetUserID() != input.UserID {
+		return Quote{}, fmt.Errorf("exchange service: wallet ownership mismatch")
 	}
 
-	// Check sufficient balance
-	if fromWallet.GetBalance().LessThan(fromAmount) {
-		return nil, ErrExchangeInsufficientBalance
+	// Get trading pair from the assets the wallets hold
+	pair, err := s.walletPair(ctx, fromWallet, toWallet)
+	if err != nil {
+		return Quote{}, err
+	}
+	if !pair.IsActive() || !pair.HasLiquidity() {
+		return Quote{}, ErrExchangeInvalidTradingPair
 	}
 
-	// Get trading pair (determine base/quote from wallet chains)
-	// For simplicity, we'll use chain as symbol - in real implementation, this would be more sophisticated
-	baseSymbol := string(fromWallet.GetChain())
-	quoteSymbol := string(toWallet.GetChain())
-
-	pair, err := s.GetExchangeRate(ctx, baseSymbol, quoteSymbol)
-	if err != nil {
-		return nil, err
+	strategy := s.pricing.For(pair)
+	fromAmount := input.FromAmount
+	if input.ToAmount.IsPositive() {
+		if fromAmount, err = s.requiredAmount(ctx, strategy, pair, fromWallet, input.UserID, input.ToAmount); err != nil {
+			return Quote{}, err
+		}
 	}
 
 	// Validate amount constraints
-	if fromAmount.LessThan(pair.GetMinSwapAmount()) {
-		return nil, ErrExchangeAmountTooSmall
+	quote := Quote{MinSwapAmount: pair.GetMinSwapAmount(), MaxSwapAmount: pair.GetMaxSwapAmount()}
+	if max := quote.MaxSwapAmount; fromAmount.LessThan(quote.MinSwapAmount) || (max != nil && fromAmount.GreaterThan(*max)) {
+		return quote, &SwapAmountError{Amount: fromAmount, MinSwapAmount: quote.MinSwapAmount, MaxSwapAmount: quote.MaxSwapAmount}
+	}
+
+	// Check sufficient balance
+	if fromWallet.GetBalance().LessThan(fromAmount) {
+		return quote, ErrExchangeInsufficientBalance
 	}
-	if max := pair.GetMaxSwapAmount(); max != nil && fromAmount.GreaterThan(*max) {
-		return nil, ErrExchangeAmountTooLarge
+
+	// Price the quote with the pair's strategy
+	priced, err := strategy.Price(ctx, PricingInput{Pair: pair, UserID: input.UserID, FromAmount: fromAmount})
+	if err != nil {
+		return quote, fmt.Errorf("exchange service: price quote with %s: %w", strategy.Name(), err)
 	}
 
-	// Calculate exchange amounts
-	feeAmount := pair.GetFeePercentage().Div(decimal.NewFromInt(100)).Mul(fromAmount)
-	netAmount := fromAmount.Sub(feeAmount)
-	toAmount := netAmount.Mul(pair.GetExchangeRate())
+	// A quote the reserve cannot pay out would only fail once accepted
+	if err := s.checkLiquidity(ctx, pair, priced.ToAmount); err != nil {
+		return quote, err
+	}
 
-	// Create exchange operation with quote
+	// Create exchange operation with quote, valid for the pair's TTL unless the strategy shortened it
 	now := time.Now().UTC()
-	quoteExpiresAt := now.Add(60 * time.Second) // 60 second quote expiration
+	quoteTTL := pair.GetQuoteTTL()
+	if priced.QuoteTTL > 0 && priced.QuoteTTL < quoteTTL {
+		quoteTTL = priced.QuoteTTL
+	}
+	quoteExpiresAt := now.Add(quoteTTL)
 
 	operation, err := entities.NewExchangeOperationEntity(entities.ExchangeOperationParams{
-		UserID:         userID,
-		FromWalletID:   fromWalletID,
-		ToWalletID:     toWalletID,
-		FromAmount:     fromAmount,
-		ToAmount:       toAmount,
-		ExchangeRate:   pair.GetExchangeRate(),
-		FeePercentage:  pair.GetFeePercentage(),
-		FeeAmount:      feeAmount,
-		Status:         entities.ExchangeStatusPending,
-		QuoteExpiresAt: quoteExpiresAt,
-		CreatedAt:      now,
-		UpdatedAt:      now,
+		UserID:          input.UserID,
+		FromWalletID:    input.FromWalletID,
+		ToWalletID:      input.ToWalletID,
+		FromAmount:      fromAmount,
+		ToAmount:        priced.ToAmount,
+		ExchangeRate:    priced.ExchangeRate,
+		FeePercentage:   priced.FeePercentage,
+		FeeAmount:       priced.FeeAmount,
+		Status:          entities.ExchangeStatusPending,
+		QuoteExpiresAt:  quoteExpiresAt,
+		PricingStrategy: strategy.Name(),
+		PricingMetadata: priced.Metadata,
+		CreatedAt:       now,
+		UpdatedAt:       now,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("exchange service: create exchange operation: %w", err)
+		return quote, fmt.Errorf("exchange service: create exchange operation: %w", err)
 	}
 
+	quote.Operation = operation
+	return quote, nil
+}
+
+// AcceptQuote stores a quote priced by CalculateQuote so it can be executed. Quotes are accepted by
+// ID, so accepting one again, for instance when a client retries, returns the stored operation. The
+// payout is held against the pair's liquidity ledger first; ErrExchangeNoLiquidity is returned when
+// the reserve can no longer cover it.
+func (s *ExchangeService) AcceptQuote(ctx context.Context, operation *entities.ExchangeOperationEntity) (entities.ExchangeOperation, error) {
+	if stored, err := s.GetExchangeOperation(ctx, operation.GetID()); !errors.Is(err, ErrExchangeNotFound) {
+		return stored, err
+	}
+	if err := s.holdLiquidity(ctx, operation); err != nil {
+		return nil, err
+	}
+	if err := s.exchangeRepo.Create(ctx, operation); err != nil {
+		// A concurrent retry may have stored it first, sharing the hold
+		if stored, getErr := s.GetExchangeOperation(ctx, operation.GetID()); getErr == nil {
+			return stored, nil
+		}
+		s.releaseLiquidity(ctx, operation.GetID())
+		return nil, fmt.Errorf("exchange service: store quote: %w", err)
+	}
 	return operation, nil
 }
 
-// ExecuteExchange executes a pending exchange operation.
+// PreviewQuote prices exchanging fromAmount of one asset into another with the pair's strategy,
+// without wallets and without creating an operation. It estimates a swap the user has not asked
+// for yet, so the pair's amount limits are left to the caller.
+func (s *ExchangeService) PreviewQuote(ctx context.Context, userID uuid.UUID, baseSymbol, quoteSymbol string, fromAmount decimal.Decimal) (entities.TradingPair, PricingResult, error) {
+	pair, err := s.GetExchangeRate(ctx, baseSymbol, quoteSymbol)
+	if err != nil {
+		return nil, PricingResult{}, err
+	}
+
+	strategy := s.pricing.For(pair)
+	priced, err := strategy.Price(ctx, PricingInput{Pair: pair, UserID: userID, FromAmount: fromAmount})
+	if err != nil {
+		return nil, PricingResult{}, fmt.Errorf("exchange service: price quote with %s: %w", strategy.Name(), err)
+	}
+	return pair, priced, nil
+}
+
+// ExecuteExchange executes a pending exchange operation. Only one caller can move an operation
+// out of pending; a retry of an operation that already completed or failed returns that final
+// state instead of an error, and a retry while another execution is running gets
+// ErrExchangeExecutionInProgress.
 func (s *ExchangeService) ExecuteExchange(
 	ctx context.Context,
 	operationID uuid.UUID,
 ) (*entities.ExchangeOperationEntity, error) {
-	// Get the exchange operation
-	operation, err := s.exchangeRepo.GetByID(ctx, operationID)
+	// Claim the operation under its lock so concurrent executions cannot both pass the status check
+	operation, claimed, err := s.exchangeRepo.ClaimForExecution(ctx, operationID, time.Now().UTC())
 	if err != nil {
 		if errors.Is(err, repositories.ErrNotFound) {
-			return nil, fmt.Errorf("exchange service: exchange operation not found")
+			return nil, ErrExchangeNotFound
+		}
+		return nil, fmt.Errorf("exchange service: claim exchange operation: %w", err)
+	}
+
+	if !claimed {
+		switch operation.GetStatus() {
+		case entities.ExchangeStatusCompleted, entities.ExchangeStatusFailed:
+			return operation.(*entities.ExchangeOperationEntity), nil
+		case entities.ExchangeStatusProcessing:
+			return nil, ErrExchangeExecutionInProgress
+		case entities.ExchangeStatusPending:
+			return nil, ErrExchangeQuoteExpired
+		default:
+			return nil, ErrExchangeInvalidStatus
 		}
-		return nil, fmt.Errorf("exchange service: get exchange operation: %w", err)
-	}
-
-	// Validate status
-	if operation.GetStatus() != entities.ExchangeStatusPending {
-		return nil, ErrExchangeInvalidStatus
-	}
-
-	// Check quote expiration
-	if operation.(*entities.ExchangeOperationEntity).IsQuoteExpired() {
-		return nil, ErrExchangeQuoteExpired
-	}
-
-	// Mark as processing
-	if err := operation.(*entities.ExchangeOperationEntity).MarkProcessing(); err != nil {
-		return nil, fmt.Errorf("exchange service: mark processing: %w", err)
 	}
 
-	// Update in repository
-	if err := s.exchangeRepo.Update(ctx, operation); err != nil {
-		return nil, fmt.Errorf("exchange service: update processing status: %w", err)
-	}
+	// Debit, credit and completion commit together; failure is set when the exchange cannot go
+	// ahead, and the operation is then marked failed once the transaction has rolled back.
+	var (
+		failure    string
+		fromWallet entities.Wallet
+		toWallet   entities.Wallet
+		ledger     *entities.TradingPairLiquidity
+	)
+	err = s.unitOfWork(ctx, func(ctx context.Context) error {
+		// Lock both wallets in a stable order so opposite exchanges cannot deadlock
+		firstID, secondID := operation.GetFromWalletID(), operation.GetToWalletID()
+		if secondID.String() < firstID.String() {
+			firstID, secondID = secondID, firstID
+		}
+		locked := make(map[uuid.UUID]entities.Wallet, 2)
+		for _, id := range []uuid.UUID{firstID, secondID} {
+			wallet, err := s.walletRepo.GetByIDForUpdate(ctx, id)
+			if err != nil {
+				role := "destination"
+				if id == operation.GetFromWalletID() {
+					role = "source"
+				}
+				failure = fmt.Sprintf("failed to get %s wallet: %v", role, err)
+				return errExchangeAborted
+			}
+			locked[id] = wallet
+		}
+		fromWallet, toWallet = locked[operation.GetFromWalletID()], locked[operation.GetToWalletID()]
 
-	// Get wallets to perform the exchange
-	fromWallet, err := s.walletRepo.GetByID(ctx, operation.GetFromWalletID())
-	if err != nil {
-		return s.markExchangeFailed(ctx, operation, fmt.Sprintf("failed to get source wallet: %v", err))
-	}
+		// Check final balance (in case it changed since quote)
+		if fromWallet.GetBalance().LessThan(operation.GetFromAmount()) {
+			failure = "insufficient balance at execution time"
+			return errExchangeAborted
+		}
 
-	toWallet, err := s.walletRepo.GetByID(ctx, operation.GetToWalletID())
-	if err != nil {
-		return s.markExchangeFailed(ctx, operation, fmt.Sprintf("failed to get destination wallet: %v", err))
-	}
+		now := time.Now().UTC()
+
+		// Pay the exchange out of its pair's liquidity reserve, consuming the quote's hold
+		if s.liquidity != nil {
+			pair, err := s.walletPair(ctx, fromWallet, toWallet)
+			if err != nil {
+				failure = fmt.Sprintf("failed to get trading pair: %v", err)
+				return errExchangeAborted
+			}
+			settled, ok, err := s.liquidity.Settle(ctx, pair.GetID(), operation.GetID(), operation.GetToAmount(), operation.GetFromAmount(), now)
+			switch {
+			case errors.Is(err, repositories.ErrNotFound):
+				// The pair is not liquidity-managed
+			case err != nil:
+				failure = fmt.Sprintf("failed to settle liquidity: %v", err)
+				return errExchangeAborted
+			case !ok:
+				failure = "insufficient liquidity at execution time"
+				return errExchangeAborted
+			default:
+				ledger = &settled
+			}
+		}
 
-	// Check final balance (in case it changed since quote)
-	if fromWallet.GetBalance().LessThan(operation.GetFromAmount()) {
-		return s.markExchangeFailed(ctx, operation, "insufficient balance at execution time")
-	}
+		// Update from wallet (subtract amount)
+		fromWalletEntity := fromWallet.(*entities.WalletEntity)
+		if err := fromWalletEntity.UpdateBalance(fromWallet.GetBalance().Sub(operation.GetFromAmount()), now); err != nil {
+			failure = fmt.Sprintf("failed to update source wallet balance: %v", err)
+			return errExchangeAborted
+		}
+		fromWalletEntity.Touch(now)
 
-	// Perform the exchange (this would typically involve transactions)
-	// For now, we'll simulate the exchange
-	now := time.Now().UTC()
+		if err := s.walletRepo.Update(ctx, fromWallet); err != nil {
+			failure = fmt.Sprintf("failed to update source wallet: %v", err)
+			return errExchangeAborted
+		}
 
-	// Update from wallet (subtract amount)
-	fromWalletEntity := fromWallet.(*entities.WalletEntity)
-	if err := fromWalletEntity.UpdateBalance(fromWallet.GetBalance().Sub(operation.GetFromAmount()), now); err != nil {
-		return s.markExchangeFailed(ctx, operation, fmt.Sprintf("failed to update source wallet balance: %v", err))
-	}
-	fromWalletEntity.Touch(now)
+		// Update to wallet (add amount)
+		toWalletEntity := toWallet.(*entities.WalletEntity)
+		if err := toWalletEntity.UpdateBalance(toWallet.GetBalance().Add(operation.GetToAmount()), now); err != nil {
+			failure = fmt.Sprintf("failed to update destination wallet balance: %v", err)
+			return errExchangeAborted
+		}
+		toWalletEntity.Touch(now)
 
-	if err := s.walletRepo.Update(ctx, fromWallet); err != nil {
-		return s.markExchangeFailed(ctx, operation, fmt.Sprintf("failed to update source wallet: %v", err))
-	}
+		if err := s.walletRepo.Update(ctx, toWallet); err != nil {
+			failure = fmt.Sprintf("failed to update destination wallet: %v", err)
+			return errExchangeAborted
+		}
 
-	// Update to wallet (add amount)
-	toWalletEntity := toWallet.(*entities.WalletEntity)
-	if err := toWalletEntity.UpdateBalance(toWallet.GetBalance().Add(operation.GetToAmount()), now); err != nil {
-		return s.markExchangeFailed(ctx, operation, fmt.Sprintf("failed to update destination wallet balance: %v", err))
-	}
-	toWalletEntity.Touch(now)
+		// Mark exchange as completed
+		if err := operation.(*entities.ExchangeOperationEntity).MarkCompleted(now); err != nil {
+			failure = fmt.Sprintf("failed to complete exchange: %v", err)
+			return errExchangeAborted
+		}
 
-	if err := s.walletRepo.Update(ctx, toWallet); err != nil {
-		return s.markExchangeFailed(ctx, operation, fmt.Sprintf("failed to update destination wallet: %v", err))
+		if err := s.exchangeRepo.Update(ctx, operation); err != nil {
+			return fmt.Errorf("exchange service: update completed status: %w", err)
+		}
+		return nil
+	})
+	if failure != "" {
+		return s.markExchangeFailed(ctx, operation, failure)
 	}
-
-	// Mark exchange as completed
-	if err := operation.(*entities.ExchangeOperationEntity).MarkCompleted(now); err != nil {
-		return nil, fmt.Errorf("exchange service: mark completed: %w", err)
+	if err != nil {
+		// The completion write or the commit failed after the balances were applied, so whether the
+		// exchange took effect is unknown; the operation stays processing for reconciliation.
+		return nil, err
 	}
 
-	// Update trading pair volume
-	pair, err := s.tradingPairRepo.GetBySymbols(ctx,
-		string(fromWallet.GetChain()),
-		string(toWallet.GetChain()))
+	// Update trading pair volume, and its liquidity flag once the reserve has paid out, outside the
+	// transaction; they are informational and a failed statement would abort the exchange with them.
+	pair, err := s.walletPair(ctx, fromWallet, toWallet)
 	if err == nil {
 		pairEntity := pair.(*entities.TradingPairEntity)
+		if ledger != nil {
+			pairEntity.SetLiquidity(ledger.Sufficient())
+		}
 		if err := pairEntity.AddVolume(operation.GetFromAmount()); err == nil {
 			s.tradingPairRepo.Update(ctx, pair)
 		}
 	}
 
-	// Final update
-	if err := s.exchangeRepo.Update(ctx, operation); err != nil {
-		return nil, fmt.Errorf("exchange service: update completed status: %w", err)
-	}
-
 	return operation.(*entities.ExchangeOperationEntity), nil
 }
 
+// unitOfWork runs fn in the configured unit of work, or directly when none is configured.
+func (s *ExchangeService) unitOfWork(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.uow == nil {
+		return fn(ctx)
+	}
+	return s.uow.Do(ctx, fn)
+}
+
 // CancelExchange cancels a pending exchange operation.
 func (s *ExchangeService) CancelExchange(
 	ctx context.Context,
 	operationID uuid.UUID,
 	reason string,
 ) error {
-	operation, err := s.exchangeRepo.GetByID(ctx, operationID)
+	_, cancelled, err := s.CancelPendingExchange(ctx, operationID, reason)
+	if err != nil {
+		return err
+	}
+	if !cancelled {
+		return ErrExchangeInvalidStatus
+	}
+	return nil
+}
+
+// CancelPendingExchange cancels the operation if it is still pending. cancelled is false when the
+// operation had already left pending; it is then returned in the state it reached.
+func (s *ExchangeService) CancelPendingExchange(
+	ctx context.Context,
+	operationID uuid.UUID,
+	reason string,
+) (entities.ExchangeOperation, bool, error) {
+	operation, cancelled, err := s.exchangeRepo.CancelPending(ctx, operationID, reason, time.Now().UTC())
 	if err != nil {
 		if errors.Is(err, repositories.ErrNotFound) {
-			return fmt.Errorf("exchange service: exchange operation not found")
+			return nil, false, ErrExchangeNotFound
 		}
-		return fmt.Errorf("exchange service: get exchange operation: %w", err)
+		return nil, false, fmt.Errorf("exchange service: cancel exchange operation: %w", err)
 	}
-
-	// Validate status
-	if operation.GetStatus() != entities.ExchangeStatusPending {
-		return ErrExchangeInvalidStatus
+	if cancelled {
+		s.releaseLiquidity(ctx, operationID)
 	}
+	return operation, cancelled, nil
+}
 
-	// Mark as cancelled
-	if err := operation.(*entities.ExchangeOperationEntity).MarkCancelled(); err != nil {
-		return fmt.Errorf("exchange service: mark cancelled: %w", err)
+// ListPendingExchanges returns up to limit pending operations matching the filter.
+func (s *ExchangeService) ListPendingExchanges(
+	ctx context.Context,
+	filter repositories.PendingExchangeFilter,
+	limit int,
+) ([]entities.ExchangeOperation, error) {
+	operations, err := s.exchangeRepo.ListPending(ctx, filter, limit)
+	if err != nil {
+		return nil, fmt.Errorf("exchange service: list pending: %w", err)
 	}
+	return operations, nil
+}
 
-	// Set error message if provided
-	if reason != "" {
-		operation.(*entities.ExchangeOperationEntity).SetErrorMessage(reason)
+// ListExecutedExchanges returns up to limit completed operations matching the filter.
+func (s *ExchangeService) ListExecutedExchanges(
+	ctx context.Context,
+	filter repositories.ExecutedExchangeFilter,
+	limit int,
+) ([]entities.ExchangeOperation, error) {
+	operations, err := s.exchangeRepo.ListExecuted(ctx, filter, limit)
+	if err != nil {
+		return nil, fmt.Errorf("exchange service: list executed: %w", err)
 	}
+	return operations, nil
+}
 
-	// Update in repository
-	if err := s.exchangeRepo.Update(ctx, operation); err != nil {
-		return fmt.Errorf("exchange service: update cancelled status: %w", err)
+// GetTradingPair retrieves a trading pair by ID.
+func (s *ExchangeService) GetTradingPair(ctx context.Context, pairID uuid.UUID) (entities.TradingPair, error) {
+	pair, err := s.tradingPairRepo.GetByID(ctx, pairID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrExchangeInvalidTradingPair
+		}
+		return nil, fmt.Errorf("exchange service: get trading pair: %w", err)
 	}
+	return pair, nil
+}
 
-	return nil
+// GetExchangeOperation retrieves a single exchange operation.
+func (s *ExchangeService) GetExchangeOperation(ctx context.Context, operationID uuid.UUID) (entities.ExchangeOperation, error) {
+	operation, err := s.exchangeRepo.GetByID(ctx, operationID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrExchangeNotFound
+		}
+		return nil, fmt.Errorf("exchange service: get exchange operation: %w", err)
+	}
+	return operation, nil
 }
 
 // GetUserExchangeHistory retrieves exchange history for a user.
@@ -310,27 +553,114 @@ func (s *ExchangeService) GetActiveTradingPairs(ctx context.Context) ([]entities
 	return s.tradingPairRepo.GetActivePairs(ctx)
 }
 
-// ExpirePendingQuotes expires all pending quotes that have passed their expiration time.
-func (s *ExchangeService) ExpirePendingQuotes(ctx context.Context) ([]entities.ExchangeOperation, error) {
-	expiredOperations, err := s.exchangeRepo.GetExpiredPending(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("exchange service: get expired pending: %w", err)
+// ExpirePendingQuotes cancels up to batchSize pending quotes that have passed their expiration
+// time, oldest first. A non-positive batchSize uses DefaultQuoteExpiryBatch.
+func (s *ExchangeService) ExpirePendingQuotes(ctx context.Context, batchSize int) (QuoteExpiryResult, error) {
+	if batchSize <= 0 {
+		batchSize = DefaultQuoteExpiryBatch
 	}
 
 	now := time.Now().UTC()
-	for _, operation := range expiredOperations {
-		operationEntity := operation.(*entities.ExchangeOperationEntity)
-		if err := operationEntity.MarkCancelled(); err != nil {
-			continue // Skip if we can't mark as cancelled
+	candidates, err := s.exchangeRepo.ListPending(ctx, repositories.PendingExchangeFilter{ExpiredAt: &now}, batchSize)
+	if err != nil {
+		return QuoteExpiryResult{}, fmt.Errorf("exchange service: get expired pending: %w", err)
+	}
+
+	result := QuoteExpiryResult{
+		Expired: make([]entities.ExchangeOperation, 0, len(candidates)),
+		HasMore: len(candidates) == batchSize,
+	}
+	for _, candidate := range candidates {
+		operation, cancelled, err := s.exchangeRepo.CancelPending(ctx, candidate.GetID(), quoteExpiredReason, now)
+		if err != nil {
+			result.Failed++
+			continue
+		}
+		// Quotes executed or cancelled since they were listed are left alone.
+		if cancelled {
+			s.releaseLiquidity(ctx, operation.GetID())
+			result.Expired = append(result.Expired, operation)
 		}
-		operationEntity.SetErrorMessage("Quote expired")
-		operationEntity.Touch(now)
+	}
+
+	return result, nil
+}
 
-		// Update in repository (ignore errors for individual operations)
-		s.exchangeRepo.Update(ctx, operation)
+// ResetDailyVolumes zeroes the daily volume of every trading pair; it runs at the start of each UTC day.
+func (s *ExchangeService) ResetDailyVolumes(ctx context.Context) error {
+	if err := s.tradingPairRepo.ResetDailyVolumes(ctx); err != nil {
+		return fmt.Errorf("exchange service: reset daily volumes: %w", err)
 	}
+	return nil
+}
 
-	return expiredOperations, nil
+// LiquidityTopUpInput adds Amount of a trading pair's quote asset to its liquidity reserve.
+// LowThreshold, when set, replaces the available reserve below which the pair stops quoting.
+type LiquidityTopUpInput struct {
+	PairID       uuid.UUID
+	Amount       decimal.Decimal
+	LowThreshold *decimal.Decimal
+	Actor        string
+	Note         string
+}
+
+// TopUpLiquidity adds to a pair's liquidity reserve, creating its ledger on the first top-up, and
+// turns the pair's liquidity back on once the reserve covers its threshold.
+func (s *ExchangeService) TopUpLiquidity(ctx context.Context, input LiquidityTopUpInput) (entities.TradingPairLiquidity, entities.TradingPair, error) {
+	if s.liquidity == nil {
+		return entities.TradingPairLiquidity{}, nil, errExchangeLiquidityNotConfigured
+	}
+	pair, err := s.GetTradingPair(ctx, input.PairID)
+	if err != nil {
+		return entities.TradingPairLiquidity{}, nil, err
+	}
+
+	ledger, err := s.liquidity.TopUp(ctx, repositories.LiquidityTopUp{
+		PairID:       pair.GetID(),
+		Amount:       input.Amount,
+		LowThreshold: input.LowThreshold,
+		Actor:        input.Actor,
+		Note:         input.Note,
+		At:           time.Now().UTC(),
+	})
+	if err != nil {
+		return entities.TradingPairLiquidity{}, nil, fmt.Errorf("exchange service: top up liquidity: %w", err)
+	}
+	if err := s.syncPairLiquidity(ctx, pair, ledger); err != nil {
+		return ledger, pair, fmt.Errorf("exchange service: update trading pair liquidity: %w", err)
+	}
+	return ledger, pair, nil
+}
+
+// ListLiquidity returns the ledger of every liquidity-managed trading pair.
+func (s *ExchangeService) ListLiquidity(ctx context.Context) ([]entities.TradingPairLiquidity, error) {
+	if s.liquidity == nil {
+		return nil, errExchangeLiquidityNotConfigured
+	}
+	ledgers, err := s.liquidity.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("exchange service: list liquidity: %w", err)
+	}
+	return ledgers, nil
+}
+
+// GetLiquidity returns a trading pair's ledger with up to entryLimit of its latest entries.
+func (s *ExchangeService) GetLiquidity(ctx context.Context, pairID uuid.UUID, entryLimit int) (entities.TradingPairLiquidity, []entities.LiquidityEntry, error) {
+	if s.liquidity == nil {
+		return entities.TradingPairLiquidity{}, nil, errExchangeLiquidityNotConfigured
+	}
+	ledger, err := s.liquidity.Get(ctx, pairID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return entities.TradingPairLiquidity{}, nil, ErrExchangeLiquidityNotFound
+		}
+		return entities.TradingPairLiquidity{}, nil, fmt.Errorf("exchange service: get liquidity: %w", err)
+	}
+	entries, err := s.liquidity.ListEntries(ctx, pairID, entryLimit)
+	if err != nil {
+		return entities.TradingPairLiquidity{}, nil, fmt.Errorf("exchange service: list liquidity entries: %w", err)
+	}
+	return ledger, entries, nil
 }
 
 // GetExchangeStats retrieves exchange statistics for a user.
@@ -385,7 +715,164 @@ func (s *ExchangeService) GetExchangeStats(ctx context.Context, userID uuid.UUID
 	return response, nil
 }
 
-// Helper method to mark exchange as failed
+// requiredAmount derives the source amount that receives toAmount. The pair's required amount
+// inverts its mid rate and fee; strategies scale the converted amount linearly, so pricing that
+// estimate and scaling it by the shortfall gives the amount at the strategy's price. It is rounded
+// up to the source asset's precision so the quote never receives less than asked.
+func (s *ExchangeService) requiredAmount(ctx context.Context, strategy PricingStrategy, pair entities.TradingPair, fromWallet entities.Wallet, userID uuid.UUID, toAmount decimal.Decimal) (decimal.Decimal, error) {
+	if !pair.GetExchangeRate().IsPositive() || pair.GetFeePercentage().GreaterThanOrEqual(hundred) {
+		return decimal.Zero, ErrExchangeInvalidTradingPair
+	}
+
+	estimate := pair.CalculateRequiredAmount(toAmount)
+	priced, err := strategy.Price(ctx, PricingInput{Pair: pair, UserID: userID, FromAmount: estimate})
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("exchange service: price quote with %s: %w", strategy.Name(), err)
+	}
+	if !priced.ToAmount.IsPositive() {
+		return decimal.Zero, ErrExchangeInvalidTradingPair
+	}
+	required := estimate.Mul(toAmount).Div(priced.ToAmount)
+
+	asset, err := s.walletAsset(ctx, fromWallet)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if asset.Decimals > 0 {
+		required = required.RoundUp(int32(asset.Decimals))
+	}
+	return required, nil
+}
+
+// walletPair resolves the trading pair quoting the source wallet's asset against the destination
+// wallet's. Pairs linked to registry assets are matched by asset ID; unlinked pairs are matched by
+// the assets' trading symbols.
+func (s *ExchangeService) walletPair(ctx context.Context, fromWallet, toWallet entities.Wallet) (entities.TradingPair, error) {
+	baseAsset, err := s.walletAsset(ctx, fromWallet)
+	if err != nil {
+		return nil, err
+	}
+	quoteAsset, err := s.walletAsset(ctx, toWallet)
+	if err != nil {
+		return nil, err
+	}
+
+	pair, err := s.tradingPairRepo.GetByAssets(ctx, baseAsset.ID, quoteAsset.ID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		pair, err = s.tradingPairRepo.GetBySymbols(ctx, baseAsset.TradingSymbol(), quoteAsset.TradingSymbol())
+	}
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrExchangeInvalidTradingPair
+		}
+		return nil, fmt.Errorf("exchange service: get trading pair: %w", err)
+	}
+	return pair, nil
+}
+
+// walletAsset returns the registry asset a wallet holds, falling back to the chain's native asset
+// for wallets that predate the registry.
+func (s *ExchangeService) walletAsset(ctx context.Context, wallet entities.Wallet) (entities.Asset, error) {
+	if s.assetRepo == nil {
+		return entities.Asset{}, fmt.Errorf("exchange service: asset registry is not configured")
+	}
+
+	asset, err := s.assetRepo.GetByID(ctx, wallet.GetAssetID())
+	if errors.Is(err, repositories.ErrNotFound) {
+		asset, err = s.assetRepo.GetNative(ctx, wallet.GetChain())
+	}
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return entities.Asset{}, ErrExchangeInvalidTradingPair
+		}
+		return entities.Asset{}, fmt.Errorf("exchange service: get wallet asset: %w", err)
+	}
+	if !asset.IsActive {
+		return entities.Asset{}, ErrExchangeInvalidTradingPair
+	}
+	return asset, nil
+}
+
+// checkLiquidity reports ErrExchangeNoLiquidity when the pair's available reserve cannot pay
+// amount out. Pairs without a ledger are not checked.
+func (s *ExchangeService) checkLiquidity(ctx context.Context, pair entities.TradingPair, amount decimal.Decimal) error {
+	if s.liquidity == nil {
+		return nil
+	}
+	ledger, err := s.liquidity.Get(ctx, pair.GetID())
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("exchange service: get liquidity: %w", err)
+	}
+	if ledger.Available().LessThan(amount) {
+		return ErrExchangeNoLiquidity
+	}
+	return nil
+}
+
+// holdLiquidity holds a quote's payout against its pair's liquidity ledger. Pairs without a ledger
+// hold nothing.
+func (s *ExchangeService) holdLiquidity(ctx context.Context, operation entities.ExchangeOperation) error {
+	if s.liquidity == nil {
+		return nil
+	}
+	fromWallet, err := s.walletRepo.GetByID(ctx, operation.GetFromWalletID())
+	if err != nil {
+		return fmt.Errorf("exchange service: get source wallet: %w", err)
+	}
+	toWallet, err := s.walletRepo.GetByID(ctx, operation.GetToWalletID())
+	if err != nil {
+		return fmt.Errorf("exchange service: get destination wallet: %w", err)
+	}
+	pair, err := s.walletPair(ctx, fromWallet, toWallet)
+	if err != nil {
+		return err
+	}
+
+	ledger, held, err := s.liquidity.Hold(ctx, pair.GetID(), operation.GetID(), operation.GetToAmount(), time.Now().UTC())
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("exchange service: hold liquidity: %w", err)
+	}
+	// The pair flag is informational here; the hold itself is what protects the reserve
+	_ = s.syncPairLiquidity(ctx, pair, ledger)
+	if !held {
+		return ErrExchangeNoLiquidity
+	}
+	return nil
+}
+
+// releaseLiquidity returns an operation's liquidity hold, if it has one. The operation's own
+// change is already stored, so a failure is not reported; the hold then stays counted as held.
+func (s *ExchangeService) releaseLiquidity(ctx context.Context, operationID uuid.UUID) {
+	if s.liquidity == nil {
+		return
+	}
+	ledger, released, err := s.liquidity.Release(ctx, operationID, time.Now().UTC())
+	if err != nil || !released {
+		return
+	}
+	if pair, err := s.tradingPairRepo.GetByID(ctx, ledger.PairID); err == nil {
+		_ = s.syncPairLiquidity(ctx, pair, ledger)
+	}
+}
+
+// syncPairLiquidity sets the pair's liquidity flag from its ledger: on while the available reserve
+// stays at or above the low threshold, off once it drops below.
+func (s *ExchangeService) syncPairLiquidity(ctx context.Context, pair entities.TradingPair, ledger entities.TradingPairLiquidity) error {
+	pairEntity, ok := pair.(*entities.TradingPairEntity)
+	if !ok || pair.HasLiquidity() == ledger.Sufficient() {
+		return nil
+	}
+	pairEntity.SetLiquidity(ledger.Sufficient())
+	return s.tradingPairRepo.Update(ctx, pair)
+}
+
+// Helper method to mark exchange as failed; the quote's liquidity hold is released with it.
 func (s *ExchangeService) markExchangeFailed(
 	ctx context.Context,
 	operation entities.ExchangeOperation,
@@ -399,6 +886,7 @@ func (s *ExchangeService) markExchangeFailed(
 	if err := s.exchangeRepo.Update(ctx, operation); err != nil {
 		return nil, fmt.Errorf("exchange service: update failed status: %w", err)
 	}
+	s.releaseLiquidity(ctx, operation.GetID())
 
 	return operationEntity, nil
 }
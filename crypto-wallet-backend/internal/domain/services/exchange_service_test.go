@@ -0,0 +1,259 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// claimingExchangeRepo keeps one row per operation and claims it the way the Postgres repository
+// does: under a per-operation lock, pending to processing only while the quote is valid. Reads
+// return fresh copies, as scanning a row would.
+type claimingExchangeRepo struct {
+	repositories.ExchangeOperationRepository
+
+	mu     sync.Mutex
+	rows   map[uuid.UUID]entities.ExchangeOperationParams
+	claims atomic.Int32
+}
+
+func newClaimingExchangeRepo(operations ...*entities.ExchangeOperationEntity) *claimingExchangeRepo {
+	repo := &claimingExchangeRepo{rows: make(map[uuid.UUID]entities.ExchangeOperationParams)}
+	for _, operation := range operations {
+		repo.rows[operation.GetID()] = exchangeOperationRow(operation)
+	}
+	return repo
+}
+
+func exchangeOperationRow(operation entities.ExchangeOperation) entities.ExchangeOperationParams {
+	return entities.ExchangeOperationParams{
+		ID:             operation.GetID(),
+		UserID:         operation.GetUserID(),
+		FromWalletID:   operation.GetFromWalletID(),
+		ToWalletID:     operation.GetToWalletID(),
+		FromAmount:     operation.GetFromAmount(),
+		ToAmount:       operation.GetToAmount(),
+		ExchangeRate:   operation.GetExchangeRate(),
+		Status:         operation.GetStatus(),
+		QuoteExpiresAt: operation.GetQuoteExpiresAt(),
+		ExecutedAt:     operation.GetExecutedAt(),
+		ErrorMessage:   operation.GetErrorMessage(),
+		CreatedAt:      operation.GetCreatedAt(),
+		UpdatedAt:      operation.GetUpdatedAt(),
+	}
+}
+
+func (r *claimingExchangeRepo) ClaimForExecution(_ context.Context, id uuid.UUID, at time.Time) (entities.ExchangeOperation, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	row, ok := r.rows[id]
+	if !ok {
+		return nil, false, repositories.ErrNotFound
+	}
+	if row.Status != entities.ExchangeStatusPending || !at.Before(row.QuoteExpiresAt) {
+		return entities.HydrateExchangeOperationEntity(row), false, nil
+	}
+
+	row.Status = entities.ExchangeStatusProcessing
+	row.UpdatedAt = at
+	r.rows[id] = row
+	r.claims.Add(1)
+	return entities.HydrateExchangeOperationEntity(row), true, nil
+}
+
+func (r *claimingExchangeRepo) Update(_ context.Context, operation entities.ExchangeOperation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rows[operation.GetID()] = exchangeOperationRow(operation)
+	return nil
+}
+
+func (r *claimingExchangeRepo) status(id uuid.UUID) entities.ExchangeStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.rows[id].Status
+}
+
+// ledgerWalletRepo stores wallet balances and counts balance writes.
+type ledgerWalletRepo struct {
+	repositories.WalletRepository
+
+	mu       sync.Mutex
+	wallets  map[uuid.UUID]entities.WalletParams
+	balances atomic.Int32
+}
+
+func newLedgerWalletRepo(wallets ...entities.WalletParams) *ledgerWalletRepo {
+	repo := &ledgerWalletRepo{wallets: make(map[uuid.UUID]entities.WalletParams)}
+	for _, wallet := range wallets {
+		repo.wallets[wallet.ID] = wallet
+	}
+	return repo
+}
+
+func (r *ledgerWalletRepo) GetByIDForUpdate(_ context.Context, id uuid.UUID) (entities.Wallet, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	params, ok := r.wallets[id]
+	if !ok {
+		return nil, repositories.ErrNotFound
+	}
+	return entities.HydrateWalletEntity(params), nil
+}
+
+func (r *ledgerWalletRepo) Update(_ context.Context, wallet entities.Wallet) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	params := r.wallets[wallet.GetID()]
+	params.Balance = wallet.GetBalance()
+	r.wallets[wallet.GetID()] = params
+	r.balances.Add(1)
+	return nil
+}
+
+func (r *ledgerWalletRepo) balance(id uuid.UUID) decimal.Decimal {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.wallets[id].Balance
+}
+
+// gatedUnitOfWork holds the first execution inside its unit of work until release is closed, so
+// the test controls when that execution finishes.
+type gatedUnitOfWork struct {
+	entered chan struct{}
+	release chan struct{}
+	once    sync.Once
+	runs    atomic.Int32
+}
+
+func newGatedUnitOfWork() *gatedUnitOfWork {
+	return &gatedUnitOfWork{entered: make(chan struct{}), release: make(chan struct{})}
+}
+
+func (u *gatedUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	u.runs.Add(1)
+	u.once.Do(func() { close(u.entered) })
+	<-u.release
+	return fn(ctx)
+}
+
+func TestExecuteExchangeConcurrentCallsSettleOnce(t *testing.T) {
+	const callers = 16
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+	fromWallet := entities.WalletParams{ID: uuid.New(), Chain: entities.ChainETH, Balance: decimal.RequireFromString("10"), Status: entities.WalletStatusActive}
+	toWallet := entities.WalletParams{ID: uuid.New(), Chain: entities.ChainBTC, Balance: decimal.RequireFromString("0"), Status: entities.WalletStatusActive}
+	operation := entities.HydrateExchangeOperationEntity(entities.ExchangeOperationParams{
+		ID:             uuid.New(),
+		UserID:         uuid.New(),
+		FromWalletID:   fromWallet.ID,
+		ToWalletID:     toWallet.ID,
+		FromAmount:     decimal.RequireFromString("2"),
+		ToAmount:       decimal.RequireFromString("0.05"),
+		ExchangeRate:   decimal.RequireFromString("0.025"),
+		Status:         entities.ExchangeStatusPending,
+		QuoteExpiresAt: now.Add(time.Minute),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	})
+
+	exchanges := newClaimingExchangeRepo(operation)
+	wallets := newLedgerWalletRepo(fromWallet, toWallet)
+	uow := newGatedUnitOfWork()
+	release := sync.OnceFunc(func() { close(uow.release) })
+	t.Cleanup(release)
+	service := NewExchangeService(exchanges, nil, wallets, nil, PricingStrategies{}, uow, nil)
+
+	type outcome struct {
+		operation *entities.ExchangeOperationEntity
+		err       error
+	}
+	outcomes := make(chan outcome, callers)
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			result, err := service.ExecuteExchange(ctx, operation.GetID())
+			outcomes <- outcome{operation: result, err: err}
+		}()
+	}
+	close(start)
+
+	// One caller claims the operation and is held inside the unit of work. Every other caller
+	// overlaps with it and must be turned away without touching the wallets.
+	select {
+	case <-uow.entered:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no caller claimed the operation")
+	}
+	for range callers - 1 {
+		select {
+		case result := <-outcomes:
+			if !errors.Is(result.err, ErrExchangeExecutionInProgress) {
+				t.Fatalf("overlapping ExecuteExchange() = %v, %v; want ErrExchangeExecutionInProgress", result.operation, result.err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("overlapping caller did not return while the execution was running")
+		}
+	}
+
+	release()
+	wg.Wait()
+	winner := <-outcomes
+	if winner.err != nil {
+		t.Fatalf("claiming ExecuteExchange() error = %v", winner.err)
+	}
+	if winner.operation.GetStatus() != entities.ExchangeStatusCompleted {
+		t.Fatalf("claiming ExecuteExchange() status = %s, want completed", winner.operation.GetStatus())
+	}
+
+	if got := exchanges.claims.Load(); got != 1 {
+		t.Errorf("claims = %d, want 1", got)
+	}
+	if got := uow.runs.Load(); got != 1 {
+		t.Errorf("settlements = %d, want 1", got)
+	}
+	if got := exchanges.status(operation.GetID()); got != entities.ExchangeStatusCompleted {
+		t.Errorf("stored status = %s, want completed", got)
+	}
+	wantFrom, wantTo := decimal.RequireFromString("8"), decimal.RequireFromString("0.05")
+	if got := wallets.balance(fromWallet.ID); !got.Equal(wantFrom) {
+		t.Errorf("source balance = %s, want %s", got, wantFrom)
+	}
+	if got := wallets.balance(toWallet.ID); !got.Equal(wantTo) {
+		t.Errorf("destination balance = %s, want %s", got, wantTo)
+	}
+
+	// A retry after completion returns the final state and does not debit again.
+	writes := wallets.balances.Load()
+	repeat, err := service.ExecuteExchange(ctx, operation.GetID())
+	if err != nil {
+		t.Fatalf("repeat ExecuteExchange() error = %v", err)
+	}
+	if repeat.GetStatus() != entities.ExchangeStatusCompleted || repeat.GetExecutedAt() == nil {
+		t.Errorf("repeat ExecuteExchange() = %s executed %v, want completed with execution time", repeat.GetStatus(), repeat.GetExecutedAt())
+	}
+	if got := wallets.balances.Load(); got != writes {
+		t.Errorf("repeat ExecuteExchange() wrote balances %d more times", got-writes)
+	}
+	if got := wallets.balance(fromWallet.ID); !got.Equal(wantFrom) {
+		t.Errorf("source balance after repeat = %s, want %s", got, wantFrom)
+	}
+	if got := uow.runs.Load(); got != 1 {
+		t.Errorf("settlements after repeat = %d, want 1", got)
+	}
+}
@@ -0,0 +1,206 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/blockchain"
+	appLogging "github.com/crypto-wallet/backend/internal/infrastructure/logging"
+)
+
+// GetHDSeed returns the user's HD wallet seed metadata.
+func (s *WalletService) GetHDSeed(ctx context.Context, userID uuid.UUID) (entities.HDSeed, error) {
+	if s.hdSeeds == nil || s.keychain == nil {
+		return entities.HDSeed{}, ErrHDWalletNotConfigured
+	}
+	seed, err := s.hdSeeds.GetByUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return entities.HDSeed{}, ErrHDSeedNotFound
+		}
+		return entities.HDSeed{}, fmt.Errorf("wallet service: load hd seed: %w", err)
+	}
+	return seed, nil
+}
+
+// GenerateMnemonic creates a new mnemonic for the user and stores its encrypted seed. The phrase is
+// returned exactly once and never persisted; the backup stays unconfirmed until the user proves
+// they wrote it down with ConfirmMnemonicBackup.
+func (s *WalletService) GenerateMnemonic(ctx context.Context, userID uuid.UUID) (string, entities.HDSeed, error) {
+	if s.keychain == nil {
+		return "", entities.HDSeed{}, ErrHDWalletNotConfigured
+	}
+	mnemonic, err := s.keychain.NewMnemonic()
+	if err != nil {
+		return "", entities.HDSeed{}, fmt.Errorf("wallet service: generate mnemonic: %w", err)
+	}
+	seed, err := s.storeHDSeed(ctx, userID, mnemonic, entities.HDSeedSourceGenerated)
+	if err != nil {
+		return "", entities.HDSeed{}, err
+	}
+	return mnemonic, seed, nil
+}
+
+// ImportMnemonic stores the seed of a mnemonic the user brought from another wallet. Imported
+// phrases are already backed up by definition, so the backup is recorded as confirmed.
+func (s *WalletService) ImportMnemonic(ctx context.Context, userID uuid.UUID, mnemonic string) (entities.HDSeed, error) {
+	return s.storeHDSeed(ctx, userID, mnemonic, entities.HDSeedSourceImported)
+}
+
+// ConfirmMnemonicBackup records that the user backed up their mnemonic, which they prove by
+// entering it again. Only the seed is stored, so the phrase is checked by comparing seeds.
+func (s *WalletService) ConfirmMnemonicBackup(ctx context.Context, userID uuid.UUID, mnemonic string) (entities.HDSeed, error) {
+	seed, err := s.GetHDSeed(ctx, userID)
+	if err != nil {
+		return entities.HDSeed{}, err
+	}
+	if seed.IsBackupConfirmed() {
+		return entities.HDSeed{}, ErrHDBackupConfirmed
+	}
+
+	candidate, err := s.keychain.MnemonicToSeed(mnemonic)
+	if err != nil {
+		return entities.HDSeed{}, fmt.Errorf("%w: %v", ErrInvalidMnemonic, err)
+	}
+	stored, err := s.decryptSecret(ctx, seed.EncryptedSeed, entities.HDSeedAssociatedData(userID))
+	if err != nil {
+		return entities.HDSeed{}, fmt.Errorf("wallet service: decrypt hd seed: %w", err)
+	}
+	if subtle.ConstantTimeCompare(candidate, stored) != 1 {
+		appLogging.LoggerFromContext(ctx, s.logger).Warn("mnemonic backup confirmation mismatch",
+			slog.String("user_id", userID.String()))
+		return entities.HDSeed{}, ErrMnemonicMismatch
+	}
+
+	now := s.now()
+	if err := s.hdSeeds.ConfirmBackup(ctx, userID, now); err != nil {
+		switch {
+		case errors.Is(err, repositories.ErrNotFound):
+			return entities.HDSeed{}, ErrHDSeedNotFound
+		case errors.Is(err, repositories.ErrConflict):
+			return entities.HDSeed{}, ErrHDBackupConfirmed
+		}
+		return entities.HDSeed{}, fmt.Errorf("wallet service: confirm hd backup: %w", err)
+	}
+	seed.BackupConfirmedAt = &now
+	seed.UpdatedAt = now
+	return seed, nil
+}
+
+func (s *WalletService) storeHDSeed(ctx context.Context, userID uuid.UUID, mnemonic string, source entities.HDSeedSource) (entities.HDSeed, error) {
+	if s.hdSeeds == nil || s.keychain == nil {
+		return entities.HDSeed{}, ErrHDWalletNotConfigured
+	}
+	if s.encryptor == nil {
+		return entities.HDSeed{}, ErrEncryptorNotConfigured
+	}
+	if userID == uuid.Nil {
+		return entities.HDSeed{}, fmt.Errorf("wallet service: user id is required")
+	}
+
+	seedBytes, err := s.keychain.MnemonicToSeed(mnemonic)
+	if err != nil {
+		return entities.HDSeed{}, fmt.Errorf("%w: %v", ErrInvalidMnemonic, err)
+	}
+	encrypted, err := s.encryptor.EncryptToString(ctx, seedBytes, entities.HDSeedAssociatedData(userID))
+	if err != nil {
+		return entities.HDSeed{}, fmt.Errorf("wallet service: encrypt hd seed: %w", err)
+	}
+
+	now := s.now()
+	seed := entities.HDSeed{
+		UserID:        userID,
+		EncryptedSeed: encrypted,
+		Source:        source,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if source == entities.HDSeedSourceImported {
+		seed.BackupConfirmedAt = &now
+	}
+
+	if err := s.hdSeeds.Create(ctx, seed); err != nil {
+		if errors.Is(err, repositories.ErrDuplicate) {
+			return entities.HDSeed{}, ErrHDSeedExists
+		}
+		return entities.HDSeed{}, fmt.Errorf("wallet service: persist hd seed: %w", err)
+	}
+
+	appLogging.LoggerFromContext(ctx, s.logger).Info("hd seed stored",
+		slog.String("user_id", userID.String()),
+		slog.String("source", string(source)))
+	return seed, nil
+}
+
+// deriveHDWallet derives the user's next account of the chain. It reports false when the user has
+// no seed, in which case the caller falls back to the chain adapter.
+func (s *WalletService) deriveHDWallet(ctx context.Context, userID uuid.UUID, chain entities.Chain) (*blockchain.Wallet, bool, error) {
+	if s.hdSeeds == nil || s.keychain == nil {
+		return nil, false, nil
+	}
+	seed, err := s.hdSeeds.GetByUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("wallet service: load hd seed: %w", err)
+	}
+
+	seedBytes, err := s.decryptSecret(ctx, seed.EncryptedSeed, entities.HDSeedAssociatedData(userID))
+	if err != nil {
+		return nil, false, fmt.Errorf("wallet service: decrypt hd seed: %w", err)
+	}
+	index, err := s.hdSeeds.ReserveAccountIndex(ctx, userID, chain)
+	if err != nil {
+		return nil, false, fmt.Errorf("wallet service: reserve hd account: %w", err)
+	}
+	path, err := s.keychain.AccountPath(chain, index)
+	if err != nil {
+		return nil, false, fmt.Errorf("wallet service: hd account path: %w", err)
+	}
+	wallet, err := s.keychain.Derive(seedBytes, chain, path)
+	if err != nil {
+		return nil, false, fmt.Errorf("wallet service: derive hd account: %w", err)
+	}
+	return wallet, true, nil
+}
+
+// deriveHDPrivateKey re-derives an HD wallet's private key from its owner's seed and checks that
+// it still belongs to the wallet's address.
+func (s *WalletService) deriveHDPrivateKey(ctx context.Context, wallet entities.Wallet) ([]byte, error) {
+	if s.hdSeeds == nil || s.keychain == nil {
+		return nil, ErrHDWalletNotConfigured
+	}
+	seed, err := s.hdSeeds.GetByUser(ctx, wallet.GetUserID())
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrHDSeedNotFound
+		}
+		return nil, fmt.Errorf("wallet service: load hd seed: %w", err)
+	}
+	seedBytes, err := s.decryptSecret(ctx, seed.EncryptedSeed, entities.HDSeedAssociatedData(wallet.GetUserID()))
+	if err != nil {
+		return nil, fmt.Errorf("wallet service: decrypt hd seed: %w", err)
+	}
+	derived, err := s.keychain.Derive(seedBytes, wallet.GetChain(), wallet.GetDerivationPath())
+	if err != nil {
+		return nil, fmt.Errorf("wallet service: derive hd account: %w", err)
+	}
+	if derived.Address != wallet.GetAddress() {
+		return nil, fmt.Errorf("wallet service: derived address does not match wallet")
+	}
+	return []byte(derived.PrivateKey), nil
+}
+
+// isHDWallet reports whether the wallet's key is derived from its owner's seed rather than stored.
+func isHDWallet(wallet entities.Wallet) bool {
+	return strings.TrimSpace(wallet.GetEncryptedPrivateKey()) == "" && strings.TrimSpace(wallet.GetDerivationPath()) != ""
+}
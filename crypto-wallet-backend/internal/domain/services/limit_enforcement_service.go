@@ -0,0 +1,343 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// LimitMode selects what happens to an operation that would exceed the owner's KYC limits.
+type LimitMode string
+
+const (
+	// LimitModeEnforce rejects the operation.
+	LimitModeEnforce LimitMode = "enforce"
+	// LimitModeFlag lets the operation through and queues the user for manual compliance review.
+	LimitModeFlag LimitMode = "flag"
+)
+
+// ParseLimitMode normalises a configured mode; an empty value means LimitModeEnforce.
+func ParseLimitMode(value string) (LimitMode, bool) {
+	switch mode := LimitMode(strings.ToLower(strings.TrimSpace(value))); mode {
+	case "":
+		return LimitModeEnforce, true
+	case LimitModeEnforce, LimitModeFlag:
+		return mode, true
+	default:
+		return "", false
+	}
+}
+
+// Rolling windows the daily and monthly KYC limits are measured over.
+const (
+	LimitDailyWindow   = 24 * time.Hour
+	LimitMonthlyWindow = 30 * 24 * time.Hour
+)
+
+// Limit windows as reported in headroom and LimitExceededError.
+const (
+	LimitWindowDaily   = "daily"
+	LimitWindowMonthly = "monthly"
+)
+
+var (
+	// ErrLimitExceeded is matched by every LimitExceededError.
+	ErrLimitExceeded = errors.New("limit enforcement: operation exceeds the kyc limit")
+	// ErrLimitRateUnavailable is returned when an outflow cannot be valued because its chain has no USD rate.
+	ErrLimitRateUnavailable = errors.New("limit enforcement: usd rate unavailable")
+)
+
+// LimitExceededError reports the window an operation would overrun.
+type LimitExceededError struct {
+	Window       string
+	LimitUSD     decimal.Decimal
+	UsedUSD      decimal.Decimal
+	RequestedUSD decimal.Decimal
+}
+
+func (e *LimitExceededError) Error() string {
+	return fmt.Sprintf("limit enforcement: %s limit of %s USD exceeded (used %s USD, requested %s USD)",
+		e.Window, e.LimitUSD.StringFixed(2), e.UsedUSD.StringFixed(2), e.RequestedUSD.StringFixed(2))
+}
+
+// Unwrap lets errors.Is match ErrLimitExceeded.
+func (e *LimitExceededError) Unwrap() error {
+	return ErrLimitExceeded
+}
+
+// RemainingUSD is the headroom left in the window before the operation.
+func (e *LimitExceededError) RemainingUSD() decimal.Decimal {
+	return decimal.Max(e.LimitUSD.Sub(e.UsedUSD), decimal.Zero)
+}
+
+// LimitWindowUsage is the usage of one rolling window.
+type LimitWindowUsage struct {
+	Window       string
+	LimitUSD     decimal.Decimal
+	UsedUSD      decimal.Decimal
+	RemainingUSD decimal.Decimal
+	Since        time.Time
+}
+
+// LimitHeadroom is a user's usage of their KYC limits.
+type LimitHeadroom struct {
+	UserID            uuid.UUID
+	VerificationLevel entities.VerificationLevel
+	Mode              LimitMode
+	Daily             LimitWindowUsage
+	Monthly           LimitWindowUsage
+	CalculatedAt      time.Time
+}
+
+// LimitEnforcementServiceConfig configures the LimitEnforcementService.
+type LimitEnforcementServiceConfig struct {
+	Usage   repositories.LimitUsageRepository
+	Wallets repositories.WalletRepository
+	// KYC supplies the limits and, in LimitModeFlag, receives the review items.
+	KYC    repositories.KYCRepository
+	Rates  repositories.RateRepository
+	Mode   LimitMode
+	Logger *slog.Logger
+}
+
+// LimitEnforcementService holds a user's sends and exchanges to the daily and monthly USD limits of
+// their KYC profile. Outflows are totalled per chain in native units and valued at current USD
+// rates, so usage moves with the market. Users without a profile get the unverified limits.
+// Checks are not serialised, so operations started at the same moment can together overrun a limit
+// by at most one operation each.
+type LimitEnforcementService struct {
+	usage   repositories.LimitUsageRepository
+	wallets repositories.WalletRepository
+	kyc     repositories.KYCRepository
+	rates   repositories.RateRepository
+	mode    LimitMode
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+// NewLimitEnforcementService constructs the service. An unknown mode falls back to LimitModeEnforce.
+func NewLimitEnforcementService(cfg LimitEnforcementServiceConfig) *LimitEnforcementService {
+	mode, ok := ParseLimitMode(string(cfg.Mode))
+	if !ok {
+		mode = LimitModeEnforce
+	}
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &LimitEnforcementService{
+		usage:   cfg.Usage,
+		wallets: cfg.Wallets,
+		kyc:     cfg.KYC,
+		rates:   cfg.Rates,
+		mode:    mode,
+		logger:  logger.With(slog.String("component", "limit_enforcement")),
+		now:     func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Mode returns the configured enforcement mode.
+func (s *LimitEnforcementService) Mode() LimitMode {
+	return s.mode
+}
+
+// Headroom returns how much of the user's daily and monthly limits is used and left.
+func (s *LimitEnforcementService) Headroom(ctx context.Context, userID uuid.UUID) (LimitHeadroom, error) {
+	headroom, _, err := s.measure(ctx, userID, "")
+	return headroom, err
+}
+
+// CheckOutflow checks an operation moving amount out of the wallet against the limits of the
+// wallet's owner, who is the one whose funds leave. operation names the kind of outflow for logs
+// and review items. In LimitModeEnforce an overrun returns a *LimitExceededError and an outflow
+// that cannot be valued returns ErrLimitRateUnavailable. In LimitModeFlag an overrun is queued for
+// compliance review and the operation is allowed, as it is when usage cannot be measured.
+func (s *LimitEnforcementService) CheckOutflow(ctx context.Context, walletID uuid.UUID, amount decimal.Decimal, operation string) error {
+	if s.usage == nil || s.wallets == nil || s.kyc == nil || s.rates == nil {
+		return errors.New("limit enforcement: service not configured")
+	}
+
+	wallet, err := s.wallets.GetByID(ctx, walletID)
+	if err != nil {
+		return err
+	}
+	userID, chain := wallet.GetUserID(), wallet.GetChain()
+	logger := s.logger.With(
+		slog.String("user_id", userID.String()),
+		slog.String("wallet_id", walletID.String()),
+		slog.String("operation", operation))
+
+	headroom, prices, err := s.measure(ctx, userID, chain)
+	if err == nil {
+		price, ok := prices[chain]
+		if !ok {
+			err = fmt.Errorf("%w: %s", ErrLimitRateUnavailable, chain)
+		} else {
+			err = exceeded(headroom, amount.Mul(price))
+		}
+	}
+
+	var overrun *LimitExceededError
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &overrun):
+		if s.mode == LimitModeEnforce {
+			logger.Warn("operation rejected over kyc limit", slog.String("window", overrun.Window))
+			return err
+		}
+		logger.Warn("operation over kyc limit flagged for review", slog.String("window", overrun.Window))
+		s.flag(ctx, userID, walletID, chain, amount, operation, overrun)
+		return nil
+	case s.mode == LimitModeFlag:
+		logger.Warn("limit check skipped", slog.String("error", err.Error()))
+		return nil
+	default:
+		return err
+	}
+}
+
+// exceeded returns the first window the requested value would overrun.
+func exceeded(headroom LimitHeadroom, requestedUSD decimal.Decimal) error {
+	for _, window := range []LimitWindowUsage{headroom.Daily, headroom.Monthly} {
+		if window.UsedUSD.Add(requestedUSD).GreaterThan(window.LimitUSD) {
+			return &LimitExceededError{
+				Window:       window.Window,
+				LimitUSD:     window.LimitUSD,
+				UsedUSD:      window.UsedUSD,
+				RequestedUSD: requestedUSD,
+			}
+		}
+	}
+	return nil
+}
+
+// measure totals the user's usage in USD. The prices of every chain with outflows, plus extra when
+// set, are returned for valuing a new operation.
+func (s *LimitEnforcementService) measure(ctx context.Context, userID uuid.UUID, extra entities.Chain) (LimitHeadroom, map[entities.Chain]decimal.Decimal, error) {
+	if s.usage == nil || s.kyc == nil || s.rates == nil {
+		return LimitHeadroom{}, nil, errors.New("limit enforcement: service not configured")
+	}
+
+	now := s.now()
+	headroom := LimitHeadroom{
+		UserID:            userID,
+		VerificationLevel: entities.VerificationLevelUnverified,
+		Mode:              s.mode,
+		Daily:             LimitWindowUsage{Window: LimitWindowDaily, UsedUSD: decimal.Zero, Since: now.Add(-LimitDailyWindow)},
+		Monthly:           LimitWindowUsage{Window: LimitWindowMonthly, UsedUSD: decimal.Zero, Since: now.Add(-LimitMonthlyWindow)},
+		CalculatedAt:      now,
+	}
+
+	profile, err := s.kyc.GetProfileByUserID(ctx, userID)
+	switch {
+	case err == nil && profile != nil:
+		headroom.VerificationLevel = profile.GetVerificationLevel()
+		headroom.Daily.LimitUSD = profile.GetDailyLimitUSD()
+		headroom.Monthly.LimitUSD = profile.GetMonthlyLimitUSD()
+	case err == nil || errors.Is(err, repositories.ErrNotFound):
+		headroom.Daily.LimitUSD, headroom.Monthly.LimitUSD = entities.KYCLimitsForLevel(entities.VerificationLevelUnverified)
+	default:
+		return LimitHeadroom{}, nil, fmt.Errorf("limit enforcement: load kyc profile: %w", err)
+	}
+
+	outflows, err := s.usage.SumOutflows(ctx, userID, headroom.Daily.Since, headroom.Monthly.Since)
+	if err != nil {
+		return LimitHeadroom{}, nil, fmt.Errorf("limit enforcement: sum outflows: %w", err)
+	}
+
+	chains := make([]entities.Chain, 0, len(outflows)+1)
+	for _, outflow := range outflows {
+		chains = append(chains, outflow.Chain)
+	}
+	if extra != "" {
+		chains = append(chains, extra)
+	}
+	prices, err := s.prices(ctx, chains)
+	if err != nil {
+		return LimitHeadroom{}, nil, err
+	}
+
+	for _, outflow := range outflows {
+		price, ok := prices[outflow.Chain]
+		if !ok {
+			return LimitHeadroom{}, nil, fmt.Errorf("%w: %s", ErrLimitRateUnavailable, outflow.Chain)
+		}
+		headroom.Daily.UsedUSD = headroom.Daily.UsedUSD.Add(outflow.Daily.Mul(price))
+		headroom.Monthly.UsedUSD = headroom.Monthly.UsedUSD.Add(outflow.Monthly.Mul(price))
+	}
+	for _, window := range []*LimitWindowUsage{&headroom.Daily, &headroom.Monthly} {
+		window.UsedUSD = window.UsedUSD.Round(2)
+		window.RemainingUSD = decimal.Max(window.LimitUSD.Sub(window.UsedUSD), decimal.Zero)
+	}
+	return headroom, prices, nil
+}
+
+// prices looks up the USD price of each chain's native asset, keyed by chain. Chains without a
+// rate are left out.
+func (s *LimitEnforcementService) prices(ctx context.Context, chains []entities.Chain) (map[entities.Chain]decimal.Decimal, error) {
+	prices := make(map[entities.Chain]decimal.Decimal, len(chains))
+	if len(chains) == 0 {
+		return prices, nil
+	}
+
+	symbols := make([]string, 0, len(chains))
+	seen := make(map[string]entities.Chain, len(chains))
+	for _, chain := range chains {
+		symbol := strings.ToUpper(string(chain))
+		if _, dup := seen[symbol]; dup {
+			continue
+		}
+		seen[symbol] = chain
+		symbols = append(symbols, symbol)
+	}
+
+	rates, err := s.rates.GetRatesBySymbols(ctx, symbols)
+	if err != nil {
+		return nil, fmt.Errorf("limit enforcement: load rates: %w", err)
+	}
+	for _, rate := range rates {
+		if rate == nil {
+			continue
+		}
+		if chain, ok := seen[strings.ToUpper(strings.TrimSpace(rate.GetSymbol()))]; ok {
+			prices[chain] = rate.GetPriceUSD()
+		}
+	}
+	return prices, nil
+}
+
+// flag queues a flagged overrun for manual review. Failures are logged; the operation proceeds.
+func (s *LimitEnforcementService) flag(ctx context.Context, userID, walletID uuid.UUID, chain entities.Chain, amount decimal.Decimal, operation string, overrun *LimitExceededError) {
+	item := &entities.ComplianceReviewItem{
+		UserID:    userID,
+		Source:    entities.ComplianceReviewSourceLimitExceeded,
+		Reason:    fmt.Sprintf("%s of %s %s exceeded the %s limit", operation, amount.String(), chain, overrun.Window),
+		RiskLevel: entities.RiskLevelMedium,
+		Details: map[string]any{
+			"operation":     operation,
+			"wallet_id":     walletID.String(),
+			"chain":         string(chain),
+			"amount":        amount.String(),
+			"window":        overrun.Window,
+			"limit_usd":     overrun.LimitUSD.String(),
+			"used_usd":      overrun.UsedUSD.String(),
+			"requested_usd": overrun.RequestedUSD.StringFixed(2),
+		},
+		CreatedAt: s.now(),
+	}
+	if err := s.kyc.EnqueueComplianceReview(ctx, item); err != nil {
+		s.logger.Error("failed to queue limit overrun for review",
+			slog.String("user_id", userID.String()),
+			slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,332 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// Pricing strategy names recorded on quotes.
+const (
+	PricingStrategyFixedSpread      = "fixed_spread"
+	PricingStrategyVolatilitySpread = "volatility_spread"
+	PricingStrategyTierDiscount     = "tier_discount"
+)
+
+var (
+	errPricingVolatilityUnavailable = errors.New("pricing: volatility source is not configured")
+	errPricingNotEnoughHistory      = errors.New("pricing: not enough price history to estimate volatility")
+	errPricingRateNotPositive       = errors.New("pricing: priced exchange rate must be positive")
+
+	basisPointsPerUnit = decimal.NewFromInt(10000)
+	hundred            = decimal.NewFromInt(100)
+)
+
+// PricingInput carries what a strategy may consider when pricing a quote.
+type PricingInput struct {
+	Pair       entities.TradingPair
+	UserID     uuid.UUID
+	FromAmount decimal.Decimal
+}
+
+// PricingResult is a priced quote. Metadata records the inputs the strategy used so the quote can
+// still be explained after the pair's rates or the strategy configuration change. A positive
+// QuoteTTL shortens the pair's quote TTL for this quote; it never lengthens it.
+type PricingResult struct {
+	ExchangeRate  decimal.Decimal
+	FeePercentage decimal.Decimal
+	FeeAmount     decimal.Decimal
+	ToAmount      decimal.Decimal
+	QuoteTTL      time.Duration
+	Metadata      map[string]string
+}
+
+// PricingStrategy turns a trading pair's mid rate and fee into the rate and fee a user is quoted.
+type PricingStrategy interface {
+	Name() string
+	Price(ctx context.Context, input PricingInput) (PricingResult, error)
+}
+
+// PricingStrategies selects the strategy for each trading pair. Pairs are keyed "BASE/QUOTE";
+// pairs without an entry use Default, and a nil Default prices at the mid rate with no spread.
+type PricingStrategies struct {
+	Default PricingStrategy
+	Pairs   map[string]PricingStrategy
+}
+
+// For returns the strategy configured for the pair.
+func (p PricingStrategies) For(pair entities.TradingPair) PricingStrategy {
+	key := strings.ToUpper(pair.GetBaseSymbol()) + "/" + strings.ToUpper(pair.GetQuoteSymbol())
+	if strategy, ok := p.Pairs[key]; ok && strategy != nil {
+		return strategy
+	}
+	if p.Default != nil {
+		return p.Default
+	}
+	return FixedSpreadStrategy{}
+}
+
+// FixedSpreadStrategy quotes the pair's mid rate less a constant spread and charges the pair's fee.
+type FixedSpreadStrategy struct {
+	SpreadBps decimal.Decimal
+}
+
+// Name implements PricingStrategy.
+func (FixedSpreadStrategy) Name() string {
+	return PricingStrategyFixedSpread
+}
+
+// Price implements PricingStrategy.
+func (s FixedSpreadStrategy) Price(_ context.Context, input PricingInput) (PricingResult, error) {
+	result, err := priceWithSpread(input, s.SpreadBps)
+	if err != nil {
+		return PricingResult{}, err
+	}
+	result.Metadata["strategy"] = s.Name()
+	return result, nil
+}
+
+// VolatilitySource estimates a pair's recent volatility in basis points.
+type VolatilitySource interface {
+	PairVolatilityBps(ctx context.Context, baseSymbol, quoteSymbol string) (decimal.Decimal, error)
+}
+
+// VolatilitySpreadStrategy widens the spread with recent volatility:
+// spread = BaseSpreadBps + Multiplier × volatility, capped at MaxSpreadBps when it is set.
+// When volatility reaches VolatileBps the quote is also limited to VolatileQuoteTTL.
+type VolatilitySpreadStrategy struct {
+	Volatility       VolatilitySource
+	BaseSpreadBps    decimal.Decimal
+	Multiplier       decimal.Decimal
+	MaxSpreadBps     decimal.Decimal
+	VolatileBps      decimal.Decimal
+	VolatileQuoteTTL time.Duration
+}
+
+// Name implements PricingStrategy.
+func (VolatilitySpreadStrategy) Name() string {
+	return PricingStrategyVolatilitySpread
+}
+
+// Price implements PricingStrategy. Quotes fail rather than fall back to a narrower spread when
+// volatility cannot be estimated.
+func (s VolatilitySpreadStrategy) Price(ctx context.Context, input PricingInput) (PricingResult, error) {
+	if s.Volatility == nil {
+		return PricingResult{}, errPricingVolatilityUnavailable
+	}
+	volatility, err := s.Volatility.PairVolatilityBps(ctx, input.Pair.GetBaseSymbol(), input.Pair.GetQuoteSymbol())
+	if err != nil {
+		return PricingResult{}, fmt.Errorf("pricing: estimate volatility: %w", err)
+	}
+
+	spread := s.BaseSpreadBps.Add(s.Multiplier.Mul(volatility))
+	if s.MaxSpreadBps.IsPositive() && spread.GreaterThan(s.MaxSpreadBps) {
+		spread = s.MaxSpreadBps
+	}
+
+	result, err := priceWithSpread(input, spread)
+	if err != nil {
+		return PricingResult{}, err
+	}
+	result.Metadata["strategy"] = s.Name()
+	result.Metadata["volatility_bps"] = volatility.StringFixed(2)
+	result.Metadata["base_spread_bps"] = s.BaseSpreadBps.String()
+	result.Metadata["volatility_multiplier"] = s.Multiplier.String()
+	if s.VolatileQuoteTTL > 0 && s.VolatileBps.IsPositive() && volatility.GreaterThanOrEqual(s.VolatileBps) {
+		result.QuoteTTL = s.VolatileQuoteTTL
+		result.Metadata["quote_ttl_seconds"] = strconv.Itoa(int(s.VolatileQuoteTTL.Seconds()))
+	}
+	return result, nil
+}
+
+// TierResolver looks up the verification level that determines a user's fee tier.
+type TierResolver interface {
+	VerificationLevel(ctx context.Context, userID uuid.UUID) (entities.VerificationLevel, error)
+}
+
+// TierDiscountStrategy prices with Base and then discounts the fee by the user's verification
+// tier. Discounts are fractions of the fee, so 0.25 takes a quarter off.
+type TierDiscountStrategy struct {
+	Base      PricingStrategy
+	Tiers     TierResolver
+	Discounts map[entities.VerificationLevel]decimal.Decimal
+}
+
+// Name implements PricingStrategy.
+func (TierDiscountStrategy) Name() string {
+	return PricingStrategyTierDiscount
+}
+
+// Price implements PricingStrategy. A tier that cannot be resolved gets the undiscounted fee.
+func (s TierDiscountStrategy) Price(ctx context.Context, input PricingInput) (PricingResult, error) {
+	base := s.Base
+	if base == nil {
+		base = FixedSpreadStrategy{}
+	}
+	result, err := base.Price(ctx, input)
+	if err != nil {
+		return PricingResult{}, err
+	}
+	result.Metadata["base_strategy"] = result.Metadata["strategy"]
+	result.Metadata["strategy"] = s.Name()
+
+	tier := "unknown"
+	discount := decimal.Zero
+	if s.Tiers != nil {
+		if level, err := s.Tiers.VerificationLevel(ctx, input.UserID); err == nil {
+			tier = string(level)
+			discount = clampFraction(s.Discounts[level])
+		}
+	}
+	result.Metadata["tier"] = tier
+	result.Metadata["tier_discount"] = discount.String()
+	if discount.IsZero() {
+		return result, nil
+	}
+
+	result.Metadata["undiscounted_fee_percentage"] = result.FeePercentage.String()
+	result.FeePercentage = result.FeePercentage.Mul(decimal.NewFromInt(1).Sub(discount))
+	result.FeeAmount, result.ToAmount = settleQuote(input.FromAmount, result.ExchangeRate, result.FeePercentage)
+	result.Metadata["fee_percentage"] = result.FeePercentage.String()
+	return result, nil
+}
+
+// KYCTierResolver resolves fee tiers from KYC profiles; users without a profile are unverified.
+type KYCTierResolver struct {
+	Profiles repositories.KYCRepository
+}
+
+// VerificationLevel implements TierResolver.
+func (r KYCTierResolver) VerificationLevel(ctx context.Context, userID uuid.UUID) (entities.VerificationLevel, error) {
+	profile, err := r.Profiles.GetProfileByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return entities.VerificationLevelUnverified, nil
+		}
+		return "", err
+	}
+	if profile.GetStatus() != entities.KYCStatusApproved {
+		return entities.VerificationLevelUnverified, nil
+	}
+	return profile.GetVerificationLevel(), nil
+}
+
+// PriceHistoryVolatility estimates pair volatility as the standard deviation of per-interval
+// returns over Window. The pair's variance is taken as the sum of both legs' variances, which
+// overstates it for correlated assets and so errs towards a wider spread.
+type PriceHistoryVolatility struct {
+	Rates    repositories.RateRepository
+	Interval entities.IntervalType
+	Window   time.Duration
+	Now      func() time.Time
+}
+
+// PairVolatilityBps implements VolatilitySource.
+func (v PriceHistoryVolatility) PairVolatilityBps(ctx context.Context, baseSymbol, quoteSymbol string) (decimal.Decimal, error) {
+	interval := v.Interval
+	if interval == "" {
+		interval = entities.Interval1h
+	}
+	window := v.Window
+	if window <= 0 {
+		window = 24 * time.Hour
+	}
+	now := time.Now().UTC()
+	if v.Now != nil {
+		now = v.Now()
+	}
+
+	base := strings.ToUpper(strings.TrimSpace(baseSymbol))
+	quote := strings.ToUpper(strings.TrimSpace(quoteSymbol))
+	series, err := v.Rates.ListCloseSeries(ctx, []string{base, quote}, interval, now.Add(-window))
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	variance := 0.0
+	for _, symbol := range []string{base, quote} {
+		legVariance, ok := returnVariance(series[symbol])
+		if !ok {
+			return decimal.Zero, fmt.Errorf("%w: %s", errPricingNotEnoughHistory, symbol)
+		}
+		variance += legVariance
+	}
+	return decimal.NewFromFloat(math.Sqrt(variance) * 10000), nil
+}
+
+// priceWithSpread applies a spread to the pair's mid rate and settles the pair's fee against it.
+func priceWithSpread(input PricingInput, spreadBps decimal.Decimal) (PricingResult, error) {
+	mid := input.Pair.GetExchangeRate()
+	rate := mid.Mul(decimal.NewFromInt(1).Sub(spreadBps.Div(basisPointsPerUnit)))
+	if !rate.IsPositive() {
+		return PricingResult{}, errPricingRateNotPositive
+	}
+
+	feePercentage := input.Pair.GetFeePercentage()
+	feeAmount, toAmount := settleQuote(input.FromAmount, rate, feePercentage)
+	return PricingResult{
+		ExchangeRate:  rate,
+		FeePercentage: feePercentage,
+		FeeAmount:     feeAmount,
+		ToAmount:      toAmount,
+		Metadata: map[string]string{
+			"mid_rate":       mid.String(),
+			"spread_bps":     spreadBps.String(),
+			"fee_percentage": feePercentage.String(),
+		},
+	}, nil
+}
+
+// settleQuote charges the fee on the source amount and converts the remainder at rate.
+func settleQuote(fromAmount, rate, feePercentage decimal.Decimal) (feeAmount, toAmount decimal.Decimal) {
+	feeAmount = feePercentage.Div(hundred).Mul(fromAmount)
+	toAmount = fromAmount.Sub(feeAmount).Mul(rate)
+	return feeAmount, toAmount
+}
+
+func clampFraction(value decimal.Decimal) decimal.Decimal {
+	if value.IsNegative() {
+		return decimal.Zero
+	}
+	if value.GreaterThan(decimal.NewFromInt(1)) {
+		return decimal.NewFromInt(1)
+	}
+	return value
+}
+
+// returnVariance is the sample variance of consecutive simple returns in closes.
+func returnVariance(closes []decimal.Decimal) (float64, bool) {
+	returns := make([]float64, 0, len(closes))
+	for i := 1; i < len(closes); i++ {
+		if !closes[i-1].IsPositive() {
+			continue
+		}
+		r, _ := closes[i].Sub(closes[i-1]).Div(closes[i-1]).Float64()
+		returns = append(returns, r)
+	}
+	if len(returns) < 2 {
+		return 0, false
+	}
+
+	mean := 0.0
+	for _, r := range returns {
+		mean += r
+	}
+	mean /= float64(len(returns))
+
+	sum := 0.0
+	for _, r := range returns {
+		sum += (r - mean) * (r - mean)
+	}
+	return sum / float64(len(returns)-1), true
+}
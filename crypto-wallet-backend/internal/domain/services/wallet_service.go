@@ -13,7 +13,9 @@ import (
 	"github.com/shopspring/decimal"
 
 	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/events"
 	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
 	"github.com/crypto-wallet/backend/internal/infrastructure/blockchain"
 	appLogging "github.com/crypto-wallet/backend/internal/infrastructure/logging"
 )
@@ -27,31 +29,73 @@ var (
 	ErrEncryptorNotConfigured = errors.New("wallet service: encryption service not configured")
 	// ErrWalletNotFound is returned when the requested wallet cannot be located.
 	ErrWalletNotFound = errors.New("wallet service: wallet not found")
+	// ErrWalletPermissionDenied is returned when a member's grant does not cover the requested action.
+	ErrWalletPermissionDenied = errors.New("wallet service: wallet permission denied")
+	// ErrHDWalletNotConfigured indicates that HD wallet support is not enabled.
+	ErrHDWalletNotConfigured = errors.New("wallet service: hd wallets not configured")
+	// ErrHDSeedExists is returned when the user already has an HD wallet seed.
+	ErrHDSeedExists = errors.New("wallet service: hd seed already exists")
+	// ErrHDSeedNotFound is returned when the user has no HD wallet seed.
+	ErrHDSeedNotFound = errors.New("wallet service: hd seed not found")
+	// ErrInvalidMnemonic is returned when a mnemonic is not a valid BIP39 phrase.
+	ErrInvalidMnemonic = errors.New("wallet service: invalid mnemonic")
+	// ErrMnemonicMismatch is returned when a backup confirmation does not match the stored seed.
+	ErrMnemonicMismatch = errors.New("wallet service: mnemonic does not match seed")
+	// ErrHDBackupConfirmed is returned when the mnemonic backup was already confirmed.
+	ErrHDBackupConfirmed = errors.New("wallet service: mnemonic backup already confirmed")
 )
 
 // KeyEncryptor abstracts encryption of private keys for storage.
 type KeyEncryptor interface {
-	EncryptToString(plaintext, additionalData []byte) (string, error)
+	EncryptToString(ctx context.Context, plaintext, additionalData []byte) (string, error)
+}
+
+// KeyAccessAuditor records every decryption of a stored private key.
+type KeyAccessAuditor interface {
+	Record(ctx context.Context, entry audit.Entry) error
+}
+
+// HDKeychain creates BIP39 mnemonics and derives chain accounts from their seeds.
+type HDKeychain interface {
+	NewMnemonic() (string, error)
+	MnemonicToSeed(mnemonic string) ([]byte, error)
+	AccountPath(chain entities.Chain, index uint32) (string, error)
+	Derive(seed []byte, chain entities.Chain, path string) (*blockchain.Wallet, error)
 }
 
 // WalletService coordinates wallet operations across repositories and blockchain adapters.
 type WalletService struct {
-	repo      repositories.WalletRepository
-	encryptor KeyEncryptor
-	adapters  map[entities.Chain]blockchain.BlockchainAdapter
-	logger    *slog.Logger
-	now       func() time.Time
-	retryCfg  blockchain.RetryConfig
+	repo        repositories.WalletRepository
+	permissions repositories.WalletPermissionRepository
+	encryptor   KeyEncryptor
+	keyAudit    KeyAccessAuditor
+	adapters    map[entities.Chain]blockchain.BlockchainAdapter
+	hdSeeds     repositories.HDSeedRepository
+	keychain    HDKeychain
+	events      events.Publisher
+	logger      *slog.Logger
+	now         func() time.Time
+	retryCfg    blockchain.RetryConfig
 }
 
 // WalletServiceConfig configures a WalletService instance.
 type WalletServiceConfig struct {
 	Repository repositories.WalletRepository
-	Encryptor  KeyEncryptor
-	Adapters   map[entities.Chain]blockchain.BlockchainAdapter
-	Logger     *slog.Logger
-	Now        func() time.Time
-	Retry      blockchain.RetryConfig
+	// Permissions enables shared wallets; without it only owners may act on a wallet.
+	Permissions repositories.WalletPermissionRepository
+	Encryptor   KeyEncryptor
+	Adapters    map[entities.Chain]blockchain.BlockchainAdapter
+	Logger      *slog.Logger
+	Now         func() time.Time
+	Retry       blockchain.RetryConfig
+	// KeyAudit, when set, receives an entry for each private key decryption.
+	KeyAudit KeyAccessAuditor
+	// HDSeeds and Keychain enable HD wallets. Once a user has a seed, their new wallets are derived
+	// from it instead of generated by the chain adapter.
+	HDSeeds  repositories.HDSeedRepository
+	Keychain HDKeychain
+	// Events, when set, receives WalletCreated and BalanceUpdated events.
+	Events events.Publisher
 }
 
 // NewWalletService constructs a WalletService.
@@ -74,12 +118,17 @@ func NewWalletService(cfg WalletServiceConfig) *WalletService {
 	}
 
 	return &WalletService{
-		repo:      cfg.Repository,
-		encryptor: cfg.Encryptor,
-		adapters:  adapterMap,
-		logger:    logger,
-		now:       now,
-		retryCfg:  cfg.Retry,
+		repo:        cfg.Repository,
+		permissions: cfg.Permissions,
+		encryptor:   cfg.Encryptor,
+		keyAudit:    cfg.KeyAudit,
+		adapters:    adapterMap,
+		hdSeeds:     cfg.HDSeeds,
+		keychain:    cfg.Keychain,
+		events:      cfg.Events,
+		logger:      logger,
+		now:         now,
+		retryCfg:    cfg.Retry,
 	}
 }
 
@@ -91,6 +140,7 @@ type CreateWalletParams struct {
 }
 
 // CreateWallet generates a new blockchain wallet, encrypts the private key, and persists the aggregate.
+// Users with an HD seed get the next account derived from it; only the path is stored for those.
 func (s *WalletService) CreateWallet(ctx context.Context, params CreateWalletParams) (entities.Wallet, error) {
 	logger := appLogging.LoggerFromContext(ctx, s.logger).With(
 		slog.String("user_id", params.UserID.String()),
@@ -115,14 +165,21 @@ func (s *WalletService) CreateWallet(ctx context.Context, params CreateWalletPar
 		return nil, ErrEncryptorNotConfigured
 	}
 
-	generatedWallet, err := blockchain.Retry(ctx, logger, s.retryCfg, "generate_wallet", func(inner context.Context) (*blockchain.Wallet, error) {
-		return adapter.GenerateWallet(inner)
-	})
+	generatedWallet, derived, err := s.deriveHDWallet(ctx, params.UserID, chain)
 	if err != nil {
-		return nil, fmt.Errorf("wallet service: generate wallet: %w", err)
+		logger.Error("failed to derive hd wallet", slog.String("error", err.Error()))
+		return nil, err
 	}
-	if generatedWallet == nil {
-		return nil, fmt.Errorf("wallet service: blockchain adapter returned nil wallet")
+	if !derived {
+		generatedWallet, err = blockchain.Retry(ctx, logger, s.retryCfg, "generate_wallet", func(inner context.Context) (*blockchain.Wallet, error) {
+			return adapter.GenerateWallet(inner)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("wallet service: generate wallet: %w", err)
+		}
+		if generatedWallet == nil {
+			return nil, fmt.Errorf("wallet service: blockchain adapter returned nil wallet")
+		}
 	}
 
 	privateKey := strings.TrimSpace(generatedWallet.PrivateKey)
@@ -135,9 +192,12 @@ func (s *WalletService) CreateWallet(ctx context.Context, params CreateWalletPar
 		return nil, fmt.Errorf("wallet service: blockchain adapter returned empty address")
 	}
 
-	encryptedKey, err := s.encryptor.EncryptToString([]byte(privateKey), []byte(address))
-	if err != nil {
-		return nil, fmt.Errorf("wallet service: encrypt private key: %w", err)
+	var encryptedKey string
+	if !derived {
+		encryptedKey, err = s.encryptor.EncryptToString(ctx, []byte(privateKey), []byte(address))
+		if err != nil {
+			return nil, fmt.Errorf("wallet service: encrypt private key: %w", err)
+		}
 	}
 
 	label := strings.TrimSpace(params.Label)
@@ -170,6 +230,15 @@ func (s *WalletService) CreateWallet(ctx context.Context, params CreateWalletPar
 
 	logger.Info("wallet created", slog.String("wallet_id", entity.GetID().String()))
 
+	s.publish(ctx, logger, events.WalletCreated{
+		WalletID: entity.GetID(),
+		OwnerID:  entity.GetUserID(),
+		Chain:    chain,
+		Address:  address,
+		Label:    label,
+		At:       now,
+	})
+
 	return entity, nil
 }
 
@@ -207,6 +276,50 @@ func (s *WalletService) GetWalletByID(ctx context.Context, id uuid.UUID) (entiti
 	return wallet, nil
 }
 
+// AuthorizeWallet loads the wallet and checks that the user may perform the action on it. Owners
+// hold every permission; other users need a grant covering it. Users without any grant get
+// ErrWalletNotFound so shared wallets cannot be probed.
+func (s *WalletService) AuthorizeWallet(ctx context.Context, walletID, userID uuid.UUID, permission entities.WalletPermission) (entities.Wallet, error) {
+	if userID == uuid.Nil {
+		return nil, fmt.Errorf("wallet service: user id is required")
+	}
+
+	wallet, err := s.GetWalletByID(ctx, walletID)
+	if err != nil {
+		return nil, err
+	}
+	if wallet.GetUserID() == userID {
+		return wallet, nil
+	}
+
+	logger := appLogging.LoggerFromContext(ctx, s.logger).With(
+		slog.String("wallet_id", walletID.String()),
+		slog.String("user_id", userID.String()),
+		slog.String("permission", string(permission)),
+	)
+
+	if s.permissions == nil {
+		return nil, ErrWalletNotFound
+	}
+
+	grant, err := s.permissions.Get(ctx, walletID, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			logger.Warn("wallet access attempted without grant")
+			return nil, ErrWalletNotFound
+		}
+		logger.Error("failed to load wallet permission", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if !grant.Allows(permission) {
+		logger.Warn("wallet permission denied")
+		return nil, ErrWalletPermissionDenied
+	}
+
+	return wallet, nil
+}
+
 // RefreshWalletBalance pulls the latest balance from the blockchain and persists it.
 func (s *WalletService) RefreshWalletBalance(ctx context.Context, walletID uuid.UUID) (entities.Wallet, *blockchain.Balance, error) {
 	if walletID == uuid.Nil {
@@ -258,6 +371,7 @@ func (s *WalletService) RefreshWalletBalance(ctx context.Context, walletID uuid.
 		lastUpdated = s.now()
 	}
 
+	previous := wallet.GetBalance()
 	if err := wallet.UpdateBalance(balanceValue, lastUpdated); err != nil {
 		logger.Error("failed to update wallet balance", slog.String("error", err.Error()))
 		return nil, nil, fmt.Errorf("wallet service: update balance: %w", err)
@@ -274,43 +388,111 @@ func (s *WalletService) RefreshWalletBalance(ctx context.Context, walletID uuid.
 		slog.String("address", wallet.GetAddress()),
 	)
 
+	if !previous.Equal(balanceValue) {
+		s.publish(ctx, logger, events.BalanceUpdated{
+			WalletID: wallet.GetID(),
+			OwnerID:  wallet.GetUserID(),
+			Chain:    chain,
+			Previous: previous,
+			Balance:  balanceValue,
+			At:       lastUpdated,
+		})
+	}
+
 	return wallet, balance, nil
 }
 
-// DecryptPrivateKey attempts to decrypt a previously stored private key using the configured encryptor.
-func (s *WalletService) DecryptPrivateKey(encrypted string, address string) (string, error) {
+// publish raises a wallet event. The change it describes is already stored, so a failing
+// subscriber is logged rather than failing the operation.
+func (s *WalletService) publish(ctx context.Context, logger *slog.Logger, event events.Event) {
+	if s.events == nil {
+		return
+	}
+	if err := s.events.Publish(ctx, event); err != nil {
+		logger.Warn("failed to publish wallet event",
+			slog.String("event", event.EventName()),
+			slog.String("error", err.Error()))
+	}
+}
+
+// DecryptPrivateKey decrypts the wallet's stored private key using the configured encryptor. HD
+// wallets have their key re-derived from the owner's seed instead. Every attempt is audited,
+// whether or not it succeeds.
+func (s *WalletService) DecryptPrivateKey(ctx context.Context, wallet entities.Wallet) (string, error) {
+	if wallet == nil {
+		return "", ErrWalletNotFound
+	}
+	var (
+		plaintext []byte
+		err       error
+	)
+	if isHDWallet(wallet) {
+		plaintext, err = s.deriveHDPrivateKey(ctx, wallet)
+	} else {
+		plaintext, err = s.decryptSecret(ctx, wallet.GetEncryptedPrivateKey(), []byte(wallet.GetAddress()))
+	}
+	s.recordKeyAccess(ctx, wallet, err)
+	return string(plaintext), err
+}
+
+func (s *WalletService) decryptSecret(ctx context.Context, encrypted string, additionalData []byte) ([]byte, error) {
 	if s.encryptor == nil {
-		return "", ErrEncryptorNotConfigured
+		return nil, ErrEncryptorNotConfigured
 	}
 	if strings.TrimSpace(encrypted) == "" {
-		return "", fmt.Errorf("wallet service: encrypted payload is empty")
-	}
-	data, err := base64.StdEncoding.DecodeString(encrypted)
-	if err != nil {
-		return "", fmt.Errorf("wallet service: decode payload: %w", err)
+		return nil, fmt.Errorf("wallet service: encrypted payload is empty")
 	}
 
 	// The encryptor interface only exposes EncryptToString. To support decrypting without
-	// introducing a broader interface, we rely on type assertion where available.
+	// introducing a broader interface, we rely on type assertion where available. String payloads
+	// are preferred: envelope-encrypted secrets are not plain base64.
 	if decryptor, ok := s.encryptor.(interface {
-		Decrypt([]byte, []byte) ([]byte, error)
+		DecryptString(context.Context, string, []byte) ([]byte, error)
 	}); ok {
-		plaintext, err := decryptor.Decrypt(data, []byte(address))
+		plaintext, err := decryptor.DecryptString(ctx, encrypted, additionalData)
 		if err != nil {
-			return "", fmt.Errorf("wallet service: decrypt payload: %w", err)
+			return nil, fmt.Errorf("wallet service: decrypt payload: %w", err)
 		}
-		return string(plaintext), nil
+		return plaintext, nil
 	}
 
 	if decryptor, ok := s.encryptor.(interface {
-		DecryptString(string, []byte) ([]byte, error)
+		Decrypt([]byte, []byte) ([]byte, error)
 	}); ok {
-		plaintext, err := decryptor.DecryptString(encrypted, []byte(address))
+		data, err := base64.StdEncoding.DecodeString(encrypted)
 		if err != nil {
-			return "", fmt.Errorf("wallet service: decrypt payload: %w", err)
+			return nil, fmt.Errorf("wallet service: decode payload: %w", err)
 		}
-		return string(plaintext), nil
+		plaintext, err := decryptor.Decrypt(data, additionalData)
+		if err != nil {
+			return nil, fmt.Errorf("wallet service: decrypt payload: %w", err)
+		}
+		return plaintext, nil
 	}
 
-	return "", fmt.Errorf("wallet service: encryptor does not support decryption")
+	return nil, fmt.Errorf("wallet service: encryptor does not support decryption")
+}
+
+func (s *WalletService) recordKeyAccess(ctx context.Context, wallet entities.Wallet, decryptErr error) {
+	if s.keyAudit == nil {
+		return
+	}
+	entry := audit.Entry{
+		Action:       "wallet_key_decrypt",
+		ResourceType: "wallet",
+		TargetID:     wallet.GetID().String(),
+		Metadata: map[string]any{
+			"owner_id": wallet.GetUserID().String(),
+			"chain":    string(wallet.GetChain()),
+			"address":  wallet.GetAddress(),
+		},
+	}
+	if decryptErr != nil {
+		entry.Error = decryptErr.Error()
+	}
+	if err := s.keyAudit.Record(ctx, entry); err != nil {
+		appLogging.LoggerFromContext(ctx, s.logger).Warn("failed to record key decryption audit entry",
+			slog.String("wallet_id", wallet.GetID().String()),
+			slog.String("error", err.Error()))
+	}
 }
@@ -0,0 +1,47 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// FileArchiveStore writes audit archives to a local directory, typically a mounted cold-storage volume.
+type FileArchiveStore struct {
+	dir string
+}
+
+// NewFileArchiveStore constructs a FileArchiveStore rooted at dir.
+func NewFileArchiveStore(dir string) (*FileArchiveStore, error) {
+	if dir == "" {
+		return nil, errors.New("audit archive directory is required")
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("create audit archive directory: %w", err)
+	}
+	return &FileArchiveStore{dir: dir}, nil
+}
+
+// Put writes data under key and returns the file path. Existing archives are never overwritten.
+func (s *FileArchiveStore) Put(_ context.Context, key string, data []byte) (string, error) {
+	if key == "" || filepath.Base(key) != key {
+		return "", fmt.Errorf("invalid audit archive key %q", key)
+	}
+
+	path := filepath.Join(s.dir, key)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o640); err != nil {
+		return "", fmt.Errorf("write audit archive: %w", err)
+	}
+	if _, err := os.Stat(path); err == nil {
+		_ = os.Remove(tmp)
+		return "", fmt.Errorf("audit archive %q already exists", key)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return "", fmt.Errorf("store audit archive: %w", err)
+	}
+	return path, nil
+}
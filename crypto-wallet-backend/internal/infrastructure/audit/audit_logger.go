@@ -2,37 +2,146 @@ package audit
 
 import (
     "context"
+    "fmt"
     "log/slog"
+    "strings"
     "time"
+
+    "github.com/google/uuid"
+
+    "github.com/crypto-wallet/backend/internal/domain/entities"
+    appLogging "github.com/crypto-wallet/backend/internal/infrastructure/logging"
+)
+
+// Audit results stored with each entry.
+const (
+    ResultSuccess = "success"
+    ResultFailure = "failure"
 )
 
-// Entry represents an auditable action within the platform.
+// Entry represents an auditable action within the platform. Before and After hold snapshots of
+// the target around the change; Error marks the action as failed.
 type Entry struct {
-    ActorID  any
-    Action   string
-    TargetID string
-    Metadata map[string]any
-    Occurred time.Time
+    ActorID      any
+    Action       string
+    ResourceType string
+    TargetID     string
+    Metadata     map[string]any
+    Before       any
+    After        any
+    Error        string
+    Occurred     time.Time
 }
 
-// Logger writes audit entries to the configured destination (stdout by default).
+// Store persists audit records; the audit database repository satisfies it.
+type Store interface {
+    Append(ctx context.Context, record *entities.AuditRecord) error
+}
+
+// Logger writes audit entries to the log and, when a store is configured, to the audit database.
 type Logger struct {
+    store  Store
     logger *slog.Logger
 }
 
-// NewLogger constructs an Audit Logger.
+// NewLogger constructs an Audit Logger that only writes to the log.
 func NewLogger(logger *slog.Logger) *Logger {
+    return NewPersistentLogger(nil, logger)
+}
+
+// NewPersistentLogger constructs an Audit Logger that also appends every entry to the store.
+func NewPersistentLogger(store Store, logger *slog.Logger) *Logger {
     if logger == nil {
         logger = slog.Default()
     }
-    return &Logger{logger: logger.With(slog.String("component", "audit"))}
+    return &Logger{store: store, logger: logger.With(slog.String("component", "audit"))}
 }
 
-// Record persists an audit entry.
-func (l *Logger) Record(_ context.Context, entry Entry) error {
+// Record persists an audit entry. Request metadata attached to ctx by the HTTP layer is stored
+// alongside it, and the authenticated user stands in for a missing actor.
+func (l *Logger) Record(ctx context.Context, entry Entry) error {
     if entry.Occurred.IsZero() {
         entry.Occurred = time.Now().UTC()
     }
-    l.logger.Info("audit entry", slog.Any("actor", entry.ActorID), slog.String("action", entry.Action), slog.String("target", entry.TargetID), slog.Any("metadata", entry.Metadata), slog.Time("occurred", entry.Occurred))
+    info := RequestInfoFromContext(ctx)
+    if info.RequestID == "" {
+        info.RequestID = appLogging.RequestIDFromContext(ctx)
+    }
+    l.logger.Info("audit entry", slog.Any("actor", entry.ActorID), slog.String("action", entry.Action), slog.String("target", entry.TargetID), slog.String("request_id", info.RequestID), slog.Any("metadata", entry.Metadata), slog.Time("occurred", entry.Occurred))
+
+    if l.store == nil {
+        return nil
+    }
+    if err := l.store.Append(ctx, entry.toRecord(ctx, info)); err != nil {
+        return fmt.Errorf("audit: persist %s entry: %w", entry.Action, err)
+    }
     return nil
 }
+
+func (e Entry) toRecord(ctx context.Context, info RequestInfo) *entities.AuditRecord {
+    details := make(map[string]any, len(e.Metadata)+4)
+    for key, value := range e.Metadata {
+        details[key] = value
+    }
+    if e.Before != nil {
+        details["before"] = e.Before
+    }
+    if e.After != nil {
+        details["after"] = e.After
+    }
+    if info.RequestID != "" {
+        details["request_id"] = info.RequestID
+    }
+
+    record := &entities.AuditRecord{
+        Action:       e.Action,
+        ResourceType: e.ResourceType,
+        Details:      details,
+        IPAddress:    info.IPAddress,
+        UserAgent:    info.UserAgent,
+        SessionID:    info.SessionID,
+        Result:       ResultSuccess,
+        ErrorMessage: e.Error,
+        Timestamp:    e.Occurred,
+    }
+    if e.Error != "" {
+        record.Result = ResultFailure
+    }
+
+    actor := e.ActorID
+    if actor == nil {
+        if userID := appLogging.UserIDFromContext(ctx); userID != "" {
+            actor = userID
+        }
+    }
+    if actorID, ok := parseID(actor); ok {
+        record.UserID = &actorID
+    } else if actor != nil {
+        // Non-user actors such as background jobs are kept by name.
+        details["actor"] = fmt.Sprint(actor)
+    }
+
+    if targetID, ok := parseID(e.TargetID); ok {
+        record.ResourceID = &targetID
+    } else if e.TargetID != "" {
+        details["target"] = e.TargetID
+    }
+    return record
+}
+
+func parseID(value any) (uuid.UUID, bool) {
+    switch v := value.(type) {
+    case uuid.UUID:
+        return v, v != uuid.Nil
+    case *uuid.UUID:
+        if v == nil || *v == uuid.Nil {
+            return uuid.Nil, false
+        }
+        return *v, true
+    case string:
+        id, err := uuid.Parse(strings.TrimSpace(v))
+        return id, err == nil && id != uuid.Nil
+    default:
+        return uuid.Nil, false
+    }
+}
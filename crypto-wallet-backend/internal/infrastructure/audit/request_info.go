@@ -0,0 +1,30 @@
+package audit
+
+import "context"
+
+type requestInfoKey struct{}
+
+// RequestInfo identifies the HTTP request an audited action came from.
+type RequestInfo struct {
+	IPAddress string
+	UserAgent string
+	RequestID string
+	SessionID string
+}
+
+// ContextWithRequestInfo stores request metadata on the context for later audit entries.
+func ContextWithRequestInfo(ctx context.Context, info RequestInfo) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, requestInfoKey{}, info)
+}
+
+// RequestInfoFromContext returns the request metadata stored on the context, if any.
+func RequestInfoFromContext(ctx context.Context) RequestInfo {
+	if ctx == nil {
+		return RequestInfo{}
+	}
+	info, _ := ctx.Value(requestInfoKey{}).(RequestInfo)
+	return info
+}
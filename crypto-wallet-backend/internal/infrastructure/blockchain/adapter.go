@@ -189,11 +189,12 @@ type BlockchainAdapter interface {
 type BaseAdapter struct {
 	chain                 Chain
 	confirmationThreshold int
+	rpc                   *RPCClient
 	logger                *slog.Logger
 }
 
 // newBaseAdapter constructs a BaseAdapter with sane defaults.
-func newBaseAdapter(chain Chain, confirmationThreshold int, logger *slog.Logger) BaseAdapter {
+func newBaseAdapter(chain Chain, confirmationThreshold int, rpc *RPCClient, logger *slog.Logger) BaseAdapter {
 	if confirmationThreshold <= 0 {
 		confirmationThreshold = 1
 	}
@@ -203,6 +204,7 @@ func newBaseAdapter(chain Chain, confirmationThreshold int, logger *slog.Logger)
 	return BaseAdapter{
 		chain:                 chain,
 		confirmationThreshold: confirmationThreshold,
+		rpc:                   rpc,
 		logger:                logger,
 	}
 }
@@ -215,6 +217,11 @@ func (b BaseAdapter) GetConfirmationThreshold() int {
 	return b.confirmationThreshold
 }
 
+// RPC returns the client the adapter sends node calls through.
+func (b BaseAdapter) RPC() *RPCClient {
+	return b.rpc
+}
+
 func (b BaseAdapter) notImplemented(operation string) error {
 	b.logger.Warn("blockchain operation not implemented",
 		slog.String("chain", string(b.chain)),
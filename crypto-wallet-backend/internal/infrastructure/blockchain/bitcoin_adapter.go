@@ -12,10 +12,14 @@ import (
 // BitcoinConfig captures connection parameters for the Bitcoin RPC client.
 type BitcoinConfig struct {
 	RPCURL                string
+	RPCURLFallback        string
 	RPCUser               string
 	RPCPassword           string
 	Network               string
 	ConfirmationThreshold int
+	// RPC overrides the client built from the endpoints, e.g. to share breaker state across
+	// components calling the same nodes.
+	RPC *RPCClient
 }
 
 // BitcoinAdapter provides Bitcoin blockchain integration (stub implementation).
@@ -30,8 +34,12 @@ func NewBitcoinAdapter(cfg BitcoinConfig, logger *slog.Logger) *BitcoinAdapter {
 	if threshold <= 0 {
 		threshold = 6
 	}
+	rpc := cfg.RPC
+	if rpc == nil {
+		rpc = NewRPCClient(ChainBTC, rpcProbeFor(ChainBTC, AdapterConfigs{Bitcoin: cfg}), RPCPolicy{}, logger, cfg.RPCURL, cfg.RPCURLFallback)
+	}
 	return &BitcoinAdapter{
-		BaseAdapter: newBaseAdapter(ChainBTC, threshold, logger),
+		BaseAdapter: newBaseAdapter(ChainBTC, threshold, rpc, logger),
 		config:      cfg,
 	}
 }
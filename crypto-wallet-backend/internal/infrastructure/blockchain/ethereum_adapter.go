@@ -12,9 +12,13 @@ import (
 // EthereumConfig captures configuration for the Ethereum JSON-RPC client.
 type EthereumConfig struct {
 	RPCURL                string
+	RPCURLFallback        string
 	Network               string
 	ChainID               int64
 	ConfirmationThreshold int
+	// RPC overrides the client built from the endpoints, e.g. to share breaker state across
+	// components calling the same nodes.
+	RPC *RPCClient
 }
 
 // EthereumAdapter provides Ethereum blockchain integration (stub implementation).
@@ -29,8 +33,12 @@ func NewEthereumAdapter(cfg EthereumConfig, logger *slog.Logger) *EthereumAdapte
 	if threshold <= 0 {
 		threshold = 12
 	}
+	rpc := cfg.RPC
+	if rpc == nil {
+		rpc = NewRPCClient(ChainETH, rpcProbeFor(ChainETH, AdapterConfigs{Ethereum: cfg}), RPCPolicy{}, logger, cfg.RPCURL, cfg.RPCURLFallback)
+	}
 	return &EthereumAdapter{
-		BaseAdapter: newBaseAdapter(ChainETH, threshold, logger),
+		BaseAdapter: newBaseAdapter(ChainETH, threshold, rpc, logger),
 		config:      cfg,
 	}
 }
@@ -192,6 +200,6 @@ func (e *EthereumAdapter) GetNetworkInfo(ctx context.Context) (*NetworkInfo, err
 		CurrentBlockNumber: uint64(time.Now().Unix()),
 		AverageBlockTime:   15 * time.Second,
 		PeerCount:          12,
-		IsHealthy:          true,
+		IsHealthy:          !e.rpc.Unavailable(),
 	}, nil
 }
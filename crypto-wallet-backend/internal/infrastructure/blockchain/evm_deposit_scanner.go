@@ -0,0 +1,243 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/infrastructure/tracing"
+)
+
+const defaultDepositScanTimeout = 15 * time.Second
+
+// IncomingTransfer is a successful native-asset transfer to a watched address. Amount is in whole
+// units of the chain's asset (ETH, not wei).
+type IncomingTransfer struct {
+	Hash        string
+	FromAddress string
+	ToAddress   string
+	Amount      decimal.Decimal
+	BlockNumber uint64
+}
+
+// DepositScanner reads inbound transfers block by block. The generic adapters cannot list the
+// transfers of a block, so deposit detection is served by dedicated node or explorer clients.
+type DepositScanner interface {
+	GetChain() Chain
+	// GetConfirmationThreshold is the number of confirmations after which a block is final enough
+	// to credit its deposits.
+	GetConfirmationThreshold() int
+	LatestBlock(ctx context.Context) (uint64, error)
+	// IncomingTransfers returns the block's successful transfers whose recipient watched accepts.
+	IncomingTransfers(ctx context.Context, block uint64, watched func(address string) bool) ([]IncomingTransfer, error)
+}
+
+// EVMDepositScannerConfig configures the JSON-RPC deposit scanner.
+type EVMDepositScannerConfig struct {
+	Chain                 Chain
+	RPCURL                string
+	ConfirmationThreshold int
+	Timeout               time.Duration
+	HTTPClient            *http.Client
+	// RPC, when set, replaces RPCURL so the scanner fails over with the chain's adapter.
+	RPC *RPCClient
+}
+
+// EVMDepositScanner finds native transfers to watched addresses on an EVM JSON-RPC node. Token
+// transfers are not detected.
+type EVMDepositScanner struct {
+	chain      Chain
+	rpc        *RPCClient
+	threshold  int
+	httpClient *http.Client
+	logger     *slog.Logger
+}
+
+// NewEVMDepositScanner constructs a deposit scanner for the configured node.
+func NewEVMDepositScanner(cfg EVMDepositScannerConfig, logger *slog.Logger) (*EVMDepositScanner, error) {
+	if cfg.RPC == nil && strings.TrimSpace(cfg.RPCURL) == "" {
+		return nil, errors.New("evm deposit scanner: rpc url is required")
+	}
+	if cfg.Chain == "" {
+		return nil, errors.New("evm deposit scanner: chain is required")
+	}
+	if cfg.ConfirmationThreshold <= 0 {
+		cfg.ConfirmationThreshold = 1
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		timeout := cfg.Timeout
+		if timeout <= 0 {
+			timeout = defaultDepositScanTimeout
+		}
+		httpClient = &http.Client{Timeout: timeout, Transport: tracing.NewTransport(nil)}
+	}
+
+	rpc := cfg.RPC
+	if rpc == nil {
+		rpc = NewRPCClient(cfg.Chain, nil, RPCPolicy{}, logger, cfg.RPCURL)
+	}
+
+	return &EVMDepositScanner{
+		chain:      cfg.Chain,
+		rpc:        rpc,
+		threshold:  cfg.ConfirmationThreshold,
+		httpClient: httpClient,
+		logger:     logger,
+	}, nil
+}
+
+// GetChain returns the chain the scanner reads from.
+func (s *EVMDepositScanner) GetChain() Chain {
+	return s.chain
+}
+
+// GetConfirmationThreshold returns the confirmations required before deposits are credited.
+func (s *EVMDepositScanner) GetConfirmationThreshold() int {
+	return s.threshold
+}
+
+// LatestBlock returns the node's current block number.
+func (s *EVMDepositScanner) LatestBlock(ctx context.Context) (uint64, error) {
+	var result string
+	if err := s.call(ctx, "eth_blockNumber", []any{}, &result); err != nil {
+		return 0, err
+	}
+	number, err := parseHexUint(result)
+	if err != nil {
+		return 0, fmt.Errorf("evm deposit scanner: block number: %w", err)
+	}
+	return number, nil
+}
+
+type evmBlock struct {
+	Transactions []struct {
+		Hash  string  `json:"hash"`
+		From  string  `json:"from"`
+		To    *string `json:"to"`
+		Value string  `json:"value"`
+	} `json:"transactions"`
+}
+
+type evmReceipt struct {
+	Status string `json:"status"`
+}
+
+// IncomingTransfers returns the block's value transfers to watched addresses. Only matching
+// transactions have their receipt fetched, to drop the ones that reverted.
+func (s *EVMDepositScanner) IncomingTransfers(ctx context.Context, block uint64, watched func(address string) bool) ([]IncomingTransfer, error) {
+	var result *evmBlock
+	if err := s.call(ctx, "eth_getBlockByNumber", []any{fmt.Sprintf("0x%x", block), true}, &result); err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, fmt.Errorf("evm deposit scanner: block %d not found", block)
+	}
+
+	var transfers []IncomingTransfer
+	for _, tx := range result.Transactions {
+		// Contract creations have no recipient.
+		if tx.To == nil || !watched(*tx.To) {
+			continue
+		}
+		wei, err := parseHexWei(tx.Value)
+		if err != nil {
+			return nil, fmt.Errorf("evm deposit scanner: value of %s: %w", tx.Hash, err)
+		}
+		if !wei.IsPositive() {
+			continue
+		}
+
+		var receipt *evmReceipt
+		if err := s.call(ctx, "eth_getTransactionReceipt", []any{tx.Hash}, &receipt); err != nil {
+			return nil, err
+		}
+		if receipt == nil {
+			return nil, fmt.Errorf("evm deposit scanner: receipt of %s not found", tx.Hash)
+		}
+		if status, err := parseHexUint(receipt.Status); err != nil || status != 1 {
+			s.logger.Debug("skipping reverted transfer", slog.String("chain", string(s.chain)), slog.String("hash", tx.Hash))
+			continue
+		}
+
+		transfers = append(transfers, IncomingTransfer{
+			Hash:        tx.Hash,
+			FromAddress: tx.From,
+			ToAddress:   *tx.To,
+			Amount:      wei.Shift(-18),
+			BlockNumber: block,
+		})
+	}
+	return transfers, nil
+}
+
+type rpcResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+}
+
+func (s *EVMDepositScanner) call(ctx context.Context, method string, params []any, out any) error {
+	body, err := json.Marshal(rpcRequest{JSONRPC: "2.0", ID: 1, Method: method, Params: params})
+	if err != nil {
+		return fmt.Errorf("evm deposit scanner: encode %s: %w", method, err)
+	}
+
+	return s.rpc.Call(ctx, func(ctx context.Context, endpoint string) error {
+		return s.callEndpoint(ctx, endpoint, method, body, out)
+	})
+}
+
+func (s *EVMDepositScanner) callEndpoint(ctx context.Context, endpoint, method string, body []byte, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("evm deposit scanner: build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("evm deposit scanner: call node: %w", err)
+	}
+	defer resp.Body.Close()
+
+	payload, err := io.ReadAll(io.LimitReader(resp.Body, 32<<20))
+	if err != nil {
+		return fmt.Errorf("evm deposit scanner: read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return &BlockchainError{
+			Code:    "RPC_HTTP_ERROR",
+			Message: fmt.Sprintf("node returned status %d", resp.StatusCode),
+			Details: map[string]any{"chain": string(s.chain), "method": method},
+		}
+	}
+
+	var decoded rpcResponse
+	if err := json.Unmarshal(payload, &decoded); err != nil {
+		return fmt.Errorf("evm deposit scanner: decode %s: %w", method, err)
+	}
+	if decoded.Error != nil {
+		return &BlockchainError{
+			Code:    "RPC_ERROR",
+			Message: decoded.Error.Message,
+			Details: map[string]any{"chain": string(s.chain), "method": method, "rpc_code": decoded.Error.Code},
+		}
+	}
+	if err := json.Unmarshal(decoded.Result, out); err != nil {
+		return fmt.Errorf("evm deposit scanner: decode %s result: %w", method, err)
+	}
+	return nil
+}
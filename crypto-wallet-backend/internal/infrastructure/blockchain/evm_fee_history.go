@@ -0,0 +1,258 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/infrastructure/tracing"
+)
+
+const (
+	defaultFeeHistoryTimeout = 10 * time.Second
+	maxFeeHistoryBlocks      = 1024
+)
+
+// FeeHistoryBlock is the fee market of one EVM block. PriorityFeesWei holds the priority fee paid at
+// each requested percentile of the block's transactions, weighted by gas used.
+type FeeHistoryBlock struct {
+	Number          uint64
+	BaseFeeWei      decimal.Decimal
+	GasUsedRatio    float64
+	PriorityFeesWei []decimal.Decimal
+}
+
+// FeeHistory is the result of eth_feeHistory: the requested blocks, oldest first, and the base fee
+// of the block after the newest one.
+type FeeHistory struct {
+	Chain          Chain
+	Blocks         []FeeHistoryBlock
+	NextBaseFeeWei decimal.Decimal
+}
+
+// FeeHistoryProvider reads recent EIP-1559 fee history from an EVM chain.
+type FeeHistoryProvider interface {
+	FeeHistory(ctx context.Context, blockCount int, percentiles []float64) (*FeeHistory, error)
+	GetChain() Chain
+}
+
+// EVMFeeHistoryConfig configures the JSON-RPC fee history client.
+type EVMFeeHistoryConfig struct {
+	Chain      Chain
+	RPCURL     string
+	Timeout    time.Duration
+	HTTPClient *http.Client
+	// RPC, when set, replaces RPCURL so the client fails over with the chain's adapter.
+	RPC *RPCClient
+}
+
+// EVMFeeHistoryClient calls eth_feeHistory on an EVM JSON-RPC node.
+type EVMFeeHistoryClient struct {
+	chain      Chain
+	rpc        *RPCClient
+	httpClient *http.Client
+	logger     *slog.Logger
+}
+
+// NewEVMFeeHistoryClient constructs a fee history client for the configured node.
+func NewEVMFeeHistoryClient(cfg EVMFeeHistoryConfig, logger *slog.Logger) (*EVMFeeHistoryClient, error) {
+	if cfg.RPC == nil && strings.TrimSpace(cfg.RPCURL) == "" {
+		return nil, errors.New("evm fee history: rpc url is required")
+	}
+	if cfg.Chain == "" {
+		return nil, errors.New("evm fee history: chain is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		timeout := cfg.Timeout
+		if timeout <= 0 {
+			timeout = defaultFeeHistoryTimeout
+		}
+		httpClient = &http.Client{Timeout: timeout, Transport: tracing.NewTransport(nil)}
+	}
+
+	rpc := cfg.RPC
+	if rpc == nil {
+		rpc = NewRPCClient(cfg.Chain, nil, RPCPolicy{}, logger, cfg.RPCURL)
+	}
+
+	return &EVMFeeHistoryClient{
+		chain:      cfg.Chain,
+		rpc:        rpc,
+		httpClient: httpClient,
+		logger:     logger,
+	}, nil
+}
+
+// GetChain returns the chain the client reads from.
+func (c *EVMFeeHistoryClient) GetChain() Chain {
+	return c.chain
+}
+
+type rpcRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      int    `json:"id"`
+	Method  string `json:"method"`
+	Params  []any  `json:"params"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type feeHistoryResponse struct {
+	Result *struct {
+		OldestBlock   string     `json:"oldestBlock"`
+		BaseFeePerGas []string   `json:"baseFeePerGas"`
+		GasUsedRatio  []float64  `json:"gasUsedRatio"`
+		Reward        [][]string `json:"reward"`
+	} `json:"result"`
+	Error *rpcError `json:"error"`
+}
+
+// FeeHistory returns the fee history of the latest blockCount blocks with priority fees at the
+// given percentiles, which must be ascending values between 0 and 100.
+func (c *EVMFeeHistoryClient) FeeHistory(ctx context.Context, blockCount int, percentiles []float64) (*FeeHistory, error) {
+	if blockCount <= 0 || blockCount > maxFeeHistoryBlocks {
+		return nil, fmt.Errorf("evm fee history: block count must be between 1 and %d", maxFeeHistoryBlocks)
+	}
+
+	body, err := json.Marshal(rpcRequest{
+		JSONRPC: "2.0",
+		ID:      1,
+		Method:  "eth_feeHistory",
+		Params:  []any{fmt.Sprintf("0x%x", blockCount), "latest", percentiles},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("evm fee history: encode request: %w", err)
+	}
+
+	var decoded feeHistoryResponse
+	err = c.rpc.Call(ctx, func(ctx context.Context, endpoint string) error {
+		var fetchErr error
+		decoded, fetchErr = c.fetch(ctx, endpoint, body)
+		return fetchErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := decoded.Result
+	oldest, err := parseHexUint(result.OldestBlock)
+	if err != nil {
+		return nil, fmt.Errorf("evm fee history: oldest block: %w", err)
+	}
+	// baseFeePerGas carries one extra entry: the base fee of the next block.
+	if len(result.BaseFeePerGas) != len(result.GasUsedRatio)+1 {
+		return nil, errors.New("evm fee history: base fee and gas used lengths disagree")
+	}
+
+	history := &FeeHistory{
+		Chain:  c.chain,
+		Blocks: make([]FeeHistoryBlock, 0, len(result.GasUsedRatio)),
+	}
+	for i, ratio := range result.GasUsedRatio {
+		baseFee, err := parseHexWei(result.BaseFeePerGas[i])
+		if err != nil {
+			return nil, fmt.Errorf("evm fee history: base fee: %w", err)
+		}
+		block := FeeHistoryBlock{
+			Number:          oldest + uint64(i),
+			BaseFeeWei:      baseFee,
+			GasUsedRatio:    ratio,
+			PriorityFeesWei: make([]decimal.Decimal, len(percentiles)),
+		}
+		if i < len(result.Reward) {
+			for j := range percentiles {
+				if j >= len(result.Reward[i]) {
+					break
+				}
+				fee, err := parseHexWei(result.Reward[i][j])
+				if err != nil {
+					return nil, fmt.Errorf("evm fee history: reward: %w", err)
+				}
+				block.PriorityFeesWei[j] = fee
+			}
+		}
+		history.Blocks = append(history.Blocks, block)
+	}
+
+	history.NextBaseFeeWei, err = parseHexWei(result.BaseFeePerGas[len(result.BaseFeePerGas)-1])
+	if err != nil {
+		return nil, fmt.Errorf("evm fee history: next base fee: %w", err)
+	}
+	return history, nil
+}
+
+func (c *EVMFeeHistoryClient) fetch(ctx context.Context, endpoint string, body []byte) (feeHistoryResponse, error) {
+	var decoded feeHistoryResponse
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return decoded, fmt.Errorf("evm fee history: build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return decoded, fmt.Errorf("evm fee history: call node: %w", err)
+	}
+	defer resp.Body.Close()
+
+	payload, err := io.ReadAll(io.LimitReader(resp.Body, 4<<20))
+	if err != nil {
+		return decoded, fmt.Errorf("evm fee history: read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return decoded, &BlockchainError{
+			Code:    "RPC_HTTP_ERROR",
+			Message: fmt.Sprintf("node returned status %d", resp.StatusCode),
+			Details: map[string]any{"chain": string(c.chain)},
+		}
+	}
+
+	if err := json.Unmarshal(payload, &decoded); err != nil {
+		return decoded, fmt.Errorf("evm fee history: decode response: %w", err)
+	}
+	if decoded.Error != nil {
+		return decoded, &BlockchainError{
+			Code:    "RPC_ERROR",
+			Message: decoded.Error.Message,
+			Details: map[string]any{"chain": string(c.chain), "rpc_code": decoded.Error.Code},
+		}
+	}
+	if decoded.Result == nil {
+		return decoded, errors.New("evm fee history: empty result")
+	}
+	return decoded, nil
+}
+
+func parseHexUint(raw string) (uint64, error) {
+	value, ok := new(big.Int).SetString(strings.TrimPrefix(strings.TrimSpace(raw), "0x"), 16)
+	if !ok || !value.IsUint64() {
+		return 0, fmt.Errorf("invalid quantity %q", raw)
+	}
+	return value.Uint64(), nil
+}
+
+func parseHexWei(raw string) (decimal.Decimal, error) {
+	value, ok := new(big.Int).SetString(strings.TrimPrefix(strings.TrimSpace(raw), "0x"), 16)
+	if !ok || value.Sign() < 0 {
+		return decimal.Zero, fmt.Errorf("invalid quantity %q", raw)
+	}
+	return decimal.NewFromBigInt(value, 0), nil
+}
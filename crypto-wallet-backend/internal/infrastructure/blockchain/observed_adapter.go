@@ -0,0 +1,112 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+)
+
+// AdapterObserver receives the outcome of every adapter call, e.g. to track error rates per chain.
+type AdapterObserver interface {
+	ObserveAdapterCall(adapter, operation string, failed bool)
+}
+
+// observedAdapter reports call outcomes of the wrapped adapter to an observer.
+type observedAdapter struct {
+	BlockchainAdapter
+	name     string
+	observer AdapterObserver
+}
+
+// NewObservedAdapter wraps adapter so each call is reported to observer. Caller errors such as
+// invalid addresses or insufficient funds are not counted as failures.
+func NewObservedAdapter(adapter BlockchainAdapter, observer AdapterObserver) BlockchainAdapter {
+	if adapter == nil || observer == nil {
+		return adapter
+	}
+	return &observedAdapter{
+		BlockchainAdapter: adapter,
+		name:              string(adapter.GetChain()),
+		observer:          observer,
+	}
+}
+
+func (a *observedAdapter) observe(operation string, err error) {
+	failed := err != nil &&
+		!errors.Is(err, ErrInvalidAddress) &&
+		!errors.Is(err, ErrInsufficientFunds) &&
+		!errors.Is(err, ErrNotImplemented) &&
+		!errors.Is(err, context.Canceled)
+	a.observer.ObserveAdapterCall(a.name, operation, failed)
+}
+
+func (a *observedAdapter) GenerateWallet(ctx context.Context) (*Wallet, error) {
+	wallet, err := a.BlockchainAdapter.GenerateWallet(ctx)
+	a.observe("generate_wallet", err)
+	return wallet, err
+}
+
+func (a *observedAdapter) ImportWallet(ctx context.Context, privateKey string) (*Wallet, error) {
+	wallet, err := a.BlockchainAdapter.ImportWallet(ctx, privateKey)
+	a.observe("import_wallet", err)
+	return wallet, err
+}
+
+func (a *observedAdapter) ValidateAddress(ctx context.Context, address string) (bool, error) {
+	valid, err := a.BlockchainAdapter.ValidateAddress(ctx, address)
+	a.observe("validate_address", err)
+	return valid, err
+}
+
+func (a *observedAdapter) GetBalance(ctx context.Context, address string) (*Balance, error) {
+	balance, err := a.BlockchainAdapter.GetBalance(ctx, address)
+	a.observe("get_balance", err)
+	return balance, err
+}
+
+func (a *observedAdapter) EstimateFee(ctx context.Context, req *FeeEstimateRequest) (*FeeEstimate, error) {
+	estimate, err := a.BlockchainAdapter.EstimateFee(ctx, req)
+	a.observe("estimate_fee", err)
+	return estimate, err
+}
+
+func (a *observedAdapter) CreateTransaction(ctx context.Context, req *TransactionRequest) (*UnsignedTransaction, error) {
+	tx, err := a.BlockchainAdapter.CreateTransaction(ctx, req)
+	a.observe("create_transaction", err)
+	return tx, err
+}
+
+func (a *observedAdapter) SignTransaction(ctx context.Context, tx *UnsignedTransaction, privateKey string) (*SignedTransaction, error) {
+	signed, err := a.BlockchainAdapter.SignTransaction(ctx, tx, privateKey)
+	a.observe("sign_transaction", err)
+	return signed, err
+}
+
+func (a *observedAdapter) BroadcastTransaction(ctx context.Context, tx *SignedTransaction) (string, error) {
+	hash, err := a.BlockchainAdapter.BroadcastTransaction(ctx, tx)
+	a.observe("broadcast_transaction", err)
+	return hash, err
+}
+
+func (a *observedAdapter) GetTransaction(ctx context.Context, txHash string) (*Transaction, error) {
+	tx, err := a.BlockchainAdapter.GetTransaction(ctx, txHash)
+	a.observe("get_transaction", err)
+	return tx, err
+}
+
+func (a *observedAdapter) GetTransactionStatus(ctx context.Context, txHash string) (*TransactionStatus, error) {
+	status, err := a.BlockchainAdapter.GetTransactionStatus(ctx, txHash)
+	a.observe("get_transaction_status", err)
+	return status, err
+}
+
+func (a *observedAdapter) GetBlockNumber(ctx context.Context) (uint64, error) {
+	number, err := a.BlockchainAdapter.GetBlockNumber(ctx)
+	a.observe("get_block_number", err)
+	return number, err
+}
+
+func (a *observedAdapter) GetNetworkInfo(ctx context.Context) (*NetworkInfo, error) {
+	info, err := a.BlockchainAdapter.GetNetworkInfo(ctx)
+	a.observe("get_network_info", err)
+	return info, err
+}
@@ -0,0 +1,317 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// ErrChainDisabled is returned by registry adapters of chains that staff disabled.
+var ErrChainDisabled = errors.New("blockchain: chain is disabled")
+
+// Default chain IDs used when a managed Ethereum chain switches network.
+const (
+	ethereumMainnetChainID int64 = 1
+	ethereumTestnetChainID int64 = 11155111
+)
+
+// AdapterConfigs holds the settings of every chain adapter.
+type AdapterConfigs struct {
+	Bitcoin  BitcoinConfig
+	Ethereum EthereumConfig
+	Solana   SolanaConfig
+	Stellar  StellarConfig
+}
+
+// WithChainConfig overlays a managed registry entry on the settings of its chain. Credentials and
+// settings the registry does not hold, such as the Bitcoin RPC user, are kept.
+func (c AdapterConfigs) WithChainConfig(config entities.ChainConfig) AdapterConfigs {
+	if config == nil || !config.IsManaged() {
+		return c
+	}
+
+	network := string(config.GetNetworkType())
+	switch config.GetSymbol() {
+	case ChainBTC:
+		c.Bitcoin.RPCURL = config.GetRPCURL()
+		c.Bitcoin.RPCURLFallback = config.GetRPCFallbackURL()
+		c.Bitcoin.Network = network
+		c.Bitcoin.ConfirmationThreshold = config.GetConfirmationThreshold()
+	case ChainETH:
+		if c.Ethereum.Network != network {
+			c.Ethereum.ChainID = ethereumMainnetChainID
+			if config.GetNetworkType() == entities.ChainNetworkTestnet {
+				c.Ethereum.ChainID = ethereumTestnetChainID
+			}
+		}
+		c.Ethereum.RPCURL = config.GetRPCURL()
+		c.Ethereum.RPCURLFallback = config.GetRPCFallbackURL()
+		c.Ethereum.Network = network
+		c.Ethereum.ConfirmationThreshold = config.GetConfirmationThreshold()
+	case ChainSOL:
+		c.Solana.RPCURL = config.GetRPCURL()
+		c.Solana.RPCURLFallback = config.GetRPCFallbackURL()
+		c.Solana.Network = network
+		c.Solana.ConfirmationThreshold = config.GetConfirmationThreshold()
+	case ChainXLM:
+		c.Stellar.HorizonURL = config.GetRPCURL()
+		c.Stellar.HorizonURLFallback = config.GetRPCFallbackURL()
+		c.Stellar.Network = "public"
+		if config.GetNetworkType() == entities.ChainNetworkTestnet {
+			c.Stellar.Network = "testnet"
+		}
+		c.Stellar.ConfirmationThreshold = config.GetConfirmationThreshold()
+	}
+	return c
+}
+
+// NewAdapter constructs the adapter of a supported chain, or nil for any other chain.
+func NewAdapter(chain Chain, configs AdapterConfigs, logger *slog.Logger) BlockchainAdapter {
+	switch chain {
+	case ChainBTC:
+		return NewBitcoinAdapter(configs.Bitcoin, logger)
+	case ChainETH:
+		return NewEthereumAdapter(configs.Ethereum, logger)
+	case ChainSOL:
+		return NewSolanaAdapter(configs.Solana, logger)
+	case ChainXLM:
+		return NewStellarAdapter(configs.Stellar, logger)
+	default:
+		return nil
+	}
+}
+
+// Registry owns one adapter per supported chain and rebuilds them when the chain registry
+// changes. The adapters and RPC clients it hands out stay valid across reloads, so components
+// built once at startup pick up new endpoints and networks without being rebuilt.
+type Registry struct {
+	base     AdapterConfigs
+	wrap     func(BlockchainAdapter) BlockchainAdapter
+	logger   *slog.Logger
+	mu       sync.Mutex
+	adapters map[Chain]*registryAdapter
+	clients  map[Chain]*RPCClient
+	applied  map[Chain]AdapterConfigs
+}
+
+// NewRegistry builds the adapters from the base settings; wrap, which may be nil, decorates
+// every adapter the registry builds, e.g. with metrics or tracing. Each chain's node calls fail
+// over between its endpoints under policy.
+func NewRegistry(base AdapterConfigs, wrap func(BlockchainAdapter) BlockchainAdapter, policy RPCPolicy, logger *slog.Logger) *Registry {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if wrap == nil {
+		wrap = func(adapter BlockchainAdapter) BlockchainAdapter { return adapter }
+	}
+	r := &Registry{
+		base:     base,
+		wrap:     wrap,
+		logger:   logger,
+		adapters: make(map[Chain]*registryAdapter),
+		clients:  make(map[Chain]*RPCClient),
+		applied:  make(map[Chain]AdapterConfigs),
+	}
+	for _, chain := range entities.SupportedChains() {
+		r.clients[chain] = NewRPCClient(chain, rpcProbeFor(chain, base), policy, logger.With(slog.String("chain", string(chain))), rpcEndpointsFor(chain, base)...)
+		adapter := &registryAdapter{chain: chain}
+		adapter.slot.Store(&adapterSlot{adapter: r.build(chain, base)})
+		r.adapters[chain] = adapter
+		r.applied[chain] = base
+	}
+	return r
+}
+
+// Adapters returns the registry's adapter of every supported chain.
+func (r *Registry) Adapters() map[Chain]BlockchainAdapter {
+	adapters := make(map[Chain]BlockchainAdapter, len(r.adapters))
+	for chain, adapter := range r.adapters {
+		adapters[chain] = adapter
+	}
+	return adapters
+}
+
+// RPCClient returns the chain's RPC client, or nil for an unsupported chain. Components that call
+// the chain's nodes directly share it to fail over with the adapter.
+func (r *Registry) RPCClient(chain Chain) *RPCClient {
+	return r.clients[chain]
+}
+
+// ProbeRPC health-checks the endpoints of every chain, closing the circuits of endpoints that
+// answer again before their cooldown elapses.
+func (r *Registry) ProbeRPC(ctx context.Context) {
+	for _, chain := range entities.SupportedChains() {
+		r.clients[chain].Probe(ctx)
+	}
+}
+
+// ApplyChainConfigs reconfigures the adapters from the chain registry. Managed chains take their
+// endpoint, network and confirmation threshold from their entry; chains without a managed entry
+// fall back to the base settings. Inactive chains reject every call with ErrChainDisabled.
+func (r *Registry) ApplyChainConfigs(configs []entities.ChainConfig) {
+	byChain := make(map[Chain]entities.ChainConfig, len(configs))
+	for _, config := range configs {
+		if config != nil {
+			byChain[config.GetSymbol()] = config
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for chain, adapter := range r.adapters {
+		config := byChain[chain]
+		settings := r.base.WithChainConfig(config)
+		disabled := config != nil && !config.IsActive()
+
+		current := adapter.slot.Load()
+		next := &adapterSlot{adapter: current.adapter, disabled: disabled}
+		if settings != r.applied[chain] {
+			r.clients[chain].SetEndpoints(rpcEndpointsFor(chain, settings)...)
+			next.adapter = r.build(chain, settings)
+			r.applied[chain] = settings
+			r.logger.Info("chain adapter reconfigured", slog.String("chain", string(chain)))
+		}
+		if disabled != current.disabled {
+			r.logger.Info("chain availability changed", slog.String("chain", string(chain)), slog.Bool("disabled", disabled))
+		}
+		adapter.slot.Store(next)
+	}
+}
+
+func (r *Registry) build(chain Chain, settings AdapterConfigs) BlockchainAdapter {
+	client := r.clients[chain]
+	settings.Bitcoin.RPC = client
+	settings.Ethereum.RPC = client
+	settings.Solana.RPC = client
+	settings.Stellar.RPC = client
+	return r.wrap(NewAdapter(chain, settings, r.logger.With(slog.String("chain", string(chain)))))
+}
+
+// adapterSlot is the adapter a registryAdapter currently delegates to.
+type adapterSlot struct {
+	adapter  BlockchainAdapter
+	disabled bool
+}
+
+// registryAdapter delegates to the chain's current adapter.
+type registryAdapter struct {
+	chain Chain
+	slot  atomic.Pointer[adapterSlot]
+}
+
+func (a *registryAdapter) current() (BlockchainAdapter, error) {
+	slot := a.slot.Load()
+	if slot.disabled {
+		return nil, ErrChainDisabled
+	}
+	return slot.adapter, nil
+}
+
+func (a *registryAdapter) GenerateWallet(ctx context.Context) (*Wallet, error) {
+	adapter, err := a.current()
+	if err != nil {
+		return nil, err
+	}
+	return adapter.GenerateWallet(ctx)
+}
+
+func (a *registryAdapter) ImportWallet(ctx context.Context, privateKey string) (*Wallet, error) {
+	adapter, err := a.current()
+	if err != nil {
+		return nil, err
+	}
+	return adapter.ImportWallet(ctx, privateKey)
+}
+
+func (a *registryAdapter) ValidateAddress(ctx context.Context, address string) (bool, error) {
+	adapter, err := a.current()
+	if err != nil {
+		return false, err
+	}
+	return adapter.ValidateAddress(ctx, address)
+}
+
+func (a *registryAdapter) GetBalance(ctx context.Context, address string) (*Balance, error) {
+	adapter, err := a.current()
+	if err != nil {
+		return nil, err
+	}
+	return adapter.GetBalance(ctx, address)
+}
+
+func (a *registryAdapter) EstimateFee(ctx context.Context, req *FeeEstimateRequest) (*FeeEstimate, error) {
+	adapter, err := a.current()
+	if err != nil {
+		return nil, err
+	}
+	return adapter.EstimateFee(ctx, req)
+}
+
+func (a *registryAdapter) CreateTransaction(ctx context.Context, req *TransactionRequest) (*UnsignedTransaction, error) {
+	adapter, err := a.current()
+	if err != nil {
+		return nil, err
+	}
+	return adapter.CreateTransaction(ctx, req)
+}
+
+func (a *registryAdapter) SignTransaction(ctx context.Context, tx *UnsignedTransaction, privateKey string) (*SignedTransaction, error) {
+	adapter, err := a.current()
+	if err != nil {
+		return nil, err
+	}
+	return adapter.SignTransaction(ctx, tx, privateKey)
+}
+
+func (a *registryAdapter) BroadcastTransaction(ctx context.Context, tx *SignedTransaction) (string, error) {
+	adapter, err := a.current()
+	if err != nil {
+		return "", err
+	}
+	return adapter.BroadcastTransaction(ctx, tx)
+}
+
+func (a *registryAdapter) GetTransaction(ctx context.Context, txHash string) (*Transaction, error) {
+	adapter, err := a.current()
+	if err != nil {
+		return nil, err
+	}
+	return adapter.GetTransaction(ctx, txHash)
+}
+
+func (a *registryAdapter) GetTransactionStatus(ctx context.Context, txHash string) (*TransactionStatus, error) {
+	adapter, err := a.current()
+	if err != nil {
+		return nil, err
+	}
+	return adapter.GetTransactionStatus(ctx, txHash)
+}
+
+func (a *registryAdapter) GetBlockNumber(ctx context.Context) (uint64, error) {
+	adapter, err := a.current()
+	if err != nil {
+		return 0, err
+	}
+	return adapter.GetBlockNumber(ctx)
+}
+
+func (a *registryAdapter) GetNetworkInfo(ctx context.Context) (*NetworkInfo, error) {
+	adapter, err := a.current()
+	if err != nil {
+		return nil, err
+	}
+	return adapter.GetNetworkInfo(ctx)
+}
+
+func (a *registryAdapter) GetChain() Chain {
+	return a.chain
+}
+
+func (a *registryAdapter) GetConfirmationThreshold() int {
+	return a.slot.Load().adapter.GetConfirmationThreshold()
+}
@@ -0,0 +1,390 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/infrastructure/monitoring"
+	"github.com/crypto-wallet/backend/internal/infrastructure/tracing"
+)
+
+// ErrRPCUnavailable is returned when a chain has no endpoint that can take calls.
+var ErrRPCUnavailable = errors.New("blockchain: no rpc endpoint available")
+
+// RPC circuit breaker defaults.
+const (
+	DefaultRPCFailureThreshold = 5
+	DefaultRPCCooldown         = 30 * time.Second
+	defaultRPCProbeTimeout     = 5 * time.Second
+)
+
+// RPCObserver receives per-endpoint call outcomes and circuit breaker transitions.
+type RPCObserver interface {
+	ObserveRPCCall(endpoint string, failed bool)
+	RecordCircuitState(name string, from, to monitoring.CircuitState)
+}
+
+// RPCPolicy configures failover and circuit breaking of an RPCClient.
+type RPCPolicy struct {
+	// FailureThreshold is how many consecutive failures open an endpoint's circuit.
+	FailureThreshold int
+	// Cooldown is how long an open circuit rejects calls before a trial call is let through.
+	Cooldown time.Duration
+	// Observer is optional.
+	Observer RPCObserver
+}
+
+func (p RPCPolicy) normalize() RPCPolicy {
+	if p.FailureThreshold <= 0 {
+		p.FailureThreshold = DefaultRPCFailureThreshold
+	}
+	if p.Cooldown <= 0 {
+		p.Cooldown = DefaultRPCCooldown
+	}
+	return p
+}
+
+// RPCProbe checks that an endpoint answers.
+type RPCProbe func(ctx context.Context, endpoint string) error
+
+// rpcEndpoint is one node URL and the state of its circuit breaker.
+type rpcEndpoint struct {
+	url      string
+	name     string
+	state    monitoring.CircuitState
+	failures int
+	openedAt time.Time
+	// trial is set while the single call a half-open circuit lets through is in flight.
+	trial bool
+}
+
+// RPCClient spreads a chain's node calls over its primary and fallback endpoints. Endpoints are
+// tried in order; one whose circuit opened after consecutive failures is skipped until its
+// cooldown elapses or a health probe finds it answering again. It is safe for concurrent use.
+type RPCClient struct {
+	chain     Chain
+	policy    RPCPolicy
+	probe     RPCProbe
+	logger    *slog.Logger
+	now       func() time.Time
+	mu        sync.Mutex
+	endpoints []*rpcEndpoint
+}
+
+// NewRPCClient constructs a client for the endpoints, primary first. Blank URLs are ignored and
+// probe may be nil, in which case only calls close an open circuit.
+func NewRPCClient(chain Chain, probe RPCProbe, policy RPCPolicy, logger *slog.Logger, urls ...string) *RPCClient {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	c := &RPCClient{
+		chain:  chain,
+		policy: policy.normalize(),
+		probe:  probe,
+		logger: logger,
+		now:    time.Now,
+	}
+	c.SetEndpoints(urls...)
+	return c
+}
+
+// SetEndpoints replaces the endpoints, primary first. Endpoints that stay keep their breaker state.
+func (c *RPCClient) SetEndpoints(urls ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	existing := make(map[string]*rpcEndpoint, len(c.endpoints))
+	for _, endpoint := range c.endpoints {
+		existing[endpoint.url] = endpoint
+	}
+
+	endpoints := make([]*rpcEndpoint, 0, len(urls))
+	seen := make(map[string]bool, len(urls))
+	for _, raw := range urls {
+		raw = strings.TrimSpace(raw)
+		if raw == "" || seen[raw] {
+			continue
+		}
+		seen[raw] = true
+		endpoint, ok := existing[raw]
+		if !ok {
+			endpoint = &rpcEndpoint{url: raw, name: rpcEndpointName(c.chain, raw), state: monitoring.CircuitClosed}
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	c.endpoints = endpoints
+}
+
+// Call runs fn against the first endpoint that can take calls and fails over to the next when the
+// endpoint fails. Errors the node reports about the request itself, and cancellation, are returned
+// without failing over.
+func (c *RPCClient) Call(ctx context.Context, fn func(ctx context.Context, endpoint string) error) error {
+	c.mu.Lock()
+	endpoints := append([]*rpcEndpoint(nil), c.endpoints...)
+	c.mu.Unlock()
+
+	var lastErr error
+	for _, endpoint := range endpoints {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !c.acquire(endpoint) {
+			continue
+		}
+
+		err := fn(ctx, endpoint.url)
+		failed := err != nil && isEndpointFailure(ctx, err)
+		c.release(endpoint, failed)
+		if c.policy.Observer != nil {
+			c.policy.Observer.ObserveRPCCall(endpoint.name, failed)
+		}
+		if !failed {
+			return err
+		}
+
+		lastErr = err
+		c.logger.Warn("rpc endpoint failed",
+			slog.String("chain", string(c.chain)),
+			slog.String("endpoint", endpoint.name),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	if lastErr != nil {
+		return lastErr
+	}
+	return fmt.Errorf("%w: %s", ErrRPCUnavailable, c.chain)
+}
+
+// Probe checks every endpoint that is not serving a trial call. An answering endpoint's circuit
+// closes; a failing one counts towards opening it, or stays open for another cooldown.
+func (c *RPCClient) Probe(ctx context.Context) {
+	if c.probe == nil {
+		return
+	}
+
+	c.mu.Lock()
+	endpoints := append([]*rpcEndpoint(nil), c.endpoints...)
+	c.mu.Unlock()
+
+	for _, endpoint := range endpoints {
+		if ctx.Err() != nil {
+			return
+		}
+		c.mu.Lock()
+		busy := endpoint.trial
+		c.mu.Unlock()
+		if busy {
+			continue
+		}
+
+		probeCtx, cancel := context.WithTimeout(ctx, defaultRPCProbeTimeout)
+		err := c.probe(probeCtx, endpoint.url)
+		cancel()
+		if err != nil && ctx.Err() != nil {
+			return
+		}
+
+		c.mu.Lock()
+		if err == nil {
+			c.transition(endpoint, monitoring.CircuitClosed)
+			endpoint.failures = 0
+		} else {
+			c.fail(endpoint)
+		}
+		c.mu.Unlock()
+	}
+}
+
+// Unavailable reports whether endpoints are configured and every one of them has an open circuit.
+func (c *RPCClient) Unavailable() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.endpoints) == 0 {
+		return false
+	}
+	for _, endpoint := range c.endpoints {
+		if endpoint.state == monitoring.CircuitClosed || c.now().Sub(endpoint.openedAt) >= c.policy.Cooldown {
+			return false
+		}
+	}
+	return true
+}
+
+// acquire reports whether the endpoint may take a call, moving an open circuit whose cooldown
+// elapsed to half-open for a single trial call.
+func (c *RPCClient) acquire(endpoint *rpcEndpoint) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch endpoint.state {
+	case monitoring.CircuitClosed:
+		return true
+	case monitoring.CircuitOpen:
+		if c.now().Sub(endpoint.openedAt) < c.policy.Cooldown {
+			return false
+		}
+		c.transition(endpoint, monitoring.CircuitHalfOpen)
+	}
+	if endpoint.trial {
+		return false
+	}
+	endpoint.trial = true
+	return true
+}
+
+func (c *RPCClient) release(endpoint *rpcEndpoint, failed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	endpoint.trial = false
+	if failed {
+		c.fail(endpoint)
+		return
+	}
+	endpoint.failures = 0
+	c.transition(endpoint, monitoring.CircuitClosed)
+}
+
+// fail counts a failure; the caller holds the lock.
+func (c *RPCClient) fail(endpoint *rpcEndpoint) {
+	endpoint.failures++
+	if endpoint.state == monitoring.CircuitClosed && endpoint.failures < c.policy.FailureThreshold {
+		return
+	}
+	endpoint.openedAt = c.now()
+	c.transition(endpoint, monitoring.CircuitOpen)
+}
+
+// transition moves the endpoint's circuit to state; the caller holds the lock.
+func (c *RPCClient) transition(endpoint *rpcEndpoint, state monitoring.CircuitState) {
+	from := endpoint.state
+	if from == state {
+		return
+	}
+	endpoint.state = state
+	c.logger.Info("rpc circuit changed",
+		slog.String("chain", string(c.chain)),
+		slog.String("endpoint", endpoint.name),
+		slog.String("from", string(from)),
+		slog.String("to", string(state)),
+	)
+	if c.policy.Observer != nil {
+		c.policy.Observer.RecordCircuitState(endpoint.name, from, state)
+	}
+}
+
+// isEndpointFailure tells endpoint faults, which fail over, from the caller's own errors and
+// errors the node returned for a request it did answer.
+func isEndpointFailure(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if errors.Is(err, ErrInvalidAddress) || errors.Is(err, ErrInsufficientFunds) || errors.Is(err, ErrNotImplemented) {
+		return false
+	}
+	var chainErr *BlockchainError
+	if errors.As(err, &chainErr) && chainErr.Code == "RPC_ERROR" {
+		return false
+	}
+	return true
+}
+
+// rpcEndpointName identifies an endpoint by chain and host, leaving out paths and credentials
+// that often carry API keys.
+func rpcEndpointName(chain Chain, raw string) string {
+	host := raw
+	if parsed, err := url.Parse(raw); err == nil && parsed.Host != "" {
+		host = parsed.Host
+	}
+	return string(chain) + " rpc " + host
+}
+
+var rpcProbeClient = &http.Client{Timeout: defaultRPCProbeTimeout, Transport: tracing.NewTransport(nil)}
+
+// jsonRPCProbe calls a cheap JSON-RPC method and expects a result. user and password are sent as
+// basic auth when set.
+func jsonRPCProbe(method, user, password string) RPCProbe {
+	return func(ctx context.Context, endpoint string) error {
+		body := fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":%q,"params":[]}`, method)
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if user != "" || password != "" {
+			req.SetBasicAuth(user, password)
+		}
+		return doRPCProbe(req)
+	}
+}
+
+// httpProbe expects the endpoint's root to answer, as Horizon does.
+func httpProbe() RPCProbe {
+	return func(ctx context.Context, endpoint string) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return err
+		}
+		return doRPCProbe(req)
+	}
+}
+
+func doRPCProbe(req *http.Request) error {
+	resp, err := rpcProbeClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	payload, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("probe returned status %d", resp.StatusCode)
+	}
+	if req.Method == http.MethodPost && bytes.Contains(payload, []byte(`"error":{`)) {
+		return errors.New("probe returned an rpc error")
+	}
+	return nil
+}
+
+// rpcProbeFor returns the health probe of a chain's endpoints.
+func rpcProbeFor(chain Chain, configs AdapterConfigs) RPCProbe {
+	switch chain {
+	case ChainBTC:
+		return jsonRPCProbe("getblockcount", configs.Bitcoin.RPCUser, configs.Bitcoin.RPCPassword)
+	case ChainETH:
+		return jsonRPCProbe("eth_blockNumber", "", "")
+	case ChainSOL:
+		return jsonRPCProbe("getHealth", "", "")
+	case ChainXLM:
+		return httpProbe()
+	default:
+		return nil
+	}
+}
+
+// rpcEndpointsFor returns a chain's endpoints, primary first.
+func rpcEndpointsFor(chain Chain, configs AdapterConfigs) []string {
+	switch chain {
+	case ChainBTC:
+		return []string{configs.Bitcoin.RPCURL, configs.Bitcoin.RPCURLFallback}
+	case ChainETH:
+		return []string{configs.Ethereum.RPCURL, configs.Ethereum.RPCURLFallback}
+	case ChainSOL:
+		return []string{configs.Solana.RPCURL, configs.Solana.RPCURLFallback}
+	case ChainXLM:
+		return []string{configs.Stellar.HorizonURL, configs.Stellar.HorizonURLFallback}
+	default:
+		return nil
+	}
+}
@@ -12,9 +12,13 @@ import (
 // SolanaConfig captures configuration for the Solana RPC client.
 type SolanaConfig struct {
 	RPCURL                string
+	RPCURLFallback        string
 	Network               string
 	ConfirmationThreshold int
 	Commitment            string
+	// RPC overrides the client built from the endpoints, e.g. to share breaker state across
+	// components calling the same nodes.
+	RPC *RPCClient
 }
 
 // SolanaAdapter provides Solana blockchain integration (stub implementation).
@@ -29,8 +33,12 @@ func NewSolanaAdapter(cfg SolanaConfig, logger *slog.Logger) *SolanaAdapter {
 	if threshold <= 0 {
 		threshold = 32
 	}
+	rpc := cfg.RPC
+	if rpc == nil {
+		rpc = NewRPCClient(ChainSOL, rpcProbeFor(ChainSOL, AdapterConfigs{Solana: cfg}), RPCPolicy{}, logger, cfg.RPCURL, cfg.RPCURLFallback)
+	}
 	return &SolanaAdapter{
-		BaseAdapter: newBaseAdapter(ChainSOL, threshold, logger),
+		BaseAdapter: newBaseAdapter(ChainSOL, threshold, rpc, logger),
 		config:      cfg,
 	}
 }
@@ -191,6 +199,6 @@ func (s *SolanaAdapter) GetNetworkInfo(ctx context.Context) (*NetworkInfo, error
 		CurrentBlockNumber: uint64(time.Now().Unix()),
 		AverageBlockTime:   400 * time.Millisecond,
 		PeerCount:          16,
-		IsHealthy:          true,
+		IsHealthy:          !s.rpc.Unavailable(),
 	}, nil
 }
@@ -12,8 +12,12 @@ import (
 // StellarConfig captures configuration for the Stellar Horizon client.
 type StellarConfig struct {
 	HorizonURL            string
+	HorizonURLFallback    string
 	Network               string
 	ConfirmationThreshold int
+	// RPC overrides the client built from the endpoints, e.g. to share breaker state across
+	// components calling the same nodes.
+	RPC *RPCClient
 }
 
 // StellarAdapter provides Stellar blockchain integration (stub implementation).
@@ -28,8 +32,12 @@ func NewStellarAdapter(cfg StellarConfig, logger *slog.Logger) *StellarAdapter {
 	if threshold <= 0 {
 		threshold = 1
 	}
+	rpc := cfg.RPC
+	if rpc == nil {
+		rpc = NewRPCClient(ChainXLM, rpcProbeFor(ChainXLM, AdapterConfigs{Stellar: cfg}), RPCPolicy{}, logger, cfg.HorizonURL, cfg.HorizonURLFallback)
+	}
 	return &StellarAdapter{
-		BaseAdapter: newBaseAdapter(ChainXLM, threshold, logger),
+		BaseAdapter: newBaseAdapter(ChainXLM, threshold, rpc, logger),
 		config:      cfg,
 	}
 }
@@ -190,6 +198,6 @@ func (s *StellarAdapter) GetNetworkInfo(ctx context.Context) (*NetworkInfo, erro
 		CurrentBlockNumber: uint64(time.Now().Unix()),
 		AverageBlockTime:   5 * time.Second,
 		PeerCount:          6,
-		IsHealthy:          true,
+		IsHealthy:          !s.rpc.Unavailable(),
 	}, nil
 }
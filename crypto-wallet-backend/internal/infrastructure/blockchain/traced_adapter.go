@@ -0,0 +1,119 @@
+package blockchain
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/crypto-wallet/backend/internal/infrastructure/tracing"
+)
+
+// tracedAdapter records a span around every call to the wrapped adapter.
+type tracedAdapter struct {
+	BlockchainAdapter
+	chain string
+}
+
+// NewTracedAdapter wraps adapter so each call appears as a span in the caller's trace. Arguments
+// are not recorded, so keys and addresses stay out of the trace backend.
+func NewTracedAdapter(adapter BlockchainAdapter) BlockchainAdapter {
+	if adapter == nil {
+		return adapter
+	}
+	return &tracedAdapter{
+		BlockchainAdapter: adapter,
+		chain:             string(adapter.GetChain()),
+	}
+}
+
+func (a *tracedAdapter) start(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "blockchain."+operation,
+		attribute.String("blockchain.chain", a.chain),
+		attribute.String("blockchain.operation", operation),
+	)
+}
+
+func (a *tracedAdapter) GenerateWallet(ctx context.Context) (*Wallet, error) {
+	ctx, span := a.start(ctx, "generate_wallet")
+	wallet, err := a.BlockchainAdapter.GenerateWallet(ctx)
+	tracing.End(span, err)
+	return wallet, err
+}
+
+func (a *tracedAdapter) ImportWallet(ctx context.Context, privateKey string) (*Wallet, error) {
+	ctx, span := a.start(ctx, "import_wallet")
+	wallet, err := a.BlockchainAdapter.ImportWallet(ctx, privateKey)
+	tracing.End(span, err)
+	return wallet, err
+}
+
+func (a *tracedAdapter) ValidateAddress(ctx context.Context, address string) (bool, error) {
+	ctx, span := a.start(ctx, "validate_address")
+	valid, err := a.BlockchainAdapter.ValidateAddress(ctx, address)
+	tracing.End(span, err)
+	return valid, err
+}
+
+func (a *tracedAdapter) GetBalance(ctx context.Context, address string) (*Balance, error) {
+	ctx, span := a.start(ctx, "get_balance")
+	balance, err := a.BlockchainAdapter.GetBalance(ctx, address)
+	tracing.End(span, err)
+	return balance, err
+}
+
+func (a *tracedAdapter) EstimateFee(ctx context.Context, req *FeeEstimateRequest) (*FeeEstimate, error) {
+	ctx, span := a.start(ctx, "estimate_fee")
+	estimate, err := a.BlockchainAdapter.EstimateFee(ctx, req)
+	tracing.End(span, err)
+	return estimate, err
+}
+
+func (a *tracedAdapter) CreateTransaction(ctx context.Context, req *TransactionRequest) (*UnsignedTransaction, error) {
+	ctx, span := a.start(ctx, "create_transaction")
+	tx, err := a.BlockchainAdapter.CreateTransaction(ctx, req)
+	tracing.End(span, err)
+	return tx, err
+}
+
+func (a *tracedAdapter) SignTransaction(ctx context.Context, tx *UnsignedTransaction, privateKey string) (*SignedTransaction, error) {
+	ctx, span := a.start(ctx, "sign_transaction")
+	signed, err := a.BlockchainAdapter.SignTransaction(ctx, tx, privateKey)
+	tracing.End(span, err)
+	return signed, err
+}
+
+func (a *tracedAdapter) BroadcastTransaction(ctx context.Context, tx *SignedTransaction) (string, error) {
+	ctx, span := a.start(ctx, "broadcast_transaction")
+	hash, err := a.BlockchainAdapter.BroadcastTransaction(ctx, tx)
+	tracing.End(span, err)
+	return hash, err
+}
+
+func (a *tracedAdapter) GetTransaction(ctx context.Context, txHash string) (*Transaction, error) {
+	ctx, span := a.start(ctx, "get_transaction")
+	tx, err := a.BlockchainAdapter.GetTransaction(ctx, txHash)
+	tracing.End(span, err)
+	return tx, err
+}
+
+func (a *tracedAdapter) GetTransactionStatus(ctx context.Context, txHash string) (*TransactionStatus, error) {
+	ctx, span := a.start(ctx, "get_transaction_status")
+	status, err := a.BlockchainAdapter.GetTransactionStatus(ctx, txHash)
+	tracing.End(span, err)
+	return status, err
+}
+
+func (a *tracedAdapter) GetBlockNumber(ctx context.Context) (uint64, error) {
+	ctx, span := a.start(ctx, "get_block_number")
+	number, err := a.BlockchainAdapter.GetBlockNumber(ctx)
+	tracing.End(span, err)
+	return number, err
+}
+
+func (a *tracedAdapter) GetNetworkInfo(ctx context.Context) (*NetworkInfo, error) {
+	ctx, span := a.start(ctx, "get_network_info")
+	info, err := a.BlockchainAdapter.GetNetworkInfo(ctx)
+	tracing.End(span, err)
+	return info, err
+}
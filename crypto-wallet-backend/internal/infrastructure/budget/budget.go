@@ -0,0 +1,125 @@
+// Package budget bounds how long individual use cases may run. Budgets are configured centrally by
+// use case name. A use case starts a run, passes the run's context to its slow calls and, when
+// Exhausted reports true, returns what it has so far flagged as truncated instead of running on.
+package budget
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+)
+
+// Names of the budgeted use cases, as used in configuration and metrics.
+const (
+	PortfolioPerformance = "analytics.portfolio_performance"
+	ExportTransactions   = "export.transactions"
+	ExportAccounting     = "export.accounting"
+	ExportReport         = "export.report"
+)
+
+// Defaults are the budgets of use cases the configuration does not mention.
+var Defaults = map[string]time.Duration{
+	PortfolioPerformance: 5 * time.Second,
+	ExportTransactions:   10 * time.Minute,
+	ExportAccounting:     10 * time.Minute,
+	ExportReport:         5 * time.Minute,
+}
+
+// ErrExhausted is the cause of a run's context once its budget has run out.
+var ErrExhausted = errors.New("execution budget exhausted")
+
+// Recorder receives the outcome of every budgeted run.
+type Recorder interface {
+	RecordBudgetRun(name string, limit, elapsed time.Duration, exhausted bool)
+}
+
+// Budgets holds the time budget of each use case. A nil *Budgets imposes none.
+type Budgets struct {
+	limits   map[string]time.Duration
+	recorder Recorder
+}
+
+// New applies overrides on top of Defaults. A non-positive override leaves that use case
+// unbounded. recorder may be nil.
+func New(overrides map[string]time.Duration, recorder Recorder) *Budgets {
+	limits := make(map[string]time.Duration, len(Defaults)+len(overrides))
+	for name, limit := range Defaults {
+		limits[name] = limit
+	}
+	for name, limit := range overrides {
+		limits[name] = limit
+	}
+	return &Budgets{limits: limits, recorder: recorder}
+}
+
+// Names returns the configured use case names in order.
+func (b *Budgets) Names() []string {
+	if b == nil {
+		return nil
+	}
+	names := make([]string, 0, len(b.limits))
+	for name := range b.limits {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Limit returns the use case's budget; zero means unbounded.
+func (b *Budgets) Limit(name string) time.Duration {
+	if b == nil {
+		return 0
+	}
+	return max(0, b.limits[name])
+}
+
+// Run is one budgeted execution of a use case.
+type Run struct {
+	name     string
+	limit    time.Duration
+	ctx      context.Context
+	cancel   context.CancelFunc
+	started  time.Time
+	recorder Recorder
+}
+
+// Start returns a context that is cancelled with ErrExhausted once the use case's budget has run
+// out. End must be called when the use case returns.
+func (b *Budgets) Start(ctx context.Context, name string) (context.Context, *Run) {
+	run := &Run{name: name, ctx: ctx, started: time.Now()}
+	if b == nil {
+		return ctx, run
+	}
+	run.recorder = b.recorder
+	if run.limit = b.Limit(name); run.limit > 0 {
+		run.ctx, run.cancel = context.WithTimeoutCause(ctx, run.limit, ErrExhausted)
+	}
+	return run.ctx, run
+}
+
+// Exhausted reports whether the run's budget has run out. Cancellation by the caller does not
+// count.
+func (r *Run) Exhausted() bool {
+	return r != nil && Exhausted(r.ctx)
+}
+
+// End releases the run's timer and records its outcome.
+func (r *Run) End() {
+	if r == nil {
+		return
+	}
+	exhausted := r.Exhausted()
+	if r.cancel != nil {
+		r.cancel()
+	}
+	if r.recorder != nil {
+		r.recorder.RecordBudgetRun(r.name, r.limit, time.Since(r.started), exhausted)
+	}
+}
+
+// Exhausted reports whether ctx was cancelled because a budget ran out, for code that receives a
+// budgeted context without its Run.
+func Exhausted(ctx context.Context) bool {
+	return ctx != nil && errors.Is(context.Cause(ctx), ErrExhausted)
+}
@@ -0,0 +1,89 @@
+// Package cache decorates repositories on hot read paths with a Redis read-through cache. Each
+// decorator serves reads from Redis, loads misses from the underlying repository once per key
+// however many callers miss at the same time, and invalidates entries when writes go through it.
+// Writes made through other repository instances are picked up when entries expire, so TTLs bound
+// staleness. Redis failures fall back to the underlying repository.
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// DefaultRedisPrefix namespaces cache entries in a shared Redis.
+const DefaultRedisPrefix = "cache:"
+
+// Default entry lifetimes.
+const (
+	DefaultRateTTL    = 30 * time.Second
+	DefaultBalanceTTL = 15 * time.Second
+)
+
+// Config configures a cache decorator.
+type Config struct {
+	Client redis.Cmdable
+	// Prefix defaults to DefaultRedisPrefix.
+	Prefix string
+	// TTL defaults to the decorator's default lifetime.
+	TTL    time.Duration
+	Logger *slog.Logger
+}
+
+// redisCache holds what the decorators share: the client, key prefix, lifetime and failure state.
+type redisCache struct {
+	client  redis.Cmdable
+	prefix  string
+	ttl     time.Duration
+	logger  *slog.Logger
+	failing atomic.Bool
+}
+
+func newRedisCache(cfg Config, defaultTTL time.Duration) *redisCache {
+	if cfg.Prefix == "" {
+		cfg.Prefix = DefaultRedisPrefix
+	}
+	if cfg.TTL <= 0 {
+		cfg.TTL = defaultTTL
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = slog.Default()
+	}
+	return &redisCache{client: cfg.Client, prefix: cfg.Prefix, ttl: cfg.TTL, logger: cfg.Logger}
+}
+
+// observe logs the first failure of a run and the recovery after it, so an unavailable Redis does
+// not flood the log.
+func (c *redisCache) observe(err error) {
+	if err != nil && !errors.Is(err, redis.Nil) {
+		if !c.failing.Swap(true) {
+			c.logger.Warn("cache unavailable; reading through to the database", slog.String("error", err.Error()))
+		}
+		return
+	}
+	if c.failing.Swap(false) {
+		c.logger.Info("cache recovered")
+	}
+}
+
+// encode marshals value for storage; values that cannot be marshalled are not cached.
+func (c *redisCache) encode(value any) ([]byte, bool) {
+	payload, err := json.Marshal(value)
+	if err != nil {
+		c.logger.Warn("failed to encode cache entry", slog.String("error", err.Error()))
+		return nil, false
+	}
+	return payload, true
+}
+
+func (c *redisCache) del(ctx context.Context, keys ...string) {
+	if len(keys) == 0 {
+		return
+	}
+	c.observe(c.client.Del(ctx, keys...).Err())
+}
@@ -0,0 +1,232 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// cachedRate is the stored form of an exchange rate.
+type cachedRate struct {
+	ID             uuid.UUID       `json:"id"`
+	Symbol         string          `json:"symbol"`
+	PriceUSD       decimal.Decimal `json:"price_usd"`
+	PriceChange24h decimal.Decimal `json:"price_change_24h"`
+	Volume24h      decimal.Decimal `json:"volume_24h"`
+	MarketCap      decimal.Decimal `json:"market_cap"`
+	Source         string          `json:"source"`
+	LastUpdated    time.Time       `json:"last_updated"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+func newCachedRate(rate entities.ExchangeRate) cachedRate {
+	return cachedRate{
+		ID:             rate.GetID(),
+		Symbol:         rate.GetSymbol(),
+		PriceUSD:       rate.GetPriceUSD(),
+		PriceChange24h: rate.GetPriceChange24h(),
+		Volume24h:      rate.GetVolume24h(),
+		MarketCap:      rate.GetMarketCap(),
+		Source:         rate.GetSource(),
+		LastUpdated:    rate.GetLastUpdated(),
+		CreatedAt:      rate.GetCreatedAt(),
+		UpdatedAt:      rate.GetUpdatedAt(),
+	}
+}
+
+func (r cachedRate) entity() *entities.ExchangeRateEntity {
+	return entities.HydrateExchangeRateEntity(entities.ExchangeRateParams{
+		ID:             r.ID,
+		Symbol:         r.Symbol,
+		PriceUSD:       r.PriceUSD,
+		PriceChange24h: r.PriceChange24h,
+		Volume24h:      r.Volume24h,
+		MarketCap:      r.MarketCap,
+		Source:         r.Source,
+		LastUpdated:    r.LastUpdated,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+	})
+}
+
+// RateRepository caches current exchange rates by symbol. Rate writes made through it invalidate
+// the symbol; other methods pass through to the underlying repository.
+type RateRepository struct {
+	repositories.RateRepository
+	cache *redisCache
+	group singleflight.Group
+}
+
+// NewRateRepository decorates rates with a cache; the TTL defaults to DefaultRateTTL.
+func NewRateRepository(rates repositories.RateRepository, cfg Config) *RateRepository {
+	return &RateRepository{RateRepository: rates, cache: newRedisCache(cfg, DefaultRateTTL)}
+}
+
+// GetRateBySymbol returns the current rate of symbol.
+func (r *RateRepository) GetRateBySymbol(ctx context.Context, symbol string) (entities.ExchangeRate, error) {
+	symbol = normalizeSymbol(symbol)
+	if rates := r.lookup(ctx, []string{symbol}); rates[symbol] != nil {
+		return rates[symbol], nil
+	}
+
+	value, err, _ := r.group.Do("one:"+symbol, func() (any, error) {
+		rate, err := r.RateRepository.GetRateBySymbol(ctx, symbol)
+		if err != nil {
+			return nil, err
+		}
+		r.store(ctx, []entities.ExchangeRate{rate})
+		return rate, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(entities.ExchangeRate), nil
+}
+
+// GetRatesBySymbols returns the current rates of the symbols that have one, loading only the
+// symbols missing from the cache.
+func (r *RateRepository) GetRatesBySymbols(ctx context.Context, symbols []string) ([]entities.ExchangeRate, error) {
+	wanted := make([]string, 0, len(symbols))
+	for _, symbol := range symbols {
+		if symbol = normalizeSymbol(symbol); symbol != "" && !slices.Contains(wanted, symbol) {
+			wanted = append(wanted, symbol)
+		}
+	}
+
+	cached := r.lookup(ctx, wanted)
+	missing := make([]string, 0, len(wanted))
+	for _, symbol := range wanted {
+		if cached[symbol] == nil {
+			missing = append(missing, symbol)
+		}
+	}
+
+	if len(missing) > 0 {
+		slices.Sort(missing)
+		value, err, _ := r.group.Do("many:"+strings.Join(missing, ","), func() (any, error) {
+			rates, err := r.RateRepository.GetRatesBySymbols(ctx, missing)
+			if err != nil {
+				return nil, err
+			}
+			r.store(ctx, rates)
+			return rates, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, rate := range value.([]entities.ExchangeRate) {
+			cached[normalizeSymbol(rate.GetSymbol())] = rate
+		}
+	}
+
+	result := make([]entities.ExchangeRate, 0, len(wanted))
+	for _, symbol := range wanted {
+		if rate := cached[symbol]; rate != nil {
+			result = append(result, rate)
+		}
+	}
+	return result, nil
+}
+
+// UpsertRate writes the rate and invalidates its symbol.
+func (r *RateRepository) UpsertRate(ctx context.Context, rate *entities.ExchangeRateEntity) error {
+	if err := r.RateRepository.UpsertRate(ctx, rate); err != nil {
+		return err
+	}
+	r.invalidate(ctx, rate.GetSymbol())
+	return nil
+}
+
+// CreateRate writes the rate and invalidates its symbol.
+func (r *RateRepository) CreateRate(ctx context.Context, rate *entities.ExchangeRateEntity) error {
+	if err := r.RateRepository.CreateRate(ctx, rate); err != nil {
+		return err
+	}
+	r.invalidate(ctx, rate.GetSymbol())
+	return nil
+}
+
+// UpdateRate writes the rate and invalidates its symbol.
+func (r *RateRepository) UpdateRate(ctx context.Context, rate entities.ExchangeRate) error {
+	if err := r.RateRepository.UpdateRate(ctx, rate); err != nil {
+		return err
+	}
+	r.invalidate(ctx, rate.GetSymbol())
+	return nil
+}
+
+// DeleteRate removes the rate and invalidates its symbol.
+func (r *RateRepository) DeleteRate(ctx context.Context, symbol string) error {
+	if err := r.RateRepository.DeleteRate(ctx, symbol); err != nil {
+		return err
+	}
+	r.invalidate(ctx, symbol)
+	return nil
+}
+
+// lookup returns the cached rates of symbols; misses and undecodable entries are left out.
+func (r *RateRepository) lookup(ctx context.Context, symbols []string) map[string]entities.ExchangeRate {
+	found := make(map[string]entities.ExchangeRate, len(symbols))
+	if len(symbols) == 0 {
+		return found
+	}
+
+	keys := make([]string, len(symbols))
+	for i, symbol := range symbols {
+		keys[i] = r.key(symbol)
+	}
+	values, err := r.cache.client.MGet(ctx, keys...).Result()
+	r.cache.observe(err)
+	if err != nil {
+		return found
+	}
+
+	for i, value := range values {
+		payload, ok := value.(string)
+		if !ok {
+			continue
+		}
+		var entry cachedRate
+		if err := json.Unmarshal([]byte(payload), &entry); err != nil {
+			continue
+		}
+		found[symbols[i]] = entry.entity()
+	}
+	return found
+}
+
+func (r *RateRepository) store(ctx context.Context, rates []entities.ExchangeRate) {
+	if len(rates) == 0 {
+		return
+	}
+	pipe := r.cache.client.Pipeline()
+	for _, rate := range rates {
+		if payload, ok := r.cache.encode(newCachedRate(rate)); ok {
+			pipe.Set(ctx, r.key(rate.GetSymbol()), payload, r.cache.ttl)
+		}
+	}
+	_, err := pipe.Exec(ctx)
+	r.cache.observe(err)
+}
+
+func (r *RateRepository) invalidate(ctx context.Context, symbol string) {
+	r.cache.del(ctx, r.key(symbol))
+}
+
+func (r *RateRepository) key(symbol string) string {
+	return r.cache.prefix + "rate:" + normalizeSymbol(symbol)
+}
+
+func normalizeSymbol(symbol string) string {
+	return strings.ToUpper(strings.TrimSpace(symbol))
+}
@@ -0,0 +1,167 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// cachedWallet is the stored form of a wallet. The encrypted private key is never cached.
+type cachedWallet struct {
+	ID               uuid.UUID             `json:"id"`
+	UserID           uuid.UUID             `json:"user_id"`
+	Chain            entities.Chain        `json:"chain"`
+	AssetID          uuid.UUID             `json:"asset_id"`
+	Address          string                `json:"address"`
+	DerivationPath   string                `json:"derivation_path,omitempty"`
+	Label            string                `json:"label,omitempty"`
+	Balance          decimal.Decimal       `json:"balance"`
+	BalanceUpdatedAt *time.Time            `json:"balance_updated_at,omitempty"`
+	Status           entities.WalletStatus `json:"status"`
+	CreatedAt        time.Time             `json:"created_at"`
+	UpdatedAt        time.Time             `json:"updated_at"`
+}
+
+func newCachedWallet(wallet entities.Wallet) cachedWallet {
+	return cachedWallet{
+		ID:               wallet.GetID(),
+		UserID:           wallet.GetUserID(),
+		Chain:            wallet.GetChain(),
+		AssetID:          wallet.GetAssetID(),
+		Address:          wallet.GetAddress(),
+		DerivationPath:   wallet.GetDerivationPath(),
+		Label:            wallet.GetLabel(),
+		Balance:          wallet.GetBalance(),
+		BalanceUpdatedAt: wallet.GetBalanceUpdatedAt(),
+		Status:           wallet.GetStatus(),
+		CreatedAt:        wallet.GetCreatedAt(),
+		UpdatedAt:        wallet.GetUpdatedAt(),
+	}
+}
+
+func (w cachedWallet) entity() *entities.WalletEntity {
+	return entities.HydrateWalletEntity(entities.WalletParams{
+		ID:               w.ID,
+		UserID:           w.UserID,
+		Chain:            w.Chain,
+		AssetID:          w.AssetID,
+		Address:          w.Address,
+		DerivationPath:   w.DerivationPath,
+		Label:            w.Label,
+		Balance:          w.Balance,
+		BalanceUpdatedAt: w.BalanceUpdatedAt,
+		Status:           w.Status,
+		CreatedAt:        w.CreatedAt,
+		UpdatedAt:        w.UpdatedAt,
+	})
+}
+
+// WalletRepository caches each user's wallet listings, which carry the balances portfolio views
+// read. Creates, updates and deletes made through it invalidate the owner's listings; other
+// methods pass through to the underlying repository. Cached wallets have no encrypted private key,
+// so use it only where wallets are read for display.
+type WalletRepository struct {
+	repositories.WalletRepository
+	cache *redisCache
+	group singleflight.Group
+}
+
+// NewWalletRepository decorates wallets with a cache; the TTL defaults to DefaultBalanceTTL.
+func NewWalletRepository(wallets repositories.WalletRepository, cfg Config) *WalletRepository {
+	return &WalletRepository{WalletRepository: wallets, cache: newRedisCache(cfg, DefaultBalanceTTL)}
+}
+
+// ListByUser returns the user's wallets matching the filter.
+func (r *WalletRepository) ListByUser(ctx context.Context, userID uuid.UUID, filter repositories.WalletFilter, opts repositories.ListOptions) ([]entities.Wallet, error) {
+	field, err := json.Marshal(struct {
+		Filter  repositories.WalletFilter
+		Options repositories.ListOptions
+	}{filter, opts})
+	if err != nil {
+		return r.WalletRepository.ListByUser(ctx, userID, filter, opts)
+	}
+
+	key := r.key(userID)
+	payload, err := r.cache.client.HGet(ctx, key, string(field)).Result()
+	r.cache.observe(err)
+	if err == nil {
+		var entries []cachedWallet
+		if json.Unmarshal([]byte(payload), &entries) == nil {
+			wallets := make([]entities.Wallet, len(entries))
+			for i, entry := range entries {
+				wallets[i] = entry.entity()
+			}
+			return wallets, nil
+		}
+	}
+
+	value, err, _ := r.group.Do(key+"|"+string(field), func() (any, error) {
+		wallets, err := r.WalletRepository.ListByUser(ctx, userID, filter, opts)
+		if err != nil {
+			return nil, err
+		}
+		entries := make([]cachedWallet, len(wallets))
+		for i, wallet := range wallets {
+			entries[i] = newCachedWallet(wallet)
+		}
+		if encoded, ok := r.cache.encode(entries); ok {
+			// The expiry (NX needs Redis 7) is set only when the user's listings are first cached, so
+			// caching another listing does not keep older ones alive past the TTL.
+			pipe := r.cache.client.TxPipeline()
+			pipe.HSet(ctx, key, string(field), encoded)
+			pipe.ExpireNX(ctx, key, r.cache.ttl)
+			_, err := pipe.Exec(ctx)
+			r.cache.observe(err)
+		}
+		return wallets, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.([]entities.Wallet), nil
+}
+
+// Create stores the wallet and invalidates its owner's listings.
+func (r *WalletRepository) Create(ctx context.Context, wallet *entities.WalletEntity) error {
+	if err := r.WalletRepository.Create(ctx, wallet); err != nil {
+		return err
+	}
+	r.invalidate(ctx, wallet.GetUserID())
+	return nil
+}
+
+// Update stores the wallet and invalidates its owner's listings.
+func (r *WalletRepository) Update(ctx context.Context, wallet entities.Wallet) error {
+	if err := r.WalletRepository.Update(ctx, wallet); err != nil {
+		return err
+	}
+	r.invalidate(ctx, wallet.GetUserID())
+	return nil
+}
+
+// Delete removes the wallet and invalidates its owner's listings.
+func (r *WalletRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	wallet, lookupErr := r.WalletRepository.GetByID(ctx, id)
+	if err := r.WalletRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	if lookupErr == nil {
+		r.invalidate(ctx, wallet.GetUserID())
+	}
+	return nil
+}
+
+func (r *WalletRepository) invalidate(ctx context.Context, userID uuid.UUID) {
+	r.cache.del(ctx, r.key(userID))
+}
+
+func (r *WalletRepository) key(userID uuid.UUID) string {
+	return r.cache.prefix + "wallets:" + userID.String()
+}
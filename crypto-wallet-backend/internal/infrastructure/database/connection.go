@@ -10,6 +10,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -30,6 +31,8 @@ type PoolConfig struct {
 	HealthCheckInterval time.Duration
 	ConnectTimeout     time.Duration
 	LazyConnect        bool
+	// QueryTracer, when set, observes every query on the pool's connections.
+	QueryTracer        pgx.QueryTracer
 }
 
 // PoolManager coordinates pgx connection pools for the multiple logical databases used by the platform.
@@ -89,6 +92,9 @@ func (m *PoolManager) Register(ctx context.Context, name string, cfg PoolConfig)
 	if cfg.HealthCheckInterval > 0 {
 		poolConfig.HealthCheckPeriod = cfg.HealthCheckInterval
 	}
+	if cfg.QueryTracer != nil {
+		poolConfig.ConnConfig.Tracer = cfg.QueryTracer
+	}
 
 	connectTimeout := cfg.ConnectTimeout
 	if connectTimeout <= 0 {
@@ -185,3 +191,15 @@ func (m *PoolManager) ConfigFor(name string) (PoolConfig, bool) {
 	cfg, ok := m.configs[name]
 	return cfg, ok
 }
+
+// Stats returns a statistics snapshot for each registered pool.
+func (m *PoolManager) Stats() map[string]*pgxpool.Stat {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	stats := make(map[string]*pgxpool.Stat, len(m.pools))
+	for name, pool := range m.pools {
+		stats[name] = pool.Stat()
+	}
+	return stats
+}
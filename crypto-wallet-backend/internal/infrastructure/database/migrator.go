@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"io/fs"
 	"log"
 	"os"
 	"path/filepath"
@@ -14,6 +15,7 @@ import (
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
 	_ "github.com/jackc/pgx/v5/stdlib"
 )
 
@@ -27,6 +29,9 @@ type DatabaseConfig struct {
 	DSN string
 	// MigrationsDir is the filesystem directory that contains .sql migrations for this database.
 	MigrationsDir string
+	// Source, when set, holds the migrations instead of the filesystem; MigrationsDir is then a
+	// path within it.
+	Source fs.FS
 }
 
 // Migrator coordinates schema migrations across multiple logical databases.
@@ -61,7 +66,7 @@ func NewMigrator(configs []DatabaseConfig, logger *log.Logger) (*Migrator, error
 			return nil, fmt.Errorf("database: duplicate database name %q", cfg.Name)
 		}
 
-		if err := ensureDirectory(cfg.MigrationsDir); err != nil {
+		if err := ensureDirectory(cfg.Source, cfg.MigrationsDir); err != nil {
 			return nil, fmt.Errorf("database: migrations directory invalid for %q: %w", cfg.Name, err)
 		}
 
@@ -110,6 +115,20 @@ func (m *Migrator) Down(ctx context.Context, steps int, targets ...string) error
 	})
 }
 
+// Force records version as the current schema version of target without running migrations and
+// clears the dirty flag. It recovers from a failed migration, or baselines a database whose schema
+// was applied by another tool.
+func (m *Migrator) Force(ctx context.Context, target string, version int) error {
+	cfg, err := m.configForTarget(target)
+	if err != nil {
+		return err
+	}
+	return m.withMigrator(ctx, cfg, func(mig *migrate.Migrate) error {
+		m.logger.Printf("forcing version=%d for database=%s", version, cfg.Name)
+		return mig.Force(version)
+	})
+}
+
 // Version returns the current schema version for the specified database target.
 func (m *Migrator) Version(ctx context.Context, target string) (uint, bool, error) {
 	cfg, err := m.configForTarget(target)
@@ -181,12 +200,20 @@ func (m *Migrator) withMigrator(ctx context.Context, cfg DatabaseConfig, fn func
 		return fmt.Errorf("create postgres driver: %w", err)
 	}
 
-	sourceURL, err := toFileURL(cfg.MigrationsDir)
-	if err != nil {
-		return fmt.Errorf("resolve migrations path: %w", err)
+	var migrator *migrate.Migrate
+	if cfg.Source != nil {
+		source, srcErr := iofs.New(cfg.Source, cfg.MigrationsDir)
+		if srcErr != nil {
+			return fmt.Errorf("open embedded migrations: %w", srcErr)
+		}
+		migrator, err = migrate.NewWithInstance("iofs", source, cfg.Name, driver)
+	} else {
+		sourceURL, urlErr := toFileURL(cfg.MigrationsDir)
+		if urlErr != nil {
+			return fmt.Errorf("resolve migrations path: %w", urlErr)
+		}
+		migrator, err = migrate.NewWithDatabaseInstance(sourceURL, cfg.Name, driver)
 	}
-
-	migrator, err := migrate.NewWithDatabaseInstance(sourceURL, cfg.Name, driver)
 	if err != nil {
 		return fmt.Errorf("create migrator: %w", err)
 	}
@@ -244,7 +271,18 @@ func (m *Migrator) configForTarget(target string) (DatabaseConfig, error) {
 	return cfg, nil
 }
 
-func ensureDirectory(dir string) error {
+func ensureDirectory(source fs.FS, dir string) error {
+	if source != nil {
+		info, err := fs.Stat(source, dir)
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return fmt.Errorf("%s is not a directory", dir)
+		}
+		return nil
+	}
+
 	absDir, err := filepath.Abs(dir)
 	if err != nil {
 		return err
@@ -0,0 +1,47 @@
+package eventexport
+
+import (
+	"fmt"
+	"strings"
+
+	eventexportusecase "github.com/crypto-wallet/backend/internal/application/usecases/eventexport"
+	"github.com/crypto-wallet/backend/internal/infrastructure/messaging"
+)
+
+// SinkConfig selects and configures an export sink. Kind is s3, kafka, file, or empty for none.
+type SinkConfig struct {
+	Kind             string
+	Dir              string
+	Prefix           string
+	S3               S3Config
+	Kafka            messaging.KafkaConfig
+	KafkaTopicPrefix string
+}
+
+// NewSink builds the sink named by cfg.Kind; it returns nil without error when Kind is empty.
+func NewSink(cfg SinkConfig) (eventexportusecase.Sink, error) {
+	switch strings.ToLower(strings.TrimSpace(cfg.Kind)) {
+	case "":
+		return nil, nil
+	case "file":
+		store, err := NewFileObjectStore(cfg.Dir)
+		if err != nil {
+			return nil, err
+		}
+		return NewObjectSink("file", store, cfg.Prefix)
+	case "s3":
+		store, err := NewS3ObjectStore(cfg.S3)
+		if err != nil {
+			return nil, err
+		}
+		return NewObjectSink("s3", store, cfg.Prefix)
+	case "kafka":
+		producer, err := messaging.NewKafkaProducer(cfg.Kafka)
+		if err != nil {
+			return nil, err
+		}
+		return NewKafkaSink(producer, cfg.KafkaTopicPrefix)
+	default:
+		return nil, fmt.Errorf("event export: unsupported sink %q", cfg.Kind)
+	}
+}
@@ -0,0 +1,49 @@
+package eventexport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FileObjectStore writes objects below a local directory, typically a volume synced to a bucket.
+type FileObjectStore struct {
+	dir string
+}
+
+// NewFileObjectStore constructs a FileObjectStore rooted at dir.
+func NewFileObjectStore(dir string) (*FileObjectStore, error) {
+	if dir == "" {
+		return nil, errors.New("event export directory is required")
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("create event export directory: %w", err)
+	}
+	return &FileObjectStore{dir: dir}, nil
+}
+
+// Put writes data under key, replacing any previous object with the same key.
+func (s *FileObjectStore) Put(_ context.Context, key, _ string, data []byte) (string, error) {
+	clean := filepath.Clean(filepath.FromSlash(key))
+	if key == "" || filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid event export key %q", key)
+	}
+
+	path := filepath.Join(s.dir, clean)
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return "", fmt.Errorf("create event export partition: %w", err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o640); err != nil {
+		return "", fmt.Errorf("write event export: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return "", fmt.Errorf("store event export: %w", err)
+	}
+	return path, nil
+}
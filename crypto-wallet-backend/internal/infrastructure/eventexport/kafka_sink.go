@@ -0,0 +1,68 @@
+package eventexport
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	eventexportusecase "github.com/crypto-wallet/backend/internal/application/usecases/eventexport"
+	"github.com/crypto-wallet/backend/internal/infrastructure/messaging"
+)
+
+// KafkaSink produces events to Kafka over the configured KafkaProducer transport. Each event type
+// gets its own topic ("<prefix>.<event type>") and records are keyed by partition date so a day's
+// events stay ordered.
+type KafkaSink struct {
+	producer    messaging.KafkaProducer
+	topicPrefix string
+}
+
+// NewKafkaSink constructs a KafkaSink producing through producer.
+func NewKafkaSink(producer messaging.KafkaProducer, topicPrefix string) (*KafkaSink, error) {
+	if producer == nil {
+		return nil, errors.New("event export: kafka producer is required")
+	}
+
+	prefix := strings.Trim(strings.TrimSpace(topicPrefix), ".")
+	if prefix == "" {
+		prefix = "wallet.events"
+	}
+
+	return &KafkaSink{
+		producer:    producer,
+		topicPrefix: prefix,
+	}, nil
+}
+
+// Name identifies the sink.
+func (s *KafkaSink) Name() string {
+	return "kafka"
+}
+
+// Write produces every event in the batch to the event type's topic.
+func (s *KafkaSink) Write(ctx context.Context, batch eventexportusecase.Batch) (string, error) {
+	topic := s.Topic(batch.EventType)
+	key := batch.Date.UTC().Format("2006-01-02")
+
+	records := make([]messaging.KafkaRecord, 0, len(batch.Events))
+	for _, event := range batch.Events {
+		records = append(records, messaging.KafkaRecord{Key: key, Value: event})
+	}
+	if err := s.producer.Produce(ctx, topic, records); err != nil {
+		return "", err
+	}
+
+	return "kafka://" + topic, nil
+}
+
+// Topic returns the topic events of eventType are produced to.
+func (s *KafkaSink) Topic(eventType string) string {
+	return s.topicPrefix + "." + strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '_', r == '-':
+			return r
+		default:
+			return '_'
+		}
+	}, eventType)
+}
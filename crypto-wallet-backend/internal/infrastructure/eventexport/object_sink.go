@@ -0,0 +1,59 @@
+package eventexport
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+
+	eventexportusecase "github.com/crypto-wallet/backend/internal/application/usecases/eventexport"
+)
+
+const ndjsonContentType = "application/x-ndjson"
+
+// ObjectStore stores objects under a key and returns their location.
+type ObjectStore interface {
+	Put(ctx context.Context, key, contentType string, data []byte) (string, error)
+}
+
+// ObjectSink writes each batch as an NDJSON object under its partition path, ready for external
+// tables or warehouse load jobs.
+type ObjectSink struct {
+	name   string
+	store  ObjectStore
+	prefix string
+}
+
+// NewObjectSink constructs an ObjectSink; prefix is prepended to every object key.
+func NewObjectSink(name string, store ObjectStore, prefix string) (*ObjectSink, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, errors.New("event export: sink name is required")
+	}
+	if store == nil {
+		return nil, errors.New("event export: object store is required")
+	}
+	return &ObjectSink{
+		name:   name,
+		store:  store,
+		prefix: strings.Trim(prefix, "/"),
+	}, nil
+}
+
+// Name identifies the sink.
+func (s *ObjectSink) Name() string {
+	return s.name
+}
+
+// Write stores the batch under a deterministic key, so replays overwrite the same object.
+func (s *ObjectSink) Write(ctx context.Context, batch eventexportusecase.Batch) (string, error) {
+	content, err := batch.NDJSON()
+	if err != nil {
+		return "", err
+	}
+
+	key := batch.ObjectKey()
+	if s.prefix != "" {
+		key = path.Join(s.prefix, key)
+	}
+	return s.store.Put(ctx, key, ndjsonContentType, content)
+}
@@ -0,0 +1,34 @@
+package eventexport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crypto-wallet/backend/internal/infrastructure/objectstore"
+)
+
+// S3Config configures an S3ObjectStore. Endpoint is only needed for S3-compatible stores
+// (MinIO, GCS interoperability); it switches to path-style addressing.
+type S3Config = objectstore.S3Config
+
+// S3ObjectStore uploads event exports with SigV4-signed PUT requests.
+type S3ObjectStore struct {
+	store *objectstore.S3Store
+}
+
+// NewS3ObjectStore validates the configuration and constructs an S3ObjectStore.
+func NewS3ObjectStore(cfg S3Config) (*S3ObjectStore, error) {
+	store, err := objectstore.NewS3Store(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("event export: %w", err)
+	}
+	return &S3ObjectStore{store: store}, nil
+}
+
+// Put uploads data under key and returns its s3:// URI.
+func (s *S3ObjectStore) Put(ctx context.Context, key, contentType string, data []byte) (string, error) {
+	if err := s.store.Put(ctx, key, contentType, data); err != nil {
+		return "", fmt.Errorf("event export: %w", err)
+	}
+	return s.store.URI(key), nil
+}
@@ -0,0 +1,186 @@
+package external
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	defaultAMLProviderTimeout   = 15 * time.Second
+	defaultAMLProviderUserAgent = "atlas-wallet-aml-client/1.0"
+)
+
+var (
+	// ErrAMLProviderUnavailable indicates the upstream screening provider is unavailable.
+	ErrAMLProviderUnavailable = errors.New("aml provider: service unavailable")
+	// ErrAMLProviderUnauthorized indicates the provided API credentials are invalid.
+	ErrAMLProviderUnauthorized = errors.New("aml provider: invalid credentials")
+	// ErrAMLProviderRequest indicates the upstream service rejected the request payload.
+	ErrAMLProviderRequest = errors.New("aml provider: request rejected")
+)
+
+// AMLHitType classifies a screening match.
+type AMLHitType string
+
+const (
+	AMLHitTypePEP          AMLHitType = "pep"
+	AMLHitTypeSanctions    AMLHitType = "sanctions"
+	AMLHitTypeAdverseMedia AMLHitType = "adverse_media"
+)
+
+// AMLScreeningRequest identifies the person to screen.
+type AMLScreeningRequest struct {
+	ExternalUserID string       `json:"externalUserId"`
+	FullName       string       `json:"fullName"`
+	DateOfBirth    string       `json:"dateOfBirth,omitempty"`
+	Nationality    string       `json:"nationality,omitempty"`
+	Types          []AMLHitType `json:"types"`
+}
+
+// AMLHit is a single watch-list or media match.
+type AMLHit struct {
+	Type       AMLHitType `json:"type"`
+	Name       string     `json:"name"`
+	Source     string     `json:"source"`
+	MatchScore int        `json:"matchScore"`
+}
+
+// Key identifies a hit across screenings so new matches can be told apart from known ones.
+func (h AMLHit) Key() string {
+	return strings.ToLower(fmt.Sprintf("%s:%s:%s", h.Type, strings.TrimSpace(h.Source), strings.TrimSpace(h.Name)))
+}
+
+// AMLScreeningResult is the provider's assessment. RiskScore ranges from 0 to 100.
+type AMLScreeningResult struct {
+	ReferenceID string   `json:"referenceId"`
+	RiskScore   int      `json:"riskScore"`
+	Hits        []AMLHit `json:"hits"`
+}
+
+// AMLScreeningClient screens users against PEP, sanctions and adverse media sources.
+type AMLScreeningClient interface {
+	Screen(ctx context.Context, request AMLScreeningRequest) (*AMLScreeningResult, error)
+}
+
+// AMLProviderConfig configures the HTTP screening client.
+type AMLProviderConfig struct {
+	BaseURL    string
+	APIKey     string
+	Timeout    time.Duration
+	Logger     *slog.Logger
+	UserAgent  string
+	HTTPClient *http.Client
+}
+
+type amlProviderClient struct {
+	baseURL    *url.URL
+	apiKey     string
+	httpClient *http.Client
+	logger     *slog.Logger
+	userAgent  string
+}
+
+// NewAMLScreeningClient constructs an HTTP screening client.
+func NewAMLScreeningClient(cfg AMLProviderConfig) (AMLScreeningClient, error) {
+	if strings.TrimSpace(cfg.BaseURL) == "" {
+		return nil, errors.New("aml provider: baseURL is required")
+	}
+	if strings.TrimSpace(cfg.APIKey) == "" {
+		return nil, errors.New("aml provider: api key is required")
+	}
+
+	baseURL, err := url.Parse(cfg.BaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("aml provider: parse baseURL: %w", err)
+	}
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultAMLProviderTimeout
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: timeout}
+	}
+
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	userAgent := cfg.UserAgent
+	if strings.TrimSpace(userAgent) == "" {
+		userAgent = defaultAMLProviderUserAgent
+	}
+
+	return &amlProviderClient{
+		baseURL:    baseURL,
+		apiKey:     cfg.APIKey,
+		httpClient: httpClient,
+		logger:     logger,
+		userAgent:  userAgent,
+	}, nil
+}
+
+func (c *amlProviderClient) Screen(ctx context.Context, request AMLScreeningRequest) (*AMLScreeningResult, error) {
+	if strings.TrimSpace(request.FullName) == "" {
+		return nil, errors.New("aml provider: full name is required")
+	}
+
+	buf, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("aml provider: encode payload: %w", err)
+	}
+
+	endpoint := *c.baseURL
+	endpoint.Path = path.Join(endpoint.Path, "screenings")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), bytes.NewReader(buf))
+	if err != nil {
+		return nil, fmt.Errorf("aml provider: create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Token "+c.apiKey)
+	req.Header.Set("User-Agent", c.userAgent)
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrAMLProviderUnavailable, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusUnauthorized || res.StatusCode == http.StatusForbidden {
+		return nil, ErrAMLProviderUnauthorized
+	}
+	if res.StatusCode >= 400 && res.StatusCode < 500 {
+		detail, _ := io.ReadAll(io.LimitReader(res.Body, 2048))
+		c.logger.Warn("aml provider request rejected", slog.Int("status", res.StatusCode), slog.String("response", string(detail)))
+		return nil, ErrAMLProviderRequest
+	}
+	if res.StatusCode >= 500 {
+		return nil, fmt.Errorf("%w: status=%d", ErrAMLProviderUnavailable, res.StatusCode)
+	}
+
+	result := &AMLScreeningResult{}
+	if err := json.NewDecoder(res.Body).Decode(result); err != nil {
+		return nil, fmt.Errorf("aml provider: decode response: %w", err)
+	}
+	if result.RiskScore < 0 {
+		result.RiskScore = 0
+	}
+	if result.RiskScore > 100 {
+		result.RiskScore = 100
+	}
+	return result, nil
+}
@@ -59,6 +59,16 @@ type CoinGeckoClient interface {
 
 	// GetHistoricalPrices fetches historical OHLCV data for a symbol.
 	GetHistoricalPrices(ctx context.Context, symbol string, days int) ([]OHLCVData, error)
+
+	// GetPriceRange fetches the price samples of a symbol between two times. CoinGecko picks the
+	// spacing: five-minutely within the last day, hourly for ranges up to 90 days, daily beyond.
+	GetPriceRange(ctx context.Context, symbol string, from, to time.Time) ([]PriceSample, error)
+}
+
+// PriceSample is a single USD price observation.
+type PriceSample struct {
+	Timestamp time.Time
+	Price     decimal.Decimal
 }
 
 // OHLCVData represents Open-High-Low-Close-Volume candle data.
@@ -78,6 +88,7 @@ type coinGeckoClientImpl struct {
 	logger        *slog.Logger
 	retryAttempts int
 	retryDelay    time.Duration
+	coinIDs       map[string]string
 }
 
 // CoinGeckoConfig holds configuration for the CoinGecko client.
@@ -86,7 +97,10 @@ type CoinGeckoConfig struct {
 	Timeout       time.Duration
 	RetryAttempts int
 	RetryDelay    time.Duration
-	Logger        *slog.Logger
+	// CoinIDs maps further symbols, such as listed tokens, to CoinGecko coin IDs. Entries override
+	// CoinGeckoSymbolMap.
+	CoinIDs map[string]string
+	Logger  *slog.Logger
 }
 
 // NewCoinGeckoClient creates a new CoinGecko API client.
@@ -104,6 +118,14 @@ func NewCoinGeckoClient(config CoinGeckoConfig) CoinGeckoClient {
 		config.Logger = slog.Default()
 	}
 
+	coinIDs := make(map[string]string, len(CoinGeckoSymbolMap)+len(config.CoinIDs))
+	for symbol, coinID := range CoinGeckoSymbolMap {
+		coinIDs[symbol] = coinID
+	}
+	for symbol, coinID := range config.CoinIDs {
+		coinIDs[strings.ToUpper(strings.TrimSpace(symbol))] = coinID
+	}
+
 	return &coinGeckoClientImpl{
 		httpClient: &http.Client{
 			Timeout: config.Timeout,
@@ -112,6 +134,7 @@ func NewCoinGeckoClient(config CoinGeckoConfig) CoinGeckoClient {
 		logger:        config.Logger,
 		retryAttempts: config.RetryAttempts,
 		retryDelay:    config.RetryDelay,
+		coinIDs:       coinIDs,
 	}
 }
 
@@ -127,7 +150,7 @@ func (c *coinGeckoClientImpl) GetPrices(ctx context.Context, symbols []string) (
 
 	for _, symbol := range symbols {
 		symbol = strings.ToUpper(strings.TrimSpace(symbol))
-		if coinID, ok := CoinGeckoSymbolMap[symbol]; ok {
+		if coinID, ok := c.coinIDs[symbol]; ok {
 			coinIDs = append(coinIDs, coinID)
 			symbolToCoinID[coinID] = symbol
 		} else {
@@ -191,7 +214,7 @@ func (c *coinGeckoClientImpl) GetPrice(ctx context.Context, symbol string) (*Coi
 // GetHistoricalPrices fetches OHLC data for a symbol.
 func (c *coinGeckoClientImpl) GetHistoricalPrices(ctx context.Context, symbol string, days int) ([]OHLCVData, error) {
 	symbol = strings.ToUpper(strings.TrimSpace(symbol))
-	coinID, ok := CoinGeckoSymbolMap[symbol]
+	coinID, ok := c.coinIDs[symbol]
 	if !ok {
 		return nil, fmt.Errorf("unknown symbol: %s", symbol)
 	}
@@ -229,6 +252,39 @@ func (c *coinGeckoClientImpl) GetHistoricalPrices(ctx context.Context, symbol st
 	return results, nil
 }
 
+// GetPriceRange fetches price samples from the market chart range endpoint in ascending order.
+func (c *coinGeckoClientImpl) GetPriceRange(ctx context.Context, symbol string, from, to time.Time) ([]PriceSample, error) {
+	symbol = strings.ToUpper(strings.TrimSpace(symbol))
+	coinID, ok := c.coinIDs[symbol]
+	if !ok {
+		return nil, fmt.Errorf("unknown symbol: %s", symbol)
+	}
+
+	apiURL := fmt.Sprintf("%s/coins/%s/market_chart/range?vs_currency=usd&from=%d&to=%d",
+		coinGeckoAPIBaseURL, coinID, from.Unix(), to.Unix())
+
+	// Prices are [timestamp in milliseconds, price] pairs
+	var response struct {
+		Prices [][]float64 `json:"prices"`
+	}
+	if err := c.doRequestWithRetry(ctx, apiURL, &response); err != nil {
+		return nil, err
+	}
+
+	results := make([]PriceSample, 0, len(response.Prices))
+	for _, point := range response.Prices {
+		if len(point) != 2 {
+			continue
+		}
+		results = append(results, PriceSample{
+			Timestamp: time.UnixMilli(int64(point[0])).UTC(),
+			Price:     decimal.NewFromFloat(point[1]),
+		})
+	}
+
+	return results, nil
+}
+
 func (c *coinGeckoClientImpl) doRequestWithRetry(ctx context.Context, url string, response interface{}) error {
 	var lastErr error
 
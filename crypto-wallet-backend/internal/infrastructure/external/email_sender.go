@@ -0,0 +1,160 @@
+package external
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+// Email drivers supported by NewEmailSender.
+const (
+	EmailDriverSMTP     = "smtp"
+	EmailDriverSES      = "ses"
+	EmailDriverSendGrid = "sendgrid"
+	// EmailDriverLog writes messages to the log instead of delivering them; for local development.
+	EmailDriverLog = "log"
+)
+
+const defaultEmailTimeout = 10 * time.Second
+
+var (
+	// ErrEmailProviderUnavailable indicates the mail server or API could not be reached.
+	ErrEmailProviderUnavailable = errors.New("email provider: service unavailable")
+	// ErrEmailProviderUnauthorized indicates the configured credentials were rejected.
+	ErrEmailProviderUnauthorized = errors.New("email provider: invalid credentials")
+	// ErrEmailProviderRequest indicates the provider rejected the message.
+	ErrEmailProviderRequest = errors.New("email provider: request rejected")
+
+	errEmailRecipientRequired = errors.New("email: recipient is required")
+	errEmailSubjectRequired   = errors.New("email: subject is required")
+	errEmailBodyRequired      = errors.New("email: a text or HTML body is required")
+)
+
+// EmailMessage is a single transactional email. HTMLBody is optional; TextBody is sent as the plain
+// text alternative.
+type EmailMessage struct {
+	To       string
+	Subject  string
+	TextBody string
+	HTMLBody string
+}
+
+// Validate checks the message can be delivered.
+func (m EmailMessage) Validate() error {
+	var validationErr error
+	if _, err := mail.ParseAddress(strings.TrimSpace(m.To)); err != nil {
+		validationErr = errors.Join(validationErr, errEmailRecipientRequired)
+	}
+	if strings.TrimSpace(m.Subject) == "" {
+		validationErr = errors.Join(validationErr, errEmailSubjectRequired)
+	}
+	if strings.TrimSpace(m.TextBody) == "" && strings.TrimSpace(m.HTMLBody) == "" {
+		validationErr = errors.Join(validationErr, errEmailBodyRequired)
+	}
+	return validationErr
+}
+
+// EmailSender delivers transactional email.
+type EmailSender interface {
+	Send(ctx context.Context, message EmailMessage) error
+}
+
+// SMTPConfig configures the SMTP driver. STARTTLS is used whenever the server offers it, and
+// credentials are only sent over TLS.
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+}
+
+// SESConfig configures the Amazon SES v2 driver.
+type SESConfig struct {
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	// Endpoint overrides https://email.<region>.amazonaws.com, for example for a VPC endpoint.
+	Endpoint string
+}
+
+// SendGridConfig configures the SendGrid v3 driver.
+type SendGridConfig struct {
+	APIKey string
+	// BaseURL overrides https://api.sendgrid.com.
+	BaseURL string
+}
+
+// EmailSenderConfig selects and configures an email driver.
+type EmailSenderConfig struct {
+	Driver      string
+	FromAddress string
+	FromName    string
+	SMTP        SMTPConfig
+	SES         SESConfig
+	SendGrid    SendGridConfig
+	Timeout     time.Duration
+	Logger      *slog.Logger
+	HTTPClient  *http.Client
+}
+
+// NewEmailSender constructs the configured driver.
+func NewEmailSender(cfg EmailSenderConfig) (EmailSender, error) {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultEmailTimeout
+	}
+
+	driver := strings.ToLower(strings.TrimSpace(cfg.Driver))
+	if driver == EmailDriverLog {
+		return &logEmailSender{logger: logger}, nil
+	}
+
+	from, err := mail.ParseAddress(strings.TrimSpace(cfg.FromAddress))
+	if err != nil {
+		return nil, fmt.Errorf("email: from address is invalid: %w", err)
+	}
+	from.Name = strings.TrimSpace(cfg.FromName)
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: timeout}
+	}
+
+	switch driver {
+	case EmailDriverSMTP:
+		return newSMTPEmailSender(cfg.SMTP, from, timeout)
+	case EmailDriverSES:
+		return newSESEmailSender(cfg.SES, from, httpClient, logger)
+	case EmailDriverSendGrid:
+		return newSendGridEmailSender(cfg.SendGrid, from, httpClient, logger)
+	default:
+		return nil, fmt.Errorf("email: unsupported driver %q (expected smtp, ses, sendgrid or log)", cfg.Driver)
+	}
+}
+
+// logEmailSender records messages in the log without delivering them.
+type logEmailSender struct {
+	logger *slog.Logger
+}
+
+func (s *logEmailSender) Send(_ context.Context, message EmailMessage) error {
+	if err := message.Validate(); err != nil {
+		return err
+	}
+	s.logger.Info("email not delivered (log driver)",
+		slog.String("to", message.To),
+		slog.String("subject", message.Subject),
+		slog.String("body", message.TextBody))
+	return nil
+}
@@ -0,0 +1,111 @@
+package external
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"path"
+	"strings"
+)
+
+const defaultSendGridBaseURL = "https://api.sendgrid.com"
+
+type sendGridAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+type sendGridContent struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type sendGridMailRequest struct {
+	Personalizations []struct {
+		To []sendGridAddress `json:"to"`
+	} `json:"personalizations"`
+	From    sendGridAddress   `json:"from"`
+	Subject string            `json:"subject"`
+	Content []sendGridContent `json:"content"`
+}
+
+// sendGridEmailSender sends through the SendGrid v3 mail/send API.
+type sendGridEmailSender struct {
+	endpoint   *url.URL
+	apiKey     string
+	from       *mail.Address
+	httpClient *http.Client
+	logger     *slog.Logger
+}
+
+func newSendGridEmailSender(cfg SendGridConfig, from *mail.Address, httpClient *http.Client, logger *slog.Logger) (*sendGridEmailSender, error) {
+	if strings.TrimSpace(cfg.APIKey) == "" {
+		return nil, errors.New("email: sendgrid api key is required")
+	}
+
+	baseURL := strings.TrimSpace(cfg.BaseURL)
+	if baseURL == "" {
+		baseURL = defaultSendGridBaseURL
+	}
+	endpoint, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("email: parse sendgrid baseURL: %w", err)
+	}
+	endpoint.Path = path.Join(endpoint.Path, "v3", "mail", "send")
+
+	return &sendGridEmailSender{
+		endpoint:   endpoint,
+		apiKey:     cfg.APIKey,
+		from:       from,
+		httpClient: httpClient,
+		logger:     logger,
+	}, nil
+}
+
+func (s *sendGridEmailSender) Send(ctx context.Context, message EmailMessage) error {
+	if err := message.Validate(); err != nil {
+		return err
+	}
+
+	payload := sendGridMailRequest{
+		From:    sendGridAddress{Email: s.from.Address, Name: s.from.Name},
+		Subject: message.Subject,
+	}
+	payload.Personalizations = make([]struct {
+		To []sendGridAddress `json:"to"`
+	}, 1)
+	payload.Personalizations[0].To = []sendGridAddress{{Email: strings.TrimSpace(message.To)}}
+	// SendGrid requires text/plain to precede text/html.
+	if strings.TrimSpace(message.TextBody) != "" {
+		payload.Content = append(payload.Content, sendGridContent{Type: "text/plain", Value: message.TextBody})
+	}
+	if strings.TrimSpace(message.HTMLBody) != "" {
+		payload.Content = append(payload.Content, sendGridContent{Type: "text/html", Value: message.HTMLBody})
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("email: encode sendgrid payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint.String(), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("email: create sendgrid request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+s.apiKey)
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrEmailProviderUnavailable, err)
+	}
+	defer res.Body.Close()
+
+	return checkEmailProviderResponse(res, "sendgrid", s.logger)
+}
@@ -0,0 +1,211 @@
+package external
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	sesService          = "ses"
+	sesSigningAlgorithm = "AWS4-HMAC-SHA256"
+	sesSendEmailPath    = "/v2/email/outbound-emails"
+)
+
+type sesContent struct {
+	Data    string `json:"Data"`
+	Charset string `json:"Charset"`
+}
+
+type sesSendEmailRequest struct {
+	FromEmailAddress string `json:"FromEmailAddress"`
+	Destination      struct {
+		ToAddresses []string `json:"ToAddresses"`
+	} `json:"Destination"`
+	Content struct {
+		Simple struct {
+			Subject sesContent `json:"Subject"`
+			Body    struct {
+				Text *sesContent `json:"Text,omitempty"`
+				HTML *sesContent `json:"Html,omitempty"`
+			} `json:"Body"`
+		} `json:"Simple"`
+	} `json:"Content"`
+}
+
+// sesEmailSender sends through the SES v2 SendEmail API with SigV4-signed requests.
+type sesEmailSender struct {
+	endpoint     *url.URL
+	region       string
+	accessKeyID  string
+	secretKey    string
+	sessionToken string
+	from         *mail.Address
+	httpClient   *http.Client
+	logger       *slog.Logger
+	now          func() time.Time
+}
+
+func newSESEmailSender(cfg SESConfig, from *mail.Address, httpClient *http.Client, logger *slog.Logger) (*sesEmailSender, error) {
+	region := strings.TrimSpace(cfg.Region)
+	if region == "" {
+		return nil, errors.New("email: ses region is required")
+	}
+	if strings.TrimSpace(cfg.AccessKeyID) == "" || strings.TrimSpace(cfg.SecretAccessKey) == "" {
+		return nil, errors.New("email: ses access key id and secret access key are required")
+	}
+
+	rawEndpoint := strings.TrimSpace(cfg.Endpoint)
+	if rawEndpoint == "" {
+		rawEndpoint = "https://email." + region + ".amazonaws.com"
+	}
+	endpoint, err := url.Parse(rawEndpoint)
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("email: ses endpoint is invalid: %q", rawEndpoint)
+	}
+	endpoint.Path = sesSendEmailPath
+
+	return &sesEmailSender{
+		endpoint:     endpoint,
+		region:       region,
+		accessKeyID:  cfg.AccessKeyID,
+		secretKey:    cfg.SecretAccessKey,
+		sessionToken: cfg.SessionToken,
+		from:         from,
+		httpClient:   httpClient,
+		logger:       logger,
+		now:          time.Now,
+	}, nil
+}
+
+func (s *sesEmailSender) Send(ctx context.Context, message EmailMessage) error {
+	if err := message.Validate(); err != nil {
+		return err
+	}
+
+	var payload sesSendEmailRequest
+	payload.FromEmailAddress = s.from.String()
+	payload.Destination.ToAddresses = []string{strings.TrimSpace(message.To)}
+	payload.Content.Simple.Subject = sesContent{Data: message.Subject, Charset: "UTF-8"}
+	if strings.TrimSpace(message.TextBody) != "" {
+		payload.Content.Simple.Body.Text = &sesContent{Data: message.TextBody, Charset: "UTF-8"}
+	}
+	if strings.TrimSpace(message.HTMLBody) != "" {
+		payload.Content.Simple.Body.HTML = &sesContent{Data: message.HTMLBody, Charset: "UTF-8"}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("email: encode ses payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint.String(), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("email: create ses request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	s.sign(req, body)
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrEmailProviderUnavailable, err)
+	}
+	defer res.Body.Close()
+
+	return checkEmailProviderResponse(res, "ses", s.logger)
+}
+
+// sign adds AWS Signature Version 4 headers for the request body.
+func (s *sesEmailSender) sign(req *http.Request, body []byte) {
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	dateStamp := now.Format("20060102")
+	payloadHash := sesSHA256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	if s.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.sessionToken)
+	}
+
+	headers := map[string]string{
+		"content-type": req.Header.Get("Content-Type"),
+		"host":         req.URL.Host,
+		"x-amz-date":   amzDate,
+	}
+	names := []string{"content-type", "host", "x-amz-date"}
+	if s.sessionToken != "" {
+		headers["x-amz-security-token"] = s.sessionToken
+		names = append(names, "x-amz-security-token")
+	}
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{dateStamp, s.region, sesService, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		sesSigningAlgorithm,
+		amzDate,
+		scope,
+		sesSHA256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := sesHMAC([]byte("AWS4"+s.secretKey), dateStamp)
+	signingKey = sesHMAC(signingKey, s.region)
+	signingKey = sesHMAC(signingKey, sesService)
+	signingKey = sesHMAC(signingKey, "aws4_request")
+	signature := hex.EncodeToString(sesHMAC(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		sesSigningAlgorithm, s.accessKeyID, scope, signedHeaders, signature))
+}
+
+// checkEmailProviderResponse maps an HTTP email API response onto the email provider errors.
+func checkEmailProviderResponse(res *http.Response, provider string, logger *slog.Logger) error {
+	if res.StatusCode == http.StatusUnauthorized || res.StatusCode == http.StatusForbidden {
+		return ErrEmailProviderUnauthorized
+	}
+	if res.StatusCode >= 400 && res.StatusCode < 500 {
+		detail, _ := io.ReadAll(io.LimitReader(res.Body, 2048))
+		logger.Warn("email provider request rejected", slog.String("provider", provider), slog.Int("status", res.StatusCode), slog.String("response", string(detail)))
+		return ErrEmailProviderRequest
+	}
+	if res.StatusCode >= 500 {
+		return fmt.Errorf("%w: status=%d", ErrEmailProviderUnavailable, res.StatusCode)
+	}
+	return nil
+}
+
+func sesSHA256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func sesHMAC(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,176 @@
+package external
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultSMTPPort = 587
+
+type smtpEmailSender struct {
+	host     string
+	port     int
+	username string
+	password string
+	from     *mail.Address
+	timeout  time.Duration
+	now      func() time.Time
+}
+
+func newSMTPEmailSender(cfg SMTPConfig, from *mail.Address, timeout time.Duration) (*smtpEmailSender, error) {
+	host := strings.TrimSpace(cfg.Host)
+	if host == "" {
+		return nil, errors.New("email: smtp host is required")
+	}
+	port := cfg.Port
+	if port <= 0 {
+		port = defaultSMTPPort
+	}
+	return &smtpEmailSender{
+		host:     host,
+		port:     port,
+		username: cfg.Username,
+		password: cfg.Password,
+		from:     from,
+		timeout:  timeout,
+		now:      time.Now,
+	}, nil
+}
+
+func (s *smtpEmailSender) Send(ctx context.Context, message EmailMessage) error {
+	if err := message.Validate(); err != nil {
+		return err
+	}
+
+	body, err := s.compose(message)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(s.host, strconv.Itoa(s.port)))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrEmailProviderUnavailable, err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("%w: %v", ErrEmailProviderUnavailable, err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("%w: starttls: %v", ErrEmailProviderUnavailable, err)
+		}
+	}
+
+	if s.username != "" {
+		// PlainAuth refuses to send credentials over an unencrypted connection to a remote host.
+		if err := client.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return fmt.Errorf("%w: %v", ErrEmailProviderUnauthorized, err)
+		}
+	}
+
+	recipient, _ := mail.ParseAddress(strings.TrimSpace(message.To))
+	if err := client.Mail(s.from.Address); err != nil {
+		return fmt.Errorf("%w: %v", ErrEmailProviderRequest, err)
+	}
+	if err := client.Rcpt(recipient.Address); err != nil {
+		return fmt.Errorf("%w: %v", ErrEmailProviderRequest, err)
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrEmailProviderRequest, err)
+	}
+	if _, err := writer.Write(body); err != nil {
+		writer.Close()
+		return fmt.Errorf("%w: %v", ErrEmailProviderUnavailable, err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("%w: %v", ErrEmailProviderRequest, err)
+	}
+
+	return client.Quit()
+}
+
+// compose renders the message as MIME, with a multipart/alternative body when HTML is present.
+func (s *smtpEmailSender) compose(message EmailMessage) ([]byte, error) {
+	var buf bytes.Buffer
+	header := func(name, value string) {
+		buf.WriteString(name + ": " + value + "\r\n")
+	}
+
+	header("From", s.from.String())
+	header("To", strings.TrimSpace(message.To))
+	header("Subject", mime.QEncoding.Encode("utf-8", message.Subject))
+	header("Date", s.now().UTC().Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+
+	if strings.TrimSpace(message.HTMLBody) == "" {
+		header("Content-Type", "text/plain; charset=utf-8")
+		header("Content-Transfer-Encoding", "quoted-printable")
+		buf.WriteString("\r\n")
+		return buf.Bytes(), writeQuotedPrintable(&buf, message.TextBody)
+	}
+
+	boundaryBytes := make([]byte, 12)
+	if _, err := rand.Read(boundaryBytes); err != nil {
+		return nil, fmt.Errorf("email: generate boundary: %w", err)
+	}
+	boundary := "alt-" + hex.EncodeToString(boundaryBytes)
+	header("Content-Type", `multipart/alternative; boundary="`+boundary+`"`)
+	buf.WriteString("\r\n")
+
+	parts := []struct {
+		contentType string
+		body        string
+	}{
+		{"text/plain; charset=utf-8", message.TextBody},
+		{"text/html; charset=utf-8", message.HTMLBody},
+	}
+	for _, part := range parts {
+		if strings.TrimSpace(part.body) == "" {
+			continue
+		}
+		buf.WriteString("--" + boundary + "\r\n")
+		header("Content-Type", part.contentType)
+		header("Content-Transfer-Encoding", "quoted-printable")
+		buf.WriteString("\r\n")
+		if err := writeQuotedPrintable(&buf, part.body); err != nil {
+			return nil, err
+		}
+		buf.WriteString("\r\n")
+	}
+	buf.WriteString("--" + boundary + "--\r\n")
+	return buf.Bytes(), nil
+}
+
+func writeQuotedPrintable(buf *bytes.Buffer, body string) error {
+	writer := quotedprintable.NewWriter(buf)
+	if _, err := writer.Write([]byte(body)); err != nil {
+		return fmt.Errorf("email: encode body: %w", err)
+	}
+	return writer.Close()
+}
@@ -0,0 +1,165 @@
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// DefaultFXProviderBaseURL serves daily ECB reference rates in the Frankfurter format.
+	DefaultFXProviderBaseURL = "https://api.frankfurter.app"
+
+	defaultFXProviderTimeout   = 10 * time.Second
+	defaultFXProviderUserAgent = "atlas-wallet-fx-client/1.0"
+)
+
+var (
+	// ErrFXProviderUnavailable indicates the upstream rate provider is unavailable.
+	ErrFXProviderUnavailable = errors.New("fx provider: service unavailable")
+	// ErrFXProviderInvalidResponse indicates the provider answered with an unexpected payload.
+	ErrFXProviderInvalidResponse = errors.New("fx provider: invalid response format")
+)
+
+// FXRates is one set of reference rates: units of each currency one unit of Base buys.
+type FXRates struct {
+	Base  string
+	Rates map[string]decimal.Decimal
+	// AsOf is the publication date of the rates, not the time they were fetched.
+	AsOf time.Time
+}
+
+// FXRateClient fetches fiat reference rates.
+type FXRateClient interface {
+	GetRates(ctx context.Context, base string, currencies []string) (*FXRates, error)
+}
+
+// FXProviderConfig configures the HTTP rate client.
+type FXProviderConfig struct {
+	BaseURL    string
+	Timeout    time.Duration
+	Logger     *slog.Logger
+	UserAgent  string
+	HTTPClient *http.Client
+}
+
+type fxProviderClient struct {
+	baseURL    *url.URL
+	httpClient *http.Client
+	logger     *slog.Logger
+	userAgent  string
+}
+
+// NewFXRateClient constructs an HTTP rate client; an empty BaseURL uses DefaultFXProviderBaseURL.
+func NewFXRateClient(cfg FXProviderConfig) (FXRateClient, error) {
+	rawURL := strings.TrimSpace(cfg.BaseURL)
+	if rawURL == "" {
+		rawURL = DefaultFXProviderBaseURL
+	}
+	baseURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("fx provider: parse baseURL: %w", err)
+	}
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultFXProviderTimeout
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: timeout}
+	}
+
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	userAgent := cfg.UserAgent
+	if strings.TrimSpace(userAgent) == "" {
+		userAgent = defaultFXProviderUserAgent
+	}
+
+	return &fxProviderClient{
+		baseURL:    baseURL,
+		httpClient: httpClient,
+		logger:     logger,
+		userAgent:  userAgent,
+	}, nil
+}
+
+type fxLatestResponse struct {
+	Base  string                 `json:"base"`
+	Date  string                 `json:"date"`
+	Rates map[string]json.Number `json:"rates"`
+}
+
+func (c *fxProviderClient) GetRates(ctx context.Context, base string, currencies []string) (*FXRates, error) {
+	base = strings.ToUpper(strings.TrimSpace(base))
+	if base == "" {
+		return nil, errors.New("fx provider: base currency is required")
+	}
+
+	endpoint := *c.baseURL
+	endpoint.Path = path.Join(endpoint.Path, "latest")
+	query := url.Values{}
+	query.Set("from", base)
+	if len(currencies) > 0 {
+		query.Set("to", strings.ToUpper(strings.Join(currencies, ",")))
+	}
+	endpoint.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("fx provider: create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrFXProviderUnavailable, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: status=%d", ErrFXProviderUnavailable, res.StatusCode)
+	}
+
+	decoder := json.NewDecoder(res.Body)
+	decoder.UseNumber()
+	var payload fxLatestResponse
+	if err := decoder.Decode(&payload); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrFXProviderInvalidResponse, err)
+	}
+	if !strings.EqualFold(payload.Base, base) {
+		return nil, fmt.Errorf("%w: base %q", ErrFXProviderInvalidResponse, payload.Base)
+	}
+
+	asOf, err := time.Parse(time.DateOnly, payload.Date)
+	if err != nil {
+		return nil, fmt.Errorf("%w: date %q", ErrFXProviderInvalidResponse, payload.Date)
+	}
+
+	rates := make(map[string]decimal.Decimal, len(payload.Rates))
+	for currency, raw := range payload.Rates {
+		value, err := decimal.NewFromString(raw.String())
+		if err != nil || !value.IsPositive() {
+			c.logger.Warn("skipping invalid fx rate", slog.String("currency", currency), slog.String("value", raw.String()))
+			continue
+		}
+		rates[strings.ToUpper(currency)] = value
+	}
+
+	return &FXRates{Base: base, Rates: rates, AsOf: asOf.UTC()}, nil
+}
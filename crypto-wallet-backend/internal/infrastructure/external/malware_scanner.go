@@ -0,0 +1,244 @@
+package external
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	defaultMalwareScanTimeout = 60 * time.Second
+	defaultClamAVAddress      = "localhost:3310"
+	// clamAVChunkSize is the size of each INSTREAM chunk sent to clamd.
+	clamAVChunkSize = 32 * 1024
+)
+
+var (
+	// ErrMalwareScannerUnavailable indicates the scanner could not be reached or gave no verdict.
+	ErrMalwareScannerUnavailable = errors.New("malware scanner: service unavailable")
+)
+
+// MalwareScanResult is a scanner's verdict on one file. Signature names the detected malware.
+type MalwareScanResult struct {
+	Clean     bool
+	Signature string
+	Engine    string
+}
+
+// MalwareScanner checks uploaded files for malware.
+type MalwareScanner interface {
+	Scan(ctx context.Context, content io.Reader) (*MalwareScanResult, error)
+}
+
+// ClamAVConfig configures the clamd scanner. Address is host:port, or a unix socket path.
+type ClamAVConfig struct {
+	Address string
+	Timeout time.Duration
+}
+
+type clamAVScanner struct {
+	network string
+	address string
+	timeout time.Duration
+}
+
+// NewClamAVScanner constructs a scanner that streams files to clamd with the INSTREAM command.
+func NewClamAVScanner(cfg ClamAVConfig) (MalwareScanner, error) {
+	address := strings.TrimSpace(cfg.Address)
+	if address == "" {
+		address = defaultClamAVAddress
+	}
+	network := "tcp"
+	if strings.HasPrefix(address, "unix:") || strings.HasPrefix(address, "/") {
+		network = "unix"
+		address = strings.TrimPrefix(address, "unix:")
+	}
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultMalwareScanTimeout
+	}
+
+	return &clamAVScanner{
+		network: network,
+		address: address,
+		timeout: timeout,
+	}, nil
+}
+
+func (s *clamAVScanner) Scan(ctx context.Context, content io.Reader) (*MalwareScanResult, error) {
+	dialer := net.Dialer{Timeout: s.timeout}
+	conn, err := dialer.DialContext(ctx, s.network, s.address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalwareScannerUnavailable, err)
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(s.timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalwareScannerUnavailable, err)
+	}
+
+	if err := writeClamAVStream(conn, content); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalwareScannerUnavailable, err)
+	}
+
+	reply, err := bufio.NewReader(conn).ReadString(0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: %v", ErrMalwareScannerUnavailable, err)
+	}
+	return parseClamAVReply(strings.TrimRight(reply, "\x00\n"))
+}
+
+// writeClamAVStream sends content as length-prefixed chunks, ending with a zero-length chunk.
+func writeClamAVStream(w io.Writer, content io.Reader) error {
+	if _, err := io.WriteString(w, "zINSTREAM\x00"); err != nil {
+		return err
+	}
+	buf := make([]byte, 4+clamAVChunkSize)
+	for {
+		n, readErr := content.Read(buf[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(buf[:4], uint32(n))
+			if _, err := w.Write(buf[:4+n]); err != nil {
+				return err
+			}
+		}
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			return readErr
+		}
+	}
+	_, err := w.Write([]byte{0, 0, 0, 0})
+	return err
+}
+
+// parseClamAVReply reads "stream: OK", "stream: <signature> FOUND" or "<reason> ERROR".
+func parseClamAVReply(reply string) (*MalwareScanResult, error) {
+	reply = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(reply), "stream:"))
+	switch {
+	case reply == "OK":
+		return &MalwareScanResult{Clean: true, Engine: "clamav"}, nil
+	case strings.HasSuffix(reply, " FOUND"):
+		return &MalwareScanResult{
+			Clean:     false,
+			Signature: strings.TrimSpace(strings.TrimSuffix(reply, " FOUND")),
+			Engine:    "clamav",
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: clamd replied %q", ErrMalwareScannerUnavailable, reply)
+	}
+}
+
+// HTTPMalwareScannerConfig configures a scanner behind an HTTP API, such as a cloud scanning
+// service or a sidecar. The file is POSTed as the request body and the service must reply with
+// {"clean": bool, "signature": "...", "engine": "..."}.
+type HTTPMalwareScannerConfig struct {
+	URL        string
+	APIKey     string
+	Timeout    time.Duration
+	Logger     *slog.Logger
+	HTTPClient *http.Client
+}
+
+type httpMalwareScanner struct {
+	url        string
+	apiKey     string
+	httpClient *http.Client
+	logger     *slog.Logger
+}
+
+// NewHTTPMalwareScanner constructs an HTTP scanning client.
+func NewHTTPMalwareScanner(cfg HTTPMalwareScannerConfig) (MalwareScanner, error) {
+	if strings.TrimSpace(cfg.URL) == "" {
+		return nil, errors.New("malware scanner: url is required")
+	}
+	if _, err := url.Parse(cfg.URL); err != nil {
+		return nil, fmt.Errorf("malware scanner: parse url: %w", err)
+	}
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultMalwareScanTimeout
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: timeout}
+	}
+
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &httpMalwareScanner{
+		url:        strings.TrimSpace(cfg.URL),
+		apiKey:     strings.TrimSpace(cfg.APIKey),
+		httpClient: httpClient,
+		logger:     logger,
+	}, nil
+}
+
+func (s *httpMalwareScanner) Scan(ctx context.Context, content io.Reader) (*MalwareScanResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, content)
+	if err != nil {
+		return nil, fmt.Errorf("malware scanner: create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Accept", "application/json")
+	if s.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	}
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalwareScannerUnavailable, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		s.logger.Warn("malware scanner returned an error",
+			slog.Int("status", res.StatusCode),
+			slog.String("body", strings.TrimSpace(string(message))))
+		return nil, fmt.Errorf("%w: status %d", ErrMalwareScannerUnavailable, res.StatusCode)
+	}
+
+	var verdict struct {
+		Clean     *bool  `json:"clean"`
+		Signature string `json:"signature"`
+		Engine    string `json:"engine"`
+	}
+	if err := json.NewDecoder(io.LimitReader(res.Body, 64*1024)).Decode(&verdict); err != nil {
+		return nil, fmt.Errorf("%w: decode verdict: %v", ErrMalwareScannerUnavailable, err)
+	}
+	if verdict.Clean == nil {
+		return nil, fmt.Errorf("%w: verdict missing", ErrMalwareScannerUnavailable)
+	}
+
+	engine := strings.TrimSpace(verdict.Engine)
+	if engine == "" {
+		engine = "http"
+	}
+	return &MalwareScanResult{
+		Clean:     *verdict.Clean,
+		Signature: strings.TrimSpace(verdict.Signature),
+		Engine:    engine,
+	}, nil
+}
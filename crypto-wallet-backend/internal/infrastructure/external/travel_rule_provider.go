@@ -0,0 +1,187 @@
+package external
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	defaultTravelRuleTimeout   = 15 * time.Second
+	defaultTravelRuleUserAgent = "atlas-wallet-travel-rule-client/1.0"
+)
+
+var (
+	// ErrTravelRuleProviderUnavailable indicates the travel-rule provider could not be reached.
+	ErrTravelRuleProviderUnavailable = errors.New("travel rule provider: service unavailable")
+	// ErrTravelRuleProviderUnauthorized indicates the provided API credentials are invalid.
+	ErrTravelRuleProviderUnauthorized = errors.New("travel rule provider: invalid credentials")
+	// ErrTravelRuleRequest indicates the provider rejected the request payload.
+	ErrTravelRuleRequest = errors.New("travel rule provider: request rejected")
+	// ErrTravelRuleTransferRejected indicates the beneficiary's VASP declined the transfer.
+	ErrTravelRuleTransferRejected = errors.New("travel rule provider: transfer rejected by beneficiary vasp")
+)
+
+// TravelRuleParty is the originator or beneficiary of a transfer as the travel rule requires it.
+type TravelRuleParty struct {
+	Name              string `json:"name"`
+	AccountNumber     string `json:"accountNumber,omitempty"`
+	GeographicAddress string `json:"geographicAddress,omitempty"`
+	DateOfBirth       string `json:"dateOfBirth,omitempty"`
+	NationalID        string `json:"nationalIdentification,omitempty"`
+}
+
+// TravelRuleTransfer is the travel-rule message for one withdrawal. BeneficiaryVASP is empty for
+// transfers to unhosted wallets.
+type TravelRuleTransfer struct {
+	TransferRef        string          `json:"transferRef"`
+	Asset              string          `json:"transactionAsset"`
+	Amount             string          `json:"transactionAmount"`
+	AmountUSD          string          `json:"amountUsd"`
+	OriginatorVASP     string          `json:"originatorVASPdid"`
+	BeneficiaryVASP    string          `json:"beneficiaryVASPdid,omitempty"`
+	BeneficiaryAddress string          `json:"beneficiaryAddress"`
+	Originator         TravelRuleParty `json:"originator"`
+	Beneficiary        TravelRuleParty `json:"beneficiary"`
+}
+
+// TravelRuleTransmission is the provider's acknowledgement of a transfer message.
+type TravelRuleTransmission struct {
+	ReferenceID string `json:"id"`
+	Status      string `json:"status"`
+}
+
+// TravelRuleClient transmits travel-rule data to a provider before a withdrawal is broadcast.
+type TravelRuleClient interface {
+	Transmit(ctx context.Context, transfer TravelRuleTransfer) (*TravelRuleTransmission, error)
+}
+
+// TravelRuleProviderConfig configures the HTTP travel-rule client. VASPDID identifies this
+// platform as the originating VASP.
+type TravelRuleProviderConfig struct {
+	BaseURL    string
+	APIKey     string
+	VASPDID    string
+	Timeout    time.Duration
+	Logger     *slog.Logger
+	UserAgent  string
+	HTTPClient *http.Client
+}
+
+type travelRuleClient struct {
+	baseURL    *url.URL
+	apiKey     string
+	vaspDID    string
+	httpClient *http.Client
+	logger     *slog.Logger
+	userAgent  string
+}
+
+// NewTravelRuleClient constructs an HTTP client for a Notabene-style travel-rule API.
+func NewTravelRuleClient(cfg TravelRuleProviderConfig) (TravelRuleClient, error) {
+	if strings.TrimSpace(cfg.BaseURL) == "" {
+		return nil, errors.New("travel rule provider: baseURL is required")
+	}
+	if strings.TrimSpace(cfg.APIKey) == "" {
+		return nil, errors.New("travel rule provider: api key is required")
+	}
+	if strings.TrimSpace(cfg.VASPDID) == "" {
+		return nil, errors.New("travel rule provider: vasp did is required")
+	}
+
+	baseURL, err := url.Parse(cfg.BaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("travel rule provider: parse baseURL: %w", err)
+	}
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultTravelRuleTimeout
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: timeout}
+	}
+
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	userAgent := cfg.UserAgent
+	if strings.TrimSpace(userAgent) == "" {
+		userAgent = defaultTravelRuleUserAgent
+	}
+
+	return &travelRuleClient{
+		baseURL:    baseURL,
+		apiKey:     cfg.APIKey,
+		vaspDID:    strings.TrimSpace(cfg.VASPDID),
+		httpClient: httpClient,
+		logger:     logger,
+		userAgent:  userAgent,
+	}, nil
+}
+
+func (c *travelRuleClient) Transmit(ctx context.Context, transfer TravelRuleTransfer) (*TravelRuleTransmission, error) {
+	if strings.TrimSpace(transfer.Originator.Name) == "" || strings.TrimSpace(transfer.Beneficiary.Name) == "" {
+		return nil, errors.New("travel rule provider: originator and beneficiary names are required")
+	}
+	if transfer.OriginatorVASP == "" {
+		transfer.OriginatorVASP = c.vaspDID
+	}
+
+	buf, err := json.Marshal(transfer)
+	if err != nil {
+		return nil, fmt.Errorf("travel rule provider: encode payload: %w", err)
+	}
+
+	endpoint := *c.baseURL
+	endpoint.Path = path.Join(endpoint.Path, "tx", "create")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), bytes.NewReader(buf))
+	if err != nil {
+		return nil, fmt.Errorf("travel rule provider: create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	req.Header.Set("User-Agent", c.userAgent)
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrTravelRuleProviderUnavailable, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusUnauthorized || res.StatusCode == http.StatusForbidden {
+		return nil, ErrTravelRuleProviderUnauthorized
+	}
+	if res.StatusCode >= 400 && res.StatusCode < 500 {
+		detail, _ := io.ReadAll(io.LimitReader(res.Body, 2048))
+		c.logger.Warn("travel rule request rejected", slog.Int("status", res.StatusCode), slog.String("response", string(detail)))
+		return nil, ErrTravelRuleRequest
+	}
+	if res.StatusCode >= 500 {
+		return nil, fmt.Errorf("%w: status=%d", ErrTravelRuleProviderUnavailable, res.StatusCode)
+	}
+
+	result := &TravelRuleTransmission{}
+	if err := json.NewDecoder(res.Body).Decode(result); err != nil {
+		return nil, fmt.Errorf("travel rule provider: decode response: %w", err)
+	}
+	switch strings.ToUpper(strings.TrimSpace(result.Status)) {
+	case "REJECTED", "DECLINED", "CANCELLED":
+		return result, ErrTravelRuleTransferRejected
+	}
+	return result, nil
+}
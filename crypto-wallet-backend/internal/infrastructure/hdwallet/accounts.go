@@ -0,0 +1,283 @@
+package hdwallet
+
+import (
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"golang.org/x/crypto/ripemd160"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/infrastructure/blockchain"
+)
+
+// Config selects the networks derived accounts are encoded for.
+type Config struct {
+	// BitcoinNetwork is mainnet (default), testnet, signet or regtest. Non-mainnet networks use
+	// coin type 1 and tb1 addresses.
+	BitcoinNetwork string
+}
+
+// Keychain creates BIP39 mnemonics and derives chain accounts from their seeds. Account n of each
+// chain lives at:
+//
+//	BTC m/84'/0'/n'/0/0  native SegWit (BIP84)
+//	ETH m/44'/60'/0'/0/n
+//	SOL m/44'/501'/n'/0'
+//	XLM m/44'/148'/n'    (SEP-0005)
+//
+// matching the layouts of common wallets so a user's mnemonic restores the same addresses
+// elsewhere.
+type Keychain struct {
+	bitcoinTestnet bool
+}
+
+// NewKeychain constructs a Keychain.
+func NewKeychain(cfg Config) *Keychain {
+	network := strings.ToLower(strings.TrimSpace(cfg.BitcoinNetwork))
+	return &Keychain{bitcoinTestnet: network != "" && network != "mainnet"}
+}
+
+// NewMnemonic returns a fresh mnemonic of DefaultMnemonicWords words.
+func (k *Keychain) NewMnemonic() (string, error) {
+	return NewMnemonic(DefaultMnemonicWords)
+}
+
+// MnemonicToSeed validates the phrase and returns its BIP39 seed.
+func (k *Keychain) MnemonicToSeed(mnemonic string) ([]byte, error) {
+	return MnemonicToSeed(mnemonic)
+}
+
+// AccountPath returns the derivation path of the chain's account at index.
+func (k *Keychain) AccountPath(chain entities.Chain, index uint32) (string, error) {
+	if index >= HardenedOffset {
+		return "", fmt.Errorf("%w: account index %d out of range", ErrInvalidPath, index)
+	}
+	switch chain {
+	case entities.ChainBTC:
+		coinType := 0
+		if k.bitcoinTestnet {
+			coinType = 1
+		}
+		return fmt.Sprintf("m/84'/%d'/%d'/0/0", coinType, index), nil
+	case entities.ChainETH:
+		return fmt.Sprintf("m/44'/60'/0'/0/%d", index), nil
+	case entities.ChainSOL:
+		return fmt.Sprintf("m/44'/501'/%d'/0'", index), nil
+	case entities.ChainXLM:
+		return fmt.Sprintf("m/44'/148'/%d'", index), nil
+	default:
+		return "", fmt.Errorf("hdwallet: unsupported chain %q", chain)
+	}
+}
+
+// Derive returns the chain account at path, with keys encoded the way the chain's adapter
+// expects them.
+func (k *Keychain) Derive(seed []byte, chain entities.Chain, path string) (*blockchain.Wallet, error) {
+	c := curveSecp256k1
+	if chain == entities.ChainSOL || chain == entities.ChainXLM {
+		c = curveEd25519
+	}
+	key, err := deriveKey(seed, c, path)
+	if err != nil {
+		return nil, err
+	}
+
+	wallet := &blockchain.Wallet{Chain: chain, DerivationPath: path}
+	switch chain {
+	case entities.ChainBTC:
+		publicKey := key.secp256k1PublicKey().SerializeCompressed()
+		wallet.PublicKey = hex.EncodeToString(publicKey)
+		wallet.Address = k.bitcoinAddress(publicKey)
+		wallet.PrivateKey = k.bitcoinWIF(key.key)
+	case entities.ChainETH:
+		publicKey := key.secp256k1PublicKey().SerializeUncompressed()
+		wallet.PublicKey = "0x" + hex.EncodeToString(publicKey)
+		wallet.Address = ethereumAddress(publicKey)
+		wallet.PrivateKey = "0x" + hex.EncodeToString(key.key)
+	case entities.ChainSOL:
+		private := key.ed25519Key()
+		wallet.PublicKey = encodeBase58(private[32:])
+		wallet.Address = wallet.PublicKey
+		wallet.PrivateKey = encodeBase58(private)
+	case entities.ChainXLM:
+		private := key.ed25519Key()
+		wallet.Address = stellarStrKey(stellarAccountVersion, private[32:])
+		wallet.PublicKey = wallet.Address
+		wallet.PrivateKey = stellarStrKey(stellarSeedVersion, key.key)
+	default:
+		return nil, fmt.Errorf("hdwallet: unsupported chain %q", chain)
+	}
+	return wallet, nil
+}
+
+// bitcoinAddress encodes a P2WPKH (native SegWit v0) address.
+func (k *Keychain) bitcoinAddress(publicKey []byte) string {
+	sha := sha256.Sum256(publicKey)
+	ripe := ripemd160.New()
+	ripe.Write(sha[:])
+
+	program := convertBits(ripe.Sum(nil))
+	hrp := "bc"
+	if k.bitcoinTestnet {
+		hrp = "tb"
+	}
+	return bech32Encode(hrp, append([]byte{0}, program...))
+}
+
+// bitcoinWIF encodes a private key for a compressed public key in wallet import format.
+func (k *Keychain) bitcoinWIF(key []byte) string {
+	version := byte(0x80)
+	if k.bitcoinTestnet {
+		version = 0xef
+	}
+	payload := append([]byte{version}, key...)
+	payload = append(payload, 0x01)
+	first := sha256.Sum256(payload)
+	second := sha256.Sum256(first[:])
+	return encodeBase58(append(payload, second[:4]...))
+}
+
+// ethereumAddress encodes the last 20 bytes of the public key's Keccak-256 hash with the EIP-55
+// mixed-case checksum.
+func ethereumAddress(uncompressed []byte) string {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(uncompressed[1:])
+	address := hex.EncodeToString(hash.Sum(nil)[12:])
+
+	checksum := sha3.NewLegacyKeccak256()
+	checksum.Write([]byte(address))
+	digest := checksum.Sum(nil)
+
+	out := []byte(address)
+	for i, ch := range out {
+		nibble := digest[i/2] >> 4
+		if i%2 == 1 {
+			nibble = digest[i/2] & 0x0f
+		}
+		if ch >= 'a' && nibble >= 8 {
+			out[i] = ch - 'a' + 'A'
+		}
+	}
+	return "0x" + string(out)
+}
+
+// Stellar StrKey version bytes for account IDs (G...) and secret seeds (S...).
+const (
+	stellarAccountVersion byte = 6 << 3
+	stellarSeedVersion    byte = 18 << 3
+)
+
+func stellarStrKey(version byte, payload []byte) string {
+	data := append([]byte{version}, payload...)
+	data = binary.LittleEndian.AppendUint16(data, crc16XModem(data))
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(data)
+}
+
+func crc16XModem(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+var base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+func encodeBase58(data []byte) string {
+	value := new(big.Int).SetBytes(data)
+	base := big.NewInt(58)
+	mod := new(big.Int)
+
+	var out []byte
+	for value.Sign() > 0 {
+		value.DivMod(value, base, mod)
+		out = append(out, base58Alphabet[mod.Int64()])
+	}
+	for _, b := range data {
+		if b != 0 {
+			break
+		}
+		out = append(out, base58Alphabet[0])
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out)
+}
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+func bech32Encode(hrp string, data []byte) string {
+	values := append(bech32HRPExpand(hrp), data...)
+	values = append(values, 0, 0, 0, 0, 0, 0)
+	polymod := bech32Polymod(values) ^ 1
+
+	var out strings.Builder
+	out.WriteString(hrp)
+	out.WriteByte('1')
+	for _, value := range data {
+		out.WriteByte(bech32Charset[value])
+	}
+	for i := 0; i < 6; i++ {
+		out.WriteByte(bech32Charset[(polymod>>uint(5*(5-i)))&31])
+	}
+	return out.String()
+}
+
+func bech32HRPExpand(hrp string) []byte {
+	out := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		out = append(out, hrp[i]>>5)
+	}
+	out = append(out, 0)
+	for i := 0; i < len(hrp); i++ {
+		out = append(out, hrp[i]&31)
+	}
+	return out
+}
+
+func bech32Polymod(values []byte) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, value := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(value)
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+// convertBits regroups 8-bit bytes into 5-bit values, zero-padding the last one.
+func convertBits(data []byte) []byte {
+	var acc, bits uint
+	out := make([]byte, 0, len(data)*8/5+1)
+	for _, value := range data {
+		acc = acc<<8 | uint(value)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			out = append(out, byte(acc>>bits&31))
+		}
+	}
+	if bits > 0 {
+		out = append(out, byte(acc<<(5-bits)&31))
+	}
+	return out
+}
@@ -0,0 +1,153 @@
+package hdwallet
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+// HardenedOffset is added to an index to select a hardened child.
+const HardenedOffset uint32 = 0x80000000
+
+var (
+	// ErrInvalidPath indicates a malformed derivation path.
+	ErrInvalidPath = errors.New("hdwallet: invalid derivation path")
+	// ErrInvalidSeed indicates a seed of the wrong size.
+	ErrInvalidSeed = errors.New("hdwallet: invalid seed")
+	// errInvalidChild is returned for the vanishingly rare indices BIP32 declares invalid.
+	errInvalidChild = errors.New("hdwallet: derived key is invalid; use the next index")
+)
+
+// curve selects the derivation scheme for a chain's keys.
+type curve int
+
+const (
+	curveSecp256k1 curve = iota
+	curveEd25519
+)
+
+// masterKeys are the HMAC keys BIP32 and SLIP-10 use to derive a master key from a seed.
+var masterKeys = map[curve][]byte{
+	curveSecp256k1: []byte("Bitcoin seed"),
+	curveEd25519:   []byte("ed25519 seed"),
+}
+
+// extendedKey is a private key and its chain code.
+type extendedKey struct {
+	curve     curve
+	key       []byte
+	chainCode []byte
+}
+
+// ParsePath parses a path such as m/44'/60'/0'/0/0 into child indices. Hardened indices may be
+// marked with ' or h.
+func ParsePath(path string) ([]uint32, error) {
+	segments := strings.Split(strings.TrimSpace(path), "/")
+	if len(segments) == 0 || segments[0] != "m" {
+		return nil, ErrInvalidPath
+	}
+
+	indices := make([]uint32, 0, len(segments)-1)
+	for _, segment := range segments[1:] {
+		hardened := strings.HasSuffix(segment, "'") || strings.HasSuffix(segment, "h")
+		if hardened {
+			segment = segment[:len(segment)-1]
+		}
+		index, err := strconv.ParseUint(segment, 10, 32)
+		if err != nil || uint32(index) >= HardenedOffset {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidPath, path)
+		}
+		if hardened {
+			index += uint64(HardenedOffset)
+		}
+		indices = append(indices, uint32(index))
+	}
+	return indices, nil
+}
+
+// deriveKey derives the private key at path from a BIP39 seed.
+func deriveKey(seed []byte, c curve, path string) (extendedKey, error) {
+	if len(seed) != SeedSize {
+		return extendedKey{}, ErrInvalidSeed
+	}
+	indices, err := ParsePath(path)
+	if err != nil {
+		return extendedKey{}, err
+	}
+
+	key, err := newMasterKey(seed, c)
+	if err != nil {
+		return extendedKey{}, err
+	}
+	for _, index := range indices {
+		if key, err = key.child(index); err != nil {
+			return extendedKey{}, err
+		}
+	}
+	return key, nil
+}
+
+// newMasterKey derives the BIP32 (secp256k1) or SLIP-10 (ed25519) master key from a seed of any
+// length; deriveKey restricts it to BIP39 seeds.
+func newMasterKey(seed []byte, c curve) (extendedKey, error) {
+	sum := hmacSHA512(masterKeys[c], seed)
+	key := extendedKey{curve: c, key: sum[:32], chainCode: sum[32:]}
+	if c == curveSecp256k1 && !validSecp256k1Scalar(key.key) {
+		return extendedKey{}, errInvalidChild
+	}
+	return key, nil
+}
+
+// child derives the private child key at index. ed25519 keys only have hardened children.
+func (k extendedKey) child(index uint32) (extendedKey, error) {
+	data := make([]byte, 0, 37)
+	switch {
+	case index >= HardenedOffset:
+		data = append(data, 0x00)
+		data = append(data, k.key...)
+	case k.curve == curveEd25519:
+		return extendedKey{}, fmt.Errorf("%w: ed25519 supports hardened indices only", ErrInvalidPath)
+	default:
+		data = append(data, secp256k1PublicKey(k.key).SerializeCompressed()...)
+	}
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	sum := hmacSHA512(k.chainCode, data)
+	if k.curve == curveEd25519 {
+		return extendedKey{curve: k.curve, key: sum[:32], chainCode: sum[32:]}, nil
+	}
+
+	var tweak, parent secp256k1.ModNScalar
+	if overflow := tweak.SetByteSlice(sum[:32]); overflow {
+		return extendedKey{}, errInvalidChild
+	}
+	parent.SetByteSlice(k.key)
+	if tweak.Add(&parent).IsZero() {
+		return extendedKey{}, errInvalidChild
+	}
+	childKey := tweak.Bytes()
+	return extendedKey{curve: k.curve, key: childKey[:], chainCode: sum[32:]}, nil
+}
+
+// secp256k1PublicKey returns the public key of a secp256k1 key.
+func (k extendedKey) secp256k1PublicKey() *secp256k1.PublicKey {
+	return secp256k1PublicKey(k.key)
+}
+
+// ed25519Key expands an ed25519 key's 32-byte seed into the full private key.
+func (k extendedKey) ed25519Key() ed25519.PrivateKey {
+	return ed25519.NewKeyFromSeed(k.key)
+}
+
+func hmacSHA512(key, data []byte) []byte {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,230 @@
+package hdwallet
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	"golang.org/x/crypto/ripemd160"
+)
+
+type bip32Chain struct {
+	path string
+	xpub string
+	xprv string
+}
+
+// Test vectors 1-3 from BIP32.
+func TestBIP32Vectors(t *testing.T) {
+	tests := []struct {
+		name   string
+		seed   string
+		chains []bip32Chain
+	}{
+		{
+			name: "vector 1",
+			seed: "000102030405060708090a0b0c0d0e0f",
+			chains: []bip32Chain{
+				{
+					path: "m",
+					xpub: "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8",
+					xprv: "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi",
+				},
+				{
+					path: "m/0'",
+					xpub: "xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw",
+					xprv: "xprv9uHRZZhk6KAJC1avXpDAp4MDc3sQKNxDiPvvkX8Br5ngLNv1TxvUxt4cV1rGL5hj6KCesnDYUhd7oWgT11eZG7XnxHrnYeSvkzY7d2bhkJ7",
+				},
+				{
+					path: "m/0'/1",
+					xpub: "xpub6ASuArnXKPbfEwhqN6e3mwBcDTgzisQN1wXN9BJcM47sSikHjJf3UFHKkNAWbWMiGj7Wf5uMash7SyYq527Hqck2AxYysAA7xmALppuCkwQ",
+					xprv: "xprv9wTYmMFdV23N2TdNG573QoEsfRrWKQgWeibmLntzniatZvR9BmLnvSxqu53Kw1UmYPxLgboyZQaXwTCg8MSY3H2EU4pWcQDnRnrVA1xe8fs",
+				},
+				{
+					path: "m/0'/1/2'",
+					xpub: "xpub6D4BDPcP2GT577Vvch3R8wDkScZWzQzMMUm3PWbmWvVJrZwQY4VUNgqFJPMM3No2dFDFGTsxxpG5uJh7n7epu4trkrX7x7DogT5Uv6fcLW5",
+					xprv: "xprv9z4pot5VBttmtdRTWfWQmoH1taj2axGVzFqSb8C9xaxKymcFzXBDptWmT7FwuEzG3ryjH4ktypQSAewRiNMjANTtpgP4mLTj34bhnZX7UiM",
+				},
+				{
+					path: "m/0'/1/2'/2",
+					xpub: "xpub6FHa3pjLCk84BayeJxFW2SP4XRrFd1JYnxeLeU8EqN3vDfZmbqBqaGJAyiLjTAwm6ZLRQUMv1ZACTj37sR62cfN7fe5JnJ7dh8zL4fiyLHV",
+					xprv: "xprvA2JDeKCSNNZky6uBCviVfJSKyQ1mDYahRjijr5idH2WwLsEd4Hsb2Tyh8RfQMuPh7f7RtyzTtdrbdqqsunu5Mm3wDvUAKRHSC34sJ7in334",
+				},
+				{
+					path: "m/0'/1/2'/2/1000000000",
+					xpub: "xpub6H1LXWLaKsWFhvm6RVpEL9P4KfRZSW7abD2ttkWP3SSQvnyA8FSVqNTEcYFgJS2UaFcxupHiYkro49S8yGasTvXEYBVPamhGW6cFJodrTHy",
+					xprv: "xprvA41z7zogVVwxVSgdKUHDy1SKmdb533PjDz7J6N6mV6uS3ze1ai8FHa8kmHScGpWmj4WggLyQjgPie1rFSruoUihUZREPSL39UNdE3BBDu76",
+				},
+			},
+		},
+		{
+			name: "vector 2",
+			seed: "fffcf9f6f3f0edeae7e4e1dedbd8d5d2cfccc9c6c3c0bdbab7b4b1aeaba8a5a29f9c999693908d8a8784817e7b7875726f6c696663605d5a5754514e4b484542",
+			chains: []bip32Chain{
+				{
+					path: "m",
+					xpub: "xpub661MyMwAqRbcFW31YEwpkMuc5THy2PSt5bDMsktWQcFF8syAmRUapSCGu8ED9W6oDMSgv6Zz8idoc4a6mr8BDzTJY47LJhkJ8UB7WEGuduB",
+					xprv: "xprv9s21ZrQH143K31xYSDQpPDxsXRTUcvj2iNHm5NUtrGiGG5e2DtALGdso3pGz6ssrdK4PFmM8NSpSBHNqPqm55Qn3LqFtT2emdEXVYsCzC2U",
+				},
+				{
+					path: "m/0",
+					xpub: "xpub69H7F5d8KSRgmmdJg2KhpAK8SR3DjMwAdkxj3ZuxV27CprR9LgpeyGmXUbC6wb7ERfvrnKZjXoUmmDznezpbZb7ap6r1D3tgFxHmwMkQTPH",
+					xprv: "xprv9vHkqa6EV4sPZHYqZznhT2NPtPCjKuDKGY38FBWLvgaDx45zo9WQRUT3dKYnjwih2yJD9mkrocEZXo1ex8G81dwSM1fwqWpWkeS3v86pgKt",
+				},
+				{
+					path: "m/0/2147483647'",
+					xpub: "xpub6ASAVgeehLbnwdqV6UKMHVzgqAG8Gr6riv3Fxxpj8ksbH9ebxaEyBLZ85ySDhKiLDBrQSARLq1uNRts8RuJiHjaDMBU4Zn9h8LZNnBC5y4a",
+					xprv: "xprv9wSp6B7kry3Vj9m1zSnLvN3xH8RdsPP1Mh7fAaR7aRLcQMKTR2vidYEeEg2mUCTAwCd6vnxVrcjfy2kRgVsFawNzmjuHc2YmYRmagcEPdU9",
+				},
+				{
+					path: "m/0/2147483647'/1",
+					xpub: "xpub6DF8uhdarytz3FWdA8TvFSvvAh8dP3283MY7p2V4SeE2wyWmG5mg5EwVvmdMVCQcoNJxGoWaU9DCWh89LojfZ537wTfunKau47EL2dhHKon",
+					xprv: "xprv9zFnWC6h2cLgpmSA46vutJzBcfJ8yaJGg8cX1e5StJh45BBciYTRXSd25UEPVuesF9yog62tGAQtHjXajPPdbRCHuWS6T8XA2ECKADdw4Ef",
+				},
+				{
+					path: "m/0/2147483647'/1/2147483646'",
+					xpub: "xpub6ERApfZwUNrhLCkDtcHTcxd75RbzS1ed54G1LkBUHQVHQKqhMkhgbmJbZRkrgZw4koxb5JaHWkY4ALHY2grBGRjaDMzQLcgJvLJuZZvRcEL",
+					xprv: "xprvA1RpRA33e1JQ7ifknakTFpgNXPmW2YvmhqLQYMmrj4xJXXWYpDPS3xz7iAxn8L39njGVyuoseXzU6rcxFLJ8HFsTjSyQbLYnMpCqE2VbFWc",
+				},
+				{
+					path: "m/0/2147483647'/1/2147483646'/2",
+					xpub: "xpub6FnCn6nSzZAw5Tw7cgR9bi15UV96gLZhjDstkXXxvCLsUXBGXPdSnLFbdpq8p9HmGsApME5hQTZ3emM2rnY5agb9rXpVGyy3bdW6EEgAtqt",
+					xprv: "xprvA2nrNbFZABcdryreWet9Ea4LvTJcGsqrMzxHx98MMrotbir7yrKCEXw7nadnHM8Dq38EGfSh6dqA9QWTyefMLEcBYJUuekgW4BYPJcr9E7j",
+				},
+			},
+		},
+		{
+			name: "vector 3",
+			seed: "4b381541583be4423346c643850da4b320e46a87ae3d2a4e6da11eba819cd4acba45d239319ac14f863b8d5ab5a0d0c64d2e8a1e7d1457df2e5a3c51c73235be",
+			chains: []bip32Chain{
+				{
+					path: "m",
+					xpub: "xpub661MyMwAqRbcEZVB4dScxMAdx6d4nFc9nvyvH3v4gJL378CSRZiYmhRoP7mBy6gSPSCYk6SzXPTf3ND1cZAceL7SfJ1Z3GC8vBgp2epUt13",
+					xprv: "xprv9s21ZrQH143K25QhxbucbDDuQ4naNntJRi4KUfWT7xo4EKsHt2QJDu7KXp1A3u7Bi1j8ph3EGsZ9Xvz9dGuVrtHHs7pXeTzjuxBrCmmhgC6",
+				},
+				{
+					path: "m/0'",
+					xpub: "xpub68NZiKmJWnxxS6aaHmn81bvJeTESw724CRDs6HbuccFQN9Ku14VQrADWgqbhhTHBaohPX4CjNLf9fq9MYo6oDaPPLPxSb7gwQN3ih19Zm4Y",
+					xprv: "xprv9uPDJpEQgRQfDcW7BkF7eTya6RPxXeJCqCJGHuCJ4GiRVLzkTXBAJMu2qaMWPrS7AANYqdq6vcBcBUdJCVVFceUvJFjaPdGZ2y9WACViL4L",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		seed, _ := hex.DecodeString(tt.seed)
+		for _, chain := range tt.chains {
+			t.Run(tt.name+" "+chain.path, func(t *testing.T) {
+				parent, key, index, depth := derivePath(t, seed, curveSecp256k1, chain.path)
+				if got := serializeExtendedKey(parent, key, index, depth, true); got != chain.xprv {
+					t.Errorf("xprv = %s, want %s", got, chain.xprv)
+				}
+				if got := serializeExtendedKey(parent, key, index, depth, false); got != chain.xpub {
+					t.Errorf("xpub = %s, want %s", got, chain.xpub)
+				}
+			})
+		}
+	}
+}
+
+// Test vector 1 for ed25519 from SLIP-10.
+func TestSLIP10Ed25519Vector1(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	tests := []struct {
+		path    string
+		private string
+		public  string
+	}{
+		{path: "m", private: "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7", public: "a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed"},
+		{path: "m/0'", private: "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3", public: "8c8a13df77a28f3445213a0f432fde644acaa215fc72dcdf300d5efaa85d350c"},
+		{path: "m/0'/1'", private: "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2", public: "1932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187"},
+		{path: "m/0'/1'/2'", private: "92a5b23c0b8a99e37d07df3fb9966917f5d06e02ddbd909c7e184371463e9fc9", public: "ae98736566d30ed0e9d2f4486a64bc95740d89c7db33f52121f8ea8f76ff0fc1"},
+		{path: "m/0'/1'/2'/2'", private: "30d1dc7e5fc04c31219ab25a27ae00b50f6fd66622f6e9c913253d6511d1e662", public: "8abae2d66361c879b900d204ad2cc4984fa2aa344dd7ddc46007329ac76c429c"},
+		{path: "m/0'/1'/2'/2'/1000000000'", private: "8f94d394a8e8fd6b1bc2f3f49f5c47e385281d5c17e65324b0f62483e37e8793", public: "3c24da049451555d51a7014a37337aa4e12d41e485abccfa46b47dfb2af54b7a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			_, key, _, _ := derivePath(t, seed, curveEd25519, tt.path)
+			if got := hex.EncodeToString(key.key); got != tt.private {
+				t.Errorf("private key = %s, want %s", got, tt.private)
+			}
+			if got := hex.EncodeToString(key.ed25519Key()[32:]); got != tt.public {
+				t.Errorf("public key = %s, want %s", got, tt.public)
+			}
+		})
+	}
+
+	if _, _, _, err := deriveFromMaster(seed, curveEd25519, "m/0"); err == nil {
+		t.Error("non-hardened ed25519 child derived without error")
+	}
+}
+
+func TestDeriveKeyRejectsNonBIP39Seed(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	if _, err := deriveKey(seed, curveSecp256k1, "m/0'"); err != ErrInvalidSeed {
+		t.Fatalf("deriveKey() error = %v, want ErrInvalidSeed", err)
+	}
+}
+
+// derivePath derives path from a seed of any length, returning the key's parent (the zero value
+// for the master key), the key, its child index and its depth.
+func derivePath(t *testing.T, seed []byte, c curve, path string) (parent, key extendedKey, index uint32, depth byte) {
+	t.Helper()
+	parent, key, indices, err := deriveFromMaster(seed, c, path)
+	if err != nil {
+		t.Fatalf("derive %s: %v", path, err)
+	}
+	if len(indices) > 0 {
+		index = indices[len(indices)-1]
+	}
+	return parent, key, index, byte(len(indices))
+}
+
+func deriveFromMaster(seed []byte, c curve, path string) (parent, key extendedKey, indices []uint32, err error) {
+	if indices, err = ParsePath(path); err != nil {
+		return
+	}
+	if key, err = newMasterKey(seed, c); err != nil {
+		return
+	}
+	for _, index := range indices {
+		parent = key
+		if key, err = key.child(index); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// serializeExtendedKey encodes a mainnet xprv or xpub.
+func serializeExtendedKey(parent, key extendedKey, index uint32, depth byte, private bool) string {
+	version := uint32(0x0488b21e)
+	if private {
+		version = 0x0488ade4
+	}
+	data := binary.BigEndian.AppendUint32(nil, version)
+	data = append(data, depth)
+
+	fingerprint := make([]byte, 4)
+	if parent.key != nil {
+		sha := sha256.Sum256(parent.secp256k1PublicKey().SerializeCompressed())
+		ripe := ripemd160.New()
+		ripe.Write(sha[:])
+		fingerprint = ripe.Sum(nil)[:4]
+	}
+	data = append(data, fingerprint...)
+	data = binary.BigEndian.AppendUint32(data, index)
+	data = append(data, key.chainCode...)
+	if private {
+		data = append(data, 0x00)
+		data = append(data, key.key...)
+	} else {
+		data = append(data, key.secp256k1PublicKey().SerializeCompressed()...)
+	}
+
+	first := sha256.Sum256(data)
+	second := sha256.Sum256(first[:])
+	return encodeBase58(append(data, second[:4]...))
+}
@@ -0,0 +1,146 @@
+// Package hdwallet implements hierarchical deterministic wallets: BIP39 mnemonics and seeds, BIP32
+// derivation over secp256k1 for Bitcoin and Ethereum, and SLIP-10 derivation over ed25519 for
+// Solana and Stellar.
+package hdwallet
+
+import (
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrInvalidMnemonic indicates a phrase that is not a valid English BIP39 mnemonic.
+	ErrInvalidMnemonic = errors.New("hdwallet: invalid mnemonic")
+	// ErrInvalidWordCount indicates an unsupported mnemonic length.
+	ErrInvalidWordCount = errors.New("hdwallet: mnemonic must have 12, 15, 18, 21 or 24 words")
+)
+
+// DefaultMnemonicWords is the length of generated mnemonics: 256 bits of entropy.
+const DefaultMnemonicWords = 24
+
+// SeedSize is the length in bytes of a BIP39 seed.
+const SeedSize = 64
+
+//go:embed wordlist_english.txt
+var englishWordlist string
+
+var (
+	englishWords = strings.Fields(englishWordlist)
+	englishIndex = func() map[string]int {
+		index := make(map[string]int, len(englishWords))
+		for i, word := range englishWords {
+			index[word] = i
+		}
+		return index
+	}()
+)
+
+// NewMnemonic returns a fresh English mnemonic of the given number of words.
+func NewMnemonic(words int) (string, error) {
+	if !validWordCount(words) {
+		return "", ErrInvalidWordCount
+	}
+	entropy := make([]byte, words*4/3)
+	if _, err := rand.Read(entropy); err != nil {
+		return "", fmt.Errorf("hdwallet: read entropy: %w", err)
+	}
+	return entropyToMnemonic(entropy), nil
+}
+
+// NormalizeMnemonic lower-cases the phrase and collapses whitespace between its words.
+func NormalizeMnemonic(mnemonic string) string {
+	return strings.Join(strings.Fields(strings.ToLower(mnemonic)), " ")
+}
+
+// ValidateMnemonic checks the phrase's length, words and checksum.
+func ValidateMnemonic(mnemonic string) error {
+	words := strings.Fields(NormalizeMnemonic(mnemonic))
+	if !validWordCount(len(words)) {
+		return ErrInvalidWordCount
+	}
+
+	// Each word carries 11 bits: the entropy followed by a checksum of entropy/32 bits.
+	bits := make([]byte, 0, len(words)*11)
+	for _, word := range words {
+		index, ok := englishIndex[word]
+		if !ok {
+			return ErrInvalidMnemonic
+		}
+		for shift := 10; shift >= 0; shift-- {
+			bits = append(bits, byte(index>>shift)&1)
+		}
+	}
+
+	checksumBits := len(words) / 3
+	entropy := packBits(bits[:len(bits)-checksumBits])
+	expected := sha256.Sum256(entropy)
+	for i := 0; i < checksumBits; i++ {
+		if bits[len(bits)-checksumBits+i] != (expected[i/8]>>(7-i%8))&1 {
+			return ErrInvalidMnemonic
+		}
+	}
+	return nil
+}
+
+// MnemonicToSeed validates the phrase and stretches it into the 64-byte BIP39 seed. Passphrases
+// are not supported, so the seed matches wallets that import the phrase without one.
+func MnemonicToSeed(mnemonic string) ([]byte, error) {
+	mnemonic = NormalizeMnemonic(mnemonic)
+	if err := ValidateMnemonic(mnemonic); err != nil {
+		return nil, err
+	}
+	return mnemonicSeed(mnemonic, "")
+}
+
+// mnemonicSeed stretches a normalised phrase with the BIP39 salt "mnemonic" + passphrase.
+func mnemonicSeed(mnemonic, passphrase string) ([]byte, error) {
+	return pbkdf2.Key(sha512.New, mnemonic, []byte("mnemonic"+passphrase), 2048, SeedSize)
+}
+
+func entropyToMnemonic(entropy []byte) string {
+	checksum := sha256.Sum256(entropy)
+	checksumBits := len(entropy) * 8 / 32
+
+	bits := make([]byte, 0, len(entropy)*8+checksumBits)
+	for _, b := range entropy {
+		for shift := 7; shift >= 0; shift-- {
+			bits = append(bits, (b>>shift)&1)
+		}
+	}
+	for i := 0; i < checksumBits; i++ {
+		bits = append(bits, (checksum[i/8]>>(7-i%8))&1)
+	}
+
+	words := make([]string, 0, len(bits)/11)
+	for i := 0; i < len(bits); i += 11 {
+		index := 0
+		for _, bit := range bits[i : i+11] {
+			index = index<<1 | int(bit)
+		}
+		words = append(words, englishWords[index])
+	}
+	return strings.Join(words, " ")
+}
+
+func packBits(bits []byte) []byte {
+	packed := make([]byte, len(bits)/8)
+	for i, bit := range bits {
+		packed[i/8] |= bit << (7 - i%8)
+	}
+	return packed
+}
+
+func validWordCount(words int) bool {
+	switch words {
+	case 12, 15, 18, 21, 24:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,19 @@
+package hdwallet
+
+import (
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+// secp256k1PublicKey returns the public key of a private scalar already checked with
+// validSecp256k1Scalar. Scalar multiplication runs in constant time, so the derivation does not
+// leak the key through timing.
+func secp256k1PublicKey(key []byte) *secp256k1.PublicKey {
+	return secp256k1.PrivKeyFromBytes(key).PubKey()
+}
+
+// validSecp256k1Scalar reports whether key is a usable private key, i.e. in [1, n).
+func validSecp256k1Scalar(key []byte) bool {
+	var scalar secp256k1.ModNScalar
+	overflow := scalar.SetByteSlice(key)
+	return !overflow && !scalar.IsZero()
+}
@@ -0,0 +1,266 @@
+// Package jobqueue runs background jobs from a Redis stream. Jobs are read through a consumer group,
+// so each is handled by one worker across instances and survives restarts until it is acknowledged.
+// A job whose handler fails stays pending and is claimed again once it has been idle for the retry
+// delay; after the last attempt it moves to a dead-letter stream for inspection.
+package jobqueue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	defaultGroup       = "workers"
+	defaultMaxAttempts = 5
+	defaultRetryDelay  = 30 * time.Second
+	defaultMaxLen      = 100000
+	readBatch          = 10
+	readBlock          = 5 * time.Second
+
+	payloadField = "payload"
+)
+
+// Job is one delivery of a queued job.
+type Job struct {
+	ID      string
+	Payload []byte
+	// Attempt is 1 on the first delivery.
+	Attempt int
+}
+
+// Handler processes a job. A returned error leaves the job to be retried unless it is permanent.
+type Handler func(ctx context.Context, job Job) error
+
+type permanentError struct{ err error }
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent marks a handler error that retrying cannot fix, such as a malformed payload. The job
+// is dead-lettered at once.
+func Permanent(err error) error {
+	return permanentError{err: err}
+}
+
+// RedisStreamQueueConfig configures a RedisStreamQueue. Stream is required.
+type RedisStreamQueueConfig struct {
+	Client *redis.Client
+	Stream string
+	// Group names the consumer group; instances in the same group share the jobs.
+	Group string
+	// Consumer identifies this instance within the group; it defaults to host name and pid.
+	Consumer string
+	// MaxAttempts is how many deliveries a job gets before it is dead-lettered.
+	MaxAttempts int
+	// RetryDelay is how long a failed or abandoned job waits before it is delivered again.
+	RetryDelay time.Duration
+	// MaxLen caps the stream length approximately; acknowledged entries are trimmed first.
+	MaxLen int64
+	Logger *slog.Logger
+}
+
+// RedisStreamQueue is a durable job queue on a Redis stream.
+type RedisStreamQueue struct {
+	client      *redis.Client
+	stream      string
+	deadLetter  string
+	group       string
+	consumer    string
+	maxAttempts int
+	retryDelay  time.Duration
+	maxLen      int64
+	logger      *slog.Logger
+}
+
+// NewRedisStreamQueue constructs a RedisStreamQueue. Dead-lettered jobs go to "<stream>:dead".
+func NewRedisStreamQueue(cfg RedisStreamQueueConfig) (*RedisStreamQueue, error) {
+	if cfg.Client == nil {
+		return nil, errors.New("jobqueue: redis client is required")
+	}
+	if strings.TrimSpace(cfg.Stream) == "" {
+		return nil, errors.New("jobqueue: stream is required")
+	}
+	q := &RedisStreamQueue{
+		client:      cfg.Client,
+		stream:      cfg.Stream,
+		deadLetter:  cfg.Stream + ":dead",
+		group:       cfg.Group,
+		consumer:    cfg.Consumer,
+		maxAttempts: cfg.MaxAttempts,
+		retryDelay:  cfg.RetryDelay,
+		maxLen:      cfg.MaxLen,
+		logger:      cfg.Logger,
+	}
+	if q.group == "" {
+		q.group = defaultGroup
+	}
+	if q.consumer == "" {
+		host, _ := os.Hostname()
+		q.consumer = fmt.Sprintf("%s-%d", host, os.Getpid())
+	}
+	if q.maxAttempts <= 0 {
+		q.maxAttempts = defaultMaxAttempts
+	}
+	if q.retryDelay <= 0 {
+		q.retryDelay = defaultRetryDelay
+	}
+	if q.maxLen <= 0 {
+		q.maxLen = defaultMaxLen
+	}
+	if q.logger == nil {
+		q.logger = slog.Default()
+	}
+	q.logger = q.logger.With(slog.String("stream", q.stream))
+	return q, nil
+}
+
+// Enqueue appends a job and returns its ID.
+func (q *RedisStreamQueue) Enqueue(ctx context.Context, payload []byte) (string, error) {
+	id, err := q.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: q.stream,
+		MaxLen: q.maxLen,
+		Approx: true,
+		Values: map[string]any{payloadField: payload},
+	}).Result()
+	if err != nil {
+		return "", fmt.Errorf("jobqueue: enqueue: %w", err)
+	}
+	return id, nil
+}
+
+// Consume hands jobs to handler until ctx is cancelled. Jobs left pending by failed attempts or by
+// instances that stopped mid-job are retried before new jobs are read.
+func (q *RedisStreamQueue) Consume(ctx context.Context, handler Handler) error {
+	if err := q.client.XGroupCreateMkStream(ctx, q.stream, q.group, "0").Err(); err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return fmt.Errorf("jobqueue: create consumer group: %w", err)
+	}
+
+	for ctx.Err() == nil {
+		if err := q.retryPending(ctx, handler); err != nil && ctx.Err() == nil {
+			q.logger.Error("failed to retry pending jobs", slog.String("error", err.Error()))
+		}
+
+		streams, err := q.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    q.group,
+			Consumer: q.consumer,
+			Streams:  []string{q.stream, ">"},
+			Count:    readBatch,
+			Block:    readBlock,
+		}).Result()
+		if err != nil {
+			if errors.Is(err, redis.Nil) || ctx.Err() != nil {
+				continue
+			}
+			q.logger.Error("failed to read jobs", slog.String("error", err.Error()))
+			select {
+			case <-ctx.Done():
+			case <-time.After(readBlock):
+			}
+			continue
+		}
+		for _, stream := range streams {
+			for _, message := range stream.Messages {
+				q.process(ctx, handler, message, 1)
+			}
+		}
+	}
+	return nil
+}
+
+// retryPending claims jobs that have been pending longer than the retry delay, dead-lettering
+// those that used up their attempts.
+func (q *RedisStreamQueue) retryPending(ctx context.Context, handler Handler) error {
+	pending, err := q.client.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: q.stream,
+		Group:  q.group,
+		Idle:   q.retryDelay,
+		Start:  "-",
+		End:    "+",
+		Count:  readBatch,
+	}).Result()
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range pending {
+		messages, err := q.client.XClaim(ctx, &redis.XClaimArgs{
+			Stream:   q.stream,
+			Group:    q.group,
+			Consumer: q.consumer,
+			MinIdle:  q.retryDelay,
+			Messages: []string{entry.ID},
+		}).Result()
+		if err != nil {
+			return err
+		}
+		for _, message := range messages {
+			// The claim counts as a delivery, so this is attempt RetryCount+1.
+			attempt := int(entry.RetryCount) + 1
+			if attempt > q.maxAttempts {
+				q.moveToDeadLetter(ctx, message, int(entry.RetryCount), "attempts exhausted")
+				continue
+			}
+			q.process(ctx, handler, message, attempt)
+		}
+	}
+	return nil
+}
+
+func (q *RedisStreamQueue) process(ctx context.Context, handler Handler, message redis.XMessage, attempt int) {
+	payload, _ := message.Values[payloadField].(string)
+	logger := q.logger.With(slog.String("job_id", message.ID), slog.Int("attempt", attempt))
+
+	err := handler(ctx, Job{ID: message.ID, Payload: []byte(payload), Attempt: attempt})
+	if err == nil {
+		if ackErr := q.client.XAck(ctx, q.stream, q.group, message.ID).Err(); ackErr != nil {
+			logger.Error("failed to acknowledge job", slog.String("error", ackErr.Error()))
+		}
+		return
+	}
+
+	var permanent permanentError
+	switch {
+	case errors.As(err, &permanent):
+		q.moveToDeadLetter(ctx, message, attempt, err.Error())
+	case attempt >= q.maxAttempts:
+		q.moveToDeadLetter(ctx, message, attempt, err.Error())
+	default:
+		logger.Warn("job failed; it will be retried", slog.Duration("retry_after", q.retryDelay), slog.String("error", err.Error()))
+	}
+}
+
+// moveToDeadLetter copies the job to the dead-letter stream and acknowledges it in one transaction.
+func (q *RedisStreamQueue) moveToDeadLetter(ctx context.Context, message redis.XMessage, attempts int, reason string) {
+	_, err := q.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream: q.deadLetter,
+			MaxLen: q.maxLen,
+			Approx: true,
+			Values: map[string]any{
+				payloadField: message.Values[payloadField],
+				"job_id":     message.ID,
+				"attempts":   attempts,
+				"error":      reason,
+				"failed_at":  time.Now().UTC().Format(time.RFC3339),
+			},
+		})
+		pipe.XAck(ctx, q.stream, q.group, message.ID)
+		return nil
+	})
+	if err != nil {
+		q.logger.Error("failed to dead-letter job", slog.String("job_id", message.ID), slog.String("error", err.Error()))
+		return
+	}
+	q.logger.Error("job dead-lettered",
+		slog.String("job_id", message.ID),
+		slog.Int("attempts", attempts),
+		slog.String("dead_letter_stream", q.deadLetter),
+		slog.String("error", reason))
+}
@@ -0,0 +1,69 @@
+package keybackup
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// approvalContext prefixes every signed approval so the signature cannot be replayed as anything
+// other than a key backup approval.
+const approvalContext = Format + " approval"
+
+// ApprovalMessage is what an approver signs: the request and the recipient key fingerprint they
+// checked against the offline key.
+func ApprovalMessage(requestID uuid.UUID, fingerprint string) []byte {
+	return []byte(approvalContext + "\n" + requestID.String() + "\n" + strings.ToLower(strings.TrimSpace(fingerprint)))
+}
+
+// SignApproval signs the approval of a request with an approver's private key.
+func SignApproval(key ed25519.PrivateKey, requestID uuid.UUID, fingerprint string) []byte {
+	return ed25519.Sign(key, ApprovalMessage(requestID, fingerprint))
+}
+
+// VerifyApproval reports whether signature is key's approval of the request.
+func VerifyApproval(key ed25519.PublicKey, requestID uuid.UUID, fingerprint string, signature []byte) bool {
+	if len(key) != ed25519.PublicKeySize || len(signature) != ed25519.SignatureSize {
+		return false
+	}
+	return ed25519.Verify(key, ApprovalMessage(requestID, fingerprint), signature)
+}
+
+// ParseApproverPublicKey parses a PEM "PUBLIC KEY" block holding an approver's Ed25519 key.
+func ParseApproverPublicKey(pemBytes []byte) (ed25519.PublicKey, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil || block.Type != "PUBLIC KEY" {
+		return nil, errors.New("keybackup: approver key must be a PEM \"PUBLIC KEY\" block")
+	}
+	parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("keybackup: parse approver key: %w", err)
+	}
+	key, ok := parsed.(ed25519.PublicKey)
+	if !ok {
+		return nil, errors.New("keybackup: approver key must be an Ed25519 key")
+	}
+	return key, nil
+}
+
+// ParseApproverPrivateKey parses a PEM PKCS#8 Ed25519 private key.
+func ParseApproverPrivateKey(pemBytes []byte) (ed25519.PrivateKey, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil || block.Type != "PRIVATE KEY" {
+		return nil, errors.New("keybackup: approver private key must be a PEM \"PRIVATE KEY\" block")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("keybackup: parse approver private key: %w", err)
+	}
+	key, ok := parsed.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errors.New("keybackup: approver private key must be an Ed25519 key")
+	}
+	return key, nil
+}
@@ -0,0 +1,570 @@
+// Package keybackup reads and writes disaster-recovery archives of wallet key material.
+//
+// An archive is a JSON header line, a sequence of length-prefixed AES-256-GCM segments, a zero
+// length terminator and a JSON trailer line. The segment key is random per archive and wrapped
+// with RSA-OAEP-SHA256 for an offline recipient key. Segment nonces are a random prefix, a segment
+// counter and a final-segment flag, and every segment authenticates the header line, so segments
+// cannot be reordered, dropped, truncated or moved to another archive. The last segment holds a
+// manifest of the records. The trailer carries a digest of the segments so integrity can be
+// checked without the private key.
+package keybackup
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// Format identifies the archive layout; it is also the RSA-OAEP label of the wrapped key.
+const Format = "wallet-key-backup/v1"
+
+// MinRSAKeyBits is the smallest recipient key accepted.
+const MinRSAKeyBits = 3072
+
+const (
+	segmentSize     = 64 << 10
+	maxFrameSize    = 4 << 20
+	maxHeaderSize   = 64 << 10
+	noncePrefixSize = 7
+)
+
+// ErrCorrupt is returned when an archive fails an integrity check.
+var ErrCorrupt = errors.New("keybackup: archive is corrupt or was modified")
+
+// Header is the first line of an archive.
+type Header struct {
+	Format               string    `json:"format"`
+	RequestID            uuid.UUID `json:"request_id"`
+	CreatedAt            time.Time `json:"created_at"`
+	RecipientFingerprint string    `json:"recipient_fingerprint"`
+	WrappedKey           string    `json:"wrapped_key"`
+	NoncePrefix          string    `json:"nonce_prefix"`
+}
+
+// trailer is the last line of an archive.
+type trailer struct {
+	Segments   int    `json:"segments"`
+	BodySHA256 string `json:"body_sha256"`
+}
+
+// manifest is the plaintext of the final segment.
+type manifest struct {
+	WalletCount   int    `json:"wallet_count"`
+	RecordsSHA256 string `json:"records_sha256"`
+}
+
+// Summary describes a written archive.
+type Summary struct {
+	WalletCount   int
+	Segments      int
+	BodySHA256    string
+	ArchiveSHA256 string
+}
+
+// Writer streams wallet key records into an archive.
+type Writer struct {
+	out         io.Writer
+	archiveHash hash.Hash
+	bodyHash    hash.Hash
+	recordsHash hash.Hash
+	aead        cipher.AEAD
+	noncePrefix []byte
+	aad         []byte
+	buf         bytes.Buffer
+	segments    uint32
+	count       int
+	closed      bool
+}
+
+// NewWriter writes the archive header for the recipient and returns a Writer for the records.
+func NewWriter(w io.Writer, recipient *rsa.PublicKey, requestID uuid.UUID, createdAt time.Time) (*Writer, error) {
+	if err := checkRecipient(recipient); err != nil {
+		return nil, err
+	}
+
+	dataKey := make([]byte, 32)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	noncePrefix := make([]byte, noncePrefixSize)
+	if _, err := rand.Read(noncePrefix); err != nil {
+		return nil, err
+	}
+	wrapped, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, recipient, dataKey, []byte(Format))
+	if err != nil {
+		return nil, fmt.Errorf("keybackup: wrap data key: %w", err)
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprint, err := Fingerprint(recipient)
+	if err != nil {
+		return nil, err
+	}
+	headerLine, err := json.Marshal(Header{
+		Format:               Format,
+		RequestID:            requestID,
+		CreatedAt:            createdAt.UTC(),
+		RecipientFingerprint: fingerprint,
+		WrappedKey:           base64.StdEncoding.EncodeToString(wrapped),
+		NoncePrefix:          base64.StdEncoding.EncodeToString(noncePrefix),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	writer := &Writer{
+		archiveHash: sha256.New(),
+		bodyHash:    sha256.New(),
+		recordsHash: sha256.New(),
+		aead:        aead,
+		noncePrefix: noncePrefix,
+		aad:         headerLine,
+	}
+	writer.out = io.MultiWriter(w, writer.archiveHash)
+	if _, err := writer.out.Write(append(headerLine, '\n')); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+// Add appends one wallet's key material.
+func (w *Writer) Add(record entities.WalletKeyRecord) error {
+	if w.closed {
+		return errors.New("keybackup: writer is closed")
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+	w.recordsHash.Write(line)
+	w.buf.Write(line)
+	w.count++
+	if w.buf.Len() >= segmentSize {
+		return w.flush(false)
+	}
+	return nil
+}
+
+// Close writes the remaining records, the manifest and the trailer. It does not close the
+// underlying writer.
+func (w *Writer) Close() (Summary, error) {
+	if w.closed {
+		return Summary{}, errors.New("keybackup: writer is closed")
+	}
+	if w.buf.Len() > 0 {
+		if err := w.flush(false); err != nil {
+			return Summary{}, err
+		}
+	}
+	w.closed = true
+
+	manifestLine, err := json.Marshal(manifest{
+		WalletCount:   w.count,
+		RecordsSHA256: hex.EncodeToString(w.recordsHash.Sum(nil)),
+	})
+	if err != nil {
+		return Summary{}, err
+	}
+	w.buf.Write(manifestLine)
+	if err := w.flush(true); err != nil {
+		return Summary{}, err
+	}
+
+	if _, err := w.out.Write([]byte{0, 0, 0, 0}); err != nil {
+		return Summary{}, err
+	}
+	bodySHA256 := hex.EncodeToString(w.bodyHash.Sum(nil))
+	trailerLine, err := json.Marshal(trailer{Segments: int(w.segments), BodySHA256: bodySHA256})
+	if err != nil {
+		return Summary{}, err
+	}
+	if _, err := w.out.Write(append(trailerLine, '\n')); err != nil {
+		return Summary{}, err
+	}
+
+	return Summary{
+		WalletCount:   w.count,
+		Segments:      int(w.segments),
+		BodySHA256:    bodySHA256,
+		ArchiveSHA256: hex.EncodeToString(w.archiveHash.Sum(nil)),
+	}, nil
+}
+
+func (w *Writer) flush(final bool) error {
+	sealed := w.aead.Seal(nil, segmentNonce(w.noncePrefix, w.segments, final), w.buf.Bytes(), w.aad)
+	w.buf.Reset()
+	w.segments++
+
+	frame := make([]byte, 4, 4+len(sealed))
+	binary.BigEndian.PutUint32(frame, uint32(len(sealed)))
+	frame = append(frame, sealed...)
+	w.bodyHash.Write(frame)
+	_, err := w.out.Write(frame)
+	return err
+}
+
+// VerifyOptions selects the optional checks of Verify.
+type VerifyOptions struct {
+	// Recipient, when set, must be the key the archive was wrapped for.
+	Recipient *rsa.PublicKey
+	// PrivateKey, when set, is used to decrypt and check every segment and the manifest. Record
+	// contents are never returned.
+	PrivateKey *rsa.PrivateKey
+}
+
+// Report describes a verified archive.
+type Report struct {
+	Format               string         `json:"format"`
+	RequestID            uuid.UUID      `json:"request_id"`
+	CreatedAt            time.Time      `json:"created_at"`
+	RecipientFingerprint string         `json:"recipient_fingerprint"`
+	Segments             int            `json:"segments"`
+	BodySHA256           string         `json:"body_sha256"`
+	ArchiveSHA256        string         `json:"archive_sha256"`
+	Decrypted            bool           `json:"decrypted"`
+	WalletCount          int            `json:"wallet_count,omitempty"`
+	Chains               map[string]int `json:"chains,omitempty"`
+}
+
+// Verify checks an archive's structure and segment digest and, with a private key, decrypts it to
+// check every segment and the manifest.
+func Verify(r io.Reader, opts VerifyOptions) (Report, error) {
+	archiveHash := sha256.New()
+	reader := bufio.NewReader(io.TeeReader(r, archiveHash))
+
+	headerLine, err := readLine(reader, maxHeaderSize)
+	if err != nil {
+		return Report{}, err
+	}
+	var header Header
+	if err := json.Unmarshal(headerLine, &header); err != nil {
+		return Report{}, fmt.Errorf("%w: unreadable header", ErrCorrupt)
+	}
+	if header.Format != Format {
+		return Report{}, fmt.Errorf("keybackup: unsupported archive format %q", header.Format)
+	}
+	report := Report{
+		Format:               header.Format,
+		RequestID:            header.RequestID,
+		CreatedAt:            header.CreatedAt,
+		RecipientFingerprint: header.RecipientFingerprint,
+	}
+
+	if opts.Recipient != nil {
+		if err := matchFingerprint(opts.Recipient, header.RecipientFingerprint); err != nil {
+			return report, err
+		}
+	}
+
+	var opener *segmentOpener
+	if opts.PrivateKey != nil {
+		if err := matchFingerprint(&opts.PrivateKey.PublicKey, header.RecipientFingerprint); err != nil {
+			return report, err
+		}
+		opener, err = newSegmentOpener(header, headerLine, opts.PrivateKey)
+		if err != nil {
+			return report, err
+		}
+	}
+
+	bodyHash := sha256.New()
+	var pending []byte
+	segments := 0
+	for {
+		sealed, err := readFrame(reader, bodyHash)
+		if err != nil {
+			return report, err
+		}
+		if sealed == nil {
+			break
+		}
+		if pending != nil && opener != nil {
+			if err := opener.open(pending, false); err != nil {
+				return report, err
+			}
+		}
+		pending = sealed
+		segments++
+	}
+	if pending == nil {
+		return report, fmt.Errorf("%w: no segments", ErrCorrupt)
+	}
+	if opener != nil {
+		if err := opener.open(pending, true); err != nil {
+			return report, err
+		}
+	}
+
+	trailerLine, err := readLine(reader, maxHeaderSize)
+	if err != nil {
+		return report, err
+	}
+	var tail trailer
+	if err := json.Unmarshal(trailerLine, &tail); err != nil {
+		return report, fmt.Errorf("%w: unreadable trailer", ErrCorrupt)
+	}
+	if _, err := reader.ReadByte(); err != io.EOF {
+		return report, fmt.Errorf("%w: data after trailer", ErrCorrupt)
+	}
+
+	report.Segments = segments
+	report.BodySHA256 = hex.EncodeToString(bodyHash.Sum(nil))
+	report.ArchiveSHA256 = hex.EncodeToString(archiveHash.Sum(nil))
+	if tail.Segments != segments || tail.BodySHA256 != report.BodySHA256 {
+		return report, fmt.Errorf("%w: segment digest does not match trailer", ErrCorrupt)
+	}
+
+	if opener != nil {
+		report.Decrypted = true
+		report.WalletCount = opener.count
+		report.Chains = opener.chains
+	}
+	return report, nil
+}
+
+// segmentOpener decrypts segments in order and checks the records against the manifest.
+type segmentOpener struct {
+	aead        cipher.AEAD
+	noncePrefix []byte
+	aad         []byte
+	index       uint32
+	recordsHash hash.Hash
+	partial     []byte
+	count       int
+	chains      map[string]int
+}
+
+func newSegmentOpener(header Header, headerLine []byte, key *rsa.PrivateKey) (*segmentOpener, error) {
+	wrapped, err := base64.StdEncoding.DecodeString(header.WrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: wrapped key", ErrCorrupt)
+	}
+	noncePrefix, err := base64.StdEncoding.DecodeString(header.NoncePrefix)
+	if err != nil || len(noncePrefix) != noncePrefixSize {
+		return nil, fmt.Errorf("%w: nonce prefix", ErrCorrupt)
+	}
+	dataKey, err := rsa.DecryptOAEP(sha256.New(), nil, key, wrapped, []byte(Format))
+	if err != nil {
+		return nil, fmt.Errorf("%w: data key cannot be unwrapped with this private key", ErrCorrupt)
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return &segmentOpener{
+		aead:        aead,
+		noncePrefix: noncePrefix,
+		aad:         headerLine,
+		recordsHash: sha256.New(),
+		chains:      make(map[string]int),
+	}, nil
+}
+
+func (o *segmentOpener) open(sealed []byte, final bool) error {
+	plain, err := o.aead.Open(nil, segmentNonce(o.noncePrefix, o.index, final), sealed, o.aad)
+	if err != nil {
+		return fmt.Errorf("%w: segment %d failed authentication", ErrCorrupt, o.index)
+	}
+	o.index++
+
+	if final {
+		if len(o.partial) > 0 {
+			return fmt.Errorf("%w: incomplete record", ErrCorrupt)
+		}
+		var m manifest
+		if err := json.Unmarshal(plain, &m); err != nil {
+			return fmt.Errorf("%w: unreadable manifest", ErrCorrupt)
+		}
+		if m.WalletCount != o.count || m.RecordsSHA256 != hex.EncodeToString(o.recordsHash.Sum(nil)) {
+			return fmt.Errorf("%w: records do not match manifest", ErrCorrupt)
+		}
+		return nil
+	}
+
+	o.recordsHash.Write(plain)
+	data := append(o.partial, plain...)
+	for {
+		line, rest, found := bytes.Cut(data, []byte{'\n'})
+		if !found {
+			o.partial = append(o.partial[:0:0], data...)
+			return nil
+		}
+		var record entities.WalletKeyRecord
+		if err := json.Unmarshal(line, &record); err != nil || record.WalletID == uuid.Nil || (record.EncryptedPrivateKey == "" && record.EncryptedSeed == "") {
+			return fmt.Errorf("%w: unreadable record %d", ErrCorrupt, o.count+1)
+		}
+		o.count++
+		o.chains[string(record.Chain)]++
+		data = rest
+	}
+}
+
+// ChainNames returns the chains of a report in a stable order.
+func (r Report) ChainNames() []string {
+	names := make([]string, 0, len(r.Chains))
+	for name := range r.Chains {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParsePublicKey parses a PEM "PUBLIC KEY" block holding an RSA key of at least MinRSAKeyBits.
+func ParsePublicKey(pemBytes []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil || block.Type != "PUBLIC KEY" {
+		return nil, errors.New("keybackup: recipient key must be a PEM \"PUBLIC KEY\" block")
+	}
+	parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("keybackup: parse recipient key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("keybackup: recipient key must be an RSA key")
+	}
+	if err := checkRecipient(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// ParsePrivateKey parses a PEM PKCS#8 or PKCS#1 RSA private key.
+func ParsePrivateKey(pemBytes []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, errors.New("keybackup: private key must be PEM encoded")
+	}
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("keybackup: parse private key: %w", err)
+		}
+		key, ok := parsed.(*rsa.PrivateKey)
+		if !ok {
+			return nil, errors.New("keybackup: private key must be an RSA key")
+		}
+		return key, nil
+	case "RSA PRIVATE KEY":
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("keybackup: parse private key: %w", err)
+		}
+		return key, nil
+	default:
+		return nil, fmt.Errorf("keybackup: unsupported private key block %q", block.Type)
+	}
+}
+
+// Fingerprint returns the hex SHA-256 of the key's DER SubjectPublicKeyInfo.
+func Fingerprint(key *rsa.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func checkRecipient(key *rsa.PublicKey) error {
+	if key == nil {
+		return errors.New("keybackup: recipient key is required")
+	}
+	if key.N.BitLen() < MinRSAKeyBits {
+		return fmt.Errorf("keybackup: recipient key must be at least %d bits", MinRSAKeyBits)
+	}
+	return nil
+}
+
+func matchFingerprint(key *rsa.PublicKey, expected string) error {
+	fingerprint, err := Fingerprint(key)
+	if err != nil {
+		return err
+	}
+	if fingerprint != expected {
+		return fmt.Errorf("keybackup: archive was wrapped for key %s, not %s", expected, fingerprint)
+	}
+	return nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func segmentNonce(prefix []byte, index uint32, final bool) []byte {
+	nonce := make([]byte, 0, noncePrefixSize+5)
+	nonce = append(nonce, prefix...)
+	nonce = binary.BigEndian.AppendUint32(nonce, index)
+	if final {
+		return append(nonce, 1)
+	}
+	return append(nonce, 0)
+}
+
+func readLine(r *bufio.Reader, limit int) ([]byte, error) {
+	var line []byte
+	for {
+		chunk, err := r.ReadSlice('\n')
+		line = append(line, chunk...)
+		if len(line) > limit {
+			return nil, fmt.Errorf("%w: line too long", ErrCorrupt)
+		}
+		if err == nil {
+			return line[:len(line)-1], nil
+		}
+		if err != bufio.ErrBufferFull {
+			return nil, fmt.Errorf("%w: truncated", ErrCorrupt)
+		}
+	}
+}
+
+// readFrame returns the next sealed segment, or nil at the terminator. Frames are added to digest.
+func readFrame(r io.Reader, digest hash.Hash) ([]byte, error) {
+	var length [4]byte
+	if _, err := io.ReadFull(r, length[:]); err != nil {
+		return nil, fmt.Errorf("%w: truncated", ErrCorrupt)
+	}
+	size := binary.BigEndian.Uint32(length[:])
+	if size == 0 {
+		return nil, nil
+	}
+	if size > maxFrameSize {
+		return nil, fmt.Errorf("%w: oversized segment", ErrCorrupt)
+	}
+	sealed := make([]byte, size)
+	if _, err := io.ReadFull(r, sealed); err != nil {
+		return nil, fmt.Errorf("%w: truncated", ErrCorrupt)
+	}
+	digest.Write(length[:])
+	digest.Write(sealed)
+	return sealed, nil
+}
@@ -15,6 +15,10 @@ type Config struct {
 	Format    string
 	AddSource bool
 	Output    io.Writer
+	// Environment selects the default redaction policy (see PolicyForEnvironment).
+	Environment string
+	// Redaction overrides the environment's mode for PII and financial fields when set.
+	Redaction string
 }
 
 // NewLogger constructs a slog.Logger based on the provided configuration.
@@ -52,7 +56,16 @@ func NewLogger(cfg Config) (*slog.Logger, error) {
 		return nil, errors.New("logging: unsupported format (expected json or text)")
 	}
 
-	return slog.New(handler), nil
+	policy := PolicyForEnvironment(cfg.Environment)
+	if strings.TrimSpace(cfg.Redaction) != "" {
+		mode, err := ParseRedactionMode(cfg.Redaction)
+		if err != nil {
+			return nil, err
+		}
+		policy = RedactionPolicy{PII: mode, Financial: mode}
+	}
+
+	return slog.New(NewRedactingHandler(handler, policy)), nil
 }
 
 // WithComponent attaches a component attribute to the supplied logger.
@@ -0,0 +1,260 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"unicode"
+)
+
+// redactedValue replaces fully redacted attribute values.
+const redactedValue = "[REDACTED]"
+
+// FieldClass classifies log attributes by the kind of sensitive data they carry.
+type FieldClass int
+
+const (
+	// FieldClassNone marks attributes that are logged as-is.
+	FieldClassNone FieldClass = iota
+	// FieldClassPII marks data identifying a person: emails, chain addresses, IPs, names.
+	FieldClassPII
+	// FieldClassSecret marks credentials and key material.
+	FieldClassSecret
+	// FieldClassFinancial marks amounts and balances.
+	FieldClassFinancial
+)
+
+// RedactionMode controls how much of a classified value reaches the log.
+type RedactionMode string
+
+const (
+	// RedactionNone logs the value unchanged.
+	RedactionNone RedactionMode = "none"
+	// RedactionPartial keeps enough of the value to correlate log lines (the ends of an address,
+	// the first character and domain of an email, the order of magnitude of an amount).
+	RedactionPartial RedactionMode = "partial"
+	// RedactionFull replaces the value with [REDACTED].
+	RedactionFull RedactionMode = "full"
+)
+
+// ParseRedactionMode parses none, partial or full.
+func ParseRedactionMode(value string) (RedactionMode, error) {
+	switch RedactionMode(strings.ToLower(strings.TrimSpace(value))) {
+	case RedactionNone:
+		return RedactionNone, nil
+	case RedactionPartial:
+		return RedactionPartial, nil
+	case RedactionFull:
+		return RedactionFull, nil
+	default:
+		return "", errors.New("logging: invalid redaction mode (none, partial, full)")
+	}
+}
+
+// RedactionPolicy sets the redaction mode per field class. Secrets are always fully redacted.
+type RedactionPolicy struct {
+	PII       RedactionMode
+	Financial RedactionMode
+}
+
+// PolicyForEnvironment returns the default policy of an environment: full redaction in
+// production, partial in staging and none elsewhere.
+func PolicyForEnvironment(environment string) RedactionPolicy {
+	switch strings.ToLower(strings.TrimSpace(environment)) {
+	case "production", "prod":
+		return RedactionPolicy{PII: RedactionFull, Financial: RedactionFull}
+	case "staging", "stage":
+		return RedactionPolicy{PII: RedactionPartial, Financial: RedactionPartial}
+	default:
+		return RedactionPolicy{PII: RedactionNone, Financial: RedactionNone}
+	}
+}
+
+// fieldClasses classifies attribute keys exactly; fieldSuffixes catches derived keys such as
+// from_address or fee_amount.
+var (
+	fieldClasses = map[string]FieldClass{
+		"email":         FieldClassPII,
+		"address":       FieldClassPII,
+		"recipient":     FieldClassPII,
+		"ip":            FieldClassPII,
+		"phone":         FieldClassPII,
+		"full_name":     FieldClassPII,
+		"first_name":    FieldClassPII,
+		"last_name":     FieldClassPII,
+		"date_of_birth": FieldClassPII,
+		"handle":        FieldClassPII,
+		"user_agent":    FieldClassPII,
+
+		"password":      FieldClassSecret,
+		"token":         FieldClassSecret,
+		"secret":        FieldClassSecret,
+		"authorization": FieldClassSecret,
+		"cookie":        FieldClassSecret,
+		"api_key":       FieldClassSecret,
+		"private_key":   FieldClassSecret,
+		"mnemonic":      FieldClassSecret,
+		"seed":          FieldClassSecret,
+		"otp":           FieldClassSecret,
+
+		"amount":     FieldClassFinancial,
+		"balance":    FieldClassFinancial,
+		"fee":        FieldClassFinancial,
+		"difference": FieldClassFinancial,
+		"magnitude":  FieldClassFinancial,
+	}
+	fieldSuffixes = []struct {
+		suffix string
+		class  FieldClass
+	}{
+		{"_email", FieldClassPII},
+		{"_address", FieldClassPII},
+		{"_ip", FieldClassPII},
+		{"_phone", FieldClassPII},
+		{"_handle", FieldClassPII},
+		{"_password", FieldClassSecret},
+		{"_token", FieldClassSecret},
+		{"_secret", FieldClassSecret},
+		{"_private_key", FieldClassSecret},
+		{"_api_key", FieldClassSecret},
+		{"_amount", FieldClassFinancial},
+		{"_balance", FieldClassFinancial},
+		{"_usd", FieldClassFinancial},
+	}
+)
+
+// ClassifyField returns the class of a log attribute key.
+func ClassifyField(key string) FieldClass {
+	key = strings.ToLower(strings.TrimSpace(key))
+	if class, ok := fieldClasses[key]; ok {
+		return class
+	}
+	for _, rule := range fieldSuffixes {
+		if strings.HasSuffix(key, rule.suffix) {
+			return rule.class
+		}
+	}
+	return FieldClassNone
+}
+
+func (p RedactionPolicy) mode(class FieldClass) RedactionMode {
+	switch class {
+	case FieldClassSecret:
+		return RedactionFull
+	case FieldClassPII:
+		return p.PII
+	case FieldClassFinancial:
+		return p.Financial
+	default:
+		return RedactionNone
+	}
+}
+
+func (p RedactionPolicy) redactAttr(attr slog.Attr) slog.Attr {
+	attr.Value = attr.Value.Resolve()
+	if attr.Value.Kind() == slog.KindGroup {
+		members := attr.Value.Group()
+		redacted := make([]slog.Attr, 0, len(members))
+		for _, member := range members {
+			redacted = append(redacted, p.redactAttr(member))
+		}
+		attr.Value = slog.GroupValue(redacted...)
+		return attr
+	}
+
+	class := ClassifyField(attr.Key)
+	switch p.mode(class) {
+	case RedactionFull:
+		attr.Value = slog.StringValue(redactedValue)
+	case RedactionPartial:
+		if class == FieldClassFinancial {
+			attr.Value = slog.StringValue(maskAmount(attr.Value.String()))
+		} else {
+			attr.Value = slog.StringValue(maskIdentifier(attr.Value.String()))
+		}
+	}
+	return attr
+}
+
+// maskIdentifier keeps the first character and domain of an email, or the first and last four
+// characters of longer values such as addresses.
+func maskIdentifier(value string) string {
+	if local, domain, ok := strings.Cut(value, "@"); ok && local != "" {
+		return local[:1] + "***@" + domain
+	}
+	if len(value) <= 8 {
+		if value == "" {
+			return value
+		}
+		return value[:1] + "***"
+	}
+	return value[:4] + "..." + value[len(value)-4:]
+}
+
+// maskAmount keeps the sign, the first significant digit and the layout of an amount so its order
+// of magnitude survives: 1234.56 becomes 1***.**.
+func maskAmount(value string) string {
+	var (
+		builder     strings.Builder
+		significant bool
+	)
+	for _, r := range value {
+		if !unicode.IsDigit(r) {
+			builder.WriteRune(r)
+			continue
+		}
+		if !significant && r != '0' {
+			significant = true
+			builder.WriteRune(r)
+			continue
+		}
+		if significant {
+			builder.WriteRune('*')
+		} else {
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
+
+// RedactingHandler applies a RedactionPolicy to every attribute before passing records on, including
+// attributes bound earlier with Logger.With, so every component logger inherits the policy.
+type RedactingHandler struct {
+	next   slog.Handler
+	policy RedactionPolicy
+}
+
+// NewRedactingHandler wraps next with the policy.
+func NewRedactingHandler(next slog.Handler, policy RedactionPolicy) *RedactingHandler {
+	return &RedactingHandler{next: next, policy: policy}
+}
+
+// Enabled implements slog.Handler.
+func (h *RedactingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler.
+func (h *RedactingHandler) Handle(ctx context.Context, record slog.Record) error {
+	redacted := slog.NewRecord(record.Time, record.Level, record.Message, record.PC)
+	record.Attrs(func(attr slog.Attr) bool {
+		redacted.AddAttrs(h.policy.redactAttr(attr))
+		return true
+	})
+	return h.next.Handle(ctx, redacted)
+}
+
+// WithAttrs implements slog.Handler.
+func (h *RedactingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, 0, len(attrs))
+	for _, attr := range attrs {
+		redacted = append(redacted, h.policy.redactAttr(attr))
+	}
+	return &RedactingHandler{next: h.next.WithAttrs(redacted), policy: h.policy}
+}
+
+// WithGroup implements slog.Handler.
+func (h *RedactingHandler) WithGroup(name string) slog.Handler {
+	return &RedactingHandler{next: h.next.WithGroup(name), policy: h.policy}
+}
@@ -0,0 +1,68 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// KafkaTransportNative produces straight to the brokers with the native client (default).
+	KafkaTransportNative = "native"
+	// KafkaTransportREST produces through a Confluent REST Proxy, for clusters that expose one.
+	KafkaTransportREST = "rest"
+)
+
+// ErrKafkaUnavailable indicates the brokers (or REST proxy) could not be reached or refused the request.
+var ErrKafkaUnavailable = errors.New("kafka: broker unavailable")
+
+// KafkaRecord is one message produced to a topic. Value is encoded as JSON.
+type KafkaRecord struct {
+	Key   string      `json:"key,omitempty"`
+	Value interface{} `json:"value"`
+}
+
+// KafkaProducer produces records to Kafka topics. KafkaClient and KafkaRESTClient satisfy it; the
+// channel publisher and the event export sink share it so both follow the same transport setting.
+type KafkaProducer interface {
+	// Produce writes records to topic and returns once the cluster has acknowledged them.
+	// Failures reaching the cluster wrap ErrKafkaUnavailable.
+	Produce(ctx context.Context, topic string, records []KafkaRecord) error
+	// Ping checks that the cluster is reachable.
+	Ping(ctx context.Context) error
+	Close() error
+}
+
+// KafkaConfig selects and configures a KafkaProducer.
+type KafkaConfig struct {
+	// Transport is native (default) or rest.
+	Transport string
+	// Brokers are the seed brokers for the native transport.
+	Brokers  []string
+	ClientID string
+	// SASLMechanism is plain, scram-sha-256, scram-sha-512 or empty for none; it uses Username and Password.
+	SASLMechanism string
+	Username      string
+	Password      string
+	TLS           bool
+	// REST configures the REST proxy transport. Username and Password are used when REST leaves them empty.
+	REST KafkaRESTConfig
+}
+
+// NewKafkaProducer builds the producer for cfg.Transport.
+func NewKafkaProducer(cfg KafkaConfig) (KafkaProducer, error) {
+	switch strings.ToLower(strings.TrimSpace(cfg.Transport)) {
+	case "", KafkaTransportNative:
+		return NewKafkaClient(cfg)
+	case KafkaTransportREST:
+		rest := cfg.REST
+		if rest.Username == "" {
+			rest.Username = cfg.Username
+			rest.Password = cfg.Password
+		}
+		return NewKafkaRESTClient(rest)
+	default:
+		return nil, fmt.Errorf("kafka: unsupported transport %q", cfg.Transport)
+	}
+}
@@ -0,0 +1,121 @@
+package messaging
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+)
+
+const (
+	defaultKafkaClientID = "crypto-wallet-backend"
+	// defaultKafkaDeliveryTimeout bounds how long a record is retried, so a broker outage surfaces
+	// as an error (and a fallback) instead of an indefinitely blocked publish.
+	defaultKafkaDeliveryTimeout = 10 * time.Second
+)
+
+// KafkaClient produces JSON records straight to the Kafka brokers. Records are acknowledged by all
+// in-sync replicas and the producer is idempotent, so retries do not duplicate records.
+type KafkaClient struct {
+	client *kgo.Client
+}
+
+// NewKafkaClient validates the configuration and constructs a KafkaClient. Brokers are contacted
+// lazily, on the first Produce or Ping.
+func NewKafkaClient(cfg KafkaConfig) (*KafkaClient, error) {
+	brokers := make([]string, 0, len(cfg.Brokers))
+	for _, broker := range cfg.Brokers {
+		if broker = strings.TrimSpace(broker); broker != "" {
+			brokers = append(brokers, broker)
+		}
+	}
+	if len(brokers) == 0 {
+		return nil, errors.New("kafka: at least one broker is required")
+	}
+
+	clientID := strings.TrimSpace(cfg.ClientID)
+	if clientID == "" {
+		clientID = defaultKafkaClientID
+	}
+
+	opts := []kgo.Opt{
+		kgo.SeedBrokers(brokers...),
+		kgo.ClientID(clientID),
+		kgo.RequiredAcks(kgo.AllISRAcks()),
+		kgo.RecordDeliveryTimeout(defaultKafkaDeliveryTimeout),
+	}
+	if cfg.TLS {
+		opts = append(opts, kgo.DialTLSConfig(&tls.Config{MinVersion: tls.VersionTLS12}))
+	}
+
+	switch strings.ToLower(strings.TrimSpace(cfg.SASLMechanism)) {
+	case "":
+	case "plain":
+		opts = append(opts, kgo.SASL(plain.Auth{User: cfg.Username, Pass: cfg.Password}.AsMechanism()))
+	case "scram-sha-256":
+		opts = append(opts, kgo.SASL(scram.Auth{User: cfg.Username, Pass: cfg.Password}.AsSha256Mechanism()))
+	case "scram-sha-512":
+		opts = append(opts, kgo.SASL(scram.Auth{User: cfg.Username, Pass: cfg.Password}.AsSha512Mechanism()))
+	default:
+		return nil, fmt.Errorf("kafka: unsupported sasl mechanism %q", cfg.SASLMechanism)
+	}
+
+	client, err := kgo.NewClient(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("kafka: create client: %w", err)
+	}
+	return &KafkaClient{client: client}, nil
+}
+
+// Produce writes records to topic and waits for every one to be acknowledged. Broker rejections
+// that Kafka does not consider retriable (oversized records, authorisation) are returned as is;
+// everything else wraps ErrKafkaUnavailable.
+func (c *KafkaClient) Produce(ctx context.Context, topic string, records []KafkaRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	batch := make([]*kgo.Record, 0, len(records))
+	for _, record := range records {
+		value, err := json.Marshal(record.Value)
+		if err != nil {
+			return fmt.Errorf("kafka: encode record: %w", err)
+		}
+		produced := &kgo.Record{Topic: topic, Value: value}
+		if record.Key != "" {
+			produced.Key = []byte(record.Key)
+		}
+		batch = append(batch, produced)
+	}
+
+	if err := c.client.ProduceSync(ctx, batch...).FirstErr(); err != nil {
+		var kafkaErr *kerr.Error
+		if errors.As(err, &kafkaErr) && !kafkaErr.Retriable {
+			return fmt.Errorf("kafka: %s rejected records: %w", topic, err)
+		}
+		return fmt.Errorf("%w: %v", ErrKafkaUnavailable, err)
+	}
+	return nil
+}
+
+// Ping checks that at least one broker is reachable.
+func (c *KafkaClient) Ping(ctx context.Context) error {
+	if err := c.client.Ping(ctx); err != nil {
+		return fmt.Errorf("%w: %v", ErrKafkaUnavailable, err)
+	}
+	return nil
+}
+
+// Close closes the broker connections.
+func (c *KafkaClient) Close() error {
+	c.client.Close()
+	return nil
+}
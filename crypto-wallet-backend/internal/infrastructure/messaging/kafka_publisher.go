@@ -0,0 +1,134 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"strings"
+)
+
+const defaultKafkaTopicPrefix = "wallet"
+
+// DefaultKafkaTopics maps the existing pub/sub channels to Kafka topics (relative to the topic
+// prefix). A trailing "*" matches a channel prefix; the matched suffix becomes the record key so,
+// for example, all updates for one symbol land on the same partition.
+func DefaultKafkaTopics() map[string]string {
+	return map[string]string{
+		NotificationChannel:            "notifications",
+		TransactionChannel:             "transactions",
+		BalanceUpdateChannel:           "balance-updates",
+		PriceBatchChannel:              "prices-batch",
+		PriceUpdateChannelPrefix + "*": "prices",
+	}
+}
+
+// KafkaPublisherConfig configures a KafkaPublisher.
+type KafkaPublisherConfig struct {
+	Producer    KafkaProducer
+	TopicPrefix string
+	// Topics overrides or extends DefaultKafkaTopics. Values containing a "." are used verbatim;
+	// others are placed under TopicPrefix.
+	Topics map[string]string
+	Logger *slog.Logger
+}
+
+type kafkaRoute struct {
+	pattern string
+	topic   string
+}
+
+// KafkaPublisher publishes channel messages to Kafka topics.
+type KafkaPublisher struct {
+	producer KafkaProducer
+	prefix   string
+	exact    map[string]string
+	// wildcards are ordered longest pattern first so the most specific route wins.
+	wildcards []kafkaRoute
+	logger    *slog.Logger
+}
+
+// NewKafkaPublisher constructs a KafkaPublisher with the default topic mapping plus overrides.
+func NewKafkaPublisher(cfg KafkaPublisherConfig) (*KafkaPublisher, error) {
+	if cfg.Producer == nil {
+		return nil, errors.New("kafka: producer is required")
+	}
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	prefix := strings.Trim(strings.TrimSpace(cfg.TopicPrefix), ".")
+	if prefix == "" {
+		prefix = defaultKafkaTopicPrefix
+	}
+
+	topics := DefaultKafkaTopics()
+	for channel, topic := range cfg.Topics {
+		topics[strings.TrimSpace(channel)] = strings.TrimSpace(topic)
+	}
+
+	publisher := &KafkaPublisher{
+		producer: cfg.Producer,
+		prefix:   prefix,
+		exact:    make(map[string]string),
+		logger:   logger,
+	}
+	for channel, topic := range topics {
+		if channel == "" || topic == "" {
+			continue
+		}
+		if !strings.Contains(topic, ".") {
+			topic = prefix + "." + topic
+		}
+		if strings.HasSuffix(channel, "*") {
+			publisher.wildcards = append(publisher.wildcards, kafkaRoute{pattern: channel, topic: topic})
+			continue
+		}
+		publisher.exact[channel] = topic
+	}
+	sort.Slice(publisher.wildcards, func(i, j int) bool {
+		return len(publisher.wildcards[i].pattern) > len(publisher.wildcards[j].pattern)
+	})
+
+	return publisher, nil
+}
+
+// Publish produces the message to the channel's topic.
+func (p *KafkaPublisher) Publish(ctx context.Context, channel string, message interface{}) error {
+	topic, key := p.Route(channel)
+	if key == "" {
+		if msg, ok := message.(Message); ok {
+			key = msg.Event
+		}
+	}
+
+	if err := p.producer.Produce(ctx, topic, []KafkaRecord{{Key: key, Value: message}}); err != nil {
+		p.logger.Error("Failed to publish message to kafka",
+			"channel", channel,
+			"topic", topic,
+			"error", err)
+		return err
+	}
+
+	p.logger.Debug("Published message to kafka", "channel", channel, "topic", topic)
+	return nil
+}
+
+// Route returns the topic and record key for a channel. Unmapped channels get a topic derived
+// from the channel name.
+func (p *KafkaPublisher) Route(channel string) (string, string) {
+	if topic, ok := p.exact[channel]; ok {
+		return topic, ""
+	}
+	for _, route := range p.wildcards {
+		if matchPattern(route.pattern, channel) {
+			return route.topic, strings.TrimPrefix(channel, strings.TrimSuffix(route.pattern, "*"))
+		}
+	}
+	return p.prefix + "." + strings.NewReplacer(":", "-", "*", "", " ", "-").Replace(channel), ""
+}
+
+// Close releases the producer.
+func (p *KafkaPublisher) Close() error {
+	return p.producer.Close()
+}
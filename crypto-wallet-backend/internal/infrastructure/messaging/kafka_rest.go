@@ -0,0 +1,151 @@
+package messaging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	defaultKafkaRESTTimeout = 10 * time.Second
+	kafkaRESTContentType    = "application/vnd.kafka.json.v2+json"
+	kafkaRESTAccept         = "application/vnd.kafka.v2+json"
+)
+
+// KafkaRESTConfig configures a KafkaRESTClient.
+type KafkaRESTConfig struct {
+	// BaseURL points at a Kafka REST Proxy (v2 API).
+	BaseURL    string
+	Username   string
+	Password   string
+	HTTPClient *http.Client
+}
+
+// KafkaRESTClient produces JSON records through a Confluent REST Proxy (v2 API). It is the optional
+// rest transport, for deployments that front their cluster with a proxy rather than exposing brokers.
+type KafkaRESTClient struct {
+	baseURL    string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+type kafkaProduceRequest struct {
+	Records []KafkaRecord `json:"records"`
+}
+
+type kafkaProduceResponse struct {
+	Offsets []struct {
+		Partition int    `json:"partition"`
+		Offset    int64  `json:"offset"`
+		ErrorCode *int   `json:"error_code"`
+		Error     string `json:"error"`
+	} `json:"offsets"`
+}
+
+// NewKafkaRESTClient validates the configuration and constructs a KafkaRESTClient.
+func NewKafkaRESTClient(cfg KafkaRESTConfig) (*KafkaRESTClient, error) {
+	base := strings.TrimRight(strings.TrimSpace(cfg.BaseURL), "/")
+	parsed, err := url.Parse(base)
+	if base == "" || err != nil || parsed.Host == "" {
+		return nil, fmt.Errorf("kafka: invalid rest proxy url %q", cfg.BaseURL)
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultKafkaRESTTimeout}
+	}
+
+	return &KafkaRESTClient{
+		baseURL:    base,
+		username:   cfg.Username,
+		password:   cfg.Password,
+		httpClient: httpClient,
+	}, nil
+}
+
+// Produce writes records to topic. Transport failures and 5xx responses wrap ErrKafkaUnavailable.
+func (c *KafkaRESTClient) Produce(ctx context.Context, topic string, records []KafkaRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(kafkaProduceRequest{Records: records})
+	if err != nil {
+		return fmt.Errorf("kafka: encode records: %w", err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, "/topics/"+url.PathEscape(topic), payload)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", kafkaRESTContentType)
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrKafkaUnavailable, err)
+	}
+	defer res.Body.Close()
+
+	raw, _ := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if res.StatusCode >= 500 {
+		return fmt.Errorf("%w: produce returned %d", ErrKafkaUnavailable, res.StatusCode)
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("kafka: produce to %s returned %d: %s", topic, res.StatusCode, strings.TrimSpace(string(raw)))
+	}
+
+	var produced kafkaProduceResponse
+	if err := json.Unmarshal(raw, &produced); err != nil {
+		return fmt.Errorf("kafka: decode produce response: %w", err)
+	}
+	for _, offset := range produced.Offsets {
+		if offset.ErrorCode != nil || offset.Error != "" {
+			return fmt.Errorf("kafka: %s rejected records: %s", topic, offset.Error)
+		}
+	}
+	return nil
+}
+
+// Ping checks that the REST proxy is reachable.
+func (c *KafkaRESTClient) Ping(ctx context.Context) error {
+	req, err := c.newRequest(ctx, http.MethodGet, "/topics", nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrKafkaUnavailable, err)
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 1<<16))
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("%w: health check returned %d", ErrKafkaUnavailable, res.StatusCode)
+	}
+	return nil
+}
+
+// Close is a no-op; the REST client holds no persistent connection state.
+func (c *KafkaRESTClient) Close() error {
+	return nil
+}
+
+func (c *KafkaRESTClient) newRequest(ctx context.Context, method, path string, body []byte) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("kafka: create request: %w", err)
+	}
+	req.Header.Set("Accept", kafkaRESTAccept)
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	return req, nil
+}
@@ -0,0 +1,29 @@
+package messaging
+
+import (
+	"context"
+	"time"
+)
+
+// PubSubNotifier publishes user notifications on NotificationChannel for downstream delivery (push, email).
+type PubSubNotifier struct {
+	publisher MessagePublisher
+}
+
+// NewPubSubNotifier constructs a PubSubNotifier backed by the provided publisher.
+func NewPubSubNotifier(publisher MessagePublisher) *PubSubNotifier {
+	return &PubSubNotifier{publisher: publisher}
+}
+
+// Notify publishes an event with its payload.
+func (n *PubSubNotifier) Notify(ctx context.Context, event string, data map[string]any) error {
+	if n == nil || n.publisher == nil {
+		return ErrPublisherNotConfigured
+	}
+
+	return n.publisher.Publish(ctx, NotificationChannel, Message{
+		Event:     event,
+		Data:      data,
+		Timestamp: time.Now().UTC(),
+	})
+}
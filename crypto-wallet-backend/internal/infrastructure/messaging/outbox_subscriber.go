@@ -0,0 +1,37 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/events"
+)
+
+// EventNotifier publishes a named event with its payload.
+type EventNotifier interface {
+	Notify(ctx context.Context, event string, data map[string]any) error
+}
+
+// EventAppender records domain events for export.
+type EventAppender interface {
+	Append(ctx context.Context, event entities.DomainEvent) error
+}
+
+// OutboxSubscriber records every dispatched event in the outbox, so exports see the same events
+// users are notified about. Subscribe it ahead of the notifiers: a failed append is reported but
+// does not stop delivery to the subscribers after it.
+func OutboxSubscriber(outbox EventAppender) events.Handler {
+	return func(ctx context.Context, event events.Event) error {
+		at := event.OccurredAt()
+		if at.IsZero() {
+			at = time.Now()
+		}
+		record := entities.NewDomainEvent(event.EventName(), event.Payload(), at)
+		if err := outbox.Append(ctx, record); err != nil {
+			return fmt.Errorf("append %s to outbox: %w", event.EventName(), err)
+		}
+		return nil
+	}
+}
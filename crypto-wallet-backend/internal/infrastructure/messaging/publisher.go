@@ -0,0 +1,103 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const defaultFallbackCooldown = 30 * time.Second
+
+// ErrPublisherNotConfigured indicates no message broker is configured.
+var ErrPublisherNotConfigured = errors.New("messaging: publisher is not configured")
+
+// MessagePublisher publishes messages on the logical channels used across the app
+// (NotificationChannel, TransactionChannel, ...). RedisPubSubManager satisfies it directly.
+type MessagePublisher interface {
+	Publish(ctx context.Context, channel string, message interface{}) error
+	Close() error
+}
+
+// FallbackPublisher publishes through a primary broker and switches to a fallback while the primary
+// is failing. After a failure the primary is skipped for the cooldown before it is tried again.
+type FallbackPublisher struct {
+	primary   MessagePublisher
+	fallback  MessagePublisher
+	cooldown  time.Duration
+	logger    *slog.Logger
+	now       func() time.Time
+	mu        sync.Mutex
+	downUntil time.Time
+}
+
+// NewFallbackPublisher constructs a FallbackPublisher. fallback may be nil, in which case primary
+// failures are returned to the caller.
+func NewFallbackPublisher(primary, fallback MessagePublisher, cooldown time.Duration, logger *slog.Logger) *FallbackPublisher {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if cooldown <= 0 {
+		cooldown = defaultFallbackCooldown
+	}
+	return &FallbackPublisher{
+		primary:  primary,
+		fallback: fallback,
+		cooldown: cooldown,
+		logger:   logger,
+		now:      time.Now,
+	}
+}
+
+// MarkPrimaryDown routes messages to the fallback for one cooldown, e.g. when a startup health check fails.
+func (p *FallbackPublisher) MarkPrimaryDown() {
+	p.mu.Lock()
+	p.downUntil = p.now().Add(p.cooldown)
+	p.mu.Unlock()
+}
+
+// Publish sends the message through the primary, or the fallback while the primary is down.
+func (p *FallbackPublisher) Publish(ctx context.Context, channel string, message interface{}) error {
+	if p.primaryAvailable() {
+		err := p.primary.Publish(ctx, channel, message)
+		if err == nil {
+			return nil
+		}
+		if p.fallback == nil {
+			return err
+		}
+
+		p.logger.Warn("primary broker publish failed; using fallback",
+			slog.String("channel", channel),
+			slog.Duration("cooldown", p.cooldown),
+			slog.String("error", err.Error()))
+		p.MarkPrimaryDown()
+	}
+
+	if p.fallback == nil {
+		return errors.New("messaging: primary broker unavailable and no fallback configured")
+	}
+	return p.fallback.Publish(ctx, channel, message)
+}
+
+// Close releases both publishers.
+func (p *FallbackPublisher) Close() error {
+	var err error
+	if p.primary != nil {
+		err = p.primary.Close()
+	}
+	if p.fallback != nil {
+		err = errors.Join(err, p.fallback.Close())
+	}
+	return err
+}
+
+func (p *FallbackPublisher) primaryAvailable() bool {
+	if p.primary == nil {
+		return false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return !p.now().Before(p.downUntil)
+}
@@ -0,0 +1,75 @@
+package monitoring
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// BudgetCounts summarises the runs of one budgeted use case since process start.
+type BudgetCounts struct {
+	Runs            int64         `json:"runs"`
+	Exhausted       int64         `json:"exhausted"`
+	TotalDuration   time.Duration `json:"total_duration"`
+	MaxDuration     time.Duration `json:"max_duration"`
+	LastExhaustedAt *time.Time    `json:"last_exhausted_at,omitempty"`
+}
+
+// BudgetSample is the counts of one use case together with its current budget.
+type BudgetSample struct {
+	Name  string
+	Limit time.Duration
+	BudgetCounts
+}
+
+// BudgetMetrics accumulates execution budget counters per use case. Like RolloutMetrics it is never
+// drained. It is safe for concurrent use.
+type BudgetMetrics struct {
+	mu     sync.Mutex
+	counts map[string]BudgetCounts
+	limits map[string]time.Duration
+}
+
+// NewBudgetMetrics constructs an empty BudgetMetrics.
+func NewBudgetMetrics() *BudgetMetrics {
+	return &BudgetMetrics{
+		counts: make(map[string]BudgetCounts),
+		limits: make(map[string]time.Duration),
+	}
+}
+
+// RecordBudgetRun counts one run of a budgeted use case.
+func (m *BudgetMetrics) RecordBudgetRun(name string, limit, elapsed time.Duration, exhausted bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	counts := m.counts[name]
+	counts.Runs++
+	counts.TotalDuration += elapsed
+	counts.MaxDuration = max(counts.MaxDuration, elapsed)
+	if exhausted {
+		counts.Exhausted++
+		at := time.Now().UTC()
+		counts.LastExhaustedAt = &at
+	}
+	m.counts[name] = counts
+	m.limits[name] = limit
+}
+
+// Snapshot returns the current counters, most often exhausted first, so the slowest paths lead.
+func (m *BudgetMetrics) Snapshot() []BudgetSample {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	samples := make([]BudgetSample, 0, len(m.counts))
+	for name, counts := range m.counts {
+		samples = append(samples, BudgetSample{Name: name, Limit: m.limits[name], BudgetCounts: counts})
+	}
+	sort.Slice(samples, func(i, j int) bool {
+		if samples[i].Exhausted != samples[j].Exhausted {
+			return samples[i].Exhausted > samples[j].Exhausted
+		}
+		return samples[i].Name < samples[j].Name
+	})
+	return samples
+}
@@ -0,0 +1,161 @@
+package monitoring
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	pagerDutyEventsURL    = "https://events.pagerduty.com/v2/enqueue"
+	defaultChannelTimeout = 10 * time.Second
+)
+
+// Severity ranks alerts; values match the PagerDuty Events API.
+type Severity string
+
+const (
+	SeverityWarning  Severity = "warning"
+	SeverityError    Severity = "error"
+	SeverityCritical Severity = "critical"
+)
+
+// Alert is an operational anomaly, or the resolution of one. Key identifies the condition
+// so repeated notifications and resolutions can be correlated.
+type Alert struct {
+	Key      string
+	Severity Severity
+	Summary  string
+	Source   string
+	Details  map[string]any
+	Resolved bool
+	At       time.Time
+}
+
+// Channel delivers alerts to an on-call destination.
+type Channel interface {
+	Name() string
+	Send(ctx context.Context, alert Alert) error
+}
+
+// SlackChannel posts alerts to a Slack incoming webhook.
+type SlackChannel struct {
+	webhookURL string
+	httpClient *http.Client
+}
+
+// NewSlackChannel constructs a SlackChannel.
+func NewSlackChannel(webhookURL string) (*SlackChannel, error) {
+	if strings.TrimSpace(webhookURL) == "" {
+		return nil, errors.New("monitoring: slack webhook URL is required")
+	}
+	return &SlackChannel{
+		webhookURL: webhookURL,
+		httpClient: &http.Client{Timeout: defaultChannelTimeout},
+	}, nil
+}
+
+// Name implements Channel.
+func (s *SlackChannel) Name() string {
+	return "slack"
+}
+
+// Send implements Channel.
+func (s *SlackChannel) Send(ctx context.Context, alert Alert) error {
+	var text strings.Builder
+	if alert.Resolved {
+		fmt.Fprintf(&text, ":white_check_mark: *RESOLVED* %s", alert.Summary)
+	} else {
+		fmt.Fprintf(&text, ":rotating_light: *%s* %s", strings.ToUpper(string(alert.Severity)), alert.Summary)
+	}
+	fmt.Fprintf(&text, "\nsource: `%s` · key: `%s`", alert.Source, alert.Key)
+
+	keys := make([]string, 0, len(alert.Details))
+	for key := range alert.Details {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(&text, "\n• %s: %v", key, alert.Details[key])
+	}
+
+	return postJSON(ctx, s.httpClient, s.webhookURL, map[string]string{"text": text.String()})
+}
+
+// PagerDutyChannel sends alerts to the PagerDuty Events API v2, resolving incidents by dedup key.
+type PagerDutyChannel struct {
+	routingKey string
+	eventsURL  string
+	httpClient *http.Client
+}
+
+// NewPagerDutyChannel constructs a PagerDutyChannel for the given integration routing key.
+func NewPagerDutyChannel(routingKey string) (*PagerDutyChannel, error) {
+	if strings.TrimSpace(routingKey) == "" {
+		return nil, errors.New("monitoring: pagerduty routing key is required")
+	}
+	return &PagerDutyChannel{
+		routingKey: routingKey,
+		eventsURL:  pagerDutyEventsURL,
+		httpClient: &http.Client{Timeout: defaultChannelTimeout},
+	}, nil
+}
+
+// Name implements Channel.
+func (p *PagerDutyChannel) Name() string {
+	return "pagerduty"
+}
+
+// Send implements Channel.
+func (p *PagerDutyChannel) Send(ctx context.Context, alert Alert) error {
+	event := map[string]any{
+		"routing_key":  p.routingKey,
+		"event_action": "trigger",
+		"dedup_key":    alert.Key,
+	}
+	if alert.Resolved {
+		event["event_action"] = "resolve"
+	} else {
+		event["payload"] = map[string]any{
+			"summary":        alert.Summary,
+			"source":         alert.Source,
+			"severity":       string(alert.Severity),
+			"timestamp":      alert.At.UTC().Format(time.RFC3339),
+			"custom_details": alert.Details,
+		}
+	}
+
+	return postJSON(ctx, p.httpClient, p.eventsURL, event)
+}
+
+func postJSON(ctx context.Context, client *http.Client, url string, body any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("encode alert: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, string(respBody))
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package monitoring
+
+import (
+	"sync"
+	"time"
+)
+
+// CircuitState enumerates circuit-breaker states.
+type CircuitState string
+
+const (
+	CircuitClosed   CircuitState = "closed"
+	CircuitOpen     CircuitState = "open"
+	CircuitHalfOpen CircuitState = "half_open"
+)
+
+// CircuitEvent records a circuit-breaker transition.
+type CircuitEvent struct {
+	Name string
+	From CircuitState
+	To   CircuitState
+	At   time.Time
+}
+
+// Outcome counts calls and failures over an evaluation window.
+type Outcome struct {
+	Total  int64
+	Errors int64
+}
+
+// ErrorRate returns the share of failed calls.
+func (o Outcome) ErrorRate() float64 {
+	if o.Total == 0 {
+		return 0
+	}
+	return float64(o.Errors) / float64(o.Total)
+}
+
+// Snapshot is the set of outcomes recorded since the previous drain.
+type Snapshot struct {
+	Routes        map[string]Outcome
+	Adapters      map[string]Outcome
+	RPCEndpoints  map[string]Outcome
+	CircuitEvents []CircuitEvent
+}
+
+// Metrics accumulates request, adapter, RPC endpoint and circuit-breaker signals between alert
+// evaluations. It is safe for concurrent use.
+type Metrics struct {
+	mu        sync.Mutex
+	routes    map[string]Outcome
+	adapters  map[string]Outcome
+	endpoints map[string]Outcome
+	circuits  []CircuitEvent
+}
+
+// NewMetrics constructs an empty Metrics.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		routes:    make(map[string]Outcome),
+		adapters:  make(map[string]Outcome),
+		endpoints: make(map[string]Outcome),
+	}
+}
+
+// RecordRequest counts a handled request; 5xx responses count as errors.
+func (m *Metrics) RecordRequest(method, route string, status int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := method + " " + route
+	outcome := m.routes[key]
+	outcome.Total++
+	if status >= 500 {
+		outcome.Errors++
+	}
+	m.routes[key] = outcome
+}
+
+// ObserveAdapterCall counts a blockchain adapter call.
+func (m *Metrics) ObserveAdapterCall(adapter, _ string, failed bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	outcome := m.adapters[adapter]
+	outcome.Total++
+	if failed {
+		outcome.Errors++
+	}
+	m.adapters[adapter] = outcome
+}
+
+// ObserveRPCCall counts a call to a blockchain node endpoint.
+func (m *Metrics) ObserveRPCCall(endpoint string, failed bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	outcome := m.endpoints[endpoint]
+	outcome.Total++
+	if failed {
+		outcome.Errors++
+	}
+	m.endpoints[endpoint] = outcome
+}
+
+// RecordCircuitState records a circuit-breaker transition; breakers call this whenever their state changes.
+func (m *Metrics) RecordCircuitState(name string, from, to CircuitState) {
+	if from == to {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.circuits = append(m.circuits, CircuitEvent{Name: name, From: from, To: to, At: time.Now().UTC()})
+}
+
+// Drain returns everything recorded since the previous drain and resets the counters.
+func (m *Metrics) Drain() Snapshot {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshot := Snapshot{
+		Routes:        m.routes,
+		Adapters:      m.adapters,
+		RPCEndpoints:  m.endpoints,
+		CircuitEvents: m.circuits,
+	}
+	m.routes = make(map[string]Outcome)
+	m.adapters = make(map[string]Outcome)
+	m.endpoints = make(map[string]Outcome)
+	m.circuits = nil
+	return snapshot
+}
@@ -0,0 +1,295 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PoolStatsSource exposes connection pool statistics by pool name.
+type PoolStatsSource interface {
+	Stats() map[string]*pgxpool.Stat
+}
+
+// QueueDepthFunc reports how many items are waiting in a queue.
+type QueueDepthFunc func(ctx context.Context) (int64, error)
+
+// MonitorConfig configures the anomaly monitor. Zero thresholds fall back to defaults.
+type MonitorConfig struct {
+	Metrics  *Metrics
+	Pools    PoolStatsSource
+	Queues   map[string]QueueDepthFunc
+	Channels []Channel
+	// ErrorRateThreshold is the share of failed calls per route or adapter that raises an alert.
+	ErrorRateThreshold float64
+	// MinRequests ignores routes and adapters with too few calls in a window to judge.
+	MinRequests int64
+	// PoolUtilisationThreshold is the share of acquired connections that counts as exhaustion.
+	PoolUtilisationThreshold float64
+	QueueBacklogThreshold    int64
+	// RepeatInterval is how often a still-firing alert is re-sent.
+	RepeatInterval time.Duration
+	Logger         *slog.Logger
+}
+
+// Monitor evaluates operational metrics and pushes alerts when they breach their budgets.
+// It sends a resolution once a condition clears.
+type Monitor struct {
+	metrics        *Metrics
+	pools          PoolStatsSource
+	queues         map[string]QueueDepthFunc
+	channels       []Channel
+	errorRate      float64
+	minRequests    int64
+	poolThreshold  float64
+	queueThreshold int64
+	repeatInterval time.Duration
+	logger         *slog.Logger
+
+	active        map[string]activeAlert
+	openCircuits  map[string]CircuitState
+	poolCancelled map[string]int64
+}
+
+type activeAlert struct {
+	alert    Alert
+	lastSent time.Time
+}
+
+// NewMonitor constructs a Monitor.
+func NewMonitor(cfg MonitorConfig) *Monitor {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if cfg.ErrorRateThreshold <= 0 {
+		cfg.ErrorRateThreshold = 0.05
+	}
+	if cfg.MinRequests <= 0 {
+		cfg.MinRequests = 20
+	}
+	if cfg.PoolUtilisationThreshold <= 0 {
+		cfg.PoolUtilisationThreshold = 0.9
+	}
+	if cfg.QueueBacklogThreshold <= 0 {
+		cfg.QueueBacklogThreshold = 100
+	}
+	if cfg.RepeatInterval <= 0 {
+		cfg.RepeatInterval = 30 * time.Minute
+	}
+	return &Monitor{
+		metrics:        cfg.Metrics,
+		pools:          cfg.Pools,
+		queues:         cfg.Queues,
+		channels:       cfg.Channels,
+		errorRate:      cfg.ErrorRateThreshold,
+		minRequests:    cfg.MinRequests,
+		poolThreshold:  cfg.PoolUtilisationThreshold,
+		queueThreshold: cfg.QueueBacklogThreshold,
+		repeatInterval: cfg.RepeatInterval,
+		logger:         logger,
+		active:         make(map[string]activeAlert),
+		openCircuits:   make(map[string]CircuitState),
+		poolCancelled:  make(map[string]int64),
+	}
+}
+
+// Evaluate checks every signal once and reports how many notifications were sent.
+// It is not safe for concurrent use; a single worker drives it.
+func (m *Monitor) Evaluate(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	firing := make(map[string]Alert)
+
+	if m.metrics != nil {
+		snapshot := m.metrics.Drain()
+		m.checkErrorRates(firing, "route", snapshot.Routes, now)
+		m.checkErrorRates(firing, "adapter", snapshot.Adapters, now)
+		m.checkErrorRates(firing, "endpoint", snapshot.RPCEndpoints, now)
+		m.checkCircuits(firing, snapshot.CircuitEvents, now)
+	}
+	m.checkPools(firing, now)
+	m.checkQueues(ctx, firing, now)
+
+	var (
+		sent    int
+		sendErr error
+	)
+
+	keys := make([]string, 0, len(firing))
+	for key := range firing {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		alert := firing[key]
+		if previous, ok := m.active[key]; ok && now.Sub(previous.lastSent) < m.repeatInterval {
+			previous.alert = alert
+			m.active[key] = previous
+			continue
+		}
+		if err := m.dispatch(ctx, alert); err != nil {
+			sendErr = errors.Join(sendErr, err)
+			continue
+		}
+		m.active[key] = activeAlert{alert: alert, lastSent: now}
+		sent++
+	}
+
+	for key, previous := range m.active {
+		if _, ok := firing[key]; ok {
+			continue
+		}
+		resolved := previous.alert
+		resolved.Resolved = true
+		resolved.At = now
+		if err := m.dispatch(ctx, resolved); err != nil {
+			sendErr = errors.Join(sendErr, err)
+			continue
+		}
+		delete(m.active, key)
+		sent++
+	}
+
+	return sent, sendErr
+}
+
+func (m *Monitor) checkErrorRates(firing map[string]Alert, kind string, outcomes map[string]Outcome, now time.Time) {
+	for name, outcome := range outcomes {
+		if outcome.Total < m.minRequests || outcome.ErrorRate() < m.errorRate {
+			continue
+		}
+		severity := SeverityError
+		if outcome.ErrorRate() >= 0.5 {
+			severity = SeverityCritical
+		}
+		key := fmt.Sprintf("error_rate:%s:%s", kind, name)
+		firing[key] = Alert{
+			Key:      key,
+			Severity: severity,
+			Summary:  fmt.Sprintf("%s %s error rate %.1f%% exceeds %.1f%% budget", kind, name, outcome.ErrorRate()*100, m.errorRate*100),
+			Source:   name,
+			Details: map[string]any{
+				"total":  outcome.Total,
+				"errors": outcome.Errors,
+			},
+			At: now,
+		}
+	}
+}
+
+// checkCircuits tracks breaker state across evaluations; an open or half-open breaker keeps its alert firing.
+func (m *Monitor) checkCircuits(firing map[string]Alert, events []CircuitEvent, now time.Time) {
+	for _, event := range events {
+		if event.To == CircuitClosed {
+			delete(m.openCircuits, event.Name)
+			continue
+		}
+		m.openCircuits[event.Name] = event.To
+	}
+
+	for name, state := range m.openCircuits {
+		severity := SeverityCritical
+		if state == CircuitHalfOpen {
+			severity = SeverityWarning
+		}
+		key := "circuit:" + name
+		firing[key] = Alert{
+			Key:      key,
+			Severity: severity,
+			Summary:  fmt.Sprintf("circuit breaker %s is %s", name, state),
+			Source:   name,
+			Details:  map[string]any{"state": string(state)},
+			At:       now,
+		}
+	}
+}
+
+// checkPools flags pools that are near capacity or whose acquires were cancelled while waiting.
+func (m *Monitor) checkPools(firing map[string]Alert, now time.Time) {
+	if m.pools == nil {
+		return
+	}
+
+	for name, stat := range m.pools.Stats() {
+		if stat == nil || stat.MaxConns() <= 0 {
+			continue
+		}
+
+		utilisation := float64(stat.AcquiredConns()) / float64(stat.MaxConns())
+		cancelled := stat.CanceledAcquireCount()
+		previous, seen := m.poolCancelled[name]
+		m.poolCancelled[name] = cancelled
+		newlyCancelled := int64(0)
+		if seen && cancelled > previous {
+			newlyCancelled = cancelled - previous
+		}
+
+		if utilisation < m.poolThreshold && newlyCancelled == 0 {
+			continue
+		}
+
+		key := "db_pool:" + name
+		firing[key] = Alert{
+			Key:      key,
+			Severity: SeverityCritical,
+			Summary:  fmt.Sprintf("database pool %s is exhausted (%d/%d connections in use)", name, stat.AcquiredConns(), stat.MaxConns()),
+			Source:   "db:" + name,
+			Details: map[string]any{
+				"acquired":           stat.AcquiredConns(),
+				"max":                stat.MaxConns(),
+				"cancelled_acquires": newlyCancelled,
+			},
+			At: now,
+		}
+	}
+}
+
+func (m *Monitor) checkQueues(ctx context.Context, firing map[string]Alert, now time.Time) {
+	for name, depthFn := range m.queues {
+		if depthFn == nil {
+			continue
+		}
+
+		depth, err := depthFn(ctx)
+		if err != nil {
+			m.logger.Warn("failed to read queue depth", slog.String("queue", name), slog.String("error", err.Error()))
+			continue
+		}
+		if depth < m.queueThreshold {
+			continue
+		}
+
+		key := "queue_backlog:" + name
+		firing[key] = Alert{
+			Key:      key,
+			Severity: SeverityWarning,
+			Summary:  fmt.Sprintf("queue %s backlog is %d (threshold %d)", name, depth, m.queueThreshold),
+			Source:   "queue:" + name,
+			Details:  map[string]any{"depth": depth},
+			At:       now,
+		}
+	}
+}
+
+func (m *Monitor) dispatch(ctx context.Context, alert Alert) error {
+	var sendErr error
+	for _, channel := range m.channels {
+		if err := channel.Send(ctx, alert); err != nil {
+			m.logger.Error("failed to deliver alert",
+				slog.String("channel", channel.Name()),
+				slog.String("key", alert.Key),
+				slog.String("error", err.Error()))
+			sendErr = errors.Join(sendErr, fmt.Errorf("%s: %w", channel.Name(), err))
+			continue
+		}
+	}
+	if sendErr == nil {
+		m.logger.Info("alert delivered", slog.String("key", alert.Key), slog.Bool("resolved", alert.Resolved))
+	}
+	return sendErr
+}
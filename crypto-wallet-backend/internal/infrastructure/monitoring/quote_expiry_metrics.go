@@ -0,0 +1,51 @@
+package monitoring
+
+import (
+	"sync"
+	"time"
+)
+
+// QuoteExpiryCounts summarises quote expiry passes since process start. FullBatches counts passes
+// that hit the batch limit, a sign that expiry is falling behind quote creation.
+type QuoteExpiryCounts struct {
+	Runs         int64         `json:"runs"`
+	Expired      int64         `json:"expired"`
+	Failed       int64         `json:"failed"`
+	FullBatches  int64         `json:"full_batches"`
+	LastRunAt    time.Time     `json:"last_run_at"`
+	LastDuration time.Duration `json:"last_duration"`
+}
+
+// QuoteExpiryMetrics accumulates quote expiry counters. It is safe for concurrent use.
+type QuoteExpiryMetrics struct {
+	mu     sync.Mutex
+	counts QuoteExpiryCounts
+}
+
+// NewQuoteExpiryMetrics constructs an empty QuoteExpiryMetrics.
+func NewQuoteExpiryMetrics() *QuoteExpiryMetrics {
+	return &QuoteExpiryMetrics{}
+}
+
+// RecordRun counts one expiry pass.
+func (m *QuoteExpiryMetrics) RecordRun(expired, failed int, fullBatch bool, startedAt time.Time, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.counts.Runs++
+	m.counts.Expired += int64(expired)
+	m.counts.Failed += int64(failed)
+	if fullBatch {
+		m.counts.FullBatches++
+	}
+	m.counts.LastRunAt = startedAt
+	m.counts.LastDuration = duration
+}
+
+// Snapshot returns the current counters.
+func (m *QuoteExpiryMetrics) Snapshot() QuoteExpiryCounts {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.counts
+}
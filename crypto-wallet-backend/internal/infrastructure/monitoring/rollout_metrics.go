@@ -0,0 +1,87 @@
+package monitoring
+
+import (
+	"sort"
+	"sync"
+)
+
+// RolloutCounts counts wallet creation decisions and outcomes for one chain cohort.
+type RolloutCounts struct {
+	Allowed int64 `json:"allowed"`
+	Denied  int64 `json:"denied"`
+	Created int64 `json:"created"`
+	Failed  int64 `json:"failed"`
+}
+
+// RolloutSample is the counts of one chain cohort.
+type RolloutSample struct {
+	Chain  string
+	Cohort string
+	RolloutCounts
+}
+
+type rolloutKey struct {
+	chain  string
+	cohort string
+}
+
+// RolloutMetrics accumulates chain rollout counters segmented by cohort since process start.
+// Unlike Metrics it is never drained: it backs the rollout dashboard rather than alerting.
+// It is safe for concurrent use.
+type RolloutMetrics struct {
+	mu     sync.Mutex
+	counts map[rolloutKey]RolloutCounts
+}
+
+// NewRolloutMetrics constructs an empty RolloutMetrics.
+func NewRolloutMetrics() *RolloutMetrics {
+	return &RolloutMetrics{counts: make(map[rolloutKey]RolloutCounts)}
+}
+
+// RecordDecision counts whether the cohort was let through to wallet creation.
+func (m *RolloutMetrics) RecordDecision(chain, cohort string, allowed bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := rolloutKey{chain: chain, cohort: cohort}
+	counts := m.counts[key]
+	if allowed {
+		counts.Allowed++
+	} else {
+		counts.Denied++
+	}
+	m.counts[key] = counts
+}
+
+// RecordOutcome counts whether an allowed wallet creation succeeded.
+func (m *RolloutMetrics) RecordOutcome(chain, cohort string, created bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := rolloutKey{chain: chain, cohort: cohort}
+	counts := m.counts[key]
+	if created {
+		counts.Created++
+	} else {
+		counts.Failed++
+	}
+	m.counts[key] = counts
+}
+
+// Snapshot returns the current counters ordered by chain and cohort.
+func (m *RolloutMetrics) Snapshot() []RolloutSample {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	samples := make([]RolloutSample, 0, len(m.counts))
+	for key, counts := range m.counts {
+		samples = append(samples, RolloutSample{Chain: key.chain, Cohort: key.cohort, RolloutCounts: counts})
+	}
+	sort.Slice(samples, func(i, j int) bool {
+		if samples[i].Chain != samples[j].Chain {
+			return samples[i].Chain < samples[j].Chain
+		}
+		return samples[i].Cohort < samples[j].Cohort
+	})
+	return samples
+}
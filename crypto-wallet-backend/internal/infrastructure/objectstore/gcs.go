@@ -0,0 +1,211 @@
+package objectstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultGCSEndpoint  = "https://storage.googleapis.com"
+	gcsMetadataTokenURL = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+	// gcsTokenRefreshMargin renews metadata tokens this long before they expire.
+	gcsTokenRefreshMargin = time.Minute
+)
+
+// GCSConfig configures a GCSStore. Without an access token, tokens for the instance's service
+// account are fetched from the metadata server.
+type GCSConfig struct {
+	Bucket      string
+	AccessToken string
+	// Endpoint overrides the Cloud Storage JSON API endpoint, e.g. for an emulator.
+	Endpoint   string
+	HTTPClient *http.Client
+}
+
+// GCSStore stores objects through the Cloud Storage JSON API.
+type GCSStore struct {
+	bucket      string
+	endpoint    string
+	staticToken string
+	httpClient  *http.Client
+	now         func() time.Time
+
+	mu          sync.Mutex
+	token       string
+	tokenExpiry time.Time
+}
+
+// NewGCSStore validates the configuration and constructs a GCSStore.
+func NewGCSStore(cfg GCSConfig) (*GCSStore, error) {
+	if strings.TrimSpace(cfg.Bucket) == "" {
+		return nil, errors.New("object store: gcs bucket is required")
+	}
+	endpoint := strings.TrimRight(strings.TrimSpace(cfg.Endpoint), "/")
+	if endpoint == "" {
+		endpoint = defaultGCSEndpoint
+	}
+	if parsed, err := url.Parse(endpoint); err != nil || parsed.Host == "" {
+		return nil, fmt.Errorf("object store: invalid gcs endpoint %q", endpoint)
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultS3Timeout}
+	}
+
+	return &GCSStore{
+		bucket:      strings.TrimSpace(cfg.Bucket),
+		endpoint:    endpoint,
+		staticToken: strings.TrimSpace(cfg.AccessToken),
+		httpClient:  httpClient,
+		now:         time.Now,
+	}, nil
+}
+
+// Upload streams size bytes from body under key with a single media upload.
+func (s *GCSStore) Upload(ctx context.Context, key, contentType string, body io.Reader, size int64) error {
+	key = strings.TrimLeft(key, "/")
+	if key == "" {
+		return errors.New("object store: gcs object key is required")
+	}
+
+	target := fmt.Sprintf("%s/upload/storage/v1/b/%s/o?uploadType=media&name=%s",
+		s.endpoint, url.PathEscape(s.bucket), url.QueryEscape(key))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, body)
+	if err != nil {
+		return fmt.Errorf("object store: create gcs request: %w", err)
+	}
+	req.ContentLength = size
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Content-Length", strconv.FormatInt(size, 10))
+
+	res, err := s.do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("object store: gcs upload returned %d: %s", res.StatusCode, strings.TrimSpace(string(message)))
+	}
+	return nil
+}
+
+// Open downloads the object stored under key. Returns ErrObjectNotFound when there is none.
+func (s *GCSStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(key)+"?alt=media", nil)
+	if err != nil {
+		return nil, fmt.Errorf("object store: create gcs request: %w", err)
+	}
+
+	res, err := s.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode == http.StatusNotFound {
+		res.Body.Close()
+		return nil, ErrObjectNotFound
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		defer res.Body.Close()
+		message, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return nil, fmt.Errorf("object store: gcs get returned %d: %s", res.StatusCode, strings.TrimSpace(string(message)))
+	}
+	return res.Body, nil
+}
+
+// Delete removes the object stored under key. Deleting a missing object is not an error.
+func (s *GCSStore) Delete(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key), nil)
+	if err != nil {
+		return fmt.Errorf("object store: create gcs request: %w", err)
+	}
+
+	res, err := s.do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNotFound && (res.StatusCode < 200 || res.StatusCode >= 300) {
+		message, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("object store: gcs delete returned %d: %s", res.StatusCode, strings.TrimSpace(string(message)))
+	}
+	return nil
+}
+
+func (s *GCSStore) objectURL(key string) string {
+	return fmt.Sprintf("%s/storage/v1/b/%s/o/%s", s.endpoint, url.PathEscape(s.bucket), url.PathEscape(strings.TrimLeft(key, "/")))
+}
+
+// do authorises and sends req.
+func (s *GCSStore) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	token, err := s.accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("object store: gcs %s: %w", strings.ToLower(req.Method), err)
+	}
+	return res, nil
+}
+
+// accessToken returns the configured token, or a cached metadata server token.
+func (s *GCSStore) accessToken(ctx context.Context) (string, error) {
+	if s.staticToken != "" {
+		return s.staticToken, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && s.now().Before(s.tokenExpiry) {
+		return s.token, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, gcsMetadataTokenURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("object store: create metadata token request: %w", err)
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("object store: fetch metadata token: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("object store: metadata server returned %d", res.StatusCode)
+	}
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("object store: decode metadata token: %w", err)
+	}
+	if token.AccessToken == "" {
+		return "", errors.New("object store: metadata server returned an empty access token")
+	}
+
+	s.token = token.AccessToken
+	s.tokenExpiry = s.now().Add(time.Duration(token.ExpiresIn)*time.Second - gcsTokenRefreshMargin)
+	return s.token, nil
+}
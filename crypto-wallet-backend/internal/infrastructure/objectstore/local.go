@@ -0,0 +1,109 @@
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalStore keeps objects as files under a directory. It suits development and single-node
+// deployments with an encrypted or network-backed volume.
+type LocalStore struct {
+	dir string
+}
+
+// NewLocalStore constructs a LocalStore rooted at dir, creating the directory when needed.
+func NewLocalStore(dir string) (*LocalStore, error) {
+	dir = strings.TrimSpace(dir)
+	if dir == "" {
+		return nil, errors.New("object store: local directory is required")
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, fmt.Errorf("object store: resolve local directory: %w", err)
+	}
+	if err := os.MkdirAll(abs, 0o700); err != nil {
+		return nil, fmt.Errorf("object store: create local directory: %w", err)
+	}
+	return &LocalStore{dir: abs}, nil
+}
+
+// Upload writes size bytes from body under key. The file only appears once it is complete.
+func (s *LocalStore) Upload(ctx context.Context, key, contentType string, body io.Reader, size int64) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("object store: create local directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("object store: create local file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	written, err := io.Copy(tmp, body)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("object store: write local file: %w", err)
+	}
+	if written != size {
+		return fmt.Errorf("object store: wrote %d of %d bytes", written, size)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("object store: store local file: %w", err)
+	}
+	return nil
+}
+
+// Open opens the object stored under key. Returns ErrObjectNotFound when there is none.
+func (s *LocalStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrObjectNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("object store: open local file: %w", err)
+	}
+	return file, nil
+}
+
+// Delete removes the object stored under key. Deleting a missing object is not an error.
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("object store: delete local file: %w", err)
+	}
+	return nil
+}
+
+// path maps key to a file under the root, rejecting keys that would escape it.
+func (s *LocalStore) path(key string) (string, error) {
+	key = strings.TrimLeft(key, "/")
+	if key == "" {
+		return "", errors.New("object store: local object key is required")
+	}
+	path := filepath.Join(s.dir, filepath.FromSlash(key))
+	if !strings.HasPrefix(path, s.dir+string(filepath.Separator)) {
+		return "", fmt.Errorf("object store: invalid object key %q", key)
+	}
+	return path, nil
+}
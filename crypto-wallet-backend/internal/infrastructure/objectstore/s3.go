@@ -0,0 +1,358 @@
+// Package objectstore stores files in S3 or S3-compatible object storage, Google Cloud Storage or
+// on local disk.
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultS3Timeout   = 30 * time.Second
+	s3SigningAlgorithm = "AWS4-HMAC-SHA256"
+	s3Service          = "s3"
+	unsignedPayload    = "UNSIGNED-PAYLOAD"
+	// maxPresignTTL is the longest validity SigV4 accepts for a presigned URL.
+	maxPresignTTL = 7 * 24 * time.Hour
+)
+
+// ErrObjectNotFound indicates that no object is stored under the requested key.
+var ErrObjectNotFound = errors.New("object store: object not found")
+
+// S3Config configures an S3Store. Endpoint is only needed for S3-compatible stores (MinIO, GCS
+// interoperability); it switches to path-style addressing.
+type S3Config struct {
+	Bucket          string
+	Region          string
+	Endpoint        string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	HTTPClient      *http.Client
+}
+
+// S3Store uploads objects with SigV4-signed PUT requests and issues presigned download URLs.
+type S3Store struct {
+	bucket       string
+	region       string
+	endpoint     *url.URL
+	accessKeyID  string
+	secretKey    string
+	sessionToken string
+	httpClient   *http.Client
+	now          func() time.Time
+}
+
+// NewS3Store validates the configuration and constructs an S3Store.
+func NewS3Store(cfg S3Config) (*S3Store, error) {
+	if strings.TrimSpace(cfg.Bucket) == "" {
+		return nil, errors.New("object store: s3 bucket is required")
+	}
+	if strings.TrimSpace(cfg.Region) == "" {
+		return nil, errors.New("object store: s3 region is required")
+	}
+	if cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, errors.New("object store: s3 credentials are required")
+	}
+
+	raw := strings.TrimSpace(cfg.Endpoint)
+	pathStyle := raw != ""
+	if raw == "" {
+		raw = fmt.Sprintf("https://%s.s3.%s.amazonaws.com", cfg.Bucket, cfg.Region)
+	}
+	endpoint, err := url.Parse(strings.TrimRight(raw, "/"))
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("object store: invalid s3 endpoint %q", raw)
+	}
+	if pathStyle {
+		endpoint.Path = strings.TrimRight(endpoint.Path, "/") + "/" + cfg.Bucket
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultS3Timeout}
+	}
+
+	return &S3Store{
+		bucket:       cfg.Bucket,
+		region:       cfg.Region,
+		endpoint:     endpoint,
+		accessKeyID:  cfg.AccessKeyID,
+		secretKey:    cfg.SecretAccessKey,
+		sessionToken: cfg.SessionToken,
+		httpClient:   httpClient,
+		now:          time.Now,
+	}, nil
+}
+
+// URI returns the s3:// URI of key.
+func (s *S3Store) URI(key string) string {
+	return fmt.Sprintf("s3://%s/%s", s.bucket, strings.TrimLeft(key, "/"))
+}
+
+// Put uploads data under key with a signed payload hash.
+func (s *S3Store) Put(ctx context.Context, key, contentType string, data []byte) error {
+	return s.put(ctx, key, contentType, bytes.NewReader(data), int64(len(data)), sha256Hex(data))
+}
+
+// Upload streams size bytes from body under key without buffering them, so the payload is sent
+// unsigned; TLS protects it in transit.
+func (s *S3Store) Upload(ctx context.Context, key, contentType string, body io.Reader, size int64) error {
+	return s.put(ctx, key, contentType, body, size, unsignedPayload)
+}
+
+func (s *S3Store) put(ctx context.Context, key, contentType string, body io.Reader, size int64, payloadHash string) error {
+	target, err := s.objectURL(key)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, target.String(), body)
+	if err != nil {
+		return fmt.Errorf("object store: create s3 request: %w", err)
+	}
+	req.ContentLength = size
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	s.sign(req, payloadHash)
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("object store: s3 put: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("object store: s3 put returned %d: %s", res.StatusCode, strings.TrimSpace(string(message)))
+	}
+	return nil
+}
+
+// Open downloads the object stored under key. Returns ErrObjectNotFound when there is none.
+func (s *S3Store) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	res, err := s.do(ctx, http.MethodGet, key)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode == http.StatusNotFound {
+		res.Body.Close()
+		return nil, ErrObjectNotFound
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		defer res.Body.Close()
+		message, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return nil, fmt.Errorf("object store: s3 get returned %d: %s", res.StatusCode, strings.TrimSpace(string(message)))
+	}
+	return res.Body, nil
+}
+
+// Delete removes the object stored under key. Deleting a missing object is not an error.
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	res, err := s.do(ctx, http.MethodDelete, key)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNotFound && (res.StatusCode < 200 || res.StatusCode >= 300) {
+		message, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("object store: s3 delete returned %d: %s", res.StatusCode, strings.TrimSpace(string(message)))
+	}
+	return nil
+}
+
+// do sends a signed bodyless request for key.
+func (s *S3Store) do(ctx context.Context, method, key string) (*http.Response, error) {
+	target, err := s.objectURL(key)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("object store: create s3 request: %w", err)
+	}
+	s.sign(req, sha256Hex(nil))
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("object store: s3 %s: %w", strings.ToLower(method), err)
+	}
+	return res, nil
+}
+
+// PresignGet returns a URL that downloads key without credentials until ttl has passed. When
+// filename is set the download is served as an attachment with that name.
+func (s *S3Store) PresignGet(key, filename string, ttl time.Duration) (string, error) {
+	if ttl <= 0 || ttl > maxPresignTTL {
+		return "", fmt.Errorf("object store: presigned URL lifetime must be between 1s and %s", maxPresignTTL)
+	}
+	target, err := s.objectURL(key)
+	if err != nil {
+		return "", err
+	}
+
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	dateStamp := now.Format("20060102")
+	scope := strings.Join([]string{dateStamp, s.region, s3Service, "aws4_request"}, "/")
+
+	query := map[string]string{
+		"X-Amz-Algorithm":     s3SigningAlgorithm,
+		"X-Amz-Credential":    s.accessKeyID + "/" + scope,
+		"X-Amz-Date":          amzDate,
+		"X-Amz-Expires":       strconv.Itoa(int(ttl.Seconds())),
+		"X-Amz-SignedHeaders": "host",
+	}
+	if s.sessionToken != "" {
+		query["X-Amz-Security-Token"] = s.sessionToken
+	}
+	if filename != "" {
+		query["response-content-disposition"] = fmt.Sprintf("attachment; filename=%q", filename)
+	}
+	target.RawQuery = canonicalQuery(query)
+
+	canonicalRequest := strings.Join([]string{
+		http.MethodGet,
+		target.EscapedPath(),
+		target.RawQuery,
+		"host:" + target.Host + "\n",
+		"host",
+		unsignedPayload,
+	}, "\n")
+
+	signature := s.signature(dateStamp, strings.Join([]string{
+		s3SigningAlgorithm,
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n"))
+
+	target.RawQuery += "&X-Amz-Signature=" + signature
+	return target.String(), nil
+}
+
+func (s *S3Store) objectURL(key string) (*url.URL, error) {
+	key = strings.TrimLeft(key, "/")
+	if key == "" {
+		return nil, errors.New("object store: s3 object key is required")
+	}
+
+	target := *s.endpoint
+	target.Path = s.endpoint.Path + "/" + key
+	target.RawPath = escapeS3(target.Path, true)
+	return &target, nil
+}
+
+// sign adds AWS Signature Version 4 headers for an unchunked payload.
+func (s *S3Store) sign(req *http.Request, payloadHash string) {
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	dateStamp := now.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if s.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.sessionToken)
+	}
+
+	headers := map[string]string{
+		"host":                 req.URL.Host,
+		"x-amz-content-sha256": payloadHash,
+		"x-amz-date":           amzDate,
+	}
+	names := []string{"host", "x-amz-content-sha256", "x-amz-date"}
+	if s.sessionToken != "" {
+		headers["x-amz-security-token"] = s.sessionToken
+		names = append(names, "x-amz-security-token")
+	}
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{dateStamp, s.region, s3Service, "aws4_request"}, "/")
+	signature := s.signature(dateStamp, strings.Join([]string{
+		s3SigningAlgorithm,
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n"))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SigningAlgorithm, s.accessKeyID, scope, signedHeaders, signature))
+}
+
+func (s *S3Store) signature(dateStamp, stringToSign string) string {
+	signingKey := hmacSHA256([]byte("AWS4"+s.secretKey), dateStamp)
+	signingKey = hmacSHA256(signingKey, s.region)
+	signingKey = hmacSHA256(signingKey, s3Service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+}
+
+// canonicalQuery encodes parameters sorted by name, escaped as SigV4 requires.
+func canonicalQuery(params map[string]string) string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pairs := make([]string, 0, len(names))
+	for _, name := range names {
+		pairs = append(pairs, escapeS3(name, false)+"="+escapeS3(params[name], false))
+	}
+	return strings.Join(pairs, "&")
+}
+
+// escapeS3 percent-encodes every byte except unreserved characters and, in paths, "/".
+func escapeS3(value string, path bool) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z', c >= '0' && c <= '9',
+			c == '-', c == '_', c == '.', c == '~', c == '/' && path:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
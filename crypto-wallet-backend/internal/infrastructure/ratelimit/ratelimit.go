@@ -0,0 +1,98 @@
+// Package ratelimit implements token bucket rate limiting over a pluggable counter store. The
+// in-process MemoryStore suits a single instance; RedisStore shares buckets between instances so a
+// client cannot multiply its allowance by spreading requests across them.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limit allows Requests per Window on average and up to Burst at once. Burst defaults to
+// Requests.
+type Limit struct {
+	Requests int
+	Burst    int
+	Window   time.Duration
+}
+
+func (l Limit) capacity() int {
+	if l.Burst > 0 {
+		return l.Burst
+	}
+	return l.Requests
+}
+
+// Decision is the outcome of one request against its bucket. RetryAfter is set when the request
+// was refused and says when a token will next be available.
+type Decision struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	RetryAfter time.Duration
+}
+
+// sweepInterval is how often MemoryStore drops buckets that have refilled completely.
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	full    time.Time
+}
+
+// MemoryStore keeps buckets in process memory. It is safe for concurrent use.
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewMemoryStore constructs an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Allow takes a token from key's bucket if one is available.
+func (s *MemoryStore) Allow(_ context.Context, key string, limit Limit) (Decision, error) {
+	capacity := float64(limit.capacity())
+	perSecond := float64(limit.Requests) / limit.Window.Seconds()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if now.Sub(s.lastSweep) >= sweepInterval {
+		for k, b := range s.buckets {
+			if !now.Before(b.full) {
+				delete(s.buckets, k)
+			}
+		}
+		s.lastSweep = now
+	}
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: capacity, updated: now}
+		s.buckets[key] = b
+	}
+	b.tokens = math.Min(capacity, b.tokens+now.Sub(b.updated).Seconds()*perSecond)
+	b.updated = now
+
+	decision := Decision{Limit: limit.capacity()}
+	if b.tokens >= 1 {
+		b.tokens--
+		decision.Allowed = true
+	} else {
+		decision.RetryAfter = time.Duration((1 - b.tokens) / perSecond * float64(time.Second))
+	}
+	decision.Remaining = int(b.tokens)
+	b.full = now.Add(time.Duration((capacity - b.tokens) / perSecond * float64(time.Second)))
+	return decision, nil
+}
@@ -0,0 +1,75 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// DefaultRedisPrefix namespaces rate limit buckets in a shared Redis.
+const DefaultRedisPrefix = "ratelimit:"
+
+// tokenBucketScript refills and takes from one bucket atomically. Time comes from the Redis
+// server, so instances with skewed clocks still agree. The bucket expires once it would have
+// refilled completely, as a missing bucket is treated as full.
+//
+// KEYS[1] bucket; ARGV[1] capacity, ARGV[2] requests, ARGV[3] window in microseconds.
+// Returns {allowed, remaining, retry after in microseconds}.
+var tokenBucketScript = redis.NewScript(`
+local capacity = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2]) / tonumber(ARGV[3])
+local clock = redis.call('TIME')
+local now = tonumber(clock[1]) * 1000000 + tonumber(clock[2])
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1]) or capacity
+local ts = tonumber(state[2]) or now
+tokens = math.min(capacity, tokens + math.max(0, now - ts) * rate)
+
+local allowed = 0
+local retry = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry = math.ceil((1 - tokens) / rate)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(now))
+redis.call('PEXPIRE', KEYS[1], math.ceil((capacity - tokens) / rate / 1000) + 1000)
+return {allowed, math.floor(tokens), retry}
+`)
+
+// RedisStore keeps buckets in Redis so every instance draws from the same allowance.
+type RedisStore struct {
+	client redis.Scripter
+	prefix string
+}
+
+// NewRedisStore constructs a RedisStore; prefix defaults to DefaultRedisPrefix.
+func NewRedisStore(client redis.Scripter, prefix string) *RedisStore {
+	if prefix == "" {
+		prefix = DefaultRedisPrefix
+	}
+	return &RedisStore{client: client, prefix: prefix}
+}
+
+// Allow takes a token from key's bucket if one is available.
+func (s *RedisStore) Allow(ctx context.Context, key string, limit Limit) (Decision, error) {
+	values, err := tokenBucketScript.Run(ctx, s.client, []string{s.prefix + key},
+		limit.capacity(), limit.Requests, limit.Window.Microseconds()).Int64Slice()
+	if err != nil {
+		return Decision{}, fmt.Errorf("rate limit: %w", err)
+	}
+	if len(values) != 3 {
+		return Decision{}, fmt.Errorf("rate limit: unexpected script result %v", values)
+	}
+	return Decision{
+		Allowed:    values[0] == 1,
+		Limit:      limit.capacity(),
+		Remaining:  int(values[1]),
+		RetryAfter: time.Duration(values[2]) * time.Microsecond,
+	}, nil
+}
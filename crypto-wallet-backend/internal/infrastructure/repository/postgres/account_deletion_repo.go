@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const accountDeletionSelectColumns = `
+SELECT
+	user_id,
+	requested_at,
+	purge_after,
+	purged_at
+FROM account_deletions`
+
+var errAccountDeletionNilPool = errors.New("account deletion repository: database pool is not configured")
+
+// AccountDeletionRepository persists scheduled account deletions using PostgreSQL.
+type AccountDeletionRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewAccountDeletionRepository constructs an AccountDeletionRepository backed by the provided pool.
+func NewAccountDeletionRepository(pool *pgxpool.Pool, logger *slog.Logger) *AccountDeletionRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &AccountDeletionRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// Schedule records the deletion unless the user already has one and returns the stored record.
+func (r *AccountDeletionRepository) Schedule(ctx context.Context, deletion entities.AccountDeletion) (entities.AccountDeletion, error) {
+	if r.pool == nil {
+		return entities.AccountDeletion{}, errAccountDeletionNilPool
+	}
+
+	_, err := r.pool.Exec(ctx, `
+INSERT INTO account_deletions (user_id, requested_at, purge_after)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id) DO NOTHING`,
+		deletion.UserID,
+		deletion.RequestedAt.UTC(),
+		deletion.PurgeAfter.UTC(),
+	)
+	if err != nil {
+		return entities.AccountDeletion{}, mapPGError(err)
+	}
+
+	row := r.pool.QueryRow(ctx, accountDeletionSelectColumns+" WHERE user_id = $1", deletion.UserID)
+	return scanAccountDeletion(row)
+}
+
+// ListDue returns up to limit unpurged deletions whose retention ended at or before now.
+func (r *AccountDeletionRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]entities.AccountDeletion, error) {
+	if r.pool == nil {
+		return nil, errAccountDeletionNilPool
+	}
+	if limit <= 0 {
+		limit = repositories.DefaultListLimit
+	}
+
+	rows, err := r.pool.Query(ctx, accountDeletionSelectColumns+" WHERE purged_at IS NULL AND purge_after <= $1 ORDER BY purge_after ASC LIMIT $2", now.UTC(), limit)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	deletions := make([]entities.AccountDeletion, 0)
+	for rows.Next() {
+		deletion, err := scanAccountDeletion(rows)
+		if err != nil {
+			return nil, err
+		}
+		deletions = append(deletions, deletion)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return deletions, nil
+}
+
+// Purge hard-deletes the soft-deleted user and marks the deletion purged in one transaction.
+func (r *AccountDeletionRepository) Purge(ctx context.Context, userID uuid.UUID, at time.Time) error {
+	if r.pool == nil {
+		return errAccountDeletionNilPool
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return mapPGError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	cmd, err := tx.Exec(ctx, "DELETE FROM users WHERE id = $1 AND status = 'deleted'", userID)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		var exists bool
+		if err := tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM users WHERE id = $1)", userID).Scan(&exists); err != nil {
+			return mapPGError(err)
+		}
+		if exists {
+			return repositories.ErrConflict
+		}
+	}
+
+	if _, err := tx.Exec(ctx, "UPDATE account_deletions SET purged_at = $2 WHERE user_id = $1", userID, at.UTC()); err != nil {
+		return mapPGError(err)
+	}
+	return mapPGError(tx.Commit(ctx))
+}
+
+// Cancel drops the user's deletion unless it has already been purged.
+func (r *AccountDeletionRepository) Cancel(ctx context.Context, userID uuid.UUID) error {
+	if r.pool == nil {
+		return errAccountDeletionNilPool
+	}
+
+	if _, err := r.pool.Exec(ctx, "DELETE FROM account_deletions WHERE user_id = $1 AND purged_at IS NULL", userID); err != nil {
+		return mapPGError(err)
+	}
+	return nil
+}
+
+func scanAccountDeletion(row pgx.Row) (entities.AccountDeletion, error) {
+	var deletion entities.AccountDeletion
+	if err := row.Scan(
+		&deletion.UserID,
+		&deletion.RequestedAt,
+		&deletion.PurgeAfter,
+		&deletion.PurgedAt,
+	); err != nil {
+		return entities.AccountDeletion{}, mapPGError(err)
+	}
+	return deletion, nil
+}
@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+var errAccountMappingNilPool = errors.New("account mapping repository: database pool is not configured")
+
+// AccountMappingRepository persists accounting account mappings using PostgreSQL.
+type AccountMappingRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewAccountMappingRepository constructs an AccountMappingRepository backed by the provided pool.
+func NewAccountMappingRepository(pool *pgxpool.Pool, logger *slog.Logger) *AccountMappingRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &AccountMappingRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// ListByUser returns the user's mappings, fallback first.
+func (r *AccountMappingRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]entities.AccountMapping, error) {
+	if r.pool == nil {
+		return nil, errAccountMappingNilPool
+	}
+
+	rows, err := r.pool.Query(ctx,
+		"SELECT chain, asset_account, clearing_account, fee_account, updated_at FROM accounting_account_mappings WHERE user_id = $1 ORDER BY chain ASC",
+		userID)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	mappings := make([]entities.AccountMapping, 0)
+	for rows.Next() {
+		var (
+			mapping entities.AccountMapping
+			chain   string
+		)
+		if err := rows.Scan(&chain, &mapping.AssetAccount, &mapping.ClearingAccount, &mapping.FeeAccount, &mapping.UpdatedAt); err != nil {
+			return nil, mapPGError(err)
+		}
+		mapping.Chain = entities.Chain(chain)
+		mappings = append(mappings, mapping)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+
+	return mappings, nil
+}
+
+// ReplaceForUser swaps the user's full set of mappings atomically.
+func (r *AccountMappingRepository) ReplaceForUser(ctx context.Context, userID uuid.UUID, mappings []entities.AccountMapping) error {
+	if r.pool == nil {
+		return errAccountMappingNilPool
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return mapPGError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "DELETE FROM accounting_account_mappings WHERE user_id = $1", userID); err != nil {
+		return mapPGError(err)
+	}
+
+	for _, mapping := range mappings {
+		updatedAt := mapping.UpdatedAt
+		if updatedAt.IsZero() {
+			updatedAt = time.Now().UTC()
+		}
+		_, err := tx.Exec(ctx, `
+INSERT INTO accounting_account_mappings (
+	user_id,
+	chain,
+	asset_account,
+	clearing_account,
+	fee_account,
+	created_at,
+	updated_at
+) VALUES ($1,$2,$3,$4,$5,$6,$6)`,
+			userID,
+			string(mapping.Chain),
+			mapping.AssetAccount,
+			mapping.ClearingAccount,
+			mapping.FeeAccount,
+			updatedAt,
+		)
+		if err != nil {
+			return mapPGError(err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,194 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// activityTransactionSelect lists a user's transactions, leaving out the legs of exchange
+// operations, which the feed shows through the exchange itself.
+const activityTransactionSelect = `
+SELECT
+	'transaction' AS kind,
+	t.id,
+	t.created_at,
+	t.status::text,
+	t.type::text,
+	t.wallet_id,
+	t.chain::text,
+	t.amount,
+	t.fee,
+	t.tx_hash,
+	NULL::uuid AS to_wallet_id,
+	NULL::text AS to_chain,
+	NULL::numeric AS to_amount
+FROM transactions t
+JOIN wallets w ON w.id = t.wallet_id
+WHERE w.user_id = $1
+	AND NOT EXISTS (
+		SELECT 1 FROM exchange_operations leg
+		WHERE leg.from_transaction_id = t.id OR leg.to_transaction_id = t.id
+	)`
+
+// activityExchangeSelect lists a user's exchanges. Pending and cancelled operations are quotes
+// that were never executed, so only processing, completed and failed exchanges appear.
+const activityExchangeSelect = `
+SELECT
+	'exchange' AS kind,
+	e.id,
+	e.created_at,
+	e.status::text,
+	'exchange' AS type,
+	e.from_wallet_id,
+	fw.chain::text,
+	e.from_amount,
+	e.fee_amount,
+	'' AS tx_hash,
+	e.to_wallet_id,
+	tw.chain::text,
+	e.to_amount
+FROM exchange_operations e
+JOIN wallets fw ON fw.id = e.from_wallet_id
+JOIN wallets tw ON tw.id = e.to_wallet_id
+WHERE e.user_id = $1
+	AND e.status IN ('processing', 'completed', 'failed')`
+
+var errActivityNilPool = errors.New("activity repository: database pool is not configured")
+
+// ActivityRepository reads the merged activity feed using PostgreSQL.
+type ActivityRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewActivityRepository constructs an ActivityRepository backed by the provided pool.
+func NewActivityRepository(pool *pgxpool.Pool, logger *slog.Logger) *ActivityRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ActivityRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// ListRecentActivity pages the union of the user's transactions and exchanges newest first. Each
+// branch is limited on its own keyset index before the merge, so a page reads at most limit rows
+// from either table.
+func (r *ActivityRepository) ListRecentActivity(ctx context.Context, userID uuid.UUID, filter repositories.ActivityFilter, after *repositories.PageCursor, limit int) ([]entities.ActivityItem, error) {
+	if r.pool == nil {
+		return nil, errActivityNilPool
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+
+	args := []any{userID}
+	var transactionConds, exchangeConds []string
+	if filter.WalletID != nil {
+		args = append(args, *filter.WalletID)
+		transactionConds = append(transactionConds, fmt.Sprintf("t.wallet_id = $%d", len(args)))
+		exchangeConds = append(exchangeConds, fmt.Sprintf("(e.from_wallet_id = $%d OR e.to_wallet_id = $%d)", len(args), len(args)))
+	}
+	if after != nil {
+		args = append(args, after.CreatedAt.UTC(), after.ID)
+		transactionConds = append(transactionConds, fmt.Sprintf("(t.created_at, t.id) < ($%d, $%d)", len(args)-1, len(args)))
+		exchangeConds = append(exchangeConds, fmt.Sprintf("(e.created_at, e.id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
+	args = append(args, limit)
+	limitArg := len(args)
+
+	branch := func(query, alias string, conds []string) string {
+		for _, cond := range conds {
+			query += "\n\tAND " + cond
+		}
+		return fmt.Sprintf("(%s\nORDER BY %s.created_at DESC, %s.id DESC\nLIMIT $%d)", query, alias, alias, limitArg)
+	}
+
+	branches := make([]string, 0, 2)
+	if includesActivityKind(filter.Kinds, entities.ActivityKindTransaction) {
+		branches = append(branches, branch(activityTransactionSelect, "t", transactionConds))
+	}
+	if includesActivityKind(filter.Kinds, entities.ActivityKindExchange) {
+		branches = append(branches, branch(activityExchangeSelect, "e", exchangeConds))
+	}
+	if len(branches) == 0 {
+		return []entities.ActivityItem{}, nil
+	}
+
+	query := fmt.Sprintf("SELECT * FROM (%s) activity ORDER BY created_at DESC, id DESC LIMIT $%d",
+		strings.Join(branches, "\nUNION ALL\n"), limitArg)
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	items := make([]entities.ActivityItem, 0, limit)
+	for rows.Next() {
+		var (
+			item       entities.ActivityItem
+			kind       string
+			chain      string
+			txHash     *string
+			toWalletID *uuid.UUID
+			toChain    *string
+			toAmount   *decimal.Decimal
+		)
+		if err := rows.Scan(
+			&kind,
+			&item.ID,
+			&item.OccurredAt,
+			&item.Status,
+			&item.Type,
+			&item.WalletID,
+			&chain,
+			&item.Amount,
+			&item.Fee,
+			&txHash,
+			&toWalletID,
+			&toChain,
+			&toAmount,
+		); err != nil {
+			return nil, mapPGError(err)
+		}
+		item.Kind = entities.ActivityKind(kind)
+		item.Chain = entities.Chain(chain)
+		if txHash != nil {
+			item.TxHash = *txHash
+		}
+		item.ToWalletID = toWalletID
+		if toChain != nil {
+			item.ToChain = entities.Chain(*toChain)
+		}
+		item.ToAmount = toAmount
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return items, nil
+}
+
+func includesActivityKind(kinds []entities.ActivityKind, kind entities.ActivityKind) bool {
+	if len(kinds) == 0 {
+		return true
+	}
+	for _, candidate := range kinds {
+		if candidate == kind {
+			return true
+		}
+	}
+	return false
+}
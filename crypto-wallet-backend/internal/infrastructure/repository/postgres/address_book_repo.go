@@ -0,0 +1,265 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const addressBookSelectColumns = `
+SELECT
+	id,
+	user_id,
+	chain,
+	address,
+	label,
+	memo,
+	usable_at,
+	created_at,
+	updated_at
+FROM address_book_entries`
+
+var (
+	errAddressBookNilPool  = errors.New("address book repository: database pool is not configured")
+	errAddressBookNilEntry = errors.New("address book repository: entry entity is required")
+)
+
+// AddressBookRepository persists saved recipient addresses using PostgreSQL.
+type AddressBookRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewAddressBookRepository constructs an AddressBookRepository backed by the provided pool.
+func NewAddressBookRepository(pool *pgxpool.Pool, logger *slog.Logger) *AddressBookRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &AddressBookRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// Create stores a newly saved address.
+func (r *AddressBookRepository) Create(ctx context.Context, entry *entities.AddressBookEntryEntity) error {
+	if r.pool == nil {
+		return errAddressBookNilPool
+	}
+	if entry == nil {
+		return errAddressBookNilEntry
+	}
+
+	_, err := r.pool.Exec(ctx, `
+INSERT INTO address_book_entries (
+	id,
+	user_id,
+	chain,
+	address,
+	address_key,
+	label,
+	memo,
+	usable_at,
+	created_at,
+	updated_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)`,
+		entry.GetID(),
+		entry.GetUserID(),
+		string(entry.GetChain()),
+		entry.GetAddress(),
+		entities.AddressBookKey(entry.GetChain(), entry.GetAddress()),
+		entry.GetLabel(),
+		nullIfEmpty(entry.GetMemo()),
+		entry.GetUsableAt(),
+		entry.GetCreatedAt(),
+		entry.GetUpdatedAt(),
+	)
+	return mapPGError(err)
+}
+
+// GetByID fetches an entry by its identifier.
+func (r *AddressBookRepository) GetByID(ctx context.Context, id uuid.UUID) (entities.AddressBookEntry, error) {
+	if r.pool == nil {
+		return nil, errAddressBookNilPool
+	}
+
+	row := r.pool.QueryRow(ctx, addressBookSelectColumns+" WHERE id = $1", id)
+	return scanAddressBookEntry(row)
+}
+
+// FindByAddress fetches the user's entry for an address on a chain.
+func (r *AddressBookRepository) FindByAddress(ctx context.Context, userID uuid.UUID, chain entities.Chain, address string) (entities.AddressBookEntry, error) {
+	if r.pool == nil {
+		return nil, errAddressBookNilPool
+	}
+
+	row := r.pool.QueryRow(ctx,
+		addressBookSelectColumns+" WHERE user_id = $1 AND chain = $2 AND address_key = $3",
+		userID, string(chain), entities.AddressBookKey(chain, address))
+	return scanAddressBookEntry(row)
+}
+
+// ListByUser returns the user's entries, newest first.
+func (r *AddressBookRepository) ListByUser(ctx context.Context, userID uuid.UUID, chain *entities.Chain) ([]entities.AddressBookEntry, error) {
+	if r.pool == nil {
+		return nil, errAddressBookNilPool
+	}
+
+	var (
+		rows pgx.Rows
+		err  error
+	)
+	if chain != nil {
+		rows, err = r.pool.Query(ctx, addressBookSelectColumns+" WHERE user_id = $1 AND chain = $2 ORDER BY created_at DESC", userID, string(*chain))
+	} else {
+		rows, err = r.pool.Query(ctx, addressBookSelectColumns+" WHERE user_id = $1 ORDER BY created_at DESC", userID)
+	}
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	return scanAddressBookEntries(rows)
+}
+
+// CountByUser returns how many addresses the user has saved.
+func (r *AddressBookRepository) CountByUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	if r.pool == nil {
+		return 0, errAddressBookNilPool
+	}
+
+	var count int
+	if err := r.pool.QueryRow(ctx, "SELECT COUNT(*) FROM address_book_entries WHERE user_id = $1", userID).Scan(&count); err != nil {
+		return 0, mapPGError(err)
+	}
+	return count, nil
+}
+
+// UpdateLabel persists an entry's label and memo.
+func (r *AddressBookRepository) UpdateLabel(ctx context.Context, entry *entities.AddressBookEntryEntity) error {
+	if r.pool == nil {
+		return errAddressBookNilPool
+	}
+	if entry == nil {
+		return errAddressBookNilEntry
+	}
+
+	cmd, err := r.pool.Exec(ctx,
+		"UPDATE address_book_entries SET label = $2, memo = $3, updated_at = $4 WHERE id = $1",
+		entry.GetID(), entry.GetLabel(), nullIfEmpty(entry.GetMemo()), entry.GetUpdatedAt())
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+// Delete removes an entry.
+func (r *AddressBookRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if r.pool == nil {
+		return errAddressBookNilPool
+	}
+
+	cmd, err := r.pool.Exec(ctx, "DELETE FROM address_book_entries WHERE id = $1", id)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+// GetSettings returns the user's address book settings, defaulting to unrestricted sends.
+func (r *AddressBookRepository) GetSettings(ctx context.Context, userID uuid.UUID) (entities.AddressBookSettings, error) {
+	settings := entities.AddressBookSettings{UserID: userID}
+	if r.pool == nil {
+		return settings, errAddressBookNilPool
+	}
+
+	err := r.pool.QueryRow(ctx,
+		"SELECT whitelist_only, whitelist_off_at, updated_at FROM address_book_settings WHERE user_id = $1",
+		userID).Scan(&settings.WhitelistOnly, &settings.WhitelistOffAt, &settings.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return settings, nil
+	}
+	if err != nil {
+		return settings, mapPGError(err)
+	}
+	return settings, nil
+}
+
+// SaveSettings upserts the user's address book settings.
+func (r *AddressBookRepository) SaveSettings(ctx context.Context, settings entities.AddressBookSettings) error {
+	if r.pool == nil {
+		return errAddressBookNilPool
+	}
+
+	_, err := r.pool.Exec(ctx, `
+INSERT INTO address_book_settings (user_id, whitelist_only, whitelist_off_at, updated_at)
+VALUES ($1,$2,$3,$4)
+ON CONFLICT (user_id) DO UPDATE SET
+	whitelist_only = EXCLUDED.whitelist_only,
+	whitelist_off_at = EXCLUDED.whitelist_off_at,
+	updated_at = EXCLUDED.updated_at`,
+		settings.UserID,
+		settings.WhitelistOnly,
+		settings.WhitelistOffAt,
+		settings.UpdatedAt,
+	)
+	return mapPGError(err)
+}
+
+func scanAddressBookEntries(rows pgx.Rows) ([]entities.AddressBookEntry, error) {
+	defer rows.Close()
+
+	entries := make([]entities.AddressBookEntry, 0)
+	for rows.Next() {
+		entry, err := scanAddressBookEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+
+	return entries, nil
+}
+
+func scanAddressBookEntry(row pgx.Row) (entities.AddressBookEntry, error) {
+	var (
+		params entities.AddressBookEntryParams
+		chain  string
+		memo   *string
+	)
+
+	if err := row.Scan(
+		&params.ID,
+		&params.UserID,
+		&chain,
+		&params.Address,
+		&params.Label,
+		&memo,
+		&params.UsableAt,
+		&params.CreatedAt,
+		&params.UpdatedAt,
+	); err != nil {
+		return nil, mapPGError(err)
+	}
+
+	params.Chain = entities.Chain(chain)
+	if memo != nil {
+		params.Memo = *memo
+	}
+
+	return entities.HydrateAddressBookEntryEntity(params), nil
+}
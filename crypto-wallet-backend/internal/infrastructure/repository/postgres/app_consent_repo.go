@@ -0,0 +1,192 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const appConsentSelectColumns = `
+SELECT
+	c.id,
+	c.user_id,
+	c.client_id,
+	o.name,
+	c.scopes,
+	c.created_at,
+	c.revoked_at
+FROM app_consents c
+JOIN oauth_clients o ON o.client_id = c.client_id`
+
+var errAppConsentNilPool = errors.New("app consent repository: database pool is not configured")
+
+// AppConsentRepository persists third-party app registrations and user consents using PostgreSQL.
+type AppConsentRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewAppConsentRepository constructs an AppConsentRepository backed by the provided pool.
+func NewAppConsentRepository(pool *pgxpool.Pool, logger *slog.Logger) *AppConsentRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &AppConsentRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// GetClient returns the registered client or ErrNotFound.
+func (r *AppConsentRepository) GetClient(ctx context.Context, clientID string) (entities.OAuthClient, error) {
+	if r.pool == nil {
+		return entities.OAuthClient{}, errAppConsentNilPool
+	}
+
+	var (
+		client entities.OAuthClient
+		scopes []string
+	)
+	err := r.pool.QueryRow(ctx, `
+SELECT client_id, name, allowed_scopes, is_active, created_at
+FROM oauth_clients
+WHERE client_id = $1`, clientID).Scan(
+		&client.ID,
+		&client.Name,
+		&scopes,
+		&client.IsActive,
+		&client.CreatedAt,
+	)
+	if err != nil {
+		return entities.OAuthClient{}, mapPGError(err)
+	}
+
+	client.AllowedScopes = toOAuthScopes(scopes)
+	return client, nil
+}
+
+// CreateConsent stores a new consent.
+func (r *AppConsentRepository) CreateConsent(ctx context.Context, consent entities.AppConsent) error {
+	if r.pool == nil {
+		return errAppConsentNilPool
+	}
+
+	createdAt := consent.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now().UTC()
+	}
+
+	scopes := make([]string, len(consent.Scopes))
+	for i, scope := range consent.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	_, err := r.pool.Exec(ctx, `
+INSERT INTO app_consents (
+	id,
+	user_id,
+	client_id,
+	scopes,
+	created_at
+) VALUES ($1,$2,$3,$4,$5)`,
+		consent.ID,
+		consent.UserID,
+		consent.ClientID,
+		scopes,
+		createdAt,
+	)
+	return mapPGError(err)
+}
+
+// GetConsent returns the consent, revoked or not, or ErrNotFound.
+func (r *AppConsentRepository) GetConsent(ctx context.Context, id uuid.UUID) (entities.AppConsent, error) {
+	if r.pool == nil {
+		return entities.AppConsent{}, errAppConsentNilPool
+	}
+
+	row := r.pool.QueryRow(ctx, appConsentSelectColumns+" WHERE c.id = $1", id)
+	return scanAppConsent(row)
+}
+
+// ListActiveConsents returns the user's unrevoked consents, newest first.
+func (r *AppConsentRepository) ListActiveConsents(ctx context.Context, userID uuid.UUID) ([]entities.AppConsent, error) {
+	if r.pool == nil {
+		return nil, errAppConsentNilPool
+	}
+
+	rows, err := r.pool.Query(ctx, appConsentSelectColumns+" WHERE c.user_id = $1 AND c.revoked_at IS NULL ORDER BY c.created_at DESC", userID)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	consents := make([]entities.AppConsent, 0)
+	for rows.Next() {
+		consent, err := scanAppConsent(rows)
+		if err != nil {
+			return nil, err
+		}
+		consents = append(consents, consent)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return consents, nil
+}
+
+// RevokeConsent marks the user's active consent revoked.
+func (r *AppConsentRepository) RevokeConsent(ctx context.Context, userID, id uuid.UUID, at time.Time) error {
+	if r.pool == nil {
+		return errAppConsentNilPool
+	}
+
+	cmd, err := r.pool.Exec(ctx, `
+UPDATE app_consents
+SET revoked_at = $3
+WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL`, id, userID, at)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+func scanAppConsent(row pgx.Row) (entities.AppConsent, error) {
+	var (
+		consent entities.AppConsent
+		scopes  []string
+	)
+
+	if err := row.Scan(
+		&consent.ID,
+		&consent.UserID,
+		&consent.ClientID,
+		&consent.ClientName,
+		&scopes,
+		&consent.CreatedAt,
+		&consent.RevokedAt,
+	); err != nil {
+		return entities.AppConsent{}, mapPGError(err)
+	}
+
+	consent.Scopes = toOAuthScopes(scopes)
+	return consent, nil
+}
+
+func toOAuthScopes(values []string) []entities.OAuthScope {
+	scopes := make([]entities.OAuthScope, 0, len(values))
+	for _, value := range values {
+		scopes = append(scopes, entities.OAuthScope(value))
+	}
+	return scopes
+}
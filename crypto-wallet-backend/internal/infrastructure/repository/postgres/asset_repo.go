@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+const assetSelectColumns = `
+SELECT
+	id,
+	symbol,
+	name,
+	chain,
+	COALESCE(contract_address, ''),
+	decimals,
+	is_native,
+	COALESCE(coingecko_id, ''),
+	is_active,
+	created_at,
+	updated_at
+FROM assets`
+
+var errAssetNilPool = errors.New("asset repository: database pool is not configured")
+
+// AssetRepository reads the asset registry from PostgreSQL.
+type AssetRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewAssetRepository constructs an AssetRepository backed by the provided pool.
+func NewAssetRepository(pool *pgxpool.Pool, logger *slog.Logger) *AssetRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &AssetRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// GetByID returns the asset with the identifier or ErrNotFound.
+func (r *AssetRepository) GetByID(ctx context.Context, id uuid.UUID) (entities.Asset, error) {
+	if r.pool == nil {
+		return entities.Asset{}, errAssetNilPool
+	}
+
+	asset, err := scanAsset(r.pool.QueryRow(ctx, assetSelectColumns+" WHERE id = $1", id))
+	if err != nil {
+		return entities.Asset{}, mapPGError(err)
+	}
+	return asset, nil
+}
+
+// GetNative returns the chain's native asset or ErrNotFound.
+func (r *AssetRepository) GetNative(ctx context.Context, chain entities.Chain) (entities.Asset, error) {
+	if r.pool == nil {
+		return entities.Asset{}, errAssetNilPool
+	}
+
+	asset, err := scanAsset(r.pool.QueryRow(ctx, assetSelectColumns+" WHERE chain = $1 AND is_native", string(chain)))
+	if err != nil {
+		return entities.Asset{}, mapPGError(err)
+	}
+	return asset, nil
+}
+
+// List returns registered assets ordered by chain and symbol.
+func (r *AssetRepository) List(ctx context.Context, activeOnly bool) ([]entities.Asset, error) {
+	if r.pool == nil {
+		return nil, errAssetNilPool
+	}
+
+	query := assetSelectColumns
+	if activeOnly {
+		query += " WHERE is_active"
+	}
+	query += " ORDER BY chain, symbol"
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	assets := make([]entities.Asset, 0)
+	for rows.Next() {
+		asset, err := scanAsset(rows)
+		if err != nil {
+			return nil, mapPGError(err)
+		}
+		assets = append(assets, asset)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return assets, nil
+}
+
+func scanAsset(row pgx.Row) (entities.Asset, error) {
+	var (
+		asset entities.Asset
+		chain string
+	)
+	err := row.Scan(
+		&asset.ID,
+		&asset.Symbol,
+		&asset.Name,
+		&chain,
+		&asset.ContractAddress,
+		&asset.Decimals,
+		&asset.IsNative,
+		&asset.CoingeckoID,
+		&asset.IsActive,
+		&asset.CreatedAt,
+		&asset.UpdatedAt,
+	)
+	if err != nil {
+		return entities.Asset{}, err
+	}
+	asset.Chain = entities.Chain(chain)
+	return asset, nil
+}
+
+// nullableUUID stores uuid.Nil as NULL so unlinked asset references stay empty.
+func nullableUUID(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+	return &id
+}
+
+func derefUUID(id *uuid.UUID) uuid.UUID {
+	if id == nil {
+		return uuid.Nil
+	}
+	return *id
+}
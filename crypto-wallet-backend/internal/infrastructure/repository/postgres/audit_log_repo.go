@@ -0,0 +1,459 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const auditRecordSelectColumns = `
+SELECT
+	id,
+	sequence,
+	user_id,
+	action::text,
+	COALESCE(resource_type, ''),
+	resource_id,
+	details,
+	COALESCE(host(ip_address), ''),
+	COALESCE(user_agent, ''),
+	COALESCE(session_id, ''),
+	result,
+	COALESCE(error_message, ''),
+	timestamp,
+	COALESCE(prev_hash, ''),
+	COALESCE(record_hash, '')
+FROM audit_logs`
+
+const auditArchiveSelectColumns = `
+SELECT
+	id,
+	from_sequence,
+	to_sequence,
+	from_timestamp,
+	to_timestamp,
+	record_count,
+	location,
+	checksum,
+	COALESCE(last_hash, ''),
+	archived_at
+FROM audit_log_archives`
+
+// auditChainLockKey serialises appends so every record links to its true predecessor.
+const auditChainLockKey = "audit_logs_chain"
+
+var errAuditNilPool = errors.New("audit log repository: database pool is not configured")
+
+// AuditLogRepository persists the hash-chained audit log in the audit database.
+type AuditLogRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewAuditLogRepository constructs an AuditLogRepository backed by the provided pool.
+func NewAuditLogRepository(pool *pgxpool.Pool, logger *slog.Logger) *AuditLogRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &AuditLogRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// Append chains the record onto the latest record and stores it.
+func (r *AuditLogRepository) Append(ctx context.Context, record *entities.AuditRecord) error {
+	if r.pool == nil {
+		return errAuditNilPool
+	}
+	if record == nil {
+		return errors.New("audit log repository: record is required")
+	}
+	if record.ID == uuid.Nil {
+		record.ID = uuid.New()
+	}
+	if record.Timestamp.IsZero() {
+		record.Timestamp = time.Now().UTC()
+	}
+	if record.Result == "" {
+		record.Result = "success"
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return mapPGError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", auditChainLockKey); err != nil {
+		return mapPGError(err)
+	}
+
+	prevHash, err := latestAuditHash(ctx, tx)
+	if err != nil {
+		return err
+	}
+	if err := record.Chain(prevHash); err != nil {
+		return fmt.Errorf("audit log repository: hash record: %w", err)
+	}
+
+	details, err := json.Marshal(record.Details)
+	if err != nil {
+		return fmt.Errorf("audit log repository: encode details: %w", err)
+	}
+	if record.Details == nil {
+		details = []byte("{}")
+	}
+
+	err = tx.QueryRow(ctx, `
+INSERT INTO audit_logs (
+	id,
+	user_id,
+	action,
+	resource_type,
+	resource_id,
+	details,
+	ip_address,
+	user_agent,
+	session_id,
+	result,
+	error_message,
+	timestamp,
+	prev_hash,
+	record_hash
+) VALUES ($1,$2,$3,$4,$5,$6,$7::inet,$8,$9,$10,$11,$12,$13,$14)
+RETURNING sequence`,
+		record.ID,
+		record.UserID,
+		record.Action,
+		nullIfEmpty(record.ResourceType),
+		record.ResourceID,
+		details,
+		nullIfEmpty(record.IPAddress),
+		nullIfEmpty(record.UserAgent),
+		nullIfEmpty(record.SessionID),
+		record.Result,
+		nullIfEmpty(record.ErrorMessage),
+		record.Timestamp,
+		record.PrevHash,
+		record.Hash,
+	).Scan(&record.Sequence)
+	if err != nil {
+		return mapPGError(err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Search returns records matching the filter, newest first, with the total match count.
+func (r *AuditLogRepository) Search(ctx context.Context, filter repositories.AuditLogFilter, opts repositories.ListOptions) ([]entities.AuditRecord, int64, error) {
+	if r.pool == nil {
+		return nil, 0, errAuditNilPool
+	}
+
+	options := opts.WithDefaults()
+	conditions := make([]string, 0, 7)
+	args := make([]any, 0, 9)
+
+	if filter.ActorID != nil {
+		args = append(args, *filter.ActorID)
+		conditions = append(conditions, fmt.Sprintf("user_id = $%d", len(args)))
+	}
+	if len(filter.Actions) > 0 {
+		args = append(args, filter.Actions)
+		conditions = append(conditions, fmt.Sprintf("action::text = ANY($%d)", len(args)))
+	}
+	if filter.ResourceType != "" {
+		args = append(args, filter.ResourceType)
+		conditions = append(conditions, fmt.Sprintf("resource_type = $%d", len(args)))
+	}
+	if filter.ResourceID != nil {
+		args = append(args, *filter.ResourceID)
+		conditions = append(conditions, fmt.Sprintf("resource_id = $%d", len(args)))
+	}
+	if filter.IPAddress != "" {
+		args = append(args, filter.IPAddress)
+		conditions = append(conditions, fmt.Sprintf("ip_address <<= $%d::inet", len(args)))
+	}
+	if filter.From != nil {
+		args = append(args, filter.From.UTC())
+		conditions = append(conditions, fmt.Sprintf("timestamp >= $%d", len(args)))
+	}
+	if filter.To != nil {
+		args = append(args, filter.To.UTC())
+		conditions = append(conditions, fmt.Sprintf("timestamp <= $%d", len(args)))
+	}
+
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int64
+	if err := r.pool.QueryRow(ctx, "SELECT COUNT(*) FROM audit_logs"+whereClause, args...).Scan(&total); err != nil {
+		return nil, 0, mapPGError(err)
+	}
+
+	sortOrder := "DESC"
+	if options.SortOrder == repositories.SortAscending {
+		sortOrder = "ASC"
+	}
+	query := fmt.Sprintf("%s%s ORDER BY timestamp %s, sequence %s LIMIT $%d OFFSET $%d",
+		auditRecordSelectColumns, whereClause, sortOrder, sortOrder, len(args)+1, len(args)+2)
+	args = append(args, options.Limit, options.Offset)
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, mapPGError(err)
+	}
+	defer rows.Close()
+
+	records, err := collectAuditRecords(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return records, total, nil
+}
+
+// ListAfterSequence returns chained records in sequence order, starting after the given sequence.
+func (r *AuditLogRepository) ListAfterSequence(ctx context.Context, afterSequence int64, limit int) ([]entities.AuditRecord, error) {
+	if r.pool == nil {
+		return nil, errAuditNilPool
+	}
+	if limit <= 0 {
+		limit = 1000
+	}
+
+	rows, err := r.pool.Query(ctx,
+		auditRecordSelectColumns+" WHERE sequence > $1 AND record_hash IS NOT NULL ORDER BY sequence ASC LIMIT $2",
+		afterSequence, limit)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	return collectAuditRecords(rows)
+}
+
+// ListBefore returns the oldest records written before cutoff, in sequence order.
+func (r *AuditLogRepository) ListBefore(ctx context.Context, cutoff time.Time, limit int) ([]entities.AuditRecord, error) {
+	if r.pool == nil {
+		return nil, errAuditNilPool
+	}
+	if limit <= 0 {
+		limit = 1000
+	}
+
+	rows, err := r.pool.Query(ctx,
+		auditRecordSelectColumns+" WHERE timestamp < $1 ORDER BY sequence ASC LIMIT $2",
+		cutoff, limit)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	return collectAuditRecords(rows)
+}
+
+// LatestArchive returns the most recent archive.
+func (r *AuditLogRepository) LatestArchive(ctx context.Context) (entities.AuditArchive, error) {
+	if r.pool == nil {
+		return entities.AuditArchive{}, errAuditNilPool
+	}
+
+	row := r.pool.QueryRow(ctx, auditArchiveSelectColumns+" ORDER BY to_sequence DESC LIMIT 1")
+	return scanAuditArchive(row)
+}
+
+// ListArchives returns archives, newest first.
+func (r *AuditLogRepository) ListArchives(ctx context.Context, opts repositories.ListOptions) ([]entities.AuditArchive, error) {
+	if r.pool == nil {
+		return nil, errAuditNilPool
+	}
+
+	options := opts.WithDefaults()
+	rows, err := r.pool.Query(ctx, auditArchiveSelectColumns+" ORDER BY to_sequence DESC LIMIT $1 OFFSET $2",
+		options.Limit, options.Offset)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	archives := make([]entities.AuditArchive, 0)
+	for rows.Next() {
+		archive, err := scanAuditArchive(rows)
+		if err != nil {
+			return nil, err
+		}
+		archives = append(archives, archive)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return archives, nil
+}
+
+// RecordArchive stores the archive and deletes the records it covers in one transaction.
+func (r *AuditLogRepository) RecordArchive(ctx context.Context, archive entities.AuditArchive) error {
+	if r.pool == nil {
+		return errAuditNilPool
+	}
+	if archive.ID == uuid.Nil {
+		archive.ID = uuid.New()
+	}
+	if archive.ArchivedAt.IsZero() {
+		archive.ArchivedAt = time.Now().UTC()
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return mapPGError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+INSERT INTO audit_log_archives (
+	id,
+	from_sequence,
+	to_sequence,
+	from_timestamp,
+	to_timestamp,
+	record_count,
+	location,
+	checksum,
+	last_hash,
+	archived_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)`,
+		archive.ID,
+		archive.FromSequence,
+		archive.ToSequence,
+		archive.FromTimestamp,
+		archive.ToTimestamp,
+		archive.RecordCount,
+		archive.Location,
+		archive.Checksum,
+		nullIfEmpty(archive.LastHash),
+		archive.ArchivedAt,
+	)
+	if err != nil {
+		return mapPGError(err)
+	}
+
+	cmd, err := tx.Exec(ctx, "DELETE FROM audit_logs WHERE sequence BETWEEN $1 AND $2", archive.FromSequence, archive.ToSequence)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() != int64(archive.RecordCount) {
+		return repositories.ErrConflict
+	}
+
+	return tx.Commit(ctx)
+}
+
+// latestAuditHash returns the hash new records chain onto, falling back to the latest archive.
+func latestAuditHash(ctx context.Context, tx pgx.Tx) (string, error) {
+	var hash string
+	err := tx.QueryRow(ctx,
+		"SELECT record_hash FROM audit_logs WHERE record_hash IS NOT NULL ORDER BY sequence DESC LIMIT 1",
+	).Scan(&hash)
+	if err == nil {
+		return hash, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return "", mapPGError(err)
+	}
+
+	err = tx.QueryRow(ctx,
+		"SELECT COALESCE(last_hash, '') FROM audit_log_archives ORDER BY to_sequence DESC LIMIT 1",
+	).Scan(&hash)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entities.AuditGenesisHash, nil
+	}
+	if err != nil {
+		return "", mapPGError(err)
+	}
+	if hash == "" {
+		return entities.AuditGenesisHash, nil
+	}
+	return hash, nil
+}
+
+func collectAuditRecords(rows pgx.Rows) ([]entities.AuditRecord, error) {
+	records := make([]entities.AuditRecord, 0)
+	for rows.Next() {
+		record, err := scanAuditRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return records, nil
+}
+
+func scanAuditRecord(row pgx.Row) (entities.AuditRecord, error) {
+	var (
+		record  entities.AuditRecord
+		details []byte
+	)
+
+	if err := row.Scan(
+		&record.ID,
+		&record.Sequence,
+		&record.UserID,
+		&record.Action,
+		&record.ResourceType,
+		&record.ResourceID,
+		&details,
+		&record.IPAddress,
+		&record.UserAgent,
+		&record.SessionID,
+		&record.Result,
+		&record.ErrorMessage,
+		&record.Timestamp,
+		&record.PrevHash,
+		&record.Hash,
+	); err != nil {
+		return entities.AuditRecord{}, mapPGError(err)
+	}
+
+	if len(details) > 0 {
+		if err := json.Unmarshal(details, &record.Details); err != nil {
+			return entities.AuditRecord{}, fmt.Errorf("audit log repository: decode details: %w", err)
+		}
+	}
+
+	return record, nil
+}
+
+func scanAuditArchive(row pgx.Row) (entities.AuditArchive, error) {
+	var archive entities.AuditArchive
+	if err := row.Scan(
+		&archive.ID,
+		&archive.FromSequence,
+		&archive.ToSequence,
+		&archive.FromTimestamp,
+		&archive.ToTimestamp,
+		&archive.RecordCount,
+		&archive.Location,
+		&archive.Checksum,
+		&archive.LastHash,
+		&archive.ArchivedAt,
+	); err != nil {
+		return entities.AuditArchive{}, mapPGError(err)
+	}
+	return archive, nil
+}
@@ -0,0 +1,394 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const reconciliationRunSelectColumns = `
+SELECT
+	id,
+	chain,
+	mode,
+	scanned,
+	matched,
+	corrected,
+	escalated,
+	failed,
+	started_at,
+	finished_at
+FROM balance_reconciliation_runs`
+
+const balanceDiscrepancySelectColumns = `
+SELECT
+	id,
+	run_id,
+	wallet_id,
+	user_id,
+	chain,
+	address,
+	stored_balance,
+	on_chain_balance,
+	difference,
+	magnitude,
+	status,
+	resolved_by,
+	resolution,
+	detected_at,
+	resolved_at
+FROM balance_discrepancies`
+
+var (
+	errReconciliationNilPool        = errors.New("balance reconciliation repository: database pool is not configured")
+	errReconciliationNilRun         = errors.New("balance reconciliation repository: run is required")
+	errReconciliationNilDiscrepancy = errors.New("balance reconciliation repository: discrepancy is required")
+)
+
+// BalanceReconciliationRepository persists reconciliation runs and discrepancies using PostgreSQL.
+type BalanceReconciliationRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewBalanceReconciliationRepository constructs a BalanceReconciliationRepository backed by the provided pool.
+func NewBalanceReconciliationRepository(pool *pgxpool.Pool, logger *slog.Logger) *BalanceReconciliationRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &BalanceReconciliationRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// CreateRun stores a started run.
+func (r *BalanceReconciliationRepository) CreateRun(ctx context.Context, run *entities.BalanceReconciliationRun) error {
+	if r.pool == nil {
+		return errReconciliationNilPool
+	}
+	if run == nil {
+		return errReconciliationNilRun
+	}
+	if run.ID == uuid.Nil {
+		run.ID = uuid.New()
+	}
+	if run.StartedAt.IsZero() {
+		run.StartedAt = time.Now().UTC()
+	}
+
+	_, err := r.pool.Exec(ctx, `
+INSERT INTO balance_reconciliation_runs (id, chain, mode, started_at)
+VALUES ($1,$2,$3,$4)`,
+		run.ID,
+		string(run.Chain),
+		string(run.Mode),
+		run.StartedAt,
+	)
+	return mapPGError(err)
+}
+
+// FinishRun stores the run's counts and finish time.
+func (r *BalanceReconciliationRepository) FinishRun(ctx context.Context, run *entities.BalanceReconciliationRun) error {
+	if r.pool == nil {
+		return errReconciliationNilPool
+	}
+	if run == nil {
+		return errReconciliationNilRun
+	}
+
+	cmd, err := r.pool.Exec(ctx, `
+UPDATE balance_reconciliation_runs
+SET scanned = $2,
+	matched = $3,
+	corrected = $4,
+	escalated = $5,
+	failed = $6,
+	finished_at = $7
+WHERE id = $1`,
+		run.ID,
+		run.Scanned,
+		run.Matched,
+		run.Corrected,
+		run.Escalated,
+		run.Failed,
+		run.FinishedAt,
+	)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+// ListRuns returns runs, newest first, optionally restricted to one chain.
+func (r *BalanceReconciliationRepository) ListRuns(ctx context.Context, chain *entities.Chain, opts repositories.ListOptions) ([]entities.BalanceReconciliationRun, error) {
+	if r.pool == nil {
+		return nil, errReconciliationNilPool
+	}
+
+	opts = opts.WithDefaults()
+
+	var (
+		rows pgx.Rows
+		err  error
+	)
+	if chain != nil {
+		rows, err = r.pool.Query(ctx, reconciliationRunSelectColumns+" WHERE chain = $1 ORDER BY started_at DESC LIMIT $2 OFFSET $3", string(*chain), opts.Limit, opts.Offset)
+	} else {
+		rows, err = r.pool.Query(ctx, reconciliationRunSelectColumns+" ORDER BY started_at DESC LIMIT $1 OFFSET $2", opts.Limit, opts.Offset)
+	}
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	runs := make([]entities.BalanceReconciliationRun, 0)
+	for rows.Next() {
+		var (
+			run        entities.BalanceReconciliationRun
+			chainValue string
+			modeValue  string
+			finishedAt pgtype.Timestamptz
+		)
+		if err := rows.Scan(
+			&run.ID,
+			&chainValue,
+			&modeValue,
+			&run.Scanned,
+			&run.Matched,
+			&run.Corrected,
+			&run.Escalated,
+			&run.Failed,
+			&run.StartedAt,
+			&finishedAt,
+		); err != nil {
+			return nil, mapPGError(err)
+		}
+		run.Chain = entities.Chain(chainValue)
+		run.Mode = entities.BalanceReconciliationMode(modeValue)
+		run.StartedAt = run.StartedAt.UTC()
+		if finishedAt.Valid {
+			t := finishedAt.Time.UTC()
+			run.FinishedAt = &t
+		}
+		runs = append(runs, run)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return runs, nil
+}
+
+// RecordDiscrepancy stores a discrepancy, folding a repeated open discrepancy into the wallet's
+// existing review item.
+func (r *BalanceReconciliationRepository) RecordDiscrepancy(ctx context.Context, discrepancy *entities.BalanceDiscrepancy) error {
+	if r.pool == nil {
+		return errReconciliationNilPool
+	}
+	if discrepancy == nil {
+		return errReconciliationNilDiscrepancy
+	}
+	if discrepancy.ID == uuid.Nil {
+		discrepancy.ID = uuid.New()
+	}
+
+	row := r.pool.QueryRow(ctx, `
+INSERT INTO balance_discrepancies (
+	id,
+	run_id,
+	wallet_id,
+	user_id,
+	chain,
+	address,
+	stored_balance,
+	on_chain_balance,
+	difference,
+	magnitude,
+	status,
+	resolution,
+	detected_at,
+	resolved_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14)
+ON CONFLICT (wallet_id) WHERE status = 'open' DO UPDATE SET
+	run_id = EXCLUDED.run_id,
+	stored_balance = EXCLUDED.stored_balance,
+	on_chain_balance = EXCLUDED.on_chain_balance,
+	difference = EXCLUDED.difference,
+	magnitude = EXCLUDED.magnitude,
+	detected_at = EXCLUDED.detected_at
+RETURNING id`,
+		discrepancy.ID,
+		discrepancy.RunID,
+		discrepancy.WalletID,
+		discrepancy.UserID,
+		string(discrepancy.Chain),
+		discrepancy.Address,
+		discrepancy.StoredBalance,
+		discrepancy.OnChainBalance,
+		discrepancy.Difference,
+		discrepancy.Magnitude,
+		string(discrepancy.Status),
+		nullIfEmpty(discrepancy.Resolution),
+		discrepancy.DetectedAt,
+		discrepancy.ResolvedAt,
+	)
+	return mapPGError(row.Scan(&discrepancy.ID))
+}
+
+// GetDiscrepancy fetches a discrepancy by its identifier.
+func (r *BalanceReconciliationRepository) GetDiscrepancy(ctx context.Context, id uuid.UUID) (*entities.BalanceDiscrepancy, error) {
+	if r.pool == nil {
+		return nil, errReconciliationNilPool
+	}
+
+	row := r.pool.QueryRow(ctx, balanceDiscrepancySelectColumns+" WHERE id = $1", id)
+	discrepancy, err := scanBalanceDiscrepancy(row)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	return discrepancy, nil
+}
+
+// ListDiscrepancies returns discrepancies matching the filter, largest magnitude first.
+func (r *BalanceReconciliationRepository) ListDiscrepancies(ctx context.Context, filter repositories.BalanceDiscrepancyFilter, opts repositories.ListOptions) ([]entities.BalanceDiscrepancy, error) {
+	if r.pool == nil {
+		return nil, errReconciliationNilPool
+	}
+
+	opts = opts.WithDefaults()
+
+	conditions := make([]string, 0, 3)
+	args := make([]any, 0, 5)
+	if filter.Status != nil {
+		args = append(args, string(*filter.Status))
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	}
+	if filter.Chain != nil {
+		args = append(args, string(*filter.Chain))
+		conditions = append(conditions, fmt.Sprintf("chain = $%d", len(args)))
+	}
+	if filter.RunID != nil {
+		args = append(args, *filter.RunID)
+		conditions = append(conditions, fmt.Sprintf("run_id = $%d", len(args)))
+	}
+
+	query := strings.Builder{}
+	query.WriteString(balanceDiscrepancySelectColumns)
+	if len(conditions) > 0 {
+		query.WriteString(" WHERE ")
+		query.WriteString(strings.Join(conditions, " AND "))
+	}
+	args = append(args, opts.Limit, opts.Offset)
+	query.WriteString(fmt.Sprintf(" ORDER BY magnitude DESC, detected_at DESC LIMIT $%d OFFSET $%d", len(args)-1, len(args)))
+
+	rows, err := r.pool.Query(ctx, query.String(), args...)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	results := make([]entities.BalanceDiscrepancy, 0)
+	for rows.Next() {
+		discrepancy, err := scanBalanceDiscrepancy(rows)
+		if err != nil {
+			return nil, mapPGError(err)
+		}
+		results = append(results, *discrepancy)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return results, nil
+}
+
+// ResolveDiscrepancy closes an open discrepancy.
+func (r *BalanceReconciliationRepository) ResolveDiscrepancy(ctx context.Context, discrepancy *entities.BalanceDiscrepancy) error {
+	if r.pool == nil {
+		return errReconciliationNilPool
+	}
+	if discrepancy == nil {
+		return errReconciliationNilDiscrepancy
+	}
+
+	cmd, err := r.pool.Exec(ctx, `
+UPDATE balance_discrepancies
+SET status = $2,
+	resolved_by = $3,
+	resolution = $4,
+	resolved_at = $5
+WHERE id = $1 AND status = 'open'`,
+		discrepancy.ID,
+		string(discrepancy.Status),
+		discrepancy.ResolvedBy,
+		nullIfEmpty(discrepancy.Resolution),
+		discrepancy.ResolvedAt,
+	)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrConflict
+	}
+	return nil
+}
+
+func scanBalanceDiscrepancy(row pgx.Row) (*entities.BalanceDiscrepancy, error) {
+	var (
+		discrepancy entities.BalanceDiscrepancy
+		chainValue  string
+		statusValue string
+		resolvedBy  pgtype.UUID
+		resolution  pgtype.Text
+		resolvedAt  pgtype.Timestamptz
+	)
+
+	if err := row.Scan(
+		&discrepancy.ID,
+		&discrepancy.RunID,
+		&discrepancy.WalletID,
+		&discrepancy.UserID,
+		&chainValue,
+		&discrepancy.Address,
+		&discrepancy.StoredBalance,
+		&discrepancy.OnChainBalance,
+		&discrepancy.Difference,
+		&discrepancy.Magnitude,
+		&statusValue,
+		&resolvedBy,
+		&resolution,
+		&discrepancy.DetectedAt,
+		&resolvedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	discrepancy.Chain = entities.Chain(chainValue)
+	discrepancy.Status = entities.BalanceDiscrepancyStatus(statusValue)
+	discrepancy.DetectedAt = discrepancy.DetectedAt.UTC()
+	if resolvedBy.Valid {
+		id := uuid.UUID(resolvedBy.Bytes)
+		discrepancy.ResolvedBy = &id
+	}
+	if resolution.Valid {
+		discrepancy.Resolution = resolution.String
+	}
+	if resolvedAt.Valid {
+		t := resolvedAt.Time.UTC()
+		discrepancy.ResolvedAt = &t
+	}
+	return &discrepancy, nil
+}
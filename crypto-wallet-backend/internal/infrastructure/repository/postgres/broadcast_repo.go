@@ -0,0 +1,471 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const broadcastSelectColumns = `
+SELECT
+	id,
+	name,
+	title,
+	body,
+	segment,
+	status,
+	scheduled_at,
+	throttle_per_minute,
+	recipient_count,
+	created_by,
+	started_at,
+	completed_at,
+	cancelled_at,
+	created_at,
+	updated_at
+FROM broadcast_campaigns`
+
+var (
+	errBroadcastNilPool     = errors.New("broadcast repository: database pool is not configured")
+	errBroadcastNilCampaign = errors.New("broadcast repository: campaign entity is required")
+)
+
+// BroadcastRepository persists broadcast campaigns and their deliveries using PostgreSQL.
+type BroadcastRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewBroadcastRepository constructs a BroadcastRepository backed by the provided pool.
+func NewBroadcastRepository(pool *pgxpool.Pool, logger *slog.Logger) *BroadcastRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &BroadcastRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// Create stores a newly scheduled campaign.
+func (r *BroadcastRepository) Create(ctx context.Context, campaign *entities.BroadcastCampaignEntity) error {
+	if r.pool == nil {
+		return errBroadcastNilPool
+	}
+	if campaign == nil {
+		return errBroadcastNilCampaign
+	}
+
+	segment, err := json.Marshal(campaign.GetSegment())
+	if err != nil {
+		return fmt.Errorf("broadcast repository: encode segment: %w", err)
+	}
+
+	_, err = r.pool.Exec(ctx, `
+INSERT INTO broadcast_campaigns (
+	id,
+	name,
+	title,
+	body,
+	segment,
+	status,
+	scheduled_at,
+	throttle_per_minute,
+	created_by,
+	created_at,
+	updated_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)`,
+		campaign.GetID(),
+		campaign.GetName(),
+		campaign.GetTitle(),
+		campaign.GetBody(),
+		segment,
+		campaign.GetStatus(),
+		campaign.GetScheduledAt().UTC(),
+		campaign.GetThrottlePerMinute(),
+		campaign.GetCreatedBy(),
+		campaign.GetCreatedAt().UTC(),
+		campaign.GetUpdatedAt().UTC(),
+	)
+	return mapPGError(err)
+}
+
+// GetByID fetches a campaign by its identifier.
+func (r *BroadcastRepository) GetByID(ctx context.Context, id uuid.UUID) (entities.BroadcastCampaign, error) {
+	if r.pool == nil {
+		return nil, errBroadcastNilPool
+	}
+
+	row := r.pool.QueryRow(ctx, broadcastSelectColumns+" WHERE id = $1", id)
+	return scanBroadcastCampaign(row)
+}
+
+// List returns campaigns, newest first.
+func (r *BroadcastRepository) List(ctx context.Context, filter repositories.BroadcastFilter, opts repositories.ListOptions) ([]entities.BroadcastCampaign, error) {
+	if r.pool == nil {
+		return nil, errBroadcastNilPool
+	}
+
+	options := opts.WithDefaults()
+	args := make([]any, 0, 3)
+	whereClause := ""
+	if filter.Status != nil {
+		args = append(args, string(*filter.Status))
+		whereClause = fmt.Sprintf(" WHERE status = $%d", len(args))
+	}
+
+	sortOrder := "DESC"
+	if options.SortOrder == repositories.SortAscending {
+		sortOrder = "ASC"
+	}
+	query := fmt.Sprintf("%s%s ORDER BY created_at %s LIMIT $%d OFFSET $%d",
+		broadcastSelectColumns, whereClause, sortOrder, len(args)+1, len(args)+2)
+	args = append(args, options.Limit, options.Offset)
+
+	return r.queryCampaigns(ctx, query, args...)
+}
+
+// Cancel stores a cancelled campaign and drops its undelivered recipients in one transaction.
+func (r *BroadcastRepository) Cancel(ctx context.Context, campaign *entities.BroadcastCampaignEntity) error {
+	if r.pool == nil {
+		return errBroadcastNilPool
+	}
+	if campaign == nil {
+		return errBroadcastNilCampaign
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return mapPGError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	cmd, err := tx.Exec(ctx, `
+UPDATE broadcast_campaigns
+SET status = $2,
+	cancelled_at = $3,
+	updated_at = $4
+WHERE id = $1 AND status IN ($5, $6)`,
+		campaign.GetID(),
+		campaign.GetStatus(),
+		campaign.GetCancelledAt(),
+		campaign.GetUpdatedAt().UTC(),
+		entities.BroadcastStatusScheduled,
+		entities.BroadcastStatusSending,
+	)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrConflict
+	}
+
+	if _, err := tx.Exec(ctx,
+		"DELETE FROM broadcast_deliveries WHERE campaign_id = $1 AND status = $2",
+		campaign.GetID(), entities.BroadcastDeliveryPending,
+	); err != nil {
+		return mapPGError(err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+// CountRecipients returns how many users the segment currently matches.
+func (r *BroadcastRepository) CountRecipients(ctx context.Context, scope repositories.SegmentScope) (int64, error) {
+	if r.pool == nil {
+		return 0, errBroadcastNilPool
+	}
+
+	segment := newUserSegmentQuery(scope)
+	var count int64
+	if err := r.pool.QueryRow(ctx, "SELECT COUNT(*) FROM users u WHERE "+segment.where(), segment.args...).Scan(&count); err != nil {
+		return 0, mapPGError(err)
+	}
+	return count, nil
+}
+
+// ListDue returns up to limit scheduled campaigns whose send time is at or before now, oldest first.
+func (r *BroadcastRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]entities.BroadcastCampaign, error) {
+	if r.pool == nil {
+		return nil, errBroadcastNilPool
+	}
+
+	return r.queryCampaigns(ctx,
+		broadcastSelectColumns+" WHERE status = $1 AND scheduled_at <= $2 ORDER BY scheduled_at ASC LIMIT $3",
+		entities.BroadcastStatusScheduled, now.UTC(), limit)
+}
+
+// Start fixes the campaign's recipients and moves it to sending. The campaign row is locked first
+// so two dispatchers cannot both enqueue recipients.
+func (r *BroadcastRepository) Start(ctx context.Context, campaignID uuid.UUID, scope repositories.SegmentScope, at time.Time) (int64, error) {
+	if r.pool == nil {
+		return 0, errBroadcastNilPool
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, mapPGError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	var status string
+	err = tx.QueryRow(ctx,
+		"SELECT status FROM broadcast_campaigns WHERE id = $1 FOR UPDATE", campaignID,
+	).Scan(&status)
+	if err != nil {
+		return 0, mapPGError(err)
+	}
+	if entities.BroadcastCampaignStatus(status) != entities.BroadcastStatusScheduled {
+		return 0, repositories.ErrConflict
+	}
+
+	segment := newUserSegmentQuery(scope, campaignID, at.UTC())
+	cmd, err := tx.Exec(ctx, `
+INSERT INTO broadcast_deliveries (campaign_id, user_id, created_at)
+SELECT $1, u.id, $2 FROM users u WHERE `+segment.where()+`
+ON CONFLICT (campaign_id, user_id) DO NOTHING`,
+		segment.args...)
+	if err != nil {
+		return 0, mapPGError(err)
+	}
+	recipients := cmd.RowsAffected()
+
+	if _, err := tx.Exec(ctx, `
+UPDATE broadcast_campaigns
+SET status = $2,
+	recipient_count = $3,
+	started_at = $4,
+	updated_at = $4
+WHERE id = $1`,
+		campaignID, entities.BroadcastStatusSending, recipients, at.UTC(),
+	); err != nil {
+		return 0, mapPGError(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, mapPGError(err)
+	}
+	return recipients, nil
+}
+
+// ListSending returns campaigns that still have deliveries in flight, oldest start first.
+func (r *BroadcastRepository) ListSending(ctx context.Context, limit int) ([]entities.BroadcastCampaign, error) {
+	if r.pool == nil {
+		return nil, errBroadcastNilPool
+	}
+
+	return r.queryCampaigns(ctx,
+		broadcastSelectColumns+" WHERE status = $1 ORDER BY started_at ASC LIMIT $2",
+		entities.BroadcastStatusSending, limit)
+}
+
+// ClaimDeliveries records an attempt on up to limit pending recipients and returns them. SKIP
+// LOCKED lets several dispatchers share a campaign without sending a message twice.
+func (r *BroadcastRepository) ClaimDeliveries(ctx context.Context, campaignID uuid.UUID, at, retryBefore time.Time, limit int) ([]uuid.UUID, error) {
+	if r.pool == nil {
+		return nil, errBroadcastNilPool
+	}
+
+	rows, err := r.pool.Query(ctx, `
+UPDATE broadcast_deliveries
+SET attempts = attempts + 1,
+	last_attempt_at = $3
+WHERE campaign_id = $1 AND user_id IN (
+	SELECT user_id FROM broadcast_deliveries
+	WHERE campaign_id = $1 AND status = $2
+		AND (last_attempt_at IS NULL OR last_attempt_at < $4)
+	ORDER BY last_attempt_at ASC NULLS FIRST
+	FOR UPDATE SKIP LOCKED
+	LIMIT $5
+)
+RETURNING user_id`,
+		campaignID, entities.BroadcastDeliveryPending, at.UTC(), retryBefore.UTC(), limit)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	userIDs := make([]uuid.UUID, 0, limit)
+	for rows.Next() {
+		var userID uuid.UUID
+		if err := rows.Scan(&userID); err != nil {
+			return nil, mapPGError(err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return userIDs, nil
+}
+
+// MarkDelivered records a successful delivery.
+func (r *BroadcastRepository) MarkDelivered(ctx context.Context, campaignID, userID uuid.UUID, at time.Time) error {
+	if r.pool == nil {
+		return errBroadcastNilPool
+	}
+
+	_, err := r.pool.Exec(ctx, `
+UPDATE broadcast_deliveries
+SET status = $3,
+	delivered_at = $4,
+	error_message = NULL
+WHERE campaign_id = $1 AND user_id = $2 AND status = $5`,
+		campaignID, userID, entities.BroadcastDeliveryDelivered, at.UTC(), entities.BroadcastDeliveryPending)
+	return mapPGError(err)
+}
+
+// MarkDeliveryFailed records a failed attempt and gives up on the recipient after maxAttempts.
+func (r *BroadcastRepository) MarkDeliveryFailed(ctx context.Context, campaignID, userID uuid.UUID, message string, maxAttempts int) error {
+	if r.pool == nil {
+		return errBroadcastNilPool
+	}
+
+	_, err := r.pool.Exec(ctx, `
+UPDATE broadcast_deliveries
+SET status = CASE WHEN attempts >= $4 THEN $5::broadcast_delivery_status ELSE status END,
+	error_message = $3
+WHERE campaign_id = $1 AND user_id = $2 AND status = $6`,
+		campaignID, userID, nullIfEmpty(message), maxAttempts,
+		entities.BroadcastDeliveryFailed, entities.BroadcastDeliveryPending)
+	return mapPGError(err)
+}
+
+// CompleteIfDrained marks a sending campaign completed once none of its deliveries are pending.
+func (r *BroadcastRepository) CompleteIfDrained(ctx context.Context, campaignID uuid.UUID, at time.Time) (bool, error) {
+	if r.pool == nil {
+		return false, errBroadcastNilPool
+	}
+
+	cmd, err := r.pool.Exec(ctx, `
+UPDATE broadcast_campaigns
+SET status = $2,
+	completed_at = $3,
+	updated_at = $3
+WHERE id = $1 AND status = $4
+	AND NOT EXISTS (
+		SELECT 1 FROM broadcast_deliveries
+		WHERE campaign_id = $1 AND status = $5
+	)`,
+		campaignID, entities.BroadcastStatusCompleted, at.UTC(),
+		entities.BroadcastStatusSending, entities.BroadcastDeliveryPending)
+	if err != nil {
+		return false, mapPGError(err)
+	}
+	return cmd.RowsAffected() > 0, nil
+}
+
+// MarkOpened records the first open of a delivered message; later opens keep the first timestamp.
+func (r *BroadcastRepository) MarkOpened(ctx context.Context, campaignID, userID uuid.UUID, at time.Time) error {
+	if r.pool == nil {
+		return errBroadcastNilPool
+	}
+
+	cmd, err := r.pool.Exec(ctx, `
+UPDATE broadcast_deliveries
+SET opened_at = COALESCE(opened_at, $3)
+WHERE campaign_id = $1 AND user_id = $2 AND status = $4`,
+		campaignID, userID, at.UTC(), entities.BroadcastDeliveryDelivered)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+// Stats summarises a campaign's deliveries.
+func (r *BroadcastRepository) Stats(ctx context.Context, campaignID uuid.UUID) (entities.BroadcastStats, error) {
+	if r.pool == nil {
+		return entities.BroadcastStats{}, errBroadcastNilPool
+	}
+
+	var stats entities.BroadcastStats
+	err := r.pool.QueryRow(ctx, `
+SELECT
+	COUNT(*),
+	COUNT(*) FILTER (WHERE status = $2),
+	COUNT(*) FILTER (WHERE status = $3),
+	COUNT(*) FILTER (WHERE status = $4),
+	COUNT(*) FILTER (WHERE opened_at IS NOT NULL)
+FROM broadcast_deliveries
+WHERE campaign_id = $1`,
+		campaignID,
+		entities.BroadcastDeliveryPending,
+		entities.BroadcastDeliveryDelivered,
+		entities.BroadcastDeliveryFailed,
+	).Scan(&stats.Recipients, &stats.Pending, &stats.Delivered, &stats.Failed, &stats.Opened)
+	if err != nil {
+		return entities.BroadcastStats{}, mapPGError(err)
+	}
+	return stats, nil
+}
+
+func (r *BroadcastRepository) queryCampaigns(ctx context.Context, query string, args ...any) ([]entities.BroadcastCampaign, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	campaigns := make([]entities.BroadcastCampaign, 0)
+	for rows.Next() {
+		campaign, err := scanBroadcastCampaign(rows)
+		if err != nil {
+			return nil, err
+		}
+		campaigns = append(campaigns, campaign)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return campaigns, nil
+}
+
+func scanBroadcastCampaign(row pgx.Row) (entities.BroadcastCampaign, error) {
+	var (
+		params  entities.BroadcastCampaignParams
+		segment []byte
+		status  string
+	)
+
+	if err := row.Scan(
+		&params.ID,
+		&params.Name,
+		&params.Title,
+		&params.Body,
+		&segment,
+		&status,
+		&params.ScheduledAt,
+		&params.ThrottlePerMinute,
+		&params.RecipientCount,
+		&params.CreatedBy,
+		&params.StartedAt,
+		&params.CompletedAt,
+		&params.CancelledAt,
+		&params.CreatedAt,
+		&params.UpdatedAt,
+	); err != nil {
+		return nil, mapPGError(err)
+	}
+
+	params.Status = entities.BroadcastCampaignStatus(status)
+	if len(segment) > 0 {
+		if err := json.Unmarshal(segment, &params.Segment); err != nil {
+			return nil, fmt.Errorf("broadcast repository: decode segment: %w", err)
+		}
+	}
+
+	return entities.HydrateBroadcastCampaignEntity(params), nil
+}
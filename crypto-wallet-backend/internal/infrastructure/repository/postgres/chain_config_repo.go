@@ -0,0 +1,186 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+const chainConfigSelectColumns = `
+SELECT
+	id,
+	symbol,
+	name,
+	rpc_url,
+	COALESCE(rpc_url_fallback, ''),
+	explorer_url,
+	native_token_symbol,
+	native_token_decimals,
+	confirmation_threshold,
+	average_block_time_seconds,
+	is_active,
+	managed,
+	network_type,
+	created_at,
+	updated_at
+FROM chains`
+
+var (
+	errChainConfigNilPool  = errors.New("chain config repository: database pool is not configured")
+	errChainConfigNilChain = errors.New("chain config repository: chain is nil")
+)
+
+// ChainConfigRepository persists the chain registry using PostgreSQL.
+type ChainConfigRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewChainConfigRepository constructs a ChainConfigRepository backed by the provided pool.
+func NewChainConfigRepository(pool *pgxpool.Pool, logger *slog.Logger) *ChainConfigRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ChainConfigRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// GetBySymbol returns the registered chain or ErrNotFound.
+func (r *ChainConfigRepository) GetBySymbol(ctx context.Context, chain entities.Chain) (entities.ChainConfig, error) {
+	if r.pool == nil {
+		return nil, errChainConfigNilPool
+	}
+
+	row := r.pool.QueryRow(ctx, chainConfigSelectColumns+" WHERE symbol = $1", string(chain))
+	return scanChainConfig(row)
+}
+
+// List returns every registered chain ordered by symbol.
+func (r *ChainConfigRepository) List(ctx context.Context) ([]entities.ChainConfig, error) {
+	if r.pool == nil {
+		return nil, errChainConfigNilPool
+	}
+
+	rows, err := r.pool.Query(ctx, chainConfigSelectColumns+" ORDER BY symbol ASC")
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	chains := make([]entities.ChainConfig, 0)
+	for rows.Next() {
+		chain, err := scanChainConfig(rows)
+		if err != nil {
+			return nil, err
+		}
+		chains = append(chains, chain)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return chains, nil
+}
+
+// Upsert registers the chain or replaces its configuration, keyed by symbol.
+func (r *ChainConfigRepository) Upsert(ctx context.Context, chain *entities.ChainEntity) error {
+	if r.pool == nil {
+		return errChainConfigNilPool
+	}
+	if chain == nil {
+		return errChainConfigNilChain
+	}
+
+	updatedAt := chain.GetUpdatedAt()
+	if updatedAt.IsZero() {
+		updatedAt = time.Now().UTC()
+	}
+
+	_, err := r.pool.Exec(ctx, `
+INSERT INTO chains (
+	symbol,
+	name,
+	rpc_url,
+	rpc_url_fallback,
+	explorer_url,
+	native_token_symbol,
+	native_token_decimals,
+	confirmation_threshold,
+	average_block_time_seconds,
+	is_active,
+	managed,
+	network_type,
+	created_at,
+	updated_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14)
+ON CONFLICT (symbol) DO UPDATE SET
+	name = EXCLUDED.name,
+	rpc_url = EXCLUDED.rpc_url,
+	rpc_url_fallback = EXCLUDED.rpc_url_fallback,
+	explorer_url = EXCLUDED.explorer_url,
+	native_token_symbol = EXCLUDED.native_token_symbol,
+	native_token_decimals = EXCLUDED.native_token_decimals,
+	confirmation_threshold = EXCLUDED.confirmation_threshold,
+	average_block_time_seconds = EXCLUDED.average_block_time_seconds,
+	is_active = EXCLUDED.is_active,
+	managed = EXCLUDED.managed,
+	network_type = EXCLUDED.network_type,
+	updated_at = EXCLUDED.updated_at`,
+		string(chain.GetSymbol()),
+		chain.GetName(),
+		chain.GetRPCURL(),
+		nullIfEmpty(chain.GetRPCFallbackURL()),
+		chain.GetExplorerURL(),
+		chain.GetNativeTokenSymbol(),
+		chain.GetNativeTokenDecimals(),
+		chain.GetConfirmationThreshold(),
+		int(chain.GetAverageBlockTime()/time.Second),
+		chain.IsActive(),
+		chain.IsManaged(),
+		string(chain.GetNetworkType()),
+		chain.GetCreatedAt().UTC(),
+		updatedAt.UTC(),
+	)
+	return mapPGError(err)
+}
+
+func scanChainConfig(row pgx.Row) (entities.ChainConfig, error) {
+	var (
+		params           entities.ChainParams
+		symbol           string
+		networkType      string
+		blockTimeSeconds int
+	)
+
+	if err := row.Scan(
+		&params.ID,
+		&symbol,
+		&params.Name,
+		&params.RPCURL,
+		&params.RPCURLFallback,
+		&params.ExplorerURL,
+		&params.NativeTokenSymbol,
+		&params.NativeTokenDecimals,
+		&params.ConfirmationThreshold,
+		&blockTimeSeconds,
+		&params.IsActive,
+		&params.Managed,
+		&networkType,
+		&params.CreatedAt,
+		&params.UpdatedAt,
+	); err != nil {
+		return nil, mapPGError(err)
+	}
+
+	params.Symbol = entities.Chain(symbol)
+	params.NetworkType = entities.ChainNetworkType(networkType)
+	params.AverageBlockTime = time.Duration(blockTimeSeconds) * time.Second
+	return entities.HydrateChainEntity(params), nil
+}
@@ -0,0 +1,193 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const chainRolloutSelectColumns = `
+SELECT
+	chain,
+	percentage,
+	allowlist,
+	beta_opt_in,
+	updated_by,
+	updated_at
+FROM chain_rollouts`
+
+var errChainRolloutNilPool = errors.New("chain rollout repository: database pool is not configured")
+
+// ChainRolloutRepository persists chain rollout settings and beta opt-ins using PostgreSQL.
+type ChainRolloutRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewChainRolloutRepository constructs a ChainRolloutRepository backed by the provided pool.
+func NewChainRolloutRepository(pool *pgxpool.Pool, logger *slog.Logger) *ChainRolloutRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ChainRolloutRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// GetRollout returns the chain's rollout or ErrNotFound when the chain is generally available.
+func (r *ChainRolloutRepository) GetRollout(ctx context.Context, chain entities.Chain) (entities.ChainRollout, error) {
+	if r.pool == nil {
+		return entities.ChainRollout{}, errChainRolloutNilPool
+	}
+
+	row := r.pool.QueryRow(ctx, chainRolloutSelectColumns+" WHERE chain = $1", string(chain))
+	return scanChainRollout(row)
+}
+
+// ListRollouts returns every configured rollout ordered by chain.
+func (r *ChainRolloutRepository) ListRollouts(ctx context.Context) ([]entities.ChainRollout, error) {
+	if r.pool == nil {
+		return nil, errChainRolloutNilPool
+	}
+
+	rows, err := r.pool.Query(ctx, chainRolloutSelectColumns+" ORDER BY chain ASC")
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	rollouts := make([]entities.ChainRollout, 0)
+	for rows.Next() {
+		rollout, err := scanChainRollout(rows)
+		if err != nil {
+			return nil, err
+		}
+		rollouts = append(rollouts, rollout)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return rollouts, nil
+}
+
+// UpsertRollout creates or replaces the chain's rollout.
+func (r *ChainRolloutRepository) UpsertRollout(ctx context.Context, rollout entities.ChainRollout) error {
+	if r.pool == nil {
+		return errChainRolloutNilPool
+	}
+
+	updatedAt := rollout.UpdatedAt
+	if updatedAt.IsZero() {
+		updatedAt = time.Now().UTC()
+	}
+	allowlist := rollout.Allowlist
+	if allowlist == nil {
+		allowlist = []uuid.UUID{}
+	}
+
+	_, err := r.pool.Exec(ctx, `
+INSERT INTO chain_rollouts (
+	chain,
+	percentage,
+	allowlist,
+	beta_opt_in,
+	updated_by,
+	updated_at
+) VALUES ($1,$2,$3,$4,$5,$6)
+ON CONFLICT (chain) DO UPDATE SET
+	percentage = EXCLUDED.percentage,
+	allowlist = EXCLUDED.allowlist,
+	beta_opt_in = EXCLUDED.beta_opt_in,
+	updated_by = EXCLUDED.updated_by,
+	updated_at = EXCLUDED.updated_at`,
+		string(rollout.Chain),
+		rollout.Percentage,
+		allowlist,
+		rollout.BetaOptIn,
+		rollout.UpdatedBy,
+		updatedAt,
+	)
+	return mapPGError(err)
+}
+
+// DeleteRollout removes the chain's rollout, making the chain generally available.
+func (r *ChainRolloutRepository) DeleteRollout(ctx context.Context, chain entities.Chain) error {
+	if r.pool == nil {
+		return errChainRolloutNilPool
+	}
+
+	cmd, err := r.pool.Exec(ctx, "DELETE FROM chain_rollouts WHERE chain = $1", string(chain))
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+// IsOptedIn reports whether the user joined the chain's beta.
+func (r *ChainRolloutRepository) IsOptedIn(ctx context.Context, userID uuid.UUID, chain entities.Chain) (bool, error) {
+	if r.pool == nil {
+		return false, errChainRolloutNilPool
+	}
+
+	var optedIn bool
+	err := r.pool.QueryRow(ctx,
+		"SELECT EXISTS (SELECT 1 FROM chain_beta_opt_ins WHERE user_id = $1 AND chain = $2)",
+		userID, string(chain),
+	).Scan(&optedIn)
+	if err != nil {
+		return false, mapPGError(err)
+	}
+	return optedIn, nil
+}
+
+// SetOptIn records or withdraws the user's beta opt-in.
+func (r *ChainRolloutRepository) SetOptIn(ctx context.Context, userID uuid.UUID, chain entities.Chain, optedIn bool) error {
+	if r.pool == nil {
+		return errChainRolloutNilPool
+	}
+
+	var err error
+	if optedIn {
+		_, err = r.pool.Exec(ctx,
+			"INSERT INTO chain_beta_opt_ins (user_id, chain) VALUES ($1, $2) ON CONFLICT DO NOTHING",
+			userID, string(chain))
+	} else {
+		_, err = r.pool.Exec(ctx,
+			"DELETE FROM chain_beta_opt_ins WHERE user_id = $1 AND chain = $2",
+			userID, string(chain))
+	}
+	return mapPGError(err)
+}
+
+func scanChainRollout(row pgx.Row) (entities.ChainRollout, error) {
+	var (
+		rollout entities.ChainRollout
+		chain   string
+	)
+
+	if err := row.Scan(
+		&chain,
+		&rollout.Percentage,
+		&rollout.Allowlist,
+		&rollout.BetaOptIn,
+		&rollout.UpdatedBy,
+		&rollout.UpdatedAt,
+	); err != nil {
+		return entities.ChainRollout{}, mapPGError(err)
+	}
+
+	rollout.Chain = entities.Chain(chain)
+	return rollout, nil
+}
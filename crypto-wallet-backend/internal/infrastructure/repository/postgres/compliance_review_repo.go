@@ -0,0 +1,306 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const selectComplianceReview = `
+SELECT
+	id,
+	user_id,
+	source,
+	reason,
+	risk_level::text,
+	details,
+	status::text,
+	resolved_by,
+	resolution,
+	created_at,
+	resolved_at
+FROM compliance_review_queue`
+
+// ListRiskScoresDueForReview returns risk scores whose next review is at or before now, most overdue first.
+func (r *KYCRepository) ListRiskScoresDueForReview(ctx context.Context, now time.Time, limit int) ([]entities.UserRiskScore, error) {
+	if r.pool == nil {
+		return nil, errors.New("kyc repository: pool not configured")
+	}
+	if limit <= 0 {
+		limit = 100
+	}
+
+	rows, err := r.pool.Query(ctx, selectRiskScore+" WHERE next_review_at <= $1 ORDER BY next_review_at ASC LIMIT $2", now, limit)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	var scores []entities.UserRiskScore
+	for rows.Next() {
+		score, scanErr := r.scanRiskScore(rows)
+		if scanErr != nil {
+			return nil, mapPGError(scanErr)
+		}
+		scores = append(scores, score)
+	}
+	if rows.Err() != nil {
+		return nil, mapPGError(rows.Err())
+	}
+	return scores, nil
+}
+
+// ListProfilesWithoutRiskScore returns profiles in one of the statuses that have never been
+// screened, oldest submission first.
+func (r *KYCRepository) ListProfilesWithoutRiskScore(ctx context.Context, statuses []entities.KYCStatus, limit int) ([]entities.KYCProfile, error) {
+	if r.pool == nil {
+		return nil, errors.New("kyc repository: pool not configured")
+	}
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+	if limit <= 0 {
+		limit = 100
+	}
+
+	values := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		values = append(values, string(status))
+	}
+
+	rows, err := r.pool.Query(ctx, selectKYCProfile+`
+WHERE status::text = ANY($1)
+	AND NOT EXISTS (SELECT 1 FROM user_risk_scores s WHERE s.user_id = kyc_profiles.user_id)
+ORDER BY submitted_at ASC NULLS LAST, created_at ASC
+LIMIT $2`, values, limit)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	var profiles []entities.KYCProfile
+	for rows.Next() {
+		profile, scanErr := r.scanKYCProfile(rows)
+		if scanErr != nil {
+			return nil, mapPGError(scanErr)
+		}
+		profiles = append(profiles, profile)
+	}
+	if rows.Err() != nil {
+		return nil, mapPGError(rows.Err())
+	}
+	return profiles, nil
+}
+
+// EnqueueComplianceReview adds an item to the manual compliance review queue.
+func (r *KYCRepository) EnqueueComplianceReview(ctx context.Context, item *entities.ComplianceReviewItem) error {
+	if r.pool == nil {
+		return errors.New("kyc repository: pool not configured")
+	}
+	if item == nil {
+		return errors.New("kyc repository: review item is nil")
+	}
+	if item.ID == uuid.Nil {
+		item.ID = uuid.New()
+	}
+	if item.Status == "" {
+		item.Status = entities.ComplianceReviewStatusOpen
+	}
+	if item.CreatedAt.IsZero() {
+		item.CreatedAt = time.Now().UTC()
+	}
+
+	details, err := marshalMetadata(item.Details)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, `
+INSERT INTO compliance_review_queue (
+	id,
+	user_id,
+	source,
+	reason,
+	risk_level,
+	details,
+	status,
+	created_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8)`,
+		item.ID,
+		item.UserID,
+		item.Source,
+		item.Reason,
+		item.RiskLevel,
+		details,
+		item.Status,
+		item.CreatedAt,
+	)
+	return mapPGError(err)
+}
+
+// ListComplianceReviews returns queue items matching the filter, oldest first.
+func (r *KYCRepository) ListComplianceReviews(ctx context.Context, filter repositories.ComplianceReviewFilter, limit, offset int) ([]entities.ComplianceReviewItem, error) {
+	if r.pool == nil {
+		return nil, errors.New("kyc repository: pool not configured")
+	}
+	if limit <= 0 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	conditions := make([]string, 0, 3)
+	args := make([]any, 0, 5)
+	if filter.Status != nil {
+		args = append(args, string(*filter.Status))
+		conditions = append(conditions, fmt.Sprintf("status::text = $%d", len(args)))
+	}
+	if filter.Source != nil {
+		args = append(args, string(*filter.Source))
+		conditions = append(conditions, fmt.Sprintf("source = $%d", len(args)))
+	}
+	if filter.UserID != nil {
+		args = append(args, *filter.UserID)
+		conditions = append(conditions, fmt.Sprintf("user_id = $%d", len(args)))
+	}
+
+	query := strings.Builder{}
+	query.WriteString(selectComplianceReview)
+	if len(conditions) > 0 {
+		query.WriteString(" WHERE ")
+		query.WriteString(strings.Join(conditions, " AND "))
+	}
+	args = append(args, limit, offset)
+	query.WriteString(fmt.Sprintf(" ORDER BY created_at ASC LIMIT $%d OFFSET $%d", len(args)-1, len(args)))
+
+	rows, err := r.pool.Query(ctx, query.String(), args...)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	items := make([]entities.ComplianceReviewItem, 0)
+	for rows.Next() {
+		item, scanErr := scanComplianceReview(rows)
+		if scanErr != nil {
+			return nil, mapPGError(scanErr)
+		}
+		items = append(items, item)
+	}
+	if rows.Err() != nil {
+		return nil, mapPGError(rows.Err())
+	}
+	return items, nil
+}
+
+// GetComplianceReview fetches a queue item by its identifier.
+func (r *KYCRepository) GetComplianceReview(ctx context.Context, id uuid.UUID) (entities.ComplianceReviewItem, error) {
+	if r.pool == nil {
+		return entities.ComplianceReviewItem{}, errors.New("kyc repository: pool not configured")
+	}
+
+	item, err := scanComplianceReview(r.pool.QueryRow(ctx, selectComplianceReview+" WHERE id = $1", id))
+	if err != nil {
+		return entities.ComplianceReviewItem{}, mapPGError(err)
+	}
+	return item, nil
+}
+
+// ResolveComplianceReview closes an open item. Returns ErrNotFound for an unknown item and
+// ErrConflict when it is already resolved.
+func (r *KYCRepository) ResolveComplianceReview(ctx context.Context, id, resolvedBy uuid.UUID, resolution string, at time.Time) error {
+	if r.pool == nil {
+		return errors.New("kyc repository: pool not configured")
+	}
+
+	cmd, err := r.pool.Exec(ctx, `
+UPDATE compliance_review_queue
+SET status = $2,
+	resolved_by = $3,
+	resolution = $4,
+	resolved_at = $5
+WHERE id = $1 AND status = 'open'`,
+		id,
+		string(entities.ComplianceReviewStatusResolved),
+		resolvedBy,
+		nullIfEmpty(resolution),
+		at,
+	)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() > 0 {
+		return nil
+	}
+
+	var exists bool
+	if err := r.pool.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM compliance_review_queue WHERE id = $1)", id).Scan(&exists); err != nil {
+		return mapPGError(err)
+	}
+	if !exists {
+		return repositories.ErrNotFound
+	}
+	return repositories.ErrConflict
+}
+
+func scanComplianceReview(row pgx.Row) (entities.ComplianceReviewItem, error) {
+	var (
+		item        entities.ComplianceReviewItem
+		source      string
+		riskLevel   string
+		detailsJSON []byte
+		status      string
+		resolvedBy  pgtype.UUID
+		resolution  pgtype.Text
+		resolvedAt  pgtype.Timestamptz
+	)
+
+	if err := row.Scan(
+		&item.ID,
+		&item.UserID,
+		&source,
+		&item.Reason,
+		&riskLevel,
+		&detailsJSON,
+		&status,
+		&resolvedBy,
+		&resolution,
+		&item.CreatedAt,
+		&resolvedAt,
+	); err != nil {
+		return entities.ComplianceReviewItem{}, err
+	}
+
+	item.Source = entities.ComplianceReviewSource(source)
+	item.RiskLevel = entities.RiskLevel(riskLevel)
+	item.Status = entities.ComplianceReviewStatus(status)
+	if len(detailsJSON) > 0 {
+		if err := json.Unmarshal(detailsJSON, &item.Details); err != nil {
+			return entities.ComplianceReviewItem{}, fmt.Errorf("kyc repository: decode review details: %w", err)
+		}
+	}
+	if resolvedBy.Valid {
+		id := uuid.UUID(resolvedBy.Bytes)
+		item.ResolvedBy = &id
+	}
+	if resolution.Valid {
+		item.Resolution = resolution.String
+	}
+	if resolvedAt.Valid {
+		t := resolvedAt.Time.UTC()
+		item.ResolvedAt = &t
+	}
+	item.CreatedAt = item.CreatedAt.UTC()
+	return item, nil
+}
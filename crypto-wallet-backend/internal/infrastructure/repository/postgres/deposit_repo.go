@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+var errDepositNilPool = errors.New("deposit repository: database pool is not configured")
+
+// DepositRepository persists deposits and the deposit scanner's cursors using PostgreSQL.
+type DepositRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewDepositRepository constructs a DepositRepository backed by the provided pool.
+func NewDepositRepository(pool *pgxpool.Pool, logger *slog.Logger) *DepositRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &DepositRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// GetCursor returns the chain's scan cursor, or ErrNotFound before the first scan.
+func (r *DepositRepository) GetCursor(ctx context.Context, chain entities.Chain) (entities.DepositCursor, error) {
+	if r.pool == nil {
+		return entities.DepositCursor{}, errDepositNilPool
+	}
+
+	var (
+		cursor    = entities.DepositCursor{Chain: chain}
+		lastBlock int64
+	)
+	err := r.pool.QueryRow(ctx, `
+SELECT last_block, updated_at
+FROM deposit_scan_cursors
+WHERE chain = $1`, string(chain)).Scan(&lastBlock, &cursor.UpdatedAt)
+	if err != nil {
+		return entities.DepositCursor{}, mapPGError(err)
+	}
+	cursor.LastBlock = uint64(lastBlock)
+	cursor.UpdatedAt = cursor.UpdatedAt.UTC()
+	return cursor, nil
+}
+
+// RecordDeposits stores the receive transactions, credits their wallets and advances the cursor in
+// one database transaction. The transaction key makes recording idempotent: a deposit that is
+// already stored is neither inserted nor credited again. The cursor never moves backwards.
+func (r *DepositRepository) RecordDeposits(ctx context.Context, cursor entities.DepositCursor, deposits []*entities.TransactionEntity) ([]repositories.CreditedDeposit, error) {
+	if r.pool == nil {
+		return nil, errDepositNilPool
+	}
+	if err := cursor.Validate(); err != nil {
+		return nil, err
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	credited := make([]repositories.CreditedDeposit, 0, len(deposits))
+	for _, deposit := range deposits {
+		if deposit == nil {
+			continue
+		}
+		if deposit.GetType() != entities.TransactionTypeReceive {
+			return nil, fmt.Errorf("deposit repository: transaction %s is not a receive", deposit.GetID())
+		}
+		args, err := transactionInsertArgs(deposit)
+		if err != nil {
+			return nil, err
+		}
+		tag, err := tx.Exec(ctx, insertTransactionQuery+" ON CONFLICT (chain, tx_hash, wallet_id, type) DO NOTHING", args...)
+		if err != nil {
+			return nil, mapPGError(err)
+		}
+		if tag.RowsAffected() == 0 {
+			continue
+		}
+
+		var (
+			userID  uuid.UUID
+			balance string
+		)
+		err = tx.QueryRow(ctx, `
+UPDATE wallets
+SET balance = balance + $2,
+	balance_updated_at = $3,
+	updated_at = $3
+WHERE id = $1
+RETURNING user_id, balance`,
+			deposit.GetWalletID(),
+			deposit.GetAmount().String(),
+			deposit.GetCreatedAt().UTC(),
+		).Scan(&userID, &balance)
+		if err != nil {
+			return nil, mapPGError(err)
+		}
+		balanceValue, err := decimal.NewFromString(balance)
+		if err != nil {
+			return nil, fmt.Errorf("deposit repository: parse balance: %w", err)
+		}
+		credited = append(credited, repositories.CreditedDeposit{
+			Transaction: deposit,
+			UserID:      userID,
+			Balance:     balanceValue,
+		})
+	}
+
+	_, err = tx.Exec(ctx, `
+INSERT INTO deposit_scan_cursors (chain, last_block, updated_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (chain) DO UPDATE
+SET last_block = EXCLUDED.last_block,
+	updated_at = EXCLUDED.updated_at
+WHERE deposit_scan_cursors.last_block < EXCLUDED.last_block`,
+		string(cursor.Chain),
+		int64(cursor.LastBlock),
+		cursor.UpdatedAt.UTC(),
+	)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, mapPGError(err)
+	}
+	return credited, nil
+}
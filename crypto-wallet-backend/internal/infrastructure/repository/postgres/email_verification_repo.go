@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const emailVerificationSelectColumns = `
+SELECT
+	id,
+	user_id,
+	email,
+	token_hash,
+	created_at,
+	expires_at,
+	used_at
+FROM email_verification_tokens`
+
+var errEmailVerificationNilPool = errors.New("email verification repository: database pool is not configured")
+
+// EmailVerificationRepository persists email verification tokens using PostgreSQL.
+type EmailVerificationRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewEmailVerificationRepository constructs an EmailVerificationRepository backed by the provided pool.
+func NewEmailVerificationRepository(pool *pgxpool.Pool, logger *slog.Logger) *EmailVerificationRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &EmailVerificationRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// Create stores a newly issued token.
+func (r *EmailVerificationRepository) Create(ctx context.Context, token entities.EmailVerificationToken) error {
+	if r.pool == nil {
+		return errEmailVerificationNilPool
+	}
+	if err := token.Validate(); err != nil {
+		return err
+	}
+
+	_, err := r.pool.Exec(ctx, `
+INSERT INTO email_verification_tokens (
+	id,
+	user_id,
+	email,
+	token_hash,
+	created_at,
+	expires_at
+) VALUES ($1,$2,$3,$4,$5,$6)`,
+		token.ID,
+		token.UserID,
+		token.Email,
+		token.TokenHash,
+		token.CreatedAt.UTC(),
+		token.ExpiresAt.UTC(),
+	)
+	return mapPGError(err)
+}
+
+// GetByTokenHash returns the token whatever its state, or ErrNotFound.
+func (r *EmailVerificationRepository) GetByTokenHash(ctx context.Context, tokenHash string) (entities.EmailVerificationToken, error) {
+	if r.pool == nil {
+		return entities.EmailVerificationToken{}, errEmailVerificationNilPool
+	}
+	row := r.pool.QueryRow(ctx, emailVerificationSelectColumns+" WHERE token_hash = $1", tokenHash)
+	return scanEmailVerification(row)
+}
+
+// GetLatestByUser returns the user's most recently issued token, or ErrNotFound.
+func (r *EmailVerificationRepository) GetLatestByUser(ctx context.Context, userID uuid.UUID) (entities.EmailVerificationToken, error) {
+	if r.pool == nil {
+		return entities.EmailVerificationToken{}, errEmailVerificationNilPool
+	}
+	row := r.pool.QueryRow(ctx, emailVerificationSelectColumns+" WHERE user_id = $1 ORDER BY created_at DESC LIMIT 1", userID)
+	return scanEmailVerification(row)
+}
+
+// MarkUsed marks the token used. Returns ErrConflict when it was already used.
+func (r *EmailVerificationRepository) MarkUsed(ctx context.Context, id uuid.UUID, at time.Time) error {
+	if r.pool == nil {
+		return errEmailVerificationNilPool
+	}
+
+	cmd, err := r.pool.Exec(ctx, `
+UPDATE email_verification_tokens
+SET used_at = $2
+WHERE id = $1 AND used_at IS NULL`, id, at.UTC())
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrConflict
+	}
+	return nil
+}
+
+func scanEmailVerification(row pgx.Row) (entities.EmailVerificationToken, error) {
+	var token entities.EmailVerificationToken
+	if err := row.Scan(
+		&token.ID,
+		&token.UserID,
+		&token.Email,
+		&token.TokenHash,
+		&token.CreatedAt,
+		&token.ExpiresAt,
+		&token.UsedAt,
+	); err != nil {
+		return entities.EmailVerificationToken{}, mapPGError(err)
+	}
+	return token, nil
+}
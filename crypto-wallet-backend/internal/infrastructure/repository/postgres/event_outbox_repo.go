@@ -0,0 +1,215 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+const eventOutboxSelectColumns = `
+SELECT
+	sequence,
+	event_id,
+	event_type,
+	schema_version,
+	payload,
+	occurred_at
+FROM event_outbox`
+
+var errEventOutboxNilPool = errors.New("event outbox repository: database pool is not configured")
+
+// EventOutboxRepository persists domain events and export cursors using PostgreSQL.
+type EventOutboxRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewEventOutboxRepository constructs an EventOutboxRepository backed by the provided pool.
+func NewEventOutboxRepository(pool *pgxpool.Pool, logger *slog.Logger) *EventOutboxRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &EventOutboxRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// Append records an event; the sequence is assigned by the database.
+func (r *EventOutboxRepository) Append(ctx context.Context, event entities.DomainEvent) error {
+	if r.pool == nil {
+		return errEventOutboxNilPool
+	}
+	if err := event.Validate(); err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(event.Payload)
+	if err != nil {
+		return fmt.Errorf("event outbox repository: encode payload: %w", err)
+	}
+	if event.Payload == nil {
+		payload = []byte("{}")
+	}
+
+	_, err = r.pool.Exec(ctx, `
+INSERT INTO event_outbox (
+	event_id,
+	event_type,
+	schema_version,
+	payload,
+	occurred_at
+) VALUES ($1,$2,$3,$4,$5)`,
+		event.ID,
+		event.Type,
+		event.SchemaVersion,
+		payload,
+		event.OccurredAt,
+	)
+	return mapPGError(err)
+}
+
+// ListAfter returns events with a sequence greater than afterSequence in sequence order.
+func (r *EventOutboxRepository) ListAfter(ctx context.Context, afterSequence int64, limit int) ([]entities.DomainEvent, error) {
+	if r.pool == nil {
+		return nil, errEventOutboxNilPool
+	}
+
+	rows, err := r.pool.Query(ctx, eventOutboxSelectColumns+`
+WHERE sequence > $1
+ORDER BY sequence ASC
+LIMIT $2`, afterSequence, limit)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	return scanDomainEvents(rows)
+}
+
+// ListRange returns events that occurred in [from, to) with a sequence greater than afterSequence.
+func (r *EventOutboxRepository) ListRange(ctx context.Context, from, to time.Time, afterSequence int64, limit int) ([]entities.DomainEvent, error) {
+	if r.pool == nil {
+		return nil, errEventOutboxNilPool
+	}
+
+	rows, err := r.pool.Query(ctx, eventOutboxSelectColumns+`
+WHERE occurred_at >= $1 AND occurred_at < $2 AND sequence > $3
+ORDER BY sequence ASC
+LIMIT $4`, from, to, afterSequence, limit)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	return scanDomainEvents(rows)
+}
+
+// ListAfterMatching returns events after afterSequence whose type matches the allowed patterns and,
+// when given, the requested patterns.
+func (r *EventOutboxRepository) ListAfterMatching(ctx context.Context, afterSequence int64, allowed, requested []string, limit int) ([]entities.DomainEvent, error) {
+	if r.pool == nil {
+		return nil, errEventOutboxNilPool
+	}
+
+	events := make([]entities.DomainEvent, 0)
+	if len(allowed) == 0 {
+		return events, nil
+	}
+
+	rows, err := r.pool.Query(ctx, eventOutboxSelectColumns+`
+WHERE sequence > $1
+	AND event_type LIKE ANY($2)
+	AND (cardinality($3::text[]) = 0 OR event_type LIKE ANY($3))
+ORDER BY sequence ASC
+LIMIT $4`, afterSequence, eventTypeLikePatterns(allowed), eventTypeLikePatterns(requested), limit)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	return scanDomainEvents(rows)
+}
+
+// GetCursor returns the last exported sequence for a sink, or zero when it has not exported yet.
+func (r *EventOutboxRepository) GetCursor(ctx context.Context, sink string) (int64, error) {
+	if r.pool == nil {
+		return 0, errEventOutboxNilPool
+	}
+
+	var sequence int64
+	err := r.pool.QueryRow(ctx, "SELECT last_sequence FROM event_export_cursors WHERE sink = $1", sink).Scan(&sequence)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, mapPGError(err)
+	}
+	return sequence, nil
+}
+
+// SaveCursor stores the last exported sequence for a sink.
+func (r *EventOutboxRepository) SaveCursor(ctx context.Context, sink string, sequence int64) error {
+	if r.pool == nil {
+		return errEventOutboxNilPool
+	}
+
+	_, err := r.pool.Exec(ctx, `
+INSERT INTO event_export_cursors (sink, last_sequence, updated_at)
+VALUES ($1, $2, NOW())
+ON CONFLICT (sink) DO UPDATE SET
+	last_sequence = EXCLUDED.last_sequence,
+	updated_at = EXCLUDED.updated_at`,
+		sink, sequence)
+	return mapPGError(err)
+}
+
+// eventTypeLikePatterns translates entities.MatchEventType patterns into LIKE patterns, escaping
+// the underscores that appear in event type names.
+func eventTypeLikePatterns(patterns []string) []string {
+	escaper := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	likes := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			likes = append(likes, escaper.Replace(prefix)+"%")
+			continue
+		}
+		likes = append(likes, escaper.Replace(pattern))
+	}
+	return likes
+}
+
+func scanDomainEvents(rows pgx.Rows) ([]entities.DomainEvent, error) {
+	defer rows.Close()
+
+	events := make([]entities.DomainEvent, 0)
+	for rows.Next() {
+		var (
+			event   entities.DomainEvent
+			payload []byte
+		)
+		if err := rows.Scan(
+			&event.Sequence,
+			&event.ID,
+			&event.Type,
+			&event.SchemaVersion,
+			&payload,
+			&event.OccurredAt,
+		); err != nil {
+			return nil, mapPGError(err)
+		}
+		if len(payload) > 0 {
+			if err := json.Unmarshal(payload, &event.Payload); err != nil {
+				return nil, fmt.Errorf("event outbox repository: decode payload: %w", err)
+			}
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return events, nil
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -34,6 +35,8 @@ SELECT
 	from_transaction_id,
 	to_transaction_id,
 	error_message,
+	pricing_strategy,
+	pricing_metadata,
 	created_at,
 	updated_at
 FROM exchange_operations`
@@ -53,9 +56,15 @@ SELECT
 	has_liquidity,
 	last_updated,
 	created_at,
-	updated_at
+	updated_at,
+	base_asset_id,
+	quote_asset_id,
+	quote_ttl_seconds
 FROM trading_pairs`
 
+// exchangeExecuteLockPrefix namespaces the per-operation advisory lock taken while claiming execution.
+const exchangeExecuteLockPrefix = "exchange_operations:execute:"
+
 var (
 	errExchangeNilPool              = errors.New("exchange repository: database pool is not configured")
 	errExchangeNilExchangeOperation = errors.New("exchange repository: exchange operation entity is required")
@@ -230,14 +239,115 @@ func (r *ExchangeOperationRepository) GetPendingByUser(ctx context.Context, user
 	return results, nil
 }
 
-// GetExpiredPending returns pending exchange operations that have expired.
-func (r *ExchangeOperationRepository) GetExpiredPending(ctx context.Context) ([]entities.ExchangeOperation, error) {
+// ListPending returns up to limit pending exchange operations matching the filter, soonest expiry first.
+func (r *ExchangeOperationRepository) ListPending(ctx context.Context, filter repositories.PendingExchangeFilter, limit int) ([]entities.ExchangeOperation, error) {
 	if r.pool == nil {
 		return nil, errExchangeNilPool
 	}
+	if limit <= 0 {
+		limit = repositories.DefaultListLimit
+	}
+
+	queryBuilder := strings.Builder{}
+	queryBuilder.WriteString(exchangeOperationSelectColumns)
+	queryBuilder.WriteString(" WHERE status = $1")
+
+	args := []any{string(entities.ExchangeStatusPending)}
+	argIndex := 2
+
+	if filter.UserID != nil {
+		queryBuilder.WriteString(fmt.Sprintf(" AND user_id = $%d", argIndex))
+		args = append(args, *filter.UserID)
+		argIndex++
+	}
+
+	if filter.BaseAssetID != nil {
+		queryBuilder.WriteString(fmt.Sprintf(" AND from_wallet_id IN (SELECT id FROM wallets WHERE asset_id = $%d)", argIndex))
+		args = append(args, *filter.BaseAssetID)
+		argIndex++
+	}
 
-	query := exchangeOperationSelectColumns + " WHERE status = $1 AND quote_expires_at <= $2"
-	rows, err := r.pool.Query(ctx, query, string(entities.ExchangeStatusPending), time.Now().UTC())
+	if filter.QuoteAssetID != nil {
+		queryBuilder.WriteString(fmt.Sprintf(" AND to_wallet_id IN (SELECT id FROM wallets WHERE asset_id = $%d)", argIndex))
+		args = append(args, *filter.QuoteAssetID)
+		argIndex++
+	}
+
+	if filter.ExpiredAt != nil {
+		queryBuilder.WriteString(fmt.Sprintf(" AND quote_expires_at <= $%d", argIndex))
+		args = append(args, filter.ExpiredAt.UTC())
+		argIndex++
+	}
+
+	queryBuilder.WriteString(fmt.Sprintf(" ORDER BY quote_expires_at ASC, id ASC LIMIT $%d", argIndex))
+	args = append(args, limit)
+
+	rows, err := r.pool.Query(ctx, queryBuilder.String(), args...)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	results := make([]entities.ExchangeOperation, 0)
+	for rows.Next() {
+		operation, scanErr := r.scanExchangeOperation(rows)
+		if scanErr != nil {
+			return nil, mapPGError(scanErr)
+		}
+		results = append(results, operation)
+	}
+
+	if rows.Err() != nil {
+		return nil, mapPGError(rows.Err())
+	}
+
+	return results, nil
+}
+
+// ListExecuted returns up to limit completed exchange operations matching the filter, oldest execution first.
+func (r *ExchangeOperationRepository) ListExecuted(ctx context.Context, filter repositories.ExecutedExchangeFilter, limit int) ([]entities.ExchangeOperation, error) {
+	if r.pool == nil {
+		return nil, errExchangeNilPool
+	}
+	if limit <= 0 {
+		limit = repositories.DefaultListLimit
+	}
+
+	queryBuilder := strings.Builder{}
+	queryBuilder.WriteString(exchangeOperationSelectColumns)
+	queryBuilder.WriteString(" WHERE status = $1 AND executed_at IS NOT NULL")
+
+	args := []any{string(entities.ExchangeStatusCompleted)}
+	argIndex := 2
+
+	if filter.BaseAssetID != nil {
+		queryBuilder.WriteString(fmt.Sprintf(" AND from_wallet_id IN (SELECT id FROM wallets WHERE asset_id = $%d)", argIndex))
+		args = append(args, *filter.BaseAssetID)
+		argIndex++
+	}
+
+	if filter.QuoteAssetID != nil {
+		queryBuilder.WriteString(fmt.Sprintf(" AND to_wallet_id IN (SELECT id FROM wallets WHERE asset_id = $%d)", argIndex))
+		args = append(args, *filter.QuoteAssetID)
+		argIndex++
+	}
+
+	if filter.ExecutedFrom != nil {
+		queryBuilder.WriteString(fmt.Sprintf(" AND executed_at >= $%d", argIndex))
+		args = append(args, filter.ExecutedFrom.UTC())
+		argIndex++
+	}
+
+	if filter.ExecutedTo != nil {
+		queryBuilder.WriteString(fmt.Sprintf(" AND executed_at < $%d", argIndex))
+		args = append(args, filter.ExecutedTo.UTC())
+		argIndex++
+	}
+
+	queryBuilder.WriteString(fmt.Sprintf(" ORDER BY executed_at ASC, id ASC LIMIT $%d", argIndex))
+	args = append(args, limit)
+
+	rows, err := r.pool.Query(ctx, queryBuilder.String(), args...)
 	if err != nil {
 		return nil, mapPGError(err)
 	}
@@ -285,10 +395,12 @@ INSERT INTO exchange_operations (
 	from_transaction_id,
 	to_transaction_id,
 	error_message,
+	pricing_strategy,
+	pricing_metadata,
 	created_at,
 	updated_at
 ) VALUES (
-	$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
+	$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19
 )`
 
 	var executedAt any
@@ -296,7 +408,15 @@ INSERT INTO exchange_operations (
 		executedAt = ts.UTC()
 	}
 
-	_, err := r.pool.Exec(ctx, query,
+	pricingMetadata, err := json.Marshal(operation.GetPricingMetadata())
+	if err != nil {
+		return fmt.Errorf("exchange repository: encode pricing metadata: %w", err)
+	}
+	if operation.GetPricingMetadata() == nil {
+		pricingMetadata = []byte("{}")
+	}
+
+	_, err = r.pool.Exec(ctx, query,
 		operation.GetID(),
 		operation.GetUserID(),
 		operation.GetFromWalletID(),
@@ -312,6 +432,8 @@ INSERT INTO exchange_operations (
 		operation.GetFromTransactionID(),
 		operation.GetToTransactionID(),
 		operation.GetErrorMessage(),
+		operation.GetPricingStrategy(),
+		pricingMetadata,
 		operation.GetCreatedAt().UTC(),
 		operation.GetUpdatedAt().UTC(),
 	)
@@ -352,7 +474,7 @@ WHERE id = $1`
 		executedAt = ts.UTC()
 	}
 
-	cmd, err := r.pool.Exec(ctx, query,
+	cmd, err := conn(ctx, r.pool).Exec(ctx, query,
 		operation.GetID(),
 		operation.GetToAmount().String(),
 		operation.GetExchangeRate().String(),
@@ -376,6 +498,101 @@ WHERE id = $1`
 	return nil
 }
 
+// ClaimForExecution takes a transaction-scoped advisory lock on the operation, re-reads it and
+// moves it to processing only if it is still pending with an unexpired quote.
+func (r *ExchangeOperationRepository) ClaimForExecution(ctx context.Context, id uuid.UUID, at time.Time) (entities.ExchangeOperation, bool, error) {
+	if r.pool == nil {
+		return nil, false, errExchangeNilPool
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, false, mapPGError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", exchangeExecuteLockPrefix+id.String()); err != nil {
+		return nil, false, mapPGError(err)
+	}
+
+	operation, err := r.scanExchangeOperation(tx.QueryRow(ctx, exchangeOperationSelectColumns+" WHERE id = $1", id))
+	if err != nil {
+		return nil, false, mapPGError(err)
+	}
+	if operation.GetStatus() != entities.ExchangeStatusPending || !at.Before(operation.GetQuoteExpiresAt()) {
+		return operation, false, nil
+	}
+
+	// The status guard keeps writers that do not take the lock, such as cancellation, from being overwritten.
+	cmd, err := tx.Exec(ctx, `
+UPDATE exchange_operations
+SET status = $2, updated_at = $3
+WHERE id = $1 AND status = $4`,
+		id,
+		string(entities.ExchangeStatusProcessing),
+		at.UTC(),
+		string(entities.ExchangeStatusPending),
+	)
+	if err != nil {
+		return nil, false, mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return operation, false, nil
+	}
+
+	claimed, err := r.scanExchangeOperation(tx.QueryRow(ctx, exchangeOperationSelectColumns+" WHERE id = $1", id))
+	if err != nil {
+		return nil, false, mapPGError(err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, false, mapPGError(err)
+	}
+
+	return claimed, true, nil
+}
+
+// CancelPending takes the execution lock on the operation and cancels it only if it is still pending,
+// so a cancellation never overwrites an execution that has already claimed the operation.
+func (r *ExchangeOperationRepository) CancelPending(ctx context.Context, id uuid.UUID, reason string, at time.Time) (entities.ExchangeOperation, bool, error) {
+	if r.pool == nil {
+		return nil, false, errExchangeNilPool
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, false, mapPGError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", exchangeExecuteLockPrefix+id.String()); err != nil {
+		return nil, false, mapPGError(err)
+	}
+
+	cmd, err := tx.Exec(ctx, `
+UPDATE exchange_operations
+SET status = $2, error_message = COALESCE(NULLIF($3, ''), error_message), updated_at = $4
+WHERE id = $1 AND status = $5`,
+		id,
+		string(entities.ExchangeStatusCancelled),
+		strings.TrimSpace(reason),
+		at.UTC(),
+		string(entities.ExchangeStatusPending),
+	)
+	if err != nil {
+		return nil, false, mapPGError(err)
+	}
+
+	operation, err := r.scanExchangeOperation(tx.QueryRow(ctx, exchangeOperationSelectColumns+" WHERE id = $1", id))
+	if err != nil {
+		return nil, false, mapPGError(err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, false, mapPGError(err)
+	}
+
+	return operation, cmd.RowsAffected() > 0, nil
+}
+
 // Delete removes an exchange operation by its identifier.
 func (r *ExchangeOperationRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	if r.pool == nil {
@@ -543,6 +760,20 @@ func (r *TradingPairRepository) GetBySymbols(ctx context.Context, baseSymbol, qu
 	return pair, nil
 }
 
+// GetByAssets returns the trading pair quoting baseAssetID against quoteAssetID.
+func (r *TradingPairRepository) GetByAssets(ctx context.Context, baseAssetID, quoteAssetID uuid.UUID) (entities.TradingPair, error) {
+	if r.pool == nil {
+		return nil, errExchangeNilPool
+	}
+
+	row := r.pool.QueryRow(ctx, tradingPairSelectColumns+" WHERE base_asset_id = $1 AND quote_asset_id = $2", baseAssetID, quoteAssetID)
+	pair, err := r.scanTradingPair(row)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	return pair, nil
+}
+
 // List returns trading pairs with optional filters.
 func (r *TradingPairRepository) List(ctx context.Context, filter repositories.TradingPairFilter, opts repositories.ListOptions) ([]entities.TradingPair, error) {
 	if r.pool == nil {
@@ -701,9 +932,12 @@ INSERT INTO trading_pairs (
 	has_liquidity,
 	last_updated,
 	created_at,
-	updated_at
+	updated_at,
+	base_asset_id,
+	quote_asset_id,
+	quote_ttl_seconds
 ) VALUES (
-	$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+	$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
 )`
 
 	_, err := r.pool.Exec(ctx, query,
@@ -726,6 +960,9 @@ INSERT INTO trading_pairs (
 		pair.GetLastUpdated().UTC(),
 		pair.GetCreatedAt().UTC(),
 		pair.GetUpdatedAt().UTC(),
+		nullableUUID(pair.GetBaseAssetID()),
+		nullableUUID(pair.GetQuoteAssetID()),
+		int(pair.GetQuoteTTL().Seconds()),
 	)
 	if err != nil {
 		return mapPGError(err)
@@ -755,10 +992,11 @@ SET
 	is_active = $8,
 	has_liquidity = $9,
 	last_updated = $10,
-	updated_at = $11
+	updated_at = $11,
+	quote_ttl_seconds = $12
 WHERE id = $1`
 
-	cmd, err := r.pool.Exec(ctx, query,
+	cmd, err := conn(ctx, r.pool).Exec(ctx, query,
 		pair.GetID(),
 		pair.GetExchangeRate().String(),
 		pair.GetInverseRate().String(),
@@ -775,6 +1013,7 @@ WHERE id = $1`
 		pair.HasLiquidity(),
 		pair.GetLastUpdated().UTC(),
 		pair.GetUpdatedAt().UTC(),
+		int(pair.GetQuoteTTL().Seconds()),
 	)
 	if err != nil {
 		return mapPGError(err)
@@ -902,6 +1141,8 @@ func (r *ExchangeOperationRepository) scanExchangeOperation(row pgx.Row) (entiti
 		fromTransactionID *uuid.UUID
 		toTransactionID   *uuid.UUID
 		errorMessage      string
+		pricingStrategy   string
+		pricingMetadata   []byte
 		createdAt         time.Time
 		updatedAt         time.Time
 	)
@@ -922,6 +1163,8 @@ func (r *ExchangeOperationRepository) scanExchangeOperation(row pgx.Row) (entiti
 		&fromTransactionID,
 		&toTransactionID,
 		&errorMessage,
+		&pricingStrategy,
+		&pricingMetadata,
 		&createdAt,
 		&updatedAt,
 	)
@@ -929,6 +1172,13 @@ func (r *ExchangeOperationRepository) scanExchangeOperation(row pgx.Row) (entiti
 		return nil, err
 	}
 
+	var metadata map[string]string
+	if len(pricingMetadata) > 0 {
+		if err := json.Unmarshal(pricingMetadata, &metadata); err != nil {
+			return nil, fmt.Errorf("exchange repository: decode pricing_metadata: %w", err)
+		}
+	}
+
 	fromAmount, err := decimal.NewFromString(fromAmountStr)
 	if err != nil {
 		return nil, fmt.Errorf("exchange repository: parse from_amount: %w", err)
@@ -976,6 +1226,8 @@ func (r *ExchangeOperationRepository) scanExchangeOperation(row pgx.Row) (entiti
 		QuoteExpiresAt:    quoteExpiresAt.UTC(),
 		ExecutedAt:        executedAtPtr,
 		ErrorMessage:      errorMessage,
+		PricingStrategy:   pricingStrategy,
+		PricingMetadata:   metadata,
 		CreatedAt:         createdAt.UTC(),
 		UpdatedAt:         updatedAt.UTC(),
 	})
@@ -999,6 +1251,9 @@ func (r *TradingPairRepository) scanTradingPair(row pgx.Row) (entities.TradingPa
 		lastUpdated      time.Time
 		createdAt        time.Time
 		updatedAt        time.Time
+		baseAssetID      *uuid.UUID
+		quoteAssetID     *uuid.UUID
+		quoteTTLSeconds  int
 	)
 
 	err := row.Scan(
@@ -1016,6 +1271,9 @@ func (r *TradingPairRepository) scanTradingPair(row pgx.Row) (entities.TradingPa
 		&lastUpdated,
 		&createdAt,
 		&updatedAt,
+		&baseAssetID,
+		&quoteAssetID,
+		&quoteTTLSeconds,
 	)
 	if err != nil {
 		return nil, err
@@ -1059,12 +1317,15 @@ func (r *TradingPairRepository) scanTradingPair(row pgx.Row) (entities.TradingPa
 		ID:            id,
 		BaseSymbol:    baseSymbol,
 		QuoteSymbol:   quoteSymbol,
+		BaseAssetID:   derefUUID(baseAssetID),
+		QuoteAssetID:  derefUUID(quoteAssetID),
 		ExchangeRate:  exchangeRate,
 		InverseRate:   inverseRate,
 		FeePercentage: feePercentage,
 		MinSwapAmount: minSwapAmount,
 		MaxSwapAmount: maxSwapAmount,
 		DailyVolume:   dailyVolume,
+		QuoteTTL:      time.Duration(quoteTTLSeconds) * time.Second,
 		IsActive:      isActive,
 		HasLiquidity:  hasLiquidity,
 		LastUpdated:   lastUpdated,
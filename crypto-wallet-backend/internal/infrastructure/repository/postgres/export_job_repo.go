@@ -0,0 +1,423 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// exportJobColumns lists job metadata; file contents are only read through GetContent.
+const exportJobColumns = `
+	id,
+	user_id,
+	kind,
+	format,
+	parameters,
+	status,
+	attempts,
+	filename,
+	content_type,
+	storage_key,
+	size_bytes,
+	row_count,
+	truncated,
+	error_message,
+	started_at,
+	completed_at,
+	expires_at,
+	created_at,
+	updated_at`
+
+const exportJobSelectColumns = "SELECT" + exportJobColumns + "\nFROM export_jobs"
+
+var (
+	errExportNilPool = errors.New("export job repository: database pool is not configured")
+	errExportNilJob  = errors.New("export job repository: job entity is required")
+)
+
+// ExportJobRepository persists asynchronous export jobs using PostgreSQL.
+type ExportJobRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewExportJobRepository constructs an ExportJobRepository backed by the provided pool.
+func NewExportJobRepository(pool *pgxpool.Pool, logger *slog.Logger) *ExportJobRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ExportJobRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// Create stores a newly queued job.
+func (r *ExportJobRepository) Create(ctx context.Context, job *entities.ExportJobEntity) error {
+	if r.pool == nil {
+		return errExportNilPool
+	}
+	if job == nil {
+		return errExportNilJob
+	}
+
+	parameters, err := json.Marshal(job.GetParameters())
+	if err != nil {
+		return fmt.Errorf("export job repository: encode parameters: %w", err)
+	}
+
+	_, err = r.pool.Exec(ctx, `
+INSERT INTO export_jobs (
+	id,
+	user_id,
+	kind,
+	format,
+	parameters,
+	status,
+	created_at,
+	updated_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8)`,
+		job.GetID(),
+		job.GetUserID(),
+		string(job.GetKind()),
+		job.GetFormat(),
+		parameters,
+		job.GetStatus(),
+		job.GetCreatedAt(),
+		job.GetUpdatedAt(),
+	)
+	return mapPGError(err)
+}
+
+// GetByID fetches a job by its identifier.
+func (r *ExportJobRepository) GetByID(ctx context.Context, id uuid.UUID) (entities.ExportJob, error) {
+	if r.pool == nil {
+		return nil, errExportNilPool
+	}
+
+	row := r.pool.QueryRow(ctx, exportJobSelectColumns+" WHERE id = $1", id)
+	return scanExportJob(row)
+}
+
+// ListByUser returns the user's jobs, newest first.
+func (r *ExportJobRepository) ListByUser(ctx context.Context, userID uuid.UUID, opts repositories.ListOptions) ([]entities.ExportJob, error) {
+	if r.pool == nil {
+		return nil, errExportNilPool
+	}
+
+	options := opts.WithDefaults()
+	query := fmt.Sprintf("%s WHERE user_id = $1 ORDER BY created_at %s LIMIT $2 OFFSET $3",
+		exportJobSelectColumns, options.SortOrder)
+
+	rows, err := r.pool.Query(ctx, query, userID, options.Limit, options.Offset)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	jobs := make([]entities.ExportJob, 0)
+	for rows.Next() {
+		job, err := scanExportJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+
+	return jobs, nil
+}
+
+// CountActiveByUser returns how many of the user's jobs are queued or processing.
+func (r *ExportJobRepository) CountActiveByUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	if r.pool == nil {
+		return 0, errExportNilPool
+	}
+
+	var count int
+	err := r.pool.QueryRow(ctx,
+		"SELECT COUNT(*) FROM export_jobs WHERE user_id = $1 AND status IN ($2, $3)",
+		userID, entities.ExportJobStatusQueued, entities.ExportJobStatusProcessing,
+	).Scan(&count)
+	if err != nil {
+		return 0, mapPGError(err)
+	}
+	return count, nil
+}
+
+// CountQueued returns how many jobs are waiting to be claimed across all users.
+func (r *ExportJobRepository) CountQueued(ctx context.Context) (int64, error) {
+	if r.pool == nil {
+		return 0, errExportNilPool
+	}
+
+	var count int64
+	err := r.pool.QueryRow(ctx,
+		"SELECT COUNT(*) FROM export_jobs WHERE status = $1",
+		entities.ExportJobStatusQueued,
+	).Scan(&count)
+	if err != nil {
+		return 0, mapPGError(err)
+	}
+	return count, nil
+}
+
+// ClaimNext moves the oldest claimable job to processing. SKIP LOCKED lets several workers share the queue.
+func (r *ExportJobRepository) ClaimNext(ctx context.Context, now, staleBefore time.Time) (entities.ExportJob, error) {
+	if r.pool == nil {
+		return nil, errExportNilPool
+	}
+
+	row := r.pool.QueryRow(ctx, `
+UPDATE export_jobs
+SET status = $1,
+	attempts = attempts + 1,
+	started_at = $3,
+	updated_at = $3
+WHERE id = (
+	SELECT id FROM export_jobs
+	WHERE status = $2 OR (status = $1 AND started_at < $4)
+	ORDER BY created_at ASC
+	FOR UPDATE SKIP LOCKED
+	LIMIT 1
+)
+RETURNING`+exportJobColumns,
+		entities.ExportJobStatusProcessing,
+		entities.ExportJobStatusQueued,
+		now,
+		staleBefore,
+	)
+	return scanExportJob(row)
+}
+
+// Complete stores the job's completed state together with the generated file, or with only its
+// storage key when content is nil.
+func (r *ExportJobRepository) Complete(ctx context.Context, job *entities.ExportJobEntity, content []byte) error {
+	if r.pool == nil {
+		return errExportNilPool
+	}
+	if job == nil {
+		return errExportNilJob
+	}
+
+	cmd, err := r.pool.Exec(ctx, `
+UPDATE export_jobs
+SET status = $2,
+	filename = $3,
+	content_type = $4,
+	content = $5,
+	storage_key = $6,
+	size_bytes = $7,
+	row_count = $8,
+	truncated = $9,
+	error_message = NULL,
+	completed_at = $10,
+	expires_at = $11,
+	updated_at = $12
+WHERE id = $1 AND status = $13`,
+		job.GetID(),
+		job.GetStatus(),
+		job.GetFilename(),
+		job.GetContentType(),
+		content,
+		nullIfEmpty(job.GetStorageKey()),
+		job.GetSize(),
+		job.GetRowCount(),
+		job.IsTruncated(),
+		job.GetCompletedAt(),
+		job.GetExpiresAt(),
+		job.GetUpdatedAt(),
+		entities.ExportJobStatusProcessing,
+	)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrConflict
+	}
+	return nil
+}
+
+// Fail records a job that could not be generated.
+func (r *ExportJobRepository) Fail(ctx context.Context, job *entities.ExportJobEntity) error {
+	if r.pool == nil {
+		return errExportNilPool
+	}
+	if job == nil {
+		return errExportNilJob
+	}
+
+	cmd, err := r.pool.Exec(ctx, `
+UPDATE export_jobs
+SET status = $2,
+	error_message = $3,
+	completed_at = $4,
+	updated_at = $5
+WHERE id = $1 AND status = $6`,
+		job.GetID(),
+		job.GetStatus(),
+		nullIfEmpty(job.GetErrorMessage()),
+		job.GetCompletedAt(),
+		job.GetUpdatedAt(),
+		entities.ExportJobStatusProcessing,
+	)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrConflict
+	}
+	return nil
+}
+
+// GetContent returns the generated file of a completed job.
+func (r *ExportJobRepository) GetContent(ctx context.Context, id uuid.UUID) ([]byte, error) {
+	if r.pool == nil {
+		return nil, errExportNilPool
+	}
+
+	var content []byte
+	err := r.pool.QueryRow(ctx,
+		"SELECT content FROM export_jobs WHERE id = $1 AND status = $2 AND content IS NOT NULL",
+		id, entities.ExportJobStatusCompleted,
+	).Scan(&content)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	return content, nil
+}
+
+// PurgeExpired drops generated files whose download window has passed; job metadata is kept for history.
+func (r *ExportJobRepository) PurgeExpired(ctx context.Context, now time.Time) (int64, error) {
+	if r.pool == nil {
+		return 0, errExportNilPool
+	}
+
+	cmd, err := r.pool.Exec(ctx,
+		"UPDATE export_jobs SET content = NULL, updated_at = $1 WHERE expires_at <= $1 AND content IS NOT NULL",
+		now)
+	if err != nil {
+		return 0, mapPGError(err)
+	}
+	return cmd.RowsAffected(), nil
+}
+
+// ListFinishedBefore returns up to limit completed or failed jobs created before the cutoff, oldest first.
+func (r *ExportJobRepository) ListFinishedBefore(ctx context.Context, before time.Time, limit int) ([]uuid.UUID, error) {
+	if r.pool == nil {
+		return nil, errExportNilPool
+	}
+
+	rows, err := r.pool.Query(ctx, `
+SELECT id FROM export_jobs
+WHERE status IN ($1, $2) AND created_at < $3
+ORDER BY created_at ASC
+LIMIT $4`,
+		entities.ExportJobStatusCompleted, entities.ExportJobStatusFailed, before, limit)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	ids := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, mapPGError(err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return ids, nil
+}
+
+// DeleteFinished removes the given jobs; jobs that were requeued in the meantime are left alone.
+func (r *ExportJobRepository) DeleteFinished(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	if r.pool == nil {
+		return 0, errExportNilPool
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	cmd, err := r.pool.Exec(ctx,
+		"DELETE FROM export_jobs WHERE id = ANY($1) AND status IN ($2, $3)",
+		ids, entities.ExportJobStatusCompleted, entities.ExportJobStatusFailed)
+	if err != nil {
+		return 0, mapPGError(err)
+	}
+	return cmd.RowsAffected(), nil
+}
+
+func scanExportJob(row pgx.Row) (entities.ExportJob, error) {
+	var (
+		params       entities.ExportJobParams
+		kind         string
+		status       string
+		parameters   []byte
+		filename     *string
+		contentType  *string
+		storageKey   *string
+		errorMessage *string
+	)
+
+	if err := row.Scan(
+		&params.ID,
+		&params.UserID,
+		&kind,
+		&params.Format,
+		&parameters,
+		&status,
+		&params.Attempts,
+		&filename,
+		&contentType,
+		&storageKey,
+		&params.Size,
+		&params.RowCount,
+		&params.Truncated,
+		&errorMessage,
+		&params.StartedAt,
+		&params.CompletedAt,
+		&params.ExpiresAt,
+		&params.CreatedAt,
+		&params.UpdatedAt,
+	); err != nil {
+		return nil, mapPGError(err)
+	}
+
+	params.Kind = entities.ExportJobKind(kind)
+	params.Status = entities.ExportJobStatus(status)
+	if len(parameters) > 0 {
+		if err := json.Unmarshal(parameters, &params.Parameters); err != nil {
+			return nil, fmt.Errorf("export job repository: decode parameters: %w", err)
+		}
+	}
+	if filename != nil {
+		params.Filename = *filename
+	}
+	if contentType != nil {
+		params.ContentType = *contentType
+	}
+	if storageKey != nil {
+		params.StorageKey = *storageKey
+	}
+	if errorMessage != nil {
+		params.ErrorMessage = *errorMessage
+	}
+
+	return entities.HydrateExportJobEntity(params), nil
+}
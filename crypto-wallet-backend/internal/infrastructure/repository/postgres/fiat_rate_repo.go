@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+const fiatRateSelectColumns = `
+SELECT
+	currency,
+	rate_per_usd,
+	source,
+	updated_at
+FROM fiat_rates`
+
+var errFiatRateNilPool = errors.New("fiat rate repository: database pool is not configured")
+
+// FiatRateRepository persists fiat reference rates using PostgreSQL.
+type FiatRateRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewFiatRateRepository constructs a FiatRateRepository backed by the provided pool.
+func NewFiatRateRepository(pool *pgxpool.Pool, logger *slog.Logger) *FiatRateRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &FiatRateRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// GetFiatRate returns the stored rate for the currency.
+func (r *FiatRateRepository) GetFiatRate(ctx context.Context, currency entities.CurrencyCode) (entities.FiatRate, error) {
+	if r.pool == nil {
+		return entities.FiatRate{}, errFiatRateNilPool
+	}
+
+	rate, err := scanFiatRate(r.pool.QueryRow(ctx, fiatRateSelectColumns+" WHERE currency = $1", string(currency)))
+	if err != nil {
+		return entities.FiatRate{}, mapPGError(err)
+	}
+	return rate, nil
+}
+
+// ListFiatRates returns every stored rate ordered by currency.
+func (r *FiatRateRepository) ListFiatRates(ctx context.Context) ([]entities.FiatRate, error) {
+	if r.pool == nil {
+		return nil, errFiatRateNilPool
+	}
+
+	rows, err := r.pool.Query(ctx, fiatRateSelectColumns+" ORDER BY currency")
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	rates := make([]entities.FiatRate, 0)
+	for rows.Next() {
+		rate, err := scanFiatRate(rows)
+		if err != nil {
+			return nil, mapPGError(err)
+		}
+		rates = append(rates, rate)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return rates, nil
+}
+
+// UpsertFiatRates stores the rates in one batch, replacing earlier values.
+func (r *FiatRateRepository) UpsertFiatRates(ctx context.Context, rates []entities.FiatRate) error {
+	if r.pool == nil {
+		return errFiatRateNilPool
+	}
+	if len(rates) == 0 {
+		return nil
+	}
+
+	batch := &pgx.Batch{}
+	for _, rate := range rates {
+		if err := rate.Validate(); err != nil {
+			return err
+		}
+		batch.Queue(`
+INSERT INTO fiat_rates (currency, rate_per_usd, source, updated_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (currency) DO UPDATE SET
+	rate_per_usd = EXCLUDED.rate_per_usd,
+	source = EXCLUDED.source,
+	updated_at = EXCLUDED.updated_at`,
+			string(rate.Currency), rate.PerUSD, rate.Source, rate.UpdatedAt,
+		)
+	}
+
+	results := r.pool.SendBatch(ctx, batch)
+	defer results.Close()
+	for range rates {
+		if _, err := results.Exec(); err != nil {
+			return mapPGError(err)
+		}
+	}
+	return nil
+}
+
+func scanFiatRate(row pgx.Row) (entities.FiatRate, error) {
+	var (
+		rate     entities.FiatRate
+		currency string
+	)
+	if err := row.Scan(&currency, &rate.PerUSD, &rate.Source, &rate.UpdatedAt); err != nil {
+		return entities.FiatRate{}, err
+	}
+	rate.Currency = entities.CurrencyCode(currency)
+	return rate, nil
+}
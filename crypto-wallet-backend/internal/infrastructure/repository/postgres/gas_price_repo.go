@@ -0,0 +1,181 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+const gasPriceSelectColumns = `
+SELECT
+	chain,
+	block_number,
+	base_fee_wei,
+	slow_priority_fee_wei,
+	normal_priority_fee_wei,
+	fast_priority_fee_wei,
+	gas_used_ratio,
+	observed_at
+FROM gas_price_history`
+
+var errGasPriceNilPool = errors.New("gas price repository: database pool is not configured")
+
+// GasPriceRepository persists gas oracle samples and gas speed preferences using PostgreSQL.
+type GasPriceRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewGasPriceRepository constructs a GasPriceRepository backed by the provided pool.
+func NewGasPriceRepository(pool *pgxpool.Pool, logger *slog.Logger) *GasPriceRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GasPriceRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// SaveSamples stores samples in one batch, skipping blocks already recorded.
+func (r *GasPriceRepository) SaveSamples(ctx context.Context, samples []entities.GasPriceSample) error {
+	if r.pool == nil {
+		return errGasPriceNilPool
+	}
+	if len(samples) == 0 {
+		return nil
+	}
+
+	batch := &pgx.Batch{}
+	for _, sample := range samples {
+		observedAt := sample.ObservedAt
+		if observedAt.IsZero() {
+			observedAt = time.Now().UTC()
+		}
+		batch.Queue(`
+INSERT INTO gas_price_history (
+	chain,
+	block_number,
+	base_fee_wei,
+	slow_priority_fee_wei,
+	normal_priority_fee_wei,
+	fast_priority_fee_wei,
+	gas_used_ratio,
+	observed_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8)
+ON CONFLICT (chain, block_number) DO NOTHING`,
+			string(sample.Chain),
+			sample.BlockNumber,
+			sample.BaseFeeWei,
+			sample.SlowPriorityFeeWei,
+			sample.NormalPriorityFeeWei,
+			sample.FastPriorityFeeWei,
+			sample.GasUsedRatio,
+			observedAt,
+		)
+	}
+
+	results := r.pool.SendBatch(ctx, batch)
+	defer results.Close()
+	for range samples {
+		if _, err := results.Exec(); err != nil {
+			return mapPGError(err)
+		}
+	}
+	return nil
+}
+
+// ListSamples returns the chain's samples observed at or after since, newest block first.
+func (r *GasPriceRepository) ListSamples(ctx context.Context, chain entities.Chain, since time.Time, limit int) ([]entities.GasPriceSample, error) {
+	if r.pool == nil {
+		return nil, errGasPriceNilPool
+	}
+	if limit <= 0 {
+		limit = 100
+	}
+
+	rows, err := r.pool.Query(ctx,
+		gasPriceSelectColumns+" WHERE chain = $1 AND observed_at >= $2 ORDER BY block_number DESC LIMIT $3",
+		string(chain), since, limit,
+	)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	samples := make([]entities.GasPriceSample, 0)
+	for rows.Next() {
+		var (
+			sample entities.GasPriceSample
+			code   string
+		)
+		if err := rows.Scan(
+			&code,
+			&sample.BlockNumber,
+			&sample.BaseFeeWei,
+			&sample.SlowPriorityFeeWei,
+			&sample.NormalPriorityFeeWei,
+			&sample.FastPriorityFeeWei,
+			&sample.GasUsedRatio,
+			&sample.ObservedAt,
+		); err != nil {
+			return nil, mapPGError(err)
+		}
+		sample.Chain = entities.Chain(code)
+		samples = append(samples, sample)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return samples, nil
+}
+
+// PurgeSamplesBefore deletes samples observed before cutoff.
+func (r *GasPriceRepository) PurgeSamplesBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	if r.pool == nil {
+		return 0, errGasPriceNilPool
+	}
+
+	cmd, err := r.pool.Exec(ctx, "DELETE FROM gas_price_history WHERE observed_at < $1", cutoff)
+	if err != nil {
+		return 0, mapPGError(err)
+	}
+	return cmd.RowsAffected(), nil
+}
+
+// GetSpeedPreference returns the user's default gas speed.
+func (r *GasPriceRepository) GetSpeedPreference(ctx context.Context, userID uuid.UUID) (entities.GasSpeed, error) {
+	if r.pool == nil {
+		return "", errGasPriceNilPool
+	}
+
+	var speed string
+	if err := r.pool.QueryRow(ctx, "SELECT speed FROM user_gas_preferences WHERE user_id = $1", userID).Scan(&speed); err != nil {
+		return "", mapPGError(err)
+	}
+	return entities.GasSpeed(speed), nil
+}
+
+// SetSpeedPreference records the user's default gas speed.
+func (r *GasPriceRepository) SetSpeedPreference(ctx context.Context, userID uuid.UUID, speed entities.GasSpeed) error {
+	if r.pool == nil {
+		return errGasPriceNilPool
+	}
+
+	_, err := r.pool.Exec(ctx, `
+INSERT INTO user_gas_preferences (user_id, speed, updated_at)
+VALUES ($1, $2, NOW())
+ON CONFLICT (user_id) DO UPDATE SET
+	speed = EXCLUDED.speed,
+	updated_at = EXCLUDED.updated_at`,
+		userID, string(speed),
+	)
+	return mapPGError(err)
+}
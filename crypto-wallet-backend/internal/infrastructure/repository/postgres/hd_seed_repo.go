@@ -0,0 +1,202 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const hdSeedSelectColumns = `
+SELECT
+	user_id,
+	encrypted_seed,
+	source,
+	backup_confirmed_at,
+	created_at,
+	updated_at
+FROM hd_wallet_seeds`
+
+var errHDSeedNilPool = errors.New("hd seed repository: database pool is not configured")
+
+// HDSeedRepository persists users' encrypted HD wallet seeds using PostgreSQL.
+type HDSeedRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewHDSeedRepository constructs an HDSeedRepository backed by the provided pool.
+func NewHDSeedRepository(pool *pgxpool.Pool, logger *slog.Logger) *HDSeedRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &HDSeedRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// Create stores the user's seed. Returns ErrDuplicate when the user already has one.
+func (r *HDSeedRepository) Create(ctx context.Context, seed entities.HDSeed) error {
+	if r.pool == nil {
+		return errHDSeedNilPool
+	}
+	if err := seed.Validate(); err != nil {
+		return err
+	}
+
+	_, err := r.pool.Exec(ctx, `
+INSERT INTO hd_wallet_seeds (
+	user_id,
+	encrypted_seed,
+	source,
+	backup_confirmed_at,
+	created_at,
+	updated_at
+) VALUES ($1,$2,$3,$4,$5,$6)`,
+		seed.UserID,
+		seed.EncryptedSeed,
+		string(seed.Source),
+		seed.BackupConfirmedAt,
+		seed.CreatedAt.UTC(),
+		seed.UpdatedAt.UTC(),
+	)
+	return mapPGError(err)
+}
+
+// GetByUser returns the user's seed, or ErrNotFound.
+func (r *HDSeedRepository) GetByUser(ctx context.Context, userID uuid.UUID) (entities.HDSeed, error) {
+	if r.pool == nil {
+		return entities.HDSeed{}, errHDSeedNilPool
+	}
+	row := r.pool.QueryRow(ctx, hdSeedSelectColumns+" WHERE user_id = $1", userID)
+	return scanHDSeed(row)
+}
+
+// ConfirmBackup records the backup confirmation. Returns ErrNotFound when the user has no seed
+// and ErrConflict when the backup was already confirmed.
+func (r *HDSeedRepository) ConfirmBackup(ctx context.Context, userID uuid.UUID, at time.Time) error {
+	if r.pool == nil {
+		return errHDSeedNilPool
+	}
+
+	cmd, err := r.pool.Exec(ctx, `
+UPDATE hd_wallet_seeds
+SET backup_confirmed_at = $2, updated_at = $2
+WHERE user_id = $1 AND backup_confirmed_at IS NULL`, userID, at.UTC())
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() > 0 {
+		return nil
+	}
+
+	var exists bool
+	if err := r.pool.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM hd_wallet_seeds WHERE user_id = $1)", userID).Scan(&exists); err != nil {
+		return mapPGError(err)
+	}
+	if !exists {
+		return repositories.ErrNotFound
+	}
+	return repositories.ErrConflict
+}
+
+// ReserveAccountIndex atomically hands out the next unused account index of the chain.
+func (r *HDSeedRepository) ReserveAccountIndex(ctx context.Context, userID uuid.UUID, chain entities.Chain) (uint32, error) {
+	if r.pool == nil {
+		return 0, errHDSeedNilPool
+	}
+
+	var next int64
+	err := r.pool.QueryRow(ctx, `
+INSERT INTO hd_wallet_account_indexes (user_id, chain, next_index)
+VALUES ($1, $2, 1)
+ON CONFLICT (user_id, chain) DO UPDATE
+SET next_index = hd_wallet_account_indexes.next_index + 1
+RETURNING next_index`, userID, string(chain)).Scan(&next)
+	if err != nil {
+		return 0, mapPGError(err)
+	}
+	return uint32(next - 1), nil
+}
+
+// List pages through all stored seeds.
+func (r *HDSeedRepository) List(ctx context.Context, after uuid.UUID, limit int) ([]entities.HDSeed, error) {
+	if r.pool == nil {
+		return nil, errHDSeedNilPool
+	}
+	if limit <= 0 {
+		limit = repositories.DefaultListLimit
+	}
+
+	rows, err := r.pool.Query(ctx, hdSeedSelectColumns+" WHERE user_id > $1 ORDER BY user_id LIMIT $2", after, limit)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	seeds := make([]entities.HDSeed, 0)
+	for rows.Next() {
+		seed, err := scanHDSeed(rows)
+		if err != nil {
+			return nil, err
+		}
+		seeds = append(seeds, seed)
+	}
+	return seeds, mapPGError(rows.Err())
+}
+
+// ReplaceEncryptedSeed swaps the encrypted seed only if nobody changed it in the meantime.
+func (r *HDSeedRepository) ReplaceEncryptedSeed(ctx context.Context, userID uuid.UUID, current, replacement string) error {
+	if r.pool == nil {
+		return errHDSeedNilPool
+	}
+
+	cmd, err := r.pool.Exec(ctx, `
+UPDATE hd_wallet_seeds
+SET encrypted_seed = $3
+WHERE user_id = $1 AND encrypted_seed = $2`, userID, current, replacement)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() > 0 {
+		return nil
+	}
+
+	var exists bool
+	if err := r.pool.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM hd_wallet_seeds WHERE user_id = $1)", userID).Scan(&exists); err != nil {
+		return mapPGError(err)
+	}
+	if !exists {
+		return repositories.ErrNotFound
+	}
+	return repositories.ErrConflict
+}
+
+func scanHDSeed(row pgx.Row) (entities.HDSeed, error) {
+	var (
+		seed   entities.HDSeed
+		source string
+	)
+	if err := row.Scan(
+		&seed.UserID,
+		&seed.EncryptedSeed,
+		&source,
+		&seed.BackupConfirmedAt,
+		&seed.CreatedAt,
+		&seed.UpdatedAt,
+	); err != nil {
+		return entities.HDSeed{}, mapPGError(err)
+	}
+	seed.Source = entities.HDSeedSource(source)
+	seed.CreatedAt = seed.CreatedAt.UTC()
+	seed.UpdatedAt = seed.UpdatedAt.UTC()
+	return seed, nil
+}
@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const internalEventConsumerSelectColumns = `
+SELECT
+	id,
+	name,
+	cert_fingerprint,
+	event_types,
+	is_active,
+	created_at,
+	last_seen_at
+FROM internal_event_consumers`
+
+var errInternalEventConsumerNilPool = errors.New("internal event consumer repository: database pool is not configured")
+
+// InternalEventConsumerRepository persists internal event consumers using PostgreSQL.
+type InternalEventConsumerRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewInternalEventConsumerRepository constructs an InternalEventConsumerRepository backed by the provided pool.
+func NewInternalEventConsumerRepository(pool *pgxpool.Pool, logger *slog.Logger) *InternalEventConsumerRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &InternalEventConsumerRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// Create registers a consumer and returns it as stored.
+func (r *InternalEventConsumerRepository) Create(ctx context.Context, consumer entities.InternalEventConsumer) (entities.InternalEventConsumer, error) {
+	if r.pool == nil {
+		return entities.InternalEventConsumer{}, errInternalEventConsumerNilPool
+	}
+	if err := consumer.Validate(); err != nil {
+		return entities.InternalEventConsumer{}, err
+	}
+	if consumer.ID == uuid.Nil {
+		consumer.ID = uuid.New()
+	}
+
+	row := r.pool.QueryRow(ctx, `
+INSERT INTO internal_event_consumers (
+	id,
+	name,
+	cert_fingerprint,
+	event_types,
+	is_active
+) VALUES ($1,$2,$3,$4,$5)
+RETURNING id, name, cert_fingerprint, event_types, is_active, created_at, last_seen_at`,
+		consumer.ID,
+		consumer.Name,
+		consumer.CertFingerprint,
+		consumer.EventTypes,
+		consumer.IsActive,
+	)
+	return scanInternalEventConsumer(row)
+}
+
+// GetByFingerprint returns the consumer holding the certificate or ErrNotFound.
+func (r *InternalEventConsumerRepository) GetByFingerprint(ctx context.Context, fingerprint string) (entities.InternalEventConsumer, error) {
+	if r.pool == nil {
+		return entities.InternalEventConsumer{}, errInternalEventConsumerNilPool
+	}
+
+	row := r.pool.QueryRow(ctx, internalEventConsumerSelectColumns+`
+WHERE cert_fingerprint = $1`, fingerprint)
+	return scanInternalEventConsumer(row)
+}
+
+// List returns every registered consumer ordered by name.
+func (r *InternalEventConsumerRepository) List(ctx context.Context) ([]entities.InternalEventConsumer, error) {
+	if r.pool == nil {
+		return nil, errInternalEventConsumerNilPool
+	}
+
+	rows, err := r.pool.Query(ctx, internalEventConsumerSelectColumns+`
+ORDER BY name ASC`)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	consumers := make([]entities.InternalEventConsumer, 0)
+	for rows.Next() {
+		consumer, err := scanInternalEventConsumer(rows)
+		if err != nil {
+			return nil, err
+		}
+		consumers = append(consumers, consumer)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return consumers, nil
+}
+
+// TouchLastSeen records when the consumer last called the internal API.
+func (r *InternalEventConsumerRepository) TouchLastSeen(ctx context.Context, id uuid.UUID, at time.Time) error {
+	if r.pool == nil {
+		return errInternalEventConsumerNilPool
+	}
+
+	tag, err := r.pool.Exec(ctx, "UPDATE internal_event_consumers SET last_seen_at = $2 WHERE id = $1", id, at)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+func scanInternalEventConsumer(row pgx.Row) (entities.InternalEventConsumer, error) {
+	var consumer entities.InternalEventConsumer
+	if err := row.Scan(
+		&consumer.ID,
+		&consumer.Name,
+		&consumer.CertFingerprint,
+		&consumer.EventTypes,
+		&consumer.IsActive,
+		&consumer.CreatedAt,
+		&consumer.LastSeenAt,
+	); err != nil {
+		return entities.InternalEventConsumer{}, mapPGError(err)
+	}
+	return consumer, nil
+}
@@ -0,0 +1,264 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const keyBackupSelectColumns = `
+SELECT
+	id,
+	requested_by,
+	reason,
+	recipient_public_key,
+	recipient_fingerprint,
+	quorum,
+	status,
+	exported_by,
+	archive_sha256,
+	wallet_count,
+	created_at,
+	expires_at,
+	exported_at
+FROM key_backup_requests`
+
+var errKeyBackupNilPool = errors.New("key backup repository: database pool is not configured")
+
+// KeyBackupRepository persists disaster-recovery key backup requests using PostgreSQL.
+type KeyBackupRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewKeyBackupRepository constructs a KeyBackupRepository backed by the provided pool.
+func NewKeyBackupRepository(pool *pgxpool.Pool, logger *slog.Logger) *KeyBackupRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &KeyBackupRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// Create stores a new request.
+func (r *KeyBackupRepository) Create(ctx context.Context, request entities.KeyBackupRequest) error {
+	if r.pool == nil {
+		return errKeyBackupNilPool
+	}
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	_, err := r.pool.Exec(ctx, `
+INSERT INTO key_backup_requests (
+	id,
+	requested_by,
+	reason,
+	recipient_public_key,
+	recipient_fingerprint,
+	quorum,
+	status,
+	created_at,
+	expires_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)`,
+		request.ID,
+		request.RequestedBy,
+		request.Reason,
+		request.RecipientPublicKey,
+		request.RecipientFingerprint,
+		request.Quorum,
+		string(request.Status),
+		request.CreatedAt,
+		request.ExpiresAt,
+	)
+	return mapPGError(err)
+}
+
+// GetByID returns the request with its approvals.
+func (r *KeyBackupRepository) GetByID(ctx context.Context, id uuid.UUID) (entities.KeyBackupRequest, error) {
+	if r.pool == nil {
+		return entities.KeyBackupRequest{}, errKeyBackupNilPool
+	}
+
+	var (
+		request       entities.KeyBackupRequest
+		status        string
+		exportedBy    *string
+		archiveSHA256 *string
+		walletCount   *int
+	)
+	if err := r.pool.QueryRow(ctx, keyBackupSelectColumns+" WHERE id = $1", id).Scan(
+		&request.ID,
+		&request.RequestedBy,
+		&request.Reason,
+		&request.RecipientPublicKey,
+		&request.RecipientFingerprint,
+		&request.Quorum,
+		&status,
+		&exportedBy,
+		&archiveSHA256,
+		&walletCount,
+		&request.CreatedAt,
+		&request.ExpiresAt,
+		&request.ExportedAt,
+	); err != nil {
+		return entities.KeyBackupRequest{}, mapPGError(err)
+	}
+	request.Status = entities.KeyBackupStatus(status)
+	if exportedBy != nil {
+		request.ExportedBy = *exportedBy
+	}
+	if archiveSHA256 != nil {
+		request.ArchiveSHA256 = *archiveSHA256
+	}
+	if walletCount != nil {
+		request.WalletCount = *walletCount
+	}
+
+	rows, err := r.pool.Query(ctx,
+		"SELECT approver, signature, approved_at FROM key_backup_approvals WHERE request_id = $1 ORDER BY approved_at ASC, approver ASC",
+		id)
+	if err != nil {
+		return entities.KeyBackupRequest{}, mapPGError(err)
+	}
+	defer rows.Close()
+
+	request.Approvals = make([]entities.KeyBackupApproval, 0)
+	for rows.Next() {
+		var approval entities.KeyBackupApproval
+		if err := rows.Scan(&approval.Approver, &approval.Signature, &approval.ApprovedAt); err != nil {
+			return entities.KeyBackupRequest{}, mapPGError(err)
+		}
+		request.Approvals = append(request.Approvals, approval)
+	}
+	if err := rows.Err(); err != nil {
+		return entities.KeyBackupRequest{}, mapPGError(err)
+	}
+
+	return request, nil
+}
+
+// AddApproval records an operator's approval.
+func (r *KeyBackupRepository) AddApproval(ctx context.Context, id uuid.UUID, approval entities.KeyBackupApproval) error {
+	if r.pool == nil {
+		return errKeyBackupNilPool
+	}
+
+	_, err := r.pool.Exec(ctx,
+		"INSERT INTO key_backup_approvals (request_id, approver, signature, approved_at) VALUES ($1,$2,$3,$4)",
+		id, approval.Approver, approval.Signature, approval.ApprovedAt)
+	return mapPGError(err)
+}
+
+// RemoveApproval withdraws an approval that could not be audited.
+func (r *KeyBackupRepository) RemoveApproval(ctx context.Context, id uuid.UUID, approver string) error {
+	if r.pool == nil {
+		return errKeyBackupNilPool
+	}
+
+	_, err := r.pool.Exec(ctx, "DELETE FROM key_backup_approvals WHERE request_id = $1 AND approver = $2", id, approver)
+	return mapPGError(err)
+}
+
+// ClaimExport marks a pending, unexpired request as exporting.
+func (r *KeyBackupRepository) ClaimExport(ctx context.Context, id uuid.UUID, exportedBy string, at time.Time) (bool, error) {
+	if r.pool == nil {
+		return false, errKeyBackupNilPool
+	}
+
+	cmd, err := r.pool.Exec(ctx, `
+UPDATE key_backup_requests
+SET status = $2, exported_by = $3
+WHERE id = $1 AND status = $4 AND expires_at > $5`,
+		id, string(entities.KeyBackupStatusExporting), exportedBy, string(entities.KeyBackupStatusPending), at)
+	if err != nil {
+		return false, mapPGError(err)
+	}
+	return cmd.RowsAffected() == 1, nil
+}
+
+// CompleteExport records the archive digest of a claimed request.
+func (r *KeyBackupRepository) CompleteExport(ctx context.Context, id uuid.UUID, archiveSHA256 string, walletCount int, at time.Time) error {
+	if r.pool == nil {
+		return errKeyBackupNilPool
+	}
+
+	cmd, err := r.pool.Exec(ctx, `
+UPDATE key_backup_requests
+SET status = $2, archive_sha256 = $3, wallet_count = $4, exported_at = $5
+WHERE id = $1 AND status = $6`,
+		id, string(entities.KeyBackupStatusExported), archiveSHA256, walletCount, at, string(entities.KeyBackupStatusExporting))
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+// FailExport marks a claimed request as failed.
+func (r *KeyBackupRepository) FailExport(ctx context.Context, id uuid.UUID) error {
+	if r.pool == nil {
+		return errKeyBackupNilPool
+	}
+
+	_, err := r.pool.Exec(ctx,
+		"UPDATE key_backup_requests SET status = $2 WHERE id = $1 AND status = $3",
+		id, string(entities.KeyBackupStatusFailed), string(entities.KeyBackupStatusExporting))
+	return mapPGError(err)
+}
+
+// ListWalletKeys returns a page of stored wallet key material.
+func (r *KeyBackupRepository) ListWalletKeys(ctx context.Context, afterID uuid.UUID, limit int) ([]entities.WalletKeyRecord, error) {
+	if r.pool == nil {
+		return nil, errKeyBackupNilPool
+	}
+
+	rows, err := r.pool.Query(ctx, `
+SELECT w.id, w.user_id, w.chain, w.address, w.derivation_path, w.encrypted_private_key, s.encrypted_seed
+FROM wallets w
+LEFT JOIN hd_wallet_seeds s ON s.user_id = w.user_id AND w.encrypted_private_key = ''
+WHERE w.id > $1
+ORDER BY w.id ASC
+LIMIT $2`, afterID, limit)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	records := make([]entities.WalletKeyRecord, 0, limit)
+	for rows.Next() {
+		var (
+			record         entities.WalletKeyRecord
+			chain          string
+			derivationPath *string
+			encryptedSeed  *string
+		)
+		if err := rows.Scan(&record.WalletID, &record.UserID, &chain, &record.Address, &derivationPath, &record.EncryptedPrivateKey, &encryptedSeed); err != nil {
+			return nil, mapPGError(err)
+		}
+		record.Chain = entities.Chain(chain)
+		if derivationPath != nil {
+			record.DerivationPath = *derivationPath
+		}
+		if encryptedSeed != nil {
+			record.EncryptedSeed = *encryptedSeed
+		}
+		records = append(records, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+
+	return records, nil
+}
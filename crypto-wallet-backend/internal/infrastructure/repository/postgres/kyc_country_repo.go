@@ -0,0 +1,181 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const selectKYCCountryRequirement = `
+SELECT
+	country_code,
+	required_documents::text[],
+	max_daily_limit_usd,
+	max_monthly_limit_usd,
+	blocked,
+	notes,
+	updated_by,
+	updated_at
+FROM kyc_country_requirements`
+
+// GetCountryRequirement returns the compliance matrix entry for a country code (or the * default entry).
+func (r *KYCRepository) GetCountryRequirement(ctx context.Context, countryCode string) (entities.KYCCountryRequirement, error) {
+	if r.pool == nil {
+		return entities.KYCCountryRequirement{}, errors.New("kyc repository: pool not configured")
+	}
+
+	row := r.pool.QueryRow(ctx, selectKYCCountryRequirement+" WHERE country_code = $1", entities.NormaliseCountryCode(countryCode))
+	requirement, err := scanKYCCountryRequirement(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entities.KYCCountryRequirement{}, repositories.ErrNotFound
+		}
+		return entities.KYCCountryRequirement{}, mapPGError(err)
+	}
+	return requirement, nil
+}
+
+// ListCountryRequirements returns the whole compliance matrix ordered by country code.
+func (r *KYCRepository) ListCountryRequirements(ctx context.Context) ([]entities.KYCCountryRequirement, error) {
+	if r.pool == nil {
+		return nil, errors.New("kyc repository: pool not configured")
+	}
+
+	rows, err := r.pool.Query(ctx, selectKYCCountryRequirement+" ORDER BY country_code ASC")
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	var requirements []entities.KYCCountryRequirement
+	for rows.Next() {
+		requirement, scanErr := scanKYCCountryRequirement(rows)
+		if scanErr != nil {
+			return nil, mapPGError(scanErr)
+		}
+		requirements = append(requirements, requirement)
+	}
+	if rows.Err() != nil {
+		return nil, mapPGError(rows.Err())
+	}
+	return requirements, nil
+}
+
+// UpsertCountryRequirement creates or replaces a compliance matrix entry.
+func (r *KYCRepository) UpsertCountryRequirement(ctx context.Context, requirement entities.KYCCountryRequirement) error {
+	if r.pool == nil {
+		return errors.New("kyc repository: pool not configured")
+	}
+
+	updatedAt := requirement.UpdatedAt
+	if updatedAt.IsZero() {
+		updatedAt = time.Now().UTC()
+	}
+
+	_, err := r.pool.Exec(ctx, `
+INSERT INTO kyc_country_requirements (
+	country_code,
+	required_documents,
+	max_daily_limit_usd,
+	max_monthly_limit_usd,
+	blocked,
+	notes,
+	updated_by,
+	updated_at
+) VALUES ($1,$2::document_type[],$3,$4,$5,$6,$7,$8)
+ON CONFLICT (country_code) DO UPDATE SET
+	required_documents = EXCLUDED.required_documents,
+	max_daily_limit_usd = EXCLUDED.max_daily_limit_usd,
+	max_monthly_limit_usd = EXCLUDED.max_monthly_limit_usd,
+	blocked = EXCLUDED.blocked,
+	notes = EXCLUDED.notes,
+	updated_by = EXCLUDED.updated_by,
+	updated_at = EXCLUDED.updated_at`,
+		entities.NormaliseCountryCode(requirement.CountryCode),
+		documentTypesToStrings(requirement.RequiredDocuments),
+		requirement.MaxDailyLimitUSD.String(),
+		requirement.MaxMonthlyLimitUSD.String(),
+		requirement.Blocked,
+		nullIfEmpty(requirement.Notes),
+		requirement.UpdatedBy,
+		updatedAt,
+	)
+	return mapPGError(err)
+}
+
+// DeleteCountryRequirement removes a compliance matrix entry.
+func (r *KYCRepository) DeleteCountryRequirement(ctx context.Context, countryCode string) error {
+	if r.pool == nil {
+		return errors.New("kyc repository: pool not configured")
+	}
+
+	cmd, err := r.pool.Exec(ctx, "DELETE FROM kyc_country_requirements WHERE country_code = $1", entities.NormaliseCountryCode(countryCode))
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+func scanKYCCountryRequirement(row pgx.Row) (entities.KYCCountryRequirement, error) {
+	var (
+		countryCode  string
+		documents    []string
+		dailyLimit   string
+		monthlyLimit string
+		blocked      bool
+		notes        sql.NullString
+		updatedBy    *uuid.UUID
+		updatedAt    time.Time
+	)
+
+	if err := row.Scan(
+		&countryCode,
+		&documents,
+		&dailyLimit,
+		&monthlyLimit,
+		&blocked,
+		&notes,
+		&updatedBy,
+		&updatedAt,
+	); err != nil {
+		return entities.KYCCountryRequirement{}, err
+	}
+
+	maxDaily, err := decimal.NewFromString(strings.TrimSpace(dailyLimit))
+	if err != nil {
+		return entities.KYCCountryRequirement{}, fmt.Errorf("kyc repository: parse max daily limit: %w", err)
+	}
+	maxMonthly, err := decimal.NewFromString(strings.TrimSpace(monthlyLimit))
+	if err != nil {
+		return entities.KYCCountryRequirement{}, fmt.Errorf("kyc repository: parse max monthly limit: %w", err)
+	}
+
+	required := make([]entities.DocumentType, 0, len(documents))
+	for _, doc := range documents {
+		required = append(required, entities.DocumentType(doc))
+	}
+
+	return entities.KYCCountryRequirement{
+		CountryCode:        countryCode,
+		RequiredDocuments:  required,
+		MaxDailyLimitUSD:   maxDaily,
+		MaxMonthlyLimitUSD: maxMonthly,
+		Blocked:            blocked,
+		Notes:              notes.String,
+		UpdatedBy:          updatedBy,
+		UpdatedAt:          updatedAt,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// ListDocumentTypeSpecs returns stored document type capture rules; types without a row use the defaults.
+func (r *KYCRepository) ListDocumentTypeSpecs(ctx context.Context) ([]entities.KYCDocumentTypeSpec, error) {
+	if r.pool == nil {
+		return nil, errors.New("kyc repository: pool not configured")
+	}
+
+	rows, err := r.pool.Query(ctx, `
+SELECT
+	document_type::text,
+	labels,
+	accepted_mime_types,
+	max_size_bytes,
+	double_sided,
+	requires_selfie_with_document,
+	enabled,
+	updated_at
+FROM kyc_document_type_specs
+ORDER BY document_type ASC`)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	var specs []entities.KYCDocumentTypeSpec
+	for rows.Next() {
+		var (
+			documentType string
+			labelsJSON   []byte
+			spec         entities.KYCDocumentTypeSpec
+			updatedAt    time.Time
+		)
+		if err := rows.Scan(
+			&documentType,
+			&labelsJSON,
+			&spec.AcceptedMimeTypes,
+			&spec.MaxSizeBytes,
+			&spec.DoubleSided,
+			&spec.RequiresSelfieWithDocument,
+			&spec.Enabled,
+			&updatedAt,
+		); err != nil {
+			return nil, mapPGError(err)
+		}
+		if len(labelsJSON) > 0 {
+			if err := json.Unmarshal(labelsJSON, &spec.Labels); err != nil {
+				return nil, fmt.Errorf("kyc repository: decode document type labels: %w", err)
+			}
+		}
+		spec.DocumentType = entities.DocumentType(documentType)
+		spec.UpdatedAt = updatedAt
+		specs = append(specs, spec)
+	}
+	if rows.Err() != nil {
+		return nil, mapPGError(rows.Err())
+	}
+	return specs, nil
+}
@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const selectKYCLimitSnapshot = `
+SELECT
+	id,
+	user_id,
+	verification_level::text,
+	status::text,
+	daily_limit_usd::text,
+	monthly_limit_usd::text,
+	risk_level::text,
+	risk_score,
+	change_source,
+	effective_at,
+	recorded_at
+FROM kyc_limit_history`
+
+// GetLimitSnapshotAt returns the limit snapshot in force for the user at the given time.
+func (r *KYCRepository) GetLimitSnapshotAt(ctx context.Context, userID uuid.UUID, at time.Time) (entities.KYCLimitSnapshot, error) {
+	if r.pool == nil {
+		return entities.KYCLimitSnapshot{}, errors.New("kyc repository: pool not configured")
+	}
+
+	row := r.pool.QueryRow(ctx, selectKYCLimitSnapshot+`
+WHERE user_id = $1 AND effective_at <= $2
+ORDER BY effective_at DESC, id DESC
+LIMIT 1`, userID, at.UTC())
+	snapshot, err := scanKYCLimitSnapshot(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entities.KYCLimitSnapshot{}, repositories.ErrNotFound
+		}
+		return entities.KYCLimitSnapshot{}, mapPGError(err)
+	}
+	return snapshot, nil
+}
+
+// ListLimitHistory returns the user's limit snapshots effective within the optional bounds, newest first.
+func (r *KYCRepository) ListLimitHistory(ctx context.Context, userID uuid.UUID, from, to *time.Time, limit int) ([]entities.KYCLimitSnapshot, error) {
+	if r.pool == nil {
+		return nil, errors.New("kyc repository: pool not configured")
+	}
+	if limit <= 0 {
+		limit = 100
+	}
+
+	conditions := []string{"user_id = $1"}
+	args := []any{userID}
+	if from != nil {
+		args = append(args, from.UTC())
+		conditions = append(conditions, fmt.Sprintf("effective_at >= $%d", len(args)))
+	}
+	if to != nil {
+		args = append(args, to.UTC())
+		conditions = append(conditions, fmt.Sprintf("effective_at <= $%d", len(args)))
+	}
+	args = append(args, limit)
+
+	query := fmt.Sprintf("%s WHERE %s ORDER BY effective_at DESC, id DESC LIMIT $%d",
+		selectKYCLimitSnapshot, strings.Join(conditions, " AND "), len(args))
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	var snapshots []entities.KYCLimitSnapshot
+	for rows.Next() {
+		snapshot, scanErr := scanKYCLimitSnapshot(rows)
+		if scanErr != nil {
+			return nil, mapPGError(scanErr)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	if rows.Err() != nil {
+		return nil, mapPGError(rows.Err())
+	}
+	return snapshots, nil
+}
+
+func scanKYCLimitSnapshot(row pgx.Row) (entities.KYCLimitSnapshot, error) {
+	var (
+		snapshot     entities.KYCLimitSnapshot
+		level        string
+		status       string
+		dailyLimit   string
+		monthlyLimit string
+		riskLevel    *string
+		source       string
+	)
+
+	if err := row.Scan(
+		&snapshot.ID,
+		&snapshot.UserID,
+		&level,
+		&status,
+		&dailyLimit,
+		&monthlyLimit,
+		&riskLevel,
+		&snapshot.RiskScore,
+		&source,
+		&snapshot.EffectiveAt,
+		&snapshot.RecordedAt,
+	); err != nil {
+		return entities.KYCLimitSnapshot{}, err
+	}
+
+	daily, err := decimal.NewFromString(strings.TrimSpace(dailyLimit))
+	if err != nil {
+		return entities.KYCLimitSnapshot{}, fmt.Errorf("kyc repository: parse daily limit: %w", err)
+	}
+	monthly, err := decimal.NewFromString(strings.TrimSpace(monthlyLimit))
+	if err != nil {
+		return entities.KYCLimitSnapshot{}, fmt.Errorf("kyc repository: parse monthly limit: %w", err)
+	}
+
+	snapshot.VerificationLevel = entities.VerificationLevel(level)
+	snapshot.Status = entities.KYCStatus(status)
+	snapshot.DailyLimitUSD = daily
+	snapshot.MonthlyLimitUSD = monthly
+	snapshot.Source = entities.KYCLimitChangeSource(source)
+	if riskLevel != nil {
+		value := entities.RiskLevel(*riskLevel)
+		snapshot.RiskLevel = &value
+	}
+	return snapshot, nil
+}
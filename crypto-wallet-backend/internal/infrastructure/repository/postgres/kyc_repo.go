@@ -59,6 +59,8 @@ SELECT
 	reviewed_at,
 	rejection_reason,
 	metadata,
+	scan_status,
+	scanned_at,
 	created_at,
 	updated_at
 FROM kyc_documents`
@@ -105,6 +107,69 @@ func (r *KYCRepository) GetProfileByUserID(ctx context.Context, userID uuid.UUID
 	return r.scanKYCProfile(row)
 }
 
+// ListProfilesByUserIDs returns the KYC profiles for the supplied user IDs.
+func (r *KYCRepository) ListProfilesByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]entities.KYCProfile, error) {
+	if r.pool == nil {
+		return nil, errors.New("kyc repository: pool not configured")
+	}
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	rows, err := r.pool.Query(ctx, selectKYCProfile+" WHERE user_id = ANY($1)", userIDs)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	profiles := make([]entities.KYCProfile, 0, len(userIDs))
+	for rows.Next() {
+		profile, scanErr := r.scanKYCProfile(rows)
+		if scanErr != nil {
+			return nil, mapPGError(scanErr)
+		}
+		profiles = append(profiles, profile)
+	}
+	if rows.Err() != nil {
+		return nil, mapPGError(rows.Err())
+	}
+	return profiles, nil
+}
+
+// ListUserIDsByStatus returns the users whose profile is in one of the statuses.
+func (r *KYCRepository) ListUserIDsByStatus(ctx context.Context, statuses []entities.KYCStatus) ([]uuid.UUID, error) {
+	if r.pool == nil {
+		return nil, errors.New("kyc repository: pool not configured")
+	}
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+
+	values := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		values = append(values, string(status))
+	}
+
+	rows, err := r.pool.Query(ctx, "SELECT user_id FROM kyc_profiles WHERE status::text = ANY($1)", values)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	userIDs := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var userID uuid.UUID
+		if err := rows.Scan(&userID); err != nil {
+			return nil, mapPGError(err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+	if rows.Err() != nil {
+		return nil, mapPGError(rows.Err())
+	}
+	return userIDs, nil
+}
+
 // CreateProfile inserts a new KYC profile record.
 func (r *KYCRepository) CreateProfile(ctx context.Context, profile *entities.KYCProfileEntity) error {
 	if r.pool == nil {
@@ -228,6 +293,79 @@ WHERE id = $18`
 	return nil
 }
 
+// ListProfilesForReview returns profiles in one of the statuses, oldest submission first.
+func (r *KYCRepository) ListProfilesForReview(ctx context.Context, statuses []entities.KYCStatus, limit, offset int) ([]entities.KYCProfile, error) {
+	if r.pool == nil {
+		return nil, errors.New("kyc repository: pool not configured")
+	}
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+	if limit <= 0 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	values := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		values = append(values, string(status))
+	}
+
+	rows, err := r.pool.Query(ctx,
+		selectKYCProfile+" WHERE status::text = ANY($1) ORDER BY submitted_at ASC NULLS LAST, created_at ASC LIMIT $2 OFFSET $3",
+		values, limit, offset)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	var profiles []entities.KYCProfile
+	for rows.Next() {
+		profile, scanErr := r.scanKYCProfile(rows)
+		if scanErr != nil {
+			return nil, mapPGError(scanErr)
+		}
+		profiles = append(profiles, profile)
+	}
+	if rows.Err() != nil {
+		return nil, mapPGError(rows.Err())
+	}
+	return profiles, nil
+}
+
+// ListExpiredApprovals returns approved profiles whose expiry is at or before now, oldest expiry first.
+func (r *KYCRepository) ListExpiredApprovals(ctx context.Context, now time.Time, limit int) ([]entities.KYCProfile, error) {
+	if r.pool == nil {
+		return nil, errors.New("kyc repository: pool not configured")
+	}
+	if limit <= 0 {
+		limit = 100
+	}
+
+	rows, err := r.pool.Query(ctx,
+		selectKYCProfile+" WHERE status = $1 AND expires_at <= $2 ORDER BY expires_at ASC LIMIT $3",
+		entities.KYCStatusApproved, now, limit)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	var profiles []entities.KYCProfile
+	for rows.Next() {
+		profile, scanErr := r.scanKYCProfile(rows)
+		if scanErr != nil {
+			return nil, mapPGError(scanErr)
+		}
+		profiles = append(profiles, profile)
+	}
+	if rows.Err() != nil {
+		return nil, mapPGError(rows.Err())
+	}
+	return profiles, nil
+}
+
 // CreateDocument stores a new KYC document.
 func (r *KYCRepository) CreateDocument(ctx context.Context, document *entities.KYCDocumentEntity) error {
 	if r.pool == nil {
@@ -252,10 +390,12 @@ INSERT INTO kyc_documents (
 	reviewed_at,
 	rejection_reason,
 	metadata,
+	scan_status,
+	scanned_at,
 	created_at,
 	updated_at
 ) VALUES (
-	$1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15
+	$1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17
 )`
 
 	metadataJSON, err := marshalMetadata(document.GetMetadata())
@@ -279,6 +419,8 @@ INSERT INTO kyc_documents (
 		document.GetReviewedAt(),
 		nullIfEmpty(document.GetRejectionReason()),
 		metadataJSON,
+		document.GetScanStatus(),
+		document.GetScannedAt(),
 		document.GetCreatedAt(),
 		document.GetUpdatedAt(),
 	)
@@ -341,8 +483,11 @@ UPDATE kyc_documents SET
 	reviewed_at = $2,
 	rejection_reason = $3,
 	metadata = $4,
-	updated_at = $5
-WHERE id = $6`
+	file_path_encrypted = $5,
+	scan_status = $6,
+	scanned_at = $7,
+	updated_at = $8
+WHERE id = $9`
 
 	cmd, err := r.pool.Exec(
 		ctx,
@@ -351,6 +496,9 @@ WHERE id = $6`
 		document.GetReviewedAt(),
 		nullIfEmpty(document.GetRejectionReason()),
 		metadataJSON,
+		document.GetEncryptedFilePath(),
+		document.GetScanStatus(),
+		document.GetScannedAt(),
 		time.Now().UTC(),
 		document.GetID(),
 	)
@@ -423,6 +571,47 @@ ON CONFLICT (user_id) DO UPDATE SET
 	return mapPGError(err)
 }
 
+// PurgePersonalData clears the encrypted PII of the user's profile and documents, keeping the
+// verification status and dates that compliance reporting relies on.
+func (r *KYCRepository) PurgePersonalData(ctx context.Context, userID uuid.UUID) error {
+	if r.pool == nil {
+		return errors.New("kyc repository: pool not configured")
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return mapPGError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	now := time.Now().UTC()
+	if _, err := tx.Exec(ctx, `
+UPDATE kyc_documents SET
+	file_path_encrypted = '',
+	file_name_encrypted = '',
+	metadata = NULL,
+	updated_at = $2
+WHERE kyc_profile_id IN (SELECT id FROM kyc_profiles WHERE user_id = $1)`, userID, now); err != nil {
+		return mapPGError(err)
+	}
+
+	if _, err := tx.Exec(ctx, `
+UPDATE kyc_profiles SET
+	first_name_encrypted = NULL,
+	last_name_encrypted = NULL,
+	date_of_birth_encrypted = NULL,
+	nationality_encrypted = NULL,
+	document_number_encrypted = NULL,
+	address_encrypted = NULL,
+	reviewer_notes = NULL,
+	updated_at = $2
+WHERE user_id = $1`, userID, now); err != nil {
+		return mapPGError(err)
+	}
+
+	return mapPGError(tx.Commit(ctx))
+}
+
 func (r *KYCRepository) scanKYCProfile(row pgx.Row) (entities.KYCProfile, error) {
 	var (
 		id                  uuid.UUID
@@ -526,6 +715,8 @@ func (r *KYCRepository) scanKYCDocument(row pgx.Row) (entities.KYCDocument, erro
 		reviewedAt    sql.NullTime
 		rejection     sql.NullString
 		metadataBytes []byte
+		scanStatus    string
+		scannedAt     sql.NullTime
 		createdAt     time.Time
 		updatedAt     time.Time
 	)
@@ -544,6 +735,8 @@ func (r *KYCRepository) scanKYCDocument(row pgx.Row) (entities.KYCDocument, erro
 		&reviewedAt,
 		&rejection,
 		&metadataBytes,
+		&scanStatus,
+		&scannedAt,
 		&createdAt,
 		&updatedAt,
 	)
@@ -575,6 +768,8 @@ func (r *KYCRepository) scanKYCDocument(row pgx.Row) (entities.KYCDocument, erro
 		ReviewedAt:        nullTimePtr(reviewedAt),
 		RejectionReason:   rejection.String,
 		Metadata:          metadata,
+		ScanStatus:        entities.DocumentScanStatus(scanStatus),
+		ScannedAt:         nullTimePtr(scannedAt),
 		CreatedAt:         createdAt,
 		UpdatedAt:         updatedAt,
 	}
@@ -0,0 +1,279 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const selectKYCUpgradeRequest = `
+SELECT
+	id,
+	kyc_profile_id,
+	user_id,
+	from_level,
+	target_level,
+	status,
+	required_documents::text[],
+	provider_application_id,
+	submitted_at,
+	decided_at,
+	rejection_reason,
+	created_at,
+	updated_at
+FROM kyc_upgrade_requests`
+
+// CreateUpgradeRequest inserts a new verification-level upgrade request.
+// A second open upgrade for the same user is reported as repositories.ErrDuplicate.
+func (r *KYCRepository) CreateUpgradeRequest(ctx context.Context, upgrade *entities.KYCUpgradeRequestEntity) error {
+	if r.pool == nil {
+		return errors.New("kyc repository: pool not configured")
+	}
+	if upgrade == nil {
+		return errors.New("kyc repository: upgrade request entity is nil")
+	}
+
+	query := `
+INSERT INTO kyc_upgrade_requests (
+	id,
+	kyc_profile_id,
+	user_id,
+	from_level,
+	target_level,
+	status,
+	required_documents,
+	provider_application_id,
+	submitted_at,
+	decided_at,
+	rejection_reason,
+	created_at,
+	updated_at
+) VALUES (
+	$1,$2,$3,$4,$5,$6,$7::document_type[],$8,$9,$10,$11,$12,$13
+)`
+
+	_, err := r.pool.Exec(
+		ctx,
+		query,
+		upgrade.GetID(),
+		upgrade.GetProfileID(),
+		upgrade.GetUserID(),
+		upgrade.GetFromLevel(),
+		upgrade.GetTargetLevel(),
+		upgrade.GetStatus(),
+		documentTypesToStrings(upgrade.GetRequiredDocuments()),
+		nullIfEmpty(upgrade.GetProviderApplicationID()),
+		upgrade.GetSubmittedAt(),
+		upgrade.GetDecidedAt(),
+		nullIfEmpty(upgrade.GetRejectionReason()),
+		upgrade.GetCreatedAt(),
+		upgrade.GetUpdatedAt(),
+	)
+	return mapPGError(err)
+}
+
+// GetLatestUpgradeRequest returns the user's most recent upgrade request, open or decided.
+func (r *KYCRepository) GetLatestUpgradeRequest(ctx context.Context, userID uuid.UUID) (entities.KYCUpgradeRequest, error) {
+	if r.pool == nil {
+		return nil, errors.New("kyc repository: pool not configured")
+	}
+
+	row := r.pool.QueryRow(ctx, selectKYCUpgradeRequest+" WHERE user_id = $1 ORDER BY created_at DESC LIMIT 1", userID)
+	return r.scanKYCUpgradeRequest(row)
+}
+
+// UpdateUpgradeRequest persists status changes to an existing upgrade request.
+func (r *KYCRepository) UpdateUpgradeRequest(ctx context.Context, upgrade entities.KYCUpgradeRequest) error {
+	if r.pool == nil {
+		return errors.New("kyc repository: pool not configured")
+	}
+	if upgrade == nil {
+		return errors.New("kyc repository: upgrade request entity is nil")
+	}
+
+	return updateKYCUpgradeRequest(ctx, r.pool, upgrade)
+}
+
+// ListUpgradeRequestsUnderReview returns upgrades awaiting a provider decision, oldest submission first.
+func (r *KYCRepository) ListUpgradeRequestsUnderReview(ctx context.Context, limit int) ([]entities.KYCUpgradeRequest, error) {
+	if r.pool == nil {
+		return nil, errors.New("kyc repository: pool not configured")
+	}
+	if limit <= 0 {
+		limit = 100
+	}
+
+	rows, err := r.pool.Query(ctx,
+		selectKYCUpgradeRequest+" WHERE status = $1 AND provider_application_id IS NOT NULL ORDER BY submitted_at ASC LIMIT $2",
+		entities.KYCUpgradeStatusUnderReview, limit)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	var upgrades []entities.KYCUpgradeRequest
+	for rows.Next() {
+		upgrade, scanErr := r.scanKYCUpgradeRequest(rows)
+		if scanErr != nil {
+			return nil, mapPGError(scanErr)
+		}
+		upgrades = append(upgrades, upgrade)
+	}
+	if rows.Err() != nil {
+		return nil, mapPGError(rows.Err())
+	}
+	return upgrades, nil
+}
+
+// CompleteUpgrade persists an approved upgrade together with the profile's new level and limits.
+func (r *KYCRepository) CompleteUpgrade(ctx context.Context, upgrade entities.KYCUpgradeRequest, profile entities.KYCProfile) error {
+	if r.pool == nil {
+		return errors.New("kyc repository: pool not configured")
+	}
+	if upgrade == nil || profile == nil {
+		return errors.New("kyc repository: upgrade request and profile are required")
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return mapPGError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := updateKYCUpgradeRequest(ctx, tx, upgrade); err != nil {
+		return err
+	}
+
+	// Only the level and limits change; the approval and its expiry stay as they were.
+	cmd, err := tx.Exec(ctx, `
+UPDATE kyc_profiles SET
+	verification_level = $1,
+	daily_limit_usd = $2,
+	monthly_limit_usd = $3,
+	updated_at = $4
+WHERE id = $5 AND status = $6`,
+		profile.GetVerificationLevel(),
+		profile.GetDailyLimitUSD().String(),
+		profile.GetMonthlyLimitUSD().String(),
+		time.Now().UTC(),
+		profile.GetID(),
+		entities.KYCStatusApproved,
+	)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrConflict
+	}
+
+	return mapPGError(tx.Commit(ctx))
+}
+
+// kycUpgradeExecer is satisfied by both the pool and a transaction.
+type kycUpgradeExecer interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+}
+
+func updateKYCUpgradeRequest(ctx context.Context, db kycUpgradeExecer, upgrade entities.KYCUpgradeRequest) error {
+	cmd, err := db.Exec(ctx, `
+UPDATE kyc_upgrade_requests SET
+	status = $1,
+	provider_application_id = $2,
+	submitted_at = $3,
+	decided_at = $4,
+	rejection_reason = $5,
+	updated_at = $6
+WHERE id = $7`,
+		upgrade.GetStatus(),
+		nullIfEmpty(upgrade.GetProviderApplicationID()),
+		upgrade.GetSubmittedAt(),
+		upgrade.GetDecidedAt(),
+		nullIfEmpty(upgrade.GetRejectionReason()),
+		time.Now().UTC(),
+		upgrade.GetID(),
+	)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+func (r *KYCRepository) scanKYCUpgradeRequest(row pgx.Row) (entities.KYCUpgradeRequest, error) {
+	var (
+		id              uuid.UUID
+		profileID       uuid.UUID
+		userID          uuid.UUID
+		fromLevel       string
+		targetLevel     string
+		status          string
+		requiredDocs    []string
+		applicationID   sql.NullString
+		submittedAt     sql.NullTime
+		decidedAt       sql.NullTime
+		rejectionReason sql.NullString
+		createdAt       time.Time
+		updatedAt       time.Time
+	)
+
+	err := row.Scan(
+		&id,
+		&profileID,
+		&userID,
+		&fromLevel,
+		&targetLevel,
+		&status,
+		&requiredDocs,
+		&applicationID,
+		&submittedAt,
+		&decidedAt,
+		&rejectionReason,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, err
+	}
+
+	documents := make([]entities.DocumentType, 0, len(requiredDocs))
+	for _, doc := range requiredDocs {
+		documents = append(documents, entities.DocumentType(doc))
+	}
+
+	return entities.HydrateKYCUpgradeRequestEntity(entities.KYCUpgradeRequestParams{
+		ID:                    id,
+		ProfileID:             profileID,
+		UserID:                userID,
+		FromLevel:             entities.VerificationLevel(fromLevel),
+		TargetLevel:           entities.VerificationLevel(targetLevel),
+		Status:                entities.KYCUpgradeStatus(status),
+		RequiredDocuments:     documents,
+		ProviderApplicationID: applicationID.String,
+		SubmittedAt:           nullTimePtr(submittedAt),
+		DecidedAt:             nullTimePtr(decidedAt),
+		RejectionReason:       rejectionReason.String,
+		CreatedAt:             createdAt,
+		UpdatedAt:             updatedAt,
+	}), nil
+}
+
+func documentTypesToStrings(types []entities.DocumentType) []string {
+	values := make([]string, 0, len(types))
+	for _, docType := range types {
+		values = append(values, string(docType))
+	}
+	return values
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+var errLimitUsageNilPool = errors.New("limit usage repository: database pool is not configured")
+
+// sumOutflowsQuery totals sends and executed exchanges out of the user's wallets. Exchanges are
+// counted once they are processing, so a quote that is never executed uses up no headroom.
+const sumOutflowsQuery = `
+SELECT
+	o.chain,
+	COALESCE(SUM(o.amount) FILTER (WHERE o.created_at >= $2), 0)::text,
+	COALESCE(SUM(o.amount), 0)::text
+FROM (
+	SELECT w.chain, t.amount, t.created_at
+	FROM transactions t
+	JOIN wallets w ON w.id = t.wallet_id
+	WHERE w.user_id = $1
+		AND t.type IN ('send', 'p2p_send')
+		AND t.status NOT IN ('failed', 'cancelled')
+		AND t.created_at >= $3
+	UNION ALL
+	SELECT w.chain, e.from_amount, e.created_at
+	FROM exchange_operations e
+	JOIN wallets w ON w.id = e.from_wallet_id
+	WHERE w.user_id = $1
+		AND e.status IN ('processing', 'completed')
+		AND e.created_at >= $3
+) o
+GROUP BY o.chain
+ORDER BY o.chain`
+
+// LimitUsageRepository totals limit usage from the transaction and exchange tables.
+type LimitUsageRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewLimitUsageRepository constructs a LimitUsageRepository backed by the provided pool.
+func NewLimitUsageRepository(pool *pgxpool.Pool, logger *slog.Logger) *LimitUsageRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &LimitUsageRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// SumOutflows returns the user's outflows per chain within the two windows.
+func (r *LimitUsageRepository) SumOutflows(ctx context.Context, userID uuid.UUID, dailySince, monthlySince time.Time) ([]repositories.ChainOutflow, error) {
+	if r.pool == nil {
+		return nil, errLimitUsageNilPool
+	}
+	// The monthly window bounds the scan, so it must also cover the daily one.
+	since := monthlySince.UTC()
+	if dailySince.Before(since) {
+		since = dailySince.UTC()
+	}
+
+	rows, err := r.pool.Query(ctx, sumOutflowsQuery, userID, dailySince.UTC(), since)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	var outflows []repositories.ChainOutflow
+	for rows.Next() {
+		var chain, daily, monthly string
+		if err := rows.Scan(&chain, &daily, &monthly); err != nil {
+			return nil, mapPGError(err)
+		}
+		outflow := repositories.ChainOutflow{Chain: entities.Chain(chain)}
+		if outflow.Daily, err = decimal.NewFromString(daily); err != nil {
+			return nil, fmt.Errorf("limit usage repository: parse daily outflow: %w", err)
+		}
+		if outflow.Monthly, err = decimal.NewFromString(monthly); err != nil {
+			return nil, fmt.Errorf("limit usage repository: parse monthly outflow: %w", err)
+		}
+		outflows = append(outflows, outflow)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return outflows, nil
+}
@@ -0,0 +1,201 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const notificationSelectColumns = `
+SELECT
+	id,
+	user_id,
+	type,
+	event,
+	title,
+	body,
+	data,
+	read_at,
+	created_at
+FROM notifications`
+
+var (
+	errNotificationNilPool   = errors.New("notification repository: database pool is not configured")
+	errNotificationNilEntity = errors.New("notification repository: notification is required")
+)
+
+// NotificationRepository persists in-app notifications using PostgreSQL.
+type NotificationRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewNotificationRepository constructs a NotificationRepository backed by the provided pool.
+func NewNotificationRepository(pool *pgxpool.Pool, logger *slog.Logger) *NotificationRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &NotificationRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// Create stores a new notification.
+func (r *NotificationRepository) Create(ctx context.Context, notification *entities.Notification) error {
+	if r.pool == nil {
+		return errNotificationNilPool
+	}
+	if notification == nil {
+		return errNotificationNilEntity
+	}
+	if notification.ID == uuid.Nil {
+		notification.ID = uuid.New()
+	}
+	if notification.CreatedAt.IsZero() {
+		notification.CreatedAt = time.Now().UTC()
+	}
+
+	data, err := marshalMetadata(notification.Data)
+	if err != nil {
+		return fmt.Errorf("notification repository: encode data: %w", err)
+	}
+
+	_, err = r.pool.Exec(ctx, `
+INSERT INTO notifications (
+	id,
+	user_id,
+	type,
+	event,
+	title,
+	body,
+	data,
+	read_at,
+	created_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)`,
+		notification.ID,
+		notification.UserID,
+		string(notification.Type),
+		notification.Event,
+		notification.Title,
+		notification.Body,
+		data,
+		notification.ReadAt,
+		notification.CreatedAt,
+	)
+	return mapPGError(err)
+}
+
+// ListByUser returns the user's notifications, newest first.
+func (r *NotificationRepository) ListByUser(ctx context.Context, userID uuid.UUID, unreadOnly bool, opts repositories.ListOptions) ([]entities.Notification, error) {
+	if r.pool == nil {
+		return nil, errNotificationNilPool
+	}
+
+	opts = opts.WithDefaults()
+
+	query := notificationSelectColumns + " WHERE user_id = $1"
+	if unreadOnly {
+		query += " AND read_at IS NULL"
+	}
+	query += " ORDER BY created_at DESC, id DESC LIMIT $2 OFFSET $3"
+
+	rows, err := r.pool.Query(ctx, query, userID, opts.Limit, opts.Offset)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	results := make([]entities.Notification, 0)
+	for rows.Next() {
+		notification, err := scanNotification(rows)
+		if err != nil {
+			return nil, mapPGError(err)
+		}
+		results = append(results, *notification)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return results, nil
+}
+
+// CountUnread returns how many of the user's notifications are unread.
+func (r *NotificationRepository) CountUnread(ctx context.Context, userID uuid.UUID) (int, error) {
+	if r.pool == nil {
+		return 0, errNotificationNilPool
+	}
+
+	var count int
+	err := r.pool.QueryRow(ctx, "SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL", userID).Scan(&count)
+	if err != nil {
+		return 0, mapPGError(err)
+	}
+	return count, nil
+}
+
+// MarkRead records that the user read the notification; an earlier read time is kept.
+func (r *NotificationRepository) MarkRead(ctx context.Context, userID, id uuid.UUID, at time.Time) (*entities.Notification, error) {
+	if r.pool == nil {
+		return nil, errNotificationNilPool
+	}
+
+	row := r.pool.QueryRow(ctx, `
+UPDATE notifications
+SET read_at = COALESCE(read_at, $3)
+WHERE id = $1 AND user_id = $2
+RETURNING id, user_id, type, event, title, body, data, read_at, created_at`,
+		id, userID, at)
+	notification, err := scanNotification(row)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	return notification, nil
+}
+
+func scanNotification(row pgx.Row) (*entities.Notification, error) {
+	var (
+		notification entities.Notification
+		typeValue    string
+		data         []byte
+		readAt       pgtype.Timestamptz
+	)
+
+	if err := row.Scan(
+		&notification.ID,
+		&notification.UserID,
+		&typeValue,
+		&notification.Event,
+		&notification.Title,
+		&notification.Body,
+		&data,
+		&readAt,
+		&notification.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	notification.Type = entities.NotificationType(typeValue)
+	notification.CreatedAt = notification.CreatedAt.UTC()
+	if readAt.Valid {
+		t := readAt.Time.UTC()
+		notification.ReadAt = &t
+	}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &notification.Data); err != nil {
+			return nil, fmt.Errorf("notification repository: decode data: %w", err)
+		}
+	}
+	return &notification, nil
+}
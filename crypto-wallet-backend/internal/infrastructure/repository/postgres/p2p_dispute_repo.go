@@ -0,0 +1,347 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const p2pDisputeSelectColumns = `
+SELECT
+	id,
+	transfer_id,
+	opened_by,
+	sender_user_id,
+	recipient_user_id,
+	reason,
+	description,
+	status,
+	outcome,
+	resolution_note,
+	held_at,
+	resolved_by,
+	resolved_at,
+	created_at,
+	updated_at
+FROM p2p_disputes`
+
+var (
+	errDisputeNilPool    = errors.New("p2p dispute repository: database pool is not configured")
+	errDisputeNilDispute = errors.New("p2p dispute repository: dispute entity is required")
+)
+
+// P2PDisputeRepository persists P2P transfer disputes using PostgreSQL.
+type P2PDisputeRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewP2PDisputeRepository constructs a P2PDisputeRepository backed by the provided pool.
+func NewP2PDisputeRepository(pool *pgxpool.Pool, logger *slog.Logger) *P2PDisputeRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &P2PDisputeRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// GetByID fetches a dispute by its identifier.
+func (r *P2PDisputeRepository) GetByID(ctx context.Context, id uuid.UUID) (entities.P2PDispute, error) {
+	if r.pool == nil {
+		return nil, errDisputeNilPool
+	}
+
+	row := r.pool.QueryRow(ctx, p2pDisputeSelectColumns+" WHERE id = $1", id)
+	return scanP2PDispute(row)
+}
+
+// ListByUser returns disputes on transfers the user sent or received, newest first.
+func (r *P2PDisputeRepository) ListByUser(ctx context.Context, userID uuid.UUID, opts repositories.ListOptions) ([]entities.P2PDispute, error) {
+	if r.pool == nil {
+		return nil, errDisputeNilPool
+	}
+
+	options := opts.WithDefaults()
+	query := fmt.Sprintf("%s WHERE sender_user_id = $1 OR recipient_user_id = $1 ORDER BY created_at %s LIMIT $2 OFFSET $3",
+		p2pDisputeSelectColumns, options.SortOrder)
+
+	rows, err := r.pool.Query(ctx, query, userID, options.Limit, options.Offset)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	return collectP2PDisputes(rows)
+}
+
+// List returns disputes for support review, oldest first so the queue is worked in order.
+func (r *P2PDisputeRepository) List(ctx context.Context, filter repositories.P2PDisputeFilter, opts repositories.ListOptions) ([]entities.P2PDispute, error) {
+	if r.pool == nil {
+		return nil, errDisputeNilPool
+	}
+
+	options := opts.WithDefaults()
+	args := []any{options.Limit, options.Offset}
+	where := ""
+	if filter.Status != nil {
+		args = append(args, *filter.Status)
+		where = " WHERE status = $3"
+	}
+
+	query := fmt.Sprintf("%s%s ORDER BY created_at ASC LIMIT $1 OFFSET $2", p2pDisputeSelectColumns, where)
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	return collectP2PDisputes(rows)
+}
+
+// ListEvents returns the audit trail of a dispute in chronological order.
+func (r *P2PDisputeRepository) ListEvents(ctx context.Context, disputeID uuid.UUID) ([]entities.P2PDisputeEvent, error) {
+	if r.pool == nil {
+		return nil, errDisputeNilPool
+	}
+
+	rows, err := r.pool.Query(ctx,
+		"SELECT id, dispute_id, actor_id, action, note, created_at FROM p2p_dispute_events WHERE dispute_id = $1 ORDER BY created_at ASC",
+		disputeID)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	events := make([]entities.P2PDisputeEvent, 0)
+	for rows.Next() {
+		var (
+			event  entities.P2PDisputeEvent
+			action string
+			note   *string
+		)
+		if err := rows.Scan(&event.ID, &event.DisputeID, &event.ActorID, &action, &note, &event.CreatedAt); err != nil {
+			return nil, mapPGError(err)
+		}
+		event.Action = entities.P2PDisputeAction(action)
+		if note != nil {
+			event.Note = *note
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+
+	return events, nil
+}
+
+// Create stores a new dispute together with its opening event.
+func (r *P2PDisputeRepository) Create(ctx context.Context, dispute *entities.P2PDisputeEntity, event entities.P2PDisputeEvent) error {
+	if r.pool == nil {
+		return errDisputeNilPool
+	}
+	if dispute == nil {
+		return errDisputeNilDispute
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return mapPGError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+INSERT INTO p2p_disputes (
+	id,
+	transfer_id,
+	opened_by,
+	sender_user_id,
+	recipient_user_id,
+	reason,
+	description,
+	status,
+	created_at,
+	updated_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)`,
+		dispute.GetID(),
+		dispute.GetTransferID(),
+		dispute.GetOpenedBy(),
+		dispute.GetSenderUserID(),
+		dispute.GetRecipientUserID(),
+		string(dispute.GetReason()),
+		nullIfEmpty(dispute.GetDescription()),
+		dispute.GetStatus(),
+		dispute.GetCreatedAt(),
+		dispute.GetUpdatedAt(),
+	)
+	if err != nil {
+		return mapPGError(err)
+	}
+
+	if err := insertDisputeEvent(ctx, tx, event); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Transition persists a status change, its audit event and any resulting balance movements.
+func (r *P2PDisputeRepository) Transition(ctx context.Context, dispute *entities.P2PDisputeEntity, previous entities.P2PDisputeStatus, event entities.P2PDisputeEvent, transactions ...*entities.TransactionEntity) error {
+	if r.pool == nil {
+		return errDisputeNilPool
+	}
+	if dispute == nil {
+		return errDisputeNilDispute
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return mapPGError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	cmd, err := tx.Exec(ctx, `
+UPDATE p2p_disputes
+SET status = $3,
+	outcome = $4,
+	resolution_note = $5,
+	held_at = $6,
+	resolved_by = $7,
+	resolved_at = $8,
+	updated_at = $9
+WHERE id = $1 AND status = $2`,
+		dispute.GetID(),
+		previous,
+		dispute.GetStatus(),
+		nullIfEmpty(string(dispute.GetOutcome())),
+		nullIfEmpty(dispute.GetResolutionNote()),
+		dispute.GetHeldAt(),
+		dispute.GetResolvedBy(),
+		dispute.GetResolvedAt(),
+		dispute.GetUpdatedAt(),
+	)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrConflict
+	}
+
+	if err := applyP2PLedger(ctx, tx, event.CreatedAt, transactions...); err != nil {
+		return err
+	}
+
+	if err := insertDisputeEvent(ctx, tx, event); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// applyP2PLedger moves wallet balances for internal ledger transactions and records them.
+func applyP2PLedger(ctx context.Context, tx pgx.Tx, at time.Time, transactions ...*entities.TransactionEntity) error {
+	for _, transaction := range transactions {
+		if transaction == nil {
+			continue
+		}
+		switch transaction.GetType() {
+		case entities.TransactionTypeP2PSend:
+			if err := debitWallet(ctx, tx, transaction.GetWalletID(), transaction.GetAmount(), at); err != nil {
+				return err
+			}
+		case entities.TransactionTypeP2PReceive:
+			if err := creditWallet(ctx, tx, transaction.GetWalletID(), transaction.GetAmount(), at); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("p2p dispute repository: unsupported ledger transaction type %q", transaction.GetType())
+		}
+	}
+	return insertTransactions(ctx, tx, transactions...)
+}
+
+func insertDisputeEvent(ctx context.Context, tx pgx.Tx, event entities.P2PDisputeEvent) error {
+	if event.ID == uuid.Nil {
+		event.ID = uuid.New()
+	}
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now().UTC()
+	}
+
+	_, err := tx.Exec(ctx,
+		"INSERT INTO p2p_dispute_events (id, dispute_id, actor_id, action, note, created_at) VALUES ($1,$2,$3,$4,$5,$6)",
+		event.ID, event.DisputeID, event.ActorID, string(event.Action), nullIfEmpty(event.Note), event.CreatedAt)
+	return mapPGError(err)
+}
+
+func collectP2PDisputes(rows pgx.Rows) ([]entities.P2PDispute, error) {
+	disputes := make([]entities.P2PDispute, 0)
+	for rows.Next() {
+		dispute, err := scanP2PDispute(rows)
+		if err != nil {
+			return nil, err
+		}
+		disputes = append(disputes, dispute)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return disputes, nil
+}
+
+func scanP2PDispute(row pgx.Row) (entities.P2PDispute, error) {
+	var (
+		params         entities.P2PDisputeParams
+		reason         string
+		description    *string
+		status         string
+		outcome        *string
+		resolutionNote *string
+	)
+
+	if err := row.Scan(
+		&params.ID,
+		&params.TransferID,
+		&params.OpenedBy,
+		&params.SenderUserID,
+		&params.RecipientUserID,
+		&reason,
+		&description,
+		&status,
+		&outcome,
+		&resolutionNote,
+		&params.HeldAt,
+		&params.ResolvedBy,
+		&params.ResolvedAt,
+		&params.CreatedAt,
+		&params.UpdatedAt,
+	); err != nil {
+		return nil, mapPGError(err)
+	}
+
+	params.Reason = entities.P2PDisputeReason(reason)
+	params.Status = entities.P2PDisputeStatus(status)
+	if description != nil {
+		params.Description = *description
+	}
+	if outcome != nil {
+		params.Outcome = entities.P2PDisputeOutcome(*outcome)
+	}
+	if resolutionNote != nil {
+		params.ResolutionNote = *resolutionNote
+	}
+
+	return entities.HydrateP2PDisputeEntity(params), nil
+}
@@ -0,0 +1,414 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const p2pTransferSelectColumns = `
+SELECT
+	id,
+	sender_user_id,
+	sender_wallet_id,
+	recipient_user_id,
+	recipient_wallet_id,
+	recipient_email,
+	recipient_handle,
+	chain,
+	amount,
+	note,
+	status,
+	claim_code_hash,
+	expires_at,
+	settled_at,
+	created_at,
+	updated_at
+FROM p2p_transfers`
+
+var (
+	errP2PNilPool     = errors.New("p2p repository: database pool is not configured")
+	errP2PNilTransfer = errors.New("p2p repository: transfer entity is required")
+)
+
+// P2PTransferRepository persists off-chain transfers using PostgreSQL.
+type P2PTransferRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewP2PTransferRepository constructs a P2PTransferRepository backed by the provided pool.
+func NewP2PTransferRepository(pool *pgxpool.Pool, logger *slog.Logger) *P2PTransferRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &P2PTransferRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// GetByID fetches a transfer by its identifier.
+func (r *P2PTransferRepository) GetByID(ctx context.Context, id uuid.UUID) (entities.P2PTransfer, error) {
+	if r.pool == nil {
+		return nil, errP2PNilPool
+	}
+
+	row := r.pool.QueryRow(ctx, p2pTransferSelectColumns+" WHERE id = $1", id)
+	return scanP2PTransfer(row)
+}
+
+// GetByClaimCodeHash fetches a held transfer by the hash of its claim code.
+func (r *P2PTransferRepository) GetByClaimCodeHash(ctx context.Context, hash string) (entities.P2PTransfer, error) {
+	if r.pool == nil {
+		return nil, errP2PNilPool
+	}
+
+	row := r.pool.QueryRow(ctx, p2pTransferSelectColumns+" WHERE claim_code_hash = $1", hash)
+	return scanP2PTransfer(row)
+}
+
+// ListByUser returns transfers the user sent or received, newest first.
+func (r *P2PTransferRepository) ListByUser(ctx context.Context, userID uuid.UUID, opts repositories.ListOptions) ([]entities.P2PTransfer, error) {
+	if r.pool == nil {
+		return nil, errP2PNilPool
+	}
+
+	options := opts.WithDefaults()
+	query := fmt.Sprintf("%s WHERE sender_user_id = $1 OR recipient_user_id = $1 ORDER BY created_at %s LIMIT $2 OFFSET $3",
+		p2pTransferSelectColumns, options.SortOrder)
+
+	rows, err := r.pool.Query(ctx, query, userID, options.Limit, options.Offset)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	return collectP2PTransfers(rows)
+}
+
+// ListExpiredClaims returns held transfers whose claim window has elapsed.
+func (r *P2PTransferRepository) ListExpiredClaims(ctx context.Context, now time.Time, limit int) ([]entities.P2PTransfer, error) {
+	if r.pool == nil {
+		return nil, errP2PNilPool
+	}
+	if limit <= 0 {
+		limit = 100
+	}
+
+	rows, err := r.pool.Query(ctx,
+		p2pTransferSelectColumns+" WHERE status = $1 AND expires_at <= $2 ORDER BY expires_at ASC LIMIT $3",
+		entities.P2PTransferStatusPendingClaim, now, limit)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	return collectP2PTransfers(rows)
+}
+
+// Settle records a new transfer, debits the sender and credits the recipient when already known.
+func (r *P2PTransferRepository) Settle(ctx context.Context, transfer *entities.P2PTransferEntity, transactions ...*entities.TransactionEntity) error {
+	if r.pool == nil {
+		return errP2PNilPool
+	}
+	if transfer == nil {
+		return errP2PNilTransfer
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return mapPGError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	now := transfer.GetCreatedAt()
+
+	if err := debitWallet(ctx, tx, transfer.GetSenderWalletID(), transfer.GetAmount(), now); err != nil {
+		return err
+	}
+
+	if transfer.GetStatus() == entities.P2PTransferStatusCompleted && transfer.GetRecipientWalletID() != nil {
+		if err := creditWallet(ctx, tx, *transfer.GetRecipientWalletID(), transfer.GetAmount(), now); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+INSERT INTO p2p_transfers (
+	id,
+	sender_user_id,
+	sender_wallet_id,
+	recipient_user_id,
+	recipient_wallet_id,
+	recipient_email,
+	recipient_handle,
+	chain,
+	amount,
+	note,
+	status,
+	claim_code_hash,
+	expires_at,
+	settled_at,
+	created_at,
+	updated_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16)`,
+		transfer.GetID(),
+		transfer.GetSenderUserID(),
+		transfer.GetSenderWalletID(),
+		transfer.GetRecipientUserID(),
+		transfer.GetRecipientWalletID(),
+		transfer.GetRecipientEmail(),
+		nullIfEmpty(transfer.GetRecipientHandle()),
+		transfer.GetChain(),
+		transfer.GetAmount().String(),
+		nullIfEmpty(transfer.GetNote()),
+		transfer.GetStatus(),
+		nullIfEmpty(transfer.GetClaimCodeHash()),
+		transfer.GetExpiresAt(),
+		transfer.GetSettledAt(),
+		transfer.GetCreatedAt(),
+		transfer.GetUpdatedAt(),
+	)
+	if err != nil {
+		return mapPGError(err)
+	}
+
+	if err := insertTransactions(ctx, tx, transactions...); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Claim credits a held transfer to the recipient wallet.
+func (r *P2PTransferRepository) Claim(ctx context.Context, transfer *entities.P2PTransferEntity, recipientTx *entities.TransactionEntity) error {
+	if r.pool == nil {
+		return errP2PNilPool
+	}
+	if transfer == nil || transfer.GetRecipientWalletID() == nil {
+		return errP2PNilTransfer
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return mapPGError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	settledAt := transfer.GetUpdatedAt()
+
+	// Guard on the pending state so concurrent claims and the expiry worker cannot both settle.
+	cmd, err := tx.Exec(ctx, `
+UPDATE p2p_transfers SET
+	status = $2,
+	recipient_user_id = $3,
+	recipient_wallet_id = $4,
+	settled_at = $5,
+	updated_at = $5
+WHERE id = $1 AND status = $6 AND expires_at > $5`,
+		transfer.GetID(),
+		transfer.GetStatus(),
+		transfer.GetRecipientUserID(),
+		transfer.GetRecipientWalletID(),
+		settledAt,
+		entities.P2PTransferStatusPendingClaim,
+	)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrConflict
+	}
+
+	if err := creditWallet(ctx, tx, *transfer.GetRecipientWalletID(), transfer.GetAmount(), settledAt); err != nil {
+		return err
+	}
+
+	if err := setP2PSenderTransactionStatus(ctx, tx, transfer, entities.TransactionStatusConfirmed, settledAt); err != nil {
+		return err
+	}
+
+	if err := insertTransactions(ctx, tx, recipientTx); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Return credits a held transfer back to the sender wallet.
+func (r *P2PTransferRepository) Return(ctx context.Context, transfer *entities.P2PTransferEntity) error {
+	if r.pool == nil {
+		return errP2PNilPool
+	}
+	if transfer == nil {
+		return errP2PNilTransfer
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return mapPGError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	settledAt := transfer.GetUpdatedAt()
+
+	cmd, err := tx.Exec(ctx,
+		"UPDATE p2p_transfers SET status = $2, settled_at = $3, updated_at = $3 WHERE id = $1 AND status = $4",
+		transfer.GetID(),
+		transfer.GetStatus(),
+		settledAt,
+		entities.P2PTransferStatusPendingClaim,
+	)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrConflict
+	}
+
+	if err := creditWallet(ctx, tx, transfer.GetSenderWalletID(), transfer.GetAmount(), settledAt); err != nil {
+		return err
+	}
+
+	if err := setP2PSenderTransactionStatus(ctx, tx, transfer, entities.TransactionStatusCancelled, settledAt); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// debitWallet subtracts amount from an active wallet, refusing to overdraw it.
+func debitWallet(ctx context.Context, tx pgx.Tx, walletID uuid.UUID, amount decimal.Decimal, at time.Time) error {
+	cmd, err := tx.Exec(ctx,
+		"UPDATE wallets SET balance = balance - $2, balance_updated_at = $3, updated_at = $3 WHERE id = $1 AND status = 'active' AND balance >= $2",
+		walletID, amount.String(), at)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrInsufficientFunds
+	}
+	return nil
+}
+
+func creditWallet(ctx context.Context, tx pgx.Tx, walletID uuid.UUID, amount decimal.Decimal, at time.Time) error {
+	cmd, err := tx.Exec(ctx,
+		"UPDATE wallets SET balance = balance + $2, balance_updated_at = $3, updated_at = $3 WHERE id = $1",
+		walletID, amount.String(), at)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+func setP2PSenderTransactionStatus(ctx context.Context, tx pgx.Tx, transfer *entities.P2PTransferEntity, status entities.TransactionStatus, at time.Time) error {
+	var confirmedAt *time.Time
+	if status == entities.TransactionStatusConfirmed {
+		confirmedAt = &at
+	}
+
+	_, err := tx.Exec(ctx,
+		"UPDATE transactions SET status = $3, confirmed_at = $4, updated_at = $5 WHERE chain = $1 AND tx_hash = $2",
+		transfer.GetChain(), transfer.SenderTransactionHash(), status, confirmedAt, at)
+	return mapPGError(err)
+}
+
+func insertTransactions(ctx context.Context, tx pgx.Tx, transactions ...*entities.TransactionEntity) error {
+	for _, transaction := range transactions {
+		if transaction == nil {
+			continue
+		}
+		args, err := transactionInsertArgs(transaction)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, insertTransactionQuery, args...); err != nil {
+			return mapPGError(err)
+		}
+	}
+	return nil
+}
+
+func collectP2PTransfers(rows pgx.Rows) ([]entities.P2PTransfer, error) {
+	var transfers []entities.P2PTransfer
+	for rows.Next() {
+		transfer, err := scanP2PTransfer(rows)
+		if err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, transfer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+
+	return transfers, nil
+}
+
+func scanP2PTransfer(row pgx.Row) (entities.P2PTransfer, error) {
+	var (
+		params        entities.P2PTransferParams
+		chain         string
+		amount        string
+		note          *string
+		status        string
+		claimCodeHash *string
+		handle        *string
+	)
+
+	if err := row.Scan(
+		&params.ID,
+		&params.SenderUserID,
+		&params.SenderWalletID,
+		&params.RecipientUserID,
+		&params.RecipientWalletID,
+		&params.RecipientEmail,
+		&handle,
+		&chain,
+		&amount,
+		&note,
+		&status,
+		&claimCodeHash,
+		&params.ExpiresAt,
+		&params.SettledAt,
+		&params.CreatedAt,
+		&params.UpdatedAt,
+	); err != nil {
+		return nil, mapPGError(err)
+	}
+
+	parsedAmount, err := decimal.NewFromString(amount)
+	if err != nil {
+		return nil, fmt.Errorf("p2p repository: parse amount: %w", err)
+	}
+
+	params.Chain = entities.Chain(chain)
+	params.Amount = parsedAmount
+	params.Status = entities.P2PTransferStatus(status)
+	if note != nil {
+		params.Note = *note
+	}
+	if claimCodeHash != nil {
+		params.ClaimCodeHash = *claimCodeHash
+	}
+	if handle != nil {
+		params.RecipientHandle = *handle
+	}
+
+	return entities.HydrateP2PTransferEntity(params), nil
+}
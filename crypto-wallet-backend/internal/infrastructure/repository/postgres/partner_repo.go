@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+var errPartnerNilPool = errors.New("partner repository: database pool is not configured")
+
+// PartnerRepository persists white-label partners and their user links using PostgreSQL.
+type PartnerRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewPartnerRepository constructs a PartnerRepository backed by the provided pool.
+func NewPartnerRepository(pool *pgxpool.Pool, logger *slog.Logger) *PartnerRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &PartnerRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// GetByAPIKeyHash returns the partner holding the key or ErrNotFound.
+func (r *PartnerRepository) GetByAPIKeyHash(ctx context.Context, hash string) (entities.Partner, error) {
+	if r.pool == nil {
+		return entities.Partner{}, errPartnerNilPool
+	}
+
+	var partner entities.Partner
+	err := r.pool.QueryRow(ctx, `
+SELECT id, name, api_key_hash, is_active, created_at
+FROM partners
+WHERE api_key_hash = $1`, hash).Scan(
+		&partner.ID,
+		&partner.Name,
+		&partner.APIKeyHash,
+		&partner.IsActive,
+		&partner.CreatedAt,
+	)
+	if err != nil {
+		return entities.Partner{}, mapPGError(err)
+	}
+	return partner, nil
+}
+
+// ListUserLinks returns the partner's links among the given references.
+func (r *PartnerRepository) ListUserLinks(ctx context.Context, partnerID uuid.UUID, userRefs []string) ([]entities.PartnerUserLink, error) {
+	if r.pool == nil {
+		return nil, errPartnerNilPool
+	}
+
+	links := make([]entities.PartnerUserLink, 0, len(userRefs))
+	if len(userRefs) == 0 {
+		return links, nil
+	}
+
+	rows, err := r.pool.Query(ctx, `
+SELECT partner_id, user_ref, user_id, created_at
+FROM partner_users
+WHERE partner_id = $1 AND user_ref = ANY($2)`, partnerID, userRefs)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var link entities.PartnerUserLink
+		if err := rows.Scan(&link.PartnerID, &link.UserRef, &link.UserID, &link.CreatedAt); err != nil {
+			return nil, mapPGError(err)
+		}
+		links = append(links, link)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return links, nil
+}
@@ -0,0 +1,292 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const paymentProfileSelectColumns = `
+SELECT
+	user_id,
+	handle,
+	display_name,
+	accepted_assets,
+	is_public,
+	allow_handle_payments,
+	handle_changed_at,
+	created_at,
+	updated_at
+FROM payment_profiles`
+
+var (
+	errProfileNilPool    = errors.New("payment profile repository: database pool is not configured")
+	errProfileNilProfile = errors.New("payment profile repository: profile entity is required")
+)
+
+// PaymentProfileRepository persists user handles and payment profiles using PostgreSQL.
+type PaymentProfileRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewPaymentProfileRepository constructs a PaymentProfileRepository backed by the provided pool.
+func NewPaymentProfileRepository(pool *pgxpool.Pool, logger *slog.Logger) *PaymentProfileRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &PaymentProfileRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// GetByUserID fetches the profile owned by the user.
+func (r *PaymentProfileRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (entities.PaymentProfile, error) {
+	if r.pool == nil {
+		return nil, errProfileNilPool
+	}
+
+	row := r.pool.QueryRow(ctx, paymentProfileSelectColumns+" WHERE user_id = $1", userID)
+	return scanPaymentProfile(row)
+}
+
+// GetByHandle fetches a profile by its case-insensitive handle.
+func (r *PaymentProfileRepository) GetByHandle(ctx context.Context, handle string) (entities.PaymentProfile, error) {
+	if r.pool == nil {
+		return nil, errProfileNilPool
+	}
+
+	row := r.pool.QueryRow(ctx, paymentProfileSelectColumns+" WHERE LOWER(handle) = LOWER($1)", handle)
+	return scanPaymentProfile(row)
+}
+
+// IsHandleAvailable reports whether the handle can be claimed by the user.
+func (r *PaymentProfileRepository) IsHandleAvailable(ctx context.Context, handle string, userID uuid.UUID, now time.Time) (bool, error) {
+	if r.pool == nil {
+		return false, errProfileNilPool
+	}
+
+	const query = `
+SELECT NOT EXISTS (
+	SELECT 1 FROM payment_profiles WHERE LOWER(handle) = LOWER($1) AND user_id <> $2
+) AND NOT EXISTS (
+	SELECT 1 FROM released_handles WHERE handle = LOWER($1) AND previous_user_id <> $2 AND available_at > $3
+)`
+
+	var available bool
+	if err := r.pool.QueryRow(ctx, query, handle, userID, now).Scan(&available); err != nil {
+		return false, mapPGError(err)
+	}
+	return available, nil
+}
+
+// Create inserts a new profile after checking the handle is not held.
+func (r *PaymentProfileRepository) Create(ctx context.Context, profile *entities.PaymentProfileEntity) error {
+	if r.pool == nil {
+		return errProfileNilPool
+	}
+	if profile == nil {
+		return errProfileNilProfile
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return mapPGError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := ensureHandleNotHeld(ctx, tx, profile.GetHandle(), profile.GetUserID(), profile.GetCreatedAt()); err != nil {
+		return err
+	}
+
+	const query = `
+INSERT INTO payment_profiles (
+	user_id,
+	handle,
+	display_name,
+	accepted_assets,
+	is_public,
+	allow_handle_payments,
+	handle_changed_at,
+	created_at,
+	updated_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	if _, err := tx.Exec(ctx, query,
+		profile.GetUserID(),
+		profile.GetHandle(),
+		nullableString(profile.GetDisplayName()),
+		chainsToStrings(profile.GetAcceptedAssets()),
+		profile.IsPublic(),
+		profile.AllowsHandlePayments(),
+		profile.GetHandleChangedAt(),
+		profile.GetCreatedAt(),
+		profile.GetUpdatedAt(),
+	); err != nil {
+		return mapPGError(err)
+	}
+
+	if err := clearOwnHandleHold(ctx, tx, profile.GetHandle(), profile.GetUserID()); err != nil {
+		return err
+	}
+
+	return mapPGError(tx.Commit(ctx))
+}
+
+// Update persists profile details and privacy settings.
+func (r *PaymentProfileRepository) Update(ctx context.Context, profile *entities.PaymentProfileEntity) error {
+	if r.pool == nil {
+		return errProfileNilPool
+	}
+	if profile == nil {
+		return errProfileNilProfile
+	}
+
+	const query = `
+UPDATE payment_profiles
+SET display_name = $2,
+	accepted_assets = $3,
+	is_public = $4,
+	allow_handle_payments = $5,
+	updated_at = $6
+WHERE user_id = $1`
+
+	tag, err := r.pool.Exec(ctx, query,
+		profile.GetUserID(),
+		nullableString(profile.GetDisplayName()),
+		chainsToStrings(profile.GetAcceptedAssets()),
+		profile.IsPublic(),
+		profile.AllowsHandlePayments(),
+		profile.GetUpdatedAt(),
+	)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+// ChangeHandle swaps the profile's handle and places the previous handle on hold.
+func (r *PaymentProfileRepository) ChangeHandle(ctx context.Context, profile *entities.PaymentProfileEntity, previousHandle string, releaseUntil time.Time) error {
+	if r.pool == nil {
+		return errProfileNilPool
+	}
+	if profile == nil {
+		return errProfileNilProfile
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return mapPGError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := ensureHandleNotHeld(ctx, tx, profile.GetHandle(), profile.GetUserID(), profile.GetHandleChangedAt()); err != nil {
+		return err
+	}
+
+	tag, err := tx.Exec(ctx,
+		"UPDATE payment_profiles SET handle = $2, handle_changed_at = $3, updated_at = $4 WHERE user_id = $1 AND handle = $5",
+		profile.GetUserID(),
+		profile.GetHandle(),
+		profile.GetHandleChangedAt(),
+		profile.GetUpdatedAt(),
+		previousHandle,
+	)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrConflict
+	}
+
+	const releaseQuery = `
+INSERT INTO released_handles (handle, previous_user_id, available_at, released_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (handle) DO UPDATE
+SET previous_user_id = EXCLUDED.previous_user_id,
+	available_at = EXCLUDED.available_at,
+	released_at = EXCLUDED.released_at`
+
+	if _, err := tx.Exec(ctx, releaseQuery, previousHandle, profile.GetUserID(), releaseUntil, profile.GetHandleChangedAt()); err != nil {
+		return mapPGError(err)
+	}
+
+	if err := clearOwnHandleHold(ctx, tx, profile.GetHandle(), profile.GetUserID()); err != nil {
+		return err
+	}
+
+	return mapPGError(tx.Commit(ctx))
+}
+
+// ensureHandleNotHeld rejects handles released by another user that are still within their hold period.
+func ensureHandleNotHeld(ctx context.Context, tx pgx.Tx, handle string, userID uuid.UUID, now time.Time) error {
+	var held bool
+	if err := tx.QueryRow(ctx,
+		"SELECT EXISTS (SELECT 1 FROM released_handles WHERE handle = $1 AND previous_user_id <> $2 AND available_at > $3)",
+		handle, userID, now,
+	).Scan(&held); err != nil {
+		return mapPGError(err)
+	}
+	if held {
+		return repositories.ErrConflict
+	}
+	return nil
+}
+
+// clearOwnHandleHold drops the hold when a user reclaims a handle they released.
+func clearOwnHandleHold(ctx context.Context, tx pgx.Tx, handle string, userID uuid.UUID) error {
+	_, err := tx.Exec(ctx, "DELETE FROM released_handles WHERE handle = $1 AND previous_user_id = $2", handle, userID)
+	return mapPGError(err)
+}
+
+func chainsToStrings(chains []entities.Chain) []string {
+	values := make([]string, 0, len(chains))
+	for _, chain := range chains {
+		values = append(values, string(chain))
+	}
+	return values
+}
+
+func scanPaymentProfile(row pgx.Row) (entities.PaymentProfile, error) {
+	var (
+		params      entities.PaymentProfileParams
+		displayName *string
+		assets      []string
+	)
+
+	if err := row.Scan(
+		&params.UserID,
+		&params.Handle,
+		&displayName,
+		&assets,
+		&params.Public,
+		&params.AllowHandlePayments,
+		&params.HandleChangedAt,
+		&params.CreatedAt,
+		&params.UpdatedAt,
+	); err != nil {
+		return nil, mapPGError(err)
+	}
+
+	if displayName != nil {
+		params.DisplayName = *displayName
+	}
+	params.AcceptedAssets = make([]entities.Chain, 0, len(assets))
+	for _, asset := range assets {
+		params.AcceptedAssets = append(params.AcceptedAssets, entities.Chain(asset))
+	}
+
+	return entities.HydratePaymentProfileEntity(params), nil
+}
@@ -0,0 +1,279 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const portfolioShareSelectColumns = `
+SELECT
+	id,
+	user_id,
+	label,
+	scope,
+	token_hash,
+	created_at,
+	expires_at,
+	revoked_at,
+	last_accessed_at
+FROM portfolio_shares`
+
+var errPortfolioShareNilPool = errors.New("portfolio share repository: database pool is not configured")
+
+// PortfolioShareRepository persists portfolio share links using PostgreSQL.
+type PortfolioShareRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewPortfolioShareRepository constructs a PortfolioShareRepository backed by the provided pool.
+func NewPortfolioShareRepository(pool *pgxpool.Pool, logger *slog.Logger) *PortfolioShareRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &PortfolioShareRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// Create stores a newly issued share.
+func (r *PortfolioShareRepository) Create(ctx context.Context, share entities.PortfolioShare) error {
+	if r.pool == nil {
+		return errPortfolioShareNilPool
+	}
+	if err := share.Validate(); err != nil {
+		return err
+	}
+
+	_, err := r.pool.Exec(ctx, `
+INSERT INTO portfolio_shares (
+	id,
+	user_id,
+	label,
+	scope,
+	token_hash,
+	created_at,
+	expires_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7)`,
+		share.ID,
+		share.UserID,
+		share.Label,
+		string(share.Scope),
+		share.TokenHash,
+		share.CreatedAt.UTC(),
+		share.ExpiresAt.UTC(),
+	)
+	return mapPGError(err)
+}
+
+// GetByTokenHash returns the share whatever its state, or ErrNotFound.
+func (r *PortfolioShareRepository) GetByTokenHash(ctx context.Context, tokenHash string) (entities.PortfolioShare, error) {
+	if r.pool == nil {
+		return entities.PortfolioShare{}, errPortfolioShareNilPool
+	}
+	row := r.pool.QueryRow(ctx, portfolioShareSelectColumns+" WHERE token_hash = $1", tokenHash)
+	return scanPortfolioShare(row)
+}
+
+// GetByID returns the user's share, or ErrNotFound when the user has no such share.
+func (r *PortfolioShareRepository) GetByID(ctx context.Context, userID, id uuid.UUID) (entities.PortfolioShare, error) {
+	if r.pool == nil {
+		return entities.PortfolioShare{}, errPortfolioShareNilPool
+	}
+	row := r.pool.QueryRow(ctx, portfolioShareSelectColumns+" WHERE id = $1 AND user_id = $2", id, userID)
+	return scanPortfolioShare(row)
+}
+
+// ListByUser returns the user's shares, newest first.
+func (r *PortfolioShareRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]entities.PortfolioShare, error) {
+	if r.pool == nil {
+		return nil, errPortfolioShareNilPool
+	}
+
+	rows, err := r.pool.Query(ctx, portfolioShareSelectColumns+" WHERE user_id = $1 ORDER BY created_at DESC", userID)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	shares := make([]entities.PortfolioShare, 0)
+	for rows.Next() {
+		share, err := scanPortfolioShare(rows)
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, share)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return shares, nil
+}
+
+// CountActiveByUser counts the user's unrevoked shares expiring after at.
+func (r *PortfolioShareRepository) CountActiveByUser(ctx context.Context, userID uuid.UUID, at time.Time) (int, error) {
+	if r.pool == nil {
+		return 0, errPortfolioShareNilPool
+	}
+	var count int
+	err := r.pool.QueryRow(ctx, `
+SELECT COUNT(*)
+FROM portfolio_shares
+WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > $2`, userID, at.UTC()).Scan(&count)
+	if err != nil {
+		return 0, mapPGError(err)
+	}
+	return count, nil
+}
+
+// Revoke revokes the user's share. Returns ErrNotFound when the user has no such share and
+// ErrConflict when it was already revoked.
+func (r *PortfolioShareRepository) Revoke(ctx context.Context, userID, id uuid.UUID, at time.Time) error {
+	if r.pool == nil {
+		return errPortfolioShareNilPool
+	}
+
+	cmd, err := r.pool.Exec(ctx, `
+UPDATE portfolio_shares
+SET revoked_at = $3
+WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL`, id, userID, at.UTC())
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() > 0 {
+		return nil
+	}
+
+	var exists bool
+	if err := r.pool.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM portfolio_shares WHERE id = $1 AND user_id = $2)", id, userID).Scan(&exists); err != nil {
+		return mapPGError(err)
+	}
+	if !exists {
+		return repositories.ErrNotFound
+	}
+	return repositories.ErrConflict
+}
+
+// RecordAccess appends to the share's access log and updates its last access time.
+func (r *PortfolioShareRepository) RecordAccess(ctx context.Context, access entities.PortfolioShareAccess) error {
+	if r.pool == nil {
+		return errPortfolioShareNilPool
+	}
+	if err := access.Validate(); err != nil {
+		return err
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return mapPGError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `
+INSERT INTO portfolio_share_accesses (
+	id,
+	share_id,
+	resource,
+	ip_address,
+	user_agent,
+	accessed_at
+) VALUES ($1,$2,$3,$4,$5,$6)`,
+		access.ID,
+		access.ShareID,
+		access.Resource,
+		access.IPAddress,
+		access.UserAgent,
+		access.AccessedAt.UTC(),
+	); err != nil {
+		return mapPGError(err)
+	}
+
+	if _, err := tx.Exec(ctx, `
+UPDATE portfolio_shares
+SET last_accessed_at = GREATEST(COALESCE(last_accessed_at, $2), $2)
+WHERE id = $1`, access.ShareID, access.AccessedAt.UTC()); err != nil {
+		return mapPGError(err)
+	}
+
+	return mapPGError(tx.Commit(ctx))
+}
+
+// ListAccesses returns the share's access log, newest first.
+func (r *PortfolioShareRepository) ListAccesses(ctx context.Context, shareID uuid.UUID, opts repositories.ListOptions) ([]entities.PortfolioShareAccess, error) {
+	if r.pool == nil {
+		return nil, errPortfolioShareNilPool
+	}
+
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = repositories.DefaultListLimit
+	}
+	offset := opts.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	rows, err := r.pool.Query(ctx, `
+SELECT id, share_id, resource, ip_address, user_agent, accessed_at
+FROM portfolio_share_accesses
+WHERE share_id = $1
+ORDER BY accessed_at DESC
+LIMIT $2 OFFSET $3`, shareID, limit, offset)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	accesses := make([]entities.PortfolioShareAccess, 0)
+	for rows.Next() {
+		var access entities.PortfolioShareAccess
+		if err := rows.Scan(
+			&access.ID,
+			&access.ShareID,
+			&access.Resource,
+			&access.IPAddress,
+			&access.UserAgent,
+			&access.AccessedAt,
+		); err != nil {
+			return nil, mapPGError(err)
+		}
+		access.AccessedAt = access.AccessedAt.UTC()
+		accesses = append(accesses, access)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return accesses, nil
+}
+
+func scanPortfolioShare(row pgx.Row) (entities.PortfolioShare, error) {
+	var (
+		share entities.PortfolioShare
+		scope string
+	)
+	if err := row.Scan(
+		&share.ID,
+		&share.UserID,
+		&share.Label,
+		&scope,
+		&share.TokenHash,
+		&share.CreatedAt,
+		&share.ExpiresAt,
+		&share.RevokedAt,
+		&share.LastAccessedAt,
+	); err != nil {
+		return entities.PortfolioShare{}, mapPGError(err)
+	}
+	share.Scope = entities.PortfolioShareScope(scope)
+	return share, nil
+}
@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+var errPortfolioValueNilPool = errors.New("portfolio value repository: database pool is not configured")
+
+// PortfolioValueRepository persists daily portfolio values using PostgreSQL.
+type PortfolioValueRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewPortfolioValueRepository constructs a PortfolioValueRepository backed by the provided pool.
+func NewPortfolioValueRepository(pool *pgxpool.Pool, logger *slog.Logger) *PortfolioValueRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &PortfolioValueRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// UpsertPortfolioValues stores the values with one multi-row statement.
+func (r *PortfolioValueRepository) UpsertPortfolioValues(ctx context.Context, values []entities.PortfolioDailyValue) error {
+	if r.pool == nil {
+		return errPortfolioValueNilPool
+	}
+	if len(values) == 0 {
+		return nil
+	}
+
+	var (
+		userIDs    = make([]uuid.UUID, len(values))
+		days       = make([]time.Time, len(values))
+		amounts    = make([]string, len(values))
+		computedAt = make([]time.Time, len(values))
+	)
+	for i, value := range values {
+		if err := value.Validate(); err != nil {
+			return err
+		}
+		userIDs[i] = value.UserID
+		days[i] = value.Day.UTC()
+		amounts[i] = value.ValueUSD.StringFixedBank(2)
+		computedAt[i] = value.ComputedAt.UTC()
+	}
+
+	_, err := r.pool.Exec(ctx, `
+INSERT INTO portfolio_daily_values (user_id, day, value_usd, computed_at)
+SELECT user_id, day, value_usd::numeric, computed_at
+FROM unnest($1::uuid[], $2::date[], $3::text[], $4::timestamptz[]) AS batch (user_id, day, value_usd, computed_at)
+ON CONFLICT (user_id, day) DO UPDATE SET
+	value_usd = EXCLUDED.value_usd,
+	computed_at = EXCLUDED.computed_at`,
+		userIDs, days, amounts, computedAt,
+	)
+	if err != nil {
+		return mapPGError(err)
+	}
+	return nil
+}
+
+// ListPortfolioValues returns the user's daily values from the day containing from, oldest first.
+func (r *PortfolioValueRepository) ListPortfolioValues(ctx context.Context, userID uuid.UUID, from time.Time) ([]entities.PortfolioDailyValue, error) {
+	if r.pool == nil {
+		return nil, errPortfolioValueNilPool
+	}
+
+	rows, err := r.pool.Query(ctx, `
+SELECT user_id, day, value_usd::text, computed_at
+FROM portfolio_daily_values
+WHERE user_id = $1
+	AND day >= $2::date
+ORDER BY day ASC`,
+		userID, from.UTC(),
+	)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	values := make([]entities.PortfolioDailyValue, 0)
+	for rows.Next() {
+		var (
+			value  entities.PortfolioDailyValue
+			amount string
+		)
+		if err := rows.Scan(&value.UserID, &value.Day, &amount, &value.ComputedAt); err != nil {
+			return nil, mapPGError(err)
+		}
+		if value.ValueUSD, err = decimal.NewFromString(amount); err != nil {
+			return nil, err
+		}
+		value.Day = value.Day.UTC()
+		values = append(values, value)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return values, nil
+}
@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const priceBackfillReturningColumns = `
+RETURNING
+	id,
+	symbol,
+	interval,
+	range_start,
+	range_end,
+	cursor,
+	candles_inserted,
+	status,
+	COALESCE(last_error, ''),
+	created_at,
+	updated_at`
+
+var errPriceBackfillNilPool = errors.New("price backfill repository: database pool is not configured")
+
+// PriceBackfillRepository persists historical price backfill progress using PostgreSQL.
+type PriceBackfillRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewPriceBackfillRepository constructs a PriceBackfillRepository backed by the provided pool.
+func NewPriceBackfillRepository(pool *pgxpool.Pool, logger *slog.Logger) *PriceBackfillRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &PriceBackfillRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// StartPriceBackfill inserts the job unless one exists with the same start, in which case that job's
+// range is extended to the later end. It returns the stored job.
+func (r *PriceBackfillRepository) StartPriceBackfill(ctx context.Context, job entities.PriceBackfillJob) (entities.PriceBackfillJob, error) {
+	if r.pool == nil {
+		return entities.PriceBackfillJob{}, errPriceBackfillNilPool
+	}
+	if err := job.Validate(); err != nil {
+		return entities.PriceBackfillJob{}, err
+	}
+
+	query := `
+INSERT INTO price_backfill_jobs (
+	id,
+	symbol,
+	interval,
+	range_start,
+	range_end,
+	cursor,
+	candles_inserted,
+	status,
+	created_at,
+	updated_at
+) VALUES (
+	$1, $2, $3, $4, $5, $6, $7, $8, $9, $9
+)
+ON CONFLICT (symbol, interval, range_start) DO UPDATE SET
+	range_end = GREATEST(price_backfill_jobs.range_end, EXCLUDED.range_end)` + priceBackfillReturningColumns
+
+	stored, err := scanPriceBackfillJob(r.pool.QueryRow(ctx, query,
+		job.ID,
+		job.Symbol,
+		string(job.Interval),
+		job.RangeStart.UTC(),
+		job.RangeEnd.UTC(),
+		job.Cursor.UTC(),
+		job.CandlesInserted,
+		string(job.Status),
+		job.CreatedAt.UTC(),
+	))
+	if err != nil {
+		return entities.PriceBackfillJob{}, mapPGError(err)
+	}
+	return stored, nil
+}
+
+// UpdatePriceBackfill saves the job's progress and status.
+func (r *PriceBackfillRepository) UpdatePriceBackfill(ctx context.Context, job entities.PriceBackfillJob) error {
+	if r.pool == nil {
+		return errPriceBackfillNilPool
+	}
+	if err := job.Validate(); err != nil {
+		return err
+	}
+
+	cmd, err := r.pool.Exec(ctx, `
+UPDATE price_backfill_jobs SET
+	cursor = $2,
+	candles_inserted = $3,
+	status = $4,
+	last_error = NULLIF($5, ''),
+	updated_at = $6
+WHERE id = $1`,
+		job.ID,
+		job.Cursor.UTC(),
+		job.CandlesInserted,
+		string(job.Status),
+		job.LastError,
+		job.UpdatedAt.UTC(),
+	)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+func scanPriceBackfillJob(row pgx.Row) (entities.PriceBackfillJob, error) {
+	var (
+		job      entities.PriceBackfillJob
+		interval string
+		status   string
+	)
+	if err := row.Scan(
+		&job.ID,
+		&job.Symbol,
+		&interval,
+		&job.RangeStart,
+		&job.RangeEnd,
+		&job.Cursor,
+		&job.CandlesInserted,
+		&status,
+		&job.LastError,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+	); err != nil {
+		return entities.PriceBackfillJob{}, err
+	}
+	job.Interval = entities.IntervalType(interval)
+	job.Status = entities.PriceBackfillStatus(status)
+	return job, nil
+}
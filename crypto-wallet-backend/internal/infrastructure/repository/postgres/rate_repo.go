@@ -25,6 +25,7 @@ SELECT
 	price_change_24h,
 	volume_24h,
 	market_cap,
+	source,
 	last_updated,
 	created_at
 FROM exchange_rates`
@@ -43,12 +44,29 @@ SELECT
 	created_at
 FROM price_history`
 
+const transactionSummarySelectColumns = `
+SELECT
+	id,
+	chain,
+	date,
+	transaction_count,
+	total_volume_usd::text,
+	unique_senders,
+	unique_receivers,
+	avg_transaction_value_usd::text,
+	swap_count,
+	total_fees_usd::text,
+	created_at,
+	updated_at
+FROM transaction_summary`
+
 var (
 	errNilRatePool         = errors.New("rate repository: database pool is not configured")
 	errNilExchangeRate     = errors.New("rate repository: exchange rate entity is required")
 	errNilPriceHistory     = errors.New("rate repository: price history entity is required")
 	errEmptySymbol         = errors.New("rate repository: symbol is required")
 	errEmptySymbolList     = errors.New("rate repository: symbol list is required")
+	errEmptySummaryChain   = errors.New("rate repository: transaction summary chain is required")
 )
 
 // RateRepository persists exchange rate and price history aggregates using PostgreSQL.
@@ -169,10 +187,11 @@ INSERT INTO exchange_rates (
 	price_change_24h,
 	volume_24h,
 	market_cap,
+	source,
 	last_updated,
 	created_at
 ) VALUES (
-	$1, $2, $3, $4, $5, $6, $7, $8
+	$1, $2, $3, $4, $5, $6, $7, $8, $9
 )
 ON CONFLICT (symbol)
 DO UPDATE SET
@@ -180,6 +199,7 @@ DO UPDATE SET
 	price_change_24h = EXCLUDED.price_change_24h,
 	volume_24h = EXCLUDED.volume_24h,
 	market_cap = EXCLUDED.market_cap,
+	source = EXCLUDED.source,
 	last_updated = EXCLUDED.last_updated`
 
 	_, err := r.pool.Exec(ctx, query,
@@ -189,6 +209,7 @@ DO UPDATE SET
 		rate.GetPriceChange24h().String(),
 		rate.GetVolume24h().String(),
 		rate.GetMarketCap().String(),
+		rate.GetSource(),
 		rate.GetLastUpdated().UTC(),
 		rate.GetCreatedAt().UTC(),
 	)
@@ -216,10 +237,11 @@ INSERT INTO exchange_rates (
 	price_change_24h,
 	volume_24h,
 	market_cap,
+	source,
 	last_updated,
 	created_at
 ) VALUES (
-	$1, $2, $3, $4, $5, $6, $7, $8
+	$1, $2, $3, $4, $5, $6, $7, $8, $9
 )`
 
 	_, err := r.pool.Exec(ctx, query,
@@ -229,6 +251,7 @@ INSERT INTO exchange_rates (
 		rate.GetPriceChange24h().String(),
 		rate.GetVolume24h().String(),
 		rate.GetMarketCap().String(),
+		rate.GetSource(),
 		rate.GetLastUpdated().UTC(),
 		rate.GetCreatedAt().UTC(),
 	)
@@ -255,7 +278,8 @@ SET
 	price_change_24h = $3,
 	volume_24h = $4,
 	market_cap = $5,
-	last_updated = $6
+	source = $6,
+	last_updated = $7
 WHERE symbol = $1`
 
 	cmd, err := r.pool.Exec(ctx, query,
@@ -264,6 +288,7 @@ WHERE symbol = $1`
 		rate.GetPriceChange24h().String(),
 		rate.GetVolume24h().String(),
 		rate.GetMarketCap().String(),
+		rate.GetSource(),
 		rate.GetLastUpdated().UTC(),
 	)
 	if err != nil {
@@ -383,7 +408,7 @@ func (r *RateRepository) ListPriceHistory(ctx context.Context, filter repositori
 	return results, nil
 }
 
-// CreatePriceHistory persists the supplied price history entity.
+// CreatePriceHistory persists the supplied price history entity. The price_usd column mirrors the close.
 func (r *RateRepository) CreatePriceHistory(ctx context.Context, history *entities.PriceHistoryEntity) error {
 	if r.pool == nil {
 		return errNilRatePool
@@ -402,10 +427,11 @@ INSERT INTO price_history (
 	high,
 	low,
 	close,
+	price_usd,
 	volume,
 	created_at
 ) VALUES (
-	$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+	$1, $2, $3, $4, $5, $6, $7, $8, $8, $9, $10
 )
 ON CONFLICT (symbol, interval, timestamp) DO NOTHING`
 
@@ -428,13 +454,165 @@ ON CONFLICT (symbol, interval, timestamp) DO NOTHING`
 	return nil
 }
 
-// DeleteOldPriceHistory removes price history entries older than the specified time.
-func (r *RateRepository) DeleteOldPriceHistory(ctx context.Context, before time.Time) (int64, error) {
+// priceHistoryBatchSize bounds the rows sent in one statement by CreatePriceHistoryBatch.
+const priceHistoryBatchSize = 5000
+
+// CreatePriceHistoryBatch persists the supplied candles with one multi-row insert per batch, skipping
+// candles that already exist. It returns the number of rows inserted.
+func (r *RateRepository) CreatePriceHistoryBatch(ctx context.Context, history []*entities.PriceHistoryEntity) (int64, error) {
+	if r.pool == nil {
+		return 0, errNilRatePool
+	}
+
+	query := `
+INSERT INTO price_history (
+	id,
+	symbol,
+	interval,
+	timestamp,
+	open,
+	high,
+	low,
+	close,
+	price_usd,
+	volume,
+	created_at
+)
+SELECT
+	id,
+	symbol,
+	interval::price_interval,
+	timestamp,
+	open::numeric,
+	high::numeric,
+	low::numeric,
+	close::numeric,
+	close::numeric,
+	volume::numeric,
+	created_at
+FROM unnest(
+	$1::uuid[],
+	$2::text[],
+	$3::text[],
+	$4::timestamptz[],
+	$5::text[],
+	$6::text[],
+	$7::text[],
+	$8::text[],
+	$9::text[],
+	$10::timestamptz[]
+) AS batch (id, symbol, interval, timestamp, open, high, low, close, volume, created_at)
+ON CONFLICT (symbol, interval, timestamp) DO NOTHING`
+
+	var inserted int64
+	for start := 0; start < len(history); start += priceHistoryBatchSize {
+		end := min(start+priceHistoryBatchSize, len(history))
+		chunk := history[start:end]
+
+		var (
+			ids        = make([]uuid.UUID, len(chunk))
+			symbols    = make([]string, len(chunk))
+			intervals  = make([]string, len(chunk))
+			timestamps = make([]time.Time, len(chunk))
+			opens      = make([]string, len(chunk))
+			highs      = make([]string, len(chunk))
+			lows       = make([]string, len(chunk))
+			closes     = make([]string, len(chunk))
+			volumes    = make([]string, len(chunk))
+			createdAt  = make([]time.Time, len(chunk))
+		)
+		for i, candle := range chunk {
+			if candle == nil {
+				return inserted, errNilPriceHistory
+			}
+			ids[i] = candle.GetID()
+			symbols[i] = candle.GetSymbol()
+			intervals[i] = string(candle.GetInterval())
+			timestamps[i] = candle.GetTimestamp().UTC()
+			opens[i] = candle.GetOpen().String()
+			highs[i] = candle.GetHigh().String()
+			lows[i] = candle.GetLow().String()
+			closes[i] = candle.GetClose().String()
+			volumes[i] = candle.GetVolume().String()
+			createdAt[i] = candle.GetCreatedAt().UTC()
+		}
+
+		cmd, err := r.pool.Exec(ctx, query, ids, symbols, intervals, timestamps, opens, highs, lows, closes, volumes, createdAt)
+		if err != nil {
+			return inserted, mapPGError(err)
+		}
+		inserted += cmd.RowsAffected()
+	}
+
+	return inserted, nil
+}
+
+// ListCloseSeries returns ascending close prices per symbol since the supplied time using a single grouped query.
+func (r *RateRepository) ListCloseSeries(ctx context.Context, symbols []string, interval entities.IntervalType, from time.Time) (map[string][]decimal.Decimal, error) {
+	if r.pool == nil {
+		return nil, errNilRatePool
+	}
+
+	if len(symbols) == 0 {
+		return nil, errEmptySymbolList
+	}
+
+	normalizedSymbols := make([]string, len(symbols))
+	for i, sym := range symbols {
+		normalizedSymbols[i] = strings.ToUpper(strings.TrimSpace(sym))
+	}
+
+	query := `
+SELECT
+	symbol,
+	array_agg(close::text ORDER BY timestamp ASC)
+FROM price_history
+WHERE symbol = ANY($1)
+	AND interval = $2
+	AND timestamp >= $3
+GROUP BY symbol`
+
+	rows, err := r.pool.Query(ctx, query, normalizedSymbols, string(interval), from.UTC())
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	results := make(map[string][]decimal.Decimal, len(normalizedSymbols))
+	for rows.Next() {
+		var (
+			symbol    string
+			closeStrs []string
+		)
+		if err := rows.Scan(&symbol, &closeStrs); err != nil {
+			return nil, mapPGError(err)
+		}
+
+		series := make([]decimal.Decimal, 0, len(closeStrs))
+		for _, closeStr := range closeStrs {
+			value, decErr := decimal.NewFromString(closeStr)
+			if decErr != nil {
+				return nil, fmt.Errorf("rate repository: parse close: %w", decErr)
+			}
+			series = append(series, value)
+		}
+		results[symbol] = series
+	}
+
+	if rows.Err() != nil {
+		return nil, mapPGError(rows.Err())
+	}
+
+	return results, nil
+}
+
+// DeleteOldPriceHistory removes price history entries of the interval older than the specified time.
+func (r *RateRepository) DeleteOldPriceHistory(ctx context.Context, interval entities.IntervalType, before time.Time) (int64, error) {
 	if r.pool == nil {
 		return 0, errNilRatePool
 	}
 
-	cmd, err := r.pool.Exec(ctx, "DELETE FROM price_history WHERE timestamp < $1", before.UTC())
+	cmd, err := r.pool.Exec(ctx, "DELETE FROM price_history WHERE interval = $1 AND timestamp < $2", string(interval), before.UTC())
 	if err != nil {
 		return 0, mapPGError(err)
 	}
@@ -442,6 +620,179 @@ func (r *RateRepository) DeleteOldPriceHistory(ctx context.Context, before time.
 	return cmd.RowsAffected(), nil
 }
 
+// RollupPriceHistory aggregates the source candles older than before into target candles, keeping
+// any target candle already stored. Only daily and weekly targets are supported; weeks start on
+// Monday as they do for the live rollup. It returns the number of candles created.
+func (r *RateRepository) RollupPriceHistory(ctx context.Context, source, target entities.IntervalType, before time.Time) (int64, error) {
+	if r.pool == nil {
+		return 0, errNilRatePool
+	}
+
+	var unit string
+	switch target {
+	case entities.Interval1d:
+		unit = "day"
+	case entities.Interval1w:
+		unit = "week"
+	default:
+		return 0, fmt.Errorf("rate repository: cannot roll up into %q candles", target)
+	}
+
+	query := `
+INSERT INTO price_history (
+	symbol,
+	interval,
+	timestamp,
+	open,
+	high,
+	low,
+	close,
+	price_usd,
+	volume,
+	created_at
+)
+SELECT
+	symbol,
+	$2::price_interval,
+	bucket,
+	(array_agg(open ORDER BY timestamp ASC))[1],
+	max(high),
+	min(low),
+	(array_agg(close ORDER BY timestamp DESC))[1],
+	(array_agg(close ORDER BY timestamp DESC))[1],
+	sum(volume),
+	NOW()
+FROM (
+	SELECT *, date_trunc($4, timestamp, 'UTC') AS bucket
+	FROM price_history
+	WHERE interval = $1
+		AND timestamp < $3
+) source
+GROUP BY symbol, bucket
+ON CONFLICT (symbol, interval, timestamp) DO NOTHING`
+
+	cmd, err := r.pool.Exec(ctx, query, string(source), string(target), before.UTC(), unit)
+	if err != nil {
+		return 0, mapPGError(err)
+	}
+
+	return cmd.RowsAffected(), nil
+}
+
+// ListTransactionSummaries returns the daily summaries for a chain within the date range, oldest first.
+func (r *RateRepository) ListTransactionSummaries(ctx context.Context, filter repositories.TransactionSummaryFilter) ([]entities.TransactionSummary, error) {
+	if r.pool == nil {
+		return nil, errNilRatePool
+	}
+
+	if filter.Chain == "" {
+		return nil, errEmptySummaryChain
+	}
+
+	query := transactionSummarySelectColumns + `
+WHERE chain = $1
+	AND date BETWEEN $2 AND $3
+ORDER BY date ASC`
+
+	rows, err := r.pool.Query(ctx, query, string(filter.Chain), filter.From.UTC(), filter.To.UTC())
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	summaries := make([]entities.TransactionSummary, 0)
+	for rows.Next() {
+		summary, scanErr := r.scanTransactionSummary(rows)
+		if scanErr != nil {
+			return nil, mapPGError(scanErr)
+		}
+		summaries = append(summaries, summary)
+	}
+
+	if rows.Err() != nil {
+		return nil, mapPGError(rows.Err())
+	}
+
+	return summaries, nil
+}
+
+// AggregateTransactionSummary rolls the daily summaries for a chain within the date range into a
+// single summary. Sums and the average are computed in NUMERIC and read back as text so no amount
+// passes through float64. Unique sender and receiver counts cannot be summed across days, so the
+// busiest day's counts are reported.
+func (r *RateRepository) AggregateTransactionSummary(ctx context.Context, filter repositories.TransactionSummaryFilter) (entities.TransactionSummary, error) {
+	if r.pool == nil {
+		return entities.TransactionSummary{}, errNilRatePool
+	}
+
+	if filter.Chain == "" {
+		return entities.TransactionSummary{}, errEmptySummaryChain
+	}
+
+	query := `
+SELECT
+	COALESCE(SUM(transaction_count), 0),
+	COALESCE(SUM(total_volume_usd), 0)::text,
+	COALESCE(MAX(unique_senders), 0),
+	COALESCE(MAX(unique_receivers), 0),
+	COALESCE(SUM(total_volume_usd) / NULLIF(SUM(transaction_count), 0), 0)::text,
+	COALESCE(SUM(swap_count), 0),
+	COALESCE(SUM(total_fees_usd), 0)::text
+FROM transaction_summary
+WHERE chain = $1
+	AND date BETWEEN $2 AND $3`
+
+	var (
+		transactionCount int64
+		totalVolumeStr   string
+		uniqueSenders    int
+		uniqueReceivers  int
+		avgValueStr      string
+		swapCount        int64
+		totalFeesStr     string
+	)
+
+	err := r.pool.QueryRow(ctx, query, string(filter.Chain), filter.From.UTC(), filter.To.UTC()).Scan(
+		&transactionCount,
+		&totalVolumeStr,
+		&uniqueSenders,
+		&uniqueReceivers,
+		&avgValueStr,
+		&swapCount,
+		&totalFeesStr,
+	)
+	if err != nil {
+		return entities.TransactionSummary{}, mapPGError(err)
+	}
+
+	totalVolume, err := decimal.NewFromString(totalVolumeStr)
+	if err != nil {
+		return entities.TransactionSummary{}, fmt.Errorf("rate repository: parse total_volume_usd: %w", err)
+	}
+
+	avgValue, err := decimal.NewFromString(avgValueStr)
+	if err != nil {
+		return entities.TransactionSummary{}, fmt.Errorf("rate repository: parse avg_transaction_value_usd: %w", err)
+	}
+
+	totalFees, err := decimal.NewFromString(totalFeesStr)
+	if err != nil {
+		return entities.TransactionSummary{}, fmt.Errorf("rate repository: parse total_fees_usd: %w", err)
+	}
+
+	return entities.TransactionSummary{
+		Chain:                  filter.Chain,
+		Date:                   filter.From.UTC(),
+		TransactionCount:       int(transactionCount),
+		TotalVolumeUSD:         totalVolume,
+		UniqueSenders:          uniqueSenders,
+		UniqueReceivers:        uniqueReceivers,
+		AvgTransactionValueUSD: avgValue,
+		SwapCount:              int(swapCount),
+		TotalFeesUSD:           totalFees,
+	}, nil
+}
+
 func (r *RateRepository) scanExchangeRate(row pgx.Row) (entities.ExchangeRate, error) {
 	var (
 		id              uuid.UUID
@@ -450,6 +801,7 @@ func (r *RateRepository) scanExchangeRate(row pgx.Row) (entities.ExchangeRate, e
 		priceChange24hStr string
 		volume24hStr    string
 		marketCapStr    string
+		source          string
 		lastUpdated     time.Time
 		createdAt       time.Time
 	)
@@ -461,6 +813,7 @@ func (r *RateRepository) scanExchangeRate(row pgx.Row) (entities.ExchangeRate, e
 		&priceChange24hStr,
 		&volume24hStr,
 		&marketCapStr,
+		&source,
 		&lastUpdated,
 		&createdAt,
 	)
@@ -507,6 +860,7 @@ func (r *RateRepository) scanExchangeRate(row pgx.Row) (entities.ExchangeRate, e
 		PriceChange24h: priceChange24h,
 		Volume24h:      volume24h,
 		MarketCap:      marketCap,
+		Source:         source,
 		LastUpdated:    lastUpdated.UTC(),
 		CreatedAt:      createdAt.UTC(),
 		UpdatedAt:      time.Now().UTC(), // Set current time since DB doesn't have updated_at
@@ -587,6 +941,53 @@ func (r *RateRepository) scanPriceHistory(row pgx.Row) (entities.PriceHistory, e
 	return history, nil
 }
 
+func (r *RateRepository) scanTransactionSummary(row pgx.Row) (entities.TransactionSummary, error) {
+	var (
+		summary        entities.TransactionSummary
+		chain          string
+		totalVolumeStr string
+		avgValueStr    string
+		totalFeesStr   string
+	)
+
+	err := row.Scan(
+		&summary.ID,
+		&chain,
+		&summary.Date,
+		&summary.TransactionCount,
+		&totalVolumeStr,
+		&summary.UniqueSenders,
+		&summary.UniqueReceivers,
+		&avgValueStr,
+		&summary.SwapCount,
+		&totalFeesStr,
+		&summary.CreatedAt,
+		&summary.UpdatedAt,
+	)
+	if err != nil {
+		return entities.TransactionSummary{}, err
+	}
+
+	if summary.TotalVolumeUSD, err = decimal.NewFromString(totalVolumeStr); err != nil {
+		return entities.TransactionSummary{}, fmt.Errorf("rate repository: parse total_volume_usd: %w", err)
+	}
+
+	if summary.AvgTransactionValueUSD, err = decimal.NewFromString(avgValueStr); err != nil {
+		return entities.TransactionSummary{}, fmt.Errorf("rate repository: parse avg_transaction_value_usd: %w", err)
+	}
+
+	if summary.TotalFeesUSD, err = decimal.NewFromString(totalFeesStr); err != nil {
+		return entities.TransactionSummary{}, fmt.Errorf("rate repository: parse total_fees_usd: %w", err)
+	}
+
+	summary.Chain = entities.Chain(chain)
+	summary.Date = summary.Date.UTC()
+	summary.CreatedAt = summary.CreatedAt.UTC()
+	summary.UpdatedAt = summary.UpdatedAt.UTC()
+
+	return summary, nil
+}
+
 func sanitizePriceHistorySortColumn(sortBy string) string {
 	switch strings.ToLower(strings.TrimSpace(sortBy)) {
 	case "symbol":
@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const refreshTokenSelectColumns = `
+SELECT
+	id,
+	family_id,
+	user_id,
+	issued_at,
+	expires_at,
+	rotated_at,
+	revoked_at
+FROM refresh_tokens`
+
+const refreshTokenInsert = `
+INSERT INTO refresh_tokens (
+	id,
+	family_id,
+	user_id,
+	issued_at,
+	expires_at
+) VALUES ($1,$2,$3,$4,$5)`
+
+var errRefreshTokenNilPool = errors.New("refresh token repository: database pool is not configured")
+
+// RefreshTokenRepository persists refresh token families using PostgreSQL.
+type RefreshTokenRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewRefreshTokenRepository constructs a RefreshTokenRepository backed by the provided pool.
+func NewRefreshTokenRepository(pool *pgxpool.Pool, logger *slog.Logger) *RefreshTokenRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RefreshTokenRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// Create stores the first token of a new family.
+func (r *RefreshTokenRepository) Create(ctx context.Context, token entities.RefreshToken) error {
+	if r.pool == nil {
+		return errRefreshTokenNilPool
+	}
+	if err := token.Validate(); err != nil {
+		return err
+	}
+
+	_, err := r.pool.Exec(ctx, refreshTokenInsert,
+		token.ID,
+		token.FamilyID,
+		token.UserID,
+		token.IssuedAt.UTC(),
+		token.ExpiresAt.UTC(),
+	)
+	return mapPGError(err)
+}
+
+// Get returns the token, whatever its state, or ErrNotFound.
+func (r *RefreshTokenRepository) Get(ctx context.Context, id uuid.UUID) (entities.RefreshToken, error) {
+	if r.pool == nil {
+		return entities.RefreshToken{}, errRefreshTokenNilPool
+	}
+
+	row := r.pool.QueryRow(ctx, refreshTokenSelectColumns+" WHERE id = $1", id)
+	return scanRefreshToken(row)
+}
+
+// Rotate marks the current token rotated and stores its successor in one transaction.
+func (r *RefreshTokenRepository) Rotate(ctx context.Context, currentID uuid.UUID, next entities.RefreshToken) error {
+	if r.pool == nil {
+		return errRefreshTokenNilPool
+	}
+	if err := next.Validate(); err != nil {
+		return err
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return mapPGError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	cmd, err := tx.Exec(ctx, `
+UPDATE refresh_tokens
+SET rotated_at = $3
+WHERE id = $1 AND family_id = $2 AND rotated_at IS NULL AND revoked_at IS NULL`,
+		currentID,
+		next.FamilyID,
+		next.IssuedAt.UTC(),
+	)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrConflict
+	}
+
+	if _, err := tx.Exec(ctx, refreshTokenInsert,
+		next.ID,
+		next.FamilyID,
+		next.UserID,
+		next.IssuedAt.UTC(),
+		next.ExpiresAt.UTC(),
+	); err != nil {
+		return mapPGError(err)
+	}
+
+	return mapPGError(tx.Commit(ctx))
+}
+
+// RevokeFamily revokes every unrevoked token of the family.
+func (r *RefreshTokenRepository) RevokeFamily(ctx context.Context, familyID uuid.UUID, at time.Time) error {
+	if r.pool == nil {
+		return errRefreshTokenNilPool
+	}
+
+	cmd, err := r.pool.Exec(ctx, `
+UPDATE refresh_tokens
+SET revoked_at = $2
+WHERE family_id = $1 AND revoked_at IS NULL`, familyID, at.UTC())
+	if err != nil {
+		return mapPGError(err)
+	}
+
+	r.logger.Debug("refresh token family revoked",
+		slog.String("family_id", familyID.String()),
+		slog.Int64("tokens", cmd.RowsAffected()))
+	return nil
+}
+
+func scanRefreshToken(row pgx.Row) (entities.RefreshToken, error) {
+	var token entities.RefreshToken
+	if err := row.Scan(
+		&token.ID,
+		&token.FamilyID,
+		&token.UserID,
+		&token.IssuedAt,
+		&token.ExpiresAt,
+		&token.RotatedAt,
+		&token.RevokedAt,
+	); err != nil {
+		return entities.RefreshToken{}, mapPGError(err)
+	}
+	return token, nil
+}
@@ -0,0 +1,313 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+var errReportQueryNilPool = errors.New("report query repository: database pool is not configured")
+
+// reportSource maps a report dataset onto its read model. Only names found here ever reach SQL;
+// every operand is bound as a parameter.
+type reportSource struct {
+	view       string
+	dimensions map[string]string
+	metrics    map[string]string
+	filters    map[string]string
+}
+
+// Time buckets shared by every dataset; weeks start on Monday.
+var reportTimeDimensions = map[string]string{
+	"day":   "to_char(created_at AT TIME ZONE 'UTC', 'YYYY-MM-DD')",
+	"week":  "to_char(date_trunc('week', created_at AT TIME ZONE 'UTC'), 'YYYY-MM-DD')",
+	"month": "to_char(created_at AT TIME ZONE 'UTC', 'YYYY-MM')",
+}
+
+var reportSources = map[entities.ReportDataset]reportSource{
+	entities.ReportDatasetTransactions: {
+		view: "analytics_report_transactions",
+		dimensions: withReportTimeDimensions(map[string]string{
+			"chain":     "chain",
+			"type":      "type",
+			"status":    "status",
+			"wallet_id": "wallet_id::text",
+		}),
+		metrics: map[string]string{
+			"count":        "COUNT(*)",
+			"total_amount": "COALESCE(SUM(amount), 0)",
+			"avg_amount":   "COALESCE(ROUND(AVG(amount), 18), 0)",
+			"min_amount":   "MIN(amount)",
+			"max_amount":   "MAX(amount)",
+			"total_fee":    "COALESCE(SUM(fee), 0)",
+		},
+		filters: map[string]string{
+			"chain":                  "chain",
+			"type":                   "type",
+			"status":                 "status",
+			"wallet_id":              "wallet_id::text",
+			entities.ReportTimeField: "created_at",
+			"amount":                 "amount",
+		},
+	},
+	entities.ReportDatasetExchanges: {
+		view: "analytics_report_exchanges",
+		dimensions: withReportTimeDimensions(map[string]string{
+			"from_chain": "from_chain",
+			"to_chain":   "to_chain",
+			"status":     "status",
+		}),
+		metrics: map[string]string{
+			"count":             "COUNT(*)",
+			"total_from_amount": "COALESCE(SUM(from_amount), 0)",
+			"total_to_amount":   "COALESCE(SUM(to_amount), 0)",
+			"total_fee":         "COALESCE(SUM(fee_amount), 0)",
+			"avg_rate":          "COALESCE(ROUND(AVG(exchange_rate), 18), 0)",
+		},
+		filters: map[string]string{
+			"from_chain":             "from_chain",
+			"to_chain":               "to_chain",
+			"status":                 "status",
+			entities.ReportTimeField: "created_at",
+			"from_amount":            "from_amount",
+		},
+	},
+}
+
+func withReportTimeDimensions(dimensions map[string]string) map[string]string {
+	for name, expr := range reportTimeDimensions {
+		dimensions[name] = expr
+	}
+	return dimensions
+}
+
+var reportComparisons = map[entities.ReportFilterOp]string{
+	entities.ReportFilterEq:  "=",
+	entities.ReportFilterNeq: "<>",
+	entities.ReportFilterGt:  ">",
+	entities.ReportFilterGte: ">=",
+	entities.ReportFilterLt:  "<",
+	entities.ReportFilterLte: "<=",
+}
+
+// ReportQueryRepository executes custom reports over the analytics read models using PostgreSQL.
+type ReportQueryRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewReportQueryRepository constructs a ReportQueryRepository backed by the provided pool.
+func NewReportQueryRepository(pool *pgxpool.Pool, logger *slog.Logger) *ReportQueryRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ReportQueryRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// Run executes the definition over the user's records. The query is cancelled with ctx.
+func (r *ReportQueryRepository) Run(ctx context.Context, userID uuid.UUID, definition entities.ReportDefinition) (entities.ReportResult, error) {
+	if r.pool == nil {
+		return entities.ReportResult{}, errReportQueryNilPool
+	}
+
+	query, args, err := buildReportQuery(userID, definition)
+	if err != nil {
+		return entities.ReportResult{}, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return entities.ReportResult{}, mapPGError(err)
+	}
+	defer rows.Close()
+
+	result := entities.ReportResult{
+		Columns: definition.ReportColumns(),
+		Rows:    make([][]string, 0),
+	}
+	width := len(result.Columns)
+	for rows.Next() {
+		if len(result.Rows) == definition.Limit {
+			result.Truncated = true
+			break
+		}
+		values := make([]*string, width)
+		dest := make([]any, width)
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return entities.ReportResult{}, mapPGError(err)
+		}
+		row := make([]string, width)
+		for i, value := range values {
+			if value != nil {
+				row[i] = *value
+			}
+		}
+		result.Rows = append(result.Rows, row)
+	}
+	if err := rows.Err(); err != nil {
+		return entities.ReportResult{}, mapPGError(err)
+	}
+	return result, nil
+}
+
+// reportQuery accumulates the WHERE conditions of a report and their bound arguments.
+type reportQuery struct {
+	conditions []string
+	args       []any
+}
+
+// add binds value as the next parameter and records the condition, whose %s is the placeholder.
+func (q *reportQuery) add(condition string, value any) {
+	q.args = append(q.args, value)
+	q.conditions = append(q.conditions, fmt.Sprintf(condition, fmt.Sprintf("$%d", len(q.args))))
+}
+
+// buildReportQuery renders a normalized, validated definition as one aggregate query. It fetches
+// one row beyond the limit so truncation can be detected.
+func buildReportQuery(userID uuid.UUID, definition entities.ReportDefinition) (string, []any, error) {
+	source, ok := reportSources[definition.Dataset]
+	if !ok {
+		return "", nil, fmt.Errorf("report query repository: unknown dataset %q", definition.Dataset)
+	}
+	spec, _ := entities.LookupReportDataset(definition.Dataset)
+
+	selects := make([]string, 0, len(definition.GroupBy)+len(definition.Metrics))
+	groupBy := make([]string, 0, len(definition.GroupBy))
+	for _, dimension := range definition.GroupBy {
+		expr, ok := source.dimensions[dimension]
+		if !ok {
+			return "", nil, fmt.Errorf("report query repository: unknown dimension %q", dimension)
+		}
+		selects = append(selects, expr)
+		groupBy = append(groupBy, expr)
+	}
+	for _, metric := range definition.Metrics {
+		expr, ok := source.metrics[metric]
+		if !ok {
+			return "", nil, fmt.Errorf("report query repository: unknown metric %q", metric)
+		}
+		selects = append(selects, "("+expr+")::text")
+	}
+
+	q := &reportQuery{}
+	q.add("user_id = %s", userID)
+	for _, filter := range definition.Filters {
+		column, ok := source.filters[filter.Field]
+		field, known := spec.FilterField(filter.Field)
+		if !ok || !known {
+			return "", nil, fmt.Errorf("report query repository: unknown filter field %q", filter.Field)
+		}
+		if err := q.addFilter(column, field.Type, filter); err != nil {
+			return "", nil, err
+		}
+	}
+
+	var query strings.Builder
+	query.WriteString("SELECT " + strings.Join(selects, ", "))
+	query.WriteString(" FROM " + source.view)
+	query.WriteString(" WHERE " + strings.Join(q.conditions, " AND "))
+	if len(groupBy) > 0 {
+		query.WriteString(" GROUP BY " + strings.Join(groupBy, ", "))
+	}
+	if order := reportOrderBy(source, definition); len(order) > 0 {
+		query.WriteString(" ORDER BY " + strings.Join(order, ", "))
+	}
+	q.args = append(q.args, definition.Limit+1)
+	query.WriteString(fmt.Sprintf(" LIMIT $%d", len(q.args)))
+
+	return query.String(), q.args, nil
+}
+
+func (q *reportQuery) addFilter(column string, fieldType entities.ReportFieldType, filter entities.ReportFilter) error {
+	cast := ""
+	switch fieldType {
+	case entities.ReportFieldDecimal:
+		cast = "::numeric"
+	case entities.ReportFieldTime:
+		cast = "::timestamptz"
+	}
+
+	if filter.Op == entities.ReportFilterIn {
+		values := make([]string, 0, len(filter.Values))
+		for _, value := range filter.Values {
+			operand, err := reportOperand(fieldType, value)
+			if err != nil {
+				return err
+			}
+			values = append(values, operand)
+		}
+		arrayCast := "::text[]"
+		if cast != "" {
+			arrayCast = cast + "[]"
+		}
+		q.add(column+" = ANY(%s"+arrayCast+")", values)
+		return nil
+	}
+
+	comparison, ok := reportComparisons[filter.Op]
+	if !ok {
+		return fmt.Errorf("report query repository: unknown operator %q", filter.Op)
+	}
+	operand, err := reportOperand(fieldType, filter.Value)
+	if err != nil {
+		return err
+	}
+	q.add(column+" "+comparison+" %s"+cast, operand)
+	return nil
+}
+
+// reportOperand normalises a filter operand to the text form PostgreSQL casts from.
+func reportOperand(fieldType entities.ReportFieldType, value string) (string, error) {
+	if fieldType != entities.ReportFieldTime {
+		return value, nil
+	}
+	at, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return "", fmt.Errorf("report query repository: invalid time %q", value)
+	}
+	return at.UTC().Format(time.RFC3339Nano), nil
+}
+
+// reportOrderBy orders rows by the requested sort fields, then by any remaining group-by
+// dimensions so results are deterministic.
+func reportOrderBy(source reportSource, definition entities.ReportDefinition) []string {
+	order := make([]string, 0, len(definition.Sort)+len(definition.GroupBy))
+	used := make(map[string]struct{}, len(definition.Sort))
+	for _, sort := range definition.Sort {
+		expr, ok := source.metrics[sort.Field]
+		if slices.Contains(definition.GroupBy, sort.Field) {
+			expr, ok = source.dimensions[sort.Field]
+		}
+		if !ok {
+			continue
+		}
+		direction := " ASC"
+		if sort.Desc {
+			direction = " DESC"
+		}
+		order = append(order, expr+direction+" NULLS LAST")
+		used[sort.Field] = struct{}{}
+	}
+	for _, dimension := range definition.GroupBy {
+		if _, ok := used[dimension]; ok {
+			continue
+		}
+		order = append(order, source.dimensions[dimension]+" ASC")
+	}
+	return order
+}
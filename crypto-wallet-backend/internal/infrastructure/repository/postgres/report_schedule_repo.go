@@ -0,0 +1,185 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const reportScheduleSelectColumns = `
+SELECT
+	user_id,
+	frequency,
+	timezone,
+	hour,
+	weekday,
+	currency,
+	next_run_at,
+	last_sent_at,
+	created_at,
+	updated_at
+FROM report_schedules`
+
+var errReportScheduleNilPool = errors.New("report schedule repository: database pool is not configured")
+
+// ReportScheduleRepository persists scheduled portfolio reports using PostgreSQL.
+type ReportScheduleRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewReportScheduleRepository constructs a ReportScheduleRepository backed by the provided pool.
+func NewReportScheduleRepository(pool *pgxpool.Pool, logger *slog.Logger) *ReportScheduleRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ReportScheduleRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// GetByUser returns the user's schedule, or ErrNotFound when they have not opted in.
+func (r *ReportScheduleRepository) GetByUser(ctx context.Context, userID uuid.UUID) (entities.ReportSchedule, error) {
+	if r.pool == nil {
+		return entities.ReportSchedule{}, errReportScheduleNilPool
+	}
+	row := r.pool.QueryRow(ctx, reportScheduleSelectColumns+" WHERE user_id = $1", userID)
+	return scanReportSchedule(row)
+}
+
+// Upsert creates or replaces the user's schedule, keeping its creation and last delivery times.
+func (r *ReportScheduleRepository) Upsert(ctx context.Context, schedule entities.ReportSchedule) error {
+	if r.pool == nil {
+		return errReportScheduleNilPool
+	}
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+
+	_, err := r.pool.Exec(ctx, `
+INSERT INTO report_schedules (
+	user_id,
+	frequency,
+	timezone,
+	hour,
+	weekday,
+	currency,
+	next_run_at,
+	created_at,
+	updated_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$8)
+ON CONFLICT (user_id) DO UPDATE SET
+	frequency = EXCLUDED.frequency,
+	timezone = EXCLUDED.timezone,
+	hour = EXCLUDED.hour,
+	weekday = EXCLUDED.weekday,
+	currency = EXCLUDED.currency,
+	next_run_at = EXCLUDED.next_run_at,
+	updated_at = EXCLUDED.updated_at`,
+		schedule.UserID,
+		string(schedule.Frequency),
+		schedule.Timezone,
+		schedule.Hour,
+		int(schedule.Weekday),
+		string(schedule.Currency),
+		schedule.NextRunAt.UTC(),
+		schedule.UpdatedAt.UTC(),
+	)
+	return mapPGError(err)
+}
+
+// Delete removes the user's schedule. Returns ErrNotFound when they have none.
+func (r *ReportScheduleRepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	if r.pool == nil {
+		return errReportScheduleNilPool
+	}
+	cmd, err := r.pool.Exec(ctx, "DELETE FROM report_schedules WHERE user_id = $1", userID)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+// ListDue returns up to limit schedules whose next run is at or before now, earliest first.
+func (r *ReportScheduleRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]entities.ReportSchedule, error) {
+	if r.pool == nil {
+		return nil, errReportScheduleNilPool
+	}
+	if limit <= 0 {
+		limit = repositories.DefaultListLimit
+	}
+
+	rows, err := r.pool.Query(ctx, reportScheduleSelectColumns+" WHERE next_run_at <= $1 ORDER BY next_run_at ASC LIMIT $2", now.UTC(), limit)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	schedules := make([]entities.ReportSchedule, 0)
+	for rows.Next() {
+		schedule, err := scanReportSchedule(rows)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, schedule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return schedules, nil
+}
+
+// Claim advances a due schedule from dueAt to nextRunAt and records sentAt. It reports false when
+// the schedule no longer runs at dueAt.
+func (r *ReportScheduleRepository) Claim(ctx context.Context, userID uuid.UUID, dueAt, nextRunAt, sentAt time.Time) (bool, error) {
+	if r.pool == nil {
+		return false, errReportScheduleNilPool
+	}
+	cmd, err := r.pool.Exec(ctx, `
+UPDATE report_schedules
+SET next_run_at = $3, last_sent_at = $4
+WHERE user_id = $1 AND next_run_at = $2`, userID, dueAt.UTC(), nextRunAt.UTC(), sentAt.UTC())
+	if err != nil {
+		return false, mapPGError(err)
+	}
+	return cmd.RowsAffected() > 0, nil
+}
+
+func scanReportSchedule(row pgx.Row) (entities.ReportSchedule, error) {
+	var (
+		schedule  entities.ReportSchedule
+		frequency string
+		weekday   int
+		currency  string
+	)
+	if err := row.Scan(
+		&schedule.UserID,
+		&frequency,
+		&schedule.Timezone,
+		&schedule.Hour,
+		&weekday,
+		&currency,
+		&schedule.NextRunAt,
+		&schedule.LastSentAt,
+		&schedule.CreatedAt,
+		&schedule.UpdatedAt,
+	); err != nil {
+		return entities.ReportSchedule{}, mapPGError(err)
+	}
+	schedule.Frequency = entities.ReportFrequency(frequency)
+	schedule.Weekday = time.Weekday(weekday)
+	schedule.Currency = entities.CurrencyCode(currency)
+	return schedule, nil
+}
@@ -0,0 +1,306 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+var errSandboxProfileNilPool = errors.New("sandbox profile repository: database pool is not configured")
+
+// sandboxSignificantDigits bounds the precision of captured values, so a quantile that lands on a
+// single record does not reproduce its exact amount.
+const sandboxSignificantDigits = 3
+
+// SandboxProfileRepository captures sandbox profiles from the core database using PostgreSQL.
+type SandboxProfileRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewSandboxProfileRepository constructs a SandboxProfileRepository backed by the provided pool.
+func NewSandboxProfileRepository(pool *pgxpool.Pool, logger *slog.Logger) *SandboxProfileRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SandboxProfileRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// Capture reads every statistic in one read-only snapshot so the shares agree with each other.
+// Version, CapturedAt and WindowDays are left to the caller.
+func (r *SandboxProfileRepository) Capture(ctx context.Context, since time.Time, minGroupSize int) (entities.SandboxProfile, error) {
+	if r.pool == nil {
+		return entities.SandboxProfile{}, errSandboxProfileNilPool
+	}
+	if minGroupSize <= 0 {
+		minGroupSize = entities.DefaultSandboxMinGroupSize
+	}
+	since = since.UTC()
+
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return entities.SandboxProfile{}, mapPGError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	profile := entities.SandboxProfile{MinGroupSize: minGroupSize}
+	quantiles := entities.SandboxQuantiles
+
+	var (
+		holders       int64
+		wallets       int64
+		walletsByUser []float64
+	)
+	if err := tx.QueryRow(ctx, `
+SELECT COUNT(*), COALESCE(SUM(n), 0), percentile_cont($1::float8[]) WITHIN GROUP (ORDER BY n)
+FROM (
+	SELECT COUNT(*) AS n FROM wallets WHERE status <> 'archived' GROUP BY user_id
+) per_user`, quantiles).Scan(&holders, &wallets, &walletsByUser); err != nil {
+		return entities.SandboxProfile{}, mapPGError(err)
+	}
+	profile.WalletsPerUser = sandboxDistribution(holders, minGroupSize, walletsByUser)
+
+	rows, err := tx.Query(ctx, `
+SELECT chain::text, COUNT(*), percentile_cont($1::float8[]) WITHIN GROUP (ORDER BY balance::float8)
+FROM wallets
+WHERE status <> 'archived'
+GROUP BY chain
+HAVING COUNT(*) >= $2
+ORDER BY chain`, quantiles, minGroupSize)
+	if err != nil {
+		return entities.SandboxProfile{}, mapPGError(err)
+	}
+	profile.Wallets, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (entities.SandboxWalletChain, error) {
+		var (
+			chain    string
+			count    int64
+			balances []float64
+		)
+		if err := row.Scan(&chain, &count, &balances); err != nil {
+			return entities.SandboxWalletChain{}, err
+		}
+		return entities.SandboxWalletChain{
+			Chain:   entities.Chain(chain),
+			Weight:  sandboxShare(count, wallets),
+			Balance: sandboxDistribution(count, minGroupSize, balances),
+		}, nil
+	})
+	if err != nil {
+		return entities.SandboxProfile{}, mapPGError(err)
+	}
+
+	types, err := sandboxCategories(ctx, tx, `
+SELECT chain::text, type::text, COUNT(*)
+FROM transactions
+WHERE created_at >= $1
+GROUP BY chain, type
+HAVING COUNT(*) >= $2`, since, minGroupSize)
+	if err != nil {
+		return entities.SandboxProfile{}, err
+	}
+	statuses, err := sandboxCategories(ctx, tx, `
+SELECT chain::text, status::text, COUNT(*)
+FROM transactions
+WHERE created_at >= $1
+GROUP BY chain, status
+HAVING COUNT(*) >= $2`, since, minGroupSize)
+	if err != nil {
+		return entities.SandboxProfile{}, err
+	}
+
+	rows, err = tx.Query(ctx, `
+SELECT chain::text, COUNT(*),
+	percentile_cont($2::float8[]) WITHIN GROUP (ORDER BY amount::float8),
+	percentile_cont($2::float8[]) WITHIN GROUP (ORDER BY fee::float8)
+FROM transactions
+WHERE created_at >= $1
+GROUP BY chain
+HAVING COUNT(*) >= $3
+ORDER BY chain`, since, quantiles, minGroupSize)
+	if err != nil {
+		return entities.SandboxProfile{}, mapPGError(err)
+	}
+	profile.Transactions, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (entities.SandboxChainActivity, error) {
+		var (
+			chain   string
+			count   int64
+			amounts []float64
+			fees    []float64
+		)
+		if err := row.Scan(&chain, &count, &amounts, &fees); err != nil {
+			return entities.SandboxChainActivity{}, err
+		}
+		return entities.SandboxChainActivity{
+			Chain:    entities.Chain(chain),
+			Amount:   sandboxDistribution(count, minGroupSize, amounts),
+			Fee:      sandboxDistribution(count, minGroupSize, fees),
+			Types:    types[chain],
+			Statuses: statuses[chain],
+		}, nil
+	})
+	if err != nil {
+		return entities.SandboxProfile{}, mapPGError(err)
+	}
+
+	var (
+		activeWallets int64
+		perWallet     []float64
+	)
+	if err := tx.QueryRow(ctx, `
+SELECT COUNT(*), percentile_cont($2::float8[]) WITHIN GROUP (ORDER BY n)
+FROM (
+	SELECT COUNT(*) AS n FROM transactions WHERE created_at >= $1 GROUP BY wallet_id
+) per_wallet`, since, quantiles).Scan(&activeWallets, &perWallet); err != nil {
+		return entities.SandboxProfile{}, mapPGError(err)
+	}
+	profile.ActiveWalletShare = sandboxShare(activeWallets, wallets)
+	profile.TransactionsPerWallet = sandboxDistribution(activeWallets, minGroupSize, perWallet)
+
+	var (
+		swappers int64
+		perUser  []float64
+	)
+	if err := tx.QueryRow(ctx, `
+SELECT COUNT(*), percentile_cont($2::float8[]) WITHIN GROUP (ORDER BY n)
+FROM (
+	SELECT COUNT(*) AS n FROM exchange_operations WHERE created_at >= $1 GROUP BY user_id
+) per_user`, since, quantiles).Scan(&swappers, &perUser); err != nil {
+		return entities.SandboxProfile{}, mapPGError(err)
+	}
+	profile.SwapperShare = sandboxShare(swappers, holders)
+	profile.SwapsPerUser = sandboxDistribution(swappers, minGroupSize, perUser)
+
+	swapStatuses, err := sandboxCategories(ctx, tx, `
+SELECT '', status::text, COUNT(*)
+FROM exchange_operations
+WHERE created_at >= $1
+GROUP BY status
+HAVING COUNT(*) >= $2`, since, minGroupSize)
+	if err != nil {
+		return entities.SandboxProfile{}, err
+	}
+	profile.SwapStatuses = swapStatuses[""]
+
+	var swaps int64
+	if err := tx.QueryRow(ctx, `
+SELECT COUNT(*) FROM exchange_operations WHERE created_at >= $1`, since).Scan(&swaps); err != nil {
+		return entities.SandboxProfile{}, mapPGError(err)
+	}
+	rows, err = tx.Query(ctx, `
+SELECT fw.chain::text, tw.chain::text, COUNT(*),
+	percentile_cont($2::float8[]) WITHIN GROUP (ORDER BY e.from_amount::float8),
+	percentile_cont(0.5) WITHIN GROUP (ORDER BY e.exchange_rate::float8),
+	percentile_cont(0.5) WITHIN GROUP (ORDER BY e.fee_percentage::float8)
+FROM exchange_operations e
+JOIN wallets fw ON fw.id = e.from_wallet_id
+JOIN wallets tw ON tw.id = e.to_wallet_id
+WHERE e.created_at >= $1
+GROUP BY fw.chain, tw.chain
+HAVING COUNT(*) >= $3
+ORDER BY fw.chain, tw.chain`, since, quantiles, minGroupSize)
+	if err != nil {
+		return entities.SandboxProfile{}, mapPGError(err)
+	}
+	profile.SwapPairs, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (entities.SandboxSwapPair, error) {
+		var (
+			fromChain string
+			toChain   string
+			count     int64
+			amounts   []float64
+			rate      float64
+			fee       float64
+		)
+		if err := row.Scan(&fromChain, &toChain, &count, &amounts, &rate, &fee); err != nil {
+			return entities.SandboxSwapPair{}, err
+		}
+		return entities.SandboxSwapPair{
+			FromChain:     entities.Chain(fromChain),
+			ToChain:       entities.Chain(toChain),
+			Weight:        sandboxShare(count, swaps),
+			FromAmount:    sandboxDistribution(count, minGroupSize, amounts),
+			Rate:          roundSignificant(rate, sandboxSignificantDigits),
+			FeePercentage: roundSignificant(fee, sandboxSignificantDigits),
+		}, nil
+	})
+	if err != nil {
+		return entities.SandboxProfile{}, mapPGError(err)
+	}
+
+	r.logger.Info("sandbox profile captured",
+		slog.Int64("wallet_holders", holders),
+		slog.Int("wallet_chains", len(profile.Wallets)),
+		slog.Int("transaction_chains", len(profile.Transactions)),
+		slog.Int("swap_pairs", len(profile.SwapPairs)),
+	)
+	return profile, nil
+}
+
+// sandboxCategories reads rows of (group, value, count) into per-group weights.
+func sandboxCategories(ctx context.Context, tx pgx.Tx, query string, since time.Time, minGroupSize int) (map[string][]entities.SandboxWeight, error) {
+	rows, err := tx.Query(ctx, query, since, minGroupSize)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string][]entities.SandboxWeight)
+	totals := make(map[string]int64)
+	for rows.Next() {
+		var (
+			group string
+			value string
+			count int64
+		)
+		if err := rows.Scan(&group, &value, &count); err != nil {
+			return nil, mapPGError(err)
+		}
+		counts[group] = append(counts[group], entities.SandboxWeight{Value: value, Weight: float64(count)})
+		totals[group] += count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	for group, weights := range counts {
+		for i := range weights {
+			weights[i].Weight = sandboxShare(int64(weights[i].Weight), totals[group])
+		}
+	}
+	return counts, nil
+}
+
+// sandboxDistribution keeps quantiles only for populations of at least minGroupSize.
+func sandboxDistribution(population int64, minGroupSize int, quantiles []float64) entities.SandboxDistribution {
+	if population < int64(minGroupSize) || len(quantiles) == 0 {
+		return entities.SandboxDistribution{}
+	}
+	rounded := make([]float64, len(quantiles))
+	for i, value := range quantiles {
+		rounded[i] = roundSignificant(value, sandboxSignificantDigits)
+	}
+	return entities.SandboxDistribution{Quantiles: rounded}
+}
+
+func sandboxShare(count, total int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return math.Min(1, roundSignificant(float64(count)/float64(total), sandboxSignificantDigits))
+}
+
+func roundSignificant(value float64, digits int) float64 {
+	if value == 0 || math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0
+	}
+	scale := math.Pow(10, float64(digits)-math.Ceil(math.Log10(math.Abs(value))))
+	return math.Round(value*scale) / scale
+}
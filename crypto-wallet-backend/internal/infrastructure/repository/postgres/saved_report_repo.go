@@ -0,0 +1,222 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const savedReportSelectColumns = `
+SELECT
+	id,
+	user_id,
+	name,
+	description,
+	definition,
+	created_at,
+	updated_at
+FROM saved_reports`
+
+var (
+	errSavedReportNilPool   = errors.New("saved report repository: database pool is not configured")
+	errSavedReportNilEntity = errors.New("saved report repository: saved report is required")
+)
+
+// SavedReportRepository persists users' saved report definitions using PostgreSQL.
+type SavedReportRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewSavedReportRepository constructs a SavedReportRepository backed by the provided pool.
+func NewSavedReportRepository(pool *pgxpool.Pool, logger *slog.Logger) *SavedReportRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SavedReportRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// Create stores a new saved report.
+func (r *SavedReportRepository) Create(ctx context.Context, report *entities.SavedReport) error {
+	if r.pool == nil {
+		return errSavedReportNilPool
+	}
+	if report == nil {
+		return errSavedReportNilEntity
+	}
+	if report.ID == uuid.Nil {
+		report.ID = uuid.New()
+	}
+	if report.CreatedAt.IsZero() {
+		report.CreatedAt = time.Now().UTC()
+	}
+	if report.UpdatedAt.IsZero() {
+		report.UpdatedAt = report.CreatedAt
+	}
+
+	definition, err := json.Marshal(report.Definition)
+	if err != nil {
+		return fmt.Errorf("saved report repository: encode definition: %w", err)
+	}
+
+	_, err = r.pool.Exec(ctx, `
+INSERT INTO saved_reports (
+	id,
+	user_id,
+	name,
+	description,
+	definition,
+	created_at,
+	updated_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7)`,
+		report.ID,
+		report.UserID,
+		report.Name,
+		report.Description,
+		definition,
+		report.CreatedAt,
+		report.UpdatedAt,
+	)
+	return mapPGError(err)
+}
+
+// Update replaces the saved report's name, description and definition.
+func (r *SavedReportRepository) Update(ctx context.Context, report *entities.SavedReport) error {
+	if r.pool == nil {
+		return errSavedReportNilPool
+	}
+	if report == nil {
+		return errSavedReportNilEntity
+	}
+
+	definition, err := json.Marshal(report.Definition)
+	if err != nil {
+		return fmt.Errorf("saved report repository: encode definition: %w", err)
+	}
+
+	tag, err := r.pool.Exec(ctx, `
+UPDATE saved_reports
+SET name = $3, description = $4, definition = $5, updated_at = $6
+WHERE id = $1 AND user_id = $2`,
+		report.ID,
+		report.UserID,
+		report.Name,
+		report.Description,
+		definition,
+		report.UpdatedAt,
+	)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+// GetByID returns a saved report regardless of its owner.
+func (r *SavedReportRepository) GetByID(ctx context.Context, id uuid.UUID) (entities.SavedReport, error) {
+	if r.pool == nil {
+		return entities.SavedReport{}, errSavedReportNilPool
+	}
+
+	report, err := scanSavedReport(r.pool.QueryRow(ctx, savedReportSelectColumns+" WHERE id = $1", id))
+	if err != nil {
+		return entities.SavedReport{}, mapPGError(err)
+	}
+	return report, nil
+}
+
+// ListByUser returns the user's saved reports ordered by name.
+func (r *SavedReportRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]entities.SavedReport, error) {
+	if r.pool == nil {
+		return nil, errSavedReportNilPool
+	}
+
+	rows, err := r.pool.Query(ctx, savedReportSelectColumns+" WHERE user_id = $1 ORDER BY name, id", userID)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	results := make([]entities.SavedReport, 0)
+	for rows.Next() {
+		report, err := scanSavedReport(rows)
+		if err != nil {
+			return nil, mapPGError(err)
+		}
+		results = append(results, report)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return results, nil
+}
+
+// CountByUser returns how many reports the user has saved.
+func (r *SavedReportRepository) CountByUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	if r.pool == nil {
+		return 0, errSavedReportNilPool
+	}
+
+	var count int
+	if err := r.pool.QueryRow(ctx, "SELECT COUNT(*) FROM saved_reports WHERE user_id = $1", userID).Scan(&count); err != nil {
+		return 0, mapPGError(err)
+	}
+	return count, nil
+}
+
+// Delete removes the user's saved report.
+func (r *SavedReportRepository) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	if r.pool == nil {
+		return errSavedReportNilPool
+	}
+
+	tag, err := r.pool.Exec(ctx, "DELETE FROM saved_reports WHERE id = $1 AND user_id = $2", id, userID)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+func scanSavedReport(row pgx.Row) (entities.SavedReport, error) {
+	var (
+		report     entities.SavedReport
+		definition []byte
+	)
+
+	if err := row.Scan(
+		&report.ID,
+		&report.UserID,
+		&report.Name,
+		&report.Description,
+		&definition,
+		&report.CreatedAt,
+		&report.UpdatedAt,
+	); err != nil {
+		return entities.SavedReport{}, err
+	}
+
+	report.CreatedAt = report.CreatedAt.UTC()
+	report.UpdatedAt = report.UpdatedAt.UTC()
+	if err := json.Unmarshal(definition, &report.Definition); err != nil {
+		return entities.SavedReport{}, fmt.Errorf("saved report repository: decode definition: %w", err)
+	}
+	return report, nil
+}
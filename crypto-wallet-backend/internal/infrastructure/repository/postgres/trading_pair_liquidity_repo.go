@@ -0,0 +1,457 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+var errLiquidityNilPool = errors.New("trading pair liquidity repository: database pool is not configured")
+
+const liquiditySelectColumns = `pair_id, quote_reserve::text, quote_held::text, base_reserve::text, quote_funded::text, low_threshold::text, created_at, updated_at`
+
+// TradingPairLiquidityRepository persists trading pair liquidity ledgers using PostgreSQL. Each
+// change locks the pair's ledger row, so concurrent holds and settlements cannot both spend the
+// same reserve.
+type TradingPairLiquidityRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewTradingPairLiquidityRepository constructs a TradingPairLiquidityRepository backed by the provided pool.
+func NewTradingPairLiquidityRepository(pool *pgxpool.Pool, logger *slog.Logger) *TradingPairLiquidityRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &TradingPairLiquidityRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// Get returns the pair's ledger.
+func (r *TradingPairLiquidityRepository) Get(ctx context.Context, pairID uuid.UUID) (entities.TradingPairLiquidity, error) {
+	if r.pool == nil {
+		return entities.TradingPairLiquidity{}, errLiquidityNilPool
+	}
+	return scanLiquidity(conn(ctx, r.pool).QueryRow(ctx, `
+SELECT `+liquiditySelectColumns+`
+FROM trading_pair_liquidity
+WHERE pair_id = $1`,
+		pairID,
+	))
+}
+
+// List returns every ledger, ordered by pair.
+func (r *TradingPairLiquidityRepository) List(ctx context.Context) ([]entities.TradingPairLiquidity, error) {
+	if r.pool == nil {
+		return nil, errLiquidityNilPool
+	}
+
+	rows, err := r.pool.Query(ctx, `
+SELECT `+liquiditySelectColumns+`
+FROM trading_pair_liquidity
+ORDER BY pair_id`)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	ledgers := make([]entities.TradingPairLiquidity, 0)
+	for rows.Next() {
+		ledger, err := scanLiquidity(rows)
+		if err != nil {
+			return nil, err
+		}
+		ledgers = append(ledgers, ledger)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return ledgers, nil
+}
+
+// ListEntries returns up to limit of the pair's ledger entries, newest first.
+func (r *TradingPairLiquidityRepository) ListEntries(ctx context.Context, pairID uuid.UUID, limit int) ([]entities.LiquidityEntry, error) {
+	if r.pool == nil {
+		return nil, errLiquidityNilPool
+	}
+	if limit <= 0 {
+		limit = 50
+	}
+
+	rows, err := r.pool.Query(ctx, `
+SELECT id, pair_id, operation_id, kind, quote_delta::text, held_delta::text, base_delta::text, quote_reserve::text, actor, note, created_at
+FROM trading_pair_liquidity_entries
+WHERE pair_id = $1
+ORDER BY created_at DESC, id
+LIMIT $2`,
+		pairID, limit,
+	)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	entries := make([]entities.LiquidityEntry, 0)
+	for rows.Next() {
+		var (
+			entry                                      entities.LiquidityEntry
+			operationID                                *uuid.UUID
+			kind                                       string
+			quoteDelta, heldDelta, baseDelta, reserved string
+		)
+		if err := rows.Scan(&entry.ID, &entry.PairID, &operationID, &kind, &quoteDelta, &heldDelta, &baseDelta, &reserved, &entry.Actor, &entry.Note, &entry.CreatedAt); err != nil {
+			return nil, mapPGError(err)
+		}
+		if operationID != nil {
+			entry.OperationID = *operationID
+		}
+		entry.Kind = entities.LiquidityEntryKind(kind)
+		if entry.QuoteDelta, err = decimal.NewFromString(quoteDelta); err != nil {
+			return nil, err
+		}
+		if entry.HeldDelta, err = decimal.NewFromString(heldDelta); err != nil {
+			return nil, err
+		}
+		if entry.BaseDelta, err = decimal.NewFromString(baseDelta); err != nil {
+			return nil, err
+		}
+		if entry.QuoteReserve, err = decimal.NewFromString(reserved); err != nil {
+			return nil, err
+		}
+		entry.CreatedAt = entry.CreatedAt.UTC()
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return entries, nil
+}
+
+// TopUp adds to the pair's quote reserve, creating the ledger on the first top-up.
+func (r *TradingPairLiquidityRepository) TopUp(ctx context.Context, topUp repositories.LiquidityTopUp) (entities.TradingPairLiquidity, error) {
+	if r.pool == nil {
+		return entities.TradingPairLiquidity{}, errLiquidityNilPool
+	}
+
+	var threshold *string
+	if topUp.LowThreshold != nil {
+		value := topUp.LowThreshold.String()
+		threshold = &value
+	}
+
+	var ledger entities.TradingPairLiquidity
+	err := r.inTx(ctx, func(q querier) error {
+		var err error
+		ledger, err = scanLiquidity(q.QueryRow(ctx, `
+INSERT INTO trading_pair_liquidity (pair_id, quote_reserve, quote_funded, low_threshold, created_at, updated_at)
+VALUES ($1, $2::numeric, $2::numeric, COALESCE($3::numeric, 0), $4, $4)
+ON CONFLICT (pair_id) DO UPDATE SET
+	quote_reserve = trading_pair_liquidity.quote_reserve + EXCLUDED.quote_reserve,
+	quote_funded = trading_pair_liquidity.quote_funded + EXCLUDED.quote_funded,
+	low_threshold = COALESCE($3::numeric, trading_pair_liquidity.low_threshold),
+	updated_at = EXCLUDED.updated_at
+RETURNING `+liquiditySelectColumns,
+			topUp.PairID, topUp.Amount.String(), threshold, topUp.At.UTC(),
+		))
+		if err != nil {
+			return err
+		}
+		return insertLiquidityEntry(ctx, q, entities.LiquidityEntry{
+			PairID:       topUp.PairID,
+			Kind:         entities.LiquidityEntryTopUp,
+			QuoteDelta:   topUp.Amount,
+			QuoteReserve: ledger.QuoteReserve,
+			Actor:        topUp.Actor,
+			Note:         topUp.Note,
+			CreatedAt:    topUp.At,
+		})
+	})
+	if err != nil {
+		return entities.TradingPairLiquidity{}, err
+	}
+	return ledger, nil
+}
+
+// Hold holds amount of the pair's available reserve for the operation.
+func (r *TradingPairLiquidityRepository) Hold(ctx context.Context, pairID, operationID uuid.UUID, amount decimal.Decimal, at time.Time) (entities.TradingPairLiquidity, bool, error) {
+	if r.pool == nil {
+		return entities.TradingPairLiquidity{}, false, errLiquidityNilPool
+	}
+
+	var (
+		ledger entities.TradingPairLiquidity
+		held   bool
+	)
+	err := r.inTx(ctx, func(q querier) error {
+		var err error
+		if ledger, err = lockLiquidity(ctx, q, pairID); err != nil {
+			return err
+		}
+
+		var exists bool
+		if err := q.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM trading_pair_liquidity_holds WHERE operation_id = $1)`, operationID).Scan(&exists); err != nil {
+			return mapPGError(err)
+		}
+		if exists {
+			held = true
+			return nil
+		}
+		if ledger.Available().LessThan(amount) {
+			return nil
+		}
+
+		if _, err := q.Exec(ctx, `
+INSERT INTO trading_pair_liquidity_holds (operation_id, pair_id, amount, created_at)
+VALUES ($1, $2, $3::numeric, $4)`,
+			operationID, pairID, amount.String(), at.UTC(),
+		); err != nil {
+			return mapPGError(err)
+		}
+		ledger.QuoteHeld = ledger.QuoteHeld.Add(amount)
+		if err := updateLiquidity(ctx, q, &ledger, at); err != nil {
+			return err
+		}
+		held = true
+		return insertLiquidityEntry(ctx, q, entities.LiquidityEntry{
+			PairID:       pairID,
+			OperationID:  operationID,
+			Kind:         entities.LiquidityEntryHold,
+			HeldDelta:    amount,
+			QuoteReserve: ledger.QuoteReserve,
+			CreatedAt:    at,
+		})
+	})
+	if err != nil {
+		return entities.TradingPairLiquidity{}, false, err
+	}
+	return ledger, held, nil
+}
+
+// Release returns the operation's hold to its pair's available reserve.
+func (r *TradingPairLiquidityRepository) Release(ctx context.Context, operationID uuid.UUID, at time.Time) (entities.TradingPairLiquidity, bool, error) {
+	if r.pool == nil {
+		return entities.TradingPairLiquidity{}, false, errLiquidityNilPool
+	}
+
+	var (
+		ledger   entities.TradingPairLiquidity
+		released bool
+	)
+	err := r.inTx(ctx, func(q querier) error {
+		var pairID uuid.UUID
+		err := q.QueryRow(ctx, `SELECT pair_id FROM trading_pair_liquidity_holds WHERE operation_id = $1`, operationID).Scan(&pairID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return mapPGError(err)
+		}
+
+		// Lock the ledger before taking the hold, in the order holds and settlements lock them
+		if ledger, err = lockLiquidity(ctx, q, pairID); err != nil {
+			return err
+		}
+		amount, ok, err := takeHold(ctx, q, operationID)
+		if err != nil || !ok {
+			return err
+		}
+
+		ledger.QuoteHeld = ledger.QuoteHeld.Sub(amount)
+		if err := updateLiquidity(ctx, q, &ledger, at); err != nil {
+			return err
+		}
+		released = true
+		return insertLiquidityEntry(ctx, q, entities.LiquidityEntry{
+			PairID:       pairID,
+			OperationID:  operationID,
+			Kind:         entities.LiquidityEntryRelease,
+			HeldDelta:    amount.Neg(),
+			QuoteReserve: ledger.QuoteReserve,
+			CreatedAt:    at,
+		})
+	})
+	if err != nil {
+		return entities.TradingPairLiquidity{}, false, err
+	}
+	return ledger, released, nil
+}
+
+// Settle pays an executed exchange out of the pair's reserve.
+func (r *TradingPairLiquidityRepository) Settle(ctx context.Context, pairID, operationID uuid.UUID, paidOut, received decimal.Decimal, at time.Time) (entities.TradingPairLiquidity, bool, error) {
+	if r.pool == nil {
+		return entities.TradingPairLiquidity{}, false, errLiquidityNilPool
+	}
+
+	var (
+		ledger  entities.TradingPairLiquidity
+		settled bool
+	)
+	err := r.inTx(ctx, func(q querier) error {
+		var err error
+		if ledger, err = lockLiquidity(ctx, q, pairID); err != nil {
+			return err
+		}
+
+		held := decimal.Zero
+		var raw string
+		err = q.QueryRow(ctx, `SELECT amount::text FROM trading_pair_liquidity_holds WHERE operation_id = $1 AND pair_id = $2`, operationID, pairID).Scan(&raw)
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+		case err != nil:
+			return mapPGError(err)
+		default:
+			if held, err = decimal.NewFromString(raw); err != nil {
+				return err
+			}
+		}
+		// The hold counts towards what is available to this operation
+		if ledger.Available().Add(held).LessThan(paidOut) {
+			return nil
+		}
+
+		if held.IsPositive() {
+			if _, _, err := takeHold(ctx, q, operationID); err != nil {
+				return err
+			}
+		}
+		ledger.QuoteReserve = ledger.QuoteReserve.Sub(paidOut)
+		ledger.QuoteHeld = ledger.QuoteHeld.Sub(held)
+		ledger.BaseReserve = ledger.BaseReserve.Add(received)
+		if err := updateLiquidity(ctx, q, &ledger, at); err != nil {
+			return err
+		}
+		settled = true
+		return insertLiquidityEntry(ctx, q, entities.LiquidityEntry{
+			PairID:       pairID,
+			OperationID:  operationID,
+			Kind:         entities.LiquidityEntrySettle,
+			QuoteDelta:   paidOut.Neg(),
+			HeldDelta:    held.Neg(),
+			BaseDelta:    received,
+			QuoteReserve: ledger.QuoteReserve,
+			CreatedAt:    at,
+		})
+	})
+	if err != nil {
+		return entities.TradingPairLiquidity{}, false, err
+	}
+	return ledger, settled, nil
+}
+
+// inTx runs fn in the unit of work ctx carries, or in a transaction of its own outside one.
+func (r *TradingPairLiquidityRepository) inTx(ctx context.Context, fn func(q querier) error) error {
+	if tx, ok := conn(ctx, r.pool).(pgx.Tx); ok {
+		return fn(tx)
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return mapPGError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("trading pair liquidity repository: commit: %w", mapPGError(err))
+	}
+	return nil
+}
+
+func lockLiquidity(ctx context.Context, q querier, pairID uuid.UUID) (entities.TradingPairLiquidity, error) {
+	return scanLiquidity(q.QueryRow(ctx, `
+SELECT `+liquiditySelectColumns+`
+FROM trading_pair_liquidity
+WHERE pair_id = $1
+FOR UPDATE`,
+		pairID,
+	))
+}
+
+// takeHold deletes the operation's hold and returns its amount; ok is false when there was none.
+func takeHold(ctx context.Context, q querier, operationID uuid.UUID) (decimal.Decimal, bool, error) {
+	var raw string
+	err := q.QueryRow(ctx, `DELETE FROM trading_pair_liquidity_holds WHERE operation_id = $1 RETURNING amount::text`, operationID).Scan(&raw)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return decimal.Zero, false, nil
+	}
+	if err != nil {
+		return decimal.Zero, false, mapPGError(err)
+	}
+	amount, err := decimal.NewFromString(raw)
+	if err != nil {
+		return decimal.Zero, false, err
+	}
+	return amount, true, nil
+}
+
+func updateLiquidity(ctx context.Context, q querier, ledger *entities.TradingPairLiquidity, at time.Time) error {
+	ledger.UpdatedAt = at.UTC()
+	_, err := q.Exec(ctx, `
+UPDATE trading_pair_liquidity SET
+	quote_reserve = $2::numeric,
+	quote_held = $3::numeric,
+	base_reserve = $4::numeric,
+	updated_at = $5
+WHERE pair_id = $1`,
+		ledger.PairID, ledger.QuoteReserve.String(), ledger.QuoteHeld.String(), ledger.BaseReserve.String(), ledger.UpdatedAt,
+	)
+	return mapPGError(err)
+}
+
+func insertLiquidityEntry(ctx context.Context, q querier, entry entities.LiquidityEntry) error {
+	var operationID *uuid.UUID
+	if entry.OperationID != uuid.Nil {
+		operationID = &entry.OperationID
+	}
+	_, err := q.Exec(ctx, `
+INSERT INTO trading_pair_liquidity_entries (pair_id, operation_id, kind, quote_delta, held_delta, base_delta, quote_reserve, actor, note, created_at)
+VALUES ($1, $2, $3, $4::numeric, $5::numeric, $6::numeric, $7::numeric, $8, $9, $10)`,
+		entry.PairID, operationID, string(entry.Kind),
+		entry.QuoteDelta.String(), entry.HeldDelta.String(), entry.BaseDelta.String(), entry.QuoteReserve.String(),
+		entry.Actor, entry.Note, entry.CreatedAt.UTC(),
+	)
+	return mapPGError(err)
+}
+
+func scanLiquidity(row pgx.Row) (entities.TradingPairLiquidity, error) {
+	var (
+		ledger                                        entities.TradingPairLiquidity
+		reserve, held, baseReserve, funded, threshold string
+	)
+	if err := row.Scan(&ledger.PairID, &reserve, &held, &baseReserve, &funded, &threshold, &ledger.CreatedAt, &ledger.UpdatedAt); err != nil {
+		return entities.TradingPairLiquidity{}, mapPGError(err)
+	}
+
+	var err error
+	for _, field := range []struct {
+		raw    string
+		target *decimal.Decimal
+	}{
+		{reserve, &ledger.QuoteReserve},
+		{held, &ledger.QuoteHeld},
+		{baseReserve, &ledger.BaseReserve},
+		{funded, &ledger.QuoteFunded},
+		{threshold, &ledger.LowThreshold},
+	} {
+		if *field.target, err = decimal.NewFromString(field.raw); err != nil {
+			return entities.TradingPairLiquidity{}, err
+		}
+	}
+	ledger.CreatedAt = ledger.CreatedAt.UTC()
+	ledger.UpdatedAt = ledger.UpdatedAt.UTC()
+	return ledger, nil
+}
@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const transactionNoteColumns = `
+	user_id,
+	transaction_id,
+	ciphertext,
+	key_id,
+	device_id,
+	version,
+	revision,
+	deleted,
+	created_at,
+	updated_at`
+
+var errTransactionNoteNilPool = errors.New("transaction note repository: database pool is not configured")
+
+// TransactionNoteRepository persists encrypted transaction notes using PostgreSQL.
+type TransactionNoteRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewTransactionNoteRepository constructs a TransactionNoteRepository backed by the provided pool.
+func NewTransactionNoteRepository(pool *pgxpool.Pool, logger *slog.Logger) *TransactionNoteRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &TransactionNoteRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// GetNote returns the user's note on the transaction, including tombstones.
+func (r *TransactionNoteRepository) GetNote(ctx context.Context, userID, transactionID uuid.UUID) (entities.TransactionNote, error) {
+	if r.pool == nil {
+		return entities.TransactionNote{}, errTransactionNoteNilPool
+	}
+
+	row := r.pool.QueryRow(ctx,
+		"SELECT"+transactionNoteColumns+" FROM transaction_notes WHERE user_id = $1 AND transaction_id = $2",
+		userID, transactionID,
+	)
+	return scanTransactionNote(row)
+}
+
+// SaveNote creates or updates the note if nobody wrote it since baseVersion.
+func (r *TransactionNoteRepository) SaveNote(ctx context.Context, note entities.TransactionNote, baseVersion int64) (entities.TransactionNote, error) {
+	if r.pool == nil {
+		return entities.TransactionNote{}, errTransactionNoteNilPool
+	}
+
+	var ciphertext []byte
+	var keyID *string
+	if !note.Deleted {
+		ciphertext = note.Ciphertext
+		keyID = &note.KeyID
+	}
+	var deviceID *string
+	if note.DeviceID != "" {
+		deviceID = &note.DeviceID
+	}
+
+	var row pgx.Row
+	if baseVersion == 0 {
+		row = r.pool.QueryRow(ctx, `
+INSERT INTO transaction_notes (user_id, transaction_id, ciphertext, key_id, device_id, deleted)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (user_id, transaction_id) DO NOTHING
+RETURNING`+transactionNoteColumns,
+			note.UserID, note.TransactionID, ciphertext, keyID, deviceID, note.Deleted,
+		)
+	} else {
+		row = r.pool.QueryRow(ctx, `
+UPDATE transaction_notes SET
+	ciphertext = $3,
+	key_id = $4,
+	device_id = $5,
+	deleted = $6,
+	version = version + 1,
+	revision = nextval('transaction_note_revision_seq'),
+	updated_at = NOW()
+WHERE user_id = $1 AND transaction_id = $2 AND version = $7
+RETURNING`+transactionNoteColumns,
+			note.UserID, note.TransactionID, ciphertext, keyID, deviceID, note.Deleted, baseVersion,
+		)
+	}
+
+	saved, err := scanTransactionNote(row)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return entities.TransactionNote{}, repositories.ErrConflict
+	}
+	return saved, err
+}
+
+// ListNoteChanges returns notes written after the revision in revision order.
+func (r *TransactionNoteRepository) ListNoteChanges(ctx context.Context, userID uuid.UUID, afterRevision int64, limit int) ([]entities.TransactionNote, error) {
+	if r.pool == nil {
+		return nil, errTransactionNoteNilPool
+	}
+	if limit <= 0 {
+		limit = 100
+	}
+
+	rows, err := r.pool.Query(ctx,
+		"SELECT"+transactionNoteColumns+" FROM transaction_notes WHERE user_id = $1 AND revision > $2 ORDER BY revision ASC LIMIT $3",
+		userID, afterRevision, limit,
+	)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	notes := make([]entities.TransactionNote, 0)
+	for rows.Next() {
+		note, err := scanTransactionNote(rows)
+		if err != nil {
+			return nil, err
+		}
+		notes = append(notes, note)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return notes, nil
+}
+
+func scanTransactionNote(row pgx.Row) (entities.TransactionNote, error) {
+	var (
+		note     entities.TransactionNote
+		keyID    *string
+		deviceID *string
+	)
+
+	if err := row.Scan(
+		&note.UserID,
+		&note.TransactionID,
+		&note.Ciphertext,
+		&keyID,
+		&deviceID,
+		&note.Version,
+		&note.Revision,
+		&note.Deleted,
+		&note.CreatedAt,
+		&note.UpdatedAt,
+	); err != nil {
+		return entities.TransactionNote{}, mapPGError(err)
+	}
+
+	if keyID != nil {
+		note.KeyID = *keyID
+	}
+	if deviceID != nil {
+		note.DeviceID = *deviceID
+	}
+	return note, nil
+}
@@ -41,6 +41,30 @@ SELECT
 FROM transactions
 `
 
+const insertTransactionQuery = `
+INSERT INTO transactions (
+    id,
+    wallet_id,
+    chain,
+    tx_hash,
+    type,
+    amount,
+    fee,
+    status,
+    from_address,
+    to_address,
+    block_number,
+    confirmations,
+    error_message,
+    metadata,
+    created_at,
+    confirmed_at,
+    updated_at
+) VALUES (
+    $1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17
+)
+`
+
 // PostgresTransactionRepository persists transactions in PostgreSQL.
 type PostgresTransactionRepository struct {
     pool *pgxpool.Pool
@@ -57,9 +81,10 @@ func (r *PostgresTransactionRepository) GetByID(ctx context.Context, id uuid.UUI
     return scanTransaction(row)
 }
 
-// GetByHash retrieves a transaction by chain/hash combination.
+// GetByHash retrieves a transaction by chain/hash combination. A transfer between two platform
+// wallets has a send and a receive row under the same hash; the older one, the send, is returned.
 func (r *PostgresTransactionRepository) GetByHash(ctx context.Context, chain entities.Chain, hash string) (entities.Transaction, error) {
-    row := r.pool.QueryRow(ctx, selectTransactionBase+" WHERE chain = $1 AND tx_hash = $2", chain, hash)
+    row := r.pool.QueryRow(ctx, selectTransactionBase+" WHERE chain = $1 AND tx_hash = $2 ORDER BY created_at LIMIT 1", chain, hash)
     return scanTransaction(row)
 }
 
@@ -77,9 +102,15 @@ func (r *PostgresTransactionRepository) ListByWallet(ctx context.Context, wallet
         sortDirection = "DESC"
     }
 
-    query := fmt.Sprintf("%s WHERE wallet_id = $1 ORDER BY %s %s LIMIT $2 OFFSET $3", selectTransactionBase, sortColumn, sortDirection)
+    tail, args, exhausted, err := r.transactionPage(ctx, []string{"wallet_id = $1"}, []any{walletID}, sortColumn, sortDirection, options)
+    if err != nil {
+        return nil, err
+    }
+    if exhausted {
+        return nil, nil
+    }
 
-    rows, err := r.pool.Query(ctx, query, walletID, options.Limit, options.Offset)
+    rows, err := r.pool.Query(ctx, selectTransactionBase+tail, args...)
     if err != nil {
         return nil, err
     }
@@ -109,45 +140,73 @@ func (r *PostgresTransactionRepository) ListWithFilters(ctx context.Context, fil
 
     opts = opts.WithDefaults()
 
-    conditions := make([]string, 0, 6)
-    args := make([]any, 0, 6)
+    conditions, args := transactionFilterConditions(filter)
 
-    if filter.WalletID != nil {
-        conditions = append(conditions, fmt.Sprintf("wallet_id = $%d", len(args)+1))
-        args = append(args, *filter.WalletID)
+    whereClause := ""
+    if len(conditions) > 0 {
+        whereClause = " WHERE " + strings.Join(conditions, " AND ")
     }
 
-    if filter.Chain != nil && *filter.Chain != "" {
-        conditions = append(conditions, fmt.Sprintf("chain = $%d", len(args)+1))
-        args = append(args, string(*filter.Chain))
+    countQuery := "SELECT COUNT(*) FROM transactions" + whereClause
+    var total int64
+    if err := r.pool.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+        return nil, 0, err
     }
 
-    if filter.Type != nil && *filter.Type != "" {
-        conditions = append(conditions, fmt.Sprintf("type = $%d", len(args)+1))
-        args = append(args, string(*filter.Type))
+    sortColumn := sanitizeTransactionSortColumn(opts.SortBy)
+    sortOrder := strings.ToUpper(string(opts.SortOrder))
+    if sortOrder != "ASC" {
+        sortOrder = "DESC"
     }
 
-    if filter.Status != nil && *filter.Status != "" {
-        conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)+1))
-        args = append(args, string(*filter.Status))
+    tail, queryArgs, exhausted, err := r.transactionPage(ctx, conditions, args, sortColumn, sortOrder, opts)
+    if err != nil {
+        return nil, 0, err
+    }
+    if exhausted {
+        return []entities.Transaction{}, total, nil
     }
 
-    if filter.StartDate != nil {
-        conditions = append(conditions, fmt.Sprintf("created_at >= $%d", len(args)+1))
-        args = append(args, filter.StartDate.UTC())
+    rows, err := r.pool.Query(ctx, selectTransactionBase+tail, queryArgs...)
+    if err != nil {
+        return nil, 0, err
     }
+    defer rows.Close()
 
-    if filter.EndDate != nil {
-        conditions = append(conditions, fmt.Sprintf("created_at <= $%d", len(args)+1))
-        args = append(args, filter.EndDate.UTC())
+    results := make([]entities.Transaction, 0, opts.Limit)
+    for rows.Next() {
+        tx, scanErr := scanTransaction(rows)
+        if scanErr != nil {
+            return nil, 0, scanErr
+        }
+        results = append(results, tx)
     }
 
-    whereClause := ""
-    if len(conditions) > 0 {
-        whereClause = " WHERE " + strings.Join(conditions, " AND ")
+    if rows.Err() != nil {
+        return nil, 0, rows.Err()
     }
 
-    countQuery := "SELECT COUNT(*) FROM transactions" + whereClause
+    return results, total, nil
+}
+
+// Search returns a page of transactions matching the search. Every criterion is bound as a query
+// parameter; only the fixed conditions and placeholders are formatted into the SQL.
+func (r *PostgresTransactionRepository) Search(ctx context.Context, search repositories.TransactionSearch, opts repositories.ListOptions) ([]entities.Transaction, int64, error) {
+    if r.pool == nil {
+        return nil, 0, errors.New("transaction repository: database pool is not configured")
+    }
+
+    opts = opts.WithDefaults()
+
+    conditions, args, err := transactionSearchConditions(search)
+    if err != nil {
+        return nil, 0, err
+    }
+
+    countQuery := "SELECT COUNT(*) FROM transactions"
+    if len(conditions) > 0 {
+        countQuery += " WHERE " + strings.Join(conditions, " AND ")
+    }
     var total int64
     if err := r.pool.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
         return nil, 0, err
@@ -159,13 +218,15 @@ func (r *PostgresTransactionRepository) ListWithFilters(ctx context.Context, fil
         sortOrder = "DESC"
     }
 
-    limitPlaceholder := len(args) + 1
-    offsetPlaceholder := len(args) + 2
-
-    query := fmt.Sprintf("%s%s ORDER BY %s %s LIMIT $%d OFFSET $%d", selectTransactionBase, whereClause, sortColumn, sortOrder, limitPlaceholder, offsetPlaceholder)
-    queryArgs := append(args, opts.Limit, opts.Offset)
+    tail, queryArgs, exhausted, err := r.transactionPage(ctx, conditions, args, sortColumn, sortOrder, opts)
+    if err != nil {
+        return nil, 0, err
+    }
+    if exhausted {
+        return []entities.Transaction{}, total, nil
+    }
 
-    rows, err := r.pool.Query(ctx, query, queryArgs...)
+    rows, err := r.pool.Query(ctx, selectTransactionBase+tail, queryArgs...)
     if err != nil {
         return nil, 0, err
     }
@@ -187,6 +248,47 @@ func (r *PostgresTransactionRepository) ListWithFilters(ctx context.Context, fil
     return results, total, nil
 }
 
+// ListChronological returns up to limit matching transactions in ascending (created_at, id) order
+// after the cursor, reading only the requested page.
+func (r *PostgresTransactionRepository) ListChronological(ctx context.Context, filter repositories.TransactionFilter, after *repositories.PageCursor, limit int) ([]entities.Transaction, error) {
+    if r.pool == nil {
+        return nil, errors.New("transaction repository: database pool is not configured")
+    }
+    if limit <= 0 {
+        limit = repositories.DefaultListLimit
+    }
+
+    conditions, args := transactionFilterConditions(filter)
+    tail, queryArgs, _, err := r.transactionPage(ctx, conditions, args, "created_at", "ASC", repositories.ListOptions{
+        Limit: limit,
+        After: after,
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    rows, err := r.pool.Query(ctx, selectTransactionBase+tail, queryArgs...)
+    if err != nil {
+        return nil, err
+    }
+    defer rows.Close()
+
+    results := make([]entities.Transaction, 0, limit)
+    for rows.Next() {
+        tx, scanErr := scanTransaction(rows)
+        if scanErr != nil {
+            return nil, scanErr
+        }
+        results = append(results, tx)
+    }
+
+    if rows.Err() != nil {
+        return nil, rows.Err()
+    }
+
+    return results, nil
+}
+
 // ListPending returns transactions awaiting confirmations for monitoring workers.
 func (r *PostgresTransactionRepository) ListPending(ctx context.Context, chain entities.Chain, limit int) ([]entities.Transaction, error) {
     if limit <= 0 {
@@ -215,62 +317,56 @@ func (r *PostgresTransactionRepository) ListPending(ctx context.Context, chain e
     return results, nil
 }
 
+// ListRecentByWallets returns the newest transactions of each wallet, at most perWallet apiece.
+func (r *PostgresTransactionRepository) ListRecentByWallets(ctx context.Context, walletIDs []uuid.UUID, perWallet int) (map[uuid.UUID][]entities.Transaction, error) {
+    results := make(map[uuid.UUID][]entities.Transaction, len(walletIDs))
+    if len(walletIDs) == 0 {
+        return results, nil
+    }
+    if perWallet <= 0 {
+        perWallet = 20
+    }
+
+    query := selectTransactionBase + ` WHERE id IN (
+    SELECT id FROM (
+        SELECT id, ROW_NUMBER() OVER (PARTITION BY wallet_id ORDER BY created_at DESC, id DESC) AS rn
+        FROM transactions
+        WHERE wallet_id = ANY($1)
+    ) ranked
+    WHERE rn <= $2
+) ORDER BY wallet_id, created_at DESC, id DESC`
+    rows, err := r.pool.Query(ctx, query, walletIDs, perWallet)
+    if err != nil {
+        return nil, err
+    }
+    defer rows.Close()
+
+    for rows.Next() {
+        tx, scanErr := scanTransaction(rows)
+        if scanErr != nil {
+            return nil, scanErr
+        }
+        results[tx.GetWalletID()] = append(results[tx.GetWalletID()], tx)
+    }
+    if rows.Err() != nil {
+        return nil, rows.Err()
+    }
+
+    return results, nil
+}
+
 // Create inserts a new transaction record.
 func (r *PostgresTransactionRepository) Create(ctx context.Context, tx *entities.TransactionEntity) error {
     if tx == nil {
         return errors.New("transaction entity is nil")
     }
 
-    metadataJSON, err := json.Marshal(tx.GetMetadata())
+    args, err := transactionInsertArgs(tx)
     if err != nil {
         return err
     }
 
-    query := `
-INSERT INTO transactions (
-    id,
-    wallet_id,
-    chain,
-    tx_hash,
-    type,
-    amount,
-    fee,
-    status,
-    from_address,
-    to_address,
-    block_number,
-    confirmations,
-    error_message,
-    metadata,
-    created_at,
-    confirmed_at,
-    updated_at
-) VALUES (
-    $1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17
-)
-`
-
-    _, err = r.pool.Exec(
-        ctx,
-        query,
-        tx.GetID(),
-        tx.GetWalletID(),
-        tx.GetChain(),
-        tx.GetHash(),
-        tx.GetType(),
-        tx.GetAmount().String(),
-        tx.GetFee().String(),
-        tx.GetStatus(),
-        tx.GetFromAddress(),
-        tx.GetToAddress(),
-        nullableUint64(tx.GetBlockNumber()),
-        tx.GetConfirmations(),
-        tx.GetErrorMessage(),
-        metadataJSON,
-        tx.GetCreatedAt(),
-        tx.GetConfirmedAt(),
-        tx.GetUpdatedAt(),
-    )
+    _, err = r.pool.Exec(ctx, insertTransactionQuery, args...)
     if err != nil {
         var pgErr *pgconn.PgError
         if errors.As(err, &pgErr) && pgErr.Code == "23505" {
@@ -426,6 +522,34 @@ func scanTransaction(row pgx.Row) (entities.Transaction, error) {
     return entities.HydrateTransactionEntity(params), nil
 }
 
+// transactionInsertArgs returns the positional arguments for insertTransactionQuery.
+func transactionInsertArgs(tx *entities.TransactionEntity) ([]any, error) {
+    metadataJSON, err := json.Marshal(tx.GetMetadata())
+    if err != nil {
+        return nil, err
+    }
+
+    return []any{
+        tx.GetID(),
+        tx.GetWalletID(),
+        tx.GetChain(),
+        tx.GetHash(),
+        tx.GetType(),
+        tx.GetAmount().String(),
+        tx.GetFee().String(),
+        tx.GetStatus(),
+        tx.GetFromAddress(),
+        tx.GetToAddress(),
+        nullableUint64(tx.GetBlockNumber()),
+        tx.GetConfirmations(),
+        tx.GetErrorMessage(),
+        metadataJSON,
+        tx.GetCreatedAt(),
+        tx.GetConfirmedAt(),
+        tx.GetUpdatedAt(),
+    }, nil
+}
+
 func nullableUint64(value *uint64) any {
     if value == nil {
         return nil
@@ -449,6 +573,148 @@ func nullableTimePtr(value sql.NullTime) *time.Time {
     return &t
 }
 
+// transactionFilterConditions translates a filter into WHERE conditions and their arguments.
+func transactionFilterConditions(filter repositories.TransactionFilter) ([]string, []any) {
+    conditions := make([]string, 0, 6)
+    args := make([]any, 0, 6)
+
+    if filter.WalletID != nil {
+        conditions = append(conditions, fmt.Sprintf("wallet_id = $%d", len(args)+1))
+        args = append(args, *filter.WalletID)
+    }
+
+    if len(filter.WalletIDs) > 0 {
+        conditions = append(conditions, fmt.Sprintf("wallet_id = ANY($%d)", len(args)+1))
+        args = append(args, filter.WalletIDs)
+    }
+
+    if filter.Chain != nil && *filter.Chain != "" {
+        conditions = append(conditions, fmt.Sprintf("chain = $%d", len(args)+1))
+        args = append(args, string(*filter.Chain))
+    }
+
+    if filter.Type != nil && *filter.Type != "" {
+        conditions = append(conditions, fmt.Sprintf("type = $%d", len(args)+1))
+        args = append(args, string(*filter.Type))
+    }
+
+    if filter.Status != nil && *filter.Status != "" {
+        conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)+1))
+        args = append(args, string(*filter.Status))
+    }
+
+    if filter.StartDate != nil {
+        conditions = append(conditions, fmt.Sprintf("created_at >= $%d", len(args)+1))
+        args = append(args, filter.StartDate.UTC())
+    }
+
+    if filter.EndDate != nil {
+        conditions = append(conditions, fmt.Sprintf("created_at <= $%d", len(args)+1))
+        args = append(args, filter.EndDate.UTC())
+    }
+
+    return conditions, args
+}
+
+// transactionSearchConditions extends the filter conditions with the search criteria. The hash,
+// address and memo conditions match the expressions indexed in migration 039.
+func transactionSearchConditions(search repositories.TransactionSearch) ([]string, []any, error) {
+    conditions, args := transactionFilterConditions(search.TransactionFilter)
+    placeholder := func(value any) string {
+        args = append(args, value)
+        return fmt.Sprintf("$%d", len(args))
+    }
+
+    if prefix := strings.TrimSpace(search.HashPrefix); prefix != "" {
+        conditions = append(conditions, "lower(tx_hash) LIKE "+placeholder(escapeLike(strings.ToLower(prefix))+"%"))
+    }
+
+    if address := strings.TrimSpace(search.Address); address != "" {
+        param := placeholder(strings.ToLower(address))
+        conditions = append(conditions, fmt.Sprintf("(lower(from_address) = %s OR lower(to_address) = %s)", param, param))
+    }
+
+    if search.MinAmount != nil {
+        conditions = append(conditions, "amount >= "+placeholder(search.MinAmount.String())+"::numeric")
+    }
+    if search.MaxAmount != nil {
+        conditions = append(conditions, "amount <= "+placeholder(search.MaxAmount.String())+"::numeric")
+    }
+
+    if len(search.MetadataKeys) > 0 {
+        conditions = append(conditions, "metadata ?& "+placeholder(search.MetadataKeys))
+    }
+    if len(search.Metadata) > 0 {
+        contained, err := json.Marshal(search.Metadata)
+        if err != nil {
+            return nil, nil, fmt.Errorf("transaction repository: encode metadata filter: %w", err)
+        }
+        conditions = append(conditions, "metadata @> "+placeholder(string(contained))+"::jsonb")
+    }
+
+    for _, word := range strings.Fields(search.Text) {
+        conditions = append(conditions, "(metadata->>'memo') ILIKE "+placeholder("%"+escapeLike(word)+"%"))
+    }
+
+    return conditions, args, nil
+}
+
+// escapeLike escapes the LIKE wildcards in a user-supplied pattern fragment.
+func escapeLike(value string) string {
+    return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
+// transactionPage builds the WHERE, ORDER BY and LIMIT tail of a transaction listing. Listings
+// sorted by created_at page by keyset when a cursor is supplied or the offset is deep; a deep
+// offset is first resolved to a cursor by walking only the (created_at, id) index columns, so the
+// full rows before the page are never read. exhausted reports that the offset is past the end.
+func (r *PostgresTransactionRepository) transactionPage(ctx context.Context, conditions []string, args []any, sortColumn, sortOrder string, opts repositories.ListOptions) (string, []any, bool, error) {
+    conditions = conditions[:len(conditions):len(conditions)]
+    args = args[:len(args):len(args)]
+
+    where := func(conds []string) string {
+        if len(conds) == 0 {
+            return ""
+        }
+        return " WHERE " + strings.Join(conds, " AND ")
+    }
+
+    if sortColumn != "created_at" {
+        tail := fmt.Sprintf("%s ORDER BY %s %s LIMIT $%d OFFSET $%d", where(conditions), sortColumn, sortOrder, len(args)+1, len(args)+2)
+        return tail, append(args, opts.Limit, opts.Offset), false, nil
+    }
+
+    order := fmt.Sprintf(" ORDER BY created_at %s, id %s", sortOrder, sortOrder)
+
+    after := opts.After
+    if after == nil && opts.Offset >= repositories.KeysetOffsetThreshold {
+        boundaryQuery := fmt.Sprintf("SELECT created_at, id FROM transactions%s%s OFFSET $%d LIMIT 1", where(conditions), order, len(args)+1)
+        var boundary repositories.PageCursor
+        err := r.pool.QueryRow(ctx, boundaryQuery, append(args, opts.Offset-1)...).Scan(&boundary.CreatedAt, &boundary.ID)
+        if errors.Is(err, pgx.ErrNoRows) {
+            return "", nil, true, nil
+        }
+        if err != nil {
+            return "", nil, false, err
+        }
+        after = &boundary
+    }
+
+    if after == nil {
+        tail := fmt.Sprintf("%s%s LIMIT $%d OFFSET $%d", where(conditions), order, len(args)+1, len(args)+2)
+        return tail, append(args, opts.Limit, opts.Offset), false, nil
+    }
+
+    comparator := "<"
+    if sortOrder == "ASC" {
+        comparator = ">"
+    }
+    conditions = append(conditions, fmt.Sprintf("(created_at, id) %s ($%d, $%d)", comparator, len(args)+1, len(args)+2))
+    args = append(args, after.CreatedAt.UTC(), after.ID)
+    tail := fmt.Sprintf("%s%s LIMIT $%d", where(conditions), order, len(args)+1)
+    return tail, append(args, opts.Limit), false, nil
+}
+
 func sanitizeTransactionSortColumn(sortBy string) string {
     switch strings.ToLower(strings.TrimSpace(sortBy)) {
     case "amount":
@@ -0,0 +1,224 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const travelRuleSelectColumns = `
+SELECT
+	id,
+	user_id,
+	wallet_id,
+	transaction_id,
+	chain,
+	to_address,
+	amount::text,
+	amount_usd::text,
+	threshold_usd::text,
+	originator_encrypted,
+	beneficiary_encrypted,
+	beneficiary_vasp,
+	status,
+	provider_reference,
+	created_at,
+	transmitted_at
+FROM travel_rule_records`
+
+var (
+	errTravelRuleNilPool   = errors.New("travel rule repository: database pool is not configured")
+	errTravelRuleNilRecord = errors.New("travel rule repository: record is required")
+)
+
+// TravelRuleRepository persists travel-rule records in the KYC database.
+type TravelRuleRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewTravelRuleRepository constructs a TravelRuleRepository backed by the provided pool.
+func NewTravelRuleRepository(pool *pgxpool.Pool, logger *slog.Logger) *TravelRuleRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &TravelRuleRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// Create inserts a record, assigning its ID and creation time when unset.
+func (r *TravelRuleRepository) Create(ctx context.Context, record *entities.TravelRuleRecord) error {
+	if r.pool == nil {
+		return errTravelRuleNilPool
+	}
+	if record == nil {
+		return errTravelRuleNilRecord
+	}
+	if record.ID == uuid.Nil {
+		record.ID = uuid.New()
+	}
+	if record.CreatedAt.IsZero() {
+		record.CreatedAt = time.Now().UTC()
+	}
+
+	_, err := r.pool.Exec(ctx, `
+INSERT INTO travel_rule_records (
+	id,
+	user_id,
+	wallet_id,
+	transaction_id,
+	chain,
+	to_address,
+	amount,
+	amount_usd,
+	threshold_usd,
+	originator_encrypted,
+	beneficiary_encrypted,
+	beneficiary_vasp,
+	status,
+	provider_reference,
+	created_at,
+	transmitted_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16)`,
+		record.ID,
+		record.UserID,
+		record.WalletID,
+		record.TransactionID,
+		string(record.Chain),
+		record.ToAddress,
+		record.Amount.String(),
+		record.AmountUSD.StringFixed(2),
+		record.ThresholdUSD.StringFixed(2),
+		record.OriginatorEncrypted,
+		record.BeneficiaryEncrypted,
+		nullIfEmpty(record.BeneficiaryVASP),
+		string(record.Status),
+		nullIfEmpty(record.ProviderReference),
+		record.CreatedAt,
+		record.TransmittedAt,
+	)
+	return mapPGError(err)
+}
+
+// Update saves the record's transmission outcome and transaction link.
+func (r *TravelRuleRepository) Update(ctx context.Context, record *entities.TravelRuleRecord) error {
+	if r.pool == nil {
+		return errTravelRuleNilPool
+	}
+	if record == nil {
+		return errTravelRuleNilRecord
+	}
+
+	cmd, err := r.pool.Exec(ctx, `
+UPDATE travel_rule_records
+SET transaction_id = $2,
+	status = $3,
+	provider_reference = $4,
+	transmitted_at = $5
+WHERE id = $1`,
+		record.ID,
+		record.TransactionID,
+		string(record.Status),
+		nullIfEmpty(record.ProviderReference),
+		record.TransmittedAt,
+	)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+// GetByTransactionID returns the record linked to a broadcast transaction.
+func (r *TravelRuleRepository) GetByTransactionID(ctx context.Context, transactionID uuid.UUID) (*entities.TravelRuleRecord, error) {
+	if r.pool == nil {
+		return nil, errTravelRuleNilPool
+	}
+
+	record, err := scanTravelRuleRecord(r.pool.QueryRow(ctx, travelRuleSelectColumns+" WHERE transaction_id = $1", transactionID))
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	return record, nil
+}
+
+func scanTravelRuleRecord(row pgx.Row) (*entities.TravelRuleRecord, error) {
+	var (
+		record        entities.TravelRuleRecord
+		transactionID pgtype.UUID
+		chainValue    string
+		amount        string
+		amountUSD     string
+		thresholdUSD  string
+		vasp          pgtype.Text
+		statusValue   string
+		reference     pgtype.Text
+		transmittedAt pgtype.Timestamptz
+	)
+
+	if err := row.Scan(
+		&record.ID,
+		&record.UserID,
+		&record.WalletID,
+		&transactionID,
+		&chainValue,
+		&record.ToAddress,
+		&amount,
+		&amountUSD,
+		&thresholdUSD,
+		&record.OriginatorEncrypted,
+		&record.BeneficiaryEncrypted,
+		&vasp,
+		&statusValue,
+		&reference,
+		&record.CreatedAt,
+		&transmittedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if record.Amount, err = decimal.NewFromString(amount); err != nil {
+		return nil, fmt.Errorf("travel rule repository: parse amount: %w", err)
+	}
+	if record.AmountUSD, err = decimal.NewFromString(amountUSD); err != nil {
+		return nil, fmt.Errorf("travel rule repository: parse amount usd: %w", err)
+	}
+	if record.ThresholdUSD, err = decimal.NewFromString(thresholdUSD); err != nil {
+		return nil, fmt.Errorf("travel rule repository: parse threshold usd: %w", err)
+	}
+
+	record.Chain = entities.Chain(chainValue)
+	record.Status = entities.TravelRuleStatus(statusValue)
+	if transactionID.Valid {
+		id := uuid.UUID(transactionID.Bytes)
+		record.TransactionID = &id
+	}
+	if vasp.Valid {
+		record.BeneficiaryVASP = vasp.String
+	}
+	if reference.Valid {
+		record.ProviderReference = reference.String
+	}
+	if transmittedAt.Valid {
+		t := transmittedAt.Time.UTC()
+		record.TransmittedAt = &t
+	}
+	record.CreatedAt = record.CreatedAt.UTC()
+	return &record, nil
+}
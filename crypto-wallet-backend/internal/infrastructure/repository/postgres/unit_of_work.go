@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var errUnitOfWorkNilPool = errors.New("unit of work: database pool is not configured")
+
+// querier is satisfied by both the pool and a transaction.
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type unitOfWorkKey struct{}
+
+// unitOfWorkTx is the transaction a context carries, with the pool it was started on so
+// repositories of other databases do not join it.
+type unitOfWorkTx struct {
+	pool *pgxpool.Pool
+	tx   pgx.Tx
+}
+
+// UnitOfWork implements repositories.UnitOfWork over one pool.
+type UnitOfWork struct {
+	pool *pgxpool.Pool
+}
+
+// NewUnitOfWork constructs a UnitOfWork on the pool.
+func NewUnitOfWork(pool *pgxpool.Pool) *UnitOfWork {
+	return &UnitOfWork{pool: pool}
+}
+
+// Do runs fn in a transaction that repositories on the same pool join through ctx.
+func (u *UnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if u.pool == nil {
+		return errUnitOfWorkNilPool
+	}
+	if current, ok := ctx.Value(unitOfWorkKey{}).(unitOfWorkTx); ok && current.pool == u.pool {
+		return fn(ctx)
+	}
+
+	tx, err := u.pool.Begin(ctx)
+	if err != nil {
+		return mapPGError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(context.WithValue(ctx, unitOfWorkKey{}, unitOfWorkTx{pool: u.pool, tx: tx})); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("unit of work: commit: %w", mapPGError(err))
+	}
+	return nil
+}
+
+// conn returns the transaction ctx carries for pool, or the pool itself outside a unit of work.
+func conn(ctx context.Context, pool *pgxpool.Pool) querier {
+	if current, ok := ctx.Value(unitOfWorkKey{}).(unitOfWorkTx); ok && current.pool == pool {
+		return current.tx
+	}
+	return pool
+}
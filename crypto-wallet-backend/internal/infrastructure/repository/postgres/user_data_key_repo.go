@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+var errUserDataKeyNilPool = errors.New("user data key repository: database pool is not configured")
+
+// UserDataKeyRepository persists wrapped per-user data keys using PostgreSQL.
+type UserDataKeyRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewUserDataKeyRepository constructs a UserDataKeyRepository backed by the provided pool.
+func NewUserDataKeyRepository(pool *pgxpool.Pool, logger *slog.Logger) *UserDataKeyRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &UserDataKeyRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// Get returns the user's wrapped key, or ErrNotFound when the user has none.
+func (r *UserDataKeyRepository) Get(ctx context.Context, userID uuid.UUID) (string, error) {
+	if r.pool == nil {
+		return "", errUserDataKeyNilPool
+	}
+	var wrapped string
+	if err := r.pool.QueryRow(ctx, "SELECT wrapped_key FROM user_data_keys WHERE user_id = $1", userID).Scan(&wrapped); err != nil {
+		return "", mapPGError(err)
+	}
+	return wrapped, nil
+}
+
+// Create stores the user's wrapped key. Returns ErrDuplicate when the user already has one.
+func (r *UserDataKeyRepository) Create(ctx context.Context, userID uuid.UUID, wrappedKey string) error {
+	if r.pool == nil {
+		return errUserDataKeyNilPool
+	}
+	_, err := r.pool.Exec(ctx, "INSERT INTO user_data_keys (user_id, wrapped_key) VALUES ($1, $2)", userID, wrappedKey)
+	return mapPGError(err)
+}
+
+// Delete destroys the user's key. Returns ErrNotFound when the user has none.
+func (r *UserDataKeyRepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	if r.pool == nil {
+		return errUserDataKeyNilPool
+	}
+	cmd, err := r.pool.Exec(ctx, "DELETE FROM user_data_keys WHERE user_id = $1", userID)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+// List returns up to limit keys of users with IDs greater than after, ordered by user ID.
+func (r *UserDataKeyRepository) List(ctx context.Context, after uuid.UUID, limit int) ([]repositories.UserDataKey, error) {
+	if r.pool == nil {
+		return nil, errUserDataKeyNilPool
+	}
+	if limit <= 0 {
+		limit = repositories.DefaultListLimit
+	}
+
+	rows, err := r.pool.Query(ctx, "SELECT user_id, wrapped_key FROM user_data_keys WHERE user_id > $1 ORDER BY user_id LIMIT $2", after, limit)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	keys := make([]repositories.UserDataKey, 0)
+	for rows.Next() {
+		var key repositories.UserDataKey
+		if err := rows.Scan(&key.UserID, &key.WrappedKey); err != nil {
+			return nil, mapPGError(err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, mapPGError(rows.Err())
+}
+
+// Replace swaps the wrapped key only if nobody changed it in the meantime.
+func (r *UserDataKeyRepository) Replace(ctx context.Context, userID uuid.UUID, current, replacement string) error {
+	if r.pool == nil {
+		return errUserDataKeyNilPool
+	}
+
+	cmd, err := r.pool.Exec(ctx, "UPDATE user_data_keys SET wrapped_key = $3 WHERE user_id = $1 AND wrapped_key = $2", userID, current, replacement)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() > 0 {
+		return nil
+	}
+
+	var exists bool
+	if err := r.pool.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM user_data_keys WHERE user_id = $1)", userID).Scan(&exists); err != nil {
+		return mapPGError(err)
+	}
+	if !exists {
+		return repositories.ErrNotFound
+	}
+	return repositories.ErrConflict
+}
@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+var errUserDeviceNilPool = errors.New("user device repository: database pool is not configured")
+
+// UserDeviceRepository records the devices users sign in from using PostgreSQL.
+type UserDeviceRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewUserDeviceRepository constructs a UserDeviceRepository backed by the provided pool.
+func NewUserDeviceRepository(pool *pgxpool.Pool, logger *slog.Logger) *UserDeviceRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &UserDeviceRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// CountByUser returns how many devices the user has signed in from.
+func (r *UserDeviceRepository) CountByUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	if r.pool == nil {
+		return 0, errUserDeviceNilPool
+	}
+
+	var count int
+	if err := r.pool.QueryRow(ctx, "SELECT COUNT(*) FROM user_devices WHERE user_id = $1", userID).Scan(&count); err != nil {
+		return 0, mapPGError(err)
+	}
+	return count, nil
+}
+
+// Touch upserts the device. created is true when the row was inserted rather than updated, which
+// PostgreSQL reports through xmax being zero.
+func (r *UserDeviceRepository) Touch(ctx context.Context, device entities.UserDevice) (bool, error) {
+	if r.pool == nil {
+		return false, errUserDeviceNilPool
+	}
+
+	seenAt := device.LastSeenAt
+	if seenAt.IsZero() {
+		seenAt = time.Now()
+	}
+
+	var created bool
+	err := r.pool.QueryRow(ctx, `
+INSERT INTO user_devices (
+	user_id,
+	fingerprint,
+	user_agent,
+	ip_address,
+	first_seen_at,
+	last_seen_at
+) VALUES ($1,$2,$3,$4,$5,$5)
+ON CONFLICT (user_id, fingerprint) DO UPDATE
+SET ip_address = EXCLUDED.ip_address,
+	last_seen_at = EXCLUDED.last_seen_at
+RETURNING (xmax = 0)`,
+		device.UserID,
+		device.Fingerprint,
+		device.UserAgent,
+		device.IPAddress,
+		seenAt.UTC(),
+	).Scan(&created)
+	if err != nil {
+		return false, mapPGError(err)
+	}
+	return created, nil
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+var errUserPreferencesNilPool = errors.New("user preferences repository: database pool is not configured")
+
+// UserPreferencesRepository persists user preferences as JSONB documents using PostgreSQL.
+type UserPreferencesRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewUserPreferencesRepository constructs a UserPreferencesRepository backed by the provided pool.
+func NewUserPreferencesRepository(pool *pgxpool.Pool, logger *slog.Logger) *UserPreferencesRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &UserPreferencesRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// GetByUser returns the user's preferences, or the defaults when they have saved none.
+func (r *UserPreferencesRepository) GetByUser(ctx context.Context, userID uuid.UUID) (entities.UserPreferences, error) {
+	if r.pool == nil {
+		return entities.UserPreferences{}, errUserPreferencesNilPool
+	}
+
+	var (
+		document    []byte
+		preferences entities.UserPreferences
+	)
+	err := r.pool.QueryRow(ctx, "SELECT preferences, updated_at FROM user_preferences WHERE user_id = $1", userID).
+		Scan(&document, &preferences.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entities.UserPreferences{UserID: userID}, nil
+	}
+	if err != nil {
+		return entities.UserPreferences{}, mapPGError(err)
+	}
+	if err := json.Unmarshal(document, &preferences); err != nil {
+		return entities.UserPreferences{}, fmt.Errorf("user preferences repository: decode preferences: %w", err)
+	}
+	preferences.UserID = userID
+	return preferences, nil
+}
+
+// Save creates or replaces the user's preferences document.
+func (r *UserPreferencesRepository) Save(ctx context.Context, preferences entities.UserPreferences) error {
+	if r.pool == nil {
+		return errUserPreferencesNilPool
+	}
+	if err := preferences.Validate(); err != nil {
+		return err
+	}
+
+	document, err := json.Marshal(preferences)
+	if err != nil {
+		return fmt.Errorf("user preferences repository: encode preferences: %w", err)
+	}
+
+	_, err = r.pool.Exec(ctx, `
+INSERT INTO user_preferences (user_id, preferences, updated_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id) DO UPDATE SET
+	preferences = EXCLUDED.preferences,
+	updated_at = EXCLUDED.updated_at`,
+		preferences.UserID,
+		document,
+		preferences.UpdatedAt.UTC(),
+	)
+	return mapPGError(err)
+}
@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+var errUserRoleNilPool = errors.New("user role repository: database pool is not configured")
+
+// UserRoleRepository reads user roles from PostgreSQL.
+type UserRoleRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewUserRoleRepository constructs a UserRoleRepository backed by the provided pool.
+func NewUserRoleRepository(pool *pgxpool.Pool, logger *slog.Logger) *UserRoleRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &UserRoleRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// ListRoles returns the user's roles in name order.
+func (r *UserRoleRepository) ListRoles(ctx context.Context, userID uuid.UUID) ([]entities.UserRole, error) {
+	if r.pool == nil {
+		return nil, errUserRoleNilPool
+	}
+
+	rows, err := r.pool.Query(ctx, "SELECT role FROM user_roles WHERE user_id = $1 ORDER BY role", userID)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	roles := make([]entities.UserRole, 0)
+	for rows.Next() {
+		var role string
+		if err := rows.Scan(&role); err != nil {
+			return nil, mapPGError(err)
+		}
+		roles = append(roles, entities.UserRole(role))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return roles, nil
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// userSegmentQuery builds the WHERE clause selecting a segment's users from "users u". Arguments
+// are appended after any the caller has already bound, so the clause can be embedded in larger
+// statements.
+type userSegmentQuery struct {
+	conditions []string
+	args       []any
+}
+
+func newUserSegmentQuery(scope repositories.SegmentScope, args ...any) *userSegmentQuery {
+	q := &userSegmentQuery{args: args}
+	segment := scope.Segment
+
+	statuses := make([]string, 0, len(segment.EffectiveStatuses()))
+	for _, status := range segment.EffectiveStatuses() {
+		statuses = append(statuses, string(status))
+	}
+	q.add("u.status::text = ANY(%s)", statuses)
+
+	if scope.Restricted {
+		if len(scope.UserIDs) == 0 {
+			q.conditions = append(q.conditions, "FALSE")
+		} else {
+			q.add("u.id = ANY(%s)", scope.UserIDs)
+		}
+	}
+	if len(segment.PreferredCurrencies) > 0 {
+		currencies := make([]string, 0, len(segment.PreferredCurrencies))
+		for _, currency := range segment.PreferredCurrencies {
+			currencies = append(currencies, string(currency))
+		}
+		q.add("u.preferred_currency::text = ANY(%s)", currencies)
+	}
+	if len(segment.Chains) > 0 {
+		chains := make([]string, 0, len(segment.Chains))
+		for _, chain := range segment.Chains {
+			chains = append(chains, string(chain))
+		}
+		q.add("EXISTS (SELECT 1 FROM wallets w WHERE w.user_id = u.id AND w.chain::text = ANY(%s))", chains)
+	}
+	if segment.EmailVerified != nil {
+		q.add("u.email_verified = %s", *segment.EmailVerified)
+	}
+	if segment.TwoFactorEnabled != nil {
+		q.add("u.two_factor_enabled = %s", *segment.TwoFactorEnabled)
+	}
+	if segment.CreatedAfter != nil {
+		q.add("u.created_at >= %s", segment.CreatedAfter.UTC())
+	}
+	if segment.CreatedBefore != nil {
+		q.add("u.created_at < %s", segment.CreatedBefore.UTC())
+	}
+	if segment.LastLoginAfter != nil {
+		q.add("u.last_login_at >= %s", segment.LastLoginAfter.UTC())
+	}
+	if segment.LastLoginBefore != nil {
+		// Users who never logged in have been inactive for longer than any cutoff.
+		q.add("(u.last_login_at IS NULL OR u.last_login_at < %s)", segment.LastLoginBefore.UTC())
+	}
+
+	return q
+}
+
+// add binds value as the next parameter and records the condition, whose %s is the placeholder.
+func (q *userSegmentQuery) add(condition string, value any) {
+	q.args = append(q.args, value)
+	q.conditions = append(q.conditions, fmt.Sprintf(condition, fmt.Sprintf("$%d", len(q.args))))
+}
+
+// where returns the clause without the WHERE keyword; it is never empty.
+func (q *userSegmentQuery) where() string {
+	return strings.Join(q.conditions, " AND ")
+}
@@ -0,0 +1,199 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+var errWalletDormancyNilPool = errors.New("wallet dormancy repository: database pool is not configured")
+
+// walletActivityTypes are the customer-initiated transaction types that count as wallet activity.
+const walletActivityTypes = `('send', 'p2p_send', 'swap_in', 'swap_out')`
+
+const walletActivitySelect = `
+SELECT
+	w.id,
+	w.user_id,
+	w.chain,
+	w.status,
+	w.created_at,
+	t.last_transaction_at,
+	u.last_login_at,
+	w.reactivated_at,
+	w.dormant_since,
+	w.dormancy_reminded_at
+FROM wallets w
+JOIN users u ON u.id = w.user_id
+LEFT JOIN LATERAL (
+	SELECT MAX(created_at) AS last_transaction_at
+	FROM transactions
+	WHERE wallet_id = w.id AND type IN ` + walletActivityTypes + `
+) t ON TRUE`
+
+// walletInactiveSince matches wallets with no activity at or after $1, spelled out per source so
+// the transaction lookup only runs for wallets whose owner has not signed in since.
+const walletInactiveSince = `
+	w.created_at < $1
+	AND (w.reactivated_at IS NULL OR w.reactivated_at < $1)
+	AND (u.last_login_at IS NULL OR u.last_login_at < $1)
+	AND NOT EXISTS (
+		SELECT 1 FROM transactions x
+		WHERE x.wallet_id = w.id AND x.type IN ` + walletActivityTypes + ` AND x.created_at >= $1
+	)`
+
+// WalletDormancyRepository persists wallet dormancy state using PostgreSQL.
+type WalletDormancyRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewWalletDormancyRepository constructs a WalletDormancyRepository backed by the provided pool.
+func NewWalletDormancyRepository(pool *pgxpool.Pool, logger *slog.Logger) *WalletDormancyRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &WalletDormancyRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// GetActivity returns the wallet's activity, or ErrNotFound.
+func (r *WalletDormancyRepository) GetActivity(ctx context.Context, walletID uuid.UUID) (entities.WalletActivity, error) {
+	if r.pool == nil {
+		return entities.WalletActivity{}, errWalletDormancyNilPool
+	}
+	row := r.pool.QueryRow(ctx, walletActivitySelect+`
+WHERE w.id = $1`, walletID)
+	activity, err := scanWalletActivity(row)
+	if err != nil {
+		return entities.WalletActivity{}, mapPGError(err)
+	}
+	return activity, nil
+}
+
+// ListInactive returns active wallets with no activity since the given time.
+func (r *WalletDormancyRepository) ListInactive(ctx context.Context, since time.Time, after uuid.UUID, limit int) ([]entities.WalletActivity, error) {
+	if r.pool == nil {
+		return nil, errWalletDormancyNilPool
+	}
+	if limit <= 0 {
+		limit = 100
+	}
+
+	rows, err := r.pool.Query(ctx, walletActivitySelect+`
+WHERE w.status = 'active'
+	AND w.id > $2
+	AND`+walletInactiveSince+`
+ORDER BY w.id
+LIMIT $3`, since.UTC(), after, limit)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	var activities []entities.WalletActivity
+	for rows.Next() {
+		activity, err := scanWalletActivity(rows)
+		if err != nil {
+			return nil, mapPGError(err)
+		}
+		activities = append(activities, activity)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return activities, nil
+}
+
+// MarkReminded records the reminder time on the wallets.
+func (r *WalletDormancyRepository) MarkReminded(ctx context.Context, walletIDs []uuid.UUID, at time.Time) error {
+	if r.pool == nil {
+		return errWalletDormancyNilPool
+	}
+	if len(walletIDs) == 0 {
+		return nil
+	}
+	_, err := r.pool.Exec(ctx, `
+UPDATE wallets
+SET dormancy_reminded_at = $2
+WHERE id = ANY($1)`, walletIDs, at.UTC())
+	return mapPGError(err)
+}
+
+// MarkDormant moves the wallet to dormant unless it was used or reactivated since the scan.
+func (r *WalletDormancyRepository) MarkDormant(ctx context.Context, walletID uuid.UUID, since, at time.Time) (bool, error) {
+	if r.pool == nil {
+		return false, errWalletDormancyNilPool
+	}
+	tag, err := r.pool.Exec(ctx, `
+UPDATE wallets w
+SET status = 'dormant', dormant_since = $3, updated_at = $3
+FROM users u
+WHERE u.id = w.user_id
+	AND w.id = $2
+	AND w.status = 'active'
+	AND`+walletInactiveSince, since.UTC(), walletID, at.UTC())
+	if err != nil {
+		return false, mapPGError(err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// Reactivate returns a dormant wallet to active and clears its dormancy state.
+func (r *WalletDormancyRepository) Reactivate(ctx context.Context, walletID uuid.UUID, at time.Time) (bool, error) {
+	if r.pool == nil {
+		return false, errWalletDormancyNilPool
+	}
+	tag, err := r.pool.Exec(ctx, `
+UPDATE wallets
+SET status = 'active',
+	reactivated_at = $2,
+	dormant_since = NULL,
+	dormancy_reminded_at = NULL,
+	updated_at = $2
+WHERE id = $1 AND status = 'dormant'`, walletID, at.UTC())
+	if err != nil {
+		return false, mapPGError(err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func scanWalletActivity(row pgx.Row) (entities.WalletActivity, error) {
+	var (
+		activity entities.WalletActivity
+		chain    string
+		status   string
+	)
+	if err := row.Scan(
+		&activity.WalletID,
+		&activity.UserID,
+		&chain,
+		&status,
+		&activity.CreatedAt,
+		&activity.LastTransactionAt,
+		&activity.LastLoginAt,
+		&activity.ReactivatedAt,
+		&activity.DormantSince,
+		&activity.RemindedAt,
+	); err != nil {
+		return entities.WalletActivity{}, err
+	}
+	activity.Chain = entities.Chain(chain)
+	activity.Status = entities.WalletStatus(status)
+	activity.CreatedAt = activity.CreatedAt.UTC()
+	for _, at := range []*time.Time{activity.LastTransactionAt, activity.LastLoginAt, activity.ReactivatedAt, activity.DormantSince, activity.RemindedAt} {
+		if at != nil {
+			*at = at.UTC()
+		}
+	}
+	return activity, nil
+}
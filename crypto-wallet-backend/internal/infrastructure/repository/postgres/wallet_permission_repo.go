@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const walletPermissionSelectColumns = `
+SELECT
+	wallet_id,
+	user_id,
+	permissions,
+	granted_by,
+	created_at,
+	updated_at
+FROM wallet_permissions`
+
+var errWalletPermissionNilPool = errors.New("wallet permission repository: database pool is not configured")
+
+// WalletPermissionRepository persists member permissions on shared wallets using PostgreSQL.
+type WalletPermissionRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewWalletPermissionRepository constructs a WalletPermissionRepository backed by the provided pool.
+func NewWalletPermissionRepository(pool *pgxpool.Pool, logger *slog.Logger) *WalletPermissionRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &WalletPermissionRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// Get returns the user's grant on the wallet or ErrNotFound.
+func (r *WalletPermissionRepository) Get(ctx context.Context, walletID, userID uuid.UUID) (entities.WalletPermissionGrant, error) {
+	if r.pool == nil {
+		return entities.WalletPermissionGrant{}, errWalletPermissionNilPool
+	}
+
+	row := r.pool.QueryRow(ctx, walletPermissionSelectColumns+" WHERE wallet_id = $1 AND user_id = $2", walletID, userID)
+	return scanWalletPermission(row)
+}
+
+// ListByWallet returns every grant on the wallet, oldest first.
+func (r *WalletPermissionRepository) ListByWallet(ctx context.Context, walletID uuid.UUID) ([]entities.WalletPermissionGrant, error) {
+	if r.pool == nil {
+		return nil, errWalletPermissionNilPool
+	}
+
+	rows, err := r.pool.Query(ctx, walletPermissionSelectColumns+" WHERE wallet_id = $1 ORDER BY created_at ASC", walletID)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	defer rows.Close()
+
+	grants := make([]entities.WalletPermissionGrant, 0)
+	for rows.Next() {
+		grant, err := scanWalletPermission(rows)
+		if err != nil {
+			return nil, err
+		}
+		grants = append(grants, grant)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return grants, nil
+}
+
+// Upsert creates the grant or replaces its permissions.
+func (r *WalletPermissionRepository) Upsert(ctx context.Context, grant entities.WalletPermissionGrant) error {
+	if r.pool == nil {
+		return errWalletPermissionNilPool
+	}
+
+	now := time.Now().UTC()
+	createdAt := grant.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = now
+	}
+	updatedAt := grant.UpdatedAt
+	if updatedAt.IsZero() {
+		updatedAt = now
+	}
+
+	permissions := make([]string, len(grant.Permissions))
+	for i, permission := range grant.Permissions {
+		permissions[i] = string(permission)
+	}
+
+	_, err := r.pool.Exec(ctx, `
+INSERT INTO wallet_permissions (
+	wallet_id,
+	user_id,
+	permissions,
+	granted_by,
+	created_at,
+	updated_at
+) VALUES ($1,$2,$3,$4,$5,$6)
+ON CONFLICT (wallet_id, user_id) DO UPDATE SET
+	permissions = EXCLUDED.permissions,
+	granted_by = EXCLUDED.granted_by,
+	updated_at = EXCLUDED.updated_at`,
+		grant.WalletID,
+		grant.UserID,
+		permissions,
+		grant.GrantedBy,
+		createdAt,
+		updatedAt,
+	)
+	return mapPGError(err)
+}
+
+// Delete removes the user's grant on the wallet.
+func (r *WalletPermissionRepository) Delete(ctx context.Context, walletID, userID uuid.UUID) error {
+	if r.pool == nil {
+		return errWalletPermissionNilPool
+	}
+
+	cmd, err := r.pool.Exec(ctx, "DELETE FROM wallet_permissions WHERE wallet_id = $1 AND user_id = $2", walletID, userID)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+func scanWalletPermission(row pgx.Row) (entities.WalletPermissionGrant, error) {
+	var (
+		grant       entities.WalletPermissionGrant
+		permissions []string
+	)
+
+	if err := row.Scan(
+		&grant.WalletID,
+		&grant.UserID,
+		&permissions,
+		&grant.GrantedBy,
+		&grant.CreatedAt,
+		&grant.UpdatedAt,
+	); err != nil {
+		return entities.WalletPermissionGrant{}, mapPGError(err)
+	}
+
+	grant.Permissions = make([]entities.WalletPermission, 0, len(permissions))
+	for _, permission := range permissions {
+		grant.Permissions = append(grant.Permissions, entities.WalletPermission(permission))
+	}
+	return grant, nil
+}
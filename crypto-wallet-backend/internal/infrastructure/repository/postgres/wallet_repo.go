@@ -31,6 +31,7 @@ SELECT
 	balance,
 	balance_updated_at,
 	status,
+	asset_id,
 	created_at,
 	updated_at
 FROM wallets`
@@ -63,7 +64,22 @@ func (r *WalletRepository) GetByID(ctx context.Context, id uuid.UUID) (entities.
 		return nil, errNilPool
 	}
 
-	row := r.pool.QueryRow(ctx, walletSelectColumns+" WHERE id = $1", id)
+	row := conn(ctx, r.pool).QueryRow(ctx, walletSelectColumns+" WHERE id = $1", id)
+	wallet, err := r.scanWallet(row)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	return wallet, nil
+}
+
+// GetByIDForUpdate returns the wallet and locks its row until the enclosing unit of work ends.
+// Outside a unit of work the lock is released as soon as the row is read.
+func (r *WalletRepository) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (entities.Wallet, error) {
+	if r.pool == nil {
+		return nil, errNilPool
+	}
+
+	row := conn(ctx, r.pool).QueryRow(ctx, walletSelectColumns+" WHERE id = $1 FOR UPDATE", id)
 	wallet, err := r.scanWallet(row)
 	if err != nil {
 		return nil, mapPGError(err)
@@ -144,6 +160,86 @@ func (r *WalletRepository) ListByUser(ctx context.Context, userID uuid.UUID, fil
 	return results, nil
 }
 
+// ListByChain returns a keyset page of the chain's non-archived wallets ordered by ID.
+func (r *WalletRepository) ListByChain(ctx context.Context, chain entities.Chain, after uuid.UUID, limit int) ([]entities.Wallet, error) {
+	if r.pool == nil {
+		return nil, errNilPool
+	}
+	if limit <= 0 {
+		limit = repositories.DefaultListLimit
+	}
+
+	rows, err := r.pool.Query(ctx,
+		walletSelectColumns+" WHERE chain = $1 AND status <> $2 AND id > $3 ORDER BY id LIMIT $4",
+		string(chain), string(entities.WalletStatusArchived), after, limit)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	return r.collectWallets(rows)
+}
+
+// SampleByChain returns a random selection of the chain's non-archived wallets.
+func (r *WalletRepository) SampleByChain(ctx context.Context, chain entities.Chain, limit int) ([]entities.Wallet, error) {
+	if r.pool == nil {
+		return nil, errNilPool
+	}
+	if limit <= 0 {
+		limit = repositories.DefaultListLimit
+	}
+
+	rows, err := r.pool.Query(ctx,
+		walletSelectColumns+" WHERE chain = $1 AND status <> $2 ORDER BY random() LIMIT $3",
+		string(chain), string(entities.WalletStatusArchived), limit)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	return r.collectWallets(rows)
+}
+
+// ListWithEncryptedKeys pages through wallets holding an encrypted private key, HD wallets excluded.
+func (r *WalletRepository) ListWithEncryptedKeys(ctx context.Context, after uuid.UUID, limit int) ([]entities.Wallet, error) {
+	if r.pool == nil {
+		return nil, errNilPool
+	}
+	if limit <= 0 {
+		limit = repositories.DefaultListLimit
+	}
+
+	rows, err := r.pool.Query(ctx,
+		walletSelectColumns+" WHERE encrypted_private_key <> '' AND id > $1 ORDER BY id LIMIT $2",
+		after, limit)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	return r.collectWallets(rows)
+}
+
+// ReplaceEncryptedKey swaps the encrypted private key only if nobody changed it in the meantime.
+func (r *WalletRepository) ReplaceEncryptedKey(ctx context.Context, id uuid.UUID, current, replacement string) error {
+	if r.pool == nil {
+		return errNilPool
+	}
+
+	cmd, err := r.pool.Exec(ctx,
+		"UPDATE wallets SET encrypted_private_key = $3 WHERE id = $1 AND encrypted_private_key = $2",
+		id, current, replacement)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() > 0 {
+		return nil
+	}
+
+	var exists bool
+	if err := r.pool.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM wallets WHERE id = $1)", id).Scan(&exists); err != nil {
+		return mapPGError(err)
+	}
+	if !exists {
+		return repositories.ErrNotFound
+	}
+	return repositories.ErrConflict
+}
+
 // Create persists the supplied wallet entity.
 func (r *WalletRepository) Create(ctx context.Context, wallet *entities.WalletEntity) error {
 	if r.pool == nil {
@@ -166,9 +262,10 @@ INSERT INTO wallets (
 	balance_updated_at,
 	status,
 	created_at,
-	updated_at
+	updated_at,
+	asset_id
 ) VALUES (
-	$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+	$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
 )`
 
 	balanceStr := wallet.GetBalance().String()
@@ -190,6 +287,7 @@ INSERT INTO wallets (
 		string(wallet.GetStatus()),
 		wallet.GetCreatedAt().UTC(),
 		wallet.GetUpdatedAt().UTC(),
+		wallet.GetAssetID(),
 	)
 	if err != nil {
 		return mapPGError(err)
@@ -224,7 +322,7 @@ WHERE id = $1`
 		balanceUpdatedAt = ts.UTC()
 	}
 
-	cmd, err := r.pool.Exec(ctx, query,
+	cmd, err := conn(ctx, r.pool).Exec(ctx, query,
 		wallet.GetID(),
 		wallet.GetEncryptedPrivateKey(),
 		nullIfEmpty(wallet.GetDerivationPath()),
@@ -274,6 +372,7 @@ func (r *WalletRepository) scanWallet(row pgx.Row) (entities.Wallet, error) {
 		balanceNumeric     string
 		balanceUpdatedAt   pgtype.Timestamptz
 		statusValue        string
+		assetID            uuid.UUID
 		createdAt          time.Time
 		updatedAt          time.Time
 	)
@@ -289,6 +388,7 @@ func (r *WalletRepository) scanWallet(row pgx.Row) (entities.Wallet, error) {
 		&balanceNumeric,
 		&balanceUpdatedAt,
 		&statusValue,
+		&assetID,
 		&createdAt,
 		&updatedAt,
 	)
@@ -325,6 +425,7 @@ func (r *WalletRepository) scanWallet(row pgx.Row) (entities.Wallet, error) {
 		ID:                  id,
 		UserID:              userID,
 		Chain:               entities.Chain(chainValue),
+		AssetID:             assetID,
 		Address:             address,
 		EncryptedPrivateKey: encryptedKey,
 		DerivationPath:      derivationPath,
@@ -339,6 +440,23 @@ func (r *WalletRepository) scanWallet(row pgx.Row) (entities.Wallet, error) {
 	return wallet, nil
 }
 
+func (r *WalletRepository) collectWallets(rows pgx.Rows) ([]entities.Wallet, error) {
+	defer rows.Close()
+
+	results := make([]entities.Wallet, 0)
+	for rows.Next() {
+		wallet, err := r.scanWallet(rows)
+		if err != nil {
+			return nil, mapPGError(err)
+		}
+		results = append(results, wallet)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+	return results, nil
+}
+
 func sanitizeWalletSortColumn(sortBy string) string {
 	switch strings.ToLower(strings.TrimSpace(sortBy)) {
 	case "label":
@@ -0,0 +1,207 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const webhookEndpointSelectColumns = `
+SELECT
+	id,
+	user_id,
+	url,
+	description,
+	secret_encrypted,
+	active,
+	created_at,
+	updated_at
+FROM webhook_endpoints`
+
+var (
+	errWebhookNilPool     = errors.New("webhook endpoint repository: database pool is not configured")
+	errWebhookNilEndpoint = errors.New("webhook endpoint repository: endpoint entity is required")
+)
+
+// WebhookEndpointRepository persists third-party webhook endpoints using PostgreSQL.
+type WebhookEndpointRepository struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewWebhookEndpointRepository constructs a WebhookEndpointRepository backed by the provided pool.
+func NewWebhookEndpointRepository(pool *pgxpool.Pool, logger *slog.Logger) *WebhookEndpointRepository {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &WebhookEndpointRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// Create stores a newly registered endpoint.
+func (r *WebhookEndpointRepository) Create(ctx context.Context, endpoint *entities.WebhookEndpointEntity) error {
+	if r.pool == nil {
+		return errWebhookNilPool
+	}
+	if endpoint == nil {
+		return errWebhookNilEndpoint
+	}
+
+	_, err := r.pool.Exec(ctx, `
+INSERT INTO webhook_endpoints (
+	id,
+	user_id,
+	url,
+	description,
+	secret_encrypted,
+	active,
+	created_at,
+	updated_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8)`,
+		endpoint.GetID(),
+		endpoint.GetUserID(),
+		endpoint.GetURL(),
+		nullIfEmpty(endpoint.GetDescription()),
+		endpoint.GetEncryptedSecret(),
+		endpoint.IsActive(),
+		endpoint.GetCreatedAt(),
+		endpoint.GetUpdatedAt(),
+	)
+	return mapPGError(err)
+}
+
+// GetByID fetches an endpoint by its identifier.
+func (r *WebhookEndpointRepository) GetByID(ctx context.Context, id uuid.UUID) (entities.WebhookEndpoint, error) {
+	if r.pool == nil {
+		return nil, errWebhookNilPool
+	}
+
+	row := r.pool.QueryRow(ctx, webhookEndpointSelectColumns+" WHERE id = $1", id)
+	return scanWebhookEndpoint(row)
+}
+
+// ListByUser returns the user's endpoints, newest first.
+func (r *WebhookEndpointRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]entities.WebhookEndpoint, error) {
+	if r.pool == nil {
+		return nil, errWebhookNilPool
+	}
+
+	rows, err := r.pool.Query(ctx, webhookEndpointSelectColumns+" WHERE user_id = $1 ORDER BY created_at DESC", userID)
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	return scanWebhookEndpoints(rows)
+}
+
+// ListAll returns every endpoint, oldest first.
+func (r *WebhookEndpointRepository) ListAll(ctx context.Context) ([]entities.WebhookEndpoint, error) {
+	if r.pool == nil {
+		return nil, errWebhookNilPool
+	}
+
+	rows, err := r.pool.Query(ctx, webhookEndpointSelectColumns+" ORDER BY created_at ASC")
+	if err != nil {
+		return nil, mapPGError(err)
+	}
+	return scanWebhookEndpoints(rows)
+}
+
+// UpdateSecret replaces an endpoint's encrypted signing secret.
+func (r *WebhookEndpointRepository) UpdateSecret(ctx context.Context, id uuid.UUID, encryptedSecret string) error {
+	if r.pool == nil {
+		return errWebhookNilPool
+	}
+
+	cmd, err := r.pool.Exec(ctx,
+		"UPDATE webhook_endpoints SET secret_encrypted = $2, updated_at = NOW() WHERE id = $1",
+		id, encryptedSecret)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+// CountByUser returns how many endpoints the user has registered.
+func (r *WebhookEndpointRepository) CountByUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	if r.pool == nil {
+		return 0, errWebhookNilPool
+	}
+
+	var count int
+	if err := r.pool.QueryRow(ctx, "SELECT COUNT(*) FROM webhook_endpoints WHERE user_id = $1", userID).Scan(&count); err != nil {
+		return 0, mapPGError(err)
+	}
+	return count, nil
+}
+
+// Delete removes an endpoint.
+func (r *WebhookEndpointRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if r.pool == nil {
+		return errWebhookNilPool
+	}
+
+	cmd, err := r.pool.Exec(ctx, "DELETE FROM webhook_endpoints WHERE id = $1", id)
+	if err != nil {
+		return mapPGError(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+func scanWebhookEndpoints(rows pgx.Rows) ([]entities.WebhookEndpoint, error) {
+	defer rows.Close()
+
+	endpoints := make([]entities.WebhookEndpoint, 0)
+	for rows.Next() {
+		endpoint, err := scanWebhookEndpoint(rows)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, mapPGError(err)
+	}
+
+	return endpoints, nil
+}
+
+func scanWebhookEndpoint(row pgx.Row) (entities.WebhookEndpoint, error) {
+	var (
+		params      entities.WebhookEndpointParams
+		description *string
+	)
+
+	if err := row.Scan(
+		&params.ID,
+		&params.UserID,
+		&params.URL,
+		&description,
+		&params.EncryptedSecret,
+		&params.Active,
+		&params.CreatedAt,
+		&params.UpdatedAt,
+	); err != nil {
+		return nil, mapPGError(err)
+	}
+
+	if description != nil {
+		params.Description = *description
+	}
+
+	return entities.HydrateWebhookEndpointEntity(params), nil
+}
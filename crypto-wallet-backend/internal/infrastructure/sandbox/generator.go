@@ -0,0 +1,454 @@
+// Package sandbox generates synthetic core database seeds for QA and demo environments.
+//
+// Every row is drawn from the aggregate distributions of an entities.SandboxProfile with a seeded
+// random source: identifiers, names, emails, addresses and hashes are invented, and no value is
+// copied from a real record. The same profile, options and seed always produce the same SQL.
+package sandbox
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+)
+
+// MaxUsers bounds one generated dataset.
+const MaxUsers = 100000
+
+// DisabledPasswordHash is stored when no sandbox password is set. It is not a bcrypt hash, so
+// password sign-in fails for every generated user.
+const DisabledPasswordHash = "!sandbox-login-disabled"
+
+const (
+	defaultDays   = 30
+	insertBatch   = 500
+	amountDigits  = 8
+	quoteLifetime = 30 * time.Second
+	// historyDays is how far before the generated period users and wallets may have been created.
+	historyDays = 365
+)
+
+// nativeAssets are the fixed native asset identifiers of the core schema.
+var nativeAssets = map[entities.Chain]string{
+	entities.ChainBTC: "00000000-0000-4000-8000-000000000001",
+	entities.ChainETH: "00000000-0000-4000-8000-000000000002",
+	entities.ChainSOL: "00000000-0000-4000-8000-000000000003",
+	entities.ChainXLM: "00000000-0000-4000-8000-000000000004",
+}
+
+var (
+	firstNames = []string{"Avery", "Blake", "Casey", "Devon", "Emery", "Finley", "Harper", "Jordan", "Kai", "Logan", "Morgan", "Quinn", "Riley", "Rowan", "Sage", "Taylor"}
+	lastNames  = []string{"Ash", "Birch", "Cedar", "Elm", "Fir", "Hazel", "Juniper", "Larch", "Maple", "Oak", "Pine", "Rowan", "Spruce", "Willow"}
+	currencies = []string{"USD", "EUR", "THB", "GBP", "JPY"}
+)
+
+// Options controls the size and time span of a generated dataset.
+type Options struct {
+	Users int
+	Seed  uint64
+	// Days is the span of generated activity, ending at End; counts are scaled from the profile's
+	// window. Defaults to the profile's window, or 30 days.
+	Days int
+	// End defaults to the start of the current UTC day.
+	End time.Time
+	// PasswordHash is stored for every user; empty stores DisabledPasswordHash.
+	PasswordHash string
+}
+
+// Summary counts the generated rows.
+type Summary struct {
+	Users        int `json:"users"`
+	Wallets      int `json:"wallets"`
+	Transactions int `json:"transactions"`
+	Swaps        int `json:"swaps"`
+}
+
+type wallet struct {
+	id      string
+	chain   entities.Chain
+	address string
+}
+
+type generator struct {
+	profile  entities.SandboxProfile
+	opts     Options
+	rng      *rand.Rand
+	start    time.Time
+	scale    float64
+	activity map[entities.Chain]entities.SandboxChainActivity
+	summary  Summary
+
+	users        [][]string
+	accounts     [][]string
+	wallets      [][]string
+	transactions [][]string
+	swaps        [][]string
+}
+
+// Generate writes a core database seed drawn from profile. The script runs in one transaction and
+// skips rows that already exist, so it can be applied repeatedly.
+func Generate(w io.Writer, profile entities.SandboxProfile, opts Options) (Summary, error) {
+	if profile.Version != entities.SandboxProfileVersion {
+		return Summary{}, fmt.Errorf("sandbox: unsupported profile version %d", profile.Version)
+	}
+	if opts.Users <= 0 || opts.Users > MaxUsers {
+		return Summary{}, fmt.Errorf("sandbox: users must be between 1 and %d", MaxUsers)
+	}
+	if profile.WalletsPerUser.IsEmpty() || len(profile.Wallets) == 0 {
+		return Summary{}, errors.New("sandbox: profile has no wallet distribution")
+	}
+	for _, chain := range profile.Wallets {
+		if !entities.IsSupportedChain(chain.Chain) {
+			return Summary{}, fmt.Errorf("sandbox: profile references unsupported chain %q", chain.Chain)
+		}
+	}
+	if opts.Days <= 0 {
+		opts.Days = profile.WindowDays
+	}
+	if opts.Days <= 0 {
+		opts.Days = defaultDays
+	}
+	if opts.End.IsZero() {
+		opts.End = time.Now().UTC().Truncate(24 * time.Hour)
+	}
+	if opts.PasswordHash == "" {
+		opts.PasswordHash = DisabledPasswordHash
+	}
+
+	g := &generator{
+		profile:  profile,
+		opts:     opts,
+		rng:      rand.New(rand.NewPCG(opts.Seed, opts.Seed^0x5eed)),
+		start:    opts.End.UTC().AddDate(0, 0, -opts.Days),
+		scale:    1,
+		activity: make(map[entities.Chain]entities.SandboxChainActivity, len(profile.Transactions)),
+	}
+	if profile.WindowDays > 0 {
+		g.scale = float64(opts.Days) / float64(profile.WindowDays)
+	}
+	for _, activity := range profile.Transactions {
+		g.activity[activity.Chain] = activity
+	}
+
+	for i := 1; i <= opts.Users; i++ {
+		g.user(i)
+	}
+	return g.summary, g.write(w)
+}
+
+func (g *generator) user(n int) {
+	userID := g.uuid()
+	createdAt := g.between(g.start.AddDate(0, 0, -historyDays), g.start)
+	g.users = append(g.users, []string{
+		quote(userID),
+		quote(fmt.Sprintf("sandbox-%06d@sandbox.invalid", n)),
+		quote(g.opts.PasswordHash),
+		quote(pick(g.rng, firstNames)),
+		quote(pick(g.rng, lastNames)),
+		quote("active"),
+		quote(pick(g.rng, currencies)),
+		"FALSE",
+		"TRUE",
+		timestamp(createdAt),
+		timestamp(createdAt),
+		timestamp(createdAt),
+	})
+	g.accounts = append(g.accounts, []string{quote(g.uuid()), quote(userID), "0", timestamp(createdAt), timestamp(createdAt)})
+	g.summary.Users++
+
+	count := max(1, int(math.Round(sample(g.rng, g.profile.WalletsPerUser))))
+	owned := make([]wallet, 0, count)
+	for range count {
+		chain := g.profile.Wallets[weighted(g.rng, len(g.profile.Wallets), func(i int) float64 { return g.profile.Wallets[i].Weight })]
+		w := wallet{id: g.uuid(), chain: chain.Chain, address: g.address(chain.Chain)}
+		walletCreated := g.between(createdAt, g.start)
+		g.wallets = append(g.wallets, []string{
+			quote(w.id),
+			quote(userID),
+			quote(nativeAssets[w.chain]),
+			quote(string(w.chain)),
+			quote(w.address),
+			quote("sandbox:unusable"),
+			quote(fmt.Sprintf("%s Wallet", w.chain)),
+			amount(sample(g.rng, chain.Balance)),
+			quote("active"),
+			timestamp(walletCreated),
+			timestamp(walletCreated),
+		})
+		g.summary.Wallets++
+		owned = append(owned, w)
+		g.walletTransactions(w)
+	}
+	g.userSwaps(userID, owned)
+}
+
+func (g *generator) walletTransactions(w wallet) {
+	activity, ok := g.activity[w.chain]
+	if !ok || activity.Amount.IsEmpty() || g.rng.Float64() >= g.profile.ActiveWalletShare {
+		return
+	}
+	types := make([]entities.SandboxWeight, 0, len(activity.Types))
+	for _, weight := range activity.Types {
+		// Swap legs come from the generated exchange operations instead.
+		if weight.Value != string(entities.TransactionTypeSwapIn) && weight.Value != string(entities.TransactionTypeSwapOut) {
+			types = append(types, weight)
+		}
+	}
+	if len(types) == 0 {
+		types = []entities.SandboxWeight{{Value: string(entities.TransactionTypeSend), Weight: 1}, {Value: string(entities.TransactionTypeReceive), Weight: 1}}
+	}
+	statuses := activity.Statuses
+	if len(statuses) == 0 {
+		statuses = []entities.SandboxWeight{{Value: string(entities.TransactionStatusConfirmed), Weight: 1}}
+	}
+
+	count := g.count(g.profile.TransactionsPerWallet)
+	for range count {
+		txType := types[weighted(g.rng, len(types), func(i int) float64 { return types[i].Weight })].Value
+		status := statuses[weighted(g.rng, len(statuses), func(i int) float64 { return statuses[i].Weight })].Value
+		from, to := w.address, g.address(w.chain)
+		if txType == string(entities.TransactionTypeReceive) || txType == string(entities.TransactionTypeP2PReceive) {
+			from, to = to, from
+		}
+		createdAt := g.between(g.start, g.opts.End)
+		confirmedAt, confirmations := "NULL", "0"
+		if status == string(entities.TransactionStatusConfirmed) {
+			confirmedAt, confirmations = timestamp(createdAt.Add(time.Duration(1+g.rng.IntN(30))*time.Minute)), "12"
+		}
+		g.transactions = append(g.transactions, []string{
+			quote(g.uuid()),
+			quote(w.id),
+			quote(string(w.chain)),
+			quote(g.txHash(w.chain)),
+			quote(txType),
+			amount(sample(g.rng, activity.Amount)),
+			amount(sample(g.rng, activity.Fee)),
+			quote(status),
+			quote(from),
+			quote(to),
+			confirmations,
+			quote(`{"sandbox":true}`),
+			timestamp(createdAt),
+			confirmedAt,
+			timestamp(createdAt),
+		})
+		g.summary.Transactions++
+	}
+}
+
+func (g *generator) userSwaps(userID string, owned []wallet) {
+	if g.profile.SwapsPerUser.IsEmpty() || g.rng.Float64() >= g.profile.SwapperShare {
+		return
+	}
+	type candidate struct {
+		pair     entities.SandboxSwapPair
+		from, to wallet
+	}
+	var candidates []candidate
+	for _, pair := range g.profile.SwapPairs {
+		if pair.FromAmount.IsEmpty() {
+			continue
+		}
+		from, okFrom := findWallet(owned, pair.FromChain, "")
+		to, okTo := findWallet(owned, pair.ToChain, from.id)
+		if okFrom && okTo {
+			candidates = append(candidates, candidate{pair: pair, from: from, to: to})
+		}
+	}
+	if len(candidates) == 0 {
+		return
+	}
+	statuses := g.profile.SwapStatuses
+	if len(statuses) == 0 {
+		statuses = []entities.SandboxWeight{{Value: "completed", Weight: 1}}
+	}
+
+	count := g.count(g.profile.SwapsPerUser)
+	for range count {
+		c := candidates[weighted(g.rng, len(candidates), func(i int) float64 { return candidates[i].pair.Weight })]
+		fromAmount := sample(g.rng, c.pair.FromAmount)
+		feeAmount := fromAmount * c.pair.FeePercentage
+		status := statuses[weighted(g.rng, len(statuses), func(i int) float64 { return statuses[i].Weight })].Value
+		createdAt := g.between(g.start, g.opts.End)
+		executedAt := "NULL"
+		if status == "completed" {
+			executedAt = timestamp(createdAt.Add(time.Duration(1+g.rng.IntN(20)) * time.Second))
+		}
+		g.swaps = append(g.swaps, []string{
+			quote(g.uuid()),
+			quote(userID),
+			quote(c.from.id),
+			quote(c.to.id),
+			amount(fromAmount),
+			amount((fromAmount - feeAmount) * c.pair.Rate),
+			strconv.FormatFloat(c.pair.Rate, 'f', -1, 64),
+			strconv.FormatFloat(c.pair.FeePercentage, 'f', 4, 64),
+			amount(feeAmount),
+			quote(status),
+			timestamp(createdAt.Add(quoteLifetime)),
+			executedAt,
+			timestamp(createdAt),
+			timestamp(createdAt),
+		})
+		g.summary.Swaps++
+	}
+}
+
+func (g *generator) write(w io.Writer) error {
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(out, "-- Synthetic sandbox data for core_db, generated by walletctl sandbox generate.\n")
+	fmt.Fprintf(out, "-- Profile captured %s over %d days; seed %d; activity from %s until %s.\n",
+		g.profile.CapturedAt.UTC().Format(time.RFC3339), g.profile.WindowDays, g.opts.Seed,
+		g.start.Format(time.DateOnly), g.opts.End.UTC().Format(time.DateOnly))
+	fmt.Fprintf(out, "-- Every row is invented; nothing is copied from a real record.\n\nBEGIN;\n")
+
+	insert(out, "users", "id, email, password_hash, first_name, last_name, status, preferred_currency, two_factor_enabled, email_verified, email_verified_at, created_at, updated_at", g.users)
+	insert(out, "accounts", "id, user_id, total_balance_usd, created_at, updated_at", g.accounts)
+	insert(out, "wallets", "id, user_id, asset_id, chain, address, encrypted_private_key, label, balance, status, created_at, updated_at", g.wallets)
+	insert(out, "transactions", "id, wallet_id, chain, tx_hash, type, amount, fee, status, from_address, to_address, confirmations, metadata, created_at, confirmed_at, updated_at", g.transactions)
+	insert(out, "exchange_operations", "id, user_id, from_wallet_id, to_wallet_id, from_amount, to_amount, exchange_rate, fee_percentage, fee_amount, status, quote_expires_at, executed_at, created_at, updated_at", g.swaps)
+
+	fmt.Fprintf(out, "COMMIT;\n")
+	return out.Flush()
+}
+
+// insert writes rows as multi-row INSERT statements that skip existing rows.
+func insert(out *bufio.Writer, table, columns string, rows [][]string) {
+	for len(rows) > 0 {
+		batch := rows[:min(insertBatch, len(rows))]
+		rows = rows[len(batch):]
+		fmt.Fprintf(out, "\nINSERT INTO %s (%s)\nVALUES\n", table, columns)
+		for i, row := range batch {
+			separator := ","
+			if i == len(batch)-1 {
+				separator = "\nON CONFLICT DO NOTHING;"
+			}
+			fmt.Fprintf(out, "    (%s)%s\n", strings.Join(row, ", "), separator)
+		}
+	}
+}
+
+// count draws a per-period count, scaled from the profile window to the generated span.
+func (g *generator) count(d entities.SandboxDistribution) int {
+	return max(1, int(math.Round(sample(g.rng, d)*g.scale)))
+}
+
+func (g *generator) uuid() string {
+	var id uuid.UUID
+	for i := range id {
+		id[i] = byte(g.rng.UintN(256))
+	}
+	id[6] = id[6]&0x0f | 0x40
+	id[8] = id[8]&0x3f | 0x80
+	return id.String()
+}
+
+func (g *generator) between(from, to time.Time) time.Time {
+	span := to.Sub(from)
+	if span <= 0 {
+		return from.UTC()
+	}
+	return from.Add(time.Duration(g.rng.Int64N(int64(span)))).UTC().Truncate(time.Second)
+}
+
+// address returns a random string shaped like an address on the chain. It is not checksummed and
+// not derived from any real address.
+func (g *generator) address(chain entities.Chain) string {
+	switch chain {
+	case entities.ChainBTC:
+		return "bc1q" + g.randomString("qpzry9x8gf2tvdw0s3jn54khce6mua7l", 38)
+	case entities.ChainETH:
+		return "0x" + g.randomString("0123456789abcdef", 40)
+	case entities.ChainSOL:
+		return g.randomString("123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz", 44)
+	case entities.ChainXLM:
+		return "G" + g.randomString("ABCDEFGHIJKLMNOPQRSTUVWXYZ234567", 55)
+	default:
+		return "sandbox-" + g.randomString("0123456789abcdef", 32)
+	}
+}
+
+func (g *generator) txHash(chain entities.Chain) string {
+	hash := g.randomString("0123456789abcdef", 64)
+	if chain == entities.ChainETH {
+		return "0x" + hash
+	}
+	return hash
+}
+
+func (g *generator) randomString(alphabet string, length int) string {
+	var b strings.Builder
+	b.Grow(length)
+	for range length {
+		b.WriteByte(alphabet[g.rng.IntN(len(alphabet))])
+	}
+	return b.String()
+}
+
+// sample draws from a distribution by interpolating between its evenly spaced quantiles.
+func sample(rng *rand.Rand, d entities.SandboxDistribution) float64 {
+	q := d.Quantiles
+	switch len(q) {
+	case 0:
+		return 0
+	case 1:
+		return q[0]
+	}
+	pos := rng.Float64() * float64(len(q)-1)
+	i := int(pos)
+	return q[i] + (q[i+1]-q[i])*(pos-float64(i))
+}
+
+// weighted picks an index in proportion to its weight, falling back to uniform when no weight is
+// positive.
+func weighted(rng *rand.Rand, n int, weight func(int) float64) int {
+	total := 0.0
+	for i := range n {
+		total += max(0, weight(i))
+	}
+	if total <= 0 {
+		return rng.IntN(n)
+	}
+	target := rng.Float64() * total
+	for i := range n {
+		target -= max(0, weight(i))
+		if target < 0 {
+			return i
+		}
+	}
+	return n - 1
+}
+
+func pick(rng *rand.Rand, values []string) string {
+	return values[rng.IntN(len(values))]
+}
+
+func findWallet(wallets []wallet, chain entities.Chain, excludeID string) (wallet, bool) {
+	for _, w := range wallets {
+		if w.chain == chain && w.id != excludeID {
+			return w, true
+		}
+	}
+	return wallet{}, false
+}
+
+func quote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+func timestamp(t time.Time) string {
+	return quote(t.UTC().Format(time.RFC3339))
+}
+
+func amount(value float64) string {
+	return strconv.FormatFloat(math.Max(0, value), 'f', amountDigits, 64)
+}
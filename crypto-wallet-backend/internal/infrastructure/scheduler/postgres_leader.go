@@ -0,0 +1,84 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresLeaderLock elects a leader with a session-level Postgres advisory lock. The lock lives on
+// a connection held for as long as this instance leads, so leadership passes to another instance
+// as soon as this one exits or loses its connection.
+type PostgresLeaderLock struct {
+	pool *pgxpool.Pool
+	key  string
+
+	mu   sync.Mutex
+	conn *pgxpool.Conn
+}
+
+// NewPostgresLeaderLock constructs a leader lock; instances contend for the same key.
+func NewPostgresLeaderLock(pool *pgxpool.Pool, key string) *PostgresLeaderLock {
+	return &PostgresLeaderLock{pool: pool, key: key}
+}
+
+// IsLeader implements LeaderElector. A leader confirms its connection is still alive; other
+// instances try to take the lock.
+func (l *PostgresLeaderLock) IsLeader(ctx context.Context) (bool, error) {
+	if l.pool == nil {
+		return false, errors.New("scheduler: leader lock requires a database pool")
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn != nil {
+		if err := l.conn.Ping(ctx); err != nil {
+			l.drop()
+			return false, err
+		}
+		return true, nil
+	}
+
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return false, err
+	}
+	var locked bool
+	if err := conn.QueryRow(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", l.key).Scan(&locked); err != nil {
+		conn.Release()
+		return false, err
+	}
+	if !locked {
+		conn.Release()
+		return false, nil
+	}
+	l.conn = conn
+	return true, nil
+}
+
+// Resign implements LeaderElector.
+func (l *PostgresLeaderLock) Resign(ctx context.Context) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn == nil {
+		return
+	}
+	if _, err := l.conn.Exec(ctx, "SELECT pg_advisory_unlock(hashtext($1))", l.key); err != nil {
+		l.drop()
+		return
+	}
+	l.conn.Release()
+	l.conn = nil
+}
+
+// drop closes the lock connection rather than returning it to the pool, where it could still hold
+// the lock.
+func (l *PostgresLeaderLock) drop() {
+	_ = l.conn.Conn().Close(context.Background())
+	l.conn.Release()
+	l.conn = nil
+}
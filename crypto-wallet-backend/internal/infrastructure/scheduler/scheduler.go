@@ -0,0 +1,187 @@
+// Package scheduler runs maintenance jobs on fixed schedules. When several instances run, a leader
+// elector makes sure only one of them runs the jobs at a time.
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// defaultJobTimeout bounds a job run that does not set its own timeout.
+const defaultJobTimeout = 10 * time.Minute
+
+// Schedule decides when a job runs next.
+type Schedule interface {
+	// Next returns the first run time strictly after the given time.
+	Next(after time.Time) time.Time
+}
+
+type every time.Duration
+
+// Every runs a job at each multiple of interval, counted from the Unix epoch, so instances agree
+// on run times. Every(time.Minute) runs at the start of each minute.
+func Every(interval time.Duration) Schedule {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return every(interval)
+}
+
+func (e every) Next(after time.Time) time.Time {
+	interval := time.Duration(e)
+	return after.Truncate(interval).Add(interval)
+}
+
+type daily struct {
+	hour, minute int
+}
+
+// DailyAt runs a job once a day at the given UTC hour and minute.
+func DailyAt(hour, minute int) Schedule {
+	return daily{hour: hour, minute: minute}
+}
+
+func (d daily) Next(after time.Time) time.Time {
+	after = after.UTC()
+	next := time.Date(after.Year(), after.Month(), after.Day(), d.hour, d.minute, 0, 0, time.UTC)
+	if !next.After(after) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+// Job is a named task run on a schedule.
+type Job struct {
+	Name     string
+	Schedule Schedule
+	// Timeout bounds one run; it defaults to ten minutes.
+	Timeout time.Duration
+	Run     func(ctx context.Context) error
+}
+
+// LeaderElector decides whether this instance runs the jobs.
+type LeaderElector interface {
+	// IsLeader reports whether this instance leads, acquiring leadership when it is free.
+	IsLeader(ctx context.Context) (bool, error)
+	// Resign gives up leadership if this instance holds it.
+	Resign(ctx context.Context)
+}
+
+// Config configures a Scheduler. Without a Leader every instance runs every job.
+type Config struct {
+	Jobs   []Job
+	Leader LeaderElector
+	Logger *slog.Logger
+}
+
+// Scheduler runs jobs on their schedules. Jobs run one at a time; a run that overlaps the next
+// scheduled time delays it rather than running twice.
+type Scheduler struct {
+	jobs   []Job
+	leader LeaderElector
+	logger *slog.Logger
+}
+
+// New constructs a Scheduler.
+func New(cfg Config) *Scheduler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	jobs := make([]Job, 0, len(cfg.Jobs))
+	for _, job := range cfg.Jobs {
+		if job.Run == nil || job.Schedule == nil {
+			continue
+		}
+		if job.Timeout <= 0 {
+			job.Timeout = defaultJobTimeout
+		}
+		jobs = append(jobs, job)
+	}
+	return &Scheduler{
+		jobs:   jobs,
+		leader: cfg.Leader,
+		logger: logger,
+	}
+}
+
+// Start runs the jobs until the context is cancelled.
+func (s *Scheduler) Start(ctx context.Context) {
+	if len(s.jobs) == 0 {
+		return
+	}
+	names := make([]string, len(s.jobs))
+	for i, job := range s.jobs {
+		names[i] = job.Name
+	}
+	s.logger.Info("Starting scheduler", "jobs", names)
+
+	defer func() {
+		if s.leader != nil {
+			resignCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			s.leader.Resign(resignCtx)
+		}
+	}()
+
+	now := time.Now().UTC()
+	next := make([]time.Time, len(s.jobs))
+	for i, job := range s.jobs {
+		next[i] = job.Schedule.Next(now)
+	}
+
+	for {
+		earliest := next[0]
+		for _, at := range next[1:] {
+			if at.Before(earliest) {
+				earliest = at
+			}
+		}
+
+		timer := time.NewTimer(time.Until(earliest))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			s.logger.Info("Scheduler stopped by context")
+			return
+		case <-timer.C:
+		}
+
+		now = time.Now().UTC()
+		leading := s.isLeader(ctx)
+		for i, job := range s.jobs {
+			if next[i].After(now) {
+				continue
+			}
+			if leading && ctx.Err() == nil {
+				s.run(ctx, job)
+			}
+			next[i] = job.Schedule.Next(time.Now().UTC())
+		}
+	}
+}
+
+func (s *Scheduler) isLeader(ctx context.Context) bool {
+	if s.leader == nil {
+		return true
+	}
+	leading, err := s.leader.IsLeader(ctx)
+	if err != nil {
+		s.logger.Warn("Scheduler leader election failed; skipping due jobs", "error", err)
+		return false
+	}
+	return leading
+}
+
+func (s *Scheduler) run(ctx context.Context, job Job) {
+	runCtx, cancel := context.WithTimeout(ctx, job.Timeout)
+	defer cancel()
+
+	startedAt := time.Now()
+	if err := job.Run(runCtx); err != nil {
+		s.logger.Error("Scheduled job failed", "job", job.Name, "duration", time.Since(startedAt), "error", err)
+		return
+	}
+	s.logger.Debug("Scheduled job finished", "job", job.Name, "duration", time.Since(startedAt))
+}
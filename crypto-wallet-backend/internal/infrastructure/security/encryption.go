@@ -1,6 +1,7 @@
 package security
 
 import (
+	"context"
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
@@ -108,6 +109,16 @@ func (e *AESGCMEncryptor) Decrypt(ciphertext, additionalData []byte) ([]byte, er
 	return plaintext, nil
 }
 
+// EncryptContext is Encrypt for use as a KeyWrapper; the key is local, so ctx is not consulted.
+func (e *AESGCMEncryptor) EncryptContext(_ context.Context, plaintext, additionalData []byte) ([]byte, error) {
+	return e.Encrypt(plaintext, additionalData)
+}
+
+// DecryptContext is Decrypt for use as a KeyWrapper.
+func (e *AESGCMEncryptor) DecryptContext(_ context.Context, ciphertext, additionalData []byte) ([]byte, error) {
+	return e.Decrypt(ciphertext, additionalData)
+}
+
 // EncryptToString encrypts plaintext and returns a base64 encoded payload.
 func (e *AESGCMEncryptor) EncryptToString(plaintext, additionalData []byte) (string, error) {
 	bytes, err := e.Encrypt(plaintext, additionalData)
@@ -0,0 +1,179 @@
+package security
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// envelopePrefix marks envelope ciphertexts: "env1:<wrapped data key>.<ciphertext>", both base64.
+// Ciphertexts without it predate envelope encryption and were encrypted under a master key directly.
+const envelopePrefix = "env1:"
+
+// KeyWrapper encrypts and decrypts data keys under a master key. AESGCMEncryptor satisfies it; a
+// KMS client can be adapted to it so master keys never leave the KMS. ctx bounds remote calls.
+type KeyWrapper interface {
+	EncryptContext(ctx context.Context, plaintext, additionalData []byte) ([]byte, error)
+	DecryptContext(ctx context.Context, ciphertext, additionalData []byte) ([]byte, error)
+}
+
+// EnvelopeEncryptor encrypts every secret under its own random data key and stores the data key,
+// wrapped by the primary master key, in front of the ciphertext. Rotating the master key then only
+// re-wraps data keys (see Rewrap); the secrets themselves are never re-encrypted. Previous master
+// keys are only used to unwrap, so they can stay configured while a rotation is in progress.
+type EnvelopeEncryptor struct {
+	primary  KeyWrapper
+	previous []KeyWrapper
+}
+
+// NewEnvelopeEncryptor constructs an EnvelopeEncryptor.
+func NewEnvelopeEncryptor(primary KeyWrapper, previous ...KeyWrapper) *EnvelopeEncryptor {
+	return &EnvelopeEncryptor{
+		primary:  primary,
+		previous: previous,
+	}
+}
+
+// EncryptToString encrypts plaintext under a fresh data key wrapped by the primary master key.
+// additionalData is bound to both the ciphertext and the wrapped data key.
+func (e *EnvelopeEncryptor) EncryptToString(ctx context.Context, plaintext, additionalData []byte) (string, error) {
+	if e == nil || e.primary == nil {
+		return "", errors.New("security: envelope encryptor not initialised")
+	}
+
+	dataKey, err := GenerateRandomKey()
+	if err != nil {
+		return "", err
+	}
+	dataCipher, err := NewAESGCMEncryptor(AESGCMConfig{Key: dataKey})
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := dataCipher.Encrypt(plaintext, additionalData)
+	if err != nil {
+		return "", err
+	}
+
+	return e.seal(ctx, dataKey, ciphertext, additionalData)
+}
+
+// DecryptString decrypts a payload produced by EncryptToString, or a legacy payload encrypted
+// under one of the master keys directly.
+func (e *EnvelopeEncryptor) DecryptString(ctx context.Context, payload string, additionalData []byte) ([]byte, error) {
+	if e == nil || e.primary == nil {
+		return nil, errors.New("security: envelope encryptor not initialised")
+	}
+
+	wrapped, ciphertext, ok, err := splitEnvelope(payload)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return e.decryptLegacy(ctx, payload, additionalData)
+	}
+
+	dataKey, _, err := e.unwrap(ctx, wrapped, additionalData)
+	if err != nil {
+		return nil, err
+	}
+	dataCipher, err := NewAESGCMEncryptor(AESGCMConfig{Key: dataKey})
+	if err != nil {
+		return nil, err
+	}
+	return dataCipher.Decrypt(ciphertext, additionalData)
+}
+
+// Rewrap returns the payload with its data key wrapped by the primary master key, and whether it
+// changed. The ciphertext is carried over unchanged; legacy payloads, which have no data key, are
+// decrypted and sealed into a new envelope.
+func (e *EnvelopeEncryptor) Rewrap(ctx context.Context, payload string, additionalData []byte) (string, bool, error) {
+	if e == nil || e.primary == nil {
+		return "", false, errors.New("security: envelope encryptor not initialised")
+	}
+
+	wrapped, ciphertext, ok, err := splitEnvelope(payload)
+	if err != nil {
+		return "", false, err
+	}
+	if !ok {
+		plaintext, err := e.decryptLegacy(ctx, payload, additionalData)
+		if err != nil {
+			return "", false, err
+		}
+		sealed, err := e.EncryptToString(ctx, plaintext, additionalData)
+		return sealed, err == nil, err
+	}
+
+	dataKey, primary, err := e.unwrap(ctx, wrapped, additionalData)
+	if err != nil {
+		return "", false, err
+	}
+	if primary {
+		return payload, false, nil
+	}
+	sealed, err := e.seal(ctx, dataKey, ciphertext, additionalData)
+	return sealed, err == nil, err
+}
+
+func (e *EnvelopeEncryptor) seal(ctx context.Context, dataKey, ciphertext, additionalData []byte) (string, error) {
+	wrapped, err := e.primary.EncryptContext(ctx, dataKey, dataKeyAssociatedData(additionalData))
+	if err != nil {
+		return "", fmt.Errorf("security: wrap data key: %w", err)
+	}
+	return envelopePrefix + base64.StdEncoding.EncodeToString(wrapped) + "." + base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// unwrap recovers the data key, reporting whether it was wrapped by the primary master key.
+func (e *EnvelopeEncryptor) unwrap(ctx context.Context, wrapped, additionalData []byte) ([]byte, bool, error) {
+	aad := dataKeyAssociatedData(additionalData)
+	if dataKey, err := e.primary.DecryptContext(ctx, wrapped, aad); err == nil {
+		return dataKey, true, nil
+	}
+	for _, master := range e.previous {
+		if dataKey, err := master.DecryptContext(ctx, wrapped, aad); err == nil {
+			return dataKey, false, nil
+		}
+	}
+	return nil, false, fmt.Errorf("security: unwrap data key: %w", ErrInvalidCiphertext)
+}
+
+func (e *EnvelopeEncryptor) decryptLegacy(ctx context.Context, payload string, additionalData []byte) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, fmt.Errorf("security: decode payload: %w", err)
+	}
+	if plaintext, err := e.primary.DecryptContext(ctx, data, additionalData); err == nil {
+		return plaintext, nil
+	}
+	for _, master := range e.previous {
+		if plaintext, err := master.DecryptContext(ctx, data, additionalData); err == nil {
+			return plaintext, nil
+		}
+	}
+	return nil, fmt.Errorf("security: decrypt payload: %w", ErrInvalidCiphertext)
+}
+
+// splitEnvelope decodes an envelope payload; ok is false for legacy payloads.
+func splitEnvelope(payload string) (wrapped, ciphertext []byte, ok bool, err error) {
+	rest, found := strings.CutPrefix(payload, envelopePrefix)
+	if !found {
+		return nil, nil, false, nil
+	}
+	rawWrapped, rawCiphertext, found := strings.Cut(rest, ".")
+	if !found {
+		return nil, nil, false, ErrInvalidCiphertext
+	}
+	if wrapped, err = base64.StdEncoding.DecodeString(rawWrapped); err != nil {
+		return nil, nil, false, fmt.Errorf("security: decode data key: %w", err)
+	}
+	if ciphertext, err = base64.StdEncoding.DecodeString(rawCiphertext); err != nil {
+		return nil, nil, false, fmt.Errorf("security: decode payload: %w", err)
+	}
+	return wrapped, ciphertext, true, nil
+}
+
+func dataKeyAssociatedData(additionalData []byte) []byte {
+	return append([]byte("data-key:"), additionalData...)
+}
@@ -17,6 +17,10 @@ var (
 	ErrTokenExpired = errors.New("security: token has expired")
 )
 
+// TokenUseRefresh marks refresh tokens. They are only accepted by ParseRefreshToken, never as
+// bearer tokens.
+const TokenUseRefresh = "refresh"
+
 // JWTConfig defines configuration required to initialise the JWT service.
 type JWTConfig struct {
 	Secret        string
@@ -27,12 +31,61 @@ type JWTConfig struct {
 	Clock         func() time.Time
 }
 
-// Claims wraps jwt.RegisteredClaims with custom metadata.
+// Claims wraps jwt.RegisteredClaims with custom metadata. ClientID and Scope are only set on
+// tokens issued to third-party apps; first-party tokens carry neither. TokenUse and FamilyID are
+// only set on refresh tokens, whose ID claim identifies the stored token.
 type Claims struct {
-	Metadata map[string]any `json:"metadata,omitempty"`
+	Metadata  map[string]any `json:"metadata,omitempty"`
+	ClientID  string         `json:"client_id,omitempty"`
+	ConsentID string         `json:"consent_id,omitempty"`
+	Scope     string         `json:"scope,omitempty"`
+	TokenUse  string         `json:"token_use,omitempty"`
+	FamilyID  string         `json:"fid,omitempty"`
+	Roles     []string       `json:"roles,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// IsRefresh reports whether the token is a refresh token.
+func (c *Claims) IsRefresh() bool {
+	return c != nil && c.TokenUse == TokenUseRefresh
+}
+
+// IsDelegated reports whether the token was issued to a third-party app on the user's behalf.
+func (c *Claims) IsDelegated() bool {
+	return c != nil && strings.TrimSpace(c.ClientID) != ""
+}
+
+// Scopes returns the space-delimited scope claim as a slice.
+func (c *Claims) Scopes() []string {
+	if c == nil {
+		return nil
+	}
+	return strings.Fields(c.Scope)
+}
+
+// HasScope reports whether the scope claim includes the scope.
+func (c *Claims) HasScope(scope string) bool {
+	for _, granted := range c.Scopes() {
+		if granted == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// HasRole reports whether the token carries the staff role. Delegated app tokens never do.
+func (c *Claims) HasRole(role string) bool {
+	if c == nil || c.IsDelegated() {
+		return false
+	}
+	for _, granted := range c.Roles {
+		if granted == role {
+			return true
+		}
+	}
+	return false
+}
+
 // JWTService provides helpers for issuing and validating JWT tokens.
 type JWTService struct {
 	secret        []byte
@@ -113,6 +166,11 @@ func (s *JWTService) Sign(_ context.Context, claims Claims) (string, error) {
 
 // GenerateToken creates a token for the supplied subject with the provided TTL and optional metadata.
 func (s *JWTService) GenerateToken(ctx context.Context, subject string, ttl time.Duration, metadata map[string]any) (string, error) {
+	return s.GenerateTokenWithRoles(ctx, subject, nil, ttl, metadata)
+}
+
+// GenerateTokenWithRoles creates a token like GenerateToken that also carries the subject's staff roles.
+func (s *JWTService) GenerateTokenWithRoles(ctx context.Context, subject string, roles []string, ttl time.Duration, metadata map[string]any) (string, error) {
 	if strings.TrimSpace(subject) == "" {
 		return "", errors.New("security: subject is required")
 	}
@@ -121,6 +179,34 @@ func (s *JWTService) GenerateToken(ctx context.Context, subject string, ttl time
 	}
 	claims := Claims{
 		Metadata: metadata,
+		Roles:    roles,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject,
+			ExpiresAt: jwt.NewNumericDate(s.clock().UTC().Add(ttl)),
+		},
+	}
+	return s.Sign(ctx, claims)
+}
+
+// GenerateScopedToken issues a token that lets a third-party app act for subject within scopes
+// under the given consent.
+func (s *JWTService) GenerateScopedToken(ctx context.Context, subject, clientID, consentID string, scopes []string, ttl time.Duration) (string, error) {
+	if strings.TrimSpace(subject) == "" {
+		return "", errors.New("security: subject is required")
+	}
+	if strings.TrimSpace(clientID) == "" || strings.TrimSpace(consentID) == "" {
+		return "", errors.New("security: client and consent are required for scoped tokens")
+	}
+	if len(scopes) == 0 {
+		return "", errors.New("security: at least one scope is required")
+	}
+	if ttl <= 0 {
+		return "", errors.New("security: token TTL must be positive")
+	}
+	claims := Claims{
+		ClientID:  clientID,
+		ConsentID: consentID,
+		Scope:     strings.Join(scopes, " "),
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   subject,
 			ExpiresAt: jwt.NewNumericDate(s.clock().UTC().Add(ttl)),
@@ -129,8 +215,55 @@ func (s *JWTService) GenerateToken(ctx context.Context, subject string, ttl time
 	return s.Sign(ctx, claims)
 }
 
-// Parse validates a token string and returns the claims when successful.
-func (s *JWTService) Parse(_ context.Context, tokenString string) (*Claims, error) {
+// GenerateRefreshToken issues a refresh token for subject. tokenID identifies the stored token and
+// familyID the chain of tokens it was rotated from.
+func (s *JWTService) GenerateRefreshToken(ctx context.Context, subject, tokenID, familyID string, ttl time.Duration) (string, error) {
+	if strings.TrimSpace(subject) == "" {
+		return "", errors.New("security: subject is required")
+	}
+	if strings.TrimSpace(tokenID) == "" || strings.TrimSpace(familyID) == "" {
+		return "", errors.New("security: token and family ids are required for refresh tokens")
+	}
+	if ttl <= 0 {
+		return "", errors.New("security: token TTL must be positive")
+	}
+	claims := Claims{
+		TokenUse: TokenUseRefresh,
+		FamilyID: familyID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenID,
+			Subject:   subject,
+			ExpiresAt: jwt.NewNumericDate(s.clock().UTC().Add(ttl)),
+		},
+	}
+	return s.Sign(ctx, claims)
+}
+
+// Parse validates a bearer token and returns the claims when successful. Refresh tokens are rejected.
+func (s *JWTService) Parse(ctx context.Context, tokenString string) (*Claims, error) {
+	claims, err := s.parse(ctx, tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if claims.IsRefresh() {
+		return nil, fmt.Errorf("%w: refresh tokens cannot be used as bearer tokens", ErrTokenInvalid)
+	}
+	return claims, nil
+}
+
+// ParseRefreshToken validates a refresh token and returns its claims.
+func (s *JWTService) ParseRefreshToken(ctx context.Context, tokenString string) (*Claims, error) {
+	claims, err := s.parse(ctx, tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if !claims.IsRefresh() || claims.ID == "" || claims.FamilyID == "" {
+		return nil, fmt.Errorf("%w: not a refresh token", ErrTokenInvalid)
+	}
+	return claims, nil
+}
+
+func (s *JWTService) parse(_ context.Context, tokenString string) (*Claims, error) {
 	if s == nil {
 		return nil, errors.New("security: JWT service not initialised")
 	}
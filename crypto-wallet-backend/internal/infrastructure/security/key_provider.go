@@ -0,0 +1,173 @@
+package security
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Master key provider backends.
+const (
+	KeyProviderLocal  = "local"
+	KeyProviderAWSKMS = "aws-kms"
+	KeyProviderGCPKMS = "gcp-kms"
+	KeyProviderVault  = "vault"
+)
+
+const defaultKeyProviderTimeout = 5 * time.Second
+
+// ErrKeyProviderUnavailable indicates that the master key could not be reached.
+var ErrKeyProviderUnavailable = errors.New("security: key provider unavailable")
+
+// KeyProvider is a master key that wraps data keys. Remote providers keep the key in a KMS or HSM,
+// so it never reaches the process; only data keys travel to them.
+type KeyProvider interface {
+	KeyWrapper
+	// Name identifies the backend in logs.
+	Name() string
+	// HealthCheck round-trips a probe through the key to confirm it is reachable and usable.
+	HealthCheck(ctx context.Context) error
+}
+
+// KeyProviderConfig selects and configures the backend holding a master key.
+type KeyProviderConfig struct {
+	// Backend is one of the KeyProvider constants; empty means local.
+	Backend string
+	// KeyID names the remote key: an AWS KMS key ID or ARN, a GCP KMS crypto key resource name
+	// (projects/.../cryptoKeys/...), or a Vault transit key name.
+	KeyID string
+	// Timeout bounds each call to the backend.
+	Timeout    time.Duration
+	AWS        AWSKMSConfig
+	GCP        GCPKMSConfig
+	Vault      VaultTransitConfig
+	HTTPClient *http.Client
+}
+
+// NewKeyProvider builds the configured provider. localKey is the AES-256 key used by the local
+// backend and is ignored by the others.
+func NewKeyProvider(cfg KeyProviderConfig, localKey []byte) (KeyProvider, error) {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultKeyProviderTimeout
+	}
+	client := cfg.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: timeout}
+	}
+
+	backend := strings.ToLower(strings.TrimSpace(cfg.Backend))
+	if backend != "" && backend != KeyProviderLocal && strings.TrimSpace(cfg.KeyID) == "" {
+		return nil, fmt.Errorf("security: %s key provider requires a key id", backend)
+	}
+
+	switch backend {
+	case "", KeyProviderLocal:
+		return NewLocalKeyProvider(localKey)
+	case KeyProviderAWSKMS:
+		return newAWSKMSProvider(cfg.AWS, cfg.KeyID, client, timeout)
+	case KeyProviderGCPKMS:
+		return newGCPKMSProvider(cfg.GCP, cfg.KeyID, client, timeout)
+	case KeyProviderVault:
+		return newVaultTransitProvider(cfg.Vault, cfg.KeyID, client, timeout)
+	default:
+		return nil, fmt.Errorf("security: unsupported key provider %q", cfg.Backend)
+	}
+}
+
+// OpenKeyProvider builds the configured provider and health-checks it. When a remote provider
+// fails its check and fallbackLocal is set, the local key is used instead so the service can
+// start; otherwise the check's error is returned.
+func OpenKeyProvider(ctx context.Context, cfg KeyProviderConfig, localKey []byte, fallbackLocal bool, logger *slog.Logger) (KeyProvider, error) {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	provider, err := NewKeyProvider(cfg, localKey)
+	if err == nil {
+		err = provider.HealthCheck(ctx)
+		if err == nil {
+			return provider, nil
+		}
+	}
+	if provider != nil && provider.Name() == KeyProviderLocal {
+		return nil, err
+	}
+	if !fallbackLocal || len(localKey) == 0 {
+		return nil, err
+	}
+
+	logger.Warn("master key provider unavailable; falling back to the local key",
+		slog.String("provider", cfg.Backend),
+		slog.String("error", err.Error()))
+	return NewLocalKeyProvider(localKey)
+}
+
+// LocalKeyProvider holds the master key in process memory, read from the environment.
+type LocalKeyProvider struct {
+	*AESGCMEncryptor
+}
+
+// NewLocalKeyProvider constructs a LocalKeyProvider for an AES-256 key.
+func NewLocalKeyProvider(key []byte) (*LocalKeyProvider, error) {
+	if len(key) == 0 {
+		return nil, errors.New("security: local key provider requires a key")
+	}
+	encryptor, err := NewAESGCMEncryptor(AESGCMConfig{Key: key})
+	if err != nil {
+		return nil, err
+	}
+	return &LocalKeyProvider{AESGCMEncryptor: encryptor}, nil
+}
+
+// Name identifies the backend.
+func (p *LocalKeyProvider) Name() string {
+	return KeyProviderLocal
+}
+
+// HealthCheck round-trips a probe through the key.
+func (p *LocalKeyProvider) HealthCheck(ctx context.Context) error {
+	return probeKeyWrapper(ctx, p)
+}
+
+// probeKeyWrapper wraps and unwraps a random value, checking it survives the round trip.
+func probeKeyWrapper(ctx context.Context, wrapper KeyWrapper) error {
+	probe := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, probe); err != nil {
+		return fmt.Errorf("security: generate probe: %w", err)
+	}
+	aad := []byte("key-provider-health")
+
+	wrapped, err := wrapper.EncryptContext(ctx, probe, aad)
+	if err != nil {
+		return err
+	}
+	unwrapped, err := wrapper.DecryptContext(ctx, wrapped, aad)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(probe, unwrapped) {
+		return errors.New("security: key provider probe did not round-trip")
+	}
+	return nil
+}
+
+// keyProviderStatusError maps an HTTP status from a key backend onto an error. 5xx responses and
+// throttling are reported as ErrKeyProviderUnavailable.
+func keyProviderStatusError(provider string, res *http.Response) error {
+	if res.StatusCode < 300 {
+		return nil
+	}
+	detail, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+	if res.StatusCode >= 500 || res.StatusCode == http.StatusTooManyRequests {
+		return fmt.Errorf("%w: %s status=%d", ErrKeyProviderUnavailable, provider, res.StatusCode)
+	}
+	return fmt.Errorf("security: %s request rejected: status=%d %s", provider, res.StatusCode, strings.TrimSpace(string(detail)))
+}
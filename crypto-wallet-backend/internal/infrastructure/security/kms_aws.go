@@ -0,0 +1,122 @@
+package security
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/smithy-go"
+)
+
+// AWSKMSConfig selects the AWS KMS region and endpoint. Credentials are not configured here: they
+// come from the SDK's default chain, so production uses the workload's role (IRSA web identity,
+// the ECS task role or the instance profile) and no long-lived secret is kept in the environment.
+type AWSKMSConfig struct {
+	Region string
+	// Endpoint overrides the regional endpoint, e.g. for a VPC endpoint or LocalStack.
+	Endpoint string
+}
+
+// awsKMSProvider wraps data keys with a symmetric AWS KMS key. The associated data is passed as
+// encryption context, which KMS binds to the ciphertext.
+type awsKMSProvider struct {
+	client  *kms.Client
+	keyID   string
+	timeout time.Duration
+}
+
+func newAWSKMSProvider(cfg AWSKMSConfig, keyID string, httpClient *http.Client, timeout time.Duration) (*awsKMSProvider, error) {
+	region := strings.TrimSpace(cfg.Region)
+	if region == "" {
+		return nil, errors.New("security: aws kms region is required")
+	}
+
+	// Loading only reads shared config files; credentials are resolved, and refreshed, on use.
+	awsCfg, err := awsconfig.LoadDefaultConfig(context.Background(),
+		awsconfig.WithRegion(region),
+		awsconfig.WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("security: load aws config: %w", err)
+	}
+
+	endpoint := strings.TrimSpace(cfg.Endpoint)
+	client := kms.NewFromConfig(awsCfg, func(o *kms.Options) {
+		if endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		}
+	})
+
+	return &awsKMSProvider{
+		client:  client,
+		keyID:   strings.TrimSpace(keyID),
+		timeout: timeout,
+	}, nil
+}
+
+// Name identifies the backend.
+func (p *awsKMSProvider) Name() string {
+	return KeyProviderAWSKMS
+}
+
+// HealthCheck round-trips a probe through the KMS key.
+func (p *awsKMSProvider) HealthCheck(ctx context.Context) error {
+	return probeKeyWrapper(ctx, p)
+}
+
+// EncryptContext wraps plaintext with the KMS key.
+func (p *awsKMSProvider) EncryptContext(ctx context.Context, plaintext, additionalData []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	out, err := p.client.Encrypt(ctx, &kms.EncryptInput{
+		KeyId:             aws.String(p.keyID),
+		Plaintext:         plaintext,
+		EncryptionContext: awsKMSEncryptionContext(additionalData),
+	})
+	if err != nil {
+		return nil, awsKMSError(err)
+	}
+	return out.CiphertextBlob, nil
+}
+
+// DecryptContext unwraps a ciphertext produced by EncryptContext.
+func (p *awsKMSProvider) DecryptContext(ctx context.Context, ciphertext, additionalData []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	out, err := p.client.Decrypt(ctx, &kms.DecryptInput{
+		KeyId:             aws.String(p.keyID),
+		CiphertextBlob:    ciphertext,
+		EncryptionContext: awsKMSEncryptionContext(additionalData),
+	})
+	if err != nil {
+		return nil, awsKMSError(err)
+	}
+	return out.Plaintext, nil
+}
+
+// awsKMSError reports server faults, throttling and failures to reach KMS or obtain credentials as
+// ErrKeyProviderUnavailable; anything KMS rejected is returned as is.
+func awsKMSError(err error) error {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) && apiErr.ErrorFault() != smithy.FaultServer && apiErr.ErrorCode() != "ThrottlingException" {
+		return fmt.Errorf("security: aws-kms request rejected: %w", err)
+	}
+	return fmt.Errorf("%w: aws-kms: %v", ErrKeyProviderUnavailable, err)
+}
+
+// awsKMSEncryptionContext carries the associated data; KMS only accepts string values.
+func awsKMSEncryptionContext(additionalData []byte) map[string]string {
+	if len(additionalData) == 0 {
+		return nil
+	}
+	return map[string]string{"aad": base64.StdEncoding.EncodeToString(additionalData)}
+}
@@ -0,0 +1,195 @@
+package security
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	gcpKMSDefaultEndpoint = "https://cloudkms.googleapis.com"
+	gcpMetadataTokenURL   = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+	// gcpTokenRefreshMargin renews metadata tokens this long before they expire.
+	gcpTokenRefreshMargin = time.Minute
+)
+
+// GCPKMSConfig configures access to Google Cloud KMS. Without an access token, tokens for the
+// instance's service account are fetched from the metadata server.
+type GCPKMSConfig struct {
+	AccessToken string
+	// Endpoint overrides the Cloud KMS API endpoint.
+	Endpoint string
+}
+
+type gcpKMSEncryptRequest struct {
+	Plaintext                   []byte `json:"plaintext"`
+	AdditionalAuthenticatedData []byte `json:"additionalAuthenticatedData,omitempty"`
+}
+
+type gcpKMSDecryptRequest struct {
+	Ciphertext                  []byte `json:"ciphertext"`
+	AdditionalAuthenticatedData []byte `json:"additionalAuthenticatedData,omitempty"`
+}
+
+type gcpKMSResponse struct {
+	Ciphertext []byte `json:"ciphertext"`
+	Plaintext  []byte `json:"plaintext"`
+}
+
+type gcpMetadataToken struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// gcpKMSProvider wraps data keys with a symmetric Cloud KMS crypto key through the REST API.
+type gcpKMSProvider struct {
+	endpoint    string
+	keyName     string
+	staticToken string
+	httpClient  *http.Client
+	timeout     time.Duration
+	now         func() time.Time
+
+	mu          sync.Mutex
+	token       string
+	tokenExpiry time.Time
+}
+
+func newGCPKMSProvider(cfg GCPKMSConfig, keyName string, httpClient *http.Client, timeout time.Duration) (*gcpKMSProvider, error) {
+	keyName = strings.Trim(strings.TrimSpace(keyName), "/")
+	if !strings.HasPrefix(keyName, "projects/") || !strings.Contains(keyName, "/cryptoKeys/") {
+		return nil, fmt.Errorf("security: gcp kms key must be a crypto key resource name, got %q", keyName)
+	}
+
+	endpoint := strings.TrimRight(strings.TrimSpace(cfg.Endpoint), "/")
+	if endpoint == "" {
+		endpoint = gcpKMSDefaultEndpoint
+	}
+
+	return &gcpKMSProvider{
+		endpoint:    endpoint,
+		keyName:     keyName,
+		staticToken: strings.TrimSpace(cfg.AccessToken),
+		httpClient:  httpClient,
+		timeout:     timeout,
+		now:         time.Now,
+	}, nil
+}
+
+// Name identifies the backend.
+func (p *gcpKMSProvider) Name() string {
+	return KeyProviderGCPKMS
+}
+
+// HealthCheck round-trips a probe through the crypto key.
+func (p *gcpKMSProvider) HealthCheck(ctx context.Context) error {
+	return probeKeyWrapper(ctx, p)
+}
+
+// EncryptContext wraps plaintext with the crypto key's primary version.
+func (p *gcpKMSProvider) EncryptContext(ctx context.Context, plaintext, additionalData []byte) ([]byte, error) {
+	var res gcpKMSResponse
+	if err := p.call(ctx, "encrypt", gcpKMSEncryptRequest{
+		Plaintext:                   plaintext,
+		AdditionalAuthenticatedData: additionalData,
+	}, &res); err != nil {
+		return nil, err
+	}
+	return res.Ciphertext, nil
+}
+
+// DecryptContext unwraps a ciphertext produced by EncryptContext; Cloud KMS picks the key version itself.
+func (p *gcpKMSProvider) DecryptContext(ctx context.Context, ciphertext, additionalData []byte) ([]byte, error) {
+	var res gcpKMSResponse
+	if err := p.call(ctx, "decrypt", gcpKMSDecryptRequest{
+		Ciphertext:                  ciphertext,
+		AdditionalAuthenticatedData: additionalData,
+	}, &res); err != nil {
+		return nil, err
+	}
+	return res.Plaintext, nil
+}
+
+func (p *gcpKMSProvider) call(ctx context.Context, method string, payload, out any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("security: encode gcp kms payload: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	token, err := p.accessToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	endpoint := p.endpoint + "/v1/" + p.keyName + ":" + method
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("security: create gcp kms request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: gcp-kms: %v", ErrKeyProviderUnavailable, err)
+	}
+	defer res.Body.Close()
+
+	if err := keyProviderStatusError(KeyProviderGCPKMS, res); err != nil {
+		return err
+	}
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("security: decode gcp kms response: %w", err)
+	}
+	return nil
+}
+
+// accessToken returns the configured token, or a cached metadata server token.
+func (p *gcpKMSProvider) accessToken(ctx context.Context) (string, error) {
+	if p.staticToken != "" {
+		return p.staticToken, nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.token != "" && p.now().Before(p.tokenExpiry) {
+		return p.token, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, gcpMetadataTokenURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("security: create metadata token request: %w", err)
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("%w: gcp metadata server: %v", ErrKeyProviderUnavailable, err)
+	}
+	defer res.Body.Close()
+
+	if err := keyProviderStatusError("gcp metadata server", res); err != nil {
+		return "", err
+	}
+	var token gcpMetadataToken
+	if err := json.NewDecoder(res.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("security: decode metadata token: %w", err)
+	}
+	if token.AccessToken == "" {
+		return "", errors.New("security: metadata server returned an empty access token")
+	}
+
+	p.token = token.AccessToken
+	p.tokenExpiry = p.now().Add(time.Duration(token.ExpiresIn)*time.Second - gcpTokenRefreshMargin)
+	return p.token, nil
+}
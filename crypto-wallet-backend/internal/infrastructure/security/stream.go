@@ -0,0 +1,215 @@
+package security
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Streams are encrypted in chunks so files of any size can be encrypted and decrypted without
+// holding them in memory. The layout is a header of streamMagic and a random nonce prefix, then
+// chunks of up to streamChunkSize plaintext bytes, each sealed with AES-256-GCM under the nonce
+// prefix, the chunk counter and a final-chunk flag. The flag and counter make truncation and
+// reordering detectable.
+const (
+	streamMagic      = "CWS1"
+	streamPrefixSize = 7
+	streamHeaderSize = len(streamMagic) + streamPrefixSize
+	streamChunkSize  = 64 * 1024
+	streamTagSize    = 16
+)
+
+// ErrStreamTruncated indicates that an encrypted stream ended before its final chunk.
+var ErrStreamTruncated = errors.New("security: encrypted stream truncated")
+
+// StreamCiphertextSize returns the encrypted size of a stream of plaintextSize bytes.
+func StreamCiphertextSize(plaintextSize int64) int64 {
+	chunks := (plaintextSize + streamChunkSize - 1) / streamChunkSize
+	if chunks == 0 {
+		chunks = 1
+	}
+	return int64(streamHeaderSize) + chunks*streamTagSize + plaintextSize
+}
+
+// NewStreamEncrypter returns a writer that encrypts everything written to it into dst under key,
+// binding additionalData to every chunk. Close must be called to write the final chunk; it does
+// not close dst.
+func NewStreamEncrypter(dst io.Writer, key, additionalData []byte) (io.WriteCloser, error) {
+	aead, err := newStreamAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	prefix := make([]byte, streamPrefixSize)
+	if _, err := io.ReadFull(rand.Reader, prefix); err != nil {
+		return nil, fmt.Errorf("security: generate stream nonce: %w", err)
+	}
+	if _, err := dst.Write(append([]byte(streamMagic), prefix...)); err != nil {
+		return nil, err
+	}
+	return &streamEncrypter{
+		dst:            dst,
+		aead:           aead,
+		prefix:         prefix,
+		additionalData: additionalData,
+		buf:            make([]byte, 0, streamChunkSize),
+	}, nil
+}
+
+type streamEncrypter struct {
+	dst            io.Writer
+	aead           cipher.AEAD
+	prefix         []byte
+	additionalData []byte
+	buf            []byte
+	counter        uint32
+	closed         bool
+}
+
+func (e *streamEncrypter) Write(p []byte) (int, error) {
+	if e.closed {
+		return 0, errors.New("security: write to closed stream")
+	}
+	written := 0
+	for len(p) > 0 {
+		// A full chunk is only sealed once more data arrives, so the final chunk is never empty
+		// unless the whole stream is.
+		if len(e.buf) == streamChunkSize {
+			if err := e.seal(false); err != nil {
+				return written, err
+			}
+		}
+		n := copy(e.buf[len(e.buf):streamChunkSize], p)
+		e.buf = e.buf[:len(e.buf)+n]
+		p = p[n:]
+		written += n
+	}
+	return written, nil
+}
+
+func (e *streamEncrypter) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+	return e.seal(true)
+}
+
+func (e *streamEncrypter) seal(final bool) error {
+	if e.counter == ^uint32(0) {
+		return errors.New("security: stream too long")
+	}
+	sealed := e.aead.Seal(nil, streamNonce(e.prefix, e.counter, final), e.buf, e.additionalData)
+	e.counter++
+	e.buf = e.buf[:0]
+	_, err := e.dst.Write(sealed)
+	return err
+}
+
+// NewStreamDecrypter reads the header of a stream produced by NewStreamEncrypter and returns a
+// reader of its plaintext. Every chunk is authenticated before it is returned; a stream that is
+// cut short fails with ErrStreamTruncated.
+func NewStreamDecrypter(src io.Reader, key, additionalData []byte) (io.Reader, error) {
+	aead, err := newStreamAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	header := make([]byte, streamHeaderSize)
+	if _, err := io.ReadFull(src, header); err != nil {
+		return nil, fmt.Errorf("security: read stream header: %w", ErrInvalidCiphertext)
+	}
+	if string(header[:len(streamMagic)]) != streamMagic {
+		return nil, ErrInvalidCiphertext
+	}
+	return &streamDecrypter{
+		src:            bufio.NewReaderSize(src, streamChunkSize+streamTagSize+1),
+		aead:           aead,
+		prefix:         header[len(streamMagic):],
+		additionalData: additionalData,
+		chunk:          make([]byte, streamChunkSize+streamTagSize),
+	}, nil
+}
+
+type streamDecrypter struct {
+	src            *bufio.Reader
+	aead           cipher.AEAD
+	prefix         []byte
+	additionalData []byte
+	chunk          []byte
+	plaintext      []byte
+	counter        uint32
+	done           bool
+}
+
+func (d *streamDecrypter) Read(p []byte) (int, error) {
+	for len(d.plaintext) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err := d.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, d.plaintext)
+	d.plaintext = d.plaintext[n:]
+	return n, nil
+}
+
+func (d *streamDecrypter) open() error {
+	n, err := io.ReadFull(d.src, d.chunk)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return err
+	}
+	if n < streamTagSize {
+		return ErrStreamTruncated
+	}
+	// The chunk is final when nothing follows it.
+	final := n < len(d.chunk)
+	if !final {
+		if _, err := d.src.Peek(1); errors.Is(err, io.EOF) {
+			final = true
+		} else if err != nil {
+			return err
+		}
+	}
+
+	plaintext, err := d.aead.Open(nil, streamNonce(d.prefix, d.counter, final), d.chunk[:n], d.additionalData)
+	if err != nil {
+		if final {
+			// A non-final chunk at the end of the stream means the rest was cut off.
+			if _, retryErr := d.aead.Open(nil, streamNonce(d.prefix, d.counter, false), d.chunk[:n], d.additionalData); retryErr == nil {
+				return ErrStreamTruncated
+			}
+		}
+		return ErrInvalidCiphertext
+	}
+	d.counter++
+	d.plaintext = plaintext
+	d.done = final
+	return nil
+}
+
+func newStreamAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != AES256KeySize {
+		return nil, fmt.Errorf("security: stream key must be %d bytes", AES256KeySize)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func streamNonce(prefix []byte, counter uint32, final bool) []byte {
+	nonce := make([]byte, streamPrefixSize+5)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[streamPrefixSize:], counter)
+	if final {
+		nonce[len(nonce)-1] = 1
+	}
+	return nonce
+}
@@ -54,10 +54,17 @@ func ValidateTOTP(secret, code string) bool {
 
 // ValidateTOTPWithWindow verifies a code allowing for configurable skew (number of periods).
 func ValidateTOTPWithWindow(secret, code string, window int) bool {
+    _, ok := MatchTOTP(secret, code, window)
+    return ok
+}
+
+// MatchTOTP verifies a code like ValidateTOTPWithWindow and returns the time step it belongs to,
+// so callers can refuse a code that was already used.
+func MatchTOTP(secret, code string, window int) (int64, bool) {
     secret = strings.TrimSpace(secret)
     code = strings.TrimSpace(code)
     if secret == "" || code == "" {
-        return false
+        return 0, false
     }
 
     if window < 0 {
@@ -66,7 +73,7 @@ func ValidateTOTPWithWindow(secret, code string, window int) bool {
 
     key, err := decodeSecret(secret)
     if err != nil {
-        return false
+        return 0, false
     }
 
     counter := time.Now().UTC().Unix() / defaultTOTPPeriod
@@ -76,12 +83,15 @@ func ValidateTOTPWithWindow(secret, code string, window int) bool {
             continue
         }
         if subtleConstantTimeCompare(code, expected) {
-            return true
+            return counter + int64(offset), true
         }
     }
-    return false
+    return 0, false
 }
 
+// TOTPPeriod is the length of one TOTP time step.
+const TOTPPeriod = defaultTOTPPeriod * time.Second
+
 func decodeSecret(secret string) ([]byte, error) {
     padding := len(secret) % 8
     if padding != 0 {
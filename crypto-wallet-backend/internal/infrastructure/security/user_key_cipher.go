@@ -0,0 +1,188 @@
+package security
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// userKeyPrefix marks ciphertexts encrypted under a per-user key. Ciphertexts without it predate
+// per-user keys and were encrypted under the master key directly.
+const userKeyPrefix = "uk1:"
+
+// ErrUserKeyUnavailable indicates that the user's data key was destroyed, so their ciphertexts can
+// no longer be read.
+var ErrUserKeyUnavailable = errors.New("security: user data key unavailable")
+
+// UserKeyCipher encrypts personal data under a random key per user, wrapped by the master key and
+// stored in a UserDataKeyRepository. Shred destroys a user's key, which makes every ciphertext
+// encrypted under it unreadable without touching the rows that hold them. The user ID is bound
+// into every ciphertext as associated data. Previous master keys only unwrap, so keys can be
+// moved to a new master with RewrapKey while both are configured.
+type UserKeyCipher struct {
+	master   KeyWrapper
+	previous []KeyWrapper
+	keys     repositories.UserDataKeyRepository
+}
+
+// NewUserKeyCipher constructs a UserKeyCipher.
+func NewUserKeyCipher(master KeyWrapper, keys repositories.UserDataKeyRepository, previous ...KeyWrapper) *UserKeyCipher {
+	return &UserKeyCipher{
+		master:   master,
+		previous: previous,
+		keys:     keys,
+	}
+}
+
+// Encrypt encrypts plaintext under the user's key, creating the key on first use.
+func (c *UserKeyCipher) Encrypt(ctx context.Context, userID uuid.UUID, plaintext []byte) (string, error) {
+	if c == nil || c.master == nil || c.keys == nil {
+		return "", errors.New("security: user key cipher not initialised")
+	}
+	encryptor, err := c.userEncryptor(ctx, userID, true)
+	if err != nil {
+		return "", err
+	}
+	payload, err := encryptor.EncryptToString(plaintext, []byte(userID.String()))
+	if err != nil {
+		return "", err
+	}
+	return userKeyPrefix + payload, nil
+}
+
+// Decrypt decrypts a ciphertext produced by Encrypt, or one encrypted under the master key before
+// per-user keys were introduced. Returns ErrUserKeyUnavailable once the user's key is shredded.
+func (c *UserKeyCipher) Decrypt(ctx context.Context, userID uuid.UUID, ciphertext string) ([]byte, error) {
+	if c == nil || c.master == nil || c.keys == nil {
+		return nil, errors.New("security: user key cipher not initialised")
+	}
+	payload, ok := strings.CutPrefix(ciphertext, userKeyPrefix)
+	if !ok {
+		return c.decryptLegacy(ctx, ciphertext, []byte(userID.String()))
+	}
+	encryptor, err := c.userEncryptor(ctx, userID, false)
+	if err != nil {
+		return nil, err
+	}
+	return encryptor.DecryptString(payload, []byte(userID.String()))
+}
+
+// Shred destroys the user's key. Shredding a user without a key is not an error.
+func (c *UserKeyCipher) Shred(ctx context.Context, userID uuid.UUID) error {
+	if c == nil || c.keys == nil {
+		return errors.New("security: user key cipher not initialised")
+	}
+	if err := c.keys.Delete(ctx, userID); err != nil && !errors.Is(err, repositories.ErrNotFound) {
+		return fmt.Errorf("security: shred user key: %w", err)
+	}
+	return nil
+}
+
+// RewrapKey returns the user's wrapped key re-wrapped by the master key, and whether it changed.
+func (c *UserKeyCipher) RewrapKey(ctx context.Context, userID uuid.UUID, wrapped string) (string, bool, error) {
+	if c == nil || c.master == nil {
+		return "", false, errors.New("security: user key cipher not initialised")
+	}
+	wrapAD := userKeyAssociatedData(userID)
+	key, primary, err := c.unwrap(ctx, wrapped, wrapAD)
+	if err != nil {
+		return "", false, err
+	}
+	if primary {
+		return wrapped, false, nil
+	}
+	rewrapped, err := c.wrap(ctx, key, wrapAD)
+	return rewrapped, err == nil, err
+}
+
+func (c *UserKeyCipher) userEncryptor(ctx context.Context, userID uuid.UUID, create bool) (*AESGCMEncryptor, error) {
+	wrapAD := userKeyAssociatedData(userID)
+
+	wrapped, err := c.keys.Get(ctx, userID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		if !create {
+			return nil, ErrUserKeyUnavailable
+		}
+		wrapped, err = c.createKey(ctx, userID, wrapAD)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key, _, err := c.unwrap(ctx, wrapped, wrapAD)
+	if err != nil {
+		return nil, err
+	}
+	return NewAESGCMEncryptor(AESGCMConfig{Key: key})
+}
+
+// createKey stores a new wrapped key for the user, returning the stored one when a concurrent
+// request created it first.
+func (c *UserKeyCipher) createKey(ctx context.Context, userID uuid.UUID, wrapAD []byte) (string, error) {
+	key, err := GenerateRandomKey()
+	if err != nil {
+		return "", err
+	}
+	wrapped, err := c.wrap(ctx, key, wrapAD)
+	if err != nil {
+		return "", err
+	}
+	if err := c.keys.Create(ctx, userID, wrapped); err != nil {
+		if errors.Is(err, repositories.ErrDuplicate) {
+			return c.keys.Get(ctx, userID)
+		}
+		return "", err
+	}
+	return wrapped, nil
+}
+
+func (c *UserKeyCipher) wrap(ctx context.Context, key, wrapAD []byte) (string, error) {
+	wrapped, err := c.master.EncryptContext(ctx, key, wrapAD)
+	if err != nil {
+		return "", fmt.Errorf("security: wrap user key: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(wrapped), nil
+}
+
+// unwrap recovers a user key, reporting whether it was wrapped by the current master key.
+func (c *UserKeyCipher) unwrap(ctx context.Context, wrapped string, wrapAD []byte) ([]byte, bool, error) {
+	data, err := base64.StdEncoding.DecodeString(wrapped)
+	if err != nil {
+		return nil, false, fmt.Errorf("security: decode user key: %w", err)
+	}
+	if key, err := c.master.DecryptContext(ctx, data, wrapAD); err == nil {
+		return key, true, nil
+	}
+	for _, master := range c.previous {
+		if key, err := master.DecryptContext(ctx, data, wrapAD); err == nil {
+			return key, false, nil
+		}
+	}
+	return nil, false, fmt.Errorf("security: unwrap user key: %w", ErrInvalidCiphertext)
+}
+
+func (c *UserKeyCipher) decryptLegacy(ctx context.Context, ciphertext string, additionalData []byte) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("security: decode payload: %w", err)
+	}
+	if plaintext, err := c.master.DecryptContext(ctx, data, additionalData); err == nil {
+		return plaintext, nil
+	}
+	for _, master := range c.previous {
+		if plaintext, err := master.DecryptContext(ctx, data, additionalData); err == nil {
+			return plaintext, nil
+		}
+	}
+	return nil, fmt.Errorf("security: decrypt payload: %w", ErrInvalidCiphertext)
+}
+
+func userKeyAssociatedData(userID uuid.UUID) []byte {
+	return []byte("user-data-key:" + userID.String())
+}
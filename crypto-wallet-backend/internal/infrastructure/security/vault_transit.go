@@ -0,0 +1,167 @@
+package security
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const defaultVaultTransitMount = "transit"
+
+// VaultTransitConfig configures access to a HashiCorp Vault transit secrets engine.
+type VaultTransitConfig struct {
+	Address string
+	Token   string
+	// Namespace is sent as X-Vault-Namespace on Vault Enterprise.
+	Namespace string
+	// Mount is the path the transit engine is mounted at; defaults to "transit".
+	Mount string
+}
+
+type vaultTransitRequest struct {
+	Plaintext  string `json:"plaintext,omitempty"`
+	Ciphertext string `json:"ciphertext,omitempty"`
+}
+
+type vaultTransitResponse struct {
+	Data struct {
+		Plaintext  string `json:"plaintext"`
+		Ciphertext string `json:"ciphertext"`
+	} `json:"data"`
+}
+
+// vaultTransitProvider wraps data keys with a Vault transit key. Transit only binds a context to
+// derived keys, so the associated data is bound here instead: its SHA-256 is sealed alongside the
+// plaintext and checked on decrypt. Ciphertexts are Vault's "vault:v<n>:..." strings.
+type vaultTransitProvider struct {
+	baseURL    *url.URL
+	token      string
+	namespace  string
+	mount      string
+	keyName    string
+	httpClient *http.Client
+	timeout    time.Duration
+}
+
+func newVaultTransitProvider(cfg VaultTransitConfig, keyName string, httpClient *http.Client, timeout time.Duration) (*vaultTransitProvider, error) {
+	rawAddress := strings.TrimSpace(cfg.Address)
+	if rawAddress == "" {
+		return nil, errors.New("security: vault address is required")
+	}
+	baseURL, err := url.Parse(rawAddress)
+	if err != nil || baseURL.Host == "" {
+		return nil, fmt.Errorf("security: vault address is invalid: %q", rawAddress)
+	}
+	if strings.TrimSpace(cfg.Token) == "" {
+		return nil, errors.New("security: vault token is required")
+	}
+
+	mount := strings.Trim(strings.TrimSpace(cfg.Mount), "/")
+	if mount == "" {
+		mount = defaultVaultTransitMount
+	}
+
+	return &vaultTransitProvider{
+		baseURL:    baseURL,
+		token:      strings.TrimSpace(cfg.Token),
+		namespace:  strings.TrimSpace(cfg.Namespace),
+		mount:      mount,
+		keyName:    strings.TrimSpace(keyName),
+		httpClient: httpClient,
+		timeout:    timeout,
+	}, nil
+}
+
+// Name identifies the backend.
+func (p *vaultTransitProvider) Name() string {
+	return KeyProviderVault
+}
+
+// HealthCheck round-trips a probe through the transit key.
+func (p *vaultTransitProvider) HealthCheck(ctx context.Context) error {
+	return probeKeyWrapper(ctx, p)
+}
+
+// EncryptContext wraps plaintext with the transit key.
+func (p *vaultTransitProvider) EncryptContext(ctx context.Context, plaintext, additionalData []byte) ([]byte, error) {
+	digest := sha256.Sum256(additionalData)
+	sealed := append(digest[:], plaintext...)
+
+	var res vaultTransitResponse
+	if err := p.call(ctx, "encrypt", vaultTransitRequest{
+		Plaintext: base64.StdEncoding.EncodeToString(sealed),
+	}, &res); err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(res.Data.Ciphertext, "vault:") {
+		return nil, errors.New("security: vault returned an unexpected ciphertext")
+	}
+	return []byte(res.Data.Ciphertext), nil
+}
+
+// DecryptContext unwraps a ciphertext produced by EncryptContext, rejecting it if the associated
+// data differs.
+func (p *vaultTransitProvider) DecryptContext(ctx context.Context, ciphertext, additionalData []byte) ([]byte, error) {
+	if !bytes.HasPrefix(ciphertext, []byte("vault:")) {
+		return nil, ErrInvalidCiphertext
+	}
+
+	var res vaultTransitResponse
+	if err := p.call(ctx, "decrypt", vaultTransitRequest{Ciphertext: string(ciphertext)}, &res); err != nil {
+		return nil, err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(res.Data.Plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("security: decode vault plaintext: %w", err)
+	}
+
+	digest := sha256.Sum256(additionalData)
+	if len(sealed) < len(digest) || subtle.ConstantTimeCompare(sealed[:len(digest)], digest[:]) != 1 {
+		return nil, ErrInvalidCiphertext
+	}
+	return sealed[len(digest):], nil
+}
+
+func (p *vaultTransitProvider) call(ctx context.Context, operation string, payload, out any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("security: encode vault payload: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	endpoint := p.baseURL.JoinPath("v1", p.mount, operation, p.keyName)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("security: create vault request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Vault-Token", p.token)
+	if p.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.namespace)
+	}
+
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: vault: %v", ErrKeyProviderUnavailable, err)
+	}
+	defer res.Body.Close()
+
+	if err := keyProviderStatusError(KeyProviderVault, res); err != nil {
+		return err
+	}
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("security: decode vault response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+// Package stepup keeps the short-lived state behind two-factor step-up: which TOTP codes have
+// been spent and which sessions were recently elevated. MemoryStore suits a single instance;
+// RedisStore shares the state so a code spent on one instance cannot be replayed on another.
+package stepup
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// DefaultRedisPrefix namespaces step-up keys in a shared Redis.
+const DefaultRedisPrefix = "stepup:"
+
+// MemoryStore keeps step-up state in process memory. It is safe for concurrent use.
+type MemoryStore struct {
+	mu      sync.Mutex
+	entries map[string]time.Time
+	now     func() time.Time
+}
+
+// NewMemoryStore constructs an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: make(map[string]time.Time), now: time.Now}
+}
+
+// ClaimCode records that the user spent the code of a TOTP time step and reports false when it
+// had already been spent.
+func (s *MemoryStore) ClaimCode(_ context.Context, userID string, step int64, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+	key := codeKey(userID, step)
+	if _, spent := s.entries[key]; spent {
+		return false, nil
+	}
+	s.entries[key] = now.Add(ttl)
+	return true, nil
+}
+
+// Elevate marks the session as recently verified for ttl.
+func (s *MemoryStore) Elevate(_ context.Context, session string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[sessionKey(session)] = s.now().Add(ttl)
+	return nil
+}
+
+// IsElevated reports whether the session was verified within its elevation window.
+func (s *MemoryStore) IsElevated(_ context.Context, session string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expires, ok := s.entries[sessionKey(session)]
+	return ok && s.now().Before(expires), nil
+}
+
+func (s *MemoryStore) sweep(now time.Time) {
+	for key, expires := range s.entries {
+		if !now.Before(expires) {
+			delete(s.entries, key)
+		}
+	}
+}
+
+// RedisStore keeps step-up state in Redis with expiring keys.
+type RedisStore struct {
+	client redis.Cmdable
+	prefix string
+}
+
+// NewRedisStore constructs a RedisStore; prefix defaults to DefaultRedisPrefix.
+func NewRedisStore(client redis.Cmdable, prefix string) *RedisStore {
+	if prefix == "" {
+		prefix = DefaultRedisPrefix
+	}
+	return &RedisStore{client: client, prefix: prefix}
+}
+
+// ClaimCode records that the user spent the code of a TOTP time step and reports false when it
+// had already been spent.
+func (s *RedisStore) ClaimCode(ctx context.Context, userID string, step int64, ttl time.Duration) (bool, error) {
+	claimed, err := s.client.SetNX(ctx, s.prefix+codeKey(userID, step), 1, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("step-up: claim code: %w", err)
+	}
+	return claimed, nil
+}
+
+// Elevate marks the session as recently verified for ttl.
+func (s *RedisStore) Elevate(ctx context.Context, session string, ttl time.Duration) error {
+	if err := s.client.Set(ctx, s.prefix+sessionKey(session), 1, ttl).Err(); err != nil {
+		return fmt.Errorf("step-up: elevate session: %w", err)
+	}
+	return nil
+}
+
+// IsElevated reports whether the session was verified within its elevation window.
+func (s *RedisStore) IsElevated(ctx context.Context, session string) (bool, error) {
+	count, err := s.client.Exists(ctx, s.prefix+sessionKey(session)).Result()
+	if err != nil {
+		return false, fmt.Errorf("step-up: check session: %w", err)
+	}
+	return count > 0, nil
+}
+
+func codeKey(userID string, step int64) string {
+	return "code:" + userID + ":" + strconv.FormatInt(step, 10)
+}
+
+func sessionKey(session string) string {
+	return "session:" + session
+}
@@ -0,0 +1,65 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// maxStatementLength bounds the SQL recorded on query spans.
+const maxStatementLength = 2048
+
+type querySpanKey struct{}
+
+// QueryTracer records a client span for every pgx query made within a traced request. Statements
+// are recorded with their placeholders; argument values are never attached.
+type QueryTracer struct {
+	// Database names the logical database (core, kyc, rates, audit) on each span.
+	Database string
+}
+
+// TraceQueryStart implements pgx.QueryTracer.
+func (t QueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	operation := sqlOperation(data.SQL)
+	statement := data.SQL
+	if len(statement) > maxStatementLength {
+		statement = statement[:maxStatementLength]
+	}
+	ctx, span, ok := startChild(ctx, "postgres "+operation, trace.SpanKindClient,
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.namespace", t.Database),
+		attribute.String("db.operation.name", operation),
+		attribute.String("db.query.text", statement),
+	)
+	if !ok {
+		return ctx
+	}
+	return context.WithValue(ctx, querySpanKey{}, span)
+}
+
+// TraceQueryEnd implements pgx.QueryTracer. A query that finds no row is not a failure.
+func (t QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	// The span is looked up by its own key: without one, the context's span is the caller's.
+	span, ok := ctx.Value(querySpanKey{}).(trace.Span)
+	if !ok {
+		return
+	}
+	err := data.Err
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = nil
+	}
+	span.SetAttributes(attribute.Int64("db.response.rows_affected", data.CommandTag.RowsAffected()))
+	End(span, err)
+}
+
+func sqlOperation(sql string) string {
+	fields := strings.Fields(sql)
+	if len(fields) == 0 {
+		return "QUERY"
+	}
+	return strings.ToUpper(fields[0])
+}
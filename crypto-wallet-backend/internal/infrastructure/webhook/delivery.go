@@ -0,0 +1,116 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultDeliveryTimeout = 10 * time.Second
+	defaultUserAgent       = "atlas-wallet-webhooks/1.0"
+	// maxResponseExcerpt bounds how much of the receiver's response body is kept for diagnostics.
+	maxResponseExcerpt = 1024
+)
+
+// Delivery is one signed payload bound for an endpoint.
+type Delivery struct {
+	ID        string
+	Event     string
+	URL       string
+	Secret    string
+	Payload   []byte
+	Timestamp time.Time
+}
+
+// DeliveryResult reports what was sent and how the receiver answered.
+type DeliveryResult struct {
+	StatusCode      int
+	ResponseExcerpt string
+	Duration        time.Duration
+	Headers         map[string]string
+}
+
+// Succeeded reports whether the receiver acknowledged the delivery with a 2xx status.
+func (r DeliveryResult) Succeeded() bool {
+	return r.StatusCode >= 200 && r.StatusCode < 300
+}
+
+// Deliverer posts signed payloads to webhook endpoints.
+type Deliverer struct {
+	httpClient *http.Client
+	userAgent  string
+	now        func() time.Time
+}
+
+// NewDeliverer constructs a Deliverer; a nil client gets a default with a short timeout.
+func NewDeliverer(httpClient *http.Client) *Deliverer {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultDeliveryTimeout}
+	}
+	return &Deliverer{
+		httpClient: httpClient,
+		userAgent:  defaultUserAgent,
+		now:        time.Now,
+	}
+}
+
+// Deliver signs the payload with the current scheme and posts it. Transport failures are returned
+// as errors; non-2xx responses are reported through the result.
+func (d *Deliverer) Deliver(ctx context.Context, delivery Delivery) (DeliveryResult, error) {
+	signer, err := NewSigner(delivery.Secret)
+	if err != nil {
+		return DeliveryResult{}, err
+	}
+
+	timestamp := delivery.Timestamp
+	if timestamp.IsZero() {
+		timestamp = d.now()
+	}
+	signature, err := signer.Sign(CurrentSignatureVersion, timestamp, delivery.Payload)
+	if err != nil {
+		return DeliveryResult{}, err
+	}
+
+	headers := map[string]string{
+		HeaderID:               delivery.ID,
+		HeaderEvent:            delivery.Event,
+		HeaderTimestamp:        strconv.FormatInt(timestamp.Unix(), 10),
+		HeaderSignature:        signature,
+		HeaderSignatureVersion: CurrentSignatureVersion,
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return DeliveryResult{}, fmt.Errorf("webhook: create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", d.userAgent)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	started := d.now()
+	res, err := d.httpClient.Do(req)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return DeliveryResult{Headers: headers}, fmt.Errorf("webhook: delivery timed out: %w", err)
+		}
+		return DeliveryResult{Headers: headers}, fmt.Errorf("webhook: deliver: %w", err)
+	}
+	defer res.Body.Close()
+
+	excerpt, _ := io.ReadAll(io.LimitReader(res.Body, maxResponseExcerpt))
+	return DeliveryResult{
+		StatusCode:      res.StatusCode,
+		ResponseExcerpt: strings.TrimSpace(string(excerpt)),
+		Duration:        d.now().Sub(started),
+		Headers:         headers,
+	}, nil
+}
@@ -0,0 +1,111 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// HeaderID carries the unique delivery identifier; receivers use it to de-duplicate retries.
+	HeaderID = "X-Webhook-Id"
+	// HeaderEvent carries the event type of the payload.
+	HeaderEvent = "X-Webhook-Event"
+	// HeaderTimestamp carries the Unix time (seconds) the payload was signed at.
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	// HeaderSignature carries the signature as "<version>=<hex digest>".
+	HeaderSignature = "X-Webhook-Signature"
+	// HeaderSignatureVersion names the scheme used to compute HeaderSignature.
+	HeaderSignatureVersion = "X-Webhook-Signature-Version"
+
+	// SignatureVersionV1 is HMAC-SHA256 over "<timestamp>.<raw body>" keyed with the endpoint secret.
+	SignatureVersionV1 = "v1"
+	// CurrentSignatureVersion is the scheme used for new deliveries.
+	CurrentSignatureVersion = SignatureVersionV1
+
+	// DefaultTolerance bounds how old a signed timestamp may be before verification rejects it.
+	DefaultTolerance = 5 * time.Minute
+)
+
+var (
+	// ErrUnsupportedVersion indicates the signature names an unknown scheme.
+	ErrUnsupportedVersion = errors.New("webhook: unsupported signature version")
+	// ErrSignatureMismatch indicates the signature does not match the payload.
+	ErrSignatureMismatch = errors.New("webhook: signature mismatch")
+	// ErrTimestampOutOfRange indicates the signed timestamp is outside the tolerance window.
+	ErrTimestampOutOfRange = errors.New("webhook: timestamp outside tolerance")
+	// ErrMalformedSignature indicates the signature or timestamp header could not be parsed.
+	ErrMalformedSignature = errors.New("webhook: malformed signature")
+)
+
+// SupportedVersions lists the signature schemes the server can produce and verify.
+func SupportedVersions() []string {
+	return []string{SignatureVersionV1}
+}
+
+// Signer computes and checks webhook signatures for one endpoint secret.
+type Signer struct {
+	secret []byte
+}
+
+// NewSigner constructs a Signer for the supplied secret.
+func NewSigner(secret string) (*Signer, error) {
+	if strings.TrimSpace(secret) == "" {
+		return nil, errors.New("webhook: signing secret is required")
+	}
+	return &Signer{secret: []byte(secret)}, nil
+}
+
+// Sign returns the HeaderSignature value for the payload signed at timestamp.
+func (s *Signer) Sign(version string, timestamp time.Time, payload []byte) (string, error) {
+	digest, err := s.digest(version, timestamp.Unix(), payload)
+	if err != nil {
+		return "", err
+	}
+	return version + "=" + digest, nil
+}
+
+// Verify checks a HeaderSignature value against the payload. A zero tolerance skips the timestamp check.
+func (s *Signer) Verify(signature, timestamp string, payload []byte, now time.Time, tolerance time.Duration) error {
+	version, provided, ok := strings.Cut(strings.TrimSpace(signature), "=")
+	if !ok || provided == "" {
+		return ErrMalformedSignature
+	}
+
+	unix, err := strconv.ParseInt(strings.TrimSpace(timestamp), 10, 64)
+	if err != nil {
+		return ErrMalformedSignature
+	}
+	if tolerance > 0 {
+		age := now.Sub(time.Unix(unix, 0))
+		if age > tolerance || age < -tolerance {
+			return ErrTimestampOutOfRange
+		}
+	}
+
+	expected, err := s.digest(version, unix, payload)
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal([]byte(expected), []byte(strings.ToLower(provided))) {
+		return ErrSignatureMismatch
+	}
+	return nil
+}
+
+func (s *Signer) digest(version string, unix int64, payload []byte) (string, error) {
+	switch version {
+	case SignatureVersionV1:
+		mac := hmac.New(sha256.New, s.secret)
+		fmt.Fprintf(mac, "%d.", unix)
+		mac.Write(payload)
+		return hex.EncodeToString(mac.Sum(nil)), nil
+	default:
+		return "", ErrUnsupportedVersion
+	}
+}
@@ -0,0 +1,65 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	accountusecase "github.com/crypto-wallet/backend/internal/application/usecases/account"
+)
+
+// AccountPurgeWorker periodically purges deleted accounts whose retention window has ended.
+type AccountPurgeWorker struct {
+	purgeUC  *accountusecase.PurgeDeletedAccountsUseCase
+	logger   *slog.Logger
+	interval time.Duration
+}
+
+// NewAccountPurgeWorker creates a new AccountPurgeWorker.
+func NewAccountPurgeWorker(
+	purgeUC *accountusecase.PurgeDeletedAccountsUseCase,
+	logger *slog.Logger,
+	interval time.Duration,
+) *AccountPurgeWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	return &AccountPurgeWorker{
+		purgeUC:  purgeUC,
+		logger:   logger,
+		interval: interval,
+	}
+}
+
+// Start runs the worker until the context is cancelled.
+func (w *AccountPurgeWorker) Start(ctx context.Context) {
+	w.logger.Info("Starting account purge worker", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Account purge worker stopped by context")
+			return
+		case <-ticker.C:
+			w.purge(ctx)
+		}
+	}
+}
+
+func (w *AccountPurgeWorker) purge(ctx context.Context) {
+	result, err := w.purgeUC.Execute(ctx, time.Now().UTC())
+	if err != nil {
+		w.logger.Error("Failed to purge deleted accounts", "error", err)
+		return
+	}
+
+	if result.Purged > 0 || result.Failed > 0 {
+		w.logger.Info("Purged deleted accounts", "purged", result.Purged, "failed", result.Failed)
+	}
+}
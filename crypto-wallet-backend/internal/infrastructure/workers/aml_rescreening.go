@@ -0,0 +1,65 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	kycusecase "github.com/crypto-wallet/backend/internal/application/usecases/kyc"
+)
+
+// AMLRescreeningWorker re-screens users whose periodic risk review is due.
+type AMLRescreeningWorker struct {
+	rescreenUC *kycusecase.RescreenRiskScoresUseCase
+	logger     *slog.Logger
+	interval   time.Duration
+}
+
+// NewAMLRescreeningWorker creates a new AMLRescreeningWorker.
+func NewAMLRescreeningWorker(
+	rescreenUC *kycusecase.RescreenRiskScoresUseCase,
+	logger *slog.Logger,
+	interval time.Duration,
+) *AMLRescreeningWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	return &AMLRescreeningWorker{
+		rescreenUC: rescreenUC,
+		logger:     logger,
+		interval:   interval,
+	}
+}
+
+// Start runs the worker until the context is cancelled.
+func (w *AMLRescreeningWorker) Start(ctx context.Context) {
+	w.logger.Info("Starting aml re-screening worker", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("AML re-screening worker stopped by context")
+			return
+		case <-ticker.C:
+			w.rescreen(ctx)
+		}
+	}
+}
+
+func (w *AMLRescreeningWorker) rescreen(ctx context.Context) {
+	screened, err := w.rescreenUC.Execute(ctx)
+	if err != nil {
+		w.logger.Error("Failed to re-screen users", "error", err)
+		return
+	}
+
+	if screened > 0 {
+		w.logger.Info("Re-screened users", "count", screened)
+	}
+}
@@ -0,0 +1,65 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/infrastructure/monitoring"
+)
+
+// AnomalyMonitorWorker periodically evaluates operational metrics and pushes alerts.
+// The interval doubles as the error-rate evaluation window.
+type AnomalyMonitorWorker struct {
+	monitor  *monitoring.Monitor
+	logger   *slog.Logger
+	interval time.Duration
+}
+
+// NewAnomalyMonitorWorker creates a new AnomalyMonitorWorker.
+func NewAnomalyMonitorWorker(
+	monitor *monitoring.Monitor,
+	logger *slog.Logger,
+	interval time.Duration,
+) *AnomalyMonitorWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &AnomalyMonitorWorker{
+		monitor:  monitor,
+		logger:   logger,
+		interval: interval,
+	}
+}
+
+// Start runs the worker until the context is cancelled.
+func (w *AnomalyMonitorWorker) Start(ctx context.Context) {
+	w.logger.Info("Starting anomaly monitor worker", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Anomaly monitor worker stopped by context")
+			return
+		case <-ticker.C:
+			w.evaluate(ctx)
+		}
+	}
+}
+
+func (w *AnomalyMonitorWorker) evaluate(ctx context.Context) {
+	sent, err := w.monitor.Evaluate(ctx)
+	if err != nil {
+		w.logger.Error("Failed to deliver some alerts", "error", err)
+	}
+
+	if sent > 0 {
+		w.logger.Info("Delivered alerts", "count", sent)
+	}
+}
@@ -0,0 +1,65 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	auditusecase "github.com/crypto-wallet/backend/internal/application/usecases/audit"
+)
+
+// AuditRetentionWorker archives audit records that have outlived the retention period.
+type AuditRetentionWorker struct {
+	archiveUC *auditusecase.ArchiveAuditLogsUseCase
+	logger    *slog.Logger
+	interval  time.Duration
+}
+
+// NewAuditRetentionWorker creates a new AuditRetentionWorker.
+func NewAuditRetentionWorker(
+	archiveUC *auditusecase.ArchiveAuditLogsUseCase,
+	logger *slog.Logger,
+	interval time.Duration,
+) *AuditRetentionWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if interval <= 0 {
+		interval = 6 * time.Hour
+	}
+	return &AuditRetentionWorker{
+		archiveUC: archiveUC,
+		logger:    logger,
+		interval:  interval,
+	}
+}
+
+// Start runs the worker until the context is cancelled.
+func (w *AuditRetentionWorker) Start(ctx context.Context) {
+	w.logger.Info("Starting audit retention worker", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Audit retention worker stopped by context")
+			return
+		case <-ticker.C:
+			w.archive(ctx)
+		}
+	}
+}
+
+func (w *AuditRetentionWorker) archive(ctx context.Context) {
+	archived, err := w.archiveUC.Execute(ctx)
+	if err != nil {
+		w.logger.Error("Failed to archive audit records", "error", err)
+		return
+	}
+
+	if archived > 0 {
+		w.logger.Info("Archived audit records", "count", archived)
+	}
+}
@@ -0,0 +1,89 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	reconciliationusecase "github.com/crypto-wallet/backend/internal/application/usecases/reconciliation"
+)
+
+// BalanceReconciliationWorker compares stored wallet balances with the chain once a night.
+type BalanceReconciliationWorker struct {
+	reconcileUC *reconciliationusecase.ReconcileChainUseCase
+	logger      *slog.Logger
+	hour        int
+	now         func() time.Time
+}
+
+// NewBalanceReconciliationWorker creates a new BalanceReconciliationWorker that runs daily at the
+// given hour (UTC, 0-23).
+func NewBalanceReconciliationWorker(
+	reconcileUC *reconciliationusecase.ReconcileChainUseCase,
+	logger *slog.Logger,
+	hour int,
+) *BalanceReconciliationWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if hour < 0 || hour > 23 {
+		hour = 2
+	}
+	return &BalanceReconciliationWorker{
+		reconcileUC: reconcileUC,
+		logger:      logger,
+		hour:        hour,
+		now:         time.Now,
+	}
+}
+
+// Start runs the worker until the context is cancelled.
+func (w *BalanceReconciliationWorker) Start(ctx context.Context) {
+	w.logger.Info("Starting balance reconciliation worker", "hour_utc", w.hour)
+
+	timer := time.NewTimer(w.untilNextRun())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Balance reconciliation worker stopped by context")
+			return
+		case <-timer.C:
+			w.reconcile(ctx)
+			timer.Reset(w.untilNextRun())
+		}
+	}
+}
+
+func (w *BalanceReconciliationWorker) untilNextRun() time.Duration {
+	now := w.now().UTC()
+	next := time.Date(now.Year(), now.Month(), now.Day(), w.hour, 0, 0, 0, time.UTC)
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next.Sub(now)
+}
+
+func (w *BalanceReconciliationWorker) reconcile(ctx context.Context) {
+	for _, chain := range w.reconcileUC.Chains() {
+		run, err := w.reconcileUC.Execute(ctx, chain)
+		if err != nil {
+			w.logger.Error("Failed to reconcile balances", "chain", chain, "error", err)
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+
+		w.logger.Info("Reconciled balances",
+			"chain", chain,
+			"run_id", run.ID,
+			"scanned", run.Scanned,
+			"matched", run.Matched,
+			"corrected", run.Corrected,
+			"escalated", run.Escalated,
+			"failed", run.Failed,
+		)
+	}
+}
@@ -0,0 +1,65 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	broadcastusecase "github.com/crypto-wallet/backend/internal/application/usecases/broadcast"
+)
+
+// BroadcastDispatcherWorker starts due broadcast campaigns and delivers their messages.
+type BroadcastDispatcherWorker struct {
+	dispatchUC *broadcastusecase.DispatchCampaignsUseCase
+	logger     *slog.Logger
+	interval   time.Duration
+}
+
+// NewBroadcastDispatcherWorker creates a new BroadcastDispatcherWorker.
+func NewBroadcastDispatcherWorker(
+	dispatchUC *broadcastusecase.DispatchCampaignsUseCase,
+	logger *slog.Logger,
+	interval time.Duration,
+) *BroadcastDispatcherWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	return &BroadcastDispatcherWorker{
+		dispatchUC: dispatchUC,
+		logger:     logger,
+		interval:   interval,
+	}
+}
+
+// Start runs the worker until the context is cancelled.
+func (w *BroadcastDispatcherWorker) Start(ctx context.Context) {
+	w.logger.Info("Starting broadcast dispatcher worker", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Broadcast dispatcher worker stopped by context")
+			return
+		case <-ticker.C:
+			w.process(ctx)
+		}
+	}
+}
+
+func (w *BroadcastDispatcherWorker) process(ctx context.Context) {
+	delivered, err := w.dispatchUC.Execute(ctx)
+	if err != nil {
+		w.logger.Error("Failed to dispatch broadcasts", "error", err)
+		return
+	}
+
+	if delivered > 0 {
+		w.logger.Info("Delivered broadcast messages", "count", delivered)
+	}
+}
@@ -0,0 +1,57 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	usecasewallet "github.com/crypto-wallet/backend/internal/application/usecases/wallet"
+)
+
+// ChainRegistryWorker reapplies the chain registry so changes made through another instance
+// reach this one's blockchain adapters.
+type ChainRegistryWorker struct {
+	reloadUC *usecasewallet.ReloadChainConfigsUseCase
+	logger   *slog.Logger
+	interval time.Duration
+}
+
+// NewChainRegistryWorker creates a new ChainRegistryWorker.
+func NewChainRegistryWorker(
+	reloadUC *usecasewallet.ReloadChainConfigsUseCase,
+	logger *slog.Logger,
+	interval time.Duration,
+) *ChainRegistryWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	return &ChainRegistryWorker{
+		reloadUC: reloadUC,
+		logger:   logger,
+		interval: interval,
+	}
+}
+
+// Start runs the worker until the context is cancelled. The registry is applied at startup, so
+// the first reload waits for the interval.
+func (w *ChainRegistryWorker) Start(ctx context.Context) {
+	w.logger.Info("Starting chain registry worker", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Chain registry worker stopped by context")
+			return
+		case <-ticker.C:
+			if err := w.reloadUC.Execute(ctx); err != nil {
+				w.logger.Error("Failed to reload chain registry", "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,311 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/events"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/infrastructure/blockchain"
+	"github.com/crypto-wallet/backend/internal/infrastructure/messaging"
+)
+
+// Events published when the deposit monitor credits a wallet: TransactionEventReceived on
+// messaging.TransactionChannel, BalanceEventUpdated on messaging.BalanceUpdateChannel, and both
+// through the event dispatcher, the latter as events.BalanceUpdated.
+const (
+	TransactionEventReceived = "transaction.received"
+	BalanceEventUpdated      = events.BalanceUpdatedEvent
+)
+
+const (
+	defaultDepositBlocksPerTick = 50
+	depositWalletPageSize       = 500
+)
+
+// DepositMonitorConfig configures the deposit monitor.
+type DepositMonitorConfig struct {
+	Deposits  repositories.DepositRepository
+	Wallets   repositories.WalletRepository
+	Scanners  []blockchain.DepositScanner
+	Publisher messaging.MessagePublisher
+	Notifier  events.Publisher
+	Interval  time.Duration
+	// BlocksPerTick caps how many blocks of one chain are scanned per tick, so catching up after
+	// downtime happens in bounded steps.
+	BlocksPerTick int
+	Logger        *slog.Logger
+}
+
+// DepositMonitor detects inbound transfers to platform wallets. Each tick it scans every chain from
+// the block after its cursor up to the newest block with enough confirmations, records a confirmed
+// receive transaction per transfer, credits the wallet and publishes the new balance. Blocks are
+// committed one at a time together with the cursor, so a restart resumes where the scan stopped.
+// A chain without a cursor starts at its current safe block; earlier history is not backfilled.
+type DepositMonitor struct {
+	deposits      repositories.DepositRepository
+	wallets       repositories.WalletRepository
+	scanners      []blockchain.DepositScanner
+	publisher     messaging.MessagePublisher
+	notifier      events.Publisher
+	interval      time.Duration
+	blocksPerTick uint64
+	logger        *slog.Logger
+	now           func() time.Time
+}
+
+// NewDepositMonitor constructs a monitor with sane defaults. Publisher and notifier are optional.
+func NewDepositMonitor(cfg DepositMonitorConfig) *DepositMonitor {
+	if cfg.Interval <= 0 {
+		cfg.Interval = 30 * time.Second
+	}
+	if cfg.BlocksPerTick <= 0 {
+		cfg.BlocksPerTick = defaultDepositBlocksPerTick
+	}
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &DepositMonitor{
+		deposits:      cfg.Deposits,
+		wallets:       cfg.Wallets,
+		scanners:      cfg.Scanners,
+		publisher:     cfg.Publisher,
+		notifier:      cfg.Notifier,
+		interval:      cfg.Interval,
+		blocksPerTick: uint64(cfg.BlocksPerTick),
+		logger:        logger.With(slog.String("component", "deposit_monitor")),
+		now:           func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Start runs the monitor until the context is cancelled.
+func (m *DepositMonitor) Start(ctx context.Context) {
+	if m.deposits == nil || m.wallets == nil || len(m.scanners) == 0 {
+		m.logger.Warn("deposit monitor misconfigured; skipping execution")
+		return
+	}
+
+	m.logger.Info("deposit monitor started", slog.Duration("interval", m.interval), slog.Int("chains", len(m.scanners)))
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			m.logger.Info("deposit monitor exiting", slog.String("reason", ctx.Err().Error()))
+			return
+		case <-ticker.C:
+			for _, scanner := range m.scanners {
+				if ctx.Err() != nil {
+					break
+				}
+				m.scanChain(ctx, scanner)
+			}
+		}
+	}
+}
+
+func (m *DepositMonitor) scanChain(ctx context.Context, scanner blockchain.DepositScanner) {
+	chain := scanner.GetChain()
+	logger := m.logger.With(slog.String("chain", string(chain)))
+
+	latest, err := scanner.LatestBlock(ctx)
+	if err != nil {
+		logger.Warn("failed to fetch latest block", slog.String("error", err.Error()))
+		return
+	}
+	confirmations := uint64(max(scanner.GetConfirmationThreshold(), 1))
+	if latest+1 < confirmations {
+		return
+	}
+	safe := latest + 1 - confirmations
+
+	cursor, err := m.deposits.GetCursor(ctx, chain)
+	switch {
+	case errors.Is(err, repositories.ErrNotFound):
+		// First run: start from the current safe block rather than replaying the chain.
+		if _, err := m.deposits.RecordDeposits(ctx, entities.DepositCursor{Chain: chain, LastBlock: safe, UpdatedAt: m.now()}, nil); err != nil {
+			logger.Error("failed to initialise deposit cursor", slog.String("error", err.Error()))
+			return
+		}
+		logger.Info("deposit cursor initialised", slog.Uint64("block", safe))
+		return
+	case err != nil:
+		logger.Error("failed to load deposit cursor", slog.String("error", err.Error()))
+		return
+	}
+	if cursor.LastBlock >= safe {
+		return
+	}
+
+	watched, err := m.watchedWallets(ctx, chain)
+	if err != nil {
+		logger.Error("failed to load watched wallets", slog.String("error", err.Error()))
+		return
+	}
+
+	end := min(safe, cursor.LastBlock+m.blocksPerTick)
+	for block := cursor.LastBlock + 1; block <= end; block++ {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := m.scanBlock(ctx, scanner, block, latest, watched); err != nil {
+			// The cursor stays on the previous block, so this one is retried next tick.
+			logger.Error("failed to scan block", slog.Uint64("block", block), slog.String("error", err.Error()))
+			return
+		}
+	}
+}
+
+func (m *DepositMonitor) scanBlock(ctx context.Context, scanner blockchain.DepositScanner, block, latest uint64, watched map[string]entities.Wallet) error {
+	chain := scanner.GetChain()
+	transfers, err := scanner.IncomingTransfers(ctx, block, func(address string) bool {
+		_, ok := watched[depositAddressKey(chain, address)]
+		return ok
+	})
+	if err != nil {
+		return err
+	}
+
+	now := m.now()
+	deposits := make([]*entities.TransactionEntity, 0, len(transfers))
+	for _, transfer := range transfers {
+		wallet, ok := watched[depositAddressKey(chain, transfer.ToAddress)]
+		if !ok {
+			continue
+		}
+		blockNumber := transfer.BlockNumber
+		confirmedAt := now
+		deposit, err := entities.NewTransactionEntity(entities.TransactionParams{
+			WalletID:      wallet.GetID(),
+			Chain:         chain,
+			Hash:          transfer.Hash,
+			Type:          entities.TransactionTypeReceive,
+			Amount:        transfer.Amount,
+			Status:        entities.TransactionStatusConfirmed,
+			FromAddress:   transfer.FromAddress,
+			ToAddress:     wallet.GetAddress(),
+			BlockNumber:   &blockNumber,
+			Confirmations: int(latest - blockNumber + 1),
+			Metadata:      map[string]any{"source": "deposit_scanner"},
+			CreatedAt:     now,
+			ConfirmedAt:   &confirmedAt,
+			UpdatedAt:     now,
+		})
+		if err != nil {
+			m.logger.Warn("skipping invalid deposit",
+				slog.String("chain", string(chain)),
+				slog.String("hash", transfer.Hash),
+				slog.String("error", err.Error()))
+			continue
+		}
+		deposits = append(deposits, deposit)
+	}
+
+	credited, err := m.deposits.RecordDeposits(ctx, entities.DepositCursor{Chain: chain, LastBlock: block, UpdatedAt: now}, deposits)
+	if err != nil {
+		return err
+	}
+	for _, deposit := range credited {
+		m.logger.Info("deposit credited",
+			slog.String("chain", string(chain)),
+			slog.String("transaction_id", deposit.Transaction.GetID().String()),
+			slog.String("wallet_id", deposit.Transaction.GetWalletID().String()),
+			slog.String("amount", deposit.Transaction.GetAmount().String()))
+		m.publish(ctx, deposit)
+	}
+	return nil
+}
+
+// watchedWallets loads every non-archived wallet of the chain, keyed by depositAddressKey.
+func (m *DepositMonitor) watchedWallets(ctx context.Context, chain entities.Chain) (map[string]entities.Wallet, error) {
+	watched := make(map[string]entities.Wallet)
+	after := uuid.Nil
+	for {
+		page, err := m.wallets.ListByChain(ctx, chain, after, depositWalletPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, wallet := range page {
+			watched[depositAddressKey(chain, wallet.GetAddress())] = wallet
+		}
+		if len(page) < depositWalletPageSize {
+			return watched, nil
+		}
+		after = page[len(page)-1].GetID()
+	}
+}
+
+// depositAddressKey normalises an address for matching; EVM addresses are case-insensitive.
+func depositAddressKey(chain entities.Chain, address string) string {
+	address = strings.TrimSpace(address)
+	if entities.IsEVMChain(chain) {
+		return strings.ToLower(address)
+	}
+	return address
+}
+
+func (m *DepositMonitor) publish(ctx context.Context, deposit repositories.CreditedDeposit) {
+	tx := deposit.Transaction
+	data := map[string]interface{}{
+		"transaction_id": tx.GetID().String(),
+		"wallet_id":      tx.GetWalletID().String(),
+		"user_id":        deposit.UserID.String(),
+		"chain":          string(tx.GetChain()),
+		"hash":           tx.GetHash(),
+		"amount":         tx.GetAmount().String(),
+		"from_address":   tx.GetFromAddress(),
+		"status":         string(tx.GetStatus()),
+	}
+	if block := tx.GetBlockNumber(); block != nil {
+		data["block_number"] = *block
+	}
+	now := m.now()
+	balance := events.BalanceUpdated{
+		WalletID:      tx.GetWalletID(),
+		OwnerID:       deposit.UserID,
+		Chain:         tx.GetChain(),
+		Previous:      deposit.Balance.Sub(tx.GetAmount()),
+		Balance:       deposit.Balance,
+		TransactionID: tx.GetID(),
+		At:            now,
+	}
+
+	if m.publisher != nil {
+		m.publishMessage(ctx, messaging.TransactionChannel, TransactionEventReceived, data)
+		m.publishMessage(ctx, messaging.BalanceUpdateChannel, BalanceEventUpdated, balance.Payload())
+	}
+
+	if m.notifier != nil {
+		for _, event := range []events.Event{events.Named{Name: TransactionEventReceived, Data: data, At: now}, balance} {
+			if err := m.notifier.Publish(ctx, event); err != nil {
+				m.logger.Warn("failed to record deposit event",
+					slog.String("transaction_id", tx.GetID().String()),
+					slog.String("event", event.EventName()),
+					slog.String("error", err.Error()))
+			}
+		}
+	}
+}
+
+func (m *DepositMonitor) publishMessage(ctx context.Context, channel, event string, data map[string]interface{}) {
+	err := m.publisher.Publish(ctx, channel, messaging.Message{
+		Event:     event,
+		Data:      data,
+		Timestamp: m.now(),
+	})
+	if err != nil {
+		m.logger.Warn("failed to publish deposit update",
+			slog.String("channel", channel),
+			slog.String("event", event),
+			slog.String("error", err.Error()))
+	}
+}
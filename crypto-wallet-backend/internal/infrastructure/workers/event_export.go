@@ -0,0 +1,65 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	eventexportusecase "github.com/crypto-wallet/backend/internal/application/usecases/eventexport"
+)
+
+// EventExportWorker ships outbox events to the warehouse sink.
+type EventExportWorker struct {
+	exportUC *eventexportusecase.ExportEventsUseCase
+	logger   *slog.Logger
+	interval time.Duration
+}
+
+// NewEventExportWorker creates a new EventExportWorker.
+func NewEventExportWorker(
+	exportUC *eventexportusecase.ExportEventsUseCase,
+	logger *slog.Logger,
+	interval time.Duration,
+) *EventExportWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &EventExportWorker{
+		exportUC: exportUC,
+		logger:   logger,
+		interval: interval,
+	}
+}
+
+// Start runs the worker until the context is cancelled.
+func (w *EventExportWorker) Start(ctx context.Context) {
+	w.logger.Info("Starting event export worker", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Event export worker stopped by context")
+			return
+		case <-ticker.C:
+			w.process(ctx)
+		}
+	}
+}
+
+func (w *EventExportWorker) process(ctx context.Context) {
+	processed, err := w.exportUC.Execute(ctx)
+	if err != nil {
+		w.logger.Error("Failed to export events", "error", err)
+		return
+	}
+
+	if processed > 0 {
+		w.logger.Info("Exported events", "count", processed)
+	}
+}
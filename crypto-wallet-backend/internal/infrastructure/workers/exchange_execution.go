@@ -0,0 +1,66 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	exchangeusecase "github.com/crypto-wallet/backend/internal/application/usecases/exchange"
+	"github.com/crypto-wallet/backend/internal/infrastructure/jobqueue"
+)
+
+// exchangeQueueRestartDelay is how long the worker waits before consuming again after the queue
+// could not be set up.
+const exchangeQueueRestartDelay = 10 * time.Second
+
+// ExchangeExecutionWorker executes exchanges queued by SwapTokens.RequestExecution. Failed
+// executions are retried by the queue and dead-lettered once they run out of attempts.
+type ExchangeExecutionWorker struct {
+	queue  *jobqueue.RedisStreamQueue
+	swaps  *exchangeusecase.SwapTokens
+	logger *slog.Logger
+}
+
+// NewExchangeExecutionWorker creates a new ExchangeExecutionWorker.
+func NewExchangeExecutionWorker(queue *jobqueue.RedisStreamQueue, swaps *exchangeusecase.SwapTokens, logger *slog.Logger) *ExchangeExecutionWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ExchangeExecutionWorker{
+		queue:  queue,
+		swaps:  swaps,
+		logger: logger,
+	}
+}
+
+// Start runs the worker until the context is cancelled.
+func (w *ExchangeExecutionWorker) Start(ctx context.Context) {
+	w.logger.Info("Starting exchange execution worker")
+
+	for {
+		err := w.queue.Consume(ctx, w.execute)
+		if ctx.Err() != nil {
+			w.logger.Info("Exchange execution worker stopped by context")
+			return
+		}
+		if err != nil {
+			w.logger.Error("Exchange execution queue unavailable", "error", err, "retry_in", exchangeQueueRestartDelay)
+		}
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Exchange execution worker stopped by context")
+			return
+		case <-time.After(exchangeQueueRestartDelay):
+		}
+	}
+}
+
+func (w *ExchangeExecutionWorker) execute(ctx context.Context, job jobqueue.Job) error {
+	var execution exchangeusecase.ExecutionJob
+	if err := json.Unmarshal(job.Payload, &execution); err != nil {
+		return jobqueue.Permanent(fmt.Errorf("decode exchange job: %w", err))
+	}
+	return w.swaps.ProcessExecution(ctx, execution)
+}
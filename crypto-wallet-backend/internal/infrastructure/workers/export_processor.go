@@ -0,0 +1,65 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	exportusecase "github.com/crypto-wallet/backend/internal/application/usecases/export"
+)
+
+// ExportProcessorWorker generates queued asynchronous exports.
+type ExportProcessorWorker struct {
+	processUC *exportusecase.ProcessExportsUseCase
+	logger    *slog.Logger
+	interval  time.Duration
+}
+
+// NewExportProcessorWorker creates a new ExportProcessorWorker.
+func NewExportProcessorWorker(
+	processUC *exportusecase.ProcessExportsUseCase,
+	logger *slog.Logger,
+	interval time.Duration,
+) *ExportProcessorWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	return &ExportProcessorWorker{
+		processUC: processUC,
+		logger:    logger,
+		interval:  interval,
+	}
+}
+
+// Start runs the worker until the context is cancelled.
+func (w *ExportProcessorWorker) Start(ctx context.Context) {
+	w.logger.Info("Starting export processor worker", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Export processor worker stopped by context")
+			return
+		case <-ticker.C:
+			w.process(ctx)
+		}
+	}
+}
+
+func (w *ExportProcessorWorker) process(ctx context.Context) {
+	processed, err := w.processUC.Execute(ctx)
+	if err != nil {
+		w.logger.Error("Failed to process exports", "error", err)
+		return
+	}
+
+	if processed > 0 {
+		w.logger.Info("Processed exports", "count", processed)
+	}
+}
@@ -0,0 +1,65 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	ratesusecase "github.com/crypto-wallet/backend/internal/application/usecases/rates"
+)
+
+// FiatRateSyncWorker keeps the fiat reference rates used for currency conversion up to date.
+type FiatRateSyncWorker struct {
+	syncUC   *ratesusecase.SyncFiatRatesUseCase
+	logger   *slog.Logger
+	interval time.Duration
+}
+
+// NewFiatRateSyncWorker creates a new FiatRateSyncWorker.
+func NewFiatRateSyncWorker(
+	syncUC *ratesusecase.SyncFiatRatesUseCase,
+	logger *slog.Logger,
+	interval time.Duration,
+) *FiatRateSyncWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	return &FiatRateSyncWorker{
+		syncUC:   syncUC,
+		logger:   logger,
+		interval: interval,
+	}
+}
+
+// Start runs the worker until the context is cancelled, syncing once immediately.
+func (w *FiatRateSyncWorker) Start(ctx context.Context) {
+	w.logger.Info("Starting fiat rate sync worker", "interval", w.interval)
+
+	w.sync(ctx)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Fiat rate sync worker stopped by context")
+			return
+		case <-ticker.C:
+			w.sync(ctx)
+		}
+	}
+}
+
+func (w *FiatRateSyncWorker) sync(ctx context.Context) {
+	stored, err := w.syncUC.Execute(ctx)
+	if err != nil {
+		w.logger.Error("Failed to sync fiat rates", "error", err)
+		return
+	}
+
+	w.logger.Debug("Synced fiat rates", "count", stored)
+}
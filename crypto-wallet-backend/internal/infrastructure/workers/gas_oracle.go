@@ -0,0 +1,66 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	gasusecase "github.com/crypto-wallet/backend/internal/application/usecases/gas"
+)
+
+// GasOracleWorker keeps the gas price history in step with the EVM chains' fee markets.
+type GasOracleWorker struct {
+	syncUC   *gasusecase.SyncGasPricesUseCase
+	logger   *slog.Logger
+	interval time.Duration
+}
+
+// NewGasOracleWorker creates a new GasOracleWorker.
+func NewGasOracleWorker(
+	syncUC *gasusecase.SyncGasPricesUseCase,
+	logger *slog.Logger,
+	interval time.Duration,
+) *GasOracleWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if interval <= 0 {
+		interval = 15 * time.Second
+	}
+	return &GasOracleWorker{
+		syncUC:   syncUC,
+		logger:   logger,
+		interval: interval,
+	}
+}
+
+// Start runs the worker until the context is cancelled. Fees are sampled once immediately so
+// estimates are available as soon as the server starts.
+func (w *GasOracleWorker) Start(ctx context.Context) {
+	w.logger.Info("Starting gas oracle worker", "interval", w.interval)
+
+	w.sync(ctx)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Gas oracle worker stopped by context")
+			return
+		case <-ticker.C:
+			w.sync(ctx)
+		}
+	}
+}
+
+func (w *GasOracleWorker) sync(ctx context.Context) {
+	sampled, err := w.syncUC.Execute(ctx)
+	if err != nil {
+		w.logger.Error("Failed to sample gas prices", "error", err)
+		return
+	}
+
+	w.logger.Debug("Sampled gas prices", "blocks", sampled)
+}
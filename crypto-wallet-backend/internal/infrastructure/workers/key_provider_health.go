@@ -0,0 +1,78 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"time"
+)
+
+// KeyHealthChecker round-trips a probe through a master key. security.KeyProvider satisfies it.
+type KeyHealthChecker interface {
+	HealthCheck(ctx context.Context) error
+}
+
+// KeyProviderHealthWorker probes the KMS or HSM holding each master key, so an outage or revoked
+// access is reported before wallet creation and KYC submissions start failing.
+type KeyProviderHealthWorker struct {
+	providers map[string]KeyHealthChecker
+	logger    *slog.Logger
+	interval  time.Duration
+	unhealthy map[string]bool
+}
+
+// NewKeyProviderHealthWorker creates a new KeyProviderHealthWorker. providers is keyed by the
+// component whose master key the provider holds.
+func NewKeyProviderHealthWorker(providers map[string]KeyHealthChecker, logger *slog.Logger, interval time.Duration) *KeyProviderHealthWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &KeyProviderHealthWorker{
+		providers: providers,
+		logger:    logger,
+		interval:  interval,
+		unhealthy: make(map[string]bool),
+	}
+}
+
+// Start runs the worker until the context is cancelled.
+func (w *KeyProviderHealthWorker) Start(ctx context.Context) {
+	w.logger.Info("Starting key provider health worker", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Key provider health worker stopped by context")
+			return
+		case <-ticker.C:
+			w.probe(ctx)
+		}
+	}
+}
+
+// probe checks every provider, logging failures each time and recoveries once.
+func (w *KeyProviderHealthWorker) probe(ctx context.Context) {
+	names := make([]string, 0, len(w.providers))
+	for name := range w.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := w.providers[name].HealthCheck(ctx); err != nil {
+			w.unhealthy[name] = true
+			w.logger.Error("Master key provider health check failed", "component", name, "error", err)
+			continue
+		}
+		if w.unhealthy[name] {
+			delete(w.unhealthy, name)
+			w.logger.Info("Master key provider recovered", "component", name)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	kycusecase "github.com/crypto-wallet/backend/internal/application/usecases/kyc"
+)
+
+// KYCExpirationWorker expires KYC approvals once their expiry date passes.
+type KYCExpirationWorker struct {
+	expireUC *kycusecase.ExpireApprovalsUseCase
+	logger   *slog.Logger
+	interval time.Duration
+}
+
+// NewKYCExpirationWorker creates a new KYCExpirationWorker.
+func NewKYCExpirationWorker(
+	expireUC *kycusecase.ExpireApprovalsUseCase,
+	logger *slog.Logger,
+	interval time.Duration,
+) *KYCExpirationWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	return &KYCExpirationWorker{
+		expireUC: expireUC,
+		logger:   logger,
+		interval: interval,
+	}
+}
+
+// Start runs the worker until the context is cancelled.
+func (w *KYCExpirationWorker) Start(ctx context.Context) {
+	w.logger.Info("Starting kyc expiration worker", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("KYC expiration worker stopped by context")
+			return
+		case <-ticker.C:
+			w.expire(ctx)
+		}
+	}
+}
+
+func (w *KYCExpirationWorker) expire(ctx context.Context) {
+	expired, err := w.expireUC.Execute(ctx)
+	if err != nil {
+		w.logger.Error("Failed to expire kyc approvals", "error", err)
+		return
+	}
+
+	if expired > 0 {
+		w.logger.Info("Expired kyc approvals", "count", expired)
+	}
+}
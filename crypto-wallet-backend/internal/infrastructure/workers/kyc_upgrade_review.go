@@ -0,0 +1,65 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	kycusecase "github.com/crypto-wallet/backend/internal/application/usecases/kyc"
+)
+
+// KYCUpgradeReviewWorker polls the KYC provider for decisions on verification upgrades.
+type KYCUpgradeReviewWorker struct {
+	processUC *kycusecase.ProcessUpgradeDecisionsUseCase
+	logger    *slog.Logger
+	interval  time.Duration
+}
+
+// NewKYCUpgradeReviewWorker creates a new KYCUpgradeReviewWorker.
+func NewKYCUpgradeReviewWorker(
+	processUC *kycusecase.ProcessUpgradeDecisionsUseCase,
+	logger *slog.Logger,
+	interval time.Duration,
+) *KYCUpgradeReviewWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if interval <= 0 {
+		interval = 15 * time.Minute
+	}
+	return &KYCUpgradeReviewWorker{
+		processUC: processUC,
+		logger:    logger,
+		interval:  interval,
+	}
+}
+
+// Start runs the worker until the context is cancelled.
+func (w *KYCUpgradeReviewWorker) Start(ctx context.Context) {
+	w.logger.Info("Starting kyc upgrade review worker", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("KYC upgrade review worker stopped by context")
+			return
+		case <-ticker.C:
+			w.process(ctx)
+		}
+	}
+}
+
+func (w *KYCUpgradeReviewWorker) process(ctx context.Context) {
+	decided, err := w.processUC.Execute(ctx)
+	if err != nil {
+		w.logger.Error("Failed to process kyc upgrade decisions", "error", err)
+		return
+	}
+
+	if decided > 0 {
+		w.logger.Info("Processed kyc upgrade decisions", "count", decided)
+	}
+}
@@ -0,0 +1,65 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	p2pusecase "github.com/crypto-wallet/backend/internal/application/usecases/p2p"
+)
+
+// P2PClaimExpirationWorker returns unclaimed P2P holds to their senders once the claim window lapses.
+type P2PClaimExpirationWorker struct {
+	returnUC *p2pusecase.ReturnExpiredTransfersUseCase
+	logger   *slog.Logger
+	interval time.Duration
+}
+
+// NewP2PClaimExpirationWorker creates a new P2PClaimExpirationWorker.
+func NewP2PClaimExpirationWorker(
+	returnUC *p2pusecase.ReturnExpiredTransfersUseCase,
+	logger *slog.Logger,
+	interval time.Duration,
+) *P2PClaimExpirationWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &P2PClaimExpirationWorker{
+		returnUC: returnUC,
+		logger:   logger,
+		interval: interval,
+	}
+}
+
+// Start runs the worker until the context is cancelled.
+func (w *P2PClaimExpirationWorker) Start(ctx context.Context) {
+	w.logger.Info("Starting p2p claim expiration worker", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("P2P claim expiration worker stopped by context")
+			return
+		case <-ticker.C:
+			w.returnExpired(ctx)
+		}
+	}
+}
+
+func (w *P2PClaimExpirationWorker) returnExpired(ctx context.Context) {
+	returned, err := w.returnUC.Execute(ctx)
+	if err != nil {
+		w.logger.Error("Failed to return expired p2p transfers", "error", err)
+		return
+	}
+
+	if returned > 0 {
+		w.logger.Info("Returned expired p2p transfers", "count", returned)
+	}
+}
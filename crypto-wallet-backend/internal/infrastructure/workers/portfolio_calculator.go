@@ -38,14 +38,15 @@ func NewPortfolioCalculator(cfg PortfolioCalculatorConfig) *PortfolioCalculator
 	}
 }
 
-// Recalculate recomputes portfolio summary and performance for the supplied user.
+// Recalculate recomputes portfolio summary and performance for the supplied user in their
+// preferred currency.
 func (w *PortfolioCalculator) Recalculate(ctx context.Context, userID uuid.UUID, period string) (dto.PortfolioSummary, dto.PortfolioPerformance, error) {
 	var summary dto.PortfolioSummary
 	var performance dto.PortfolioPerformance
 	var err error
 
 	if w.summaryUC != nil {
-		summary, err = w.summaryUC.Execute(ctx, userID)
+		summary, err = w.summaryUC.Execute(ctx, userID, "")
 		if err != nil {
 			w.logger.Error("failed to recompute portfolio summary", slog.String("user_id", userID.String()), slog.String("error", err.Error()))
 			return dto.PortfolioSummary{}, dto.PortfolioPerformance{}, err
@@ -53,7 +54,7 @@ func (w *PortfolioCalculator) Recalculate(ctx context.Context, userID uuid.UUID,
 	}
 
 	if w.performanceUC != nil {
-		performance, err = w.performanceUC.Execute(ctx, userID, period)
+		performance, err = w.performanceUC.Execute(ctx, userID, period, "")
 		if err != nil {
 			w.logger.Error("failed to recompute portfolio performance", slog.String("user_id", userID.String()), slog.String("error", err.Error()))
 			return summary, dto.PortfolioPerformance{}, err
@@ -7,34 +7,51 @@ import (
 
 	"github.com/crypto-wallet/backend/internal/domain/entities"
 	"github.com/crypto-wallet/backend/internal/domain/services"
+	"github.com/crypto-wallet/backend/internal/infrastructure/monitoring"
 )
 
+// maxQuoteExpiryBatchesPerTick bounds how many full batches one tick drains before yielding.
+const maxQuoteExpiryBatchesPerTick = 10
+
 // QuoteExpirationWorker handles the expiration of pending quotes.
 type QuoteExpirationWorker struct {
 	exchangeService *services.ExchangeService
+	metrics         *monitoring.QuoteExpiryMetrics
 	logger          *slog.Logger
 	interval        time.Duration
+	batchSize       int
 	ticker          *time.Ticker
 	stopChan        chan struct{}
 }
 
-// NewQuoteExpirationWorker creates a new QuoteExpirationWorker.
+// NewQuoteExpirationWorker creates a new QuoteExpirationWorker. Each tick cancels expired quotes in
+// batches of batchSize (services.DefaultQuoteExpiryBatch when not positive); metrics are optional.
 func NewQuoteExpirationWorker(
 	exchangeService *services.ExchangeService,
+	metrics *monitoring.QuoteExpiryMetrics,
 	logger *slog.Logger,
 	interval time.Duration,
+	batchSize int,
 ) *QuoteExpirationWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if batchSize <= 0 {
+		batchSize = services.DefaultQuoteExpiryBatch
+	}
 	return &QuoteExpirationWorker{
 		exchangeService: exchangeService,
+		metrics:         metrics,
 		logger:          logger,
 		interval:        interval,
+		batchSize:       batchSize,
 		stopChan:        make(chan struct{}),
 	}
 }
 
 // Start begins the quote expiration worker.
 func (w *QuoteExpirationWorker) Start(ctx context.Context) {
-	w.logger.Info("Starting quote expiration worker", "interval", w.interval)
+	w.logger.Info("Starting quote expiration worker", "interval", w.interval, "batch_size", w.batchSize)
 
 	w.ticker = time.NewTicker(w.interval)
 	defer w.ticker.Stop()
@@ -48,7 +65,9 @@ func (w *QuoteExpirationWorker) Start(ctx context.Context) {
 			w.logger.Info("Quote expiration worker stopped by signal")
 			return
 		case <-w.ticker.C:
-			w.expireQuotes(ctx)
+			if err := w.ExpireQuotes(ctx); err != nil {
+				w.logger.Error("Failed to expire pending quotes", "error", err)
+			}
 		}
 	}
 }
@@ -62,28 +81,44 @@ func (w *QuoteExpirationWorker) Stop() {
 	}
 }
 
-// expireQuotes processes the expiration of pending quotes.
-func (w *QuoteExpirationWorker) expireQuotes(ctx context.Context) {
+// ExpireQuotes processes the expiration of pending quotes, running further batches while the
+// previous one was full. Start calls it on every tick; a scheduler may call it instead.
+func (w *QuoteExpirationWorker) ExpireQuotes(ctx context.Context) error {
 	w.logger.Debug("Checking for expired quotes")
 
-	expiredOperations, err := w.exchangeService.ExpirePendingQuotes(ctx)
-	if err != nil {
-		w.logger.Error("Failed to expire pending quotes", "error", err)
-		return
-	}
+	for batch := 0; batch < maxQuoteExpiryBatchesPerTick && ctx.Err() == nil; batch++ {
+		startedAt := time.Now().UTC()
+		result, err := w.exchangeService.ExpirePendingQuotes(ctx, w.batchSize)
+		if err != nil {
+			return err
+		}
+		if w.metrics != nil {
+			w.metrics.RecordRun(len(result.Expired), result.Failed, result.HasMore, startedAt, time.Since(startedAt))
+		}
 
-	if len(expiredOperations) > 0 {
-		w.logger.Info("Expired pending quotes",
-			"count", len(expiredOperations),
-			"operations", w.getOperationIDs(expiredOperations))
+		if len(result.Expired) > 0 || result.Failed > 0 {
+			w.logger.Info("Expired pending quotes",
+				"count", len(result.Expired),
+				"failed", result.Failed,
+				"operations", w.getOperationIDs(result.Expired))
+		}
+		// A full batch where nothing could be cancelled would be listed again unchanged.
+		if !result.HasMore || len(result.Expired) == 0 {
+			return nil
+		}
+	}
+	if ctx.Err() != nil {
+		return nil
 	}
+	w.logger.Warn("Quote expiry backlog exceeds the per-tick limit", "batches", maxQuoteExpiryBatchesPerTick, "batch_size", w.batchSize)
+	return nil
 }
 
 // getOperationIDs extracts operation IDs for logging.
 func (w *QuoteExpirationWorker) getOperationIDs(operations []entities.ExchangeOperation) []string {
 	ids := make([]string, len(operations))
 	for i, op := range operations {
-		ids[i] = op.GetID()
+		ids[i] = op.GetID().String()
 	}
 	return ids
 }
@@ -0,0 +1,66 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	ratesusecase "github.com/crypto-wallet/backend/internal/application/usecases/rates"
+)
+
+// RateSyncWorker keeps the rates database in step with the market price source.
+type RateSyncWorker struct {
+	syncUC   *ratesusecase.SyncRatesUseCase
+	logger   *slog.Logger
+	interval time.Duration
+}
+
+// NewRateSyncWorker creates a new RateSyncWorker.
+func NewRateSyncWorker(
+	syncUC *ratesusecase.SyncRatesUseCase,
+	logger *slog.Logger,
+	interval time.Duration,
+) *RateSyncWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	return &RateSyncWorker{
+		syncUC:   syncUC,
+		logger:   logger,
+		interval: interval,
+	}
+}
+
+// Start runs the worker until the context is cancelled. Prices are synced once immediately so
+// rates are fresh as soon as the server starts.
+func (w *RateSyncWorker) Start(ctx context.Context) {
+	w.logger.Info("Starting rate sync worker", "interval", w.interval)
+
+	w.sync(ctx)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Rate sync worker stopped by context")
+			return
+		case <-ticker.C:
+			w.sync(ctx)
+		}
+	}
+}
+
+func (w *RateSyncWorker) sync(ctx context.Context) {
+	stored, err := w.syncUC.Execute(ctx)
+	if err != nil {
+		w.logger.Error("Failed to sync rates", "error", err)
+		return
+	}
+
+	w.logger.Debug("Synced rates", "count", stored)
+}
@@ -0,0 +1,53 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// RPCProber health-checks blockchain node endpoints.
+type RPCProber interface {
+	ProbeRPC(ctx context.Context)
+}
+
+// RPCHealthWorker probes blockchain node endpoints so failed ones return to rotation as soon as
+// they recover and failing ones are taken out before a user request hits them.
+type RPCHealthWorker struct {
+	prober   RPCProber
+	logger   *slog.Logger
+	interval time.Duration
+}
+
+// NewRPCHealthWorker creates a new RPCHealthWorker.
+func NewRPCHealthWorker(prober RPCProber, logger *slog.Logger, interval time.Duration) *RPCHealthWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if interval <= 0 {
+		interval = 15 * time.Second
+	}
+	return &RPCHealthWorker{
+		prober:   prober,
+		logger:   logger,
+		interval: interval,
+	}
+}
+
+// Start runs the worker until the context is cancelled.
+func (w *RPCHealthWorker) Start(ctx context.Context) {
+	w.logger.Info("Starting RPC health worker", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("RPC health worker stopped by context")
+			return
+		case <-ticker.C:
+			w.prober.ProbeRPC(ctx)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	analyticsusecase "github.com/crypto-wallet/backend/internal/application/usecases/analytics"
+)
+
+// ScheduledReportWorker periodically emails the portfolio reports users have scheduled.
+type ScheduledReportWorker struct {
+	sendUC   *analyticsusecase.SendScheduledReportsUseCase
+	logger   *slog.Logger
+	interval time.Duration
+}
+
+// NewScheduledReportWorker creates a new ScheduledReportWorker. The interval bounds how late a
+// report may arrive after its scheduled time.
+func NewScheduledReportWorker(
+	sendUC *analyticsusecase.SendScheduledReportsUseCase,
+	logger *slog.Logger,
+	interval time.Duration,
+) *ScheduledReportWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+	return &ScheduledReportWorker{
+		sendUC:   sendUC,
+		logger:   logger,
+		interval: interval,
+	}
+}
+
+// Start runs the worker until the context is cancelled.
+func (w *ScheduledReportWorker) Start(ctx context.Context) {
+	w.logger.Info("Starting scheduled report worker", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Scheduled report worker stopped by context")
+			return
+		case <-ticker.C:
+			w.send(ctx)
+		}
+	}
+}
+
+func (w *ScheduledReportWorker) send(ctx context.Context) {
+	result, err := w.sendUC.Execute(ctx)
+	if err != nil {
+		w.logger.Error("Failed to send scheduled reports", "error", err)
+		return
+	}
+
+	if result.Sent > 0 || result.Failed > 0 {
+		w.logger.Info("Sent scheduled reports", "sent", result.Sent, "failed", result.Failed, "skipped", result.Skipped)
+	}
+}
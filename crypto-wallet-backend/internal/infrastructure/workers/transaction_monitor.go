@@ -6,41 +6,70 @@ import (
     "time"
 
     "github.com/crypto-wallet/backend/internal/domain/entities"
+    "github.com/crypto-wallet/backend/internal/domain/events"
     "github.com/crypto-wallet/backend/internal/domain/repositories"
     "github.com/crypto-wallet/backend/internal/infrastructure/blockchain"
+    "github.com/crypto-wallet/backend/internal/infrastructure/messaging"
 )
 
-// TransactionMonitor periodically queries blockchain adapters for transaction confirmations.
+// Events published on messaging.TransactionChannel, and raised through the event dispatcher, when
+// the monitor changes a transaction. Confirmations are raised as events.TransactionConfirmed.
+const (
+    TransactionEventConfirming = "transaction.confirming"
+    TransactionEventConfirmed  = events.TransactionConfirmedEvent
+    TransactionEventFailed     = "transaction.failed"
+)
+
+const defaultTransactionMonitorBatch = 100
+
+// TransactionMonitor periodically queries blockchain adapters for transaction confirmations. Each
+// tick it loads pending transactions per chain, records confirmations and block numbers, marks
+// them confirmed once the adapter's confirmation threshold is reached, and publishes every change.
 type TransactionMonitor struct {
     repository repositories.TransactionRepository
     adapters   map[entities.Chain]blockchain.BlockchainAdapter
+    publisher  messaging.MessagePublisher
+    notifier   events.Publisher
     interval   time.Duration
+    batchSize  int
     logger     *slog.Logger
+    now        func() time.Time
 }
 
-// NewTransactionMonitor constructs a monitor with sane defaults.
-func NewTransactionMonitor(repo repositories.TransactionRepository, adapters map[entities.Chain]blockchain.BlockchainAdapter, interval time.Duration, logger *slog.Logger) *TransactionMonitor {
+// NewTransactionMonitor constructs a monitor with sane defaults. publisher may be nil, in which
+// case updates are persisted without being published; notifier, typically the event dispatcher, is
+// optional as well.
+func NewTransactionMonitor(repo repositories.TransactionRepository, adapters map[entities.Chain]blockchain.BlockchainAdapter, publisher messaging.MessagePublisher, notifier events.Publisher, interval time.Duration, batchSize int, logger *slog.Logger) *TransactionMonitor {
     if interval <= 0 {
         interval = 10 * time.Second
     }
+    if batchSize <= 0 {
+        batchSize = defaultTransactionMonitorBatch
+    }
     if logger == nil {
         logger = slog.Default()
     }
     return &TransactionMonitor{
         repository: repo,
         adapters:   adapters,
+        publisher:  publisher,
+        notifier:   notifier,
         interval:   interval,
+        batchSize:  batchSize,
         logger:     logger.With(slog.String("component", "transaction_monitor")),
+        now:        func() time.Time { return time.Now().UTC() },
     }
 }
 
-// Run executes a single monitoring loop; callers are responsible for scheduling.
-func (m *TransactionMonitor) Run(ctx context.Context) {
+// Start runs the monitor until the context is cancelled.
+func (m *TransactionMonitor) Start(ctx context.Context) {
     if m.repository == nil || len(m.adapters) == 0 {
         m.logger.Warn("transaction monitor misconfigured; skipping execution")
         return
     }
 
+    m.logger.Info("transaction monitor started", slog.Duration("interval", m.interval))
+
     ticker := time.NewTicker(m.interval)
     defer ticker.Stop()
 
@@ -50,9 +79,167 @@ func (m *TransactionMonitor) Run(ctx context.Context) {
             m.logger.Info("transaction monitor exiting", slog.String("reason", ctx.Err().Error()))
             return
         case <-ticker.C:
-            m.logger.Debug("transaction monitor tick")
-            // Stubs: in a full implementation we would pull pending transactions from the
-            // repository, query the appropriate blockchain adapter, and persist status updates.
+            for chain, adapter := range m.adapters {
+                if ctx.Err() != nil {
+                    break
+                }
+                m.checkChain(ctx, chain, adapter)
+            }
+        }
+    }
+}
+
+func (m *TransactionMonitor) checkChain(ctx context.Context, chain entities.Chain, adapter blockchain.BlockchainAdapter) {
+    logger := m.logger.With(slog.String("chain", string(chain)))
+
+    pending, err := m.repository.ListPending(ctx, chain, m.batchSize)
+    if err != nil {
+        logger.Error("failed to list pending transactions", slog.String("error", err.Error()))
+        return
+    }
+
+    threshold := adapter.GetConfirmationThreshold()
+    for _, tx := range pending {
+        if ctx.Err() != nil {
+            return
+        }
+        if tx.GetHash() == "" {
+            // Not broadcast yet; nothing to ask the chain about.
+            continue
+        }
+
+        status, err := adapter.GetTransactionStatus(ctx, tx.GetHash())
+        if err != nil {
+            logger.Warn("failed to fetch transaction status",
+                slog.String("transaction_id", tx.GetID().String()),
+                slog.String("error", err.Error()))
+            continue
+        }
+
+        event, changed, err := m.apply(tx, status, threshold)
+        if err != nil {
+            logger.Error("failed to apply transaction status",
+                slog.String("transaction_id", tx.GetID().String()),
+                slog.String("error", err.Error()))
+            continue
+        }
+        if !changed {
+            continue
+        }
+
+        if err := m.repository.Update(ctx, tx); err != nil {
+            logger.Error("failed to persist transaction status",
+                slog.String("transaction_id", tx.GetID().String()),
+                slog.String("error", err.Error()))
+            continue
+        }
+
+        m.publish(ctx, event, tx, threshold)
+    }
+}
+
+// apply folds the chain's view of a transaction into the entity and reports which event, if any,
+// the change amounts to.
+func (m *TransactionMonitor) apply(tx entities.Transaction, status *blockchain.TransactionStatus, threshold int) (string, bool, error) {
+    entity, ok := tx.(*entities.TransactionEntity)
+    if !ok || status == nil {
+        return "", false, nil
+    }
+
+    now := m.now()
+    changed := false
+    if status.BlockNumber > 0 {
+        if current := entity.GetBlockNumber(); current == nil || *current != status.BlockNumber {
+            entity.SetBlockNumber(status.BlockNumber)
+            changed = true
+        }
+    }
+
+    var event string
+    switch {
+    case status.Status == blockchain.TxStatusFailed:
+        if err := entity.SetStatus(entities.TransactionStatusFailed); err != nil {
+            return "", false, err
+        }
+        entity.SetErrorMessage(status.ErrorMessage)
+        event, changed = TransactionEventFailed, true
+    case status.Confirmations >= threshold:
+        if err := entity.MarkConfirmed(status.Confirmations, now); err != nil {
+            return "", false, err
+        }
+        event, changed = TransactionEventConfirmed, true
+    case status.Confirmations != entity.GetConfirmations() || (status.Confirmations > 0 && entity.GetStatus() == entities.TransactionStatusPending):
+        if err := entity.SetConfirmations(status.Confirmations); err != nil {
+            return "", false, err
+        }
+        if status.Confirmations > 0 {
+            if err := entity.SetStatus(entities.TransactionStatusConfirming); err != nil {
+                return "", false, err
+            }
+        }
+        event, changed = TransactionEventConfirming, true
+    }
+
+    if changed {
+        entity.Touch(now)
+        if event == "" {
+            event = TransactionEventConfirming
+        }
+    }
+    return event, changed, nil
+}
+
+func (m *TransactionMonitor) publish(ctx context.Context, event string, tx entities.Transaction, threshold int) {
+    if m.publisher == nil && m.notifier == nil {
+        return
+    }
+
+    data := map[string]interface{}{
+        "transaction_id":         tx.GetID().String(),
+        "wallet_id":              tx.GetWalletID().String(),
+        "chain":                  string(tx.GetChain()),
+        "hash":                   tx.GetHash(),
+        "status":                 string(tx.GetStatus()),
+        "confirmations":          tx.GetConfirmations(),
+        "confirmation_threshold": threshold,
+    }
+    if block := tx.GetBlockNumber(); block != nil {
+        data["block_number"] = *block
+    }
+
+    if m.publisher != nil {
+        err := m.publisher.Publish(ctx, messaging.TransactionChannel, messaging.Message{
+            Event:     event,
+            Data:      data,
+            Timestamp: m.now(),
+        })
+        if err != nil {
+            m.logger.Warn("failed to publish transaction update",
+                slog.String("transaction_id", tx.GetID().String()),
+                slog.String("event", event),
+                slog.String("error", err.Error()))
+        }
+    }
+
+    if m.notifier != nil {
+        var domainEvent events.Event = events.Named{Name: event, Data: data, At: m.now()}
+        if event == TransactionEventConfirmed {
+            domainEvent = events.TransactionConfirmed{
+                TransactionID: tx.GetID(),
+                WalletID:      tx.GetWalletID(),
+                Chain:         tx.GetChain(),
+                Hash:          tx.GetHash(),
+                Confirmations: tx.GetConfirmations(),
+                Threshold:     threshold,
+                BlockNumber:   tx.GetBlockNumber(),
+                At:            m.now(),
+            }
+        }
+        if err := m.notifier.Publish(ctx, domainEvent); err != nil {
+            m.logger.Warn("failed to record transaction event",
+                slog.String("transaction_id", tx.GetID().String()),
+                slog.String("event", event),
+                slog.String("error", err.Error()))
         }
     }
 }
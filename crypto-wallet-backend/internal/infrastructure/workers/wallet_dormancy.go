@@ -0,0 +1,65 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	walletusecase "github.com/crypto-wallet/backend/internal/application/usecases/wallet"
+)
+
+// WalletDormancyWorker periodically applies the wallet dormancy policy.
+type WalletDormancyWorker struct {
+	dormancyUC *walletusecase.ApplyDormancyPolicyUseCase
+	logger     *slog.Logger
+	interval   time.Duration
+}
+
+// NewWalletDormancyWorker creates a new WalletDormancyWorker.
+func NewWalletDormancyWorker(
+	dormancyUC *walletusecase.ApplyDormancyPolicyUseCase,
+	logger *slog.Logger,
+	interval time.Duration,
+) *WalletDormancyWorker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	return &WalletDormancyWorker{
+		dormancyUC: dormancyUC,
+		logger:     logger,
+		interval:   interval,
+	}
+}
+
+// Start runs the worker until the context is cancelled.
+func (w *WalletDormancyWorker) Start(ctx context.Context) {
+	w.logger.Info("Starting wallet dormancy worker", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Wallet dormancy worker stopped by context")
+			return
+		case <-ticker.C:
+			w.apply(ctx)
+		}
+	}
+}
+
+func (w *WalletDormancyWorker) apply(ctx context.Context) {
+	result, err := w.dormancyUC.Execute(ctx)
+	if err != nil {
+		w.logger.Error("Failed to apply wallet dormancy policy", "error", err)
+		return
+	}
+
+	if result.Reminded > 0 || result.Dormant > 0 {
+		w.logger.Info("Applied wallet dormancy policy", "reminded", result.Reminded, "dormant", result.Dormant)
+	}
+}
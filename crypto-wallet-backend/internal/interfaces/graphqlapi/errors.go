@@ -0,0 +1,58 @@
+package graphqlapi
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	appLogging "github.com/crypto-wallet/backend/internal/infrastructure/logging"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// queryError is a resolver error as clients see it: the REST error code and message, with the code
+// in the error's extensions.
+type queryError struct {
+	code    string
+	message string
+	details map[string]any
+}
+
+func (e *queryError) Error() string {
+	return e.message
+}
+
+// Extensions returns the error's code and details, which presentError adds to the response.
+func (e *queryError) Extensions() map[string]any {
+	extensions := map[string]any{"code": e.code}
+	if len(e.details) > 0 {
+		extensions["details"] = e.details
+	}
+	return extensions
+}
+
+// presentError reports a queryError's code and details in the error's extensions.
+func presentError(ctx context.Context, err error) *gqlerror.Error {
+	presented := graphql.DefaultErrorPresenter(ctx, err)
+	var queryErr *queryError
+	if errors.As(err, &queryErr) {
+		presented.Extensions = queryErr.Extensions()
+	}
+	return presented
+}
+
+// resolverError translates err the way the REST error handler does and logs server errors.
+func (s *Schema) resolverError(ctx context.Context, err error) error {
+	resp, status := utils.ToErrorResponse(err)
+	if status >= http.StatusInternalServerError {
+		appLogging.LoggerFromContext(ctx, s.logger).Error("graphql resolver failed", slog.String("error", err.Error()))
+	}
+	return &queryError{code: resp.Code, message: resp.Message, details: resp.Details}
+}
+
+func invalidArgument(message string) error {
+	return &queryError{code: "VALIDATION_ERROR", message: message}
+}
@@ -0,0 +1,90 @@
+package graphqlapi
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// batchLoader loads values by key, fetching every key queued so far in one call. Resolvers queue
+// the keys of a list as they build it, so the first element to load a value fetches them all and
+// the rest are served from the same batch. Results are kept for the rest of the request.
+type batchLoader[K comparable, V any] struct {
+	fetch func(ctx context.Context, keys []K) (map[K]V, error)
+
+	mu      sync.Mutex
+	queued  []K
+	batches map[K]*batch[K, V]
+}
+
+type batch[K comparable, V any] struct {
+	done   chan struct{}
+	values map[K]V
+	err    error
+}
+
+func newBatchLoader[K comparable, V any](fetch func(ctx context.Context, keys []K) (map[K]V, error)) *batchLoader[K, V] {
+	return &batchLoader[K, V]{
+		fetch:   fetch,
+		batches: make(map[K]*batch[K, V]),
+	}
+}
+
+// Queue adds keys to the next batch. Keys already loaded or in flight are skipped.
+func (l *batchLoader[K, V]) Queue(keys ...K) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, key := range keys {
+		if _, ok := l.batches[key]; !ok {
+			l.queued = append(l.queued, key)
+		}
+	}
+}
+
+// Load returns the value of key, and false when the fetch did not return one.
+func (l *batchLoader[K, V]) Load(ctx context.Context, key K) (V, bool, error) {
+	l.mu.Lock()
+	b, ok := l.batches[key]
+	if !ok {
+		b = &batch[K, V]{done: make(chan struct{})}
+		keys := []K{key}
+		l.batches[key] = b
+		for _, queued := range l.queued {
+			if _, taken := l.batches[queued]; !taken {
+				l.batches[queued] = b
+				keys = append(keys, queued)
+			}
+		}
+		l.queued = nil
+		l.mu.Unlock()
+
+		l.run(ctx, b, keys)
+	} else {
+		l.mu.Unlock()
+	}
+
+	var zero V
+	select {
+	case <-b.done:
+	case <-ctx.Done():
+		return zero, false, ctx.Err()
+	}
+	if b.err != nil {
+		return zero, false, b.err
+	}
+	value, found := b.values[key]
+	return value, found, nil
+}
+
+// run fetches keys into b. The batch is completed even if the fetch panics, so the other resolvers
+// waiting on it fail with the panic instead of blocking until the request is cancelled.
+func (l *batchLoader[K, V]) run(ctx context.Context, b *batch[K, V], keys []K) {
+	defer close(b.done)
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			b.values = nil
+			b.err = fmt.Errorf("graphql loader: fetch panicked: %v", recovered)
+		}
+	}()
+	b.values, b.err = l.fetch(ctx, keys)
+}
@@ -0,0 +1,75 @@
+package graphqlapi
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBatchLoaderFetchesQueuedKeysOnce(t *testing.T) {
+	var calls [][]string
+	loader := newBatchLoader(func(_ context.Context, keys []string) (map[string]int, error) {
+		calls = append(calls, keys)
+		values := make(map[string]int, len(keys))
+		for _, key := range keys {
+			values[key] = len(key)
+		}
+		return values, nil
+	})
+
+	loader.Queue("a", "bb", "ccc")
+	for _, key := range []string{"bb", "a", "ccc"} {
+		value, found, err := loader.Load(context.Background(), key)
+		if err != nil || !found || value != len(key) {
+			t.Fatalf("Load(%q) = %d, %t, %v; want %d, true, nil", key, value, found, err, len(key))
+		}
+	}
+	if len(calls) != 1 || len(calls[0]) != 3 {
+		t.Fatalf("fetch calls = %v, want one call with every queued key", calls)
+	}
+}
+
+func TestBatchLoaderPanickingFetchFailsEveryWaiter(t *testing.T) {
+	entered := make(chan struct{})
+	loader := newBatchLoader(func(context.Context, []string) (map[string]int, error) {
+		close(entered)
+		time.Sleep(10 * time.Millisecond)
+		panic("boom")
+	})
+	loader.Queue("a", "b")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				errs[0] = errors.New("panic escaped Load")
+			}
+		}()
+		_, _, errs[0] = loader.Load(ctx, "a")
+	}()
+	<-entered
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, _, errs[1] = loader.Load(ctx, "b")
+	}()
+	wg.Wait()
+
+	for i, err := range errs {
+		if err == nil || !strings.Contains(err.Error(), "boom") {
+			t.Errorf("waiter %d error = %v, want the fetch panic", i, err)
+		}
+	}
+	if ctx.Err() != nil {
+		t.Fatal("waiters were released by the deadline instead of the failed batch")
+	}
+}
@@ -0,0 +1,117 @@
+package graphqlapi
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+// maxOwnedWallets bounds the wallets loaded to check ownership and resolve transaction wallets.
+const maxOwnedWallets = 500
+
+type requestKey struct{}
+
+// request holds the loaders of one query. Wallet IDs and rate symbols are queued as resolvers are
+// built, so a list of wallets fetches its transactions and rates in one query each.
+type request struct {
+	schema *Schema
+	userID uuid.UUID
+
+	rates *batchLoader[string, entities.ExchangeRate]
+
+	mu sync.Mutex
+	// walletIDs are the wallets seen so far, queued into transaction loaders created later.
+	walletIDs    []uuid.UUID
+	transactions map[int]*batchLoader[uuid.UUID, []entities.Transaction]
+
+	ownedOnce sync.Once
+	owned     map[uuid.UUID]entities.Wallet
+	ownedErr  error
+}
+
+func newRequest(s *Schema, userID uuid.UUID) *request {
+	return &request{
+		schema: s,
+		userID: userID,
+		rates: newBatchLoader(func(ctx context.Context, symbols []string) (map[string]entities.ExchangeRate, error) {
+			rates, err := s.rates.GetRatesBySymbols(ctx, symbols)
+			if err != nil {
+				return nil, err
+			}
+			bySymbol := make(map[string]entities.ExchangeRate, len(rates))
+			for _, rate := range rates {
+				bySymbol[strings.ToUpper(rate.GetSymbol())] = rate
+			}
+			return bySymbol, nil
+		}),
+		transactions: make(map[int]*batchLoader[uuid.UUID, []entities.Transaction]),
+	}
+}
+
+func requestFromContext(ctx context.Context) *request {
+	req, _ := ctx.Value(requestKey{}).(*request)
+	return req
+}
+
+// queueWallets queues the wallets' transactions and rates for the next batch.
+func (r *request) queueWallets(wallets []entities.Wallet) {
+	ids := make([]uuid.UUID, 0, len(wallets))
+	symbols := make([]string, 0, len(wallets))
+	for _, wallet := range wallets {
+		ids = append(ids, wallet.GetID())
+		symbols = append(symbols, entities.WalletAssetSymbol(wallet))
+	}
+	r.rates.Queue(symbols...)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.walletIDs = append(r.walletIDs, ids...)
+	for _, loader := range r.transactions {
+		loader.Queue(ids...)
+	}
+}
+
+// transactionLoader returns the loader of each wallet's newest limit transactions.
+func (r *request) transactionLoader(limit int) *batchLoader[uuid.UUID, []entities.Transaction] {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	loader, ok := r.transactions[limit]
+	if !ok {
+		loader = newBatchLoader(func(ctx context.Context, walletIDs []uuid.UUID) (map[uuid.UUID][]entities.Transaction, error) {
+			return r.schema.transactions.ListRecentByWallets(ctx, walletIDs, limit)
+		})
+		loader.Queue(r.walletIDs...)
+		r.transactions[limit] = loader
+	}
+	return loader
+}
+
+// ownedWallets loads the user's wallets once per request.
+func (r *request) ownedWallets(ctx context.Context) (map[uuid.UUID]entities.Wallet, error) {
+	r.ownedOnce.Do(func() {
+		wallets, err := r.schema.wallets.ListByUser(ctx, r.userID, repositories.WalletFilter{}, repositories.ListOptions{Limit: maxOwnedWallets})
+		if err != nil {
+			r.ownedErr = err
+			return
+		}
+		r.owned = make(map[uuid.UUID]entities.Wallet, len(wallets))
+		for _, wallet := range wallets {
+			r.owned[wallet.GetID()] = wallet
+		}
+	})
+	return r.owned, r.ownedErr
+}
+
+// ownedWallet returns the user's wallet with the ID, or nil when the user holds none.
+func (r *request) ownedWallet(ctx context.Context, id uuid.UUID) (entities.Wallet, error) {
+	owned, err := r.ownedWallets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return owned[id], nil
+}
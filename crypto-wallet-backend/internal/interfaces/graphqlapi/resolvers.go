@@ -0,0 +1,514 @@
+package graphqlapi
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+const (
+	maxWalletPage      = 100
+	maxTransactionPage = 50
+	maxRateSymbols     = 50
+)
+
+// resolver is the root of the generated executable schema.
+type resolver struct {
+	schema *Schema
+}
+
+func (r *resolver) Query() QueryResolver {
+	return &queryResolver{schema: r.schema}
+}
+
+type queryResolver struct {
+	schema *Schema
+}
+
+// walletsArgs are the arguments of the wallets fields.
+type walletsArgs struct {
+	chain  *string
+	status *string
+	first  *int
+	offset *int
+}
+
+// Me resolves the authenticated user.
+func (q *queryResolver) Me(ctx context.Context) (*User, error) {
+	req := requestFromContext(ctx)
+	user, err := q.schema.users.GetByID(ctx, req.userID)
+	if err != nil {
+		return nil, q.schema.resolverError(ctx, err)
+	}
+	return &User{schema: q.schema, user: user}, nil
+}
+
+// Wallets resolves the user's wallets.
+func (q *queryResolver) Wallets(ctx context.Context, chain, status *string, first, offset *int) ([]*Wallet, error) {
+	return q.schema.listWallets(ctx, walletsArgs{chain: chain, status: status, first: first, offset: offset})
+}
+
+// Wallet resolves one of the user's wallets.
+func (q *queryResolver) Wallet(ctx context.Context, id string) (*Wallet, error) {
+	walletID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, invalidArgument("id must be a valid UUID")
+	}
+	req := requestFromContext(ctx)
+	wallet, err := req.ownedWallet(ctx, walletID)
+	if err != nil {
+		return nil, q.schema.resolverError(ctx, err)
+	}
+	if wallet == nil {
+		return nil, nil
+	}
+	return newWalletResolvers(req, []entities.Wallet{wallet})[0], nil
+}
+
+// Transactions resolves the newest transactions of one of the user's wallets.
+func (q *queryResolver) Transactions(ctx context.Context, walletID string, first *int) ([]*Transaction, error) {
+	id, err := uuid.Parse(walletID)
+	if err != nil {
+		return nil, invalidArgument("walletId must be a valid UUID")
+	}
+	req := requestFromContext(ctx)
+	wallet, err := req.ownedWallet(ctx, id)
+	if err != nil {
+		return nil, q.schema.resolverError(ctx, err)
+	}
+	if wallet == nil {
+		return nil, &queryError{code: "WALLET_NOT_FOUND", message: "wallet not found"}
+	}
+	return newWalletResolvers(req, []entities.Wallet{wallet})[0].Transactions(ctx, first)
+}
+
+// Portfolio resolves the user's portfolio summary.
+func (q *queryResolver) Portfolio(ctx context.Context, currency *string) (*Portfolio, error) {
+	return q.schema.portfolioSummary(ctx, currency)
+}
+
+// Rates resolves current rates of the symbols.
+func (q *queryResolver) Rates(ctx context.Context, symbols []string) ([]*Rate, error) {
+	if len(symbols) > maxRateSymbols {
+		return nil, invalidArgument(fmt.Sprintf("at most %d symbols may be requested", maxRateSymbols))
+	}
+	normalized := make([]string, 0, len(symbols))
+	for _, symbol := range symbols {
+		if trimmed := strings.ToUpper(strings.TrimSpace(symbol)); trimmed != "" {
+			normalized = append(normalized, trimmed)
+		}
+	}
+
+	req := requestFromContext(ctx)
+	req.rates.Queue(normalized...)
+	resolvers := make([]*Rate, 0, len(normalized))
+	for _, symbol := range normalized {
+		rate, found, err := req.rates.Load(ctx, symbol)
+		if err != nil {
+			return nil, q.schema.resolverError(ctx, err)
+		}
+		if found {
+			resolvers = append(resolvers, &Rate{rate: rate})
+		}
+	}
+	return resolvers, nil
+}
+
+func (s *Schema) listWallets(ctx context.Context, args walletsArgs) ([]*Wallet, error) {
+	var filter repositories.WalletFilter
+	if args.chain != nil && strings.TrimSpace(*args.chain) != "" {
+		chain := entities.NormalizeChain(*args.chain)
+		if chain == "" {
+			return nil, invalidArgument("chain is not supported")
+		}
+		filter.Chain = &chain
+	}
+	if args.status != nil && strings.TrimSpace(*args.status) != "" {
+		status := entities.WalletStatus(strings.ToLower(strings.TrimSpace(*args.status)))
+		switch status {
+		case entities.WalletStatusActive, entities.WalletStatusArchived, entities.WalletStatusDormant:
+			filter.Status = &status
+		default:
+			return nil, invalidArgument("status must be one of active, archived or dormant")
+		}
+	}
+	limit, err := pageSize(args.first, maxWalletPage)
+	if err != nil {
+		return nil, err
+	}
+	if args.offset == nil || *args.offset < 0 {
+		return nil, invalidArgument("offset must not be negative")
+	}
+
+	req := requestFromContext(ctx)
+	wallets, err := s.wallets.ListByUser(ctx, req.userID, filter, repositories.ListOptions{Limit: limit, Offset: *args.offset})
+	if err != nil {
+		return nil, s.resolverError(ctx, err)
+	}
+	return newWalletResolvers(req, wallets), nil
+}
+
+func (s *Schema) portfolioSummary(ctx context.Context, currency *string) (*Portfolio, error) {
+	if s.portfolio == nil {
+		return nil, &queryError{code: "HTTP_501", message: "portfolio not configured"}
+	}
+	displayCurrency := ""
+	if currency != nil {
+		displayCurrency = *currency
+	}
+
+	req := requestFromContext(ctx)
+	summary, err := s.portfolio.Execute(ctx, req.userID, displayCurrency)
+	if err != nil {
+		return nil, s.resolverError(ctx, err)
+	}
+
+	symbols := make([]string, 0, len(summary.Assets))
+	for _, asset := range summary.Assets {
+		symbols = append(symbols, strings.ToUpper(asset.Symbol))
+	}
+	req.rates.Queue(symbols...)
+	return &Portfolio{summary: summary}, nil
+}
+
+// pageSize validates a page size argument; the schema supplies its default, so nil means the
+// client sent an explicit null.
+func pageSize(first *int, max int) (int, error) {
+	if first == nil || *first < 1 || *first > max {
+		return 0, invalidArgument(fmt.Sprintf("first must be between 1 and %d", max))
+	}
+	return *first, nil
+}
+
+// User is the authenticated user.
+type User struct {
+	schema *Schema
+	user   entities.User
+}
+
+func (r *User) ID() string {
+	return r.user.GetID().String()
+}
+
+func (r *User) Email() string {
+	return r.user.GetEmail()
+}
+
+func (r *User) Status() string {
+	return string(r.user.GetStatus())
+}
+
+func (r *User) CreatedAt() time.Time {
+	return r.user.GetCreatedAt().UTC()
+}
+
+func (r *User) Wallets(ctx context.Context, chain, status *string, first, offset *int) ([]*Wallet, error) {
+	return r.schema.listWallets(ctx, walletsArgs{chain: chain, status: status, first: first, offset: offset})
+}
+
+func (r *User) Portfolio(ctx context.Context, currency *string) (*Portfolio, error) {
+	return r.schema.portfolioSummary(ctx, currency)
+}
+
+// Wallet is one of the user's wallets.
+type Wallet struct {
+	req    *request
+	wallet entities.Wallet
+}
+
+// newWalletResolvers wraps the wallets and queues their transactions and rates.
+func newWalletResolvers(req *request, wallets []entities.Wallet) []*Wallet {
+	req.queueWallets(wallets)
+	resolvers := make([]*Wallet, 0, len(wallets))
+	for _, wallet := range wallets {
+		resolvers = append(resolvers, &Wallet{req: req, wallet: wallet})
+	}
+	return resolvers
+}
+
+func (r *Wallet) ID() string {
+	return r.wallet.GetID().String()
+}
+
+func (r *Wallet) Chain() string {
+	return string(r.wallet.GetChain())
+}
+
+func (r *Wallet) Symbol() string {
+	return entities.WalletAssetSymbol(r.wallet)
+}
+
+func (r *Wallet) Address() string {
+	return r.wallet.GetAddress()
+}
+
+func (r *Wallet) Label() string {
+	return r.wallet.GetLabel()
+}
+
+func (r *Wallet) Balance() string {
+	return r.wallet.GetBalance().String()
+}
+
+func (r *Wallet) Status() string {
+	return string(r.wallet.GetStatus())
+}
+
+func (r *Wallet) CreatedAt() time.Time {
+	return r.wallet.GetCreatedAt().UTC()
+}
+
+func (r *Wallet) UpdatedAt() time.Time {
+	return r.wallet.GetUpdatedAt().UTC()
+}
+
+func (r *Wallet) BalanceUpdatedAt() *time.Time {
+	return optionalTime(r.wallet.GetBalanceUpdatedAt())
+}
+
+func (r *Wallet) Rate(ctx context.Context) (*Rate, error) {
+	rate, found, err := r.req.rates.Load(ctx, entities.WalletAssetSymbol(r.wallet))
+	if err != nil {
+		return nil, r.req.schema.resolverError(ctx, err)
+	}
+	if !found {
+		return nil, nil
+	}
+	return &Rate{rate: rate}, nil
+}
+
+func (r *Wallet) Transactions(ctx context.Context, first *int) ([]*Transaction, error) {
+	limit, err := pageSize(first, maxTransactionPage)
+	if err != nil {
+		return nil, err
+	}
+	transactions, _, err := r.req.transactionLoader(limit).Load(ctx, r.wallet.GetID())
+	if err != nil {
+		return nil, r.req.schema.resolverError(ctx, err)
+	}
+	resolvers := make([]*Transaction, 0, len(transactions))
+	for _, tx := range transactions {
+		resolvers = append(resolvers, &Transaction{req: r.req, tx: tx})
+	}
+	return resolvers, nil
+}
+
+// Transaction is a transaction of one of the user's wallets.
+type Transaction struct {
+	req *request
+	tx  entities.Transaction
+}
+
+func (r *Transaction) ID() string {
+	return r.tx.GetID().String()
+}
+
+func (r *Transaction) WalletID() string {
+	return r.tx.GetWalletID().String()
+}
+
+func (r *Transaction) Chain() string {
+	return string(r.tx.GetChain())
+}
+
+func (r *Transaction) Hash() string {
+	return r.tx.GetHash()
+}
+
+func (r *Transaction) Type() string {
+	return string(r.tx.GetType())
+}
+
+func (r *Transaction) Amount() string {
+	return r.tx.GetAmount().String()
+}
+
+func (r *Transaction) Fee() string {
+	return r.tx.GetFee().String()
+}
+
+func (r *Transaction) Status() string {
+	return string(r.tx.GetStatus())
+}
+
+func (r *Transaction) Confirmations() int {
+	return r.tx.GetConfirmations()
+}
+
+func (r *Transaction) FromAddress() string {
+	return r.tx.GetFromAddress()
+}
+
+func (r *Transaction) ToAddress() string {
+	return r.tx.GetToAddress()
+}
+
+func (r *Transaction) BlockNumber() *string {
+	block := r.tx.GetBlockNumber()
+	if block == nil {
+		return nil
+	}
+	value := strconv.FormatUint(*block, 10)
+	return &value
+}
+
+func (r *Transaction) ErrorMessage() *string {
+	return optionalString(r.tx.GetErrorMessage())
+}
+
+func (r *Transaction) CreatedAt() time.Time {
+	return r.tx.GetCreatedAt().UTC()
+}
+
+func (r *Transaction) ConfirmedAt() *time.Time {
+	return optionalTime(r.tx.GetConfirmedAt())
+}
+
+func (r *Transaction) Wallet(ctx context.Context) (*Wallet, error) {
+	wallet, err := r.req.ownedWallet(ctx, r.tx.GetWalletID())
+	if err != nil {
+		return nil, r.req.schema.resolverError(ctx, err)
+	}
+	if wallet == nil {
+		return nil, nil
+	}
+	return newWalletResolvers(r.req, []entities.Wallet{wallet})[0], nil
+}
+
+// Portfolio is the user's portfolio summary.
+type Portfolio struct {
+	summary dto.PortfolioSummary
+}
+
+func (r *Portfolio) Currency() string {
+	return r.summary.Currency
+}
+
+func (r *Portfolio) TotalBalance() string {
+	return r.summary.TotalBalance
+}
+
+func (r *Portfolio) TotalBalanceUSD() string {
+	return r.summary.TotalBalanceUSD
+}
+
+func (r *Portfolio) TotalChange() string {
+	return r.summary.TotalChange
+}
+
+func (r *Portfolio) TotalChange24h() string {
+	return r.summary.TotalChange24h
+}
+
+func (r *Portfolio) TotalChangePercentage24h() string {
+	return r.summary.TotalChangePercentage24h
+}
+
+func (r *Portfolio) FreshnessWarning() *string {
+	return optionalString(r.summary.FreshnessWarning)
+}
+
+func (r *Portfolio) Assets() []*PortfolioAsset {
+	resolvers := make([]*PortfolioAsset, 0, len(r.summary.Assets))
+	for _, asset := range r.summary.Assets {
+		resolvers = append(resolvers, &PortfolioAsset{asset: asset})
+	}
+	return resolvers
+}
+
+// PortfolioAsset is one holding in a portfolio.
+type PortfolioAsset struct {
+	asset dto.PortfolioAsset
+}
+
+func (r *PortfolioAsset) Symbol() string {
+	return r.asset.Symbol
+}
+
+func (r *PortfolioAsset) Balance() string {
+	return r.asset.Balance
+}
+
+func (r *PortfolioAsset) BalanceUSD() string {
+	return r.asset.BalanceUSD
+}
+
+func (r *PortfolioAsset) Value() string {
+	return r.asset.Value
+}
+
+func (r *PortfolioAsset) Percentage() string {
+	return r.asset.Percentage
+}
+
+func (r *PortfolioAsset) RateStale() bool {
+	return r.asset.RateStale
+}
+
+func (r *PortfolioAsset) Rate(ctx context.Context) (*Rate, error) {
+	req := requestFromContext(ctx)
+	rate, found, err := req.rates.Load(ctx, strings.ToUpper(r.asset.Symbol))
+	if err != nil {
+		return nil, req.schema.resolverError(ctx, err)
+	}
+	if !found {
+		return nil, nil
+	}
+	return &Rate{rate: rate}, nil
+}
+
+// Rate is a current USD rate.
+type Rate struct {
+	rate entities.ExchangeRate
+}
+
+func (r *Rate) Symbol() string {
+	return r.rate.GetSymbol()
+}
+
+func (r *Rate) PriceUSD() string {
+	return r.rate.GetPriceUSD().String()
+}
+
+func (r *Rate) PriceChange24h() string {
+	return r.rate.GetPriceChange24h().String()
+}
+
+func (r *Rate) Volume24h() string {
+	return r.rate.GetVolume24h().String()
+}
+
+func (r *Rate) MarketCap() string {
+	return r.rate.GetMarketCap().String()
+}
+
+func (r *Rate) Source() string {
+	return r.rate.GetSource()
+}
+
+func (r *Rate) LastUpdated() time.Time {
+	return r.rate.GetLastUpdated().UTC()
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func optionalTime(value *time.Time) *time.Time {
+	if value == nil {
+		return nil
+	}
+	utc := value.UTC()
+	return &utc
+}
@@ -0,0 +1,164 @@
+// Package graphqlapi serves a read-only GraphQL view of a user's wallets, transactions, portfolio
+// and rates, so clients can fetch in one round trip what the REST API spreads over several. Nested
+// transactions and rates are loaded in batches per request rather than once per wallet.
+package graphqlapi
+
+//go:generate go tool gqlgen generate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/executor"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/google/uuid"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/crypto-wallet/backend/internal/application/usecases/analytics"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	appLogging "github.com/crypto-wallet/backend/internal/infrastructure/logging"
+)
+
+const (
+	// maxDepth bounds query nesting; wallet -> transaction -> wallet is the deepest useful path.
+	maxDepth = 8
+	// maxQueryLength bounds the query document in bytes.
+	maxQueryLength = 16 << 10
+)
+
+// SchemaConfig wires the repositories and use cases behind the schema. Portfolio fields answer an
+// error when Portfolio is nil.
+type SchemaConfig struct {
+	Users        repositories.UserRepository
+	Wallets      repositories.WalletRepository
+	Transactions repositories.TransactionRepository
+	Rates        repositories.RateRepository
+	Portfolio    *analytics.PortfolioSummaryUseCase
+	// Introspection answers schema introspection queries; leave it off in production.
+	Introspection bool
+	Logger        *slog.Logger
+}
+
+// Params is a GraphQL request as clients post it.
+type Params struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// Schema executes queries on behalf of an authenticated user.
+type Schema struct {
+	exec         *executor.Executor
+	users        repositories.UserRepository
+	wallets      repositories.WalletRepository
+	transactions repositories.TransactionRepository
+	rates        repositories.RateRepository
+	portfolio    *analytics.PortfolioSummaryUseCase
+	logger       *slog.Logger
+}
+
+// NewSchema binds the generated executable schema to the resolvers.
+func NewSchema(cfg SchemaConfig) (*Schema, error) {
+	if cfg.Users == nil || cfg.Wallets == nil || cfg.Transactions == nil || cfg.Rates == nil {
+		return nil, errors.New("graphql schema: user, wallet, transaction and rate repositories are required")
+	}
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	s := &Schema{
+		users:        cfg.Users,
+		wallets:      cfg.Wallets,
+		transactions: cfg.Transactions,
+		rates:        cfg.Rates,
+		portfolio:    cfg.Portfolio,
+		logger:       logger,
+	}
+
+	exec := executor.New(NewExecutableSchema(Config{Resolvers: &resolver{schema: s}}))
+	exec.SetErrorPresenter(presentError)
+	exec.SetRecoverFunc(func(ctx context.Context, value any) error {
+		appLogging.LoggerFromContext(ctx, logger).Error("graphql resolver panicked", slog.String("panic", fmt.Sprint(value)))
+		return gqlerror.Errorf("internal system error")
+	})
+	exec.Use(depthLimit{max: maxDepth})
+	if cfg.Introspection {
+		exec.Use(extension.Introspection{})
+	}
+	s.exec = exec
+	return s, nil
+}
+
+// Execute runs the query as the user. Resolver failures are reported in the response's errors
+// alongside whatever data could be resolved.
+func (s *Schema) Execute(ctx context.Context, userID uuid.UUID, params Params) *graphql.Response {
+	if len(params.Query) > maxQueryLength {
+		return &graphql.Response{Errors: gqlerror.List{gqlerror.Errorf("query length %d exceeds the maximum allowed query length of %d bytes", len(params.Query), maxQueryLength)}}
+	}
+
+	ctx = context.WithValue(ctx, requestKey{}, newRequest(s, userID))
+	ctx = graphql.StartOperationTrace(ctx)
+	now := graphql.Now()
+	opCtx, errs := s.exec.CreateOperationContext(ctx, &graphql.RawParams{
+		Query:         params.Query,
+		OperationName: params.OperationName,
+		Variables:     params.Variables,
+		ReadTime:      graphql.TraceTiming{Start: now, End: now},
+	})
+	if errs != nil {
+		return s.exec.DispatchError(graphql.WithOperationContext(ctx, opCtx), errs)
+	}
+	responses, ctx := s.exec.DispatchOperation(ctx, opCtx)
+	return responses(ctx)
+}
+
+// depthLimit rejects operations whose fields nest deeper than max.
+type depthLimit struct {
+	max int
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = depthLimit{}
+
+func (depthLimit) ExtensionName() string {
+	return "DepthLimit"
+}
+
+func (depthLimit) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (d depthLimit) MutateOperationContext(_ context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	if depth := selectionDepth(opCtx.Operation.SelectionSet); depth > d.max {
+		return gqlerror.Errorf("query has depth %d, which exceeds the limit of %d", depth, d.max)
+	}
+	return nil
+}
+
+// selectionDepth returns how deeply fields nest in set. Fragments count as the fields they
+// contain; the validator has already rejected fragment cycles.
+func selectionDepth(set ast.SelectionSet) int {
+	depth := 0
+	for _, selection := range set {
+		var nested int
+		switch selection := selection.(type) {
+		case *ast.Field:
+			nested = 1 + selectionDepth(selection.SelectionSet)
+		case *ast.InlineFragment:
+			nested = selectionDepth(selection.SelectionSet)
+		case *ast.FragmentSpread:
+			if selection.Definition != nil {
+				nested = selectionDepth(selection.Definition.SelectionSet)
+			}
+		}
+		depth = max(depth, nested)
+	}
+	return depth
+}
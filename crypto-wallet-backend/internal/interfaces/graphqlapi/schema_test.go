@@ -0,0 +1,190 @@
+package graphqlapi
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+)
+
+type stubUsers struct {
+	repositories.UserRepository
+}
+
+type stubWallets struct {
+	repositories.WalletRepository
+	wallets []entities.Wallet
+}
+
+func (s *stubWallets) ListByUser(context.Context, uuid.UUID, repositories.WalletFilter, repositories.ListOptions) ([]entities.Wallet, error) {
+	return s.wallets, nil
+}
+
+type stubTransactions struct {
+	repositories.TransactionRepository
+	calls atomic.Int32
+}
+
+func (s *stubTransactions) ListRecentByWallets(_ context.Context, walletIDs []uuid.UUID, _ int) (map[uuid.UUID][]entities.Transaction, error) {
+	s.calls.Add(1)
+	byWallet := make(map[uuid.UUID][]entities.Transaction, len(walletIDs))
+	for _, id := range walletIDs {
+		byWallet[id] = []entities.Transaction{entities.HydrateTransactionEntity(entities.TransactionParams{
+			ID:        uuid.New(),
+			WalletID:  id,
+			Chain:     entities.ChainETH,
+			Amount:    decimal.RequireFromString("1.5"),
+			Status:    entities.TransactionStatusConfirmed,
+			CreatedAt: time.Now(),
+		})}
+	}
+	return byWallet, nil
+}
+
+type stubRates struct {
+	repositories.RateRepository
+	calls atomic.Int32
+}
+
+func (s *stubRates) GetRatesBySymbols(_ context.Context, symbols []string) ([]entities.ExchangeRate, error) {
+	s.calls.Add(1)
+	rates := make([]entities.ExchangeRate, 0, len(symbols))
+	for _, symbol := range symbols {
+		rates = append(rates, entities.HydrateExchangeRateEntity(entities.ExchangeRateParams{
+			Symbol:      symbol,
+			PriceUSD:    decimal.RequireFromString("100"),
+			LastUpdated: time.Now(),
+		}))
+	}
+	return rates, nil
+}
+
+func newTestSchema(t *testing.T, wallets int) (*Schema, *stubTransactions, *stubRates) {
+	t.Helper()
+	walletRepo := &stubWallets{}
+	for range wallets {
+		walletRepo.wallets = append(walletRepo.wallets, entities.HydrateWalletEntity(entities.WalletParams{
+			ID:        uuid.New(),
+			Chain:     entities.ChainETH,
+			Balance:   decimal.RequireFromString("2"),
+			Status:    entities.WalletStatusActive,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		}))
+	}
+	transactions, rates := &stubTransactions{}, &stubRates{}
+	schema, err := NewSchema(SchemaConfig{Users: stubUsers{}, Wallets: walletRepo, Transactions: transactions, Rates: rates})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return schema, transactions, rates
+}
+
+func TestExecuteBatchesNestedFields(t *testing.T) {
+	schema, transactions, rates := newTestSchema(t, 5)
+
+	resp := schema.Execute(context.Background(), uuid.New(), Params{
+		Query: `{ wallets(first: 10) { id balance rate { symbol priceUsd } transactions(first: 3) { id amount wallet { id } } } }`,
+	})
+	if len(resp.Errors) > 0 {
+		t.Fatalf("Execute() errors = %v", resp.Errors)
+	}
+
+	var data struct {
+		Wallets []struct {
+			ID           string
+			Balance      string
+			Rate         *struct{ Symbol string }
+			Transactions []struct {
+				Amount string
+				Wallet *struct{ ID string }
+			}
+		}
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		t.Fatal(err)
+	}
+	if len(data.Wallets) != 5 {
+		t.Fatalf("wallets = %d, want 5", len(data.Wallets))
+	}
+	for _, wallet := range data.Wallets {
+		if wallet.Balance != "2" || wallet.Rate == nil || wallet.Rate.Symbol != "ETH" {
+			t.Errorf("wallet %s = balance %s rate %v, want balance 2 and the ETH rate", wallet.ID, wallet.Balance, wallet.Rate)
+		}
+		if len(wallet.Transactions) != 1 || wallet.Transactions[0].Wallet == nil || wallet.Transactions[0].Wallet.ID != wallet.ID {
+			t.Errorf("wallet %s transactions = %+v, want one linked back to the wallet", wallet.ID, wallet.Transactions)
+		}
+	}
+	if got := transactions.calls.Load(); got != 1 {
+		t.Errorf("transaction queries = %d, want 1", got)
+	}
+	if got := rates.calls.Load(); got != 1 {
+		t.Errorf("rate queries = %d, want 1", got)
+	}
+}
+
+func TestExecuteReportsArgumentErrorsWithCode(t *testing.T) {
+	schema, _, _ := newTestSchema(t, 1)
+
+	resp := schema.Execute(context.Background(), uuid.New(), Params{Query: `{ wallets(first: 1000) { id } }`})
+	if len(resp.Errors) != 1 {
+		t.Fatalf("Execute() errors = %v, want one", resp.Errors)
+	}
+	if code := resp.Errors[0].Extensions["code"]; code != "VALIDATION_ERROR" {
+		t.Errorf("error code = %v, want VALIDATION_ERROR", code)
+	}
+}
+
+func TestExecuteRejectsOversizedQueries(t *testing.T) {
+	schema, _, _ := newTestSchema(t, 1)
+
+	tests := []struct {
+		name  string
+		query string
+		want  string
+		// partial is set when the rejected field resolves to null alongside the error.
+		partial bool
+	}{
+		{
+			name:  "too deep",
+			query: `{ me { wallets { transactions { wallet { transactions { wallet { transactions { wallet { id } } } } } } } } }`,
+			want:  "exceeds the limit of 8",
+		},
+		{
+			name:  "too deep through fragments",
+			query: `{ wallets { ...W } } fragment W on Wallet { transactions { wallet { transactions { wallet { transactions { wallet { transactions { id } } } } } } } }`,
+			want:  "exceeds the limit of 8",
+		},
+		{
+			name:  "too long",
+			query: "{ me { id } }" + strings.Repeat(" ", maxQueryLength),
+			want:  "maximum allowed query length",
+		},
+		{
+			name:    "introspection disabled",
+			query:   `{ __schema { types { name } } }`,
+			want:    "introspection disabled",
+			partial: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := schema.Execute(context.Background(), uuid.New(), Params{Query: tt.query})
+			if len(resp.Errors) != 1 || !strings.Contains(resp.Errors[0].Message, tt.want) {
+				t.Fatalf("Execute() errors = %v, want one containing %q", resp.Errors, tt.want)
+			}
+			if resp.Data != nil && !tt.partial {
+				t.Errorf("Execute() data = %s, want none", resp.Data)
+			}
+		})
+	}
+}
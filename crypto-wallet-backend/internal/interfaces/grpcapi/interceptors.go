@@ -0,0 +1,150 @@
+package grpcapi
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/crypto-wallet/backend/internal/application/usecases/internalevents"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	appLogging "github.com/crypto-wallet/backend/internal/infrastructure/logging"
+)
+
+// requestIDHeader carries the caller's request ID, mirroring the X-Request-ID header of the REST API.
+const requestIDHeader = "x-request-id"
+
+type consumerContextKey struct{}
+
+// ConsumerFromContext returns the internal consumer authenticated by the consumer auth interceptor.
+func ConsumerFromContext(ctx context.Context) (entities.InternalEventConsumer, bool) {
+	consumer, ok := ctx.Value(consumerContextKey{}).(entities.InternalEventConsumer)
+	return consumer, ok
+}
+
+// NewRequestContextInterceptor attaches request-scoped logging and audit metadata to the context,
+// as the REST request context middleware does.
+func NewRequestContextInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		requestID := firstMetadataValue(md, requestIDHeader)
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+
+		ipAddress := ""
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			ipAddress = p.Addr.String()
+		}
+
+		requestLogger := logger.With(slog.String("request_id", requestID))
+		ctx = appLogging.ContextWithRequestID(ctx, requestID)
+		ctx = appLogging.ContextWithLogger(ctx, requestLogger)
+		ctx = audit.ContextWithRequestInfo(ctx, audit.RequestInfo{
+			IPAddress: ipAddress,
+			UserAgent: firstMetadataValue(md, "user-agent"),
+			RequestID: requestID,
+		})
+		_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, requestID))
+
+		return handler(ctx, req)
+	}
+}
+
+// NewLoggingInterceptor logs each call with its status code and latency, and converts application
+// errors into gRPC statuses.
+func NewLoggingInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		err = toStatusError(err)
+		code := status.Code(err)
+
+		level := slog.LevelInfo
+		switch code {
+		case codes.OK:
+		case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable, codes.Unimplemented:
+			level = slog.LevelError
+		default:
+			level = slog.LevelWarn
+		}
+
+		attrs := []slog.Attr{
+			slog.String("method", info.FullMethod),
+			slog.String("code", code.String()),
+			slog.Duration("latency", time.Since(start)),
+		}
+		if consumer, ok := ConsumerFromContext(ctx); ok {
+			attrs = append(attrs, slog.String("consumer", consumer.Name))
+		}
+		if err != nil {
+			attrs = append(attrs, slog.String("error", err.Error()))
+		}
+
+		appLogging.LoggerFromContext(ctx, logger).LogAttrs(context.Background(), level, "rpc completed", attrs...)
+		return resp, err
+	}
+}
+
+// ConsumerAuthConfig configures the internal consumer interceptor.
+type ConsumerAuthConfig struct {
+	Authenticator *internalevents.AuthenticateConsumerUseCase
+	Logger        *slog.Logger
+}
+
+// NewConsumerAuthInterceptor identifies internal consumers by their verified client certificate,
+// like the REST internal consumer middleware. Calls from unregistered or inactive consumers are
+// rejected with Unauthenticated.
+func NewConsumerAuthInterceptor(cfg ConsumerAuthConfig) grpc.UnaryServerInterceptor {
+	if cfg.Logger == nil {
+		cfg.Logger = slog.Default()
+	}
+	rejection := status.Error(codes.Unauthenticated, "registered client certificate required")
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if cfg.Authenticator == nil {
+			return nil, rejection
+		}
+		p, ok := peer.FromContext(ctx)
+		if !ok {
+			return nil, rejection
+		}
+		tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+		if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+			cfg.Logger.Warn("rpc without verified client certificate", slog.String("method", info.FullMethod))
+			return nil, rejection
+		}
+
+		consumer, err := cfg.Authenticator.Execute(ctx, tlsInfo.State.VerifiedChains[0][0].Raw)
+		if err != nil {
+			return nil, rejection
+		}
+		return handler(context.WithValue(ctx, consumerContextKey{}, consumer), req)
+	}
+}
+
+func firstMetadataValue(md metadata.MD, key string) string {
+	for _, value := range md.Get(key) {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
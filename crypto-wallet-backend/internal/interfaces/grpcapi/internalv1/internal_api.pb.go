@@ -0,0 +1,992 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v5.29.3
+// source: internalv1/internal_api.proto
+
+package internalv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListWalletsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWalletsRequest) Reset() {
+	*x = ListWalletsRequest{}
+	mi := &file_internalv1_internal_api_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWalletsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWalletsRequest) ProtoMessage() {}
+
+func (x *ListWalletsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internalv1_internal_api_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWalletsRequest.ProtoReflect.Descriptor instead.
+func (*ListWalletsRequest) Descriptor() ([]byte, []int) {
+	return file_internalv1_internal_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListWalletsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListWalletsRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *ListWalletsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListWalletsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListWalletsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type Wallet struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Chain            string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Address          string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Label            string                 `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	Balance          string                 `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	BalanceUsd       string                 `protobuf:"bytes,6,opt,name=balance_usd,json=balanceUsd,proto3" json:"balance_usd,omitempty"`
+	Status           string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	BalanceUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=balance_updated_at,json=balanceUpdatedAt,proto3" json:"balance_updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Wallet) Reset() {
+	*x = Wallet{}
+	mi := &file_internalv1_internal_api_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Wallet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Wallet) ProtoMessage() {}
+
+func (x *Wallet) ProtoReflect() protoreflect.Message {
+	mi := &file_internalv1_internal_api_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Wallet.ProtoReflect.Descriptor instead.
+func (*Wallet) Descriptor() ([]byte, []int) {
+	return file_internalv1_internal_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Wallet) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Wallet) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *Wallet) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Wallet) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Wallet) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *Wallet) GetBalanceUsd() string {
+	if x != nil {
+		return x.BalanceUsd
+	}
+	return ""
+}
+
+func (x *Wallet) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Wallet) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Wallet) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Wallet) GetBalanceUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BalanceUpdatedAt
+	}
+	return nil
+}
+
+type ListWalletsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wallets       []*Wallet              `protobuf:"bytes,1,rep,name=wallets,proto3" json:"wallets,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWalletsResponse) Reset() {
+	*x = ListWalletsResponse{}
+	mi := &file_internalv1_internal_api_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWalletsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWalletsResponse) ProtoMessage() {}
+
+func (x *ListWalletsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internalv1_internal_api_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWalletsResponse.ProtoReflect.Descriptor instead.
+func (*ListWalletsResponse) Descriptor() ([]byte, []int) {
+	return file_internalv1_internal_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListWalletsResponse) GetWallets() []*Wallet {
+	if x != nil {
+		return x.Wallets
+	}
+	return nil
+}
+
+func (x *ListWalletsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type RefreshBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WalletId      string                 `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshBalanceRequest) Reset() {
+	*x = RefreshBalanceRequest{}
+	mi := &file_internalv1_internal_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshBalanceRequest) ProtoMessage() {}
+
+func (x *RefreshBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internalv1_internal_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshBalanceRequest.ProtoReflect.Descriptor instead.
+func (*RefreshBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_internalv1_internal_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RefreshBalanceRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RefreshBalanceRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+type WalletBalance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      string                 `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Balance       string                 `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	BalanceUsd    string                 `protobuf:"bytes,5,opt,name=balance_usd,json=balanceUsd,proto3" json:"balance_usd,omitempty"`
+	Confirmations int32                  `protobuf:"varint,6,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	LastUpdated   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletBalance) Reset() {
+	*x = WalletBalance{}
+	mi := &file_internalv1_internal_api_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletBalance) ProtoMessage() {}
+
+func (x *WalletBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_internalv1_internal_api_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletBalance.ProtoReflect.Descriptor instead.
+func (*WalletBalance) Descriptor() ([]byte, []int) {
+	return file_internalv1_internal_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WalletBalance) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+func (x *WalletBalance) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *WalletBalance) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *WalletBalance) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *WalletBalance) GetBalanceUsd() string {
+	if x != nil {
+		return x.BalanceUsd
+	}
+	return ""
+}
+
+func (x *WalletBalance) GetConfirmations() int32 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *WalletBalance) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+type GetRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbols       []string               `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRatesRequest) Reset() {
+	*x = GetRatesRequest{}
+	mi := &file_internalv1_internal_api_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatesRequest) ProtoMessage() {}
+
+func (x *GetRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internalv1_internal_api_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatesRequest.ProtoReflect.Descriptor instead.
+func (*GetRatesRequest) Descriptor() ([]byte, []int) {
+	return file_internalv1_internal_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetRatesRequest) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+type ExchangeRate struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Symbol          string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	PriceUsd        string                 `protobuf:"bytes,2,opt,name=price_usd,json=priceUsd,proto3" json:"price_usd,omitempty"`
+	PriceChange_24H string                 `protobuf:"bytes,3,opt,name=price_change_24h,json=priceChange24h,proto3" json:"price_change_24h,omitempty"`
+	Volume_24H      string                 `protobuf:"bytes,4,opt,name=volume_24h,json=volume24h,proto3" json:"volume_24h,omitempty"`
+	MarketCap       string                 `protobuf:"bytes,5,opt,name=market_cap,json=marketCap,proto3" json:"market_cap,omitempty"`
+	Source          string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	LastUpdated     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	Stale           bool                   `protobuf:"varint,8,opt,name=stale,proto3" json:"stale,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_internalv1_internal_api_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_internalv1_internal_api_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_internalv1_internal_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExchangeRate) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetPriceUsd() string {
+	if x != nil {
+		return x.PriceUsd
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetPriceChange_24H() string {
+	if x != nil {
+		return x.PriceChange_24H
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetVolume_24H() string {
+	if x != nil {
+		return x.Volume_24H
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetMarketCap() string {
+	if x != nil {
+		return x.MarketCap
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+func (x *ExchangeRate) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+type GetRatesResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Rates            []*ExchangeRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	LastUpdated      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	FreshnessWarning string                 `protobuf:"bytes,3,opt,name=freshness_warning,json=freshnessWarning,proto3" json:"freshness_warning,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetRatesResponse) Reset() {
+	*x = GetRatesResponse{}
+	mi := &file_internalv1_internal_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatesResponse) ProtoMessage() {}
+
+func (x *GetRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internalv1_internal_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatesResponse.ProtoReflect.Descriptor instead.
+func (*GetRatesResponse) Descriptor() ([]byte, []int) {
+	return file_internalv1_internal_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetRatesResponse) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *GetRatesResponse) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+func (x *GetRatesResponse) GetFreshnessWarning() string {
+	if x != nil {
+		return x.FreshnessWarning
+	}
+	return ""
+}
+
+type GetExchangeQuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FromWalletId  string                 `protobuf:"bytes,2,opt,name=from_wallet_id,json=fromWalletId,proto3" json:"from_wallet_id,omitempty"`
+	ToWalletId    string                 `protobuf:"bytes,3,opt,name=to_wallet_id,json=toWalletId,proto3" json:"to_wallet_id,omitempty"`
+	FromAmount    string                 `protobuf:"bytes,4,opt,name=from_amount,json=fromAmount,proto3" json:"from_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExchangeQuoteRequest) Reset() {
+	*x = GetExchangeQuoteRequest{}
+	mi := &file_internalv1_internal_api_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExchangeQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExchangeQuoteRequest) ProtoMessage() {}
+
+func (x *GetExchangeQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internalv1_internal_api_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExchangeQuoteRequest.ProtoReflect.Descriptor instead.
+func (*GetExchangeQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_internalv1_internal_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetExchangeQuoteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetExchangeQuoteRequest) GetFromWalletId() string {
+	if x != nil {
+		return x.FromWalletId
+	}
+	return ""
+}
+
+func (x *GetExchangeQuoteRequest) GetToWalletId() string {
+	if x != nil {
+		return x.ToWalletId
+	}
+	return ""
+}
+
+func (x *GetExchangeQuoteRequest) GetFromAmount() string {
+	if x != nil {
+		return x.FromAmount
+	}
+	return ""
+}
+
+type ExchangeQuote struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OperationId      string                 `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	FromWalletId     string                 `protobuf:"bytes,2,opt,name=from_wallet_id,json=fromWalletId,proto3" json:"from_wallet_id,omitempty"`
+	ToWalletId       string                 `protobuf:"bytes,3,opt,name=to_wallet_id,json=toWalletId,proto3" json:"to_wallet_id,omitempty"`
+	FromAmount       string                 `protobuf:"bytes,4,opt,name=from_amount,json=fromAmount,proto3" json:"from_amount,omitempty"`
+	ToAmount         string                 `protobuf:"bytes,5,opt,name=to_amount,json=toAmount,proto3" json:"to_amount,omitempty"`
+	ExchangeRate     string                 `protobuf:"bytes,6,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	FeePercentage    string                 `protobuf:"bytes,7,opt,name=fee_percentage,json=feePercentage,proto3" json:"fee_percentage,omitempty"`
+	FeeAmount        string                 `protobuf:"bytes,8,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
+	QuoteExpiresAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=quote_expires_at,json=quoteExpiresAt,proto3" json:"quote_expires_at,omitempty"`
+	QuoteTtlSeconds  int32                  `protobuf:"varint,10,opt,name=quote_ttl_seconds,json=quoteTtlSeconds,proto3" json:"quote_ttl_seconds,omitempty"`
+	ExpiresInSeconds int32                  `protobuf:"varint,11,opt,name=expires_in_seconds,json=expiresInSeconds,proto3" json:"expires_in_seconds,omitempty"`
+	PricingStrategy  string                 `protobuf:"bytes,12,opt,name=pricing_strategy,json=pricingStrategy,proto3" json:"pricing_strategy,omitempty"`
+	PricingMetadata  map[string]string      `protobuf:"bytes,13,rep,name=pricing_metadata,json=pricingMetadata,proto3" json:"pricing_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ExchangeQuote) Reset() {
+	*x = ExchangeQuote{}
+	mi := &file_internalv1_internal_api_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeQuote) ProtoMessage() {}
+
+func (x *ExchangeQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_internalv1_internal_api_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeQuote.ProtoReflect.Descriptor instead.
+func (*ExchangeQuote) Descriptor() ([]byte, []int) {
+	return file_internalv1_internal_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExchangeQuote) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *ExchangeQuote) GetFromWalletId() string {
+	if x != nil {
+		return x.FromWalletId
+	}
+	return ""
+}
+
+func (x *ExchangeQuote) GetToWalletId() string {
+	if x != nil {
+		return x.ToWalletId
+	}
+	return ""
+}
+
+func (x *ExchangeQuote) GetFromAmount() string {
+	if x != nil {
+		return x.FromAmount
+	}
+	return ""
+}
+
+func (x *ExchangeQuote) GetToAmount() string {
+	if x != nil {
+		return x.ToAmount
+	}
+	return ""
+}
+
+func (x *ExchangeQuote) GetExchangeRate() string {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+func (x *ExchangeQuote) GetFeePercentage() string {
+	if x != nil {
+		return x.FeePercentage
+	}
+	return ""
+}
+
+func (x *ExchangeQuote) GetFeeAmount() string {
+	if x != nil {
+		return x.FeeAmount
+	}
+	return ""
+}
+
+func (x *ExchangeQuote) GetQuoteExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.QuoteExpiresAt
+	}
+	return nil
+}
+
+func (x *ExchangeQuote) GetQuoteTtlSeconds() int32 {
+	if x != nil {
+		return x.QuoteTtlSeconds
+	}
+	return 0
+}
+
+func (x *ExchangeQuote) GetExpiresInSeconds() int32 {
+	if x != nil {
+		return x.ExpiresInSeconds
+	}
+	return 0
+}
+
+func (x *ExchangeQuote) GetPricingStrategy() string {
+	if x != nil {
+		return x.PricingStrategy
+	}
+	return ""
+}
+
+func (x *ExchangeQuote) GetPricingMetadata() map[string]string {
+	if x != nil {
+		return x.PricingMetadata
+	}
+	return nil
+}
+
+var File_internalv1_internal_api_proto protoreflect.FileDescriptor
+
+const file_internalv1_internal_api_proto_rawDesc = "" +
+	"\n" +
+	"\x1dinternalv1/internal_api.proto\x12\x18cryptowallet.internal.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x89\x01\n" +
+	"\x12ListWalletsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\"\xf1\x02\n" +
+	"\x06Wallet\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\x12\x18\n" +
+	"\abalance\x18\x05 \x01(\tR\abalance\x12\x1f\n" +
+	"\vbalance_usd\x18\x06 \x01(\tR\n" +
+	"balanceUsd\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12H\n" +
+	"\x12balance_updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x10balanceUpdatedAt\"g\n" +
+	"\x13ListWalletsResponse\x12:\n" +
+	"\awallets\x18\x01 \x03(\v2 .cryptowallet.internal.v1.WalletR\awallets\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"M\n" +
+	"\x15RefreshBalanceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\twallet_id\x18\x02 \x01(\tR\bwalletId\"\xfc\x01\n" +
+	"\rWalletBalance\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\tR\bwalletId\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x18\n" +
+	"\abalance\x18\x04 \x01(\tR\abalance\x12\x1f\n" +
+	"\vbalance_usd\x18\x05 \x01(\tR\n" +
+	"balanceUsd\x12$\n" +
+	"\rconfirmations\x18\x06 \x01(\x05R\rconfirmations\x12=\n" +
+	"\flast_updated\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vlastUpdated\"+\n" +
+	"\x0fGetRatesRequest\x12\x18\n" +
+	"\asymbols\x18\x01 \x03(\tR\asymbols\"\x98\x02\n" +
+	"\fExchangeRate\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1b\n" +
+	"\tprice_usd\x18\x02 \x01(\tR\bpriceUsd\x12(\n" +
+	"\x10price_change_24h\x18\x03 \x01(\tR\x0epriceChange24h\x12\x1d\n" +
+	"\n" +
+	"volume_24h\x18\x04 \x01(\tR\tvolume24h\x12\x1d\n" +
+	"\n" +
+	"market_cap\x18\x05 \x01(\tR\tmarketCap\x12\x16\n" +
+	"\x06source\x18\x06 \x01(\tR\x06source\x12=\n" +
+	"\flast_updated\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vlastUpdated\x12\x14\n" +
+	"\x05stale\x18\b \x01(\bR\x05stale\"\xbc\x01\n" +
+	"\x10GetRatesResponse\x12<\n" +
+	"\x05rates\x18\x01 \x03(\v2&.cryptowallet.internal.v1.ExchangeRateR\x05rates\x12=\n" +
+	"\flast_updated\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vlastUpdated\x12+\n" +
+	"\x11freshness_warning\x18\x03 \x01(\tR\x10freshnessWarning\"\x9b\x01\n" +
+	"\x17GetExchangeQuoteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12$\n" +
+	"\x0efrom_wallet_id\x18\x02 \x01(\tR\ffromWalletId\x12 \n" +
+	"\fto_wallet_id\x18\x03 \x01(\tR\n" +
+	"toWalletId\x12\x1f\n" +
+	"\vfrom_amount\x18\x04 \x01(\tR\n" +
+	"fromAmount\"\x9b\x05\n" +
+	"\rExchangeQuote\x12!\n" +
+	"\foperation_id\x18\x01 \x01(\tR\voperationId\x12$\n" +
+	"\x0efrom_wallet_id\x18\x02 \x01(\tR\ffromWalletId\x12 \n" +
+	"\fto_wallet_id\x18\x03 \x01(\tR\n" +
+	"toWalletId\x12\x1f\n" +
+	"\vfrom_amount\x18\x04 \x01(\tR\n" +
+	"fromAmount\x12\x1b\n" +
+	"\tto_amount\x18\x05 \x01(\tR\btoAmount\x12#\n" +
+	"\rexchange_rate\x18\x06 \x01(\tR\fexchangeRate\x12%\n" +
+	"\x0efee_percentage\x18\a \x01(\tR\rfeePercentage\x12\x1d\n" +
+	"\n" +
+	"fee_amount\x18\b \x01(\tR\tfeeAmount\x12D\n" +
+	"\x10quote_expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0equoteExpiresAt\x12*\n" +
+	"\x11quote_ttl_seconds\x18\n" +
+	" \x01(\x05R\x0fquoteTtlSeconds\x12,\n" +
+	"\x12expires_in_seconds\x18\v \x01(\x05R\x10expiresInSeconds\x12)\n" +
+	"\x10pricing_strategy\x18\f \x01(\tR\x0fpricingStrategy\x12g\n" +
+	"\x10pricing_metadata\x18\r \x03(\v2<.cryptowallet.internal.v1.ExchangeQuote.PricingMetadataEntryR\x0fpricingMetadata\x1aB\n" +
+	"\x14PricingMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\xba\x03\n" +
+	"\rWalletService\x12j\n" +
+	"\vListWallets\x12,.cryptowallet.internal.v1.ListWalletsRequest\x1a-.cryptowallet.internal.v1.ListWalletsResponse\x12j\n" +
+	"\x0eRefreshBalance\x12/.cryptowallet.internal.v1.RefreshBalanceRequest\x1a'.cryptowallet.internal.v1.WalletBalance\x12a\n" +
+	"\bGetRates\x12).cryptowallet.internal.v1.GetRatesRequest\x1a*.cryptowallet.internal.v1.GetRatesResponse\x12n\n" +
+	"\x10GetExchangeQuote\x121.cryptowallet.internal.v1.GetExchangeQuoteRequest\x1a'.cryptowallet.internal.v1.ExchangeQuoteBTZRgithub.com/crypto-wallet/backend/internal/interfaces/grpcapi/internalv1;internalv1b\x06proto3"
+
+var (
+	file_internalv1_internal_api_proto_rawDescOnce sync.Once
+	file_internalv1_internal_api_proto_rawDescData []byte
+)
+
+func file_internalv1_internal_api_proto_rawDescGZIP() []byte {
+	file_internalv1_internal_api_proto_rawDescOnce.Do(func() {
+		file_internalv1_internal_api_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internalv1_internal_api_proto_rawDesc), len(file_internalv1_internal_api_proto_rawDesc)))
+	})
+	return file_internalv1_internal_api_proto_rawDescData
+}
+
+var file_internalv1_internal_api_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_internalv1_internal_api_proto_goTypes = []any{
+	(*ListWalletsRequest)(nil),      // 0: cryptowallet.internal.v1.ListWalletsRequest
+	(*Wallet)(nil),                  // 1: cryptowallet.internal.v1.Wallet
+	(*ListWalletsResponse)(nil),     // 2: cryptowallet.internal.v1.ListWalletsResponse
+	(*RefreshBalanceRequest)(nil),   // 3: cryptowallet.internal.v1.RefreshBalanceRequest
+	(*WalletBalance)(nil),           // 4: cryptowallet.internal.v1.WalletBalance
+	(*GetRatesRequest)(nil),         // 5: cryptowallet.internal.v1.GetRatesRequest
+	(*ExchangeRate)(nil),            // 6: cryptowallet.internal.v1.ExchangeRate
+	(*GetRatesResponse)(nil),        // 7: cryptowallet.internal.v1.GetRatesResponse
+	(*GetExchangeQuoteRequest)(nil), // 8: cryptowallet.internal.v1.GetExchangeQuoteRequest
+	(*ExchangeQuote)(nil),           // 9: cryptowallet.internal.v1.ExchangeQuote
+	nil,                             // 10: cryptowallet.internal.v1.ExchangeQuote.PricingMetadataEntry
+	(*timestamppb.Timestamp)(nil),   // 11: google.protobuf.Timestamp
+}
+var file_internalv1_internal_api_proto_depIdxs = []int32{
+	11, // 0: cryptowallet.internal.v1.Wallet.created_at:type_name -> google.protobuf.Timestamp
+	11, // 1: cryptowallet.internal.v1.Wallet.updated_at:type_name -> google.protobuf.Timestamp
+	11, // 2: cryptowallet.internal.v1.Wallet.balance_updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 3: cryptowallet.internal.v1.ListWalletsResponse.wallets:type_name -> cryptowallet.internal.v1.Wallet
+	11, // 4: cryptowallet.internal.v1.WalletBalance.last_updated:type_name -> google.protobuf.Timestamp
+	11, // 5: cryptowallet.internal.v1.ExchangeRate.last_updated:type_name -> google.protobuf.Timestamp
+	6,  // 6: cryptowallet.internal.v1.GetRatesResponse.rates:type_name -> cryptowallet.internal.v1.ExchangeRate
+	11, // 7: cryptowallet.internal.v1.GetRatesResponse.last_updated:type_name -> google.protobuf.Timestamp
+	11, // 8: cryptowallet.internal.v1.ExchangeQuote.quote_expires_at:type_name -> google.protobuf.Timestamp
+	10, // 9: cryptowallet.internal.v1.ExchangeQuote.pricing_metadata:type_name -> cryptowallet.internal.v1.ExchangeQuote.PricingMetadataEntry
+	0,  // 10: cryptowallet.internal.v1.WalletService.ListWallets:input_type -> cryptowallet.internal.v1.ListWalletsRequest
+	3,  // 11: cryptowallet.internal.v1.WalletService.RefreshBalance:input_type -> cryptowallet.internal.v1.RefreshBalanceRequest
+	5,  // 12: cryptowallet.internal.v1.WalletService.GetRates:input_type -> cryptowallet.internal.v1.GetRatesRequest
+	8,  // 13: cryptowallet.internal.v1.WalletService.GetExchangeQuote:input_type -> cryptowallet.internal.v1.GetExchangeQuoteRequest
+	2,  // 14: cryptowallet.internal.v1.WalletService.ListWallets:output_type -> cryptowallet.internal.v1.ListWalletsResponse
+	4,  // 15: cryptowallet.internal.v1.WalletService.RefreshBalance:output_type -> cryptowallet.internal.v1.WalletBalance
+	7,  // 16: cryptowallet.internal.v1.WalletService.GetRates:output_type -> cryptowallet.internal.v1.GetRatesResponse
+	9,  // 17: cryptowallet.internal.v1.WalletService.GetExchangeQuote:output_type -> cryptowallet.internal.v1.ExchangeQuote
+	14, // [14:18] is the sub-list for method output_type
+	10, // [10:14] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_internalv1_internal_api_proto_init() }
+func file_internalv1_internal_api_proto_init() {
+	if File_internalv1_internal_api_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internalv1_internal_api_proto_rawDesc), len(file_internalv1_internal_api_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_internalv1_internal_api_proto_goTypes,
+		DependencyIndexes: file_internalv1_internal_api_proto_depIdxs,
+		MessageInfos:      file_internalv1_internal_api_proto_msgTypes,
+	}.Build()
+	File_internalv1_internal_api_proto = out.File
+	file_internalv1_internal_api_proto_goTypes = nil
+	file_internalv1_internal_api_proto_depIdxs = nil
+}
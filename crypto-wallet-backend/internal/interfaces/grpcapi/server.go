@@ -0,0 +1,82 @@
+package grpcapi
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/crypto-wallet/backend/internal/application/usecases/internalevents"
+	"github.com/crypto-wallet/backend/internal/interfaces/grpcapi/internalv1"
+)
+
+// ServerConfig configures the internal gRPC server. It uses the same certificate, key and client
+// CA as the internal REST listener.
+type ServerConfig struct {
+	CertFile      string
+	KeyFile       string
+	ClientCAFile  string
+	Authenticator *internalevents.AuthenticateConsumerUseCase
+	Wallets       WalletServerConfig
+	Logger        *slog.Logger
+}
+
+// NewServer builds the internal gRPC server. Clients must present a certificate signed by the client
+// CA that belongs to a registered, active internal consumer.
+func NewServer(cfg ServerConfig) (*grpc.Server, error) {
+	if cfg.Authenticator == nil {
+		return nil, errors.New("grpc server: consumer authenticator is required")
+	}
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	tlsConfig, err := mutualTLSConfig(cfg.CertFile, cfg.KeyFile, cfg.ClientCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	server := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.ChainUnaryInterceptor(
+			NewRequestContextInterceptor(logger),
+			NewConsumerAuthInterceptor(ConsumerAuthConfig{Authenticator: cfg.Authenticator, Logger: logger}),
+			NewLoggingInterceptor(logger),
+		),
+	)
+	internalv1.RegisterWalletServiceServer(server, NewWalletServer(cfg.Wallets))
+	return server, nil
+}
+
+func mutualTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	if strings.TrimSpace(certFile) == "" || strings.TrimSpace(keyFile) == "" || strings.TrimSpace(clientCAFile) == "" {
+		return nil, errors.New("grpc server: certificate, key and client CA files are required")
+	}
+
+	certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("grpc server: load certificate: %w", err)
+	}
+	caPEM, err := os.ReadFile(clientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("grpc server: read client CA: %w", err)
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caPEM) {
+		return nil, errors.New("grpc server: client CA file contains no certificates")
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
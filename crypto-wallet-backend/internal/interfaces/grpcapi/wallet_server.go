@@ -0,0 +1,225 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	exchangeusecase "github.com/crypto-wallet/backend/internal/application/usecases/exchange"
+	ratesusecase "github.com/crypto-wallet/backend/internal/application/usecases/rates"
+	"github.com/crypto-wallet/backend/internal/application/usecases/wallet"
+	"github.com/crypto-wallet/backend/internal/interfaces/grpcapi/internalv1"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// WalletServerConfig wires the use cases behind the internal WalletService. They are the same use
+// cases the REST handlers serve; an RPC whose use case is nil answers Unimplemented.
+type WalletServerConfig struct {
+	ListWalletsUseCase  *wallet.ListWalletsUseCase
+	BalanceUseCase      *wallet.GetWalletBalanceUseCase
+	CurrentRatesUseCase *ratesusecase.GetCurrentRatesUseCase
+	QuoteUseCase        *exchangeusecase.SwapTokens
+	Logger              *slog.Logger
+}
+
+// WalletServer implements the internal WalletService.
+type WalletServer struct {
+	internalv1.UnimplementedWalletServiceServer
+
+	listWallets  *wallet.ListWalletsUseCase
+	balance      *wallet.GetWalletBalanceUseCase
+	currentRates *ratesusecase.GetCurrentRatesUseCase
+	quotes       *exchangeusecase.SwapTokens
+	logger       *slog.Logger
+}
+
+// NewWalletServer constructs a WalletServer.
+func NewWalletServer(cfg WalletServerConfig) *WalletServer {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &WalletServer{
+		listWallets:  cfg.ListWalletsUseCase,
+		balance:      cfg.BalanceUseCase,
+		currentRates: cfg.CurrentRatesUseCase,
+		quotes:       cfg.QuoteUseCase,
+		logger:       logger,
+	}
+}
+
+// ListWallets returns the user's wallets.
+func (s *WalletServer) ListWallets(ctx context.Context, req *internalv1.ListWalletsRequest) (*internalv1.ListWalletsResponse, error) {
+	if s.listWallets == nil {
+		return nil, status.Error(codes.Unimplemented, "wallet listing not configured")
+	}
+
+	result, err := s.listWallets.Execute(ctx, wallet.ListWalletsInput{
+		UserID: req.GetUserId(),
+		Chain:  req.GetChain(),
+		Status: req.GetStatus(),
+		Limit:  int(req.GetLimit()),
+		Offset: int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &internalv1.ListWalletsResponse{
+		Wallets: make([]*internalv1.Wallet, 0, len(result.Wallets)),
+		Total:   int32(result.Total),
+	}
+	for _, w := range result.Wallets {
+		resp.Wallets = append(resp.Wallets, &internalv1.Wallet{
+			Id:               w.ID.String(),
+			Chain:            w.Chain,
+			Address:          w.Address,
+			Label:            w.Label,
+			Balance:          w.Balance,
+			BalanceUsd:       w.BalanceUSD,
+			Status:           w.Status,
+			CreatedAt:        timestamppb.New(w.CreatedAt),
+			UpdatedAt:        timestamppb.New(w.UpdatedAt),
+			BalanceUpdatedAt: optionalTimestamp(w.BalanceUpdatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// RefreshBalance fetches and returns a wallet's current balance.
+func (s *WalletServer) RefreshBalance(ctx context.Context, req *internalv1.RefreshBalanceRequest) (*internalv1.WalletBalance, error) {
+	if s.balance == nil {
+		return nil, status.Error(codes.Unimplemented, "wallet balances not configured")
+	}
+
+	balance, err := s.balance.Execute(ctx, wallet.GetWalletBalanceInput{
+		UserID:   req.GetUserId(),
+		WalletID: req.GetWalletId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &internalv1.WalletBalance{
+		WalletId:      balance.WalletID.String(),
+		Chain:         balance.Chain,
+		Address:       balance.Address,
+		Balance:       balance.Balance,
+		BalanceUsd:    balance.BalanceUSD,
+		Confirmations: int32(balance.Confirmations),
+		LastUpdated:   timestamppb.New(balance.LastUpdated),
+	}, nil
+}
+
+// GetRates returns current USD rates.
+func (s *WalletServer) GetRates(ctx context.Context, req *internalv1.GetRatesRequest) (*internalv1.GetRatesResponse, error) {
+	if s.currentRates == nil {
+		return nil, status.Error(codes.Unimplemented, "rates not configured")
+	}
+
+	result, err := s.currentRates.Execute(ctx, ratesusecase.GetCurrentRatesInput{Symbols: req.GetSymbols()})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &internalv1.GetRatesResponse{
+		Rates:            make([]*internalv1.ExchangeRate, 0, len(result.Rates)),
+		LastUpdated:      timestamppb.New(result.LastUpdated),
+		FreshnessWarning: result.FreshnessWarning,
+	}
+	for _, rate := range result.Rates {
+		resp.Rates = append(resp.Rates, &internalv1.ExchangeRate{
+			Symbol:          rate.Symbol,
+			PriceUsd:        rate.PriceUSD,
+			PriceChange_24H: rate.PriceChange24h,
+			Volume_24H:      rate.Volume24h,
+			MarketCap:       rate.MarketCap,
+			Source:          rate.Source,
+			LastUpdated:     timestamppb.New(rate.LastUpdated),
+			Stale:           rate.Stale,
+		})
+	}
+	return resp, nil
+}
+
+// GetExchangeQuote prices an exchange between two of the user's wallets.
+func (s *WalletServer) GetExchangeQuote(ctx context.Context, req *internalv1.GetExchangeQuoteRequest) (*internalv1.ExchangeQuote, error) {
+	if s.quotes == nil {
+		return nil, status.Error(codes.Unimplemented, "exchange quotes not configured")
+	}
+
+	var validation utils.ValidationErrors
+	userID, err := uuid.Parse(strings.TrimSpace(req.GetUserId()))
+	if err != nil {
+		validation.Add("user_id", "must be a valid UUID")
+	}
+	fromWalletID, err := uuid.Parse(strings.TrimSpace(req.GetFromWalletId()))
+	if err != nil {
+		validation.Add("from_wallet_id", "must be a valid UUID")
+	}
+	toWalletID, err := uuid.Parse(strings.TrimSpace(req.GetToWalletId()))
+	if err != nil {
+		validation.Add("to_wallet_id", "must be a valid UUID")
+	}
+	if !validation.IsEmpty() {
+		return nil, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"invalid exchange quote request",
+			http.StatusBadRequest,
+			validation,
+			map[string]any{"errors": validation},
+		)
+	}
+
+	quote, err := s.quotes.GetQuote(ctx, userID, &dto.QuoteRequest{
+		FromWalletID: fromWalletID,
+		ToWalletID:   toWalletID,
+		FromAmount:   strings.TrimSpace(req.GetFromAmount()),
+	})
+	if err != nil {
+		return nil, quoteError(err)
+	}
+
+	return &internalv1.ExchangeQuote{
+		OperationId:      quote.OperationID.String(),
+		FromWalletId:     quote.FromWalletID.String(),
+		ToWalletId:       quote.ToWalletID.String(),
+		FromAmount:       quote.FromAmount.String(),
+		ToAmount:         quote.ToAmount.String(),
+		ExchangeRate:     quote.ExchangeRate.String(),
+		FeePercentage:    quote.FeePercentage.String(),
+		FeeAmount:        quote.FeeAmount.String(),
+		QuoteExpiresAt:   timestamppb.New(quote.QuoteExpiresAt),
+		QuoteTtlSeconds:  int32(quote.QuoteTTL),
+		ExpiresInSeconds: int32(quote.ExpiresIn),
+		PricingStrategy:  quote.PricingStrategy,
+		PricingMetadata:  quote.PricingMetadata,
+	}, nil
+}
+
+// quoteError reports the quote use case's plain validation errors, such as an insufficient
+// balance, as failed preconditions. Application and wrapped infrastructure errors are converted
+// by the logging interceptor.
+func quoteError(err error) error {
+	var appErr *utils.AppError
+	if errors.As(err, &appErr) || errors.Unwrap(err) != nil {
+		return err
+	}
+	return status.Error(codes.FailedPrecondition, err.Error())
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
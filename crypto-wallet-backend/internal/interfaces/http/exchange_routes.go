@@ -4,11 +4,10 @@ import (
 	"github.com/gofiber/fiber/v2"
 
 	"github.com/crypto-wallet/backend/internal/interfaces/http/handlers"
-	"github.com/crypto-wallet/backend/internal/interfaces/http/middleware"
 )
 
-// SetupExchangeRoutes registers all exchange-related routes.
-func SetupExchangeRoutes(app *fiber.App, exchangeHandler *handlers.ExchangeHandler) {
+// SetupExchangeRoutes registers all exchange-related routes; auth guards everything but rates and pairs.
+func SetupExchangeRoutes(app *fiber.App, exchangeHandler *handlers.ExchangeHandler, auth fiber.Handler) {
 	// Public routes for exchange rates and trading pairs
 	api := app.Group("/api/v1/exchange")
 
@@ -19,7 +18,7 @@ func SetupExchangeRoutes(app *fiber.App, exchangeHandler *handlers.ExchangeHandl
 	api.Get("/pairs", exchangeHandler.GetActiveTradingPairs)
 
 	// Protected routes (require authentication)
-	protected := api.Group("/", middleware.AuthMiddleware())
+	protected := api.Group("/", auth)
 
 	// Quote generation
 	protected.Post("/quote", exchangeHandler.GetQuote)
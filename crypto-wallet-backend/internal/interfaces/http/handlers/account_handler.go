@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	usecaseaccount "github.com/crypto-wallet/backend/internal/application/usecases/account"
+	usecaseexport "github.com/crypto-wallet/backend/internal/application/usecases/export"
+)
+
+// AccountHandlerConfig configures the account data rights HTTP handler.
+type AccountHandlerConfig struct {
+	ExportUseCase *usecaseexport.RequestPersonalDataExportUseCase
+	DeleteUseCase *usecaseaccount.DeleteAccountUseCase
+	Logger        *slog.Logger
+}
+
+// AccountHandler exposes personal data export and account deletion.
+type AccountHandler struct {
+	exportUC *usecaseexport.RequestPersonalDataExportUseCase
+	deleteUC *usecaseaccount.DeleteAccountUseCase
+	logger   *slog.Logger
+}
+
+// NewAccountHandler constructs an AccountHandler.
+func NewAccountHandler(cfg AccountHandlerConfig) *AccountHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &AccountHandler{
+		exportUC: cfg.ExportUseCase,
+		deleteUC: cfg.DeleteUseCase,
+		logger:   logger,
+	}
+}
+
+// Register attaches routes to the router.
+func (h *AccountHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Post("/me/data-export", h.RequestDataExport)
+	router.Delete("/me", h.DeleteAccount)
+}
+
+// RequestDataExport handles POST /api/v1/users/me/data-export. The archive is generated in the
+// background and downloaded through /exports once the job completes.
+func (h *AccountHandler) RequestDataExport(c *fiber.Ctx) error {
+	if h.exportUC == nil {
+		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "data export not configured"))
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.exportUC.Execute(c.UserContext(), userID)
+	if err != nil {
+		return respondError(c, err)
+	}
+	return c.Status(fiber.StatusAccepted).JSON(result)
+}
+
+// DeleteAccount handles DELETE /api/v1/users/me.
+func (h *AccountHandler) DeleteAccount(c *fiber.Ctx) error {
+	if h.deleteUC == nil {
+		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "account deletion not configured"))
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.deleteUC.Execute(c.UserContext(), userID)
+	if err != nil {
+		return respondError(c, err)
+	}
+	return c.Status(fiber.StatusAccepted).JSON(result)
+}
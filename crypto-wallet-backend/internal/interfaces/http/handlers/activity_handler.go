@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"log/slog"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	activityusecase "github.com/crypto-wallet/backend/internal/application/usecases/activity"
+)
+
+// ActivityHandlerConfig configures the activity feed handler.
+type ActivityHandlerConfig struct {
+	RecentActivityUseCase *activityusecase.GetRecentActivityUseCase
+	Logger                *slog.Logger
+}
+
+// ActivityHandler serves the merged feed of a user's transactions and exchanges.
+type ActivityHandler struct {
+	recentUC *activityusecase.GetRecentActivityUseCase
+	logger   *slog.Logger
+}
+
+// NewActivityHandler constructs an ActivityHandler.
+func NewActivityHandler(cfg ActivityHandlerConfig) *ActivityHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ActivityHandler{
+		recentUC: cfg.RecentActivityUseCase,
+		logger:   logger,
+	}
+}
+
+// Register attaches routes to the router.
+func (h *ActivityHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Get("/recent", h.handleRecent)
+}
+
+// handleRecent handles GET /activity/recent?kind=transaction,exchange&walletId=&limit=&cursor=.
+func (h *ActivityHandler) handleRecent(c *fiber.Ctx) error {
+	if h.recentUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "activity feed not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var kinds []string
+	if raw := c.Query("kind"); raw != "" {
+		kinds = strings.Split(raw, ",")
+	}
+
+	result, err := h.recentUC.Execute(c.UserContext(), activityusecase.GetRecentActivityInput{
+		UserID:   userID,
+		Kinds:    kinds,
+		WalletID: c.Query("walletId"),
+		Limit:    c.QueryInt("limit", 0),
+		Cursor:   c.Query("cursor"),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
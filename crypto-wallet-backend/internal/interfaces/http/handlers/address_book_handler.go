@@ -0,0 +1,251 @@
+package handlers
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/application/usecases/addressbook"
+)
+
+// AddressBookHandlerConfig configures the address book HTTP handler.
+type AddressBookHandlerConfig struct {
+	ListUseCase           *addressbook.ListEntriesUseCase
+	AddUseCase            *addressbook.AddEntryUseCase
+	UpdateUseCase         *addressbook.UpdateEntryUseCase
+	DeleteUseCase         *addressbook.DeleteEntryUseCase
+	GetSettingsUseCase    *addressbook.GetSettingsUseCase
+	UpdateSettingsUseCase *addressbook.UpdateSettingsUseCase
+	ExportUseCase         *addressbook.ExportEntriesUseCase
+	ImportUseCase         *addressbook.ImportEntriesUseCase
+	Logger                *slog.Logger
+}
+
+// AddressBookHandler exposes saved recipient addresses and the whitelist-only send setting.
+type AddressBookHandler struct {
+	listUC           *addressbook.ListEntriesUseCase
+	addUC            *addressbook.AddEntryUseCase
+	updateUC         *addressbook.UpdateEntryUseCase
+	deleteUC         *addressbook.DeleteEntryUseCase
+	getSettingsUC    *addressbook.GetSettingsUseCase
+	updateSettingsUC *addressbook.UpdateSettingsUseCase
+	exportUC         *addressbook.ExportEntriesUseCase
+	importUC         *addressbook.ImportEntriesUseCase
+	logger           *slog.Logger
+}
+
+// NewAddressBookHandler constructs an AddressBookHandler.
+func NewAddressBookHandler(cfg AddressBookHandlerConfig) *AddressBookHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &AddressBookHandler{
+		listUC:           cfg.ListUseCase,
+		addUC:            cfg.AddUseCase,
+		updateUC:         cfg.UpdateUseCase,
+		deleteUC:         cfg.DeleteUseCase,
+		getSettingsUC:    cfg.GetSettingsUseCase,
+		updateSettingsUC: cfg.UpdateSettingsUseCase,
+		exportUC:         cfg.ExportUseCase,
+		importUC:         cfg.ImportUseCase,
+		logger:           logger,
+	}
+}
+
+// Register attaches routes to the router.
+func (h *AddressBookHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Get("/settings", h.handleGetSettings)
+	router.Put("/settings", h.handleUpdateSettings)
+	router.Get("/export", h.handleExport)
+	router.Post("/import", h.handleImport)
+	router.Get("/", h.handleList)
+	router.Post("/", h.handleAdd)
+	router.Patch("/:id", h.handleUpdate)
+	router.Delete("/:id", h.handleDelete)
+}
+
+func (h *AddressBookHandler) handleList(c *fiber.Ctx) error {
+	if h.listUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "address book not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.listUC.Execute(c.UserContext(), userID, c.Query("chain"))
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"items": result})
+}
+
+func (h *AddressBookHandler) handleAdd(c *fiber.Ctx) error {
+	if h.addUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "address book not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.AddressBookEntryRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.addUC.Execute(c.UserContext(), userID, payload)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(result)
+}
+
+func (h *AddressBookHandler) handleUpdate(c *fiber.Ctx) error {
+	if h.updateUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "address book not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	entryID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.UpdateAddressBookEntryRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.updateUC.Execute(c.UserContext(), userID, entryID, payload)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *AddressBookHandler) handleDelete(c *fiber.Ctx) error {
+	if h.deleteUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "address book not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	entryID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	if err := h.deleteUC.Execute(c.UserContext(), userID, entryID); err != nil {
+		return respondError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *AddressBookHandler) handleGetSettings(c *fiber.Ctx) error {
+	if h.getSettingsUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "address book not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.getSettingsUC.Execute(c.UserContext(), userID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *AddressBookHandler) handleUpdateSettings(c *fiber.Ctx) error {
+	if h.updateSettingsUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "address book not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.AddressBookSettingsRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.updateSettingsUC.Execute(c.UserContext(), userID, payload)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+// handleExport returns the signed export as JSON, or as a downloadable file when download=true.
+func (h *AddressBookHandler) handleExport(c *fiber.Ctx) error {
+	if h.exportUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "address book export not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.exportUC.Execute(c.UserContext(), userID, c.Query("chain"))
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	if c.QueryBool("download") {
+		c.Attachment("address-book.cwab")
+		c.Set(fiber.HeaderContentType, fiber.MIMETextPlainCharsetUTF8)
+		return c.Status(fiber.StatusOK).SendString(result.Payload)
+	}
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *AddressBookHandler) handleImport(c *fiber.Ctx) error {
+	if h.importUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "address book import not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.AddressBookImportRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.importUC.Execute(c.UserContext(), userID, payload)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
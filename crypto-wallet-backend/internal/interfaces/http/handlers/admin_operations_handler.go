@@ -0,0 +1,310 @@
+package handlers
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	adminusecase "github.com/crypto-wallet/backend/internal/application/usecases/admin"
+)
+
+// AdminOperationsHandlerConfig configures the destructive operations handler.
+type AdminOperationsHandlerConfig struct {
+	FreezeUserWalletsUseCase *adminusecase.FreezeUserWalletsUseCase
+	PurgeExportsUseCase      *adminusecase.PurgeExportsUseCase
+	CancelExchangesUseCase   *adminusecase.CancelPendingExchangesUseCase
+	SimulatePricingUseCase   *adminusecase.SimulatePricingUseCase
+	ListBudgetsUseCase       *adminusecase.ListExecutionBudgetsUseCase
+	ListLiquidityUseCase     *adminusecase.ListLiquidityUseCase
+	GetLiquidityUseCase      *adminusecase.GetLiquidityUseCase
+	TopUpLiquidityUseCase    *adminusecase.TopUpLiquidityUseCase
+	Logger                   *slog.Logger
+}
+
+// AdminOperationsHandler exposes bulk operations to staff. Every destructive route honours
+// ?dry_run=true, which previews the affected records and returns the confirmation token the real run
+// must send. Pricing simulations, execution budget reports and liquidity reports only read;
+// liquidity top-ups add to a reserve and are audited.
+type AdminOperationsHandler struct {
+	freezeUC        *adminusecase.FreezeUserWalletsUseCase
+	purgeUC         *adminusecase.PurgeExportsUseCase
+	cancelUC        *adminusecase.CancelPendingExchangesUseCase
+	simulateUC      *adminusecase.SimulatePricingUseCase
+	budgetsUC       *adminusecase.ListExecutionBudgetsUseCase
+	listLiquidityUC *adminusecase.ListLiquidityUseCase
+	getLiquidityUC  *adminusecase.GetLiquidityUseCase
+	topUpUC         *adminusecase.TopUpLiquidityUseCase
+	logger          *slog.Logger
+}
+
+// NewAdminOperationsHandler constructs an AdminOperationsHandler.
+func NewAdminOperationsHandler(cfg AdminOperationsHandlerConfig) *AdminOperationsHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &AdminOperationsHandler{
+		freezeUC:        cfg.FreezeUserWalletsUseCase,
+		purgeUC:         cfg.PurgeExportsUseCase,
+		cancelUC:        cfg.CancelExchangesUseCase,
+		simulateUC:      cfg.SimulatePricingUseCase,
+		budgetsUC:       cfg.ListBudgetsUseCase,
+		listLiquidityUC: cfg.ListLiquidityUseCase,
+		getLiquidityUC:  cfg.GetLiquidityUseCase,
+		topUpUC:         cfg.TopUpLiquidityUseCase,
+		logger:          logger,
+	}
+}
+
+// Register attaches routes to the router. Callers must guard the router with support access checks.
+func (h *AdminOperationsHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Post("/users/:id/wallets/freeze", h.handleFreezeUserWallets)
+	router.Post("/exports/purge", h.handlePurgeExports)
+	router.Post("/users/:id/exchanges/cancel", h.handleCancelUserExchanges)
+	router.Post("/trading-pairs/:id/exchanges/cancel", h.handleCancelPairExchanges)
+	router.Post("/trading-pairs/:id/pricing-simulation", h.handleSimulatePricing)
+	router.Get("/execution-budgets", h.handleListExecutionBudgets)
+	router.Get("/liquidity", h.handleListLiquidity)
+	router.Get("/trading-pairs/:id/liquidity", h.handleGetLiquidity)
+	router.Post("/trading-pairs/:id/liquidity/top-up", h.handleTopUpLiquidity)
+}
+
+func (h *AdminOperationsHandler) handleFreezeUserWallets(c *fiber.Ctx) error {
+	if h.freezeUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "admin operations not configured")
+	}
+
+	actorID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	userID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.AdminFreezeUserWalletsRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.freezeUC.Execute(c.UserContext(), adminusecase.FreezeUserWalletsInput{
+		DestructiveOptions: adminusecase.DestructiveOptions{
+			DryRun:            c.QueryBool("dry_run"),
+			ConfirmationToken: payload.ConfirmationToken,
+		},
+		UserID: userID,
+		Reason: payload.Reason,
+		Actor:  actorID.String(),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *AdminOperationsHandler) handlePurgeExports(c *fiber.Ctx) error {
+	if h.purgeUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "admin operations not configured")
+	}
+
+	actorID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.AdminPurgeExportsRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	before, err := parseOptionalTime(payload.Before)
+	if err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "before must be an RFC3339 timestamp"))
+	}
+
+	input := adminusecase.PurgeExportsInput{
+		DestructiveOptions: adminusecase.DestructiveOptions{
+			DryRun:            c.QueryBool("dry_run"),
+			ConfirmationToken: payload.ConfirmationToken,
+		},
+		Actor: actorID.String(),
+	}
+	if before != nil {
+		input.Before = *before
+	}
+
+	result, err := h.purgeUC.Execute(c.UserContext(), input)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *AdminOperationsHandler) handleCancelUserExchanges(c *fiber.Ctx) error {
+	userID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+	return h.cancelExchanges(c, userID, uuid.Nil)
+}
+
+func (h *AdminOperationsHandler) handleCancelPairExchanges(c *fiber.Ctx) error {
+	pairID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+	return h.cancelExchanges(c, uuid.Nil, pairID)
+}
+
+func (h *AdminOperationsHandler) cancelExchanges(c *fiber.Ctx, userID, pairID uuid.UUID) error {
+	if h.cancelUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "admin operations not configured")
+	}
+
+	actorID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.AdminCancelExchangesRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	if pairID == uuid.Nil && payload.PairID != "" {
+		pairID, err = uuid.Parse(payload.PairID)
+		if err != nil {
+			return respondError(c, fiber.NewError(fiber.StatusBadRequest, "pair_id must be a valid UUID"))
+		}
+	}
+
+	result, err := h.cancelUC.Execute(c.UserContext(), adminusecase.CancelPendingExchangesInput{
+		DestructiveOptions: adminusecase.DestructiveOptions{
+			DryRun:            c.QueryBool("dry_run"),
+			ConfirmationToken: payload.ConfirmationToken,
+		},
+		UserID:       userID,
+		PairID:       pairID,
+		OperationIDs: payload.OperationIDs,
+		Reason:       payload.Reason,
+		Actor:        actorID.String(),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *AdminOperationsHandler) handleSimulatePricing(c *fiber.Ctx) error {
+	if h.simulateUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "pricing simulation not configured")
+	}
+
+	actorID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	pairID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.AdminPricingSimulationRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.simulateUC.Execute(c.UserContext(), adminusecase.SimulatePricingInput{
+		PairID:  pairID,
+		Payload: payload,
+		Actor:   actorID.String(),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *AdminOperationsHandler) handleListExecutionBudgets(c *fiber.Ctx) error {
+	if h.budgetsUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "execution budgets not configured")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"budgets": h.budgetsUC.Execute()})
+}
+
+func (h *AdminOperationsHandler) handleListLiquidity(c *fiber.Ctx) error {
+	if h.listLiquidityUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "liquidity management not configured")
+	}
+
+	ledgers, err := h.listLiquidityUC.Execute(c.UserContext())
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"items": ledgers})
+}
+
+func (h *AdminOperationsHandler) handleGetLiquidity(c *fiber.Ctx) error {
+	if h.getLiquidityUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "liquidity management not configured")
+	}
+
+	pairID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	ledger, err := h.getLiquidityUC.Execute(c.UserContext(), pairID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ledger)
+}
+
+func (h *AdminOperationsHandler) handleTopUpLiquidity(c *fiber.Ctx) error {
+	if h.topUpUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "liquidity management not configured")
+	}
+
+	actorID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	pairID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.AdminLiquidityTopUpRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	ledger, err := h.topUpUC.Execute(c.UserContext(), adminusecase.TopUpLiquidityInput{
+		PairID:  pairID,
+		Payload: payload,
+		Actor:   actorID.String(),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ledger)
+}
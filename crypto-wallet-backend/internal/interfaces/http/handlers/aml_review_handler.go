@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	kycusecase "github.com/crypto-wallet/backend/internal/application/usecases/kyc"
+)
+
+// AMLReviewHandlerConfig configures the AML review handler.
+type AMLReviewHandlerConfig struct {
+	ListUseCase     *kycusecase.ListComplianceReviewsUseCase
+	GetUseCase      *kycusecase.GetComplianceReviewUseCase
+	ResolveUseCase  *kycusecase.ResolveComplianceReviewUseCase
+	UserRiskUseCase *kycusecase.GetAMLUserRiskUseCase
+	Logger          *slog.Logger
+}
+
+// AMLReviewHandler lets compliance staff work AML screening hits in the compliance review queue.
+type AMLReviewHandler struct {
+	listUC     *kycusecase.ListComplianceReviewsUseCase
+	getUC      *kycusecase.GetComplianceReviewUseCase
+	resolveUC  *kycusecase.ResolveComplianceReviewUseCase
+	userRiskUC *kycusecase.GetAMLUserRiskUseCase
+	logger     *slog.Logger
+}
+
+// NewAMLReviewHandler constructs an AMLReviewHandler.
+func NewAMLReviewHandler(cfg AMLReviewHandlerConfig) *AMLReviewHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &AMLReviewHandler{
+		listUC:     cfg.ListUseCase,
+		getUC:      cfg.GetUseCase,
+		resolveUC:  cfg.ResolveUseCase,
+		userRiskUC: cfg.UserRiskUseCase,
+		logger:     logger,
+	}
+}
+
+// Register attaches routes to the router. Callers must guard the router with the compliance role check.
+func (h *AMLReviewHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Get("/aml/reviews", h.handleList)
+	router.Get("/aml/reviews/:reviewId", h.handleGet)
+	router.Post("/aml/reviews/:reviewId/resolve", h.handleResolve)
+	router.Get("/aml/users/:userId", h.handleUserRisk)
+}
+
+func (h *AMLReviewHandler) handleList(c *fiber.Ctx) error {
+	if h.listUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "aml review not configured")
+	}
+
+	result, err := h.listUC.Execute(c.UserContext(), kycusecase.ListComplianceReviewsInput{
+		Status: c.Query("status"),
+		Source: c.Query("source"),
+		Limit:  c.QueryInt("limit", 0),
+		Offset: c.QueryInt("offset", 0),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"items": result})
+}
+
+func (h *AMLReviewHandler) handleGet(c *fiber.Ctx) error {
+	if h.getUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "aml review not configured")
+	}
+
+	result, err := h.getUC.Execute(c.UserContext(), c.Params("reviewId"))
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *AMLReviewHandler) handleResolve(c *fiber.Ctx) error {
+	if h.resolveUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "aml review not configured")
+	}
+
+	actorID, err := extractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var payload dto.ComplianceReviewResolveRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid request payload")
+	}
+
+	result, err := h.resolveUC.Execute(c.UserContext(), kycusecase.ResolveComplianceReviewInput{
+		ActorID:  actorID.String(),
+		ReviewID: c.Params("reviewId"),
+		Request:  payload,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *AMLReviewHandler) handleUserRisk(c *fiber.Ctx) error {
+	if h.userRiskUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "aml review not configured")
+	}
+
+	result, err := h.userRiskUC.Execute(c.UserContext(), c.Params("userId"))
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
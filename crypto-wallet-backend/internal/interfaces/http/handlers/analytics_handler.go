@@ -1,14 +1,16 @@
 package handlers
 
 import (
-	"strconv"
+	"fmt"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 
 	"github.com/crypto-wallet/backend/internal/application/dto"
 	analyticsusecase "github.com/crypto-wallet/backend/internal/application/usecases/analytics"
+	exportusecase "github.com/crypto-wallet/backend/internal/application/usecases/export"
 	transactionusecase "github.com/crypto-wallet/backend/internal/application/usecases/transaction"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
 	"github.com/crypto-wallet/backend/internal/interfaces/http/middleware"
 	"github.com/crypto-wallet/backend/pkg/utils"
 )
@@ -16,17 +18,49 @@ import (
 // AnalyticsHandlerConfig groups dependencies required by AnalyticsHandler.
 type AnalyticsHandlerConfig struct {
 	TransactionHistoryUseCase *transactionusecase.GetTransactionHistoryUseCase
-	ExportTransactionsUseCase *transactionusecase.ExportTransactionsUseCase
+	ExportTransactionsUseCase *exportusecase.RequestTransactionExportUseCase
 	PortfolioSummaryUseCase   *analyticsusecase.PortfolioSummaryUseCase
 	PortfolioPerformanceUseCase *analyticsusecase.PortfolioPerformanceUseCase
+	RebalanceUseCase            *analyticsusecase.RebalanceSuggestionsUseCase
+	TaxReportUseCase            *analyticsusecase.TaxReportUseCase
+	GetReportScheduleUseCase    *analyticsusecase.GetReportScheduleUseCase
+	SetReportScheduleUseCase    *analyticsusecase.SetReportScheduleUseCase
+	DeleteReportScheduleUseCase *analyticsusecase.DeleteReportScheduleUseCase
+	ReportCatalogUseCase        *analyticsusecase.ReportCatalogUseCase
+	RunReportUseCase            *analyticsusecase.RunReportUseCase
+	ListSavedReportsUseCase     *analyticsusecase.ListSavedReportsUseCase
+	GetSavedReportUseCase       *analyticsusecase.GetSavedReportUseCase
+	CreateSavedReportUseCase    *analyticsusecase.CreateSavedReportUseCase
+	UpdateSavedReportUseCase    *analyticsusecase.UpdateSavedReportUseCase
+	DeleteSavedReportUseCase    *analyticsusecase.DeleteSavedReportUseCase
+	CreateShareUseCase          *analyticsusecase.CreatePortfolioShareUseCase
+	ListSharesUseCase           *analyticsusecase.ListPortfolioSharesUseCase
+	RevokeShareUseCase          *analyticsusecase.RevokePortfolioShareUseCase
+	ListShareAccessesUseCase    *analyticsusecase.ListPortfolioShareAccessesUseCase
 }
 
 // AnalyticsHandler handles analytics-oriented HTTP requests.
 type AnalyticsHandler struct {
 	transactionHistoryUC   *transactionusecase.GetTransactionHistoryUseCase
-	exportTransactionsUC   *transactionusecase.ExportTransactionsUseCase
+	exportTransactionsUC   *exportusecase.RequestTransactionExportUseCase
 	portfolioSummaryUC     *analyticsusecase.PortfolioSummaryUseCase
 	portfolioPerformanceUC *analyticsusecase.PortfolioPerformanceUseCase
+	rebalanceUC            *analyticsusecase.RebalanceSuggestionsUseCase
+	taxReportUC            *analyticsusecase.TaxReportUseCase
+	getScheduleUC          *analyticsusecase.GetReportScheduleUseCase
+	setScheduleUC          *analyticsusecase.SetReportScheduleUseCase
+	deleteScheduleUC       *analyticsusecase.DeleteReportScheduleUseCase
+	reportCatalogUC        *analyticsusecase.ReportCatalogUseCase
+	runReportUC            *analyticsusecase.RunReportUseCase
+	listReportsUC          *analyticsusecase.ListSavedReportsUseCase
+	getReportUC            *analyticsusecase.GetSavedReportUseCase
+	createReportUC         *analyticsusecase.CreateSavedReportUseCase
+	updateReportUC         *analyticsusecase.UpdateSavedReportUseCase
+	deleteReportUC         *analyticsusecase.DeleteSavedReportUseCase
+	createShareUC          *analyticsusecase.CreatePortfolioShareUseCase
+	listSharesUC           *analyticsusecase.ListPortfolioSharesUseCase
+	revokeShareUC          *analyticsusecase.RevokePortfolioShareUseCase
+	listShareAccessesUC    *analyticsusecase.ListPortfolioShareAccessesUseCase
 }
 
 // NewAnalyticsHandler constructs an AnalyticsHandler instance.
@@ -36,6 +70,22 @@ func NewAnalyticsHandler(cfg AnalyticsHandlerConfig) *AnalyticsHandler {
 		exportTransactionsUC:   cfg.ExportTransactionsUseCase,
 		portfolioSummaryUC:     cfg.PortfolioSummaryUseCase,
 		portfolioPerformanceUC: cfg.PortfolioPerformanceUseCase,
+		rebalanceUC:            cfg.RebalanceUseCase,
+		taxReportUC:            cfg.TaxReportUseCase,
+		getScheduleUC:          cfg.GetReportScheduleUseCase,
+		setScheduleUC:          cfg.SetReportScheduleUseCase,
+		deleteScheduleUC:       cfg.DeleteReportScheduleUseCase,
+		reportCatalogUC:        cfg.ReportCatalogUseCase,
+		runReportUC:            cfg.RunReportUseCase,
+		listReportsUC:          cfg.ListSavedReportsUseCase,
+		getReportUC:            cfg.GetSavedReportUseCase,
+		createReportUC:         cfg.CreateSavedReportUseCase,
+		updateReportUC:         cfg.UpdateSavedReportUseCase,
+		deleteReportUC:         cfg.DeleteSavedReportUseCase,
+		createShareUC:          cfg.CreateShareUseCase,
+		listSharesUC:           cfg.ListSharesUseCase,
+		revokeShareUC:          cfg.RevokeShareUseCase,
+		listShareAccessesUC:    cfg.ListShareAccessesUseCase,
 	}
 }
 
@@ -45,30 +95,12 @@ func (h *AnalyticsHandler) GetTransactionHistory(c *fiber.Ctx) error {
 		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "transaction history not configured"))
 	}
 
-	req := dto.GetTransactionHistoryRequest{
-		WalletID:  c.Query("walletId"),
-		Chain:     c.Query("chain"),
-		Type:      c.Query("type"),
-		Status:    c.Query("status"),
-		StartDate: c.Query("startDate"),
-		EndDate:   c.Query("endDate"),
-		Limit:     50,
-		Offset:    0,
-	}
-
-	if limitStr := c.Query("limit"); limitStr != "" {
-		if limit, err := strconv.Atoi(limitStr); err == nil && limit > 0 {
-			req.Limit = limit
-		}
-	}
-
-	if offsetStr := c.Query("offset"); offsetStr != "" {
-		if offset, err := strconv.Atoi(offsetStr); err == nil && offset >= 0 {
-			req.Offset = offset
-		}
+	req, err := middleware.BindQuery[dto.GetTransactionHistoryRequest](c)
+	if err != nil {
+		return respondError(c, err)
 	}
 
-	response, err := h.transactionHistoryUC.ExecuteFromRequest(c.UserContext(), req)
+	response, err := h.transactionHistoryUC.ExecuteFromRequest(c.UserContext(), *req)
 	if err != nil {
 		return respondError(c, err)
 	}
@@ -76,12 +108,18 @@ func (h *AnalyticsHandler) GetTransactionHistory(c *fiber.Ctx) error {
 	return c.JSON(response)
 }
 
-// ExportTransactions handles POST /api/v1/analytics/transactions/export.
+// ExportTransactions handles POST /api/v1/analytics/transactions/export. The export is queued
+// like POST /api/v1/exports/transactions; poll GET /api/v1/exports/:id for the download link.
 func (h *AnalyticsHandler) ExportTransactions(c *fiber.Ctx) error {
 	if h.exportTransactionsUC == nil {
 		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "transaction export not configured"))
 	}
 
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
 	var req dto.ExportTransactionsRequest
 	if err := c.BodyParser(&req); err != nil {
 		return respondError(c, utils.NewAppError(
@@ -93,12 +131,15 @@ func (h *AnalyticsHandler) ExportTransactions(c *fiber.Ctx) error {
 		))
 	}
 
-	response, err := h.exportTransactionsUC.ExecuteFromRequest(c.UserContext(), req)
+	response, err := h.exportTransactionsUC.Execute(c.UserContext(), exportusecase.RequestTransactionExportInput{
+		UserID:  userID,
+		Payload: req,
+	})
 	if err != nil {
 		return respondError(c, err)
 	}
 
-	return c.JSON(response)
+	return c.Status(fiber.StatusAccepted).JSON(response)
 }
 
 // GetTransactionAnalytics handles GET /api/v1/analytics/transactions/summary.
@@ -134,7 +175,8 @@ func (h *AnalyticsHandler) GetWalletAnalytics(c *fiber.Ctx) error {
 	))
 }
 
-// GetPortfolioSummary handles GET /api/v1/analytics/portfolio.
+// GetPortfolioSummary handles GET /api/v1/analytics/portfolio. ?currency= overrides the user's
+// preferred display currency.
 func (h *AnalyticsHandler) GetPortfolioSummary(c *fiber.Ctx) error {
 	if h.portfolioSummaryUC == nil {
 		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "portfolio summary not configured"))
@@ -145,7 +187,7 @@ func (h *AnalyticsHandler) GetPortfolioSummary(c *fiber.Ctx) error {
 		return respondError(c, err)
 	}
 
-	summary, err := h.portfolioSummaryUC.Execute(c.UserContext(), userID)
+	summary, err := h.portfolioSummaryUC.Execute(c.UserContext(), userID, c.Query("currency"))
 	if err != nil {
 		return respondError(c, err)
 	}
@@ -153,7 +195,8 @@ func (h *AnalyticsHandler) GetPortfolioSummary(c *fiber.Ctx) error {
 	return c.JSON(summary)
 }
 
-// GetPortfolioPerformance handles GET /api/v1/analytics/performance.
+// GetPortfolioPerformance handles GET /api/v1/analytics/performance. ?currency= overrides the
+// user's preferred display currency.
 func (h *AnalyticsHandler) GetPortfolioPerformance(c *fiber.Ctx) error {
 	if h.portfolioPerformanceUC == nil {
 		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "portfolio performance not configured"))
@@ -165,7 +208,7 @@ func (h *AnalyticsHandler) GetPortfolioPerformance(c *fiber.Ctx) error {
 	}
 
 	period := c.Query("period", "30d")
-	performance, err := h.portfolioPerformanceUC.Execute(c.UserContext(), userID, period)
+	performance, err := h.portfolioPerformanceUC.Execute(c.UserContext(), userID, period, c.Query("currency"))
 	if err != nil {
 		return respondError(c, err)
 	}
@@ -173,6 +216,404 @@ func (h *AnalyticsHandler) GetPortfolioPerformance(c *fiber.Ctx) error {
 	return c.JSON(performance)
 }
 
+// GetRebalanceSuggestions handles GET /api/v1/analytics/rebalance. ?targets=BTC:60,ETH:40 sets the
+// target allocation and ?tolerance= the drift, in percentage points, left alone.
+func (h *AnalyticsHandler) GetRebalanceSuggestions(c *fiber.Ctx) error {
+	if h.rebalanceUC == nil {
+		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "rebalance suggestions not configured"))
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	req, err := middleware.BindQuery[dto.RebalanceRequest](c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	suggestions, err := h.rebalanceUC.Execute(c.UserContext(), userID, *req)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(suggestions)
+}
+
+// GetTaxReport handles GET /api/v1/analytics/tax-report?year=. ?method= picks fifo (default), lifo
+// or hifo lots; ?format=csv downloads the disposals in the layout of IRS Form 8949.
+func (h *AnalyticsHandler) GetTaxReport(c *fiber.Ctx) error {
+	if h.taxReportUC == nil {
+		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "tax report not configured"))
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	req, err := middleware.BindQuery[dto.TaxReportRequest](c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	report, err := h.taxReportUC.Execute(c.UserContext(), userID, *req)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	if req.Format != dto.TaxReportFormatCSV {
+		return c.JSON(report)
+	}
+
+	content, err := analyticsusecase.TaxReportCSV(report)
+	if err != nil {
+		return respondError(c, err)
+	}
+	c.Set(fiber.HeaderContentType, "text/csv")
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("tax_report_%d_%s.csv", report.Year, report.Method)))
+	return c.Status(fiber.StatusOK).Send(content)
+}
+
+// GetReportSchedule handles GET /api/v1/analytics/report-schedule.
+func (h *AnalyticsHandler) GetReportSchedule(c *fiber.Ctx) error {
+	if h.getScheduleUC == nil {
+		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "scheduled reports not configured"))
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	schedule, err := h.getScheduleUC.Execute(c.UserContext(), userID)
+	if err != nil {
+		return respondError(c, err)
+	}
+	return c.JSON(schedule)
+}
+
+// SetReportSchedule handles PUT /api/v1/analytics/report-schedule, opting the user into daily or
+// weekly portfolio report emails.
+func (h *AnalyticsHandler) SetReportSchedule(c *fiber.Ctx) error {
+	if h.setScheduleUC == nil {
+		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "scheduled reports not configured"))
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	req, err := middleware.BindBody[dto.ReportScheduleRequest](c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	schedule, err := h.setScheduleUC.Execute(c.UserContext(), userID, *req)
+	if err != nil {
+		return respondError(c, err)
+	}
+	return c.JSON(schedule)
+}
+
+// DeleteReportSchedule handles DELETE /api/v1/analytics/report-schedule.
+func (h *AnalyticsHandler) DeleteReportSchedule(c *fiber.Ctx) error {
+	if h.deleteScheduleUC == nil {
+		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "scheduled reports not configured"))
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	if err := h.deleteScheduleUC.Execute(c.UserContext(), userID); err != nil {
+		return respondError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// GetReportCatalog handles GET /api/v1/analytics/reports/catalog.
+func (h *AnalyticsHandler) GetReportCatalog(c *fiber.Ctx) error {
+	if h.reportCatalogUC == nil {
+		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "report catalog not configured"))
+	}
+	return c.JSON(h.reportCatalogUC.Execute())
+}
+
+// RunReport handles POST /api/v1/analytics/reports/run. Reports answered inline return 200 with
+// the result; heavy reports return 202 with an export job to poll at GET /api/v1/exports/:id.
+func (h *AnalyticsHandler) RunReport(c *fiber.Ctx) error {
+	if h.runReportUC == nil {
+		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "report runner not configured"))
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var req dto.RunReportRequest
+	if err := c.BodyParser(&req); err != nil {
+		return respondError(c, invalidRequestBody(err))
+	}
+
+	response, err := h.runReportUC.Execute(c.UserContext(), userID, req)
+	if err != nil {
+		return respondError(c, err)
+	}
+	return respondReportRun(c, response)
+}
+
+// ListSavedReports handles GET /api/v1/analytics/reports.
+func (h *AnalyticsHandler) ListSavedReports(c *fiber.Ctx) error {
+	if h.listReportsUC == nil {
+		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "saved reports not configured"))
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	reports, err := h.listReportsUC.Execute(c.UserContext(), userID)
+	if err != nil {
+		return respondError(c, err)
+	}
+	return c.JSON(fiber.Map{"reports": reports})
+}
+
+// CreateSavedReport handles POST /api/v1/analytics/reports.
+func (h *AnalyticsHandler) CreateSavedReport(c *fiber.Ctx) error {
+	if h.createReportUC == nil {
+		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "saved reports not configured"))
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var req dto.SaveReportRequest
+	if err := c.BodyParser(&req); err != nil {
+		return respondError(c, invalidRequestBody(err))
+	}
+
+	report, err := h.createReportUC.Execute(c.UserContext(), userID, req)
+	if err != nil {
+		return respondError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(report)
+}
+
+// GetSavedReport handles GET /api/v1/analytics/reports/:id.
+func (h *AnalyticsHandler) GetSavedReport(c *fiber.Ctx) error {
+	if h.getReportUC == nil {
+		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "saved reports not configured"))
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+	reportID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	report, err := h.getReportUC.Execute(c.UserContext(), userID, reportID)
+	if err != nil {
+		return respondError(c, err)
+	}
+	return c.JSON(report)
+}
+
+// UpdateSavedReport handles PUT /api/v1/analytics/reports/:id.
+func (h *AnalyticsHandler) UpdateSavedReport(c *fiber.Ctx) error {
+	if h.updateReportUC == nil {
+		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "saved reports not configured"))
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+	reportID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var req dto.SaveReportRequest
+	if err := c.BodyParser(&req); err != nil {
+		return respondError(c, invalidRequestBody(err))
+	}
+
+	report, err := h.updateReportUC.Execute(c.UserContext(), userID, reportID, req)
+	if err != nil {
+		return respondError(c, err)
+	}
+	return c.JSON(report)
+}
+
+// DeleteSavedReport handles DELETE /api/v1/analytics/reports/:id.
+func (h *AnalyticsHandler) DeleteSavedReport(c *fiber.Ctx) error {
+	if h.deleteReportUC == nil {
+		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "saved reports not configured"))
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+	reportID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	if err := h.deleteReportUC.Execute(c.UserContext(), userID, reportID); err != nil {
+		return respondError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// RunSavedReport handles POST /api/v1/analytics/reports/:id/run. The optional body carries the
+// same async and format options as POST /api/v1/analytics/reports/run.
+func (h *AnalyticsHandler) RunSavedReport(c *fiber.Ctx) error {
+	if h.runReportUC == nil {
+		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "report runner not configured"))
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+	reportID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var opts dto.ReportRunOptions
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&opts); err != nil {
+			return respondError(c, invalidRequestBody(err))
+		}
+	}
+
+	response, err := h.runReportUC.ExecuteSaved(c.UserContext(), userID, reportID, opts)
+	if err != nil {
+		return respondError(c, err)
+	}
+	return respondReportRun(c, response)
+}
+
+// ListPortfolioShares handles GET /api/v1/analytics/shares.
+func (h *AnalyticsHandler) ListPortfolioShares(c *fiber.Ctx) error {
+	if h.listSharesUC == nil {
+		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "portfolio sharing not configured"))
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	shares, err := h.listSharesUC.Execute(c.UserContext(), userID)
+	if err != nil {
+		return respondError(c, err)
+	}
+	return c.JSON(fiber.Map{"shares": shares})
+}
+
+// CreatePortfolioShare handles POST /api/v1/analytics/shares. The response carries the link token,
+// which is not shown again.
+func (h *AnalyticsHandler) CreatePortfolioShare(c *fiber.Ctx) error {
+	if h.createShareUC == nil {
+		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "portfolio sharing not configured"))
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var req dto.CreatePortfolioShareRequest
+	if err := c.BodyParser(&req); err != nil {
+		return respondError(c, invalidRequestBody(err))
+	}
+
+	share, err := h.createShareUC.Execute(c.UserContext(), userID, req)
+	if err != nil {
+		return respondError(c, err)
+	}
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	return c.Status(fiber.StatusCreated).JSON(share)
+}
+
+// RevokePortfolioShare handles DELETE /api/v1/analytics/shares/:id.
+func (h *AnalyticsHandler) RevokePortfolioShare(c *fiber.Ctx) error {
+	if h.revokeShareUC == nil {
+		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "portfolio sharing not configured"))
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+	shareID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	if err := h.revokeShareUC.Execute(c.UserContext(), userID, shareID); err != nil {
+		return respondError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// ListPortfolioShareAccesses handles GET /api/v1/analytics/shares/:id/accesses.
+func (h *AnalyticsHandler) ListPortfolioShareAccesses(c *fiber.Ctx) error {
+	if h.listShareAccessesUC == nil {
+		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "portfolio sharing not configured"))
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+	shareID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	accesses, err := h.listShareAccessesUC.Execute(c.UserContext(), userID, shareID, repositories.ListOptions{
+		Limit:  parseQueryInt(c, "limit", 0),
+		Offset: parseQueryInt(c, "offset", 0),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+	return c.JSON(fiber.Map{"accesses": accesses})
+}
+
+func respondReportRun(c *fiber.Ctx, response dto.ReportRunResponse) error {
+	if response.Status == dto.ReportRunQueued {
+		return c.Status(fiber.StatusAccepted).JSON(response)
+	}
+	return c.JSON(response)
+}
+
+func invalidRequestBody(err error) error {
+	return utils.NewAppError(
+		"INVALID_REQUEST",
+		"invalid request body",
+		fiber.StatusBadRequest,
+		err,
+		nil,
+	)
+}
+
 // Register registers analytics routes.
 func (h *AnalyticsHandler) Register(router fiber.Router) {
 	if h == nil || router == nil {
@@ -180,7 +621,7 @@ func (h *AnalyticsHandler) Register(router fiber.Router) {
 	}
 
 	if h.transactionHistoryUC != nil {
-		router.Get("/transactions", h.GetTransactionHistory)
+		router.Get("/transactions", middleware.ValidateQuery[dto.GetTransactionHistoryRequest](), h.GetTransactionHistory)
 	}
 
 	if h.exportTransactionsUC != nil {
@@ -195,13 +636,78 @@ func (h *AnalyticsHandler) Register(router fiber.Router) {
 		router.Get("/performance", h.GetPortfolioPerformance)
 	}
 
+	if h.rebalanceUC != nil {
+		router.Get("/rebalance", middleware.ValidateQuery[dto.RebalanceRequest](), h.GetRebalanceSuggestions)
+	}
+
+	if h.taxReportUC != nil {
+		router.Get("/tax-report", middleware.ValidateQuery[dto.TaxReportRequest](), h.GetTaxReport)
+	}
+
+	if h.getScheduleUC != nil {
+		router.Get("/report-schedule", h.GetReportSchedule)
+	}
+
+	if h.setScheduleUC != nil {
+		router.Put("/report-schedule", middleware.ValidateBody[dto.ReportScheduleRequest](), h.SetReportSchedule)
+	}
+
+	if h.deleteScheduleUC != nil {
+		router.Delete("/report-schedule", h.DeleteReportSchedule)
+	}
+
+	if h.reportCatalogUC != nil {
+		router.Get("/reports/catalog", h.GetReportCatalog)
+	}
+
+	if h.runReportUC != nil {
+		router.Post("/reports/run", h.RunReport)
+		router.Post("/reports/:id/run", h.RunSavedReport)
+	}
+
+	if h.listReportsUC != nil {
+		router.Get("/reports", h.ListSavedReports)
+	}
+
+	if h.createReportUC != nil {
+		router.Post("/reports", h.CreateSavedReport)
+	}
+
+	if h.getReportUC != nil {
+		router.Get("/reports/:id", h.GetSavedReport)
+	}
+
+	if h.updateReportUC != nil {
+		router.Put("/reports/:id", h.UpdateSavedReport)
+	}
+
+	if h.deleteReportUC != nil {
+		router.Delete("/reports/:id", h.DeleteSavedReport)
+	}
+
+	if h.listSharesUC != nil {
+		router.Get("/shares", h.ListPortfolioShares)
+	}
+
+	if h.createShareUC != nil {
+		router.Post("/shares", h.CreatePortfolioShare)
+	}
+
+	if h.revokeShareUC != nil {
+		router.Delete("/shares/:id", h.RevokePortfolioShare)
+	}
+
+	if h.listShareAccessesUC != nil {
+		router.Get("/shares/:id/accesses", h.ListPortfolioShareAccesses)
+	}
+
 	// Placeholder routes for future analytics endpoints.
 	router.Get("/transactions/summary", h.GetTransactionAnalytics)
 	router.Get("/wallets/:walletId", h.GetWalletAnalytics)
 }
 
 func respondError(c *fiber.Ctx, err error) error {
-	resp, status := utils.ToErrorResponse(err)
+	resp, status := utils.ToErrorResponse(utils.RequestContextError(c.UserContext(), err))
 	return c.Status(status).JSON(resp)
 }
 
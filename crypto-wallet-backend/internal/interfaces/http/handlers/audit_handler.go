@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	usecaseaudit "github.com/crypto-wallet/backend/internal/application/usecases/audit"
+)
+
+// AuditHandlerConfig configures the compliance audit log handler.
+type AuditHandlerConfig struct {
+	SearchUseCase       *usecaseaudit.SearchAuditLogsUseCase
+	ExportUseCase       *usecaseaudit.ExportAuditLogsUseCase
+	VerifyUseCase       *usecaseaudit.VerifyAuditChainUseCase
+	ListArchivesUseCase *usecaseaudit.ListAuditArchivesUseCase
+	Logger              *slog.Logger
+}
+
+// AuditHandler exposes audit log search, export and integrity checks to compliance staff.
+type AuditHandler struct {
+	searchUC       *usecaseaudit.SearchAuditLogsUseCase
+	exportUC       *usecaseaudit.ExportAuditLogsUseCase
+	verifyUC       *usecaseaudit.VerifyAuditChainUseCase
+	listArchivesUC *usecaseaudit.ListAuditArchivesUseCase
+	logger         *slog.Logger
+}
+
+// NewAuditHandler constructs an AuditHandler.
+func NewAuditHandler(cfg AuditHandlerConfig) *AuditHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &AuditHandler{
+		searchUC:       cfg.SearchUseCase,
+		exportUC:       cfg.ExportUseCase,
+		verifyUC:       cfg.VerifyUseCase,
+		listArchivesUC: cfg.ListArchivesUseCase,
+		logger:         logger,
+	}
+}
+
+// Register attaches routes to the router. Callers must guard the router with support access checks.
+func (h *AuditHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Get("/logs", h.handleSearch)
+	router.Get("/logs/export", h.handleExport)
+	router.Get("/verify", h.handleVerify)
+	router.Get("/archives", h.handleListArchives)
+}
+
+func (h *AuditHandler) handleSearch(c *fiber.Ctx) error {
+	if h.searchUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "audit log not configured")
+	}
+
+	var query dto.AuditLogQuery
+	if err := c.QueryParser(&query); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid query parameters"))
+	}
+
+	result, err := h.searchUC.Execute(c.UserContext(), query)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *AuditHandler) handleExport(c *fiber.Ctx) error {
+	if h.exportUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "audit log not configured")
+	}
+
+	var query dto.AuditLogQuery
+	if err := c.QueryParser(&query); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid query parameters"))
+	}
+
+	file, err := h.exportUC.Execute(c.UserContext(), query)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, file.ContentType)
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", file.Filename))
+	return c.Status(fiber.StatusOK).Send(file.Content)
+}
+
+func (h *AuditHandler) handleVerify(c *fiber.Ctx) error {
+	if h.verifyUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "audit log not configured")
+	}
+
+	result, err := h.verifyUC.Execute(c.UserContext())
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *AuditHandler) handleListArchives(c *fiber.Ctx) error {
+	if h.listArchivesUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "audit log not configured")
+	}
+
+	result, err := h.listArchivesUC.Execute(c.UserContext(), parseQueryInt(c, "limit", 50), parseQueryInt(c, "offset", 0))
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
@@ -5,7 +5,6 @@ import (
 	"io"
 
 	"github.com/gofiber/fiber/v2"
-	"github.com/google/uuid"
 
 	"github.com/crypto-wallet/backend/internal/application/dto"
 	"github.com/crypto-wallet/backend/internal/application/usecases/auth"
@@ -18,10 +17,15 @@ type AuthHandler struct {
 	registerUC      *auth.RegisterUseCase
 	loginUC         *auth.LoginUseCase
 	logoutUC        *auth.LogoutUseCase
+	refreshUC       *auth.RefreshTokenUseCase
+	revokeUC        *auth.RevokeTokenUseCase
 	setup2FAUC      *auth.GenerateTwoFactorSetupUseCase
 	enable2FAUC     *auth.EnableTwoFactorUseCase
 	disable2FAUC    *auth.DisableTwoFactorUseCase
+	sendVerifyUC    *auth.SendEmailVerificationUseCase
+	verifyEmailUC   *auth.VerifyEmailUseCase
 	twoFactorIssuer string
+	cookies         middleware.CookieAuthConfig
 }
 
 // NewAuthHandler constructs an AuthHandler.
@@ -29,26 +33,72 @@ func NewAuthHandler(
 	registerUC *auth.RegisterUseCase,
 	loginUC *auth.LoginUseCase,
 	logoutUC *auth.LogoutUseCase,
+	refreshUC *auth.RefreshTokenUseCase,
+	revokeUC *auth.RevokeTokenUseCase,
 	setup2FAUC *auth.GenerateTwoFactorSetupUseCase,
 	enable2FAUC *auth.EnableTwoFactorUseCase,
 	disable2FAUC *auth.DisableTwoFactorUseCase,
+	sendVerifyUC *auth.SendEmailVerificationUseCase,
+	verifyEmailUC *auth.VerifyEmailUseCase,
 	twoFactorIssuer string,
+	cookies middleware.CookieAuthConfig,
 ) *AuthHandler {
 	return &AuthHandler{
 		registerUC:      registerUC,
 		loginUC:         loginUC,
 		logoutUC:        logoutUC,
+		refreshUC:       refreshUC,
+		revokeUC:        revokeUC,
 		setup2FAUC:      setup2FAUC,
 		enable2FAUC:     enable2FAUC,
 		disable2FAUC:    disable2FAUC,
+		sendVerifyUC:    sendVerifyUC,
+		verifyEmailUC:   verifyEmailUC,
 		twoFactorIssuer: twoFactorIssuer,
+		cookies:         cookies.WithDefaults(),
 	}
 }
 
 // Register handles user registration requests.
 func (h *AuthHandler) Register() fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		var payload dto.RegisterRequest
+		payload, err := middleware.BindBody[dto.RegisterRequest](c)
+		if err != nil {
+			return respondError(c, err)
+		}
+
+		result, err := h.registerUC.Execute(c.UserContext(), *payload)
+		if err != nil {
+			return respondError(c, err)
+		}
+
+		return h.respondWithSession(c, fiber.StatusCreated, result)
+	}
+}
+
+// Login handles user login requests.
+func (h *AuthHandler) Login() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		payload, err := middleware.BindBody[dto.LoginRequest](c)
+		if err != nil {
+			return respondError(c, err)
+		}
+		payload.UserAgent = c.Get(fiber.HeaderUserAgent)
+		payload.IPAddress = c.IP()
+
+		result, err := h.loginUC.Execute(c.UserContext(), *payload)
+		if err != nil {
+			return respondError(c, err)
+		}
+
+		return h.respondWithSession(c, fiber.StatusOK, result)
+	}
+}
+
+// Logout handles user logout requests.
+func (h *AuthHandler) Logout() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var payload dto.LogoutRequest
 		if err := c.BodyParser(&payload); err != nil {
 			resp, status := utils.ToErrorResponse(utils.NewAppError(
 				"INVALID_JSON",
@@ -60,21 +110,28 @@ func (h *AuthHandler) Register() fiber.Handler {
 			return c.Status(status).JSON(resp)
 		}
 
-		result, err := h.registerUC.Execute(c.Context(), payload)
-		if err != nil {
-			resp, status := utils.ToErrorResponse(err)
-			return c.Status(status).JSON(resp)
+		if err := h.logoutUC.Execute(c.UserContext(), payload); err != nil {
+			return respondError(c, err)
 		}
 
-		return c.Status(fiber.StatusCreated).JSON(result)
+		if h.cookies.Enabled {
+			h.cookies.ClearSession(c)
+		}
+		return c.SendStatus(fiber.StatusNoContent)
 	}
 }
 
-// Login handles user login requests.
-func (h *AuthHandler) Login() fiber.Handler {
+// Refresh exchanges a refresh token for a new token pair. The refresh token is the credential, so
+// the route must not sit behind the bearer token middleware. In cookie-auth mode the body may be
+// empty and the refresh token cookie is used.
+func (h *AuthHandler) Refresh() fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		var payload dto.LoginRequest
-		if err := c.BodyParser(&payload); err != nil {
+		if h.refreshUC == nil {
+			return fiber.NewError(fiber.StatusNotImplemented, "token refresh not configured")
+		}
+
+		var payload dto.RefreshTokenRequest
+		if err := h.parseTokenBody(c, &payload); err != nil {
 			resp, status := utils.ToErrorResponse(utils.NewAppError(
 				"INVALID_JSON",
 				"unable to parse request body",
@@ -85,21 +142,28 @@ func (h *AuthHandler) Login() fiber.Handler {
 			return c.Status(status).JSON(resp)
 		}
 
-		result, err := h.loginUC.Execute(c.Context(), payload)
+		if payload.RefreshToken == "" && h.cookies.Enabled {
+			payload.RefreshToken = h.cookies.RefreshToken(c)
+		}
+
+		result, err := h.refreshUC.Execute(c.UserContext(), payload)
 		if err != nil {
-			resp, status := utils.ToErrorResponse(err)
-			return c.Status(status).JSON(resp)
+			return respondError(c, err)
 		}
 
-		return c.Status(fiber.StatusOK).JSON(result)
+		return h.respondWithSession(c, fiber.StatusOK, result)
 	}
 }
 
-// Logout handles user logout requests.
-func (h *AuthHandler) Logout() fiber.Handler {
+// Revoke ends the session the presented refresh token belongs to.
+func (h *AuthHandler) Revoke() fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		var payload dto.LogoutRequest
-		if err := c.BodyParser(&payload); err != nil {
+		if h.revokeUC == nil {
+			return fiber.NewError(fiber.StatusNotImplemented, "token revocation not configured")
+		}
+
+		var payload dto.RevokeTokenRequest
+		if err := h.parseTokenBody(c, &payload); err != nil {
 			resp, status := utils.ToErrorResponse(utils.NewAppError(
 				"INVALID_JSON",
 				"unable to parse request body",
@@ -110,15 +174,48 @@ func (h *AuthHandler) Logout() fiber.Handler {
 			return c.Status(status).JSON(resp)
 		}
 
-		if err := h.logoutUC.Execute(c.Context(), payload); err != nil {
-			resp, status := utils.ToErrorResponse(err)
-			return c.Status(status).JSON(resp)
+		if payload.RefreshToken == "" && h.cookies.Enabled {
+			payload.RefreshToken = h.cookies.RefreshToken(c)
 		}
 
+		if err := h.revokeUC.Execute(c.UserContext(), payload); err != nil {
+			return respondError(c, err)
+		}
+
+		if h.cookies.Enabled {
+			h.cookies.ClearSession(c)
+		}
 		return c.SendStatus(fiber.StatusNoContent)
 	}
 }
 
+// parseTokenBody parses a refresh or revoke payload. Cookie-auth clients may send no body.
+func (h *AuthHandler) parseTokenBody(c *fiber.Ctx, payload any) error {
+	if h.cookies.Enabled && len(c.Body()) == 0 {
+		return nil
+	}
+	return c.BodyParser(payload)
+}
+
+// respondWithSession sends an auth result. In cookie-auth mode the tokens move into httpOnly
+// cookies and the body carries the new CSRF token instead.
+func (h *AuthHandler) respondWithSession(c *fiber.Ctx, status int, result *dto.AuthResponse) error {
+	if !h.cookies.Enabled || result == nil || result.Tokens.AccessToken == "" {
+		return c.Status(status).JSON(result)
+	}
+
+	tokens := result.Tokens
+	csrfToken, err := h.cookies.IssueSession(c, tokens.AccessToken, tokens.ExpiresAt, tokens.RefreshToken, tokens.RefreshExpiresAt)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result.Tokens.AccessToken = ""
+	result.Tokens.RefreshToken = ""
+	result.Tokens.CSRFToken = csrfToken
+	return c.Status(status).JSON(result)
+}
+
 // GenerateTwoFactorSetup initiates the TOTP enrolment process for the authenticated user.
 func (h *AuthHandler) GenerateTwoFactorSetup() fiber.Handler {
 	return func(c *fiber.Ctx) error {
@@ -156,14 +253,14 @@ func (h *AuthHandler) EnableTwoFactor() fiber.Handler {
 			return err
 		}
 
-		var payload dto.EnableTwoFactorRequest
-		if err := c.BodyParser(&payload); err != nil {
-			return fiber.NewError(fiber.StatusBadRequest, "invalid request payload")
+		payload, err := middleware.BindBody[dto.EnableTwoFactorRequest](c)
+		if err != nil {
+			return respondError(c, err)
 		}
 
 		result, execErr := h.enable2FAUC.Execute(c.UserContext(), auth.EnableTwoFactorInput{
 			UserID:  userIDUUID.String(),
-			Payload: payload,
+			Payload: *payload,
 		})
 		if execErr != nil {
 			return respondError(c, execErr)
@@ -201,3 +298,46 @@ func (h *AuthHandler) DisableTwoFactor() fiber.Handler {
 		return c.Status(fiber.StatusOK).JSON(result)
 	}
 }
+
+// SendEmailVerification emails a verification link to the authenticated user's address.
+func (h *AuthHandler) SendEmailVerification() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if h.sendVerifyUC == nil {
+			return fiber.NewError(fiber.StatusNotImplemented, "email verification not configured")
+		}
+
+		userIDUUID, err := extractUserID(c)
+		if err != nil {
+			return err
+		}
+
+		result, execErr := h.sendVerifyUC.Execute(c.UserContext(), userIDUUID)
+		if execErr != nil {
+			return respondError(c, execErr)
+		}
+
+		return c.Status(fiber.StatusAccepted).JSON(result)
+	}
+}
+
+// VerifyEmail redeems a verification token. The token is the credential, so the route must not
+// sit behind the bearer token middleware.
+func (h *AuthHandler) VerifyEmail() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if h.verifyEmailUC == nil {
+			return fiber.NewError(fiber.StatusNotImplemented, "email verification not configured")
+		}
+
+		payload, err := middleware.BindBody[dto.VerifyEmailRequest](c)
+		if err != nil {
+			return respondError(c, err)
+		}
+
+		result, execErr := h.verifyEmailUC.Execute(c.UserContext(), *payload)
+		if execErr != nil {
+			return respondError(c, execErr)
+		}
+
+		return c.Status(fiber.StatusOK).JSON(result)
+	}
+}
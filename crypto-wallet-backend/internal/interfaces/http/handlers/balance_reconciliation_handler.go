@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	reconciliationusecase "github.com/crypto-wallet/backend/internal/application/usecases/reconciliation"
+)
+
+// BalanceReconciliationHandlerConfig configures the support-facing reconciliation report handler.
+type BalanceReconciliationHandlerConfig struct {
+	ListRunsUseCase          *reconciliationusecase.ListRunsUseCase
+	ListDiscrepanciesUseCase *reconciliationusecase.ListDiscrepanciesUseCase
+	ResolveUseCase           *reconciliationusecase.ResolveDiscrepancyUseCase
+	Logger                   *slog.Logger
+}
+
+// BalanceReconciliationHandler exposes reconciliation runs and the discrepancy review queue to support staff.
+type BalanceReconciliationHandler struct {
+	listRunsUC *reconciliationusecase.ListRunsUseCase
+	listUC     *reconciliationusecase.ListDiscrepanciesUseCase
+	resolveUC  *reconciliationusecase.ResolveDiscrepancyUseCase
+	logger     *slog.Logger
+}
+
+// NewBalanceReconciliationHandler constructs a BalanceReconciliationHandler.
+func NewBalanceReconciliationHandler(cfg BalanceReconciliationHandlerConfig) *BalanceReconciliationHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &BalanceReconciliationHandler{
+		listRunsUC: cfg.ListRunsUseCase,
+		listUC:     cfg.ListDiscrepanciesUseCase,
+		resolveUC:  cfg.ResolveUseCase,
+		logger:     logger,
+	}
+}
+
+// Register attaches routes to the router. Callers must guard the router with support access checks.
+func (h *BalanceReconciliationHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Get("/runs", h.handleListRuns)
+	router.Get("/discrepancies", h.handleListDiscrepancies)
+	router.Post("/discrepancies/:id/resolve", h.handleResolve)
+}
+
+func (h *BalanceReconciliationHandler) handleListRuns(c *fiber.Ctx) error {
+	if h.listRunsUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "balance reconciliation not configured")
+	}
+
+	result, err := h.listRunsUC.Execute(c.UserContext(), reconciliationusecase.ListRunsInput{
+		Chain:  c.Query("chain"),
+		Limit:  parseQueryInt(c, "limit", 50),
+		Offset: parseQueryInt(c, "offset", 0),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *BalanceReconciliationHandler) handleListDiscrepancies(c *fiber.Ctx) error {
+	if h.listUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "balance reconciliation not configured")
+	}
+
+	result, err := h.listUC.Execute(c.UserContext(), reconciliationusecase.ListDiscrepanciesInput{
+		Status: c.Query("status"),
+		Chain:  c.Query("chain"),
+		RunID:  c.Query("run_id"),
+		Limit:  parseQueryInt(c, "limit", 50),
+		Offset: parseQueryInt(c, "offset", 0),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *BalanceReconciliationHandler) handleResolve(c *fiber.Ctx) error {
+	if h.resolveUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "balance reconciliation not configured")
+	}
+
+	actorID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	discrepancyID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.ResolveBalanceDiscrepancyRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.resolveUC.Execute(c.UserContext(), reconciliationusecase.ResolveDiscrepancyInput{
+		ActorID:       actorID,
+		DiscrepancyID: discrepancyID,
+		Payload:       payload,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
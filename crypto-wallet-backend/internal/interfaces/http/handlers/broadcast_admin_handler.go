@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	usecasebroadcast "github.com/crypto-wallet/backend/internal/application/usecases/broadcast"
+)
+
+// BroadcastAdminHandlerConfig configures the support-facing broadcast campaign handler.
+type BroadcastAdminHandlerConfig struct {
+	CreateUseCase  *usecasebroadcast.CreateCampaignUseCase
+	PreviewUseCase *usecasebroadcast.PreviewSegmentUseCase
+	ListUseCase    *usecasebroadcast.ListCampaignsUseCase
+	GetUseCase     *usecasebroadcast.GetCampaignUseCase
+	CancelUseCase  *usecasebroadcast.CancelCampaignUseCase
+	Logger         *slog.Logger
+}
+
+// BroadcastAdminHandler lets staff schedule broadcast campaigns and follow their delivery.
+type BroadcastAdminHandler struct {
+	createUC  *usecasebroadcast.CreateCampaignUseCase
+	previewUC *usecasebroadcast.PreviewSegmentUseCase
+	listUC    *usecasebroadcast.ListCampaignsUseCase
+	getUC     *usecasebroadcast.GetCampaignUseCase
+	cancelUC  *usecasebroadcast.CancelCampaignUseCase
+	logger    *slog.Logger
+}
+
+// NewBroadcastAdminHandler constructs a BroadcastAdminHandler.
+func NewBroadcastAdminHandler(cfg BroadcastAdminHandlerConfig) *BroadcastAdminHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &BroadcastAdminHandler{
+		createUC:  cfg.CreateUseCase,
+		previewUC: cfg.PreviewUseCase,
+		listUC:    cfg.ListUseCase,
+		getUC:     cfg.GetUseCase,
+		cancelUC:  cfg.CancelUseCase,
+		logger:    logger,
+	}
+}
+
+// Register attaches routes to the router. Callers must guard the router with support access checks.
+func (h *BroadcastAdminHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Get("/", h.handleList)
+	router.Post("/", h.handleCreate)
+	router.Post("/preview", h.handlePreview)
+	router.Get("/:id", h.handleGet)
+	router.Post("/:id/cancel", h.handleCancel)
+}
+
+func (h *BroadcastAdminHandler) handleList(c *fiber.Ctx) error {
+	if h.listUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "broadcasts not configured")
+	}
+
+	result, err := h.listUC.Execute(c.UserContext(), usecasebroadcast.ListCampaignsInput{
+		Status: c.Query("status"),
+		Limit:  parseQueryInt(c, "limit", 50),
+		Offset: parseQueryInt(c, "offset", 0),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *BroadcastAdminHandler) handleCreate(c *fiber.Ctx) error {
+	if h.createUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "broadcasts not configured")
+	}
+
+	actorID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.CreateBroadcastRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.createUC.Execute(c.UserContext(), usecasebroadcast.CreateCampaignInput{
+		ActorID: actorID,
+		Payload: payload,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(result)
+}
+
+func (h *BroadcastAdminHandler) handlePreview(c *fiber.Ctx) error {
+	if h.previewUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "broadcasts not configured")
+	}
+
+	var payload dto.PreviewBroadcastSegmentRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.previewUC.Execute(c.UserContext(), payload)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *BroadcastAdminHandler) handleGet(c *fiber.Ctx) error {
+	if h.getUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "broadcasts not configured")
+	}
+
+	campaignID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.getUC.Execute(c.UserContext(), campaignID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *BroadcastAdminHandler) handleCancel(c *fiber.Ctx) error {
+	if h.cancelUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "broadcasts not configured")
+	}
+
+	actorID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	campaignID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.cancelUC.Execute(c.UserContext(), usecasebroadcast.CancelCampaignInput{
+		ActorID:    actorID,
+		CampaignID: campaignID,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
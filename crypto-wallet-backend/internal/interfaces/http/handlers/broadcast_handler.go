@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	usecasebroadcast "github.com/crypto-wallet/backend/internal/application/usecases/broadcast"
+)
+
+// BroadcastHandlerConfig configures the user-facing broadcast handler.
+type BroadcastHandlerConfig struct {
+	RecordOpenUseCase *usecasebroadcast.RecordOpenUseCase
+	Logger            *slog.Logger
+}
+
+// BroadcastHandler lets clients report that a user opened a broadcast message.
+type BroadcastHandler struct {
+	recordOpenUC *usecasebroadcast.RecordOpenUseCase
+	logger       *slog.Logger
+}
+
+// NewBroadcastHandler constructs a BroadcastHandler.
+func NewBroadcastHandler(cfg BroadcastHandlerConfig) *BroadcastHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &BroadcastHandler{
+		recordOpenUC: cfg.RecordOpenUseCase,
+		logger:       logger,
+	}
+}
+
+// Register attaches routes to the router.
+func (h *BroadcastHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Post("/:id/opened", h.handleOpened)
+}
+
+func (h *BroadcastHandler) handleOpened(c *fiber.Ctx) error {
+	if h.recordOpenUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "broadcasts not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	campaignID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	if err := h.recordOpenUC.Execute(c.UserContext(), usecasebroadcast.RecordOpenInput{
+		UserID:     userID,
+		CampaignID: campaignID,
+	}); err != nil {
+		return respondError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	usecasewallet "github.com/crypto-wallet/backend/internal/application/usecases/wallet"
+)
+
+// ChainConfigHandlerConfig configures the chain registry handler.
+type ChainConfigHandlerConfig struct {
+	ListUseCase      *usecasewallet.ListChainConfigsUseCase
+	UpsertUseCase    *usecasewallet.UpsertChainConfigUseCase
+	SetActiveUseCase *usecasewallet.SetChainActiveUseCase
+	Logger           *slog.Logger
+}
+
+// ChainConfigHandler lets staff register chains, update their endpoints and networks, and disable
+// them without redeploying.
+type ChainConfigHandler struct {
+	listUC      *usecasewallet.ListChainConfigsUseCase
+	upsertUC    *usecasewallet.UpsertChainConfigUseCase
+	setActiveUC *usecasewallet.SetChainActiveUseCase
+	logger      *slog.Logger
+}
+
+// NewChainConfigHandler constructs a ChainConfigHandler.
+func NewChainConfigHandler(cfg ChainConfigHandlerConfig) *ChainConfigHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ChainConfigHandler{
+		listUC:      cfg.ListUseCase,
+		upsertUC:    cfg.UpsertUseCase,
+		setActiveUC: cfg.SetActiveUseCase,
+		logger:      logger,
+	}
+}
+
+// Register attaches routes to the router. Callers must guard the router with support access checks.
+func (h *ChainConfigHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Get("/", h.handleList)
+	router.Put("/:chain", h.handleUpsert)
+	router.Post("/:chain/disable", h.handleSetActive(false))
+	router.Post("/:chain/enable", h.handleSetActive(true))
+}
+
+func (h *ChainConfigHandler) handleList(c *fiber.Ctx) error {
+	if h.listUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "chain registry not configured")
+	}
+
+	result, err := h.listUC.Execute(c.UserContext())
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"items": result})
+}
+
+func (h *ChainConfigHandler) handleUpsert(c *fiber.Ctx) error {
+	if h.upsertUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "chain registry not configured")
+	}
+
+	actorID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.ChainConfigRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.upsertUC.Execute(c.UserContext(), usecasewallet.UpsertChainConfigInput{
+		ActorID: actorID.String(),
+		Chain:   c.Params("chain"),
+		Request: payload,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *ChainConfigHandler) handleSetActive(active bool) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if h.setActiveUC == nil {
+			return fiber.NewError(fiber.StatusNotImplemented, "chain registry not configured")
+		}
+
+		actorID, err := extractUserID(c)
+		if err != nil {
+			return respondError(c, err)
+		}
+
+		result, err := h.setActiveUC.Execute(c.UserContext(), usecasewallet.SetChainActiveInput{
+			ActorID: actorID.String(),
+			Chain:   c.Params("chain"),
+			Active:  active,
+		})
+		if err != nil {
+			return respondError(c, err)
+		}
+
+		return c.Status(fiber.StatusOK).JSON(result)
+	}
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	usecasewallet "github.com/crypto-wallet/backend/internal/application/usecases/wallet"
+)
+
+// ChainRolloutHandlerConfig configures the chain rollout handler.
+type ChainRolloutHandlerConfig struct {
+	ListUseCase   *usecasewallet.ListChainRolloutsUseCase
+	UpsertUseCase *usecasewallet.UpsertChainRolloutUseCase
+	DeleteUseCase *usecasewallet.DeleteChainRolloutUseCase
+	Logger        *slog.Logger
+}
+
+// ChainRolloutHandler lets staff manage the soft launch of new chains and watch its cohort metrics.
+type ChainRolloutHandler struct {
+	listUC   *usecasewallet.ListChainRolloutsUseCase
+	upsertUC *usecasewallet.UpsertChainRolloutUseCase
+	deleteUC *usecasewallet.DeleteChainRolloutUseCase
+	logger   *slog.Logger
+}
+
+// NewChainRolloutHandler constructs a ChainRolloutHandler.
+func NewChainRolloutHandler(cfg ChainRolloutHandlerConfig) *ChainRolloutHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ChainRolloutHandler{
+		listUC:   cfg.ListUseCase,
+		upsertUC: cfg.UpsertUseCase,
+		deleteUC: cfg.DeleteUseCase,
+		logger:   logger,
+	}
+}
+
+// Register attaches routes to the router. Callers must guard the router with support access checks.
+func (h *ChainRolloutHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Get("/rollouts", h.handleList)
+	router.Put("/rollouts/:chain", h.handleUpsert)
+	router.Delete("/rollouts/:chain", h.handleDelete)
+}
+
+func (h *ChainRolloutHandler) handleList(c *fiber.Ctx) error {
+	if h.listUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "chain rollouts not configured")
+	}
+
+	result, err := h.listUC.Execute(c.UserContext())
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"items": result})
+}
+
+func (h *ChainRolloutHandler) handleUpsert(c *fiber.Ctx) error {
+	if h.upsertUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "chain rollouts not configured")
+	}
+
+	actorID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.ChainRolloutRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.upsertUC.Execute(c.UserContext(), usecasewallet.UpsertChainRolloutInput{
+		ActorID: actorID.String(),
+		Chain:   c.Params("chain"),
+		Request: payload,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *ChainRolloutHandler) handleDelete(c *fiber.Ctx) error {
+	if h.deleteUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "chain rollouts not configured")
+	}
+
+	actorID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	if err := h.deleteUC.Execute(c.UserContext(), usecasewallet.DeleteChainRolloutInput{
+		ActorID: actorID.String(),
+		Chain:   c.Params("chain"),
+	}); err != nil {
+		return respondError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
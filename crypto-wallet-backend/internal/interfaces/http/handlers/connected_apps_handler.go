@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	usecaseapps "github.com/crypto-wallet/backend/internal/application/usecases/apps"
+)
+
+// ConnectedAppsHandlerConfig configures the third-party app consent HTTP handler.
+type ConnectedAppsHandlerConfig struct {
+	AuthorizeUseCase *usecaseapps.AuthorizeAppUseCase
+	ListUseCase      *usecaseapps.ListConnectedAppsUseCase
+	RevokeUseCase    *usecaseapps.RevokeConnectedAppUseCase
+	Logger           *slog.Logger
+}
+
+// ConnectedAppsHandler lets users grant third-party apps scoped access and revoke it again.
+type ConnectedAppsHandler struct {
+	authorizeUC *usecaseapps.AuthorizeAppUseCase
+	listUC      *usecaseapps.ListConnectedAppsUseCase
+	revokeUC    *usecaseapps.RevokeConnectedAppUseCase
+	logger      *slog.Logger
+}
+
+// NewConnectedAppsHandler constructs a ConnectedAppsHandler.
+func NewConnectedAppsHandler(cfg ConnectedAppsHandlerConfig) *ConnectedAppsHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ConnectedAppsHandler{
+		authorizeUC: cfg.AuthorizeUseCase,
+		listUC:      cfg.ListUseCase,
+		revokeUC:    cfg.RevokeUseCase,
+		logger:      logger,
+	}
+}
+
+// Register attaches routes to the router.
+func (h *ConnectedAppsHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Post("/consents", h.handleAuthorize)
+	router.Get("/connected", h.handleList)
+	router.Delete("/connected/:consentId", h.handleRevoke)
+}
+
+func (h *ConnectedAppsHandler) handleAuthorize(c *fiber.Ctx) error {
+	if h.authorizeUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "connected apps not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.AppConsentRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.authorizeUC.Execute(c.UserContext(), usecaseapps.AuthorizeAppInput{
+		UserID:  userID.String(),
+		Request: payload,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(result)
+}
+
+func (h *ConnectedAppsHandler) handleList(c *fiber.Ctx) error {
+	if h.listUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "connected apps not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.listUC.Execute(c.UserContext(), userID.String())
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"items": result})
+}
+
+func (h *ConnectedAppsHandler) handleRevoke(c *fiber.Ctx) error {
+	if h.revokeUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "connected apps not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	if err := h.revokeUC.Execute(c.UserContext(), usecaseapps.RevokeConnectedAppInput{
+		UserID:    userID.String(),
+		ConsentID: c.Params("consentId"),
+	}); err != nil {
+		return respondError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	eventexportusecase "github.com/crypto-wallet/backend/internal/application/usecases/eventexport"
+)
+
+// EventExportHandlerConfig configures the event export support handler.
+type EventExportHandlerConfig struct {
+	BackfillUseCase *eventexportusecase.BackfillEventsUseCase
+	Logger          *slog.Logger
+}
+
+// EventExportHandler lets staff replay domain events into the warehouse sink.
+type EventExportHandler struct {
+	backfillUC *eventexportusecase.BackfillEventsUseCase
+	logger     *slog.Logger
+}
+
+// NewEventExportHandler constructs an EventExportHandler.
+func NewEventExportHandler(cfg EventExportHandlerConfig) *EventExportHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &EventExportHandler{
+		backfillUC: cfg.BackfillUseCase,
+		logger:     logger,
+	}
+}
+
+// Register attaches routes to the router. Callers must guard the router with support access checks.
+func (h *EventExportHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Post("/backfill", h.handleBackfill)
+}
+
+func (h *EventExportHandler) handleBackfill(c *fiber.Ctx) error {
+	if h.backfillUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "event export not configured")
+	}
+
+	var payload dto.EventBackfillRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.backfillUC.Execute(c.UserContext(), payload)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	exchangeusecase "github.com/crypto-wallet/backend/internal/application/usecases/exchange"
+)
+
+// ExchangeExecutionHandlerConfig configures the exchange execution handler.
+type ExchangeExecutionHandlerConfig struct {
+	SwapTokens *exchangeusecase.SwapTokens
+	Logger     *slog.Logger
+}
+
+// ExchangeExecutionHandler quotes exchanges, executes them and reports their progress.
+type ExchangeExecutionHandler struct {
+	swaps  *exchangeusecase.SwapTokens
+	logger *slog.Logger
+}
+
+// NewExchangeExecutionHandler constructs an ExchangeExecutionHandler.
+func NewExchangeExecutionHandler(cfg ExchangeExecutionHandlerConfig) *ExchangeExecutionHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ExchangeExecutionHandler{
+		swaps:  cfg.SwapTokens,
+		logger: logger,
+	}
+}
+
+// Register attaches routes to the router.
+func (h *ExchangeExecutionHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Post("/quote", h.handleQuote)
+	router.Post("/execute", h.handleExecute)
+	router.Get("/:id", h.handleGet)
+}
+
+// handleQuote prices an exchange from the amount to spend or the amount to receive.
+func (h *ExchangeExecutionHandler) handleQuote(c *fiber.Ctx) error {
+	if h.swaps == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "exchange not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var req dto.QuoteRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid request body")
+	}
+
+	quote, err := h.swaps.GetQuote(c.UserContext(), userID, &req)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(quote)
+}
+
+// handleExecute answers 202 when the execution was queued and 200 when it already ran.
+func (h *ExchangeExecutionHandler) handleExecute(c *fiber.Ctx) error {
+	if h.swaps == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "exchange not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var req dto.ExecuteExchangeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid request body")
+	}
+
+	result, err := h.swaps.RequestExecution(c.UserContext(), userID, &req)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	status := fiber.StatusOK
+	if result.Queued {
+		status = fiber.StatusAccepted
+	}
+	return c.Status(status).JSON(result)
+}
+
+func (h *ExchangeExecutionHandler) handleGet(c *fiber.Ctx) error {
+	if h.swaps == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "exchange not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+	operationID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return err
+	}
+
+	result, err := h.swaps.GetSwap(c.UserContext(), userID, operationID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
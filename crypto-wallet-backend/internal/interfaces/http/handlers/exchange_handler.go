@@ -37,90 +37,83 @@ func (h *ExchangeHandler) GetExchangeRate(c *fiber.Ctx) error {
 	quoteSymbol := c.Query("quote_symbol")
 
 	if baseSymbol == "" {
-		return utils.ErrorResponse(c, fiber.StatusBadRequest, "base_symbol is required")
+		return fiber.NewError(fiber.StatusBadRequest, "base_symbol is required")
 	}
 	if quoteSymbol == "" {
-		return utils.ErrorResponse(c, fiber.StatusBadRequest, "quote_symbol is required")
+		return fiber.NewError(fiber.StatusBadRequest, "quote_symbol is required")
 	}
 
-	req := &dto.ExchangeRateRequest{
-		BaseSymbol:  baseSymbol,
-		QuoteSymbol: quoteSymbol,
-	}
-
-	response, err := h.getExchangeRate.Execute(c.Context(), req)
+	response, err := h.getExchangeRate.Execute(c.UserContext(), baseSymbol, quoteSymbol)
 	if err != nil {
-		return utils.ErrorResponse(c, fiber.StatusInternalServerError, err.Error())
+		return respondError(c, err)
 	}
 
-	return utils.SuccessResponse(c, response)
+	return c.Status(fiber.StatusOK).JSON(response)
 }
 
 // GetQuote handles POST /api/v1/exchange/quote
 func (h *ExchangeHandler) GetQuote(c *fiber.Ctx) error {
-	var req dto.QuoteRequest
-	if err := c.BodyParser(&req); err != nil {
-		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Invalid request body")
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
 	}
 
-	// Validate request
-	if err := utils.ValidateStruct(&req); err != nil {
-		return utils.ErrorResponse(c, fiber.StatusBadRequest, err.Error())
+	var req dto.QuoteRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid request body")
 	}
 
-	response, err := h.swapTokens.GetQuote(c.Context(), &req)
+	response, err := h.swapTokens.GetQuote(c.UserContext(), userID, &req)
 	if err != nil {
-		return utils.ErrorResponse(c, fiber.StatusInternalServerError, err.Error())
+		return respondError(c, err)
 	}
 
-	return utils.SuccessResponse(c, response)
+	return c.Status(fiber.StatusOK).JSON(response)
 }
 
 // ExecuteSwap handles POST /api/v1/exchange/execute
 func (h *ExchangeHandler) ExecuteSwap(c *fiber.Ctx) error {
-	var req dto.ExecuteSwapRequest
-	if err := c.BodyParser(&req); err != nil {
-		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Invalid request body")
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
 	}
 
-	// Validate request
-	if err := utils.ValidateStruct(&req); err != nil {
-		return utils.ErrorResponse(c, fiber.StatusBadRequest, err.Error())
+	var req dto.ExecuteExchangeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid request body")
 	}
 
-	response, err := h.swapTokens.ExecuteSwap(c.Context(), &req)
+	response, err := h.swapTokens.ExecuteSwap(c.UserContext(), userID, &req)
 	if err != nil {
-		return utils.ErrorResponse(c, fiber.StatusInternalServerError, err.Error())
+		return respondError(c, err)
 	}
 
-	return utils.SuccessResponse(c, response)
+	return c.Status(fiber.StatusOK).JSON(response)
 }
 
 // CancelSwap handles POST /api/v1/exchange/cancel
 func (h *ExchangeHandler) CancelSwap(c *fiber.Ctx) error {
-	var req dto.CancelSwapRequest
+	var req dto.CancelExchangeRequest
 	if err := c.BodyParser(&req); err != nil {
-		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Invalid request body")
+		return fiber.NewError(fiber.StatusBadRequest, "invalid request body")
 	}
-
-	// Validate request
-	if err := utils.ValidateStruct(&req); err != nil {
-		return utils.ErrorResponse(c, fiber.StatusBadRequest, err.Error())
+	if errs := utils.ValidateStruct(&req); !errs.IsEmpty() {
+		return respondError(c, errs)
 	}
 
-	response, err := h.swapTokens.CancelSwap(c.Context(), &req)
+	response, err := h.swapTokens.CancelSwap(c.UserContext(), &req)
 	if err != nil {
-		return utils.ErrorResponse(c, fiber.StatusInternalServerError, err.Error())
+		return respondError(c, err)
 	}
 
-	return utils.SuccessResponse(c, response)
+	return c.Status(fiber.StatusOK).JSON(response)
 }
 
 // GetExchangeHistory handles GET /api/v1/exchange/history
 func (h *ExchangeHandler) GetExchangeHistory(c *fiber.Ctx) error {
 	userID, err := uuid.Parse(c.Params("userID"))
 	if err != nil {
-		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Invalid user ID")
+		return fiber.NewError(fiber.StatusBadRequest, "invalid user ID")
 	}
 
 	// Parse query parameters
@@ -166,35 +159,40 @@ func (h *ExchangeHandler) GetExchangeHistory(c *fiber.Ctx) error {
 		req.MaxAmount = &maxAmount
 	}
 
-	response, err := h.getExchangeHistory.Execute(c.Context(), userID, req)
+	response, err := h.getExchangeHistory.Execute(c.UserContext(), userID, req)
 	if err != nil {
-		return utils.ErrorResponse(c, fiber.StatusInternalServerError, err.Error())
+		return respondError(c, err)
 	}
 
-	return utils.SuccessResponse(c, response)
+	return streamJSONList(c, fiber.StatusOK, nil, "operations", response.Operations,
+		jsonField{key: "total", value: response.Total},
+		jsonField{key: "page", value: response.Page},
+		jsonField{key: "page_size", value: response.PageSize},
+		jsonField{key: "total_pages", value: response.TotalPages},
+	)
 }
 
 // GetExchangeStats handles GET /api/v1/exchange/stats/:userID
 func (h *ExchangeHandler) GetExchangeStats(c *fiber.Ctx) error {
 	userID, err := uuid.Parse(c.Params("userID"))
 	if err != nil {
-		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Invalid user ID")
+		return fiber.NewError(fiber.StatusBadRequest, "invalid user ID")
 	}
 
-	response, err := h.getExchangeHistory.GetStats(c.Context(), userID)
+	response, err := h.getExchangeHistory.GetStats(c.UserContext(), userID)
 	if err != nil {
-		return utils.ErrorResponse(c, fiber.StatusInternalServerError, err.Error())
+		return respondError(c, err)
 	}
 
-	return utils.SuccessResponse(c, response)
+	return c.Status(fiber.StatusOK).JSON(response)
 }
 
 // GetActiveTradingPairs handles GET /api/v1/exchange/pairs
 func (h *ExchangeHandler) GetActiveTradingPairs(c *fiber.Ctx) error {
-	response, err := h.getExchangeHistory.GetActiveTradingPairs(c.Context())
+	response, err := h.getExchangeHistory.GetActiveTradingPairs(c.UserContext())
 	if err != nil {
-		return utils.ErrorResponse(c, fiber.StatusInternalServerError, err.Error())
+		return respondError(c, err)
 	}
 
-	return utils.SuccessResponse(c, response)
+	return c.Status(fiber.StatusOK).JSON(response)
 }
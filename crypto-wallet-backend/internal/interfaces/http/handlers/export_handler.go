@@ -0,0 +1,239 @@
+package handlers
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	usecaseexport "github.com/crypto-wallet/backend/internal/application/usecases/export"
+)
+
+// ExportHandlerConfig configures the asynchronous export HTTP handler.
+type ExportHandlerConfig struct {
+	RequestAccountingUseCase   *usecaseexport.RequestAccountingExportUseCase
+	RequestTransactionsUseCase *usecaseexport.RequestTransactionExportUseCase
+	GetUseCase                 *usecaseexport.GetExportUseCase
+	ListUseCase                *usecaseexport.ListExportsUseCase
+	DownloadUseCase            *usecaseexport.DownloadExportUseCase
+	GetMappingsUseCase         *usecaseexport.GetAccountMappingsUseCase
+	UpdateMappingsUseCase      *usecaseexport.UpdateAccountMappingsUseCase
+	Logger                     *slog.Logger
+}
+
+// ExportHandler exposes export job and accounting mapping endpoints.
+type ExportHandler struct {
+	requestAccountingUC   *usecaseexport.RequestAccountingExportUseCase
+	requestTransactionsUC *usecaseexport.RequestTransactionExportUseCase
+	getUC                 *usecaseexport.GetExportUseCase
+	listUC                *usecaseexport.ListExportsUseCase
+	downloadUC            *usecaseexport.DownloadExportUseCase
+	getMappingsUC         *usecaseexport.GetAccountMappingsUseCase
+	updateMappingsUC      *usecaseexport.UpdateAccountMappingsUseCase
+	logger                *slog.Logger
+}
+
+// NewExportHandler constructs an ExportHandler.
+func NewExportHandler(cfg ExportHandlerConfig) *ExportHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ExportHandler{
+		requestAccountingUC:   cfg.RequestAccountingUseCase,
+		requestTransactionsUC: cfg.RequestTransactionsUseCase,
+		getUC:                 cfg.GetUseCase,
+		listUC:                cfg.ListUseCase,
+		downloadUC:            cfg.DownloadUseCase,
+		getMappingsUC:         cfg.GetMappingsUseCase,
+		updateMappingsUC:      cfg.UpdateMappingsUseCase,
+		logger:                logger,
+	}
+}
+
+// Register attaches routes to the router.
+func (h *ExportHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Get("/", h.handleList)
+	router.Post("/accounting", h.handleRequestAccounting)
+	router.Post("/transactions", h.handleRequestTransactions)
+	router.Get("/accounting/mappings", h.handleGetMappings)
+	router.Put("/accounting/mappings", h.handleUpdateMappings)
+	router.Get("/:id", h.handleGet)
+	router.Get("/:id/download", h.handleDownload)
+}
+
+func (h *ExportHandler) handleList(c *fiber.Ctx) error {
+	if h.listUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "exports not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.listUC.Execute(c.UserContext(), usecaseexport.ListExportsInput{
+		UserID: userID,
+		Limit:  parseQueryInt(c, "limit", 20),
+		Offset: parseQueryInt(c, "offset", 0),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *ExportHandler) handleRequestAccounting(c *fiber.Ctx) error {
+	if h.requestAccountingUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "accounting exports not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.AccountingExportRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.requestAccountingUC.Execute(c.UserContext(), usecaseexport.RequestAccountingExportInput{
+		UserID:  userID,
+		Payload: payload,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(result)
+}
+
+func (h *ExportHandler) handleRequestTransactions(c *fiber.Ctx) error {
+	if h.requestTransactionsUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "transaction exports not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.ExportTransactionsRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.requestTransactionsUC.Execute(c.UserContext(), usecaseexport.RequestTransactionExportInput{
+		UserID:  userID,
+		Payload: payload,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(result)
+}
+
+func (h *ExportHandler) handleGetMappings(c *fiber.Ctx) error {
+	if h.getMappingsUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "accounting exports not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.getMappingsUC.Execute(c.UserContext(), userID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *ExportHandler) handleUpdateMappings(c *fiber.Ctx) error {
+	if h.updateMappingsUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "accounting exports not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.UpdateAccountMappingsRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.updateMappingsUC.Execute(c.UserContext(), usecaseexport.UpdateAccountMappingsInput{
+		UserID:  userID,
+		Payload: payload,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *ExportHandler) handleGet(c *fiber.Ctx) error {
+	if h.getUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "exports not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	jobID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.getUC.Execute(c.UserContext(), userID, jobID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *ExportHandler) handleDownload(c *fiber.Ctx) error {
+	if h.downloadUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "exports not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	jobID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	file, err := h.downloadUC.Execute(c.UserContext(), userID, jobID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	if file.DownloadURL != "" {
+		c.Set(fiber.HeaderCacheControl, "no-store")
+		return c.Redirect(file.DownloadURL, fiber.StatusFound)
+	}
+
+	c.Set(fiber.HeaderContentType, file.ContentType)
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", file.Filename))
+	return c.Status(fiber.StatusOK).Send(file.Content)
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	gasusecase "github.com/crypto-wallet/backend/internal/application/usecases/gas"
+)
+
+// GasHandlerConfig configures the gas oracle handler.
+type GasHandlerConfig struct {
+	OracleUseCase        *gasusecase.GetGasOracleUseCase
+	GetPreferenceUseCase *gasusecase.GetGasPreferenceUseCase
+	SetPreferenceUseCase *gasusecase.SetGasPreferenceUseCase
+	Logger               *slog.Logger
+}
+
+// GasHandler serves gas price estimates and the user's default gas speed.
+type GasHandler struct {
+	oracleUC        *gasusecase.GetGasOracleUseCase
+	getPreferenceUC *gasusecase.GetGasPreferenceUseCase
+	setPreferenceUC *gasusecase.SetGasPreferenceUseCase
+	logger          *slog.Logger
+}
+
+// NewGasHandler constructs a GasHandler.
+func NewGasHandler(cfg GasHandlerConfig) *GasHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GasHandler{
+		oracleUC:        cfg.OracleUseCase,
+		getPreferenceUC: cfg.GetPreferenceUseCase,
+		setPreferenceUC: cfg.SetPreferenceUseCase,
+		logger:          logger,
+	}
+}
+
+// Register attaches routes to the router.
+func (h *GasHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Get("/gas/preference", h.handleGetPreference)
+	router.Put("/gas/preference", h.handleSetPreference)
+	router.Get("/:chain/gas", h.handleOracle)
+}
+
+func (h *GasHandler) handleOracle(c *fiber.Ctx) error {
+	if h.oracleUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "gas oracle not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := h.oracleUC.Execute(c.UserContext(), gasusecase.GetGasOracleInput{
+		UserID: userID,
+		Chain:  c.Params("chain"),
+		Window: c.Query("window"),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *GasHandler) handleGetPreference(c *fiber.Ctx) error {
+	if h.getPreferenceUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "gas preferences not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := h.getPreferenceUC.Execute(c.UserContext(), userID.String())
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *GasHandler) handleSetPreference(c *fiber.Ctx) error {
+	if h.setPreferenceUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "gas preferences not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var payload dto.GasPreferenceRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid request payload")
+	}
+
+	result, err := h.setPreferenceUC.Execute(c.UserContext(), gasusecase.SetGasPreferenceInput{
+		UserID:  userID.String(),
+		Request: payload,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"log/slog"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/interfaces/graphqlapi"
+)
+
+// GraphQLHandlerConfig configures the GraphQL endpoint.
+type GraphQLHandlerConfig struct {
+	Schema *graphqlapi.Schema
+	Logger *slog.Logger
+}
+
+// GraphQLHandler executes GraphQL queries for the authenticated user.
+type GraphQLHandler struct {
+	schema *graphqlapi.Schema
+	logger *slog.Logger
+}
+
+// NewGraphQLHandler constructs a GraphQLHandler.
+func NewGraphQLHandler(cfg GraphQLHandlerConfig) *GraphQLHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &GraphQLHandler{
+		schema: cfg.Schema,
+		logger: logger,
+	}
+}
+
+// Register attaches routes to the router.
+func (h *GraphQLHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Post("/", h.handleQuery)
+}
+
+// handleQuery handles POST /graphql. Resolver errors are reported in the response body with
+// status 200, as GraphQL clients expect.
+func (h *GraphQLHandler) handleQuery(c *fiber.Ctx) error {
+	if h.schema == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "graphql not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var params graphqlapi.Params
+	if err := c.BodyParser(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid request body")
+	}
+	if strings.TrimSpace(params.Query) == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "query is required")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(h.schema.Execute(c.UserContext(), userID, params))
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	internaleventsusecase "github.com/crypto-wallet/backend/internal/application/usecases/internalevents"
+	"github.com/crypto-wallet/backend/internal/interfaces/http/middleware"
+)
+
+// InternalEventHandlerConfig configures the internal event stream handler.
+type InternalEventHandlerConfig struct {
+	StreamUseCase *internaleventsusecase.StreamEventsUseCase
+	OffsetUseCase *internaleventsusecase.ConsumerOffsetUseCase
+	Logger        *slog.Logger
+}
+
+// InternalEventHandler serves the outbox event stream to internal consumers over the mTLS listener.
+type InternalEventHandler struct {
+	streamUC *internaleventsusecase.StreamEventsUseCase
+	offsetUC *internaleventsusecase.ConsumerOffsetUseCase
+	logger   *slog.Logger
+}
+
+// NewInternalEventHandler constructs an InternalEventHandler.
+func NewInternalEventHandler(cfg InternalEventHandlerConfig) *InternalEventHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &InternalEventHandler{
+		streamUC: cfg.StreamUseCase,
+		offsetUC: cfg.OffsetUseCase,
+		logger:   logger,
+	}
+}
+
+// Register attaches routes to the router. Callers must guard the router with the internal consumer
+// middleware.
+func (h *InternalEventHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Get("/", h.handleStream)
+	router.Get("/offset", h.handleGetOffset)
+	router.Put("/offset", h.handleCommitOffset)
+}
+
+func (h *InternalEventHandler) handleStream(c *fiber.Ctx) error {
+	if h.streamUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "internal event stream not configured")
+	}
+	consumer, ok := middleware.InternalConsumerFromContext(c)
+	if !ok {
+		return respondError(c, fiber.NewError(fiber.StatusUnauthorized, "unauthorized"))
+	}
+
+	input := internaleventsusecase.StreamEventsInput{
+		Limit: c.QueryInt("limit", 0),
+	}
+	if raw := strings.TrimSpace(c.Query("after")); raw != "" {
+		after, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return respondError(c, fiber.NewError(fiber.StatusBadRequest, "after must be an integer offset"))
+		}
+		input.After = &after
+	}
+	for _, value := range strings.Split(c.Query("types"), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			input.Types = append(input.Types, value)
+		}
+	}
+
+	result, err := h.streamUC.Execute(c.UserContext(), consumer, input)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *InternalEventHandler) handleGetOffset(c *fiber.Ctx) error {
+	if h.offsetUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "internal event offsets not configured")
+	}
+	consumer, ok := middleware.InternalConsumerFromContext(c)
+	if !ok {
+		return respondError(c, fiber.NewError(fiber.StatusUnauthorized, "unauthorized"))
+	}
+
+	result, err := h.offsetUC.Get(c.UserContext(), consumer)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *InternalEventHandler) handleCommitOffset(c *fiber.Ctx) error {
+	if h.offsetUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "internal event offsets not configured")
+	}
+	consumer, ok := middleware.InternalConsumerFromContext(c)
+	if !ok {
+		return respondError(c, fiber.NewError(fiber.StatusUnauthorized, "unauthorized"))
+	}
+
+	var payload dto.InternalEventOffsetRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.offsetUC.Commit(c.UserContext(), consumer, payload)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	kycusecase "github.com/crypto-wallet/backend/internal/application/usecases/kyc"
+)
+
+// KYCComplianceHandlerConfig configures the compliance matrix handler.
+type KYCComplianceHandlerConfig struct {
+	ListUseCase   *kycusecase.ListCountryRequirementsUseCase
+	UpsertUseCase *kycusecase.UpsertCountryRequirementUseCase
+	DeleteUseCase *kycusecase.DeleteCountryRequirementUseCase
+	// SnapshotUseCase and HistoryUseCase serve the point-in-time limit history.
+	SnapshotUseCase *kycusecase.GetLimitSnapshotUseCase
+	HistoryUseCase  *kycusecase.ListLimitHistoryUseCase
+	Logger          *slog.Logger
+}
+
+// KYCComplianceHandler lets compliance staff manage per-country KYC requirements and look up the
+// limits that applied to a user at any point in time.
+type KYCComplianceHandler struct {
+	listUC     *kycusecase.ListCountryRequirementsUseCase
+	upsertUC   *kycusecase.UpsertCountryRequirementUseCase
+	deleteUC   *kycusecase.DeleteCountryRequirementUseCase
+	snapshotUC *kycusecase.GetLimitSnapshotUseCase
+	historyUC  *kycusecase.ListLimitHistoryUseCase
+	logger     *slog.Logger
+}
+
+// NewKYCComplianceHandler constructs a KYCComplianceHandler.
+func NewKYCComplianceHandler(cfg KYCComplianceHandlerConfig) *KYCComplianceHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &KYCComplianceHandler{
+		listUC:     cfg.ListUseCase,
+		upsertUC:   cfg.UpsertUseCase,
+		deleteUC:   cfg.DeleteUseCase,
+		snapshotUC: cfg.SnapshotUseCase,
+		historyUC:  cfg.HistoryUseCase,
+		logger:     logger,
+	}
+}
+
+// Register attaches routes to the router. Callers must guard the router with support access checks.
+// The country code "*" addresses the default entry.
+func (h *KYCComplianceHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Get("/countries", h.handleList)
+	router.Put("/countries/:code", h.handleUpsert)
+	router.Delete("/countries/:code", h.handleDelete)
+	router.Get("/users/:userId/limits", h.handleLimitSnapshot)
+	router.Get("/users/:userId/limits/history", h.handleLimitHistory)
+}
+
+func (h *KYCComplianceHandler) handleList(c *fiber.Ctx) error {
+	if h.listUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "kyc compliance matrix not configured")
+	}
+
+	result, err := h.listUC.Execute(c.UserContext())
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"items": result})
+}
+
+func (h *KYCComplianceHandler) handleUpsert(c *fiber.Ctx) error {
+	if h.upsertUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "kyc compliance matrix not configured")
+	}
+
+	actorID, err := extractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var payload dto.KYCCountryRequirementRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid request payload")
+	}
+
+	result, err := h.upsertUC.Execute(c.UserContext(), kycusecase.UpsertCountryRequirementInput{
+		ActorID:     actorID.String(),
+		CountryCode: c.Params("code"),
+		Request:     payload,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *KYCComplianceHandler) handleDelete(c *fiber.Ctx) error {
+	if h.deleteUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "kyc compliance matrix not configured")
+	}
+
+	actorID, err := extractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.deleteUC.Execute(c.UserContext(), kycusecase.DeleteCountryRequirementInput{
+		ActorID:     actorID.String(),
+		CountryCode: c.Params("code"),
+	}); err != nil {
+		return respondError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *KYCComplianceHandler) handleLimitSnapshot(c *fiber.Ctx) error {
+	if h.snapshotUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "kyc limit history not configured")
+	}
+
+	at, err := parseOptionalTime(c.Query("at"))
+	if err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "at must be a valid RFC3339 datetime"))
+	}
+
+	result, err := h.snapshotUC.Execute(c.UserContext(), kycusecase.GetLimitSnapshotInput{
+		UserID: c.Params("userId"),
+		At:     at,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *KYCComplianceHandler) handleLimitHistory(c *fiber.Ctx) error {
+	if h.historyUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "kyc limit history not configured")
+	}
+
+	from, err := parseOptionalTime(c.Query("from"))
+	if err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "from must be a valid RFC3339 datetime"))
+	}
+	to, err := parseOptionalTime(c.Query("to"))
+	if err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "to must be a valid RFC3339 datetime"))
+	}
+
+	result, err := h.historyUC.Execute(c.UserContext(), kycusecase.ListLimitHistoryInput{
+		UserID: c.Params("userId"),
+		From:   from,
+		To:     to,
+		Limit:  c.QueryInt("limit", 0),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"items": result})
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"log/slog"
+	"mime"
+
+	"github.com/gofiber/fiber/v2"
+
+	kycusecase "github.com/crypto-wallet/backend/internal/application/usecases/kyc"
+)
+
+// KYCDocumentDownloadHandlerConfig configures the KYC document download handler.
+type KYCDocumentDownloadHandlerConfig struct {
+	UseCase *kycusecase.DownloadDocumentUseCase
+	Logger  *slog.Logger
+}
+
+// KYCDocumentDownloadTokenHeader carries a document download token. Browsers following a link
+// send it as the token query parameter instead.
+const KYCDocumentDownloadTokenHeader = "X-Document-Token"
+
+// KYCDocumentDownloadHandler streams decrypted KYC documents to holders of a download link issued
+// to a compliance reviewer. The short-lived link token is the only credential.
+type KYCDocumentDownloadHandler struct {
+	useCase *kycusecase.DownloadDocumentUseCase
+	logger  *slog.Logger
+}
+
+// NewKYCDocumentDownloadHandler constructs a KYCDocumentDownloadHandler.
+func NewKYCDocumentDownloadHandler(cfg KYCDocumentDownloadHandlerConfig) *KYCDocumentDownloadHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &KYCDocumentDownloadHandler{
+		useCase: cfg.UseCase,
+		logger:  logger,
+	}
+}
+
+// Register attaches routes to the router. Routes must be mounted outside the authenticated group.
+func (h *KYCDocumentDownloadHandler) Register(router fiber.Router) {
+	if h == nil || router == nil || h.useCase == nil {
+		return
+	}
+
+	router.Get("/download", h.handleDownload)
+}
+
+func (h *KYCDocumentDownloadHandler) handleDownload(c *fiber.Ctx) error {
+	// The link URL carries the token, so keep it out of caches and Referer headers.
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	c.Set(fiber.HeaderReferrerPolicy, "no-referrer")
+
+	token := c.Get(KYCDocumentDownloadTokenHeader)
+	if token == "" {
+		token = c.Query("token")
+	}
+
+	download, err := h.useCase.Execute(c.UserContext(), token)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, download.MimeType)
+	c.Set(fiber.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": download.FileName}))
+	c.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+	return c.SendStream(download.Content, int(download.Size))
+}
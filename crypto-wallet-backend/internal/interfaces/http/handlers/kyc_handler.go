@@ -10,16 +10,22 @@ import (
 
 	"github.com/crypto-wallet/backend/internal/application/dto"
 	kycusecase "github.com/crypto-wallet/backend/internal/application/usecases/kyc"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
 	"github.com/crypto-wallet/backend/internal/interfaces/http/middleware"
-	"github.com/crypto-wallet/backend/pkg/utils"
 )
 
 // KYCHandler wires KYC-related use cases to HTTP endpoints.
 type KYCHandler struct {
-	submitUC *kycusecase.SubmitKYCUseCase
-	uploadUC *kycusecase.UploadDocumentUseCase
-	statusUC *kycusecase.GetKYCStatusUseCase
-	logger   *slog.Logger
+	submitUC        *kycusecase.SubmitKYCUseCase
+	uploadUC        *kycusecase.UploadDocumentUseCase
+	statusUC        *kycusecase.GetKYCStatusUseCase
+	startUpgradeUC  *kycusecase.StartUpgradeUseCase
+	upgradeStatusUC *kycusecase.GetUpgradeStatusUseCase
+	submitUpgradeUC *kycusecase.SubmitUpgradeUseCase
+	requirementsUC  *kycusecase.GetKYCRequirementsUseCase
+	documentTypesUC *kycusecase.ListDocumentTypesUseCase
+	limitsUC        *kycusecase.GetLimitHeadroomUseCase
+	logger          *slog.Logger
 }
 
 // KYCHandlerConfig configures handler dependencies.
@@ -27,6 +33,14 @@ type KYCHandlerConfig struct {
 	SubmitUseCase *kycusecase.SubmitKYCUseCase
 	UploadUseCase *kycusecase.UploadDocumentUseCase
 	StatusUseCase *kycusecase.GetKYCStatusUseCase
+	// Upgrade use cases move an approved basic profile to full verification.
+	StartUpgradeUseCase  *kycusecase.StartUpgradeUseCase
+	UpgradeStatusUseCase *kycusecase.GetUpgradeStatusUseCase
+	SubmitUpgradeUseCase *kycusecase.SubmitUpgradeUseCase
+	RequirementsUseCase  *kycusecase.GetKYCRequirementsUseCase
+	DocumentTypesUseCase *kycusecase.ListDocumentTypesUseCase
+	// LimitsUseCase reports the remaining daily and monthly limit headroom.
+	LimitsUseCase *kycusecase.GetLimitHeadroomUseCase
 	Logger        *slog.Logger
 }
 
@@ -37,10 +51,16 @@ func NewKYCHandler(cfg KYCHandlerConfig) *KYCHandler {
 		logger = slog.Default()
 	}
 	return &KYCHandler{
-		submitUC: cfg.SubmitUseCase,
-		uploadUC: cfg.UploadUseCase,
-		statusUC: cfg.StatusUseCase,
-		logger:   logger,
+		submitUC:        cfg.SubmitUseCase,
+		uploadUC:        cfg.UploadUseCase,
+		statusUC:        cfg.StatusUseCase,
+		startUpgradeUC:  cfg.StartUpgradeUseCase,
+		upgradeStatusUC: cfg.UpgradeStatusUseCase,
+		submitUpgradeUC: cfg.SubmitUpgradeUseCase,
+		requirementsUC:  cfg.RequirementsUseCase,
+		documentTypesUC: cfg.DocumentTypesUseCase,
+		limitsUC:        cfg.LimitsUseCase,
+		logger:          logger,
 	}
 }
 
@@ -52,7 +72,15 @@ func (h *KYCHandler) Register(router fiber.Router) {
 
 	router.Post("/submit", h.handleSubmit)
 	router.Post("/documents", h.handleUploadDocument)
+	router.Get("/document-types", h.handleDocumentTypes)
 	router.Get("/status", h.handleStatus)
+	router.Get("/requirements", h.handleRequirements)
+	router.Get("/limits", h.handleLimits)
+
+	// Upgrade documents are uploaded through /documents like any other verification document.
+	router.Post("/upgrade", h.handleStartUpgrade)
+	router.Get("/upgrade", h.handleUpgradeStatus)
+	router.Post("/upgrade/submit", h.handleSubmitUpgrade)
 }
 
 func (h *KYCHandler) handleSubmit(c *fiber.Ctx) error {
@@ -71,7 +99,7 @@ func (h *KYCHandler) handleSubmit(c *fiber.Ctx) error {
 	}
 
 	result, err := h.submitUC.Execute(c.UserContext(), kycusecase.SubmitKYCInput{
-		UserID:  userID,
+		UserID:  userID.String(),
 		Payload: payload,
 		Email:   extractUserEmail(c),
 	})
@@ -98,7 +126,7 @@ func (h *KYCHandler) handleUploadDocument(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "document file is required")
 	}
 
-	if fileHeader.Size > 10*1024*1024 {
+	if fileHeader.Size > entities.KYCDocumentMaxUploadBytes {
 		return fiber.NewError(fiber.StatusBadRequest, "document file exceeds 10MB limit")
 	}
 
@@ -108,11 +136,12 @@ func (h *KYCHandler) handleUploadDocument(c *fiber.Ctx) error {
 	}
 
 	result, err := h.uploadUC.Execute(c.UserContext(), kycusecase.UploadDocumentInput{
-		UserID:       userID,
+		UserID:       userID.String(),
 		DocumentType: documentType,
 		FileName:     fileHeader.Filename,
 		MimeType:     fileHeader.Header.Get("Content-Type"),
 		Content:      content,
+		Side:         c.FormValue("side"),
 	})
 	if err != nil {
 		return respondError(c, err)
@@ -121,6 +150,26 @@ func (h *KYCHandler) handleUploadDocument(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusCreated).JSON(result)
 }
 
+func (h *KYCHandler) handleDocumentTypes(c *fiber.Ctx) error {
+	if h.documentTypesUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "kyc document types not configured")
+	}
+
+	locale := c.Query("locale")
+	if locale == "" {
+		locale = preferredLanguage(c.Get(fiber.HeaderAcceptLanguage))
+	}
+
+	result, err := h.documentTypesUC.Execute(c.UserContext(), kycusecase.ListDocumentTypesInput{
+		Locale: locale,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"items": result})
+}
+
 func (h *KYCHandler) handleStatus(c *fiber.Ctx) error {
 	if h.statusUC == nil {
 		return fiber.NewError(fiber.StatusNotImplemented, "kyc status not configured")
@@ -132,7 +181,109 @@ func (h *KYCHandler) handleStatus(c *fiber.Ctx) error {
 	}
 
 	result, err := h.statusUC.Execute(c.UserContext(), kycusecase.GetKYCStatusInput{
-		UserID: userID,
+		UserID: userID.String(),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *KYCHandler) handleStartUpgrade(c *fiber.Ctx) error {
+	if h.startUpgradeUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "kyc upgrade not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := h.startUpgradeUC.Execute(c.UserContext(), kycusecase.StartUpgradeInput{
+		UserID: userID.String(),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *KYCHandler) handleUpgradeStatus(c *fiber.Ctx) error {
+	if h.upgradeStatusUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "kyc upgrade not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := h.upgradeStatusUC.Execute(c.UserContext(), kycusecase.GetUpgradeStatusInput{
+		UserID: userID.String(),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *KYCHandler) handleLimits(c *fiber.Ctx) error {
+	if h.limitsUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "kyc limit enforcement not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := h.limitsUC.Execute(c.UserContext(), kycusecase.GetLimitHeadroomInput{
+		UserID: userID.String(),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *KYCHandler) handleSubmitUpgrade(c *fiber.Ctx) error {
+	if h.submitUpgradeUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "kyc upgrade not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := h.submitUpgradeUC.Execute(c.UserContext(), kycusecase.SubmitUpgradeInput{
+		UserID: userID.String(),
+		Email:  extractUserEmail(c),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(result)
+}
+
+func (h *KYCHandler) handleRequirements(c *fiber.Ctx) error {
+	if h.requirementsUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "kyc requirements not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := h.requirementsUC.Execute(c.UserContext(), kycusecase.GetKYCRequirementsInput{
+		UserID:  userID.String(),
+		Country: c.Query("country"),
 	})
 	if err != nil {
 		return respondError(c, err)
@@ -148,13 +299,25 @@ func readFileContent(fileHeader *multipart.FileHeader) ([]byte, error) {
 	}
 	defer file.Close()
 
-	content, err := io.ReadAll(io.LimitReader(file, 10*1024*1024))
+	content, err := io.ReadAll(io.LimitReader(file, entities.KYCDocumentMaxUploadBytes))
 	if err != nil {
 		return nil, err
 	}
 	return content, nil
 }
 
+// preferredLanguage returns the first language tag of an Accept-Language header.
+func preferredLanguage(header string) string {
+	first := strings.TrimSpace(strings.Split(header, ",")[0])
+	if idx := strings.Index(first, ";"); idx >= 0 {
+		first = first[:idx]
+	}
+	if first == "*" {
+		return ""
+	}
+	return strings.TrimSpace(first)
+}
+
 func extractUserEmail(c *fiber.Ctx) string {
 	claims := c.Locals(middleware.AuthContextKey)
 	switch value := claims.(type) {
@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	kycusecase "github.com/crypto-wallet/backend/internal/application/usecases/kyc"
+)
+
+// KYCReviewHandlerConfig configures the KYC review handler.
+type KYCReviewHandlerConfig struct {
+	ListUseCase    *kycusecase.ListProfilesForReviewUseCase
+	GetUseCase     *kycusecase.GetProfileForReviewUseCase
+	ApproveUseCase *kycusecase.ApproveProfileUseCase
+	RejectUseCase  *kycusecase.RejectProfileUseCase
+	UpgradeUseCase *kycusecase.UpgradeProfileLimitsUseCase
+	// DocumentLinkUseCase issues download links for stored documents.
+	DocumentLinkUseCase *kycusecase.CreateDocumentDownloadLinkUseCase
+	Logger              *slog.Logger
+}
+
+// KYCReviewHandler lets compliance staff work the KYC review queue.
+type KYCReviewHandler struct {
+	listUC    *kycusecase.ListProfilesForReviewUseCase
+	getUC     *kycusecase.GetProfileForReviewUseCase
+	approveUC *kycusecase.ApproveProfileUseCase
+	rejectUC  *kycusecase.RejectProfileUseCase
+	upgradeUC *kycusecase.UpgradeProfileLimitsUseCase
+	linkUC    *kycusecase.CreateDocumentDownloadLinkUseCase
+	logger    *slog.Logger
+}
+
+// NewKYCReviewHandler constructs a KYCReviewHandler.
+func NewKYCReviewHandler(cfg KYCReviewHandlerConfig) *KYCReviewHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &KYCReviewHandler{
+		listUC:    cfg.ListUseCase,
+		getUC:     cfg.GetUseCase,
+		approveUC: cfg.ApproveUseCase,
+		rejectUC:  cfg.RejectUseCase,
+		upgradeUC: cfg.UpgradeUseCase,
+		linkUC:    cfg.DocumentLinkUseCase,
+		logger:    logger,
+	}
+}
+
+// Register attaches routes to the router. Callers must guard the router with the compliance role check.
+func (h *KYCReviewHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Get("/profiles", h.handleList)
+	router.Get("/profiles/:userId", h.handleGet)
+	router.Post("/profiles/:userId/approve", h.handleApprove)
+	router.Post("/profiles/:userId/reject", h.handleReject)
+	router.Post("/profiles/:userId/upgrade", h.handleUpgrade)
+	router.Post("/profiles/:userId/documents/:documentId/download-link", h.handleDocumentLink)
+}
+
+func (h *KYCReviewHandler) handleList(c *fiber.Ctx) error {
+	if h.listUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "kyc review not configured")
+	}
+
+	result, err := h.listUC.Execute(c.UserContext(), kycusecase.ListProfilesForReviewInput{
+		Status: c.Query("status"),
+		Limit:  c.QueryInt("limit", 0),
+		Offset: c.QueryInt("offset", 0),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"items": result})
+}
+
+func (h *KYCReviewHandler) handleGet(c *fiber.Ctx) error {
+	if h.getUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "kyc review not configured")
+	}
+
+	actorID, err := extractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := h.getUC.Execute(c.UserContext(), kycusecase.ReviewProfileInput{
+		ActorID: actorID.String(),
+		UserID:  c.Params("userId"),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *KYCReviewHandler) handleApprove(c *fiber.Ctx) error {
+	if h.approveUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "kyc review not configured")
+	}
+
+	actorID, err := extractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var payload dto.KYCApproveRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&payload); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "invalid request payload")
+		}
+	}
+
+	result, err := h.approveUC.Execute(c.UserContext(), kycusecase.ApproveProfileInput{
+		ActorID: actorID.String(),
+		UserID:  c.Params("userId"),
+		Request: payload,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *KYCReviewHandler) handleReject(c *fiber.Ctx) error {
+	if h.rejectUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "kyc review not configured")
+	}
+
+	actorID, err := extractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var payload dto.KYCRejectRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid request payload")
+	}
+
+	result, err := h.rejectUC.Execute(c.UserContext(), kycusecase.RejectProfileInput{
+		ActorID: actorID.String(),
+		UserID:  c.Params("userId"),
+		Request: payload,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *KYCReviewHandler) handleUpgrade(c *fiber.Ctx) error {
+	if h.upgradeUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "kyc review not configured")
+	}
+
+	actorID, err := extractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var payload dto.KYCLimitUpgradeRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid request payload")
+	}
+
+	result, err := h.upgradeUC.Execute(c.UserContext(), kycusecase.UpgradeProfileLimitsInput{
+		ActorID: actorID.String(),
+		UserID:  c.Params("userId"),
+		Request: payload,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *KYCReviewHandler) handleDocumentLink(c *fiber.Ctx) error {
+	if h.linkUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "kyc document storage not configured")
+	}
+
+	actorID, err := extractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := h.linkUC.Execute(c.UserContext(), kycusecase.DocumentDownloadLinkInput{
+		ActorID:    actorID.String(),
+		UserID:     c.Params("userId"),
+		DocumentID: c.Params("documentId"),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(result)
+}
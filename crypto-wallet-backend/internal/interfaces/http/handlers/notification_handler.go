@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	notificationusecase "github.com/crypto-wallet/backend/internal/application/usecases/notification"
+)
+
+// NotificationHandlerConfig configures the in-app notification handler.
+type NotificationHandlerConfig struct {
+	ListUseCase     *notificationusecase.ListNotificationsUseCase
+	MarkReadUseCase *notificationusecase.MarkReadUseCase
+	Logger          *slog.Logger
+}
+
+// NotificationHandler exposes the authenticated user's notification inbox.
+type NotificationHandler struct {
+	listUC     *notificationusecase.ListNotificationsUseCase
+	markReadUC *notificationusecase.MarkReadUseCase
+	logger     *slog.Logger
+}
+
+// NewNotificationHandler constructs a NotificationHandler.
+func NewNotificationHandler(cfg NotificationHandlerConfig) *NotificationHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &NotificationHandler{
+		listUC:     cfg.ListUseCase,
+		markReadUC: cfg.MarkReadUseCase,
+		logger:     logger,
+	}
+}
+
+// Register attaches routes to the router.
+func (h *NotificationHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Get("/", h.handleList)
+	router.Patch("/:id/read", h.handleMarkRead)
+}
+
+func (h *NotificationHandler) handleList(c *fiber.Ctx) error {
+	if h.listUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "notifications not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.listUC.Execute(c.UserContext(), notificationusecase.ListNotificationsInput{
+		UserID:     userID,
+		UnreadOnly: c.QueryBool("unread"),
+		Limit:      parseQueryInt(c, "limit", 50),
+		Offset:     parseQueryInt(c, "offset", 0),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *NotificationHandler) handleMarkRead(c *fiber.Ctx) error {
+	if h.markReadUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "notifications not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	notificationID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.markReadUC.Execute(c.UserContext(), userID, notificationID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/interfaces/http/openapi"
+)
+
+// swaggerUIVersion pins the Swagger UI assets loaded from the CDN.
+const swaggerUIVersion = "5.17.14"
+
+// OpenAPIHandlerConfig configures the API description handler.
+type OpenAPIHandlerConfig struct {
+	Document *openapi.Document
+	// SwaggerUI serves an interactive explorer at /docs; enable it outside production only.
+	SwaggerUI bool
+	Logger    *slog.Logger
+}
+
+// OpenAPIHandler serves the OpenAPI document and, optionally, Swagger UI.
+type OpenAPIHandler struct {
+	document  *openapi.Document
+	spec      []byte
+	swaggerUI bool
+	logger    *slog.Logger
+}
+
+// NewOpenAPIHandler constructs an OpenAPIHandler. The document is rendered once.
+func NewOpenAPIHandler(cfg OpenAPIHandlerConfig) *OpenAPIHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	h := &OpenAPIHandler{
+		document:  cfg.Document,
+		swaggerUI: cfg.SwaggerUI,
+		logger:    logger,
+	}
+	if cfg.Document != nil {
+		spec, err := json.Marshal(cfg.Document)
+		if err != nil {
+			logger.Error("failed to render openapi document", slog.String("error", err.Error()))
+		}
+		h.spec = spec
+	}
+	return h
+}
+
+// Document returns the served OpenAPI document.
+func (h *OpenAPIHandler) Document() *openapi.Document {
+	return h.document
+}
+
+// Register attaches routes to the router.
+func (h *OpenAPIHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Get("/openapi.json", h.handleSpec)
+	if h.swaggerUI {
+		router.Get("/docs", h.handleSwaggerUI)
+	}
+}
+
+func (h *OpenAPIHandler) handleSpec(c *fiber.Ctx) error {
+	if h.spec == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "openapi document not configured")
+	}
+
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSONCharsetUTF8)
+	return c.Status(fiber.StatusOK).Send(h.spec)
+}
+
+func (h *OpenAPIHandler) handleSwaggerUI(c *fiber.Ctx) error {
+	if h.document == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "openapi document not configured")
+	}
+
+	// The page sits next to openapi.json, so a relative URL works under any prefix.
+	c.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+	return c.Status(fiber.StatusOK).SendString(fmt.Sprintf(swaggerUIPage,
+		html.EscapeString(h.document.Info.Title), swaggerUIVersion, swaggerUIVersion))
+}
+
+const swaggerUIPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>%s</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@%s/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@%s/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.onload = function () {
+      window.ui = SwaggerUIBundle({ url: "openapi.json", dom_id: "#swagger-ui" });
+    };
+  </script>
+</body>
+</html>
+`
@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	usecasep2p "github.com/crypto-wallet/backend/internal/application/usecases/p2p"
+)
+
+// P2PDisputeSupportHandlerConfig configures the support-facing dispute handler.
+type P2PDisputeSupportHandlerConfig struct {
+	ListUseCase    *usecasep2p.ListDisputesUseCase
+	GetUseCase     *usecasep2p.GetDisputeUseCase
+	HoldUseCase    *usecasep2p.HoldDisputeUseCase
+	ResolveUseCase *usecasep2p.ResolveDisputeUseCase
+	Logger         *slog.Logger
+}
+
+// P2PDisputeSupportHandler exposes the dispute review queue to support staff.
+type P2PDisputeSupportHandler struct {
+	listUC    *usecasep2p.ListDisputesUseCase
+	getUC     *usecasep2p.GetDisputeUseCase
+	holdUC    *usecasep2p.HoldDisputeUseCase
+	resolveUC *usecasep2p.ResolveDisputeUseCase
+	logger    *slog.Logger
+}
+
+// NewP2PDisputeSupportHandler constructs a P2PDisputeSupportHandler.
+func NewP2PDisputeSupportHandler(cfg P2PDisputeSupportHandlerConfig) *P2PDisputeSupportHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &P2PDisputeSupportHandler{
+		listUC:    cfg.ListUseCase,
+		getUC:     cfg.GetUseCase,
+		holdUC:    cfg.HoldUseCase,
+		resolveUC: cfg.ResolveUseCase,
+		logger:    logger,
+	}
+}
+
+// Register attaches routes to the router. Callers must guard the router with support access checks.
+func (h *P2PDisputeSupportHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Get("/", h.handleList)
+	router.Get("/:id", h.handleGet)
+	router.Post("/:id/hold", h.handleHold)
+	router.Post("/:id/resolve", h.handleResolve)
+}
+
+func (h *P2PDisputeSupportHandler) handleList(c *fiber.Ctx) error {
+	if h.listUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "p2p disputes not configured")
+	}
+
+	result, err := h.listUC.Execute(c.UserContext(), usecasep2p.ListDisputesInput{
+		Support: true,
+		Status:  c.Query("status"),
+		Limit:   parseQueryInt(c, "limit", 50),
+		Offset:  parseQueryInt(c, "offset", 0),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *P2PDisputeSupportHandler) handleGet(c *fiber.Ctx) error {
+	if h.getUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "p2p disputes not configured")
+	}
+
+	disputeID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.getUC.Execute(c.UserContext(), usecasep2p.GetDisputeInput{
+		DisputeID: disputeID,
+		Support:   true,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *P2PDisputeSupportHandler) handleHold(c *fiber.Ctx) error {
+	if h.holdUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "p2p disputes not configured")
+	}
+
+	actorID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	disputeID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.HoldP2PDisputeRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.holdUC.Execute(c.UserContext(), usecasep2p.HoldDisputeInput{
+		ActorID:   actorID,
+		DisputeID: disputeID,
+		Payload:   payload,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *P2PDisputeSupportHandler) handleResolve(c *fiber.Ctx) error {
+	if h.resolveUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "p2p disputes not configured")
+	}
+
+	actorID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	disputeID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.ResolveP2PDisputeRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.resolveUC.Execute(c.UserContext(), usecasep2p.ResolveDisputeInput{
+		ActorID:   actorID,
+		DisputeID: disputeID,
+		Payload:   payload,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
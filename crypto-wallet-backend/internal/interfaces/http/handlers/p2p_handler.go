@@ -0,0 +1,227 @@
+package handlers
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	usecasep2p "github.com/crypto-wallet/backend/internal/application/usecases/p2p"
+)
+
+// P2PHandlerConfig configures the P2P transfer HTTP handler.
+type P2PHandlerConfig struct {
+	SendUseCase  *usecasep2p.SendTransferUseCase
+	ClaimUseCase *usecasep2p.ClaimTransferUseCase
+	ListUseCase  *usecasep2p.ListTransfersUseCase
+	// Dispute use cases back the party-facing dispute endpoints.
+	OpenDisputeUseCase  *usecasep2p.OpenDisputeUseCase
+	GetDisputeUseCase   *usecasep2p.GetDisputeUseCase
+	ListDisputesUseCase *usecasep2p.ListDisputesUseCase
+	Logger              *slog.Logger
+}
+
+// P2PHandler exposes off-chain transfer endpoints.
+type P2PHandler struct {
+	sendUC         *usecasep2p.SendTransferUseCase
+	claimUC        *usecasep2p.ClaimTransferUseCase
+	listUC         *usecasep2p.ListTransfersUseCase
+	openDisputeUC  *usecasep2p.OpenDisputeUseCase
+	getDisputeUC   *usecasep2p.GetDisputeUseCase
+	listDisputesUC *usecasep2p.ListDisputesUseCase
+	logger         *slog.Logger
+}
+
+// NewP2PHandler constructs a P2PHandler.
+func NewP2PHandler(cfg P2PHandlerConfig) *P2PHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &P2PHandler{
+		sendUC:         cfg.SendUseCase,
+		claimUC:        cfg.ClaimUseCase,
+		listUC:         cfg.ListUseCase,
+		openDisputeUC:  cfg.OpenDisputeUseCase,
+		getDisputeUC:   cfg.GetDisputeUseCase,
+		listDisputesUC: cfg.ListDisputesUseCase,
+		logger:         logger,
+	}
+}
+
+// Register attaches routes to the router.
+func (h *P2PHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Post("/", h.handleSend)
+	router.Get("/", h.handleList)
+	router.Post("/claims", h.handleClaim)
+	router.Get("/disputes", h.handleListDisputes)
+	router.Get("/disputes/:id", h.handleGetDispute)
+	router.Post("/:id/disputes", h.handleOpenDispute)
+}
+
+func (h *P2PHandler) handleSend(c *fiber.Ctx) error {
+	if h.sendUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "p2p transfers not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.SendP2PTransferRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.sendUC.Execute(c.UserContext(), usecasep2p.SendTransferInput{
+		UserID:  userID,
+		Payload: payload,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(result)
+}
+
+func (h *P2PHandler) handleList(c *fiber.Ctx) error {
+	if h.listUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "p2p transfers not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.listUC.Execute(c.UserContext(), usecasep2p.ListTransfersInput{
+		UserID: userID,
+		Limit:  parseQueryInt(c, "limit", 50),
+		Offset: parseQueryInt(c, "offset", 0),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *P2PHandler) handleClaim(c *fiber.Ctx) error {
+	if h.claimUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "p2p claims not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.ClaimP2PTransferRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.claimUC.Execute(c.UserContext(), usecasep2p.ClaimTransferInput{
+		UserID:  userID,
+		Payload: payload,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *P2PHandler) handleOpenDispute(c *fiber.Ctx) error {
+	if h.openDisputeUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "p2p disputes not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	transferID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.OpenP2PDisputeRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.openDisputeUC.Execute(c.UserContext(), usecasep2p.OpenDisputeInput{
+		UserID:     userID,
+		TransferID: transferID,
+		Payload:    payload,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(result)
+}
+
+func (h *P2PHandler) handleListDisputes(c *fiber.Ctx) error {
+	if h.listDisputesUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "p2p disputes not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.listDisputesUC.Execute(c.UserContext(), usecasep2p.ListDisputesInput{
+		UserID: userID,
+		Limit:  parseQueryInt(c, "limit", 50),
+		Offset: parseQueryInt(c, "offset", 0),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *P2PHandler) handleGetDispute(c *fiber.Ctx) error {
+	if h.getDisputeUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "p2p disputes not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	disputeID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.getDisputeUC.Execute(c.UserContext(), usecasep2p.GetDisputeInput{
+		UserID:    userID,
+		DisputeID: disputeID,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func parsePathUUID(c *fiber.Ctx, key string) (uuid.UUID, error) {
+	id, err := uuid.Parse(c.Params(key))
+	if err != nil {
+		return uuid.Nil, fiber.NewError(fiber.StatusBadRequest, key+" must be a valid UUID")
+	}
+	return id, nil
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	kycusecase "github.com/crypto-wallet/backend/internal/application/usecases/kyc"
+	"github.com/crypto-wallet/backend/internal/interfaces/http/middleware"
+)
+
+// PartnerKYCHandlerConfig configures the partner KYC HTTP handler.
+type PartnerKYCHandlerConfig struct {
+	BatchStatusUseCase *kycusecase.GetPartnerKYCStatusesUseCase
+	Logger             *slog.Logger
+}
+
+// PartnerKYCHandler serves KYC status to white-label partners authenticated by API key.
+type PartnerKYCHandler struct {
+	batchStatusUC *kycusecase.GetPartnerKYCStatusesUseCase
+	logger        *slog.Logger
+}
+
+// NewPartnerKYCHandler constructs a PartnerKYCHandler.
+func NewPartnerKYCHandler(cfg PartnerKYCHandlerConfig) *PartnerKYCHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &PartnerKYCHandler{
+		batchStatusUC: cfg.BatchStatusUseCase,
+		logger:        logger,
+	}
+}
+
+// Register attaches routes to the router.
+func (h *PartnerKYCHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Post("/status", h.handleBatchStatus)
+}
+
+func (h *PartnerKYCHandler) handleBatchStatus(c *fiber.Ctx) error {
+	if h.batchStatusUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "partner kyc not configured")
+	}
+
+	partner, ok := middleware.PartnerFromContext(c)
+	if !ok {
+		return respondError(c, fiber.NewError(fiber.StatusUnauthorized, "partner authentication required"))
+	}
+
+	var payload dto.PartnerKYCStatusRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.batchStatusUC.Execute(c.UserContext(), kycusecase.GetPartnerKYCStatusesInput{
+		PartnerID: partner.ID,
+		Request:   payload,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
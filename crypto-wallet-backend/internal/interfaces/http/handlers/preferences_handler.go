@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	usecasepreferences "github.com/crypto-wallet/backend/internal/application/usecases/preferences"
+	"github.com/crypto-wallet/backend/internal/interfaces/http/middleware"
+)
+
+// PreferencesHandlerConfig configures the user preferences HTTP handler.
+type PreferencesHandlerConfig struct {
+	GetUseCase    *usecasepreferences.GetPreferencesUseCase
+	UpdateUseCase *usecasepreferences.UpdatePreferencesUseCase
+	Logger        *slog.Logger
+}
+
+// PreferencesHandler exposes the user preferences endpoints.
+type PreferencesHandler struct {
+	getUC    *usecasepreferences.GetPreferencesUseCase
+	updateUC *usecasepreferences.UpdatePreferencesUseCase
+	logger   *slog.Logger
+}
+
+// NewPreferencesHandler constructs a PreferencesHandler.
+func NewPreferencesHandler(cfg PreferencesHandlerConfig) *PreferencesHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &PreferencesHandler{
+		getUC:    cfg.GetUseCase,
+		updateUC: cfg.UpdateUseCase,
+		logger:   logger,
+	}
+}
+
+// Register attaches routes to the router.
+func (h *PreferencesHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Get("/me/preferences", h.GetPreferences)
+	router.Patch("/me/preferences", middleware.ValidateBody[dto.UpdatePreferencesRequest](), h.UpdatePreferences)
+}
+
+// GetPreferences handles GET /api/v1/users/me/preferences.
+func (h *PreferencesHandler) GetPreferences(c *fiber.Ctx) error {
+	if h.getUC == nil {
+		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "preferences not configured"))
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	preferences, err := h.getUC.Execute(c.UserContext(), userID)
+	if err != nil {
+		return respondError(c, err)
+	}
+	return c.JSON(preferences)
+}
+
+// UpdatePreferences handles PATCH /api/v1/users/me/preferences, changing only the fields sent.
+func (h *PreferencesHandler) UpdatePreferences(c *fiber.Ctx) error {
+	if h.updateUC == nil {
+		return respondError(c, fiber.NewError(fiber.StatusNotImplemented, "preferences not configured"))
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	req, err := middleware.BindBody[dto.UpdatePreferencesRequest](c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	preferences, err := h.updateUC.Execute(c.UserContext(), userID, *req)
+	if err != nil {
+		return respondError(c, err)
+	}
+	return c.JSON(preferences)
+}
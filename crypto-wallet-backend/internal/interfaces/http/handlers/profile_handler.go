@@ -0,0 +1,195 @@
+package handlers
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	usecaseprofile "github.com/crypto-wallet/backend/internal/application/usecases/profile"
+	"github.com/crypto-wallet/backend/internal/interfaces/http/middleware"
+)
+
+// ProfileHandlerConfig configures the payment profile HTTP handler.
+type ProfileHandlerConfig struct {
+	GetUseCase          *usecaseprofile.GetProfileUseCase
+	ClaimHandleUseCase  *usecaseprofile.ClaimHandleUseCase
+	UpdateUseCase       *usecaseprofile.UpdateProfileUseCase
+	LookupUseCase       *usecaseprofile.LookupHandleUseCase
+	AvailabilityUseCase *usecaseprofile.CheckAvailabilityUseCase
+	// LookupMaxRequests and LookupWindow bound handle lookups per user to prevent enumeration.
+	LookupMaxRequests int
+	LookupWindow      time.Duration
+	Logger            *slog.Logger
+}
+
+// ProfileHandler exposes handle and payment profile endpoints.
+type ProfileHandler struct {
+	getUC          *usecaseprofile.GetProfileUseCase
+	claimUC        *usecaseprofile.ClaimHandleUseCase
+	updateUC       *usecaseprofile.UpdateProfileUseCase
+	lookupUC       *usecaseprofile.LookupHandleUseCase
+	availabilityUC *usecaseprofile.CheckAvailabilityUseCase
+	lookupLimiter  fiber.Handler
+	logger         *slog.Logger
+}
+
+// NewProfileHandler constructs a ProfileHandler.
+func NewProfileHandler(cfg ProfileHandlerConfig) *ProfileHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if cfg.LookupMaxRequests <= 0 {
+		cfg.LookupMaxRequests = 30
+	}
+
+	// Key on the caller rather than the path so probing many handles shares one budget.
+	lookupLimiter := middleware.NewRateLimitMiddleware(middleware.RateLimitConfig{
+		Enabled:     true,
+		MaxRequests: cfg.LookupMaxRequests,
+		Window:      cfg.LookupWindow,
+		KeyGenerator: func(c *fiber.Ctx) string {
+			if userID, err := extractUserID(c); err == nil {
+				return "handle-lookup:" + userID.String()
+			}
+			return "handle-lookup:" + c.IP()
+		},
+	})
+
+	return &ProfileHandler{
+		getUC:          cfg.GetUseCase,
+		claimUC:        cfg.ClaimHandleUseCase,
+		updateUC:       cfg.UpdateUseCase,
+		lookupUC:       cfg.LookupUseCase,
+		availabilityUC: cfg.AvailabilityUseCase,
+		lookupLimiter:  lookupLimiter,
+		logger:         logger,
+	}
+}
+
+// Register attaches routes to the router.
+func (h *ProfileHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Get("/me", h.handleGet)
+	router.Put("/me", h.handleUpdate)
+	router.Put("/me/handle", h.handleClaimHandle)
+	router.Get("/handles/:handle", h.lookupLimiter, h.handleLookup)
+	router.Get("/handles/:handle/availability", h.lookupLimiter, h.handleAvailability)
+}
+
+func (h *ProfileHandler) handleGet(c *fiber.Ctx) error {
+	if h.getUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "payment profiles not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.getUC.Execute(c.UserContext(), userID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *ProfileHandler) handleUpdate(c *fiber.Ctx) error {
+	if h.updateUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "payment profiles not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.UpdatePaymentProfileRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.updateUC.Execute(c.UserContext(), usecaseprofile.UpdateProfileInput{
+		UserID:  userID,
+		Payload: payload,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *ProfileHandler) handleClaimHandle(c *fiber.Ctx) error {
+	if h.claimUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "payment profiles not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.ClaimHandleRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.claimUC.Execute(c.UserContext(), usecaseprofile.ClaimHandleInput{
+		UserID:  userID,
+		Payload: payload,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *ProfileHandler) handleLookup(c *fiber.Ctx) error {
+	if h.lookupUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "payment profiles not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.lookupUC.Execute(c.UserContext(), usecaseprofile.LookupHandleInput{
+		RequesterID: userID,
+		Handle:      c.Params("handle"),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *ProfileHandler) handleAvailability(c *fiber.Ctx) error {
+	if h.availabilityUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "payment profiles not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.availabilityUC.Execute(c.UserContext(), usecaseprofile.CheckAvailabilityInput{
+		UserID: userID,
+		Handle: c.Params("handle"),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/cache"
+	fiberutils "github.com/gofiber/fiber/v2/utils"
+
+	"github.com/crypto-wallet/backend/internal/application/usecases/rates"
+	"github.com/crypto-wallet/backend/internal/interfaces/http/middleware"
+)
+
+// PublicMarketHandlerConfig configures the unauthenticated market data handler.
+type PublicMarketHandlerConfig struct {
+	RatesUseCase *rates.GetCurrentRatesUseCase
+	PairsUseCase *rates.GetTradingPairsUseCase
+	// CacheTTL controls both the in-process response cache and the Cache-Control max-age.
+	CacheTTL time.Duration
+	// RateLimitMaxRequests and RateLimitWindow bound requests per client IP, separately from the API-wide limiter.
+	RateLimitMaxRequests int
+	RateLimitWindow      time.Duration
+	Logger               *slog.Logger
+}
+
+// PublicMarketHandler serves cached, read-only market data to anonymous clients.
+type PublicMarketHandler struct {
+	ratesUC *rates.GetCurrentRatesUseCase
+	pairsUC *rates.GetTradingPairsUseCase
+	limiter fiber.Handler
+	cache   fiber.Handler
+	logger  *slog.Logger
+}
+
+// NewPublicMarketHandler constructs a PublicMarketHandler.
+func NewPublicMarketHandler(cfg PublicMarketHandlerConfig) *PublicMarketHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = 30 * time.Second
+	}
+	if cfg.RateLimitMaxRequests <= 0 {
+		cfg.RateLimitMaxRequests = 60
+	}
+
+	return &PublicMarketHandler{
+		ratesUC: cfg.RatesUseCase,
+		pairsUC: cfg.PairsUseCase,
+		limiter: middleware.NewRateLimitMiddleware(middleware.RateLimitConfig{
+			Enabled:     true,
+			MaxRequests: cfg.RateLimitMaxRequests,
+			Window:      cfg.RateLimitWindow,
+			KeyGenerator: func(c *fiber.Ctx) string {
+				return "public-market:" + c.IP()
+			},
+		}),
+		// Key on the full URL so query variants (e.g. ?symbols=) are cached independently.
+		cache: cache.New(cache.Config{
+			Expiration:   cfg.CacheTTL,
+			CacheControl: true,
+			KeyGenerator: func(c *fiber.Ctx) string {
+				return fiberutils.CopyString(strings.ToUpper(c.OriginalURL()))
+			},
+		}),
+		logger: logger,
+	}
+}
+
+// Register attaches routes to the router. Routes must be mounted outside the authenticated group.
+func (h *PublicMarketHandler) Register(router fiber.Router) {
+	if h == nil || router == nil {
+		return
+	}
+
+	router.Use(h.limiter, h.cache)
+
+	if h.ratesUC != nil {
+		router.Get("/rates", h.handleRates)
+	}
+	if h.pairsUC != nil {
+		router.Get("/pairs", h.handlePairs)
+	}
+}
+
+func (h *PublicMarketHandler) handleRates(c *fiber.Ctx) error {
+	var symbols []string
+	if param := c.Query("symbols"); param != "" {
+		symbols = strings.Split(param, ",")
+	}
+
+	result, err := h.ratesUC.Execute(c.UserContext(), rates.GetCurrentRatesInput{Symbols: symbols})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *PublicMarketHandler) handlePairs(c *fiber.Ctx) error {
+	result, err := h.pairsUC.Execute(c.UserContext())
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
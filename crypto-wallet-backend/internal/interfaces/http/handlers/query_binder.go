@@ -0,0 +1,228 @@
+package handlers
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// ListQuerySpec declares the pagination and sorting parameters a list endpoint accepts.
+type ListQuerySpec struct {
+	// SortFields maps the accepted sort_by values to the repository sort keys. Endpoints without
+	// sorting leave it empty and reject sort_by.
+	SortFields   map[string]string
+	DefaultSort  string
+	DefaultOrder repositories.SortOrder
+	DefaultLimit int
+	// Caps are checked here when set; otherwise the use case applies its own.
+	Caps repositories.PaginationCaps
+	// Cursor accepts a keyset cursor from a previous page in place of offset.
+	Cursor bool
+}
+
+// QueryBinder reads typed query parameters. Invalid values are collected rather than returned one
+// at a time, so a single response lists every problem; call Err once binding is done.
+type QueryBinder struct {
+	c    *fiber.Ctx
+	errs utils.ValidationErrors
+}
+
+// NewQueryBinder returns a binder over the request's query string.
+func NewQueryBinder(c *fiber.Ctx) *QueryBinder {
+	return &QueryBinder{c: c}
+}
+
+// String returns the trimmed value of the first key present, so renamed parameters can keep their
+// old spelling as an alias.
+func (b *QueryBinder) String(keys ...string) string {
+	_, value := b.lookup(keys...)
+	return value
+}
+
+// Int returns the parameter as an integer, or fallback when it is absent.
+func (b *QueryBinder) Int(key string, fallback int) int {
+	value := b.String(key)
+	if value == "" {
+		return fallback
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		b.errs.Add(key, "must be an integer")
+		return fallback
+	}
+	return parsed
+}
+
+// Bool returns the parameter as a boolean, or nil when it is absent.
+func (b *QueryBinder) Bool(key string) *bool {
+	value := b.String(key)
+	if value == "" {
+		return nil
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		b.errs.Add(key, "must be true or false")
+		return nil
+	}
+	return &parsed
+}
+
+// UUID returns the parameter as a UUID, or nil when it is absent.
+func (b *QueryBinder) UUID(key string) *uuid.UUID {
+	value := b.String(key)
+	if value == "" {
+		return nil
+	}
+	parsed, err := uuid.Parse(value)
+	if err != nil {
+		b.errs.Add(key, "must be a valid UUID")
+		return nil
+	}
+	return &parsed
+}
+
+// Time returns the parameter as a UTC time, or nil when it is absent. RFC 3339 timestamps and
+// plain dates are accepted.
+func (b *QueryBinder) Time(key string) *time.Time {
+	value := b.String(key)
+	if value == "" {
+		return nil
+	}
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			parsed = parsed.UTC()
+			return &parsed
+		}
+	}
+	b.errs.Add(key, "must be an RFC 3339 timestamp or a YYYY-MM-DD date")
+	return nil
+}
+
+// Enum returns the allowed value matching the parameter case-insensitively, or "" when it is absent.
+func (b *QueryBinder) Enum(key string, allowed ...string) string {
+	value := b.String(key)
+	if value == "" {
+		return ""
+	}
+	for _, candidate := range allowed {
+		if strings.EqualFold(candidate, value) {
+			return candidate
+		}
+	}
+	b.errs.Add(key, "must be one of "+strings.Join(allowed, ", "))
+	return ""
+}
+
+// List binds limit, offset, cursor, sort_by and sort_order. The camelCase sortBy and sortOrder
+// spellings are accepted as aliases.
+func (b *QueryBinder) List(spec ListQuerySpec) repositories.ListOptions {
+	opts := repositories.ListOptions{
+		Limit:     spec.DefaultLimit,
+		SortBy:    spec.DefaultSort,
+		SortOrder: spec.DefaultOrder,
+		Caps:      spec.Caps,
+	}
+	if limit, ok := b.atLeast("limit", 1); ok {
+		opts.Limit = limit
+	}
+	if offset, ok := b.atLeast("offset", 0); ok {
+		opts.Offset = offset
+	}
+
+	if spec.Cursor {
+		if raw := b.String("cursor"); raw != "" {
+			cursor, err := repositories.ParsePageCursor(raw)
+			if err != nil {
+				b.errs.Add("cursor", "is not a valid cursor")
+			} else {
+				opts.After = &cursor
+			}
+		}
+	}
+
+	if key, value := b.lookup("sort_by", "sortBy"); value != "" {
+		sortKey, ok := spec.SortFields[strings.ToLower(value)]
+		switch {
+		case ok:
+			opts.SortBy = sortKey
+		case len(spec.SortFields) == 0:
+			b.errs.Add(key, "sorting is not supported")
+		default:
+			fields := make([]string, 0, len(spec.SortFields))
+			for field := range spec.SortFields {
+				fields = append(fields, field)
+			}
+			slices.Sort(fields)
+			b.errs.Add(key, "must be one of "+strings.Join(fields, ", "))
+		}
+	}
+
+	if key, value := b.lookup("sort_order", "sortOrder"); value != "" {
+		switch order := repositories.SortOrder(strings.ToUpper(value)); order {
+		case repositories.SortAscending, repositories.SortDescending:
+			opts.SortOrder = order
+		default:
+			b.errs.Add(key, "must be asc or desc")
+		}
+	}
+
+	if spec.Caps != (repositories.PaginationCaps{}) {
+		var capErr *repositories.PaginationCapError
+		if errors.As(opts.CheckCaps(), &capErr) {
+			b.errs.Add(capErr.Field, "must not exceed "+strconv.Itoa(capErr.Max))
+		}
+	}
+	return opts
+}
+
+// Err returns a 400 listing every invalid parameter, or nil when binding succeeded.
+func (b *QueryBinder) Err() error {
+	if b.errs.IsEmpty() {
+		return nil
+	}
+	return utils.NewAppError(
+		"VALIDATION_ERROR",
+		"invalid query parameters",
+		fiber.StatusBadRequest,
+		b.errs,
+		b.errs.ToDetails(),
+	)
+}
+
+// atLeast parses an integer parameter with a lower bound; ok is false when it is absent or invalid.
+func (b *QueryBinder) atLeast(key string, floor int) (int, bool) {
+	value := b.String(key)
+	if value == "" {
+		return 0, false
+	}
+	parsed, err := strconv.Atoi(value)
+	switch {
+	case err != nil:
+		b.errs.Add(key, "must be an integer")
+	case parsed < floor:
+		b.errs.Add(key, "must be at least "+strconv.Itoa(floor))
+	default:
+		return parsed, true
+	}
+	return 0, false
+}
+
+func (b *QueryBinder) lookup(keys ...string) (string, string) {
+	for _, key := range keys {
+		if value := strings.TrimSpace(b.c.Query(key)); value != "" {
+			return key, value
+		}
+	}
+	if len(keys) == 0 {
+		return "", ""
+	}
+	return keys[0], ""
+}
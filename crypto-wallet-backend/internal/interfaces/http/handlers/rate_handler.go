@@ -6,8 +6,8 @@ import (
 	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/compress"
 
-	"github.com/crypto-wallet/backend/internal/application/dto"
 	"github.com/crypto-wallet/backend/internal/application/usecases/rates"
 )
 
@@ -15,6 +15,9 @@ import (
 type RateHandler struct {
 	getCurrentRatesUseCase  *rates.GetCurrentRatesUseCase
 	getPriceHistoryUseCase  *rates.GetPriceHistoryUseCase
+	getCandlesUseCase       *rates.GetCandlesUseCase
+	getSymbolCatalogUseCase *rates.GetSymbolCatalogUseCase
+	getSparklinesUseCase    *rates.GetSparklinesUseCase
 	logger                  *slog.Logger
 }
 
@@ -22,6 +25,9 @@ type RateHandler struct {
 func NewRateHandler(
 	getCurrentRatesUseCase *rates.GetCurrentRatesUseCase,
 	getPriceHistoryUseCase *rates.GetPriceHistoryUseCase,
+	getCandlesUseCase *rates.GetCandlesUseCase,
+	getSymbolCatalogUseCase *rates.GetSymbolCatalogUseCase,
+	getSparklinesUseCase *rates.GetSparklinesUseCase,
 	logger *slog.Logger,
 ) *RateHandler {
 	if logger == nil {
@@ -30,10 +36,39 @@ func NewRateHandler(
 	return &RateHandler{
 		getCurrentRatesUseCase:  getCurrentRatesUseCase,
 		getPriceHistoryUseCase:  getPriceHistoryUseCase,
+		getCandlesUseCase:       getCandlesUseCase,
+		getSymbolCatalogUseCase: getSymbolCatalogUseCase,
+		getSparklinesUseCase:    getSparklinesUseCase,
 		logger:                  logger,
 	}
 }
 
+// Register wires rate routes into the provided router.
+func (h *RateHandler) Register(router fiber.Router) {
+	if h == nil || router == nil {
+		return
+	}
+
+	// Chart payloads are large and highly repetitive, so compress them.
+	router.Use(compress.New(compress.Config{Level: compress.LevelBestSpeed}))
+
+	if h.getCurrentRatesUseCase != nil {
+		router.Get("/", h.GetRates)
+	}
+	if h.getPriceHistoryUseCase != nil {
+		router.Get("/history", h.GetPriceHistory)
+	}
+	if h.getSymbolCatalogUseCase != nil {
+		router.Get("/symbols", h.GetSymbols)
+	}
+	if h.getSparklinesUseCase != nil {
+		router.Get("/sparklines", h.GetSparklines)
+	}
+	if h.getCandlesUseCase != nil {
+		router.Get("/:symbol/candles", h.GetCandles)
+	}
+}
+
 // GetRates handles GET /v1/rates - Get current exchange rates.
 func (h *RateHandler) GetRates(c *fiber.Ctx) error {
 	// Parse symbols query parameter
@@ -47,7 +82,7 @@ func (h *RateHandler) GetRates(c *fiber.Ctx) error {
 		Symbols: symbols,
 	}
 
-	result, err := h.getCurrentRatesUseCase.Execute(c.Context(), input)
+	result, err := h.getCurrentRatesUseCase.Execute(c.UserContext(), input)
 	if err != nil {
 		return err
 	}
@@ -83,10 +118,81 @@ func (h *RateHandler) GetPriceHistory(c *fiber.Ctx) error {
 		}
 	}
 
-	result, err := h.getPriceHistoryUseCase.Execute(c.Context(), input)
+	result, err := h.getPriceHistoryUseCase.Execute(c.UserContext(), input)
 	if err != nil {
 		return err
 	}
 
 	return c.JSON(result)
 }
+
+// GetCandles handles GET /v1/rates/:symbol/candles - Get gap-filled OHLC candles.
+func (h *RateHandler) GetCandles(c *fiber.Ctx) error {
+	input := rates.GetCandlesInput{
+		Symbol:   c.Params("symbol"),
+		Interval: c.Query("interval"),
+	}
+
+	from, err := parseOptionalTime(c.Query("from"))
+	if err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "from must be a valid RFC3339 datetime"))
+	}
+	input.From = from
+
+	to, err := parseOptionalTime(c.Query("to"))
+	if err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "to must be a valid RFC3339 datetime"))
+	}
+	input.To = to
+
+	result, err := h.getCandlesUseCase.Execute(c.UserContext(), input)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return streamJSONList(c, fiber.StatusOK, h.logger, "candles", result.Candles,
+		jsonField{key: "symbol", value: result.Symbol},
+		jsonField{key: "interval", value: result.Interval},
+		jsonField{key: "requested_interval", value: result.RequestedInterval},
+		jsonField{key: "from", value: result.From},
+		jsonField{key: "to", value: result.To},
+	)
+}
+
+// GetSymbols handles GET /v1/rates/symbols - List chartable symbols and intervals.
+func (h *RateHandler) GetSymbols(c *fiber.Ctx) error {
+	result, err := h.getSymbolCatalogUseCase.Execute(c.UserContext())
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(result)
+}
+
+// GetSparklines handles GET /v1/rates/sparklines - Get 7-day hourly sparklines for several symbols.
+func (h *RateHandler) GetSparklines(c *fiber.Ctx) error {
+	var symbols []string
+	if symbolsParam := c.Query("symbols"); symbolsParam != "" {
+		symbols = strings.Split(symbolsParam, ",")
+	}
+
+	result, err := h.getSparklinesUseCase.Execute(c.UserContext(), rates.GetSparklinesInput{
+		Symbols: symbols,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(result)
+}
+
+func parseOptionalTime(value string) (*time.Time, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
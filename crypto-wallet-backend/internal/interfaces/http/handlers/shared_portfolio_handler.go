@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	analyticsusecase "github.com/crypto-wallet/backend/internal/application/usecases/analytics"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/internal/interfaces/http/middleware"
+)
+
+// SharedPortfolioHandlerConfig configures the share link handler.
+type SharedPortfolioHandlerConfig struct {
+	UseCase *analyticsusecase.SharedPortfolioUseCase
+	// RateLimitMaxRequests and RateLimitWindow bound requests per client IP, separately from the API-wide limiter.
+	RateLimitMaxRequests int
+	RateLimitWindow      time.Duration
+	Logger               *slog.Logger
+}
+
+// SharedPortfolioTokenHeader carries the share link token. It is sent as a header rather than in
+// the path so it stays out of request logs.
+const SharedPortfolioTokenHeader = "X-Share-Token"
+
+// SharedPortfolioHandler serves read-only portfolio views to holders of a share link. The link
+// token is the only credential.
+type SharedPortfolioHandler struct {
+	useCase *analyticsusecase.SharedPortfolioUseCase
+	limiter fiber.Handler
+	logger  *slog.Logger
+}
+
+// NewSharedPortfolioHandler constructs a SharedPortfolioHandler.
+func NewSharedPortfolioHandler(cfg SharedPortfolioHandlerConfig) *SharedPortfolioHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if cfg.RateLimitMaxRequests <= 0 {
+		cfg.RateLimitMaxRequests = 60
+	}
+
+	return &SharedPortfolioHandler{
+		useCase: cfg.UseCase,
+		limiter: middleware.NewRateLimitMiddleware(middleware.RateLimitConfig{
+			Enabled:     true,
+			MaxRequests: cfg.RateLimitMaxRequests,
+			Window:      cfg.RateLimitWindow,
+			KeyGenerator: func(c *fiber.Ctx) string {
+				return "shared-portfolio:" + c.IP()
+			},
+		}),
+		logger: logger,
+	}
+}
+
+// Register attaches routes to the router. Routes must be mounted outside the authenticated group.
+func (h *SharedPortfolioHandler) Register(router fiber.Router) {
+	if h == nil || router == nil || h.useCase == nil {
+		return
+	}
+
+	router.Use(h.limiter, sharedPortfolioHeaders)
+	router.Get("/", h.handleSummary)
+	router.Get("/performance", h.handlePerformance)
+	router.Get("/transactions", h.handleTransactions)
+}
+
+func (h *SharedPortfolioHandler) handleSummary(c *fiber.Ctx) error {
+	result, err := h.useCase.Summary(c.UserContext(), sharedAccess(c), c.Query("currency"))
+	if err != nil {
+		return respondError(c, err)
+	}
+	return c.JSON(result)
+}
+
+func (h *SharedPortfolioHandler) handlePerformance(c *fiber.Ctx) error {
+	result, err := h.useCase.Performance(c.UserContext(), sharedAccess(c), c.Query("period", "30d"), c.Query("currency"))
+	if err != nil {
+		return respondError(c, err)
+	}
+	return c.JSON(result)
+}
+
+func (h *SharedPortfolioHandler) handleTransactions(c *fiber.Ctx) error {
+	result, err := h.useCase.Transactions(c.UserContext(), sharedAccess(c), repositories.ListOptions{
+		Limit:  parseQueryInt(c, "limit", 0),
+		Offset: parseQueryInt(c, "offset", 0),
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+	return c.JSON(result)
+}
+
+func sharedAccess(c *fiber.Ctx) analyticsusecase.SharedAccess {
+	return analyticsusecase.SharedAccess{
+		Token:     c.Get(SharedPortfolioTokenHeader),
+		IPAddress: c.IP(),
+		UserAgent: c.Get(fiber.HeaderUserAgent),
+	}
+}
+
+// sharedPortfolioHeaders keeps shared portfolio responses out of caches and stops the share page
+// URL, which carries the token, from leaking through the Referer header.
+func sharedPortfolioHeaders(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	c.Set(fiber.HeaderReferrerPolicy, "no-referrer")
+	return c.Next()
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/crypto-wallet/backend/internal/application/dto"
 	usecasetransaction "github.com/crypto-wallet/backend/internal/application/usecases/transaction"
 	"github.com/crypto-wallet/backend/internal/interfaces/http/middleware"
-	"github.com/crypto-wallet/backend/pkg/utils"
 )
 
 // TransactionHandlerConfig configures the transaction HTTP handler.
@@ -17,14 +16,30 @@ type TransactionHandlerConfig struct {
 	SendUseCase   *usecasetransaction.SendTransactionUseCase
 	ListUseCase   *usecasetransaction.ListTransactionsUseCase
 	StatusUseCase *usecasetransaction.GetTransactionStatusUseCase
+	SearchUseCase *usecasetransaction.SearchTransactionsUseCase
 	Logger        *slog.Logger
 }
 
+// transactionListQuery lists the sort fields the transaction repository supports. Listing continues
+// by cursor once offsets get deep.
+var transactionListQuery = ListQuerySpec{
+	SortFields: map[string]string{
+		"amount":        "amount",
+		"chain":         "chain",
+		"confirmations": "confirmations",
+		"created_at":    "created_at",
+		"status":        "status",
+	},
+	DefaultLimit: 50,
+	Cursor:       true,
+}
+
 // TransactionHandler exposes transaction-related endpoints.
 type TransactionHandler struct {
 	sendUC   *usecasetransaction.SendTransactionUseCase
 	listUC   *usecasetransaction.ListTransactionsUseCase
 	statusUC *usecasetransaction.GetTransactionStatusUseCase
+	searchUC *usecasetransaction.SearchTransactionsUseCase
 	logger   *slog.Logger
 }
 
@@ -38,6 +53,7 @@ func NewTransactionHandler(cfg TransactionHandlerConfig) *TransactionHandler {
 		sendUC:   cfg.SendUseCase,
 		listUC:   cfg.ListUseCase,
 		statusUC: cfg.StatusUseCase,
+		searchUC: cfg.SearchUseCase,
 		logger:   logger,
 	}
 }
@@ -50,6 +66,7 @@ func (h *TransactionHandler) Register(router fiber.Router) {
 
 	router.Post("/", h.handleSend)
 	router.Get("/", h.handleList)
+	router.Get("/search", middleware.ValidateQuery[dto.SearchTransactionsRequest](), h.handleSearch)
 	router.Get("/:id", h.handleStatusByID)
 	router.Get("/hash/:hash", h.handleStatusByHash)
 }
@@ -70,7 +87,7 @@ func (h *TransactionHandler) handleSend(c *fiber.Ctx) error {
 	}
 
 	result, err := h.sendUC.Execute(c.UserContext(), usecasetransaction.SendTransactionInput{
-		UserID:  userID,
+		UserID:  userID.String(),
 		Payload: payload,
 	})
 	if err != nil {
@@ -90,17 +107,53 @@ func (h *TransactionHandler) handleList(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "walletId query parameter is required")
 	}
 
-	limit := parseQueryInt(c, "limit", 50)
-	offset := parseQueryInt(c, "offset", 0)
-
-	result, err := h.listUC.Execute(c.UserContext(), usecasetransaction.ListTransactionsInput{
+	query := NewQueryBinder(c)
+	opts := query.List(transactionListQuery)
+	input := usecasetransaction.ListTransactionsInput{
 		WalletID:  walletID,
-		Status:    c.Query("status"),
-		Chain:     c.Query("chain"),
-		Limit:     limit,
-		Offset:    offset,
-		SortBy:    c.Query("sortBy"),
-		SortOrder: c.Query("sortOrder"),
+		Status:    query.String("status"),
+		Chain:     query.String("chain"),
+		Limit:     opts.Limit,
+		Offset:    opts.Offset,
+		SortBy:    opts.SortBy,
+		SortOrder: string(opts.SortOrder),
+		Cursor:    query.String("cursor"),
+	}
+	if err := query.Err(); err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.listUC.Execute(c.UserContext(), input)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return streamJSONList(c, fiber.StatusOK, h.logger, "items", result.Items,
+		jsonField{key: "total", value: result.Total},
+		jsonField{key: "limit", value: result.Limit},
+		jsonField{key: "offset", value: result.Offset},
+		jsonField{key: "nextCursor", value: result.NextCursor},
+	)
+}
+
+func (h *TransactionHandler) handleSearch(c *fiber.Ctx) error {
+	if h.searchUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "transaction search not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	req, err := middleware.BindQuery[dto.SearchTransactionsRequest](c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.searchUC.Execute(c.UserContext(), usecasetransaction.SearchTransactionsInput{
+		UserID:  userID,
+		Request: *req,
 	})
 	if err != nil {
 		return respondError(c, err)
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	usecasetransaction "github.com/crypto-wallet/backend/internal/application/usecases/transaction"
+)
+
+// TransactionNoteHandlerConfig configures the transaction note handler.
+type TransactionNoteHandlerConfig struct {
+	SyncUseCase *usecasetransaction.SyncTransactionNotesUseCase
+	Logger      *slog.Logger
+}
+
+// TransactionNoteHandler syncs end-to-end encrypted transaction notes across a user's devices.
+type TransactionNoteHandler struct {
+	syncUC *usecasetransaction.SyncTransactionNotesUseCase
+	logger *slog.Logger
+}
+
+// NewTransactionNoteHandler constructs a TransactionNoteHandler.
+func NewTransactionNoteHandler(cfg TransactionNoteHandlerConfig) *TransactionNoteHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &TransactionNoteHandler{
+		syncUC: cfg.SyncUseCase,
+		logger: logger,
+	}
+}
+
+// Register attaches routes to the router.
+func (h *TransactionNoteHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Get("/", h.handlePull)
+	router.Post("/sync", h.handleSync)
+}
+
+// handlePull returns the notes written after the cursor without pushing changes.
+func (h *TransactionNoteHandler) handlePull(c *fiber.Ctx) error {
+	if h.syncUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "transaction notes not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := h.syncUC.Execute(c.UserContext(), usecasetransaction.SyncTransactionNotesInput{
+		UserID: userID,
+		Payload: dto.SyncTransactionNotesRequest{
+			Cursor: int64(c.QueryInt("cursor", 0)),
+			Limit:  c.QueryInt("limit", 0),
+		},
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"changes":    result.Changes,
+		"nextCursor": result.NextCursor,
+		"hasMore":    result.HasMore,
+	})
+}
+
+func (h *TransactionNoteHandler) handleSync(c *fiber.Ctx) error {
+	if h.syncUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "transaction notes not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var payload dto.SyncTransactionNotesRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid request payload")
+	}
+
+	result, err := h.syncUC.Execute(c.UserContext(), usecasetransaction.SyncTransactionNotesInput{
+		UserID:  userID,
+		Payload: payload,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
@@ -2,7 +2,6 @@ package handlers
 
 import (
 	"log/slog"
-	"strconv"
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
@@ -18,7 +17,32 @@ type WalletHandlerConfig struct {
 	CreateUseCase  *usecasewallet.CreateWalletUseCase
 	ListUseCase    *usecasewallet.ListWalletsUseCase
 	BalanceUseCase *usecasewallet.GetWalletBalanceUseCase
-	Logger         *slog.Logger
+	ChainsUseCase  *usecasewallet.ListChainAvailabilityUseCase
+	BetaUseCase    *usecasewallet.SetBetaOptInUseCase
+	// Permission use cases manage members of shared wallets; routes reply 501 when unset.
+	ListPermissionsUseCase  *usecasewallet.ListWalletPermissionsUseCase
+	GrantPermissionUseCase  *usecasewallet.GrantWalletPermissionUseCase
+	RevokePermissionUseCase *usecasewallet.RevokeWalletPermissionUseCase
+	// HD use cases manage the user's mnemonic seed; routes reply 501 when unset.
+	HDStatusUseCase         *usecasewallet.GetHDWalletStatusUseCase
+	GenerateMnemonicUseCase *usecasewallet.GenerateMnemonicUseCase
+	ImportMnemonicUseCase   *usecasewallet.ImportMnemonicUseCase
+	ConfirmBackupUseCase    *usecasewallet.ConfirmMnemonicBackupUseCase
+	// Dormancy use cases report wallet activity and reactivate dormant wallets; routes reply 501
+	// when unset.
+	ActivityUseCase   *usecasewallet.GetWalletActivityUseCase
+	ReactivateUseCase *usecasewallet.ReactivateWalletUseCase
+	Logger            *slog.Logger
+}
+
+// walletListQuery lists the sort fields the wallet repository supports.
+var walletListQuery = ListQuerySpec{
+	SortFields: map[string]string{
+		"balance":    "balance",
+		"chain":      "chain",
+		"created_at": "created_at",
+		"label":      "label",
+	},
 }
 
 // WalletHandler exposes wallet-related endpoints.
@@ -26,6 +50,17 @@ type WalletHandler struct {
 	createUseCase  *usecasewallet.CreateWalletUseCase
 	listUseCase    *usecasewallet.ListWalletsUseCase
 	balanceUseCase *usecasewallet.GetWalletBalanceUseCase
+	chainsUseCase  *usecasewallet.ListChainAvailabilityUseCase
+	betaUseCase    *usecasewallet.SetBetaOptInUseCase
+	listPermsUC    *usecasewallet.ListWalletPermissionsUseCase
+	grantPermUC    *usecasewallet.GrantWalletPermissionUseCase
+	revokePermUC   *usecasewallet.RevokeWalletPermissionUseCase
+	hdStatusUC     *usecasewallet.GetHDWalletStatusUseCase
+	generateUC     *usecasewallet.GenerateMnemonicUseCase
+	importUC       *usecasewallet.ImportMnemonicUseCase
+	confirmUC      *usecasewallet.ConfirmMnemonicBackupUseCase
+	activityUC     *usecasewallet.GetWalletActivityUseCase
+	reactivateUC   *usecasewallet.ReactivateWalletUseCase
 	logger         *slog.Logger
 }
 
@@ -39,6 +74,17 @@ func NewWalletHandler(cfg WalletHandlerConfig) *WalletHandler {
 		createUseCase:  cfg.CreateUseCase,
 		listUseCase:    cfg.ListUseCase,
 		balanceUseCase: cfg.BalanceUseCase,
+		chainsUseCase:  cfg.ChainsUseCase,
+		betaUseCase:    cfg.BetaUseCase,
+		listPermsUC:    cfg.ListPermissionsUseCase,
+		grantPermUC:    cfg.GrantPermissionUseCase,
+		revokePermUC:   cfg.RevokePermissionUseCase,
+		hdStatusUC:     cfg.HDStatusUseCase,
+		generateUC:     cfg.GenerateMnemonicUseCase,
+		importUC:       cfg.ImportMnemonicUseCase,
+		confirmUC:      cfg.ConfirmBackupUseCase,
+		activityUC:     cfg.ActivityUseCase,
+		reactivateUC:   cfg.ReactivateUseCase,
 		logger:         logger,
 	}
 }
@@ -51,7 +97,19 @@ func (h *WalletHandler) Register(router fiber.Router) {
 
 	router.Get("/", h.handleListWallets)
 	router.Post("/", h.handleCreateWallet)
+	router.Get("/chains", h.handleListChains)
+	router.Put("/chains/:chain/beta", h.handleSetBetaOptIn(true))
+	router.Delete("/chains/:chain/beta", h.handleSetBetaOptIn(false))
+	router.Get("/hd", h.handleGetHDStatus)
+	router.Post("/hd/mnemonic", h.handleGenerateMnemonic)
+	router.Post("/hd/import", h.handleImportMnemonic)
+	router.Post("/hd/backup/confirm", h.handleConfirmMnemonicBackup)
 	router.Get("/:id/balance", h.handleGetBalance)
+	router.Get("/:id/activity", h.handleGetActivity)
+	router.Post("/:id/reactivate", h.handleReactivate)
+	router.Get("/:id/permissions", h.handleListPermissions)
+	router.Put("/:id/permissions/:userId", h.handleGrantPermission)
+	router.Delete("/:id/permissions/:userId", h.handleRevokePermission)
 }
 
 func (h *WalletHandler) handleListWallets(c *fiber.Ctx) error {
@@ -64,17 +122,19 @@ func (h *WalletHandler) handleListWallets(c *fiber.Ctx) error {
 		return h.respondError(c, err)
 	}
 
-	limit := parseIntWithDefault(c.Query("limit"), 0)
-	offset := parseIntWithDefault(c.Query("offset"), 0)
-
+	query := NewQueryBinder(c)
+	opts := query.List(walletListQuery)
 	input := usecasewallet.ListWalletsInput{
 		UserID:    userID,
-		Chain:     c.Query("chain"),
-		Status:    c.Query("status"),
-		Limit:     limit,
-		Offset:    offset,
-		SortBy:    c.Query("sort_by"),
-		SortOrder: c.Query("sort_order"),
+		Chain:     query.String("chain"),
+		Status:    query.String("status"),
+		Limit:     opts.Limit,
+		Offset:    opts.Offset,
+		SortBy:    opts.SortBy,
+		SortOrder: string(opts.SortOrder),
+	}
+	if err := query.Err(); err != nil {
+		return h.respondError(c, err)
 	}
 
 	result, err := h.listUseCase.Execute(c.UserContext(), input)
@@ -117,7 +177,13 @@ func (h *WalletHandler) handleGetBalance(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusNotImplemented, "wallet balance not configured")
 	}
 
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		return h.respondError(c, err)
+	}
+
 	input := usecasewallet.GetWalletBalanceInput{
+		UserID:   userID,
 		WalletID: c.Params("id"),
 	}
 
@@ -129,8 +195,252 @@ func (h *WalletHandler) handleGetBalance(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(result)
 }
 
+func (h *WalletHandler) handleListChains(c *fiber.Ctx) error {
+	if h.chainsUseCase == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "chain availability not configured")
+	}
+
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		return h.respondError(c, err)
+	}
+
+	result, err := h.chainsUseCase.Execute(c.UserContext(), userID)
+	if err != nil {
+		return h.respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"chains": result})
+}
+
+func (h *WalletHandler) handleSetBetaOptIn(optedIn bool) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if h.betaUseCase == nil {
+			return fiber.NewError(fiber.StatusNotImplemented, "chain beta not configured")
+		}
+
+		userID, err := h.extractUserID(c)
+		if err != nil {
+			return h.respondError(c, err)
+		}
+
+		result, err := h.betaUseCase.Execute(c.UserContext(), usecasewallet.SetBetaOptInInput{
+			UserID:  userID,
+			Chain:   c.Params("chain"),
+			OptedIn: optedIn,
+		})
+		if err != nil {
+			return h.respondError(c, err)
+		}
+
+		return c.Status(fiber.StatusOK).JSON(result)
+	}
+}
+
+func (h *WalletHandler) handleListPermissions(c *fiber.Ctx) error {
+	if h.listPermsUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "wallet permissions not configured")
+	}
+
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		return h.respondError(c, err)
+	}
+
+	result, err := h.listPermsUC.Execute(c.UserContext(), usecasewallet.ListWalletPermissionsInput{
+		ActorID:  userID,
+		WalletID: c.Params("id"),
+	})
+	if err != nil {
+		return h.respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"items": result})
+}
+
+func (h *WalletHandler) handleGrantPermission(c *fiber.Ctx) error {
+	if h.grantPermUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "wallet permissions not configured")
+	}
+
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		return h.respondError(c, err)
+	}
+
+	var payload dto.WalletPermissionRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return h.respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.grantPermUC.Execute(c.UserContext(), usecasewallet.GrantWalletPermissionInput{
+		ActorID:  userID,
+		WalletID: c.Params("id"),
+		UserID:   c.Params("userId"),
+		Request:  payload,
+	})
+	if err != nil {
+		return h.respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *WalletHandler) handleRevokePermission(c *fiber.Ctx) error {
+	if h.revokePermUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "wallet permissions not configured")
+	}
+
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		return h.respondError(c, err)
+	}
+
+	if err := h.revokePermUC.Execute(c.UserContext(), usecasewallet.RevokeWalletPermissionInput{
+		ActorID:  userID,
+		WalletID: c.Params("id"),
+		UserID:   c.Params("userId"),
+	}); err != nil {
+		return h.respondError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *WalletHandler) handleGetHDStatus(c *fiber.Ctx) error {
+	if h.hdStatusUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "hd wallets not configured")
+	}
+
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		return h.respondError(c, err)
+	}
+
+	result, err := h.hdStatusUC.Execute(c.UserContext(), userID)
+	if err != nil {
+		return h.respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *WalletHandler) handleGenerateMnemonic(c *fiber.Ctx) error {
+	if h.generateUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "hd wallets not configured")
+	}
+
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		return h.respondError(c, err)
+	}
+
+	result, err := h.generateUC.Execute(c.UserContext(), userID)
+	if err != nil {
+		return h.respondError(c, err)
+	}
+
+	// The mnemonic is shown exactly once and must not linger in any cache.
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	return c.Status(fiber.StatusCreated).JSON(result)
+}
+
+func (h *WalletHandler) handleImportMnemonic(c *fiber.Ctx) error {
+	if h.importUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "hd wallets not configured")
+	}
+
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		return h.respondError(c, err)
+	}
+
+	var payload dto.MnemonicRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return h.respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.importUC.Execute(c.UserContext(), userID, payload)
+	if err != nil {
+		return h.respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(result)
+}
+
+func (h *WalletHandler) handleConfirmMnemonicBackup(c *fiber.Ctx) error {
+	if h.confirmUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "hd wallets not configured")
+	}
+
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		return h.respondError(c, err)
+	}
+
+	var payload dto.MnemonicRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return h.respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.confirmUC.Execute(c.UserContext(), userID, payload)
+	if err != nil {
+		return h.respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *WalletHandler) handleGetActivity(c *fiber.Ctx) error {
+	if h.activityUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "wallet dormancy not configured")
+	}
+
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		return h.respondError(c, err)
+	}
+
+	result, err := h.activityUC.Execute(c.UserContext(), usecasewallet.GetWalletActivityInput{
+		ActorID:  userID,
+		WalletID: c.Params("id"),
+	})
+	if err != nil {
+		return h.respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *WalletHandler) handleReactivate(c *fiber.Ctx) error {
+	if h.reactivateUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "wallet dormancy not configured")
+	}
+
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		return h.respondError(c, err)
+	}
+
+	var payload dto.ReactivateWalletRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return h.respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.reactivateUC.Execute(c.UserContext(), usecasewallet.ReactivateWalletInput{
+		ActorID:  userID,
+		WalletID: c.Params("id"),
+		Payload:  payload,
+	})
+	if err != nil {
+		return h.respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
 func (h *WalletHandler) respondError(c *fiber.Ctx, err error) error {
-	resp, status := utils.ToErrorResponse(err)
+	resp, status := utils.ToErrorResponse(utils.RequestContextError(c.UserContext(), err))
 	return c.Status(status).JSON(resp)
 }
 
@@ -174,14 +484,3 @@ func getString(value any) (string, bool) {
 		return "", false
 	}
 }
-
-func parseIntWithDefault(value string, fallback int) int {
-	if strings.TrimSpace(value) == "" {
-		return fallback
-	}
-	parsed, err := strconv.Atoi(value)
-	if err != nil {
-		return fallback
-	}
-	return parsed
-}
@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	usecasewebhook "github.com/crypto-wallet/backend/internal/application/usecases/webhook"
+	infrawebhook "github.com/crypto-wallet/backend/internal/infrastructure/webhook"
+)
+
+// WebhookHandlerConfig configures the webhook endpoint HTTP handler.
+type WebhookHandlerConfig struct {
+	RegisterUseCase     *usecasewebhook.RegisterEndpointUseCase
+	ListUseCase         *usecasewebhook.ListEndpointsUseCase
+	DeleteUseCase       *usecasewebhook.DeleteEndpointUseCase
+	TestDeliveryUseCase *usecasewebhook.SendTestDeliveryUseCase
+	VerifyUseCase       *usecasewebhook.VerifySignatureUseCase
+	Logger              *slog.Logger
+}
+
+// WebhookHandler exposes webhook endpoint management and signature tooling for integrators.
+type WebhookHandler struct {
+	registerUC     *usecasewebhook.RegisterEndpointUseCase
+	listUC         *usecasewebhook.ListEndpointsUseCase
+	deleteUC       *usecasewebhook.DeleteEndpointUseCase
+	testDeliveryUC *usecasewebhook.SendTestDeliveryUseCase
+	verifyUC       *usecasewebhook.VerifySignatureUseCase
+	logger         *slog.Logger
+}
+
+// NewWebhookHandler constructs a WebhookHandler.
+func NewWebhookHandler(cfg WebhookHandlerConfig) *WebhookHandler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &WebhookHandler{
+		registerUC:     cfg.RegisterUseCase,
+		listUC:         cfg.ListUseCase,
+		deleteUC:       cfg.DeleteUseCase,
+		testDeliveryUC: cfg.TestDeliveryUseCase,
+		verifyUC:       cfg.VerifyUseCase,
+		logger:         logger,
+	}
+}
+
+// Register attaches routes to the router.
+func (h *WebhookHandler) Register(router fiber.Router) {
+	if router == nil {
+		return
+	}
+
+	router.Get("/signature-schemes", h.handleSignatureSchemes)
+	router.Get("/endpoints", h.handleList)
+	router.Post("/endpoints", h.handleRegister)
+	router.Delete("/endpoints/:id", h.handleDelete)
+	router.Post("/endpoints/:id/test", h.handleTestDelivery)
+	router.Post("/endpoints/:id/verify", h.handleVerify)
+}
+
+func (h *WebhookHandler) handleSignatureSchemes(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"current":   infrawebhook.CurrentSignatureVersion,
+		"supported": infrawebhook.SupportedVersions(),
+		"headers": fiber.Map{
+			"id":               infrawebhook.HeaderID,
+			"event":            infrawebhook.HeaderEvent,
+			"timestamp":        infrawebhook.HeaderTimestamp,
+			"signature":        infrawebhook.HeaderSignature,
+			"signatureVersion": infrawebhook.HeaderSignatureVersion,
+		},
+		"schemes": fiber.Map{
+			infrawebhook.SignatureVersionV1: "hex(HMAC-SHA256(secret, timestamp + \".\" + raw body)), sent as v1=<digest>",
+		},
+	})
+}
+
+func (h *WebhookHandler) handleList(c *fiber.Ctx) error {
+	if h.listUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "webhooks not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.listUC.Execute(c.UserContext(), userID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"items": result})
+}
+
+func (h *WebhookHandler) handleRegister(c *fiber.Ctx) error {
+	if h.registerUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "webhooks not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.WebhookEndpointRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.registerUC.Execute(c.UserContext(), userID, payload)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(result)
+}
+
+func (h *WebhookHandler) handleDelete(c *fiber.Ctx) error {
+	if h.deleteUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "webhooks not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	endpointID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	if err := h.deleteUC.Execute(c.UserContext(), userID, endpointID); err != nil {
+		return respondError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *WebhookHandler) handleTestDelivery(c *fiber.Ctx) error {
+	if h.testDeliveryUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "webhooks not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	endpointID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	result, err := h.testDeliveryUC.Execute(c.UserContext(), userID, endpointID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (h *WebhookHandler) handleVerify(c *fiber.Ctx) error {
+	if h.verifyUC == nil {
+		return fiber.NewError(fiber.StatusNotImplemented, "webhooks not configured")
+	}
+
+	userID, err := extractUserID(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	endpointID, err := parsePathUUID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var payload dto.WebhookVerifyRequest
+	if err := c.BodyParser(&payload); err != nil {
+		return respondError(c, fiber.NewError(fiber.StatusBadRequest, "invalid request payload"))
+	}
+
+	result, err := h.verifyUC.Execute(c.UserContext(), userID, endpointID, payload)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
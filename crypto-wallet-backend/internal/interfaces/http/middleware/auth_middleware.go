@@ -1,7 +1,8 @@
 package middleware
 
 import (
-	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"log/slog"
 	"strings"
 
@@ -15,12 +16,19 @@ import (
 // AuthContextKey is the default key used to store JWT claims in the Fiber context.
 const AuthContextKey = "auth.claims"
 
+// SessionContextKey stores a fingerprint of the request's access token, which identifies the
+// session for step-up authentication without keeping the token itself.
+const SessionContextKey = "auth.session"
+
 // AuthConfig configures the authentication middleware.
 type AuthConfig struct {
 	JWTService *security.JWTService
 	Logger     *slog.Logger
 	ContextKey string
 	Skipper    func(*fiber.Ctx) bool
+	// CookieName, when set, names the cookie holding the access token in cookie-auth mode. It is
+	// only read when the request has no Authorization header.
+	CookieName string
 }
 
 // NewAuthMiddleware builds a Fiber middleware that validates JWT bearer tokens.
@@ -41,14 +49,20 @@ func NewAuthMiddleware(cfg AuthConfig) fiber.Handler {
 			return c.Next()
 		}
 
-		token, err := extractBearerToken(c.Get(fiber.HeaderAuthorization))
+		header := c.Get(fiber.HeaderAuthorization)
+		token, err := extractBearerToken(header)
+		if err != nil && cfg.CookieName != "" && strings.TrimSpace(header) == "" {
+			if cookie := strings.TrimSpace(c.Cookies(cfg.CookieName)); cookie != "" {
+				token, err = cookie, nil
+			}
+		}
 		if err != nil {
 			cfg.Logger.Warn("authorization header invalid", slog.String("error", err.Error()))
 			resp, status := utils.ToErrorResponse(fiber.NewError(fiber.StatusUnauthorized, err.Error()))
 			return c.Status(status).JSON(resp)
 		}
 
-        claims, err := cfg.JWTService.Parse(context.Background(), token)
+        claims, err := cfg.JWTService.Parse(c.UserContext(), token)
         if err != nil {
             cfg.Logger.Warn("token validation failed",
                 slog.String("error", err.Error()),
@@ -59,6 +73,8 @@ func NewAuthMiddleware(cfg AuthConfig) fiber.Handler {
         }
 
 		c.Locals(contextKey, claims)
+		fingerprint := sha256.Sum256([]byte(token))
+		c.Locals(SessionContextKey, hex.EncodeToString(fingerprint[:16]))
 
 		subject := strings.TrimSpace(claims.Subject)
 		if subject != "" {
@@ -0,0 +1,172 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// Default cookie and header names used in cookie-auth mode.
+const (
+	DefaultAccessCookieName  = "access_token"
+	DefaultRefreshCookieName = "refresh_token"
+	DefaultCSRFCookieName    = "csrf_token"
+	DefaultCSRFHeaderName    = "X-CSRF-Token"
+)
+
+// CookieAuthConfig configures cookie-auth mode, where tokens travel in httpOnly cookies instead of
+// response bodies and state-changing requests must echo a double-submit CSRF token.
+type CookieAuthConfig struct {
+	Enabled bool
+	Domain  string
+	Secure  bool
+	// SameSite is Strict, Lax or None; None requires Secure.
+	SameSite string
+	// RefreshPath limits the refresh cookie to the token endpoints.
+	RefreshPath       string
+	AccessCookieName  string
+	RefreshCookieName string
+	CSRFCookieName    string
+	CSRFHeaderName    string
+}
+
+// WithDefaults fills unset names and normalises SameSite.
+func (c CookieAuthConfig) WithDefaults() CookieAuthConfig {
+	if strings.TrimSpace(c.AccessCookieName) == "" {
+		c.AccessCookieName = DefaultAccessCookieName
+	}
+	if strings.TrimSpace(c.RefreshCookieName) == "" {
+		c.RefreshCookieName = DefaultRefreshCookieName
+	}
+	if strings.TrimSpace(c.CSRFCookieName) == "" {
+		c.CSRFCookieName = DefaultCSRFCookieName
+	}
+	if strings.TrimSpace(c.CSRFHeaderName) == "" {
+		c.CSRFHeaderName = DefaultCSRFHeaderName
+	}
+	if strings.TrimSpace(c.RefreshPath) == "" {
+		c.RefreshPath = "/"
+	}
+
+	switch strings.ToLower(strings.TrimSpace(c.SameSite)) {
+	case "lax":
+		c.SameSite = fiber.CookieSameSiteLaxMode
+	case "none":
+		c.SameSite = fiber.CookieSameSiteNoneMode
+		c.Secure = true
+	default:
+		c.SameSite = fiber.CookieSameSiteStrictMode
+	}
+	return c
+}
+
+// IssueSession stores the token pair in httpOnly cookies alongside a fresh CSRF token, which is
+// readable by scripts so it can be echoed in the CSRF header. Returns the CSRF token.
+func (c CookieAuthConfig) IssueSession(ctx *fiber.Ctx, accessToken string, accessExpiresAt time.Time, refreshToken string, refreshExpiresAt time.Time) (string, error) {
+	csrfToken, err := newCSRFToken()
+	if err != nil {
+		return "", err
+	}
+
+	ctx.Cookie(c.cookie(c.AccessCookieName, accessToken, "/", accessExpiresAt, true))
+	if refreshToken != "" {
+		ctx.Cookie(c.cookie(c.RefreshCookieName, refreshToken, c.RefreshPath, refreshExpiresAt, true))
+	}
+	// The CSRF token lives as long as the session so refreshes can be protected too.
+	ctx.Cookie(c.cookie(c.CSRFCookieName, csrfToken, "/", refreshExpiresAt, false))
+	return csrfToken, nil
+}
+
+// ClearSession expires every session cookie.
+func (c CookieAuthConfig) ClearSession(ctx *fiber.Ctx) {
+	expired := time.Unix(0, 0)
+	ctx.Cookie(c.cookie(c.AccessCookieName, "", "/", expired, true))
+	ctx.Cookie(c.cookie(c.RefreshCookieName, "", c.RefreshPath, expired, true))
+	ctx.Cookie(c.cookie(c.CSRFCookieName, "", "/", expired, false))
+}
+
+// RefreshToken returns the refresh token cookie, if any.
+func (c CookieAuthConfig) RefreshToken(ctx *fiber.Ctx) string {
+	return strings.TrimSpace(ctx.Cookies(c.RefreshCookieName))
+}
+
+func (c CookieAuthConfig) cookie(name, value, path string, expires time.Time, httpOnly bool) *fiber.Cookie {
+	return &fiber.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     path,
+		Domain:   c.Domain,
+		Expires:  expires,
+		Secure:   c.Secure,
+		HTTPOnly: httpOnly,
+		SameSite: c.SameSite,
+	}
+}
+
+// usesCookies reports whether the request is authenticated by session cookies rather than a
+// bearer header. Bearer requests cannot be forged cross-site and are not checked.
+func (c CookieAuthConfig) usesCookies(ctx *fiber.Ctx) bool {
+	if strings.TrimSpace(ctx.Get(fiber.HeaderAuthorization)) != "" {
+		return false
+	}
+	return ctx.Cookies(c.AccessCookieName) != "" || ctx.Cookies(c.RefreshCookieName) != ""
+}
+
+// CSRFConfig configures the CSRF validation middleware.
+type CSRFConfig struct {
+	Cookies CookieAuthConfig
+	Logger  *slog.Logger
+}
+
+// NewCSRFMiddleware validates double-submit CSRF tokens on state-changing requests authenticated
+// by session cookies: the CSRF header must match the CSRF cookie. Safe methods pass through.
+func NewCSRFMiddleware(cfg CSRFConfig) fiber.Handler {
+	if cfg.Logger == nil {
+		cfg.Logger = slog.Default()
+	}
+	cookies := cfg.Cookies.WithDefaults()
+
+	return func(c *fiber.Ctx) error {
+		switch c.Method() {
+		case fiber.MethodGet, fiber.MethodHead, fiber.MethodOptions:
+			return c.Next()
+		}
+		if !cookies.usesCookies(c) {
+			return c.Next()
+		}
+
+		expected := c.Cookies(cookies.CSRFCookieName)
+		provided := strings.TrimSpace(c.Get(cookies.CSRFHeaderName))
+		if expected == "" || provided == "" || subtle.ConstantTimeCompare([]byte(expected), []byte(provided)) != 1 {
+			cfg.Logger.Warn("csrf token validation failed",
+				slog.String("method", c.Method()),
+				slog.String("path", c.Path()))
+			resp, status := utils.ToErrorResponse(utils.NewAppError(
+				"CSRF_TOKEN_INVALID",
+				"missing or invalid CSRF token",
+				fiber.StatusForbidden,
+				nil,
+				nil,
+			))
+			return c.Status(status).JSON(resp)
+		}
+
+		return c.Next()
+	}
+}
+
+func newCSRFToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate csrf token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
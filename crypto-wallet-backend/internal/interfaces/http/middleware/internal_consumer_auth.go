@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/application/usecases/internalevents"
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// InternalConsumerContextKey is the key used to store the authenticated internal consumer in the Fiber context.
+const InternalConsumerContextKey = "internal_consumer"
+
+// InternalConsumerAuthConfig configures the internal consumer middleware.
+type InternalConsumerAuthConfig struct {
+	Consumers repositories.InternalEventConsumerRepository
+	Logger    *slog.Logger
+}
+
+// NewInternalConsumerAuthMiddleware identifies internal consumers by the client certificate they
+// presented. The listener verifies the certificate chain during the TLS handshake; this middleware
+// maps the verified leaf certificate to a registered, active consumer.
+func NewInternalConsumerAuthMiddleware(cfg InternalConsumerAuthConfig) fiber.Handler {
+	authenticate := internalevents.NewAuthenticateConsumerUseCase(cfg.Consumers, cfg.Logger)
+
+	reject := func(c *fiber.Ctx) error {
+		resp, status := utils.ToErrorResponse(utils.NewAppError(
+			"INTERNAL_CONSUMER_AUTH_REQUIRED",
+			"registered client certificate required",
+			fiber.StatusUnauthorized,
+			nil,
+			nil,
+		))
+		return c.Status(status).JSON(resp)
+	}
+
+	return func(c *fiber.Ctx) error {
+		state := c.Context().TLSConnectionState()
+		if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+			return reject(c)
+		}
+
+		consumer, err := authenticate.Execute(c.UserContext(), state.VerifiedChains[0][0].Raw)
+		if err != nil {
+			return reject(c)
+		}
+
+		c.Locals(InternalConsumerContextKey, consumer)
+		return c.Next()
+	}
+}
+
+// InternalConsumerFromContext returns the consumer stored by the internal consumer middleware.
+func InternalConsumerFromContext(c *fiber.Ctx) (entities.InternalEventConsumer, bool) {
+	consumer, ok := c.Locals(InternalConsumerContextKey).(entities.InternalEventConsumer)
+	return consumer, ok
+}
@@ -1,7 +1,6 @@
 package middleware
 
 import (
-	"context"
 	"log/slog"
 	"strings"
 
@@ -63,7 +62,7 @@ func (e *KYCEnforcer) Require(level entities.VerificationLevel) fiber.Handler {
 			return c.Status(status).JSON(resp)
 		}
 
-		profile, err := e.repo.GetProfileByUserID(context.Background(), userID)
+		profile, err := e.repo.GetProfileByUserID(c.UserContext(), userID)
 		if err != nil {
 			resp, status := utils.ToErrorResponse(utils.NewAppError(
 				"KYC_PROFILE_REQUIRED",
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// RequestRecorder receives the outcome of every handled request.
+type RequestRecorder interface {
+	RecordRequest(method, route string, status int)
+}
+
+// NewMetricsMiddleware records each request against its route pattern so error rates can be tracked per route.
+func NewMetricsMiddleware(recorder RequestRecorder) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if recorder == nil {
+			return c.Next()
+		}
+
+		err := c.Next()
+
+		// The error handler has not run yet, so derive the status it will write.
+		status := c.Response().StatusCode()
+		if err != nil {
+			status = utils.HTTPStatusFromError(err)
+		}
+
+		recorder.RecordRequest(c.Method(), c.Route().Path, status)
+		return err
+	}
+}
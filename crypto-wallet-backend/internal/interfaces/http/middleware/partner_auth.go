@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/domain/repositories"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+const (
+	// PartnerContextKey is the key used to store the authenticated partner in the Fiber context.
+	PartnerContextKey = "partner"
+	// PartnerAPIKeyHeader carries the partner API key.
+	PartnerAPIKeyHeader = "X-API-Key"
+)
+
+// PartnerAuthConfig configures the partner API key middleware.
+type PartnerAuthConfig struct {
+	Partners repositories.PartnerRepository
+	Logger   *slog.Logger
+}
+
+// NewPartnerAuthMiddleware authenticates white-label partners by API key. It replaces, rather than
+// follows, the JWT auth middleware on partner routes.
+func NewPartnerAuthMiddleware(cfg PartnerAuthConfig) fiber.Handler {
+	if cfg.Logger == nil {
+		cfg.Logger = slog.Default()
+	}
+
+	reject := func(c *fiber.Ctx) error {
+		resp, status := utils.ToErrorResponse(utils.NewAppError(
+			"PARTNER_AUTH_REQUIRED",
+			"valid partner API key required",
+			fiber.StatusUnauthorized,
+			nil,
+			nil,
+		))
+		return c.Status(status).JSON(resp)
+	}
+
+	return func(c *fiber.Ctx) error {
+		key := strings.TrimSpace(c.Get(PartnerAPIKeyHeader))
+		if key == "" || cfg.Partners == nil {
+			return reject(c)
+		}
+
+		partner, err := cfg.Partners.GetByAPIKeyHash(c.UserContext(), entities.HashPartnerAPIKey(key))
+		if err != nil {
+			if !errors.Is(err, repositories.ErrNotFound) {
+				cfg.Logger.Error("partner lookup failed", slog.String("error", err.Error()))
+			}
+			cfg.Logger.Warn("partner authentication failed", slog.String("path", c.Path()))
+			return reject(c)
+		}
+		if !partner.IsActive {
+			cfg.Logger.Warn("inactive partner rejected", slog.String("partner_id", partner.ID.String()))
+			return reject(c)
+		}
+
+		c.Locals(PartnerContextKey, partner)
+		return c.Next()
+	}
+}
+
+// PartnerFromContext returns the partner stored by the partner auth middleware.
+func PartnerFromContext(c *fiber.Ctx) (entities.Partner, bool) {
+	partner, ok := c.Locals(PartnerContextKey).(entities.Partner)
+	return partner, ok
+}
@@ -1,22 +1,69 @@
 package middleware
 
 import (
+	"context"
+	"log/slog"
+	"math"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
-	"github.com/gofiber/fiber/v2/middleware/limiter"
+
+	"github.com/crypto-wallet/backend/internal/infrastructure/ratelimit"
 )
 
+// RateLimiterStore keeps the token buckets behind the rate limiter. Use a shared store such as
+// ratelimit.RedisStore when several instances serve traffic.
+type RateLimiterStore interface {
+	Allow(ctx context.Context, key string, limit ratelimit.Limit) (ratelimit.Decision, error)
+}
+
+// RouteRateLimit replaces the default limit for requests whose path starts with Prefix.
+type RouteRateLimit struct {
+	Prefix      string
+	MaxRequests int
+	Window      time.Duration
+}
+
 // RateLimitConfig configures the rate limiter middleware.
 type RateLimitConfig struct {
 	Enabled     bool
 	MaxRequests int
-	Window      time.Duration
+	// Burst is how many requests may arrive at once; it defaults to MaxRequests.
+	Burst        int
+	Window       time.Duration
 	ExcludePaths []string
+	// KeyGenerator overrides the default IP+path key, e.g. to limit a route family as a whole.
+	KeyGenerator func(c *fiber.Ctx) string
+	// Store defaults to an in-process store, whose limits apply per instance.
+	Store RateLimiterStore
+	// Scope namespaces keys when limiters share a store, e.g. "ip" and "user".
+	Scope string
+	// Routes set stricter limits on sensitive path prefixes; the longest matching prefix wins and
+	// is counted in its own bucket.
+	Routes []RouteRateLimit
+	Logger *slog.Logger
+}
+
+// RateLimitKeyByIP limits each client IP across all routes.
+func RateLimitKeyByIP(c *fiber.Ctx) string {
+	return "ip:" + c.IP()
 }
 
-// NewRateLimitMiddleware creates a rate limiting middleware with sensible defaults.
+// RateLimitKeyByUser limits each authenticated user across all routes and falls back to the client
+// IP before authentication.
+func RateLimitKeyByUser(c *fiber.Ctx) string {
+	if userID, ok := c.Locals("user_id").(string); ok && strings.TrimSpace(userID) != "" {
+		return "user:" + userID
+	}
+	return RateLimitKeyByIP(c)
+}
+
+// NewRateLimitMiddleware creates a token bucket rate limiting middleware with sensible defaults.
+// When the store fails, requests are let through so an unavailable Redis does not take the API
+// down with it.
 func NewRateLimitMiddleware(cfg RateLimitConfig) fiber.Handler {
 	if !cfg.Enabled {
 		return func(c *fiber.Ctx) error {
@@ -32,23 +79,74 @@ func NewRateLimitMiddleware(cfg RateLimitConfig) fiber.Handler {
 		cfg.Window = time.Minute
 	}
 
-	baseHandler := limiter.New(limiter.Config{
-		Max:        cfg.MaxRequests,
-		Expiration: cfg.Window,
-		KeyGenerator: func(c *fiber.Ctx) string {
+	if cfg.KeyGenerator == nil {
+		cfg.KeyGenerator = func(c *fiber.Ctx) string {
 			return c.IP() + ":" + c.Path()
-		},
-		LimitReached: func(c *fiber.Ctx) error {
-			return fiber.NewError(fiber.StatusTooManyRequests, "rate limit exceeded")
-		},
-	})
+		}
+	}
+
+	if cfg.Store == nil {
+		cfg.Store = ratelimit.NewMemoryStore()
+	}
+
+	if cfg.Logger == nil {
+		cfg.Logger = slog.Default()
+	}
+
+	defaultLimit := ratelimit.Limit{Requests: cfg.MaxRequests, Burst: cfg.Burst, Window: cfg.Window}
+	routes := make([]RouteRateLimit, 0, len(cfg.Routes))
+	for _, route := range cfg.Routes {
+		if route.Prefix == "" || route.MaxRequests <= 0 {
+			continue
+		}
+		if route.Window <= 0 {
+			route.Window = cfg.Window
+		}
+		routes = append(routes, route)
+	}
+
+	var storeFailing atomic.Bool
 
 	return func(c *fiber.Ctx) error {
+		path := c.Path()
 		for _, excluded := range cfg.ExcludePaths {
-			if strings.HasPrefix(c.Path(), excluded) {
+			if strings.HasPrefix(path, excluded) {
 				return c.Next()
 			}
 		}
-		return baseHandler(c)
+
+		key, limit := cfg.KeyGenerator(c), defaultLimit
+		matched := ""
+		for _, route := range routes {
+			if strings.HasPrefix(path, route.Prefix) && len(route.Prefix) > len(matched) {
+				matched = route.Prefix
+				limit = ratelimit.Limit{Requests: route.MaxRequests, Window: route.Window}
+			}
+		}
+		if matched != "" {
+			key = matched + "|" + key
+		}
+		if cfg.Scope != "" {
+			key = cfg.Scope + "|" + key
+		}
+
+		decision, err := cfg.Store.Allow(c.UserContext(), key, limit)
+		if err != nil {
+			if !storeFailing.Swap(true) {
+				cfg.Logger.Warn("rate limit store unavailable; allowing requests", slog.String("error", err.Error()))
+			}
+			return c.Next()
+		}
+		if storeFailing.Swap(false) {
+			cfg.Logger.Info("rate limit store recovered")
+		}
+
+		c.Set("X-RateLimit-Limit", strconv.Itoa(decision.Limit))
+		c.Set("X-RateLimit-Remaining", strconv.Itoa(decision.Remaining))
+		if !decision.Allowed {
+			c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(decision.RetryAfter.Seconds()))))
+			return fiber.NewError(fiber.StatusTooManyRequests, "rate limit exceeded")
+		}
+		return c.Next()
 	}
 }
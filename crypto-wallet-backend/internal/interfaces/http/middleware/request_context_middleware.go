@@ -7,10 +7,11 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
 	appLogging "github.com/crypto-wallet/backend/internal/infrastructure/logging"
 )
 
-// NewRequestContextMiddleware attaches request-scoped logging metadata to the context.
+// NewRequestContextMiddleware attaches request-scoped logging and audit metadata to the context.
 func NewRequestContextMiddleware(logger *slog.Logger) fiber.Handler {
 	if logger == nil {
 		logger = slog.Default()
@@ -28,6 +29,11 @@ func NewRequestContextMiddleware(logger *slog.Logger) fiber.Handler {
 		requestLogger := logger.With(slog.String("request_id", requestID))
 		ctx = appLogging.ContextWithRequestID(ctx, requestID)
 		ctx = appLogging.ContextWithLogger(ctx, requestLogger)
+		ctx = audit.ContextWithRequestInfo(ctx, audit.RequestInfo{
+			IPAddress: c.IP(),
+			UserAgent: c.Get(fiber.HeaderUserAgent),
+			RequestID: requestID,
+		})
 
 		c.SetUserContext(ctx)
 		c.Response().Header.Set("X-Request-ID", requestID)
@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// RouteTimeout overrides the request timeout for a path prefix.
+type RouteTimeout struct {
+	Prefix  string
+	Timeout time.Duration
+}
+
+// RequestTimeoutConfig configures the request deadline middleware.
+type RequestTimeoutConfig struct {
+	// Timeout bounds each request; a non-positive value disables the middleware.
+	Timeout time.Duration
+	// ExcludePaths are left without a deadline, e.g. downloads whose body is streamed from a
+	// reader opened with the request context after the handler returns.
+	ExcludePaths []string
+	// Routes set their own timeout on path prefixes; the longest matching prefix wins.
+	Routes []RouteTimeout
+}
+
+// NewRequestTimeoutMiddleware puts a deadline on the request context, so use cases, repositories
+// and chain adapters called with c.UserContext() give up together once the request has run too
+// long. A handler that fails because the deadline passed responds 503 REQUEST_TIMEOUT rather than
+// holding the connection until the server's write timeout drops it.
+func NewRequestTimeoutMiddleware(cfg RequestTimeoutConfig) fiber.Handler {
+	if cfg.Timeout <= 0 {
+		return func(c *fiber.Ctx) error {
+			return c.Next()
+		}
+	}
+
+	routes := make([]RouteTimeout, 0, len(cfg.Routes))
+	for _, route := range cfg.Routes {
+		if route.Prefix != "" && route.Timeout > 0 {
+			routes = append(routes, route)
+		}
+	}
+
+	return func(c *fiber.Ctx) error {
+		path := c.Path()
+		for _, excluded := range cfg.ExcludePaths {
+			if strings.HasPrefix(path, excluded) {
+				return c.Next()
+			}
+		}
+
+		timeout, matched := cfg.Timeout, ""
+		for _, route := range routes {
+			if strings.HasPrefix(path, route.Prefix) && len(route.Prefix) > len(matched) {
+				matched = route.Prefix
+				timeout = route.Timeout
+			}
+		}
+
+		ctx, cancel := context.WithTimeoutCause(c.UserContext(), timeout, utils.ErrRequestTimeout)
+		defer cancel()
+		c.SetUserContext(ctx)
+
+		return utils.RequestContextError(ctx, c.Next())
+	}
+}
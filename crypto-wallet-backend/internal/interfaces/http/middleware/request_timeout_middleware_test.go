@@ -0,0 +1,124 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// newTimeoutApp mounts the middleware with the server's error handler. The handler at every path
+// blocks until its request context is done and reports that context on downstream.
+func newTimeoutApp(cfg RequestTimeoutConfig, downstream chan<- context.Context) *fiber.App {
+	app := fiber.New(fiber.Config{
+		ErrorHandler: func(c *fiber.Ctx, err error) error {
+			resp, status := utils.ToErrorResponse(err)
+			return c.Status(status).JSON(resp)
+		},
+	})
+	app.Use(NewRequestTimeoutMiddleware(cfg))
+	app.Get("/*", func(c *fiber.Ctx) error {
+		ctx := c.UserContext()
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Second):
+		}
+		downstream <- ctx
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return c.SendStatus(fiber.StatusNoContent)
+	})
+	return app
+}
+
+func TestRequestTimeoutMiddlewareRespondsUnavailableAfterDeadline(t *testing.T) {
+	downstream := make(chan context.Context, 1)
+	app := newTimeoutApp(RequestTimeoutConfig{Timeout: 20 * time.Millisecond}, downstream)
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/api/v1/wallets", nil), 2000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != fiber.StatusServiceUnavailable {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, fiber.StatusServiceUnavailable)
+	}
+	var body utils.ErrorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Code != "REQUEST_TIMEOUT" {
+		t.Errorf("code = %q, want REQUEST_TIMEOUT", body.Code)
+	}
+
+	ctx := <-downstream
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		t.Errorf("downstream ctx.Err() = %v, want context.DeadlineExceeded", ctx.Err())
+	}
+	if cause := context.Cause(ctx); !errors.Is(cause, utils.ErrRequestTimeout) {
+		t.Errorf("downstream context.Cause() = %v, want ErrRequestTimeout", cause)
+	}
+}
+
+func TestRequestTimeoutMiddlewareDeadlines(t *testing.T) {
+	cfg := RequestTimeoutConfig{
+		Timeout:      time.Minute,
+		ExcludePaths: []string{"/api/v1/kyc-documents"},
+		Routes: []RouteTimeout{
+			{Prefix: "/api/v1/reports", Timeout: 5 * time.Minute},
+			{Prefix: "/api/v1/reports/quick", Timeout: 2 * time.Minute},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		path         string
+		wantDeadline time.Duration
+	}{
+		{name: "default timeout", path: "/api/v1/wallets", wantDeadline: time.Minute},
+		{name: "route override", path: "/api/v1/reports/monthly", wantDeadline: 5 * time.Minute},
+		{name: "longest prefix wins", path: "/api/v1/reports/quick/daily", wantDeadline: 2 * time.Minute},
+		{name: "excluded path", path: "/api/v1/kyc-documents/abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captured := make(chan context.Context, 1)
+			app := fiber.New()
+			app.Use(NewRequestTimeoutMiddleware(cfg))
+			app.Get("/*", func(c *fiber.Ctx) error {
+				captured <- c.UserContext()
+				return c.SendStatus(fiber.StatusNoContent)
+			})
+
+			start := time.Now()
+			resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, tt.path, nil), 2000)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			deadline, ok := (<-captured).Deadline()
+			if tt.wantDeadline == 0 {
+				if ok {
+					t.Fatalf("deadline = %v, want none", deadline)
+				}
+				return
+			}
+			if !ok {
+				t.Fatal("request context has no deadline")
+			}
+			if got := deadline.Sub(start); got < tt.wantDeadline || got > tt.wantDeadline+time.Second {
+				t.Errorf("deadline in %v, want about %v", got, tt.wantDeadline)
+			}
+		})
+	}
+}
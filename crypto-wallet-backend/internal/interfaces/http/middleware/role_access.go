@@ -0,0 +1,137 @@
+package middleware
+
+import (
+	"log/slog"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/infrastructure/security"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// AccessControlConfig configures the role and permission middleware helpers.
+type AccessControlConfig struct {
+	ContextKey string
+	Logger     *slog.Logger
+}
+
+// AccessControl restricts routes by the roles carried in access tokens. Its handlers must run
+// after the auth middleware; delegated app tokens never pass them.
+type AccessControl struct {
+	contextKey string
+	logger     *slog.Logger
+}
+
+// NewAccessControl constructs the role and permission middleware helper.
+func NewAccessControl(cfg AccessControlConfig) *AccessControl {
+	if cfg.Logger == nil {
+		cfg.Logger = slog.Default()
+	}
+	contextKey := cfg.ContextKey
+	if strings.TrimSpace(contextKey) == "" {
+		contextKey = AuthContextKey
+	}
+	return &AccessControl{
+		contextKey: contextKey,
+		logger:     cfg.Logger,
+	}
+}
+
+// RequireRole returns a Fiber middleware that admits tokens carrying any of the roles.
+func (a *AccessControl) RequireRole(roles ...entities.UserRole) fiber.Handler {
+	if len(roles) == 0 {
+		panic("middleware: at least one role is required")
+	}
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, string(role))
+	}
+
+	return func(c *fiber.Ctx) error {
+		claims, _ := c.Locals(a.contextKey).(*security.Claims)
+		for _, role := range roles {
+			if claims.HasRole(string(role)) {
+				return c.Next()
+			}
+		}
+
+		a.deny(c, claims, slog.String("roles", strings.Join(names, ",")))
+		resp, status := utils.ToErrorResponse(utils.NewAppError(
+			"ROLE_REQUIRED",
+			"this route requires the "+strings.Join(names, " or ")+" role",
+			fiber.StatusForbidden,
+			nil,
+			nil,
+		))
+		return c.Status(status).JSON(resp)
+	}
+}
+
+// RequirePermission returns a Fiber middleware that admits tokens with a role granting the permission.
+func (a *AccessControl) RequirePermission(permission entities.Permission) fiber.Handler {
+	if strings.TrimSpace(string(permission)) == "" {
+		panic("middleware: permission is required")
+	}
+
+	return func(c *fiber.Ctx) error {
+		claims, _ := c.Locals(a.contextKey).(*security.Claims)
+		if claimsHavePermission(claims, permission) {
+			return c.Next()
+		}
+
+		a.deny(c, claims, slog.String("permission", string(permission)))
+		resp, status := utils.ToErrorResponse(utils.NewAppError(
+			"PERMISSION_REQUIRED",
+			"you do not have permission to access this resource",
+			fiber.StatusForbidden,
+			nil,
+			map[string]any{"requiredPermission": permission},
+		))
+		return c.Status(status).JSON(resp)
+	}
+}
+
+func (a *AccessControl) deny(c *fiber.Ctx, claims *security.Claims, required slog.Attr) {
+	subject := ""
+	if claims != nil {
+		subject = claims.Subject
+	}
+	a.logger.Warn("route access denied",
+		slog.String("path", c.Path()),
+		required,
+		slog.String("user_id", subject))
+}
+
+// claimsHavePermission reports whether any role on a first-party token grants the permission.
+func claimsHavePermission(claims *security.Claims, permission entities.Permission) bool {
+	if claims == nil || claims.IsDelegated() {
+		return false
+	}
+	for _, role := range claims.Roles {
+		if entities.UserRole(role).HasPermission(permission) {
+			return true
+		}
+	}
+	return false
+}
+
+// RoleAccessConfig configures the role authorization middleware.
+type RoleAccessConfig struct {
+	Role       string
+	ContextKey string
+	Logger     *slog.Logger
+}
+
+// NewRoleAccessMiddleware restricts routes to tokens carrying the configured role claim. It must
+// run after the auth middleware.
+func NewRoleAccessMiddleware(cfg RoleAccessConfig) fiber.Handler {
+	if strings.TrimSpace(cfg.Role) == "" {
+		panic("middleware: role is required for role access middleware")
+	}
+	return NewAccessControl(AccessControlConfig{
+		ContextKey: cfg.ContextKey,
+		Logger:     cfg.Logger,
+	}).RequireRole(entities.UserRole(cfg.Role))
+}
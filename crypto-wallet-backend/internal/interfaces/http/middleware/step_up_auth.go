@@ -0,0 +1,255 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/infrastructure/audit"
+	"github.com/crypto-wallet/backend/internal/infrastructure/security"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// StepUpCodeHeader carries the TOTP code that authorises a sensitive operation.
+const StepUpCodeHeader = "X-2FA-Code"
+
+// DefaultStepUpElevationTTL is how long a verified code exempts its session from further codes.
+const DefaultStepUpElevationTTL = 5 * time.Minute
+
+// AuditActionStepUp records step-up verifications.
+const AuditActionStepUp = "2fa_step_up"
+
+// stepUpWindow is the clock skew, in TOTP periods, accepted on either side of now.
+const stepUpWindow = 1
+
+// StepUpStore remembers spent codes and elevated sessions. Use a shared store when several
+// instances serve traffic, or a code could be replayed against another instance.
+type StepUpStore interface {
+	ClaimCode(ctx context.Context, userID string, step int64, ttl time.Duration) (bool, error)
+	Elevate(ctx context.Context, session string, ttl time.Duration) error
+	IsElevated(ctx context.Context, session string) (bool, error)
+}
+
+// StepUpUserReader loads the user whose two-factor secret verifies the code.
+type StepUpUserReader interface {
+	GetByID(ctx context.Context, id uuid.UUID) (entities.User, error)
+}
+
+// StepUpAuditLogger records step-up attempts.
+type StepUpAuditLogger interface {
+	Record(ctx context.Context, entry audit.Entry) error
+}
+
+// StepUpAuthConfig configures the step-up authentication middleware.
+type StepUpAuthConfig struct {
+	Users        StepUpUserReader
+	Store        StepUpStore
+	ElevationTTL time.Duration
+	// Audit is optional.
+	Audit  StepUpAuditLogger
+	Logger *slog.Logger
+}
+
+// StepUpAuth requires users with two-factor authentication enabled to confirm sensitive operations
+// with a fresh TOTP code in X-2FA-Code, unless their session was elevated by a code within the
+// elevation window. Each code is accepted once. Users without two-factor authentication pass
+// through. It must run after the auth middleware.
+type StepUpAuth struct {
+	users        StepUpUserReader
+	store        StepUpStore
+	elevationTTL time.Duration
+	audit        StepUpAuditLogger
+	logger       *slog.Logger
+	now          func() time.Time
+}
+
+// NewStepUpAuth constructs the step-up authentication middleware helper.
+func NewStepUpAuth(cfg StepUpAuthConfig) *StepUpAuth {
+	if cfg.Logger == nil {
+		cfg.Logger = slog.Default()
+	}
+	if cfg.ElevationTTL <= 0 {
+		cfg.ElevationTTL = DefaultStepUpElevationTTL
+	}
+	return &StepUpAuth{
+		users:        cfg.Users,
+		store:        cfg.Store,
+		elevationTTL: cfg.ElevationTTL,
+		audit:        cfg.Audit,
+		logger:       cfg.Logger,
+		now:          time.Now,
+	}
+}
+
+// Require returns a Fiber middleware guarding a sensitive route.
+func (s *StepUpAuth) Require() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		user, err := s.loadUser(c)
+		if err != nil {
+			return respondStepUpError(c, err)
+		}
+		if !user.IsTwoFactorEnabled() {
+			return c.Next()
+		}
+
+		session, _ := c.Locals(SessionContextKey).(string)
+		code := strings.TrimSpace(c.Get(StepUpCodeHeader))
+		if code != "" {
+			if err := s.verify(c, user, session, code); err != nil {
+				return respondStepUpError(c, err)
+			}
+			return c.Next()
+		}
+
+		if session != "" {
+			elevated, err := s.store.IsElevated(c.UserContext(), session)
+			if err != nil {
+				s.logger.Error("step-up session lookup failed", slog.String("error", err.Error()))
+				return respondStepUpError(c, errStepUpUnavailable(err))
+			}
+			if elevated {
+				return c.Next()
+			}
+		}
+
+		return respondStepUpError(c, utils.NewAppError(
+			"TWO_FACTOR_REQUIRED",
+			"confirm this operation with a two-factor authentication code",
+			fiber.StatusForbidden,
+			nil,
+			map[string]any{"header": StepUpCodeHeader},
+		))
+	}
+}
+
+// Elevate returns a handler that verifies the X-2FA-Code header and elevates the session, so the
+// client can confirm once before a series of sensitive operations.
+func (s *StepUpAuth) Elevate() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		user, err := s.loadUser(c)
+		if err != nil {
+			return respondStepUpError(c, err)
+		}
+		if !user.IsTwoFactorEnabled() {
+			return respondStepUpError(c, utils.NewAppError(
+				"TWO_FACTOR_NOT_ENABLED",
+				"two-factor authentication is not enabled",
+				fiber.StatusConflict,
+				nil,
+				nil,
+			))
+		}
+
+		session, _ := c.Locals(SessionContextKey).(string)
+		if err := s.verify(c, user, session, strings.TrimSpace(c.Get(StepUpCodeHeader))); err != nil {
+			return respondStepUpError(c, err)
+		}
+
+		return c.Status(fiber.StatusOK).JSON(fiber.Map{
+			"elevated_until": s.now().UTC().Add(s.elevationTTL),
+		})
+	}
+}
+
+func (s *StepUpAuth) loadUser(c *fiber.Ctx) (entities.User, error) {
+	userID, err := extractUserIDFromContext(c.Locals("user_id"))
+	if err != nil {
+		return nil, utils.NewAppError(
+			"STEP_UP_AUTH_REQUIRED",
+			"authentication required",
+			fiber.StatusUnauthorized,
+			err,
+			nil,
+		)
+	}
+	if s.users == nil || s.store == nil {
+		return nil, errStepUpUnavailable(nil)
+	}
+
+	user, err := s.users.GetByID(c.UserContext(), userID)
+	if err != nil {
+		s.logger.Error("step-up user lookup failed", slog.String("user_id", userID.String()), slog.String("error", err.Error()))
+		return nil, errStepUpUnavailable(err)
+	}
+	return user, nil
+}
+
+// verify checks the code, spends it and elevates the session.
+func (s *StepUpAuth) verify(c *fiber.Ctx, user entities.User, session, code string) error {
+	ctx := c.UserContext()
+	userID := user.GetID()
+
+	step, ok := security.MatchTOTP(user.GetTwoFactorSecret(), code, stepUpWindow)
+	if !ok {
+		s.record(ctx, c, userID, "invalid verification code")
+		return utils.NewAppError(
+			"TWO_FACTOR_CODE_INVALID",
+			"verification code is invalid or expired",
+			fiber.StatusUnauthorized,
+			nil,
+			nil,
+		)
+	}
+
+	// A code stays acceptable for the skew window on both sides of its own step.
+	claimed, err := s.store.ClaimCode(ctx, userID.String(), step, (2*stepUpWindow+2)*security.TOTPPeriod)
+	if err != nil {
+		s.logger.Error("step-up code claim failed", slog.String("error", err.Error()))
+		return errStepUpUnavailable(err)
+	}
+	if !claimed {
+		s.record(ctx, c, userID, "verification code already used")
+		return utils.NewAppError(
+			"TWO_FACTOR_CODE_REUSED",
+			"verification code was already used; wait for the next one",
+			fiber.StatusUnauthorized,
+			nil,
+			nil,
+		)
+	}
+
+	if session != "" {
+		if err := s.store.Elevate(ctx, session, s.elevationTTL); err != nil {
+			s.logger.Warn("failed to elevate session", slog.String("error", err.Error()))
+		}
+	}
+	s.record(ctx, c, userID, "")
+	return nil
+}
+
+func (s *StepUpAuth) record(ctx context.Context, c *fiber.Ctx, userID uuid.UUID, failure string) {
+	if s.audit == nil {
+		return
+	}
+	entry := audit.Entry{
+		ActorID:      userID,
+		Action:       AuditActionStepUp,
+		ResourceType: "user",
+		TargetID:     userID.String(),
+		Metadata:     map[string]any{"method": c.Method(), "path": c.Path()},
+		Error:        failure,
+	}
+	if err := s.audit.Record(ctx, entry); err != nil {
+		s.logger.Warn("failed to record step-up audit entry", slog.String("error", err.Error()))
+	}
+}
+
+func errStepUpUnavailable(err error) error {
+	return utils.NewAppError(
+		"STEP_UP_UNAVAILABLE",
+		"two-factor verification is temporarily unavailable",
+		fiber.StatusServiceUnavailable,
+		err,
+		nil,
+	)
+}
+
+func respondStepUpError(c *fiber.Ctx, err error) error {
+	resp, status := utils.ToErrorResponse(err)
+	return c.Status(status).JSON(resp)
+}
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"log/slog"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/crypto-wallet/backend/internal/domain/entities"
+	"github.com/crypto-wallet/backend/internal/infrastructure/security"
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// SupportAccessConfig configures the support staff authorization middleware.
+type SupportAccessConfig struct {
+	StaffUserIDs []uuid.UUID
+	ContextKey   string
+	Logger       *slog.Logger
+}
+
+// NewSupportAccessMiddleware restricts routes to the configured support staff accounts and to
+// tokens whose roles grant support access. It must run after the auth middleware.
+func NewSupportAccessMiddleware(cfg SupportAccessConfig) fiber.Handler {
+	if cfg.Logger == nil {
+		cfg.Logger = slog.Default()
+	}
+	contextKey := cfg.ContextKey
+	if strings.TrimSpace(contextKey) == "" {
+		contextKey = AuthContextKey
+	}
+
+	staff := make(map[uuid.UUID]struct{}, len(cfg.StaffUserIDs))
+	for _, id := range cfg.StaffUserIDs {
+		staff[id] = struct{}{}
+	}
+
+	return func(c *fiber.Ctx) error {
+		claims, _ := c.Locals(contextKey).(*security.Claims)
+		if claimsHavePermission(claims, entities.PermissionSupportAccess) {
+			return c.Next()
+		}
+
+		userID, err := extractUserIDFromContext(c.Locals("user_id"))
+		if err == nil {
+			if _, ok := staff[userID]; ok {
+				return c.Next()
+			}
+		}
+
+		cfg.Logger.Warn("support route access denied",
+			slog.String("path", c.Path()),
+			slog.String("user_id", userID.String()))
+		resp, status := utils.ToErrorResponse(utils.NewAppError(
+			"SUPPORT_ACCESS_REQUIRED",
+			"support staff access required",
+			fiber.StatusForbidden,
+			nil,
+			nil,
+		))
+		return c.Status(status).JSON(resp)
+	}
+}
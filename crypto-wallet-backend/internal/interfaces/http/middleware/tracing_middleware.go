@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// tracerName identifies spans started by the HTTP layer.
+const tracerName = "github.com/crypto-wallet/backend/internal/interfaces/http"
+
+// NewTracingMiddleware starts a server span per request, continuing any trace-context headers sent
+// by the caller, and stores it in the user context so use cases, repositories and outbound calls
+// attach to it. The trace ID is echoed in X-Trace-ID for support requests.
+func NewTracingMiddleware() fiber.Handler {
+	tracer := otel.Tracer(tracerName)
+
+	return func(c *fiber.Ctx) error {
+		carrier := propagation.HeaderCarrier{}
+		c.Request().Header.VisitAll(func(key, value []byte) {
+			carrier.Set(string(key), string(value))
+		})
+		ctx := otel.GetTextMapPropagator().Extract(c.UserContext(), carrier)
+
+		ctx, span := tracer.Start(ctx, c.Method()+" "+c.Path(),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", c.Method()),
+				attribute.String("url.path", c.Path()),
+			),
+		)
+		defer span.End()
+
+		c.SetUserContext(ctx)
+		if spanContext := span.SpanContext(); spanContext.IsValid() {
+			c.Set("X-Trace-ID", spanContext.TraceID().String())
+		}
+
+		err := c.Next()
+
+		// Name the span by route pattern so traces group by endpoint rather than by ID.
+		route := c.Route().Path
+		span.SetName(c.Method() + " " + route)
+
+		// The error handler has not run yet, so derive the status it will write.
+		status := c.Response().StatusCode()
+		if err != nil {
+			status = utils.HTTPStatusFromError(err)
+			span.RecordError(err)
+		}
+		span.SetAttributes(
+			attribute.String("http.route", route),
+			attribute.Int("http.response.status_code", status),
+		)
+		if status >= fiber.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+		return err
+	}
+}
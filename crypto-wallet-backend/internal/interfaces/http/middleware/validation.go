@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+// ValidatedPayloadKey is the Fiber locals key under which the bound request DTO is stored.
+const ValidatedPayloadKey = "validated_payload"
+
+// Validatable is implemented by request DTOs. Validate starts from utils.ValidateStruct and adds
+// the checks the validate tags cannot express; DTOs without it are checked by their tags alone.
+type Validatable interface {
+	Validate() utils.ValidationErrors
+}
+
+// Defaulter is implemented by request DTOs that fill in omitted fields before validation.
+type Defaulter interface {
+	SetDefaults()
+}
+
+// ValidateBody parses the JSON body into T and validates it, rejecting the request with every
+// failing field before the handler runs. Handlers read the result with BindBody.
+func ValidateBody[T any]() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if _, err := BindBody[T](c); err != nil {
+			resp, status := utils.ToErrorResponse(err)
+			return c.Status(status).JSON(resp)
+		}
+		return c.Next()
+	}
+}
+
+// ValidateQuery parses the query string into T and validates it. Handlers read the result with
+// BindQuery.
+func ValidateQuery[T any]() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if _, err := BindQuery[T](c); err != nil {
+			resp, status := utils.ToErrorResponse(err)
+			return c.Status(status).JSON(resp)
+		}
+		return c.Next()
+	}
+}
+
+// BindBody returns the body bound by ValidateBody, or binds and validates it when the route does
+// not mount the middleware. An unparsable body yields INVALID_JSON and failing fields
+// VALIDATION_ERROR with the field errors under details.errors.
+func BindBody[T any](c *fiber.Ctx) (*T, error) {
+	return bind[T](c, c.BodyParser, "INVALID_JSON", "unable to parse request body")
+}
+
+// BindQuery is BindBody for query parameters.
+func BindQuery[T any](c *fiber.Ctx) (*T, error) {
+	return bind[T](c, c.QueryParser, "INVALID_QUERY", "unable to parse query parameters")
+}
+
+func bind[T any](c *fiber.Ctx, parse func(any) error, parseCode, parseMessage string) (*T, error) {
+	if payload, ok := c.Locals(ValidatedPayloadKey).(*T); ok {
+		return payload, nil
+	}
+
+	payload := new(T)
+	if err := parse(payload); err != nil {
+		return nil, utils.NewAppError(parseCode, parseMessage, fiber.StatusBadRequest, err, nil)
+	}
+	if defaulter, ok := any(payload).(Defaulter); ok {
+		defaulter.SetDefaults()
+	}
+
+	var errs utils.ValidationErrors
+	if validatable, ok := any(payload).(Validatable); ok {
+		errs = validatable.Validate()
+	} else {
+		errs = utils.ValidateStruct(payload)
+	}
+	if !errs.IsEmpty() {
+		return nil, utils.NewAppError(
+			"VALIDATION_ERROR",
+			"invalid request",
+			fiber.StatusBadRequest,
+			errs,
+			map[string]any{"errors": errs},
+		)
+	}
+
+	c.Locals(ValidatedPayloadKey, payload)
+	return payload, nil
+}
@@ -0,0 +1,93 @@
+package openapi
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Route is a method and path in OpenAPI form, relative to the server URL.
+type Route struct {
+	Method string
+	Path   string
+}
+
+// String renders the route as "GET /wallets/{id}".
+func (r Route) String() string {
+	return r.Method + " " + r.Path
+}
+
+// Coverage compares the routes registered on a Fiber app with the document.
+type Coverage struct {
+	// Undocumented routes are served but missing from the document.
+	Undocumented []Route
+	// Unregistered operations are documented but not served, usually because their handler is
+	// not configured in this deployment.
+	Unregistered []Route
+}
+
+// Complete reports whether every served route is documented.
+func (c Coverage) Complete() bool {
+	return len(c.Undocumented) == 0
+}
+
+// CheckRoutes matches the app's routes under prefix against the document. Middleware-only
+// registrations and methods the API does not document, such as the HEAD routes Fiber adds for
+// every GET, are ignored.
+func CheckRoutes(doc *Document, routes []fiber.Route, prefix string) Coverage {
+	prefix = strings.TrimRight(prefix, "/")
+	documented := make(map[Route]bool)
+	for _, route := range doc.Operations() {
+		documented[route] = true
+	}
+
+	var coverage Coverage
+	served := make(map[Route]bool)
+	for _, r := range routes {
+		if !slices.Contains(methods, r.Method) {
+			continue
+		}
+		path, ok := strings.CutPrefix(r.Path, prefix)
+		if !ok || (path != "" && !strings.HasPrefix(path, "/")) {
+			continue
+		}
+		path, _ = openAPIPath(path)
+		route := Route{Method: r.Method, Path: path}
+		if served[route] {
+			// Step-up checks are registered as a separate handler on the same route.
+			continue
+		}
+		served[route] = true
+		if !documented[route] {
+			coverage.Undocumented = append(coverage.Undocumented, route)
+		}
+	}
+
+	for route := range documented {
+		if !served[route] {
+			coverage.Unregistered = append(coverage.Unregistered, route)
+		}
+	}
+	sortRoutes(coverage.Undocumented)
+	sortRoutes(coverage.Unregistered)
+	return coverage
+}
+
+// normalizePath drops the trailing slash group roots register with, keeping "/" itself.
+func normalizePath(path string) string {
+	path = strings.TrimRight(path, "/")
+	if path == "" {
+		return "/"
+	}
+	return path
+}
+
+func sortRoutes(routes []Route) {
+	slices.SortFunc(routes, func(a, b Route) int {
+		if c := strings.Compare(a.Path, b.Path); c != 0 {
+			return c
+		}
+		return slices.Index(methods, a.Method) - slices.Index(methods, b.Method)
+	})
+}
@@ -0,0 +1,660 @@
+package openapi
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/crypto-wallet/backend/internal/application/dto"
+	"github.com/crypto-wallet/backend/internal/interfaces/graphqlapi"
+)
+
+// groups declares every operation of the public API, grouped the way RegisterRoutes mounts the
+// handlers. Keep it in step with the handlers' Register methods; CheckRoutes reports drift at
+// startup.
+func groups() []group {
+	return []group{
+		{
+			tag:         "Health",
+			description: "Service health and API description.",
+			auth:        authNone,
+			endpoints: []*endpoint{
+				get("/health", "Health check").returns(http.StatusOK, inline{
+					"status":     "",
+					"timestamp":  time.Time{},
+					"component":  "",
+					"version":    "",
+					"request_id": "",
+				}),
+				get("/openapi.json", "OpenAPI document").returns(http.StatusOK, &Schema{Type: "object"}),
+				get("/docs", "Swagger UI; served outside production only").produces(http.StatusOK, contentHTML),
+			},
+		},
+		{
+			tag:         "Public market data",
+			description: "Unauthenticated exchange rates and trading pairs.",
+			prefix:      "/public",
+			auth:        authNone,
+			endpoints: []*endpoint{
+				get("/rates", "Current USD rates").
+					query(queryString("symbols", "comma separated symbols; all when omitted")).
+					returns(http.StatusOK, dto.ExchangeRateList{}),
+				get("/pairs", "Active trading pairs").returns(http.StatusOK, dto.TradingPairsResponse{}),
+			},
+		},
+		{
+			tag:         "Shared portfolios",
+			description: "Read-only portfolio views behind a share link token.",
+			prefix:      "/shared/portfolio",
+			auth:        authShareToken,
+			endpoints: []*endpoint{
+				get("/", "Shared portfolio summary").
+					query(queryString("currency", "display currency")).
+					returns(http.StatusOK, dto.SharedPortfolio{}),
+				get("/performance", "Shared portfolio performance").
+					query(queryString("period", "performance period"), queryString("currency", "display currency")).
+					returns(http.StatusOK, dto.PortfolioPerformance{}),
+				get("/transactions", "Shared portfolio transactions").returns(http.StatusOK, dto.SharedTransactionList{}),
+			},
+		},
+		{
+			tag:         "KYC document downloads",
+			description: "Single-use KYC document download links.",
+			prefix:      "/kyc-documents",
+			auth:        authNone,
+			endpoints: []*endpoint{
+				get("/download", "Download a KYC document").
+					query(Parameter{Name: "token", In: "query", Required: true, Description: "download link token", Schema: &Schema{Type: "string"}}).
+					produces(http.StatusOK, contentBinary),
+			},
+		},
+		{
+			tag:         "Partners",
+			description: "Partner integrations authenticated by API key.",
+			prefix:      "/partners/kyc",
+			auth:        authPartnerKey,
+			endpoints: []*endpoint{
+				post("/status", "Batch KYC status lookup").
+					body(dto.PartnerKYCStatusRequest{}).
+					returns(http.StatusOK, dto.PartnerKYCStatusResponse{}),
+			},
+		},
+		{
+			tag:         "Session tokens",
+			description: "Token refresh, revocation and email verification, authenticated by the token itself.",
+			prefix:      "/auth",
+			auth:        authNone,
+			endpoints: []*endpoint{
+				post("/refresh", "Refresh the token pair").
+					body(dto.RefreshTokenRequest{}).
+					returns(http.StatusOK, dto.AuthResponse{}),
+				post("/revoke", "Revoke a session").
+					body(dto.RevokeTokenRequest{}).
+					returns(http.StatusNoContent, nil),
+				post("/email/verify", "Verify an email address").
+					body(dto.VerifyEmailRequest{}).
+					returns(http.StatusOK, dto.EmailVerificationStatusResponse{}),
+			},
+		},
+		{
+			tag:         "Auth",
+			description: "Registration, login and two-factor authentication.",
+			prefix:      "/auth",
+			endpoints: []*endpoint{
+				post("/register", "Register a user").
+					body(dto.RegisterRequest{}).
+					returns(http.StatusCreated, dto.AuthResponse{}),
+				post("/login", "Log in").
+					body(dto.LoginRequest{}).
+					returns(http.StatusOK, dto.AuthResponse{}),
+				post("/logout", "Log out").
+					body(dto.LogoutRequest{}).
+					returns(http.StatusNoContent, nil),
+				post("/2fa/setup", "Start two-factor setup").returns(http.StatusOK, dto.TwoFactorSetupResponse{}),
+				post("/2fa/enable", "Enable two-factor authentication").
+					body(dto.EnableTwoFactorRequest{}).
+					returns(http.StatusOK, dto.TwoFactorStatusResponse{}),
+				post("/2fa/disable", "Disable two-factor authentication; requires a step-up code").
+					body(dto.DisableTwoFactorRequest{}).
+					returns(http.StatusOK, dto.TwoFactorStatusResponse{}),
+				post("/2fa/step-up", "Elevate the session with the X-2FA-Code header").
+					returns(http.StatusOK, inline{"elevated_until": time.Time{}}),
+				post("/email/verification", "Send an email verification message").
+					returns(http.StatusAccepted, dto.EmailVerificationSentResponse{}),
+			},
+		},
+		{
+			tag:         "KYC",
+			description: "Identity verification of the current user.",
+			prefix:      "/kyc",
+			endpoints: []*endpoint{
+				post("/submit", "Submit KYC details").
+					body(dto.KYCSubmitRequest{}).
+					returns(http.StatusCreated, dto.KYCProfile{}),
+				post("/documents", "Upload a KYC document").
+					upload("file").
+					returns(http.StatusCreated, dto.KYCDocumentUploadResponse{}),
+				get("/document-types", "Accepted document types").
+					query(queryString("locale", "label locale")).
+					returns(http.StatusOK, inline{"items": []dto.KYCDocumentType{}}),
+				get("/status", "KYC status").returns(http.StatusOK, dto.KYCStatusResponse{}),
+				get("/requirements", "KYC requirements for a country").
+					query(queryString("country", "ISO 3166-1 alpha-2 country code")).
+					returns(http.StatusOK, dto.KYCRequirementsResponse{}),
+				get("/limits", "Remaining transaction limits").returns(http.StatusOK, dto.KYCLimitHeadroomResponse{}),
+				post("/upgrade", "Start a verification level upgrade").returns(http.StatusOK, dto.KYCUpgradeResponse{}),
+				get("/upgrade", "Verification level upgrade status").returns(http.StatusOK, dto.KYCUpgradeResponse{}),
+				post("/upgrade/submit", "Submit a verification level upgrade").returns(http.StatusAccepted, dto.KYCUpgradeResponse{}),
+			},
+		},
+		{
+			tag:         "Wallets",
+			description: "Wallets, balances, HD seeds and shared wallet members.",
+			prefix:      "/wallets",
+			endpoints: []*endpoint{
+				get("/", "List wallets").
+					query(listQuery(false, "balance", "chain", "created_at", "label")...).
+					query(queryString("chain", "chain filter"), queryString("status", "status filter")).
+					returns(http.StatusOK, dto.WalletList{}),
+				post("/", "Create a wallet").
+					body(dto.CreateWalletRequest{}).
+					returns(http.StatusCreated, dto.Wallet{}),
+				get("/chains", "Chain availability").returns(http.StatusOK, inline{"chains": []dto.ChainAvailability{}}),
+				put("/chains/:chain/beta", "Opt in to a beta chain").returns(http.StatusOK, dto.ChainAvailability{}),
+				del("/chains/:chain/beta", "Opt out of a beta chain").returns(http.StatusOK, dto.ChainAvailability{}),
+				get("/hd", "HD seed status").returns(http.StatusOK, dto.HDWalletStatus{}),
+				post("/hd/mnemonic", "Generate a mnemonic").returns(http.StatusCreated, dto.GeneratedMnemonic{}),
+				post("/hd/import", "Import a mnemonic").
+					body(dto.MnemonicRequest{}).
+					returns(http.StatusCreated, dto.HDWalletStatus{}),
+				post("/hd/backup/confirm", "Confirm the mnemonic backup").
+					body(dto.MnemonicRequest{}).
+					returns(http.StatusOK, dto.HDWalletStatus{}),
+				get("/:id/balance", "Refresh and return a wallet balance").returns(http.StatusOK, dto.WalletBalance{}),
+				get("/:id/activity", "Wallet activity and dormancy").returns(http.StatusOK, dto.WalletActivity{}),
+				post("/:id/reactivate", "Reactivate a dormant wallet").
+					body(dto.ReactivateWalletRequest{}).
+					returns(http.StatusOK, dto.WalletActivity{}),
+				get("/:id/permissions", "List wallet members").returns(http.StatusOK, inline{"items": []dto.WalletPermissionGrant{}}),
+				put("/:id/permissions/:userId", "Grant a wallet member a role").
+					body(dto.WalletPermissionRequest{}).
+					returns(http.StatusOK, dto.WalletPermissionGrant{}),
+				del("/:id/permissions/:userId", "Remove a wallet member").returns(http.StatusNoContent, nil),
+			},
+		},
+		{
+			tag:         "Transaction notes",
+			description: "End-to-end encrypted transaction note sync.",
+			prefix:      "/transactions/notes",
+			endpoints: []*endpoint{
+				get("/", "Note changes since a cursor").
+					query(queryInt("cursor", "sync cursor from a previous response"), queryInt("limit", "page size")).
+					returns(http.StatusOK, inline{"changes": []dto.TransactionNote{}, "nextCursor": int64(0), "hasMore": false}),
+				post("/sync", "Push note changes").
+					body(dto.SyncTransactionNotesRequest{}).
+					returns(http.StatusOK, dto.SyncTransactionNotesResponse{}),
+			},
+		},
+		{
+			tag:         "Transactions",
+			description: "Sends and transaction history.",
+			prefix:      "/transactions",
+			endpoints: []*endpoint{
+				post("/", "Send a transaction; requires a step-up code").
+					body(dto.SendTransactionRequest{}).
+					returns(http.StatusAccepted, dto.TransactionStatusResponse{}),
+				get("/", "List transactions").
+					query(queryString("walletId", "wallet filter")).
+					query(listQuery(true, "amount", "chain", "confirmations", "created_at", "status")...).
+					query(queryString("status", "status filter"), queryString("chain", "chain filter")).
+					returns(http.StatusOK, inline{
+						"items":      []dto.TransactionStatusResponse{},
+						"total":      0,
+						"limit":      0,
+						"offset":     0,
+						"nextCursor": "",
+					}),
+				get("/search", "Search transactions").
+					queryFrom(dto.SearchTransactionsRequest{}).
+					returns(http.StatusOK, dto.TransactionListResponse{}),
+				get("/:id", "Get a transaction").returns(http.StatusOK, dto.TransactionStatusResponse{}),
+				get("/hash/:hash", "Get a transaction by hash").
+					query(queryString("chain", "chain of the transaction")).
+					returns(http.StatusOK, dto.TransactionStatusResponse{}),
+			},
+		},
+		{
+			tag:         "Activity",
+			description: "Merged transaction and exchange feed.",
+			prefix:      "/activity",
+			endpoints: []*endpoint{
+				get("/recent", "Recent activity").
+					query(
+						queryString("kind", "activity kind filter"),
+						queryString("walletId", "wallet filter"),
+						queryInt("limit", "page size"),
+						queryString("cursor", "cursor from a previous page"),
+					).
+					returns(http.StatusOK, dto.ActivityListResponse{}),
+			},
+		},
+		{
+			tag:         "GraphQL",
+			description: "Read-only GraphQL view of the user's wallets, transactions, portfolio and rates.",
+			prefix:      "/graphql",
+			endpoints: []*endpoint{
+				post("/", "Execute a GraphQL query").
+					body(graphqlapi.Params{}).
+					returns(http.StatusOK, inline{
+						"data":   &Schema{Type: "object", Nullable: true},
+						"errors": &Schema{Type: "array", Items: &Schema{Type: "object"}},
+					}),
+			},
+		},
+		{
+			tag:         "Exchange",
+			description: "Exchange quotes and execution. Quotes are priced from the amount to spend or the amount to receive and carry a signed quote token to execute. Executions run in the background; poll the operation or follow the exchange events for the outcome.",
+			prefix:      "/exchange",
+			endpoints: []*endpoint{
+				post("/quote", "Quote an exchange").
+					body(dto.QuoteRequest{}).
+					returns(http.StatusOK, dto.QuoteResponse{}),
+				post("/execute", "Execute a quoted exchange; requires a step-up code").
+					body(dto.ExecuteExchangeRequest{}).
+					returns(http.StatusAccepted, dto.ExecuteExchangeResponse{}),
+				get("/:id", "Get an exchange operation").returns(http.StatusOK, dto.ExchangeOperationResponse{}),
+			},
+		},
+		{
+			tag:         "P2P",
+			description: "Peer-to-peer transfers and disputes.",
+			prefix:      "/p2p",
+			endpoints: []*endpoint{
+				post("/", "Send a P2P transfer; requires a step-up code").
+					body(dto.SendP2PTransferRequest{}).
+					returns(http.StatusCreated, dto.P2PTransferResponse{}),
+				get("/", "List P2P transfers").returns(http.StatusOK, dto.P2PTransferList{}),
+				post("/claims", "Claim a P2P transfer").
+					body(dto.ClaimP2PTransferRequest{}).
+					returns(http.StatusOK, dto.P2PTransferResponse{}),
+				get("/disputes", "List the user's disputes").returns(http.StatusOK, dto.P2PDisputeList{}),
+				get("/disputes/:id", "Get a dispute").returns(http.StatusOK, dto.P2PDisputeDetail{}),
+				post("/:id/disputes", "Open a dispute on a transfer").
+					body(dto.OpenP2PDisputeRequest{}).
+					returns(http.StatusCreated, dto.P2PDisputeResponse{}),
+			},
+		},
+		{
+			tag:         "Profiles",
+			description: "Payment profiles and handles.",
+			prefix:      "/profiles",
+			endpoints: []*endpoint{
+				get("/me", "Get the payment profile").returns(http.StatusOK, dto.PaymentProfileResponse{}),
+				put("/me", "Update the payment profile").
+					body(dto.UpdatePaymentProfileRequest{}).
+					returns(http.StatusOK, dto.PaymentProfileResponse{}),
+				put("/me/handle", "Claim a handle").
+					body(dto.ClaimHandleRequest{}).
+					returns(http.StatusOK, dto.PaymentProfileResponse{}),
+				get("/handles/:handle", "Look up a handle").returns(http.StatusOK, dto.PublicPaymentProfileResponse{}),
+				get("/handles/:handle/availability", "Check handle availability").returns(http.StatusOK, dto.HandleAvailabilityResponse{}),
+			},
+		},
+		{
+			tag:         "Users",
+			description: "Preferences, data exports and account deletion.",
+			prefix:      "/users",
+			endpoints: []*endpoint{
+				get("/me/preferences", "Get preferences").returns(http.StatusOK, dto.PreferencesResponse{}),
+				patch("/me/preferences", "Update preferences").
+					body(dto.UpdatePreferencesRequest{}).
+					returns(http.StatusOK, dto.PreferencesResponse{}),
+				post("/me/data-export", "Request a personal data export").returns(http.StatusAccepted, dto.ExportJobResponse{}),
+				del("/me", "Delete the account; requires a step-up code").returns(http.StatusAccepted, dto.AccountDeletionResponse{}),
+			},
+		},
+		{
+			tag:         "Exports",
+			description: "Transaction and accounting exports.",
+			prefix:      "/exports",
+			endpoints: []*endpoint{
+				get("/", "List export jobs").returns(http.StatusOK, dto.ExportJobList{}),
+				post("/accounting", "Request an accounting export").
+					body(dto.AccountingExportRequest{}).
+					returns(http.StatusAccepted, dto.ExportJobResponse{}),
+				post("/transactions", "Request a transaction export").
+					body(dto.ExportTransactionsRequest{}).
+					returns(http.StatusAccepted, dto.ExportJobResponse{}),
+				get("/accounting/mappings", "Get account mappings").returns(http.StatusOK, dto.AccountMappingsResponse{}),
+				put("/accounting/mappings", "Update account mappings").
+					body(dto.UpdateAccountMappingsRequest{}).
+					returns(http.StatusOK, dto.AccountMappingsResponse{}),
+				get("/:id", "Get an export job").returns(http.StatusOK, dto.ExportJobResponse{}),
+				get("/:id/download", "Download an export; may redirect to a signed URL").produces(http.StatusOK, contentBinary),
+			},
+		},
+		{
+			tag:         "Webhooks",
+			description: "Webhook endpoints and signature verification.",
+			prefix:      "/webhooks",
+			endpoints: []*endpoint{
+				get("/signature-schemes", "Webhook signature schemes").returns(http.StatusOK, inline{
+					"current":   "",
+					"supported": []string{},
+					"headers":   map[string]string{},
+					"schemes":   map[string]string{},
+				}),
+				get("/endpoints", "List webhook endpoints").returns(http.StatusOK, inline{"items": []dto.WebhookEndpoint{}}),
+				post("/endpoints", "Register a webhook endpoint").
+					body(dto.WebhookEndpointRequest{}).
+					returns(http.StatusCreated, dto.WebhookEndpointCreatedResponse{}),
+				del("/endpoints/:id", "Delete a webhook endpoint").returns(http.StatusNoContent, nil),
+				post("/endpoints/:id/test", "Send a test delivery").returns(http.StatusOK, dto.WebhookTestDeliveryResponse{}),
+				post("/endpoints/:id/verify", "Verify a webhook signature").
+					body(dto.WebhookVerifyRequest{}).
+					returns(http.StatusOK, dto.WebhookVerifyResponse{}),
+			},
+		},
+		{
+			tag:         "Address book",
+			description: "Saved recipient addresses.",
+			prefix:      "/address-book",
+			endpoints: []*endpoint{
+				get("/settings", "Get address book settings").returns(http.StatusOK, dto.AddressBookSettingsResponse{}),
+				put("/settings", "Update address book settings").
+					body(dto.AddressBookSettingsRequest{}).
+					returns(http.StatusOK, dto.AddressBookSettingsResponse{}),
+				get("/export", "Export the signed address book; a file with download=true").
+					query(queryString("chain", "chain filter"), queryBool("download", "download as a file")).
+					returns(http.StatusOK, dto.AddressBookExport{}),
+				post("/import", "Import a signed address book; requires a step-up code").
+					body(dto.AddressBookImportRequest{}).
+					returns(http.StatusOK, dto.AddressBookImportResult{}),
+				get("/", "List address book entries").
+					query(queryString("chain", "chain filter")).
+					returns(http.StatusOK, inline{"items": []dto.AddressBookEntry{}}),
+				post("/", "Add an address book entry; requires a step-up code").
+					body(dto.AddressBookEntryRequest{}).
+					returns(http.StatusCreated, dto.AddressBookEntry{}),
+				patch("/:id", "Update an address book entry").
+					body(dto.UpdateAddressBookEntryRequest{}).
+					returns(http.StatusOK, dto.AddressBookEntry{}),
+				del("/:id", "Delete an address book entry").returns(http.StatusNoContent, nil),
+			},
+		},
+		{
+			tag:         "Notifications",
+			description: "In-app notification inbox.",
+			prefix:      "/notifications",
+			endpoints: []*endpoint{
+				get("/", "List notifications").
+					query(queryBool("unread", "only unread notifications")).
+					returns(http.StatusOK, dto.NotificationList{}),
+				patch("/:id/read", "Mark a notification read").returns(http.StatusOK, dto.NotificationReadResponse{}),
+			},
+		},
+		{
+			tag:         "Broadcasts",
+			description: "Broadcast message tracking.",
+			prefix:      "/broadcasts",
+			endpoints: []*endpoint{
+				post("/:id/opened", "Record that a broadcast was opened").returns(http.StatusNoContent, nil),
+			},
+		},
+		{
+			tag:         "Support",
+			description: "Staff tools; admin and compliance routes also require the matching permission.",
+			prefix:      "/support",
+			endpoints: []*endpoint{
+				get("/p2p/disputes", "List P2P disputes").
+					query(queryString("status", "status filter")).
+					returns(http.StatusOK, dto.P2PDisputeList{}),
+				get("/p2p/disputes/:id", "Get a P2P dispute").returns(http.StatusOK, dto.P2PDisputeDetail{}),
+				post("/p2p/disputes/:id/hold", "Hold a disputed transfer").
+					body(dto.HoldP2PDisputeRequest{}).
+					returns(http.StatusOK, dto.P2PDisputeResponse{}),
+				post("/p2p/disputes/:id/resolve", "Resolve a dispute").
+					body(dto.ResolveP2PDisputeRequest{}).
+					returns(http.StatusOK, dto.P2PDisputeResponse{}),
+
+				get("/audit/logs", "Search audit logs").
+					queryFrom(dto.AuditLogQuery{}).
+					returns(http.StatusOK, dto.AuditLogList{}),
+				get("/audit/logs/export", "Export audit logs").
+					queryFrom(dto.AuditLogQuery{}).
+					produces(http.StatusOK, contentBinary),
+				get("/audit/verify", "Verify the audit hash chain").returns(http.StatusOK, dto.AuditChainVerification{}),
+				get("/audit/archives", "List audit archives").returns(http.StatusOK, dto.AuditArchiveList{}),
+
+				post("/events/backfill", "Backfill the event export").
+					body(dto.EventBackfillRequest{}).
+					returns(http.StatusOK, dto.EventBackfillResponse{}),
+
+				get("/kyc/countries", "List country KYC requirements").returns(http.StatusOK, inline{"items": []dto.KYCCountryRequirement{}}),
+				put("/kyc/countries/:code", "Set a country's KYC requirements").
+					body(dto.KYCCountryRequirementRequest{}).
+					returns(http.StatusOK, dto.KYCCountryRequirement{}),
+				del("/kyc/countries/:code", "Remove a country's KYC requirements").returns(http.StatusNoContent, nil),
+				get("/kyc/users/:userId/limits", "A user's limits at a point in time").
+					query(queryString("at", "RFC 3339 timestamp; now when omitted")).
+					returns(http.StatusOK, dto.KYCLimitSnapshotAtResponse{}),
+				get("/kyc/users/:userId/limits/history", "A user's limit history").
+					query(queryString("from", "range start"), queryString("to", "range end"), queryInt("limit", "page size")).
+					returns(http.StatusOK, inline{"items": []dto.KYCLimitSnapshot{}}),
+
+				post("/admin/users/:id/wallets/freeze", "Freeze a user's wallets").
+					query(queryBool("dry_run", "report without applying")).
+					body(dto.AdminFreezeUserWalletsRequest{}).
+					returns(http.StatusOK, dto.AdminOperationResult{}),
+				post("/admin/exports/purge", "Purge expired exports").
+					query(queryBool("dry_run", "report without applying")).
+					body(dto.AdminPurgeExportsRequest{}).
+					returns(http.StatusOK, dto.AdminOperationResult{}),
+				post("/admin/users/:id/exchanges/cancel", "Cancel a user's pending exchanges").
+					query(queryBool("dry_run", "report without applying")).
+					body(dto.AdminCancelExchangesRequest{}).
+					returns(http.StatusOK, dto.AdminExchangeCancellationResult{}),
+				post("/admin/trading-pairs/:id/exchanges/cancel", "Cancel a trading pair's pending exchanges").
+					query(queryBool("dry_run", "report without applying")).
+					body(dto.AdminCancelExchangesRequest{}).
+					returns(http.StatusOK, dto.AdminExchangeCancellationResult{}),
+				post("/admin/trading-pairs/:id/pricing-simulation", "Simulate trading pair pricing").
+					body(dto.AdminPricingSimulationRequest{}).
+					returns(http.StatusOK, dto.AdminPricingSimulationResult{}),
+				get("/admin/execution-budgets", "Admin operation execution budgets").
+					returns(http.StatusOK, inline{"budgets": []dto.AdminExecutionBudget{}}),
+				get("/admin/liquidity", "Trading pair liquidity ledgers").
+					returns(http.StatusOK, inline{"items": []dto.AdminTradingPairLiquidity{}}),
+				get("/admin/trading-pairs/:id/liquidity", "A trading pair's liquidity ledger").
+					returns(http.StatusOK, dto.AdminTradingPairLiquidity{}),
+				post("/admin/trading-pairs/:id/liquidity/top-up", "Top up a trading pair's liquidity").
+					body(dto.AdminLiquidityTopUpRequest{}).
+					returns(http.StatusOK, dto.AdminTradingPairLiquidity{}),
+
+				get("/chains/rollouts", "List chain rollouts").returns(http.StatusOK, inline{"items": []dto.ChainRollout{}}),
+				put("/chains/rollouts/:chain", "Set a chain rollout").
+					body(dto.ChainRolloutRequest{}).
+					returns(http.StatusOK, dto.ChainRollout{}),
+				del("/chains/rollouts/:chain", "Remove a chain rollout").returns(http.StatusNoContent, nil),
+				get("/chains", "List chain configurations").returns(http.StatusOK, inline{"items": []dto.ChainConfig{}}),
+				put("/chains/:chain", "Configure a chain").
+					body(dto.ChainConfigRequest{}).
+					returns(http.StatusOK, dto.ChainConfig{}),
+				post("/chains/:chain/disable", "Disable a chain").returns(http.StatusOK, dto.ChainConfig{}),
+				post("/chains/:chain/enable", "Enable a chain").returns(http.StatusOK, dto.ChainConfig{}),
+
+				get("/broadcasts", "List broadcast campaigns").
+					query(queryString("status", "status filter")).
+					returns(http.StatusOK, dto.BroadcastCampaignList{}),
+				post("/broadcasts", "Create a broadcast campaign").
+					body(dto.CreateBroadcastRequest{}).
+					returns(http.StatusCreated, dto.BroadcastCampaignResponse{}),
+				post("/broadcasts/preview", "Preview a broadcast segment").
+					body(dto.PreviewBroadcastSegmentRequest{}).
+					returns(http.StatusOK, dto.BroadcastSegmentPreview{}),
+				get("/broadcasts/:id", "Get a broadcast campaign").returns(http.StatusOK, dto.BroadcastCampaignResponse{}),
+				post("/broadcasts/:id/cancel", "Cancel a broadcast campaign").returns(http.StatusOK, dto.BroadcastCampaignResponse{}),
+
+				get("/reconciliation/runs", "List balance reconciliation runs").
+					query(queryString("chain", "chain filter")).
+					returns(http.StatusOK, dto.BalanceReconciliationRunList{}),
+				get("/reconciliation/discrepancies", "List balance discrepancies").
+					query(queryString("status", "status filter"), queryString("chain", "chain filter"), queryString("run_id", "reconciliation run")).
+					returns(http.StatusOK, dto.BalanceDiscrepancyList{}),
+				post("/reconciliation/discrepancies/:id/resolve", "Resolve a balance discrepancy").
+					body(dto.ResolveBalanceDiscrepancyRequest{}).
+					returns(http.StatusOK, dto.BalanceDiscrepancyResponse{}),
+			},
+		},
+		{
+			tag:         "KYC review",
+			description: "Compliance review of KYC profiles and AML screening hits.",
+			prefix:      "/admin/kyc",
+			endpoints: []*endpoint{
+				get("/profiles", "List KYC profiles for review").
+					query(queryString("status", "status filter"), queryInt("limit", "page size"), queryInt("offset", "number of items to skip")).
+					returns(http.StatusOK, inline{"items": []dto.KYCReviewProfile{}}),
+				get("/profiles/:userId", "Get a KYC profile for review").returns(http.StatusOK, dto.KYCReviewDetail{}),
+				post("/profiles/:userId/approve", "Approve a KYC profile").
+					body(dto.KYCApproveRequest{}).
+					returns(http.StatusOK, dto.KYCProfile{}),
+				post("/profiles/:userId/reject", "Reject a KYC profile").
+					body(dto.KYCRejectRequest{}).
+					returns(http.StatusOK, dto.KYCProfile{}),
+				post("/profiles/:userId/upgrade", "Raise a user's verification level").
+					body(dto.KYCLimitUpgradeRequest{}).
+					returns(http.StatusOK, dto.KYCProfile{}),
+				post("/profiles/:userId/documents/:documentId/download-link", "Issue a document download link").
+					returns(http.StatusCreated, dto.KYCDocumentDownloadLink{}),
+				get("/aml/reviews", "List AML reviews").
+					query(
+						queryString("status", "status filter"),
+						queryString("source", "screening source filter"),
+						queryInt("limit", "page size"),
+						queryInt("offset", "number of items to skip"),
+					).
+					returns(http.StatusOK, inline{"items": []dto.ComplianceReview{}}),
+				get("/aml/reviews/:reviewId", "Get an AML review").returns(http.StatusOK, dto.ComplianceReview{}),
+				post("/aml/reviews/:reviewId/resolve", "Resolve an AML review").
+					body(dto.ComplianceReviewResolveRequest{}).
+					returns(http.StatusOK, dto.ComplianceReview{}),
+				get("/aml/users/:userId", "A user's AML risk").returns(http.StatusOK, dto.AMLUserRisk{}),
+			},
+		},
+		{
+			tag:         "Chains",
+			description: "Gas prices and gas preferences.",
+			prefix:      "/chains",
+			endpoints: []*endpoint{
+				get("/gas/preference", "Get the gas preference").returns(http.StatusOK, dto.GasPreferenceResponse{}),
+				put("/gas/preference", "Update the gas preference").
+					body(dto.GasPreferenceRequest{}).
+					returns(http.StatusOK, dto.GasPreferenceResponse{}),
+				get("/:chain/gas", "Gas price estimates").
+					query(queryString("window", "history window")).
+					returns(http.StatusOK, dto.GasOracleResponse{}),
+			},
+		},
+		{
+			tag:         "Rates",
+			description: "Exchange rates and price history.",
+			prefix:      "/rates",
+			endpoints: []*endpoint{
+				get("/", "Current USD rates").
+					query(queryString("symbols", "comma separated symbols; all when omitted")).
+					returns(http.StatusOK, dto.ExchangeRateList{}),
+				get("/history", "Price history").
+					query(
+						queryString("symbol", "asset symbol"),
+						queryString("interval", "sample interval"),
+						queryInt("limit", "page size"),
+						queryInt("offset", "number of items to skip"),
+						queryString("sort_by", "sort field"),
+						queryString("from", "range start"),
+						queryString("to", "range end"),
+					).
+					returns(http.StatusOK, dto.PriceHistoryList{}),
+				get("/symbols", "Supported symbols").returns(http.StatusOK, dto.RateSymbolCatalog{}),
+				get("/sparklines", "Price sparklines").
+					query(queryString("symbols", "comma separated symbols")).
+					returns(http.StatusOK, dto.SparklineList{}),
+				get("/:symbol/candles", "OHLC candles").
+					query(queryString("interval", "candle interval"), queryString("from", "range start"), queryString("to", "range end")).
+					returns(http.StatusOK, inline{
+						"symbol":             "",
+						"interval":           "",
+						"requested_interval": "",
+						"from":               time.Time{},
+						"to":                 time.Time{},
+						"candles":            []dto.Candle{},
+					}),
+			},
+		},
+		{
+			tag:         "Analytics",
+			description: "Portfolio analytics, tax reports and saved reports.",
+			prefix:      "/analytics",
+			endpoints: []*endpoint{
+				get("/transactions", "Transaction history").
+					queryFrom(dto.GetTransactionHistoryRequest{}).
+					returns(http.StatusOK, dto.TransactionListResponse{}),
+				post("/transactions/export", "Request a transaction export").
+					body(dto.ExportTransactionsRequest{}).
+					returns(http.StatusAccepted, dto.ExportJobResponse{}),
+				get("/transactions/summary", "Transaction analytics; not yet implemented").returns(http.StatusNotImplemented, nil),
+				get("/wallets/:walletId", "Wallet analytics; not yet implemented").returns(http.StatusNotImplemented, nil),
+				get("/portfolio", "Portfolio summary").
+					query(queryString("currency", "display currency")).
+					returns(http.StatusOK, dto.PortfolioSummary{}),
+				get("/performance", "Portfolio performance").
+					query(queryString("period", "performance period"), queryString("currency", "display currency")).
+					returns(http.StatusOK, dto.PortfolioPerformance{}),
+				get("/rebalance", "Rebalancing suggestions").
+					queryFrom(dto.RebalanceRequest{}).
+					returns(http.StatusOK, dto.RebalanceSuggestions{}),
+				get("/tax-report", "Tax report; format=csv downloads Form 8949 rows").
+					queryFrom(dto.TaxReportRequest{}).
+					returns(http.StatusOK, dto.TaxReport{}),
+				get("/report-schedule", "Get the report schedule").returns(http.StatusOK, dto.ReportScheduleResponse{}),
+				put("/report-schedule", "Set the report schedule").
+					body(dto.ReportScheduleRequest{}).
+					returns(http.StatusOK, dto.ReportScheduleResponse{}),
+				del("/report-schedule", "Remove the report schedule").returns(http.StatusNoContent, nil),
+				get("/reports/catalog", "Report datasets and fields").returns(http.StatusOK, dto.ReportCatalogResponse{}),
+				post("/reports/run", "Run an ad hoc report; 202 when queued").
+					body(dto.RunReportRequest{}).
+					returns(http.StatusOK, dto.ReportRunResponse{}),
+				post("/reports/:id/run", "Run a saved report; 202 when queued").
+					body(dto.ReportRunOptions{}).
+					returns(http.StatusOK, dto.ReportRunResponse{}),
+				get("/reports", "List saved reports").returns(http.StatusOK, inline{"reports": []dto.SavedReportResponse{}}),
+				post("/reports", "Save a report").
+					body(dto.SaveReportRequest{}).
+					returns(http.StatusCreated, dto.SavedReportResponse{}),
+				get("/reports/:id", "Get a saved report").returns(http.StatusOK, dto.SavedReportResponse{}),
+				put("/reports/:id", "Update a saved report").
+					body(dto.SaveReportRequest{}).
+					returns(http.StatusOK, dto.SavedReportResponse{}),
+				del("/reports/:id", "Delete a saved report").returns(http.StatusNoContent, nil),
+				get("/shares", "List portfolio share links").returns(http.StatusOK, inline{"shares": []dto.PortfolioShareResponse{}}),
+				post("/shares", "Create a portfolio share link").
+					body(dto.CreatePortfolioShareRequest{}).
+					returns(http.StatusCreated, dto.CreatedPortfolioShare{}),
+				del("/shares/:id", "Revoke a portfolio share link").returns(http.StatusNoContent, nil),
+				get("/shares/:id/accesses", "List accesses of a share link").
+					returns(http.StatusOK, inline{"accesses": []dto.PortfolioShareAccessResponse{}}),
+			},
+		},
+		{
+			tag:         "Connected apps",
+			description: "Third-party app consents.",
+			prefix:      "/apps",
+			endpoints: []*endpoint{
+				post("/consents", "Grant an app consent").
+					body(dto.AppConsentRequest{}).
+					returns(http.StatusCreated, dto.AppConsentResponse{}),
+				get("/connected", "List connected apps").returns(http.StatusOK, inline{"items": []dto.ConnectedApp{}}),
+				del("/connected/:consentId", "Disconnect an app").returns(http.StatusNoContent, nil),
+			},
+		},
+	}
+}
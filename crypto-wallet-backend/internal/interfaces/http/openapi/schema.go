@@ -0,0 +1,227 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/crypto-wallet/backend/pkg/money"
+)
+
+// stringTypes are types that marshal to JSON strings, with the format to advertise.
+var stringTypes = map[reflect.Type]string{
+	reflect.TypeOf(time.Time{}):       "date-time",
+	reflect.TypeOf(uuid.UUID{}):       "uuid",
+	reflect.TypeOf(decimal.Decimal{}): "decimal",
+	reflect.TypeOf(money.Money{}):     "decimal",
+}
+
+var rawMessageType = reflect.TypeOf(json.RawMessage{})
+
+// schemaRegistry derives schemas from Go types by their json tags. Named structs are registered
+// as components and referenced, so recursive types terminate.
+type schemaRegistry struct {
+	schemas map[string]*Schema
+	names   map[reflect.Type]string
+}
+
+func newSchemaRegistry() *schemaRegistry {
+	return &schemaRegistry{
+		schemas: make(map[string]*Schema),
+		names:   make(map[reflect.Type]string),
+	}
+}
+
+// schemaFor returns the schema of the value's type. A nil value yields nil.
+func (r *schemaRegistry) schemaFor(v any) *Schema {
+	if v == nil {
+		return nil
+	}
+	switch v := v.(type) {
+	case *Schema:
+		return v
+	case inline:
+		s := &Schema{Type: "object", Properties: make(map[string]*Schema, len(v))}
+		for name, value := range v {
+			s.Properties[name] = r.schemaFor(value)
+		}
+		return s
+	}
+	return r.schema(reflect.TypeOf(v))
+}
+
+func (r *schemaRegistry) schema(t reflect.Type) *Schema {
+	if format, ok := stringTypes[t]; ok {
+		return &Schema{Type: "string", Format: format}
+	}
+	if t == rawMessageType {
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		s := r.schema(t.Elem())
+		if s.Ref != "" {
+			// OpenAPI 3.0 ignores siblings of $ref, so a nullable reference is left as is.
+			return s
+		}
+		s.Nullable = true
+		return s
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: r.schema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: r.schema(t.Elem())}
+	case reflect.Struct:
+		return r.structRef(t)
+	default:
+		// Interfaces and anything else accept any JSON value.
+		return &Schema{}
+	}
+}
+
+// structRef registers the struct as a component and returns a reference to it. Anonymous structs
+// are inlined.
+func (r *schemaRegistry) structRef(t reflect.Type) *Schema {
+	if t.Name() == "" {
+		return r.structSchema(t)
+	}
+	name, ok := r.names[t]
+	if !ok {
+		name = r.componentName(t)
+		r.names[t] = name
+		// Reserve the name before descending so self-references resolve.
+		r.schemas[name] = &Schema{}
+		*r.schemas[name] = *r.structSchema(t)
+	}
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// componentName is the type name, qualified by its package when another package already uses it.
+func (r *schemaRegistry) componentName(t reflect.Type) string {
+	name := t.Name()
+	if _, taken := r.schemas[name]; !taken {
+		return name
+	}
+	pkg := t.PkgPath()
+	if i := strings.LastIndex(pkg, "/"); i >= 0 {
+		pkg = pkg[i+1:]
+	}
+	return strings.ToUpper(pkg[:1]) + pkg[1:] + name
+}
+
+func (r *schemaRegistry) structSchema(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	r.addFields(s, t)
+	return s
+}
+
+func (r *schemaRegistry) addFields(s *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+
+		// Untagged embedded structs are flattened, as encoding/json does.
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				r.addFields(s, embedded)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		prop := r.schema(field.Type)
+		if options == "string" && prop.Type != "" {
+			prop = &Schema{Type: "string"}
+		}
+		validate := field.Tag.Get("validate")
+		if values := oneOf(validate); len(values) > 0 && prop.Type == "string" {
+			prop.Enum = values
+		}
+		s.Properties[name] = prop
+		if hasRule(validate, "required") {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+// queryParameters lists the query parameters bound from the struct's query tags.
+func (r *schemaRegistry) queryParameters(v any) []Parameter {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	var params []Parameter
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("query"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		schema := r.schema(field.Type)
+		if schema.Type == "array" {
+			// Fiber binds comma separated values into slices.
+			schema = &Schema{Type: "string", Description: "comma separated list"}
+		}
+		validate := field.Tag.Get("validate")
+		if values := oneOf(validate); len(values) > 0 && schema.Type == "string" {
+			schema.Enum = values
+		}
+		params = append(params, Parameter{
+			Name:     name,
+			In:       "query",
+			Required: hasRule(validate, "required"),
+			Schema:   schema,
+		})
+	}
+	return params
+}
+
+func hasRule(validate, rule string) bool {
+	for _, r := range strings.Split(validate, ",") {
+		if r == rule {
+			return true
+		}
+	}
+	return false
+}
+
+func oneOf(validate string) []string {
+	for _, r := range strings.Split(validate, ",") {
+		if values, ok := strings.CutPrefix(r, "oneof="); ok {
+			return strings.Fields(values)
+		}
+	}
+	return nil
+}
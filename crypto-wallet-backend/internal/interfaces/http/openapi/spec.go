@@ -0,0 +1,271 @@
+package openapi
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/crypto-wallet/backend/pkg/utils"
+)
+
+const (
+	methodGet    = http.MethodGet
+	methodPost   = http.MethodPost
+	methodPut    = http.MethodPut
+	methodPatch  = http.MethodPatch
+	methodDelete = http.MethodDelete
+)
+
+// methods are the HTTP methods the API documents, in the order they are listed.
+var methods = []string{methodGet, methodPost, methodPut, methodPatch, methodDelete}
+
+const (
+	contentJSON      = "application/json"
+	contentMultipart = "multipart/form-data"
+	contentBinary    = "application/octet-stream"
+	contentHTML      = "text/html"
+)
+
+// Security scheme names.
+const (
+	schemeBearer     = "bearerAuth"
+	schemePartnerKey = "partnerApiKey"
+	schemeShareToken = "shareToken"
+)
+
+// authMode selects the security requirement of an endpoint.
+type authMode int
+
+const (
+	authBearer authMode = iota
+	authNone
+	authPartnerKey
+	authShareToken
+)
+
+// group is a set of endpoints mounted under one prefix, as a handler's Register call is.
+type group struct {
+	tag         string
+	description string
+	prefix      string
+	auth        authMode
+	endpoints   []*endpoint
+}
+
+// endpoint declares one operation. Paths use Fiber's :param syntax so they read like the
+// handler's route registration.
+type endpoint struct {
+	method       string
+	path         string
+	summary      string
+	params       []Parameter
+	queryType    any
+	request      any
+	requestType  string
+	status       int
+	response     any
+	responseType string
+}
+
+func get(path, summary string) *endpoint   { return newEndpoint(methodGet, path, summary) }
+func post(path, summary string) *endpoint  { return newEndpoint(methodPost, path, summary) }
+func put(path, summary string) *endpoint   { return newEndpoint(methodPut, path, summary) }
+func patch(path, summary string) *endpoint { return newEndpoint(methodPatch, path, summary) }
+func del(path, summary string) *endpoint   { return newEndpoint(methodDelete, path, summary) }
+
+func newEndpoint(method, path, summary string) *endpoint {
+	return &endpoint{method: method, path: path, summary: summary, status: http.StatusOK}
+}
+
+// query adds query parameters.
+func (e *endpoint) query(params ...Parameter) *endpoint {
+	e.params = append(e.params, params...)
+	return e
+}
+
+// queryFrom adds the query parameters bound from the struct's query tags.
+func (e *endpoint) queryFrom(v any) *endpoint {
+	e.queryType = v
+	return e
+}
+
+// body sets the JSON request body.
+func (e *endpoint) body(v any) *endpoint {
+	e.request = v
+	e.requestType = contentJSON
+	return e
+}
+
+// upload sets a multipart request body carrying one file field.
+func (e *endpoint) upload(field string) *endpoint {
+	e.request = &Schema{
+		Type:       "object",
+		Properties: map[string]*Schema{field: {Type: "string", Format: "binary"}},
+		Required:   []string{field},
+	}
+	e.requestType = contentMultipart
+	return e
+}
+
+// returns sets the success status and JSON body; a nil body documents an empty response.
+func (e *endpoint) returns(status int, v any) *endpoint {
+	e.status = status
+	e.response = v
+	e.responseType = contentJSON
+	return e
+}
+
+// produces sets a success body in a content type other than JSON.
+func (e *endpoint) produces(status int, contentType string) *endpoint {
+	e.status = status
+	e.response = &Schema{Type: "string", Format: "binary"}
+	e.responseType = contentType
+	return e
+}
+
+func queryString(name, description string) Parameter {
+	return Parameter{Name: name, In: "query", Description: description, Schema: &Schema{Type: "string"}}
+}
+
+func queryInt(name, description string) Parameter {
+	return Parameter{Name: name, In: "query", Description: description, Schema: &Schema{Type: "integer"}}
+}
+
+func queryBool(name, description string) Parameter {
+	return Parameter{Name: name, In: "query", Description: description, Schema: &Schema{Type: "boolean"}}
+}
+
+func queryEnum(name, description string, values ...string) Parameter {
+	return Parameter{Name: name, In: "query", Description: description, Schema: &Schema{Type: "string", Enum: values}}
+}
+
+// listQuery documents the pagination and sorting parameters of handlers using QueryBinder.List.
+func listQuery(cursor bool, sortFields ...string) []Parameter {
+	params := []Parameter{
+		queryInt("limit", "page size"),
+		queryInt("offset", "number of items to skip"),
+	}
+	if cursor {
+		params = append(params, queryString("cursor", "opaque cursor from a previous page; takes precedence over offset"))
+	}
+	if len(sortFields) > 0 {
+		params = append(params,
+			queryEnum("sort_by", "sort field", sortFields...),
+			queryEnum("sort_order", "sort direction", "asc", "desc"),
+		)
+	}
+	return params
+}
+
+// inline is an object schema for bodies that are not DTOs, such as the fiber.Map envelopes of
+// plain lists. Values are Go values of the property types, or schemas.
+type inline map[string]any
+
+// NewDocument builds the API description. serverURL is the prefix the paths are relative to.
+func NewDocument(serverURL string) *Document {
+	registry := newSchemaRegistry()
+	doc := &Document{
+		OpenAPI: Version,
+		Info: Info{
+			Title:       "Crypto Wallet API",
+			Description: "Wallets, transfers, exchange rates, KYC and account management.",
+			Version:     "1.0.0",
+		},
+		Servers: []Server{{URL: serverURL}},
+		Paths:   make(map[string]*PathItem),
+		Components: Components{
+			SecuritySchemes: map[string]SecurityScheme{
+				schemeBearer: {
+					Type:         "http",
+					Scheme:       "bearer",
+					BearerFormat: "JWT",
+					Description:  "Access token; in cookie-auth mode the access token cookie is accepted instead.",
+				},
+				schemePartnerKey: {Type: "apiKey", In: "header", Name: "X-API-Key", Description: "Partner API key."},
+				schemeShareToken: {Type: "apiKey", In: "header", Name: "X-Share-Token", Description: "Portfolio share link token."},
+			},
+		},
+	}
+
+	errorResponse := Response{
+		Description: "Error",
+		Content:     map[string]MediaType{contentJSON: {Schema: registry.schemaFor(utils.ErrorResponse{})}},
+	}
+
+	for _, g := range groups() {
+		doc.Tags = append(doc.Tags, Tag{Name: g.tag, Description: g.description})
+		for _, e := range g.endpoints {
+			path, pathParams := openAPIPath(g.prefix + e.path)
+			item, ok := doc.Paths[path]
+			if !ok {
+				item = &PathItem{}
+				doc.Paths[path] = item
+			}
+
+			op := &Operation{
+				Tags:        []string{g.tag},
+				Summary:     e.summary,
+				OperationID: operationID(e.method, path),
+				Parameters:  pathParams,
+				Responses:   map[string]Response{"default": errorResponse},
+			}
+			op.Parameters = append(op.Parameters, e.params...)
+			if e.queryType != nil {
+				op.Parameters = append(op.Parameters, registry.queryParameters(e.queryType)...)
+			}
+			if e.request != nil {
+				op.RequestBody = &RequestBody{
+					Required: true,
+					Content:  map[string]MediaType{e.requestType: {Schema: registry.schemaFor(e.request)}},
+				}
+			}
+
+			success := Response{Description: http.StatusText(e.status)}
+			if e.response != nil {
+				success.Content = map[string]MediaType{e.responseType: {Schema: registry.schemaFor(e.response)}}
+			}
+			op.Responses[strconv.Itoa(e.status)] = success
+
+			switch g.auth {
+			case authBearer:
+				op.Security = []SecurityRequirement{{schemeBearer: {}}}
+			case authPartnerKey:
+				op.Security = []SecurityRequirement{{schemePartnerKey: {}}}
+			case authShareToken:
+				op.Security = []SecurityRequirement{{schemeShareToken: {}}}
+			}
+			item.setOperation(e.method, op)
+		}
+	}
+
+	doc.Components.Schemas = registry.schemas
+	return doc
+}
+
+// openAPIPath converts Fiber :param segments to {param} and documents them as path parameters.
+func openAPIPath(path string) (string, []Parameter) {
+	path = normalizePath(path)
+	segments := strings.Split(path, "/")
+	var params []Parameter
+	for i, segment := range segments {
+		name, ok := strings.CutPrefix(segment, ":")
+		if !ok {
+			continue
+		}
+		segments[i] = "{" + name + "}"
+		params = append(params, Parameter{Name: name, In: "path", Required: true, Schema: &Schema{Type: "string"}})
+	}
+	return strings.Join(segments, "/"), params
+}
+
+// operationID derives a stable identifier such as getWalletsIdBalance from the method and path.
+func operationID(method, path string) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(method))
+	for _, segment := range strings.FieldsFunc(path, func(r rune) bool {
+		return r == '/' || r == '{' || r == '}' || r == '-' || r == '.' || r == '_'
+	}) {
+		b.WriteString(strings.ToUpper(segment[:1]) + segment[1:])
+	}
+	return b.String()
+}
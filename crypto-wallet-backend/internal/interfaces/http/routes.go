@@ -6,9 +6,11 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 
+	"github.com/crypto-wallet/backend/internal/application/dto"
 	"github.com/crypto-wallet/backend/internal/domain/entities"
 	"github.com/crypto-wallet/backend/internal/interfaces/http/handlers"
 	"github.com/crypto-wallet/backend/internal/interfaces/http/middleware"
+	"github.com/crypto-wallet/backend/internal/interfaces/http/openapi"
 )
 
 // DefaultAPIPrefix defines the root path for versioned API routes.
@@ -22,9 +24,73 @@ type RouteOptions struct {
 	AuthHandler        *handlers.AuthHandler
 	WalletHandler      *handlers.WalletHandler
 	TransactionHandler *handlers.TransactionHandler
-	AnalyticsHandler   *handlers.AnalyticsHandler
-	KYCHandler         *handlers.KYCHandler
-	KYCEnforcer        *middleware.KYCEnforcer
+	P2PHandler         *handlers.P2PHandler
+	ProfileHandler     *handlers.ProfileHandler
+	PreferencesHandler *handlers.PreferencesHandler
+	AccountHandler     *handlers.AccountHandler
+	ExportHandler      *handlers.ExportHandler
+	WebhookHandler     *handlers.WebhookHandler
+	AddressBookHandler *handlers.AddressBookHandler
+	BroadcastHandler   *handlers.BroadcastHandler
+	// SupportAccess guards /support routes; support routes are not registered without it.
+	SupportAccess         fiber.Handler
+	DisputeSupportHandler *handlers.P2PDisputeSupportHandler
+	AuditHandler          *handlers.AuditHandler
+	KYCComplianceHandler  *handlers.KYCComplianceHandler
+	EventExportHandler    *handlers.EventExportHandler
+	AdminOpsHandler       *handlers.AdminOperationsHandler
+	ChainRolloutHandler   *handlers.ChainRolloutHandler
+	// ChainConfigHandler manages the chain registry under /support/chains.
+	ChainConfigHandler    *handlers.ChainConfigHandler
+	BroadcastAdminHandler *handlers.BroadcastAdminHandler
+	// ReconciliationHandler serves balance reconciliation reports under /support/reconciliation.
+	ReconciliationHandler *handlers.BalanceReconciliationHandler
+	AnalyticsHandler      *handlers.AnalyticsHandler
+	RateHandler           *handlers.RateHandler
+	KYCHandler            *handlers.KYCHandler
+	KYCEnforcer           *middleware.KYCEnforcer
+	// ScopeEnforcer limits third-party app tokens per route group. When nil, app tokens are
+	// rejected everywhere.
+	ScopeEnforcer        *middleware.ScopeEnforcer
+	ConnectedAppsHandler *handlers.ConnectedAppsHandler
+	// PartnerAuth authenticates partner API keys; partner routes are not registered without it.
+	PartnerAuth       fiber.Handler
+	PartnerKYCHandler *handlers.PartnerKYCHandler
+	// PublicMarketHandler serves unauthenticated market data; leave nil to disable the public API.
+	PublicMarketHandler *handlers.PublicMarketHandler
+	// SharedPortfolioHandler serves portfolio share links, authenticated by the link token alone.
+	SharedPortfolioHandler *handlers.SharedPortfolioHandler
+	// KYCDocumentDownloadHandler serves KYC document download links, authenticated by the link token alone.
+	KYCDocumentDownloadHandler *handlers.KYCDocumentDownloadHandler
+	// CSRFMiddleware validates CSRF tokens of cookie-authenticated requests; set it only in
+	// cookie-auth mode.
+	CSRFMiddleware fiber.Handler
+	// AccessControl enforces role permissions on staff routes. /admin/kyc routes are not
+	// registered without it, and the admin-only /support routes are skipped.
+	AccessControl    *middleware.AccessControl
+	KYCReviewHandler *handlers.KYCReviewHandler
+	// AMLReviewHandler serves AML screening hits under /admin/kyc/aml, behind the compliance review
+	// permission.
+	AMLReviewHandler *handlers.AMLReviewHandler
+	GasHandler       *handlers.GasHandler
+	// TransactionNoteHandler syncs encrypted transaction notes under /transactions/notes.
+	TransactionNoteHandler *handlers.TransactionNoteHandler
+	// ActivityHandler serves the merged transaction and exchange feed under /activity.
+	ActivityHandler *handlers.ActivityHandler
+	// GraphQLHandler serves the read-only GraphQL endpoint under /graphql.
+	GraphQLHandler *handlers.GraphQLHandler
+	// ExchangeExecutionHandler executes quoted exchanges and reports their status under /exchange.
+	ExchangeExecutionHandler *handlers.ExchangeExecutionHandler
+	// NotificationHandler serves the in-app notification inbox under /notifications.
+	NotificationHandler *handlers.NotificationHandler
+	// UserRateLimit limits each authenticated user; it runs after authentication.
+	UserRateLimit fiber.Handler
+	// StepUp requires a fresh two-factor code on sends, address book additions and disabling
+	// two-factor authentication. Those routes are unguarded when it is nil.
+	StepUp *middleware.StepUpAuth
+	// OpenAPIHandler serves the API description; registered routes are checked against its
+	// document once routing is set up.
+	OpenAPIHandler *handlers.OpenAPIHandler
 }
 
 // RegisterRoutes wires application endpoints onto the provided Fiber application.
@@ -47,9 +113,59 @@ func RegisterRoutes(app *fiber.App, opts RouteOptions) {
 	public := app.Group(prefix)
 	registerHealthRoutes(public, logger)
 
+	if opts.OpenAPIHandler != nil {
+		opts.OpenAPIHandler.Register(public)
+		logger.Debug("openapi routes registered")
+	}
+
+	if opts.PublicMarketHandler != nil {
+		opts.PublicMarketHandler.Register(public.Group("/public"))
+		logger.Debug("public market routes registered")
+	}
+
+	if opts.SharedPortfolioHandler != nil {
+		opts.SharedPortfolioHandler.Register(public.Group("/shared/portfolio"))
+		logger.Debug("shared portfolio routes registered")
+	}
+
+	if opts.KYCDocumentDownloadHandler != nil {
+		opts.KYCDocumentDownloadHandler.Register(public.Group("/kyc-documents"))
+		logger.Debug("kyc document download routes registered")
+	}
+
+	// Partner endpoints authenticate with API keys instead of user tokens.
+	if opts.PartnerAuth != nil && opts.PartnerKYCHandler != nil {
+		partnerGroup := public.Group("/partners", opts.PartnerAuth)
+		opts.PartnerKYCHandler.Register(partnerGroup.Group("/kyc"))
+		logger.Debug("partner routes registered")
+	}
+
+	// Token refresh, revocation and email verification authenticate with the token itself, so they
+	// are registered ahead of the bearer token middleware.
+	if opts.AuthHandler != nil {
+		tokenGroup := public.Group("/auth")
+		if opts.CSRFMiddleware != nil {
+			tokenGroup.Use(opts.CSRFMiddleware)
+		}
+		tokenGroup.Post("/refresh", opts.AuthHandler.Refresh())
+		tokenGroup.Post("/revoke", opts.AuthHandler.Revoke())
+		tokenGroup.Post("/email/verify", middleware.ValidateBody[dto.VerifyEmailRequest](), opts.AuthHandler.VerifyEmail())
+		logger.Debug("auth token routes registered")
+	}
+
+	if opts.ScopeEnforcer == nil {
+		opts.ScopeEnforcer = middleware.NewScopeEnforcer(middleware.ScopeEnforcerConfig{Logger: logger})
+	}
+
 	// Secure endpoints (authentication required).
 	if opts.AuthMiddleware != nil {
 		secure := public.Group("", opts.AuthMiddleware)
+		if opts.UserRateLimit != nil {
+			secure.Use(opts.UserRateLimit)
+		}
+		if opts.CSRFMiddleware != nil {
+			secure.Use(opts.CSRFMiddleware)
+		}
 		registerSecureRoutes(secure, logger, opts)
 	}
 
@@ -64,6 +180,63 @@ func RegisterRoutes(app *fiber.App, opts RouteOptions) {
 	})
 
 	logger.Info("http routes registered", slog.String("prefix", prefix))
+
+	if opts.OpenAPIHandler != nil && opts.OpenAPIHandler.Document() != nil {
+		checkAPIDocument(app, prefix, opts.OpenAPIHandler.Document(), logger)
+	}
+}
+
+// checkAPIDocument logs routes that are served but missing from the OpenAPI document, so drift
+// shows up at startup. Documented operations that are not served are expected when a feature is
+// not configured and are only logged at debug level.
+func checkAPIDocument(app *fiber.App, prefix string, document *openapi.Document, logger *slog.Logger) {
+	coverage := openapi.CheckRoutes(document, app.GetRoutes(true), prefix)
+	for _, route := range coverage.Undocumented {
+		logger.Warn("route missing from openapi document", slog.String("route", route.String()))
+	}
+	for _, route := range coverage.Unregistered {
+		logger.Debug("documented route not registered", slog.String("route", route.String()))
+	}
+	if coverage.Complete() {
+		logger.Debug("openapi document covers all routes", slog.Int("unregistered", len(coverage.Unregistered)))
+	}
+}
+
+// DefaultInternalAPIPrefix defines the root path of the server-to-server API on the internal listener.
+const DefaultInternalAPIPrefix = "/internal/v1"
+
+// InternalRouteOptions defines dependencies of the internal, mTLS-only API.
+type InternalRouteOptions struct {
+	Logger *slog.Logger
+	// ConsumerAuth maps client certificates to registered consumers; nothing is registered without it.
+	ConsumerAuth         fiber.Handler
+	InternalEventHandler *handlers.InternalEventHandler
+}
+
+// RegisterInternalRoutes wires the server-to-server endpoints onto the internal Fiber application,
+// which is served separately from the public API.
+func RegisterInternalRoutes(app *fiber.App, opts InternalRouteOptions) {
+	if app == nil {
+		return
+	}
+
+	logger := opts.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	internal := app.Group(DefaultInternalAPIPrefix)
+	registerHealthRoutes(internal, logger)
+
+	if opts.ConsumerAuth == nil {
+		logger.Warn("internal consumer authentication not configured; internal routes disabled")
+		return
+	}
+	if opts.InternalEventHandler != nil {
+		opts.InternalEventHandler.Register(internal.Group("/events", opts.ConsumerAuth))
+	}
+
+	logger.Info("internal http routes registered", slog.String("prefix", DefaultInternalAPIPrefix))
 }
 
 func registerHealthRoutes(router fiber.Router, logger *slog.Logger) {
@@ -80,43 +253,218 @@ func registerHealthRoutes(router fiber.Router, logger *slog.Logger) {
 }
 
 func registerSecureRoutes(router fiber.Router, logger *slog.Logger, opts RouteOptions) {
+	// Routes not opened to a scope below are first-party only.
+	firstPartyOnly := opts.ScopeEnforcer.Require("", "")
+
+	// stepUp guards one route; it is registered ahead of the handler's own route and passes on to
+	// it once the code is verified.
+	stepUp := func(group fiber.Router, method, path string) {
+		if opts.StepUp != nil {
+			group.Add(method, path, opts.StepUp.Require())
+		}
+	}
+
 	if opts.AuthHandler != nil {
-		authGroup := router.Group("/auth")
-		authGroup.Post("/register", opts.AuthHandler.Register())
-		authGroup.Post("/login", opts.AuthHandler.Login())
+		authGroup := router.Group("/auth", firstPartyOnly)
+		authGroup.Post("/register", middleware.ValidateBody[dto.RegisterRequest](), opts.AuthHandler.Register())
+		authGroup.Post("/login", middleware.ValidateBody[dto.LoginRequest](), opts.AuthHandler.Login())
 		authGroup.Post("/logout", opts.AuthHandler.Logout())
 		authGroup.Post("/2fa/setup", opts.AuthHandler.GenerateTwoFactorSetup())
-		authGroup.Post("/2fa/enable", opts.AuthHandler.EnableTwoFactor())
+		authGroup.Post("/2fa/enable", middleware.ValidateBody[dto.EnableTwoFactorRequest](), opts.AuthHandler.EnableTwoFactor())
+		stepUp(authGroup, fiber.MethodPost, "/2fa/disable")
 		authGroup.Post("/2fa/disable", opts.AuthHandler.DisableTwoFactor())
+		if opts.StepUp != nil {
+			authGroup.Post("/2fa/step-up", opts.StepUp.Elevate())
+		}
+		authGroup.Post("/email/verification", opts.AuthHandler.SendEmailVerification())
 		logger.Debug("auth routes registered")
 	}
 
 	if opts.KYCHandler != nil {
-		kycGroup := router.Group("/kyc")
+		kycGroup := router.Group("/kyc", firstPartyOnly)
 		opts.KYCHandler.Register(kycGroup)
 		logger.Debug("kyc routes registered")
 	}
 
 	if opts.WalletHandler != nil {
-		walletGroup := router.Group("/wallets")
+		walletGroup := router.Group("/wallets", opts.ScopeEnforcer.Require(entities.OAuthScopeReadWallets, ""))
 		opts.WalletHandler.Register(walletGroup)
 		logger.Debug("wallet routes registered")
 	}
 
+	// Registered before the transaction routes so /transactions/:id does not capture it.
+	if opts.TransactionNoteHandler != nil {
+		noteGroup := router.Group("/transactions/notes", firstPartyOnly)
+		opts.TransactionNoteHandler.Register(noteGroup)
+		logger.Debug("transaction note routes registered")
+	}
+
 	if opts.TransactionHandler != nil {
-		txGroup := router.Group("/transactions")
+		txGroup := router.Group("/transactions", opts.ScopeEnforcer.Require(entities.OAuthScopeReadTransactions, entities.OAuthScopeTrade))
 		if opts.KYCEnforcer != nil {
 			txGroup.Use(opts.KYCEnforcer.Require(entities.VerificationLevelBasic))
 		}
+		stepUp(txGroup, fiber.MethodPost, "/")
 		opts.TransactionHandler.Register(txGroup)
 		logger.Debug("transaction routes registered")
 	}
 
+	if opts.ActivityHandler != nil {
+		activityGroup := router.Group("/activity", opts.ScopeEnforcer.Require(entities.OAuthScopeReadTransactions, ""))
+		opts.ActivityHandler.Register(activityGroup)
+		logger.Debug("activity routes registered")
+	}
+
+	if opts.GraphQLHandler != nil {
+		graphqlGroup := router.Group("/graphql", firstPartyOnly)
+		opts.GraphQLHandler.Register(graphqlGroup)
+		logger.Debug("graphql routes registered")
+	}
+
+	if opts.ExchangeExecutionHandler != nil {
+		exchangeGroup := router.Group("/exchange", opts.ScopeEnforcer.Require(entities.OAuthScopeReadTransactions, entities.OAuthScopeTrade))
+		if opts.KYCEnforcer != nil {
+			exchangeGroup.Use(opts.KYCEnforcer.Require(entities.VerificationLevelBasic))
+		}
+		stepUp(exchangeGroup, fiber.MethodPost, "/execute")
+		opts.ExchangeExecutionHandler.Register(exchangeGroup)
+		logger.Debug("exchange execution routes registered")
+	}
+
+	if opts.P2PHandler != nil {
+		p2pGroup := router.Group("/p2p", firstPartyOnly)
+		if opts.KYCEnforcer != nil {
+			p2pGroup.Use(opts.KYCEnforcer.Require(entities.VerificationLevelBasic))
+		}
+		stepUp(p2pGroup, fiber.MethodPost, "/")
+		opts.P2PHandler.Register(p2pGroup)
+		logger.Debug("p2p routes registered")
+	}
+
+	if opts.ProfileHandler != nil {
+		profileGroup := router.Group("/profiles", firstPartyOnly)
+		opts.ProfileHandler.Register(profileGroup)
+		logger.Debug("profile routes registered")
+	}
+
+	if opts.PreferencesHandler != nil || opts.AccountHandler != nil {
+		userGroup := router.Group("/users", firstPartyOnly)
+		if opts.PreferencesHandler != nil {
+			opts.PreferencesHandler.Register(userGroup)
+			logger.Debug("preferences routes registered")
+		}
+		if opts.AccountHandler != nil {
+			stepUp(userGroup, fiber.MethodDelete, "/me")
+			opts.AccountHandler.Register(userGroup)
+			logger.Debug("account routes registered")
+		}
+	}
+
+	if opts.ExportHandler != nil {
+		exportGroup := router.Group("/exports", firstPartyOnly)
+		opts.ExportHandler.Register(exportGroup)
+		logger.Debug("export routes registered")
+	}
+
+	if opts.WebhookHandler != nil {
+		webhookGroup := router.Group("/webhooks", firstPartyOnly)
+		opts.WebhookHandler.Register(webhookGroup)
+		logger.Debug("webhook routes registered")
+	}
+
+	if opts.AddressBookHandler != nil {
+		addressBookGroup := router.Group("/address-book", firstPartyOnly)
+		stepUp(addressBookGroup, fiber.MethodPost, "/")
+		stepUp(addressBookGroup, fiber.MethodPost, "/import")
+		opts.AddressBookHandler.Register(addressBookGroup)
+		logger.Debug("address book routes registered")
+	}
+
+	if opts.NotificationHandler != nil {
+		notificationGroup := router.Group("/notifications", firstPartyOnly)
+		opts.NotificationHandler.Register(notificationGroup)
+		logger.Debug("notification routes registered")
+	}
+
+	if opts.BroadcastHandler != nil {
+		broadcastGroup := router.Group("/broadcasts", firstPartyOnly)
+		opts.BroadcastHandler.Register(broadcastGroup)
+		logger.Debug("broadcast routes registered")
+	}
+
+	if opts.SupportAccess != nil && (opts.DisputeSupportHandler != nil || opts.AuditHandler != nil || opts.KYCComplianceHandler != nil || opts.EventExportHandler != nil || opts.AdminOpsHandler != nil || opts.ChainRolloutHandler != nil || opts.ChainConfigHandler != nil || opts.BroadcastAdminHandler != nil || opts.ReconciliationHandler != nil) {
+		supportGroup := router.Group("/support", firstPartyOnly, opts.SupportAccess)
+		if opts.DisputeSupportHandler != nil {
+			opts.DisputeSupportHandler.Register(supportGroup.Group("/p2p/disputes"))
+		}
+		if opts.AuditHandler != nil {
+			opts.AuditHandler.Register(supportGroup.Group("/audit"))
+		}
+		if opts.EventExportHandler != nil {
+			opts.EventExportHandler.Register(supportGroup.Group("/events"))
+		}
+		if opts.AccessControl != nil {
+			requireCompliance := opts.AccessControl.RequirePermission(entities.PermissionComplianceReview)
+			requireAdmin := opts.AccessControl.RequirePermission(entities.PermissionPlatformAdmin)
+			if opts.KYCComplianceHandler != nil {
+				opts.KYCComplianceHandler.Register(supportGroup.Group("/kyc", requireCompliance))
+			}
+			if opts.AdminOpsHandler != nil {
+				opts.AdminOpsHandler.Register(supportGroup.Group("/admin", requireAdmin))
+			}
+			if opts.ChainRolloutHandler != nil || opts.ChainConfigHandler != nil {
+				chainAdminGroup := supportGroup.Group("/chains", requireAdmin)
+				if opts.ChainRolloutHandler != nil {
+					opts.ChainRolloutHandler.Register(chainAdminGroup)
+				}
+				if opts.ChainConfigHandler != nil {
+					opts.ChainConfigHandler.Register(chainAdminGroup)
+				}
+			}
+			if opts.BroadcastAdminHandler != nil {
+				opts.BroadcastAdminHandler.Register(supportGroup.Group("/broadcasts", requireAdmin))
+			}
+			if opts.ReconciliationHandler != nil {
+				opts.ReconciliationHandler.Register(supportGroup.Group("/reconciliation", requireAdmin))
+			}
+		}
+		logger.Debug("support routes registered")
+	}
+
+	if opts.AccessControl != nil && (opts.KYCReviewHandler != nil || opts.AMLReviewHandler != nil) {
+		reviewGroup := router.Group("/admin/kyc", firstPartyOnly, opts.AccessControl.RequirePermission(entities.PermissionComplianceReview))
+		if opts.KYCReviewHandler != nil {
+			opts.KYCReviewHandler.Register(reviewGroup)
+		}
+		if opts.AMLReviewHandler != nil {
+			opts.AMLReviewHandler.Register(reviewGroup)
+		}
+		logger.Debug("kyc review routes registered")
+	}
+
+	if opts.GasHandler != nil {
+		chainGroup := router.Group("/chains", firstPartyOnly)
+		opts.GasHandler.Register(chainGroup)
+		logger.Debug("gas oracle routes registered")
+	}
+
+	if opts.RateHandler != nil {
+		rateGroup := router.Group("/rates", firstPartyOnly)
+		opts.RateHandler.Register(rateGroup)
+		logger.Debug("rate routes registered")
+	}
+
 	if opts.AnalyticsHandler != nil {
-		analyticsGroup := router.Group("/analytics")
+		analyticsGroup := router.Group("/analytics", opts.ScopeEnforcer.Require(entities.OAuthScopeReadWallets, ""))
 		opts.AnalyticsHandler.Register(analyticsGroup)
 		logger.Debug("analytics routes registered")
 	}
 
+	if opts.ConnectedAppsHandler != nil {
+		appsGroup := router.Group("/apps", firstPartyOnly)
+		opts.ConnectedAppsHandler.Register(appsGroup)
+		logger.Debug("connected app routes registered")
+	}
+
 	logger.Debug("secure routes registered")
 }
@@ -0,0 +1,240 @@
+// Package money provides a fixed-precision monetary amount with consistent serialization.
+//
+// Amounts are held as decimals and always rendered with the precision of their asset, so
+// the same value serializes identically across every API response. Arithmetic never passes
+// through float64; FromFloat exists only to lift legacy float columns onto the type.
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// AssetUSD identifies fiat US dollar amounts.
+	AssetUSD = "USD"
+	// USDPrecision is the number of fractional digits rendered for USD amounts.
+	USDPrecision int32 = 2
+	// MaxPrecision bounds the fractional digits an amount may carry (wei has 18).
+	MaxPrecision int32 = 18
+)
+
+var (
+	// ErrAssetMismatch is returned when combining amounts of different assets.
+	ErrAssetMismatch = errors.New("money: asset mismatch")
+	// ErrDivisionByZero is returned when dividing by zero.
+	ErrDivisionByZero = errors.New("money: division by zero")
+	// ErrInvalidAmount is returned when an amount cannot be parsed.
+	ErrInvalidAmount = errors.New("money: invalid amount")
+	// ErrInvalidPrecision is returned when the precision is outside [0, MaxPrecision].
+	ErrInvalidPrecision = errors.New("money: invalid precision")
+)
+
+// Money is an amount of a single asset rendered with a fixed number of fractional digits.
+// The zero value is a zero amount with no asset and no fractional digits.
+type Money struct {
+	amount    decimal.Decimal
+	asset     string
+	precision int32
+}
+
+// New constructs a Money from a decimal amount.
+func New(amount decimal.Decimal, asset string, precision int32) (Money, error) {
+	if precision < 0 || precision > MaxPrecision {
+		return Money{}, fmt.Errorf("%w: %d", ErrInvalidPrecision, precision)
+	}
+	return Money{
+		amount:    amount,
+		asset:     strings.ToUpper(strings.TrimSpace(asset)),
+		precision: precision,
+	}, nil
+}
+
+// Must is like New but panics on an invalid precision. It is intended for constants.
+func Must(amount decimal.Decimal, asset string, precision int32) Money {
+	m, err := New(amount, asset, precision)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// Parse constructs a Money from a decimal string such as "12.50".
+func Parse(amount, asset string, precision int32) (Money, error) {
+	value, err := decimal.NewFromString(strings.TrimSpace(amount))
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidAmount, amount)
+	}
+	return New(value, asset, precision)
+}
+
+// Zero returns a zero amount of the asset.
+func Zero(asset string, precision int32) Money {
+	return Must(decimal.Zero, asset, precision)
+}
+
+// USD constructs a USD amount.
+func USD(amount decimal.Decimal) Money {
+	return Must(amount, AssetUSD, USDPrecision)
+}
+
+// FromFloat lifts a float64 onto Money, rounding to the precision. It is the only place a float
+// enters the package and should be reserved for values already stored as floats; the result is
+// exact only up to the float's own representation error.
+func FromFloat(value float64, asset string, precision int32) (Money, error) {
+	if precision < 0 || precision > MaxPrecision {
+		return Money{}, fmt.Errorf("%w: %d", ErrInvalidPrecision, precision)
+	}
+	return New(decimal.NewFromFloat(value).Round(precision), asset, precision)
+}
+
+// USDFromFloat is FromFloat for USD amounts.
+func USDFromFloat(value float64) Money {
+	m, _ := FromFloat(value, AssetUSD, USDPrecision)
+	return m
+}
+
+// Amount returns the unrounded decimal amount.
+func (m Money) Amount() decimal.Decimal {
+	return m.amount
+}
+
+// Asset returns the asset code.
+func (m Money) Asset() string {
+	return m.asset
+}
+
+// Precision returns the number of fractional digits the amount is rendered with.
+func (m Money) Precision() int32 {
+	return m.precision
+}
+
+// Rounded returns the amount rounded half away from zero to the precision.
+func (m Money) Rounded() decimal.Decimal {
+	return m.amount.Round(m.precision)
+}
+
+// String renders the amount with exactly Precision fractional digits, e.g. "12.50".
+func (m Money) String() string {
+	return m.amount.StringFixed(m.precision)
+}
+
+// Display renders the amount followed by its asset, e.g. "12.50 USD".
+func (m Money) Display() string {
+	if m.asset == "" {
+		return m.String()
+	}
+	return m.String() + " " + m.asset
+}
+
+// IsZero reports whether the amount is zero.
+func (m Money) IsZero() bool {
+	return m.amount.IsZero()
+}
+
+// IsNegative reports whether the amount is below zero.
+func (m Money) IsNegative() bool {
+	return m.amount.IsNegative()
+}
+
+// Add returns m + other. Both amounts must share an asset; the larger precision is kept.
+func (m Money) Add(other Money) (Money, error) {
+	if err := m.compatible(other); err != nil {
+		return Money{}, err
+	}
+	return m.with(m.amount.Add(other.amount), other), nil
+}
+
+// Sub returns m - other. Both amounts must share an asset; the larger precision is kept.
+func (m Money) Sub(other Money) (Money, error) {
+	if err := m.compatible(other); err != nil {
+		return Money{}, err
+	}
+	return m.with(m.amount.Sub(other.amount), other), nil
+}
+
+// Cmp compares m with other, returning -1, 0 or +1. Both amounts must share an asset.
+func (m Money) Cmp(other Money) (int, error) {
+	if err := m.compatible(other); err != nil {
+		return 0, err
+	}
+	return m.amount.Cmp(other.amount), nil
+}
+
+// Mul scales the amount by factor, e.g. an exchange rate or a fee ratio.
+func (m Money) Mul(factor decimal.Decimal) Money {
+	m.amount = m.amount.Mul(factor)
+	return m
+}
+
+// Div divides the amount by divisor.
+func (m Money) Div(divisor decimal.Decimal) (Money, error) {
+	if divisor.IsZero() {
+		return Money{}, ErrDivisionByZero
+	}
+	m.amount = m.amount.Div(divisor)
+	return m, nil
+}
+
+// Neg returns the amount with its sign flipped.
+func (m Money) Neg() Money {
+	m.amount = m.amount.Neg()
+	return m
+}
+
+// Convert expresses the amount in another asset using rate units of the target per unit of m.
+func (m Money) Convert(rate decimal.Decimal, asset string, precision int32) (Money, error) {
+	return New(m.amount.Mul(rate), asset, precision)
+}
+
+// MarshalJSON renders the amount as a JSON string with exactly Precision fractional digits so
+// clients never parse monetary values as binary floats.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+// UnmarshalJSON accepts a JSON string or number. Numbers are parsed from their literal text,
+// never through float64. The asset is kept; when no precision was set the literal's own
+// fractional digits are used.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	raw := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidAmount, data)
+		}
+	}
+
+	value, err := decimal.NewFromString(strings.TrimSpace(raw))
+	if err != nil {
+		return fmt.Errorf("%w: %q", ErrInvalidAmount, raw)
+	}
+
+	m.amount = value
+	if m.precision == 0 && value.Exponent() < 0 {
+		m.precision = min(-value.Exponent(), MaxPrecision)
+	}
+	return nil
+}
+
+func (m Money) compatible(other Money) error {
+	if m.asset != other.asset {
+		return fmt.Errorf("%w: %s and %s", ErrAssetMismatch, m.asset, other.asset)
+	}
+	return nil
+}
+
+func (m Money) with(amount decimal.Decimal, other Money) Money {
+	m.amount = amount
+	m.precision = max(m.precision, other.precision)
+	return m
+}
@@ -73,6 +73,16 @@ func ToErrorResponse(err error) (ErrorResponse, int) {
 		return ErrorResponse{Code: code, Message: message, Details: appErr.Details}, status
 	}
 
+	var capErr *repositories.PaginationCapError
+	if errors.As(err, &capErr) {
+		return ErrorResponse{Code: code, Message: message, Details: map[string]any{
+			"field":     capErr.Field,
+			"requested": capErr.Requested,
+			"max":       capErr.Max,
+			"hint":      capErr.Hint(),
+		}}, status
+	}
+
 	return ErrorResponse{Code: code, Message: message}, status
 }
 
@@ -85,6 +95,8 @@ func HTTPStatusFromError(err error) int {
 		return http.StatusNotFound
 	case errors.Is(err, repositories.ErrDuplicate):
 		return http.StatusConflict
+	case errors.Is(err, repositories.ErrPaginationCapExceeded):
+		return http.StatusUnprocessableEntity
 	case errors.Is(err, context.Canceled):
 		return http.StatusRequestTimeout
 	case errors.Is(err, context.DeadlineExceeded):
@@ -125,6 +137,8 @@ func ErrorCodeFromError(err error) string {
 		return "NOT_FOUND"
 	case errors.Is(err, repositories.ErrDuplicate):
 		return "DUPLICATE"
+	case errors.Is(err, repositories.ErrPaginationCapExceeded):
+		return "PAGINATION_CAP_EXCEEDED"
 	case errors.Is(err, context.Canceled):
 		return "REQUEST_CANCELED"
 	case errors.Is(err, context.DeadlineExceeded):
@@ -155,6 +169,8 @@ func SanitizeErrorMessage(err error) string {
 		return "resource not found"
 	case errors.Is(err, repositories.ErrDuplicate):
 		return "resource already exists"
+	case errors.Is(err, repositories.ErrPaginationCapExceeded):
+		return "page is too large or too deep; lower limit, or page forward with the cursor from the previous response instead of offset"
 	case errors.Is(err, context.Canceled):
 		return "request was canceled"
 	case errors.Is(err, context.DeadlineExceeded):
@@ -173,3 +189,20 @@ func IsNotFound(err error) bool {
 func IsDuplicate(err error) bool {
 	return errors.Is(err, repositories.ErrDuplicate)
 }
+
+// ErrRequestTimeout reports that a request ran past the deadline the request timeout middleware
+// set on its context.
+var ErrRequestTimeout = NewAppError("REQUEST_TIMEOUT", "request timed out; try again later", http.StatusServiceUnavailable, nil, nil)
+
+// RequestContextError replaces a deadline error with ErrRequestTimeout when it was the request's
+// own deadline that passed, so the client sees 503 rather than the 504 a bare deadline maps to.
+// Deadlines set further down, such as execution budgets, are left as they are.
+func RequestContextError(ctx context.Context, err error) error {
+	if err == nil || ctx == nil || !errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	if cause := context.Cause(ctx); errors.Is(cause, ErrRequestTimeout) {
+		return cause
+	}
+	return err
+}
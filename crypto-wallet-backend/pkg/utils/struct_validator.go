@@ -0,0 +1,328 @@
+package utils
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ValidateStruct checks a struct against the rules in its `validate` tags and reports every failing
+// field under its json, query or form name. Rules are comma separated and apply in order:
+//
+//	required          the value is not empty; strings must contain more than whitespace
+//	omitempty         skips the remaining rules when the value is empty
+//	email, uuid, url  the string is a valid address, UUID or absolute URL
+//	numeric           the string is a decimal number
+//	rfc3339           the string is an RFC3339 timestamp
+//	oneof=a b c       the string or number is one of the space separated values
+//	len, min, max     the length of a string or slice, or the value of a number
+//	gt, gte, lt, lte  the value of a number, or of a numeric string
+//	eqfield=Field     the value equals another field of the same struct
+//	dive              applies the rules after it to each element of a slice
+//
+// Nested structs and pointers to structs are validated recursively, their fields named
+// parent.child. Pointers are dereferenced; a nil pointer is empty.
+func ValidateStruct(value any) ValidationErrors {
+	errs := ValidationErrors{}
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return errs
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return errs
+	}
+	validateStruct(&errs, "", v)
+	return errs
+}
+
+// fieldRules is the parsed `validate` tag of one struct field.
+type fieldRules struct {
+	index int
+	name  string
+	rules []fieldRule
+}
+
+type fieldRule struct {
+	name  string
+	param string
+}
+
+var structRulesCache sync.Map // reflect.Type -> []fieldRules
+
+func rulesFor(t reflect.Type) []fieldRules {
+	if cached, ok := structRulesCache.Load(t); ok {
+		return cached.([]fieldRules)
+	}
+
+	fields := make([]fieldRules, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag := field.Tag.Get("validate")
+		if tag == "-" {
+			continue
+		}
+		rules := make([]fieldRule, 0)
+		for _, raw := range strings.Split(tag, ",") {
+			raw = strings.TrimSpace(raw)
+			if raw == "" {
+				continue
+			}
+			name, param, _ := strings.Cut(raw, "=")
+			rules = append(rules, fieldRule{name: name, param: param})
+		}
+		fields = append(fields, fieldRules{index: i, name: fieldName(field), rules: rules})
+	}
+
+	structRulesCache.Store(t, fields)
+	return fields
+}
+
+// fieldName returns the name a client uses for the field.
+func fieldName(field reflect.StructField) string {
+	for _, key := range []string{"json", "query", "form"} {
+		name, _, _ := strings.Cut(field.Tag.Get(key), ",")
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+	return field.Name
+}
+
+func validateStruct(errs *ValidationErrors, prefix string, v reflect.Value) {
+	for _, field := range rulesFor(v.Type()) {
+		name := field.name
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+		value := v.Field(field.index)
+		validateValue(errs, name, value, v, field.rules)
+	}
+}
+
+func validateValue(errs *ValidationErrors, name string, value, parent reflect.Value, rules []fieldRule) {
+	for i, rule := range rules {
+		switch rule.name {
+		case "omitempty":
+			if isEmptyValue(value) {
+				return
+			}
+			continue
+		case "required":
+			if isEmptyValue(value) {
+				errs.Add(name, "is required")
+				return
+			}
+			continue
+		case "dive":
+			elem := indirect(value)
+			if elem.Kind() != reflect.Slice && elem.Kind() != reflect.Array {
+				return
+			}
+			for j := 0; j < elem.Len(); j++ {
+				validateValue(errs, fmt.Sprintf("%s[%d]", name, j), elem.Index(j), parent, rules[i+1:])
+			}
+			return
+		}
+
+		if message := checkRule(rule, indirect(value), parent); message != "" {
+			errs.Add(name, message)
+			return
+		}
+	}
+
+	if nested := indirect(value); nested.Kind() == reflect.Struct && !isLeafStruct(nested.Type()) {
+		validateStruct(errs, name, nested)
+	}
+}
+
+// checkRule returns the failure message of a rule, or an empty string when the value passes.
+func checkRule(rule fieldRule, value, parent reflect.Value) string {
+	if !value.IsValid() {
+		return ""
+	}
+
+	switch rule.name {
+	case "email":
+		if _, err := mail.ParseAddress(strings.TrimSpace(value.String())); err != nil {
+			return "must be a valid email address"
+		}
+	case "uuid":
+		if _, err := uuid.Parse(strings.TrimSpace(value.String())); err != nil {
+			return "must be a valid UUID"
+		}
+	case "url":
+		parsed, err := url.Parse(strings.TrimSpace(value.String()))
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			return "must be an absolute URL"
+		}
+	case "numeric":
+		if _, err := decimal.NewFromString(strings.TrimSpace(value.String())); err != nil {
+			return "must be numeric"
+		}
+	case "rfc3339":
+		if _, err := time.Parse(time.RFC3339, strings.TrimSpace(value.String())); err != nil {
+			return "must be a valid RFC3339 datetime"
+		}
+	case "oneof":
+		allowed := strings.Fields(rule.param)
+		current := fmt.Sprint(value.Interface())
+		for _, candidate := range allowed {
+			if current == candidate {
+				return ""
+			}
+		}
+		return fmt.Sprintf("must be one of %s", strings.Join(allowed, ", "))
+	case "len", "min", "max":
+		return checkSize(rule, value)
+	case "gt", "gte", "lt", "lte":
+		return checkBound(rule, value)
+	case "eqfield":
+		other := parent.FieldByName(rule.param)
+		if !other.IsValid() {
+			return ""
+		}
+		if !reflect.DeepEqual(value.Interface(), indirect(other).Interface()) {
+			name := rule.param
+			if field, ok := parent.Type().FieldByName(rule.param); ok {
+				name = fieldName(field)
+			}
+			return fmt.Sprintf("does not match %s", name)
+		}
+	default:
+		panic(fmt.Sprintf("utils: unknown validation rule %q", rule.name))
+	}
+	return ""
+}
+
+// checkSize applies len, min and max to the length of strings and slices and the value of numbers.
+func checkSize(rule fieldRule, value reflect.Value) string {
+	limit, err := strconv.ParseFloat(rule.param, 64)
+	if err != nil {
+		panic(fmt.Sprintf("utils: invalid %s parameter %q", rule.name, rule.param))
+	}
+
+	var (
+		size float64
+		unit string
+	)
+	switch value.Kind() {
+	case reflect.String:
+		size, unit = float64(utf8.RuneCountInString(value.String())), " characters"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		size, unit = float64(value.Len()), " items"
+	default:
+		number, ok := numericValue(value)
+		if !ok {
+			return ""
+		}
+		size = number
+	}
+
+	switch {
+	case rule.name == "len" && size != limit:
+		return fmt.Sprintf("must be exactly %s%s", rule.param, unit)
+	case rule.name == "min" && size < limit:
+		if unit == "" {
+			return fmt.Sprintf("must be at least %s", rule.param)
+		}
+		return fmt.Sprintf("must be at least %s%s", rule.param, unit)
+	case rule.name == "max" && size > limit:
+		if unit == "" {
+			return fmt.Sprintf("must be at most %s", rule.param)
+		}
+		return fmt.Sprintf("must be at most %s%s", rule.param, unit)
+	}
+	return ""
+}
+
+// checkBound compares numbers, and strings holding numbers, against the rule's parameter.
+func checkBound(rule fieldRule, value reflect.Value) string {
+	limit, err := decimal.NewFromString(rule.param)
+	if err != nil {
+		panic(fmt.Sprintf("utils: invalid %s parameter %q", rule.name, rule.param))
+	}
+
+	var current decimal.Decimal
+	if value.Kind() == reflect.String {
+		parsed, err := decimal.NewFromString(strings.TrimSpace(value.String()))
+		if err != nil {
+			return "must be numeric"
+		}
+		current = parsed
+	} else if number, ok := numericValue(value); ok {
+		current = decimal.NewFromFloat(number)
+	} else {
+		return ""
+	}
+
+	switch {
+	case rule.name == "gt" && !current.GreaterThan(limit):
+		return fmt.Sprintf("must be greater than %s", rule.param)
+	case rule.name == "gte" && current.LessThan(limit):
+		return fmt.Sprintf("must be greater than or equal to %s", rule.param)
+	case rule.name == "lt" && !current.LessThan(limit):
+		return fmt.Sprintf("must be less than %s", rule.param)
+	case rule.name == "lte" && current.GreaterThan(limit):
+		return fmt.Sprintf("must be less than or equal to %s", rule.param)
+	}
+	return ""
+}
+
+func numericValue(value reflect.Value) (float64, bool) {
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(value.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+func indirect(value reflect.Value) reflect.Value {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+	return value
+}
+
+func isEmptyValue(value reflect.Value) bool {
+	value = indirect(value)
+	if !value.IsValid() {
+		return true
+	}
+	if value.Kind() == reflect.String {
+		return strings.TrimSpace(value.String()) == ""
+	}
+	return value.IsZero()
+}
+
+var (
+	timeType    = reflect.TypeOf(time.Time{})
+	decimalType = reflect.TypeOf(decimal.Decimal{})
+)
+
+// isLeafStruct reports whether a struct is a value such as a timestamp rather than a nested request.
+func isLeafStruct(t reflect.Type) bool {
+	return t == timeType || t == decimalType
+}
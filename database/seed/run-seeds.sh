@@ -12,19 +12,33 @@ Required environment variables:
   AUDIT_DB_DSN  - connection string for the audit database
 
 Optional flags:
-  --dry-run      Display commands without executing them
+  --dry-run           Display commands without executing them
+  --sandbox <file>    Also load a synthetic core_db dataset written by
+                      "walletctl sandbox generate"
 USAGE
 }
 
 dry_run=false
-for arg in "$@"; do
-  case "$arg" in
+sandbox_file=""
+while [[ $# -gt 0 ]]; do
+  case "$1" in
     --dry-run) dry_run=true ;;
+    --sandbox)
+      if [[ -z "${2:-}" ]]; then
+        echo "--sandbox requires a file" >&2; print_usage; exit 1
+      fi
+      sandbox_file="$2"; shift ;;
     --help|-h) print_usage; exit 0 ;;
-    *) echo "Unknown argument: $arg" >&2; print_usage; exit 1 ;;
+    *) echo "Unknown argument: $1" >&2; print_usage; exit 1 ;;
   esac
+  shift
 done
 
+if [[ -n "$sandbox_file" && ! -f "$sandbox_file" ]]; then
+  echo "Sandbox dataset not found: $sandbox_file" >&2
+  exit 1
+fi
+
 require_dsn() {
   local name="$1"
   local value="$2"
@@ -58,4 +72,8 @@ run_psql "${KYC_DB_DSN}"    "${SCRIPT_DIR}/kyc_db_seed.sql"
 run_psql "${RATES_DB_DSN}"  "${SCRIPT_DIR}/rates_db_seed.sql"
 run_psql "${AUDIT_DB_DSN}"  "${SCRIPT_DIR}/audit_db_seed.sql"
 
+if [[ -n "$sandbox_file" ]]; then
+  run_psql "${CORE_DB_DSN}" "$sandbox_file"
+fi
+
 echo "Seed data applied successfully."